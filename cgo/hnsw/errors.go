@@ -0,0 +1,7 @@
+package hnsw
+
+import "errors"
+
+// ErrLoadFailed indicates a persisted index exists but could not be opened.
+// The index is corrupt or was built with a different embedding dimension.
+var ErrLoadFailed = errors.New("hnsw: failed to load index")
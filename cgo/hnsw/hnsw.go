@@ -14,14 +14,21 @@ import "C"
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"unsafe"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Index implements the interface.
-var _ driven.VectorIndex = (*Index)(nil)
+// Ensure Index implements the interfaces.
+var (
+	_ driven.VectorIndex    = (*Index)(nil)
+	_ driven.ChunkLister    = (*Index)(nil)
+	_ driven.MetricReporter = (*Index)(nil)
+)
 
 // Default configuration values
 const (
@@ -52,6 +59,8 @@ type Index struct {
 
 // New creates or opens an HNSW index with the specified storage precision.
 // The precision parameter only affects disk storage; runtime always uses float32.
+// Returns ErrLoadFailed if an index exists at path but cannot be opened; it is
+// left in place rather than replaced with an empty one.
 func New(path string, dimension int, precision Precision) (*Index, error) {
 	if path == "" {
 		return nil, errors.New("hnsw: path cannot be empty")
@@ -66,6 +75,10 @@ func New(path string, dimension int, precision Precision) (*Index, error) {
 	// Try to open existing index first
 	idx := C.hnsw_open(cpath, C.int(dimension))
 	if idx == nil {
+		if indexExists(path) {
+			return nil, fmt.Errorf("%w at %s", ErrLoadFailed, path)
+		}
+
 		// Create new index with specified precision
 		idx = C.hnsw_create(cpath, C.int(dimension), C.int(DefaultMaxElements), C.HnswPrecision(precision))
 		if idx == nil {
@@ -81,6 +94,12 @@ func New(path string, dimension int, precision Precision) (*Index, error) {
 	}, nil
 }
 
+// indexExists reports whether an index has been saved at path.
+func indexExists(path string) bool {
+	_, err := os.Stat(filepath.Join(path, "id_mapping.bin"))
+	return err == nil
+}
+
 // Add inserts a vector for the given chunk ID.
 func (idx *Index) Add(_ context.Context, chunkID string, embedding []float32) error {
 	idx.mu.Lock()
@@ -131,6 +150,12 @@ func (idx *Index) Delete(_ context.Context, chunkID string) error {
 	return nil
 }
 
+// Metric returns VectorMetricCosine: vectors are normalised on insert and
+// compared by inner product.
+func (idx *Index) Metric() driven.VectorMetric {
+	return driven.VectorMetricCosine
+}
+
 // Search finds the k nearest neighbours to the query vector.
 func (idx *Index) Search(_ context.Context, query []float32, k int) ([]driven.VectorHit, error) {
 	idx.mu.RLock()
@@ -157,6 +182,58 @@ func (idx *Index) Search(_ context.Context, query []float32, k int) ([]driven.Ve
 		&results,
 	)
 
+	return convertResults(results, count)
+}
+
+// SearchWithFilter finds the k nearest neighbours among the candidate chunk IDs.
+// Vectors outside the candidate set are never returned.
+func (idx *Index) SearchWithFilter(
+	_ context.Context, query []float32, k int, candidates []string,
+) ([]driven.VectorHit, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.idx == nil {
+		return nil, errors.New("hnsw: index is closed")
+	}
+
+	if len(query) != idx.dimension {
+		return nil, errors.New("hnsw: query dimension mismatch")
+	}
+
+	if k <= 0 || len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// Build a C array of candidate IDs
+	cCandidates := (**C.char)(C.malloc(C.size_t(len(candidates)) * C.size_t(unsafe.Sizeof((*C.char)(nil)))))
+	defer C.free(unsafe.Pointer(cCandidates))
+	ids := unsafe.Slice(cCandidates, len(candidates))
+	for i, id := range candidates {
+		ids[i] = C.CString(id)
+	}
+	defer func() {
+		for _, id := range ids {
+			C.free(unsafe.Pointer(id))
+		}
+	}()
+
+	var results *C.HnswSearchResult
+	count := C.hnsw_search_filtered(
+		idx.idx,
+		(*C.float)(unsafe.Pointer(&query[0])),
+		C.int(idx.dimension),
+		C.int(k),
+		cCandidates,
+		C.int(len(candidates)),
+		&results,
+	)
+
+	return convertResults(results, count)
+}
+
+// convertResults copies C search results into Go hits and frees them.
+func convertResults(results *C.HnswSearchResult, count C.int) ([]driven.VectorHit, error) {
 	if count < 0 {
 		return nil, errors.New("hnsw: search failed")
 	}
@@ -181,6 +258,32 @@ func (idx *Index) Search(_ context.Context, query []float32, k int) ([]driven.Ve
 	return hits, nil
 }
 
+// ChunkIDs returns the ID of every vector in the index.
+func (idx *Index) ChunkIDs(_ context.Context) ([]string, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.idx == nil {
+		return nil, errors.New("hnsw: index is closed")
+	}
+
+	var cIDs **C.char
+	count := C.hnsw_list_ids(idx.idx, &cIDs)
+	if count < 0 {
+		return nil, errors.New("hnsw: failed to list vectors")
+	}
+	if count == 0 || cIDs == nil {
+		return nil, nil
+	}
+	defer C.hnsw_free_ids(cIDs, count)
+
+	ids := make([]string, int(count))
+	for i, id := range unsafe.Slice(cIDs, int(count)) {
+		ids[i] = C.GoString(id)
+	}
+	return ids, nil
+}
+
 // Close releases resources.
 func (idx *Index) Close() error {
 	idx.mu.Lock()
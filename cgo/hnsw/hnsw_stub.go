@@ -9,8 +9,12 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Index implements the interface.
-var _ driven.VectorIndex = (*Index)(nil)
+// Ensure Index implements the interfaces.
+var (
+	_ driven.VectorIndex    = (*Index)(nil)
+	_ driven.ChunkLister    = (*Index)(nil)
+	_ driven.MetricReporter = (*Index)(nil)
+)
 
 // Precision defines the storage precision for vectors.
 // Runtime operations always use float32; this only affects disk storage.
@@ -53,11 +57,26 @@ func (idx *Index) Delete(_ context.Context, _ string) error {
 	return domain.ErrNotImplemented
 }
 
+// Metric returns VectorMetricCosine.
+func (idx *Index) Metric() driven.VectorMetric {
+	return driven.VectorMetricCosine
+}
+
 // Search finds the k nearest neighbors to the query vector.
 func (idx *Index) Search(_ context.Context, _ []float32, _ int) ([]driven.VectorHit, error) {
 	return nil, domain.ErrNotImplemented
 }
 
+// SearchWithFilter finds the k nearest neighbors among the candidate chunk IDs.
+func (idx *Index) SearchWithFilter(_ context.Context, _ []float32, _ int, _ []string) ([]driven.VectorHit, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// ChunkIDs returns the ID of every vector in the index.
+func (idx *Index) ChunkIDs(_ context.Context) ([]string, error) {
+	return nil, domain.ErrNotImplemented
+}
+
 // Close releases resources.
 func (idx *Index) Close() error {
 	return nil
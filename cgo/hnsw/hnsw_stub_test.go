@@ -0,0 +1,32 @@
+//go:build !cgo
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestIndex_SearchWithFilter_Stub(t *testing.T) {
+	idx, err := New(t.TempDir(), 4, PrecisionFloat32)
+	assert.NoError(t, err)
+
+	hits, err := idx.SearchWithFilter(context.Background(), []float32{1, 0, 0, 0}, 5, []string{"chunk-0"})
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+	assert.Nil(t, hits)
+}
+
+func TestIndex_ChunkIDs_Stub(t *testing.T) {
+	idx, err := New(t.TempDir(), 4, PrecisionFloat32)
+	assert.NoError(t, err)
+
+	ids, err := idx.ChunkIDs(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+	assert.Nil(t, ids)
+}
@@ -0,0 +1,164 @@
+//go:build cgo
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestIndex creates an index with n 4-dimensional vectors named chunk-0..chunk-(n-1).
+// Each vector points mostly along one axis so neighbours are predictable.
+func newTestIndex(t *testing.T, n int) *Index {
+	t.Helper()
+
+	idx, err := New(t.TempDir(), 4, PrecisionFloat32)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = idx.Close() })
+
+	ctx := context.Background()
+	for i := 0; i < n; i++ {
+		vec := []float32{0.1, 0.1, 0.1, 0.1}
+		vec[i%4] = 1
+		vec[(i+1)%4] += float32(i) / float32(n)
+		require.NoError(t, idx.Add(ctx, fmt.Sprintf("chunk-%d", i), vec))
+	}
+	return idx
+}
+
+func TestIndex_SearchWithFilter(t *testing.T) {
+	ctx := context.Background()
+	query := []float32{1, 0, 0, 0}
+
+	t.Run("never returns hits outside candidates", func(t *testing.T) {
+		idx := newTestIndex(t, 40)
+		candidates := []string{"chunk-1", "chunk-6", "chunk-13", "chunk-22", "chunk-39"}
+		allowed := make(map[string]bool)
+		for _, id := range candidates {
+			allowed[id] = true
+		}
+
+		hits, err := idx.SearchWithFilter(ctx, query, 10, candidates)
+
+		require.NoError(t, err)
+		require.Len(t, hits, len(candidates))
+		for _, hit := range hits {
+			assert.True(t, allowed[hit.ChunkID], "unexpected hit %s", hit.ChunkID)
+		}
+		for i := 1; i < len(hits); i++ {
+			assert.GreaterOrEqual(t, hits[i-1].Similarity, hits[i].Similarity)
+		}
+	})
+
+	t.Run("excludes nearer vectors outside candidates", func(t *testing.T) {
+		idx := newTestIndex(t, 8)
+
+		unfiltered, err := idx.Search(ctx, query, 1)
+		require.NoError(t, err)
+		require.Len(t, unfiltered, 1)
+		assert.Equal(t, "chunk-0", unfiltered[0].ChunkID)
+
+		hits, err := idx.SearchWithFilter(ctx, query, 1, []string{"chunk-2"})
+
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, "chunk-2", hits[0].ChunkID)
+	})
+
+	t.Run("respects k", func(t *testing.T) {
+		idx := newTestIndex(t, 20)
+
+		hits, err := idx.SearchWithFilter(ctx, query, 2, []string{"chunk-0", "chunk-4", "chunk-8", "chunk-12"})
+
+		require.NoError(t, err)
+		assert.Len(t, hits, 2)
+	})
+
+	t.Run("skips unknown and deleted candidates", func(t *testing.T) {
+		idx := newTestIndex(t, 8)
+		require.NoError(t, idx.Delete(ctx, "chunk-4"))
+
+		hits, err := idx.SearchWithFilter(ctx, query, 5, []string{"chunk-4", "missing", "chunk-0"})
+
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, "chunk-0", hits[0].ChunkID)
+	})
+
+	t.Run("returns nothing for empty candidates", func(t *testing.T) {
+		idx := newTestIndex(t, 8)
+
+		hits, err := idx.SearchWithFilter(ctx, query, 5, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+	})
+
+	t.Run("rejects dimension mismatch", func(t *testing.T) {
+		idx := newTestIndex(t, 1)
+
+		_, err := idx.SearchWithFilter(ctx, []float32{1, 0}, 5, []string{"chunk-0"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestIndex_ChunkIDs(t *testing.T) {
+	ctx := context.Background()
+	idx := newTestIndex(t, 4)
+	require.NoError(t, idx.Delete(ctx, "chunk-2"))
+
+	ids, err := idx.ChunkIDs(ctx)
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"chunk-0", "chunk-1", "chunk-3"}, ids)
+
+	empty := newTestIndex(t, 0)
+	ids, err = empty.ChunkIDs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestNew_ReopensSavedIndex(t *testing.T) {
+	dir := t.TempDir()
+	idx, err := New(dir, 4, PrecisionFloat32)
+	require.NoError(t, err)
+	require.NoError(t, idx.Add(context.Background(), "chunk-0", []float32{1, 0, 0, 0}))
+	require.NoError(t, idx.Close())
+
+	reopened, err := New(dir, 4, PrecisionFloat32)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	hits, err := reopened.Search(context.Background(), []float32{1, 0, 0, 0}, 1)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "chunk-0", hits[0].ChunkID)
+}
+
+func TestNew_DimensionMismatch(t *testing.T) {
+	dir := t.TempDir()
+	idx, err := New(dir, 4, PrecisionFloat32)
+	require.NoError(t, err)
+	require.NoError(t, idx.Add(context.Background(), "chunk-0", []float32{1, 0, 0, 0}))
+	require.NoError(t, idx.Close())
+
+	_, err = New(dir, 8, PrecisionFloat32)
+
+	assert.ErrorIs(t, err, ErrLoadFailed)
+}
+
+func TestNew_CorruptIndex(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "id_mapping.bin"), []byte("garbage"), 0o600))
+
+	_, err := New(dir, 4, PrecisionFloat32)
+
+	assert.ErrorIs(t, err, ErrLoadFailed)
+}
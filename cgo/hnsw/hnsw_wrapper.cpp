@@ -10,6 +10,7 @@
 #include "hnsw_wrapper.h"
 #include <hnswlib/hnswlib.h>
 #include <unordered_map>
+#include <unordered_set>
 #include <vector>
 #include <string>
 #include <fstream>
@@ -353,6 +354,11 @@ static bool load_id_mappings(HnswIndex* idx) {
     // Read next_label
     in.read(reinterpret_cast<char*>(&idx->next_label), sizeof(idx->next_label));
 
+    // A truncated header leaves count undefined
+    if (!in.good()) {
+        return false;
+    }
+
     // Read each mapping
     idx->label_to_id.resize(count);
     idx->id_to_label.clear();
@@ -376,6 +382,20 @@ static bool load_id_mappings(HnswIndex* idx) {
     return in.good();
 }
 
+// Filter functor restricting HNSW search to a set of labels
+class CandidateFilter : public hnswlib::BaseFilterFunctor {
+public:
+    explicit CandidateFilter(std::unordered_set<hnswlib::labeltype> labels)
+        : labels_(std::move(labels)) {}
+
+    bool operator()(hnswlib::labeltype label) override {
+        return labels_.count(label) > 0;
+    }
+
+private:
+    std::unordered_set<hnswlib::labeltype> labels_;
+};
+
 extern "C" {
 
 HnswIndex* hnsw_create(const char* path, int dimension, int max_elements, HnswPrecision precision) {
@@ -455,6 +475,15 @@ HnswIndex* hnsw_open(const char* path, int dimension) {
             }
             idx->hnsw = new hnswlib::HierarchicalNSW<float>(idx->space, index_path);
             idx->max_elements = idx->hnsw->max_elements_;
+
+            // hnswlib trusts the caller's dimension; reject an index built with another
+            size_t stored_data_size = idx->hnsw->label_offset_ - idx->hnsw->offsetData_;
+            if (stored_data_size != idx->space->get_data_size()) {
+                delete idx->hnsw;
+                delete idx->space;
+                delete idx;
+                return nullptr;
+            }
         } else {
             // For compressed storage, create empty HNSW and load vectors
             size_t max_elements = idx->label_to_id.size();
@@ -633,6 +662,77 @@ int hnsw_search(HnswIndex* index, const float* query, int dimension, int k,
     }
 }
 
+int hnsw_search_filtered(HnswIndex* index, const float* query, int dimension, int k,
+                         const char** candidate_ids, int candidate_count,
+                         HnswSearchResult** results) {
+    if (index == nullptr || query == nullptr || results == nullptr || k <= 0) {
+        return -1;
+    }
+
+    if (dimension != index->dimension || candidate_count < 0) {
+        return -1;
+    }
+
+    if (candidate_count > 0 && candidate_ids == nullptr) {
+        return -1;
+    }
+
+    std::lock_guard<std::mutex> lock(index->mutex);
+
+    try {
+        // Map candidate chunk IDs to labels (unknown or deleted IDs are skipped)
+        std::unordered_set<hnswlib::labeltype> labels;
+        labels.reserve(static_cast<size_t>(candidate_count));
+        for (int i = 0; i < candidate_count; i++) {
+            if (candidate_ids[i] == nullptr) {
+                continue;
+            }
+            auto it = index->id_to_label.find(candidate_ids[i]);
+            if (it != index->id_to_label.end()) {
+                labels.insert(it->second);
+            }
+        }
+
+        if (labels.empty()) {
+            *results = nullptr;
+            return 0;
+        }
+
+        // Normalize query vector
+        std::vector<float> normalized(query, query + dimension);
+        normalize_vector(normalized.data(), dimension);
+
+        // Search only among candidates (results are ordered closest first)
+        size_t limit = std::min(static_cast<size_t>(k), labels.size());
+        CandidateFilter filter(std::move(labels));
+        auto found = index->hnsw->searchKnnCloserFirst(normalized.data(), limit, &filter);
+
+        if (found.empty()) {
+            *results = nullptr;
+            return 0;
+        }
+
+        // Allocate results array
+        int count = static_cast<int>(found.size());
+        *results = static_cast<HnswSearchResult*>(malloc(sizeof(HnswSearchResult) * count));
+        if (*results == nullptr) {
+            return -1;
+        }
+
+        for (int i = 0; i < count; i++) {
+            hnswlib::labeltype label = found[i].second;
+            const std::string& chunk_id = index->label_to_id[label];
+
+            (*results)[i].chunk_id = strdup(chunk_id.c_str());
+            (*results)[i].similarity = 1.0f - found[i].first;  // Convert distance to similarity
+        }
+
+        return count;
+    } catch (...) {
+        return -1;
+    }
+}
+
 void hnsw_free_results(HnswSearchResult* results, int count) {
     if (results != nullptr) {
         for (int i = 0; i < count; i++) {
@@ -642,6 +742,45 @@ void hnsw_free_results(HnswSearchResult* results, int count) {
     }
 }
 
+int hnsw_list_ids(HnswIndex* index, char*** ids) {
+    if (index == nullptr || ids == nullptr) {
+        return -1;
+    }
+
+    std::lock_guard<std::mutex> lock(index->mutex);
+
+    try {
+        *ids = nullptr;
+        int count = static_cast<int>(index->id_to_label.size());
+        if (count == 0) {
+            return 0;
+        }
+
+        *ids = static_cast<char**>(malloc(sizeof(char*) * count));
+        if (*ids == nullptr) {
+            return -1;
+        }
+
+        int i = 0;
+        for (const auto& entry : index->id_to_label) {
+            (*ids)[i++] = strdup(entry.first.c_str());
+        }
+
+        return count;
+    } catch (...) {
+        return -1;
+    }
+}
+
+void hnsw_free_ids(char** ids, int count) {
+    if (ids != nullptr) {
+        for (int i = 0; i < count; i++) {
+            free(ids[i]);
+        }
+        free(ids);
+    }
+}
+
 void hnsw_close(HnswIndex* index) {
     if (index == nullptr) {
         return;
@@ -45,9 +45,23 @@ int hnsw_delete(HnswIndex* index, const char* chunk_id);
 int hnsw_search(HnswIndex* index, const float* query, int dimension, int k,
                 HnswSearchResult** results);
 
+// Search for the k nearest neighbors among the given candidate chunk IDs.
+// Vectors whose chunk ID is not in candidate_ids are never returned.
+// Returns the number of results, or -1 on error.
+int hnsw_search_filtered(HnswIndex* index, const float* query, int dimension, int k,
+                         const char** candidate_ids, int candidate_count,
+                         HnswSearchResult** results);
+
 // Free search results.
 void hnsw_free_results(HnswSearchResult* results, int count);
 
+// List the chunk ID of every vector in the index.
+// Returns the number of IDs, or -1 on error.
+int hnsw_list_ids(HnswIndex* index, char*** ids);
+
+// Free a list of chunk IDs.
+void hnsw_free_ids(char** ids, int count);
+
 // Close and free the index.
 void hnsw_close(HnswIndex* index);
 
@@ -0,0 +1,124 @@
+package xapian
+
+import (
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Analyzer rewrites text before it reaches Xapian's term generator.
+// Xapian splits terms on spaces and punctuation and stems English words,
+// which leaves scripts without word boundaries as one term per sentence.
+// The same analyzer must be used for indexing and querying.
+type Analyzer interface {
+	// Analyze rewrites document text for indexing.
+	Analyze(text string) string
+
+	// AnalyzeQuery rewrites a query string so it matches analyzed text.
+	AnalyzeQuery(query string) string
+}
+
+// NewAnalyzer returns the analyzer for a language, defaulting to English.
+func NewAnalyzer(lang domain.Language) Analyzer {
+	if lang == domain.LanguageCJK {
+		return NewNgramAnalyzer(2)
+	}
+	return StandardAnalyzer{}
+}
+
+// StandardAnalyzer leaves text unchanged, relying on Xapian's own word
+// splitting and English stemming.
+type StandardAnalyzer struct{}
+
+// Analyze returns text unchanged.
+func (StandardAnalyzer) Analyze(text string) string { return text }
+
+// AnalyzeQuery returns query unchanged.
+func (StandardAnalyzer) AnalyzeQuery(query string) string { return query }
+
+// NgramAnalyzer splits runs of CJK characters into overlapping n-grams,
+// so "東京都庁" is indexed as "東京 京都 都庁". Other text is left to Xapian.
+//
+// Every CJK character starts a term, so the index grows to roughly one
+// posting per character instead of one per word. Bigrams also match
+// across word boundaries, which costs some precision; queries are turned
+// into phrases to keep results tight.
+type NgramAnalyzer struct {
+	n int
+}
+
+// NewNgramAnalyzer creates an analyzer that emits n-character grams.
+// Values below 1 are treated as 1.
+func NewNgramAnalyzer(n int) *NgramAnalyzer {
+	return &NgramAnalyzer{n: max(n, 1)}
+}
+
+// Analyze replaces each CJK run with its n-grams.
+// Runs shorter than n are kept whole.
+func (a *NgramAnalyzer) Analyze(text string) string {
+	return rewriteCJK(text, func(run []rune, _ bool) string {
+		return strings.Join(ngrams(run, a.n), " ")
+	})
+}
+
+// AnalyzeQuery replaces each CJK run with a phrase of its n-grams, so the
+// grams must appear together. A run shorter than n becomes a prefix
+// wildcard that matches the grams it starts.
+func (a *NgramAnalyzer) AnalyzeQuery(query string) string {
+	return rewriteCJK(query, func(run []rune, inPhrase bool) string {
+		if len(run) < a.n {
+			return string(run) + "*"
+		}
+		grams := ngrams(run, a.n)
+		if len(grams) == 1 || inPhrase {
+			return strings.Join(grams, " ")
+		}
+		return `"` + strings.Join(grams, " ") + `"`
+	})
+}
+
+// ngrams returns the overlapping n-grams of run, or run itself if it is shorter than n.
+func ngrams(run []rune, n int) []string {
+	if len(run) <= n {
+		return []string{string(run)}
+	}
+	grams := make([]string, 0, len(run)-n+1)
+	for i := 0; i+n <= len(run); i++ {
+		grams = append(grams, string(run[i:i+n]))
+	}
+	return grams
+}
+
+// rewriteCJK replaces every run of CJK characters in s with replace(run),
+// padded with spaces so it does not join neighbouring Latin words.
+// inPhrase reports whether the run is inside a double-quoted phrase.
+func rewriteCJK(s string, replace func(run []rune, inPhrase bool) string) string {
+	var b strings.Builder
+	var run []rune
+	inPhrase := false
+
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		b.WriteByte(' ')
+		b.WriteString(replace(run, inPhrase))
+		b.WriteByte(' ')
+		run = run[:0]
+	}
+
+	for _, r := range s {
+		if domain.IsCJK(r) {
+			run = append(run, r)
+			continue
+		}
+		flush()
+		if r == '"' {
+			inPhrase = !inPhrase
+		}
+		b.WriteRune(r)
+	}
+	flush()
+
+	return b.String()
+}
@@ -0,0 +1,90 @@
+package xapian
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// words collapses whitespace so tests do not depend on padding.
+func words(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func TestNewAnalyzer(t *testing.T) {
+	assert.IsType(t, StandardAnalyzer{}, NewAnalyzer(domain.LanguageEnglish))
+	assert.IsType(t, StandardAnalyzer{}, NewAnalyzer(""))
+	assert.IsType(t, &NgramAnalyzer{}, NewAnalyzer(domain.LanguageCJK))
+}
+
+func TestStandardAnalyzer(t *testing.T) {
+	a := StandardAnalyzer{}
+
+	assert.Equal(t, "Running tests 東京", a.Analyze("Running tests 東京"))
+	assert.Equal(t, `"exact phrase" OR word`, a.AnalyzeQuery(`"exact phrase" OR word`))
+}
+
+func TestNgramAnalyzer_Analyze(t *testing.T) {
+	a := NewNgramAnalyzer(2)
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"chinese", "东京都厅", "东京 京都 都厅"},
+		{"japanese kana and kanji", "検索エンジン", "検索 索エ エン ンジ ジン"},
+		{"korean", "검색엔진", "검색 색엔 엔진"},
+		{"prolonged sound mark", "データ", "デー ータ"},
+		{"latin text unchanged", "search engine", "search engine"},
+		{"mixed text splits at script boundary", "iPhone用ケース", "iPhone 用ケ ケー ース"},
+		{"single character kept", "A的B", "A 的 B"},
+		{"punctuation breaks runs", "東京。大阪", "東京 。 大阪"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, words(a.Analyze(tt.text)))
+		})
+	}
+}
+
+func TestNgramAnalyzer_AnalyzeQuery(t *testing.T) {
+	a := NewNgramAnalyzer(2)
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"run becomes phrase", "東京都", `"東京 京都"`},
+		{"two characters stay a term", "東京", "東京"},
+		{"single character becomes prefix", "東", "東*"},
+		{"latin terms unchanged", "東京都 tower", `"東京 京都" tower`},
+		{"existing phrase is not requoted", `"東京都 tower"`, `" 東京 京都 tower"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, words(a.AnalyzeQuery(tt.query)))
+		})
+	}
+}
+
+func TestNgramAnalyzer_QueryMatchesIndexedGrams(t *testing.T) {
+	a := NewNgramAnalyzer(2)
+
+	indexed := strings.Fields(a.Analyze("今日は東京都庁を訪問しました"))
+	query := strings.Trim(words(a.AnalyzeQuery("東京都庁")), `"`)
+
+	for _, gram := range strings.Fields(query) {
+		assert.Contains(t, indexed, gram)
+	}
+}
+
+func TestNewNgramAnalyzer_ClampsSize(t *testing.T) {
+	assert.Equal(t, "東 京", words(NewNgramAnalyzer(0).Analyze("東京")))
+}
@@ -14,6 +14,7 @@ import "C"
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
 	"unsafe"
 
@@ -21,14 +22,19 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Engine implements the interface.
-var _ driven.SearchEngine = (*Engine)(nil)
+// Ensure Engine implements the interfaces.
+var (
+	_ driven.SearchEngine = (*Engine)(nil)
+	_ driven.ChunkLister  = (*Engine)(nil)
+)
 
 // Engine provides full-text search using Xapian.
 type Engine struct {
-	mu   sync.RWMutex
-	db   C.xapian_db
-	path string
+	mu          sync.RWMutex
+	db          C.xapian_db
+	path        string
+	analyzer    Analyzer
+	titleWeight float64
 }
 
 // New creates a new Xapian search engine.
@@ -43,11 +49,33 @@ func New(path string) (*Engine, error) {
 	}
 
 	return &Engine{
-		db:   db,
-		path: path,
+		db:          db,
+		path:        path,
+		analyzer:    StandardAnalyzer{},
+		titleWeight: domain.DefaultTitleWeight,
 	}, nil
 }
 
+// SetAnalyzer sets the analyzer applied to content and queries.
+// Defaults to StandardAnalyzer. Changing it requires reindexing.
+func (e *Engine) SetAnalyzer(a Analyzer) {
+	if a == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.analyzer = a
+}
+
+// SetTitleWeight sets the weight of query terms matching a chunk's document
+// title, added to the weight of content matches. Zero or less ignores titles.
+// Titles are always indexed, so changing it takes effect without reindexing.
+func (e *Engine) SetTitleWeight(weight float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.titleWeight = weight
+}
+
 // Index adds or updates a chunk in the search index.
 func (e *Engine) Index(_ context.Context, chunk domain.Chunk) error {
 	e.mu.Lock()
@@ -63,10 +91,32 @@ func (e *Engine) Index(_ context.Context, chunk domain.Chunk) error {
 	cDocID := C.CString(chunk.DocumentID)
 	defer C.free(unsafe.Pointer(cDocID))
 
-	cContent := C.CString(chunk.Content)
+	cContent := C.CString(e.analyzer.Analyze(chunk.Content))
 	defer C.free(unsafe.Pointer(cContent))
 
-	result := C.xapian_index(e.db, cChunkID, cDocID, cContent)
+	title, _ := chunk.Metadata[domain.MetadataDocumentTitle].(string)
+	cTitle := C.CString(e.analyzer.Analyze(title))
+	defer C.free(unsafe.Pointer(cTitle))
+
+	keywords := chunkKeywords(chunk)
+	analyzed := make([]string, len(keywords))
+	for i, kw := range keywords {
+		analyzed[i] = e.analyzer.Analyze(kw)
+	}
+	cKeywords := C.CString(strings.Join(analyzed, "\n"))
+	defer C.free(unsafe.Pointer(cKeywords))
+
+	author := domain.AuthorFromMetadata(chunk.Metadata)
+	cAuthorName := C.CString(author.Name)
+	defer C.free(unsafe.Pointer(cAuthorName))
+
+	cAuthorID := C.CString(author.Identifier)
+	defer C.free(unsafe.Pointer(cAuthorID))
+
+	cLanguage := C.CString(domain.DocumentLanguage(chunk.Metadata))
+	defer C.free(unsafe.Pointer(cLanguage))
+
+	result := C.xapian_index(e.db, cChunkID, cDocID, cContent, cTitle, cKeywords, cAuthorName, cAuthorID, cLanguage)
 	if result != 0 {
 		errMsg := C.GoString(C.xapian_get_error())
 		return errors.New("xapian: failed to index chunk: " + errMsg)
@@ -75,6 +125,25 @@ func (e *Engine) Index(_ context.Context, chunk domain.Chunk) error {
 	return nil
 }
 
+// chunkKeywords returns LLM-extracted keywords stored in chunk metadata.
+// Metadata loaded from storage decodes string slices as []any.
+func chunkKeywords(chunk domain.Chunk) []string {
+	switch v := chunk.Metadata[domain.MetadataKeywords].(type) {
+	case []string:
+		return v
+	case []any:
+		keywords := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				keywords = append(keywords, s)
+			}
+		}
+		return keywords
+	default:
+		return nil
+	}
+}
+
 // Delete removes a chunk from the search index.
 func (e *Engine) Delete(_ context.Context, chunkID string) error {
 	e.mu.Lock()
@@ -105,10 +174,10 @@ func (e *Engine) Search(_ context.Context, query string, limit int) ([]driven.Se
 		return nil, errors.New("xapian: database is closed")
 	}
 
-	cQuery := C.CString(query)
+	cQuery := C.CString(e.analyzer.AnalyzeQuery(query))
 	defer C.free(unsafe.Pointer(cQuery))
 
-	results := C.xapian_search(e.db, cQuery, C.int(limit))
+	results := C.xapian_search(e.db, cQuery, C.int(limit), C.double(e.titleWeight))
 	defer C.xapian_free_results(results)
 
 	if results.results == nil {
@@ -136,6 +205,33 @@ func (e *Engine) Search(_ context.Context, query string, limit int) ([]driven.Se
 	return hits, nil
 }
 
+// ChunkIDs returns the ID of every indexed chunk.
+func (e *Engine) ChunkIDs(_ context.Context) ([]string, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.db == nil {
+		return nil, errors.New("xapian: database is closed")
+	}
+
+	list := C.xapian_list_ids(e.db)
+	if list.count < 0 {
+		return nil, errors.New("xapian: failed to list chunks: " + C.GoString(C.xapian_get_error()))
+	}
+	defer C.xapian_free_ids(list)
+
+	if list.count == 0 || list.ids == nil {
+		return nil, nil
+	}
+
+	cIDs := unsafe.Slice(list.ids, int(list.count))
+	ids := make([]string, len(cIDs))
+	for i, id := range cIDs {
+		ids[i] = C.GoString(id)
+	}
+	return ids, nil
+}
+
 // Close releases resources.
 func (e *Engine) Close() error {
 	e.mu.Lock()
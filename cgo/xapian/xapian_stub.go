@@ -9,22 +9,41 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Engine implements the interface.
-var _ driven.SearchEngine = (*Engine)(nil)
+// Ensure Engine implements the interfaces.
+var (
+	_ driven.SearchEngine = (*Engine)(nil)
+	_ driven.ChunkLister  = (*Engine)(nil)
+)
 
 // Engine provides full-text search using Xapian.
 // This is a stub for builds without CGO.
 type Engine struct {
-	path string
+	path        string
+	analyzer    Analyzer
+	titleWeight float64
 }
 
 // New creates a new Xapian search engine.
 func New(path string) (*Engine, error) {
 	return &Engine{
-		path: path,
+		path:        path,
+		analyzer:    StandardAnalyzer{},
+		titleWeight: domain.DefaultTitleWeight,
 	}, nil
 }
 
+// SetAnalyzer sets the analyzer applied to content and queries.
+func (e *Engine) SetAnalyzer(a Analyzer) {
+	if a != nil {
+		e.analyzer = a
+	}
+}
+
+// SetTitleWeight sets the weight of query terms matching a chunk's document title.
+func (e *Engine) SetTitleWeight(weight float64) {
+	e.titleWeight = weight
+}
+
 // Index adds or updates a chunk in the search index.
 func (e *Engine) Index(_ context.Context, _ domain.Chunk) error {
 	return domain.ErrNotImplemented
@@ -40,6 +59,11 @@ func (e *Engine) Search(_ context.Context, _ string, _ int) ([]driven.SearchHit,
 	return nil, domain.ErrNotImplemented
 }
 
+// ChunkIDs returns the ID of every indexed chunk.
+func (e *Engine) ChunkIDs(_ context.Context) ([]string, error) {
+	return nil, domain.ErrNotImplemented
+}
+
 // Close releases resources.
 func (e *Engine) Close() error {
 	return nil
@@ -0,0 +1,101 @@
+//go:build cgo
+
+package xapian
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// newTestEngine creates an engine in a temporary directory.
+func newTestEngine(t *testing.T) *Engine {
+	t.Helper()
+
+	engine, err := New(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = engine.Close() })
+	return engine
+}
+
+// titledChunk returns a chunk of a document with the given title.
+func titledChunk(id, title, content string) domain.Chunk {
+	return domain.Chunk{
+		ID:         id,
+		DocumentID: "doc-" + id,
+		Content:    content,
+		Metadata:   map[string]any{domain.MetadataDocumentTitle: title},
+	}
+}
+
+func TestEngine_Search_TitleWeight(t *testing.T) {
+	engine := newTestEngine(t)
+	ctx := context.Background()
+
+	// Both bodies mention the query term equally; only one title does
+	body := "Notes on the quarterly budget review and follow-up actions."
+	require.NoError(t, engine.Index(ctx, titledChunk("plain", "Meeting notes", body)))
+	require.NoError(t, engine.Index(ctx, titledChunk("titled", "Budget planning", body)))
+
+	hits, err := engine.Search(ctx, "budget", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 2)
+	assert.Equal(t, "titled", hits[0].ChunkID)
+	assert.Greater(t, hits[0].Score, hits[1].Score)
+
+	// Without a title weight the documents score the same
+	engine.SetTitleWeight(0)
+	hits, err = engine.Search(ctx, "budget", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 2)
+	assert.InDelta(t, hits[0].Score, hits[1].Score, 1e-9)
+}
+
+func TestEngine_Search_TitleOnlyMatch(t *testing.T) {
+	engine := newTestEngine(t)
+	ctx := context.Background()
+
+	require.NoError(t, engine.Index(ctx, titledChunk("roadmap", "Roadmap", "Plans for next year.")))
+
+	// Title matches are found even when the content does not contain the term
+	hits, err := engine.Search(ctx, "roadmap", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "roadmap", hits[0].ChunkID)
+
+	// And the title field can be queried on its own
+	hits, err = engine.Search(ctx, "title:roadmap", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+}
+
+func TestEngine_Search_DocumentLanguage(t *testing.T) {
+	engine := newTestEngine(t)
+	ctx := context.Background()
+
+	french := domain.Chunk{
+		ID:         "fr",
+		DocumentID: "doc-fr",
+		Content:    "Les chevaux courent dans le pré avant la revue du budget.",
+		Metadata:   map[string]any{domain.MetadataLanguage: "fr"},
+	}
+	english := domain.Chunk{ID: "en", DocumentID: "doc-en", Content: "The budget review is on Thursday."}
+	require.NoError(t, engine.Index(ctx, french))
+	require.NoError(t, engine.Index(ctx, english))
+
+	// Words stemmed in French are still found as typed
+	hits, err := engine.Search(ctx, "chevaux", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "fr", hits[0].ChunkID)
+
+	// The language can be queried as a filter
+	hits, err = engine.Search(ctx, "budget language:fr", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "fr", hits[0].ChunkID)
+}
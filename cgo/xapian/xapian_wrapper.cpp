@@ -8,12 +8,41 @@
 #include "xapian_wrapper.h"
 #include <xapian.h>
 #include <string>
+#include <vector>
 #include <cstring>
 #include <cstdlib>
 
 // Thread-local storage for error messages
 static thread_local std::string last_error;
 
+// Term prefix for document titles (queried as "title:<term>")
+static const char* const TITLE_PREFIX = "S";
+
+// Term prefix for LLM-extracted keywords (queried as "keyword:<term>")
+static const char* const KEYWORD_PREFIX = "XK";
+
+// Term prefix for document authors (queried as "author:<name or identifier>")
+static const char* const AUTHOR_PREFIX = "XA";
+
+// Value slot holding the author identifier, or the name without one
+static const Xapian::valueno AUTHOR_SLOT = 2;
+
+// Term prefix for document languages (queried as "language:<code>")
+static const char* const LANGUAGE_PREFIX = "L";
+
+// stemmer_for returns the stemmer for an ISO 639-1 language code, English
+// when there is none, and no stemming for languages Xapian cannot stem
+static Xapian::Stem stemmer_for(const char* language) {
+    if (language == nullptr || language[0] == '\0') {
+        return Xapian::Stem("en");
+    }
+    try {
+        return Xapian::Stem(language);
+    } catch (const Xapian::InvalidArgumentError&) {
+        return Xapian::Stem();
+    }
+}
+
 // Internal database wrapper to hold both readable and writable database handles
 struct XapianDatabase {
     Xapian::WritableDatabase db;
@@ -50,7 +79,9 @@ void xapian_close(xapian_db db) {
     }
 }
 
-int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content) {
+int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content,
+                 const char* title, const char* keywords, const char* author_name,
+                 const char* author_id, const char* language) {
     if (db == nullptr || chunk_id == nullptr || content == nullptr) {
         last_error = "invalid arguments: db, chunk_id, and content must not be null";
         return -1;
@@ -59,9 +90,9 @@ int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const c
     try {
         XapianDatabase* wrapper = static_cast<XapianDatabase*>(db);
 
-        // Create a term generator for indexing
+        // Create a term generator for indexing, stemming in the document's language
         Xapian::TermGenerator indexer;
-        indexer.set_stemmer(Xapian::Stem("en"));
+        indexer.set_stemmer(stemmer_for(language));
         indexer.set_stemming_strategy(Xapian::TermGenerator::STEM_SOME);
 
         // Create a new document
@@ -71,11 +102,43 @@ int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const c
         // Index the content with positional information for phrase queries
         indexer.index_text(content);
 
+        // Index the title as a separate field, weighted at query time
+        if (title != nullptr && title[0] != '\0') {
+            indexer.increase_termpos();
+            indexer.index_text(title, 1, TITLE_PREFIX);
+        }
+
+        // Index extracted keywords as free text and under the "keyword:" prefix
+        if (keywords != nullptr && keywords[0] != '\0') {
+            indexer.increase_termpos();
+            indexer.index_text(keywords);
+            indexer.index_text(keywords, 1, KEYWORD_PREFIX);
+        }
+
+        // Index the author's name and identifier under the "author:" prefix
+        std::string author_key;
+        if (author_name != nullptr && author_name[0] != '\0') {
+            indexer.index_text(author_name, 1, AUTHOR_PREFIX);
+            author_key = author_name;
+        }
+        if (author_id != nullptr && author_id[0] != '\0') {
+            indexer.index_text(author_id, 1, AUTHOR_PREFIX);
+            author_key = author_id;
+        }
+
+        // Tag the language so queries can filter on it
+        if (language != nullptr && language[0] != '\0') {
+            doc.add_boolean_term(std::string(LANGUAGE_PREFIX) + language);
+        }
+
         // Store metadata
         doc.add_value(0, chunk_id);  // Slot 0: chunk_id for retrieval
         if (doc_id != nullptr) {
             doc.add_value(1, doc_id);  // Slot 1: parent document ID
         }
+        if (!author_key.empty()) {
+            doc.add_value(AUTHOR_SLOT, author_key);  // Slot 2: author for faceting
+        }
 
         // Store the original content for potential snippeting
         doc.set_data(content);
@@ -123,7 +186,7 @@ int xapian_delete(xapian_db db, const char* chunk_id) {
     }
 }
 
-SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
+SearchResults xapian_search(xapian_db db, const char* query_str, int limit, double title_weight) {
     SearchResults results = {nullptr, 0};
 
     if (db == nullptr || query_str == nullptr || limit <= 0) {
@@ -140,14 +203,41 @@ SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
         parser.set_stemmer(Xapian::Stem("en"));
         parser.set_stemming_strategy(Xapian::QueryParser::STEM_SOME);
         parser.set_default_op(Xapian::Query::OP_OR);
+        parser.add_prefix("title", TITLE_PREFIX);
+        parser.add_prefix("keyword", KEYWORD_PREFIX);
+        parser.add_prefix("author", AUTHOR_PREFIX);
+        parser.add_boolean_prefix("language", LANGUAGE_PREFIX);
 
         // Parse the query with partial matching for better recall
-        Xapian::Query query = parser.parse_query(
-            query_str,
-            Xapian::QueryParser::FLAG_DEFAULT |
-            Xapian::QueryParser::FLAG_WILDCARD |
-            Xapian::QueryParser::FLAG_PARTIAL
-        );
+        const unsigned flags = Xapian::QueryParser::FLAG_DEFAULT |
+                               Xapian::QueryParser::FLAG_WILDCARD |
+                               Xapian::QueryParser::FLAG_PARTIAL;
+        Xapian::Query query = parser.parse_query(query_str, flags);
+
+        // Match the same terms against the title field and add their scaled
+        // weight, so documents matching in the title rank higher (BM25F-style)
+        Xapian::Query title_query;
+        if (title_weight > 0 && !query.empty()) {
+            title_query = parser.parse_query(query_str, flags, TITLE_PREFIX);
+        }
+
+        // Documents are stemmed in their own language but queries in English,
+        // so also match the words as typed, which every document indexes
+        if (!query.empty()) {
+            parser.set_stemming_strategy(Xapian::QueryParser::STEM_NONE);
+            Xapian::Query exact_query = parser.parse_query(query_str, flags);
+            if (!exact_query.empty()) {
+                query = Xapian::Query(Xapian::Query::OP_OR, query, exact_query);
+            }
+        }
+
+        if (!title_query.empty()) {
+            query = Xapian::Query(
+                Xapian::Query::OP_OR,
+                query,
+                Xapian::Query(Xapian::Query::OP_SCALE_WEIGHT, title_query, title_weight)
+            );
+        }
 
         // If empty query, return no results
         if (query.empty()) {
@@ -234,6 +324,65 @@ void xapian_free_results(SearchResults results) {
     }
 }
 
+IdList xapian_list_ids(xapian_db db) {
+    IdList list = {nullptr, 0};
+
+    if (db == nullptr) {
+        last_error = "invalid arguments: db must not be null";
+        list.count = -1;
+        return list;
+    }
+
+    try {
+        XapianDatabase* wrapper = static_cast<XapianDatabase*>(db);
+
+        // Every chunk has a unique "Q" + chunk_id term (see xapian_index)
+        std::vector<std::string> ids;
+        for (Xapian::TermIterator it = wrapper->db.allterms_begin("Q");
+             it != wrapper->db.allterms_end("Q"); ++it) {
+            ids.push_back((*it).substr(1));
+        }
+
+        if (ids.empty()) {
+            last_error.clear();
+            return list;
+        }
+
+        list.ids = static_cast<char**>(malloc(sizeof(char*) * ids.size()));
+        if (list.ids == nullptr) {
+            last_error = "memory allocation failed";
+            list.count = -1;
+            return list;
+        }
+        for (size_t i = 0; i < ids.size(); ++i) {
+            list.ids[i] = strdup(ids[i].c_str());
+        }
+        list.count = static_cast<int>(ids.size());
+
+        last_error.clear();
+        return list;
+    } catch (const Xapian::Error& e) {
+        last_error = e.get_description();
+    } catch (const std::exception& e) {
+        last_error = e.what();
+    }
+
+    // Error: free any partial list
+    xapian_free_ids(list);
+    list.ids = nullptr;
+    list.count = -1;
+    return list;
+}
+
+void xapian_free_ids(IdList ids) {
+    if (ids.ids != nullptr) {
+        for (int i = 0; i < ids.count; ++i) {
+            free(ids.ids[i]);
+        }
+        free(ids.ids);
+    }
+}
+
 const char* xapian_get_error(void) {
     return last_error.c_str();
 }
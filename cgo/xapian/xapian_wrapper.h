@@ -37,9 +37,22 @@ void xapian_close(xapian_db db);
  * @param chunk_id: Unique identifier for the chunk
  * @param doc_id: Parent document ID
  * @param content: Text content to index
+ * @param title: Title of the parent document (may be NULL), indexed as a
+ *               separate field searchable with the "title:" prefix
+ * @param keywords: Newline-separated extracted keywords (may be NULL), also
+ *                  searchable with the "keyword:" prefix
+ * @param author_name: Display name of the document's author (may be NULL)
+ * @param author_id: Identifier of the document's author (may be NULL); the
+ *                   identifier, or the name without one, is stored in value
+ *                   slot 2 and both are searchable with the "author:" prefix
+ * @param language: ISO 639-1 code of the document's language (may be NULL);
+ *                  picks the stemmer, defaulting to English, and is searchable
+ *                  with the "language:" prefix
  * @return: 0 on success, -1 on error
  */
-int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content);
+int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content,
+                 const char* title, const char* keywords, const char* author_name,
+                 const char* author_id, const char* language);
 
 /*
  * xapian_delete - Remove a document from the index
@@ -72,9 +85,12 @@ typedef struct {
  * @param db: Database handle
  * @param query: Search query string
  * @param limit: Maximum number of results
+ * @param title_weight: Weight of query terms matching the title field,
+ *                      added to the weight of content matches; 0 or less
+ *                      searches the content only
  * @return: SearchResults struct (caller must free with xapian_free_results)
  */
-SearchResults xapian_search(xapian_db db, const char* query, int limit);
+SearchResults xapian_search(xapian_db db, const char* query, int limit, double title_weight);
 
 /*
  * xapian_free_results - Free search results memory
@@ -83,6 +99,30 @@ SearchResults xapian_search(xapian_db db, const char* query, int limit);
  */
 void xapian_free_results(SearchResults results);
 
+/*
+ * IdList - Array of chunk IDs
+ */
+typedef struct {
+    char** ids;
+    int count;
+} IdList;
+
+/*
+ * xapian_list_ids - List the chunk ID of every indexed document
+ *
+ * @param db: Database handle
+ * @return: IdList struct (caller must free with xapian_free_ids);
+ *          ids is NULL with count -1 on error
+ */
+IdList xapian_list_ids(xapian_db db);
+
+/*
+ * xapian_free_ids - Free an IdList
+ *
+ * @param ids: IdList to free
+ */
+void xapian_free_ids(IdList ids);
+
 /*
  * xapian_get_error - Get the last error message
  *
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
 
 	"github.com/custodia-labs/sercha-cli/cgo/xapian"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/ai"
@@ -12,13 +17,25 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/sqlite"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/cli"
 	"github.com/custodia-labs/sercha-cli/internal/connectors"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/httpcache"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 	"github.com/custodia-labs/sercha-cli/internal/core/services"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers"
 	"github.com/custodia-labs/sercha-cli/internal/postprocessors"
 )
 
 var version = "dev"
 
+// shutdownTimeout bounds how long an interrupted command waits for syncs in
+// progress before the indexes and stores are closed.
+const shutdownTimeout = 10 * time.Second
+
+// exitInterrupted is the conventional exit code for a process stopped by SIGINT.
+const exitInterrupted = 130
+
 func main() {
 	os.Exit(run())
 }
@@ -27,6 +44,25 @@ func main() {
 func run() int {
 	cli.SetVersion(version)
 
+	// Interrupting cancels the commands' context; the deferred closes below then
+	// run in reverse order, so the indexes are committed and the WAL checkpointed
+	// before exit. A second interrupt exits immediately.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	// Shared structured logger; level and outputs are set from CLI flags before commands run
+	defer logger.Close()
+	appLogger := logger.Slog()
+
+	// Safe mode starts only the required subsystems, so a broken optional one
+	// cannot lock the user out of keyword search
+	safeMode := cli.SafeModeRequested(os.Args[1:])
+	var disabled []domain.DisabledSubsystem
+
 	// Create unified SQLite store for all metadata persistence
 	sqliteStore, err := sqlite.NewStore("")
 	if err != nil {
@@ -50,6 +86,10 @@ func run() int {
 		log.Printf("failed to create config store: %v", err)
 		return 1
 	}
+	if err := configStore.Validate(); err != nil {
+		log.Printf("Warning: %v", err)
+		log.Println("Invalid settings fall back to defaults. Run 'sercha doctor' for details.")
+	}
 	aiConfigValidator := ai.NewConfigValidator()
 	settingsSvc := services.NewSettingsService(configStore, aiConfigValidator)
 
@@ -71,12 +111,26 @@ func run() int {
 		log.Printf("failed to create Xapian directory: %v", err)
 		return 1
 	}
-	searchEngine, err := xapian.New(xapianPath)
-	if err != nil {
+	// Left nil only in safe mode, where search and sync report it unavailable
+	var searchEngine driven.SearchEngine
+	xapianEngine, err := xapian.New(xapianPath)
+	switch {
+	case err == nil:
+		defer xapianEngine.Close()
+		xapianEngine.SetAnalyzer(xapian.NewAnalyzer(settings.Search.Language))
+		xapianEngine.SetTitleWeight(settings.Search.TitleWeight)
+		searchEngine = xapianEngine
+	case safeMode:
+		log.Printf("Warning: failed to create Xapian search engine: %v", err)
+		disabled = append(disabled, domain.DisabledSubsystem{
+			Name:   "Keyword search and syncing",
+			Reason: fmt.Sprintf("the search index failed to open: %v", err),
+		})
+	default:
 		log.Printf("failed to create Xapian search engine: %v", err)
+		log.Println("Run with --safe-mode to browse sources and documents without it.")
 		return 1
 	}
-	defer searchEngine.Close()
 
 	// Initialise AI services with auto-fallback on failure
 	vectorPath := filepath.Join(home, ".sercha", "data", "vectors")
@@ -85,10 +139,19 @@ func run() int {
 		return 1
 	}
 
-	aiResult, err := ai.InitialiseServices(settings, vectorPath)
-	if err != nil {
-		log.Printf("fatal error initialising AI: %v", err)
-		return 1
+	aiResult := &ai.InitResult{}
+	if safeMode {
+		disabled = append(disabled,
+			domain.DisabledSubsystem{Name: "Vector search and embeddings", Reason: "skipped by --safe-mode"},
+			domain.DisabledSubsystem{Name: "LLM query expansion and enrichment", Reason: "skipped by --safe-mode"},
+		)
+	} else {
+		aiResult, err = ai.InitialiseServices(settings, vectorPath)
+		if err != nil {
+			log.Printf("fatal error initialising AI: %v", err)
+			log.Println("Run with --safe-mode to start without AI features.")
+			return 1
+		}
 	}
 	defer aiResult.Close()
 
@@ -98,7 +161,22 @@ func run() int {
 		for _, w := range aiResult.Warnings {
 			log.Printf("  - %s", w)
 		}
-		log.Println("Run 'sercha settings wizard' to configure AI features.")
+		if aiResult.VectorIndexErr != nil {
+			log.Println("The vector index failed to load; rebuild it to restore semantic search.")
+		} else {
+			log.Println("Run 'sercha settings wizard' to configure AI features.")
+		}
+	}
+
+	// Pre-load the vector index pages in the background so the first search is fast
+	var vectorDimensions int
+	if aiResult.EmbeddingService != nil {
+		vectorDimensions = aiResult.EmbeddingService.Dimensions()
+	}
+	indexWarmer := services.NewVectorIndexWarmer(aiResult.VectorIndex, vectorDimensions)
+	indexWarmer.SetLogger(appLogger)
+	if settings.VectorIndex.WarmUpOnStart {
+		indexWarmer.Start(ctx)
 	}
 
 	// Provider registry is created after connector registry (see below)
@@ -112,7 +190,15 @@ func run() int {
 
 	// Create connector and normaliser registries
 	connectorFactory := connectors.NewFactory(tokenProviderFactory)
+	connectorFactory.SetLogger(appLogger)
 	normaliserRegistry := normalisers.NewRegistry()
+	normaliserRegistry.SetDefaultLanguage(settings.Search.Language.Code())
+	connectorFactory.SetSupportedMIMETypes(normaliserRegistry.SupportedMIMETypes())
+	// Revalidate connector GET responses from disk instead of re-downloading unchanged bodies
+	if settings.HTTPCache.Enabled {
+		httpCachePath := filepath.Join(home, ".sercha", "cache", "http")
+		connectorFactory.SetHTTPCache(httpcache.New(httpCachePath, settings.HTTPCache.MaxSizeBytes()))
+	}
 
 	// Create PostProcessor pipeline from configuration
 	pipelineCfg := settingsSvc.GetPipelineConfig()
@@ -122,6 +208,10 @@ func run() int {
 	pipeline := postprocessors.NewPipeline()
 	for _, name := range pipelineCfg.Processors {
 		cfg := pipelineCfg.GetProcessorConfig(name)
+		// Token-sized chunks are capped at the configured embedding model's input limit
+		if name == "chunker" && cfg != nil && cfg["model"] == nil && settings.Embedding.Model != "" {
+			cfg["model"] = settings.Embedding.Model
+		}
 		processor, err := processorRegistry.Build(name, cfg)
 		if err != nil {
 			log.Printf("failed to build processor %s: %v", name, err)
@@ -130,16 +220,37 @@ func run() int {
 		pipeline.Add(processor)
 	}
 
+	// The LLM may exist only for enrichment; search uses it only when the mode asks for it
+	searchLLM := aiResult.LLMService
+	if !settings.Search.Mode.RequiresLLM() {
+		searchLLM = nil
+	}
+
 	// Create core services with AI dependencies
 	searchSvc := services.NewSearchService(
 		docStore, searchEngine, aiResult.VectorIndex,
-		aiResult.EmbeddingService, aiResult.LLMService,
+		aiResult.EmbeddingService, searchLLM,
 	)
 	// Set optional stores for SourceName enrichment in search results
 	searchSvc.SetSourceStore(sourceStore)
 	searchSvc.SetCredentialsStore(credentialsStore)
+	searchSvc.SetSearchMode(settings.Search.Mode)
+	searchSvc.SetHybridOverFetch(settings.Search.HybridOverFetchMultiplier())
+	searchSvc.SetVectorMinScore(settings.Search.MinScore)
+	searchSvc.SetVectorSnippetLength(settings.Search.VectorSnippetChars())
+	// Boost or penalise results using local relevance feedback from the TUI
+	feedbackStore := sqliteStore.FeedbackStore()
+	searchSvc.SetFeedbackStore(feedbackStore)
+	feedbackSvc := services.NewFeedbackService(feedbackStore)
+	if aiResult.Reranker != nil {
+		searchSvc.SetReranker(aiResult.Reranker, settings.Rerank)
+	}
+	if aiResult.VectorIndexErr != nil {
+		searchSvc.SetVectorIndexUnavailable(aiResult.VectorIndexErr)
+	}
 
 	sourceSvc := services.NewSourceService(sourceStore, syncStore, docStore)
+	timelineSvc := services.NewTimelineService(docStore, sourceStore)
 
 	// Create connector registry (needed before sourceSvc.SetConnectorRegistry)
 	connectorRegistry := services.NewConnectorRegistry(connectorFactory)
@@ -152,8 +263,37 @@ func run() int {
 		sourceStore, syncStore, docStore, exclusionStore, connectorFactory, normaliserRegistry,
 		pipeline, searchEngine, aiResult.VectorIndex, aiResult.EmbeddingService,
 	)
+	syncSvc.SetLogger(appLogger)
+	syncSvc.SetSyncSettings(settings.Sync)
+	syncSvc.SetChangeLogStore(sqliteStore.SyncChangeLogStore())
+	// Enrich documents with LLM-extracted keywords in the background (opt-in)
+	if settings.Enrichment.Enabled && aiResult.LLMService != nil {
+		enrichmentSvc := services.NewEnrichmentService(aiResult.LLMService, docStore, searchEngine, 2)
+		defer enrichmentSvc.Close()
+		syncSvc.SetEnrichmentService(enrichmentSvc)
+	}
+	// Queue chunks for background embedding instead of embedding during sync
+	var embeddingQueue driving.EmbeddingQueue
+	if aiResult.EmbeddingService != nil {
+		embeddingJobs := sqliteStore.EmbeddingJobStore()
+		syncSvc.SetEmbeddingQueue(embeddingJobs)
+		embeddingWorker := services.NewEmbeddingWorker(
+			embeddingJobs, docStore, aiResult.EmbeddingService, aiResult.VectorIndex,
+			settings.Embedding.WorkerCount(),
+		)
+		embeddingWorker.SetLogger(appLogger)
+		embeddingWorker.SetMaxAttempts(settings.Embedding.AttemptLimit())
+		embeddingQueue = embeddingWorker
+	}
 	resultActionSvc := services.NewResultActionService(sourceStore, connectorRegistry)
 	documentSvc := services.NewDocumentService(docStore, sourceStore, exclusionStore, connectorRegistry)
+	integritySvc := services.NewIntegrityService(
+		sourceStore, docStore, exclusionStore, searchEngine, aiResult.VectorIndex,
+	)
+	statusSvc := services.NewStatusService(
+		sourceStore, syncStore, docStore, credentialsStore, connectorRegistry,
+		searchEngine, aiResult.VectorIndex,
+	)
 
 	// Create scheduler (started only by TUI command which is long-running)
 	schedulerCfg := settingsSvc.GetSchedulerConfig()
@@ -162,8 +302,10 @@ func run() int {
 		schedulerStore,
 		syncSvc,
 	)
+	scheduler.SetLogger(appLogger)
 
 	// Inject services into CLI commands
+	cli.SetDisabledSubsystems(disabled)
 	cli.SetServices(&cli.Services{
 		Search:            searchSvc,
 		Source:            sourceSvc,
@@ -174,6 +316,11 @@ func run() int {
 		Settings:          settingsSvc,
 		AuthProvider:      authProviderSvc,
 		Credentials:       credentialsSvc,
+		Embeddings:        embeddingQueue,
+		Integrity:         integritySvc,
+		IndexWarmer:       indexWarmer,
+		Status:            statusSvc,
+		DataDir:           filepath.Join(home, ".sercha", "data"),
 	})
 
 	// Inject services into TUI command (including scheduler for background tasks)
@@ -190,9 +337,25 @@ func run() int {
 		AuthProviderService: authProviderSvc,
 		Scheduler:           scheduler,
 		SchedulerConfig:     schedulerCfg,
+		EmbeddingQueue:      embeddingQueue,
+		FeedbackService:     feedbackSvc,
+		TimelineService:     timelineSvc,
 	})
 
-	if err := cli.Execute(); err != nil {
+	err = cli.ExecuteContext(ctx)
+
+	// Let interrupted syncs finish with the documents they hold before the
+	// stores are closed
+	waitCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if waitErr := syncSvc.Wait(waitCtx); waitErr != nil {
+		appLogger.Warn("syncs still running at shutdown", "timeout", shutdownTimeout)
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		return exitInterrupted
+	case err != nil:
 		return 1
 	}
 	return 0
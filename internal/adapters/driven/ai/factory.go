@@ -3,6 +3,7 @@ package ai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 	anthropicllm "github.com/custodia-labs/sercha-cli/internal/adapters/driven/llm/anthropic"
 	ollamallm "github.com/custodia-labs/sercha-cli/internal/adapters/driven/llm/ollama"
 	openaillm "github.com/custodia-labs/sercha-cli/internal/adapters/driven/llm/openai"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/rerank/crossencoder"
+	llmrerank "github.com/custodia-labs/sercha-cli/internal/adapters/driven/rerank/llm"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/logger"
@@ -26,9 +29,11 @@ type InitResult struct {
 	EmbeddingService driven.EmbeddingService
 	LLMService       driven.LLMService
 	VectorIndex      driven.VectorIndex
+	Reranker         driven.Reranker    // Reorders the top search results, if configured.
 	PromptStore      driven.PromptStore // User-customisable prompt templates.
 	Warnings         []string           // Non-fatal issues that caused fallback.
 	FellBack         bool               // True if fell back to text-only mode.
+	VectorIndexErr   error              // Set if the persisted vector index failed to load.
 }
 
 // Close releases all resources held by InitResult.
@@ -152,13 +157,23 @@ func CreateEmbeddingService(settings *domain.EmbeddingSettings) (driven.Embeddin
 	if settings == nil || !settings.IsConfigured() {
 		return nil, nil
 	}
+	if err := settings.ValidateDimensions(); err != nil {
+		return nil, err
+	}
+	headers, err := settings.RequestHeaders()
+	if err != nil {
+		return nil, err
+	}
 
 	switch settings.Provider {
 	case domain.AIProviderOllama:
-		return createOllamaEmbedding(settings), nil
+		return createOllamaEmbedding(settings, headers), nil
 
 	case domain.AIProviderOpenAI:
-		return createOpenAIEmbedding(settings)
+		return createOpenAIEmbedding(settings, headers)
+
+	case domain.AIProviderOpenAICompatible:
+		return createOpenAICompatibleEmbedding(settings, headers)
 
 	case domain.AIProviderAnthropic:
 		// Anthropic does not support embeddings.
@@ -192,8 +207,9 @@ func CreateLLMService(settings *domain.LLMSettings) (driven.LLMService, error) {
 }
 
 // createOllamaEmbedding creates an Ollama embedding service.
-func createOllamaEmbedding(settings *domain.EmbeddingSettings) driven.EmbeddingService {
-	dimensions := domain.EmbeddingDimensions()[settings.Model]
+// Ollama always returns full vectors, so a reduced size is applied locally.
+func createOllamaEmbedding(settings *domain.EmbeddingSettings, headers map[string]string) driven.EmbeddingService {
+	dimensions := settings.VectorDimensions()
 	if dimensions == 0 {
 		dimensions = ollamaembed.DefaultDimensions
 	}
@@ -202,21 +218,58 @@ func createOllamaEmbedding(settings *domain.EmbeddingSettings) driven.EmbeddingS
 		BaseURL:    settings.BaseURL,
 		Model:      settings.Model,
 		Dimensions: dimensions,
+		Truncate:   settings.Dimensions > 0,
+		Headers:    headers,
 	})
 }
 
 // createOpenAIEmbedding creates an OpenAI embedding service.
-func createOpenAIEmbedding(settings *domain.EmbeddingSettings) (driven.EmbeddingService, error) {
-	dimensions := domain.EmbeddingDimensions()[settings.Model]
+// A reduced size is requested from the API with the dimensions parameter.
+func createOpenAIEmbedding(
+	settings *domain.EmbeddingSettings,
+	headers map[string]string,
+) (driven.EmbeddingService, error) {
+	dimensions := settings.VectorDimensions()
 
 	return openaiembed.NewEmbeddingService(openaiembed.Config{
 		APIKey:     settings.APIKey,
 		BaseURL:    settings.BaseURL,
 		Model:      settings.Model,
 		Dimensions: dimensions,
+		Headers:    headers,
 	})
 }
 
+// createOpenAICompatibleEmbedding creates an embedding service for a self-hosted
+// server that speaks the OpenAI embeddings API, such as the llama.cpp server.
+// The vector size of an arbitrary local model is unknown, so a probe string is
+// embedded up front to learn it and to check the server answers.
+func createOpenAICompatibleEmbedding(
+	settings *domain.EmbeddingSettings,
+	headers map[string]string,
+) (driven.EmbeddingService, error) {
+	svc, err := openaiembed.NewEmbeddingService(openaiembed.Config{
+		APIKey:     settings.APIKey,
+		BaseURL:    settings.BaseURL,
+		Model:      settings.Model,
+		Dimensions: settings.VectorDimensions(),
+		Headers:    headers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	probed, err := newProbedEmbedding(ctx, svc)
+	if err != nil {
+		svc.Close()
+		return nil, err
+	}
+	return probed, nil
+}
+
 // createOllamaLLM creates an Ollama LLM service.
 func createOllamaLLM(settings *domain.LLMSettings) driven.LLMService {
 	return ollamallm.NewLLMService(ollamallm.LLMConfig{
@@ -290,8 +343,14 @@ func InitialiseServices(settings *domain.AppSettings, vectorPath string) (*InitR
 		idx, err := hnsw.New(vectorPath, result.EmbeddingService.Dimensions(), precision)
 		if err != nil {
 			logger.Warn("Vector index failed: %v", err)
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Vector index: %v. Run 'sercha settings wizard' to fix", err))
+			if errors.Is(err, hnsw.ErrLoadFailed) {
+				// Corrupt or built for another embedding model: keep it for inspection
+				result.VectorIndexErr = err
+				result.Warnings = append(result.Warnings, vectorIndexLoadWarning(err, vectorPath))
+			} else {
+				result.Warnings = append(result.Warnings,
+					fmt.Sprintf("Vector index: %v. Run 'sercha settings wizard' to fix", err))
+			}
 			result.EmbeddingService.Close()
 			result.EmbeddingService = nil
 			result.FellBack = true
@@ -301,9 +360,11 @@ func InitialiseServices(settings *domain.AppSettings, vectorPath string) (*InitR
 		}
 	}
 
-	// Try to create LLM service if mode requires it.
-	if settings.Search.Mode.RequiresLLM() {
-		logger.Debug("LLM required: yes (mode=%s)", settings.Search.Mode)
+	// Try to create LLM service if mode, document enrichment or reranking requires it.
+	llmReranking := settings.Rerank.Provider == domain.RerankProviderLLM
+	if settings.Search.Mode.RequiresLLM() || settings.Enrichment.Enabled || llmReranking {
+		logger.Debug("LLM required: yes (mode=%s, enrichment=%t, rerank=%t)",
+			settings.Search.Mode, settings.Enrichment.Enabled, llmReranking)
 		logger.Debug("LLM provider: %s", settings.LLM.Provider.Description())
 		logger.Debug("LLM model: %s", settings.LLM.Model)
 		initLLMService(result, &settings.LLM)
@@ -311,6 +372,8 @@ func InitialiseServices(settings *domain.AppSettings, vectorPath string) (*InitR
 		logger.Debug("LLM required: no")
 	}
 
+	initReranker(result, &settings.Rerank)
+
 	if result.FellBack {
 		logger.Warn("Fell back to text-only mode due to service failures")
 	}
@@ -318,6 +381,13 @@ func InitialiseServices(settings *domain.AppSettings, vectorPath string) (*InitR
 	return result, nil
 }
 
+// vectorIndexLoadWarning explains a failed index load and how to rebuild it.
+func vectorIndexLoadWarning(err error, vectorPath string) string {
+	return fmt.Sprintf("Vector index: %v. The index is corrupt or was built with a different "+
+		"embedding model or dimension setting. Search is keyword-only until it is rebuilt: delete %s and re-index your sources",
+		err, vectorPath)
+}
+
 // initLLMService creates and configures the LLM service, updating result accordingly.
 func initLLMService(result *InitResult, settings *domain.LLMSettings) {
 	svc, err := CreateLLMService(settings)
@@ -337,6 +407,67 @@ func initLLMService(result *InitResult, settings *domain.LLMSettings) {
 	injectPromptStore(svc, result.PromptStore)
 }
 
+// initReranker creates the reranker, updating result accordingly. Reranking
+// is optional, so a failure is only a warning and search keeps its mode.
+func initReranker(result *InitResult, settings *domain.RerankSettings) {
+	reranker, err := CreateReranker(settings, result.LLMService, result.PromptStore)
+	if err != nil {
+		logger.Warn("Reranker failed: %v", err)
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Reranker: %v", err))
+		return
+	}
+	if reranker == nil {
+		logger.Debug("Reranker: not configured")
+		return
+	}
+
+	logger.Info("Reranker: created (provider=%s, enabled=%t)", settings.Provider, settings.Enabled)
+	result.Reranker = reranker
+}
+
+// CreateReranker creates the reranker based on settings. The LLM provider
+// reranks with llm, prompted from store when it is set.
+// Returns nil if no rerank provider is configured.
+func CreateReranker(
+	settings *domain.RerankSettings,
+	llm driven.LLMService,
+	store driven.PromptStore,
+) (driven.Reranker, error) {
+	if settings == nil || settings.Provider == "" {
+		return nil, nil
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch settings.Provider {
+	case domain.RerankProviderCrossEncoder:
+		headers, err := settings.RequestHeaders()
+		if err != nil {
+			return nil, err
+		}
+		return crossencoder.NewReranker(crossencoder.Config{
+			Endpoint: settings.Endpoint,
+			Model:    settings.Model,
+			APIKey:   settings.APIKey,
+			Headers:  headers,
+		})
+
+	case domain.RerankProviderLLM:
+		if llm == nil {
+			return nil, fmt.Errorf("the llm rerank provider needs an LLM provider to be configured")
+		}
+		reranker := llmrerank.NewReranker(llm)
+		if store != nil {
+			reranker.SetPromptStore(store)
+		}
+		return reranker, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported rerank provider: %s", settings.Provider)
+	}
+}
+
 // injectPromptStore sets the prompt store on services that support it.
 func injectPromptStore(svc driven.LLMService, store driven.PromptStore) {
 	if store == nil {
@@ -1,6 +1,12 @@
 package ai
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -417,7 +423,7 @@ func TestInitResult_Close_AllServices(t *testing.T) {
 		Provider: domain.AIProviderOllama,
 		BaseURL:  "http://localhost:11434",
 		Model:    "nomic-embed-text",
-	})
+	}, nil)
 	result.EmbeddingService = embSvc
 
 	// Create mock LLM service
@@ -477,7 +483,7 @@ func TestCreateOllamaEmbedding_WithDimensions(t *testing.T) {
 		Model:    "nomic-embed-text", // Known model with 768 dimensions
 	}
 
-	svc := createOllamaEmbedding(settings)
+	svc := createOllamaEmbedding(settings, nil)
 	if svc == nil {
 		t.Fatal("expected non-nil service")
 	}
@@ -498,7 +504,7 @@ func TestCreateOllamaEmbedding_UnknownModel(t *testing.T) {
 		Model:    "custom-model-unknown",
 	}
 
-	svc := createOllamaEmbedding(settings)
+	svc := createOllamaEmbedding(settings, nil)
 	if svc == nil {
 		t.Fatal("expected non-nil service")
 	}
@@ -513,7 +519,7 @@ func TestCreateOpenAIEmbedding_Success(t *testing.T) {
 		Model:    "text-embedding-3-small",
 	}
 
-	svc, err := createOpenAIEmbedding(settings)
+	svc, err := createOpenAIEmbedding(settings, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -586,3 +592,209 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestVectorIndexLoadWarning(t *testing.T) {
+	warning := vectorIndexLoadWarning(errors.New("hnsw: failed to load index"), "/data/vectors")
+
+	for _, want := range []string{"hnsw: failed to load index", "keyword-only", "delete /data/vectors and re-index"} {
+		if !strings.Contains(warning, want) {
+			t.Errorf("warning %q does not contain %q", warning, want)
+		}
+	}
+}
+
+func TestCreateEmbeddingService_ReducedDimensions(t *testing.T) {
+	settings := &domain.EmbeddingSettings{
+		Provider:   domain.AIProviderOpenAI,
+		APIKey:     "test-key",
+		Model:      "text-embedding-3-large",
+		Dimensions: 256,
+	}
+
+	svc, err := CreateEmbeddingService(settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer svc.Close()
+
+	if svc.Dimensions() != 256 {
+		t.Errorf("expected 256 dimensions, got %d", svc.Dimensions())
+	}
+
+	ollama := createOllamaEmbedding(&domain.EmbeddingSettings{
+		Provider:   domain.AIProviderOllama,
+		Model:      "nomic-embed-text",
+		Dimensions: 128,
+	}, nil)
+	defer ollama.Close()
+
+	if ollama.Dimensions() != 128 {
+		t.Errorf("expected 128 dimensions, got %d", ollama.Dimensions())
+	}
+}
+
+func TestCreateEmbeddingService_UnsupportedReducedDimensions(t *testing.T) {
+	settings := &domain.EmbeddingSettings{
+		Provider:   domain.AIProviderOpenAI,
+		APIKey:     "test-key",
+		Model:      "text-embedding-ada-002",
+		Dimensions: 512,
+	}
+
+	svc, err := CreateEmbeddingService(settings)
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+	if svc != nil {
+		t.Error("expected nil service")
+	}
+}
+
+// newCompatibleServer serves OpenAI-style embeddings of the given size and
+// rejects requests without the X-Api-Key header.
+func newCompatibleServer(t *testing.T, dimensions *int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"missing key"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []map[string]any{{"index": 0, "embedding": make([]float64, *dimensions)}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCreateEmbeddingService_OpenAICompatible(t *testing.T) {
+	dimensions := 384
+	server := newCompatibleServer(t, &dimensions)
+	settings := &domain.EmbeddingSettings{
+		Provider: domain.AIProviderOpenAICompatible,
+		BaseURL:  server.URL,
+		Model:    "all-MiniLM-L6-v2.Q8_0",
+		Headers:  []string{"X-Api-Key: secret"},
+	}
+
+	svc, err := CreateEmbeddingService(settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer svc.Close()
+
+	if svc.Dimensions() != 384 {
+		t.Errorf("expected probed 384 dimensions, got %d", svc.Dimensions())
+	}
+	if err := svc.Ping(context.Background()); err != nil {
+		t.Errorf("unexpected ping error: %v", err)
+	}
+
+	// The server restarted with another model
+	dimensions = 768
+	if err := svc.Ping(context.Background()); err == nil {
+		t.Error("expected ping error after the vector size changed")
+	}
+}
+
+func TestCreateEmbeddingService_OpenAICompatibleProbeFails(t *testing.T) {
+	dimensions := 384
+	server := newCompatibleServer(t, &dimensions)
+	settings := &domain.EmbeddingSettings{
+		Provider: domain.AIProviderOpenAICompatible,
+		BaseURL:  server.URL,
+		Model:    "all-MiniLM-L6-v2.Q8_0",
+	}
+
+	svc, err := CreateEmbeddingService(settings)
+	if err == nil || !contains(err.Error(), "embed probe string") {
+		t.Fatalf("expected probe error, got %v", err)
+	}
+	if svc != nil {
+		t.Error("expected nil service")
+	}
+}
+
+func TestCreateEmbeddingService_InvalidHeaders(t *testing.T) {
+	settings := &domain.EmbeddingSettings{
+		Provider: domain.AIProviderOllama,
+		Model:    "nomic-embed-text",
+		Headers:  []string{"no-colon"},
+	}
+
+	_, err := CreateEmbeddingService(settings)
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestCreateReranker(t *testing.T) {
+	llm, err := CreateLLMService(&domain.LLMSettings{
+		Provider: domain.AIProviderOllama,
+		BaseURL:  "http://localhost:11434",
+		Model:    "llama3.2",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		settings *domain.RerankSettings
+		wantNil  bool
+		wantErr  bool
+	}{
+		{name: "nil settings returns nil", settings: nil, wantNil: true},
+		{name: "no provider returns nil", settings: &domain.RerankSettings{Candidates: 10}, wantNil: true},
+		{
+			name: "cross encoder creates reranker",
+			settings: &domain.RerankSettings{
+				Provider: domain.RerankProviderCrossEncoder,
+				Endpoint: "http://localhost:8080/v1/rerank",
+			},
+		},
+		{
+			name:     "cross encoder without endpoint fails",
+			settings: &domain.RerankSettings{Provider: domain.RerankProviderCrossEncoder},
+			wantErr:  true,
+		},
+		{
+			name: "cross encoder with invalid headers fails",
+			settings: &domain.RerankSettings{
+				Provider: domain.RerankProviderCrossEncoder,
+				Endpoint: "http://localhost:8080/v1/rerank",
+				Headers:  []string{"no-colon"},
+			},
+			wantErr: true,
+		},
+		{name: "llm creates reranker", settings: &domain.RerankSettings{Provider: domain.RerankProviderLLM}},
+		{name: "unknown provider fails", settings: &domain.RerankSettings{Provider: "magic"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reranker, err := CreateReranker(tt.settings, llm, nil)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantNil != (reranker == nil) {
+				t.Errorf("reranker = %v, wantNil %v", reranker, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestCreateReranker_LLMWithoutLLMService(t *testing.T) {
+	_, err := CreateReranker(&domain.RerankSettings{Provider: domain.RerankProviderLLM}, nil, nil)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,63 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// probeText is embedded to validate a self-hosted embedding server.
+const probeText = "sercha embedding probe"
+
+// Ensure probedEmbedding implements the interface.
+var _ driven.EmbeddingService = (*probedEmbedding)(nil)
+
+// probedEmbedding wraps an embedding service whose vector size is learnt by
+// embedding a probe string rather than looked up from the model name.
+// Self-hosted servers do not all expose a models endpoint, so Ping embeds
+// the probe string too.
+type probedEmbedding struct {
+	driven.EmbeddingService
+	dimensions int
+}
+
+// newProbedEmbedding embeds the probe string with svc and records the vector size.
+func newProbedEmbedding(ctx context.Context, svc driven.EmbeddingService) (*probedEmbedding, error) {
+	dimensions, err := probe(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	return &probedEmbedding{EmbeddingService: svc, dimensions: dimensions}, nil
+}
+
+// probe embeds the probe string and returns the size of the vector.
+func probe(ctx context.Context, svc driven.EmbeddingService) (int, error) {
+	vector, err := svc.Embed(ctx, probeText)
+	if err != nil {
+		return 0, fmt.Errorf("embed probe string: %w", err)
+	}
+	if len(vector) == 0 {
+		return 0, errors.New("embed probe string: server returned an empty vector")
+	}
+	return len(vector), nil
+}
+
+// Dimensions returns the vector size reported by the probe.
+func (p *probedEmbedding) Dimensions() int {
+	return p.dimensions
+}
+
+// Ping embeds the probe string and checks the vector size has not changed,
+// which would happen if the server was restarted with another model.
+func (p *probedEmbedding) Ping(ctx context.Context) error {
+	dimensions, err := probe(ctx, p.EmbeddingService)
+	if err != nil {
+		return err
+	}
+	if dimensions != p.dimensions {
+		return fmt.Errorf("embedding server returned %d dimensions, expected %d", dimensions, p.dimensions)
+	}
+	return nil
+}
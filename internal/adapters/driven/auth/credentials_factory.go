@@ -66,6 +66,9 @@ func (f *CredentialsFactory) CreateTokenProvider(
 	case creds.PAT != nil:
 		return NewCredentialsPATProvider(credentialsID, f.credentialsStore), nil
 
+	case creds.GitHubApp != nil:
+		return NewCredentialsGitHubAppProvider(credentialsID, f.credentialsStore), nil
+
 	default:
 		// No credentials set - treat as no-auth
 		return NewNullTokenProvider(), nil
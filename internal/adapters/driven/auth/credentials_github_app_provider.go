@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure CredentialsGitHubAppProvider implements the TokenProvider interface.
+var _ driven.TokenProvider = (*CredentialsGitHubAppProvider)(nil)
+
+// DefaultGitHubAPIURL is the GitHub API installation tokens are minted from.
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+const (
+	// githubAppJWTLifetime is how long an App JWT is valid. GitHub allows
+	// at most 10 minutes.
+	githubAppJWTLifetime = 9 * time.Minute
+
+	// githubAppClockSkew backdates the JWT issue time to allow for clock drift.
+	githubAppClockSkew = 60 * time.Second
+)
+
+// CredentialsGitHubAppProvider provides GitHub App installation tokens.
+// It signs a short-lived JWT with the App's private key and exchanges it for
+// an installation token, which GitHub expires after an hour. Tokens are
+// cached and minted again shortly before they expire.
+type CredentialsGitHubAppProvider struct {
+	credentialsID    string
+	credentialsStore driven.CredentialsStore
+	apiURL           string
+	httpClient       *http.Client
+
+	mu            sync.RWMutex
+	cachedToken   string
+	cacheExpiry   time.Time
+	refreshBuffer time.Duration
+}
+
+// NewCredentialsGitHubAppProvider creates a token provider for GitHub App
+// authentication using the Credentials store.
+func NewCredentialsGitHubAppProvider(
+	credentialsID string,
+	credentialsStore driven.CredentialsStore,
+) *CredentialsGitHubAppProvider {
+	return &CredentialsGitHubAppProvider{
+		credentialsID:    credentialsID,
+		credentialsStore: credentialsStore,
+		apiURL:           DefaultGitHubAPIURL,
+		httpClient:       http.DefaultClient,
+		refreshBuffer:    5 * time.Minute,
+	}
+}
+
+// SetAPIURL sets the GitHub API base URL, such as a GitHub Enterprise Server
+// API or a test server.
+func (p *CredentialsGitHubAppProvider) SetAPIURL(apiURL string) {
+	p.apiURL = apiURL
+}
+
+// GetToken returns a valid installation token, minting a new one if the
+// cached token is missing or about to expire.
+func (p *CredentialsGitHubAppProvider) GetToken(ctx context.Context) (string, error) {
+	// Fast path: check cache with read lock
+	p.mu.RLock()
+	if p.cachedToken != "" && time.Now().Before(p.cacheExpiry) {
+		token := p.cachedToken
+		p.mu.RUnlock()
+		return token, nil
+	}
+	p.mu.RUnlock()
+
+	// Slow path: need a new token, acquire write lock
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Double-check after acquiring write lock
+	if p.cachedToken != "" && time.Now().Before(p.cacheExpiry) {
+		return p.cachedToken, nil
+	}
+
+	creds, err := p.credentialsStore.Get(ctx, p.credentialsID)
+	if err != nil {
+		return "", fmt.Errorf("get credentials: %w", err)
+	}
+	if creds.GitHubApp == nil || !creds.GitHubApp.IsComplete() {
+		return "", errors.New("credentials have no GitHub App installation")
+	}
+
+	token, expiry, err := p.mintInstallationToken(ctx, creds.GitHubApp)
+	if err != nil {
+		return "", fmt.Errorf("mint installation token: %w", err)
+	}
+
+	p.cachedToken = token
+	p.cacheExpiry = expiry.Add(-p.refreshBuffer)
+	return p.cachedToken, nil
+}
+
+// mintInstallationToken exchanges an App JWT for an installation token.
+func (p *CredentialsGitHubAppProvider) mintInstallationToken(
+	ctx context.Context,
+	app *domain.GitHubAppCredentials,
+) (string, time.Time, error) {
+	jwt, err := signGitHubAppJWT(app.AppID, app.PrivateKey, time.Now())
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", p.apiURL, app.InstallationID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, http.NoBody)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", time.Time{}, fmt.Errorf("installation token request failed with status %d", resp.StatusCode)
+	}
+
+	var tokenResp struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", time.Time{}, fmt.Errorf("decode installation token response: %w", err)
+	}
+	if tokenResp.Token == "" {
+		return "", time.Time{}, errors.New("installation token response has no token")
+	}
+	if tokenResp.ExpiresAt.IsZero() {
+		tokenResp.ExpiresAt = time.Now().Add(time.Hour)
+	}
+	return tokenResp.Token, tokenResp.ExpiresAt, nil
+}
+
+// signGitHubAppJWT signs the RS256 JWT that authenticates as a GitHub App.
+func signGitHubAppJWT(appID int64, privateKeyPEM string, now time.Time) (string, error) {
+	key, err := parseRSAPrivateKey(privateKeyPEM)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"iat": now.Add(-githubAppClockSkew).Unix(),
+		"exp": now.Add(githubAppJWTLifetime).Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	signingInput := encoding.EncodeToString(header) + "." + encoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("sign app JWT: %w", err)
+	}
+	return signingInput + "." + encoding.EncodeToString(signature), nil
+}
+
+// parseRSAPrivateKey parses a PEM-encoded RSA private key in PKCS#1 form, as
+// GitHub issues them, or PKCS#8 form.
+func parseRSAPrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// AuthorizationID returns the credentials ID (for compatibility).
+func (p *CredentialsGitHubAppProvider) AuthorizationID() string {
+	return p.credentialsID
+}
+
+// AuthMethod returns AuthMethodGitHubApp.
+func (p *CredentialsGitHubAppProvider) AuthMethod() domain.AuthMethod {
+	return domain.AuthMethodGitHubApp
+}
+
+// IsAuthenticated returns true if a complete GitHub App installation is stored.
+func (p *CredentialsGitHubAppProvider) IsAuthenticated() bool {
+	creds, err := p.credentialsStore.Get(context.Background(), p.credentialsID)
+	if err != nil {
+		return false
+	}
+	return creds.GitHubApp != nil && creds.GitHubApp.IsComplete()
+}
+
+// InvalidateCache clears the cached token so the next call mints a new one.
+func (p *CredentialsGitHubAppProvider) InvalidateCache() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cachedToken = ""
+	p.cacheExpiry = time.Time{}
+}
@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// newGitHubAppServer serves installation tokens for installation 99,
+// checking the App JWT against key. Each token expires after expiresIn.
+func newGitHubAppServer(t *testing.T, key *rsa.PrivateKey, expiresIn time.Duration) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var minted atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/app/installations/99/access_tokens" {
+			http.NotFound(w, r)
+			return
+		}
+		jwt, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || verifyJWT(jwt, &key.PublicKey) != "42" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		n := minted.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{ //nolint:errcheck // test server
+			"token":      fmt.Sprintf("ghs_token%d", n),
+			"expires_at": time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &minted
+}
+
+// verifyJWT checks an RS256 JWT signature and returns its issuer.
+func verifyJWT(jwt string, key *rsa.PublicKey) string {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return ""
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) != nil {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if json.Unmarshal(payload, &claims) != nil {
+		return ""
+	}
+	return claims.Issuer
+}
+
+func newGitHubAppProvider(t *testing.T, privateKeyPEM, apiURL string) *CredentialsGitHubAppProvider {
+	t.Helper()
+	store := memory.NewCredentialsStore()
+	require.NoError(t, store.Save(context.Background(), domain.Credentials{
+		ID:       "cred-1",
+		SourceID: "src-1",
+		GitHubApp: &domain.GitHubAppCredentials{
+			AppID:          42,
+			InstallationID: 99,
+			PrivateKey:     privateKeyPEM,
+		},
+	}))
+	provider := NewCredentialsGitHubAppProvider("cred-1", store)
+	provider.SetAPIURL(apiURL)
+	return provider
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func TestCredentialsGitHubAppProvider_MintsAndCachesToken(t *testing.T) {
+	key := generateKey(t)
+	server, minted := newGitHubAppServer(t, key, time.Hour)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	provider := newGitHubAppProvider(t, string(keyPEM), server.URL)
+
+	token, err := provider.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token1", token)
+
+	token, err = provider.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token1", token)
+	assert.Equal(t, int32(1), minted.Load())
+
+	assert.Equal(t, domain.AuthMethodGitHubApp, provider.AuthMethod())
+	assert.True(t, provider.IsAuthenticated())
+}
+
+func TestCredentialsGitHubAppProvider_RefreshesExpiringToken(t *testing.T) {
+	key := generateKey(t)
+	// Tokens inside the refresh buffer are minted again on every call
+	server, minted := newGitHubAppServer(t, key, time.Minute)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	provider := newGitHubAppProvider(t, string(keyPEM), server.URL)
+
+	_, err = provider.GetToken(context.Background())
+	require.NoError(t, err)
+	token, err := provider.GetToken(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "ghs_token2", token)
+	assert.Equal(t, int32(2), minted.Load())
+}
+
+func TestCredentialsGitHubAppProvider_RejectedKey(t *testing.T) {
+	server, _ := newGitHubAppServer(t, generateKey(t), time.Hour)
+	other := generateKey(t)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(other)})
+	provider := newGitHubAppProvider(t, string(keyPEM), server.URL)
+
+	_, err := provider.GetToken(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 401")
+}
+
+func TestCredentialsGitHubAppProvider_InvalidKey(t *testing.T) {
+	provider := newGitHubAppProvider(t, "not a key", "http://127.0.0.1:0")
+
+	_, err := provider.GetToken(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not PEM encoded")
+}
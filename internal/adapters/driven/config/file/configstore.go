@@ -1,12 +1,14 @@
 package file
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"sync"
 
 	"github.com/pelletier/go-toml/v2"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
@@ -19,6 +21,9 @@ type ConfigStore struct {
 	mu       sync.RWMutex
 	filePath string
 	data     map[string]any
+
+	// validationErr holds the problems found in the file by the last Load.
+	validationErr error
 }
 
 // NewConfigStore creates a new TOML-based config store.
@@ -42,8 +47,10 @@ func NewConfigStore(configDir string) (*ConfigStore, error) {
 		data:     make(map[string]any),
 	}
 
-	// Load existing data if file exists
-	if err := s.Load(); err != nil && !os.IsNotExist(err) {
+	// Load existing data if file exists. Invalid settings are not fatal:
+	// the settings service falls back to defaults and Validate reports them.
+	var validationErr *domain.ConfigValidationError
+	if err := s.Load(); err != nil && !os.IsNotExist(err) && !errors.As(err, &validationErr) {
 		return nil, err
 	}
 
@@ -156,7 +163,9 @@ func (s *ConfigStore) save() error {
 	return os.WriteFile(s.filePath, data, 0600)
 }
 
-// Load reads configuration from the TOML file.
+// Load reads configuration from the TOML file and validates it against the
+// embedded schema. Invalid entries are still loaded; the returned
+// *domain.ConfigValidationError lists every one of them.
 func (s *ConfigStore) Load() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -166,6 +175,7 @@ func (s *ConfigStore) Load() error {
 		if os.IsNotExist(err) {
 			// No config file yet - that's fine, start empty
 			s.data = make(map[string]any)
+			s.validationErr = nil
 			return nil
 		}
 		return err
@@ -182,7 +192,16 @@ func (s *ConfigStore) Load() error {
 
 	// Flatten nested maps into dot-notation keys for easier access
 	s.data = flattenMap(loaded, "")
-	return nil
+	s.validationErr = validate(s.filePath, s.data)
+	return s.validationErr
+}
+
+// Validate returns the problems found in the configuration file when it was
+// last loaded, or nil if it is valid.
+func (s *ConfigStore) Validate() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.validationErr
 }
 
 // FlattenMap converts nested maps to dot-notation keys.
@@ -2,6 +2,6 @@
 // These adapters persist data to the local filesystem.
 //
 // Adapters:
-//   - ConfigStore: TOML-based configuration storage
+//   - ConfigStore: TOML-based configuration storage, validated against schema.json
 //   - AuthorizationStore: JSON-based authorization persistence
 package file
@@ -0,0 +1,21 @@
+//go:build ignore
+
+// gen_schema writes schema.json from the domain.AppSettings struct tags.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/config/file"
+)
+
+func main() {
+	data, err := file.MarshalSchema()
+	if err != nil {
+		log.Fatalf("generate config schema: %v", err)
+	}
+	if err := os.WriteFile("schema.json", data, 0644); err != nil {
+		log.Fatalf("write schema.json: %v", err)
+	}
+}
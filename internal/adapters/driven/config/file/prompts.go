@@ -45,6 +45,25 @@ Content:
 
 Summary:`,
 
+	driven.PromptExtractKeywords: `Extract up to %d keywords, key phrases and named entities (people, organisations, products, places) from the content below.
+Include closely related concepts the content discusses without naming them directly.
+Return ONLY a comma-separated list, nothing else.
+
+Content:
+%s
+
+Keywords:`,
+
+	driven.PromptRerank: `Rate how relevant each passage is to the search query, from 0 (unrelated) to 10 (answers it exactly).
+Return ONLY one line per passage in the form "number: rating", nothing else.
+
+Query: %s
+
+Passages:
+%s
+
+Ratings:`,
+
 	driven.PromptChatSystem: `You are Sercha, a knowledgeable search assistant. You help users find and understand information from their indexed documents.
 
 You have access to the following tools:
@@ -0,0 +1,202 @@
+package file
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+//go:generate go run gen_schema.go
+
+// schemaJSON is the published JSON Schema for config.toml.
+// Regenerate it with `go generate` after changing domain.AppSettings.
+//
+//go:embed schema.json
+var schemaJSON []byte
+
+// GenerateSchema builds the JSON Schema for the settings sections of config.toml
+// from the json and jsonschema struct tags on domain.AppSettings.
+func GenerateSchema() (*jsonschema.Schema, error) {
+	schema, err := jsonschema.For[domain.AppSettings](&jsonschema.ForOptions{
+		TypeSchemas: map[reflect.Type]*jsonschema.Schema{
+			// Every integer setting is a count or a number of seconds.
+			reflect.TypeFor[int]():                    {Type: "integer", Minimum: jsonschema.Ptr(0.0)},
+			reflect.TypeFor[domain.SearchMode]():      enumSchema(domain.AllSearchModes()),
+			reflect.TypeFor[domain.AIProvider]():      enumSchema(domain.AllLLMProviders()),
+			reflect.TypeFor[domain.Language]():        enumSchema(domain.AllLanguages()),
+			reflect.TypeFor[domain.VectorPrecision](): enumSchema(domain.AllVectorPrecisions()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// AIProvider covers LLM providers; not all of them can embed.
+	embedding := schema.Properties["embedding"].Properties["provider"]
+	embedding.Enum = enumSchema(domain.AllEmbeddingProviders()).Enum
+
+	schema.Title = "Sercha configuration"
+	schema.Description = "Settings sections of ~/.sercha/config.toml"
+	// Sections outside AppSettings (pipeline, scheduler) are free-form.
+	schema.AdditionalProperties = nil
+	return schema, nil
+}
+
+// enumSchema returns a string schema restricted to the given values.
+func enumSchema[T ~string](values []T) *jsonschema.Schema {
+	enum := make([]any, len(values))
+	for i, v := range values {
+		enum[i] = string(v)
+	}
+	return &jsonschema.Schema{Type: "string", Enum: enum}
+}
+
+// MarshalSchema renders the generated schema in the layout of schema.json.
+func MarshalSchema() ([]byte, error) {
+	schema, err := GenerateSchema()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+var (
+	loadSchemaOnce sync.Once
+	loadedSchema   *jsonschema.Schema
+	loadSchemaErr  error
+)
+
+// configSchema returns the embedded schema, parsed once.
+func configSchema() (*jsonschema.Schema, error) {
+	loadSchemaOnce.Do(func() {
+		var schema jsonschema.Schema
+		if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+			loadSchemaErr = fmt.Errorf("parse config schema: %w", err)
+			return
+		}
+		loadedSchema = &schema
+	})
+	return loadedSchema, loadSchemaErr
+}
+
+// validate checks flattened configuration values against the schema and
+// collects every problem, so the user can fix the file in one pass.
+// Keys in sections the schema does not describe are not checked.
+func validate(path string, data map[string]any) error {
+	schema, err := configSchema()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var fieldErrs []domain.ConfigFieldError
+	for _, key := range keys {
+		fieldErr, err := validateKey(schema, key, data[key])
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			fieldErrs = append(fieldErrs, *fieldErr)
+		}
+	}
+
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+	return &domain.ConfigValidationError{Path: path, Errors: fieldErrs}
+}
+
+// validateKey checks a single dot-notation key and its value.
+// It returns a field error for invalid entries and an error only if the
+// schema itself is unusable.
+func validateKey(schema *jsonschema.Schema, key string, value any) (*domain.ConfigFieldError, error) {
+	parts := strings.Split(key, ".")
+	if _, known := schema.Properties[parts[0]]; !known {
+		return nil, nil
+	}
+
+	current := schema
+	for _, part := range parts {
+		next, ok := current.Properties[part]
+		if !ok {
+			return &domain.ConfigFieldError{
+				Field:   key,
+				Value:   fmt.Sprint(value),
+				Message: "unknown setting, expected one of: " + strings.Join(propertyNames(current), ", "),
+			}, nil
+		}
+		current = next
+	}
+
+	// Empty strings mean unset; the settings service falls back to defaults.
+	if s, ok := value.(string); ok && s == "" && current.Enum != nil {
+		return nil, nil
+	}
+
+	resolved, err := current.CloneSchemas().Resolve(nil)
+	if err != nil {
+		return nil, fmt.Errorf("resolve schema for %s: %w", key, err)
+	}
+	if err := resolved.Validate(value); err != nil {
+		return &domain.ConfigFieldError{
+			Field:   key,
+			Value:   fmt.Sprint(value),
+			Message: describeSchema(current),
+		}, nil
+	}
+	return nil, nil
+}
+
+// describeSchema explains the values a schema accepts.
+func describeSchema(s *jsonschema.Schema) string {
+	if len(s.Enum) > 0 {
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = fmt.Sprint(v)
+		}
+		return "must be one of: " + strings.Join(values, ", ")
+	}
+
+	switch s.Type {
+	case "boolean":
+		return "must be true or false"
+	case "integer":
+		if s.Minimum != nil {
+			return fmt.Sprintf("must be a whole number of at least %g", *s.Minimum)
+		}
+		return "must be a whole number"
+	case "string":
+		return "must be a quoted string"
+	case "object":
+		return "must be a table of settings: " + strings.Join(propertyNames(s), ", ")
+	default:
+		return "invalid value"
+	}
+}
+
+// propertyNames returns the sorted property names of an object schema.
+func propertyNames(s *jsonschema.Schema) []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
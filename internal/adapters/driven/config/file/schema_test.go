@@ -0,0 +1,122 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// TestSchema_UpToDate fails when schema.json drifts from domain.AppSettings.
+// Run `go generate ./internal/adapters/driven/config/file` to refresh it.
+func TestSchema_UpToDate(t *testing.T) {
+	generated, err := MarshalSchema()
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(generated), string(schemaJSON))
+}
+
+func TestGenerateSchema_FromStructTags(t *testing.T) {
+	schema, err := GenerateSchema()
+	require.NoError(t, err)
+
+	search := schema.Properties["search"]
+	require.NotNil(t, search)
+	assert.Equal(t, "search retrieval mode", search.Properties["mode"].Description)
+	assert.Contains(t, search.Properties["mode"].Enum, "hybrid")
+	assert.NotContains(t, schema.Properties["embedding"].Properties["provider"].Enum, "anthropic")
+	assert.Contains(t, schema.Properties["llm"].Properties["provider"].Enum, "anthropic")
+	assert.Equal(t, "integer", schema.Properties["sync"].Properties["full_timeout_seconds"].Type)
+}
+
+func TestValidate_Valid(t *testing.T) {
+	data := map[string]any{
+		"search.mode":            "hybrid",
+		"search.show_chunks":     true,
+		"embedding.provider":     "",
+		"embedding.workers":      int64(4),
+		"vector_index.precision": "float16",
+		"pipeline.processors":    []any{"chunker"},
+		"scheduler.enabled":      true,
+		"tui.keys.up":            []any{"up", "c"},
+	}
+
+	assert.NoError(t, validate("config.toml", data))
+}
+
+func TestValidate_CollectsEveryProblem(t *testing.T) {
+	data := map[string]any{
+		"search.mode":        "fast",
+		"search.modes":       "hybrid",
+		"embedding.workers":  int64(-1),
+		"enrichment.enabled": "yes",
+		"llm.provider":       "openai",
+	}
+
+	err := validate("config.toml", data)
+
+	var validationErr *domain.ConfigValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Equal(t, "config.toml", validationErr.Path)
+	require.Len(t, validationErr.Errors, 4)
+
+	// Errors are reported in key order.
+	assert.Equal(t, "embedding.workers", validationErr.Errors[0].Field)
+	assert.Equal(t, "-1", validationErr.Errors[0].Value)
+	assert.Equal(t, "must be a whole number of at least 0", validationErr.Errors[0].Message)
+
+	assert.Equal(t, "enrichment.enabled", validationErr.Errors[1].Field)
+	assert.Equal(t, "must be true or false", validationErr.Errors[1].Message)
+
+	assert.Equal(t, "search.mode", validationErr.Errors[2].Field)
+	assert.Equal(t, "fast", validationErr.Errors[2].Value)
+	assert.Equal(t, "must be one of: text_only, hybrid, vector_only, llm_assisted, full",
+		validationErr.Errors[2].Message)
+
+	assert.Equal(t, "search.modes", validationErr.Errors[3].Field)
+	assert.Contains(t, validationErr.Errors[3].Message, "unknown setting")
+}
+
+func TestValidate_SectionNotATable(t *testing.T) {
+	err := validate("config.toml", map[string]any{"search": "hybrid"})
+
+	var validationErr *domain.ConfigValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Errors, 1)
+	assert.Contains(t, validationErr.Errors[0].Message, "must be a table of settings")
+}
+
+func TestNewConfigStore_InvalidConfigIsNotFatal(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := []byte("[search]\nmode = \"fast\"\n\n[embedding]\nworkers = -3\n")
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "config.toml"), content, 0600))
+
+	store, err := NewConfigStore(tmpDir)
+	require.NoError(t, err)
+
+	// Values are still loaded so the settings service can fall back.
+	assert.Equal(t, "fast", store.GetString("search.mode"))
+
+	var validationErr *domain.ConfigValidationError
+	require.ErrorAs(t, store.Validate(), &validationErr)
+	assert.Len(t, validationErr.Errors, 2)
+	assert.Equal(t, store.Path(), validationErr.Path)
+
+	// Load reports the same problems.
+	require.ErrorAs(t, store.Load(), &validationErr)
+}
+
+func TestConfigStore_Validate_ValidFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	store, err := NewConfigStore(tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, store.Set("search.mode", "hybrid"))
+
+	require.NoError(t, store.Load())
+	assert.NoError(t, store.Validate())
+}
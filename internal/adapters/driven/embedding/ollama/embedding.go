@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"time"
 
@@ -37,6 +38,15 @@ type Config struct {
 
 	// Dimensions is the embedding vector size (model-dependent).
 	Dimensions int
+
+	// Truncate cuts returned vectors down to Dimensions and re-normalises
+	// them. Only Matryoshka models such as nomic-embed-text keep their
+	// quality when truncated.
+	Truncate bool
+
+	// Headers are extra HTTP headers sent with every request, for an
+	// Ollama instance behind an authenticating proxy.
+	Headers map[string]string
 }
 
 // EmbeddingService generates embeddings using Ollama.
@@ -45,6 +55,8 @@ type EmbeddingService struct {
 	baseURL    string
 	model      string
 	dimensions int
+	truncate   bool
+	headers    map[string]string
 }
 
 // embedRequest is the Ollama API request format.
@@ -80,6 +92,8 @@ func NewEmbeddingService(cfg Config) *EmbeddingService {
 		baseURL:    cfg.BaseURL,
 		model:      cfg.Model,
 		dimensions: cfg.Dimensions,
+		truncate:   cfg.Truncate,
+		headers:    cfg.Headers,
 	}
 }
 
@@ -105,6 +119,7 @@ func (s *EmbeddingService) Embed(ctx context.Context, text string) ([]float32, e
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	s.setHeaders(req)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -125,15 +140,44 @@ func (s *EmbeddingService) Embed(ctx context.Context, text string) ([]float32, e
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 
+	values := embedResp.Embedding
+	if s.truncate {
+		values = truncate(values, s.dimensions)
+	}
+
 	// Convert float64 to float32
-	embedding := make([]float32, len(embedResp.Embedding))
-	for i, v := range embedResp.Embedding {
+	embedding := make([]float32, len(values))
+	for i, v := range values {
 		embedding[i] = float32(v)
 	}
 
 	return embedding, nil
 }
 
+// truncate keeps the first dimensions values of a Matryoshka embedding and
+// scales them back to unit length. Shorter vectors are returned unchanged.
+func truncate(values []float64, dimensions int) []float64 {
+	if dimensions <= 0 || len(values) <= dimensions {
+		return values
+	}
+	values = values[:dimensions]
+
+	var sum float64
+	for _, v := range values {
+		sum += v * v
+	}
+	norm := math.Sqrt(sum)
+	if norm == 0 {
+		return values
+	}
+
+	scaled := make([]float64, dimensions)
+	for i, v := range values {
+		scaled[i] = v / norm
+	}
+	return scaled
+}
+
 // EmbedBatch generates embeddings for multiple texts efficiently.
 func (s *EmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
 	// Ollama doesn't have a native batch API, so we call Embed for each text.
@@ -166,6 +210,7 @@ func (s *EmbeddingService) Ping(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("ollama: failed to create ping request: %w", err)
 	}
+	s.setHeaders(req)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -183,6 +228,13 @@ func (s *EmbeddingService) Ping(ctx context.Context) error {
 	return nil
 }
 
+// setHeaders adds the configured extra headers to a request.
+func (s *EmbeddingService) setHeaders(req *http.Request) {
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+}
+
 // Close releases resources.
 func (s *EmbeddingService) Close() error {
 	// HTTP client doesn't need explicit cleanup
@@ -0,0 +1,81 @@
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, embedding []float64) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(embedResponse{Embedding: embedding})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEmbed_FullVector(t *testing.T) {
+	server := newTestServer(t, []float64{0.6, 0.8, 0, 0})
+	svc := NewEmbeddingService(Config{BaseURL: server.URL, Dimensions: 4})
+
+	embedding, err := svc.Embed(context.Background(), "text")
+
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.6, 0.8, 0, 0}, embedding)
+}
+
+func TestEmbed_Truncate(t *testing.T) {
+	server := newTestServer(t, []float64{3, 4, 12, 0})
+	svc := NewEmbeddingService(Config{BaseURL: server.URL, Dimensions: 2, Truncate: true})
+
+	embedding, err := svc.Embed(context.Background(), "text")
+
+	require.NoError(t, err)
+	require.Len(t, embedding, 2)
+	assert.InDelta(t, 0.6, embedding[0], 1e-6)
+	assert.InDelta(t, 0.8, embedding[1], 1e-6)
+	assert.Equal(t, 2, svc.Dimensions())
+}
+
+func TestEmbed_Headers(t *testing.T) {
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Api-Key")
+		_ = json.NewEncoder(w).Encode(embedResponse{Embedding: []float64{1}})
+	}))
+	t.Cleanup(server.Close)
+	svc := NewEmbeddingService(Config{BaseURL: server.URL, Dimensions: 1, Headers: map[string]string{"X-Api-Key": "secret"}})
+
+	_, err := svc.Embed(context.Background(), "text")
+
+	require.NoError(t, err)
+	assert.Equal(t, "secret", header)
+}
+
+func TestTruncate(t *testing.T) {
+	t.Run("unit length after truncation", func(t *testing.T) {
+		values := truncate([]float64{1, 1, 1, 1, 1, 1}, 3)
+
+		require.Len(t, values, 3)
+		var sum float64
+		for _, v := range values {
+			sum += v * v
+		}
+		assert.InDelta(t, 1.0, math.Sqrt(sum), 1e-9)
+	})
+
+	t.Run("shorter vector unchanged", func(t *testing.T) {
+		assert.Equal(t, []float64{1, 2}, truncate([]float64{1, 2}, 4))
+	})
+
+	t.Run("zero vector unchanged", func(t *testing.T) {
+		assert.Equal(t, []float64{0, 0}, truncate([]float64{0, 0, 0}, 2))
+	})
+}
@@ -32,7 +32,8 @@ var modelDimensions = map[string]int{
 
 // Config holds configuration for the OpenAI embedding service.
 type Config struct {
-	// APIKey is the OpenAI API key (required).
+	// APIKey is the OpenAI API key. It is required for the OpenAI API and
+	// optional for a custom BaseURL, where it is only sent when set.
 	APIKey string
 
 	// BaseURL is the API base URL (default: https://api.openai.com/v1).
@@ -48,6 +49,9 @@ type Config struct {
 	// Dimensions overrides the default dimension for the model.
 	// Only applicable to text-embedding-3-* models.
 	Dimensions int
+
+	// Headers are extra HTTP headers sent with every request.
+	Headers map[string]string
 }
 
 // EmbeddingService generates embeddings using OpenAI API.
@@ -57,6 +61,7 @@ type EmbeddingService struct {
 	apiKey     string
 	model      string
 	dimensions int
+	headers    map[string]string
 }
 
 // embeddingRequest is the OpenAI API request format.
@@ -84,12 +89,12 @@ type embeddingResponse struct {
 
 // NewEmbeddingService creates a new OpenAI embedding service.
 func NewEmbeddingService(cfg Config) (*EmbeddingService, error) {
-	if cfg.APIKey == "" {
-		return nil, fmt.Errorf("openai: API key is required")
-	}
 	if cfg.BaseURL == "" {
 		cfg.BaseURL = DefaultBaseURL
 	}
+	if cfg.APIKey == "" && cfg.BaseURL == DefaultBaseURL {
+		return nil, fmt.Errorf("openai: API key is required")
+	}
 	if cfg.Model == "" {
 		cfg.Model = DefaultModel
 	}
@@ -115,6 +120,7 @@ func NewEmbeddingService(cfg Config) (*EmbeddingService, error) {
 		apiKey:     cfg.APIKey,
 		model:      cfg.Model,
 		dimensions: dimensions,
+		headers:    cfg.Headers,
 	}, nil
 }
 
@@ -163,7 +169,7 @@ func (s *EmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	s.setHeaders(req)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -202,6 +208,17 @@ func (s *EmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]
 	return embeddings, nil
 }
 
+// setHeaders adds authentication and the configured extra headers to a request.
+// An extra Authorization header replaces the bearer token.
+func (s *EmbeddingService) setHeaders(req *http.Request) {
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+}
+
 // Dimensions returns the embedding vector size.
 func (s *EmbeddingService) Dimensions() int {
 	return s.dimensions
@@ -219,7 +236,7 @@ func (s *EmbeddingService) Ping(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("openai: failed to create ping request: %w", err)
 	}
-	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	s.setHeaders(req)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
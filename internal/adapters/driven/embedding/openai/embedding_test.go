@@ -0,0 +1,83 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer serves a fixed embedding and records the last request's headers.
+func newTestServer(t *testing.T, embedding []float64) (*httptest.Server, *http.Header) {
+	t.Helper()
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		var resp embeddingResponse
+		resp.Data = append(resp.Data, struct {
+			Embedding []float64 `json:"embedding"`
+			Index     int       `json:"index"`
+		}{Embedding: embedding})
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server, &headers
+}
+
+func TestNewEmbeddingService_APIKey(t *testing.T) {
+	_, err := NewEmbeddingService(Config{})
+	require.Error(t, err, "the OpenAI API needs a key")
+
+	_, err = NewEmbeddingService(Config{BaseURL: "http://localhost:8080/v1"})
+	require.NoError(t, err, "a self-hosted server may not")
+}
+
+func TestEmbed_Headers(t *testing.T) {
+	server, headers := newTestServer(t, []float64{0.6, 0.8})
+	svc, err := NewEmbeddingService(Config{
+		BaseURL: server.URL,
+		Model:   "local-model",
+		Headers: map[string]string{"X-Api-Key": "secret"},
+	})
+	require.NoError(t, err)
+
+	embedding, err := svc.Embed(context.Background(), "text")
+
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.6, 0.8}, embedding)
+	assert.Equal(t, "secret", headers.Get("X-Api-Key"))
+	assert.Empty(t, headers.Get("Authorization"), "no bearer token without an API key")
+}
+
+func TestEmbed_AuthorizationHeaderOverridesAPIKey(t *testing.T) {
+	server, headers := newTestServer(t, []float64{1})
+	svc, err := NewEmbeddingService(Config{
+		APIKey:  "sk-test",
+		BaseURL: server.URL,
+		Headers: map[string]string{"Authorization": "Token proxy"},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.Embed(context.Background(), "text")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Token proxy", headers.Get("Authorization"))
+}
+
+func TestPing_Headers(t *testing.T) {
+	server, headers := newTestServer(t, nil)
+	svc, err := NewEmbeddingService(Config{
+		APIKey:  "sk-test",
+		BaseURL: server.URL,
+		Headers: map[string]string{"X-Route": "gpu"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Ping(context.Background()))
+	assert.Equal(t, "Bearer sk-test", headers.Get("Authorization"))
+	assert.Equal(t, "gpu", headers.Get("X-Route"))
+}
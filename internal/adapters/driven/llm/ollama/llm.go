@@ -270,6 +270,34 @@ func (s *LLMService) Summarise(ctx context.Context, content string, maxLength in
 	return strings.TrimSpace(result), nil
 }
 
+// defaultExtractKeywordsPrompt is the fallback prompt when no PromptStore is configured.
+//
+//nolint:lll // Prompt content is intentionally long and should not be wrapped.
+const defaultExtractKeywordsPrompt = `Extract up to %d keywords, key phrases and named entities (people, organisations, products, places) from the content below.
+Include closely related concepts the content discusses without naming them directly.
+Return ONLY a comma-separated list, nothing else.
+
+Content:
+%s
+
+Keywords:`
+
+// ExtractKeywords returns keyphrases and named entities that describe the content.
+func (s *LLMService) ExtractKeywords(ctx context.Context, content string, maxKeywords int) ([]string, error) {
+	promptTemplate := s.loadPrompt(driven.PromptExtractKeywords, defaultExtractKeywordsPrompt)
+	prompt := fmt.Sprintf(promptTemplate, maxKeywords, content)
+
+	result, err := s.Generate(ctx, prompt, driven.GenerateOptions{
+		MaxTokens:   maxKeywords * 8, // Keyphrases are a few tokens each
+		Temperature: 0.0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("extract keywords: %w", err)
+	}
+
+	return driven.ParseKeywordList(result, maxKeywords), nil
+}
+
 // loadPrompt loads a prompt from the store, falling back to the default if unavailable.
 func (s *LLMService) loadPrompt(name, fallback string) string {
 	if s.promptStore == nil {
@@ -0,0 +1,199 @@
+// Package crossencoder provides a reranker that scores search candidates
+// with a cross-encoder served behind a rerank endpoint.
+package crossencoder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Reranker implements the interface.
+var _ driven.Reranker = (*Reranker)(nil)
+
+// DefaultTimeout is the default request timeout. Reranking holds up the
+// search, so it is kept short.
+const DefaultTimeout = 15 * time.Second
+
+// Config holds configuration for the cross-encoder reranker.
+type Config struct {
+	// Endpoint is the full URL of the rerank endpoint,
+	// e.g. http://localhost:8080/v1/rerank.
+	Endpoint string
+
+	// Model is sent with each request when set, for servers that host
+	// more than one model.
+	Model string
+
+	// APIKey is sent as a bearer token when set.
+	APIKey string
+
+	// Timeout is the request timeout (default: 15s).
+	Timeout time.Duration
+
+	// Headers are extra HTTP headers sent with every request.
+	Headers map[string]string
+}
+
+// Reranker scores passages with a rerank endpoint that speaks the
+// Cohere/Jina rerank API, as served by the llama.cpp server, Infinity and
+// vLLM. The bare result list returned by text-embeddings-inference is
+// accepted too.
+type Reranker struct {
+	client   *http.Client
+	endpoint string
+	model    string
+	apiKey   string
+	headers  map[string]string
+}
+
+// rerankRequest is the Cohere/Jina rerank request format.
+type rerankRequest struct {
+	Model     string   `json:"model,omitempty"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+	// Texts carries the passages for text-embeddings-inference.
+	Texts []string `json:"texts"`
+	TopN  int      `json:"top_n"`
+}
+
+// rerankResult scores the passage at Index. Cohere/Jina servers name the
+// score relevance_score; text-embeddings-inference names it score.
+type rerankResult struct {
+	Index          int      `json:"index"`
+	RelevanceScore *float64 `json:"relevance_score"`
+	Score          *float64 `json:"score"`
+}
+
+// rerankResponse is the Cohere/Jina rerank response format.
+type rerankResponse struct {
+	Results []rerankResult `json:"results"`
+}
+
+// NewReranker creates a cross-encoder reranker.
+func NewReranker(cfg Config) (*Reranker, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("cross-encoder: rerank endpoint is required")
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	return &Reranker{
+		client:   &http.Client{Timeout: cfg.Timeout},
+		endpoint: cfg.Endpoint,
+		model:    cfg.Model,
+		apiKey:   cfg.APIKey,
+		headers:  cfg.Headers,
+	}, nil
+}
+
+// Rerank posts the query and passages to the endpoint and returns the
+// score of each passage.
+func (r *Reranker) Rerank(ctx context.Context, query string, passages []string) ([]float64, error) {
+	if len(passages) == 0 {
+		return nil, nil
+	}
+
+	jsonBody, err := json.Marshal(rerankRequest{
+		Model:     r.model,
+		Query:     query,
+		Documents: passages,
+		Texts:     passages,
+		TopN:      len(passages),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.setHeaders(req)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cross-encoder error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	results, err := decodeResults(body)
+	if err != nil {
+		return nil, err
+	}
+	return scoresByIndex(results, len(passages))
+}
+
+// decodeResults reads either a Cohere/Jina response object or the bare
+// result list returned by text-embeddings-inference.
+func decodeResults(body []byte) ([]rerankResult, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var results []rerankResult
+		if err := json.Unmarshal(body, &results); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+		return results, nil
+	}
+
+	var resp rerankResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return resp.Results, nil
+}
+
+// scoresByIndex orders the scores of the results by passage. Every passage
+// must be scored, as a missing score cannot be compared with the others.
+func scoresByIndex(results []rerankResult, count int) ([]float64, error) {
+	scores := make([]float64, count)
+	scored := make([]bool, count)
+	for _, result := range results {
+		if result.Index < 0 || result.Index >= count {
+			return nil, fmt.Errorf("cross-encoder: result for unknown passage %d", result.Index)
+		}
+		switch {
+		case result.RelevanceScore != nil:
+			scores[result.Index] = *result.RelevanceScore
+		case result.Score != nil:
+			scores[result.Index] = *result.Score
+		default:
+			return nil, fmt.Errorf("cross-encoder: no score for passage %d", result.Index)
+		}
+		scored[result.Index] = true
+	}
+	for i := range scored {
+		if !scored[i] {
+			return nil, fmt.Errorf("cross-encoder: no score for passage %d", i)
+		}
+	}
+	return scores, nil
+}
+
+// setHeaders adds authentication and the configured extra headers to a request.
+// An extra Authorization header replaces the bearer token.
+func (r *Reranker) setHeaders(req *http.Request) {
+	if r.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+r.apiKey)
+	}
+	for name, value := range r.headers {
+		req.Header.Set(name, value)
+	}
+}
@@ -0,0 +1,104 @@
+package crossencoder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer serves a fixed rerank response and records the request.
+func newTestServer(t *testing.T, status int, response string) (*httptest.Server, *rerankRequest, *http.Header) {
+	t.Helper()
+	var request rerankRequest
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server, &request, &headers
+}
+
+func TestNewReranker_RequiresEndpoint(t *testing.T) {
+	_, err := NewReranker(Config{})
+
+	assert.Error(t, err)
+}
+
+func TestReranker_Rerank(t *testing.T) {
+	server, request, headers := newTestServer(t, http.StatusOK,
+		`{"results":[{"index":1,"relevance_score":0.9},{"index":0,"relevance_score":0.1}]}`)
+	reranker, err := NewReranker(Config{
+		Endpoint: server.URL + "/v1/rerank",
+		Model:    "bge-reranker-v2-m3",
+		APIKey:   "secret",
+		Headers:  map[string]string{"X-Team": "search"},
+	})
+	require.NoError(t, err)
+
+	scores, err := reranker.Rerank(context.Background(), "budget", []string{"lunch menu", "budget review"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.1, 0.9}, scores)
+	assert.Equal(t, "budget", request.Query)
+	assert.Equal(t, []string{"lunch menu", "budget review"}, request.Documents)
+	assert.Equal(t, "bge-reranker-v2-m3", request.Model)
+	assert.Equal(t, 2, request.TopN)
+	assert.Equal(t, "Bearer secret", headers.Get("Authorization"))
+	assert.Equal(t, "search", headers.Get("X-Team"))
+}
+
+func TestReranker_Rerank_BareResultList(t *testing.T) {
+	server, request, _ := newTestServer(t, http.StatusOK, `[{"index":0,"score":0.4},{"index":1,"score":0.7}]`)
+	reranker, err := NewReranker(Config{Endpoint: server.URL + "/rerank"})
+	require.NoError(t, err)
+
+	scores, err := reranker.Rerank(context.Background(), "q", []string{"a", "b"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.4, 0.7}, scores)
+	assert.Equal(t, []string{"a", "b"}, request.Texts)
+}
+
+func TestReranker_Rerank_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+	}{
+		{name: "server error", status: http.StatusInternalServerError, response: `{"error":"model not loaded"}`},
+		{name: "missing passage", status: http.StatusOK, response: `{"results":[{"index":0,"relevance_score":0.5}]}`},
+		{name: "unknown passage", status: http.StatusOK, response: `{"results":[{"index":0,"relevance_score":0.5},{"index":5,"relevance_score":0.1}]}`},
+		{name: "missing score", status: http.StatusOK, response: `{"results":[{"index":0},{"index":1}]}`},
+		{name: "invalid JSON", status: http.StatusOK, response: `not json`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, _ := newTestServer(t, tt.status, tt.response)
+			reranker, err := NewReranker(Config{Endpoint: server.URL})
+			require.NoError(t, err)
+
+			_, err = reranker.Rerank(context.Background(), "q", []string{"a", "b"})
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReranker_Rerank_Empty(t *testing.T) {
+	reranker, err := NewReranker(Config{Endpoint: "http://127.0.0.1:0"})
+	require.NoError(t, err)
+
+	scores, err := reranker.Rerank(context.Background(), "q", nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, scores)
+}
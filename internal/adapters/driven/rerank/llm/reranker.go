@@ -0,0 +1,128 @@
+// Package llm provides a reranker that asks an LLM to rate search candidates.
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Reranker implements the interfaces.
+var (
+	_ driven.Reranker         = (*Reranker)(nil)
+	_ driven.PromptStoreAware = (*Reranker)(nil)
+)
+
+// maxPassageLength caps the characters of each passage sent to the LLM,
+// so a long chunk does not crowd the others out of the context window.
+const maxPassageLength = 1000
+
+// defaultRerankPrompt is the fallback prompt when no PromptStore is configured.
+//
+//nolint:lll // Prompt content is intentionally long and should not be wrapped.
+const defaultRerankPrompt = `Rate how relevant each passage is to the search query, from 0 (unrelated) to 10 (answers it exactly).
+Return ONLY one line per passage in the form "number: rating", nothing else.
+
+Query: %s
+
+Passages:
+%s
+
+Ratings:`
+
+// ratingLine matches a "number: rating" line of the LLM's response,
+// allowing the number to be bracketed as the passages are.
+var ratingLine = regexp.MustCompile(`^\[?(\d+)\]?\s*[:=.)-]\s*(\d+(?:\.\d+)?)`)
+
+// Reranker scores search candidates with a single LLM call per search.
+type Reranker struct {
+	llm         driven.LLMService
+	promptStore driven.PromptStore
+}
+
+// NewReranker creates a reranker that prompts the given LLM.
+func NewReranker(llm driven.LLMService) *Reranker {
+	return &Reranker{llm: llm}
+}
+
+// SetPromptStore sets the prompt store for loading a customised rerank prompt.
+func (r *Reranker) SetPromptStore(store driven.PromptStore) {
+	r.promptStore = store
+}
+
+// Rerank asks the LLM to rate every passage from 0 to 10. Passages the
+// response leaves unrated score 0.
+func (r *Reranker) Rerank(ctx context.Context, query string, passages []string) ([]float64, error) {
+	if len(passages) == 0 {
+		return nil, nil
+	}
+
+	prompt := fmt.Sprintf(r.loadPrompt(), query, formatPassages(passages))
+	response, err := r.llm.Generate(ctx, prompt, driven.GenerateOptions{
+		MaxTokens:   len(passages)*6 + 16, // A short "n: rating" line each
+		Temperature: 0.0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("llm rerank: %w", err)
+	}
+
+	return parseRatings(response, len(passages))
+}
+
+// formatPassages numbers the passages from 1, one paragraph each.
+func formatPassages(passages []string) string {
+	var b strings.Builder
+	for i, passage := range passages {
+		passage = strings.Join(strings.Fields(passage), " ")
+		if runes := []rune(passage); len(runes) > maxPassageLength {
+			passage = string(runes[:maxPassageLength]) + "..."
+		}
+		fmt.Fprintf(&b, "[%d] %s\n\n", i+1, passage)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// parseRatings reads "number: rating" lines into a score per passage.
+// A response without a single rating is an error, so the caller can keep
+// its own ranking rather than one made of zeroes.
+func parseRatings(response string, count int) ([]float64, error) {
+	scores := make([]float64, count)
+	rated := 0
+	for _, line := range strings.Split(response, "\n") {
+		match := ratingLine.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		number, err := strconv.Atoi(match[1])
+		if err != nil || number < 1 || number > count {
+			continue
+		}
+		rating, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			continue
+		}
+		scores[number-1] = rating
+		rated++
+	}
+	if rated == 0 {
+		return nil, errors.New("llm rerank: no ratings in response")
+	}
+	return scores, nil
+}
+
+// loadPrompt loads the prompt from the store, falling back to the default if unavailable.
+func (r *Reranker) loadPrompt() string {
+	if r.promptStore == nil {
+		return defaultRerankPrompt
+	}
+	prompt, err := r.promptStore.Load(driven.PromptRerank)
+	if err != nil {
+		return defaultRerankPrompt
+	}
+	return prompt
+}
@@ -0,0 +1,103 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// stubLLM returns a fixed response to Generate and records the prompt.
+type stubLLM struct {
+	driven.LLMService
+	response string
+	err      error
+	prompt   string
+}
+
+func (s *stubLLM) Generate(_ context.Context, prompt string, _ driven.GenerateOptions) (string, error) {
+	s.prompt = prompt
+	return s.response, s.err
+}
+
+// stubPromptStore returns a fixed prompt.
+type stubPromptStore struct {
+	prompt string
+}
+
+func (s *stubPromptStore) Load(string) (string, error) { return s.prompt, nil }
+func (s *stubPromptStore) Reload()                     {}
+
+func TestReranker_Rerank(t *testing.T) {
+	llm := &stubLLM{response: "1: 2\n[2]: 9.5\n3 - 7\n"}
+	reranker := NewReranker(llm)
+
+	scores, err := reranker.Rerank(context.Background(), "quarterly budget",
+		[]string{"Team lunch menu", "Q3 budget review", "Budget template"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{2, 9.5, 7}, scores)
+	assert.Contains(t, llm.prompt, "Query: quarterly budget")
+	assert.Contains(t, llm.prompt, "[2] Q3 budget review")
+}
+
+func TestReranker_Rerank_UnratedPassagesScoreZero(t *testing.T) {
+	reranker := NewReranker(&stubLLM{response: "Ratings:\n2: 8\n9: 10"})
+
+	scores, err := reranker.Rerank(context.Background(), "q", []string{"a", "b", "c"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0, 8, 0}, scores)
+}
+
+func TestReranker_Rerank_NoRatings(t *testing.T) {
+	reranker := NewReranker(&stubLLM{response: "I cannot rate these passages."})
+
+	_, err := reranker.Rerank(context.Background(), "q", []string{"a", "b"})
+
+	assert.Error(t, err)
+}
+
+func TestReranker_Rerank_LLMError(t *testing.T) {
+	reranker := NewReranker(&stubLLM{err: errors.New("rate limited")})
+
+	_, err := reranker.Rerank(context.Background(), "q", []string{"a"})
+
+	assert.ErrorContains(t, err, "rate limited")
+}
+
+func TestReranker_Rerank_Empty(t *testing.T) {
+	llm := &stubLLM{}
+	scores, err := NewReranker(llm).Rerank(context.Background(), "q", nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, scores)
+	assert.Empty(t, llm.prompt)
+}
+
+func TestReranker_SetPromptStore(t *testing.T) {
+	llm := &stubLLM{response: "1: 5"}
+	reranker := NewReranker(llm)
+	reranker.SetPromptStore(&stubPromptStore{prompt: "Q=%s P=%s"})
+
+	_, err := reranker.Rerank(context.Background(), "notes", []string{"meeting notes"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Q=notes P=[1] meeting notes", llm.prompt)
+}
+
+func TestFormatPassages_Truncates(t *testing.T) {
+	long := make([]byte, maxPassageLength+50)
+	for i := range long {
+		long[i] = 'a'
+	}
+
+	formatted := formatPassages([]string{string(long), "short\n\ntext"})
+
+	assert.Contains(t, formatted, "[1] "+string(long[:maxPassageLength])+"...")
+	assert.Contains(t, formatted, "[2] short text")
+}
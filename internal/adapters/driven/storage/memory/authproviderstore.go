@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure AuthProviderStore implements the interface.
+var _ driven.AuthProviderStore = (*AuthProviderStore)(nil)
+
+// AuthProviderStore is an in-memory implementation of driven.AuthProviderStore.
+// Providers are listed in insertion order. Unlike the SQLite store it does not
+// check whether sources still use a provider on Delete; AuthProviderService does.
+type AuthProviderStore struct {
+	mu        sync.RWMutex
+	providers map[string]domain.AuthProvider
+	order     []string
+}
+
+// NewAuthProviderStore creates a new in-memory auth provider store.
+func NewAuthProviderStore() *AuthProviderStore {
+	return &AuthProviderStore{
+		providers: make(map[string]domain.AuthProvider),
+	}
+}
+
+// Save stores or updates an auth provider.
+func (s *AuthProviderStore) Save(_ context.Context, provider domain.AuthProvider) error {
+	if provider.ID == "" {
+		return domain.ErrInvalidInput
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.providers[provider.ID]; !ok {
+		s.order = append(s.order, provider.ID)
+	}
+	s.providers[provider.ID] = copyAuthProvider(provider)
+	return nil
+}
+
+// Get retrieves an auth provider by ID.
+func (s *AuthProviderStore) Get(_ context.Context, id string) (*domain.AuthProvider, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	provider, ok := s.providers[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	provider = copyAuthProvider(provider)
+	return &provider, nil
+}
+
+// List returns all auth providers.
+func (s *AuthProviderStore) List(_ context.Context) ([]domain.AuthProvider, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]domain.AuthProvider, 0, len(s.order))
+	for _, id := range s.order {
+		result = append(result, copyAuthProvider(s.providers[id]))
+	}
+	return result, nil
+}
+
+// ListByProvider returns all auth providers for a specific provider type.
+func (s *AuthProviderStore) ListByProvider(
+	_ context.Context,
+	providerType domain.ProviderType,
+) ([]domain.AuthProvider, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var result []domain.AuthProvider
+	for _, id := range s.order {
+		if provider := s.providers[id]; provider.ProviderType == providerType {
+			result = append(result, copyAuthProvider(provider))
+		}
+	}
+	return result, nil
+}
+
+// Delete removes an auth provider by ID.
+func (s *AuthProviderStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.providers[id]; ok {
+		delete(s.providers, id)
+		s.order = slices.DeleteFunc(s.order, func(o string) bool { return o == id })
+	}
+	return nil
+}
+
+// copyAuthProvider copies the OAuth config so callers cannot change stored values.
+func copyAuthProvider(provider domain.AuthProvider) domain.AuthProvider {
+	if provider.OAuth != nil {
+		oauth := *provider.OAuth
+		provider.OAuth = &oauth
+	}
+	return provider
+}
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestAuthProviderStore_SaveAndGet(t *testing.T) {
+	store := NewAuthProviderStore()
+	ctx := context.Background()
+
+	provider := domain.AuthProvider{
+		ID:           "ap-1",
+		Name:         "Work GitHub",
+		ProviderType: domain.ProviderGitHub,
+		AuthMethod:   domain.AuthMethodOAuth,
+		OAuth:        &domain.OAuthProviderConfig{ClientID: "client", ClientSecret: "secret"},
+	}
+	require.NoError(t, store.Save(ctx, provider))
+
+	// Mutating the caller's config must not change the stored provider
+	provider.OAuth.ClientID = "changed"
+
+	got, err := store.Get(ctx, "ap-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Work GitHub", got.Name)
+	assert.Equal(t, "client", got.OAuth.ClientID)
+
+	_, err = store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestAuthProviderStore_Save_RequiresID(t *testing.T) {
+	store := NewAuthProviderStore()
+
+	err := store.Save(context.Background(), domain.AuthProvider{Name: "No ID"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestAuthProviderStore_ListAndListByProvider(t *testing.T) {
+	store := NewAuthProviderStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, domain.AuthProvider{ID: "ap-1", ProviderType: domain.ProviderGitHub}))
+	require.NoError(t, store.Save(ctx, domain.AuthProvider{ID: "ap-2", ProviderType: domain.ProviderGoogle}))
+	require.NoError(t, store.Save(ctx, domain.AuthProvider{ID: "ap-3", ProviderType: domain.ProviderGitHub}))
+	// Updating keeps the original position
+	require.NoError(t, store.Save(ctx, domain.AuthProvider{ID: "ap-1", Name: "Renamed", ProviderType: domain.ProviderGitHub}))
+
+	all, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "ap-1", all[0].ID)
+	assert.Equal(t, "Renamed", all[0].Name)
+
+	github, err := store.ListByProvider(ctx, domain.ProviderGitHub)
+	require.NoError(t, err)
+	require.Len(t, github, 2)
+	assert.Equal(t, "ap-1", github[0].ID)
+	assert.Equal(t, "ap-3", github[1].ID)
+}
+
+func TestAuthProviderStore_Delete(t *testing.T) {
+	store := NewAuthProviderStore()
+	ctx := context.Background()
+	require.NoError(t, store.Save(ctx, domain.AuthProvider{ID: "ap-1"}))
+
+	require.NoError(t, store.Delete(ctx, "ap-1"))
+	require.NoError(t, store.Delete(ctx, "ap-1"), "deleting a missing provider is not an error")
+
+	all, err := store.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
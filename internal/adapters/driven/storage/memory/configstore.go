@@ -112,6 +112,11 @@ func (s *ConfigStore) Load() error {
 	return nil
 }
 
+// Validate reports configuration problems (always nil for memory store).
+func (s *ConfigStore) Validate() error {
+	return nil
+}
+
 // Path returns the configuration file path.
 func (s *ConfigStore) Path() string {
 	return ":memory:"
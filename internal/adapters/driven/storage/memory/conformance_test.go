@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/storetest"
+)
+
+func TestStoreConformance(t *testing.T) {
+	newStores := func(_ *testing.T) storetest.Stores {
+		return storetest.Stores{
+			Sources:    NewSourceStore(),
+			Documents:  NewDocumentStore(),
+			SyncStates: NewSyncStateStore(),
+			Exclusions: NewExclusionStore(),
+			Changes:    NewSyncChangeLogStore(),
+		}
+	}
+
+	// Memory stores are independent maps, so there is no referential integrity
+	storetest.Run(t, newStores, storetest.Behaviour{ForeignKeys: false})
+}
@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure CredentialsStore implements the interface.
+var _ driven.CredentialsStore = (*CredentialsStore)(nil)
+
+// CredentialsStore is an in-memory implementation of driven.CredentialsStore.
+type CredentialsStore struct {
+	mu          sync.RWMutex
+	credentials map[string]domain.Credentials
+}
+
+// NewCredentialsStore creates a new in-memory credentials store.
+func NewCredentialsStore() *CredentialsStore {
+	return &CredentialsStore{
+		credentials: make(map[string]domain.Credentials),
+	}
+}
+
+// Save stores or updates credentials.
+func (s *CredentialsStore) Save(_ context.Context, creds domain.Credentials) error {
+	if creds.ID == "" || creds.SourceID == "" {
+		return domain.ErrInvalidInput
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.credentials[creds.ID] = copyCredentials(creds)
+	return nil
+}
+
+// Get retrieves credentials by ID.
+func (s *CredentialsStore) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	creds, ok := s.credentials[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	creds = copyCredentials(creds)
+	return &creds, nil
+}
+
+// GetBySourceID retrieves credentials for a specific source.
+// Returns nil and no error if the source has no credentials.
+func (s *CredentialsStore) GetBySourceID(_ context.Context, sourceID string) (*domain.Credentials, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, creds := range s.credentials {
+		if creds.SourceID == sourceID {
+			creds = copyCredentials(creds)
+			return &creds, nil
+		}
+	}
+	return nil, nil
+}
+
+// Delete removes credentials by ID.
+func (s *CredentialsStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.credentials, id)
+	return nil
+}
+
+// copyCredentials copies the token structs so callers cannot change stored values.
+func copyCredentials(creds domain.Credentials) domain.Credentials {
+	if creds.OAuth != nil {
+		oauth := *creds.OAuth
+		creds.OAuth = &oauth
+	}
+	if creds.PAT != nil {
+		pat := *creds.PAT
+		creds.PAT = &pat
+	}
+	if creds.GitHubApp != nil {
+		app := *creds.GitHubApp
+		creds.GitHubApp = &app
+	}
+	return creds
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestCredentialsStore_SaveAndGet(t *testing.T) {
+	store := NewCredentialsStore()
+	ctx := context.Background()
+
+	creds := domain.Credentials{
+		ID:                "cred-1",
+		SourceID:          "src-1",
+		AccountIdentifier: "octocat",
+		PAT:               &domain.PATCredentials{Token: "ghp_token"},
+	}
+	require.NoError(t, store.Save(ctx, creds))
+
+	// Mutating the caller's token must not change the stored credentials
+	creds.PAT.Token = "changed"
+
+	got, err := store.Get(ctx, "cred-1")
+	require.NoError(t, err)
+	assert.Equal(t, "octocat", got.AccountIdentifier)
+	assert.Equal(t, "ghp_token", got.PAT.Token)
+
+	bySource, err := store.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	require.NotNil(t, bySource)
+	assert.Equal(t, "cred-1", bySource.ID)
+}
+
+func TestCredentialsStore_Save_InvalidInput(t *testing.T) {
+	store := NewCredentialsStore()
+	ctx := context.Background()
+
+	assert.ErrorIs(t, store.Save(ctx, domain.Credentials{SourceID: "src-1"}), domain.ErrInvalidInput)
+	assert.ErrorIs(t, store.Save(ctx, domain.Credentials{ID: "cred-1"}), domain.ErrInvalidInput)
+}
+
+func TestCredentialsStore_Missing(t *testing.T) {
+	store := NewCredentialsStore()
+	ctx := context.Background()
+
+	_, err := store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	// A source without credentials is not an error
+	creds, err := store.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Nil(t, creds)
+}
+
+func TestCredentialsStore_Delete(t *testing.T) {
+	store := NewCredentialsStore()
+	ctx := context.Background()
+	require.NoError(t, store.Save(ctx, domain.Credentials{ID: "cred-1", SourceID: "src-1"}))
+
+	require.NoError(t, store.Delete(ctx, "cred-1"))
+
+	_, err := store.Get(ctx, "cred-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -1,7 +1,9 @@
 package memory
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"sync"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -12,10 +14,14 @@ import (
 var _ driven.DocumentStore = (*DocumentStore)(nil)
 
 // DocumentStore is an in-memory implementation of driven.DocumentStore.
+// Documents are listed in insertion order and chunks by position,
+// matching the SQLite store.
 type DocumentStore struct {
 	mu        sync.RWMutex
 	documents map[string]domain.Document
+	order     []string
 	chunks    map[string][]domain.Chunk
+	originals map[string][]byte
 }
 
 // NewDocumentStore creates a new in-memory document store.
@@ -23,6 +29,7 @@ func NewDocumentStore() *DocumentStore {
 	return &DocumentStore{
 		documents: make(map[string]domain.Document),
 		chunks:    make(map[string][]domain.Chunk),
+		originals: make(map[string][]byte),
 	}
 }
 
@@ -30,22 +37,56 @@ func NewDocumentStore() *DocumentStore {
 func (s *DocumentStore) SaveDocument(_ context.Context, doc *domain.Document) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.documents[doc.ID] = *doc
+	s.saveDocument(doc)
 	return nil
 }
 
+// saveDocument upserts a document, dropping its original if the content
+// changed. The caller must hold the lock.
+func (s *DocumentStore) saveDocument(doc *domain.Document) {
+	existing, ok := s.documents[doc.ID]
+	if !ok {
+		s.order = append(s.order, doc.ID)
+	} else if existing.Content != doc.Content {
+		delete(s.originals, doc.ID)
+	}
+	s.documents[doc.ID] = *doc
+}
+
 // SaveChunks stores chunks for a document.
+// Chunks are upserted by ID; existing chunks not in the batch are kept.
 func (s *DocumentStore) SaveChunks(_ context.Context, chunks []domain.Chunk) error {
 	if len(chunks) == 0 {
 		return nil
 	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	docID := chunks[0].DocumentID
-	s.chunks[docID] = chunks
+	s.saveChunks(chunks)
+	return nil
+}
+
+// SaveBatch stores or updates several documents and their chunks at once.
+func (s *DocumentStore) SaveBatch(_ context.Context, docs []domain.Document, chunks []domain.Chunk) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range docs {
+		s.saveDocument(&docs[i])
+	}
+	s.saveChunks(chunks)
 	return nil
 }
 
+// saveChunks upserts chunks by ID. The caller must hold the lock.
+func (s *DocumentStore) saveChunks(chunks []domain.Chunk) {
+	for _, chunk := range chunks {
+		// A chunk may move between documents, so drop it wherever it is
+		for docID, existing := range s.chunks {
+			s.chunks[docID] = slices.DeleteFunc(existing, func(c domain.Chunk) bool { return c.ID == chunk.ID })
+		}
+		s.chunks[chunk.DocumentID] = append(s.chunks[chunk.DocumentID], chunk)
+	}
+}
+
 // GetDocument retrieves a document by ID.
 func (s *DocumentStore) GetDocument(_ context.Context, id string) (*domain.Document, error) {
 	s.mu.RLock()
@@ -57,15 +98,37 @@ func (s *DocumentStore) GetDocument(_ context.Context, id string) (*domain.Docum
 	return &doc, nil
 }
 
+// GetDocumentByURI retrieves the most recently updated document with the URI.
+func (s *DocumentStore) GetDocumentByURI(_ context.Context, sourceID, uri string) (*domain.Document, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var found *domain.Document
+	for _, id := range s.order {
+		doc := s.documents[id]
+		if doc.SourceID != sourceID || doc.URI != uri {
+			continue
+		}
+		if found == nil || !doc.UpdatedAt.Before(found.UpdatedAt) {
+			found = &doc
+		}
+	}
+	if found == nil {
+		return nil, domain.ErrNotFound
+	}
+	return found, nil
+}
+
 // GetChunks retrieves all chunks for a document.
 func (s *DocumentStore) GetChunks(_ context.Context, documentID string) ([]domain.Chunk, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	chunks, ok := s.chunks[documentID]
-	if !ok {
+	chunks := s.chunks[documentID]
+	if len(chunks) == 0 {
 		return nil, nil
 	}
-	return chunks, nil
+	result := slices.Clone(chunks)
+	slices.SortStableFunc(result, func(a, b domain.Chunk) int { return cmp.Compare(a.Position, b.Position) })
+	return result, nil
 }
 
 // GetChunk retrieves a specific chunk by ID.
@@ -82,12 +145,31 @@ func (s *DocumentStore) GetChunk(_ context.Context, id string) (*domain.Chunk, e
 	return nil, domain.ErrNotFound
 }
 
+// UpdateChunkEmbedding sets the embedding of an existing chunk.
+func (s *DocumentStore) UpdateChunkEmbedding(_ context.Context, chunkID string, embedding []float32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, chunks := range s.chunks {
+		for i := range chunks {
+			if chunks[i].ID == chunkID {
+				chunks[i].Embedding = slices.Clone(embedding)
+				return nil
+			}
+		}
+	}
+	return domain.ErrNotFound
+}
+
 // DeleteDocument removes a document and its chunks.
 func (s *DocumentStore) DeleteDocument(_ context.Context, id string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	delete(s.documents, id)
+	if _, ok := s.documents[id]; ok {
+		delete(s.documents, id)
+		s.order = slices.DeleteFunc(s.order, func(o string) bool { return o == id })
+	}
 	delete(s.chunks, id)
+	delete(s.originals, id)
 	return nil
 }
 
@@ -96,11 +178,91 @@ func (s *DocumentStore) ListDocuments(_ context.Context, sourceID string) ([]dom
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	var result []domain.Document
-	for id := range s.documents {
-		doc := s.documents[id]
-		if doc.SourceID == sourceID {
+	for _, id := range s.order {
+		if doc := s.documents[id]; doc.SourceID == sourceID {
 			result = append(result, doc)
 		}
 	}
 	return result, nil
 }
+
+// ListDocumentsPaginated returns a page of documents for a source and the total count.
+func (s *DocumentStore) ListDocumentsPaginated(
+	ctx context.Context, sourceID string, offset, limit int,
+) ([]domain.Document, int64, error) {
+	docs, err := s.ListDocuments(ctx, sourceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := int64(len(docs))
+
+	docs = docs[min(max(offset, 0), len(docs)):]
+	if limit > 0 && limit < len(docs) {
+		docs = docs[:limit]
+	}
+	return docs, total, nil
+}
+
+// ListRecentDocuments returns documents across sources, most recently updated first.
+func (s *DocumentStore) ListRecentDocuments(_ context.Context, opts domain.TimelineOptions) ([]domain.Document, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var result []domain.Document
+	for _, id := range s.order {
+		doc := s.documents[id]
+		if len(opts.SourceIDs) > 0 && !slices.Contains(opts.SourceIDs, doc.SourceID) {
+			continue
+		}
+		if !opts.Since.IsZero() && doc.UpdatedAt.Before(opts.Since) {
+			continue
+		}
+		if !opts.Until.IsZero() && !doc.UpdatedAt.Before(opts.Until) {
+			continue
+		}
+		result = append(result, doc)
+	}
+	slices.SortStableFunc(result, func(a, b domain.Document) int { return b.UpdatedAt.Compare(a.UpdatedAt) })
+	if limit := opts.EffectiveLimit(); len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// CountBySource returns the number of documents stored for a source.
+func (s *DocumentStore) CountBySource(_ context.Context, sourceID string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	count := 0
+	for _, doc := range s.documents {
+		if doc.SourceID == sourceID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// SaveOriginal stores the original bytes of a document.
+func (s *DocumentStore) SaveOriginal(_ context.Context, documentID string, content []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.documents[documentID]; !ok {
+		return domain.ErrNotFound
+	}
+	if content == nil {
+		delete(s.originals, documentID)
+		return nil
+	}
+	s.originals[documentID] = slices.Clone(content)
+	return nil
+}
+
+// GetOriginal retrieves the original bytes of a document.
+func (s *DocumentStore) GetOriginal(_ context.Context, documentID string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	content, ok := s.originals[documentID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return slices.Clone(content), nil
+}
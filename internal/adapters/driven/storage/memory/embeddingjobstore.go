@@ -0,0 +1,168 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure EmbeddingJobStore implements the interface.
+var _ driven.EmbeddingJobStore = (*EmbeddingJobStore)(nil)
+
+// EmbeddingJobStore is an in-memory implementation of driven.EmbeddingJobStore.
+// Job IDs increase in queue order, so the oldest jobs are claimed first.
+type EmbeddingJobStore struct {
+	mu     sync.RWMutex
+	jobs   []domain.EmbeddingJob
+	nextID int64
+}
+
+// NewEmbeddingJobStore creates a new in-memory embedding job store.
+func NewEmbeddingJobStore() *EmbeddingJobStore {
+	return &EmbeddingJobStore{nextID: 1}
+}
+
+// Enqueue adds a pending job for each chunk.
+// Chunks that already have a pending job are not queued twice, and finished
+// jobs for re-queued chunks are removed.
+func (s *EmbeddingJobStore) Enqueue(_ context.Context, chunkIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, chunkID := range chunkIDs {
+		pending := false
+		s.jobs = slices.DeleteFunc(s.jobs, func(job domain.EmbeddingJob) bool {
+			if job.ChunkID != chunkID {
+				return false
+			}
+			pending = pending || job.Status == domain.EmbeddingJobPending
+			return job.Status == domain.EmbeddingJobDone || job.Status == domain.EmbeddingJobFailed
+		})
+		if pending {
+			continue
+		}
+		s.jobs = append(s.jobs, domain.EmbeddingJob{
+			ID:        s.nextID,
+			ChunkID:   chunkID,
+			Status:    domain.EmbeddingJobPending,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		s.nextID++
+	}
+	return nil
+}
+
+// Claim marks up to limit of the oldest pending jobs as running and returns them.
+// Jobs waiting to be retried are not claimed before their RetryAt.
+func (s *EmbeddingJobStore) Claim(_ context.Context, limit int) ([]domain.EmbeddingJob, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var claimed []domain.EmbeddingJob
+	now := time.Now()
+	for i := range s.jobs {
+		if len(claimed) == limit {
+			break
+		}
+		if s.jobs[i].Status != domain.EmbeddingJobPending || s.jobs[i].RetryAt.After(now) {
+			continue
+		}
+		s.jobs[i].Status = domain.EmbeddingJobRunning
+		s.jobs[i].UpdatedAt = now
+		claimed = append(claimed, s.jobs[i])
+	}
+	return claimed, nil
+}
+
+// SetStatus updates the status of a job.
+func (s *EmbeddingJobStore) SetStatus(_ context.Context, jobID int64, status domain.EmbeddingJobStatus) error {
+	if !status.IsValid() {
+		return domain.ErrInvalidInput
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.jobs {
+		if s.jobs[i].ID == jobID {
+			s.jobs[i].Status = status
+			s.jobs[i].UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+// Retry returns a job whose attempt failed to the queue, counting the attempt.
+func (s *EmbeddingJobStore) Retry(_ context.Context, jobID int64, retryAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.jobs {
+		if s.jobs[i].ID == jobID {
+			s.jobs[i].Status = domain.EmbeddingJobPending
+			s.jobs[i].Attempts++
+			s.jobs[i].RetryAt = retryAt
+			s.jobs[i].UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+// CountPending returns the number of pending and running jobs.
+func (s *EmbeddingJobStore) CountPending(_ context.Context) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	count := 0
+	for _, job := range s.jobs {
+		if job.Status == domain.EmbeddingJobPending || job.Status == domain.EmbeddingJobRunning {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Stats counts the pending, retrying and failed jobs.
+func (s *EmbeddingJobStore) Stats(_ context.Context) (domain.EmbeddingQueueStats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var stats domain.EmbeddingQueueStats
+	now := time.Now()
+	for _, job := range s.jobs {
+		switch job.Status {
+		case domain.EmbeddingJobPending:
+			stats.Pending++
+			if job.RetryAt.After(now) {
+				stats.Retrying++
+			}
+		case domain.EmbeddingJobRunning:
+			stats.Pending++
+		case domain.EmbeddingJobFailed:
+			stats.Failed++
+		}
+	}
+	return stats, nil
+}
+
+// ResetRunning returns running jobs to pending.
+func (s *EmbeddingJobStore) ResetRunning(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for i := range s.jobs {
+		if s.jobs[i].Status == domain.EmbeddingJobRunning {
+			s.jobs[i].Status = domain.EmbeddingJobPending
+			s.jobs[i].UpdatedAt = now
+		}
+	}
+	return nil
+}
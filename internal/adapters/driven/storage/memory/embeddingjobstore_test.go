@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestEmbeddingJobStore_EnqueueAndClaim(t *testing.T) {
+	store := NewEmbeddingJobStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Enqueue(ctx, []string{"chunk-1", "chunk-2", "chunk-3"}))
+	// Pending chunks are not queued twice
+	require.NoError(t, store.Enqueue(ctx, []string{"chunk-1"}))
+
+	count, err := store.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	jobs, err := store.Claim(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "chunk-1", jobs[0].ChunkID)
+	assert.Equal(t, "chunk-2", jobs[1].ChunkID)
+	assert.Equal(t, domain.EmbeddingJobRunning, jobs[0].Status)
+
+	// Running jobs still count as pending
+	count, err = store.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	jobs, err = store.Claim(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestEmbeddingJobStore_SetStatus(t *testing.T) {
+	store := NewEmbeddingJobStore()
+	ctx := context.Background()
+	require.NoError(t, store.Enqueue(ctx, []string{"chunk-1"}))
+	jobs, err := store.Claim(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	require.NoError(t, store.SetStatus(ctx, jobs[0].ID, domain.EmbeddingJobDone))
+	count, err := store.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	assert.ErrorIs(t, store.SetStatus(ctx, 999, domain.EmbeddingJobDone), domain.ErrNotFound)
+	assert.ErrorIs(t, store.SetStatus(ctx, jobs[0].ID, "bogus"), domain.ErrInvalidInput)
+
+	// Re-queueing a finished chunk replaces its old job
+	require.NoError(t, store.Enqueue(ctx, []string{"chunk-1"}))
+	assert.Len(t, store.jobs, 1)
+	assert.Equal(t, domain.EmbeddingJobPending, store.jobs[0].Status)
+}
+
+func TestEmbeddingJobStore_ResetRunning(t *testing.T) {
+	store := NewEmbeddingJobStore()
+	ctx := context.Background()
+	require.NoError(t, store.Enqueue(ctx, []string{"chunk-1", "chunk-2"}))
+	_, err := store.Claim(ctx, 2)
+	require.NoError(t, err)
+
+	require.NoError(t, store.ResetRunning(ctx))
+
+	jobs, err := store.Claim(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+}
+
+func TestEmbeddingJobStore_Retry(t *testing.T) {
+	store := NewEmbeddingJobStore()
+	ctx := context.Background()
+	require.NoError(t, store.Enqueue(ctx, []string{"chunk-1", "chunk-2"}))
+	jobs, err := store.Claim(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	// A job waiting out its delay is pending but not claimed
+	require.NoError(t, store.Retry(ctx, jobs[0].ID, time.Now().Add(time.Hour)))
+	require.NoError(t, store.SetStatus(ctx, jobs[1].ID, domain.EmbeddingJobFailed))
+	claimed, err := store.Claim(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	stats, err := store.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.EmbeddingQueueStats{Pending: 1, Retrying: 1, Failed: 1}, stats)
+
+	// Once the delay has passed the job is claimed with its attempts counted
+	require.NoError(t, store.Retry(ctx, jobs[0].ID, time.Now().Add(-time.Second)))
+	claimed, err = store.Claim(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "chunk-1", claimed[0].ChunkID)
+	assert.Equal(t, 2, claimed[0].Attempts)
+
+	assert.ErrorIs(t, store.Retry(ctx, 999, time.Now()), domain.ErrNotFound)
+}
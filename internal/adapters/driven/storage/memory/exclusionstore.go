@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -12,31 +14,76 @@ import (
 var _ driven.ExclusionStore = (*ExclusionStore)(nil)
 
 // ExclusionStore is an in-memory implementation of driven.ExclusionStore.
+// Exclusions are listed in insertion order, matching the SQLite store.
 type ExclusionStore struct {
 	mu         sync.RWMutex
 	exclusions map[string]domain.Exclusion
+	order      []string
+	failures   map[failureKey]int
+}
+
+// failureKey identifies a document within a source.
+type failureKey struct {
+	sourceID string
+	uri      string
 }
 
 // NewExclusionStore creates a new in-memory exclusion store.
 func NewExclusionStore() *ExclusionStore {
 	return &ExclusionStore{
 		exclusions: make(map[string]domain.Exclusion),
+		failures:   make(map[failureKey]int),
 	}
 }
 
 // Add creates a new exclusion.
+// Returns domain.ErrAlreadyExists if an exclusion with the same ID exists.
 func (s *ExclusionStore) Add(_ context.Context, exclusion *domain.Exclusion) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.exclusions[exclusion.ID] = *exclusion
+	if _, ok := s.exclusions[exclusion.ID]; ok {
+		return fmt.Errorf("exclusion %s: %w", exclusion.ID, domain.ErrAlreadyExists)
+	}
+	s.insert(exclusion)
 	return nil
 }
 
+// AddMany creates several exclusions atomically.
+// If any ID already exists, or appears twice in the batch, nothing is stored.
+func (s *ExclusionStore) AddMany(_ context.Context, exclusions []*domain.Exclusion) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seen := make(map[string]bool, len(exclusions))
+	for _, exclusion := range exclusions {
+		if _, ok := s.exclusions[exclusion.ID]; ok || seen[exclusion.ID] {
+			return fmt.Errorf("exclusion %s: %w", exclusion.ID, domain.ErrAlreadyExists)
+		}
+		seen[exclusion.ID] = true
+	}
+	for _, exclusion := range exclusions {
+		s.insert(exclusion)
+	}
+	return nil
+}
+
+// insert stores an exclusion. The caller must hold the write lock.
+func (s *ExclusionStore) insert(exclusion *domain.Exclusion) {
+	stored := *exclusion
+	if stored.PatternType == "" {
+		stored.PatternType = domain.ExclusionPatternExact
+	}
+	s.exclusions[exclusion.ID] = stored
+	s.order = append(s.order, exclusion.ID)
+}
+
 // Remove deletes an exclusion by ID.
 func (s *ExclusionStore) Remove(_ context.Context, id string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	delete(s.exclusions, id)
+	if _, ok := s.exclusions[id]; ok {
+		delete(s.exclusions, id)
+		s.order = slices.DeleteFunc(s.order, func(o string) bool { return o == id })
+	}
 	return nil
 }
 
@@ -45,20 +92,21 @@ func (s *ExclusionStore) GetBySourceID(_ context.Context, sourceID string) ([]do
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	result := make([]domain.Exclusion, 0)
-	for _, exclusion := range s.exclusions {
-		if exclusion.SourceID == sourceID {
+	for _, id := range s.order {
+		if exclusion := s.exclusions[id]; exclusion.SourceID == sourceID {
 			result = append(result, exclusion)
 		}
 	}
 	return result, nil
 }
 
-// IsExcluded checks if a URI is excluded for a source.
+// IsExcluded checks if a URI is excluded for a source, either exactly or
+// by a pattern exclusion.
 func (s *ExclusionStore) IsExcluded(_ context.Context, sourceID, uri string) (bool, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	for _, exclusion := range s.exclusions {
-		if exclusion.SourceID == sourceID && exclusion.URI == uri {
+		if exclusion.SourceID == sourceID && exclusion.Matches(uri) {
 			return true, nil
 		}
 	}
@@ -69,9 +117,27 @@ func (s *ExclusionStore) IsExcluded(_ context.Context, sourceID, uri string) (bo
 func (s *ExclusionStore) List(_ context.Context) ([]domain.Exclusion, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	result := make([]domain.Exclusion, 0, len(s.exclusions))
-	for _, exclusion := range s.exclusions {
-		result = append(result, exclusion)
+	result := make([]domain.Exclusion, 0, len(s.order))
+	for _, id := range s.order {
+		result = append(result, s.exclusions[id])
 	}
 	return result, nil
 }
+
+// RecordFailure records a processing failure for a URI and returns the
+// number of consecutive failures, including this one.
+func (s *ExclusionStore) RecordFailure(_ context.Context, sourceID, uri, _ string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := failureKey{sourceID: sourceID, uri: uri}
+	s.failures[key]++
+	return s.failures[key], nil
+}
+
+// ClearFailures resets the consecutive failure count for a URI.
+func (s *ExclusionStore) ClearFailures(_ context.Context, sourceID, uri string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failures, failureKey{sourceID: sourceID, uri: uri})
+	return nil
+}
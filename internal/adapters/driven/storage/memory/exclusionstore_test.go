@@ -40,6 +40,25 @@ func TestExclusionStore_Add(t *testing.T) {
 	assert.Equal(t, "excl-1", exclusions[0].ID)
 }
 
+func TestExclusionStore_AddMany(t *testing.T) {
+	store := NewExclusionStore()
+	ctx := context.Background()
+
+	err := store.AddMany(ctx, []*domain.Exclusion{
+		{ID: "excl-1", SourceID: "src-1", URI: "/a", Category: domain.ExclusionReasonIrrelevant},
+		{ID: "excl-2", SourceID: "src-1", URI: "/b", Category: domain.ExclusionReasonSensitive},
+	})
+	require.NoError(t, err)
+
+	exclusions, err := store.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, exclusions, 2)
+
+	excluded, err := store.IsExcluded(ctx, "src-1", "/b")
+	require.NoError(t, err)
+	assert.True(t, excluded)
+}
+
 func TestExclusionStore_Remove(t *testing.T) {
 	store := NewExclusionStore()
 	ctx := context.Background()
@@ -186,7 +205,7 @@ func TestExclusionStore_Remove_EmptyID(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestExclusionStore_Add_Update(t *testing.T) {
+func TestExclusionStore_Add_DuplicateID(t *testing.T) {
 	store := NewExclusionStore()
 	ctx := context.Background()
 
@@ -206,15 +225,15 @@ func TestExclusionStore_Add_Update(t *testing.T) {
 	err := store.Add(ctx, &exclusion1)
 	require.NoError(t, err)
 
+	// IDs are unique, as in SQLite, so the second add is rejected
 	err = store.Add(ctx, &exclusion2)
-	require.NoError(t, err)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
 
-	// Should have the updated values
 	exclusions, err := store.List(ctx)
 	require.NoError(t, err)
 	assert.Len(t, exclusions, 1)
-	assert.Equal(t, "/updated/path", exclusions[0].URI)
-	assert.Equal(t, "updated reason", exclusions[0].Reason)
+	assert.Equal(t, "/original/path", exclusions[0].URI)
+	assert.Equal(t, "original reason", exclusions[0].Reason)
 }
 
 func TestExclusionStore_Add_WithDocumentID(t *testing.T) {
@@ -373,7 +392,7 @@ func TestExclusionStore_Concurrency_MixedOperations(t *testing.T) {
 	_, _ = store.List(ctx)
 }
 
-func TestExclusionStore_Concurrency_UpdateSameExclusion(t *testing.T) {
+func TestExclusionStore_Concurrency_AddSameExclusion(t *testing.T) {
 	store := NewExclusionStore()
 	ctx := context.Background()
 
@@ -387,7 +406,7 @@ func TestExclusionStore_Concurrency_UpdateSameExclusion(t *testing.T) {
 	var wg sync.WaitGroup
 	numGoroutines := 50
 
-	// Concurrent updates to the same exclusion
+	// Concurrent adds with the same ID are all rejected
 	wg.Add(numGoroutines)
 	for i := 0; i < numGoroutines; i++ {
 		go func(id int) {
@@ -403,12 +422,12 @@ func TestExclusionStore_Concurrency_UpdateSameExclusion(t *testing.T) {
 	}
 	wg.Wait()
 
-	// Verify exclusion exists and has some update
+	// Verify the original exclusion is kept
 	exclusions, err := store.List(ctx)
 	require.NoError(t, err)
 	assert.Len(t, exclusions, 1)
 	assert.Equal(t, "excl-1", exclusions[0].ID)
-	assert.NotEqual(t, "/original", exclusions[0].URI)
+	assert.Equal(t, "/original", exclusions[0].URI)
 }
 
 func TestExclusionStore_Concurrency_AddRemoveCycle(t *testing.T) {
@@ -540,3 +559,39 @@ func TestExclusionStore_InterfaceCompliance(t *testing.T) {
 	err = store.Remove(ctx, "excl-test")
 	assert.NoError(t, err)
 }
+
+func TestExclusionStore_RecordFailure(t *testing.T) {
+	store := NewExclusionStore()
+	ctx := context.Background()
+
+	// Consecutive failures are counted per source and URI
+	for want := 1; want <= 3; want++ {
+		failures, err := store.RecordFailure(ctx, "src-1", "/bad.pdf", "corrupt")
+		require.NoError(t, err)
+		assert.Equal(t, want, failures)
+	}
+
+	failures, err := store.RecordFailure(ctx, "src-2", "/bad.pdf", "corrupt")
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+
+	// Clearing resets the count
+	require.NoError(t, store.ClearFailures(ctx, "src-1", "/bad.pdf"))
+	failures, err = store.RecordFailure(ctx, "src-1", "/bad.pdf", "corrupt")
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+}
+
+func TestExclusionStore_Quarantined(t *testing.T) {
+	store := NewExclusionStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Add(ctx, &domain.Exclusion{
+		ID: "quar-1", SourceID: "src-1", URI: "/bad.pdf", ExcludedAt: time.Now(), Quarantined: true,
+	}))
+
+	exclusions, err := store.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, exclusions, 1)
+	assert.True(t, exclusions[0].Quarantined)
+}
@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure FeedbackStore implements the interface.
+var _ driven.FeedbackStore = (*FeedbackStore)(nil)
+
+// FeedbackStore is an in-memory implementation of driven.FeedbackStore.
+// Feedback is returned in the order it was first recorded, matching the SQLite store.
+type FeedbackStore struct {
+	mu       sync.RWMutex
+	feedback []domain.Feedback
+}
+
+// NewFeedbackStore creates a new in-memory feedback store.
+func NewFeedbackStore() *FeedbackStore {
+	return &FeedbackStore{}
+}
+
+// Record saves feedback, replacing any earlier signal for the same query and document.
+func (s *FeedbackStore) Record(_ context.Context, feedback *domain.Feedback) error {
+	if feedback == nil || feedback.Query == "" || feedback.DocumentID == "" || !feedback.Signal.IsValid() {
+		return domain.ErrInvalidInput
+	}
+
+	f := *feedback
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.feedback {
+		if s.feedback[i].Query == f.Query && s.feedback[i].DocumentID == f.DocumentID {
+			s.feedback[i] = f
+			return nil
+		}
+	}
+	s.feedback = append(s.feedback, f)
+	return nil
+}
+
+// Remove deletes the feedback for a query and document.
+func (s *FeedbackStore) Remove(_ context.Context, query, documentID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.feedback[:0]
+	for _, f := range s.feedback {
+		if f.Query != query || f.DocumentID != documentID {
+			kept = append(kept, f)
+		}
+	}
+	s.feedback = kept
+	return nil
+}
+
+// ForDocuments returns all feedback given on the listed documents.
+func (s *FeedbackStore) ForDocuments(_ context.Context, documentIDs []string) ([]domain.Feedback, error) {
+	if len(documentIDs) == 0 {
+		return nil, nil
+	}
+
+	wanted := make(map[string]bool, len(documentIDs))
+	for _, id := range documentIDs {
+		wanted[id] = true
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var result []domain.Feedback
+	for _, f := range s.feedback {
+		if wanted[f.DocumentID] {
+			result = append(result, f)
+		}
+	}
+	return result, nil
+}
+
+// Clear deletes all feedback.
+func (s *FeedbackStore) Clear(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.feedback = nil
+	return nil
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestFeedbackStore_RecordReplacesSignal(t *testing.T) {
+	store := NewFeedbackStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Record(ctx, &domain.Feedback{Query: "oauth", DocumentID: "doc-1", Signal: domain.FeedbackRelevant}))
+	require.NoError(t, store.Record(ctx, &domain.Feedback{Query: "tokens", DocumentID: "doc-2", Signal: domain.FeedbackRelevant}))
+	require.NoError(t, store.Record(ctx, &domain.Feedback{Query: "oauth", DocumentID: "doc-1", Signal: domain.FeedbackIrrelevant}))
+
+	feedback, err := store.ForDocuments(ctx, []string{"doc-1", "doc-2"})
+	require.NoError(t, err)
+	require.Len(t, feedback, 2)
+	assert.Equal(t, "doc-1", feedback[0].DocumentID)
+	assert.Equal(t, domain.FeedbackIrrelevant, feedback[0].Signal)
+	assert.False(t, feedback[0].CreatedAt.IsZero())
+}
+
+func TestFeedbackStore_Record_InvalidInput(t *testing.T) {
+	store := NewFeedbackStore()
+	ctx := context.Background()
+
+	assert.ErrorIs(t, store.Record(ctx, nil), domain.ErrInvalidInput)
+	assert.ErrorIs(t, store.Record(ctx, &domain.Feedback{DocumentID: "doc-1", Signal: domain.FeedbackRelevant}),
+		domain.ErrInvalidInput)
+	assert.ErrorIs(t, store.Record(ctx, &domain.Feedback{Query: "q", DocumentID: "doc-1", Signal: "meh"}),
+		domain.ErrInvalidInput)
+}
+
+func TestFeedbackStore_RemoveAndClear(t *testing.T) {
+	store := NewFeedbackStore()
+	ctx := context.Background()
+	require.NoError(t, store.Record(ctx, &domain.Feedback{Query: "a", DocumentID: "doc-1", Signal: domain.FeedbackRelevant}))
+	require.NoError(t, store.Record(ctx, &domain.Feedback{Query: "b", DocumentID: "doc-1", Signal: domain.FeedbackRelevant}))
+
+	require.NoError(t, store.Remove(ctx, "a", "doc-1"))
+	feedback, err := store.ForDocuments(ctx, []string{"doc-1"})
+	require.NoError(t, err)
+	require.Len(t, feedback, 1)
+	assert.Equal(t, "b", feedback[0].Query)
+
+	require.NoError(t, store.Clear(ctx))
+	feedback, err = store.ForDocuments(ctx, []string{"doc-1"})
+	require.NoError(t, err)
+	assert.Empty(t, feedback)
+}
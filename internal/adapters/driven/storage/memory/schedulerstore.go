@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure SchedulerStore implements the interface.
+var _ driven.SchedulerStore = (*SchedulerStore)(nil)
+
+// SchedulerStore is an in-memory implementation of driven.SchedulerStore.
+type SchedulerStore struct {
+	mu      sync.RWMutex
+	tasks   map[string]domain.ScheduledTask
+	order   []string
+	results []domain.TaskResult
+}
+
+// NewSchedulerStore creates a new in-memory scheduler store.
+func NewSchedulerStore() *SchedulerStore {
+	return &SchedulerStore{
+		tasks: make(map[string]domain.ScheduledTask),
+	}
+}
+
+// GetTask retrieves a scheduled task by ID.
+// Returns nil and no error if the task does not exist.
+func (s *SchedulerStore) GetTask(_ context.Context, taskID string) (*domain.ScheduledTask, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	task, ok := s.tasks[taskID]
+	if !ok {
+		return nil, nil
+	}
+	return &task, nil
+}
+
+// ListTasks returns all scheduled tasks in insertion order.
+func (s *SchedulerStore) ListTasks(_ context.Context) ([]domain.ScheduledTask, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tasks := make([]domain.ScheduledTask, 0, len(s.order))
+	for _, id := range s.order {
+		tasks = append(tasks, s.tasks[id])
+	}
+	return tasks, nil
+}
+
+// SaveTask persists a task's state.
+// Creates or updates the task based on ID.
+func (s *SchedulerStore) SaveTask(_ context.Context, task *domain.ScheduledTask) error {
+	if task == nil {
+		return domain.ErrInvalidInput
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tasks[task.ID]; !ok {
+		s.order = append(s.order, task.ID)
+	}
+	s.tasks[task.ID] = *task
+	return nil
+}
+
+// DeleteTask removes a task from storage.
+func (s *SchedulerStore) DeleteTask(_ context.Context, taskID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tasks[taskID]; ok {
+		delete(s.tasks, taskID)
+		s.order = slices.DeleteFunc(s.order, func(o string) bool { return o == taskID })
+	}
+	return nil
+}
+
+// RecordResult logs a task execution result.
+func (s *SchedulerStore) RecordResult(_ context.Context, result *domain.TaskResult) error {
+	if result == nil {
+		return domain.ErrInvalidInput
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results = append(s.results, *result)
+	return nil
+}
+
+// GetTaskHistory returns recent results for a task.
+// Results are ordered by start time descending (most recent first).
+func (s *SchedulerStore) GetTaskHistory(_ context.Context, taskID string, limit int) ([]domain.TaskResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	history := s.historyFor(taskID)
+	if limit >= 0 && len(history) > limit {
+		history = history[:limit]
+	}
+	return history, nil
+}
+
+// PruneHistory removes old task results beyond the retention limit.
+// Keeps the most recent 'keep' results per task.
+func (s *SchedulerStore) PruneHistory(_ context.Context, keep int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskIDs := make(map[string]bool)
+	for _, result := range s.results {
+		taskIDs[result.TaskID] = true
+	}
+
+	var kept []domain.TaskResult
+	for taskID := range taskIDs {
+		history := s.historyFor(taskID)
+		if keep >= 0 && len(history) > keep {
+			history = history[:keep]
+		}
+		kept = append(kept, history...)
+	}
+	s.results = kept
+	return nil
+}
+
+// historyFor returns the results for a task, most recent first.
+// Callers must hold the lock.
+func (s *SchedulerStore) historyFor(taskID string) []domain.TaskResult {
+	var history []domain.TaskResult
+	for _, result := range s.results {
+		if result.TaskID == taskID {
+			history = append(history, result)
+		}
+	}
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].StartedAt.After(history[j].StartedAt)
+	})
+	return history
+}
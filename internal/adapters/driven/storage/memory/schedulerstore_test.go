@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestSchedulerStore_Tasks(t *testing.T) {
+	store := NewSchedulerStore()
+	ctx := context.Background()
+
+	// Missing tasks return nil without an error
+	task, err := store.GetTask(ctx, "sync")
+	require.NoError(t, err)
+	assert.Nil(t, task)
+
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{ID: "sync", Name: "Sync", Interval: time.Hour}))
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{ID: "refresh", Name: "Refresh"}))
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{ID: "sync", Name: "Sync", Enabled: true}))
+
+	task, err = store.GetTask(ctx, "sync")
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.True(t, task.Enabled)
+
+	tasks, err := store.ListTasks(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "sync", tasks[0].ID)
+
+	require.NoError(t, store.DeleteTask(ctx, "sync"))
+	tasks, err = store.ListTasks(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "refresh", tasks[0].ID)
+
+	assert.ErrorIs(t, store.SaveTask(ctx, nil), domain.ErrInvalidInput)
+	assert.ErrorIs(t, store.RecordResult(ctx, nil), domain.ErrInvalidInput)
+}
+
+func TestSchedulerStore_History(t *testing.T) {
+	store := NewSchedulerStore()
+	ctx := context.Background()
+	base := time.Now()
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, store.RecordResult(ctx, &domain.TaskResult{
+			TaskID:         "sync",
+			StartedAt:      base.Add(time.Duration(i) * time.Minute),
+			ItemsProcessed: i,
+		}))
+	}
+	require.NoError(t, store.RecordResult(ctx, &domain.TaskResult{TaskID: "refresh", StartedAt: base}))
+
+	// Most recent first, bounded by the limit
+	history, err := store.GetTaskHistory(ctx, "sync", 2)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 3, history[0].ItemsProcessed)
+	assert.Equal(t, 2, history[1].ItemsProcessed)
+
+	require.NoError(t, store.PruneHistory(ctx, 1))
+
+	history, err = store.GetTaskHistory(ctx, "sync", 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, 3, history[0].ItemsProcessed)
+
+	history, err = store.GetTaskHistory(ctx, "refresh", 10)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+}
@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"slices"
 	"sync"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -12,9 +13,11 @@ import (
 var _ driven.SourceStore = (*SourceStore)(nil)
 
 // SourceStore is an in-memory implementation of driven.SourceStore.
+// Sources are listed in insertion order, matching the SQLite store.
 type SourceStore struct {
 	mu      sync.RWMutex
 	sources map[string]domain.Source
+	order   []string
 }
 
 // NewSourceStore creates a new in-memory source store.
@@ -28,6 +31,9 @@ func NewSourceStore() *SourceStore {
 func (s *SourceStore) Save(_ context.Context, source domain.Source) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if _, ok := s.sources[source.ID]; !ok {
+		s.order = append(s.order, source.ID)
+	}
 	s.sources[source.ID] = source
 	return nil
 }
@@ -47,7 +53,10 @@ func (s *SourceStore) Get(_ context.Context, id string) (*domain.Source, error)
 func (s *SourceStore) Delete(_ context.Context, id string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	delete(s.sources, id)
+	if _, ok := s.sources[id]; ok {
+		delete(s.sources, id)
+		s.order = slices.DeleteFunc(s.order, func(o string) bool { return o == id })
+	}
 	return nil
 }
 
@@ -55,9 +64,9 @@ func (s *SourceStore) Delete(_ context.Context, id string) error {
 func (s *SourceStore) List(_ context.Context) ([]domain.Source, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	result := make([]domain.Source, 0, len(s.sources))
-	for _, source := range s.sources {
-		result = append(result, source)
+	result := make([]domain.Source, 0, len(s.order))
+	for _, id := range s.order {
+		result = append(result, s.sources[id])
 	}
 	return result, nil
 }
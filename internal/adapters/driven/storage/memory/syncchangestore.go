@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure SyncChangeLogStore implements the interface.
+var _ driven.SyncChangeLogStore = (*SyncChangeLogStore)(nil)
+
+// SyncChangeLogStore is an in-memory implementation of driven.SyncChangeLogStore.
+type SyncChangeLogStore struct {
+	mu   sync.RWMutex
+	logs map[string]domain.SyncChangeLog
+}
+
+// NewSyncChangeLogStore creates a new in-memory sync change log store.
+func NewSyncChangeLogStore() *SyncChangeLogStore {
+	return &SyncChangeLogStore{
+		logs: make(map[string]domain.SyncChangeLog),
+	}
+}
+
+// Save stores a log, replacing the source's previous log.
+func (s *SyncChangeLogStore) Save(_ context.Context, log *domain.SyncChangeLog) error {
+	if log == nil || log.SourceID == "" {
+		return domain.ErrInvalidInput
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logs[log.SourceID] = *log
+	return nil
+}
+
+// Get retrieves the log for a source.
+func (s *SyncChangeLogStore) Get(_ context.Context, sourceID string) (*domain.SyncChangeLog, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	log, ok := s.logs[sourceID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &log, nil
+}
+
+// Delete removes the log for a source.
+func (s *SyncChangeLogStore) Delete(_ context.Context, sourceID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.logs, sourceID)
+	return nil
+}
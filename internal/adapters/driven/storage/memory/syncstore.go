@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"sync"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -28,6 +30,9 @@ func NewSyncStateStore() *SyncStateStore {
 func (s *SyncStateStore) Save(_ context.Context, state domain.SyncState) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	// Copy the map and slice so later changes by the caller are not stored
+	state.SubCursors = maps.Clone(state.SubCursors)
+	state.RecentErrors = slices.Clone(state.RecentErrors)
 	s.states[state.SourceID] = state
 	return nil
 }
@@ -40,6 +45,8 @@ func (s *SyncStateStore) Get(_ context.Context, sourceID string) (*domain.SyncSt
 	if !ok {
 		return nil, domain.ErrNotFound
 	}
+	state.SubCursors = maps.Clone(state.SubCursors)
+	state.RecentErrors = slices.Clone(state.RecentErrors)
 	return &state, nil
 }
 
@@ -40,6 +40,27 @@ func TestSyncStateStore_Save_Success(t *testing.T) {
 	assert.Equal(t, now.Unix(), saved.LastSync.Unix()) // Compare Unix timestamps to avoid precision issues
 }
 
+func TestSyncStateStore_SubCursors(t *testing.T) {
+	store := NewSyncStateStore()
+	ctx := context.Background()
+
+	subCursors := map[string]string{"octocat/hello": "c1"}
+	require.NoError(t, store.Save(ctx, domain.SyncState{SourceID: "src-1", SubCursors: subCursors}))
+
+	// Mutating the caller's map must not change the stored state
+	subCursors["octocat/hello"] = "changed"
+
+	saved, err := store.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"octocat/hello": "c1"}, saved.SubCursors)
+
+	// Nor must mutating a returned state
+	saved.SubCursors["octocat/world"] = "c2"
+	again, err := store.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, again.SubCursors, 1)
+}
+
 func TestSyncStateStore_Save_Update(t *testing.T) {
 	store := NewSyncStateStore()
 	ctx := context.Background()
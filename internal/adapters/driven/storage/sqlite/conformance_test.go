@@ -0,0 +1,27 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/storetest"
+)
+
+func TestStoreConformance(t *testing.T) {
+	newStores := func(t *testing.T) storetest.Stores {
+		store, err := NewStore(t.TempDir())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = store.Close() })
+
+		return storetest.Stores{
+			Sources:    store.SourceStore(),
+			Documents:  store.DocumentStore(),
+			SyncStates: store.SyncStateStore(),
+			Exclusions: store.ExclusionStore(),
+			Changes:    store.SyncChangeLogStore(),
+		}
+	}
+
+	storetest.Run(t, newStores, storetest.Behaviour{ForeignKeys: true})
+}
@@ -21,6 +21,13 @@
 //
 // # Thread Safety
 //
-// All operations are thread-safe. The store uses database-level locking provided
-// by SQLite in WAL mode.
+// All operations are thread-safe. SQLite allows a single writer, so the store
+// serialises writes through one connection: concurrent writers in the process
+// wait their turn instead of failing with SQLITE_BUSY. Reads use a separate
+// pool of read-only connections and, in WAL mode, run alongside the writer and
+// see every committed write.
+//
+// Other processes sharing the database, such as a sync running while the TUI
+// is open, are waited out with a busy timeout. Transactions begin IMMEDIATE,
+// taking the write lock up front, so the timeout applies to them too.
 package sqlite
@@ -0,0 +1,224 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// embeddingJobStore implements driven.EmbeddingJobStore.
+type embeddingJobStore struct {
+	store *Store
+}
+
+var _ driven.EmbeddingJobStore = (*embeddingJobStore)(nil)
+
+// Enqueue adds a pending job for each chunk.
+// Chunks that already have a pending job are not queued twice, and finished
+// jobs for re-queued chunks are removed so the table does not grow with every sync.
+func (s *embeddingJobStore) Enqueue(ctx context.Context, chunkIDs []string) error {
+	if len(chunkIDs) == 0 {
+		return nil
+	}
+
+	tx, err := s.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	prune, err := tx.PrepareContext(ctx, `
+		DELETE FROM embedding_jobs WHERE chunk_id = ? AND status IN (?, ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("preparing statement: %w", err)
+	}
+	defer prune.Close()
+
+	insert, err := tx.PrepareContext(ctx, `
+		INSERT INTO embedding_jobs (chunk_id, status, created_at, updated_at)
+		SELECT ?, ?, ?, ?
+		WHERE NOT EXISTS (SELECT 1 FROM embedding_jobs WHERE chunk_id = ? AND status = ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("preparing statement: %w", err)
+	}
+	defer insert.Close()
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	pending := string(domain.EmbeddingJobPending)
+	for _, chunkID := range chunkIDs {
+		if _, err := prune.ExecContext(ctx, chunkID,
+			string(domain.EmbeddingJobDone), string(domain.EmbeddingJobFailed)); err != nil {
+			return fmt.Errorf("pruning embedding jobs: %w", err)
+		}
+		if _, err := insert.ExecContext(ctx, chunkID, pending, now, now, chunkID, pending); err != nil {
+			return fmt.Errorf("queueing embedding job: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// Claim marks up to limit of the oldest pending jobs as running and returns them.
+// Jobs waiting to be retried are not claimed before their retry time.
+// The update is a single statement so concurrent workers never claim the same job.
+func (s *embeddingJobStore) Claim(ctx context.Context, limit int) ([]domain.EmbeddingJob, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	rows, err := s.store.db.QueryContext(ctx, `
+		UPDATE embedding_jobs SET status = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM embedding_jobs
+			WHERE status = ? AND (retry_at IS NULL OR retry_at <= ?)
+			ORDER BY id LIMIT ?
+		)
+		RETURNING id, chunk_id, status, attempts, retry_at, created_at, updated_at
+	`, string(domain.EmbeddingJobRunning), now,
+		string(domain.EmbeddingJobPending), now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claiming embedding jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []domain.EmbeddingJob //nolint:prealloc // size unknown from query
+	for rows.Next() {
+		job, err := scanEmbeddingJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating embedding jobs: %w", err)
+	}
+
+	// RETURNING does not guarantee order
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	return jobs, nil
+}
+
+// SetStatus updates the status of a job.
+func (s *embeddingJobStore) SetStatus(ctx context.Context, jobID int64, status domain.EmbeddingJobStatus) error {
+	if !status.IsValid() {
+		return domain.ErrInvalidInput
+	}
+
+	result, err := s.store.db.ExecContext(ctx, `
+		UPDATE embedding_jobs SET status = ?, updated_at = ? WHERE id = ?
+	`, string(status), time.Now().UTC().Format(time.RFC3339), jobID)
+	if err != nil {
+		return fmt.Errorf("updating embedding job: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Retry returns a job whose attempt failed to the queue, counting the attempt.
+func (s *embeddingJobStore) Retry(ctx context.Context, jobID int64, retryAt time.Time) error {
+	result, err := s.store.db.ExecContext(ctx, `
+		UPDATE embedding_jobs SET status = ?, attempts = attempts + 1, retry_at = ?, updated_at = ?
+		WHERE id = ?
+	`, string(domain.EmbeddingJobPending), retryAt.UTC().Format(time.RFC3339),
+		time.Now().UTC().Format(time.RFC3339), jobID)
+	if err != nil {
+		return fmt.Errorf("retrying embedding job: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// CountPending returns the number of pending and running jobs.
+func (s *embeddingJobStore) CountPending(ctx context.Context) (int, error) {
+	var count int
+	err := s.store.readDB.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM embedding_jobs WHERE status IN (?, ?)
+	`, string(domain.EmbeddingJobPending), string(domain.EmbeddingJobRunning)).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("counting embedding jobs: %w", err)
+	}
+	return count, nil
+}
+
+// Stats counts the pending, retrying and failed jobs.
+func (s *embeddingJobStore) Stats(ctx context.Context) (domain.EmbeddingQueueStats, error) {
+	pending := string(domain.EmbeddingJobPending)
+	var stats domain.EmbeddingQueueStats
+	err := s.store.readDB.QueryRowContext(ctx, `
+		SELECT
+			COALESCE(SUM(status IN (?, ?)), 0),
+			COALESCE(SUM(status = ? AND retry_at > ?), 0),
+			COALESCE(SUM(status = ?), 0)
+		FROM embedding_jobs
+	`, pending, string(domain.EmbeddingJobRunning),
+		pending, time.Now().UTC().Format(time.RFC3339),
+		string(domain.EmbeddingJobFailed)).Scan(&stats.Pending, &stats.Retrying, &stats.Failed)
+	if err != nil {
+		return domain.EmbeddingQueueStats{}, fmt.Errorf("counting embedding jobs: %w", err)
+	}
+	return stats, nil
+}
+
+// ResetRunning returns running jobs to pending.
+func (s *embeddingJobStore) ResetRunning(ctx context.Context) error {
+	_, err := s.store.db.ExecContext(ctx, `
+		UPDATE embedding_jobs SET status = ?, updated_at = ? WHERE status = ?
+	`, string(domain.EmbeddingJobPending), time.Now().UTC().Format(time.RFC3339),
+		string(domain.EmbeddingJobRunning))
+	if err != nil {
+		return fmt.Errorf("resetting embedding jobs: %w", err)
+	}
+	return nil
+}
+
+// scanEmbeddingJob scans an embedding job from *sql.Rows.
+func scanEmbeddingJob(rows *sql.Rows) (*domain.EmbeddingJob, error) {
+	var job domain.EmbeddingJob
+	var status, createdAt, updatedAt string
+	var retryAt sql.NullString
+
+	if err := rows.Scan(&job.ID, &job.ChunkID, &status, &job.Attempts, &retryAt, &createdAt, &updatedAt); err != nil {
+		return nil, fmt.Errorf("scanning embedding job: %w", err)
+	}
+
+	job.Status = domain.EmbeddingJobStatus(status)
+	if retryAt.Valid {
+		if t, err := time.Parse(time.RFC3339, retryAt.String); err == nil {
+			job.RetryAt = t
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, createdAt); err == nil {
+		job.CreatedAt = t
+	}
+	if t, err := time.Parse(time.RFC3339, updatedAt); err == nil {
+		job.UpdatedAt = t
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,192 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ==================== EmbeddingJobStore Tests ====================
+
+func TestEmbeddingJobStore_EnqueueAndClaim(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1", "chunk-2", "chunk-3"}))
+
+	count, err := jobStore.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	jobs, err := jobStore.Claim(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "chunk-1", jobs[0].ChunkID)
+	assert.Equal(t, "chunk-2", jobs[1].ChunkID)
+	assert.Equal(t, domain.EmbeddingJobRunning, jobs[0].Status)
+	assert.False(t, jobs[0].CreatedAt.IsZero())
+
+	// Running jobs still count as pending work
+	count, err = jobStore.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	// Claimed jobs are not handed out again
+	jobs, err = jobStore.Claim(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "chunk-3", jobs[0].ChunkID)
+}
+
+func TestEmbeddingJobStore_Enqueue_SkipsPendingDuplicates(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1"}))
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1", "chunk-2"}))
+
+	count, err := jobStore.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestEmbeddingJobStore_Enqueue_ReplacesFinishedJobs(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1"}))
+	jobs, err := jobStore.Claim(ctx, 1)
+	require.NoError(t, err)
+	require.NoError(t, jobStore.SetStatus(ctx, jobs[0].ID, domain.EmbeddingJobDone))
+
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1"}))
+
+	var rows int
+	require.NoError(t, store.db.QueryRow("SELECT COUNT(*) FROM embedding_jobs").Scan(&rows))
+	assert.Equal(t, 1, rows)
+}
+
+func TestEmbeddingJobStore_SetStatus(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1", "chunk-2"}))
+	jobs, err := jobStore.Claim(ctx, 2)
+	require.NoError(t, err)
+
+	require.NoError(t, jobStore.SetStatus(ctx, jobs[0].ID, domain.EmbeddingJobDone))
+	require.NoError(t, jobStore.SetStatus(ctx, jobs[1].ID, domain.EmbeddingJobFailed))
+
+	count, err := jobStore.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestEmbeddingJobStore_SetStatus_Errors(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	err := jobStore.SetStatus(ctx, 42, domain.EmbeddingJobDone)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	err = jobStore.SetStatus(ctx, 42, domain.EmbeddingJobStatus("lost"))
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestEmbeddingJobStore_Retry(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1", "chunk-2"}))
+	jobs, err := jobStore.Claim(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Zero(t, jobs[0].Attempts)
+	assert.True(t, jobs[0].RetryAt.IsZero())
+
+	// A job waiting out its delay is pending but not claimed
+	require.NoError(t, jobStore.Retry(ctx, jobs[0].ID, time.Now().Add(time.Hour)))
+	require.NoError(t, jobStore.SetStatus(ctx, jobs[1].ID, domain.EmbeddingJobFailed))
+	claimed, err := jobStore.Claim(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	stats, err := jobStore.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.EmbeddingQueueStats{Pending: 1, Retrying: 1, Failed: 1}, stats)
+
+	// Once the delay has passed the job is claimed with its attempts counted
+	require.NoError(t, jobStore.Retry(ctx, jobs[0].ID, time.Now().Add(-time.Minute)))
+	claimed, err = jobStore.Claim(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "chunk-1", claimed[0].ChunkID)
+	assert.Equal(t, 2, claimed[0].Attempts)
+	assert.False(t, claimed[0].RetryAt.IsZero())
+
+	assert.ErrorIs(t, jobStore.Retry(ctx, 999, time.Now()), domain.ErrNotFound)
+}
+
+func TestEmbeddingJobStore_Stats_Empty(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	stats, err := store.EmbeddingJobStore().Stats(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.EmbeddingQueueStats{}, stats)
+}
+
+func TestEmbeddingJobStore_ResetRunning(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	require.NoError(t, jobStore.Enqueue(ctx, []string{"chunk-1"}))
+	_, err := jobStore.Claim(ctx, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, jobStore.ResetRunning(ctx))
+
+	jobs, err := jobStore.Claim(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "chunk-1", jobs[0].ChunkID)
+}
+
+func TestEmbeddingJobStore_EmptyInputs(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	jobStore := store.EmbeddingJobStore()
+
+	require.NoError(t, jobStore.Enqueue(ctx, nil))
+	jobs, err := jobStore.Claim(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// feedbackStore implements driven.FeedbackStore.
+type feedbackStore struct {
+	store *Store
+}
+
+var _ driven.FeedbackStore = (*feedbackStore)(nil)
+
+// Record saves feedback, replacing any earlier signal for the same query and document.
+func (s *feedbackStore) Record(ctx context.Context, feedback *domain.Feedback) error {
+	if feedback == nil || feedback.Query == "" || feedback.DocumentID == "" || !feedback.Signal.IsValid() {
+		return domain.ErrInvalidInput
+	}
+
+	createdAt := feedback.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	_, err := s.store.db.ExecContext(ctx, `
+		INSERT INTO search_feedback (query, document_id, signal, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(query, document_id) DO UPDATE SET
+			signal = excluded.signal,
+			created_at = excluded.created_at
+	`, feedback.Query, feedback.DocumentID, string(feedback.Signal),
+		createdAt.UTC().Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("recording feedback: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes the feedback for a query and document.
+func (s *feedbackStore) Remove(ctx context.Context, query, documentID string) error {
+	_, err := s.store.db.ExecContext(ctx, `
+		DELETE FROM search_feedback WHERE query = ? AND document_id = ?
+	`, query, documentID)
+	if err != nil {
+		return fmt.Errorf("removing feedback: %w", err)
+	}
+	return nil
+}
+
+// ForDocuments returns all feedback given on the listed documents.
+func (s *feedbackStore) ForDocuments(ctx context.Context, documentIDs []string) ([]domain.Feedback, error) {
+	if len(documentIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.Repeat("?,", len(documentIDs))
+	placeholders = placeholders[:len(placeholders)-1]
+	args := make([]any, len(documentIDs))
+	for i, id := range documentIDs {
+		args[i] = id
+	}
+
+	//nolint:gosec // placeholders are only "?" characters
+	rows, err := s.store.readDB.QueryContext(ctx, `
+		SELECT query, document_id, signal, created_at FROM search_feedback
+		WHERE document_id IN (`+placeholders+`)
+		ORDER BY id
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying feedback: %w", err)
+	}
+	defer rows.Close()
+
+	var feedback []domain.Feedback //nolint:prealloc // size unknown from query
+	for rows.Next() {
+		var f domain.Feedback
+		var signal, createdAt string
+		if err := rows.Scan(&f.Query, &f.DocumentID, &signal, &createdAt); err != nil {
+			return nil, fmt.Errorf("scanning feedback: %w", err)
+		}
+		f.Signal = domain.FeedbackSignal(signal)
+		if t, err := time.Parse(time.RFC3339, createdAt); err == nil {
+			f.CreatedAt = t
+		}
+		feedback = append(feedback, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating feedback: %w", err)
+	}
+	return feedback, nil
+}
+
+// Clear deletes all feedback.
+func (s *feedbackStore) Clear(ctx context.Context) error {
+	if _, err := s.store.db.ExecContext(ctx, `DELETE FROM search_feedback`); err != nil {
+		return fmt.Errorf("clearing feedback: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ==================== FeedbackStore Tests ====================
+
+func TestFeedbackStore_RecordAndForDocuments(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	feedbackStore := store.FeedbackStore()
+
+	require.NoError(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "oauth refresh", DocumentID: "doc-1", Signal: domain.FeedbackRelevant,
+	}))
+	require.NoError(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "token expiry", DocumentID: "doc-1", Signal: domain.FeedbackIrrelevant,
+	}))
+	require.NoError(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "oauth refresh", DocumentID: "doc-2", Signal: domain.FeedbackRelevant,
+	}))
+
+	feedback, err := feedbackStore.ForDocuments(ctx, []string{"doc-1", "doc-3"})
+	require.NoError(t, err)
+	require.Len(t, feedback, 2)
+	assert.Equal(t, "oauth refresh", feedback[0].Query)
+	assert.Equal(t, domain.FeedbackRelevant, feedback[0].Signal)
+	assert.False(t, feedback[0].CreatedAt.IsZero())
+	assert.Equal(t, "token expiry", feedback[1].Query)
+	assert.Equal(t, domain.FeedbackIrrelevant, feedback[1].Signal)
+}
+
+func TestFeedbackStore_Record_ReplacesSignal(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	feedbackStore := store.FeedbackStore()
+
+	require.NoError(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "q", DocumentID: "doc-1", Signal: domain.FeedbackRelevant,
+	}))
+	require.NoError(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "q", DocumentID: "doc-1", Signal: domain.FeedbackIrrelevant,
+	}))
+
+	feedback, err := feedbackStore.ForDocuments(ctx, []string{"doc-1"})
+	require.NoError(t, err)
+	require.Len(t, feedback, 1)
+	assert.Equal(t, domain.FeedbackIrrelevant, feedback[0].Signal)
+}
+
+func TestFeedbackStore_Record_InvalidInput(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	feedbackStore := store.FeedbackStore()
+
+	assert.ErrorIs(t, feedbackStore.Record(ctx, nil), domain.ErrInvalidInput)
+	assert.ErrorIs(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "q", DocumentID: "doc-1", Signal: "meh",
+	}), domain.ErrInvalidInput)
+	assert.ErrorIs(t, feedbackStore.Record(ctx, &domain.Feedback{
+		DocumentID: "doc-1", Signal: domain.FeedbackRelevant,
+	}), domain.ErrInvalidInput)
+}
+
+func TestFeedbackStore_RemoveAndClear(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	feedbackStore := store.FeedbackStore()
+
+	require.NoError(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "q", DocumentID: "doc-1", Signal: domain.FeedbackRelevant,
+	}))
+	require.NoError(t, feedbackStore.Record(ctx, &domain.Feedback{
+		Query: "q", DocumentID: "doc-2", Signal: domain.FeedbackRelevant,
+	}))
+
+	require.NoError(t, feedbackStore.Remove(ctx, "q", "doc-1"))
+	require.NoError(t, feedbackStore.Remove(ctx, "q", "missing"))
+
+	feedback, err := feedbackStore.ForDocuments(ctx, []string{"doc-1", "doc-2"})
+	require.NoError(t, err)
+	require.Len(t, feedback, 1)
+	assert.Equal(t, "doc-2", feedback[0].DocumentID)
+
+	require.NoError(t, feedbackStore.Clear(ctx))
+	feedback, err = feedbackStore.ForDocuments(ctx, []string{"doc-2"})
+	require.NoError(t, err)
+	assert.Empty(t, feedback)
+}
+
+func TestFeedbackStore_ForDocuments_Empty(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	feedback, err := store.FeedbackStore().ForDocuments(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, feedback)
+}
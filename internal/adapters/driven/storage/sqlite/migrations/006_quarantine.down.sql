@@ -0,0 +1,7 @@
+-- Migration 006: Rollback document quarantine
+
+DROP TABLE IF EXISTS document_failures;
+DELETE FROM exclusions WHERE quarantined = 1;
+ALTER TABLE exclusions DROP COLUMN quarantined;
+
+DELETE FROM schema_migrations WHERE version = 6;
@@ -0,0 +1,20 @@
+-- Migration 006: Document quarantine
+-- Tracks consecutive normalisation failures per document so that repeatedly
+-- failing documents can be quarantined (excluded until manually retried)
+
+-- Flag exclusions created automatically by quarantine
+ALTER TABLE exclusions ADD COLUMN quarantined INTEGER NOT NULL DEFAULT 0;
+
+-- Consecutive processing failures per document
+CREATE TABLE IF NOT EXISTS document_failures (
+    source_id TEXT NOT NULL,
+    uri TEXT NOT NULL,
+    failures INTEGER NOT NULL,    -- Consecutive failure count
+    last_error TEXT,              -- Most recent failure reason
+    last_failed_at DATETIME NOT NULL,
+    PRIMARY KEY (source_id, uri),
+    FOREIGN KEY (source_id) REFERENCES sources(id) ON DELETE CASCADE
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (6);
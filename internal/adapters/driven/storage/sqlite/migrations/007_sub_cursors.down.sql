@@ -0,0 +1,5 @@
+-- Migration 007: Rollback sub-resource cursors
+
+ALTER TABLE sync_states DROP COLUMN sub_cursors;
+
+DELETE FROM schema_migrations WHERE version = 7;
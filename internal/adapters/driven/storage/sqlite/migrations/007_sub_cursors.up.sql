@@ -0,0 +1,9 @@
+-- Migration 007: Sub-resource cursors
+-- Stores per-resource incremental sync cursors (e.g. one per GitHub
+-- repository) alongside the source-level cursor
+
+-- JSON object mapping sub-resource name to opaque cursor
+ALTER TABLE sync_states ADD COLUMN sub_cursors TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (7);
@@ -0,0 +1,5 @@
+-- Migration 008: Rollback connector version
+
+ALTER TABLE sync_states DROP COLUMN connector_version;
+
+DELETE FROM schema_migrations WHERE version = 8;
@@ -0,0 +1,9 @@
+-- Migration 008: Connector version
+-- Records the connector's sync state schema version with the cursors so
+-- cursors saved by another version can be discarded
+
+-- Empty for states saved before versioning
+ALTER TABLE sync_states ADD COLUMN connector_version TEXT NOT NULL DEFAULT '';
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (8);
@@ -0,0 +1,6 @@
+-- Migration 009: Rollback exclusion categories
+
+DROP INDEX IF EXISTS idx_exclusions_category;
+ALTER TABLE exclusions DROP COLUMN category;
+
+DELETE FROM schema_migrations WHERE version = 9;
@@ -0,0 +1,14 @@
+-- Migration 009: Exclusion categories
+-- Adds a structured reason to exclusions for filtering and reporting,
+-- alongside the existing free-text reason
+
+-- Structured exclusion reason (domain.ExclusionReason), empty for quarantine
+ALTER TABLE exclusions ADD COLUMN category TEXT NOT NULL DEFAULT '';
+
+-- Existing manual exclusions were all made at the user's request
+UPDATE exclusions SET category = 'user_requested' WHERE quarantined = 0;
+
+CREATE INDEX IF NOT EXISTS idx_exclusions_category ON exclusions(category);
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (9);
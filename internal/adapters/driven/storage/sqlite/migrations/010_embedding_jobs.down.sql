@@ -0,0 +1,7 @@
+-- Migration 010: Rollback embedding job queue
+
+DROP INDEX IF EXISTS idx_embedding_jobs_chunk_id;
+DROP INDEX IF EXISTS idx_embedding_jobs_status;
+DROP TABLE IF EXISTS embedding_jobs;
+
+DELETE FROM schema_migrations WHERE version = 10;
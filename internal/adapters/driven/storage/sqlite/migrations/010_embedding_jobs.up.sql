@@ -0,0 +1,17 @@
+-- Migration 010: Embedding job queue
+-- Chunks are embedded by background workers instead of during sync
+
+-- Embedding jobs table (domain.EmbeddingJob)
+CREATE TABLE IF NOT EXISTS embedding_jobs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    chunk_id TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, running, done or failed
+    created_at TEXT NOT NULL,               -- ISO 8601 timestamp
+    updated_at TEXT NOT NULL                -- ISO 8601 timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_embedding_jobs_status ON embedding_jobs(status, id);
+CREATE INDEX IF NOT EXISTS idx_embedding_jobs_chunk_id ON embedding_jobs(chunk_id);
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (10);
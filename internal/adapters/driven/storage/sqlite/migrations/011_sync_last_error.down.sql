@@ -0,0 +1,5 @@
+-- Migration 011: Rollback last sync error
+
+ALTER TABLE sync_states DROP COLUMN last_error;
+
+DELETE FROM schema_migrations WHERE version = 11;
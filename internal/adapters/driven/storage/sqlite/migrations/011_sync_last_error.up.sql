@@ -0,0 +1,9 @@
+-- Migration 011: Last sync error
+-- Records why the most recent sync of a source failed so the TUI can
+-- show sync health alongside each source
+
+-- Error message from the last failed sync; empty after a successful sync
+ALTER TABLE sync_states ADD COLUMN last_error TEXT NOT NULL DEFAULT '';
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (11);
@@ -0,0 +1,6 @@
+-- Migration 012: Rollback search relevance feedback
+
+DROP INDEX IF EXISTS idx_search_feedback_document_id;
+DROP TABLE IF EXISTS search_feedback;
+
+DELETE FROM schema_migrations WHERE version = 12;
@@ -0,0 +1,18 @@
+-- Migration 012: Search relevance feedback
+-- Records whether a result was useful for a query so later searches for
+-- similar queries can boost or penalise it. Feedback never leaves the machine.
+
+-- Search feedback table (domain.Feedback)
+CREATE TABLE IF NOT EXISTS search_feedback (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    query TEXT NOT NULL,                    -- Normalised query text
+    document_id TEXT NOT NULL,
+    signal TEXT NOT NULL,                   -- relevant or irrelevant
+    created_at TEXT NOT NULL,               -- ISO 8601 timestamp
+    UNIQUE(query, document_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_feedback_document_id ON search_feedback(document_id);
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (12);
@@ -0,0 +1,7 @@
+-- Migration 013: Rollback exclusion patterns
+
+DROP INDEX IF EXISTS idx_exclusions_pattern_type;
+DELETE FROM exclusions WHERE pattern_type != 'exact';
+ALTER TABLE exclusions DROP COLUMN pattern_type;
+
+DELETE FROM schema_migrations WHERE version = 13;
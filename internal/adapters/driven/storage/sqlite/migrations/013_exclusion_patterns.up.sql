@@ -0,0 +1,11 @@
+-- Migration 013: Exclusion patterns
+-- Lets an exclusion match more than one URI. Exact exclusions keep using the
+-- (source_id, uri) index; pattern exclusions are matched in code.
+
+-- How uri is matched (domain.ExclusionPatternType): exact or prefix
+ALTER TABLE exclusions ADD COLUMN pattern_type TEXT NOT NULL DEFAULT 'exact';
+
+CREATE INDEX IF NOT EXISTS idx_exclusions_pattern_type ON exclusions(source_id, pattern_type);
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (13);
@@ -0,0 +1,5 @@
+-- Migration 014: Rollback recent sync errors
+
+ALTER TABLE sync_states DROP COLUMN recent_errors;
+
+DELETE FROM schema_migrations WHERE version = 14;
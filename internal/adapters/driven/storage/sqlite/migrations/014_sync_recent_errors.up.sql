@@ -0,0 +1,9 @@
+-- Migration 014: Recent sync errors
+-- Keeps the latest errors from syncs of each source, including documents
+-- that failed during successful syncs, so they can be reviewed later
+
+-- JSON array of domain.SyncErrorLog, oldest first
+ALTER TABLE sync_states ADD COLUMN recent_errors TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (14);
@@ -0,0 +1,5 @@
+-- Migration 015: Rollback archived sources
+
+ALTER TABLE sources DROP COLUMN archived;
+
+DELETE FROM schema_migrations WHERE version = 15;
@@ -0,0 +1,8 @@
+-- Migration 015: Archived sources
+-- Archived sources keep their documents searchable but are skipped by
+-- scheduled syncs and sync --all
+
+ALTER TABLE sources ADD COLUMN archived INTEGER NOT NULL DEFAULT 0;
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (15);
@@ -0,0 +1,6 @@
+-- Migration 016: Rollback document authors
+
+ALTER TABLE documents DROP COLUMN author_id;
+ALTER TABLE documents DROP COLUMN author_name;
+
+DELETE FROM schema_migrations WHERE version = 16;
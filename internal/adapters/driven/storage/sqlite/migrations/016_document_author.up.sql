@@ -0,0 +1,8 @@
+-- Migration 016: Document authors
+-- Stores who wrote each document so searches can filter and facet by author
+
+ALTER TABLE documents ADD COLUMN author_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE documents ADD COLUMN author_id TEXT NOT NULL DEFAULT '';
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (16);
@@ -0,0 +1,5 @@
+-- Migration 017: Rollback document originals
+
+ALTER TABLE documents DROP COLUMN original;
+
+DELETE FROM schema_migrations WHERE version = 17;
@@ -0,0 +1,7 @@
+-- Migration 017: Document originals
+-- Optionally keeps the gzip-compressed bytes a document was normalised from
+
+ALTER TABLE documents ADD COLUMN original BLOB;
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (17);
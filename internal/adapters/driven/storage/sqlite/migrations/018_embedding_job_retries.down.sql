@@ -0,0 +1,6 @@
+-- Migration 018: Rollback embedding job retries
+
+ALTER TABLE embedding_jobs DROP COLUMN retry_at;
+ALTER TABLE embedding_jobs DROP COLUMN attempts;
+
+DELETE FROM schema_migrations WHERE version = 18;
@@ -0,0 +1,8 @@
+-- Migration 018: Embedding job retries
+-- Failed embeddings are retried with backoff before they are given up on
+
+ALTER TABLE embedding_jobs ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0; -- failed attempts so far
+ALTER TABLE embedding_jobs ADD COLUMN retry_at TEXT;                      -- ISO 8601 timestamp, NULL until an attempt fails
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (18);
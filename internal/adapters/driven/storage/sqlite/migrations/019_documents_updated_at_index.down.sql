@@ -0,0 +1,5 @@
+-- Migration 019: Rollback documents update time index
+
+DROP INDEX IF EXISTS idx_documents_updated_at;
+
+DELETE FROM schema_migrations WHERE version = 19;
@@ -0,0 +1,7 @@
+-- Migration 019: Index documents by update time
+-- Lets the timeline list recently updated documents across sources
+
+CREATE INDEX IF NOT EXISTS idx_documents_updated_at ON documents(updated_at);
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (19);
@@ -0,0 +1,5 @@
+-- Migration 020: Rollback sync change logs
+
+DROP TABLE IF EXISTS sync_change_logs;
+
+DELETE FROM schema_migrations WHERE version = 20;
@@ -0,0 +1,14 @@
+-- Migration 020: Sync change logs
+-- Records the document changes made by the most recent sync of each source,
+-- with the documents as they were before, so that sync can be undone.
+
+-- Sync change log table (domain.SyncChangeLog)
+CREATE TABLE IF NOT EXISTS sync_change_logs (
+    source_id TEXT PRIMARY KEY,
+    started_at DATETIME NOT NULL,  -- When the logged sync started
+    log BLOB NOT NULL,             -- gzip-compressed JSON of the change log
+    FOREIGN KEY (source_id) REFERENCES sources(id) ON DELETE CASCADE
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (20);
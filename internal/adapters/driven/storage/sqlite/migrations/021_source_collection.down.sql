@@ -0,0 +1,5 @@
+-- Migration 021: Rollback source collections
+
+ALTER TABLE sources DROP COLUMN collection;
+
+DELETE FROM schema_migrations WHERE version = 21;
@@ -0,0 +1,8 @@
+-- Migration 021: Source collections
+-- Sources can be grouped into named collections, such as "Work", so a
+-- search can be scoped to every source in a collection
+
+ALTER TABLE sources ADD COLUMN collection TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (21);
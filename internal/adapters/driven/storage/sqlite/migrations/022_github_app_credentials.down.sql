@@ -0,0 +1,5 @@
+-- Migration 022: Rollback GitHub App credentials
+
+ALTER TABLE credentials DROP COLUMN github_app;
+
+DELETE FROM schema_migrations WHERE version = 22;
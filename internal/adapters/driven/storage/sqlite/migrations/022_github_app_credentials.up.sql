@@ -0,0 +1,8 @@
+-- Migration 022: GitHub App credentials
+-- Sources can authenticate as a GitHub App installation, minting
+-- short-lived installation tokens from the App's private key
+
+ALTER TABLE credentials ADD COLUMN github_app TEXT; -- JSON: GitHubAppCredentials (app_id, installation_id, private_key)
+
+-- Record this migration
+INSERT INTO schema_migrations (version) VALUES (22);
@@ -21,7 +21,7 @@ var _ driven.SchedulerStore = (*schedulerStore)(nil)
 // GetTask retrieves a scheduled task by ID.
 // Returns nil and no error if the task does not exist.
 func (s *schedulerStore) GetTask(ctx context.Context, taskID string) (*domain.ScheduledTask, error) {
-	row := s.store.db.QueryRowContext(ctx, `
+	row := s.store.readDB.QueryRowContext(ctx, `
 		SELECT id, name, interval_seconds, last_run, next_run, last_error, last_success, enabled
 		FROM scheduled_tasks WHERE id = ?
 	`, taskID)
@@ -38,7 +38,7 @@ func (s *schedulerStore) GetTask(ctx context.Context, taskID string) (*domain.Sc
 
 // ListTasks returns all scheduled tasks.
 func (s *schedulerStore) ListTasks(ctx context.Context) ([]domain.ScheduledTask, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
+	rows, err := s.store.readDB.QueryContext(ctx, `
 		SELECT id, name, interval_seconds, last_run, next_run, last_error, last_success, enabled
 		FROM scheduled_tasks
 	`)
@@ -126,7 +126,7 @@ func (s *schedulerStore) RecordResult(ctx context.Context, result *domain.TaskRe
 // GetTaskHistory returns recent results for a task.
 // Results are ordered by start time descending (most recent first).
 func (s *schedulerStore) GetTaskHistory(ctx context.Context, taskID string, limit int) ([]domain.TaskResult, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
+	rows, err := s.store.readDB.QueryContext(ctx, `
 		SELECT task_id, started_at, ended_at, success, error, items_processed
 		FROM task_results
 		WHERE task_id = ?
@@ -1,6 +1,8 @@
 package sqlite
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"database/sql"
 	"embed"
@@ -8,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"math"
 	"os"
@@ -26,11 +29,21 @@ import (
 // jsonNull is the JSON representation of null.
 const jsonNull = "null"
 
+// busyTimeout is how long a connection waits for another process to release
+// the database lock before failing with SQLITE_BUSY.
+const busyTimeout = 5 * time.Second
+
 // Store is a unified SQLite-based storage that provides access to
 // all metadata store interfaces through wrapper types.
+//
+// SQLite allows one writer at a time, so every write goes through db, a pool
+// of a single connection: concurrent writers in this process queue for it
+// instead of racing for the database lock. Reads go through readDB, a pool of
+// read-only connections that WAL mode lets run alongside the writer.
 type Store struct {
-	db   *sql.DB
-	path string
+	db     *sql.DB
+	readDB *sql.DB
+	path   string
 }
 
 // NewStore creates a new SQLite store at the specified data directory.
@@ -51,17 +64,15 @@ func NewStore(dataDir string) (*Store, error) {
 
 	dbPath := filepath.Join(dataDir, "metadata.db")
 
-	// Open database with WAL mode for better concurrency
-	db, err := sql.Open("sqlite", dbPath+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	// Open the writer with WAL mode so reads do not block on it. Transactions
+	// take the write lock when they begin, so a lock held by another process
+	// is waited out by the busy timeout rather than failing mid-transaction.
+	db, err := sql.Open("sqlite", dataSourceName(dbPath,
+		"_pragma=journal_mode(WAL)", "_pragma=foreign_keys(1)", "_txlock=immediate"))
 	if err != nil {
 		return nil, fmt.Errorf("opening database: %w", err)
 	}
-
-	// Enable foreign keys
-	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
-		db.Close()
-		return nil, fmt.Errorf("enabling foreign keys: %w", err)
-	}
+	db.SetMaxOpenConns(1)
 
 	s := &Store{
 		db:   db,
@@ -74,12 +85,41 @@ func NewStore(dataDir string) (*Store, error) {
 		return nil, fmt.Errorf("running migrations: %w", err)
 	}
 
+	// Open the read pool once the schema exists
+	readDB, err := sql.Open("sqlite", dataSourceName(dbPath, "_pragma=query_only(1)"))
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("opening read pool: %w", err)
+	}
+	s.readDB = readDB
+
 	return s, nil
 }
 
-// Close closes the database connection.
+// dataSourceName builds the driver connection string for the database at
+// path. Every connection waits out the busy timeout; pragmas among params run
+// on each new connection in the pool.
+func dataSourceName(path string, params ...string) string {
+	params = append([]string{fmt.Sprintf("_pragma=busy_timeout(%d)", busyTimeout.Milliseconds())}, params...)
+	return path + "?" + strings.Join(params, "&")
+}
+
+// Close checkpoints the write-ahead log into the database file and closes
+// the database connections. A failed checkpoint leaves the log to be replayed
+// on the next open, so it does not stop the connections from closing.
 func (s *Store) Close() error {
-	return s.db.Close()
+	readErr := s.readDB.Close()
+	_, checkpointErr := s.db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	if err := s.db.Close(); err != nil {
+		return err
+	}
+	if readErr != nil {
+		return fmt.Errorf("closing read pool: %w", readErr)
+	}
+	if checkpointErr != nil {
+		return fmt.Errorf("checkpointing WAL: %w", checkpointErr)
+	}
+	return nil
 }
 
 // Path returns the database file path.
@@ -102,6 +142,11 @@ func (s *Store) SyncStateStore() driven.SyncStateStore {
 	return &syncStateStore{store: s}
 }
 
+// SyncChangeLogStore returns a SyncChangeLogStore interface backed by this store.
+func (s *Store) SyncChangeLogStore() driven.SyncChangeLogStore {
+	return &syncChangeLogStore{store: s}
+}
+
 // ExclusionStore returns an ExclusionStore interface backed by this store.
 func (s *Store) ExclusionStore() driven.ExclusionStore {
 	return &exclusionStore{store: s}
@@ -112,6 +157,16 @@ func (s *Store) SchedulerStore() driven.SchedulerStore {
 	return &schedulerStore{store: s}
 }
 
+// EmbeddingJobStore returns an EmbeddingJobStore interface backed by this store.
+func (s *Store) EmbeddingJobStore() driven.EmbeddingJobStore {
+	return &embeddingJobStore{store: s}
+}
+
+// FeedbackStore returns a FeedbackStore interface backed by this store.
+func (s *Store) FeedbackStore() driven.FeedbackStore {
+	return &feedbackStore{store: s}
+}
+
 // AuthProviderStore returns an AuthProviderStore interface backed by this store.
 func (s *Store) AuthProviderStore() driven.AuthProviderStore {
 	return &authProviderStore{store: s}
@@ -206,18 +261,22 @@ func (s *sourceStore) Save(ctx context.Context, source domain.Source) error {
 	source.UpdatedAt = now
 
 	_, err = s.store.db.ExecContext(ctx, `
-		INSERT INTO sources (id, type, name, config, auth_provider_id, credentials_id, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO sources (
+			id, type, name, config, auth_provider_id, credentials_id, archived, collection, created_at, updated_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			type = excluded.type,
 			name = excluded.name,
 			config = excluded.config,
 			auth_provider_id = excluded.auth_provider_id,
 			credentials_id = excluded.credentials_id,
+			archived = excluded.archived,
+			collection = excluded.collection,
 			updated_at = excluded.updated_at
 	`, source.ID, source.Type, source.Name, string(configJSON),
-		nullString(source.AuthProviderID), nullString(source.CredentialsID),
-		source.CreatedAt, source.UpdatedAt)
+		nullString(source.AuthProviderID), nullString(source.CredentialsID), source.Archived,
+		nullString(source.Collection), source.CreatedAt, source.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("saving source: %w", err)
@@ -227,17 +286,17 @@ func (s *sourceStore) Save(ctx context.Context, source domain.Source) error {
 
 // Get retrieves a source by ID.
 func (s *sourceStore) Get(ctx context.Context, id string) (*domain.Source, error) {
-	row := s.store.db.QueryRowContext(ctx, `
-		SELECT id, type, name, config, auth_provider_id, credentials_id, created_at, updated_at
+	row := s.store.readDB.QueryRowContext(ctx, `
+		SELECT id, type, name, config, auth_provider_id, credentials_id, archived, collection, created_at, updated_at
 		FROM sources WHERE id = ?
 	`, id)
 
 	var source domain.Source
 	var configJSON string
-	var authProviderID, credentialsID sql.NullString
+	var authProviderID, credentialsID, collection sql.NullString
 	var createdAt, updatedAt sql.NullTime
 	if err := row.Scan(&source.ID, &source.Type, &source.Name, &configJSON,
-		&authProviderID, &credentialsID, &createdAt, &updatedAt); err != nil {
+		&authProviderID, &credentialsID, &source.Archived, &collection, &createdAt, &updatedAt); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
 		}
@@ -250,6 +309,7 @@ func (s *sourceStore) Get(ctx context.Context, id string) (*domain.Source, error
 
 	source.AuthProviderID = authProviderID.String
 	source.CredentialsID = credentialsID.String
+	source.Collection = collection.String
 	if createdAt.Valid {
 		source.CreatedAt = createdAt.Time
 	}
@@ -269,11 +329,12 @@ func (s *sourceStore) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// List returns all configured sources.
+// List returns all configured sources in insertion order.
 func (s *sourceStore) List(ctx context.Context) ([]domain.Source, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
-		SELECT id, type, name, config, auth_provider_id, credentials_id, created_at, updated_at
+	rows, err := s.store.readDB.QueryContext(ctx, `
+		SELECT id, type, name, config, auth_provider_id, credentials_id, archived, collection, created_at, updated_at
 		FROM sources
+		ORDER BY rowid
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("querying sources: %w", err)
@@ -284,10 +345,10 @@ func (s *sourceStore) List(ctx context.Context) ([]domain.Source, error) {
 	for rows.Next() {
 		var source domain.Source
 		var configJSON string
-		var authProviderID, credentialsID sql.NullString
+		var authProviderID, credentialsID, collection sql.NullString
 		var createdAt, updatedAt sql.NullTime
 		if err := rows.Scan(&source.ID, &source.Type, &source.Name, &configJSON,
-			&authProviderID, &credentialsID, &createdAt, &updatedAt); err != nil {
+			&authProviderID, &credentialsID, &source.Archived, &collection, &createdAt, &updatedAt); err != nil {
 			return nil, fmt.Errorf("scanning source: %w", err)
 		}
 
@@ -297,6 +358,7 @@ func (s *sourceStore) List(ctx context.Context) ([]domain.Source, error) {
 
 		source.AuthProviderID = authProviderID.String
 		source.CredentialsID = credentialsID.String
+		source.Collection = collection.String
 		if createdAt.Valid {
 			source.CreatedAt = createdAt.Time
 		}
@@ -322,6 +384,36 @@ type documentStore struct {
 
 var _ driven.DocumentStore = (*documentStore)(nil)
 
+// saveDocumentSQL upserts a single document row.
+const saveDocumentSQL = `
+	INSERT INTO documents (id, source_id, uri, title, content, parent_id, author_name, author_id,
+		metadata, created_at, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(id) DO UPDATE SET
+		source_id = excluded.source_id,
+		uri = excluded.uri,
+		title = excluded.title,
+		content = excluded.content,
+		parent_id = excluded.parent_id,
+		author_name = excluded.author_name,
+		author_id = excluded.author_id,
+		metadata = excluded.metadata,
+		updated_at = excluded.updated_at,
+		original = CASE WHEN documents.content = excluded.content THEN documents.original END
+`
+
+// saveChunkSQL upserts a single chunk row.
+const saveChunkSQL = `
+	INSERT INTO chunks (id, document_id, content, position, embedding, metadata)
+	VALUES (?, ?, ?, ?, ?, ?)
+	ON CONFLICT(id) DO UPDATE SET
+		document_id = excluded.document_id,
+		content = excluded.content,
+		position = excluded.position,
+		embedding = excluded.embedding,
+		metadata = excluded.metadata
+`
+
 // SaveDocument stores or updates a document.
 func (s *documentStore) SaveDocument(ctx context.Context, doc *domain.Document) error {
 	metadataJSON, err := json.Marshal(doc.Metadata)
@@ -329,19 +421,8 @@ func (s *documentStore) SaveDocument(ctx context.Context, doc *domain.Document)
 		return fmt.Errorf("marshalling metadata: %w", err)
 	}
 
-	_, err = s.store.db.ExecContext(ctx, `
-		INSERT INTO documents (id, source_id, uri, title, content, parent_id, metadata, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-		ON CONFLICT(id) DO UPDATE SET
-			source_id = excluded.source_id,
-			uri = excluded.uri,
-			title = excluded.title,
-			content = excluded.content,
-			parent_id = excluded.parent_id,
-			metadata = excluded.metadata,
-			updated_at = excluded.updated_at
-	`, doc.ID, doc.SourceID, doc.URI, doc.Title, doc.Content,
-		doc.ParentID, string(metadataJSON), doc.CreatedAt, doc.UpdatedAt)
+	_, err = s.store.db.ExecContext(ctx, saveDocumentSQL, doc.ID, doc.SourceID, doc.URI, doc.Title, doc.Content,
+		doc.ParentID, doc.Author.Name, doc.Author.Identifier, string(metadataJSON), doc.CreatedAt, doc.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("saving document: %w", err)
@@ -357,16 +438,60 @@ func (s *documentStore) SaveChunks(ctx context.Context, chunks []domain.Chunk) e
 	}
 	defer tx.Rollback() //nolint:errcheck
 
-	stmt, err := tx.PrepareContext(ctx, `
-		INSERT INTO chunks (id, document_id, content, position, embedding, metadata)
-		VALUES (?, ?, ?, ?, ?, ?)
-		ON CONFLICT(id) DO UPDATE SET
-			document_id = excluded.document_id,
-			content = excluded.content,
-			position = excluded.position,
-			embedding = excluded.embedding,
-			metadata = excluded.metadata
-	`)
+	if err := saveChunksTx(ctx, tx, chunks); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// SaveBatch stores or updates several documents and their chunks in a single transaction.
+func (s *documentStore) SaveBatch(ctx context.Context, docs []domain.Document, chunks []domain.Chunk) error {
+	if len(docs) == 0 && len(chunks) == 0 {
+		return nil
+	}
+
+	tx, err := s.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	stmt, err := tx.PrepareContext(ctx, saveDocumentSQL)
+	if err != nil {
+		return fmt.Errorf("preparing document statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for i := range docs {
+		doc := &docs[i]
+		metadataJSON, err := json.Marshal(doc.Metadata)
+		if err != nil {
+			return fmt.Errorf("marshalling metadata: %w", err)
+		}
+		if _, err := stmt.ExecContext(ctx, doc.ID, doc.SourceID, doc.URI, doc.Title, doc.Content,
+			doc.ParentID, doc.Author.Name, doc.Author.Identifier, string(metadataJSON),
+			doc.CreatedAt, doc.UpdatedAt); err != nil {
+			return fmt.Errorf("saving document %s: %w", doc.ID, err)
+		}
+	}
+
+	if err := saveChunksTx(ctx, tx, chunks); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing batch: %w", err)
+	}
+	return nil
+}
+
+// saveChunksTx upserts chunks within a transaction.
+func saveChunksTx(ctx context.Context, tx *sql.Tx, chunks []domain.Chunk) error {
+	stmt, err := tx.PrepareContext(ctx, saveChunkSQL)
 	if err != nil {
 		return fmt.Errorf("preparing statement: %w", err)
 	}
@@ -385,26 +510,36 @@ func (s *documentStore) SaveChunks(ctx context.Context, chunks []domain.Chunk) e
 			return fmt.Errorf("saving chunk: %w", err)
 		}
 	}
-
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("committing transaction: %w", err)
-	}
 	return nil
 }
 
 // GetDocument retrieves a document by ID.
 func (s *documentStore) GetDocument(ctx context.Context, id string) (*domain.Document, error) {
-	row := s.store.db.QueryRowContext(ctx, `
-		SELECT id, source_id, uri, title, content, parent_id, metadata, created_at, updated_at
+	row := s.store.readDB.QueryRowContext(ctx, `
+		SELECT id, source_id, uri, title, content, parent_id, author_name, author_id,
+			metadata, created_at, updated_at
 		FROM documents WHERE id = ?
 	`, id)
 
 	return scanDocument(row)
 }
 
+// GetDocumentByURI retrieves the most recently updated document with the URI.
+func (s *documentStore) GetDocumentByURI(ctx context.Context, sourceID, uri string) (*domain.Document, error) {
+	row := s.store.readDB.QueryRowContext(ctx, `
+		SELECT id, source_id, uri, title, content, parent_id, author_name, author_id,
+			metadata, created_at, updated_at
+		FROM documents WHERE source_id = ? AND uri = ?
+		ORDER BY updated_at DESC, rowid DESC
+		LIMIT 1
+	`, sourceID, uri)
+
+	return scanDocument(row)
+}
+
 // GetChunks retrieves all chunks for a document.
 func (s *documentStore) GetChunks(ctx context.Context, documentID string) ([]domain.Chunk, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
+	rows, err := s.store.readDB.QueryContext(ctx, `
 		SELECT id, document_id, content, position, embedding, metadata
 		FROM chunks WHERE document_id = ?
 		ORDER BY position
@@ -432,7 +567,7 @@ func (s *documentStore) GetChunks(ctx context.Context, documentID string) ([]dom
 
 // GetChunk retrieves a specific chunk by ID.
 func (s *documentStore) GetChunk(ctx context.Context, id string) (*domain.Chunk, error) {
-	row := s.store.db.QueryRowContext(ctx, `
+	row := s.store.readDB.QueryRowContext(ctx, `
 		SELECT id, document_id, content, position, embedding, metadata
 		FROM chunks WHERE id = ?
 	`, id)
@@ -440,6 +575,23 @@ func (s *documentStore) GetChunk(ctx context.Context, id string) (*domain.Chunk,
 	return scanChunkRow(row)
 }
 
+// UpdateChunkEmbedding sets the embedding of an existing chunk.
+func (s *documentStore) UpdateChunkEmbedding(ctx context.Context, chunkID string, embedding []float32) error {
+	result, err := s.store.db.ExecContext(ctx,
+		"UPDATE chunks SET embedding = ? WHERE id = ?", float32SliceToBytes(embedding), chunkID)
+	if err != nil {
+		return fmt.Errorf("updating chunk embedding: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("updating chunk embedding: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
 // DeleteDocument removes a document and its chunks.
 func (s *documentStore) DeleteDocument(ctx context.Context, id string) error {
 	_, err := s.store.db.ExecContext(ctx, "DELETE FROM documents WHERE id = ?", id)
@@ -449,12 +601,156 @@ func (s *documentStore) DeleteDocument(ctx context.Context, id string) error {
 	return nil
 }
 
-// ListDocuments returns documents for a source.
+// ListDocuments returns documents for a source in insertion order.
 func (s *documentStore) ListDocuments(ctx context.Context, sourceID string) ([]domain.Document, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
-		SELECT id, source_id, uri, title, content, parent_id, metadata, created_at, updated_at
+	return s.queryDocuments(ctx, `
+		SELECT id, source_id, uri, title, content, parent_id, author_name, author_id,
+			metadata, created_at, updated_at
 		FROM documents WHERE source_id = ?
+		ORDER BY rowid
 	`, sourceID)
+}
+
+// ListDocumentsPaginated returns a page of documents for a source in insertion
+// order, with the source's total document count.
+func (s *documentStore) ListDocumentsPaginated(
+	ctx context.Context, sourceID string, offset, limit int,
+) ([]domain.Document, int64, error) {
+	var total int64
+	err := s.store.readDB.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM documents WHERE source_id = ?", sourceID,
+	).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting documents: %w", err)
+	}
+
+	if limit <= 0 {
+		limit = -1 // SQLite treats a negative LIMIT as no limit
+	}
+	docs, err := s.queryDocuments(ctx, `
+		SELECT id, source_id, uri, title, content, parent_id, author_name, author_id,
+			metadata, created_at, updated_at
+		FROM documents WHERE source_id = ?
+		ORDER BY rowid
+		LIMIT ? OFFSET ?
+	`, sourceID, limit, max(offset, 0))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return docs, total, nil
+}
+
+// ListRecentDocuments returns documents across sources, most recently updated first.
+func (s *documentStore) ListRecentDocuments(
+	ctx context.Context, opts domain.TimelineOptions,
+) ([]domain.Document, error) {
+	var conditions []string
+	var args []any
+	if !opts.Since.IsZero() {
+		conditions = append(conditions, "updated_at >= ?")
+		args = append(args, opts.Since)
+	}
+	if !opts.Until.IsZero() {
+		conditions = append(conditions, "updated_at < ?")
+		args = append(args, opts.Until)
+	}
+	if len(opts.SourceIDs) > 0 {
+		placeholders := strings.Repeat("?,", len(opts.SourceIDs))
+		conditions = append(conditions, "source_id IN ("+placeholders[:len(placeholders)-1]+")")
+		for _, id := range opts.SourceIDs {
+			args = append(args, id)
+		}
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, opts.EffectiveLimit())
+
+	// Conditions and placeholders are fixed strings; values are bound
+	return s.queryDocuments(ctx, `
+		SELECT id, source_id, uri, title, content, parent_id, author_name, author_id,
+			metadata, created_at, updated_at
+		FROM documents `+where+`
+		ORDER BY updated_at DESC, rowid DESC
+		LIMIT ?
+	`, args...)
+}
+
+// CountBySource returns the number of documents stored for a source.
+func (s *documentStore) CountBySource(ctx context.Context, sourceID string) (int, error) {
+	var count int
+	err := s.store.readDB.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM documents WHERE source_id = ?", sourceID,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("counting documents: %w", err)
+	}
+	return count, nil
+}
+
+// SaveOriginal stores the gzip-compressed original bytes of a document.
+func (s *documentStore) SaveOriginal(ctx context.Context, documentID string, content []byte) error {
+	var compressed []byte
+	if content != nil {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(content); err != nil {
+			return fmt.Errorf("compressing original: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("compressing original: %w", err)
+		}
+		compressed = buf.Bytes()
+	}
+
+	result, err := s.store.db.ExecContext(ctx,
+		"UPDATE documents SET original = ? WHERE id = ?", compressed, documentID)
+	if err != nil {
+		return fmt.Errorf("saving original: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("saving original: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// GetOriginal retrieves and decompresses the original bytes of a document.
+func (s *documentStore) GetOriginal(ctx context.Context, documentID string) ([]byte, error) {
+	var compressed []byte
+	err := s.store.readDB.QueryRowContext(ctx,
+		"SELECT original FROM documents WHERE id = ?", documentID,
+	).Scan(&compressed)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("getting original: %w", err)
+	}
+	if compressed == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing original: %w", err)
+	}
+	defer zr.Close()
+	content, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing original: %w", err)
+	}
+	return content, nil
+}
+
+// queryDocuments runs a document query and scans every row.
+func (s *documentStore) queryDocuments(ctx context.Context, query string, args ...any) ([]domain.Document, error) {
+	rows, err := s.store.readDB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying documents: %w", err)
 	}
@@ -487,13 +783,43 @@ var _ driven.SyncStateStore = (*syncStateStore)(nil)
 
 // Save stores or updates sync state.
 func (s *syncStateStore) Save(ctx context.Context, state domain.SyncState) error {
+	var subCursors sql.NullString
+	if len(state.SubCursors) > 0 {
+		data, err := json.Marshal(state.SubCursors)
+		if err != nil {
+			return fmt.Errorf("marshalling sub-cursors: %w", err)
+		}
+		subCursors = sql.NullString{String: string(data), Valid: true}
+	}
+
+	var recentErrors sql.NullString
+	if len(state.RecentErrors) > 0 {
+		data, err := json.Marshal(state.RecentErrors)
+		if err != nil {
+			return fmt.Errorf("marshalling recent errors: %w", err)
+		}
+		recentErrors = sql.NullString{String: string(data), Valid: true}
+	}
+
+	// A source that has only ever failed has no successful sync time
+	var lastSync sql.NullTime
+	if !state.LastSync.IsZero() {
+		lastSync = sql.NullTime{Time: state.LastSync, Valid: true}
+	}
+
 	_, err := s.store.db.ExecContext(ctx, `
-		INSERT INTO sync_states (source_id, cursor, last_sync)
-		VALUES (?, ?, ?)
+		INSERT INTO sync_states (
+			source_id, cursor, sub_cursors, connector_version, last_sync, last_error, recent_errors
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(source_id) DO UPDATE SET
 			cursor = excluded.cursor,
-			last_sync = excluded.last_sync
-	`, state.SourceID, state.Cursor, state.LastSync)
+			sub_cursors = excluded.sub_cursors,
+			connector_version = excluded.connector_version,
+			last_sync = excluded.last_sync,
+			last_error = excluded.last_error,
+			recent_errors = excluded.recent_errors
+	`, state.SourceID, state.Cursor, subCursors, state.ConnectorVersion, lastSync, state.LastError, recentErrors)
 
 	if err != nil {
 		return fmt.Errorf("saving sync state: %w", err)
@@ -503,20 +829,34 @@ func (s *syncStateStore) Save(ctx context.Context, state domain.SyncState) error
 
 // Get retrieves sync state for a source.
 func (s *syncStateStore) Get(ctx context.Context, sourceID string) (*domain.SyncState, error) {
-	row := s.store.db.QueryRowContext(ctx, `
-		SELECT source_id, cursor, last_sync
+	row := s.store.readDB.QueryRowContext(ctx, `
+		SELECT source_id, cursor, sub_cursors, connector_version, last_sync, last_error, recent_errors
 		FROM sync_states WHERE source_id = ?
 	`, sourceID)
 
 	var state domain.SyncState
+	var subCursors, recentErrors sql.NullString
 	var lastSync sql.NullTime
-	if err := row.Scan(&state.SourceID, &state.Cursor, &lastSync); err != nil {
+	err := row.Scan(
+		&state.SourceID, &state.Cursor, &subCursors, &state.ConnectorVersion, &lastSync, &state.LastError, &recentErrors,
+	)
+	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
 		}
 		return nil, fmt.Errorf("scanning sync state: %w", err)
 	}
 
+	if subCursors.Valid && subCursors.String != "" {
+		if err := json.Unmarshal([]byte(subCursors.String), &state.SubCursors); err != nil {
+			return nil, fmt.Errorf("unmarshalling sub-cursors: %w", err)
+		}
+	}
+	if recentErrors.Valid && recentErrors.String != "" {
+		if err := json.Unmarshal([]byte(recentErrors.String), &state.RecentErrors); err != nil {
+			return nil, fmt.Errorf("unmarshalling recent errors: %w", err)
+		}
+	}
 	if lastSync.Valid {
 		state.LastSync = lastSync.Time
 	}
@@ -542,12 +882,19 @@ type exclusionStore struct {
 
 var _ driven.ExclusionStore = (*exclusionStore)(nil)
 
+// insertExclusionSQL inserts a single exclusion row.
+const insertExclusionSQL = `
+	INSERT INTO exclusions (
+		id, source_id, document_id, uri, pattern_type, category, reason, excluded_at, quarantined
+	)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
 // Add creates a new exclusion.
 func (s *exclusionStore) Add(ctx context.Context, exclusion *domain.Exclusion) error {
-	_, err := s.store.db.ExecContext(ctx, `
-		INSERT INTO exclusions (id, source_id, document_id, uri, reason, excluded_at)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, exclusion.ID, exclusion.SourceID, exclusion.DocumentID, exclusion.URI, exclusion.Reason, exclusion.ExcludedAt)
+	_, err := s.store.db.ExecContext(ctx, insertExclusionSQL,
+		exclusion.ID, exclusion.SourceID, exclusion.DocumentID, exclusion.URI, patternType(exclusion),
+		string(exclusion.Category), exclusion.Reason, exclusion.ExcludedAt, exclusion.Quarantined)
 
 	if err != nil {
 		return fmt.Errorf("adding exclusion: %w", err)
@@ -555,6 +902,39 @@ func (s *exclusionStore) Add(ctx context.Context, exclusion *domain.Exclusion) e
 	return nil
 }
 
+// AddMany creates several exclusions in a single transaction.
+func (s *exclusionStore) AddMany(ctx context.Context, exclusions []*domain.Exclusion) error {
+	if len(exclusions) == 0 {
+		return nil
+	}
+
+	tx, err := s.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	stmt, err := tx.PrepareContext(ctx, insertExclusionSQL)
+	if err != nil {
+		return fmt.Errorf("preparing exclusion insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, exclusion := range exclusions {
+		if _, err := stmt.ExecContext(ctx,
+			exclusion.ID, exclusion.SourceID, exclusion.DocumentID, exclusion.URI, patternType(exclusion),
+			string(exclusion.Category), exclusion.Reason, exclusion.ExcludedAt, exclusion.Quarantined,
+		); err != nil {
+			return fmt.Errorf("adding exclusion %s: %w", exclusion.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing exclusions: %w", err)
+	}
+	return nil
+}
+
 // Remove deletes an exclusion by ID.
 func (s *exclusionStore) Remove(ctx context.Context, id string) error {
 	_, err := s.store.db.ExecContext(ctx, "DELETE FROM exclusions WHERE id = ?", id)
@@ -566,9 +946,10 @@ func (s *exclusionStore) Remove(ctx context.Context, id string) error {
 
 // GetBySourceID returns all exclusions for a source.
 func (s *exclusionStore) GetBySourceID(ctx context.Context, sourceID string) ([]domain.Exclusion, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
-		SELECT id, source_id, document_id, uri, reason, excluded_at
+	rows, err := s.store.readDB.QueryContext(ctx, `
+		SELECT id, source_id, document_id, uri, pattern_type, category, reason, excluded_at, quarantined
 		FROM exclusions WHERE source_id = ?
+		ORDER BY rowid
 	`, sourceID)
 	if err != nil {
 		return nil, fmt.Errorf("querying exclusions: %w", err)
@@ -579,22 +960,51 @@ func (s *exclusionStore) GetBySourceID(ctx context.Context, sourceID string) ([]
 }
 
 // IsExcluded checks if a URI is excluded for a source.
+// Exact exclusions are looked up through the URI index first; the few pattern
+// exclusions of the source are then matched one by one.
 func (s *exclusionStore) IsExcluded(ctx context.Context, sourceID, uri string) (bool, error) {
 	var count int
-	err := s.store.db.QueryRowContext(ctx, `
-		SELECT COUNT(*) FROM exclusions WHERE source_id = ? AND uri = ?
-	`, sourceID, uri).Scan(&count)
+	err := s.store.readDB.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM exclusions WHERE source_id = ? AND uri = ? AND pattern_type = ?
+	`, sourceID, uri, string(domain.ExclusionPatternExact)).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("checking exclusion: %w", err)
 	}
-	return count > 0, nil
+	if count > 0 {
+		return true, nil
+	}
+
+	rows, err := s.store.readDB.QueryContext(ctx, `
+		SELECT uri, pattern_type FROM exclusions WHERE source_id = ? AND pattern_type != ?
+	`, sourceID, string(domain.ExclusionPatternExact))
+	if err != nil {
+		return false, fmt.Errorf("checking pattern exclusions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pattern domain.Exclusion
+		var patternType string
+		if err := rows.Scan(&pattern.URI, &patternType); err != nil {
+			return false, fmt.Errorf("scanning pattern exclusion: %w", err)
+		}
+		pattern.PatternType = domain.ExclusionPatternType(patternType)
+		if pattern.Matches(uri) {
+			return true, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, fmt.Errorf("iterating pattern exclusions: %w", err)
+	}
+	return false, nil
 }
 
 // List returns all exclusions.
 func (s *exclusionStore) List(ctx context.Context) ([]domain.Exclusion, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
-		SELECT id, source_id, document_id, uri, reason, excluded_at
+	rows, err := s.store.readDB.QueryContext(ctx, `
+		SELECT id, source_id, document_id, uri, pattern_type, category, reason, excluded_at, quarantined
 		FROM exclusions
+		ORDER BY rowid
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("querying exclusions: %w", err)
@@ -604,6 +1014,36 @@ func (s *exclusionStore) List(ctx context.Context) ([]domain.Exclusion, error) {
 	return scanExclusions(rows)
 }
 
+// RecordFailure records a processing failure for a URI and returns the
+// number of consecutive failures, including this one.
+func (s *exclusionStore) RecordFailure(ctx context.Context, sourceID, uri, reason string) (int, error) {
+	var failures int
+	err := s.store.db.QueryRowContext(ctx, `
+		INSERT INTO document_failures (source_id, uri, failures, last_error, last_failed_at)
+		VALUES (?, ?, 1, ?, ?)
+		ON CONFLICT(source_id, uri) DO UPDATE SET
+			failures = failures + 1,
+			last_error = excluded.last_error,
+			last_failed_at = excluded.last_failed_at
+		RETURNING failures
+	`, sourceID, uri, reason, time.Now()).Scan(&failures)
+	if err != nil {
+		return 0, fmt.Errorf("recording failure: %w", err)
+	}
+	return failures, nil
+}
+
+// ClearFailures resets the consecutive failure count for a URI.
+func (s *exclusionStore) ClearFailures(ctx context.Context, sourceID, uri string) error {
+	_, err := s.store.db.ExecContext(ctx, `
+		DELETE FROM document_failures WHERE source_id = ? AND uri = ?
+	`, sourceID, uri)
+	if err != nil {
+		return fmt.Errorf("clearing failures: %w", err)
+	}
+	return nil
+}
+
 // ==================== Helper Functions ====================
 
 // float32SliceToBytes converts a []float32 to a byte slice for storage.
@@ -637,7 +1077,7 @@ func scanDocument(row *sql.Row) (*domain.Document, error) {
 	var metadataJSON string
 
 	if err := row.Scan(&doc.ID, &doc.SourceID, &doc.URI, &doc.Title, &doc.Content,
-		&parentID, &metadataJSON, &doc.CreatedAt, &doc.UpdatedAt); err != nil {
+		&parentID, &doc.Author.Name, &doc.Author.Identifier, &metadataJSON, &doc.CreatedAt, &doc.UpdatedAt); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
 		}
@@ -664,7 +1104,7 @@ func scanDocumentRows(rows *sql.Rows) (*domain.Document, error) {
 	var metadataJSON string
 
 	if err := rows.Scan(&doc.ID, &doc.SourceID, &doc.URI, &doc.Title, &doc.Content,
-		&parentID, &metadataJSON, &doc.CreatedAt, &doc.UpdatedAt); err != nil {
+		&parentID, &doc.Author.Name, &doc.Author.Identifier, &metadataJSON, &doc.CreatedAt, &doc.UpdatedAt); err != nil {
 		return nil, fmt.Errorf("scanning document: %w", err)
 	}
 
@@ -728,14 +1168,28 @@ func scanChunkRow(row *sql.Row) (*domain.Chunk, error) {
 	return &chunk, nil
 }
 
+// patternType returns the stored pattern type of an exclusion, defaulting to exact.
+func patternType(exclusion *domain.Exclusion) string {
+	if exclusion.PatternType == "" {
+		return string(domain.ExclusionPatternExact)
+	}
+	return string(exclusion.PatternType)
+}
+
 // scanExclusions scans multiple exclusion rows.
 func scanExclusions(rows *sql.Rows) ([]domain.Exclusion, error) {
 	var exclusions []domain.Exclusion //nolint:prealloc // size unknown from query
 	for rows.Next() {
 		var e domain.Exclusion
-		if err := rows.Scan(&e.ID, &e.SourceID, &e.DocumentID, &e.URI, &e.Reason, &e.ExcludedAt); err != nil {
+		var patternType, category string
+		if err := rows.Scan(
+			&e.ID, &e.SourceID, &e.DocumentID, &e.URI, &patternType, &category, &e.Reason, &e.ExcludedAt,
+			&e.Quarantined,
+		); err != nil {
 			return nil, fmt.Errorf("scanning exclusion: %w", err)
 		}
+		e.PatternType = domain.ExclusionPatternType(patternType)
+		e.Category = domain.ExclusionReason(category)
 		exclusions = append(exclusions, e)
 	}
 
@@ -788,7 +1242,7 @@ func (s *authProviderStore) Save(ctx context.Context, provider domain.AuthProvid
 
 // Get retrieves an auth provider by ID.
 func (s *authProviderStore) Get(ctx context.Context, id string) (*domain.AuthProvider, error) {
-	row := s.store.db.QueryRowContext(ctx, `
+	row := s.store.readDB.QueryRowContext(ctx, `
 		SELECT id, name, provider_type, auth_method, oauth, created_at, updated_at
 		FROM auth_providers WHERE id = ?
 	`, id)
@@ -798,7 +1252,7 @@ func (s *authProviderStore) Get(ctx context.Context, id string) (*domain.AuthPro
 
 // List returns all auth providers.
 func (s *authProviderStore) List(ctx context.Context) ([]domain.AuthProvider, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
+	rows, err := s.store.readDB.QueryContext(ctx, `
 		SELECT id, name, provider_type, auth_method, oauth, created_at, updated_at
 		FROM auth_providers
 	`)
@@ -815,7 +1269,7 @@ func (s *authProviderStore) ListByProvider(
 	ctx context.Context,
 	providerType domain.ProviderType,
 ) ([]domain.AuthProvider, error) {
-	rows, err := s.store.db.QueryContext(ctx, `
+	rows, err := s.store.readDB.QueryContext(ctx, `
 		SELECT id, name, provider_type, auth_method, oauth, created_at, updated_at
 		FROM auth_providers WHERE provider_type = ?
 	`, string(providerType))
@@ -831,7 +1285,7 @@ func (s *authProviderStore) ListByProvider(
 func (s *authProviderStore) Delete(ctx context.Context, id string) error {
 	// Check if any sources are using this provider
 	var count int
-	err := s.store.db.QueryRowContext(ctx,
+	err := s.store.readDB.QueryRowContext(ctx,
 		"SELECT COUNT(*) FROM sources WHERE auth_provider_id = ?", id).Scan(&count)
 	if err != nil {
 		return fmt.Errorf("checking provider usage: %w", err)
@@ -935,18 +1389,24 @@ func (s *credentialsStore) Save(ctx context.Context, creds domain.Credentials) e
 		return fmt.Errorf("marshalling pat credentials: %w", err)
 	}
 
+	githubAppJSON, err := json.Marshal(creds.GitHubApp)
+	if err != nil {
+		return fmt.Errorf("marshalling github app credentials: %w", err)
+	}
+
 	_, err = s.store.db.ExecContext(ctx, `
 		INSERT INTO credentials
-			(id, source_id, account_identifier, oauth, pat, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
+			(id, source_id, account_identifier, oauth, pat, github_app, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			source_id = excluded.source_id,
 			account_identifier = excluded.account_identifier,
 			oauth = excluded.oauth,
 			pat = excluded.pat,
+			github_app = excluded.github_app,
 			updated_at = excluded.updated_at
 	`, creds.ID, creds.SourceID, creds.AccountIdentifier,
-		string(oauthJSON), string(patJSON), creds.CreatedAt, creds.UpdatedAt)
+		string(oauthJSON), string(patJSON), string(githubAppJSON), creds.CreatedAt, creds.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("saving credentials: %w", err)
@@ -956,8 +1416,8 @@ func (s *credentialsStore) Save(ctx context.Context, creds domain.Credentials) e
 
 // Get retrieves credentials by ID.
 func (s *credentialsStore) Get(ctx context.Context, id string) (*domain.Credentials, error) {
-	row := s.store.db.QueryRowContext(ctx, `
-		SELECT id, source_id, account_identifier, oauth, pat, created_at, updated_at
+	row := s.store.readDB.QueryRowContext(ctx, `
+		SELECT id, source_id, account_identifier, oauth, pat, github_app, created_at, updated_at
 		FROM credentials WHERE id = ?
 	`, id)
 
@@ -966,8 +1426,8 @@ func (s *credentialsStore) Get(ctx context.Context, id string) (*domain.Credenti
 
 // GetBySourceID retrieves credentials for a specific source.
 func (s *credentialsStore) GetBySourceID(ctx context.Context, sourceID string) (*domain.Credentials, error) {
-	row := s.store.db.QueryRowContext(ctx, `
-		SELECT id, source_id, account_identifier, oauth, pat, created_at, updated_at
+	row := s.store.readDB.QueryRowContext(ctx, `
+		SELECT id, source_id, account_identifier, oauth, pat, github_app, created_at, updated_at
 		FROM credentials WHERE source_id = ?
 	`, sourceID)
 
@@ -990,10 +1450,10 @@ func (s *credentialsStore) Delete(ctx context.Context, id string) error {
 // scanCredentials scans a single credentials row.
 func scanCredentials(row *sql.Row) (*domain.Credentials, error) {
 	var creds domain.Credentials
-	var oauthJSON, patJSON sql.NullString
+	var oauthJSON, patJSON, githubAppJSON sql.NullString
 
 	if err := row.Scan(&creds.ID, &creds.SourceID, &creds.AccountIdentifier,
-		&oauthJSON, &patJSON, &creds.CreatedAt, &creds.UpdatedAt); err != nil {
+		&oauthJSON, &patJSON, &githubAppJSON, &creds.CreatedAt, &creds.UpdatedAt); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
 		}
@@ -1016,5 +1476,13 @@ func scanCredentials(row *sql.Row) (*domain.Credentials, error) {
 		creds.PAT = &pat
 	}
 
+	if githubAppJSON.Valid && githubAppJSON.String != jsonNull {
+		var app domain.GitHubAppCredentials
+		if err := json.Unmarshal([]byte(githubAppJSON.String), &app); err != nil {
+			return nil, fmt.Errorf("unmarshalling github app credentials: %w", err)
+		}
+		creds.GitHubApp = &app
+	}
+
 	return &creds, nil
 }
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -282,6 +283,34 @@ func TestSourceStore_SaveUpdate(t *testing.T) {
 	assert.Equal(t, "/tmp/updated", retrieved.Config["path"])
 }
 
+func TestSourceStore_Collection(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	sourceStore := store.SourceStore()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "work", Type: "filesystem", Collection: "Work"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "loose", Type: "filesystem"}))
+
+	retrieved, err := sourceStore.Get(ctx, "work")
+	require.NoError(t, err)
+	assert.Equal(t, "Work", retrieved.Collection)
+
+	sources, err := sourceStore.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, sources, 2)
+	assert.Equal(t, "Work", sources[0].Collection)
+	assert.Empty(t, sources[1].Collection)
+
+	// Leaving the collection clears it
+	retrieved.Collection = ""
+	require.NoError(t, sourceStore.Save(ctx, *retrieved))
+	retrieved, err = sourceStore.Get(ctx, "work")
+	require.NoError(t, err)
+	assert.Empty(t, retrieved.Collection)
+}
+
 func TestSourceStore_Get_NotFound(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
@@ -538,6 +567,92 @@ func TestSyncStateStore_EmptyCursor(t *testing.T) {
 	assert.Equal(t, "", retrieved.Cursor)
 }
 
+func TestSyncStateStore_SubCursors(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	syncStore := store.SyncStateStore()
+	createTestSource(t, store, "source-1")
+
+	state := domain.SyncState{
+		SourceID: "source-1",
+		Cursor:   "header",
+		SubCursors: map[string]string{
+			"octocat/hello": `{"files_sha":"abc"}`,
+			"octocat/world": `{"files_sha":"def"}`,
+		},
+		LastSync: time.Now().UTC().Truncate(time.Second),
+	}
+
+	require.NoError(t, syncStore.Save(ctx, state))
+
+	retrieved, err := syncStore.Get(ctx, state.SourceID)
+	require.NoError(t, err)
+	assert.Equal(t, state.SubCursors, retrieved.SubCursors)
+
+	// Saving without sub-cursors clears them
+	state.SubCursors = nil
+	require.NoError(t, syncStore.Save(ctx, state))
+
+	retrieved, err = syncStore.Get(ctx, state.SourceID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved.SubCursors)
+	assert.Equal(t, "header", retrieved.Cursor)
+}
+
+func TestSyncStateStore_RecentErrors(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	syncStore := store.SyncStateStore()
+	createTestSource(t, store, "source-1")
+
+	failedAt := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	state := domain.SyncState{
+		SourceID:  "source-1",
+		LastError: "connector error: rate limited",
+		RecentErrors: []domain.SyncErrorLog{
+			{Timestamp: failedAt, Phase: domain.SyncPhaseDocument, Message: "file:///a.pdf: parse failed"},
+			{Timestamp: failedAt.Add(time.Minute), Phase: domain.SyncPhaseSync, Message: "connector error: rate limited"},
+		},
+	}
+
+	require.NoError(t, syncStore.Save(ctx, state))
+
+	retrieved, err := syncStore.Get(ctx, state.SourceID)
+	require.NoError(t, err)
+	require.Len(t, retrieved.RecentErrors, 2)
+	assert.True(t, failedAt.Equal(retrieved.RecentErrors[0].Timestamp))
+	assert.Equal(t, domain.SyncPhaseDocument, retrieved.RecentErrors[0].Phase)
+	assert.Equal(t, "connector error: rate limited", retrieved.RecentErrors[1].Message)
+
+	// Saving without errors clears them
+	state.RecentErrors = nil
+	require.NoError(t, syncStore.Save(ctx, state))
+
+	retrieved, err = syncStore.Get(ctx, state.SourceID)
+	require.NoError(t, err)
+	assert.Empty(t, retrieved.RecentErrors)
+}
+
+func TestSyncStateStore_ConnectorVersion(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	syncStore := store.SyncStateStore()
+	createTestSource(t, store, "source-1")
+
+	state := domain.SyncState{SourceID: "source-1", Cursor: "c1", ConnectorVersion: "2.0.0"}
+	require.NoError(t, syncStore.Save(ctx, state))
+
+	retrieved, err := syncStore.Get(ctx, state.SourceID)
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", retrieved.ConnectorVersion)
+}
+
 // ==================== DocumentStore Tests ====================
 
 func TestDocumentStore_SaveAndGetDocument(t *testing.T) {
@@ -1155,6 +1270,126 @@ func TestExclusionStore_List(t *testing.T) {
 	assert.Len(t, source2Excl, 1)
 }
 
+func TestExclusionStore_Quarantined(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	exclStore := store.ExclusionStore()
+	createTestSource(t, store, "source-1")
+
+	require.NoError(t, exclStore.Add(ctx, &domain.Exclusion{
+		ID:          "quar-1",
+		SourceID:    "source-1",
+		URI:         "file:///tmp/corrupt.pdf",
+		Reason:      "normalisation failed 3 times in a row",
+		ExcludedAt:  time.Now().UTC(),
+		Quarantined: true,
+	}))
+	require.NoError(t, exclStore.Add(ctx, &domain.Exclusion{
+		ID:         "excl-1",
+		SourceID:   "source-1",
+		DocumentID: "doc-1",
+		URI:        "file:///tmp/excluded.txt",
+		ExcludedAt: time.Now().UTC(),
+	}))
+
+	exclusions, err := exclStore.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, exclusions, 2)
+
+	byID := make(map[string]domain.Exclusion)
+	for _, e := range exclusions {
+		byID[e.ID] = e
+	}
+	assert.True(t, byID["quar-1"].Quarantined)
+	assert.False(t, byID["excl-1"].Quarantined)
+}
+
+func TestExclusionStore_AddMany(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	exclStore := store.ExclusionStore()
+	createTestSource(t, store, "source-1")
+
+	now := time.Now().UTC()
+	require.NoError(t, exclStore.AddMany(ctx, []*domain.Exclusion{
+		{ID: "excl-1", SourceID: "source-1", DocumentID: "doc-1", URI: "file:///a",
+			Category: domain.ExclusionReasonSensitive, Reason: "contains keys", ExcludedAt: now},
+		{ID: "excl-2", SourceID: "source-1", DocumentID: "doc-2", URI: "file:///b",
+			Category: domain.ExclusionReasonDuplicate, ExcludedAt: now},
+	}))
+	require.NoError(t, exclStore.AddMany(ctx, nil))
+
+	exclusions, err := exclStore.GetBySourceID(ctx, "source-1")
+	require.NoError(t, err)
+	require.Len(t, exclusions, 2)
+
+	byID := make(map[string]domain.Exclusion)
+	for _, e := range exclusions {
+		byID[e.ID] = e
+	}
+	assert.Equal(t, domain.ExclusionReasonSensitive, byID["excl-1"].Category)
+	assert.Equal(t, "contains keys", byID["excl-1"].Reason)
+	assert.Equal(t, domain.ExclusionReasonDuplicate, byID["excl-2"].Category)
+
+	excluded, err := exclStore.IsExcluded(ctx, "source-1", "file:///b")
+	require.NoError(t, err)
+	assert.True(t, excluded)
+}
+
+func TestExclusionStore_AddMany_Atomic(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	exclStore := store.ExclusionStore()
+	createTestSource(t, store, "source-1")
+
+	// The duplicate ID fails the batch, so nothing is stored
+	err := exclStore.AddMany(ctx, []*domain.Exclusion{
+		{ID: "excl-1", SourceID: "source-1", URI: "file:///a", ExcludedAt: time.Now().UTC()},
+		{ID: "excl-1", SourceID: "source-1", URI: "file:///b", ExcludedAt: time.Now().UTC()},
+	})
+	require.Error(t, err)
+
+	exclusions, err := exclStore.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, exclusions)
+}
+
+func TestExclusionStore_RecordFailure(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	exclStore := store.ExclusionStore()
+	createTestSource(t, store, "source-1")
+	createTestSource(t, store, "source-2")
+
+	// Consecutive failures are counted per source and URI
+	for want := 1; want <= 3; want++ {
+		failures, err := exclStore.RecordFailure(ctx, "source-1", "file:///tmp/corrupt.pdf", "bad xref table")
+		require.NoError(t, err)
+		assert.Equal(t, want, failures)
+	}
+
+	failures, err := exclStore.RecordFailure(ctx, "source-2", "file:///tmp/corrupt.pdf", "bad xref table")
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+
+	// Clearing resets the count
+	require.NoError(t, exclStore.ClearFailures(ctx, "source-1", "file:///tmp/corrupt.pdf"))
+	failures, err = exclStore.RecordFailure(ctx, "source-1", "file:///tmp/corrupt.pdf", "bad xref table")
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+
+	// Clearing an unknown URI is a no-op
+	require.NoError(t, exclStore.ClearFailures(ctx, "source-1", "file:///tmp/unknown.pdf"))
+}
+
 func TestFloat32SliceToBytes(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -1600,6 +1835,63 @@ func TestStore_ConcurrentWrites(t *testing.T) {
 	assert.Len(t, sources, numGoroutines)
 }
 
+func TestStore_ConcurrentTransactionsAndReads(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	createTestSource(t, store, "source-1")
+	docStore := store.DocumentStore()
+
+	// Batches write in transactions while readers list documents; neither
+	// should see SQLITE_BUSY
+	const numWriters = 8
+	const numReaders = 8
+	done := make(chan error, numWriters+numReaders)
+
+	for i := 0; i < numWriters; i++ {
+		go func(id int) {
+			docs := make([]domain.Document, 10)
+			for j := range docs {
+				docs[j] = domain.Document{
+					ID:       fmt.Sprintf("doc-%d-%d", id, j),
+					SourceID: "source-1",
+					URI:      fmt.Sprintf("file:///doc-%d-%d", id, j),
+				}
+			}
+			done <- docStore.SaveBatch(ctx, docs, nil)
+		}(i)
+	}
+	for i := 0; i < numReaders; i++ {
+		go func() {
+			_, err := docStore.ListDocuments(ctx, "source-1")
+			done <- err
+		}()
+	}
+
+	for i := 0; i < numWriters+numReaders; i++ {
+		assert.NoError(t, <-done)
+	}
+
+	count, err := docStore.CountBySource(ctx, "source-1")
+	require.NoError(t, err)
+	assert.Equal(t, numWriters*10, count)
+}
+
+func TestStore_ReadPoolIsReadOnly(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	_, err := store.readDB.Exec("DELETE FROM sources")
+	assert.Error(t, err)
+
+	// Writes through the writer are visible to the read pool once committed
+	createTestSource(t, store, "source-1")
+	var count int
+	require.NoError(t, store.readDB.QueryRow("SELECT COUNT(*) FROM sources").Scan(&count))
+	assert.Equal(t, 1, count)
+}
+
 // ==================== Edge Cases ====================
 
 func TestDocumentStore_EmptyMetadata(t *testing.T) {
@@ -0,0 +1,85 @@
+package sqlite
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// syncChangeLogStore implements driven.SyncChangeLogStore.
+// Logs hold whole documents, so they are stored as gzip-compressed JSON.
+type syncChangeLogStore struct {
+	store *Store
+}
+
+var _ driven.SyncChangeLogStore = (*syncChangeLogStore)(nil)
+
+// Save stores a log, replacing the source's previous log.
+func (s *syncChangeLogStore) Save(ctx context.Context, log *domain.SyncChangeLog) error {
+	if log == nil || log.SourceID == "" {
+		return domain.ErrInvalidInput
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(log); err != nil {
+		return fmt.Errorf("encoding sync change log: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("compressing sync change log: %w", err)
+	}
+
+	_, err := s.store.db.ExecContext(ctx, `
+		INSERT INTO sync_change_logs (source_id, started_at, log)
+		VALUES (?, ?, ?)
+		ON CONFLICT(source_id) DO UPDATE SET
+			started_at = excluded.started_at,
+			log = excluded.log
+	`, log.SourceID, log.StartedAt, buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("saving sync change log: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves the log for a source.
+func (s *syncChangeLogStore) Get(ctx context.Context, sourceID string) (*domain.SyncChangeLog, error) {
+	var compressed []byte
+	err := s.store.readDB.QueryRowContext(ctx,
+		"SELECT log FROM sync_change_logs WHERE source_id = ?", sourceID,
+	).Scan(&compressed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("getting sync change log: %w", err)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing sync change log: %w", err)
+	}
+	defer zr.Close()
+
+	var log domain.SyncChangeLog
+	if err := json.NewDecoder(zr).Decode(&log); err != nil {
+		return nil, fmt.Errorf("decoding sync change log: %w", err)
+	}
+	return &log, nil
+}
+
+// Delete removes the log for a source.
+func (s *syncChangeLogStore) Delete(ctx context.Context, sourceID string) error {
+	_, err := s.store.db.ExecContext(ctx, "DELETE FROM sync_change_logs WHERE source_id = ?", sourceID)
+	if err != nil {
+		return fmt.Errorf("deleting sync change log: %w", err)
+	}
+	return nil
+}
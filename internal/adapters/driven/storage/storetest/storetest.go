@@ -0,0 +1,945 @@
+// Package storetest provides a conformance suite for driven store implementations.
+// The same tests run against the memory and SQLite stores so that services can
+// be tested with the fast memory stores while relying on SQLite behaviour.
+package storetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Stores bundles the store implementations under test.
+type Stores struct {
+	Sources    driven.SourceStore
+	Documents  driven.DocumentStore
+	SyncStates driven.SyncStateStore
+	Exclusions driven.ExclusionStore
+	Changes    driven.SyncChangeLogStore
+}
+
+// Behaviour declares where an implementation intentionally differs.
+type Behaviour struct {
+	// ForeignKeys is true when the stores share referential integrity:
+	// documents, sync state and exclusions for an unknown source are rejected,
+	// and deleting a source deletes them too.
+	// Separate in-memory stores cannot do this, so services.SourceService.Remove
+	// cleans up explicitly rather than relying on the cascade.
+	ForeignKeys bool
+}
+
+// NewStoresFunc returns fresh, empty stores for a single test.
+type NewStoresFunc func(t *testing.T) Stores
+
+// Run runs the full conformance suite.
+func Run(t *testing.T, newStores NewStoresFunc, behaviour Behaviour) {
+	t.Run("SourceStore", func(t *testing.T) { RunSourceStore(t, newStores) })
+	t.Run("DocumentStore", func(t *testing.T) { RunDocumentStore(t, newStores, behaviour) })
+	t.Run("SyncStateStore", func(t *testing.T) { RunSyncStateStore(t, newStores) })
+	t.Run("ExclusionStore", func(t *testing.T) { RunExclusionStore(t, newStores) })
+	t.Run("SyncChangeLogStore", func(t *testing.T) { RunSyncChangeLogStore(t, newStores) })
+	t.Run("SourceDelete", func(t *testing.T) { RunSourceDelete(t, newStores, behaviour) })
+}
+
+// RunSourceStore tests driven.SourceStore semantics.
+func RunSourceStore(t *testing.T, newStores NewStoresFunc) {
+	ctx := context.Background()
+
+	t.Run("get missing returns ErrNotFound", func(t *testing.T) {
+		s := newStores(t)
+		_, err := s.Sources.Get(ctx, "missing")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("list empty", func(t *testing.T) {
+		s := newStores(t)
+		sources, err := s.Sources.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, sources)
+	})
+
+	t.Run("save and get", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+
+		got, err := s.Sources.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, "src-1", got.ID)
+		assert.Equal(t, "test", got.Type)
+		assert.Equal(t, "Source src-1", got.Name)
+		assert.Equal(t, map[string]string{"path": "/src-1"}, got.Config)
+	})
+
+	t.Run("save updates in place", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+
+		require.NoError(t, s.Sources.Save(ctx, domain.Source{
+			ID: "src-1", Type: "test", Name: "Renamed", Config: map[string]string{"path": "/new"},
+		}))
+
+		got, err := s.Sources.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", got.Name)
+		assert.Equal(t, "/new", got.Config["path"])
+
+		sources, err := s.Sources.List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"src-1", "src-2"}, sourceIDs(sources))
+	})
+
+	t.Run("archived flag round-trips", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		require.NoError(t, s.Sources.Save(ctx, domain.Source{ID: "src-2", Type: "test", Name: "Old", Archived: true}))
+
+		got, err := s.Sources.Get(ctx, "src-2")
+		require.NoError(t, err)
+		assert.True(t, got.Archived)
+
+		sources, err := s.Sources.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, sources, 2)
+		assert.False(t, sources[0].Archived)
+		assert.True(t, sources[1].Archived)
+
+		got.Archived = false
+		require.NoError(t, s.Sources.Save(ctx, *got))
+		got, err = s.Sources.Get(ctx, "src-2")
+		require.NoError(t, err)
+		assert.False(t, got.Archived)
+	})
+
+	t.Run("list in insertion order", func(t *testing.T) {
+		s := newStores(t)
+		for _, id := range []string{"src-c", "src-a", "src-b"} {
+			saveSource(t, s, id)
+		}
+
+		sources, err := s.Sources.List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"src-c", "src-a", "src-b"}, sourceIDs(sources))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+
+		require.NoError(t, s.Sources.Delete(ctx, "src-1"))
+
+		_, err := s.Sources.Get(ctx, "src-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		sources, err := s.Sources.List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"src-2"}, sourceIDs(sources))
+	})
+
+	t.Run("delete missing is not an error", func(t *testing.T) {
+		s := newStores(t)
+		assert.NoError(t, s.Sources.Delete(ctx, "missing"))
+	})
+}
+
+// RunDocumentStore tests driven.DocumentStore semantics.
+func RunDocumentStore(t *testing.T, newStores NewStoresFunc, behaviour Behaviour) {
+	ctx := context.Background()
+
+	t.Run("get missing returns ErrNotFound", func(t *testing.T) {
+		s := newStores(t)
+		_, err := s.Documents.GetDocument(ctx, "missing")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = s.Documents.GetChunk(ctx, "missing")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("missing chunks and documents list empty", func(t *testing.T) {
+		s := newStores(t)
+		chunks, err := s.Documents.GetChunks(ctx, "missing")
+		require.NoError(t, err)
+		assert.Empty(t, chunks)
+		docs, err := s.Documents.ListDocuments(ctx, "missing")
+		require.NoError(t, err)
+		assert.Empty(t, docs)
+	})
+
+	t.Run("save and get", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+
+		got, err := s.Documents.GetDocument(ctx, "doc-1")
+		require.NoError(t, err)
+		assert.Equal(t, "doc-1", got.ID)
+		assert.Equal(t, "src-1", got.SourceID)
+		assert.Equal(t, "file:///doc-1", got.URI)
+		assert.Equal(t, "Document doc-1", got.Title)
+		assert.Equal(t, "content of doc-1", got.Content)
+	})
+
+	t.Run("get by URI returns the latest document", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		updated := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		for id, hours := range map[string]int{"doc-old": 0, "doc-new": 2, "doc-older": -1} {
+			require.NoError(t, s.Documents.SaveDocument(ctx, &domain.Document{
+				ID: id, SourceID: "src-1", URI: "https://example.com/1",
+				UpdatedAt: updated.Add(time.Duration(hours) * time.Hour),
+			}))
+		}
+		require.NoError(t, s.Documents.SaveDocument(ctx, &domain.Document{
+			ID: "doc-x", SourceID: "src-2", URI: "https://example.com/1",
+			UpdatedAt: updated.Add(24 * time.Hour),
+		}))
+
+		got, err := s.Documents.GetDocumentByURI(ctx, "src-1", "https://example.com/1")
+		require.NoError(t, err)
+		assert.Equal(t, "doc-new", got.ID)
+
+		_, err = s.Documents.GetDocumentByURI(ctx, "src-1", "https://example.com/2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("list recent across sources", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		for id, doc := range map[string]struct {
+			source string
+			hours  int
+		}{
+			"doc-a": {"src-1", 1},
+			"doc-b": {"src-2", 3},
+			"doc-c": {"src-1", 2},
+			"doc-d": {"src-2", -5},
+		} {
+			require.NoError(t, s.Documents.SaveDocument(ctx, &domain.Document{
+				ID: id, SourceID: doc.source, URI: "file:///" + id,
+				UpdatedAt: base.Add(time.Duration(doc.hours) * time.Hour),
+			}))
+		}
+
+		docs, err := s.Documents.ListRecentDocuments(ctx, domain.TimelineOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"doc-b", "doc-c", "doc-a", "doc-d"}, documentIDs(docs))
+
+		docs, err = s.Documents.ListRecentDocuments(ctx, domain.TimelineOptions{
+			Since: base, Until: base.Add(3 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"doc-c", "doc-a"}, documentIDs(docs))
+
+		docs, err = s.Documents.ListRecentDocuments(ctx, domain.TimelineOptions{SourceIDs: []string{"src-2"}, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"doc-b"}, documentIDs(docs))
+	})
+
+	t.Run("author round-trips", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		author := domain.Author{Name: "Alice Smith", Identifier: "alice@example.com"}
+		require.NoError(t, s.Documents.SaveDocument(ctx, &domain.Document{
+			ID: "doc-1", SourceID: "src-1", URI: "file:///doc-1", Author: author,
+		}))
+		require.NoError(t, s.Documents.SaveBatch(ctx, []domain.Document{
+			{ID: "doc-2", SourceID: "src-1", URI: "file:///doc-2", Author: author},
+		}, nil))
+
+		got, err := s.Documents.GetDocument(ctx, "doc-1")
+		require.NoError(t, err)
+		assert.Equal(t, author, got.Author)
+		docs, err := s.Documents.ListDocuments(ctx, "src-1")
+		require.NoError(t, err)
+		require.Len(t, docs, 2)
+		assert.Equal(t, author, docs[1].Author)
+	})
+
+	t.Run("list filters by source in insertion order", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		saveDocument(t, s, "doc-c", "src-1")
+		saveDocument(t, s, "doc-x", "src-2")
+		saveDocument(t, s, "doc-a", "src-1")
+		saveDocument(t, s, "doc-b", "src-1")
+		// Updating a document keeps its position
+		saveDocument(t, s, "doc-c", "src-1")
+
+		docs, err := s.Documents.ListDocuments(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"doc-c", "doc-a", "doc-b"}, documentIDs(docs))
+	})
+
+	t.Run("count by source", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		saveDocument(t, s, "doc-a", "src-1")
+		saveDocument(t, s, "doc-b", "src-1")
+		saveDocument(t, s, "doc-x", "src-2")
+		// Updating a document does not count it twice
+		saveDocument(t, s, "doc-a", "src-1")
+
+		count, err := s.Documents.CountBySource(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		count, err = s.Documents.CountBySource(ctx, "missing")
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("list paginated", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		for _, id := range []string{"doc-1", "doc-2", "doc-3", "doc-4", "doc-5"} {
+			saveDocument(t, s, id, "src-1")
+		}
+		saveDocument(t, s, "doc-x", "src-2")
+
+		pages := []struct {
+			offset, limit int
+			expected      []string
+		}{
+			{0, 2, []string{"doc-1", "doc-2"}},
+			{2, 2, []string{"doc-3", "doc-4"}},
+			{4, 2, []string{"doc-5"}},
+			{5, 2, []string{}},
+			{3, 0, []string{"doc-4", "doc-5"}},
+			{-1, 1, []string{"doc-1"}},
+		}
+		for _, page := range pages {
+			docs, total, err := s.Documents.ListDocumentsPaginated(ctx, "src-1", page.offset, page.limit)
+			require.NoError(t, err)
+			assert.Equal(t, int64(5), total)
+			assert.Equal(t, page.expected, documentIDs(docs), "offset %d limit %d", page.offset, page.limit)
+		}
+
+		docs, total, err := s.Documents.ListDocumentsPaginated(ctx, "missing", 0, 10)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, docs)
+	})
+
+	t.Run("chunks ordered by position", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+		require.NoError(t, s.Documents.SaveChunks(ctx, []domain.Chunk{
+			{ID: "chunk-2", DocumentID: "doc-1", Content: "two", Position: 2},
+			{ID: "chunk-0", DocumentID: "doc-1", Content: "zero", Position: 0},
+			{ID: "chunk-1", DocumentID: "doc-1", Content: "one", Position: 1},
+		}))
+
+		chunks, err := s.Documents.GetChunks(ctx, "doc-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"chunk-0", "chunk-1", "chunk-2"}, chunkIDs(chunks))
+
+		chunk, err := s.Documents.GetChunk(ctx, "chunk-1")
+		require.NoError(t, err)
+		assert.Equal(t, "one", chunk.Content)
+	})
+
+	t.Run("chunks upsert by ID", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+		require.NoError(t, s.Documents.SaveChunks(ctx, []domain.Chunk{
+			{ID: "chunk-0", DocumentID: "doc-1", Content: "zero", Position: 0},
+			{ID: "chunk-1", DocumentID: "doc-1", Content: "one", Position: 1},
+		}))
+		require.NoError(t, s.Documents.SaveChunks(ctx, []domain.Chunk{
+			{ID: "chunk-0", DocumentID: "doc-1", Content: "zero again", Position: 0},
+		}))
+
+		// Chunks missing from a later batch are kept
+		chunks, err := s.Documents.GetChunks(ctx, "doc-1")
+		require.NoError(t, err)
+		require.Equal(t, []string{"chunk-0", "chunk-1"}, chunkIDs(chunks))
+		assert.Equal(t, "zero again", chunks[0].Content)
+	})
+
+	t.Run("update chunk embedding", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+		require.NoError(t, s.Documents.SaveChunks(ctx, []domain.Chunk{
+			{ID: "chunk-0", DocumentID: "doc-1", Content: "zero", Position: 0},
+		}))
+
+		require.NoError(t, s.Documents.UpdateChunkEmbedding(ctx, "chunk-0", []float32{0.5, 1}))
+
+		chunk, err := s.Documents.GetChunk(ctx, "chunk-0")
+		require.NoError(t, err)
+		assert.Equal(t, []float32{0.5, 1}, chunk.Embedding)
+		assert.Equal(t, "zero", chunk.Content)
+	})
+
+	t.Run("update embedding of missing chunk", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+
+		err := s.Documents.UpdateChunkEmbedding(ctx, "chunk-0", []float32{1})
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		// The chunk is not created
+		chunks, err := s.Documents.GetChunks(ctx, "doc-1")
+		require.NoError(t, err)
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("save batch", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-b", "src-1")
+		require.NoError(t, s.Documents.SaveBatch(ctx, nil, nil))
+
+		require.NoError(t, s.Documents.SaveBatch(ctx, []domain.Document{
+			{ID: "doc-a", SourceID: "src-1", URI: "file:///doc-a", Content: "a", Metadata: map[string]any{}},
+			{ID: "doc-b", SourceID: "src-1", URI: "file:///doc-b", Content: "b again", Metadata: map[string]any{}},
+		}, []domain.Chunk{
+			{ID: "chunk-a", DocumentID: "doc-a", Content: "a"},
+			{ID: "chunk-b", DocumentID: "doc-b", Content: "b again"},
+		}))
+
+		// Updated documents keep their position
+		docs, err := s.Documents.ListDocuments(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"doc-b", "doc-a"}, documentIDs(docs))
+		assert.Equal(t, "b again", docs[0].Content)
+
+		chunks, err := s.Documents.GetChunks(ctx, "doc-b")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"chunk-b"}, chunkIDs(chunks))
+	})
+
+	if behaviour.ForeignKeys {
+		t.Run("save batch is atomic", func(t *testing.T) {
+			s := newStores(t)
+			saveSource(t, s, "src-1")
+
+			err := s.Documents.SaveBatch(ctx, []domain.Document{
+				{ID: "doc-a", SourceID: "src-1", URI: "file:///doc-a", Metadata: map[string]any{}},
+				{ID: "doc-x", SourceID: "missing", URI: "file:///doc-x", Metadata: map[string]any{}},
+			}, []domain.Chunk{{ID: "chunk-a", DocumentID: "doc-a", Content: "a"}})
+			assert.Error(t, err)
+
+			docs, err := s.Documents.ListDocuments(ctx, "src-1")
+			require.NoError(t, err)
+			assert.Empty(t, docs)
+			_, err = s.Documents.GetChunk(ctx, "chunk-a")
+			assert.ErrorIs(t, err, domain.ErrNotFound)
+		})
+	}
+
+	t.Run("delete removes chunks", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+		require.NoError(t, s.Documents.SaveChunks(ctx, []domain.Chunk{
+			{ID: "chunk-0", DocumentID: "doc-1", Content: "zero"},
+		}))
+
+		require.NoError(t, s.Documents.DeleteDocument(ctx, "doc-1"))
+
+		_, err := s.Documents.GetDocument(ctx, "doc-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = s.Documents.GetChunk(ctx, "chunk-0")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		chunks, err := s.Documents.GetChunks(ctx, "doc-1")
+		require.NoError(t, err)
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("delete missing is not an error", func(t *testing.T) {
+		s := newStores(t)
+		assert.NoError(t, s.Documents.DeleteDocument(ctx, "missing"))
+	})
+
+	t.Run("original round-trips", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+
+		_, err := s.Documents.GetOriginal(ctx, "doc-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		original := []byte("<h1>Original</h1>")
+		require.NoError(t, s.Documents.SaveOriginal(ctx, "doc-1", original))
+		got, err := s.Documents.GetOriginal(ctx, "doc-1")
+		require.NoError(t, err)
+		assert.Equal(t, original, got)
+
+		require.NoError(t, s.Documents.SaveOriginal(ctx, "doc-1", nil))
+		_, err = s.Documents.GetOriginal(ctx, "doc-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("original for missing document", func(t *testing.T) {
+		s := newStores(t)
+		err := s.Documents.SaveOriginal(ctx, "missing", []byte("x"))
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("original survives unchanged content", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+		require.NoError(t, s.Documents.SaveOriginal(ctx, "doc-1", []byte("raw")))
+
+		doc, err := s.Documents.GetDocument(ctx, "doc-1")
+		require.NoError(t, err)
+		doc.Title = "Renamed"
+		require.NoError(t, s.Documents.SaveDocument(ctx, doc))
+
+		got, err := s.Documents.GetOriginal(ctx, "doc-1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("raw"), got)
+	})
+
+	t.Run("changed content drops original", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+		require.NoError(t, s.Documents.SaveOriginal(ctx, "doc-1", []byte("raw")))
+
+		doc, err := s.Documents.GetDocument(ctx, "doc-1")
+		require.NoError(t, err)
+		doc.Content = "new content"
+		require.NoError(t, s.Documents.SaveBatch(ctx, []domain.Document{*doc}, nil))
+
+		_, err = s.Documents.GetOriginal(ctx, "doc-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("delete removes original", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveDocument(t, s, "doc-1", "src-1")
+		require.NoError(t, s.Documents.SaveOriginal(ctx, "doc-1", []byte("raw")))
+		require.NoError(t, s.Documents.DeleteDocument(ctx, "doc-1"))
+		saveDocument(t, s, "doc-1", "src-1")
+
+		_, err := s.Documents.GetOriginal(ctx, "doc-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("document for unknown source", func(t *testing.T) {
+		s := newStores(t)
+		err := s.Documents.SaveDocument(ctx, &domain.Document{
+			ID: "doc-1", SourceID: "missing", URI: "file:///doc-1", Metadata: map[string]any{},
+		})
+		if behaviour.ForeignKeys {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	})
+}
+
+// RunSyncStateStore tests driven.SyncStateStore semantics.
+func RunSyncStateStore(t *testing.T, newStores NewStoresFunc) {
+	ctx := context.Background()
+
+	t.Run("get missing returns ErrNotFound", func(t *testing.T) {
+		s := newStores(t)
+		_, err := s.SyncStates.Get(ctx, "missing")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("save and update", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		subCursors := map[string]string{"a": "1"}
+		require.NoError(t, s.SyncStates.Save(ctx, domain.SyncState{
+			SourceID: "src-1", Cursor: "cursor-1", SubCursors: subCursors,
+		}))
+
+		// Later changes to the caller's map are not stored
+		subCursors["b"] = "2"
+
+		got, err := s.SyncStates.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, "cursor-1", got.Cursor)
+		assert.Equal(t, map[string]string{"a": "1"}, got.SubCursors)
+
+		require.NoError(t, s.SyncStates.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-2"}))
+		got, err = s.SyncStates.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, "cursor-2", got.Cursor)
+		assert.Empty(t, got.SubCursors)
+	})
+
+	t.Run("last error round trips", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		lastSync := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		require.NoError(t, s.SyncStates.Save(ctx, domain.SyncState{
+			SourceID: "src-1", LastSync: lastSync, LastError: "token expired",
+		}))
+
+		got, err := s.SyncStates.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, "token expired", got.LastError)
+		assert.True(t, lastSync.Equal(got.LastSync))
+
+		// A failure before any successful sync has no sync time
+		saveSource(t, s, "src-2")
+		require.NoError(t, s.SyncStates.Save(ctx, domain.SyncState{SourceID: "src-2", LastError: "boom"}))
+		got, err = s.SyncStates.Get(ctx, "src-2")
+		require.NoError(t, err)
+		assert.True(t, got.LastSync.IsZero())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		require.NoError(t, s.SyncStates.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1"}))
+
+		require.NoError(t, s.SyncStates.Delete(ctx, "src-1"))
+		_, err := s.SyncStates.Get(ctx, "src-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		assert.NoError(t, s.SyncStates.Delete(ctx, "missing"))
+	})
+}
+
+// RunSyncChangeLogStore tests driven.SyncChangeLogStore semantics.
+func RunSyncChangeLogStore(t *testing.T, newStores NewStoresFunc) {
+	ctx := context.Background()
+
+	t.Run("get missing returns ErrNotFound", func(t *testing.T) {
+		s := newStores(t)
+		_, err := s.Changes.Get(ctx, "missing")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("save rejects logs without a source", func(t *testing.T) {
+		s := newStores(t)
+		assert.ErrorIs(t, s.Changes.Save(ctx, &domain.SyncChangeLog{}), domain.ErrInvalidInput)
+		assert.ErrorIs(t, s.Changes.Save(ctx, nil), domain.ErrInvalidInput)
+	})
+
+	t.Run("save replaces and round trips", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		previous := &domain.Document{
+			ID: "doc-2", SourceID: "src-1", URI: "/b", Title: "B", Content: "before",
+			Metadata:  map[string]any{"type": "note"},
+			CreatedAt: started.Add(-time.Hour), UpdatedAt: started.Add(-time.Hour),
+		}
+		log := &domain.SyncChangeLog{
+			SourceID:      "src-1",
+			StartedAt:     started,
+			PreviousState: &domain.SyncState{SourceID: "src-1", Cursor: "cursor-1"},
+			Changes: []domain.SyncChange{
+				{Kind: domain.SyncChangeCreated, DocumentID: "doc-1"},
+				{
+					Kind: domain.SyncChangeUpdated, DocumentID: "doc-2", Previous: previous,
+					PreviousChunks: []domain.Chunk{
+						{ID: "chunk-1", DocumentID: "doc-2", Content: "before", Embedding: []float32{0.5, 0.25}},
+					},
+				},
+			},
+		}
+		require.NoError(t, s.Changes.Save(ctx, log))
+
+		got, err := s.Changes.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.True(t, started.Equal(got.StartedAt))
+		require.NotNil(t, got.PreviousState)
+		assert.Equal(t, "cursor-1", got.PreviousState.Cursor)
+		require.Len(t, got.Changes, 2)
+		assert.Nil(t, got.Changes[0].Previous)
+		require.NotNil(t, got.Changes[1].Previous)
+		assert.Equal(t, "before", got.Changes[1].Previous.Content)
+		assert.Equal(t, "note", got.Changes[1].Previous.Metadata["type"])
+		assert.Equal(t, []float32{0.5, 0.25}, got.Changes[1].PreviousChunks[0].Embedding)
+
+		// A new log replaces the previous one
+		require.NoError(t, s.Changes.Save(ctx, &domain.SyncChangeLog{SourceID: "src-1", Truncated: true}))
+		got, err = s.Changes.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.True(t, got.Truncated)
+		assert.Empty(t, got.Changes)
+		assert.Nil(t, got.PreviousState)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		require.NoError(t, s.Changes.Save(ctx, &domain.SyncChangeLog{SourceID: "src-1"}))
+
+		require.NoError(t, s.Changes.Delete(ctx, "src-1"))
+		_, err := s.Changes.Get(ctx, "src-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		assert.NoError(t, s.Changes.Delete(ctx, "missing"))
+	})
+}
+
+// RunExclusionStore tests driven.ExclusionStore semantics.
+func RunExclusionStore(t *testing.T, newStores NewStoresFunc) {
+	ctx := context.Background()
+
+	t.Run("empty", func(t *testing.T) {
+		s := newStores(t)
+		exclusions, err := s.Exclusions.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, exclusions)
+		exclusions, err = s.Exclusions.GetBySourceID(ctx, "missing")
+		require.NoError(t, err)
+		assert.Empty(t, exclusions)
+	})
+
+	t.Run("add and list in insertion order", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		for _, e := range []*domain.Exclusion{
+			exclusion("excl-c", "src-1"),
+			exclusion("excl-x", "src-2"),
+			exclusion("excl-a", "src-1"),
+		} {
+			require.NoError(t, s.Exclusions.Add(ctx, e))
+		}
+
+		all, err := s.Exclusions.List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"excl-c", "excl-x", "excl-a"}, exclusionIDs(all))
+
+		bySource, err := s.Exclusions.GetBySourceID(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"excl-c", "excl-a"}, exclusionIDs(bySource))
+		assert.Equal(t, domain.ExclusionReasonIrrelevant, bySource[0].Category)
+		assert.Equal(t, "file:///excl-c", bySource[0].URI)
+	})
+
+	t.Run("is excluded is scoped to source", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		require.NoError(t, s.Exclusions.Add(ctx, exclusion("excl-1", "src-1")))
+
+		excluded, err := s.Exclusions.IsExcluded(ctx, "src-1", "file:///excl-1")
+		require.NoError(t, err)
+		assert.True(t, excluded)
+
+		excluded, err = s.Exclusions.IsExcluded(ctx, "src-2", "file:///excl-1")
+		require.NoError(t, err)
+		assert.False(t, excluded)
+	})
+
+	t.Run("prefix exclusion matches subtree", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		saveSource(t, s, "src-2")
+		prefix := exclusion("excl-nm", "src-1")
+		prefix.URI = "file:///home/user/node_modules/"
+		prefix.PatternType = domain.ExclusionPatternPrefix
+		require.NoError(t, s.Exclusions.Add(ctx, prefix))
+		require.NoError(t, s.Exclusions.Add(ctx, exclusion("excl-1", "src-1")))
+
+		excluded, err := s.Exclusions.IsExcluded(ctx, "src-1", "file:///home/user/node_modules/lodash/index.js")
+		require.NoError(t, err)
+		assert.True(t, excluded)
+
+		excluded, err = s.Exclusions.IsExcluded(ctx, "src-1", "file:///home/user/src/index.js")
+		require.NoError(t, err)
+		assert.False(t, excluded)
+
+		excluded, err = s.Exclusions.IsExcluded(ctx, "src-2", "file:///home/user/node_modules/lodash/index.js")
+		require.NoError(t, err)
+		assert.False(t, excluded)
+
+		all, err := s.Exclusions.GetBySourceID(ctx, "src-1")
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, domain.ExclusionPatternPrefix, all[0].PatternType)
+		assert.Equal(t, domain.ExclusionPatternExact, all[1].PatternType)
+	})
+
+	t.Run("duplicate ID is rejected", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		require.NoError(t, s.Exclusions.Add(ctx, exclusion("excl-1", "src-1")))
+
+		duplicate := exclusion("excl-1", "src-1")
+		duplicate.Reason = "replaced"
+		assert.Error(t, s.Exclusions.Add(ctx, duplicate))
+
+		all, err := s.Exclusions.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		assert.Equal(t, "reason for excl-1", all[0].Reason)
+	})
+
+	t.Run("add many is atomic", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		require.NoError(t, s.Exclusions.AddMany(ctx, nil))
+
+		err := s.Exclusions.AddMany(ctx, []*domain.Exclusion{
+			exclusion("excl-1", "src-1"),
+			exclusion("excl-2", "src-1"),
+			exclusion("excl-1", "src-1"),
+		})
+		assert.Error(t, err)
+
+		all, err := s.Exclusions.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+
+		require.NoError(t, s.Exclusions.AddMany(ctx, []*domain.Exclusion{
+			exclusion("excl-2", "src-1"),
+			exclusion("excl-1", "src-1"),
+		}))
+		all, err = s.Exclusions.List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"excl-2", "excl-1"}, exclusionIDs(all))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+		require.NoError(t, s.Exclusions.Add(ctx, exclusion("excl-1", "src-1")))
+
+		require.NoError(t, s.Exclusions.Remove(ctx, "excl-1"))
+		excluded, err := s.Exclusions.IsExcluded(ctx, "src-1", "file:///excl-1")
+		require.NoError(t, err)
+		assert.False(t, excluded)
+
+		assert.NoError(t, s.Exclusions.Remove(ctx, "missing"))
+	})
+
+	t.Run("failures count until cleared", func(t *testing.T) {
+		s := newStores(t)
+		saveSource(t, s, "src-1")
+
+		for want := 1; want <= 3; want++ {
+			got, err := s.Exclusions.RecordFailure(ctx, "src-1", "file:///bad", "parse error")
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+
+		require.NoError(t, s.Exclusions.ClearFailures(ctx, "src-1", "file:///bad"))
+		got, err := s.Exclusions.RecordFailure(ctx, "src-1", "file:///bad", "parse error")
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+	})
+}
+
+// RunSourceDelete tests what happens to a source's data when the source is deleted.
+func RunSourceDelete(t *testing.T, newStores NewStoresFunc, behaviour Behaviour) {
+	ctx := context.Background()
+	s := newStores(t)
+	saveSource(t, s, "src-1")
+	saveDocument(t, s, "doc-1", "src-1")
+	require.NoError(t, s.Documents.SaveChunks(ctx, []domain.Chunk{
+		{ID: "chunk-0", DocumentID: "doc-1", Content: "zero"},
+	}))
+	require.NoError(t, s.SyncStates.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1"}))
+	require.NoError(t, s.Exclusions.Add(ctx, exclusion("excl-1", "src-1")))
+
+	require.NoError(t, s.Sources.Delete(ctx, "src-1"))
+
+	_, docErr := s.Documents.GetDocument(ctx, "doc-1")
+	_, chunkErr := s.Documents.GetChunk(ctx, "chunk-0")
+	_, stateErr := s.SyncStates.Get(ctx, "src-1")
+	exclusions, err := s.Exclusions.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+
+	if behaviour.ForeignKeys {
+		// Deleting the source cascades to everything that references it
+		assert.ErrorIs(t, docErr, domain.ErrNotFound)
+		assert.ErrorIs(t, chunkErr, domain.ErrNotFound)
+		assert.ErrorIs(t, stateErr, domain.ErrNotFound)
+		assert.Empty(t, exclusions)
+		return
+	}
+
+	// Without foreign keys the data is left for the caller to clean up
+	assert.NoError(t, docErr)
+	assert.NoError(t, chunkErr)
+	assert.NoError(t, stateErr)
+	assert.Len(t, exclusions, 1)
+}
+
+// saveSource saves a test source with the given ID.
+func saveSource(t *testing.T, s Stores, id string) {
+	t.Helper()
+	require.NoError(t, s.Sources.Save(context.Background(), domain.Source{
+		ID:     id,
+		Type:   "test",
+		Name:   "Source " + id,
+		Config: map[string]string{"path": "/" + id},
+	}))
+}
+
+// saveDocument saves a test document with the given ID.
+func saveDocument(t *testing.T, s Stores, id, sourceID string) {
+	t.Helper()
+	require.NoError(t, s.Documents.SaveDocument(context.Background(), &domain.Document{
+		ID:       id,
+		SourceID: sourceID,
+		URI:      "file:///" + id,
+		Title:    "Document " + id,
+		Content:  "content of " + id,
+		Metadata: map[string]any{},
+	}))
+}
+
+// exclusion returns a test exclusion with the given ID.
+func exclusion(id, sourceID string) *domain.Exclusion {
+	return &domain.Exclusion{
+		ID:       id,
+		SourceID: sourceID,
+		URI:      "file:///" + id,
+		Category: domain.ExclusionReasonIrrelevant,
+		Reason:   "reason for " + id,
+	}
+}
+
+func sourceIDs(sources []domain.Source) []string {
+	ids := make([]string, len(sources))
+	for i := range sources {
+		ids[i] = sources[i].ID
+	}
+	return ids
+}
+
+func documentIDs(docs []domain.Document) []string {
+	ids := make([]string, len(docs))
+	for i := range docs {
+		ids[i] = docs[i].ID
+	}
+	return ids
+}
+
+func chunkIDs(chunks []domain.Chunk) []string {
+	ids := make([]string, len(chunks))
+	for i := range chunks {
+		ids[i] = chunks[i].ID
+	}
+	return ids
+}
+
+func exclusionIDs(exclusions []domain.Exclusion) []string {
+	ids := make([]string, len(exclusions))
+	for i := range exclusions {
+		ids[i] = exclusions[i].ID
+	}
+	return ids
+}
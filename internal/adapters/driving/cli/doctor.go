@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the configuration for problems",
+	Long: `Validates ~/.sercha/config.toml against its schema and lists every
+unknown setting or invalid value, so they can all be fixed in one pass.`,
+	Args: cobra.NoArgs,
+	RunE: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
+
+func runDoctor(cmd *cobra.Command, _ []string) error {
+	if settingsService == nil {
+		return errors.New("settings service not configured")
+	}
+
+	err := checkConfig(cmd)
+	reportIndexWarmth(cmd)
+	return err
+}
+
+// checkConfig validates the configuration and lists any problems.
+func checkConfig(cmd *cobra.Command) error {
+	err := settingsService.ValidateConfig()
+	if err == nil {
+		cmd.Println("Configuration OK")
+		return nil
+	}
+
+	var validationErr *domain.ConfigValidationError
+	if !errors.As(err, &validationErr) {
+		return fmt.Errorf("failed to validate configuration: %w", err)
+	}
+
+	cmd.Printf("Configuration problems in %s:\n\n", validationErr.Path)
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SETTING\tVALUE\tPROBLEM")
+	for _, fe := range validationErr.Errors {
+		value := fe.Value
+		if strings.Contains(fe.Field, "key") {
+			value = maskAPIKey(value)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", fe.Field, value, fe.Message)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	cmd.Println()
+
+	return fmt.Errorf("%d configuration problem(s) found", len(validationErr.Errors))
+}
+
+// reportIndexWarmth prints whether the vector index has been pre-loaded into memory,
+// waiting for a warm-up that is still running.
+func reportIndexWarmth(cmd *cobra.Command) {
+	if indexWarmer == nil {
+		return
+	}
+
+	if err := indexWarmer.Wait(cmd.Context()); err != nil {
+		cmd.Println("Vector index: warming up")
+		return
+	}
+
+	cmd.Printf("Vector index: %s\n", indexWarmthLabel(indexWarmer.Status()))
+}
+
+// indexWarmthLabel describes whether the vector index is loaded into memory.
+func indexWarmthLabel(status domain.WarmUpStatus) string {
+	switch status.State {
+	case domain.WarmUpUnavailable:
+		return "not loaded"
+	case domain.WarmUpDisabled:
+		return "cold (set vector_index.warm_up_on_start = true to pre-load it)"
+	case domain.WarmUpRunning:
+		return "warming up"
+	case domain.WarmUpDone:
+		return fmt.Sprintf("warm (loaded in %s)", status.Duration.Round(time.Millisecond))
+	case domain.WarmUpFailed:
+		return fmt.Sprintf("cold (warm-up failed: %s)", status.Error)
+	}
+	return string(status.State)
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// runDoctorCmd executes the doctor command with the given settings service.
+func runDoctorCmd(t *testing.T, svc *mockSettingsService) (string, error) {
+	t.Helper()
+	oldSettings := settingsService
+	settingsService = svc
+	defer func() { settingsService = oldSettings }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"doctor"})
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestDoctorCmd_Use(t *testing.T) {
+	assert.Equal(t, "doctor", doctorCmd.Use)
+}
+
+func TestDoctorCmd_ValidConfig(t *testing.T) {
+	out, err := runDoctorCmd(t, &mockSettingsService{})
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Configuration OK")
+}
+
+func TestDoctorCmd_ListsEveryProblem(t *testing.T) {
+	svc := &mockSettingsService{validateErr: &domain.ConfigValidationError{
+		Path: "/tmp/config.toml",
+		Errors: []domain.ConfigFieldError{
+			{Field: "embedding.workers", Value: "-2", Message: "must be a whole number of at least 0"},
+			{Field: "llm.apikey", Value: "sk-1234567890abcdef", Message: "unknown setting"},
+			{Field: "search.mode", Value: "fast", Message: "must be one of: text_only, hybrid"},
+		},
+	}}
+
+	out, err := runDoctorCmd(t, svc)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3 configuration problem(s) found")
+	assert.Contains(t, out, "/tmp/config.toml")
+	assert.Contains(t, out, "SETTING")
+	assert.Contains(t, out, "embedding.workers")
+	assert.Contains(t, out, "must be one of: text_only, hybrid")
+	assert.Contains(t, out, "sk-1...cdef")
+	assert.NotContains(t, out, "sk-1234567890abcdef")
+}
+
+func TestDoctorCmd_NoSettingsService(t *testing.T) {
+	oldSettings := settingsService
+	settingsService = nil
+	defer func() { settingsService = oldSettings }()
+
+	rootCmd.SetArgs([]string{"doctor"})
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "settings service not configured")
+}
+
+// mockIndexWarmer implements driving.IndexWarmer for testing.
+type mockIndexWarmer struct {
+	status  domain.WarmUpStatus
+	waitErr error
+	waited  bool
+}
+
+func (m *mockIndexWarmer) Start(_ context.Context) {}
+
+func (m *mockIndexWarmer) Wait(_ context.Context) error {
+	m.waited = true
+	return m.waitErr
+}
+
+func (m *mockIndexWarmer) Status() domain.WarmUpStatus {
+	return m.status
+}
+
+func TestDoctorCmd_ReportsIndexWarmth(t *testing.T) {
+	tests := []struct {
+		name     string
+		warmer   *mockIndexWarmer
+		expected string
+	}{
+		{"warm", &mockIndexWarmer{status: domain.WarmUpStatus{
+			State: domain.WarmUpDone, Duration: 42 * time.Millisecond,
+		}}, "Vector index: warm (loaded in 42ms)"},
+		{"disabled", &mockIndexWarmer{status: domain.WarmUpStatus{State: domain.WarmUpDisabled}},
+			"Vector index: cold (set vector_index.warm_up_on_start = true"},
+		{"failed", &mockIndexWarmer{status: domain.WarmUpStatus{State: domain.WarmUpFailed, Error: "boom"}},
+			"warm-up failed: boom"},
+		{"no index", &mockIndexWarmer{status: domain.WarmUpStatus{State: domain.WarmUpUnavailable}},
+			"Vector index: not loaded"},
+		{"still running", &mockIndexWarmer{waitErr: context.Canceled}, "Vector index: warming up"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldWarmer := indexWarmer
+			indexWarmer = tt.warmer
+			defer func() { indexWarmer = oldWarmer }()
+
+			out, err := runDoctorCmd(t, &mockSettingsService{})
+
+			require.NoError(t, err)
+			assert.True(t, tt.warmer.waited)
+			assert.Contains(t, out, "Configuration OK")
+			assert.Contains(t, out, tt.expected)
+		})
+	}
+}
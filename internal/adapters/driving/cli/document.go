@@ -4,14 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 var documentCmd = &cobra.Command{
-	Use:   "document",
-	Short: "Manage indexed documents",
-	Long:  `List, view, exclude, or refresh indexed documents.`,
+	Use:     "document",
+	Aliases: []string{"doc"},
+	Short:   "Manage indexed documents",
+	Long:    `List, view, inspect, exclude, or refresh indexed documents.`,
 }
 
 var documentListCmd = &cobra.Command{
@@ -42,12 +49,26 @@ var documentDetailsCmd = &cobra.Command{
 	RunE:  runDocumentDetails,
 }
 
+var documentInspectCmd = &cobra.Command{
+	Use:   "inspect [doc-id]",
+	Short: "Show how a document was chunked and embedded",
+	Long: `Prints each chunk of a document with its position, a content preview and
+its metadata. Use --embeddings to also show the dimensions and norm of each
+chunk's embedding vector.
+
+Useful for diagnosing poor retrieval and tuning chunking.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDocumentInspect,
+}
+
 var documentExcludeCmd = &cobra.Command{
-	Use:   "exclude [doc-id]",
-	Short: "Exclude document from index",
-	Long:  `Removes a document from the index and marks it to be skipped during future syncs.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runDocumentExclude,
+	Use:   "exclude [doc-id...]",
+	Short: "Exclude documents from index",
+	Long: `Removes documents from the index and marks them to be skipped during future syncs.
+
+Categories: sensitive, duplicate, irrelevant, user_requested (default).`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runDocumentExclude,
 }
 
 var documentRefreshCmd = &cobra.Command{
@@ -64,16 +85,30 @@ var documentOpenCmd = &cobra.Command{
 	RunE:  runDocumentOpen,
 }
 
-// excludeReason is a flag for the exclude command.
-var excludeReason string
+// excludeReason and excludeCategory are flags for the exclude command.
+var (
+	excludeReason   string
+	excludeCategory string
+)
+
+// inspectEmbeddings shows embedding details in the inspect command.
+var inspectEmbeddings bool
+
+// chunkPreviewLength is the maximum number of characters shown per chunk.
+const chunkPreviewLength = 80
 
 func init() {
 	documentExcludeCmd.Flags().StringVarP(&excludeReason, "reason", "r", "", "Reason for excluding the document")
+	documentExcludeCmd.Flags().StringVarP(&excludeCategory, "category", "c",
+		string(domain.ExclusionReasonUserRequested), "Exclusion category")
+	documentInspectCmd.Flags().BoolVarP(&inspectEmbeddings, "embeddings", "e", false,
+		"Show embedding dimensions and norms")
 
 	documentCmd.AddCommand(documentListCmd)
 	documentCmd.AddCommand(documentGetCmd)
 	documentCmd.AddCommand(documentContentCmd)
 	documentCmd.AddCommand(documentDetailsCmd)
+	documentCmd.AddCommand(documentInspectCmd)
 	documentCmd.AddCommand(documentExcludeCmd)
 	documentCmd.AddCommand(documentRefreshCmd)
 	documentCmd.AddCommand(documentOpenCmd)
@@ -191,7 +226,7 @@ func runDocumentDetails(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runDocumentExclude(cmd *cobra.Command, args []string) error {
+func runDocumentInspect(cmd *cobra.Command, args []string) error {
 	if documentService == nil {
 		return errors.New("document service not configured")
 	}
@@ -199,19 +234,113 @@ func runDocumentExclude(cmd *cobra.Command, args []string) error {
 	docID := args[0]
 	ctx := context.Background()
 
+	doc, err := documentService.Get(ctx, docID)
+	if err != nil {
+		return fmt.Errorf("failed to get document: %w", err)
+	}
+
+	chunks, err := documentService.GetChunks(ctx, docID)
+	if err != nil {
+		return fmt.Errorf("failed to get chunks: %w", err)
+	}
+
+	cmd.Printf("Document: %s (%s)\n", doc.Title, doc.ID)
+	cmd.Printf("Chunks:   %d\n", len(chunks))
+
+	for i := range chunks {
+		chunk := &chunks[i]
+		cmd.Printf("\n[%d] %s\n", chunk.Position, chunk.ID)
+		cmd.Printf("  Content:   %s\n", chunkPreview(chunk.Content, chunkPreviewLength))
+		cmd.Printf("  Length:    %d chars\n", utf8.RuneCountInString(chunk.Content))
+		if len(chunk.Metadata) > 0 {
+			cmd.Printf("  Metadata:  %s\n", formatChunkMetadata(chunk.Metadata))
+		}
+		if inspectEmbeddings {
+			if len(chunk.Embedding) == 0 {
+				cmd.Println("  Embedding: none")
+			} else {
+				cmd.Printf("  Embedding: %d dims, norm %.4f\n", len(chunk.Embedding), vectorNorm(chunk.Embedding))
+			}
+		}
+	}
+
+	return nil
+}
+
+// chunkPreview collapses whitespace and shortens content to maxLen characters.
+func chunkPreview(content string, maxLen int) string {
+	preview := strings.Join(strings.Fields(content), " ")
+	runes := []rune(preview)
+	if len(runes) <= maxLen {
+		return preview
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
+
+// formatChunkMetadata renders metadata as sorted key=value pairs.
+func formatChunkMetadata(metadata map[string]any) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%v", k, metadata[k])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// vectorNorm returns the Euclidean length of an embedding vector.
+func vectorNorm(vec []float32) float64 {
+	var sum float64
+	for _, v := range vec {
+		sum += float64(v) * float64(v)
+	}
+	return math.Sqrt(sum)
+}
+
+func runDocumentExclude(cmd *cobra.Command, args []string) error {
+	if documentService == nil {
+		return errors.New("document service not configured")
+	}
+
+	category := domain.ExclusionReason(excludeCategory)
+	if !category.IsValid() {
+		return fmt.Errorf("invalid category %q: must be one of %s", excludeCategory, exclusionCategories())
+	}
+
+	ctx := context.Background()
+
 	reason := excludeReason
 	if reason == "" {
 		reason = "excluded via CLI"
 	}
 
-	if err := documentService.Exclude(ctx, docID, reason); err != nil {
+	count, err := documentService.ExcludeMany(ctx, args, category, reason)
+	if err != nil {
 		return fmt.Errorf("failed to exclude document: %w", err)
 	}
 
-	cmd.Printf("Document %s excluded from index.\n", docID)
+	if len(args) == 1 && count == 1 {
+		cmd.Printf("Document %s excluded from index.\n", args[0])
+	} else {
+		cmd.Printf("%d of %d documents excluded from index.\n", count, len(args))
+	}
 	return nil
 }
 
+// exclusionCategories returns the valid exclusion categories as a comma-separated list.
+func exclusionCategories() string {
+	reasons := domain.AllExclusionReasons()
+	names := make([]string, len(reasons))
+	for i, r := range reasons {
+		names[i] = r.String()
+	}
+	return strings.Join(names, ", ")
+}
+
 func runDocumentRefresh(cmd *cobra.Command, args []string) error {
 	if documentService == nil {
 		return errors.New("document service not configured")
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 // Document Command Tests
@@ -193,13 +196,83 @@ func TestDocumentDetailsCmd_ExecutesWithArg(t *testing.T) {
 	assert.Contains(t, buf.String(), "Chunks:")
 }
 
+// Document Inspect Tests
+
+func TestDocumentInspectCmd_Use(t *testing.T) {
+	assert.Equal(t, "inspect [doc-id]", documentInspectCmd.Use)
+}
+
+func TestDocumentInspectCmd_PrintsChunks(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"doc", "inspect", "doc-1"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.NoError(t, err)
+	out := buf.String()
+	assert.Contains(t, out, "Chunks:   2")
+	assert.Contains(t, out, "[0] chunk-1")
+	assert.Contains(t, out, "First chunk of the test document")
+	assert.Contains(t, out, "Metadata:  heading=Intro")
+	assert.NotContains(t, out, "Embedding:")
+}
+
+func TestDocumentInspectCmd_WithEmbeddings(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	defer func() { inspectEmbeddings = false }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"document", "inspect", "doc-1", "--embeddings"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "Embedding: 2 dims, norm 5.0000")
+	assert.Contains(t, buf.String(), "Embedding: none")
+}
+
+func TestDocumentInspectCmd_Error(t *testing.T) {
+	oldDoc := documentService
+	documentService = &mockDocumentServiceError{}
+	defer func() { documentService = oldDoc }()
+
+	rootCmd.SetOut(new(bytes.Buffer))
+	rootCmd.SetArgs([]string{"document", "inspect", "missing"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get document")
+}
+
+func TestChunkPreview(t *testing.T) {
+	assert.Equal(t, "short text", chunkPreview("short\n  text", 80))
+	assert.Equal(t, "abcdefg...", chunkPreview("abcdefghijklmnop", 10))
+	assert.Equal(t, "日本語日本語日...", chunkPreview("日本語日本語日本語日本語", 10))
+}
+
 // Document Exclude Tests
 
 func TestDocumentExcludeCmd_Use(t *testing.T) {
-	assert.Equal(t, "exclude [doc-id]", documentExcludeCmd.Use)
+	assert.Equal(t, "exclude [doc-id...]", documentExcludeCmd.Use)
 }
 
-func TestDocumentExcludeCmd_RequiresExactlyOneArg(t *testing.T) {
+func TestDocumentExcludeCmd_RequiresAtLeastOneArg(t *testing.T) {
 	buf := new(bytes.Buffer)
 	rootCmd.SetOut(buf)
 	rootCmd.SetErr(buf)
@@ -211,7 +284,7 @@ func TestDocumentExcludeCmd_RequiresExactlyOneArg(t *testing.T) {
 	err := rootCmd.Execute()
 
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "accepts 1 arg(s)")
+	assert.Contains(t, err.Error(), "requires at least 1 arg(s)")
 }
 
 func TestDocumentExcludeCmd_ExecutesWithArg(t *testing.T) {
@@ -249,6 +322,43 @@ func TestDocumentExcludeCmd_WithReasonFlag(t *testing.T) {
 	assert.Contains(t, buf.String(), "excluded from index")
 }
 
+func TestDocumentExcludeCmd_MultipleArgs(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"document", "exclude", "doc-1", "doc-2", "--category", "duplicate"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		excludeCategory = string(domain.ExclusionReasonUserRequested) // Reset flag
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "2 of 2 documents excluded from index")
+}
+
+func TestDocumentExcludeCmd_InvalidCategory(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"document", "exclude", "doc-1", "--category", "bogus"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		excludeCategory = string(domain.ExclusionReasonUserRequested) // Reset flag
+	}()
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid category")
+}
+
 // Document Refresh Tests
 
 func TestDocumentRefreshCmd_Use(t *testing.T) {
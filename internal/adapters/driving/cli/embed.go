@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var embedWait bool
+
+// errEmbeddingsDisabled is returned when no embedding provider is configured.
+var errEmbeddingsDisabled = errors.New(
+	"embeddings not configured: run 'sercha settings wizard' to set up an embedding provider")
+
+var embedCmd = &cobra.Command{
+	Use:   "embed",
+	Short: "Show or process the background embedding queue",
+	Long: `Shows how many chunks are waiting for an embedding.
+
+Sync stores chunks for keyword search straight away and queues them for
+embedding, which runs in the background while the TUI is open. Chunks that
+fail to embed are retried with backoff before they are given up on.
+Use --wait to process the queue now and block until it is empty.`,
+	Args: cobra.NoArgs,
+	RunE: runEmbed,
+}
+
+func init() {
+	embedCmd.Flags().BoolVar(&embedWait, "wait", false, "process queued embeddings and wait until the queue is empty")
+	rootCmd.AddCommand(embedCmd)
+}
+
+func runEmbed(cmd *cobra.Command, _ []string) error {
+	if embeddingQueue == nil {
+		return errEmbeddingsDisabled
+	}
+
+	ctx := cmd.Context()
+
+	stats, err := embeddingQueue.Stats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count pending embeddings: %w", err)
+	}
+
+	if !embedWait {
+		printEmbeddingStats(cmd, stats)
+		return nil
+	}
+
+	if stats.Ready() > 0 {
+		cmd.Printf("Embedding %d queued chunks...\n", stats.Ready())
+	}
+	if err := embeddingQueue.Wait(ctx); err != nil {
+		return fmt.Errorf("embedding failed: %w", err)
+	}
+
+	stats, err = embeddingQueue.Stats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count pending embeddings: %w", err)
+	}
+	if stats.Retrying > 0 {
+		cmd.Printf("%d chunks failed to embed and will be retried in the background.\n", stats.Retrying)
+		return nil
+	}
+	cmd.Println("Embedding queue is empty.")
+	return nil
+}
+
+// printEmbeddingStats prints the size of the embedding queue, with the
+// chunks waiting to be retried and given up on when there are any.
+func printEmbeddingStats(cmd *cobra.Command, stats domain.EmbeddingQueueStats) {
+	cmd.Printf("Pending embeddings: %d\n", stats.Pending)
+	if stats.Retrying > 0 {
+		cmd.Printf("Waiting to retry: %d\n", stats.Retrying)
+	}
+	if stats.Failed > 0 {
+		cmd.Printf("Failed embeddings: %d\n", stats.Failed)
+	}
+}
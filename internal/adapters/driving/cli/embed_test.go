@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockEmbeddingQueue implements driving.EmbeddingQueue for testing.
+type mockEmbeddingQueue struct {
+	pending    int
+	retrying   int
+	failed     int
+	pendingErr error
+	waitErr    error
+	waited     bool
+}
+
+func (m *mockEmbeddingQueue) Start(_ context.Context) error {
+	return nil
+}
+
+func (m *mockEmbeddingQueue) Pending(_ context.Context) (int, error) {
+	return m.pending, m.pendingErr
+}
+
+func (m *mockEmbeddingQueue) Stats(_ context.Context) (domain.EmbeddingQueueStats, error) {
+	return domain.EmbeddingQueueStats{Pending: m.pending, Retrying: m.retrying, Failed: m.failed}, m.pendingErr
+}
+
+func (m *mockEmbeddingQueue) Wait(_ context.Context) error {
+	m.waited = true
+	if m.waitErr != nil {
+		return m.waitErr
+	}
+	// Retries are left to the background worker
+	m.pending = m.retrying
+	return nil
+}
+
+// runEmbedCmd executes the embed command with the given queue and arguments.
+func runEmbedCmd(t *testing.T, queue *mockEmbeddingQueue, args ...string) (string, error) {
+	t.Helper()
+	oldQueue := embeddingQueue
+	// Avoid storing a typed nil in the interface
+	embeddingQueue = nil
+	if queue != nil {
+		embeddingQueue = queue
+	}
+	defer func() {
+		embeddingQueue = oldQueue
+		embedWait = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"embed"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestEmbedCmd_Use(t *testing.T) {
+	assert.Equal(t, "embed", embedCmd.Use)
+}
+
+func TestEmbedCmd_ShowsPending(t *testing.T) {
+	queue := &mockEmbeddingQueue{pending: 12}
+
+	out, err := runEmbedCmd(t, queue)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Pending embeddings: 12")
+	assert.False(t, queue.waited)
+}
+
+func TestEmbedCmd_Wait(t *testing.T) {
+	queue := &mockEmbeddingQueue{pending: 3}
+
+	out, err := runEmbedCmd(t, queue, "--wait")
+
+	require.NoError(t, err)
+	assert.True(t, queue.waited)
+	assert.Contains(t, out, "Embedding 3 queued chunks")
+	assert.Contains(t, out, "Embedding queue is empty.")
+}
+
+func TestEmbedCmd_ShowsRetries(t *testing.T) {
+	queue := &mockEmbeddingQueue{pending: 5, retrying: 2, failed: 1}
+
+	out, err := runEmbedCmd(t, queue)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Pending embeddings: 5")
+	assert.Contains(t, out, "Waiting to retry: 2")
+	assert.Contains(t, out, "Failed embeddings: 1")
+}
+
+func TestEmbedCmd_Wait_LeavesRetries(t *testing.T) {
+	queue := &mockEmbeddingQueue{pending: 5, retrying: 2}
+
+	out, err := runEmbedCmd(t, queue, "--wait")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Embedding 3 queued chunks")
+	assert.Contains(t, out, "2 chunks failed to embed and will be retried in the background.")
+	assert.NotContains(t, out, "Embedding queue is empty.")
+}
+
+func TestEmbedCmd_WaitError(t *testing.T) {
+	queue := &mockEmbeddingQueue{pending: 1, waitErr: errors.New("provider down")}
+
+	_, err := runEmbedCmd(t, queue, "--wait")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "provider down")
+}
+
+func TestEmbedCmd_PendingError(t *testing.T) {
+	queue := &mockEmbeddingQueue{pendingErr: errors.New("database locked")}
+
+	_, err := runEmbedCmd(t, queue)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "database locked")
+}
+
+func TestEmbedCmd_NotConfigured(t *testing.T) {
+	_, err := runEmbedCmd(t, nil)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errEmbeddingsDisabled)
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var exclusionCmd = &cobra.Command{
+	Use:   "exclusion",
+	Short: "Manage exclusions",
+	Long:  `Manage the rules that keep documents out of the index.`,
+}
+
+var exclusionAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Exclude documents matching a URI pattern",
+	Long: `Removes matching documents of a source from the index and skips them during future syncs.
+
+A pattern is either an exact document URI or a URI prefix written as
+'prefix:<uri>', which excludes a whole subtree:
+
+  sercha exclusion add --source my-docs --pattern 'prefix:file:///home/user/node_modules'
+
+Categories: sensitive, duplicate, irrelevant, user_requested (default).`,
+	Args: cobra.NoArgs,
+	RunE: runExclusionAdd,
+}
+
+// Flags for the exclusion add command.
+var (
+	exclusionSource   string
+	exclusionPattern  string
+	exclusionCategory string
+	exclusionReason   string
+)
+
+func init() {
+	exclusionAddCmd.Flags().StringVarP(&exclusionSource, "source", "s", "", "Source ID the pattern applies to")
+	exclusionAddCmd.Flags().StringVarP(&exclusionPattern, "pattern", "p", "",
+		"URI or 'prefix:<uri>' pattern to exclude")
+	exclusionAddCmd.Flags().StringVarP(&exclusionCategory, "category", "c",
+		string(domain.ExclusionReasonUserRequested), "Exclusion category")
+	exclusionAddCmd.Flags().StringVarP(&exclusionReason, "reason", "r", "", "Reason for the exclusion")
+
+	exclusionCmd.AddCommand(exclusionAddCmd)
+	rootCmd.AddCommand(exclusionCmd)
+}
+
+func runExclusionAdd(cmd *cobra.Command, _ []string) error {
+	if documentService == nil {
+		return errors.New("document service not configured")
+	}
+	if exclusionSource == "" || exclusionPattern == "" {
+		return errors.New("both --source and --pattern are required")
+	}
+
+	category := domain.ExclusionReason(exclusionCategory)
+	if !category.IsValid() {
+		return fmt.Errorf("invalid category %q: must be one of %s", exclusionCategory, exclusionCategories())
+	}
+
+	reason := exclusionReason
+	if reason == "" {
+		reason = "excluded via CLI"
+	}
+
+	ctx := context.Background()
+	removed, err := documentService.ExcludePattern(ctx, exclusionSource, exclusionPattern, category, reason)
+	if err != nil {
+		return fmt.Errorf("failed to add exclusion: %w", err)
+	}
+
+	cmd.Printf("Exclusion %s added to source %s; %d documents removed from index.\n",
+		exclusionPattern, exclusionSource, removed)
+	return nil
+}
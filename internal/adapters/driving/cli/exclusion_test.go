@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// resetExclusionFlags restores the exclusion add flags to their defaults.
+func resetExclusionFlags() {
+	exclusionSource = ""
+	exclusionPattern = ""
+	exclusionCategory = string(domain.ExclusionReasonUserRequested)
+	exclusionReason = ""
+}
+
+func TestExclusionAddCmd_Use(t *testing.T) {
+	assert.Equal(t, "add", exclusionAddCmd.Use)
+	assert.NotNil(t, exclusionAddCmd.Flags().Lookup("source"))
+	assert.NotNil(t, exclusionAddCmd.Flags().Lookup("pattern"))
+	assert.NotNil(t, exclusionAddCmd.Flags().Lookup("category"))
+	assert.NotNil(t, exclusionAddCmd.Flags().Lookup("reason"))
+}
+
+func TestExclusionAddCmd_PrefixPattern(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{
+		"exclusion", "add", "--source", "src-1", "--pattern", "prefix:file:///home/user/node_modules",
+	})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		resetExclusionFlags()
+	}()
+
+	err := rootCmd.Execute()
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "prefix:file:///home/user/node_modules")
+	assert.Contains(t, buf.String(), "3 documents removed")
+}
+
+func TestExclusionAddCmd_MissingFlags(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"exclusion", "add", "--source", "src-1"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		resetExclusionFlags()
+	}()
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--pattern are required")
+}
+
+func TestExclusionAddCmd_InvalidCategory(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{
+		"exclusion", "add", "--source", "src-1", "--pattern", "file:///a.txt", "--category", "bogus",
+	})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		resetExclusionFlags()
+	}()
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid category")
+}
+
+func TestExclusionAddCmd_ServiceError(t *testing.T) {
+	oldService := documentService
+	documentService = &mockDocumentServiceError{}
+	defer func() {
+		documentService = oldService
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"exclusion", "add", "--source", "src-1", "--pattern", "file:///a.txt"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		resetExclusionFlags()
+	}()
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to add exclusion")
+}
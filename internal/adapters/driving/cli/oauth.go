@@ -6,10 +6,10 @@ import (
 	"html"
 	"net"
 	"net/http"
-	"os/exec"
-	"runtime"
 	"sync"
 	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/platform"
 )
 
 // OAuthCallbackServer handles OAuth redirect callbacks.
@@ -188,20 +188,7 @@ func successHTML(title, message string) string {
 
 // OpenBrowser opens the default browser to the given URL.
 func OpenBrowser(url string) error {
-	var cmd *exec.Cmd
-
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", url)
-	case "linux":
-		cmd = exec.Command("xdg-open", url)
-	case "windows":
-		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
-	default:
-		return fmt.Errorf("unsupported platform: %s", runtime.GOOS)
-	}
-
-	return cmd.Start()
+	return platform.OpenURI(url)
 }
 
 // FindAvailablePort finds an available port in the given range.
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune [source-id]",
+	Short: "Delete documents older than a source's retention window",
+	Long: `Deletes documents that have not been updated within their source's
+retention window, along with their chunks and index entries.
+
+Set a retention window with the retain config key when adding a source,
+e.g. -c retain=180d. It accepts days, weeks or years (180d, 26w, 1y) or a
+duration (720h). Sources with a retention window are also pruned after
+each sync.
+
+If a source ID is provided, only that source is pruned, and it must have a
+retention window. Otherwise, every source with a retention window is pruned
+and the rest are left untouched.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPrune,
+}
+
+func init() {
+	rootCmd.AddCommand(pruneCmd)
+}
+
+func runPrune(cmd *cobra.Command, args []string) error {
+	if syncOrchestrator == nil {
+		return errors.New("sync service not configured")
+	}
+	pruner, ok := syncOrchestrator.(driving.PruningSync)
+	if !ok {
+		return errors.New("pruning is not supported by the sync service")
+	}
+
+	ctx := cmd.Context()
+
+	if len(args) > 0 {
+		sourceID := args[0]
+		pruned, err := pruner.Prune(ctx, sourceID)
+		if err != nil {
+			return fmt.Errorf("prune failed: %w", err)
+		}
+		cmd.Printf("Pruned %d documents from source %s.\n", pruned, sourceID)
+		return nil
+	}
+
+	pruned, err := pruner.PruneAll(ctx)
+	if err != nil {
+		return fmt.Errorf("prune failed after %d documents: %w", pruned, err)
+	}
+	cmd.Printf("Pruned %d documents.\n", pruned)
+	return nil
+}
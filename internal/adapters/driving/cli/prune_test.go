@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// mockPruningSync is a sync orchestrator that records prune calls.
+type mockPruningSync struct {
+	mockSyncOrchestrator
+	prunedSource string
+	prunedAll    bool
+}
+
+func (m *mockPruningSync) Prune(_ context.Context, sourceID string) (int, error) {
+	if sourceID == "no-retention" {
+		return 0, fmt.Errorf("%w: source %s has no retention set", domain.ErrInvalidInput, sourceID)
+	}
+	m.prunedSource = sourceID
+	return 3, nil
+}
+
+func (m *mockPruningSync) PruneAll(_ context.Context) (int, error) {
+	m.prunedAll = true
+	return 7, nil
+}
+
+// executePrune runs the prune command with the given orchestrator.
+func executePrune(t *testing.T, orchestrator driving.SyncOrchestrator, args ...string) (string, error) {
+	t.Helper()
+	oldSync := syncOrchestrator
+	syncOrchestrator = orchestrator
+	defer func() { syncOrchestrator = oldSync }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"prune"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestPruneCmd_Use(t *testing.T) {
+	assert.Equal(t, "prune [source-id]", pruneCmd.Use)
+}
+
+func TestPruneCmd_SingleSource(t *testing.T) {
+	mock := &mockPruningSync{}
+
+	out, err := executePrune(t, mock, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", mock.prunedSource)
+	assert.False(t, mock.prunedAll)
+	assert.Contains(t, out, "Pruned 3 documents from source src-1.")
+}
+
+func TestPruneCmd_AllSources(t *testing.T) {
+	mock := &mockPruningSync{}
+
+	out, err := executePrune(t, mock)
+
+	require.NoError(t, err)
+	assert.True(t, mock.prunedAll)
+	assert.Contains(t, out, "Pruned 7 documents.")
+}
+
+func TestPruneCmd_NoRetention(t *testing.T) {
+	_, err := executePrune(t, &mockPruningSync{}, "no-retention")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no retention set")
+}
+
+func TestPruneCmd_Unsupported(t *testing.T) {
+	_, err := executePrune(t, &mockSyncOrchestratorFull{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pruning is not supported")
+}
+
+func TestPruneCmd_ServiceNotConfigured(t *testing.T) {
+	_, err := executePrune(t, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sync service not configured")
+}
@@ -1,8 +1,15 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
@@ -14,6 +21,20 @@ var (
 	// Verbose enables debug logging.
 	verbose bool
 
+	// logLevel sets the minimum log level (overrides SERCHA_LOG_LEVEL).
+	logLevel string
+
+	// logFile enables structured logging to ~/.sercha/logs/.
+	logFile bool
+
+	// safeMode is only declared here so the flag is accepted and documented;
+	// the composition root reads it with SafeModeRequested before parsing.
+	safeMode bool
+
+	// disabledSubsystems lists the subsystems safe mode started without,
+	// shown in a banner.
+	disabledSubsystems []domain.DisabledSubsystem
+
 	// Services holds injected service implementations for CLI commands.
 	searchService       driving.SearchService
 	sourceService       driving.SourceService
@@ -24,6 +45,13 @@ var (
 	settingsService     driving.SettingsService
 	authProviderService driving.AuthProviderService
 	credentialsService  driving.CredentialsService
+	embeddingQueue      driving.EmbeddingQueue
+	integrityService    driving.IntegrityService
+	indexWarmer         driving.IndexWarmer
+	statusService       driving.StatusService
+
+	// dataDir is the directory holding the metadata database and indexes.
+	dataDir string
 )
 
 // Services holds configuration for CLI commands.
@@ -37,6 +65,14 @@ type Services struct {
 	Settings          driving.SettingsService
 	AuthProvider      driving.AuthProviderService
 	Credentials       driving.CredentialsService
+	Embeddings        driving.EmbeddingQueue
+	Integrity         driving.IntegrityService
+	IndexWarmer       driving.IndexWarmer
+	Status            driving.StatusService
+
+	// DataDir is the directory holding the metadata database and indexes,
+	// shown by sercha status.
+	DataDir string
 }
 
 // SetServices injects service implementations for CLI commands.
@@ -53,6 +89,11 @@ func SetServices(s *Services) {
 	settingsService = s.Settings
 	authProviderService = s.AuthProvider
 	credentialsService = s.Credentials
+	embeddingQueue = s.Embeddings
+	integrityService = s.Integrity
+	indexWarmer = s.IndexWarmer
+	statusService = s.Status
+	dataDir = s.DataDir
 }
 
 // rootCmd is the base command.
@@ -70,17 +111,104 @@ func Execute() error {
 	return rootCmd.Execute()
 }
 
+// ExecuteContext runs the root command with ctx. Long-running commands stop
+// when ctx is cancelled, e.g. on an interrupt.
+func ExecuteContext(ctx context.Context) error {
+	return rootCmd.ExecuteContext(ctx)
+}
+
 // SetVersion sets the version string for the CLI.
 func SetVersion(v string) {
 	version = v
 }
 
+// SetDisabledSubsystems sets the subsystems the app started without, shown
+// in a banner before each command and in the TUI.
+func SetDisabledSubsystems(disabled []domain.DisabledSubsystem) {
+	disabledSubsystems = disabled
+}
+
+// logLevelEnv is the environment variable used when --log-level is not set.
+const logLevelEnv = "SERCHA_LOG_LEVEL"
+
+// safeModeEnv is the environment variable that enables safe mode like --safe-mode.
+const safeModeEnv = "SERCHA_SAFE_MODE"
+
+// SafeModeRequested reports whether args (without the program name) or the
+// environment ask for safe mode. The services are built before the command
+// line is parsed, so the flag is looked up directly.
+func SafeModeRequested(args []string) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		name, value, hasValue := strings.Cut(arg, "=")
+		if name != "--safe-mode" {
+			continue
+		}
+		if !hasValue {
+			return true
+		}
+		enabled, err := strconv.ParseBool(value)
+		return err == nil && enabled
+	}
+	enabled, err := strconv.ParseBool(os.Getenv(safeModeEnv))
+	return err == nil && enabled
+}
+
 func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose debug output")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "",
+		"log level: debug, info, warn or error (default from "+logLevelEnv+")")
+	rootCmd.PersistentFlags().BoolVar(&logFile, "log-file", false, "write structured logs to ~/.sercha/logs/")
+	rootCmd.PersistentFlags().BoolVar(&safeMode, "safe-mode", false,
+		"start without vector search, embeddings or LLM features (also "+safeModeEnv+")")
+
+	// Use PersistentPreRunE to configure logging before any command executes
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		printSafeModeBanner(cmd)
+		return configureLogging()
+	}
+}
+
+// printSafeModeBanner tells the user which subsystems are off and why.
+func printSafeModeBanner(cmd *cobra.Command) {
+	if len(disabledSubsystems) == 0 {
+		return
+	}
+	cmd.PrintErrln("Safe mode: running without some features.")
+	for _, disabled := range disabledSubsystems {
+		cmd.PrintErrf("  - %s: %s\n", disabled.Name, disabled.Reason)
+	}
+}
 
-	// Use PersistentPreRunE to set verbose mode before any command executes
-	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		logger.SetVerbose(verbose)
-		return nil
+// configureLogging applies the logging flags and environment to the shared logger.
+// An explicit log level also enables console output, like --verbose does.
+func configureLogging() error {
+	logger.SetVerbose(verbose)
+
+	levelName := logLevel
+	if levelName == "" {
+		levelName = os.Getenv(logLevelEnv)
+	}
+	if levelName != "" {
+		level, err := logger.ParseLevel(levelName)
+		if err != nil {
+			return err
+		}
+		logger.SetLevel(level)
+		logger.SetConsole(true)
 	}
+
+	if logFile {
+		dir, err := logger.DefaultDir()
+		if err != nil {
+			return fmt.Errorf("failed to resolve log directory: %w", err)
+		}
+		if _, err := logger.OpenFile(dir); err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+	}
+
+	return nil
 }
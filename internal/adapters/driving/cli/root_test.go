@@ -2,9 +2,17 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"log/slog"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
 func TestSetVersion(t *testing.T) {
@@ -65,6 +73,22 @@ func TestExecute_ReturnsNoErrorWithHelp(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestExecuteContext_ReturnsNoErrorWithHelp(t *testing.T) {
+	oldOut := rootCmd.OutOrStdout()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"--help"})
+	defer func() {
+		rootCmd.SetOut(oldOut)
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := ExecuteContext(context.Background())
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "sercha")
+}
+
 func TestSetServices_WithNilServices(t *testing.T) {
 	// Save current state
 	oldSearch := searchService
@@ -141,3 +165,117 @@ func TestSetServices_WithValidServices(t *testing.T) {
 	assert.NotNil(t, syncOrchestrator)
 	assert.NotNil(t, documentService)
 }
+
+func TestRootCmd_HasLoggingFlags(t *testing.T) {
+	assert.NotNil(t, rootCmd.PersistentFlags().Lookup("log-level"))
+	assert.NotNil(t, rootCmd.PersistentFlags().Lookup("log-file"))
+}
+
+func TestConfigureLogging_LevelFlag(t *testing.T) {
+	oldLevel, oldVerbose := logLevel, verbose
+	defer func() {
+		logLevel, verbose = oldLevel, oldVerbose
+		logger.SetVerbose(false)
+	}()
+
+	verbose = false
+	logLevel = "warn"
+
+	require.NoError(t, configureLogging())
+	assert.Equal(t, slog.LevelWarn, logger.Level())
+}
+
+func TestConfigureLogging_LevelFromEnv(t *testing.T) {
+	oldLevel, oldVerbose := logLevel, verbose
+	defer func() {
+		logLevel, verbose = oldLevel, oldVerbose
+		logger.SetVerbose(false)
+	}()
+	t.Setenv(logLevelEnv, "error")
+
+	verbose = false
+	logLevel = ""
+
+	require.NoError(t, configureLogging())
+	assert.Equal(t, slog.LevelError, logger.Level())
+}
+
+func TestConfigureLogging_FlagOverridesEnv(t *testing.T) {
+	oldLevel, oldVerbose := logLevel, verbose
+	defer func() {
+		logLevel, verbose = oldLevel, oldVerbose
+		logger.SetVerbose(false)
+	}()
+	t.Setenv(logLevelEnv, "error")
+
+	verbose = false
+	logLevel = "debug"
+
+	require.NoError(t, configureLogging())
+	assert.Equal(t, slog.LevelDebug, logger.Level())
+}
+
+func TestConfigureLogging_InvalidLevel(t *testing.T) {
+	oldLevel, oldVerbose := logLevel, verbose
+	defer func() {
+		logLevel, verbose = oldLevel, oldVerbose
+		logger.SetVerbose(false)
+	}()
+
+	verbose = false
+	logLevel = "loud"
+
+	err := configureLogging()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid log level")
+}
+
+func TestConfigureLogging_LogFile(t *testing.T) {
+	oldFile, oldLevel, oldVerbose := logFile, logLevel, verbose
+	defer func() {
+		logFile, logLevel, verbose = oldFile, oldLevel, oldVerbose
+		_ = logger.Close()
+		logger.SetVerbose(false)
+	}()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	verbose = false
+	logLevel = ""
+	logFile = true
+
+	require.NoError(t, configureLogging())
+	assert.FileExists(t, filepath.Join(home, ".sercha", "logs", logger.FileName))
+}
+
+func TestSafeModeRequested(t *testing.T) {
+	t.Setenv(safeModeEnv, "")
+
+	assert.True(t, SafeModeRequested([]string{"--safe-mode", "tui"}))
+	assert.True(t, SafeModeRequested([]string{"search", "notes", "--safe-mode=true"}))
+	assert.False(t, SafeModeRequested([]string{"--safe-mode=false"}))
+	assert.False(t, SafeModeRequested([]string{"tui"}))
+	// Arguments after -- are not flags
+	assert.False(t, SafeModeRequested([]string{"search", "--", "--safe-mode"}))
+
+	t.Setenv(safeModeEnv, "1")
+	assert.True(t, SafeModeRequested([]string{"tui"}))
+}
+
+func TestPrintSafeModeBanner(t *testing.T) {
+	originalDisabled := disabledSubsystems
+	defer SetDisabledSubsystems(originalDisabled)
+
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetErr(buf)
+
+	SetDisabledSubsystems(nil)
+	printSafeModeBanner(cmd)
+	assert.Empty(t, buf.String())
+
+	SetDisabledSubsystems([]domain.DisabledSubsystem{{Name: "Vector search", Reason: "disabled by --safe-mode"}})
+	printSafeModeBanner(cmd)
+	assert.Contains(t, buf.String(), "Safe mode")
+	assert.Contains(t, buf.String(), "  - Vector search: disabled by --safe-mode")
+}
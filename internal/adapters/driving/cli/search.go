@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -12,15 +13,45 @@ import (
 )
 
 var (
-	searchLimit int
-	searchJSON  bool
+	searchLimit       int
+	searchJSON        bool
+	searchMode        string
+	searchSources     []string
+	searchCollection  string
+	searchSort        string
+	searchInteractive bool
+	searchChunks      bool
+	searchAuthors     []string
+	searchLanguage    string
+	searchFacets      bool
+	searchRerank      bool
+	searchCandidates  int
 )
 
+// errNoResults is returned when a search finds nothing, so scripts can
+// test the exit status.
+var errNoResults = errors.New("no results found")
+
+// snippetLength is the maximum length of a snippet taken from chunk content.
+const snippetLength = 160
+
 var searchCmd = &cobra.Command{
 	Use:   "search [query]",
 	Short: "Search indexed documents",
 	Long: `Performs hybrid search across all indexed documents.
-Combines keyword (BM25) and semantic (vector) search for best results.`,
+Combines keyword (BM25) and semantic (vector) search for best results.
+
+Use --mode to force text, hybrid or vector search for a single query.
+Use --collection to only search the sources in a collection.
+Use --sort to order results by score, date, title or source.
+Use --chunks to show the best matching chunk of each document.
+Use --author to only show documents by an author, and --facets to count
+the results by author.
+Use --language to only show documents detected as written in a language,
+given as an ISO 639-1 code such as en, de or ja.
+Use --rerank to reorder the top results with the configured reranker, or
+--rerank=false to keep the fused ranking when reranking is enabled.
+Exits with a non-zero status when there are no results.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSearch,
 }
@@ -28,34 +59,209 @@ Combines keyword (BM25) and semantic (vector) search for best results.`,
 func init() {
 	searchCmd.Flags().IntVarP(&searchLimit, "limit", "n", 10, "maximum number of results")
 	searchCmd.Flags().BoolVar(&searchJSON, "json", false, "output results as JSON")
+	searchCmd.Flags().StringVarP(&searchMode, "mode", "m", "",
+		"search mode: text, hybrid or vector (default from settings)")
+	searchCmd.Flags().StringSliceVarP(&searchSources, "source", "s", nil,
+		"only search these sources (ID or name, repeatable)")
+	searchCmd.Flags().StringVar(&searchCollection, "collection", "",
+		"only search the sources in this collection")
+	searchCmd.Flags().StringVar(&searchSort, "sort", "",
+		"order results by score, date, title or source (default score)")
+	searchCmd.Flags().BoolVarP(&searchInteractive, "interactive", "i", false,
+		"open the query in the interactive terminal UI")
+	searchCmd.Flags().BoolVar(&searchChunks, "chunks", false,
+		"show the best matching chunk of each document")
+	searchCmd.Flags().StringSliceVar(&searchAuthors, "author", nil,
+		"only show documents by these authors (name or identifier, repeatable)")
+	searchCmd.Flags().StringVar(&searchLanguage, "language", "",
+		"only show documents in this language (ISO 639-1 code, e.g. en or fr)")
+	searchCmd.Flags().BoolVar(&searchFacets, "facets", false,
+		"count the results by author (not included in --json output)")
+	searchCmd.Flags().BoolVar(&searchRerank, "rerank", false,
+		"rerank the top results with the configured reranker (default from settings)")
+	searchCmd.Flags().IntVar(&searchCandidates, "rerank-candidates", 0,
+		"how many of the top results to rerank (default from settings)")
 	rootCmd.AddCommand(searchCmd)
 }
 
 func runSearch(cmd *cobra.Command, args []string) error {
 	query := args[0]
 
+	if searchInteractive {
+		return launchTUI(cmd, query, searchChunks)
+	}
+
 	if searchService == nil {
 		return errors.New("search service not configured")
 	}
 
+	mode, err := parseSearchMode(searchMode)
+	if err != nil {
+		return err
+	}
+
+	sortBy, err := parseSortField(searchSort)
+	if err != nil {
+		return err
+	}
+
 	ctx := context.Background()
+	sourceIDs, err := resolveSearchSources(ctx, searchSources)
+	if err != nil {
+		return err
+	}
+
 	opts := domain.SearchOptions{
-		Limit: searchLimit,
+		Limit:            searchLimit,
+		SourceIDs:        sourceIDs,
+		Collection:       strings.TrimSpace(searchCollection),
+		Mode:             mode,
+		SortBy:           sortBy,
+		Authors:          searchAuthors,
+		Language:         strings.ToLower(strings.TrimSpace(searchLanguage)),
+		RerankCandidates: searchCandidates,
+	}
+	if cmd.Flags().Changed("rerank") {
+		opts.Rerank = domain.RerankModeOff
+		if searchRerank {
+			opts.Rerank = domain.RerankModeOn
+		}
 	}
 
-	results, err := searchService.Search(ctx, query, opts)
+	count, err := runSearchQuery(ctx, cmd, query, opts)
 	if err != nil {
-		return fmt.Errorf("search failed: %w", err)
+		return err
 	}
 
+	if count == 0 {
+		// The output already says so; only the exit status is needed
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		return errNoResults
+	}
+	return nil
+}
+
+// runSearchQuery runs the search, prints the results and returns how many were found.
+func runSearchQuery(ctx context.Context, cmd *cobra.Command, query string, opts domain.SearchOptions) (int, error) {
+	if searchChunks {
+		chunks, err := searchService.SearchByChunk(ctx, query, opts)
+		if err != nil {
+			return 0, fmt.Errorf("search failed: %w", err)
+		}
+		if searchJSON {
+			return len(chunks), outputChunkJSON(cmd, chunks)
+		}
+		if err := outputChunkTable(cmd, chunks); err != nil {
+			return 0, err
+		}
+		if searchFacets {
+			outputAuthorFacets(cmd, domain.AuthorFacets(chunkDocuments(chunks)))
+		}
+		return len(chunks), nil
+	}
+
+	results, err := searchService.Search(ctx, query, opts)
+	if err != nil {
+		return 0, fmt.Errorf("search failed: %w", err)
+	}
 	if searchJSON {
-		return outputSearchJSON(cmd, results)
+		return len(results), outputSearchJSON(cmd, results)
+	}
+	if err := outputSearchTable(cmd, results); err != nil {
+		return 0, err
+	}
+	if searchFacets {
+		outputAuthorFacets(cmd, domain.AuthorFacets(results))
+	}
+	return len(results), nil
+}
+
+// chunkDocuments wraps the documents of chunk results as search results.
+func chunkDocuments(chunks []domain.ChunkSearchResult) []domain.SearchResult {
+	results := make([]domain.SearchResult, len(chunks))
+	for i := range chunks {
+		results[i] = domain.SearchResult{Document: chunks[i].Document}
+	}
+	return results
+}
+
+// outputAuthorFacets prints how many results each author wrote.
+func outputAuthorFacets(cmd *cobra.Command, facets []domain.AuthorFacet) {
+	if len(facets) == 0 {
+		return
+	}
+	cmd.Println("Authors:")
+	for _, facet := range facets {
+		cmd.Printf("  %s (%d)\n", facet.Author, facet.Count)
 	}
+}
 
-	return outputSearchTable(cmd, results)
+// parseSearchMode converts a --mode flag value to a search mode.
+// An empty value leaves the mode to the settings.
+func parseSearchMode(value string) (domain.SearchMode, error) {
+	switch strings.ToLower(value) {
+	case "":
+		return "", nil
+	case "text", string(domain.SearchModeTextOnly):
+		return domain.SearchModeTextOnly, nil
+	case "hybrid":
+		return domain.SearchModeHybrid, nil
+	case "vector", string(domain.SearchModeVectorOnly):
+		return domain.SearchModeVectorOnly, nil
+	default:
+		return "", fmt.Errorf("invalid mode %q: must be text, hybrid or vector", value)
+	}
+}
+
+// parseSortField converts a --sort flag value to a sort field.
+// An empty value sorts by score.
+func parseSortField(value string) (domain.SortField, error) {
+	if value == "" {
+		return domain.SortByScore, nil
+	}
+	field := domain.SortField(strings.ToLower(value))
+	if !field.IsValid() {
+		return "", fmt.Errorf("invalid sort %q: must be score, date, title or source", value)
+	}
+	return field, nil
+}
+
+// resolveSearchSources maps --source values, which may be IDs or names, to source IDs.
+func resolveSearchSources(ctx context.Context, values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if sourceService == nil {
+		return nil, errors.New("source service not configured")
+	}
+
+	sources, err := sourceService.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		id := ""
+		for i := range sources {
+			if sources[i].ID == value || sources[i].Name == value {
+				id = sources[i].ID
+				break
+			}
+		}
+		if id == "" {
+			return nil, fmt.Errorf("unknown source %q", value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
 
 func outputSearchJSON(cmd *cobra.Command, results []domain.SearchResult) error {
+	if results == nil {
+		results = []domain.SearchResult{} // Print [] rather than null
+	}
 	data, err := json.MarshalIndent(results, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal results: %w", err)
@@ -79,23 +285,69 @@ func outputSearchTable(cmd *cobra.Command, results []domain.SearchResult) error
 			title = results[i].Document.ID
 		}
 
-		snippet := ""
-		if len(results[i].Highlights) > 0 {
-			snippet = results[i].Highlights[0]
-		}
-
 		cmd.Printf("  [%d] %s (%.2f)\n", i+1, title, results[i].Score)
 		if results[i].SourceName != "" {
 			cmd.Printf("      Source: %s\n", results[i].SourceName)
 		}
-		if snippet != "" {
+		if author := results[i].Document.Author; !author.IsZero() {
+			cmd.Printf("      Author: %s\n", author)
+		}
+		if snippet := searchSnippet(&results[i]); snippet != "" {
 			cmd.Printf("      %s\n", snippet)
 		}
 		cmd.Println()
 	}
 
-	// TODO: Add interactive result selection when needed
-	// cmd.Print("Select result [1-N, q to quit]: ")
+	return nil
+}
+
+func outputChunkJSON(cmd *cobra.Command, chunks []domain.ChunkSearchResult) error {
+	if chunks == nil {
+		chunks = []domain.ChunkSearchResult{} // Print [] rather than null
+	}
+	data, err := json.MarshalIndent(chunks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal results: %w", err)
+	}
+	cmd.Println(string(data))
+	return nil
+}
+
+func outputChunkTable(cmd *cobra.Command, chunks []domain.ChunkSearchResult) error {
+	if len(chunks) == 0 {
+		cmd.Println("No results found.")
+		return nil
+	}
+
+	cmd.Println("Results:")
+	cmd.Println()
+	for i := range chunks {
+		// Format: [N] Title (Score), then the matched chunk
+		title := chunks[i].Document.Title
+		if title == "" {
+			title = chunks[i].Document.ID
+		}
+
+		cmd.Printf("  [%d] %s (%.2f)\n", i+1, title, chunks[i].Score)
+		cmd.Printf("      Chunk: %d\n", chunks[i].Chunk.Position+1)
+		if snippet := searchSnippet(&domain.SearchResult{Chunk: chunks[i].Chunk}); snippet != "" {
+			cmd.Printf("      %s\n", snippet)
+		}
+		cmd.Println()
+	}
 
 	return nil
 }
+
+// searchSnippet returns the first highlight, or the start of the matched chunk.
+func searchSnippet(result *domain.SearchResult) string {
+	if len(result.Highlights) > 0 {
+		return result.Highlights[0]
+	}
+
+	snippet := strings.Join(strings.Fields(result.Chunk.Content), " ")
+	if runes := []rune(snippet); len(runes) > snippetLength {
+		snippet = strings.TrimSpace(string(runes[:snippetLength-3])) + "..."
+	}
+	return snippet
+}
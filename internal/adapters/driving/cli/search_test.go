@@ -2,6 +2,8 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -226,3 +228,304 @@ func TestSearchCmd_ServiceError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "search failed")
 }
+
+// recordingSearchService records the options it was called with.
+type recordingSearchService struct {
+	opts    domain.SearchOptions
+	results []domain.SearchResult
+	chunks  []domain.ChunkSearchResult
+	byChunk bool
+}
+
+func (m *recordingSearchService) Search(
+	_ context.Context, _ string, opts domain.SearchOptions,
+) ([]domain.SearchResult, error) {
+	m.opts = opts
+	return m.results, nil
+}
+
+func (m *recordingSearchService) SearchByChunk(
+	_ context.Context, _ string, opts domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	m.opts = opts
+	m.byChunk = true
+	return m.chunks, nil
+}
+
+func runSearchWith(t *testing.T, svc *recordingSearchService, args ...string) (string, error) {
+	t.Helper()
+	cleanup := setupTestServices()
+	defer cleanup()
+	searchService = svc
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"search"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		searchLimit = 10
+		searchJSON = false
+		searchMode = ""
+		searchSources = nil
+		searchCollection = ""
+		searchSort = ""
+		searchChunks = false
+		searchAuthors = nil
+		searchLanguage = ""
+		searchFacets = false
+		searchRerank = false
+		searchCandidates = 0
+		searchCmd.Flags().Lookup("rerank").Changed = false
+		searchCmd.SilenceUsage = false
+		searchCmd.SilenceErrors = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSearchCmd_HasModeSourceAndInteractiveFlags(t *testing.T) {
+	for name, shorthand := range map[string]string{"mode": "m", "source": "s", "interactive": "i"} {
+		flag := searchCmd.Flags().Lookup(name)
+		require.NotNil(t, flag, name)
+		assert.Equal(t, shorthand, flag.Shorthand)
+	}
+}
+
+func TestSearchCmd_ModeFlag(t *testing.T) {
+	results := []domain.SearchResult{{Document: domain.Document{ID: "doc-1"}, Score: 0.5}}
+
+	tests := []struct {
+		value    string
+		expected domain.SearchMode
+	}{
+		{"text", domain.SearchModeTextOnly},
+		{"hybrid", domain.SearchModeHybrid},
+		{"vector", domain.SearchModeVectorOnly},
+		{"HYBRID", domain.SearchModeHybrid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			svc := &recordingSearchService{results: results}
+			_, err := runSearchWith(t, svc, "--mode", tt.value, "query")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, svc.opts.Mode)
+		})
+	}
+}
+
+func TestSearchCmd_DefaultModeLeftToSettings(t *testing.T) {
+	svc := &recordingSearchService{results: []domain.SearchResult{{Score: 0.5}}}
+	_, err := runSearchWith(t, svc, "query")
+	require.NoError(t, err)
+	assert.Empty(t, svc.opts.Mode)
+	assert.Equal(t, 10, svc.opts.Limit)
+}
+
+func TestSearchCmd_InvalidMode(t *testing.T) {
+	svc := &recordingSearchService{}
+	_, err := runSearchWith(t, svc, "--mode", "fuzzy", "query")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid mode")
+}
+
+func TestSearchCmd_SortFlag(t *testing.T) {
+	svc := &recordingSearchService{results: []domain.SearchResult{{Score: 0.5}}}
+
+	_, err := runSearchWith(t, svc, "--sort", "Date", "query")
+	require.NoError(t, err)
+	assert.Equal(t, domain.SortByDate, svc.opts.SortBy)
+
+	_, err = runSearchWith(t, svc, "query")
+	require.NoError(t, err)
+	assert.Equal(t, domain.SortByScore, svc.opts.SortBy)
+}
+
+func TestSearchCmd_InvalidSort(t *testing.T) {
+	svc := &recordingSearchService{}
+	_, err := runSearchWith(t, svc, "--sort", "size", "query")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sort")
+}
+
+func TestSearchCmd_SourceFlag(t *testing.T) {
+	svc := &recordingSearchService{results: []domain.SearchResult{{Score: 0.5}}}
+
+	// Sources can be given by name or ID
+	_, err := runSearchWith(t, svc, "-s", "~/Documents", "-s", "src-1", "query")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-1", "src-1"}, svc.opts.SourceIDs)
+}
+
+func TestSearchCmd_CollectionFlag(t *testing.T) {
+	svc := &recordingSearchService{results: []domain.SearchResult{{Score: 0.5}}}
+
+	_, err := runSearchWith(t, svc, "--collection", " Work ", "query")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Work", svc.opts.Collection)
+	assert.Empty(t, svc.opts.SourceIDs)
+}
+
+func TestSearchCmd_LanguageFlag(t *testing.T) {
+	svc := &recordingSearchService{results: []domain.SearchResult{
+		{Document: domain.Document{ID: "doc-1", Title: "Plan"}, Score: 0.5},
+	}}
+
+	_, err := runSearchWith(t, svc, "--language", " FR ", "query")
+
+	require.NoError(t, err)
+	assert.Equal(t, "fr", svc.opts.Language)
+}
+
+func TestSearchCmd_RerankFlag(t *testing.T) {
+	results := []domain.SearchResult{{Document: domain.Document{ID: "doc-1"}, Score: 0.5}}
+
+	tests := []struct {
+		args     []string
+		expected domain.RerankMode
+	}{
+		{[]string{"query"}, domain.RerankModeDefault},
+		{[]string{"--rerank", "query"}, domain.RerankModeOn},
+		{[]string{"--rerank=false", "query"}, domain.RerankModeOff},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			svc := &recordingSearchService{results: results}
+			_, err := runSearchWith(t, svc, tt.args...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, svc.opts.Rerank)
+		})
+	}
+}
+
+func TestSearchCmd_RerankCandidatesFlag(t *testing.T) {
+	svc := &recordingSearchService{results: []domain.SearchResult{{Score: 0.5}}}
+
+	_, err := runSearchWith(t, svc, "--rerank", "--rerank-candidates", "5", "query")
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, svc.opts.RerankCandidates)
+}
+
+func TestSearchCmd_AuthorFlag(t *testing.T) {
+	alice := domain.Author{Name: "Alice Smith", Identifier: "alice@example.com"}
+	svc := &recordingSearchService{results: []domain.SearchResult{
+		{Document: domain.Document{ID: "doc-1", Title: "Plan", Author: alice}, Score: 0.5},
+	}}
+
+	output, err := runSearchWith(t, svc, "--author", "alice@example.com", "--author", "bob", "query")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com", "bob"}, svc.opts.Authors)
+	assert.Contains(t, output, "Author: Alice Smith <alice@example.com>")
+	assert.NotContains(t, output, "Authors:")
+}
+
+func TestSearchCmd_FacetsFlag(t *testing.T) {
+	alice := domain.Author{Name: "Alice", Identifier: "alice@example.com"}
+	bob := domain.Author{Identifier: "bob"}
+	svc := &recordingSearchService{results: []domain.SearchResult{
+		{Document: domain.Document{ID: "doc-1", Author: bob}, Score: 0.9},
+		{Document: domain.Document{ID: "doc-2", Author: alice}, Score: 0.8},
+		{Document: domain.Document{ID: "doc-3", Author: alice}, Score: 0.7},
+	}}
+
+	output, err := runSearchWith(t, svc, "--facets", "query")
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Authors:\n  Alice <alice@example.com> (2)\n  bob (1)\n")
+}
+
+func TestSearchCmd_FacetsWithChunks(t *testing.T) {
+	svc := &recordingSearchService{chunks: []domain.ChunkSearchResult{
+		{Document: domain.Document{ID: "doc-1", Author: domain.Author{Identifier: "octocat"}}, Score: 0.8},
+	}}
+
+	output, err := runSearchWith(t, svc, "--chunks", "--facets", "query")
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Authors:\n  octocat (1)\n")
+}
+
+func TestSearchCmd_UnknownSource(t *testing.T) {
+	svc := &recordingSearchService{}
+	_, err := runSearchWith(t, svc, "--source", "missing", "query")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown source "missing"`)
+}
+
+func TestSearchCmd_NoResultsReturnsError(t *testing.T) {
+	svc := &recordingSearchService{}
+	output, err := runSearchWith(t, svc, "query")
+	require.ErrorIs(t, err, errNoResults)
+	assert.Contains(t, output, "No results found.")
+	assert.NotContains(t, output, "Usage:")
+}
+
+func TestSearchCmd_NoResultsJSON(t *testing.T) {
+	svc := &recordingSearchService{}
+	output, err := runSearchWith(t, svc, "--json", "query")
+	require.ErrorIs(t, err, errNoResults)
+	assert.Contains(t, output, "[]")
+}
+
+func TestOutputSearchTable_ChunkSnippet(t *testing.T) {
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+
+	results := []domain.SearchResult{
+		{
+			Document: domain.Document{ID: "doc-1", Title: "Notes"},
+			Chunk:    domain.Chunk{Content: "first line\nsecond   line " + strings.Repeat("x", 200)},
+			Score:    0.5,
+		},
+	}
+
+	err := outputSearchTable(rootCmd, results)
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "first line second line x")
+	assert.Contains(t, buf.String(), "...")
+	assert.NotContains(t, buf.String(), strings.Repeat("x", 200))
+}
+
+func TestSearchCmd_ChunksFlag(t *testing.T) {
+	svc := &recordingSearchService{chunks: []domain.ChunkSearchResult{
+		{
+			Document: domain.Document{ID: "doc-1", Title: "Handbook"},
+			Chunk:    domain.Chunk{ID: "chunk-3", DocumentID: "doc-1", Content: "holiday policy", Position: 2},
+			Score:    0.8,
+		},
+	}}
+
+	output, err := runSearchWith(t, svc, "--chunks", "--sort", "title", "holiday")
+
+	require.NoError(t, err)
+	assert.True(t, svc.byChunk)
+	assert.Equal(t, domain.SortByTitle, svc.opts.SortBy)
+	assert.Contains(t, output, "[1] Handbook (0.80)")
+	assert.Contains(t, output, "Chunk: 3")
+	assert.Contains(t, output, "holiday policy")
+}
+
+func TestSearchCmd_ChunksJSON(t *testing.T) {
+	svc := &recordingSearchService{chunks: []domain.ChunkSearchResult{
+		{Document: domain.Document{ID: "doc-1"}, Chunk: domain.Chunk{ID: "chunk-1"}, Score: 0.8},
+	}}
+
+	output, err := runSearchWith(t, svc, "--chunks", "--json", "query")
+
+	require.NoError(t, err)
+	assert.Contains(t, output, `"chunk-1"`)
+}
+
+func TestSearchCmd_ChunksNoResults(t *testing.T) {
+	svc := &recordingSearchService{}
+	output, err := runSearchWith(t, svc, "--chunks", "query")
+	require.ErrorIs(t, err, errNoResults)
+	assert.Contains(t, output, "No results found.")
+}
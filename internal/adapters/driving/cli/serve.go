@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/httpapi"
+)
+
+// serveTokenEnv names the environment variable holding the API token, so it
+// need not appear in the process list.
+const serveTokenEnv = "SERCHA_API_TOKEN"
+
+// serveWebhookSecretEnv names the environment variable holding the GitHub
+// webhook secret.
+const serveWebhookSecretEnv = "SERCHA_GITHUB_WEBHOOK_SECRET"
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve search over a local HTTP API",
+	Long: `Start a headless HTTP/JSON API so other local tools, such as editor
+plugins, browser extensions and scripts, can search the index without
+shelling out to the CLI.
+
+The API listens on ` + httpapi.DefaultAddr + ` by default. Without a token only
+requests addressed to localhost are answered. With a token, every request
+must send it as "Authorization: Bearer <token>"; a token is required to
+listen on any address other than loopback.
+
+Endpoints:
+  GET  /v1/health              Check the server is up
+  GET  /v1/search?q=...        Search (limit, offset, source, collection, mode,
+                               sort, min_score, author, language, semantic,
+                               hybrid, rerank, rerank_candidates, chunks)
+  POST /v1/search              Search with the same options as a JSON body
+  GET  /v1/sources             List sources and their sync status
+  GET  /v1/documents/{id}      Fetch a document and its content
+  GET  /v1/stats               Index statistics
+  POST ` + httpapi.GitHubWebhookPath + `    GitHub webhooks (with --github-webhook-secret)
+
+With a GitHub webhook secret, push, issues and pull_request events sync
+just the repository they name in every GitHub source, for near-real-time
+indexing without polling. Point a repository or organisation webhook
+(content type application/json, same secret) at the endpoint through a
+tunnel. Deliveries are checked against their signature instead of the
+token; unsigned and unrelated events are ignored and logged.
+
+Examples:
+  sercha serve
+  sercha serve --addr 127.0.0.1:9000
+  ` + serveTokenEnv + `=secret sercha serve --addr 0.0.0.0:7700
+  ` + serveWebhookSecretEnv + `=secret sercha serve`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	serveCmd.Flags().String("addr", httpapi.DefaultAddr, "address to listen on")
+	serveCmd.Flags().String("token", "", "bearer token required on every request (default $"+serveTokenEnv+")")
+	serveCmd.Flags().String("github-webhook-secret", "",
+		"secret verifying GitHub webhook deliveries; enables the webhook endpoint (default $"+serveWebhookSecretEnv+")")
+	rootCmd.AddCommand(serveCmd)
+}
+
+func runServe(cmd *cobra.Command, _ []string) error {
+	addr, err := cmd.Flags().GetString("addr")
+	if err != nil {
+		return fmt.Errorf("getting addr flag: %w", err)
+	}
+	token, err := cmd.Flags().GetString("token")
+	if err != nil {
+		return fmt.Errorf("getting token flag: %w", err)
+	}
+	if token == "" {
+		token = os.Getenv(serveTokenEnv)
+	}
+	webhookSecret, err := cmd.Flags().GetString("github-webhook-secret")
+	if err != nil {
+		return fmt.Errorf("getting github-webhook-secret flag: %w", err)
+	}
+	if webhookSecret == "" {
+		webhookSecret = os.Getenv(serveWebhookSecretEnv)
+	}
+
+	ports := &httpapi.Ports{
+		Search:     searchService,
+		Source:     sourceService,
+		Document:   documentService,
+		Embeddings: embeddingQueue,
+		Sync:       syncOrchestrator,
+	}
+
+	server, err := httpapi.NewServer(ports, httpapi.Config{
+		Addr:                addr,
+		Token:               token,
+		GitHubWebhookSecret: webhookSecret,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Sercha API listening on http://%s\n", server.Addr())
+	if webhookSecret != "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "GitHub webhooks accepted at http://%s%s\n", server.Addr(), httpapi.GitHubWebhookPath)
+	}
+	return server.Run(cmd.Context())
+}
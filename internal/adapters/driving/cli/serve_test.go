@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/httpapi"
+)
+
+func runServeCmd(t *testing.T, args ...string) error {
+	t.Helper()
+	t.Setenv(serveTokenEnv, "")
+	t.Setenv(serveWebhookSecretEnv, "")
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"serve"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		serveCmd.Flags().Set("addr", httpapi.DefaultAddr) //nolint:errcheck
+		serveCmd.Flags().Set("github-webhook-secret", "") //nolint:errcheck
+	}()
+	return rootCmd.Execute()
+}
+
+func TestServeCmd_Flags(t *testing.T) {
+	addr := serveCmd.Flags().Lookup("addr")
+	require.NotNil(t, addr)
+	assert.Equal(t, httpapi.DefaultAddr, addr.DefValue)
+	assert.NotNil(t, serveCmd.Flags().Lookup("token"))
+	assert.NotNil(t, serveCmd.Flags().Lookup("github-webhook-secret"))
+}
+
+func TestServeCmd_WebhookRequiresSync(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	oldSync := syncOrchestrator
+	syncOrchestrator = nil
+	defer func() {
+		syncOrchestrator = oldSync
+	}()
+
+	err := runServeCmd(t, "--github-webhook-secret", "secret")
+
+	assert.ErrorIs(t, err, httpapi.ErrWebhookServicesRequired)
+}
+
+func TestServeCmd_NonLoopbackRequiresToken(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	err := runServeCmd(t, "--addr", "0.0.0.0:7700")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, httpapi.ErrTokenRequired)
+}
+
+func TestServeCmd_NoSearchService(t *testing.T) {
+	oldSearch := searchService
+	searchService = nil
+	defer func() {
+		searchService = oldSearch
+	}()
+
+	err := runServeCmd(t)
+
+	assert.ErrorIs(t, err, httpapi.ErrMissingSearchService)
+}
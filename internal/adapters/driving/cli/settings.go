@@ -14,6 +14,10 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
+// defaultCompatibleBaseURL is where the llama.cpp server serves its
+// OpenAI-compatible API by default.
+const defaultCompatibleBaseURL = "http://localhost:8080/v1"
+
 var settingsCmd = &cobra.Command{
 	Use:   "settings",
 	Short: "Manage application settings",
@@ -44,6 +48,7 @@ var settingsModeCmd = &cobra.Command{
 Available modes:
   text_only    - Keyword search only (fastest, no setup required)
   hybrid       - Text + semantic vector search (requires embedding provider)
+  vector_only  - Semantic vector search only (requires embedding provider)
   llm_assisted - Text + LLM query expansion (requires LLM provider)
   full         - Text + semantic + LLM (requires both providers)`,
 	RunE: runSettingsMode,
@@ -52,8 +57,12 @@ Available modes:
 var settingsEmbeddingCmd = &cobra.Command{
 	Use:   "embedding",
 	Short: "Configure embedding provider",
-	Long:  `Configure the embedding provider for semantic search.`,
-	RunE:  runSettingsEmbedding,
+	Long: `Configure the embedding provider for semantic search.
+
+Besides Ollama and OpenAI, any self-hosted server that speaks the OpenAI
+embeddings API can be used, such as the llama.cpp server running a local
+GGUF model. The configuration is checked by embedding a probe string.`,
+	RunE: runSettingsEmbedding,
 }
 
 var settingsLLMCmd = &cobra.Command{
@@ -89,13 +98,17 @@ func runSettingsShow(cmd *cobra.Command, _ []string) error {
 	// Search settings
 	cmd.Println("[Search]")
 	cmd.Printf("  Mode: %s\n", settings.Search.Mode.Description())
+	cmd.Printf("  Language: %s\n", settings.Search.Language.Description())
+	cmd.Printf("  Title Weight: %g\n", settings.Search.TitleWeight)
+	cmd.Printf("  Show Chunks: %t\n", settings.Search.ShowChunks)
+	cmd.Printf("  Group By Source: %t\n", settings.Search.GroupBySource)
 	cmd.Println()
 
 	// Embedding settings
 	cmd.Println("[Embedding]")
 	cmd.Printf("  Provider: %s\n", settings.Embedding.Provider.Description())
 	cmd.Printf("  Model: %s\n", settings.Embedding.Model)
-	if settings.Embedding.Provider.IsLocal() {
+	if settings.Embedding.Provider.IsLocal() || settings.Embedding.Provider.RequiresBaseURL() {
 		cmd.Printf("  Base URL: %s\n", settings.Embedding.BaseURL)
 	}
 	if settings.Embedding.Provider.RequiresAPIKey() {
@@ -105,6 +118,13 @@ func runSettingsShow(cmd *cobra.Command, _ []string) error {
 			cmd.Printf("  API Key: (not set)\n")
 		}
 	}
+	if len(settings.Embedding.Headers) > 0 {
+		// Header values often hold credentials, so only names are shown
+		cmd.Printf("  Headers: %s\n", strings.Join(headerNames(settings.Embedding.Headers), ", "))
+	}
+	if settings.Embedding.Dimensions > 0 {
+		cmd.Printf("  Reduced Dimensions: %d\n", settings.Embedding.Dimensions)
+	}
 	status := "configured"
 	if !settings.Embedding.IsConfigured() {
 		status = "not configured"
@@ -138,11 +158,21 @@ func runSettingsShow(cmd *cobra.Command, _ []string) error {
 	if settings.VectorIndex.Enabled {
 		cmd.Printf("  Enabled: yes\n")
 		cmd.Printf("  Dimensions: %d\n", settings.VectorIndex.Dimensions)
+		if settings.VectorIndex.WarmUpOnStart {
+			cmd.Printf("  Warm-up on start: yes\n")
+		} else {
+			cmd.Printf("  Warm-up on start: no\n")
+		}
 	} else {
 		cmd.Printf("  Enabled: no\n")
 	}
 	cmd.Println()
 
+	// TUI settings
+	cmd.Println("[TUI]")
+	cmd.Printf("  Live Search: %t\n", settings.TUI.LiveSearch)
+	cmd.Println()
+
 	// Validation
 	if err := settingsService.Validate(); err != nil {
 		cmd.Printf("Warning: %v\n", err)
@@ -322,6 +352,12 @@ func configureEmbeddingProvider(cmd *cobra.Command, reader *bufio.Reader) error
 		}
 	}
 
+	if selectedProvider.RequiresBaseURL() {
+		if err := configureEmbeddingEndpoint(cmd, reader); err != nil {
+			return err
+		}
+	}
+
 	if err := settingsService.SetEmbeddingProvider(selectedProvider, model, apiKey); err != nil {
 		return fmt.Errorf("failed to configure embedding provider: %w", err)
 	}
@@ -338,6 +374,41 @@ func configureEmbeddingProvider(cmd *cobra.Command, reader *bufio.Reader) error
 	return nil
 }
 
+// configureEmbeddingEndpoint prompts for the URL of a self-hosted embedding
+// server and any extra headers it needs, such as a proxy's auth token.
+func configureEmbeddingEndpoint(cmd *cobra.Command, reader *bufio.Reader) error {
+	cmd.Printf("Enter server URL [%s]: ", defaultCompatibleBaseURL)
+	baseURL := readLine(reader)
+	if baseURL == "" {
+		baseURL = defaultCompatibleBaseURL
+	}
+
+	cmd.Println("Enter extra request headers as 'Name: value', one per line (empty line to finish):")
+	var headers []string
+	for {
+		header := readLine(reader)
+		if header == "" {
+			break
+		}
+		headers = append(headers, header)
+	}
+
+	if err := settingsService.SetEmbeddingEndpoint(baseURL, headers); err != nil {
+		return fmt.Errorf("failed to configure embedding endpoint: %w", err)
+	}
+	return nil
+}
+
+// headerNames returns the names of headers written as "Name: value".
+func headerNames(headers []string) []string {
+	names := make([]string, 0, len(headers))
+	for _, header := range headers {
+		name, _, _ := strings.Cut(header, ":")
+		names = append(names, strings.TrimSpace(name))
+	}
+	return names
+}
+
 //nolint:dupl // Similar to configureEmbeddingProvider but for LLM - intentional for CLI flow clarity
 func configureLLMProvider(cmd *cobra.Command, reader *bufio.Reader) error {
 	cmd.Println("Select LLM Provider")
@@ -129,3 +129,9 @@ func TestParseChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestHeaderNames(t *testing.T) {
+	names := headerNames([]string{"X-Api-Key: secret", " X-Route :gpu"})
+
+	assert.Equal(t, []string{"X-Api-Key", "X-Route"}, names)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -47,7 +48,11 @@ Examples:
   sercha source add github --auth <auth-id> -c content_types=files,issues
 
   # Specify auth method explicitly (for connectors supporting both)
-  sercha source add github --auth-method token --token ghp_xxx -c content_types=files`,
+  sercha source add github --auth-method token --token ghp_xxx -c content_types=files
+
+  # Non-interactive: GitHub source as a GitHub App installation
+  sercha source add github --github-app-id 123 --github-installation-id 456 \
+    --github-private-key app.private-key.pem`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runSourceAdd,
 }
@@ -65,6 +70,46 @@ var sourceRemoveCmd = &cobra.Command{
 	RunE:  runSourceRemove,
 }
 
+var sourceRenameCmd = &cobra.Command{
+	Use:   "rename [source-id] [new-name]",
+	Short: "Rename a source",
+	Long: `Change the display name of a source. The source keeps its ID, so its
+sync state and indexed documents are unaffected.
+
+Names do not have to be unique, but a warning is shown when another source
+already has the new name, since the name no longer identifies one source.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSourceRename,
+}
+
+var sourceArchiveCmd = &cobra.Command{
+	Use:   "archive [source-id]",
+	Short: "Stop syncing a source but keep its documents searchable",
+	Long: `Archive a source. Archived sources are skipped by scheduled syncs and
+sync --all, but their documents stay in the index and appear in search
+results. Syncing the source by ID still works.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSourceArchive,
+}
+
+var sourceUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive [source-id]",
+	Short: "Resume syncing an archived source",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSourceUnarchive,
+}
+
+var sourceErrorsCmd = &cobra.Command{
+	Use:   "errors [source-id]",
+	Short: "Show recent sync errors for a source",
+	Long: `Show the most recent errors from syncs of a source, newest first.
+
+Errors include documents that failed during otherwise successful syncs,
+as well as syncs that failed outright. Up to 10 errors are kept.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSourceErrors,
+}
+
 var connectorCmd = &cobra.Command{
 	Use:   "connector",
 	Short: "Manage connectors",
@@ -84,6 +129,11 @@ var (
 	sourceAuth       string // --auth flag for AuthProvider ID
 	sourceToken      string
 	sourceAuthMethod string
+
+	// GitHub App installation flags
+	sourceGitHubAppID          int64
+	sourceGitHubInstallationID int64
+	sourceGitHubPrivateKey     string // Path to the App's PEM private key
 )
 
 // authSelectionResult holds the result of auth selection for the new system.
@@ -93,6 +143,9 @@ type authSelectionResult struct {
 	AuthProviderID string
 	// AccountIdentifier is the user's email/username from the provider.
 	AccountIdentifier string
+	// AccountName is the account label used in source names.
+	// The provider's display name when available, otherwise AccountIdentifier.
+	AccountName string
 	// PendingCredentials holds credential data to save AFTER source creation.
 	// This is nil for no-auth connectors.
 	PendingCredentials *pendingCredentials
@@ -101,8 +154,9 @@ type authSelectionResult struct {
 // pendingCredentials holds credential data before it's saved to the database.
 // We need this because credentials have a FK to source_id, so source must exist first.
 type pendingCredentials struct {
-	OAuth *domain.OAuthCredentials
-	PAT   *domain.PATCredentials
+	OAuth     *domain.OAuthCredentials
+	PAT       *domain.PATCredentials
+	GitHubApp *domain.GitHubAppCredentials
 }
 
 func init() {
@@ -116,13 +170,26 @@ func init() {
 		"Personal Access Token for PAT authentication (non-interactive)")
 	sourceAddCmd.Flags().StringVar(
 		&sourceAuthMethod, "auth-method", "",
-		"Authentication method: 'token' or 'oauth' (for connectors supporting both)")
+		"Authentication method: 'token', 'oauth' or 'github-app' (for connectors supporting several)")
+	sourceAddCmd.Flags().Int64Var(
+		&sourceGitHubAppID, "github-app-id", 0,
+		"GitHub App ID for GitHub App authentication (non-interactive)")
+	sourceAddCmd.Flags().Int64Var(
+		&sourceGitHubInstallationID, "github-installation-id", 0,
+		"GitHub App installation ID for GitHub App authentication (non-interactive)")
+	sourceAddCmd.Flags().StringVar(
+		&sourceGitHubPrivateKey, "github-private-key", "",
+		"Path to the GitHub App's private key (.pem) for GitHub App authentication (non-interactive)")
 	sourceAddCmd.Flags().StringArrayVarP(
 		&sourceConfig, "config", "c", nil,
 		"Configuration key=value pairs (can be repeated)")
 	sourceCmd.AddCommand(sourceAddCmd)
 	sourceCmd.AddCommand(sourceListCmd)
 	sourceCmd.AddCommand(sourceRemoveCmd)
+	sourceCmd.AddCommand(sourceRenameCmd)
+	sourceCmd.AddCommand(sourceArchiveCmd)
+	sourceCmd.AddCommand(sourceUnarchiveCmd)
+	sourceCmd.AddCommand(sourceErrorsCmd)
 	rootCmd.AddCommand(sourceCmd)
 
 	// Connector commands
@@ -151,7 +218,7 @@ func runConnectorList(cmd *cobra.Command, _ []string) error {
 		// Show auth capability
 		authDesc := "none"
 		if c.AuthCapability.SupportsMultipleMethods() {
-			authDesc = "token/oauth"
+			authDesc = strings.ReplaceAll(strings.ReplaceAll(c.AuthCapability.String(), "pat", "token"), ",", "/")
 		} else if c.AuthCapability.SupportsPAT() {
 			authDesc = "token"
 		} else if c.AuthCapability.SupportsOAuth() {
@@ -287,9 +354,9 @@ func runSourceAdd(cmd *cobra.Command, args []string) error {
 				name = val + "/" + repo
 			}
 		}
-		// Append account identifier for OAuth sources
-		if authResult.AccountIdentifier != "" {
-			name = fmt.Sprintf("%s (%s)", name, authResult.AccountIdentifier)
+		// Append account name for OAuth sources
+		if authResult.AccountName != "" {
+			name = fmt.Sprintf("%s (%s)", name, authResult.AccountName)
 		}
 	}
 
@@ -318,6 +385,7 @@ func runSourceAdd(cmd *cobra.Command, args []string) error {
 			AccountIdentifier: authResult.AccountIdentifier,
 			OAuth:             authResult.PendingCredentials.OAuth,
 			PAT:               authResult.PendingCredentials.PAT,
+			GitHubApp:         authResult.PendingCredentials.GitHubApp,
 			CreatedAt:         now,
 			UpdatedAt:         now,
 		}
@@ -362,31 +430,57 @@ func runSourceList(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
-	cmd.Println("Configured sources:")
-	cmd.Println()
+	var active, archived []domain.Source
 	for i := range sources {
-		cmd.Printf("  %s\n", sources[i].ID)
-		cmd.Printf("    Type: %s\n", sources[i].Type)
-		cmd.Printf("    Name: %s\n", sources[i].Name)
-		// Show new auth system info
-		if sources[i].AuthProviderID != "" && authProviderService != nil {
-			if provider, err := authProviderService.Get(ctx, sources[i].AuthProviderID); err == nil {
-				cmd.Printf("    OAuth App: %s (%s)\n", provider.Name, provider.ID[:8])
-			}
+		if sources[i].Archived {
+			archived = append(archived, sources[i])
+		} else {
+			active = append(active, sources[i])
 		}
-		if sources[i].CredentialsID != "" && credentialsService != nil {
-			if creds, err := credentialsService.Get(ctx, sources[i].CredentialsID); err == nil {
-				if creds.AccountIdentifier != "" {
-					cmd.Printf("    Account: %s\n", creds.AccountIdentifier)
-				}
-			}
+	}
+
+	if len(active) > 0 {
+		cmd.Println("Configured sources:")
+		cmd.Println()
+		for i := range active {
+			printSource(ctx, cmd, &active[i])
 		}
+	}
+	if len(archived) > 0 {
+		cmd.Println("Archived sources (not synced):")
 		cmd.Println()
+		for i := range archived {
+			printSource(ctx, cmd, &archived[i])
+		}
 	}
 
 	return nil
 }
 
+// printSource prints a source's details for source list.
+func printSource(ctx context.Context, cmd *cobra.Command, source *domain.Source) {
+	cmd.Printf("  %s\n", source.ID)
+	cmd.Printf("    Type: %s\n", source.Type)
+	cmd.Printf("    Name: %s\n", source.Name)
+	if source.Collection != "" {
+		cmd.Printf("    Collection: %s\n", source.Collection)
+	}
+	// Show new auth system info
+	if source.AuthProviderID != "" && authProviderService != nil {
+		if provider, err := authProviderService.Get(ctx, source.AuthProviderID); err == nil {
+			cmd.Printf("    OAuth App: %s (%s)\n", provider.Name, provider.ID[:8])
+		}
+	}
+	if source.CredentialsID != "" && credentialsService != nil {
+		if creds, err := credentialsService.Get(ctx, source.CredentialsID); err == nil {
+			if creds.AccountIdentifier != "" {
+				cmd.Printf("    Account: %s\n", creds.AccountIdentifier)
+			}
+		}
+	}
+	cmd.Println()
+}
+
 func runSourceRemove(cmd *cobra.Command, args []string) error {
 	if sourceService == nil {
 		return errors.New("source service not configured")
@@ -404,6 +498,92 @@ func runSourceRemove(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runSourceRename(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	sourceID := args[0]
+	conflicts, err := sourceService.Rename(context.Background(), sourceID, args[1])
+	if err != nil {
+		return fmt.Errorf("failed to rename source: %w", err)
+	}
+
+	cmd.Printf("Renamed source %s to %q\n", sourceID, strings.TrimSpace(args[1]))
+	for i := range conflicts {
+		cmd.Printf("Warning: source %s is also named %q; use source IDs to tell them apart.\n",
+			conflicts[i].ID, conflicts[i].Name)
+	}
+	return nil
+}
+
+func runSourceArchive(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	sourceID := args[0]
+	if err := sourceService.Archive(context.Background(), sourceID); err != nil {
+		return fmt.Errorf("failed to archive source: %w", err)
+	}
+
+	cmd.Printf("Archived source: %s\n", sourceID)
+	cmd.Println("Its documents remain searchable. Run 'sercha source unarchive' to resume syncing.")
+	return nil
+}
+
+func runSourceUnarchive(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	sourceID := args[0]
+	if err := sourceService.Unarchive(context.Background(), sourceID); err != nil {
+		return fmt.Errorf("failed to unarchive source: %w", err)
+	}
+
+	cmd.Printf("Unarchived source: %s\n", sourceID)
+	return nil
+}
+
+func runSourceErrors(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	sourceID := args[0]
+	ctx := context.Background()
+
+	source, err := sourceService.Get(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get source: %w", err)
+	}
+	statuses, err := sourceService.Statuses(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+
+	var recent []domain.SyncErrorLog
+	for i := range statuses {
+		if statuses[i].SourceID == source.ID {
+			recent = statuses[i].RecentErrors
+		}
+	}
+
+	if len(recent) == 0 {
+		cmd.Printf("No recent sync errors for %s.\n", source.Name)
+		return nil
+	}
+
+	cmd.Printf("Recent sync errors for %s:\n", source.Name)
+	cmd.Println()
+	for i := len(recent) - 1; i >= 0; i-- {
+		cmd.Printf("  %s  [%s]  %s\n",
+			recent[i].Timestamp.Local().Format("2006-01-02 15:04:05"), recent[i].Phase, recent[i].Message)
+	}
+	return nil
+}
+
 // selectAuthWithNewSystem handles authentication using the new AuthProvider/Credentials architecture.
 // For OAuth connectors: selects/creates AuthProvider, runs OAuth flow, creates Credentials.
 // For PAT connectors: prompts for PAT, creates Credentials.
@@ -452,9 +632,21 @@ func selectAuthWithNewSystem(
 					return nil, fmt.Errorf("connector %s does not support OAuth authentication", connector.ID)
 				}
 				chosenMethod = domain.AuthMethodOAuth
+			case "github-app", "github_app":
+				if !connector.AuthCapability.SupportsGitHubApp() {
+					return nil, fmt.Errorf("connector %s does not support GitHub App authentication", connector.ID)
+				}
+				chosenMethod = domain.AuthMethodGitHubApp
 			default:
-				return nil, fmt.Errorf("invalid --auth-method: %s (use 'token' or 'oauth')", sourceAuthMethod)
+				return nil, fmt.Errorf(
+					"invalid --auth-method: %s (use 'token', 'oauth' or 'github-app')", sourceAuthMethod)
 			}
+		} else if sourceGitHubAppID != 0 {
+			// App ID provided, use GitHub App auth
+			if !connector.AuthCapability.SupportsGitHubApp() {
+				return nil, fmt.Errorf("connector %s does not support GitHub App authentication", connector.ID)
+			}
+			chosenMethod = domain.AuthMethodGitHubApp
 		} else if sourceToken != "" {
 			// Token provided, use PAT auth
 			if !connector.AuthCapability.SupportsPAT() {
@@ -490,6 +682,8 @@ func selectAuthWithNewSystem(
 				desc = "Personal Access Token - Use a token from your account settings"
 			case domain.AuthMethodOAuth:
 				desc = "OAuth App - Authenticate via browser with OAuth"
+			case domain.AuthMethodGitHubApp:
+				desc = "GitHub App - Use installation tokens minted from an App's private key"
 			case domain.AuthMethodNone:
 				desc = "No authentication required"
 			}
@@ -516,6 +710,8 @@ func selectAuthWithNewSystem(
 		return handlePATAuth(ctx, cmd, reader, connector, sourceID, isNonInteractive)
 	case domain.AuthMethodOAuth:
 		return handleOAuthAuth(ctx, cmd, reader, connector, sourceID, isNonInteractive)
+	case domain.AuthMethodGitHubApp:
+		return handleGitHubAppAuth(cmd, reader, isNonInteractive)
 	default:
 		return result, nil
 	}
@@ -573,6 +769,7 @@ func handlePATAuth(
 	}
 
 	result.AccountIdentifier = accountID
+	result.AccountName = accountID
 
 	// Store credentials as pending (will be saved AFTER source is created)
 	// This avoids FK constraint violation since credentials.source_id must reference existing source
@@ -588,6 +785,102 @@ func handlePATAuth(
 	return result, nil
 }
 
+// handleGitHubAppAuth handles GitHub App authentication. The App ID,
+// installation ID and private key are stored; installation tokens are
+// minted from them at sync time.
+//
+//nolint:errcheck // CLI interactive flow
+func handleGitHubAppAuth(
+	cmd *cobra.Command,
+	reader *bufio.Reader,
+	isNonInteractive bool,
+) (*authSelectionResult, error) {
+	appID, installationID, keyPath := sourceGitHubAppID, sourceGitHubInstallationID, sourceGitHubPrivateKey
+
+	if isNonInteractive {
+		if appID == 0 || installationID == 0 || keyPath == "" {
+			return nil, errors.New(
+				"--github-app-id, --github-installation-id and --github-private-key are required " +
+					"for GitHub App authentication")
+		}
+	} else {
+		cmd.Println("\nGitHub App Configuration")
+		cmd.Println("------------------------")
+		cmd.Println("The App must be installed on the account or organisation to index,")
+		cmd.Println("with read access to contents, issues, pull requests and metadata.")
+		cmd.Println()
+
+		var err error
+		cmd.Print("Enter the App ID: ")
+		if appID, err = readInt64(reader); err != nil {
+			return nil, fmt.Errorf("invalid App ID: %w", err)
+		}
+		cmd.Print("Enter the installation ID: ")
+		if installationID, err = readInt64(reader); err != nil {
+			return nil, fmt.Errorf("invalid installation ID: %w", err)
+		}
+		cmd.Print("Enter the path to the App's private key (.pem): ")
+		keyPath, _ = reader.ReadString('\n')
+		keyPath = strings.TrimSpace(keyPath)
+	}
+
+	app, err := gitHubAppCredentials(appID, installationID, keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID := fmt.Sprintf("installation %d", installationID)
+	result := &authSelectionResult{
+		AccountIdentifier:  accountID,
+		AccountName:        accountID,
+		PendingCredentials: &pendingCredentials{GitHubApp: app},
+	}
+
+	if !isNonInteractive {
+		cmd.Println("\nCredentials prepared.")
+	}
+	return result, nil
+}
+
+// gitHubAppCredentials builds GitHub App credentials, reading the private
+// key from keyPath.
+func gitHubAppCredentials(appID, installationID int64, keyPath string) (*domain.GitHubAppCredentials, error) {
+	if appID <= 0 || installationID <= 0 {
+		return nil, errors.New("GitHub App ID and installation ID must be positive")
+	}
+	if keyPath == "" {
+		return nil, errors.New("GitHub App private key path is required")
+	}
+	key, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+	if !strings.Contains(string(key), "PRIVATE KEY-----") {
+		return nil, fmt.Errorf("%s is not a PEM private key", keyPath)
+	}
+	return &domain.GitHubAppCredentials{
+		AppID:          appID,
+		InstallationID: installationID,
+		PrivateKey:     string(key),
+	}, nil
+}
+
+// readInt64 reads a number from a line of input.
+func readInt64(reader *bufio.Reader) (int64, error) {
+	input, _ := reader.ReadString('\n')
+	return strconv.ParseInt(strings.TrimSpace(input), 10, 64)
+}
+
+// oauthTimeout returns how long to wait for the OAuth browser callback.
+func oauthTimeout() time.Duration {
+	if settingsService != nil {
+		if settings, err := settingsService.Get(); err == nil {
+			return settings.Auth.OAuthTimeout()
+		}
+	}
+	return domain.DefaultOAuthTimeoutSeconds * time.Second
+}
+
 // handleOAuthAuth handles OAuth authentication flow.
 //
 //nolint:errcheck,gocyclo,gocognit,funlen,nestif // CLI interactive flow
@@ -711,7 +1004,7 @@ func handleOAuthAuth(
 	cmd.Println("\nWaiting for authorization...")
 
 	// Wait for callback
-	code, err := callbackServer.WaitForCode(5 * time.Minute)
+	code, err := callbackServer.WaitForCode(oauthTimeout())
 	if err != nil {
 		return nil, fmt.Errorf("authorization failed: %w", err)
 	}
@@ -726,12 +1019,13 @@ func handleOAuthAuth(
 		return nil, fmt.Errorf("failed to exchange code for tokens: %w", err)
 	}
 
-	// Get account identifier from provider via connector registry
-	accountID, err := connectorRegistry.GetUserInfo(ctx, connector.ID, tokens.AccessToken)
+	// Get account details from provider via connector registry
+	account, err := connectorRegistry.GetUserInfo(ctx, connector.ID, tokens.AccessToken)
 	if err != nil {
 		cmd.Printf("Warning: could not fetch account identifier: %v\n", err)
 	}
-	result.AccountIdentifier = accountID
+	result.AccountIdentifier = account.Identifier
+	result.AccountName = account.Label()
 
 	// Store credentials as pending (will be saved AFTER source is created)
 	// This avoids FK constraint violation since credentials.source_id must reference existing source
@@ -745,8 +1039,8 @@ func handleOAuthAuth(
 	}
 
 	cmd.Println("Authentication successful!")
-	if accountID != "" {
-		cmd.Printf("Authenticated as: %s\n", accountID)
+	if result.AccountName != "" {
+		cmd.Printf("Authenticated as: %s\n", result.AccountName)
 	}
 
 	return result, nil
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var sourceCollectionCmd = &cobra.Command{
+	Use:   "collection",
+	Short: "Group sources into collections",
+	Long: `Group sources into named collections, such as "Work" or "Personal".
+A source belongs to at most one collection. Search a whole collection with
+'sercha search --collection <name>'.
+
+Collection names are matched ignoring case.`,
+}
+
+var sourceCollectionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List collections and their sources",
+	Args:  cobra.NoArgs,
+	RunE:  runSourceCollectionList,
+}
+
+var sourceCollectionAddCmd = &cobra.Command{
+	Use:   "add [collection] [source-id...]",
+	Short: "Move sources into a collection",
+	Long: `Move sources into a collection, creating it if needed. Sources already
+in another collection leave it.`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runSourceCollectionAdd,
+}
+
+var sourceCollectionRemoveCmd = &cobra.Command{
+	Use:   "remove [source-id...]",
+	Short: "Take sources out of their collection",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSourceCollectionRemove,
+}
+
+var sourceCollectionRenameCmd = &cobra.Command{
+	Use:   "rename [collection] [new-name]",
+	Short: "Rename a collection",
+	Long: `Rename a collection. Renaming to the name of another collection merges
+the two.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSourceCollectionRename,
+}
+
+func init() {
+	sourceCollectionCmd.AddCommand(sourceCollectionListCmd)
+	sourceCollectionCmd.AddCommand(sourceCollectionAddCmd)
+	sourceCollectionCmd.AddCommand(sourceCollectionRemoveCmd)
+	sourceCollectionCmd.AddCommand(sourceCollectionRenameCmd)
+	sourceCmd.AddCommand(sourceCollectionCmd)
+}
+
+func runSourceCollectionList(cmd *cobra.Command, _ []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	ctx := cmd.Context()
+	collections, err := sourceService.Collections(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list collections: %w", err)
+	}
+	if len(collections) == 0 {
+		cmd.Println("No collections. Add sources to one with 'sercha source collection add'.")
+		return nil
+	}
+
+	sources, err := sourceService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sources: %w", err)
+	}
+	names := make(map[string]string, len(sources))
+	for i := range sources {
+		names[sources[i].ID] = sources[i].Name
+	}
+
+	for _, collection := range collections {
+		cmd.Printf("%s (%d)\n", collection.Name, len(collection.SourceIDs))
+		for _, id := range collection.SourceIDs {
+			cmd.Printf("  %s  %s\n", id, names[id])
+		}
+	}
+	return nil
+}
+
+func runSourceCollectionAdd(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	collection := strings.TrimSpace(args[0])
+	if collection == "" {
+		return errors.New("collection name cannot be empty")
+	}
+	for _, sourceID := range args[1:] {
+		if err := sourceService.SetCollection(cmd.Context(), sourceID, collection); err != nil {
+			return fmt.Errorf("failed to add source %s to collection: %w", sourceID, err)
+		}
+		cmd.Printf("Added source %s to collection %q\n", sourceID, collection)
+	}
+	return nil
+}
+
+func runSourceCollectionRemove(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	for _, sourceID := range args {
+		if err := sourceService.SetCollection(cmd.Context(), sourceID, ""); err != nil {
+			return fmt.Errorf("failed to remove source %s from its collection: %w", sourceID, err)
+		}
+		cmd.Printf("Removed source %s from its collection\n", sourceID)
+	}
+	return nil
+}
+
+func runSourceCollectionRename(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	moved, err := sourceService.RenameCollection(cmd.Context(), args[0], args[1])
+	if err != nil {
+		return fmt.Errorf("failed to rename collection: %w", err)
+	}
+
+	cmd.Printf("Renamed collection %q to %q (%d sources)\n", args[0], strings.TrimSpace(args[1]), moved)
+	return nil
+}
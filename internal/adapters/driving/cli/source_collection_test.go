@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockSourceServiceCollections keeps collections on a fixed set of sources.
+type mockSourceServiceCollections struct {
+	mockSourceService
+	sources []domain.Source
+}
+
+func (m *mockSourceServiceCollections) List(_ context.Context) ([]domain.Source, error) {
+	return m.sources, nil
+}
+
+func (m *mockSourceServiceCollections) SetCollection(_ context.Context, id, collection string) error {
+	for i := range m.sources {
+		if m.sources[i].ID == id {
+			m.sources[i].Collection = collection
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func (m *mockSourceServiceCollections) Collections(_ context.Context) ([]domain.Collection, error) {
+	return domain.GroupCollections(m.sources), nil
+}
+
+func (m *mockSourceServiceCollections) RenameCollection(_ context.Context, name, newName string) (int, error) {
+	moved := 0
+	for i := range m.sources {
+		if m.sources[i].InCollection(name) {
+			m.sources[i].Collection = newName
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+func runSourceCollectionWith(t *testing.T, mock *mockSourceServiceCollections, args ...string) (string, error) {
+	t.Helper()
+	oldService := sourceService
+	sourceService = mock
+	defer func() {
+		sourceService = oldService
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"source", "collection"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSourceCollectionCmds(t *testing.T) {
+	mock := &mockSourceServiceCollections{sources: []domain.Source{
+		{ID: "src-1", Name: "Notes"},
+		{ID: "src-2", Name: "Repo"},
+		{ID: "src-3", Name: "Photos"},
+	}}
+
+	output, err := runSourceCollectionWith(t, mock, "list")
+	require.NoError(t, err)
+	assert.Contains(t, output, "No collections.")
+
+	output, err = runSourceCollectionWith(t, mock, "add", "Work", "src-1", "src-2")
+	require.NoError(t, err)
+	assert.Contains(t, output, `Added source src-2 to collection "Work"`)
+
+	output, err = runSourceCollectionWith(t, mock, "list")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Work (2)\n  src-1  Notes\n  src-2  Repo\n")
+
+	output, err = runSourceCollectionWith(t, mock, "rename", "work", "Office")
+	require.NoError(t, err)
+	assert.Contains(t, output, `Renamed collection "work" to "Office" (2 sources)`)
+
+	_, err = runSourceCollectionWith(t, mock, "remove", "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, mock.sources[0].Collection)
+	assert.Equal(t, "Office", mock.sources[1].Collection)
+}
+
+func TestSourceCollectionAddCmd_UnknownSource(t *testing.T) {
+	mock := &mockSourceServiceCollections{}
+
+	_, err := runSourceCollectionWith(t, mock, "add", "Work", "missing")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
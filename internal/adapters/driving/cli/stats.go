@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show index statistics",
+	Long:  `Shows the number of sources, indexed documents and chunks waiting for an embedding or a retry.`,
+	Args:  cobra.NoArgs,
+	RunE:  runStats,
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(cmd *cobra.Command, _ []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if documentService == nil {
+		return errors.New("document service not configured")
+	}
+
+	ctx := context.Background()
+
+	sources, err := sourceService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	documents := 0
+	for i := range sources {
+		docs, err := documentService.ListBySource(ctx, sources[i].ID)
+		if err != nil {
+			return fmt.Errorf("failed to list documents for %s: %w", sources[i].ID, err)
+		}
+		documents += len(docs)
+	}
+
+	cmd.Printf("Sources: %d\n", len(sources))
+	cmd.Printf("Documents: %d\n", documents)
+
+	if embeddingQueue == nil {
+		cmd.Println("Pending embeddings: disabled")
+		return nil
+	}
+	stats, err := embeddingQueue.Stats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count pending embeddings: %w", err)
+	}
+	printEmbeddingStats(cmd, stats)
+	return nil
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runStatsCmd executes the stats command with test services.
+func runStatsCmd(t *testing.T) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"stats"})
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestStatsCmd_Use(t *testing.T) {
+	assert.Equal(t, "stats", statsCmd.Use)
+}
+
+func TestStatsCmd_ShowsCounts(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	oldQueue := embeddingQueue
+	embeddingQueue = &mockEmbeddingQueue{pending: 4}
+	defer func() { embeddingQueue = oldQueue }()
+
+	out, err := runStatsCmd(t)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Sources: 1")
+	assert.Contains(t, out, "Documents: 2")
+	assert.Contains(t, out, "Pending embeddings: 4")
+}
+
+func TestStatsCmd_ShowsEmbeddingRetries(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	oldQueue := embeddingQueue
+	embeddingQueue = &mockEmbeddingQueue{pending: 4, retrying: 3, failed: 2}
+	defer func() { embeddingQueue = oldQueue }()
+
+	out, err := runStatsCmd(t)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Pending embeddings: 4")
+	assert.Contains(t, out, "Waiting to retry: 3")
+	assert.Contains(t, out, "Failed embeddings: 2")
+}
+
+func TestStatsCmd_EmbeddingsDisabled(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	oldQueue := embeddingQueue
+	embeddingQueue = nil
+	defer func() { embeddingQueue = oldQueue }()
+
+	out, err := runStatsCmd(t)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Pending embeddings: disabled")
+}
+
+func TestStatsCmd_NoSourceService(t *testing.T) {
+	oldSource := sourceService
+	sourceService = nil
+	defer func() { sourceService = oldSource }()
+
+	_, err := runStatsCmd(t)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source service not configured")
+}
@@ -0,0 +1,342 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// statusJSON outputs the status as JSON.
+var statusJSON bool
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Summarise sources, indexes and configuration",
+	Long: `Shows everything at a glance: sources and any that need re-authenticating,
+indexed documents and chunks, index sizes on disk, the search mode and AI
+providers, and a summary of the checks run by 'sercha doctor'.
+
+Nothing is changed. Use --json for machine-readable output.`,
+	Args: cobra.NoArgs,
+	RunE: runStatus,
+}
+
+func init() {
+	statusCmd.Flags().BoolVar(&statusJSON, "json", false, "output the status as JSON")
+	rootCmd.AddCommand(statusCmd)
+}
+
+// statusReport is the status shown by sercha status.
+type statusReport struct {
+	DataDir     string            `json:"data_dir,omitempty"`
+	Sources     statusSources     `json:"sources"`
+	Documents   int               `json:"documents"`
+	Chunks      statusChunks      `json:"chunks"`
+	Embeddings  *statusEmbeddings `json:"embeddings"` // null when embeddings are disabled
+	IndexSizes  []statusIndexSize `json:"index_sizes"`
+	Search      statusSearch      `json:"search"`
+	Config      statusConfig      `json:"config"`
+	VectorIndex string            `json:"vector_index,omitempty"`
+}
+
+type statusSources struct {
+	Total      int               `json:"total"`
+	Archived   int               `json:"archived"`
+	Failing    int               `json:"failing"`
+	AuthIssues []statusAuthIssue `json:"auth_issues"`
+}
+
+type statusAuthIssue struct {
+	SourceID   string `json:"source_id"`
+	SourceName string `json:"source_name"`
+	Issue      string `json:"issue"`
+}
+
+// statusChunks counts the chunks in each index; -1 when an index cannot
+// count them or is disabled.
+type statusChunks struct {
+	Keyword int `json:"keyword"`
+	Vector  int `json:"vector"`
+}
+
+type statusEmbeddings struct {
+	Pending  int `json:"pending"`
+	Retrying int `json:"retrying"`
+	Failed   int `json:"failed"`
+}
+
+type statusIndexSize struct {
+	Name  string `json:"name"`
+	Bytes int64  `json:"bytes"`
+}
+
+type statusSearch struct {
+	Mode           string `json:"mode"`
+	EmbeddingModel string `json:"embedding_model,omitempty"`
+	LLMModel       string `json:"llm_model,omitempty"`
+}
+
+type statusConfig struct {
+	OK       bool `json:"ok"`
+	Problems int  `json:"problems"`
+}
+
+func runStatus(cmd *cobra.Command, _ []string) error {
+	if statusService == nil {
+		return errors.New("status service not configured")
+	}
+	if settingsService == nil {
+		return errors.New("settings service not configured")
+	}
+
+	report, err := buildStatusReport(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if statusJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal status: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+	printStatusReport(cmd, report)
+	return nil
+}
+
+// buildStatusReport gathers the status from the services and data directory.
+func buildStatusReport(ctx context.Context) (*statusReport, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	status, err := statusService.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status: %w", err)
+	}
+	settings, err := settingsService.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	report := &statusReport{
+		DataDir:   dataDir,
+		Documents: status.Documents,
+		Sources: statusSources{
+			Total:      status.Sources,
+			Archived:   status.ArchivedSources,
+			Failing:    status.FailingSources,
+			AuthIssues: []statusAuthIssue{},
+		},
+		Chunks:     statusChunks{Keyword: status.KeywordChunks, Vector: status.VectorChunks},
+		IndexSizes: []statusIndexSize{},
+		Search:     statusSearch{Mode: string(settings.Search.Mode)},
+	}
+	for _, issue := range status.AuthIssues {
+		report.Sources.AuthIssues = append(report.Sources.AuthIssues, statusAuthIssue{
+			SourceID:   issue.SourceID,
+			SourceName: issue.SourceName,
+			Issue:      string(issue.Issue),
+		})
+	}
+	if settings.Embedding.IsConfigured() {
+		report.Search.EmbeddingModel = providerModel(settings.Embedding.Provider, settings.Embedding.Model)
+	}
+	if settings.LLM.IsConfigured() {
+		report.Search.LLMModel = providerModel(settings.LLM.Provider, settings.LLM.Model)
+	}
+
+	if embeddingQueue != nil {
+		stats, err := embeddingQueue.Stats(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count pending embeddings: %w", err)
+		}
+		report.Embeddings = &statusEmbeddings{Pending: stats.Pending, Retrying: stats.Retrying, Failed: stats.Failed}
+	}
+
+	if dataDir != "" {
+		sizes, err := indexSizes(dataDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to measure indexes: %w", err)
+		}
+		report.IndexSizes = sizes
+	}
+
+	report.Config.OK = true
+	if err := settingsService.ValidateConfig(); err != nil {
+		var validationErr *domain.ConfigValidationError
+		if !errors.As(err, &validationErr) {
+			return nil, fmt.Errorf("failed to validate configuration: %w", err)
+		}
+		report.Config = statusConfig{Problems: len(validationErr.Errors)}
+	}
+
+	// The warm-up is not waited for; status reports where it is
+	if indexWarmer != nil {
+		report.VectorIndex = indexWarmthLabel(indexWarmer.Status())
+	}
+	return report, nil
+}
+
+// providerModel names a provider and its model, such as "ollama/nomic-embed-text".
+func providerModel(provider domain.AIProvider, model string) string {
+	if model == "" {
+		return string(provider)
+	}
+	return string(provider) + "/" + model
+}
+
+// indexSizes returns the size on disk of each entry in the data directory.
+// SQLite's -wal and -shm files are counted with their database.
+func indexSizes(dir string) ([]statusIndexSize, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []statusIndexSize{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bytes := make(map[string]int64)
+	for _, entry := range entries {
+		size, err := diskUsage(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(strings.TrimSuffix(entry.Name(), "-wal"), "-shm")
+		bytes[name] += size
+	}
+
+	sizes := make([]statusIndexSize, 0, len(bytes))
+	for name, size := range bytes {
+		sizes = append(sizes, statusIndexSize{Name: name, Bytes: size})
+	}
+	sort.Slice(sizes, func(i, j int) bool { return sizes[i].Name < sizes[j].Name })
+	return sizes, nil
+}
+
+// diskUsage returns the total size of the files at or under path.
+func diskUsage(path string) (int64, error) {
+	var total int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			total += info.Size()
+		}
+		return nil
+	})
+	return total, err
+}
+
+// printStatusReport prints the status as a compact dashboard.
+func printStatusReport(cmd *cobra.Command, report *statusReport) {
+	row := func(label, format string, args ...any) {
+		cmd.Printf("  %-14s %s\n", label, fmt.Sprintf(format, args...))
+	}
+
+	cmd.Println("Sercha status")
+	if report.DataDir != "" {
+		row("Data", "%s", report.DataDir)
+	}
+
+	sources := fmt.Sprintf("%d", report.Sources.Total)
+	var notes []string
+	if report.Sources.Archived > 0 {
+		notes = append(notes, fmt.Sprintf("%d archived", report.Sources.Archived))
+	}
+	if report.Sources.Failing > 0 {
+		notes = append(notes, fmt.Sprintf("%d failing to sync", report.Sources.Failing))
+	}
+	if len(notes) > 0 {
+		sources += " (" + strings.Join(notes, ", ") + ")"
+	}
+	row("Sources", "%s", sources)
+	if issues := report.Sources.AuthIssues; len(issues) > 0 {
+		names := make([]string, len(issues))
+		for i, issue := range issues {
+			names[i] = fmt.Sprintf("%s (%s)", issue.SourceName, issue.Issue)
+		}
+		row("Auth", "%d need attention: %s", len(issues), strings.Join(names, ", "))
+	} else if report.Sources.Total > 0 {
+		row("Auth", "OK")
+	}
+
+	row("Documents", "%d", report.Documents)
+	row("Chunks", "%s keyword, %s vector", chunkCount(report.Chunks.Keyword), chunkCount(report.Chunks.Vector))
+	if e := report.Embeddings; e != nil {
+		embeddings := fmt.Sprintf("%d pending", e.Pending)
+		if e.Retrying > 0 {
+			embeddings += fmt.Sprintf(", %d retrying", e.Retrying)
+		}
+		if e.Failed > 0 {
+			embeddings += fmt.Sprintf(", %d failed", e.Failed)
+		}
+		row("Embeddings", "%s", embeddings)
+	}
+	if len(report.IndexSizes) > 0 {
+		sizes := make([]string, len(report.IndexSizes))
+		for i, size := range report.IndexSizes {
+			sizes[i] = fmt.Sprintf("%s %s", size.Name, formatBytes(size.Bytes))
+		}
+		row("Disk", "%s", strings.Join(sizes, ", "))
+	}
+
+	row("Search mode", "%s", report.Search.Mode)
+	row("Embedding", "%s", orNotConfigured(report.Search.EmbeddingModel))
+	row("LLM", "%s", orNotConfigured(report.Search.LLMModel))
+	if report.VectorIndex != "" {
+		row("Vector index", "%s", report.VectorIndex)
+	}
+	if report.Config.OK {
+		row("Config", "OK")
+	} else {
+		row("Config", "%d problem(s), run 'sercha doctor' for details", report.Config.Problems)
+	}
+}
+
+// chunkCount formats a chunk count, which is -1 when it is unknown.
+func chunkCount(n int) string {
+	if n < 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+func orNotConfigured(value string) string {
+	if value == "" {
+		return "not configured"
+	}
+	return value
+}
+
+// formatBytes formats a size in bytes with a binary unit, such as "1.5 MiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
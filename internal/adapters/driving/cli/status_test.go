@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockStatusService implements driving.StatusService for testing.
+type mockStatusService struct {
+	status *domain.SystemStatus
+	err    error
+}
+
+func (m *mockStatusService) Status(_ context.Context) (*domain.SystemStatus, error) {
+	return m.status, m.err
+}
+
+// runStatusCmd executes the status command against a populated data directory.
+func runStatusCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metadata.db"), make([]byte, 2048), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metadata.db-wal"), make([]byte, 1024), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "xapian"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "xapian", "postlist.glass"), make([]byte, 100), 0o600))
+
+	settings := domain.DefaultAppSettings()
+	settings.Embedding = domain.EmbeddingSettings{Provider: domain.AIProviderOllama, Model: "nomic-embed-text", BaseURL: "http://localhost:11434"}
+
+	oldStatus, oldSettings, oldDataDir, oldQueue, oldWarmer := statusService, settingsService, dataDir, embeddingQueue, indexWarmer
+	statusService = &mockStatusService{status: &domain.SystemStatus{
+		Sources:         3,
+		ArchivedSources: 1,
+		FailingSources:  1,
+		AuthIssues:      []domain.SourceAuthIssue{{SourceID: "repo", SourceName: "Repo", Issue: domain.AuthIssueExpired}},
+		Documents:       42,
+		KeywordChunks:   120,
+		VectorChunks:    -1,
+	}}
+	settingsService = &mockSettingsService{settings: &settings, validateErr: &domain.ConfigValidationError{
+		Errors: []domain.ConfigFieldError{{Field: "search.mode", Value: "fast", Message: "invalid"}},
+	}}
+	dataDir = dir
+	embeddingQueue = &mockEmbeddingQueue{pending: 5}
+	indexWarmer = &mockIndexWarmer{status: domain.WarmUpStatus{State: domain.WarmUpDisabled}}
+	defer func() {
+		statusService, settingsService, dataDir, embeddingQueue, indexWarmer = oldStatus, oldSettings, oldDataDir, oldQueue, oldWarmer
+		statusJSON = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"status"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestStatusCmd_Dashboard(t *testing.T) {
+	out, err := runStatusCmd(t)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "3 (1 archived, 1 failing to sync)")
+	assert.Contains(t, out, "1 need attention: Repo (expired)")
+	assert.Contains(t, out, "120 keyword, n/a vector")
+	assert.Contains(t, out, "5 pending")
+	assert.Contains(t, out, "metadata.db 3.0 KiB")
+	assert.Contains(t, out, "xapian 100 B")
+	assert.Contains(t, out, "ollama/nomic-embed-text")
+	assert.Contains(t, out, "1 problem(s), run 'sercha doctor' for details")
+}
+
+func TestStatusCmd_JSON(t *testing.T) {
+	out, err := runStatusCmd(t, "--json")
+	require.NoError(t, err)
+
+	var report statusReport
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 3, report.Sources.Total)
+	assert.Equal(t, "expired", report.Sources.AuthIssues[0].Issue)
+	assert.Equal(t, 42, report.Documents)
+	assert.Equal(t, -1, report.Chunks.Vector)
+	assert.Equal(t, []statusIndexSize{{Name: "metadata.db", Bytes: 3072}, {Name: "xapian", Bytes: 100}}, report.IndexSizes)
+	assert.Equal(t, "text_only", report.Search.Mode)
+	assert.Empty(t, report.Search.LLMModel)
+	assert.False(t, report.Config.OK)
+	assert.Contains(t, report.VectorIndex, "cold")
+}
+
+func TestStatusCmd_NoService(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	old := statusService
+	statusService = nil
+	defer func() { statusService = old }()
+
+	err := runStatus(statusCmd, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status service not configured")
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KiB", formatBytes(1536))
+	assert.Equal(t, "2.0 MiB", formatBytes(2<<20))
+}
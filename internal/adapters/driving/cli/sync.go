@@ -4,23 +4,61 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
+// Flags for the sync command.
+var (
+	syncSince  string
+	syncStrict bool
+	syncDryRun bool
+	syncDiff   bool
+)
+
 var syncCmd = &cobra.Command{
 	Use:   "sync [source-id]",
 	Short: "Synchronise documents from sources",
 	Long: `Triggers document synchronisation from configured sources.
 If a source ID is provided, only that source is synchronised.
-Otherwise, all sources are synchronised.`,
+Otherwise, all sources are synchronised.
+
+Use --since to fetch only items changed after a point in time, instead of
+after the last sync. It accepts a duration (24h, 7d) or a timestamp
+(2026-01-02 or 2026-01-02T15:04:05Z). The stored sync position is kept, so
+later syncs are unaffected. Sources that have never synced run a full sync.
+Filesystem, Gmail and Google Calendar sources honour --since fully; GitHub
+applies it to issues, pull requests and gists but not repository files or
+wikis. Other connectors ignore it and sync from their stored position.
+
+By default documents that fail to index are logged and skipped. Use --strict
+to stop at the first failure and exit non-zero, e.g. in CI. The error reports
+how many documents were indexed before the failure, and the sync position is
+not advanced, so the next sync retries from the same point. Documents of
+unsupported types are still skipped. When syncing all sources, --strict also
+stops at the first source that fails.
+
+Use --dry-run with a source ID to list the documents a sync would add,
+change or delete without storing anything or moving the sync position.
+Add --diff to show a unified diff between each stored document and the
+incoming version.
+
+Use "sync undo <source-id>" to reverse the last sync of a source.`,
 	RunE: runSync,
 }
 
 func init() {
+	syncCmd.Flags().StringVar(&syncSince, "since", "",
+		"only fetch changes after this duration ago or timestamp (e.g. 24h, 7d, 2026-01-02)")
+	syncCmd.Flags().BoolVar(&syncStrict, "strict", false, "abort at the first document that fails to index")
+	syncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "show what a sync would change without storing anything")
+	syncCmd.Flags().BoolVar(&syncDiff, "diff", false, "with --dry-run, show a diff of each changed document")
 	rootCmd.AddCommand(syncCmd)
 }
 
@@ -29,14 +67,53 @@ func runSync(cmd *cobra.Command, args []string) error {
 		return errors.New("sync service not configured")
 	}
 
-	ctx := context.Background()
+	if syncDiff && !syncDryRun {
+		return errors.New("--diff requires --dry-run")
+	}
+	if syncDryRun {
+		if len(args) == 0 {
+			return errors.New("--dry-run requires a source ID")
+		}
+		if syncSince != "" {
+			return errors.New("--since cannot be used with --dry-run")
+		}
+		previewer, ok := syncOrchestrator.(driving.PreviewingSync)
+		if !ok {
+			return errors.New("--dry-run is not supported by the sync service")
+		}
+		return runSyncDryRun(cmd, previewer, args[0], syncDiff)
+	}
+
+	var since time.Time
+	if syncSince != "" {
+		var err error
+		since, err = parseSince(syncSince, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	policy := domain.SyncPolicyContinue
+	if syncStrict {
+		policy = domain.SyncPolicyStrict
+	}
+	if configurable, ok := syncOrchestrator.(driving.PolicyConfigurableSync); ok {
+		configurable.SetSyncPolicy(policy)
+	} else if syncStrict {
+		return errors.New("--strict is not supported by the sync service")
+	}
+
+	ctx := cmd.Context()
 
 	if len(args) > 0 {
 		// Sync specific source
 		sourceID := args[0]
 		cmd.Printf("Synchronising source: %s...\n", sourceID)
+		if !since.IsZero() {
+			cmd.Printf("Fetching changes since %s\n", since.Format(time.RFC3339))
+		}
 
-		if err := syncWithProgress(ctx, cmd, syncOrchestrator, sourceID); err != nil {
+		if err := syncWithProgress(ctx, cmd, syncOrchestrator, sourceID, since); err != nil {
 			return fmt.Errorf("sync failed: %w", err)
 		}
 
@@ -45,7 +122,14 @@ func runSync(cmd *cobra.Command, args []string) error {
 		// Sync all sources
 		cmd.Println("Synchronising all sources...")
 
-		if err := syncOrchestrator.SyncAll(ctx); err != nil {
+		var err error
+		if since.IsZero() {
+			err = syncOrchestrator.SyncAll(ctx)
+		} else {
+			cmd.Printf("Fetching changes since %s\n", since.Format(time.RFC3339))
+			err = syncOrchestrator.SyncAllSince(ctx, since)
+		}
+		if err != nil {
 			return fmt.Errorf("sync failed: %w", err)
 		}
 
@@ -55,17 +139,83 @@ func runSync(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runSyncDryRun lists the documents a sync of a source would change,
+// followed by their diffs when showDiff is set.
+func runSyncDryRun(cmd *cobra.Command, previewer driving.PreviewingSync, sourceID string, showDiff bool) error {
+	cmd.Printf("Dry run of source %s: nothing will be stored.\n", sourceID)
+
+	counts := make(map[driving.DocumentChange]int)
+	err := previewer.DryRun(cmd.Context(), sourceID, func(diff *driving.DocumentDiff) error {
+		counts[diff.Change]++
+		if diff.Change == driving.DocumentUnchanged {
+			return nil
+		}
+		cmd.Printf("%-8s %s\n", diff.Change, diff.URI)
+		if showDiff && diff.Diff != "" {
+			cmd.Print(diff.Diff)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("dry run failed: %w", err)
+	}
+
+	cmd.Printf("Would add %d, change %d and delete %d documents; %d unchanged.\n",
+		counts[driving.DocumentNew], counts[driving.DocumentChanged],
+		counts[driving.DocumentDeleted], counts[driving.DocumentUnchanged])
+	return nil
+}
+
+// parseSince parses a --since value as a duration before now or a timestamp.
+// Durations also accept a day suffix, e.g. 7d.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	invalid := fmt.Errorf(
+		"invalid --since %q: use a duration like 24h or 7d, or a timestamp like 2026-01-02T15:04:05Z", value)
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return time.Time{}, invalid
+		}
+		return now.AddDate(0, 0, -n), nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		if d <= 0 {
+			return time.Time{}, invalid
+		}
+		return now.Add(-d), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		t, err := time.ParseInLocation(layout, value, time.Local)
+		if err != nil {
+			continue
+		}
+		if t.After(now) {
+			return time.Time{}, fmt.Errorf("invalid --since %q: time is in the future", value)
+		}
+		return t, nil
+	}
+	return time.Time{}, invalid
+}
+
 // syncWithProgress runs sync while displaying progress updates.
+// A non-zero since overrides the stored cursor for this run.
 func syncWithProgress(
 	ctx context.Context,
 	cmd *cobra.Command,
 	syncOrch driving.SyncOrchestrator,
 	sourceID string,
+	since time.Time,
 ) error {
 	// Start sync in goroutine
 	errCh := make(chan error, 1)
 	go func() {
-		errCh <- syncOrch.Sync(ctx, sourceID)
+		if since.IsZero() {
+			errCh <- syncOrch.Sync(ctx, sourceID)
+		} else {
+			errCh <- syncOrch.SyncSince(ctx, sourceID, since)
+		}
 	}()
 
 	// Poll status every 500ms
@@ -79,8 +229,7 @@ func syncWithProgress(
 			// Print final status (ignore status error - best effort)
 			status, statusErr := syncOrch.Status(ctx, sourceID)
 			if statusErr == nil && status != nil && status.DocumentsProcessed > 0 {
-				cmd.Printf("\rProcessed %d documents (%d errors)\n",
-					status.DocumentsProcessed, status.ErrorCount)
+				cmd.Printf("\rProcessed %d documents (%s)\n", status.DocumentsProcessed, syncCounts(status))
 			}
 			return err
 		case <-ticker.C:
@@ -93,3 +242,16 @@ func syncWithProgress(
 		}
 	}
 }
+
+// syncCounts describes the errors and skipped documents of a sync, such as
+// "2 errors, 3 skipped: no normaliser, 4 too short".
+func syncCounts(status *driving.SyncStatus) string {
+	counts := fmt.Sprintf("%d errors", status.ErrorCount)
+	if status.DocumentsSkipped > 0 {
+		counts += fmt.Sprintf(", %d skipped: no normaliser", status.DocumentsSkipped)
+	}
+	if status.DocumentsTooShort > 0 {
+		counts += fmt.Sprintf(", %d too short", status.DocumentsTooShort)
+	}
+	return counts
+}
@@ -4,14 +4,25 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
 // mockSyncOrchestrator implements driving.SyncOrchestrator for testing.
-type mockSyncOrchestrator struct{}
+// It records the since time passed to the SyncSince variants and the sync policy.
+type mockSyncOrchestrator struct {
+	since  time.Time
+	policy domain.SyncPolicy
+}
+
+func (m *mockSyncOrchestrator) SetSyncPolicy(policy domain.SyncPolicy) {
+	m.policy = policy
+}
 
 func (m *mockSyncOrchestrator) Sync(_ context.Context, _ string) error {
 	return nil
@@ -21,6 +32,16 @@ func (m *mockSyncOrchestrator) SyncAll(_ context.Context) error {
 	return nil
 }
 
+func (m *mockSyncOrchestrator) SyncSince(_ context.Context, _ string, since time.Time) error {
+	m.since = since
+	return nil
+}
+
+func (m *mockSyncOrchestrator) SyncAllSince(_ context.Context, since time.Time) error {
+	m.since = since
+	return nil
+}
+
 func (m *mockSyncOrchestrator) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
 	return nil, nil
 }
@@ -142,3 +163,246 @@ func TestSyncCmd_ServiceError_AllSources(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "sync failed")
 }
+
+func TestSyncCmd_Since(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"single source", []string{"sync", "src-1", "--since", "2026-01-02T15:04:05Z"}},
+		{"all sources", []string{"sync", "--since", "2026-01-02T15:04:05Z"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockSyncOrchestrator{}
+			oldSync := syncOrchestrator
+			syncOrchestrator = mock
+			defer func() {
+				syncOrchestrator = oldSync
+				syncSince = ""
+			}()
+
+			buf := new(bytes.Buffer)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(tt.args)
+			defer func() {
+				rootCmd.SetArgs(nil)
+			}()
+
+			err := rootCmd.Execute()
+
+			require.NoError(t, err)
+			assert.Equal(t, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), mock.since.UTC())
+			assert.Contains(t, buf.String(), "Fetching changes since")
+		})
+	}
+}
+
+func TestSyncCmd_Since_Invalid(t *testing.T) {
+	cleanup := setupSyncTest()
+	defer cleanup()
+	defer func() { syncSince = "" }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"sync", "--since", "yesterday"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --since")
+}
+
+func TestSyncCmd_Strict(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected domain.SyncPolicy
+	}{
+		{"default continues", []string{"sync", "src-1"}, domain.SyncPolicyContinue},
+		{"strict single source", []string{"sync", "src-1", "--strict"}, domain.SyncPolicyStrict},
+		{"strict all sources", []string{"sync", "--strict"}, domain.SyncPolicyStrict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockSyncOrchestrator{}
+			oldSync := syncOrchestrator
+			syncOrchestrator = mock
+			defer func() {
+				syncOrchestrator = oldSync
+				syncStrict = false
+			}()
+
+			buf := new(bytes.Buffer)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(tt.args)
+			defer func() {
+				rootCmd.SetArgs(nil)
+			}()
+
+			require.NoError(t, rootCmd.Execute())
+			assert.Equal(t, tt.expected, mock.policy)
+		})
+	}
+}
+
+func TestSyncCmd_Strict_Unsupported(t *testing.T) {
+	oldSync := syncOrchestrator
+	syncOrchestrator = &mockSyncOrchestratorFull{}
+	defer func() {
+		syncOrchestrator = oldSync
+		syncStrict = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"sync", "--strict"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--strict is not supported")
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{value: "24h", expected: now.Add(-24 * time.Hour)},
+		{value: "90m", expected: now.Add(-90 * time.Minute)},
+		{value: "7d", expected: now.AddDate(0, 0, -7)},
+		{value: "2026-03-01T08:30:00Z", expected: time.Date(2026, 3, 1, 8, 30, 0, 0, time.UTC)},
+		{value: "2026-03-01", expected: time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)},
+		{value: "-1h", wantErr: true},
+		{value: "0d", wantErr: true},
+		{value: "xd", wantErr: true},
+		{value: "2027-01-01", wantErr: true},
+		{value: "last week", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "got %s", got)
+		})
+	}
+}
+
+// mockPreviewingSync is a sync orchestrator that reports fixed dry run changes.
+type mockPreviewingSync struct {
+	mockSyncOrchestrator
+	dryRunSource string
+	synced       bool
+}
+
+func (m *mockPreviewingSync) Sync(_ context.Context, _ string) error {
+	m.synced = true
+	return nil
+}
+
+func (m *mockPreviewingSync) DryRun(_ context.Context, sourceID string, fn func(*driving.DocumentDiff) error) error {
+	m.dryRunSource = sourceID
+	diffs := []*driving.DocumentDiff{
+		{URI: "notes.txt", Change: driving.DocumentChanged,
+			Diff: "--- notes.txt (stored)\n+++ notes.txt (incoming)\n@@ -1 +1 @@\n-old\n+new\n"},
+		{URI: "same.txt", Change: driving.DocumentUnchanged},
+		{URI: "added.txt", Change: driving.DocumentNew, Diff: "+added\n"},
+	}
+	for _, diff := range diffs {
+		if err := fn(diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockPreviewingSync) DiffDocument(_ context.Context, _ *domain.RawDocument) (*driving.DocumentDiff, error) {
+	return nil, nil
+}
+
+// executeSync runs the sync command with the given orchestrator, resetting its flags afterwards.
+func executeSync(t *testing.T, orchestrator driving.SyncOrchestrator, args ...string) (string, error) {
+	t.Helper()
+	oldSync := syncOrchestrator
+	syncOrchestrator = orchestrator
+	defer func() {
+		syncOrchestrator = oldSync
+		syncDryRun, syncDiff, syncSince = false, false, ""
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"sync"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSyncCmd_DryRun(t *testing.T) {
+	mock := &mockPreviewingSync{}
+
+	out, err := executeSync(t, mock, "src-1", "--dry-run")
+
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", mock.dryRunSource)
+	assert.False(t, mock.synced)
+	assert.Contains(t, out, "changed  notes.txt\n")
+	assert.Contains(t, out, "new      added.txt\n")
+	assert.NotContains(t, out, "same.txt")
+	assert.NotContains(t, out, "+new")
+	assert.Contains(t, out, "Would add 1, change 1 and delete 0 documents; 1 unchanged.")
+}
+
+func TestSyncCmd_DryRunDiff(t *testing.T) {
+	out, err := executeSync(t, &mockPreviewingSync{}, "src-1", "--dry-run", "--diff")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "changed  notes.txt\n--- notes.txt (stored)\n+++ notes.txt (incoming)\n")
+	assert.Contains(t, out, "-old\n+new\n")
+	assert.Contains(t, out, "+added\n")
+}
+
+func TestSyncCmd_DryRun_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		orch     driving.SyncOrchestrator
+		args     []string
+		expected string
+	}{
+		{"diff without dry run", &mockPreviewingSync{}, []string{"src-1", "--diff"}, "--diff requires --dry-run"},
+		{"no source", &mockPreviewingSync{}, []string{"--dry-run"}, "--dry-run requires a source ID"},
+		{"with since", &mockPreviewingSync{}, []string{"src-1", "--dry-run", "--since", "1d"}, "--since cannot be used"},
+		{"unsupported", &mockSyncOrchestrator{}, []string{"src-1", "--dry-run"}, "--dry-run is not supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := executeSync(t, tt.orch, tt.args...)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func TestSyncCounts(t *testing.T) {
+	assert.Equal(t, "1 errors", syncCounts(&driving.SyncStatus{ErrorCount: 1}))
+	assert.Equal(t, "0 errors, 3 skipped: no normaliser, 4 too short",
+		syncCounts(&driving.SyncStatus{DocumentsSkipped: 3, DocumentsTooShort: 4}))
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+var syncUndoCmd = &cobra.Command{
+	Use:   "undo <source-id>",
+	Short: "Undo the last sync of a source",
+	Long: `Reverses the most recent sync of a source. Documents the sync added are
+deleted, documents it changed or deleted are restored as they were, and the
+sync position is moved back so the next sync fetches the same changes again.
+
+Only the last sync can be undone, and only once. Syncs that changed more than
+1000 documents cannot be undone.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSyncUndo,
+}
+
+func init() {
+	syncCmd.AddCommand(syncUndoCmd)
+}
+
+func runSyncUndo(cmd *cobra.Command, args []string) error {
+	if syncOrchestrator == nil {
+		return errors.New("sync service not configured")
+	}
+	undoer, ok := syncOrchestrator.(driving.UndoableSync)
+	if !ok {
+		return errors.New("undo is not supported by the sync service")
+	}
+
+	sourceID := args[0]
+	result, err := undoer.UndoLastSync(cmd.Context(), sourceID)
+	if err != nil {
+		return fmt.Errorf("undo failed: %w", err)
+	}
+
+	cmd.Printf("Undid sync of source %s started %s: removed %d and restored %d documents.\n",
+		sourceID, result.StartedAt.Local().Format(time.DateTime), result.Removed, result.Restored)
+	return nil
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// mockUndoableSync is a sync orchestrator that records undo calls.
+type mockUndoableSync struct {
+	mockSyncOrchestrator
+	undoneSource string
+}
+
+func (m *mockUndoableSync) UndoLastSync(_ context.Context, sourceID string) (*domain.SyncUndoResult, error) {
+	if sourceID == "never-synced" {
+		return nil, fmt.Errorf("no sync of source %s to undo: %w", sourceID, domain.ErrNotFound)
+	}
+	m.undoneSource = sourceID
+	return &domain.SyncUndoResult{Removed: 2, Restored: 5, StartedAt: time.Now()}, nil
+}
+
+// executeSyncUndo runs the sync undo command with the given orchestrator.
+func executeSyncUndo(t *testing.T, orchestrator driving.SyncOrchestrator, args ...string) (string, error) {
+	t.Helper()
+	oldSync := syncOrchestrator
+	syncOrchestrator = orchestrator
+	defer func() { syncOrchestrator = oldSync }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"sync", "undo"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSyncUndoCmd_Use(t *testing.T) {
+	assert.Equal(t, "undo <source-id>", syncUndoCmd.Use)
+	assert.Contains(t, syncCmd.Long, "sync undo")
+}
+
+func TestSyncUndoCmd_UndoesSource(t *testing.T) {
+	mock := &mockUndoableSync{}
+
+	out, err := executeSyncUndo(t, mock, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", mock.undoneSource)
+	assert.Contains(t, out, "removed 2 and restored 5 documents")
+}
+
+func TestSyncUndoCmd_NothingToUndo(t *testing.T) {
+	_, err := executeSyncUndo(t, &mockUndoableSync{}, "never-synced")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSyncUndoCmd_RequiresSource(t *testing.T) {
+	_, err := executeSyncUndo(t, &mockUndoableSync{})
+
+	require.Error(t, err)
+}
+
+func TestSyncUndoCmd_Unsupported(t *testing.T) {
+	_, err := executeSyncUndo(t, &mockSyncOrchestratorFull{}, "src-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "undo is not supported")
+}
+
+func TestSyncUndoCmd_ServiceNotConfigured(t *testing.T) {
+	_, err := executeSyncUndo(t, nil, "src-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sync service not configured")
+}
@@ -25,6 +25,21 @@ func (m *mockSearchService) Search(
 	}, nil
 }
 
+func (m *mockSearchService) SearchByChunk(
+	_ context.Context, query string, _ domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	if query == "" {
+		return []domain.ChunkSearchResult{}, nil
+	}
+	return []domain.ChunkSearchResult{
+		{
+			Document: domain.Document{ID: "doc-1", Title: "Test Doc"},
+			Chunk:    domain.Chunk{ID: "chunk-1", DocumentID: "doc-1", Content: "matched passage"},
+			Score:    0.95,
+		},
+	}, nil
+}
+
 // mockSourceService implements driving.SourceService for testing.
 type mockSourceService struct{}
 
@@ -46,6 +61,30 @@ func (m *mockSourceService) Remove(_ context.Context, id string) error {
 	return nil
 }
 
+func (m *mockSourceService) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockSourceService) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *mockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *mockSourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
 func (m *mockSourceService) Update(_ context.Context, _ domain.Source) error {
 	return nil
 }
@@ -54,6 +93,19 @@ func (m *mockSourceService) ValidateConfig(_ context.Context, _ string, _ map[st
 	return nil
 }
 
+func (m *mockSourceService) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	failedAt := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	return []domain.SourceStatus{
+		{
+			SourceID: "src-1",
+			RecentErrors: []domain.SyncErrorLog{
+				{Timestamp: failedAt, Phase: domain.SyncPhaseDocument, Message: "file:///a.pdf: parse failed"},
+				{Timestamp: failedAt.Add(time.Hour), Phase: domain.SyncPhaseSync, Message: "connector error: timeout"},
+			},
+		},
+	}, nil
+}
+
 // mockSourceServiceEmpty implements driving.SourceService that returns empty lists.
 type mockSourceServiceEmpty struct{}
 
@@ -73,6 +125,30 @@ func (m *mockSourceServiceEmpty) Remove(_ context.Context, _ string) error {
 	return nil
 }
 
+func (m *mockSourceServiceEmpty) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockSourceServiceEmpty) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockSourceServiceEmpty) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockSourceServiceEmpty) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *mockSourceServiceEmpty) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *mockSourceServiceEmpty) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
 func (m *mockSourceServiceEmpty) Update(_ context.Context, _ domain.Source) error {
 	return nil
 }
@@ -81,6 +157,10 @@ func (m *mockSourceServiceEmpty) ValidateConfig(_ context.Context, _ string, _ m
 	return nil
 }
 
+func (m *mockSourceServiceEmpty) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
 // mockSourceServiceWithAuth implements driving.SourceService that returns sources with authorization IDs.
 type mockSourceServiceWithAuth struct{}
 
@@ -103,6 +183,30 @@ func (m *mockSourceServiceWithAuth) Remove(_ context.Context, _ string) error {
 	return nil
 }
 
+func (m *mockSourceServiceWithAuth) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockSourceServiceWithAuth) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockSourceServiceWithAuth) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockSourceServiceWithAuth) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *mockSourceServiceWithAuth) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *mockSourceServiceWithAuth) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
 func (m *mockSourceServiceWithAuth) Update(_ context.Context, _ domain.Source) error {
 	return nil
 }
@@ -111,6 +215,10 @@ func (m *mockSourceServiceWithAuth) ValidateConfig(_ context.Context, _ string,
 	return nil
 }
 
+func (m *mockSourceServiceWithAuth) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
 // mockSyncOrchestratorFull implements driving.SyncOrchestrator for testing.
 type mockSyncOrchestratorFull struct{}
 
@@ -122,6 +230,14 @@ func (m *mockSyncOrchestratorFull) SyncAll(_ context.Context) error {
 	return nil
 }
 
+func (m *mockSyncOrchestratorFull) SyncSince(ctx context.Context, sourceID string, _ time.Time) error {
+	return m.Sync(ctx, sourceID)
+}
+
+func (m *mockSyncOrchestratorFull) SyncAllSince(ctx context.Context, _ time.Time) error {
+	return m.SyncAll(ctx)
+}
+
 func (m *mockSyncOrchestratorFull) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
 	return nil, nil
 }
@@ -152,6 +268,22 @@ func (m *mockDocumentService) GetContent(_ context.Context, _ string) (string, e
 	return "This is the content of the test document.", nil
 }
 
+func (m *mockDocumentService) GetOriginal(_ context.Context, _ string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentService) GetChunks(_ context.Context, documentID string) ([]domain.Chunk, error) {
+	return []domain.Chunk{
+		{
+			ID: "chunk-1", DocumentID: documentID, Position: 0,
+			Content:   "First chunk of the test document",
+			Embedding: []float32{3, 4},
+			Metadata:  map[string]any{"heading": "Intro"},
+		},
+		{ID: "chunk-2", DocumentID: documentID, Position: 1, Content: "Second chunk"},
+	}, nil
+}
+
 func (m *mockDocumentService) GetDetails(_ context.Context, documentID string) (*driving.DocumentDetails, error) {
 	return &driving.DocumentDetails{
 		ID:         documentID,
@@ -167,10 +299,32 @@ func (m *mockDocumentService) GetDetails(_ context.Context, documentID string) (
 	}, nil
 }
 
+func (m *mockDocumentService) GetAncestors(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentService) Exclude(_ context.Context, _, _ string) error {
 	return nil
 }
 
+func (m *mockDocumentService) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, nil
+}
+
+func (m *mockDocumentService) RetryQuarantined(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockDocumentService) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	return len(ids), nil
+}
+
+func (m *mockDocumentService) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 3, nil
+}
+
 func (m *mockDocumentService) Refresh(_ context.Context, _ string) error {
 	return nil
 }
@@ -194,14 +348,44 @@ func (m *mockDocumentServiceEmpty) GetContent(_ context.Context, _ string) (stri
 	return "", nil
 }
 
+func (m *mockDocumentServiceEmpty) GetOriginal(_ context.Context, _ string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceEmpty) GetChunks(_ context.Context, _ string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentServiceEmpty) GetDetails(_ context.Context, documentID string) (*driving.DocumentDetails, error) {
 	return &driving.DocumentDetails{ID: documentID}, nil
 }
 
+func (m *mockDocumentServiceEmpty) GetAncestors(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentServiceEmpty) Exclude(_ context.Context, _, _ string) error {
 	return nil
 }
 
+func (m *mockDocumentServiceEmpty) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, nil
+}
+
+func (m *mockDocumentServiceEmpty) RetryQuarantined(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockDocumentServiceEmpty) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	return len(ids), nil
+}
+
+func (m *mockDocumentServiceEmpty) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, nil
+}
+
 func (m *mockDocumentServiceEmpty) Refresh(_ context.Context, _ string) error {
 	return nil
 }
@@ -235,6 +419,14 @@ func (m *mockDocumentServiceNoMetadata) GetContent(_ context.Context, _ string)
 	return "content", nil
 }
 
+func (m *mockDocumentServiceNoMetadata) GetOriginal(_ context.Context, _ string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceNoMetadata) GetChunks(_ context.Context, _ string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentServiceNoMetadata) GetDetails(_ context.Context, documentID string) (*driving.DocumentDetails, error) {
 	return &driving.DocumentDetails{
 		ID:         documentID,
@@ -250,10 +442,32 @@ func (m *mockDocumentServiceNoMetadata) GetDetails(_ context.Context, documentID
 	}, nil
 }
 
+func (m *mockDocumentServiceNoMetadata) GetAncestors(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentServiceNoMetadata) Exclude(_ context.Context, _, _ string) error {
 	return nil
 }
 
+func (m *mockDocumentServiceNoMetadata) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, nil
+}
+
+func (m *mockDocumentServiceNoMetadata) RetryQuarantined(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockDocumentServiceNoMetadata) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	return len(ids), nil
+}
+
+func (m *mockDocumentServiceNoMetadata) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, nil
+}
+
 func (m *mockDocumentServiceNoMetadata) Refresh(_ context.Context, _ string) error {
 	return nil
 }
@@ -280,14 +494,44 @@ func (m *mockDocumentServiceNoURI) GetContent(_ context.Context, _ string) (stri
 	return "", nil
 }
 
+func (m *mockDocumentServiceNoURI) GetOriginal(_ context.Context, _ string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceNoURI) GetChunks(_ context.Context, _ string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentServiceNoURI) GetDetails(_ context.Context, documentID string) (*driving.DocumentDetails, error) {
 	return &driving.DocumentDetails{ID: documentID}, nil
 }
 
+func (m *mockDocumentServiceNoURI) GetAncestors(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentServiceNoURI) Exclude(_ context.Context, _, _ string) error {
 	return nil
 }
 
+func (m *mockDocumentServiceNoURI) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, nil
+}
+
+func (m *mockDocumentServiceNoURI) RetryQuarantined(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockDocumentServiceNoURI) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	return len(ids), nil
+}
+
+func (m *mockDocumentServiceNoURI) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, nil
+}
+
 func (m *mockDocumentServiceNoURI) Refresh(_ context.Context, _ string) error {
 	return nil
 }
@@ -361,8 +605,8 @@ func (m *mockConnectorRegistry) BuildAuthURL(_ string, authProvider *domain.Auth
 	return "https://example.com/oauth/authorize?client_id=" + authProvider.OAuth.ClientID + "&state=" + state, nil
 }
 
-func (m *mockConnectorRegistry) GetUserInfo(_ context.Context, _ string, _ string) (string, error) {
-	return "test@example.com", nil
+func (m *mockConnectorRegistry) GetUserInfo(_ context.Context, _ string, _ string) (domain.AccountInfo, error) {
+	return domain.AccountInfo{Identifier: "test@example.com", Email: "test@example.com"}, nil
 }
 
 func (m *mockConnectorRegistry) GetSetupHint(_ string) string {
@@ -379,6 +623,10 @@ func (m *mockConnectorRegistry) GetConnectorsForProvider(provider domain.Provide
 	return result
 }
 
+func (m *mockConnectorRegistry) ListByProvider(providerType domain.ProviderType) []domain.ConnectorType {
+	return m.GetConnectorsForProvider(providerType)
+}
+
 func (m *mockConnectorRegistry) ValidateConfig(_ string, _ map[string]string) error {
 	return nil
 }
@@ -410,8 +658,8 @@ func (m *mockConnectorRegistryEmpty) BuildAuthURL(_ string, _ *domain.AuthProvid
 	return "", domain.ErrNotFound
 }
 
-func (m *mockConnectorRegistryEmpty) GetUserInfo(_ context.Context, _ string, _ string) (string, error) {
-	return "", domain.ErrNotFound
+func (m *mockConnectorRegistryEmpty) GetUserInfo(_ context.Context, _ string, _ string) (domain.AccountInfo, error) {
+	return domain.AccountInfo{}, domain.ErrNotFound
 }
 
 func (m *mockConnectorRegistryEmpty) GetSetupHint(_ string) string {
@@ -422,6 +670,10 @@ func (m *mockConnectorRegistryEmpty) GetConnectorsForProvider(_ domain.ProviderT
 	return nil
 }
 
+func (m *mockConnectorRegistryEmpty) ListByProvider(_ domain.ProviderType) []domain.ConnectorType {
+	return nil
+}
+
 func (m *mockConnectorRegistryEmpty) ValidateConfig(_ string, _ map[string]string) error {
 	return domain.ErrNotFound
 }
@@ -437,6 +689,12 @@ func (m *mockSearchServiceError) Search(_ context.Context, _ string, _ domain.Se
 	return nil, domain.ErrNotFound
 }
 
+func (m *mockSearchServiceError) SearchByChunk(
+	_ context.Context, _ string, _ domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	return nil, domain.ErrNotFound
+}
+
 // mockSourceServiceError implements driving.SourceService that returns errors.
 type mockSourceServiceError struct{}
 
@@ -456,6 +714,30 @@ func (m *mockSourceServiceError) Remove(_ context.Context, _ string) error {
 	return domain.ErrNotFound
 }
 
+func (m *mockSourceServiceError) Archive(_ context.Context, _ string) error {
+	return domain.ErrNotFound
+}
+
+func (m *mockSourceServiceError) Unarchive(_ context.Context, _ string) error {
+	return domain.ErrNotFound
+}
+
+func (m *mockSourceServiceError) SetCollection(_ context.Context, _, _ string) error {
+	return domain.ErrNotFound
+}
+
+func (m *mockSourceServiceError) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockSourceServiceError) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, domain.ErrNotFound
+}
+
+func (m *mockSourceServiceError) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, domain.ErrNotFound
+}
+
 func (m *mockSourceServiceError) Update(_ context.Context, _ domain.Source) error {
 	return domain.ErrNotFound
 }
@@ -464,6 +746,10 @@ func (m *mockSourceServiceError) ValidateConfig(_ context.Context, _ string, _ m
 	return domain.ErrNotFound
 }
 
+func (m *mockSourceServiceError) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
 // mockDocumentServiceError implements driving.DocumentService that returns errors.
 type mockDocumentServiceError struct{}
 
@@ -479,14 +765,44 @@ func (m *mockDocumentServiceError) GetContent(_ context.Context, _ string) (stri
 	return "", domain.ErrNotFound
 }
 
+func (m *mockDocumentServiceError) GetOriginal(_ context.Context, _ string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceError) GetChunks(_ context.Context, _ string) ([]domain.Chunk, error) {
+	return nil, domain.ErrNotFound
+}
+
 func (m *mockDocumentServiceError) GetDetails(_ context.Context, _ string) (*driving.DocumentDetails, error) {
 	return nil, domain.ErrNotFound
 }
 
+func (m *mockDocumentServiceError) GetAncestors(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+	return nil, domain.ErrNotFound
+}
+
 func (m *mockDocumentServiceError) Exclude(_ context.Context, _, _ string) error {
 	return domain.ErrNotFound
 }
 
+func (m *mockDocumentServiceError) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceError) RetryQuarantined(_ context.Context, _ string) error {
+	return domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceError) ExcludeMany(_ context.Context, _ []string, _ domain.ExclusionReason, _ string) (int, error) {
+	return 0, domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceError) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, domain.ErrNotFound
+}
+
 func (m *mockDocumentServiceError) Refresh(_ context.Context, _ string) error {
 	return domain.ErrNotFound
 }
@@ -506,10 +822,38 @@ func (m *mockSyncOrchestratorError) SyncAll(_ context.Context) error {
 	return domain.ErrNotFound
 }
 
+func (m *mockSyncOrchestratorError) SyncSince(ctx context.Context, sourceID string, _ time.Time) error {
+	return m.Sync(ctx, sourceID)
+}
+
+func (m *mockSyncOrchestratorError) SyncAllSince(ctx context.Context, _ time.Time) error {
+	return m.SyncAll(ctx)
+}
+
 func (m *mockSyncOrchestratorError) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
 	return nil, domain.ErrNotFound
 }
 
+// mockSettingsService implements the config validation part of
+// driving.SettingsService for testing; other methods are not used.
+type mockSettingsService struct {
+	driving.SettingsService
+	settings    *domain.AppSettings
+	validateErr error
+}
+
+func (m *mockSettingsService) Get() (*domain.AppSettings, error) {
+	if m.settings == nil {
+		settings := domain.DefaultAppSettings()
+		return &settings, nil
+	}
+	return m.settings, nil
+}
+
+func (m *mockSettingsService) ValidateConfig() error {
+	return m.validateErr
+}
+
 // setupTestServices injects mock services for testing and returns a cleanup func.
 func setupTestServices() func() {
 	oldSearch := searchService
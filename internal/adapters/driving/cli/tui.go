@@ -28,11 +28,17 @@ type TUIConfig struct {
 	AuthProviderService driving.AuthProviderService
 	Scheduler           driving.Scheduler
 	SchedulerConfig     domain.SchedulerConfig
+	EmbeddingQueue      driving.EmbeddingQueue
+	FeedbackService     driving.FeedbackService
+	TimelineService     driving.TimelineService
 }
 
 // tuiConfig holds the current TUI configuration.
 var tuiConfig *TUIConfig
 
+// tuiChunks shows chunk-level search results in the TUI.
+var tuiChunks bool
+
 // tuiCmd represents the tui command.
 var tuiCmd = &cobra.Command{
 	Use:   "tui",
@@ -45,6 +51,7 @@ managing sources, and viewing search results with keyboard navigation.
 Controls:
   ↑/k, ↓/j - Navigate results
   Enter    - Search / Select
+  +, -     - Mark a result as useful / not useful
   Esc      - Back / Cancel
   ?        - Toggle help
   q        - Quit`,
@@ -57,10 +64,18 @@ func SetTUIConfig(config *TUIConfig) {
 }
 
 func init() {
+	tuiCmd.Flags().BoolVar(&tuiChunks, "chunks", false,
+		"show the best matching chunk of each document (default from settings)")
 	rootCmd.AddCommand(tuiCmd)
 }
 
 func runTUI(cmd *cobra.Command, args []string) error {
+	return launchTUI(cmd, "", tuiChunks)
+}
+
+// launchTUI runs the terminal UI, searching for query on start when it is set.
+// showChunks forces chunk-level search results regardless of the settings.
+func launchTUI(cmd *cobra.Command, query string, showChunks bool) error {
 	// Add panic recovery to get stack traces
 	defer func() {
 		if r := recover(); r != nil {
@@ -71,7 +86,7 @@ func runTUI(cmd *cobra.Command, args []string) error {
 
 	// Start scheduler if enabled (TUI is long-running, needs background tasks)
 	if tuiConfig != nil && tuiConfig.SchedulerConfig.Enabled && tuiConfig.Scheduler != nil {
-		schedulerCtx, schedulerCancel := context.WithCancel(context.Background())
+		schedulerCtx, schedulerCancel := context.WithCancel(cmd.Context())
 		defer schedulerCancel()
 
 		go func() {
@@ -88,6 +103,18 @@ func runTUI(cmd *cobra.Command, args []string) error {
 		}()
 	}
 
+	// Embed queued chunks in the background while the TUI is open
+	if tuiConfig != nil && tuiConfig.EmbeddingQueue != nil {
+		embeddingCtx, embeddingCancel := context.WithCancel(cmd.Context())
+		defer embeddingCancel()
+
+		go func() {
+			if err := tuiConfig.EmbeddingQueue.Start(embeddingCtx); err != nil {
+				fmt.Fprintf(os.Stderr, "embedding worker stopped: %v\n", err)
+			}
+		}()
+	}
+
 	// Build ports from configuration
 	ports := &tui.Ports{}
 
@@ -102,6 +129,9 @@ func runTUI(cmd *cobra.Command, args []string) error {
 		ports.Settings = tuiConfig.SettingsService
 		ports.Credentials = tuiConfig.CredentialsService
 		ports.AuthProvider = tuiConfig.AuthProviderService
+		ports.Embeddings = tuiConfig.EmbeddingQueue
+		ports.Feedback = tuiConfig.FeedbackService
+		ports.Timeline = tuiConfig.TimelineService
 	}
 
 	// Create the TUI app
@@ -111,10 +141,12 @@ func runTUI(cmd *cobra.Command, args []string) error {
 	}
 
 	// Set up context from command
-	app.WithContext(cmd.Context())
+	app.WithContext(cmd.Context()).WithQuery(query).WithShowChunks(showChunks).
+		WithDisabledSubsystems(disabledSubsystems)
 
 	// Create and run the bubbletea program
-	p := tea.NewProgram(app, tea.WithAltScreen())
+	// Interrupting the command closes the program so shutdown can run
+	p := tea.NewProgram(app, tea.WithAltScreen(), tea.WithContext(cmd.Context()))
 
 	if _, err := p.Run(); err != nil {
 		return fmt.Errorf("TUI error: %w", err)
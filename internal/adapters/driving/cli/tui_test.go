@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,12 @@ func (m *MockTUISearchService) Search(
 	return []domain.SearchResult{}, nil
 }
 
+func (m *MockTUISearchService) SearchByChunk(
+	_ context.Context, _ string, _ domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	return []domain.ChunkSearchResult{}, nil
+}
+
 // MockTUISourceService implements driving.SourceService for TUI tests.
 type MockTUISourceService struct{}
 
@@ -43,6 +50,30 @@ func (m *MockTUISourceService) Remove(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockTUISourceService) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockTUISourceService) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockTUISourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *MockTUISourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *MockTUISourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *MockTUISourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
 func (m *MockTUISourceService) Get(ctx context.Context, id string) (*domain.Source, error) {
 	return &domain.Source{}, nil
 }
@@ -59,6 +90,10 @@ func (m *MockTUISourceService) ValidateConfig(
 	return nil
 }
 
+func (m *MockTUISourceService) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
 // MockTUISyncOrchestrator implements driving.SyncOrchestrator for TUI tests.
 type MockTUISyncOrchestrator struct{}
 
@@ -70,6 +105,14 @@ func (m *MockTUISyncOrchestrator) SyncAll(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockTUISyncOrchestrator) SyncSince(ctx context.Context, sourceID string, _ time.Time) error {
+	return m.Sync(ctx, sourceID)
+}
+
+func (m *MockTUISyncOrchestrator) SyncAllSince(ctx context.Context, _ time.Time) error {
+	return m.SyncAll(ctx)
+}
+
 func (m *MockTUISyncOrchestrator) Status(ctx context.Context, sourceID string) (*driving.SyncStatus, error) {
 	return nil, nil
 }
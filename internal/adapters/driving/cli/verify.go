@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// verifyRepair reindexes affected documents and removes orphaned entries.
+var verifyRepair bool
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check the search indexes against the document store",
+	Long: `Cross-checks every non-excluded document against the keyword (Xapian)
+and vector (HNSW) indexes, listing chunks missing from an index and index
+entries for chunks that no longer exist.
+
+Use --repair to reindex the affected documents from the document store and
+delete orphaned entries, without a full resync.`,
+	Args: cobra.NoArgs,
+	RunE: runVerify,
+}
+
+func init() {
+	verifyCmd.Flags().BoolVar(&verifyRepair, "repair", false,
+		"Reindex affected documents and delete orphaned index entries")
+	rootCmd.AddCommand(verifyCmd)
+}
+
+func runVerify(cmd *cobra.Command, _ []string) error {
+	if integrityService == nil {
+		return errors.New("integrity service not configured")
+	}
+
+	ctx := cmd.Context()
+	report, err := integrityService.Verify(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to verify indexes: %w", err)
+	}
+
+	cmd.Printf("Checked %d document(s), %d chunk(s), %d embedded\n",
+		report.Documents, report.Chunks, report.Embedded)
+	cmd.Printf("Keyword index: %s\n", checkedLabel(report.KeywordChecked))
+	cmd.Printf("Vector index:  %s\n", checkedLabel(report.VectorChecked))
+	cmd.Println()
+
+	if report.OK() {
+		cmd.Println("Indexes OK")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tCHUNK\tDOCUMENT")
+	for _, issue := range report.Issues {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", issueLabel(issue.Kind), issue.ChunkID, issueDocument(issue))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	cmd.Println()
+
+	if !verifyRepair {
+		return fmt.Errorf("%d discrepancies found; run 'sercha verify --repair' to fix them", len(report.Issues))
+	}
+
+	repaired, err := integrityService.Repair(ctx, report)
+	if err != nil {
+		return fmt.Errorf("failed to repair indexes: %w", err)
+	}
+	cmd.Printf("Repaired %d issue(s)\n", repaired)
+	return nil
+}
+
+// checkedLabel describes whether an index was checked.
+func checkedLabel(checked bool) string {
+	if checked {
+		return "checked"
+	}
+	return "skipped (not available or cannot list entries)"
+}
+
+// issueLabel returns a readable description of an issue kind.
+func issueLabel(kind domain.IntegrityIssueKind) string {
+	switch kind {
+	case domain.IssueMissingKeyword:
+		return "missing from keyword index"
+	case domain.IssueMissingVector:
+		return "missing from vector index"
+	case domain.IssueOrphanKeyword:
+		return "orphaned keyword entry"
+	case domain.IssueOrphanVector:
+		return "orphaned vector entry"
+	default:
+		return string(kind)
+	}
+}
+
+// issueDocument returns the document column for an issue.
+func issueDocument(issue domain.IntegrityIssue) string {
+	switch {
+	case issue.URI != "":
+		return issue.URI
+	case issue.DocumentID != "":
+		return issue.DocumentID
+	default:
+		return "-"
+	}
+}
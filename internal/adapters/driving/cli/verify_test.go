@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockIntegrityService implements driving.IntegrityService for testing.
+type mockIntegrityService struct {
+	report    *domain.IntegrityReport
+	verifyErr error
+	repairErr error
+	repaired  bool
+}
+
+func (m *mockIntegrityService) Verify(_ context.Context) (*domain.IntegrityReport, error) {
+	return m.report, m.verifyErr
+}
+
+func (m *mockIntegrityService) Repair(_ context.Context, report *domain.IntegrityReport) (int, error) {
+	m.repaired = true
+	if m.repairErr != nil {
+		return 0, m.repairErr
+	}
+	return len(report.Issues), nil
+}
+
+// runVerifyCmd executes the verify command with the given service and arguments.
+func runVerifyCmd(t *testing.T, svc *mockIntegrityService, args ...string) (string, error) {
+	t.Helper()
+	oldService := integrityService
+	integrityService = svc
+	defer func() {
+		integrityService = oldService
+		verifyRepair = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"verify"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func brokenReport() *domain.IntegrityReport {
+	return &domain.IntegrityReport{
+		Documents:      2,
+		Chunks:         3,
+		Embedded:       1,
+		KeywordChecked: true,
+		Issues: []domain.IntegrityIssue{
+			{Kind: domain.IssueMissingKeyword, ChunkID: "c1", DocumentID: "doc-1", URI: "/notes/a.md"},
+			{Kind: domain.IssueOrphanKeyword, ChunkID: "stale"},
+		},
+	}
+}
+
+func TestVerifyCmd_Use(t *testing.T) {
+	assert.Equal(t, "verify", verifyCmd.Use)
+	assert.NotNil(t, verifyCmd.Flags().Lookup("repair"))
+}
+
+func TestVerifyCmd_Consistent(t *testing.T) {
+	svc := &mockIntegrityService{report: &domain.IntegrityReport{
+		Documents: 2, Chunks: 3, KeywordChecked: true, VectorChecked: true,
+	}}
+
+	out, err := runVerifyCmd(t, svc)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Checked 2 document(s), 3 chunk(s)")
+	assert.Contains(t, out, "Indexes OK")
+	assert.False(t, svc.repaired)
+}
+
+func TestVerifyCmd_ReportsIssues(t *testing.T) {
+	svc := &mockIntegrityService{report: brokenReport()}
+
+	out, err := runVerifyCmd(t, svc)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 discrepancies found")
+	assert.Contains(t, out, "missing from keyword index")
+	assert.Contains(t, out, "/notes/a.md")
+	assert.Contains(t, out, "orphaned keyword entry")
+	assert.Contains(t, out, "Vector index:  skipped")
+	assert.False(t, svc.repaired)
+}
+
+func TestVerifyCmd_Repair(t *testing.T) {
+	svc := &mockIntegrityService{report: brokenReport()}
+
+	out, err := runVerifyCmd(t, svc, "--repair")
+
+	require.NoError(t, err)
+	assert.True(t, svc.repaired)
+	assert.Contains(t, out, "Repaired 2 issue(s)")
+}
+
+func TestVerifyCmd_RepairError(t *testing.T) {
+	svc := &mockIntegrityService{report: brokenReport(), repairErr: errors.New("disk full")}
+
+	_, err := runVerifyCmd(t, svc, "--repair")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to repair indexes")
+}
+
+func TestVerifyCmd_VerifyError(t *testing.T) {
+	svc := &mockIntegrityService{verifyErr: errors.New("store closed")}
+
+	_, err := runVerifyCmd(t, svc)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to verify indexes")
+}
+
+func TestVerifyCmd_NoService(t *testing.T) {
+	oldService := integrityService
+	integrityService = nil
+	defer func() { integrityService = oldService }()
+
+	rootCmd.SetArgs([]string{"verify"})
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "integrity service not configured")
+}
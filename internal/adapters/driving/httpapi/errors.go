@@ -0,0 +1,19 @@
+// Package httpapi provides a local HTTP/JSON API adapter for Sercha.
+// It lets other local tools, such as editor plugins and browser extensions,
+// search the index through the same driving ports as the CLI and TUI.
+package httpapi
+
+import "errors"
+
+var (
+	// ErrMissingSearchService is returned when the search service is not provided.
+	ErrMissingSearchService = errors.New("httpapi: search service is required")
+
+	// ErrTokenRequired is returned when the server would listen beyond the
+	// loopback interface without a token.
+	ErrTokenRequired = errors.New("httpapi: a token is required to listen on a non-loopback address")
+
+	// ErrWebhookServicesRequired is returned when webhooks are enabled without
+	// the source and sync services they use.
+	ErrWebhookServicesRequired = errors.New("httpapi: webhooks require the source and sync services")
+)
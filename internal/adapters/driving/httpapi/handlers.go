@@ -0,0 +1,313 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// maxRequestBody bounds the size of a search request body.
+const maxRequestBody = 1 << 20
+
+// handleHealth answers so clients can check the server is up.
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleSearchQuery runs a search given as URL query parameters.
+func (s *Server) handleSearchQuery(w http.ResponseWriter, r *http.Request) {
+	req, err := searchRequestFromQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	s.search(w, r, req)
+}
+
+// handleSearchBody runs a search given as a JSON body.
+func (s *Server) handleSearchBody(w http.ResponseWriter, r *http.Request) {
+	var req SearchRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid search request: %w", err))
+		return
+	}
+	s.search(w, r, req)
+}
+
+// search runs a search request and writes its results.
+func (s *Server) search(w http.ResponseWriter, r *http.Request, req SearchRequest) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		writeError(w, http.StatusBadRequest, errors.New("query is required"))
+		return
+	}
+	opts, err := req.options()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	response := SearchResponse{Results: []SearchResult{}}
+	if req.Chunks {
+		chunks, err := s.ports.Search.SearchByChunk(r.Context(), query, opts)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		for i := range chunks {
+			response.Results = append(response.Results, SearchResult{
+				Document: newDocument(&chunks[i].Document),
+				ChunkID:  chunks[i].Chunk.ID,
+				Content:  chunks[i].Chunk.Content,
+				Score:    chunks[i].Score,
+				Feedback: string(chunks[i].Feedback),
+			})
+		}
+	} else {
+		results, err := s.ports.Search.Search(r.Context(), query, opts)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		for i := range results {
+			response.Results = append(response.Results, SearchResult{
+				Document:   newDocument(&results[i].Document),
+				ChunkID:    results[i].Chunk.ID,
+				Content:    results[i].Chunk.Content,
+				Score:      results[i].Score,
+				Highlights: results[i].Highlights,
+				SourceName: results[i].SourceName,
+				Feedback:   string(results[i].Feedback),
+			})
+		}
+	}
+	response.Count = len(response.Results)
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleSources lists the sources with their sync status.
+func (s *Server) handleSources(w http.ResponseWriter, r *http.Request) {
+	if s.ports.Source == nil {
+		writeError(w, http.StatusNotImplemented, errors.New("source service not configured"))
+		return
+	}
+	sources, err := s.ports.Source.List(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	statuses, err := s.sourceStatuses(r)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	response := SourcesResponse{Sources: make([]Source, len(sources))}
+	for i := range sources {
+		response.Sources[i] = newSource(&sources[i], statuses[sources[i].ID])
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleDocument returns a document with its content. The content is left
+// out when the content query parameter is false.
+func (s *Server) handleDocument(w http.ResponseWriter, r *http.Request) {
+	if s.ports.Document == nil {
+		writeError(w, http.StatusNotImplemented, errors.New("document service not configured"))
+		return
+	}
+	withContent := true
+	if value := r.URL.Query().Get("content"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid content %q: must be true or false", value))
+			return
+		}
+		withContent = parsed
+	}
+
+	id := r.PathValue("id")
+	doc, err := s.ports.Document.Get(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	response := DocumentResponse{Document: newDocument(doc), Metadata: doc.Metadata}
+	if withContent {
+		content, err := s.ports.Document.GetContent(r.Context(), id)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		response.Content = content
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleStats returns the index statistics shown by sercha stats.
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	if s.ports.Source == nil {
+		writeError(w, http.StatusNotImplemented, errors.New("source service not configured"))
+		return
+	}
+	sources, err := s.ports.Source.List(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	statuses, err := s.sourceStatuses(r)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	response := StatsResponse{Sources: len(sources)}
+	for i := range sources {
+		response.Documents += statuses[sources[i].ID].DocumentCount
+	}
+	if s.ports.Embeddings != nil {
+		stats, err := s.ports.Embeddings.Stats(r.Context())
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		response.Embeddings = &EmbeddingStats{Pending: stats.Pending, Retrying: stats.Retrying, Failed: stats.Failed}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// sourceStatuses returns the sync status of every source by ID.
+func (s *Server) sourceStatuses(r *http.Request) (map[string]domain.SourceStatus, error) {
+	statuses, err := s.ports.Source.Statuses(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]domain.SourceStatus, len(statuses))
+	for i := range statuses {
+		byID[statuses[i].SourceID] = statuses[i]
+	}
+	return byID, nil
+}
+
+// searchRequestFromQuery reads a search request from URL query parameters.
+// Lists are given by repeating a parameter, such as source=a&source=b.
+func searchRequestFromQuery(values url.Values) (SearchRequest, error) {
+	req := SearchRequest{
+		Query:      values.Get("q"),
+		Sources:    values["source"],
+		Collection: values.Get("collection"),
+		Mode:       values.Get("mode"),
+		Sort:       values.Get("sort"),
+		Authors:    values["author"],
+		Language:   values.Get("language"),
+		Rerank:     values.Get("rerank"),
+	}
+
+	ints := map[string]*int{
+		"limit":             &req.Limit,
+		"offset":            &req.Offset,
+		"rerank_candidates": &req.RerankCandidates,
+	}
+	for name, target := range ints {
+		if value := values.Get(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return req, fmt.Errorf("invalid %s %q: must be a number", name, value)
+			}
+			*target = parsed
+		}
+	}
+
+	bools := map[string]*bool{
+		"chunks":   &req.Chunks,
+		"semantic": &req.Semantic,
+		"hybrid":   &req.Hybrid,
+	}
+	for name, target := range bools {
+		if value := values.Get(name); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return req, fmt.Errorf("invalid %s %q: must be true or false", name, value)
+			}
+			*target = parsed
+		}
+	}
+
+	if value := values.Get("min_score"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return req, fmt.Errorf("invalid min_score %q: must be a number", value)
+		}
+		req.MinScore = parsed
+	}
+	return req, nil
+}
+
+// options converts the request to search options, checking its values.
+func (req *SearchRequest) options() (domain.SearchOptions, error) {
+	opts := domain.SearchOptions{
+		Limit:            req.Limit,
+		Offset:           req.Offset,
+		SourceIDs:        req.Sources,
+		Collection:       strings.TrimSpace(req.Collection),
+		Semantic:         req.Semantic,
+		Hybrid:           req.Hybrid,
+		Mode:             domain.SearchMode(req.Mode),
+		SortBy:           domain.SortField(req.Sort),
+		MinScore:         req.MinScore,
+		Authors:          req.Authors,
+		Language:         strings.ToLower(strings.TrimSpace(req.Language)),
+		Rerank:           domain.RerankMode(req.Rerank),
+		RerankCandidates: req.RerankCandidates,
+	}
+	if opts.Limit < 0 || opts.Offset < 0 || opts.RerankCandidates < 0 {
+		return opts, errors.New("limit, offset and rerank_candidates cannot be negative")
+	}
+	if opts.Mode != "" && !opts.Mode.IsValid() {
+		return opts, fmt.Errorf("invalid mode %q: must be text_only, hybrid, vector_only, llm_assisted or full", req.Mode)
+	}
+	if opts.SortBy != "" && !opts.SortBy.IsValid() {
+		return opts, fmt.Errorf("invalid sort %q: must be score, date, title or source", req.Sort)
+	}
+	switch opts.Rerank {
+	case domain.RerankModeDefault, domain.RerankModeOn, domain.RerankModeOff:
+	default:
+		return opts, fmt.Errorf("invalid rerank %q: must be on or off", req.Rerank)
+	}
+	return opts, nil
+}
+
+// writeServiceError writes an error from a driving port with the status
+// matching its kind.
+func writeServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		writeError(w, http.StatusNotFound, err)
+	case errors.Is(err, domain.ErrInvalidInput):
+		writeError(w, http.StatusBadRequest, err)
+	case errors.Is(err, domain.ErrNotImplemented):
+		writeError(w, http.StatusNotImplemented, err)
+	default:
+		writeError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body) //nolint:errcheck // the client may have gone
+}
@@ -0,0 +1,210 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// serve sends a request to a server built from the ports and decodes the
+// JSON response into out.
+func serve(t *testing.T, ports *Ports, method, target, body string, out any) int {
+	t.Helper()
+	server, err := NewServer(ports, Config{})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Host = "localhost:7700"
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	if out != nil {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), out))
+	}
+	return rec.Code
+}
+
+func TestHandleSearch_QueryParameters(t *testing.T) {
+	search := &mockSearchService{results: []domain.SearchResult{{
+		Document:   domain.Document{ID: "doc-1", SourceID: "src-1", Title: "Notes"},
+		Chunk:      domain.Chunk{ID: "chunk-1", Content: "meeting notes"},
+		Score:      0.9,
+		Highlights: []string{"meeting"},
+		SourceName: "Notes",
+	}}}
+
+	var response SearchResponse
+	code := serve(t, &Ports{Search: search}, http.MethodGet,
+		"/v1/search?q=meeting&limit=5&offset=10&source=src-1&source=src-2&collection=Work"+
+			"&mode=hybrid&sort=date&min_score=0.5&author=alice&language=EN&rerank=off&rerank_candidates=20",
+		"", &response)
+
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "meeting", search.query)
+	assert.Equal(t, domain.SearchOptions{
+		Limit:            5,
+		Offset:           10,
+		SourceIDs:        []string{"src-1", "src-2"},
+		Collection:       "Work",
+		Mode:             domain.SearchModeHybrid,
+		SortBy:           domain.SortByDate,
+		MinScore:         0.5,
+		Authors:          []string{"alice"},
+		Language:         "en",
+		Rerank:           domain.RerankModeOff,
+		RerankCandidates: 20,
+	}, search.opts)
+	require.Equal(t, 1, response.Count)
+	assert.Equal(t, "doc-1", response.Results[0].Document.ID)
+	assert.Equal(t, "chunk-1", response.Results[0].ChunkID)
+	assert.Equal(t, []string{"meeting"}, response.Results[0].Highlights)
+}
+
+func TestHandleSearch_Body(t *testing.T) {
+	search := &mockSearchService{chunks: []domain.ChunkSearchResult{{
+		Document: domain.Document{ID: "doc-1"},
+		Chunk:    domain.Chunk{ID: "chunk-1", Content: "budget"},
+		Score:    0.7,
+	}}}
+
+	var response SearchResponse
+	code := serve(t, &Ports{Search: search}, http.MethodPost, "/v1/search",
+		`{"query":"budget","sources":["src-1"],"semantic":true,"chunks":true}`, &response)
+
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "budget", search.query)
+	assert.Equal(t, []string{"src-1"}, search.opts.SourceIDs)
+	assert.True(t, search.opts.Semantic)
+	require.Equal(t, 1, response.Count)
+	assert.Equal(t, "chunk-1", response.Results[0].ChunkID)
+}
+
+func TestHandleSearch_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+	}{
+		{"missing query", http.MethodGet, "/v1/search", ""},
+		{"bad limit", http.MethodGet, "/v1/search?q=x&limit=ten", ""},
+		{"negative offset", http.MethodGet, "/v1/search?q=x&offset=-1", ""},
+		{"bad mode", http.MethodGet, "/v1/search?q=x&mode=fuzzy", ""},
+		{"bad sort", http.MethodGet, "/v1/search?q=x&sort=size", ""},
+		{"bad rerank", http.MethodGet, "/v1/search?q=x&rerank=maybe", ""},
+		{"bad json", http.MethodPost, "/v1/search", `{"query":`},
+		{"unknown field", http.MethodPost, "/v1/search", `{"query":"x","colour":"red"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var response ErrorResponse
+			code := serve(t, &Ports{Search: &mockSearchService{}}, tt.method, tt.target, tt.body, &response)
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.NotEmpty(t, response.Error)
+		})
+	}
+}
+
+func TestHandleSearch_ServiceError(t *testing.T) {
+	search := &mockSearchService{err: errors.New("index unavailable")}
+
+	var response ErrorResponse
+	code := serve(t, &Ports{Search: search}, http.MethodGet, "/v1/search?q=x", "", &response)
+
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, "index unavailable", response.Error)
+}
+
+func TestHandleSources(t *testing.T) {
+	lastSync := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	source := &mockSourceService{
+		sources: []domain.Source{
+			{ID: "src-1", Type: "filesystem", Name: "Notes", Collection: "Work"},
+			{ID: "src-2", Type: "github", Name: "Repo"},
+		},
+		statuses: []domain.SourceStatus{{SourceID: "src-1", LastSync: lastSync, DocumentCount: 12}},
+	}
+
+	var response SourcesResponse
+	code := serve(t, &Ports{Search: &mockSearchService{}, Source: source}, http.MethodGet, "/v1/sources", "", &response)
+
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, response.Sources, 2)
+	assert.Equal(t, "Work", response.Sources[0].Collection)
+	assert.Equal(t, 12, response.Sources[0].DocumentCount)
+	require.NotNil(t, response.Sources[0].LastSync)
+	assert.True(t, lastSync.Equal(*response.Sources[0].LastSync))
+	assert.Nil(t, response.Sources[1].LastSync)
+}
+
+func TestHandleDocument(t *testing.T) {
+	document := &mockDocumentService{
+		document: &domain.Document{ID: "doc-1", Title: "Notes", Metadata: map[string]any{"language": "en"}},
+		content:  "full content",
+	}
+	ports := &Ports{Search: &mockSearchService{}, Document: document}
+
+	var response DocumentResponse
+	code := serve(t, ports, http.MethodGet, "/v1/documents/doc-1", "", &response)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "doc-1", response.ID)
+	assert.Equal(t, "en", response.Language)
+	assert.Equal(t, "full content", response.Content)
+
+	response = DocumentResponse{}
+	code = serve(t, ports, http.MethodGet, "/v1/documents/doc-1?content=false", "", &response)
+	require.Equal(t, http.StatusOK, code)
+	assert.Empty(t, response.Content)
+}
+
+func TestHandleDocument_NotFound(t *testing.T) {
+	document := &mockDocumentService{err: domain.ErrNotFound}
+
+	code := serve(t, &Ports{Search: &mockSearchService{}, Document: document},
+		http.MethodGet, "/v1/documents/missing", "", nil)
+
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestHandleStats(t *testing.T) {
+	source := &mockSourceService{
+		sources:  []domain.Source{{ID: "src-1"}, {ID: "src-2"}},
+		statuses: []domain.SourceStatus{{SourceID: "src-1", DocumentCount: 3}, {SourceID: "src-2", DocumentCount: 4}},
+	}
+	ports := &Ports{Search: &mockSearchService{}, Source: source}
+
+	var response StatsResponse
+	code := serve(t, ports, http.MethodGet, "/v1/stats", "", &response)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 2, response.Sources)
+	assert.Equal(t, 7, response.Documents)
+	assert.Nil(t, response.Embeddings)
+
+	ports.Embeddings = &mockEmbeddingQueue{stats: domain.EmbeddingQueueStats{Pending: 5, Failed: 1}}
+	response = StatsResponse{}
+	code = serve(t, ports, http.MethodGet, "/v1/stats", "", &response)
+	require.Equal(t, http.StatusOK, code)
+	require.NotNil(t, response.Embeddings)
+	assert.Equal(t, 5, response.Embeddings.Pending)
+	assert.Equal(t, 1, response.Embeddings.Failed)
+}
+
+func TestHandlers_MissingPorts(t *testing.T) {
+	ports := &Ports{Search: &mockSearchService{}}
+
+	for _, target := range []string{"/v1/sources", "/v1/documents/doc-1", "/v1/stats"} {
+		code := serve(t, ports, http.MethodGet, target, "", nil)
+		assert.Equal(t, http.StatusNotImplemented, code, target)
+	}
+	assert.Equal(t, http.StatusNotFound, serve(t, ports, http.MethodGet, "/v2/unknown", "", nil))
+}
@@ -0,0 +1,233 @@
+package httpapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// mockSearchService is a mock implementation of driving.SearchService.
+type mockSearchService struct {
+	results []domain.SearchResult
+	chunks  []domain.ChunkSearchResult
+	err     error
+	query   string
+	opts    domain.SearchOptions
+}
+
+func (m *mockSearchService) Search(
+	_ context.Context,
+	query string,
+	opts domain.SearchOptions,
+) ([]domain.SearchResult, error) {
+	m.query = query
+	m.opts = opts
+	return m.results, m.err
+}
+
+func (m *mockSearchService) SearchByChunk(
+	_ context.Context,
+	query string,
+	opts domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	m.query = query
+	m.opts = opts
+	return m.chunks, m.err
+}
+
+// mockSourceService is a mock implementation of driving.SourceService.
+type mockSourceService struct {
+	sources  []domain.Source
+	statuses []domain.SourceStatus
+	source   *domain.Source
+	err      error
+}
+
+func (m *mockSourceService) Add(_ context.Context, _ domain.Source) error {
+	return m.err
+}
+
+func (m *mockSourceService) Get(_ context.Context, _ string) (*domain.Source, error) {
+	return m.source, m.err
+}
+
+func (m *mockSourceService) List(_ context.Context) ([]domain.Source, error) {
+	return m.sources, m.err
+}
+
+func (m *mockSourceService) Remove(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockSourceService) Archive(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockSourceService) Unarchive(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *mockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *mockSourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, m.err
+}
+
+func (m *mockSourceService) Update(_ context.Context, _ domain.Source) error {
+	return m.err
+}
+
+func (m *mockSourceService) ValidateConfig(_ context.Context, _ string, _ map[string]string) error {
+	return m.err
+}
+
+func (m *mockSourceService) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return m.statuses, m.err
+}
+
+// mockDocumentService is a mock implementation of driving.DocumentService.
+type mockDocumentService struct {
+	documents []domain.Document
+	document  *domain.Document
+	content   string
+	details   *driving.DocumentDetails
+	err       error
+}
+
+func (m *mockDocumentService) ListBySource(_ context.Context, _ string) ([]domain.Document, error) {
+	return m.documents, m.err
+}
+
+func (m *mockDocumentService) Get(_ context.Context, _ string) (*domain.Document, error) {
+	return m.document, m.err
+}
+
+func (m *mockDocumentService) GetContent(_ context.Context, _ string) (string, error) {
+	return m.content, m.err
+}
+
+func (m *mockDocumentService) GetOriginal(_ context.Context, _ string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentService) GetChunks(_ context.Context, _ string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
+func (m *mockDocumentService) GetDetails(_ context.Context, _ string) (*driving.DocumentDetails, error) {
+	return m.details, m.err
+}
+
+func (m *mockDocumentService) GetAncestors(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+	return nil, m.err
+}
+
+func (m *mockDocumentService) Exclude(_ context.Context, _, _ string) error {
+	return m.err
+}
+
+func (m *mockDocumentService) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, m.err
+}
+
+func (m *mockDocumentService) RetryQuarantined(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockDocumentService) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	return len(ids), nil
+}
+
+func (m *mockDocumentService) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, m.err
+}
+
+func (m *mockDocumentService) Refresh(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockDocumentService) Open(_ context.Context, _ string) error {
+	return m.err
+}
+
+// mockEmbeddingQueue is a mock implementation of driving.EmbeddingQueue.
+type mockEmbeddingQueue struct {
+	stats domain.EmbeddingQueueStats
+	err   error
+}
+
+func (m *mockEmbeddingQueue) Start(_ context.Context) error {
+	return m.err
+}
+
+func (m *mockEmbeddingQueue) Pending(_ context.Context) (int, error) {
+	return m.stats.Pending, m.err
+}
+
+func (m *mockEmbeddingQueue) Stats(_ context.Context) (domain.EmbeddingQueueStats, error) {
+	return m.stats, m.err
+}
+
+func (m *mockEmbeddingQueue) Wait(_ context.Context) error {
+	return m.err
+}
+
+// mockSyncOrchestrator is a mock implementation of driving.SyncOrchestrator
+// and driving.ScopedSync that records the syncs it runs.
+type mockSyncOrchestrator struct {
+	mu     sync.Mutex
+	synced []string
+	scopes map[string][]string
+	err    error
+}
+
+func (m *mockSyncOrchestrator) Sync(_ context.Context, sourceID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.synced = append(m.synced, sourceID)
+	return m.err
+}
+
+func (m *mockSyncOrchestrator) SyncScope(_ context.Context, sourceID string, scope []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.scopes == nil {
+		m.scopes = make(map[string][]string)
+	}
+	m.scopes[sourceID] = append(m.scopes[sourceID], scope...)
+	return m.err
+}
+
+func (m *mockSyncOrchestrator) SyncAll(_ context.Context) error {
+	return m.err
+}
+
+func (m *mockSyncOrchestrator) SyncSince(_ context.Context, _ string, _ time.Time) error {
+	return m.err
+}
+
+func (m *mockSyncOrchestrator) SyncAllSince(_ context.Context, _ time.Time) error {
+	return m.err
+}
+
+func (m *mockSyncOrchestrator) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
+	return nil, m.err
+}
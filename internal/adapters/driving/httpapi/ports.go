@@ -0,0 +1,34 @@
+package httpapi
+
+import (
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ports aggregates the driving port interfaces served by the HTTP API.
+// This provides a single injection point for dependency injection.
+type Ports struct {
+	// Search provides search capabilities.
+	Search driving.SearchService
+
+	// Source lists sources and their sync status.
+	Source driving.SourceService
+
+	// Document retrieves documents.
+	Document driving.DocumentService
+
+	// Embeddings reports the embedding queue. Nil when embeddings are disabled.
+	Embeddings driving.EmbeddingQueue
+
+	// Sync runs the syncs webhooks ask for. Required with a webhook secret.
+	Sync driving.SyncOrchestrator
+}
+
+// Validate ensures all required ports are set.
+// Returns an error if any required port is nil.
+func (p *Ports) Validate() error {
+	if p.Search == nil {
+		return ErrMissingSearchService
+	}
+	// Endpoints of the other ports answer 501 when they are not set
+	return nil
+}
@@ -0,0 +1,182 @@
+package httpapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/logger"
+)
+
+// DefaultAddr is the address the API listens on when none is given. It is
+// bound to loopback so only local tools can reach it.
+const DefaultAddr = "127.0.0.1:7700"
+
+// Config holds configuration for the HTTP API server.
+type Config struct {
+	// Addr is the host:port to listen on. Defaults to DefaultAddr.
+	Addr string
+
+	// Token, when set, must be sent by every request as a bearer token in
+	// the Authorization header. Required to listen beyond loopback.
+	Token string
+
+	// GitHubWebhookSecret, when set, enables the GitHub webhook endpoint.
+	// Deliveries are authenticated by their HMAC signature with this secret
+	// instead of the token, so GitHub can reach the endpoint through a tunnel.
+	GitHubWebhookSecret string
+}
+
+// Server serves the driving ports over HTTP/JSON.
+type Server struct {
+	ports *Ports
+	cfg   Config
+	log   *slog.Logger
+	syncs *syncQueue
+}
+
+// NewServer creates a new HTTP API server with the given ports.
+func NewServer(ports *Ports, cfg Config) (*Server, error) {
+	if err := ports.Validate(); err != nil {
+		return nil, fmt.Errorf("validating ports: %w", err)
+	}
+	if cfg.Addr == "" {
+		cfg.Addr = DefaultAddr
+	}
+	loopback, err := isLoopbackAddr(cfg.Addr)
+	if err != nil {
+		return nil, err
+	}
+	if !loopback && cfg.Token == "" {
+		return nil, ErrTokenRequired
+	}
+
+	s := &Server{ports: ports, cfg: cfg, log: logger.Slog()}
+	if cfg.GitHubWebhookSecret != "" {
+		// Webhooks look up GitHub sources and sync them
+		if ports.Source == nil || ports.Sync == nil {
+			return nil, ErrWebhookServicesRequired
+		}
+		s.syncs = newSyncQueue(ports.Sync, s.log)
+	}
+	return s, nil
+}
+
+// SetLogger sets the structured logger used for webhook events.
+// Defaults to the shared application logger.
+func (s *Server) SetLogger(log *slog.Logger) {
+	if log == nil {
+		return
+	}
+	s.log = log
+	if s.syncs != nil {
+		s.syncs.log = log
+	}
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() string {
+	return s.cfg.Addr
+}
+
+// Handler returns the HTTP handler serving the API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/health", s.handleHealth)
+	mux.HandleFunc("GET /v1/search", s.handleSearchQuery)
+	mux.HandleFunc("POST /v1/search", s.handleSearchBody)
+	mux.HandleFunc("GET /v1/sources", s.handleSources)
+	mux.HandleFunc("GET /v1/documents/{id}", s.handleDocument)
+	mux.HandleFunc("GET /v1/stats", s.handleStats)
+	if s.syncs != nil {
+		mux.HandleFunc("POST "+GitHubWebhookPath, s.handleGitHubWebhook)
+	}
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+	})
+	return s.authenticate(mux)
+}
+
+// Run starts the server on the configured address.
+// It blocks until the context is cancelled or an error occurs.
+func (s *Server) Run(ctx context.Context) error {
+	httpServer := &http.Server{
+		Addr:              s.cfg.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Graceful shutdown when context is cancelled
+	go func() {
+		<-ctx.Done()
+		httpServer.Shutdown(context.Background()) //nolint:errcheck
+	}()
+
+	err := httpServer.ListenAndServe()
+	if s.syncs != nil {
+		s.syncs.stop()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// authenticate checks the bearer token when one is configured. Without a
+// token, only requests addressed to a loopback host name are served, so a
+// web page cannot reach the API through DNS rebinding. Webhook deliveries
+// carry a signature instead, checked by their handler.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.syncs != nil && r.URL.Path == GitHubWebhookPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if s.cfg.Token == "" {
+			if !isLoopbackHost(r.Host) {
+				writeError(w, http.StatusForbidden, errors.New("requests must be addressed to localhost"))
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="sercha"`)
+			writeError(w, http.StatusUnauthorized, errors.New("missing or invalid token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isLoopbackAddr reports whether a listen address is bound to loopback.
+// An empty host listens on every interface.
+func isLoopbackAddr(addr string) (bool, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false, fmt.Errorf("invalid listen address %q: %w", addr, err)
+	}
+	return host != "" && isLoopbackHost(host), nil
+}
+
+// isLoopbackHost reports whether a host, with or without a port, names the
+// loopback interface.
+func isLoopbackHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,100 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServer(t *testing.T) {
+	t.Run("nil search service returns error", func(t *testing.T) {
+		server, err := NewServer(&Ports{}, Config{})
+		require.Error(t, err)
+		assert.Nil(t, server)
+		assert.ErrorIs(t, err, ErrMissingSearchService)
+	})
+
+	t.Run("defaults to loopback address", func(t *testing.T) {
+		server, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{})
+		require.NoError(t, err)
+		assert.Equal(t, DefaultAddr, server.Addr())
+	})
+
+	t.Run("non-loopback address requires token", func(t *testing.T) {
+		_, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{Addr: "0.0.0.0:7700"})
+		assert.ErrorIs(t, err, ErrTokenRequired)
+
+		_, err = NewServer(&Ports{Search: &mockSearchService{}}, Config{Addr: ":7700"})
+		assert.ErrorIs(t, err, ErrTokenRequired)
+
+		server, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{Addr: "0.0.0.0:7700", Token: "secret"})
+		require.NoError(t, err)
+		assert.NotNil(t, server)
+	})
+
+	t.Run("invalid address returns error", func(t *testing.T) {
+		_, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{Addr: "localhost"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPorts_Validate(t *testing.T) {
+	t.Run("nil search service returns error", func(t *testing.T) {
+		ports := &Ports{}
+		assert.ErrorIs(t, ports.Validate(), ErrMissingSearchService)
+	})
+
+	t.Run("search only is valid", func(t *testing.T) {
+		ports := &Ports{Search: &mockSearchService{}}
+		assert.NoError(t, ports.Validate())
+	})
+}
+
+func TestServer_Authenticate(t *testing.T) {
+	t.Run("without token only loopback hosts are served", func(t *testing.T) {
+		server, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{})
+		require.NoError(t, err)
+
+		for _, host := range []string{"localhost:7700", "127.0.0.1:7700", "[::1]:7700"} {
+			req := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+			req.Host = host
+			rec := httptest.NewRecorder()
+			server.Handler().ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code, host)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+		req.Host = "attacker.example:7700"
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("token must match", func(t *testing.T) {
+		server, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{Token: "secret"})
+		require.NoError(t, err)
+
+		tests := []struct {
+			header string
+			want   int
+		}{
+			{"", http.StatusUnauthorized},
+			{"Bearer wrong", http.StatusUnauthorized},
+			{"secret", http.StatusUnauthorized},
+			{"Bearer secret", http.StatusOK},
+		}
+		for _, tt := range tests {
+			req := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+			req.Host = "sercha.lan:7700"
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			server.Handler().ServeHTTP(rec, req)
+			assert.Equal(t, tt.want, rec.Code, tt.header)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package httpapi
+
+import (
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// SearchRequest is a search, given as a JSON body or as URL query parameters
+// of the same names (q for the query, repeated source and author for lists).
+// Zero values follow the configured settings.
+type SearchRequest struct {
+	Query            string   `json:"query"`
+	Limit            int      `json:"limit,omitempty"`
+	Offset           int      `json:"offset,omitempty"`
+	Sources          []string `json:"sources,omitempty"`
+	Collection       string   `json:"collection,omitempty"`
+	Semantic         bool     `json:"semantic,omitempty"`
+	Hybrid           bool     `json:"hybrid,omitempty"`
+	Mode             string   `json:"mode,omitempty"`
+	Sort             string   `json:"sort,omitempty"`
+	MinScore         float64  `json:"min_score,omitempty"`
+	Authors          []string `json:"authors,omitempty"`
+	Language         string   `json:"language,omitempty"`
+	Rerank           string   `json:"rerank,omitempty"`
+	RerankCandidates int      `json:"rerank_candidates,omitempty"`
+	Chunks           bool     `json:"chunks,omitempty"`
+}
+
+// SearchResponse is the response to a search.
+type SearchResponse struct {
+	Results []SearchResult `json:"results"`
+	Count   int            `json:"count"`
+}
+
+// SearchResult is a single search hit.
+type SearchResult struct {
+	Document   Document `json:"document"`
+	ChunkID    string   `json:"chunk_id,omitempty"`
+	Content    string   `json:"content,omitempty"`
+	Score      float64  `json:"score"`
+	Highlights []string `json:"highlights,omitempty"`
+	SourceName string   `json:"source_name,omitempty"`
+	Feedback   string   `json:"feedback,omitempty"`
+}
+
+// Document describes an indexed document.
+type Document struct {
+	ID        string    `json:"id"`
+	SourceID  string    `json:"source_id"`
+	URI       string    `json:"uri"`
+	Title     string    `json:"title"`
+	Author    string    `json:"author,omitempty"`
+	Language  string    `json:"language,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DocumentResponse is a document with its content and metadata.
+type DocumentResponse struct {
+	Document
+	Content  string         `json:"content,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// Source describes a configured source and its sync status.
+type Source struct {
+	ID            string     `json:"id"`
+	Type          string     `json:"type"`
+	Name          string     `json:"name"`
+	Collection    string     `json:"collection,omitempty"`
+	Archived      bool       `json:"archived"`
+	DocumentCount int        `json:"document_count"`
+	LastSync      *time.Time `json:"last_sync,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+}
+
+// SourcesResponse lists the configured sources.
+type SourcesResponse struct {
+	Sources []Source `json:"sources"`
+}
+
+// StatsResponse summarises the index.
+type StatsResponse struct {
+	Sources    int             `json:"sources"`
+	Documents  int             `json:"documents"`
+	Embeddings *EmbeddingStats `json:"embeddings"` // null when embeddings are disabled
+}
+
+// EmbeddingStats counts the chunks in the embedding queue.
+type EmbeddingStats struct {
+	Pending  int `json:"pending"`
+	Retrying int `json:"retrying"`
+	Failed   int `json:"failed"`
+}
+
+// ErrorResponse is the body of every error response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// newDocument converts a domain document to its API form.
+func newDocument(doc *domain.Document) Document {
+	return Document{
+		ID:        doc.ID,
+		SourceID:  doc.SourceID,
+		URI:       doc.URI,
+		Title:     doc.Title,
+		Author:    doc.Author.String(),
+		Language:  domain.DocumentLanguage(doc.Metadata),
+		CreatedAt: doc.CreatedAt,
+		UpdatedAt: doc.UpdatedAt,
+	}
+}
+
+// newSource converts a domain source and its status to its API form.
+func newSource(source *domain.Source, status domain.SourceStatus) Source {
+	out := Source{
+		ID:            source.ID,
+		Type:          source.Type,
+		Name:          source.Name,
+		Collection:    source.Collection,
+		Archived:      source.Archived,
+		DocumentCount: status.DocumentCount,
+		LastError:     status.LastError,
+	}
+	if !status.NeverSynced() {
+		lastSync := status.LastSync
+		out.LastSync = &lastSync
+	}
+	return out
+}
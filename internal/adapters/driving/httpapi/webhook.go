@@ -0,0 +1,233 @@
+package httpapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// GitHubWebhookPath is the path GitHub webhooks are delivered to.
+const GitHubWebhookPath = "/v1/webhooks/github"
+
+// maxWebhookBody bounds the size of a webhook payload. GitHub caps
+// payloads at 25MB.
+const maxWebhookBody = 25 << 20
+
+// GitHub webhook events that change indexed content.
+const (
+	githubEventPush        = "push"
+	githubEventIssues      = "issues"
+	githubEventPullRequest = "pull_request"
+	githubEventPing        = "ping"
+)
+
+// WebhookResponse is the response to a webhook delivery.
+type WebhookResponse struct {
+	Status     string   `json:"status"` // accepted or ignored
+	Reason     string   `json:"reason,omitempty"`
+	Repository string   `json:"repository,omitempty"`
+	Sources    []string `json:"sources,omitempty"`
+}
+
+// githubPayload holds the fields of a webhook payload used to target a sync.
+type githubPayload struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		FullName      string `json:"full_name"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+}
+
+// handleGitHubWebhook verifies a GitHub webhook delivery and starts an
+// incremental sync of the repository it names for every GitHub source.
+// Deliveries that cannot change indexed content are ignored with a logged
+// reason.
+func (s *Server) handleGitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	event := r.Header.Get("X-GitHub-Event")
+	log := s.log.With("event", event, "delivery", r.Header.Get("X-GitHub-Delivery"))
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		log.Warn("github webhook rejected", "reason", "unreadable body", "error", err)
+		writeError(w, http.StatusBadRequest, fmt.Errorf("reading body: %w", err))
+		return
+	}
+	if !validSignature(s.cfg.GitHubWebhookSecret, body, r.Header.Get("X-Hub-Signature-256")) {
+		log.Warn("github webhook rejected", "reason", "missing or invalid signature")
+		writeError(w, http.StatusUnauthorized, errors.New("missing or invalid signature"))
+		return
+	}
+
+	ignore := func(reason string) {
+		log.Info("github webhook ignored", "reason", reason)
+		writeJSON(w, http.StatusOK, WebhookResponse{Status: "ignored", Reason: reason})
+	}
+
+	switch event {
+	case githubEventPing:
+		ignore("ping")
+		return
+	case githubEventPush, githubEventIssues, githubEventPullRequest:
+	default:
+		ignore(fmt.Sprintf("unsupported event %q", event))
+		return
+	}
+
+	var payload githubPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		log.Warn("github webhook rejected", "reason", "invalid payload", "error", err)
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid payload: %w", err))
+		return
+	}
+	repo := payload.Repository.FullName
+	if repo == "" {
+		ignore("payload names no repository")
+		return
+	}
+	// Only the default branch is indexed
+	if event == githubEventPush && payload.Ref != "refs/heads/"+payload.Repository.DefaultBranch {
+		ignore(fmt.Sprintf("push to %s, not the default branch", payload.Ref))
+		return
+	}
+
+	sources, err := s.ports.Source.List(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	var sourceIDs []string
+	for i := range sources {
+		if sources[i].Type == "github" && !sources[i].Archived {
+			sourceIDs = append(sourceIDs, sources[i].ID)
+		}
+	}
+	if len(sourceIDs) == 0 {
+		ignore("no github sources")
+		return
+	}
+
+	for _, sourceID := range sourceIDs {
+		s.syncs.add(sourceID, repo)
+	}
+	log.Info("github webhook accepted", "repository", repo, "sources", sourceIDs)
+	writeJSON(w, http.StatusAccepted, WebhookResponse{Status: "accepted", Repository: repo, Sources: sourceIDs})
+}
+
+// validSignature reports whether header holds the HMAC-SHA256 of body keyed
+// with secret, in GitHub's "sha256=<hex>" form.
+func validSignature(secret string, body []byte, header string) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok || secret == "" {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// syncQueue runs the syncs webhooks ask for in the background, one at a
+// time, merging the repositories of requests for a source that arrive
+// while it waits.
+type syncQueue struct {
+	sync   driving.SyncOrchestrator
+	log    *slog.Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	pending map[string]map[string]bool // Repositories to sync per source
+	order   []string                   // Sources in the order they were queued
+	running bool
+	done    sync.WaitGroup
+}
+
+// newSyncQueue creates a queue running syncs with orchestrator.
+func newSyncQueue(orchestrator driving.SyncOrchestrator, log *slog.Logger) *syncQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &syncQueue{
+		sync:    orchestrator,
+		log:     log,
+		ctx:     ctx,
+		cancel:  cancel,
+		pending: make(map[string]map[string]bool),
+	}
+}
+
+// add queues a sync of repo for a source.
+func (q *syncQueue) add(sourceID, repo string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.pending[sourceID] == nil {
+		q.pending[sourceID] = make(map[string]bool)
+		q.order = append(q.order, sourceID)
+	}
+	q.pending[sourceID][repo] = true
+
+	if !q.running {
+		q.running = true
+		q.done.Add(1)
+		go q.drain()
+	}
+}
+
+// drain runs queued syncs until the queue is empty.
+func (q *syncQueue) drain() {
+	defer q.done.Done()
+	for {
+		q.mu.Lock()
+		if len(q.order) == 0 || q.ctx.Err() != nil {
+			q.running = false
+			q.mu.Unlock()
+			return
+		}
+		sourceID := q.order[0]
+		q.order = q.order[1:]
+		scope := make([]string, 0, len(q.pending[sourceID]))
+		for repo := range q.pending[sourceID] {
+			scope = append(scope, repo)
+		}
+		delete(q.pending, sourceID)
+		q.mu.Unlock()
+
+		sort.Strings(scope)
+		if err := q.run(sourceID, scope); err != nil {
+			q.log.Error("webhook sync failed", "source_id", sourceID, "scope", scope, "error", err)
+		}
+	}
+}
+
+// run syncs a source, limited to scope when the orchestrator can scope syncs.
+func (q *syncQueue) run(sourceID string, scope []string) error {
+	if scoped, ok := q.sync.(driving.ScopedSync); ok {
+		return scoped.SyncScope(q.ctx, sourceID, scope)
+	}
+	return q.sync.Sync(q.ctx, sourceID)
+}
+
+// stop cancels running syncs and waits for them to return.
+func (q *syncQueue) stop() {
+	q.cancel()
+	q.done.Wait()
+}
+
+// wait blocks until the queue is empty.
+func (q *syncQueue) wait() {
+	q.done.Wait()
+}
@@ -0,0 +1,192 @@
+package httpapi
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+// sign returns the X-Hub-Signature-256 header GitHub sends for body.
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookServer creates a token-protected server with webhooks enabled
+// and two GitHub sources, one archived.
+func newWebhookServer(t *testing.T, orchestrator *mockSyncOrchestrator) *Server {
+	t.Helper()
+	source := &mockSourceService{sources: []domain.Source{
+		{ID: "gh-1", Type: "github"},
+		{ID: "gh-2", Type: "github", Archived: true},
+		{ID: "fs-1", Type: "filesystem"},
+	}}
+	server, err := NewServer(
+		&Ports{Search: &mockSearchService{}, Source: source, Sync: orchestrator},
+		Config{Token: "api-token", GitHubWebhookSecret: testWebhookSecret},
+	)
+	require.NoError(t, err)
+	return server
+}
+
+// deliver sends a webhook to the server, signed with secret when it is set.
+func deliver(t *testing.T, server *Server, event, secret, body string) (int, WebhookResponse) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, GitHubWebhookPath, strings.NewReader(body))
+	// Tunnels forward the public host name
+	req.Host = "example.ngrok.app"
+	req.Header.Set("X-GitHub-Event", event)
+	if secret != "" {
+		req.Header.Set("X-Hub-Signature-256", sign(secret, body))
+	}
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+	server.syncs.wait()
+
+	var response WebhookResponse
+	if rec.Code < http.StatusBadRequest {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	}
+	return rec.Code, response
+}
+
+func TestNewServer_Webhooks(t *testing.T) {
+	_, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{GitHubWebhookSecret: "secret"})
+	assert.ErrorIs(t, err, ErrWebhookServicesRequired)
+
+	server, err := NewServer(&Ports{Search: &mockSearchService{}}, Config{})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, GitHubWebhookPath, strings.NewReader("{}"))
+	req.Host = "localhost:7700"
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandleGitHubWebhook_SyncsRepository(t *testing.T) {
+	tests := []struct {
+		event string
+		body  string
+	}{
+		{"push", `{"ref":"refs/heads/main","repository":{"full_name":"octocat/hello","default_branch":"main"}}`},
+		{"issues", `{"action":"opened","repository":{"full_name":"octocat/hello"}}`},
+		{"pull_request", `{"action":"closed","repository":{"full_name":"octocat/hello"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.event, func(t *testing.T) {
+			orchestrator := &mockSyncOrchestrator{}
+			server := newWebhookServer(t, orchestrator)
+
+			code, response := deliver(t, server, tt.event, testWebhookSecret, tt.body)
+
+			require.Equal(t, http.StatusAccepted, code)
+			assert.Equal(t, "accepted", response.Status)
+			assert.Equal(t, []string{"gh-1"}, response.Sources)
+			assert.Equal(t, map[string][]string{"gh-1": {"octocat/hello"}}, orchestrator.scopes)
+			assert.Empty(t, orchestrator.synced)
+		})
+	}
+}
+
+func TestHandleGitHubWebhook_RejectsUnverified(t *testing.T) {
+	body := `{"ref":"refs/heads/main","repository":{"full_name":"octocat/hello","default_branch":"main"}}`
+
+	for name, secret := range map[string]string{"unsigned": "", "wrong secret": "guess"} {
+		t.Run(name, func(t *testing.T) {
+			orchestrator := &mockSyncOrchestrator{}
+			server := newWebhookServer(t, orchestrator)
+
+			code, _ := deliver(t, server, "push", secret, body)
+
+			assert.Equal(t, http.StatusUnauthorized, code)
+			assert.Empty(t, orchestrator.scopes)
+		})
+	}
+}
+
+func TestHandleGitHubWebhook_IgnoresUnrelatedEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  string
+		body   string
+		reason string
+	}{
+		{"ping", "ping", `{"zen":"Keep it simple."}`, "ping"},
+		{"unsupported event", "star", `{"repository":{"full_name":"octocat/hello"}}`, `unsupported event "star"`},
+		{"no repository", "issues", `{"action":"opened"}`, "payload names no repository"},
+		{
+			"push to other branch", "push",
+			`{"ref":"refs/heads/feature","repository":{"full_name":"octocat/hello","default_branch":"main"}}`,
+			"push to refs/heads/feature, not the default branch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestrator := &mockSyncOrchestrator{}
+			server := newWebhookServer(t, orchestrator)
+
+			code, response := deliver(t, server, tt.event, testWebhookSecret, tt.body)
+
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "ignored", response.Status)
+			assert.Equal(t, tt.reason, response.Reason)
+			assert.Empty(t, orchestrator.scopes)
+		})
+	}
+}
+
+func TestHandleGitHubWebhook_OtherRoutesKeepToken(t *testing.T) {
+	server := newWebhookServer(t, &mockSyncOrchestrator{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+	req.Host = "example.ngrok.app"
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestSyncQueue_MergesPendingRepositories(t *testing.T) {
+	orchestrator := &mockSyncOrchestrator{}
+	queue := newSyncQueue(orchestrator, logger.Slog())
+
+	// Hold the queue so both requests wait together
+	queue.mu.Lock()
+	queue.running = true
+	queue.mu.Unlock()
+	queue.add("gh-1", "octocat/world")
+	queue.add("gh-1", "octocat/hello")
+	queue.add("gh-1", "octocat/hello")
+
+	queue.mu.Lock()
+	queue.running = false
+	queue.mu.Unlock()
+	queue.add("gh-1", "octocat/hello")
+	queue.wait()
+
+	assert.Equal(t, map[string][]string{"gh-1": {"octocat/hello", "octocat/world"}}, orchestrator.scopes)
+}
+
+func TestValidSignature(t *testing.T) {
+	body := []byte(`{"zen":"Keep it simple."}`)
+
+	assert.True(t, validSignature("secret", body, sign("secret", string(body))))
+	assert.False(t, validSignature("secret", body, sign("other", string(body))))
+	assert.False(t, validSignature("secret", body, "sha1=abc"))
+	assert.False(t, validSignature("secret", body, "sha256=not-hex"))
+	assert.False(t, validSignature("", body, sign("", string(body))))
+}
@@ -11,16 +11,26 @@ import (
 type mockSearchService struct {
 	results []domain.SearchResult
 	err     error
+	opts    domain.SearchOptions
 }
 
 func (m *mockSearchService) Search(
 	_ context.Context,
 	_ string,
-	_ domain.SearchOptions,
+	opts domain.SearchOptions,
 ) ([]domain.SearchResult, error) {
+	m.opts = opts
 	return m.results, m.err
 }
 
+func (m *mockSearchService) SearchByChunk(
+	_ context.Context,
+	_ string,
+	_ domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	return nil, m.err
+}
+
 // mockSourceService is a mock implementation of driving.SourceService.
 type mockSourceService struct {
 	sources []domain.Source
@@ -44,6 +54,30 @@ func (m *mockSourceService) Remove(_ context.Context, _ string) error {
 	return m.err
 }
 
+func (m *mockSourceService) Archive(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockSourceService) Unarchive(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *mockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *mockSourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, m.err
+}
+
 func (m *mockSourceService) Update(_ context.Context, _ domain.Source) error {
 	return m.err
 }
@@ -52,6 +86,10 @@ func (m *mockSourceService) ValidateConfig(_ context.Context, _ string, _ map[st
 	return m.err
 }
 
+func (m *mockSourceService) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
 // mockDocumentService is a mock implementation of driving.DocumentService.
 type mockDocumentService struct {
 	documents []domain.Document
@@ -73,14 +111,47 @@ func (m *mockDocumentService) GetContent(_ context.Context, _ string) (string, e
 	return m.content, m.err
 }
 
+func (m *mockDocumentService) GetOriginal(_ context.Context, _ string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockDocumentService) GetChunks(_ context.Context, _ string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
 func (m *mockDocumentService) GetDetails(_ context.Context, _ string) (*driving.DocumentDetails, error) {
 	return m.details, m.err
 }
 
+func (m *mockDocumentService) GetAncestors(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+	return nil, m.err
+}
+
 func (m *mockDocumentService) Exclude(_ context.Context, _, _ string) error {
 	return m.err
 }
 
+func (m *mockDocumentService) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, m.err
+}
+
+func (m *mockDocumentService) RetryQuarantined(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockDocumentService) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	return len(ids), nil
+}
+
+func (m *mockDocumentService) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, m.err
+}
+
 func (m *mockDocumentService) Refresh(_ context.Context, _ string) error {
 	return m.err
 }
@@ -63,10 +63,11 @@ func (s *Server) handleSourcesResource(
 
 	// Build simplified source list.
 	type sourceInfo struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-		Type string `json:"type"`
-		URI  string `json:"uri"`
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		URI      string `json:"uri"`
+		Archived bool   `json:"archived,omitempty"`
 	}
 
 	infos := make([]sourceInfo, len(sources))
@@ -77,10 +78,11 @@ func (s *Server) handleSourcesResource(
 			uri = path
 		}
 		infos[i] = sourceInfo{
-			ID:   src.ID,
-			Name: src.Name,
-			Type: src.Type,
-			URI:  uri,
+			ID:       src.ID,
+			Name:     src.Name,
+			Type:     src.Type,
+			URI:      uri,
+			Archived: src.Archived,
 		}
 	}
 
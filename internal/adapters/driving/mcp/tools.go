@@ -10,8 +10,10 @@ import (
 
 // SearchInput is the input schema for the search tool.
 type SearchInput struct {
-	Query string `json:"query" jsonschema:"the search query to find documents"`
-	Limit int    `json:"limit,omitempty" jsonschema:"maximum number of results to return (default 10)"`
+	Query    string   `json:"query" jsonschema:"the search query to find documents"`
+	Limit    int      `json:"limit,omitempty" jsonschema:"maximum number of results to return (default 10)"`
+	Authors  []string `json:"authors,omitempty" jsonschema:"only return documents by these authors (name or identifier)"`
+	Language string   `json:"language,omitempty" jsonschema:"only return documents in this language (ISO 639-1 code such as en or fr)"`
 }
 
 // SearchOutput is the output schema for the search tool.
@@ -25,6 +27,7 @@ type SearchResultOutput struct {
 	DocumentID string   `json:"document_id"`
 	Title      string   `json:"title"`
 	URI        string   `json:"uri"`
+	Author     string   `json:"author,omitempty"`
 	Score      float64  `json:"score"`
 	Highlights []string `json:"highlights,omitempty"`
 	Content    string   `json:"content,omitempty"`
@@ -49,7 +52,7 @@ func (s *Server) handleSearch(
 		limit = 10
 	}
 
-	opts := domain.SearchOptions{Limit: limit}
+	opts := domain.SearchOptions{Limit: limit, Authors: input.Authors, Language: input.Language}
 	results, err := s.ports.Search.Search(ctx, input.Query, opts)
 	if err != nil {
 		return nil, SearchOutput{}, err
@@ -65,6 +68,7 @@ func (s *Server) handleSearch(
 			DocumentID: results[i].Document.ID,
 			Title:      results[i].Document.Title,
 			URI:        results[i].Document.URI,
+			Author:     results[i].Document.Author.String(),
 			Score:      results[i].Score,
 			Highlights: results[i].Highlights,
 			Content:    results[i].Chunk.Content,
@@ -49,6 +49,29 @@ func TestServer_handleSearch(t *testing.T) {
 		assert.Equal(t, "This is the content", output.Results[0].Content)
 	})
 
+	t.Run("passes filters and reports authors", func(t *testing.T) {
+		mockSearch := &mockSearchService{
+			results: []domain.SearchResult{
+				{Document: domain.Document{
+					ID:     "doc-1",
+					Author: domain.Author{Name: "Alice Smith", Identifier: "alice@example.com"},
+				}},
+			},
+		}
+		ports := &Ports{Search: mockSearch}
+		server, err := NewServer(ports)
+		require.NoError(t, err)
+
+		input := SearchInput{Query: "test", Authors: []string{"alice@example.com"}, Language: "en"}
+		_, output, err := server.handleSearch(ctx, nil, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice@example.com"}, mockSearch.opts.Authors)
+		assert.Equal(t, "en", mockSearch.opts.Language)
+		require.Len(t, output.Results, 1)
+		assert.Equal(t, "Alice Smith <alice@example.com>", output.Results[0].Author)
+	})
+
 	t.Run("default limit is 10", func(t *testing.T) {
 		mockSearch := &mockSearchService{}
 		ports := &Ports{Search: mockSearch}
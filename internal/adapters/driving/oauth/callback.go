@@ -6,16 +6,20 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"html"
 	"net"
 	"net/http"
-	"os/exec"
-	"runtime"
 	"sync"
 	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/platform"
 )
 
+// ErrCallbackTimeout is returned by WaitForCode when no callback arrives in time.
+var ErrCallbackTimeout = errors.New("timeout waiting for authorization callback")
+
 // CallbackServer handles OAuth redirect callbacks.
 // It starts a local HTTP server to receive the authorization code.
 type CallbackServer struct {
@@ -131,7 +135,7 @@ func (s *CallbackServer) WaitForCode(timeout time.Duration) (string, error) {
 	case err := <-s.errChan:
 		return "", err
 	case <-ctx.Done():
-		return "", fmt.Errorf("timeout waiting for authorization callback")
+		return "", ErrCallbackTimeout
 	}
 }
 
@@ -225,20 +229,7 @@ func successHTML(title, message string) string {
 
 // OpenBrowser opens the default browser to the given URL.
 func OpenBrowser(url string) error {
-	var cmd *exec.Cmd
-
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", url)
-	case "linux":
-		cmd = exec.Command("xdg-open", url)
-	case "windows":
-		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
-	default:
-		return fmt.Errorf("unsupported platform: %s", runtime.GOOS)
-	}
-
-	return cmd.Start()
+	return platform.OpenURI(url)
 }
 
 // FindAvailablePort finds an available port in the given range.
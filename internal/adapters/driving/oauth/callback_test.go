@@ -325,6 +325,7 @@ func TestCallbackServer_WaitForCode_Timeout(t *testing.T) {
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "timeout waiting for authorization callback")
+	assert.ErrorIs(t, err, ErrCallbackTimeout)
 	assert.Empty(t, code)
 }
 
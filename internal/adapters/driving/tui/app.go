@@ -4,19 +4,23 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/addsource"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/doccontent"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/docdetails"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/documents"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/editsource"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/menu"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/search"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/settings"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/sourcedetail"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/sources"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/timeline"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
@@ -33,6 +37,9 @@ type App struct {
 	// styles holds the TUI styles.
 	styles *styles.Styles
 
+	// keys holds the keybindings shared by every view.
+	keys *keymap.KeyMap
+
 	// menuView is the main navigation menu.
 	menuView *menu.View
 
@@ -60,6 +67,12 @@ type App struct {
 	// settingsView is the settings configuration view component.
 	settingsView *settings.View
 
+	// editSourceView is the edit source configuration view component.
+	editSourceView *editsource.View
+
+	// timelineView is the cross-source timeline view component.
+	timelineView *timeline.View
+
 	// selectedSource tracks the currently selected source for navigation.
 	selectedSource *domain.Source
 
@@ -72,6 +85,9 @@ type App struct {
 	// query is the current search query (kept for accessor compatibility).
 	query string
 
+	// initialQuery is searched for when the program starts.
+	initialQuery string
+
 	// results holds the current search results (kept for accessor compatibility).
 	results []domain.SearchResult
 
@@ -87,6 +103,10 @@ type App struct {
 
 	// ready indicates if the app has initialised.
 	ready bool
+
+	// progress delivers sync progress events; nil when the sync
+	// orchestrator does not report progress.
+	progress *ProgressReporter
 }
 
 // Ensure App implements tea.Model.
@@ -98,10 +118,22 @@ func NewApp(ports *Ports) (*App, error) {
 		return nil, fmt.Errorf("creating app: %w", err)
 	}
 
+	km, err := loadKeyMap(ports.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("creating app: %w", err)
+	}
+
 	s := styles.DefaultStyles()
 	menuView := menu.NewView(s)
-	searchView := search.NewView(s, nil, ports.Search, ports.ResultAction)
+	menuView.SetKeyMap(km)
+	if ports.Timeline != nil {
+		menuView.EnableTimeline()
+	}
+	searchView := search.NewView(s, km, ports.Search, ports.ResultAction)
+	searchView.SetFeedbackService(ports.Feedback)
 	sourcesView := sources.NewView(s, ports.Source, ports.Credentials)
+	sourcesView.SetSyncOrchestrator(ports.Sync)
+	sourcesView.SetConnectorRegistry(ports.ConnectorRegistry)
 	sourceDetailView := sourcedetail.NewView(s, ports.Source, ports.Sync, ports.Document)
 	documentsView := documents.NewView(s, ports.Document)
 	docContentView := doccontent.NewView(s, ports.Document)
@@ -110,12 +142,38 @@ func NewApp(ports *Ports) (*App, error) {
 		s, ports.Source, ports.ConnectorRegistry, ports.ProviderRegistry,
 		ports.AuthProvider, ports.Credentials,
 	)
+	if ports.Settings != nil {
+		if settings, err := ports.Settings.Get(); err == nil {
+			addSourceView.SetOAuthTimeout(settings.Auth.OAuthTimeout())
+			searchView.SetShowChunks(settings.Search.ShowChunks)
+			searchView.SetGroupBySource(settings.Search.GroupBySource)
+			searchView.SetLiveSearch(settings.TUI.LiveSearch)
+		}
+	}
 	settingsView := settings.NewView(s, ports.Settings)
+	editSourceView := editsource.NewView(s, ports.Source, ports.ConnectorRegistry)
+	timelineView := timeline.NewView(s, ports.Timeline)
+
+	sourcesView.SetKeyMap(km)
+	sourceDetailView.SetKeyMap(km)
+	documentsView.SetKeyMap(km)
+	docContentView.SetKeyMap(km)
+	docDetailsView.SetKeyMap(km)
+	addSourceView.SetKeyMap(km)
+	settingsView.SetKeyMap(km)
+	timelineView.SetKeyMap(km)
+
+	var progress *ProgressReporter
+	if reporting, ok := ports.Sync.(driving.ProgressReportingSync); ok {
+		progress = NewProgressReporter()
+		reporting.SetProgressReporter(progress)
+	}
 
 	return &App{
 		ports:            ports,
 		ctx:              context.Background(),
 		styles:           s,
+		keys:             km,
 		menuView:         menuView,
 		searchView:       searchView,
 		sourcesView:      sourcesView,
@@ -125,23 +183,96 @@ func NewApp(ports *Ports) (*App, error) {
 		docDetailsView:   docDetailsView,
 		addSourceView:    addSourceView,
 		settingsView:     settingsView,
+		editSourceView:   editSourceView,
+		timelineView:     timelineView,
 		currentView:      messages.ViewMenu, // Start with menu
+		progress:         progress,
 	}, nil
 }
 
+// loadKeyMap builds the keybindings from the TUI settings, falling back to
+// the defaults when settings are unavailable. Conflicting bindings are an
+// error, so a misconfigured key does not silently shadow another action.
+func loadKeyMap(settingsService driving.SettingsService) (*keymap.KeyMap, error) {
+	if settingsService == nil {
+		return keymap.DefaultKeyMap(), nil
+	}
+	settings, err := settingsService.Get()
+	if err != nil {
+		return keymap.DefaultKeyMap(), nil
+	}
+	km, err := keymap.FromBindings(settings.TUI.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TUI key bindings: %w", err)
+	}
+	return km, nil
+}
+
 // WithContext sets the context for the app.
 func (a *App) WithContext(ctx context.Context) *App {
 	a.ctx = ctx
 	return a
 }
 
+// WithQuery opens the app on the search view and runs the query on start.
+func (a *App) WithQuery(query string) *App {
+	if query == "" {
+		return a
+	}
+	a.initialQuery = query
+	a.query = query
+	a.searchView.SetQuery(query)
+	a.currentView = messages.ViewSearch
+	return a
+}
+
+// WithShowChunks shows the best matching chunk of each document in search
+// results, overriding the setting when show is true.
+func (a *App) WithShowChunks(show bool) *App {
+	if show {
+		a.searchView.SetShowChunks(true)
+	}
+	return a
+}
+
+// WithDisabledSubsystems shows a safe mode banner on the menu listing the
+// subsystems the app started without.
+func (a *App) WithDisabledSubsystems(disabled []domain.DisabledSubsystem) *App {
+	a.menuView.SetDisabledSubsystems(disabled)
+	return a
+}
+
 // Init implements tea.Model.
 // It runs initial commands when the program starts.
 func (a *App) Init() tea.Cmd {
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		tea.EnterAltScreen,
 		tea.SetWindowTitle("sercha - Local Search"),
-	)
+	}
+	if a.initialQuery != "" {
+		cmds = append(cmds, a.searchView.Search(a.initialQuery))
+	}
+	if cmd := a.loadPendingEmbeddings(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	if a.progress != nil {
+		cmds = append(cmds, a.progress.Listen())
+	}
+	return tea.Batch(cmds...)
+}
+
+// loadPendingEmbeddings fetches the embedding queue size for the menu.
+// Returns nil when embeddings are not configured.
+func (a *App) loadPendingEmbeddings() tea.Cmd {
+	queue := a.ports.Embeddings
+	if queue == nil {
+		return nil
+	}
+	ctx := a.ctx
+	return func() tea.Msg {
+		count, err := queue.Pending(ctx)
+		return messages.EmbeddingsPending{Count: count, Err: err}
+	}
 }
 
 // Update implements tea.Model.
@@ -166,6 +297,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.docDetailsView.SetDimensions(msg.Width, msg.Height)
 		a.addSourceView.SetDimensions(msg.Width, msg.Height)
 		a.settingsView.SetDimensions(msg.Width, msg.Height)
+		a.editSourceView.SetDimensions(msg.Width, msg.Height)
+		a.timelineView.SetDimensions(msg.Width, msg.Height)
 		return a, nil
 
 	case tea.KeyMsg:
@@ -190,8 +323,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, cmd
 
 		case messages.ViewSources:
-			// Esc from sources goes to menu
-			if msg.Type == tea.KeyEsc {
+			// Back from sources goes to menu, unless it cancels a rename
+			if key.Matches(msg, a.keys.Back) && !a.sourcesView.Renaming() {
 				a.currentView = messages.ViewMenu
 				return a, nil
 			}
@@ -215,8 +348,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, cmd
 
 		case messages.ViewHelp:
-			// Esc from help goes to menu
-			if msg.Type == tea.KeyEsc {
+			// Back from help goes to menu
+			if key.Matches(msg, a.keys.Back) {
 				a.currentView = messages.ViewMenu
 				return a, nil
 			}
@@ -229,6 +362,14 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case messages.ViewSettings:
 			a.settingsView, cmd = a.settingsView.Update(msg)
 			return a, cmd
+
+		case messages.ViewEditSource:
+			a.editSourceView, cmd = a.editSourceView.Update(msg)
+			return a, cmd
+
+		case messages.ViewTimeline:
+			a.timelineView, cmd = a.timelineView.Update(msg)
+			return a, cmd
 		}
 		return a, nil
 
@@ -246,6 +387,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Initialise views when switching to them
 		switch msg.View {
 		case messages.ViewSearch:
+			if msg.Keep {
+				return a, nil
+			}
 			a.searchView.Reset()
 			return a, a.searchView.Init()
 		case messages.ViewSources:
@@ -258,12 +402,36 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case messages.ViewSettings:
 			a.settingsView.Reset()
 			return a, a.settingsView.Init()
-		case messages.ViewMenu, messages.ViewHelp,
-			messages.ViewDocuments, messages.ViewDocContent, messages.ViewDocDetails:
+		case messages.ViewEditSource:
+			if source := a.sourceDetailView.Source(); source != nil {
+				a.editSourceView.SetSource(*source)
+			}
+			return a, a.editSourceView.Init()
+		case messages.ViewTimeline:
+			if msg.Keep {
+				return a, nil
+			}
+			return a, a.timelineView.Init()
+		case messages.ViewMenu:
+			return a, a.loadPendingEmbeddings()
+		case messages.ViewHelp, messages.ViewDocuments, messages.ViewDocContent, messages.ViewDocDetails:
 			// Other views don't need special initialisation
 		}
 		return a, nil
 
+	case messages.EmbeddingsPending:
+		if msg.Err == nil {
+			a.menuView.SetPendingEmbeddings(msg.Count)
+		}
+		return a, nil
+
+	case messages.SyncProgress:
+		a.sourceDetailView.HandleProgress(msg)
+		if a.progress == nil {
+			return a, nil
+		}
+		return a, a.progress.Listen()
+
 	case messages.SourceSelected:
 		// Navigate from sources to source detail
 		a.selectedSource = &msg.Source
@@ -278,14 +446,35 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.currentView = messages.ViewSourceDetail
 		return a, a.sourceDetailView.Init()
 
+	case messages.SourceUpdated:
+		if msg.Err != nil {
+			a.editSourceView, cmd = a.editSourceView.Update(msg)
+			return a, cmd
+		}
+		// Return to source detail showing the updated config
+		a.selectedSource = &msg.Source
+		a.sourceDetailView.SetSource(msg.Source)
+		a.currentView = messages.ViewSourceDetail
+		return a, a.sourceDetailView.Init()
+
 	case messages.DocumentsLoaded:
 		a.documentsView, cmd = a.documentsView.Update(msg)
 		return a, cmd
 
+	case messages.TimelineLoaded:
+		a.timelineView, cmd = a.timelineView.Update(msg)
+		return a, cmd
+
 	case messages.DocumentSelected:
-		// Navigate to document content
+		// Navigate to document content, returning to where it was opened from
+		returnView := messages.ViewDocuments
+		if a.currentView == messages.ViewSearch || a.currentView == messages.ViewTimeline {
+			returnView = a.currentView
+		}
 		a.selectedDocument = &msg.Document
 		a.currentView = messages.ViewDocContent
+		a.docContentView.SetReturnView(returnView)
+		a.docContentView.SetQuery(msg.Query)
 		return a, a.docContentView.SetDocument(&msg.Document)
 
 	case messages.DocumentContentLoaded:
@@ -301,7 +490,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return a, nil
 
-	case messages.DocumentExcluded:
+	case messages.DocumentExcluded, messages.DocumentsExcluded:
 		a.documentsView, cmd = a.documentsView.Update(msg)
 		return a, cmd
 
@@ -309,6 +498,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.documentsView, cmd = a.documentsView.Update(msg)
 		return a, cmd
 
+	case messages.QuarantineLoaded, messages.QuarantineRetried:
+		a.documentsView, cmd = a.documentsView.Update(msg)
+		return a, cmd
+
 	case messages.ErrorOccurred:
 		a.err = msg.Err
 		// Forward to current view
@@ -323,6 +516,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.docDetailsView, cmd = a.docDetailsView.Update(msg)
 		case messages.ViewAddSource:
 			a.addSourceView, cmd = a.addSourceView.Update(msg)
+		case messages.ViewEditSource:
+			a.editSourceView, cmd = a.editSourceView.Update(msg)
+		case messages.ViewTimeline:
+			a.timelineView, cmd = a.timelineView.Update(msg)
 		case messages.ViewMenu, messages.ViewSources, messages.ViewHelp,
 			messages.ViewSourceDetail, messages.ViewSettings:
 			// Other views don't handle error messages
@@ -332,7 +529,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case messages.Quit:
 		return a, tea.Quit
 
-	case messages.SourcesLoaded, messages.SourceRemoved:
+	case messages.SourcesLoaded, messages.SourceRemoved, messages.SourceArchived, messages.SourceRenamed:
 		// Forward to relevant view
 		if a.currentView == messages.ViewSources {
 			a.sourcesView, cmd = a.sourcesView.Update(msg)
@@ -378,6 +575,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.addSourceView, cmd = a.addSourceView.Update(msg)
 	case messages.ViewSettings:
 		a.settingsView, cmd = a.settingsView.Update(msg)
+	case messages.ViewEditSource:
+		a.editSourceView, cmd = a.editSourceView.Update(msg)
+	case messages.ViewTimeline:
+		a.timelineView, cmd = a.timelineView.Update(msg)
 	case messages.ViewHelp:
 		// Help view doesn't need to handle other messages
 	}
@@ -411,6 +612,10 @@ func (a *App) View() string {
 		return a.addSourceView.View()
 	case messages.ViewSettings:
 		return a.settingsView.View()
+	case messages.ViewEditSource:
+		return a.editSourceView.View()
+	case messages.ViewTimeline:
+		return a.timelineView.View()
 	case messages.ViewHelp:
 		return a.viewHelp()
 	default:
@@ -448,8 +653,20 @@ Search:
 
 Results:
   j/k, ↑/↓    Navigate results
+  enter       Show actions
+  o           Open document in default application
+  s           Cycle sort: score, date, title, source
+  esc         Back to Menu
+
+Timeline:
+  j/k, ↑/↓    Navigate documents
+  enter       Show content
+  w           Cycle window: day, week, month, all
+  r           Reload
   esc         Back to Menu
 
+Navigation keys can be remapped under tui.keys in the config file.
+
 [esc] back to menu`
 }
 
@@ -285,6 +285,42 @@ func TestApp_Update_KeyMsg_Escape(t *testing.T) {
 	assert.Equal(t, messages.ViewMenu, app.CurrentView()) // Escape goes to menu now
 }
 
+// stubKeySettings returns settings with the given TUI key bindings.
+type stubKeySettings struct {
+	driving.SettingsService
+	keys domain.KeyBindings
+}
+
+func (s *stubKeySettings) Get() (*domain.AppSettings, error) {
+	return &domain.AppSettings{TUI: domain.TUISettings{Keys: s.keys}}, nil
+}
+
+func TestApp_Update_KeyMsg_RemappedBack(t *testing.T) {
+	ports := newTestPorts()
+	ports.Settings = &stubKeySettings{keys: domain.KeyBindings{Back: []string{"h"}}}
+	app, err := NewApp(ports)
+	require.NoError(t, err)
+	app.SetDimensions(80, 24)
+
+	app.Update(messages.ViewChanged{View: messages.ViewHelp})
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, messages.ViewHelp, app.CurrentView())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}})
+	assert.Equal(t, messages.ViewMenu, app.CurrentView())
+}
+
+func TestNewApp_ConflictingKeyBindings(t *testing.T) {
+	ports := newTestPorts()
+	ports.Settings = &stubKeySettings{keys: domain.KeyBindings{Up: []string{"j"}}}
+
+	app, err := NewApp(ports)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Nil(t, app)
+}
+
 func TestApp_View_NotReady(t *testing.T) {
 	ports := newTestPorts()
 	app, _ := NewApp(ports)
@@ -573,6 +609,63 @@ func TestApp_Update_SourceSelected_FromSourcesList(t *testing.T) {
 	assert.Equal(t, "source1", app.selectedSource.ID)
 }
 
+// Test that Esc cancels a source rename instead of leaving the sources view.
+func TestApp_Update_EscCancelsSourceRename(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+	app.SetDimensions(80, 24)
+	app.Update(messages.ViewChanged{View: messages.ViewSources})
+	app.Update(messages.SourcesLoaded{Sources: []domain.Source{{ID: "source1", Name: "Test Source"}}})
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	require.True(t, app.sourcesView.Renaming())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, app.sourcesView.Renaming())
+	assert.Equal(t, messages.ViewSources, app.CurrentView())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, messages.ViewMenu, app.CurrentView())
+}
+
+// Test editing a source from source detail and returning with the updated config.
+func TestApp_Update_EditSource(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+	app.SetDimensions(80, 24)
+	app.Update(messages.ViewChanged{View: messages.ViewSources})
+
+	source := domain.Source{ID: "source1", Name: "Test Source", Config: map[string]string{"path": "/old"}}
+	app.Update(messages.SourceSelected{Source: source})
+
+	app.Update(messages.ViewChanged{View: messages.ViewEditSource})
+	assert.Equal(t, messages.ViewEditSource, app.CurrentView())
+	require.NotNil(t, app.editSourceView.Source())
+	assert.Equal(t, "source1", app.editSourceView.Source().ID)
+
+	updated := source
+	updated.Config = map[string]string{"path": "/new"}
+	_, cmd := app.Update(messages.SourceUpdated{Source: updated})
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, messages.ViewSourceDetail, app.CurrentView())
+	assert.Equal(t, "/new", app.sourceDetailView.Source().Config["path"])
+	assert.Equal(t, "/new", app.selectedSource.Config["path"])
+}
+
+// Test a failed source update stays on the edit view.
+func TestApp_Update_SourceUpdated_Error(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+	app.SetDimensions(80, 24)
+	app.Update(messages.SourceSelected{Source: domain.Source{ID: "source1"}})
+	app.Update(messages.ViewChanged{View: messages.ViewEditSource})
+
+	app.Update(messages.SourceUpdated{Err: errors.New("update failed")})
+
+	assert.Equal(t, messages.ViewEditSource, app.CurrentView())
+	assert.Error(t, app.editSourceView.Err())
+}
+
 // Test SourceSelected message handling - navigate from source detail to documents.
 func TestApp_Update_SourceSelected_FromSourceDetail(t *testing.T) {
 	ports := newTestPorts()
@@ -634,6 +727,56 @@ func TestApp_Update_DocumentSelected(t *testing.T) {
 	assert.Equal(t, "doc1", app.selectedDocument.ID)
 }
 
+func TestApp_Update_DocumentSelectedFromSearch(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+	goToSearchView(app)
+	app.searchView.SetQuery("notes")
+
+	app.Update(messages.DocumentSelected{Document: domain.Document{ID: "doc1"}, Query: "notes"})
+	assert.Equal(t, messages.ViewDocContent, app.CurrentView())
+
+	// Going back keeps the search instead of resetting it
+	app.Update(messages.ViewChanged{View: messages.ViewSearch, Keep: true})
+	assert.Equal(t, messages.ViewSearch, app.CurrentView())
+	assert.Equal(t, "notes", app.searchView.Query())
+}
+
+// mockTimelineService returns fixed timeline entries.
+type mockTimelineService struct {
+	entries []domain.TimelineEntry
+}
+
+func (m *mockTimelineService) Timeline(context.Context, domain.TimelineOptions) ([]domain.TimelineEntry, error) {
+	return m.entries, nil
+}
+
+func TestApp_Timeline(t *testing.T) {
+	ports := newTestPorts()
+	ports.Timeline = &mockTimelineService{entries: []domain.TimelineEntry{
+		{Document: domain.Document{ID: "doc1", Title: "Weekly notes"}, SourceName: "Notes"},
+	}}
+	app, err := NewApp(ports)
+	require.NoError(t, err)
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	_, cmd := app.Update(messages.ViewChanged{View: messages.ViewTimeline})
+	require.NotNil(t, cmd)
+	app.Update(cmd())
+	assert.Equal(t, messages.ViewTimeline, app.CurrentView())
+	assert.Contains(t, app.View(), "Weekly notes")
+
+	// Opening a document returns to the timeline
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	app.Update(cmd())
+	assert.Equal(t, messages.ViewDocContent, app.CurrentView())
+
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.Equal(t, messages.ViewChanged{View: messages.ViewTimeline, Keep: true}, cmd())
+}
+
 // Test DocumentContentLoaded message handling.
 func TestApp_Update_DocumentContentLoaded(t *testing.T) {
 	ports := newTestPorts()
@@ -1524,3 +1667,95 @@ func TestApp_Update_SettingsSaved_InOtherView(t *testing.T) {
 
 	assert.Equal(t, app, model)
 }
+
+func TestApp_WithQuery(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+
+	result := app.WithQuery("hybrid search")
+
+	assert.Equal(t, app, result)
+	assert.Equal(t, messages.ViewSearch, app.CurrentView())
+	assert.Equal(t, "hybrid search", app.Query())
+	assert.NotNil(t, app.Init())
+}
+
+func TestApp_WithShowChunks(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+	assert.False(t, app.searchView.ShowChunks())
+
+	// False leaves the setting in place rather than turning chunks off
+	assert.Equal(t, app, app.WithShowChunks(false))
+	assert.False(t, app.searchView.ShowChunks())
+
+	app.WithShowChunks(true)
+	assert.True(t, app.searchView.ShowChunks())
+}
+
+func TestApp_WithQuery_Empty(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+
+	app.WithQuery("")
+
+	assert.Equal(t, messages.ViewMenu, app.CurrentView())
+	assert.Empty(t, app.Query())
+}
+
+// stubEmbeddingQueue implements driving.EmbeddingQueue for testing.
+type stubEmbeddingQueue struct {
+	pending int
+}
+
+func (s *stubEmbeddingQueue) Start(_ context.Context) error { return nil }
+
+func (s *stubEmbeddingQueue) Pending(_ context.Context) (int, error) { return s.pending, nil }
+
+func (s *stubEmbeddingQueue) Stats(_ context.Context) (domain.EmbeddingQueueStats, error) {
+	return domain.EmbeddingQueueStats{Pending: s.pending}, nil
+}
+
+func (s *stubEmbeddingQueue) Wait(_ context.Context) error { return nil }
+
+func TestApp_PendingEmbeddings_ShownOnMenu(t *testing.T) {
+	ports := newTestPorts()
+	ports.Embeddings = &stubEmbeddingQueue{pending: 7}
+	app, err := NewApp(ports)
+	require.NoError(t, err)
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	cmd := app.loadPendingEmbeddings()
+	require.NotNil(t, cmd)
+	msg := cmd()
+	assert.Equal(t, messages.EmbeddingsPending{Count: 7}, msg)
+
+	app.Update(msg)
+
+	assert.Contains(t, app.View(), "7 embeddings pending")
+}
+
+func TestApp_PendingEmbeddings_RefreshedOnMenu(t *testing.T) {
+	ports := newTestPorts()
+	ports.Embeddings = &stubEmbeddingQueue{pending: 2}
+	app, _ := NewApp(ports)
+
+	_, cmd := app.Update(messages.ViewChanged{View: messages.ViewMenu})
+
+	require.NotNil(t, cmd)
+	assert.Equal(t, messages.EmbeddingsPending{Count: 2}, cmd())
+}
+
+func TestApp_PendingEmbeddings_NotConfigured(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+
+	assert.Nil(t, app.loadPendingEmbeddings())
+}
+
+func TestApp_PendingEmbeddings_ErrorIgnored(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	app.Update(messages.EmbeddingsPending{Count: 5, Err: errors.New("database locked")})
+
+	assert.NotContains(t, app.View(), "embeddings pending")
+}
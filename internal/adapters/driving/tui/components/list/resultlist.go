@@ -13,11 +13,15 @@ import (
 
 // ResultList displays search results in a navigable list.
 type ResultList struct {
-	results  []domain.SearchResult
-	selected int
-	styles   *styles.Styles
-	width    int
-	height   int
+	results    []domain.SearchResult // In display order
+	ranked     []domain.SearchResult // In the order the search returned them
+	grouped    bool
+	terms      []string
+	selected   int
+	styles     *styles.Styles
+	width      int
+	height     int
+	showChunks bool
 }
 
 // NewResultList creates a new result list component.
@@ -91,6 +95,12 @@ func (r *ResultList) View() string {
 	}
 
 	for i := start; i < end; i++ {
+		if r.grouped {
+			source := resultSource(&r.results[i])
+			if i == start || source != resultSource(&r.results[i-1]) {
+				lines = append(lines, r.styles.Subtitle.Render(source))
+			}
+		}
 		line := r.renderResult(i, &r.results[i])
 		lines = append(lines, line)
 	}
@@ -122,20 +132,31 @@ func (r *ResultList) renderResult(index int, result *domain.SearchResult) string
 	}
 
 	score := fmt.Sprintf("%.2f", result.Score)
+	switch result.Feedback {
+	case domain.FeedbackRelevant:
+		score += " ▲"
+	case domain.FeedbackIrrelevant:
+		score += " ▼"
+	}
 
 	var titleLine string
 	if index == r.selected {
 		titleLine = r.styles.Selected.Render(fmt.Sprintf("%s%-*s  %s", indicator, maxTitleLen, title, score))
 	} else {
-		titleLine = r.styles.Normal.Render(fmt.Sprintf("%s%-*s  ", indicator, maxTitleLen, title)) +
-			r.styles.Muted.Render(score)
+		padded := fmt.Sprintf("%s%-*s  ", indicator, maxTitleLen, title)
+		titleLine = r.styles.Highlight(padded, r.terms, r.styles.Normal) + r.styles.Muted.Render(score)
 	}
 
 	// Preview text (first highlight or chunk content)
 	preview := ""
-	if len(result.Highlights) > 0 {
+	switch {
+	case r.showChunks && result.Chunk.Content != "":
+		// Chunk results show where in the document the match is
+		content := strings.Join(strings.Fields(result.Chunk.Content), " ")
+		preview = fmt.Sprintf("[chunk %d] %s", result.Chunk.Position+1, content)
+	case len(result.Highlights) > 0:
 		preview = result.Highlights[0]
-	} else if result.Chunk.Content != "" {
+	case result.Chunk.Content != "":
 		preview = result.Chunk.Content
 	}
 
@@ -148,11 +169,11 @@ func (r *ResultList) renderResult(index int, result *domain.SearchResult) string
 		preview = preview[:maxPreviewLen-3] + "..."
 	}
 
-	previewLine := r.styles.Muted.Render("    " + preview)
+	previewLine := r.styles.Highlight("    "+preview, r.terms, r.styles.Muted)
 
-	// Source name line (if available)
+	// Source name line (if available); grouped lists show it in the header
 	var sourceLine string
-	if result.SourceName != "" {
+	if result.SourceName != "" && !r.grouped {
 		sourceLine = "\n" + r.styles.Subtitle.Render("    "+result.SourceName)
 	}
 
@@ -161,10 +182,57 @@ func (r *ResultList) renderResult(index int, result *domain.SearchResult) string
 
 // SetResults updates the result list.
 func (r *ResultList) SetResults(results []domain.SearchResult) {
+	r.ranked = results
 	r.results = results
+	if r.grouped {
+		r.results = groupBySource(results)
+	}
 	r.selected = 0
 }
 
+// SetGrouped sets whether results are grouped under source headers.
+// The selected result stays selected when the order changes.
+func (r *ResultList) SetGrouped(grouped bool) {
+	if grouped == r.grouped {
+		return
+	}
+	selected := r.SelectedResult()
+	r.grouped = grouped
+
+	results := r.ranked
+	if grouped {
+		results = groupBySource(r.ranked)
+	}
+	r.selected = indexOf(results, selected)
+	r.results = results
+}
+
+// Grouped returns whether results are grouped under source headers.
+func (r *ResultList) Grouped() bool {
+	return r.grouped
+}
+
+// SetShowChunks sets whether previews show the matched chunk and its position.
+func (r *ResultList) SetShowChunks(show bool) {
+	r.showChunks = show
+}
+
+// SetQuery sets the query whose terms are highlighted in titles and previews.
+func (r *ResultList) SetQuery(query string) {
+	r.terms = styles.QueryTerms(query)
+}
+
+// SetFeedback records the feedback shown next to every result of a document.
+func (r *ResultList) SetFeedback(documentID string, signal domain.FeedbackSignal) {
+	for _, results := range [][]domain.SearchResult{r.ranked, r.results} {
+		for i := range results {
+			if results[i].Document.ID == documentID {
+				results[i].Feedback = signal
+			}
+		}
+	}
+}
+
 // Results returns the current results.
 func (r *ResultList) Results() []domain.SearchResult {
 	return r.results
@@ -229,3 +297,49 @@ func (r *ResultList) Count() int {
 func (r *ResultList) IsEmpty() bool {
 	return len(r.results) == 0
 }
+
+// groupBySource orders results so each source's results are adjacent.
+// Sources appear in the order of their best result and results keep
+// their ranking within each source.
+func groupBySource(results []domain.SearchResult) []domain.SearchResult {
+	order := make([]string, 0)
+	groups := make(map[string][]domain.SearchResult)
+	for i := range results {
+		source := resultSource(&results[i])
+		if _, ok := groups[source]; !ok {
+			order = append(order, source)
+		}
+		groups[source] = append(groups[source], results[i])
+	}
+
+	grouped := make([]domain.SearchResult, 0, len(results))
+	for _, source := range order {
+		grouped = append(grouped, groups[source]...)
+	}
+	return grouped
+}
+
+// resultSource returns the source a result is grouped under.
+func resultSource(result *domain.SearchResult) string {
+	switch {
+	case result.SourceName != "":
+		return result.SourceName
+	case result.Document.SourceID != "":
+		return result.Document.SourceID
+	default:
+		return "Unknown source"
+	}
+}
+
+// indexOf returns the index of the result matching target, or 0 if none does.
+func indexOf(results []domain.SearchResult, target *domain.SearchResult) int {
+	if target == nil {
+		return 0
+	}
+	for i := range results {
+		if results[i].Document.ID == target.Document.ID && results[i].Chunk.ID == target.Chunk.ID {
+			return i
+		}
+	}
+	return 0
+}
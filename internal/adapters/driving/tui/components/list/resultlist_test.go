@@ -1,9 +1,11 @@
 package list
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -223,6 +225,128 @@ func TestResultList_View_WithResults(t *testing.T) {
 	assert.Contains(t, view, "0.95")
 }
 
+func TestResultList_View_HighlightsQueryTerms(t *testing.T) {
+	s := styles.DefaultStyles()
+	s.Match = lipgloss.NewStyle().Transform(func(text string) string { return "[" + text + "]" })
+	list := NewResultList(s)
+	list.SetResults([]domain.SearchResult{
+		{Document: domain.Document{Title: "Selected"}, Highlights: []string{"Hybrid search ranks results"}},
+		{Document: domain.Document{Title: "Search tips"}, Chunk: domain.Chunk{Content: "nearest neighbours"}},
+	})
+	list.SetQuery("search")
+
+	view := list.View()
+
+	assert.Contains(t, view, "Hybrid [search] ranks results")
+	assert.Contains(t, view, "[Search] tips")
+	// Vector-only matches have no literal terms and are left unhighlighted
+	assert.Contains(t, view, "    nearest neighbours")
+}
+
+func TestResultList_View_ShowChunks(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetShowChunks(true)
+	list.SetResults([]domain.SearchResult{
+		{
+			Document:   domain.Document{Title: "Handbook"},
+			Chunk:      domain.Chunk{Content: "holiday\n  policy", Position: 4},
+			Highlights: []string{"ignored highlight"},
+		},
+	})
+
+	view := list.View()
+
+	assert.Contains(t, view, "[chunk 5] holiday policy")
+	assert.NotContains(t, view, "ignored highlight")
+}
+
+func groupedResults() []domain.SearchResult {
+	return []domain.SearchResult{
+		{Document: domain.Document{ID: "a1", Title: "Alpha One"}, SourceName: "Gmail", Score: 0.9},
+		{Document: domain.Document{ID: "b1", Title: "Beta One"}, SourceName: "GitHub", Score: 0.8},
+		{Document: domain.Document{ID: "a2", Title: "Alpha Two"}, SourceName: "Gmail", Score: 0.7},
+		{Document: domain.Document{ID: "c1", Title: "Gamma One", SourceID: "src-3"}, Score: 0.6},
+	}
+}
+
+func resultIDs(results []domain.SearchResult) []string {
+	ids := make([]string, len(results))
+	for i := range results {
+		ids[i] = results[i].Document.ID
+	}
+	return ids
+}
+
+func TestResultList_SetGrouped_OrdersBySource(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetResults(groupedResults())
+
+	list.SetGrouped(true)
+
+	assert.True(t, list.Grouped())
+	assert.Equal(t, []string{"a1", "a2", "b1", "c1"}, resultIDs(list.Results()))
+
+	list.SetGrouped(false)
+
+	assert.Equal(t, []string{"a1", "b1", "a2", "c1"}, resultIDs(list.Results()))
+}
+
+func TestResultList_SetGrouped_KeepsSelection(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetResults(groupedResults())
+	list.SetSelected(1) // b1
+
+	list.SetGrouped(true)
+	assert.Equal(t, "b1", list.SelectedResult().Document.ID)
+
+	list.SetGrouped(false)
+	assert.Equal(t, "b1", list.SelectedResult().Document.ID)
+}
+
+func TestResultList_SetResults_WhileGrouped(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetGrouped(true)
+
+	list.SetResults(groupedResults())
+
+	assert.Equal(t, []string{"a1", "a2", "b1", "c1"}, resultIDs(list.Results()))
+	assert.Equal(t, 0, list.Selected())
+}
+
+func TestResultList_View_Grouped(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetDimensions(80, 40)
+	list.SetResults(groupedResults())
+	list.SetGrouped(true)
+
+	view := list.View()
+
+	assert.Equal(t, 1, strings.Count(view, "Gmail"))
+	assert.Equal(t, 1, strings.Count(view, "GitHub"))
+	assert.Contains(t, view, "src-3")
+	assert.Less(t, strings.Index(view, "Alpha Two"), strings.Index(view, "GitHub"))
+}
+
+func TestResultList_SetFeedback_KeptWhenRegrouped(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetDimensions(80, 40)
+	list.SetResults(groupedResults())
+	list.SetGrouped(true)
+
+	list.SetFeedback("b1", domain.FeedbackRelevant)
+	list.SetFeedback("a2", domain.FeedbackIrrelevant)
+	assert.Contains(t, list.View(), "▲")
+	assert.Contains(t, list.View(), "▼")
+
+	list.SetGrouped(false)
+	assert.Equal(t, domain.FeedbackRelevant, list.Results()[1].Feedback)
+	assert.Equal(t, domain.FeedbackIrrelevant, list.Results()[2].Feedback)
+
+	list.SetFeedback("b1", "")
+	assert.Empty(t, list.Results()[1].Feedback)
+	assert.NotContains(t, list.View(), "▲")
+}
+
 func TestResultList_View_SelectedIndicator(t *testing.T) {
 	list := NewResultList(nil)
 	list.SetResults(sampleResults())
@@ -31,6 +31,8 @@ type Bar struct {
 	state       State
 	message     string
 	resultCount int
+	sort        string
+	grouped     bool
 	width       int
 }
 
@@ -101,7 +103,14 @@ func (s *Bar) renderLeft() string {
 		return s.styles.Normal.Render("Help")
 	case StateReady, StateResults:
 		if s.resultCount > 0 {
-			return s.styles.Normal.Render(fmt.Sprintf("%d results", s.resultCount))
+			text := fmt.Sprintf("%d results", s.resultCount)
+			if s.sort != "" {
+				text += ", by " + s.sort
+			}
+			if s.grouped {
+				text += ", grouped by source"
+			}
+			return s.styles.Normal.Render(text)
 		}
 		return s.styles.Muted.Render("Ready")
 	}
@@ -158,6 +167,27 @@ func (s *Bar) ResultCount() int {
 	return s.resultCount
 }
 
+// SetSort sets the result ordering shown beside the result count.
+// An empty value hides it.
+func (s *Bar) SetSort(sort string) {
+	s.sort = sort
+}
+
+// Sort returns the result ordering shown.
+func (s *Bar) Sort() string {
+	return s.sort
+}
+
+// SetGrouped sets whether results are shown grouped by source.
+func (s *Bar) SetGrouped(grouped bool) {
+	s.grouped = grouped
+}
+
+// Grouped returns whether results are shown grouped by source.
+func (s *Bar) Grouped() bool {
+	return s.grouped
+}
+
 // SetWidth sets the status bar width.
 func (s *Bar) SetWidth(width int) {
 	s.width = width
@@ -173,6 +173,28 @@ func TestStatusBar_View_WithResults(t *testing.T) {
 	assert.Contains(t, view, "5 results")
 }
 
+func TestStatusBar_View_WithSort(t *testing.T) {
+	bar := NewBar(nil, nil)
+	bar.SetResultCount(5)
+	bar.SetSort("date")
+
+	assert.Equal(t, "date", bar.Sort())
+	assert.Contains(t, bar.View(), "5 results, by date")
+}
+
+func TestStatusBar_View_Grouped(t *testing.T) {
+	bar := NewBar(nil, nil)
+	bar.SetWidth(200)
+	bar.SetResultCount(5)
+	bar.SetGrouped(true)
+
+	assert.True(t, bar.Grouped())
+	assert.Contains(t, bar.View(), "5 results, grouped by source")
+
+	bar.SetGrouped(false)
+	assert.NotContains(t, bar.View(), "grouped")
+}
+
 func TestStatusBar_View_ShowsKeybindings(t *testing.T) {
 	bar := NewBar(nil, nil)
 
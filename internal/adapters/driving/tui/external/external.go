@@ -0,0 +1,58 @@
+// Package external opens documents outside the TUI: local files in the
+// user's editor and web pages in the browser.
+package external
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/oauth"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/platform"
+)
+
+// Overridden in tests so nothing is actually launched.
+var (
+	openBrowser   = oauth.OpenBrowser
+	openLocal     = platform.OpenURI
+	editorCommand = platform.EditorCommand
+)
+
+// Open returns a command that opens uri outside the TUI.
+//
+// file:// URIs open in $VISUAL or $EDITOR, with the TUI suspended until
+// the editor exits, or in the OS default application when neither is set.
+// http and https URLs open in the browser. Any other URI returns nil so
+// the caller can fall back to resolving it through its connector.
+//
+// Failures are reported as messages.ErrorOccurred.
+func Open(uri string) tea.Cmd {
+	if path, ok := platform.LocalPath(uri); ok {
+		if cmd := editorCommand(path); cmd != nil {
+			return tea.ExecProcess(cmd, func(err error) tea.Msg {
+				if err != nil {
+					return messages.ErrorOccurred{Err: fmt.Errorf("editor: %w", err)}
+				}
+				return nil
+			})
+		}
+		return run(openLocal, uri)
+	}
+
+	if strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://") {
+		return run(openBrowser, uri)
+	}
+	return nil
+}
+
+// run returns a command that calls open and reports any error.
+func run(open func(string) error, uri string) tea.Cmd {
+	return func() tea.Msg {
+		if err := open(uri); err != nil {
+			return messages.ErrorOccurred{Err: err}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+package external
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+)
+
+// fakeOpeners replaces the launchers for the duration of a test and
+// records the URI each was called with.
+func fakeOpeners(t *testing.T, editor *exec.Cmd) (browser, local *string) {
+	t.Helper()
+	browser, local = new(string), new(string)
+	oldBrowser, oldLocal, oldEditor := openBrowser, openLocal, editorCommand
+	openBrowser = func(uri string) error { *browser = uri; return nil }
+	openLocal = func(uri string) error { *local = uri; return errors.New("no handler") }
+	editorCommand = func(string) *exec.Cmd { return editor }
+	t.Cleanup(func() {
+		openBrowser, openLocal, editorCommand = oldBrowser, oldLocal, oldEditor
+	})
+	return browser, local
+}
+
+func TestOpen_WebURLUsesBrowser(t *testing.T) {
+	browser, local := fakeOpeners(t, nil)
+
+	cmd := Open("https://github.com/octocat/hello/issues/1")
+
+	require.NotNil(t, cmd)
+	assert.Nil(t, cmd())
+	assert.Equal(t, "https://github.com/octocat/hello/issues/1", *browser)
+	assert.Empty(t, *local)
+}
+
+func TestOpen_LocalFileWithoutEditorUsesDefaultApp(t *testing.T) {
+	browser, local := fakeOpeners(t, nil)
+
+	cmd := Open("file:///home/ada/notes.md")
+
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(messages.ErrorOccurred)
+	require.True(t, ok)
+	assert.EqualError(t, msg.Err, "no handler")
+	assert.Equal(t, "file:///home/ada/notes.md", *local)
+	assert.Empty(t, *browser)
+}
+
+func TestOpen_LocalFileWithEditor(t *testing.T) {
+	_, local := fakeOpeners(t, exec.Command("true"))
+
+	cmd := Open("file:///home/ada/notes.md")
+
+	// The editor runs through tea.ExecProcess rather than the default app
+	require.NotNil(t, cmd)
+	assert.Empty(t, *local)
+}
+
+func TestOpen_OtherSchemesFallBack(t *testing.T) {
+	fakeOpeners(t, nil)
+
+	assert.Nil(t, Open("github://octocat/hello/blob/main/README.md"))
+	assert.Nil(t, Open("gmail://message/123"))
+}
@@ -2,7 +2,11 @@
 package keymap
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/bubbles/key"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 // KeyMap defines all keybindings for the TUI.
@@ -19,6 +23,9 @@ type KeyMap struct {
 	// Search triggers a search.
 	Search key.Binding
 
+	// OpenSearch opens the search view from the menu.
+	OpenSearch key.Binding
+
 	// Up navigates up in a list.
 	Up key.Binding
 
@@ -36,6 +43,24 @@ type KeyMap struct {
 
 	// Actions opens the action menu on a result.
 	Actions key.Binding
+
+	// Open opens the selected document in its default application.
+	Open key.Binding
+
+	// Edit opens a local document in $EDITOR and a web document in the browser.
+	Edit key.Binding
+
+	// Sort cycles the result ordering.
+	Sort key.Binding
+
+	// Group toggles grouping results by source.
+	Group key.Binding
+
+	// Relevant marks the selected result as useful for the query.
+	Relevant key.Binding
+
+	// Irrelevant marks the selected result as not useful for the query.
+	Irrelevant key.Binding
 }
 
 // DefaultKeyMap returns the default keybindings.
@@ -57,6 +82,10 @@ func DefaultKeyMap() *KeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "search"),
 		),
+		OpenSearch: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
 		Up: key.NewBinding(
 			key.WithKeys("up", "k"),
 			key.WithHelp("↑/k", "up"),
@@ -81,9 +110,65 @@ func DefaultKeyMap() *KeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "actions"),
 		),
+		Open: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "open"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit"),
+		),
+		Sort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "sort"),
+		),
+		Group: key.NewBinding(
+			key.WithKeys("g"),
+			key.WithHelp("g", "group"),
+		),
+		Relevant: key.NewBinding(
+			key.WithKeys("+"),
+			key.WithHelp("+", "useful"),
+		),
+		Irrelevant: key.NewBinding(
+			key.WithKeys("-"),
+			key.WithHelp("-", "not useful"),
+		),
 	}
 }
 
+// FromBindings returns the default keybindings with the navigation actions
+// bound as configured. Actions left unset keep their default keys, and
+// Ctrl+C always quits. It fails if a key is bound to more than one action.
+func FromBindings(bindings domain.KeyBindings) (*KeyMap, error) {
+	if err := bindings.Validate(); err != nil {
+		return nil, err
+	}
+	bindings = bindings.WithDefaults()
+
+	km := DefaultKeyMap()
+	km.Up = newBinding(bindings.Up, "up")
+	km.Down = newBinding(bindings.Down, "down")
+	km.Select = newBinding(bindings.Select, "select")
+	km.Actions = newBinding(bindings.Select, "actions")
+	km.Back = newBinding(bindings.Back, "back")
+	km.Cancel = newBinding(bindings.Back, "cancel")
+	km.OpenSearch = newBinding(bindings.Search, "search")
+	km.Quit = key.NewBinding(
+		key.WithKeys(append(bindings.Quit, "ctrl+c")...),
+		key.WithHelp(strings.Join(bindings.Quit, "/"), "quit"),
+	)
+	return km, nil
+}
+
+// newBinding returns a binding for keys, with all of them shown in its help.
+func newBinding(keys []string, desc string) key.Binding {
+	return key.NewBinding(
+		key.WithKeys(keys...),
+		key.WithHelp(strings.Join(keys, "/"), desc),
+	)
+}
+
 // ShortHelp returns a short list of keybindings for the help view.
 func (k *KeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{k.Quit, k.Help}
@@ -91,7 +176,7 @@ func (k *KeyMap) ShortHelp() []key.Binding {
 
 // ResultsHelp returns keybindings for the results view.
 func (k *KeyMap) ResultsHelp() []key.Binding {
-	return []key.Binding{k.NewSearch, k.Up, k.Actions, k.Back}
+	return []key.Binding{k.NewSearch, k.Up, k.Actions, k.Open, k.Edit, k.Sort, k.Group, k.Relevant, k.Irrelevant, k.Back}
 }
 
 // FullHelp returns the full list of keybindings for the help view.
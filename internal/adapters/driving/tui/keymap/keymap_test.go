@@ -6,6 +6,8 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 func TestDefaultKeyMap(t *testing.T) {
@@ -73,6 +75,43 @@ func TestDefaultKeyMap_CancelBinding(t *testing.T) {
 	assert.Contains(t, keys, "esc")
 }
 
+func TestDefaultKeyMap_OpenBinding(t *testing.T) {
+	km := DefaultKeyMap()
+
+	assert.Equal(t, []string{"o"}, km.Open.Keys())
+	assert.Contains(t, km.ResultsHelp(), km.Open)
+}
+
+func TestDefaultKeyMap_EditBinding(t *testing.T) {
+	km := DefaultKeyMap()
+
+	assert.Equal(t, []string{"e"}, km.Edit.Keys())
+	assert.Contains(t, km.ResultsHelp(), km.Edit)
+}
+
+func TestDefaultKeyMap_SortBinding(t *testing.T) {
+	km := DefaultKeyMap()
+
+	assert.Equal(t, []string{"s"}, km.Sort.Keys())
+	assert.Contains(t, km.ResultsHelp(), km.Sort)
+}
+
+func TestDefaultKeyMap_GroupBinding(t *testing.T) {
+	km := DefaultKeyMap()
+
+	assert.Equal(t, []string{"g"}, km.Group.Keys())
+	assert.Contains(t, km.ResultsHelp(), km.Group)
+}
+
+func TestDefaultKeyMap_FeedbackBindings(t *testing.T) {
+	km := DefaultKeyMap()
+
+	assert.Equal(t, []string{"+"}, km.Relevant.Keys())
+	assert.Equal(t, []string{"-"}, km.Irrelevant.Keys())
+	assert.Contains(t, km.ResultsHelp(), km.Relevant)
+	assert.Contains(t, km.ResultsHelp(), km.Irrelevant)
+}
+
 func TestShortHelp(t *testing.T) {
 	km := DefaultKeyMap()
 
@@ -127,6 +166,11 @@ func TestBindings_HaveHelp(t *testing.T) {
 		{"Down", km.Down},
 		{"Select", km.Select},
 		{"Cancel", km.Cancel},
+		{"Open", km.Open},
+		{"Sort", km.Sort},
+		{"Group", km.Group},
+		{"Relevant", km.Relevant},
+		{"Irrelevant", km.Irrelevant},
 	}
 
 	for _, tc := range testCases {
@@ -136,3 +180,39 @@ func TestBindings_HaveHelp(t *testing.T) {
 		})
 	}
 }
+
+func TestFromBindings_Defaults(t *testing.T) {
+	km, err := FromBindings(domain.KeyBindings{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"up", "k"}, km.Up.Keys())
+	assert.Equal(t, []string{"/"}, km.OpenSearch.Keys())
+	assert.Contains(t, km.Quit.Keys(), "ctrl+c")
+}
+
+func TestFromBindings_Remapped(t *testing.T) {
+	km, err := FromBindings(domain.KeyBindings{
+		Up:     []string{"up", "c"},
+		Down:   []string{"down", "t"},
+		Select: []string{"enter", "l"},
+		Quit:   []string{"x"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, Matches("c", km.Up))
+	assert.False(t, Matches("k", km.Up))
+	assert.True(t, Matches("t", km.Down))
+	assert.True(t, Matches("l", km.Select))
+	assert.True(t, Matches("l", km.Actions))
+	assert.Equal(t, []string{"x", "ctrl+c"}, km.Quit.Keys())
+	assert.Equal(t, "x", km.Quit.Help().Key)
+	// Bindings outside the navigation actions are unchanged
+	assert.Equal(t, []string{"o"}, km.Open.Keys())
+}
+
+func TestFromBindings_Conflict(t *testing.T) {
+	_, err := FromBindings(domain.KeyBindings{Down: []string{"k"}})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -3,6 +3,8 @@
 package messages
 
 import (
+	"time"
+
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
@@ -31,6 +33,8 @@ type ResultSelected struct {
 // ViewChanged is sent when navigating between views.
 type ViewChanged struct {
 	View ViewType
+	// Keep returns to the view without resetting its state.
+	Keep bool
 }
 
 // ViewType identifies which view is currently active.
@@ -57,6 +61,10 @@ const (
 	ViewAddSource
 	// ViewSettings is the settings configuration view.
 	ViewSettings
+	// ViewEditSource edits an existing source's configuration.
+	ViewEditSource
+	// ViewTimeline lists recently updated documents across sources.
+	ViewTimeline
 )
 
 // String returns the string representation of the view type.
@@ -82,6 +90,10 @@ func (v ViewType) String() string {
 		return "add_source"
 	case ViewSettings:
 		return "settings"
+	case ViewEditSource:
+		return "edit_source"
+	case ViewTimeline:
+		return "timeline"
 	default:
 		return "unknown"
 	}
@@ -101,6 +113,24 @@ type SourcesLoaded struct {
 	Err     error
 }
 
+// SyncStatesLoaded carries the sync status of every source.
+// Syncing holds the IDs of sources with a sync in progress.
+type SyncStatesLoaded struct {
+	Statuses []domain.SourceStatus
+	Syncing  map[string]bool
+	Err      error
+}
+
+// SyncProgress reports that a document or chunk of a syncing source
+// finished an indexing phase (one of the driving.IndexPhase values).
+// ChunkID is set only for the embedded phase.
+type SyncProgress struct {
+	SourceID   string
+	DocumentID string
+	ChunkID    string
+	Phase      string
+}
+
 // SourceAdded signals a source was added.
 type SourceAdded struct {
 	Source domain.Source
@@ -113,6 +143,28 @@ type SourceRemoved struct {
 	Err error
 }
 
+// SourceArchived signals a source was archived or unarchived.
+type SourceArchived struct {
+	ID       string
+	Archived bool
+	Err      error
+}
+
+// SourceRenamed signals a source was renamed. Conflicts are other sources
+// that already had the new name.
+type SourceRenamed struct {
+	ID        string
+	Name      string
+	Conflicts []domain.Source
+	Err       error
+}
+
+// SourceUpdated signals a source's configuration was updated.
+type SourceUpdated struct {
+	Source domain.Source
+	Err    error
+}
+
 // SourceSelected signals a source was selected for detail view.
 type SourceSelected struct {
 	Source domain.Source
@@ -125,16 +177,26 @@ type DocumentsLoaded struct {
 	Err       error
 }
 
+// TimelineLoaded carries the recently updated documents across sources.
+type TimelineLoaded struct {
+	Entries []domain.TimelineEntry
+	Err     error
+}
+
 // DocumentSelected signals a document was selected.
 type DocumentSelected struct {
 	Document domain.Document
+	// Query is highlighted in the content when the document was opened from search.
+	Query string
 }
 
 // DocumentContentLoaded carries the content of a document.
 type DocumentContentLoaded struct {
-	DocumentID string
-	Content    string
-	Err        error
+	DocumentID  string
+	Content     string
+	Original    string                 // Original text the document was normalised from, if kept
+	Breadcrumbs domain.BreadcrumbTrail // Ancestors of the document, outermost first
+	Err         error
 }
 
 // DocumentDetailsLoaded carries the metadata of a document.
@@ -150,6 +212,25 @@ type DocumentExcluded struct {
 	Err        error
 }
 
+// DocumentsExcluded signals a batch of documents was excluded.
+type DocumentsExcluded struct {
+	Count int
+	Err   error
+}
+
+// QuarantineLoaded carries the documents quarantined for a source.
+type QuarantineLoaded struct {
+	SourceID   string
+	Exclusions []domain.Exclusion
+	Err        error
+}
+
+// QuarantineRetried signals a quarantined document was released for retry.
+type QuarantineRetried struct {
+	ExclusionID string
+	Err         error
+}
+
 // DocumentRefreshed signals a document refresh completed.
 type DocumentRefreshed struct {
 	DocumentID string
@@ -178,6 +259,15 @@ type AuthProviderDeleted struct {
 type OAuthFlowCompleted struct {
 	CredentialsID string
 	Err           error
+	// Deadline identifies the flow by its deadline, so a view can ignore
+	// results of a flow that was cancelled or restarted.
+	Deadline time.Time
+}
+
+// OAuthFlowTimeout signals the OAuth flow deadline passed without a callback.
+type OAuthFlowTimeout struct {
+	// Deadline identifies the flow that timed out.
+	Deadline time.Time
 }
 
 // SettingsLoaded carries the application settings.
@@ -190,3 +280,9 @@ type SettingsLoaded struct {
 type SettingsSaved struct {
 	Err error
 }
+
+// EmbeddingsPending carries the number of chunks waiting for an embedding.
+type EmbeddingsPending struct {
+	Count int
+	Err   error
+}
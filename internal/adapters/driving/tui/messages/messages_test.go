@@ -151,6 +151,8 @@ func TestViewType_String(t *testing.T) {
 		{"ViewDocDetails", ViewDocDetails, "doc_details"},
 		{"ViewAddSource", ViewAddSource, "add_source"},
 		{"ViewSettings", ViewSettings, "settings"},
+		{"ViewEditSource", ViewEditSource, "edit_source"},
+		{"ViewTimeline", ViewTimeline, "timeline"},
 		{"UnknownView", ViewType(99), "unknown"},
 		{"NegativeView", ViewType(-1), "unknown"},
 		{"LargeView", ViewType(1000), "unknown"},
@@ -276,6 +278,16 @@ func TestSourceRemoved(t *testing.T) {
 	})
 }
 
+// TestSourceUpdated tests the SourceUpdated message type
+func TestSourceUpdated(t *testing.T) {
+	source := domain.Source{ID: "src-123", Config: map[string]string{"path": "/new"}}
+	msg := SourceUpdated{Source: source, Err: nil}
+
+	assert.Equal(t, "src-123", msg.Source.ID)
+	assert.Equal(t, "/new", msg.Source.Config["path"])
+	assert.NoError(t, msg.Err)
+}
+
 // TestSourceSelected tests the SourceSelected message type
 func TestSourceSelected(t *testing.T) {
 	t.Run("with valid source", func(t *testing.T) {
@@ -466,6 +478,17 @@ func TestDocumentExcluded(t *testing.T) {
 	})
 }
 
+// TestDocumentsExcluded tests the DocumentsExcluded message type
+func TestDocumentsExcluded(t *testing.T) {
+	msg := DocumentsExcluded{Count: 3}
+	assert.Equal(t, 3, msg.Count)
+	assert.NoError(t, msg.Err)
+
+	msg = DocumentsExcluded{Err: errors.New("bulk exclusion failed")}
+	assert.Zero(t, msg.Count)
+	assert.Error(t, msg.Err)
+}
+
 // TestDocumentRefreshed tests the DocumentRefreshed message type
 func TestDocumentRefreshed(t *testing.T) {
 	t.Run("successful refresh", func(t *testing.T) {
@@ -526,6 +549,14 @@ func TestOAuthFlowCompleted(t *testing.T) {
 	})
 }
 
+// TestOAuthFlowTimeout tests the OAuthFlowTimeout message type
+func TestOAuthFlowTimeout(t *testing.T) {
+	var msg any = OAuthFlowTimeout{}
+
+	_, ok := msg.(OAuthFlowTimeout)
+	assert.True(t, ok)
+}
+
 // TestSettingsLoaded tests the SettingsLoaded message type
 func TestSettingsLoaded(t *testing.T) {
 	t.Run("with settings", func(t *testing.T) {
@@ -38,6 +38,15 @@ type Ports struct {
 
 	// AuthProvider manages OAuth app configurations (reusable across sources).
 	AuthProvider driving.AuthProviderService
+
+	// Embeddings reports the background embedding queue (optional).
+	Embeddings driving.EmbeddingQueue
+
+	// Feedback records whether search results were useful (optional).
+	Feedback driving.FeedbackService
+
+	// Timeline lists recently updated documents across sources (optional).
+	Timeline driving.TimelineService
 }
 
 // NewPorts creates a new Ports aggregate with the given services.
@@ -3,6 +3,7 @@ package tui
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,6 +17,9 @@ type MockSearchService struct {
 	SearchFunc func(
 		ctx context.Context, query string, opts domain.SearchOptions,
 	) ([]domain.SearchResult, error)
+	SearchByChunkFunc func(
+		ctx context.Context, query string, opts domain.SearchOptions,
+	) ([]domain.ChunkSearchResult, error)
 }
 
 func (m *MockSearchService) Search(
@@ -27,6 +31,15 @@ func (m *MockSearchService) Search(
 	return nil, nil
 }
 
+func (m *MockSearchService) SearchByChunk(
+	ctx context.Context, query string, opts domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	if m.SearchByChunkFunc != nil {
+		return m.SearchByChunkFunc(ctx, query, opts)
+	}
+	return nil, nil
+}
+
 // MockSourceService implements driving.SourceService for testing.
 type MockSourceService struct {
 	AddFunc    func(ctx context.Context, source domain.Source) error
@@ -63,6 +76,30 @@ func (m *MockSourceService) Remove(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockSourceService) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *MockSourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
 func (m *MockSourceService) Update(ctx context.Context, source domain.Source) error {
 	return nil
 }
@@ -71,6 +108,10 @@ func (m *MockSourceService) ValidateConfig(ctx context.Context, connectorType st
 	return nil
 }
 
+func (m *MockSourceService) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
 // MockSyncOrchestrator implements driving.SyncOrchestrator for testing.
 type MockSyncOrchestrator struct {
 	SyncFunc    func(ctx context.Context, sourceID string) error
@@ -92,6 +133,14 @@ func (m *MockSyncOrchestrator) SyncAll(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockSyncOrchestrator) SyncSince(ctx context.Context, sourceID string, _ time.Time) error {
+	return m.Sync(ctx, sourceID)
+}
+
+func (m *MockSyncOrchestrator) SyncAllSince(ctx context.Context, _ time.Time) error {
+	return m.SyncAll(ctx)
+}
+
 func (m *MockSyncOrchestrator) Status(ctx context.Context, sourceID string) (*driving.SyncStatus, error) {
 	if m.StatusFunc != nil {
 		return m.StatusFunc(ctx, sourceID)
@@ -0,0 +1,53 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// progressBuffer is the number of progress events held while the TUI is busy.
+const progressBuffer = 256
+
+// ProgressReporter turns indexing progress into messages.SyncProgress
+// messages delivered through a channel, so syncs started anywhere (the
+// source detail view or the scheduler) show up in the TUI.
+type ProgressReporter struct {
+	events chan messages.SyncProgress
+}
+
+// Ensure ProgressReporter implements the interface.
+var _ driving.IndexProgressReporter = (*ProgressReporter)(nil)
+
+// NewProgressReporter creates a progress reporter.
+func NewProgressReporter() *ProgressReporter {
+	return &ProgressReporter{events: make(chan messages.SyncProgress, progressBuffer)}
+}
+
+// OnDocumentProcessed implements driving.IndexProgressReporter.
+func (r *ProgressReporter) OnDocumentProcessed(sourceID, docID, phase string) {
+	r.send(messages.SyncProgress{SourceID: sourceID, DocumentID: docID, Phase: phase})
+}
+
+// OnChunkEmbedded implements driving.IndexProgressReporter.
+func (r *ProgressReporter) OnChunkEmbedded(sourceID, chunkID string) {
+	r.send(messages.SyncProgress{SourceID: sourceID, ChunkID: chunkID, Phase: driving.IndexPhaseEmbedded})
+}
+
+// send queues an event without blocking. Events are dropped when the TUI
+// falls behind, so a slow render never holds up a sync.
+func (r *ProgressReporter) send(msg messages.SyncProgress) {
+	select {
+	case r.events <- msg:
+	default:
+	}
+}
+
+// Listen returns a command that waits for the next progress event.
+// The app issues it again after handling each event.
+func (r *ProgressReporter) Listen() tea.Cmd {
+	return func() tea.Msg {
+		return <-r.events
+	}
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// reportingSyncOrchestrator is a MockSyncOrchestrator that accepts a progress reporter.
+type reportingSyncOrchestrator struct {
+	MockSyncOrchestrator
+	reporter driving.IndexProgressReporter
+}
+
+func (m *reportingSyncOrchestrator) SetProgressReporter(reporter driving.IndexProgressReporter) {
+	m.reporter = reporter
+}
+
+func TestProgressReporter_SendsMessages(t *testing.T) {
+	reporter := NewProgressReporter()
+
+	reporter.OnDocumentProcessed("src-1", "doc-1", driving.IndexPhaseNormalised)
+	reporter.OnChunkEmbedded("src-1", "chunk-1")
+
+	assert.Equal(t, messages.SyncProgress{
+		SourceID: "src-1", DocumentID: "doc-1", Phase: driving.IndexPhaseNormalised,
+	}, reporter.Listen()())
+	assert.Equal(t, messages.SyncProgress{
+		SourceID: "src-1", ChunkID: "chunk-1", Phase: driving.IndexPhaseEmbedded,
+	}, reporter.Listen()())
+}
+
+func TestProgressReporter_DropsWhenFull(t *testing.T) {
+	reporter := NewProgressReporter()
+
+	// Must not block once the buffer is full
+	for range progressBuffer + 10 {
+		reporter.OnDocumentProcessed("src-1", "doc-1", driving.IndexPhaseIndexed)
+	}
+
+	assert.Len(t, reporter.events, progressBuffer)
+}
+
+func TestApp_SyncProgress_UpdatesSourceDetail(t *testing.T) {
+	sync := &reportingSyncOrchestrator{}
+	ports := newTestPorts()
+	ports.Sync = sync
+	app, err := NewApp(ports)
+	require.NoError(t, err)
+	require.NotNil(t, sync.reporter)
+
+	app.SetDimensions(80, 24)
+	app.Update(messages.SourceSelected{Source: domain.Source{ID: "src-1", Name: "Docs"}})
+
+	sync.reporter.OnDocumentProcessed("src-1", "doc-1", driving.IndexPhaseIndexed)
+	msg := app.progress.Listen()()
+	_, cmd := app.Update(msg)
+
+	// The app keeps listening for the next event
+	assert.NotNil(t, cmd)
+	assert.Equal(t, 1, app.sourceDetailView.DocumentsIndexed())
+}
+
+func TestApp_NoProgressReporter(t *testing.T) {
+	app, err := NewApp(newTestPorts())
+	require.NoError(t, err)
+
+	assert.Nil(t, app.progress)
+	_, cmd := app.Update(messages.SyncProgress{SourceID: "src-1", Phase: driving.IndexPhaseIndexed})
+	assert.Nil(t, cmd)
+}
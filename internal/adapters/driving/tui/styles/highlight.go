@@ -0,0 +1,133 @@
+package styles
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// QueryTerms splits a search query into the distinct terms to highlight,
+// the way the keyword index splits text (see termEnd).
+// Single-character terms are dropped as they would match almost everywhere.
+func QueryTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	query = strings.ToLower(query)
+	for i := 0; i < len(query); {
+		r, size := utf8.DecodeRuneInString(query[i:])
+		if !domain.IsTermRune(r) {
+			i += size
+			continue
+		}
+		end := termEnd(query, i)
+		term := query[i:end]
+		i = end
+		if utf8.RuneCountInString(term) < 2 || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// Highlight renders text in the base style with the words matching terms in
+// the Match style. Matching ignores case and only covers whole words, so
+// "art" is not highlighted in "start"; within CJK text, which is indexed
+// as character n-grams, terms match anywhere. Text without matches, such
+// as a result found only by vector search, is rendered in the base style alone.
+func (s *Styles) Highlight(text string, terms []string, base lipgloss.Style) string {
+	if len(terms) == 0 || text == "" {
+		return base.Render(text)
+	}
+
+	var b strings.Builder
+	plainStart := 0
+	mark := func(start, end int) {
+		if plainStart < start {
+			b.WriteString(base.Render(text[plainStart:start]))
+		}
+		b.WriteString(s.Match.Render(text[start:end]))
+		plainStart = end
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !domain.IsTermRune(r) {
+			i += size
+			continue
+		}
+		end := termEnd(text, i)
+		if domain.IsCJK(r) {
+			markCJK(text, i, end, terms, mark)
+		} else if matchesWord(text[i:end], terms) {
+			mark(i, end)
+		}
+		i = end
+	}
+	if plainStart == 0 {
+		return base.Render(text)
+	}
+	if plainStart < len(text) {
+		b.WriteString(base.Render(text[plainStart:]))
+	}
+	return b.String()
+}
+
+// termEnd returns the byte offset where the term starting at i ends. A term
+// is a run of term characters, or a run of CJK characters; an apostrophe
+// between letters stays inside the term, as in "don't".
+func termEnd(text string, i int) int {
+	first, _ := utf8.DecodeRuneInString(text[i:])
+	cjk := domain.IsCJK(first)
+	j := i
+	for j < len(text) {
+		r, size := utf8.DecodeRuneInString(text[j:])
+		if domain.IsTermRune(r) && domain.IsCJK(r) == cjk {
+			j += size
+			continue
+		}
+		if !cjk && j > i && (r == '\'' || r == '’') {
+			next, _ := utf8.DecodeRuneInString(text[j+size:])
+			if domain.IsTermRune(next) && !domain.IsCJK(next) {
+				j += size
+				continue
+			}
+		}
+		break
+	}
+	return j
+}
+
+// matchesWord reports whether word equals one of terms, ignoring case.
+func matchesWord(word string, terms []string) bool {
+	for _, term := range terms {
+		if strings.EqualFold(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// markCJK marks the longest term matching at each position of the CJK run
+// text[start:end].
+func markCJK(text string, start, end int, terms []string, mark func(start, end int)) {
+	for i := start; i < end; {
+		longest := 0
+		for _, term := range terms {
+			if n := len(term); n > longest && i+n <= end && text[i:i+n] == term {
+				longest = n
+			}
+		}
+		if longest == 0 {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			i += size
+			continue
+		}
+		mark(i, i+longest)
+		i += longest
+	}
+}
@@ -0,0 +1,61 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+// bracketStyles marks matches with brackets so they are visible without colour.
+func bracketStyles() *Styles {
+	s := DefaultStyles()
+	s.Match = lipgloss.NewStyle().Transform(func(text string) string {
+		return "[" + text + "]"
+	})
+	return s
+}
+
+func TestQueryTerms(t *testing.T) {
+	assert.Equal(t, []string{"hybrid", "search"}, QueryTerms("  Hybrid search a HYBRID "))
+	assert.Equal(t, []string{"exact", "phrase"}, QueryTerms(`"exact phrase"`))
+	assert.Equal(t, []string{"start", "up", "don't"}, QueryTerms("start-up don't"))
+	assert.Equal(t, []string{"東京都", "tokyo"}, QueryTerms("東京都tokyo"))
+	assert.Empty(t, QueryTerms(""))
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		expected string
+	}{
+		{"no terms", "plain text", nil, "plain text"},
+		{"no match", "semantic neighbour", []string{"hybrid"}, "semantic neighbour"},
+		{"case insensitive", "Hybrid search is hybrid", []string{"hybrid"}, "[Hybrid] search is [hybrid]"},
+		{"several terms", "local search engine", []string{"local", "engine"}, "[local] search [engine]"},
+		{"whole words only", "start the art", []string{"art"}, "start the [art]"},
+		{"prefix", "searching files", []string{"search"}, "searching files"},
+		{"punctuation splits words", "start-up (art)", []string{"up", "art"}, "start-[up] ([art])"},
+		{"apostrophe", "don't stop", []string{"don't"}, "[don't] stop"},
+		{"unicode", "café crème", []string{"crème"}, "café [crème]"},
+		{"cjk substring", "東京都庁へ", []string{"京都"}, "東[京都]庁へ"},
+		{"cjk longest term wins", "東京都庁", []string{"京都", "京都庁"}, "東[京都庁]"},
+	}
+
+	s := bracketStyles()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, s.Highlight(tt.text, tt.terms, lipgloss.NewStyle()))
+		})
+	}
+}
+
+func TestHighlight_BaseStyleApplied(t *testing.T) {
+	s := bracketStyles()
+	base := lipgloss.NewStyle().Transform(strings.ToUpper)
+
+	assert.Equal(t, "A [match] B", s.Highlight("a match b", []string{"match"}, base))
+}
@@ -89,6 +89,9 @@ type Styles struct {
 
 	// Border style for bordered containers.
 	Border lipgloss.Style
+
+	// Match style for query terms found in result text.
+	Match lipgloss.Style
 }
 
 // NewStyles creates styles from a theme.
@@ -144,6 +147,10 @@ func NewStyles(theme *Theme) *Styles {
 		Border: lipgloss.NewStyle().
 			BorderStyle(lipgloss.RoundedBorder()).
 			BorderForeground(theme.Border),
+
+		Match: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(theme.Warning),
 	}
 }
 
@@ -80,6 +80,7 @@ func TestStyles_AllStylesInitialised(t *testing.T) {
 	assert.NotEqual(t, lipgloss.Style{}, styles.StatusBar)
 	assert.NotEqual(t, lipgloss.Style{}, styles.Help)
 	assert.NotEqual(t, lipgloss.Style{}, styles.Border)
+	assert.NotEqual(t, lipgloss.Style{}, styles.Match)
 }
 
 func TestStyles_TitleIsBold(t *testing.T) {
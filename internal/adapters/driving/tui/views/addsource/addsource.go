@@ -3,16 +3,19 @@ package addsource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/google/uuid"
 
 	drivenoauth "github.com/custodia-labs/sercha-cli/internal/adapters/driven/oauth"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/oauth"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -38,9 +41,15 @@ const (
 	keyDown  = "down"
 )
 
+// errOAuthTimeout is shown when the browser authorisation does not complete in time.
+//
+//nolint:stylecheck,revive // user-facing message rendered verbatim
+var errOAuthTimeout = errors.New("Authorisation timed out. Please try again.")
+
 // View is the add source wizard view.
 type View struct {
 	styles              *styles.Styles
+	keys                *keymap.KeyMap
 	sourceService       driving.SourceService
 	connectorRegistry   driving.ConnectorRegistry
 	providerRegistry    driving.ProviderRegistry
@@ -82,7 +91,10 @@ type View struct {
 	selectedAuthProviderID string                   // ID of selected/created AuthProvider
 	pendingOAuthTokens     *domain.OAuthCredentials // Tokens received from OAuth flow
 	accountIdentifier      string                   // Account ID fetched after OAuth
+	accountName            string                   // Account label for the source name
 	callbackServer         *oauth.CallbackServer
+	oauthTimeout           time.Duration // How long to wait for the OAuth callback
+	oauthDeadline          time.Time     // When the current OAuth flow times out
 
 	// Result
 	source *domain.Source
@@ -119,6 +131,7 @@ func NewView(
 
 	return &View{
 		styles:              s,
+		keys:                keymap.DefaultKeyMap(),
 		sourceService:       sourceService,
 		connectorRegistry:   connectorRegistry,
 		providerRegistry:    providerRegistry,
@@ -128,6 +141,21 @@ func NewView(
 		clientIDInput:       clientIDInput,
 		clientSecretInput:   clientSecretInput,
 		tokenInput:          tokenInput,
+		oauthTimeout:        domain.DefaultOAuthTimeoutSeconds * time.Second,
+	}
+}
+
+// SetOAuthTimeout sets how long the wizard waits for the OAuth browser callback.
+func (v *View) SetOAuthTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		v.oauthTimeout = timeout
+	}
+}
+
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
 	}
 }
 
@@ -198,13 +226,34 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		}
 		return v, nil
 
-	case messages.OAuthFlowCompleted:
+	case oauthCountdownTick:
+		// Ignore ticks from a flow that already finished or was restarted
+		if !v.isCurrentFlow(msg.deadline) {
+			return v, nil
+		}
+		if !time.Now().Before(v.oauthDeadline) {
+			deadline := v.oauthDeadline
+			return v, func() tea.Msg { return messages.OAuthFlowTimeout{Deadline: deadline} }
+		}
+		return v, v.oauthCountdown()
+
+	case messages.OAuthFlowTimeout:
+		if !v.isCurrentFlow(msg.Deadline) {
+			return v, nil
+		}
 		v.waitingForAuth = false
-		// Stop callback server if running
-		if v.callbackServer != nil {
-			_ = v.callbackServer.Stop() //nolint:errcheck // best-effort cleanup
-			v.callbackServer = nil
+		v.stopCallbackServer()
+		v.err = errOAuthTimeout
+		v.step = StepEnterCredentials
+		return v, nil
+
+	case messages.OAuthFlowCompleted:
+		// Ignore results of a flow that timed out, was cancelled or restarted
+		if !v.isCurrentFlow(msg.Deadline) {
+			return v, nil
 		}
+		v.waitingForAuth = false
+		v.stopCallbackServer()
 		if msg.Err != nil {
 			v.err = msg.Err
 			v.step = StepEnterCredentials
@@ -219,6 +268,7 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		v.selectedAuthProviderID = msg.authProviderID
 		v.oauthState = msg.flowState
 		v.waitingForAuth = true
+		v.oauthDeadline = time.Now().Add(v.oauthTimeout)
 		v.step = StepOAuthFlow
 
 		// Start callback server
@@ -232,18 +282,53 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		// Open browser (failure is ok, URL is shown in UI)
 		_ = oauth.OpenBrowser(msg.flowState.AuthURL) //nolint:errcheck // URL shown in UI
 
-		// Return a command that waits for the callback
-		return v, v.waitForOAuthCallback(msg.authProviderID, msg.flowState)
+		// Wait for the callback while counting down to the deadline
+		return v, tea.Batch(
+			v.waitForOAuthCallback(msg.authProviderID, msg.flowState),
+			v.oauthCountdown(),
+		)
 	}
 
 	return v, nil
 }
 
+// isCurrentFlow reports whether the OAuth flow with the given deadline is
+// the one being waited for.
+func (v *View) isCurrentFlow(deadline time.Time) bool {
+	return v.waitingForAuth && deadline.Equal(v.oauthDeadline)
+}
+
+// oauthCountdownTick refreshes the OAuth countdown once per second.
+type oauthCountdownTick struct {
+	deadline time.Time
+}
+
+// oauthCountdown returns a command that ticks the OAuth countdown after one second.
+func (v *View) oauthCountdown() tea.Cmd {
+	deadline := v.oauthDeadline
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return oauthCountdownTick{deadline: deadline}
+	})
+}
+
+// stopCallbackServer stops the OAuth callback server if it is running.
+func (v *View) stopCallbackServer() {
+	if v.callbackServer != nil {
+		_ = v.callbackServer.Stop() //nolint:errcheck // best-effort cleanup
+		v.callbackServer = nil
+	}
+}
+
 // handleKeyMsg handles key presses based on current step.
 //
 //nolint:gocyclo // central key handler requires complexity for wizard navigation
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	if msg.String() == "esc" { //nolint:nestif // escape handling requires nested conditionals for step navigation
+	// Go back; text entry steps only take Esc, so typed keys reach the inputs
+	back := key.Matches(msg, v.keys.Back)
+	if v.step == StepEnterConfig || v.step == StepEnterCredentials {
+		back = msg.Type == tea.KeyEsc
+	}
+	if back { //nolint:nestif // escape handling requires nested conditionals for step navigation
 		// Go back one step or exit
 		switch v.step {
 		case StepSelectConnector:
@@ -277,6 +362,7 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		case StepOAuthFlow:
 			// Cancel OAuth flow
 			v.waitingForAuth = false
+			v.stopCallbackServer()
 			v.step = StepEnterCredentials
 			return v, nil
 		case StepComplete:
@@ -301,7 +387,7 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		// Waiting for OAuth callback - no key handling needed
 		return v, nil
 	case StepComplete:
-		if msg.String() == keyEnter {
+		if key.Matches(msg, v.keys.Select) {
 			return v, func() tea.Msg {
 				return messages.ViewChanged{View: messages.ViewSources}
 			}
@@ -312,16 +398,16 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 }
 
 func (v *View) handleConnectorSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case key.Matches(msg, v.keys.Down):
 		if v.selected < len(v.connectors)-1 {
 			v.selected++
 		}
-	case keyEnter:
+	case key.Matches(msg, v.keys.Select):
 		if len(v.connectors) > 0 && v.selected < len(v.connectors) {
 			v.connector = &v.connectors[v.selected]
 			cmd := v.initConfigInputs()
@@ -413,8 +499,8 @@ func (v *View) determineNextStepAfterConfig() tea.Cmd {
 	}
 
 	// If connector supports multiple auth methods (PAT + OAuth), show auth method selection
-	if v.connector.AuthCapability.SupportsMultipleMethods() {
-		v.authMethodOptions = v.connector.AuthCapability.SupportedMethods()
+	if methods := tuiAuthMethods(v.connector.AuthCapability); len(methods) > 1 {
+		v.authMethodOptions = methods
 		v.selectedAuthMethodIndex = 0
 		v.step = StepSelectAuthMethod
 		return nil
@@ -500,6 +586,19 @@ func (v *View) loadAuthorizations() tea.Cmd {
 	}
 }
 
+// tuiAuthMethods returns the auth methods a connector can be added with here.
+// GitHub App sources need a private key file, so they are added with
+// sercha source add instead.
+func tuiAuthMethods(capability domain.AuthCapability) []domain.AuthMethod {
+	var methods []domain.AuthMethod
+	for _, method := range capability.SupportedMethods() {
+		if method != domain.AuthMethodGitHubApp {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
 // handleAuthMethodSelect handles user selection in the auth method selection step.
 // This step is shown when a connector supports both PAT and OAuth.
 //
@@ -507,16 +606,16 @@ func (v *View) loadAuthorizations() tea.Cmd {
 func (v *View) handleAuthMethodSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
 	maxIndex := len(v.authMethodOptions) - 1
 
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selectedAuthMethodIndex > 0 {
 			v.selectedAuthMethodIndex--
 		}
-	case keyDown, "j":
+	case key.Matches(msg, v.keys.Down):
 		if v.selectedAuthMethodIndex < maxIndex {
 			v.selectedAuthMethodIndex++
 		}
-	case keyEnter:
+	case key.Matches(msg, v.keys.Select):
 		if v.selectedAuthMethodIndex >= 0 && v.selectedAuthMethodIndex < len(v.authMethodOptions) {
 			v.chosenAuthMethod = v.authMethodOptions[v.selectedAuthMethodIndex]
 
@@ -551,22 +650,18 @@ func (v *View) handleAuthSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
 	// Options: existing auth providers + "Create new OAuth app" at the end
 	maxIndex := len(v.authProviders) // last index is "create new"
 
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selectedAuthIndex > 0 {
 			v.selectedAuthIndex--
 		}
-	case keyDown, "j":
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
 		if v.selectedAuthIndex < maxIndex {
 			v.selectedAuthIndex++
 		}
-	case "n", "a":
-		// Shortcut to add new OAuth app
-		v.creatingNewAuth = true
-		v.initCredentialInputs()
-		v.step = StepEnterCredentials
-		return v, v.clientIDInput.Focus()
-	case keyEnter:
+		return v, nil
+	case key.Matches(msg, v.keys.Select):
 		if v.selectedAuthIndex == len(v.authProviders) {
 			// "Create new OAuth app" selected
 			v.creatingNewAuth = true
@@ -579,6 +674,16 @@ func (v *View) handleAuthSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
 			return v, v.startOAuthWithExistingProvider()
 		}
 		v.err = fmt.Errorf("no OAuth app selected")
+		return v, nil
+	}
+
+	switch msg.String() {
+	case "n", "a":
+		// Shortcut to add new OAuth app
+		v.creatingNewAuth = true
+		v.initCredentialInputs()
+		v.step = StepEnterCredentials
+		return v, v.clientIDInput.Focus()
 	}
 	return v, nil
 }
@@ -717,8 +822,14 @@ func (v *View) createAuthorizationAndSource() tea.Cmd {
 
 		// Update source with credentials_id
 		source.CredentialsID = creds.ID
-		//nolint:errcheck // Best effort - source exists but credentials_id not linked
-		v.sourceService.Update(ctx, source)
+		if err := v.sourceService.Update(ctx, source); err != nil {
+			// Rollback credentials and source - ignore errors as this is best-effort cleanup
+			//nolint:errcheck // Best-effort cleanup on failure
+			v.credentialsService.Delete(ctx, creds.ID)
+			//nolint:errcheck // Best-effort cleanup on failure
+			v.sourceService.Remove(ctx, sourceID)
+			return messages.SourceAdded{Err: fmt.Errorf("failed to link credentials: %w", err)}
+		}
 
 		return messages.SourceAdded{Source: source, Err: nil}
 	}
@@ -826,35 +937,46 @@ type oauthFlowStarted struct {
 // waitForOAuthCallback returns a command that waits for the OAuth callback.
 // It exchanges the code for tokens and fetches the account identifier.
 func (v *View) waitForOAuthCallback(authProviderID string, flowState *driving.OAuthFlowState) tea.Cmd {
+	// Capture server, timeout and deadline now; the view may stop the server
+	// when the flow ends, or start another flow
+	callbackServer := v.callbackServer
+	timeout := v.oauthTimeout
+	deadline := v.oauthDeadline
+	done := func(err error) tea.Msg {
+		return messages.OAuthFlowCompleted{Err: err, Deadline: deadline}
+	}
 	return func() tea.Msg {
-		if v.callbackServer == nil {
-			return messages.OAuthFlowCompleted{Err: fmt.Errorf("callback server not running")}
+		if callbackServer == nil {
+			return done(fmt.Errorf("callback server not running"))
 		}
 
 		ctx := context.Background()
 
-		// Wait for callback (5 minute timeout)
-		code, err := v.callbackServer.WaitForCode(5 * time.Minute)
+		// Wait for callback until the configured timeout
+		code, err := callbackServer.WaitForCode(timeout)
+		if errors.Is(err, oauth.ErrCallbackTimeout) {
+			return messages.OAuthFlowTimeout{Deadline: deadline}
+		}
 		if err != nil {
-			return messages.OAuthFlowCompleted{Err: fmt.Errorf("authorization failed: %w", err)}
+			return done(fmt.Errorf("authorization failed: %w", err))
 		}
 
 		// Get the AuthProvider to exchange tokens
 		if v.authProviderService == nil {
-			return messages.OAuthFlowCompleted{Err: fmt.Errorf("auth provider service not available")}
+			return done(fmt.Errorf("auth provider service not available"))
 		}
 
 		authProvider, err := v.authProviderService.Get(ctx, authProviderID)
 		if err != nil {
-			return messages.OAuthFlowCompleted{Err: fmt.Errorf("failed to get auth provider: %w", err)}
+			return done(fmt.Errorf("failed to get auth provider: %w", err))
 		}
 
 		if authProvider.OAuth == nil {
-			return messages.OAuthFlowCompleted{Err: fmt.Errorf("auth provider has no OAuth configuration")}
+			return done(fmt.Errorf("auth provider has no OAuth configuration"))
 		}
 
 		oauthCfg := authProvider.OAuth
-		redirectURI := v.callbackServer.RedirectURI()
+		redirectURI := callbackServer.RedirectURI()
 
 		// Exchange code for tokens
 		tokens, err := drivenoauth.ExchangeCodeForTokens(
@@ -867,7 +989,7 @@ func (v *View) waitForOAuthCallback(authProviderID string, flowState *driving.OA
 			flowState.CodeVerifier,
 		)
 		if err != nil {
-			return messages.OAuthFlowCompleted{Err: fmt.Errorf("failed to exchange code for tokens: %w", err)}
+			return done(fmt.Errorf("failed to exchange code for tokens: %w", err))
 		}
 
 		// Store tokens in view state for later credential creation
@@ -880,13 +1002,14 @@ func (v *View) waitForOAuthCallback(authProviderID string, flowState *driving.OA
 
 		// Fetch account identifier using connector registry
 		if v.connector != nil && v.connectorRegistry != nil {
-			accountID, err := v.connectorRegistry.GetUserInfo(ctx, v.connector.ID, tokens.AccessToken)
-			if err == nil && accountID != "" {
-				v.accountIdentifier = accountID
+			account, err := v.connectorRegistry.GetUserInfo(ctx, v.connector.ID, tokens.AccessToken)
+			if err == nil && account.Identifier != "" {
+				v.accountIdentifier = account.Identifier
+				v.accountName = account.Label()
 			}
 		}
 
-		return messages.OAuthFlowCompleted{Err: nil}
+		return done(nil)
 	}
 }
 
@@ -918,9 +1041,9 @@ func (v *View) createSourceWithNewAuthorization() tea.Cmd {
 			}
 		}
 
-		// Append account identifier for OAuth sources (like CLI does)
-		if v.accountIdentifier != "" {
-			name = fmt.Sprintf("%s (%s)", name, v.accountIdentifier)
+		// Append account name for OAuth sources (like CLI does)
+		if v.accountName != "" {
+			name = fmt.Sprintf("%s (%s)", name, v.accountName)
 		}
 
 		// Create source first (credentials have FK to source)
@@ -957,8 +1080,14 @@ func (v *View) createSourceWithNewAuthorization() tea.Cmd {
 
 		// Update source with credentials_id
 		source.CredentialsID = creds.ID
-		//nolint:errcheck // Best effort - source exists but credentials_id not linked
-		v.sourceService.Update(ctx, source)
+		if err := v.sourceService.Update(ctx, source); err != nil {
+			// Rollback credentials and source - ignore errors as this is best-effort cleanup
+			//nolint:errcheck // Best-effort cleanup on failure
+			v.credentialsService.Delete(ctx, creds.ID)
+			//nolint:errcheck // Best-effort cleanup on failure
+			v.sourceService.Remove(ctx, sourceID)
+			return messages.SourceAdded{Err: fmt.Errorf("failed to link credentials: %w", err)}
+		}
 
 		return messages.SourceAdded{Source: source, Err: nil}
 	}
@@ -1126,18 +1255,69 @@ func (v *View) renderConnectorSelect() string {
 			authBadge = "[oauth]"
 		}
 
-		line := fmt.Sprintf("%s%s - %s %s", indicator, c.Name, c.Description, authBadge)
+		line := fmt.Sprintf("%s%s %s", indicator, c.Name, authBadge)
 		if i == v.selected {
 			b.WriteString(v.styles.Selected.Render(line))
 		} else {
 			b.WriteString(v.styles.Normal.Render(line))
 		}
 		b.WriteString("\n")
+		if c.Description != "" {
+			b.WriteString(v.styles.Muted.Render("    " + c.Description))
+			b.WriteString("\n")
+		}
+	}
+
+	if v.selected >= 0 && v.selected < len(v.connectors) {
+		b.WriteString("\n")
+		b.WriteString(v.renderConnectorDetails(v.connectors[v.selected]))
 	}
 
 	return b.String()
 }
 
+// renderConnectorDetails renders the detail pane for the highlighted connector.
+func (v *View) renderConnectorDetails(c domain.ConnectorType) string {
+	var b strings.Builder
+
+	if len(c.ContentTypes) > 0 {
+		b.WriteString(v.styles.Normal.Render("Indexes: " + strings.Join(c.ContentTypes, ", ")))
+		b.WriteString("\n")
+	}
+
+	auth := "none"
+	if c.AuthCapability.RequiresAuth() {
+		var methods []string
+		for _, m := range c.AuthCapability.SupportedMethods() {
+			methods = append(methods, authMethodLabel(m))
+		}
+		auth = strings.Join(methods, " or ")
+	}
+	b.WriteString(v.styles.Normal.Render("Authentication: " + auth))
+	b.WriteString("\n")
+
+	if c.AuthHint != "" {
+		b.WriteString(v.styles.Muted.Render(c.AuthHint))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// authMethodLabel returns a readable name for an auth method.
+func authMethodLabel(m domain.AuthMethod) string {
+	switch m {
+	case domain.AuthMethodPAT:
+		return "Personal Access Token"
+	case domain.AuthMethodOAuth:
+		return "OAuth App"
+	case domain.AuthMethodGitHubApp:
+		return "GitHub App"
+	default:
+		return string(m)
+	}
+}
+
 func (v *View) renderConfigInput() string {
 	var b strings.Builder
 
@@ -1227,6 +1407,12 @@ func (v *View) renderAuthSelect() string {
 	b.WriteString(v.styles.Muted.Render("Select an existing OAuth app or create a new one."))
 	b.WriteString("\n\n")
 
+	if others := v.sharedAuthConnectors(); len(others) > 0 {
+		b.WriteString(v.styles.Muted.Render(fmt.Sprintf("Other %s services using this OAuth app: %s",
+			v.connector.ProviderType.DisplayName(), strings.Join(others, ", "))))
+		b.WriteString("\n\n")
+	}
+
 	// Show existing auth providers
 	for i := range v.authProviders {
 		provider := &v.authProviders[i]
@@ -1266,6 +1452,21 @@ func (v *View) renderAuthSelect() string {
 	return b.String()
 }
 
+// sharedAuthConnectors returns the names of the provider's other connectors,
+// which can reuse an OAuth app configured for the selected connector.
+func (v *View) sharedAuthConnectors() []string {
+	if v.connector == nil || v.connectorRegistry == nil {
+		return nil
+	}
+	var names []string
+	for _, c := range v.connectorRegistry.ListByProvider(v.connector.ProviderType) {
+		if c.ID != v.connector.ID {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
 func (v *View) renderCredentialsInput() string {
 	var b strings.Builder
 
@@ -1319,6 +1520,9 @@ func (v *View) renderOAuthFlow() string {
 	if v.waitingForAuth {
 		b.WriteString(v.styles.Normal.Render("A browser window should have opened for authentication."))
 		b.WriteString("\n\n")
+		b.WriteString(v.styles.Normal.Render(fmt.Sprintf(
+			"Waiting for authorisation... %s remaining", formatRemaining(time.Until(v.oauthDeadline)))))
+		b.WriteString("\n\n")
 		b.WriteString(v.styles.Muted.Render("Complete the authorization in your browser."))
 		b.WriteString("\n")
 		b.WriteString(v.styles.Muted.Render("This window will update automatically when done."))
@@ -1333,6 +1537,15 @@ func (v *View) renderOAuthFlow() string {
 	return b.String()
 }
 
+// formatRemaining formats a countdown duration as m:ss.
+func formatRemaining(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	secs := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
 func (v *View) renderComplete() string {
 	var b strings.Builder
 
@@ -1404,6 +1617,7 @@ func (v *View) Reset() {
 	v.selectedAuthProviderID = ""
 	v.pendingOAuthTokens = nil
 	v.accountIdentifier = ""
+	v.accountName = ""
 	v.source = nil
 	v.err = nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -21,6 +22,7 @@ type MockSourceService struct {
 	AddFunc    func(ctx context.Context, source domain.Source) error
 	ListFunc   func(ctx context.Context) ([]domain.Source, error)
 	RemoveFunc func(ctx context.Context, id string) error
+	UpdateFunc func(ctx context.Context, source domain.Source) error
 }
 
 func (m *MockSourceService) Add(ctx context.Context, source domain.Source) error {
@@ -48,7 +50,34 @@ func (m *MockSourceService) Remove(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockSourceService) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *MockSourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
 func (m *MockSourceService) Update(ctx context.Context, source domain.Source) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, source)
+	}
 	return nil
 }
 
@@ -60,6 +89,10 @@ func (m *MockSourceService) ValidateConfig(
 	return nil
 }
 
+func (m *MockSourceService) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
 // MockConnectorRegistry implements driving.ConnectorRegistry for testing.
 type MockConnectorRegistry struct {
 	ListFunc           func() []domain.ConnectorType
@@ -67,7 +100,7 @@ type MockConnectorRegistry struct {
 	GetOAuthDefaultsFn func(connectorType string) *driving.OAuthDefaults
 	SupportsOAuthFn    func(connectorType string) bool
 	BuildAuthURLFn     func(connectorType string, authProvider *domain.AuthProvider, redirectURI, state, codeChallenge string) (string, error)
-	GetUserInfoFn      func(ctx context.Context, connectorType string, accessToken string) (string, error)
+	GetUserInfoFn      func(ctx context.Context, connectorType string, accessToken string) (domain.AccountInfo, error)
 	hints              map[string]string
 }
 
@@ -150,11 +183,11 @@ func (m *MockConnectorRegistry) BuildAuthURL(
 	return "https://example.com/oauth/authorize?client_id=" + authProvider.OAuth.ClientID + "&state=" + state, nil
 }
 
-func (m *MockConnectorRegistry) GetUserInfo(ctx context.Context, connectorType string, accessToken string) (string, error) {
+func (m *MockConnectorRegistry) GetUserInfo(ctx context.Context, connectorType string, accessToken string) (domain.AccountInfo, error) {
 	if m.GetUserInfoFn != nil {
 		return m.GetUserInfoFn(ctx, connectorType, accessToken)
 	}
-	return "test@example.com", nil
+	return domain.AccountInfo{Identifier: "test@example.com", Email: "test@example.com"}, nil
 }
 
 func (m *MockConnectorRegistry) GetSetupHint(connectorType string) string {
@@ -174,6 +207,10 @@ func (m *MockConnectorRegistry) GetConnectorsForProvider(provider domain.Provide
 	return result
 }
 
+func (m *MockConnectorRegistry) ListByProvider(providerType domain.ProviderType) []domain.ConnectorType {
+	return m.GetConnectorsForProvider(providerType)
+}
+
 func (m *MockConnectorRegistry) ExchangeCode(_ context.Context, _ string, _ *domain.AuthProvider, _, _, _ string) (*domain.OAuthToken, error) {
 	return nil, nil
 }
@@ -652,6 +689,108 @@ func TestView_Update_OAuthFlowCompleted_Error(t *testing.T) {
 	assert.Equal(t, StepEnterCredentials, view.step)
 }
 
+func TestView_Update_OAuthFlowTimeout(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepOAuthFlow
+	view.waitingForAuth = true
+
+	view.Update(messages.OAuthFlowTimeout{})
+
+	assert.False(t, view.waitingForAuth)
+	assert.Equal(t, StepEnterCredentials, view.step)
+	require.Error(t, view.err)
+	assert.Equal(t, "Authorisation timed out. Please try again.", view.err.Error())
+}
+
+func TestView_Update_OAuthFlowTimeout_IgnoredWhenNotWaiting(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepComplete
+	view.waitingForAuth = false
+
+	view.Update(messages.OAuthFlowTimeout{})
+
+	assert.Equal(t, StepComplete, view.step)
+	assert.NoError(t, view.err)
+}
+
+func TestView_Update_OAuthCountdownTick_BeforeDeadline(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepOAuthFlow
+	view.waitingForAuth = true
+	view.oauthDeadline = time.Now().Add(time.Minute)
+
+	_, cmd := view.Update(oauthCountdownTick{deadline: view.oauthDeadline})
+
+	// Keeps ticking while time remains
+	assert.NotNil(t, cmd)
+	assert.True(t, view.waitingForAuth)
+}
+
+func TestView_Update_OAuthCountdownTick_AfterDeadline(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepOAuthFlow
+	view.waitingForAuth = true
+	view.oauthDeadline = time.Now().Add(-time.Second)
+
+	_, cmd := view.Update(oauthCountdownTick{deadline: view.oauthDeadline})
+
+	require.NotNil(t, cmd)
+	assert.Equal(t, messages.OAuthFlowTimeout{Deadline: view.oauthDeadline}, cmd())
+}
+
+func TestView_Update_OAuthCountdownTick_StaleFlow(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepOAuthFlow
+	view.waitingForAuth = true
+	view.oauthDeadline = time.Now().Add(time.Minute)
+
+	_, cmd := view.Update(oauthCountdownTick{deadline: time.Now().Add(-time.Minute)})
+
+	assert.Nil(t, cmd)
+}
+
+func TestView_Update_OAuthFlowCompleted_StaleFlow(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepOAuthFlow
+	view.waitingForAuth = true
+	view.oauthDeadline = time.Now().Add(time.Minute)
+	stale := view.oauthDeadline.Add(-time.Minute)
+
+	// Results of an earlier flow neither fail nor finish the current one
+	_, cmd := view.Update(messages.OAuthFlowCompleted{Err: errors.New("oauth failed"), Deadline: stale})
+	assert.Nil(t, cmd)
+	_, cmd = view.Update(messages.OAuthFlowCompleted{Deadline: stale})
+	assert.Nil(t, cmd)
+	view.Update(messages.OAuthFlowTimeout{Deadline: stale})
+
+	assert.True(t, view.waitingForAuth)
+	assert.Equal(t, StepOAuthFlow, view.step)
+	assert.NoError(t, view.err)
+
+	// The current flow's result is applied
+	view.Update(messages.OAuthFlowCompleted{Err: errors.New("oauth failed"), Deadline: view.oauthDeadline})
+
+	assert.False(t, view.waitingForAuth)
+	assert.Equal(t, StepEnterCredentials, view.step)
+}
+
+func TestView_SetOAuthTimeout(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	assert.Equal(t, 5*time.Minute, view.oauthTimeout)
+
+	view.SetOAuthTimeout(30 * time.Second)
+	assert.Equal(t, 30*time.Second, view.oauthTimeout)
+
+	view.SetOAuthTimeout(0)
+	assert.Equal(t, 30*time.Second, view.oauthTimeout)
+}
+
+func TestFormatRemaining(t *testing.T) {
+	assert.Equal(t, "4:32", formatRemaining(4*time.Minute+32*time.Second))
+	assert.Equal(t, "0:05", formatRemaining(5*time.Second))
+	assert.Equal(t, "0:00", formatRemaining(-time.Second))
+}
+
 func TestView_ValidateConfig_Valid(t *testing.T) {
 	view := NewView(nil, nil, nil, nil, nil, nil)
 	view.connector = &domain.ConnectorType{
@@ -800,6 +939,39 @@ func TestView_CreateAuthorizationAndSource_Success(t *testing.T) {
 	assert.NoError(t, added.Err)
 }
 
+func TestView_CreateAuthorizationAndSource_UpdateError_RollsBack(t *testing.T) {
+	var removedSource, deletedCreds string
+	sourceService := &MockSourceService{
+		UpdateFunc: func(_ context.Context, source domain.Source) error {
+			assert.NotEmpty(t, source.CredentialsID)
+			return errors.New("update failed")
+		},
+		RemoveFunc: func(_ context.Context, id string) error {
+			removedSource = id
+			return nil
+		},
+	}
+	credentialsService := &MockCredentialsService{
+		DeleteFunc: func(_ context.Context, id string) error {
+			deletedCreds = id
+			return nil
+		},
+	}
+	view := NewView(nil, sourceService, nil, nil, nil, credentialsService)
+	view.connector = &domain.ConnectorType{ID: "github", Name: "GitHub", AuthMethod: domain.AuthMethodPAT}
+	view.configInputs = []textinput.Model{}
+	view.configKeys = []string{}
+	view.tokenInput.SetValue("ghp_test_token")
+
+	msg := view.createAuthorizationAndSource()()
+	added, ok := msg.(messages.SourceAdded)
+	require.True(t, ok)
+	require.Error(t, added.Err)
+	assert.Contains(t, added.Err.Error(), "failed to link credentials")
+	assert.NotEmpty(t, removedSource)
+	assert.NotEmpty(t, deletedCreds)
+}
+
 func TestView_CreateAuthorizationAndSource_NilService(t *testing.T) {
 	view := NewView(nil, nil, nil, nil, nil, nil)
 	view.connector = &domain.ConnectorType{ID: "github"}
@@ -920,6 +1092,7 @@ func TestView_CreateSourceWithNewAuthorization_Success(t *testing.T) {
 		TokenType:    "Bearer",
 	}
 	view.accountIdentifier = "test@example.com"
+	view.accountName = "Test User"
 	ti := textinput.New()
 	ti.SetValue("My Folder")
 	view.configInputs = []textinput.Model{ti}
@@ -933,6 +1106,7 @@ func TestView_CreateSourceWithNewAuthorization_Success(t *testing.T) {
 	require.True(t, ok)
 	assert.NoError(t, added.Err)
 	assert.Equal(t, "auth-provider-1", added.Source.AuthProviderID)
+	assert.Equal(t, "Google Drive (Test User)", added.Source.Name)
 }
 
 func TestView_CreateSourceWithNewAuthorization_NilService(t *testing.T) {
@@ -1652,6 +1826,25 @@ func TestView_View_SelectAuth(t *testing.T) {
 	assert.Contains(t, output, "Create new OAuth app")
 }
 
+func TestView_View_SelectAuth_SharedConnectors(t *testing.T) {
+	s := styles.DefaultStyles()
+	google := []domain.ConnectorType{
+		{ID: "gmail", Name: "Gmail", ProviderType: domain.ProviderGoogle},
+		{ID: "google-calendar", Name: "Google Calendar", ProviderType: domain.ProviderGoogle},
+		{ID: "google-drive", Name: "Google Drive", ProviderType: domain.ProviderGoogle},
+	}
+	registry := &MockConnectorRegistry{ListFunc: func() []domain.ConnectorType { return google }}
+	view := NewView(s, nil, registry, nil, nil, nil)
+	view.ready = true
+	view.step = StepSelectAuth
+	view.connector = &google[2]
+
+	output := view.View()
+
+	assert.Contains(t, output, "Other Google services using this OAuth app: Gmail, Google Calendar")
+	assert.Equal(t, []string{"Gmail", "Google Calendar"}, view.sharedAuthConnectors())
+}
+
 func TestView_View_EnterCredentials_PAT(t *testing.T) {
 	s := styles.DefaultStyles()
 	view := NewView(s, nil, nil, nil, nil, nil)
@@ -1699,12 +1892,14 @@ func TestView_View_OAuthFlow(t *testing.T) {
 	view.oauthState = &driving.OAuthFlowState{
 		AuthURL: "https://example.com/oauth",
 	}
+	view.oauthDeadline = time.Now().Add(4*time.Minute + 32*time.Second + 500*time.Millisecond)
 
 	output := view.View()
 
 	assert.Contains(t, output, "Authenticating")
 	assert.Contains(t, output, "browser window")
 	assert.Contains(t, output, "https://example.com/oauth")
+	assert.Contains(t, output, "Waiting for authorisation... 4:32 remaining")
 }
 
 func TestView_View_OAuthFlow_NotWaiting(t *testing.T) {
@@ -1911,6 +2106,45 @@ func TestView_RenderConnectorSelect_WithConnectors(t *testing.T) {
 	assert.Contains(t, output, "[no auth]")
 }
 
+func TestView_RenderConnectorSelect_ShowsDescriptionAndDetails(t *testing.T) {
+	s := styles.DefaultStyles()
+	view := NewView(s, nil, nil, nil, nil, nil)
+	view.connectors = []domain.ConnectorType{
+		{
+			ID: "filesystem", Name: "Filesystem", Description: "Index files from a local directory",
+			AuthCapability: domain.AuthCapNone, ContentTypes: []string{"files"},
+		},
+		{
+			ID: "github", Name: "GitHub", Description: "Index repositories from GitHub",
+			AuthCapability: domain.AuthCapPAT | domain.AuthCapOAuth,
+			ContentTypes:   []string{"files", "issues", "prs"},
+			AuthHint:       "Requires: repo scope on GitHub token",
+		},
+	}
+	view.selected = 1
+
+	output := view.renderConnectorSelect()
+
+	assert.Contains(t, output, "Index files from a local directory")
+	assert.Contains(t, output, "Index repositories from GitHub")
+	assert.Contains(t, output, "Indexes: files, issues, prs")
+	assert.Contains(t, output, "Authentication: Personal Access Token or OAuth App")
+	assert.Contains(t, output, "Requires: repo scope on GitHub token")
+}
+
+func TestView_RenderConnectorDetails_NoAuth(t *testing.T) {
+	s := styles.DefaultStyles()
+	view := NewView(s, nil, nil, nil, nil, nil)
+
+	output := view.renderConnectorDetails(domain.ConnectorType{
+		ID: "filesystem", AuthCapability: domain.AuthCapNone,
+	})
+
+	assert.Contains(t, output, "Authentication: none")
+	assert.NotContains(t, output, "Indexes:")
+	assert.NotContains(t, output, "Requires:")
+}
+
 func TestView_RenderConfigInput_NoConfig(t *testing.T) {
 	s := styles.DefaultStyles()
 	view := NewView(s, nil, nil, nil, nil, nil)
@@ -2151,3 +2385,10 @@ func TestView_HandleConfigInput_InvalidIndex(t *testing.T) {
 
 	// Should not crash
 }
+
+func TestTUIAuthMethods_LeavesOutGitHubApp(t *testing.T) {
+	methods := tuiAuthMethods(domain.AuthCapPAT | domain.AuthCapOAuth | domain.AuthCapGitHubApp)
+
+	assert.Equal(t, []domain.AuthMethod{domain.AuthMethodPAT, domain.AuthMethodOAuth}, methods)
+	assert.Len(t, tuiAuthMethods(domain.AuthCapPAT|domain.AuthCapGitHubApp), 1)
+}
@@ -5,9 +5,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/external"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -17,11 +21,17 @@ import (
 // View is the document content view.
 type View struct {
 	styles          *styles.Styles
+	keys            *keymap.KeyMap
 	documentService driving.DocumentService
 
 	document     *domain.Document
+	breadcrumbs  domain.BreadcrumbTrail
 	content      string
+	original     string // original text, empty unless kept at sync
+	showOriginal bool
 	lines        []string
+	terms        []string
+	returnView   messages.ViewType
 	scrollOffset int
 	width        int
 	height       int
@@ -34,20 +44,43 @@ type View struct {
 func NewView(s *styles.Styles, documentService driving.DocumentService) *View {
 	return &View{
 		styles:          s,
+		keys:            keymap.DefaultKeyMap(),
 		documentService: documentService,
+		returnView:      messages.ViewDocuments,
 	}
 }
 
+// SetQuery sets the query whose terms are highlighted in the content.
+// An empty query turns highlighting off.
+func (v *View) SetQuery(query string) {
+	v.terms = styles.QueryTerms(query)
+}
+
+// SetReturnView sets the view Esc goes back to.
+func (v *View) SetReturnView(view messages.ViewType) {
+	v.returnView = view
+}
+
 // SetDocument sets the document and loads its content.
 func (v *View) SetDocument(doc *domain.Document) tea.Cmd {
 	v.document = doc
+	v.breadcrumbs = nil
 	v.content = ""
+	v.original = ""
+	v.showOriginal = false
 	v.lines = nil
 	v.scrollOffset = 0
 	v.err = nil
 	return v.loadContent()
 }
 
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
+	}
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -61,15 +94,58 @@ func (v *View) loadContent() tea.Cmd {
 		}
 
 		v.loading = true
-		content, err := v.documentService.GetContent(context.Background(), v.document.ID)
+		ctx := context.Background()
+		content, err := v.documentService.GetContent(ctx, v.document.ID)
+		// Breadcrumbs are decoration; a failed lookup just leaves them out
+		breadcrumbs, _ := v.documentService.GetAncestors(ctx, v.document.ID)
 		return messages.DocumentContentLoaded{
-			DocumentID: v.document.ID,
-			Content:    content,
-			Err:        err,
+			DocumentID:  v.document.ID,
+			Content:     content,
+			Original:    v.loadOriginal(ctx),
+			Breadcrumbs: breadcrumbs,
+			Err:         err,
 		}
 	}
 }
 
+// loadOriginal returns the document's original text. Documents synced
+// without originals, and binary originals that cannot be shown as text,
+// have none; like breadcrumbs, a failed lookup just leaves it out.
+func (v *View) loadOriginal(ctx context.Context) string {
+	original, err := v.documentService.GetOriginal(ctx, v.document.ID)
+	if err != nil || !utf8.Valid(original) {
+		return ""
+	}
+	return string(original)
+}
+
+// openDocument returns a command that opens the document in its default application.
+func (v *View) openDocument() tea.Cmd {
+	return func() tea.Msg {
+		if v.document == nil || v.documentService == nil {
+			return messages.ErrorOccurred{Err: fmt.Errorf("document service not available")}
+		}
+
+		if err := v.documentService.Open(context.Background(), v.document.ID); err != nil {
+			return messages.ErrorOccurred{Err: err}
+		}
+		return nil
+	}
+}
+
+// editDocument returns a command that opens the document outside the TUI:
+// local files in the user's editor and web pages in the browser. Other
+// documents open as with "o", through their connector's web URL.
+func (v *View) editDocument() tea.Cmd {
+	if v.document == nil {
+		return nil
+	}
+	if cmd := external.Open(v.document.URI); cmd != nil {
+		return cmd
+	}
+	return v.openDocument()
+}
+
 // Update handles messages for the document content view.
 func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -89,6 +165,8 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 			v.err = msg.Err
 		} else {
 			v.content = msg.Content
+			v.original = msg.Original
+			v.breadcrumbs = msg.Breadcrumbs
 			v.wrapContent()
 			v.err = nil
 		}
@@ -104,16 +182,25 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.scrollOffset > 0 {
 			v.scrollOffset--
 		}
-	case "down", "j":
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
 		maxOffset := v.maxScrollOffset()
 		if v.scrollOffset < maxOffset {
 			v.scrollOffset++
 		}
+		return v, nil
+	case key.Matches(msg, v.keys.Back):
+		return v, func() tea.Msg {
+			return messages.ViewChanged{View: v.returnView, Keep: true}
+		}
+	}
+
+	switch msg.String() {
 	case "pgup", "ctrl+u":
 		v.scrollOffset -= v.visibleLines()
 		if v.scrollOffset < 0 {
@@ -132,10 +219,16 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 	case "c":
 		// Copy all content - stub for now
 		return v, nil
-	case "esc":
-		return v, func() tea.Msg {
-			return messages.ViewChanged{View: messages.ViewDocuments}
+	case "r":
+		if v.original != "" {
+			v.showOriginal = !v.showOriginal
+			v.scrollOffset = 0
+			v.wrapContent()
 		}
+	case "o":
+		return v, v.openDocument()
+	case "e":
+		return v, v.editDocument()
 	}
 
 	return v, nil
@@ -143,7 +236,8 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 
 // wrapContent wraps the content to fit the view width.
 func (v *View) wrapContent() {
-	if v.content == "" {
+	text := v.Content()
+	if text == "" {
 		v.lines = nil
 		return
 	}
@@ -155,7 +249,7 @@ func (v *View) wrapContent() {
 	}
 
 	// Split into lines and wrap long lines
-	rawLines := strings.Split(v.content, "\n")
+	rawLines := strings.Split(text, "\n")
 	v.lines = make([]string, 0, len(rawLines))
 
 	for _, line := range rawLines {
@@ -178,6 +272,9 @@ func (v *View) wrapContent() {
 func (v *View) visibleLines() int {
 	// Reserve lines for title, separator, help, and padding
 	reserved := 6
+	if len(v.breadcrumbs) > 0 {
+		reserved++
+	}
 	available := v.height - reserved
 	if available < 1 {
 		available = 1
@@ -207,6 +304,14 @@ func (v *View) View() string {
 		}
 		title = docTitle
 	}
+	if v.showOriginal {
+		title += " (original)"
+	}
+	// Breadcrumb trail for documents nested under a parent
+	if len(v.breadcrumbs) > 0 {
+		b.WriteString(v.styles.Muted.Render(v.breadcrumbs.String() + " ›"))
+		b.WriteString("\n")
+	}
 	b.WriteString(v.styles.Title.Render(title))
 	b.WriteString("\n")
 
@@ -241,7 +346,7 @@ func (v *View) View() string {
 	// Content
 	visibleLines := v.visibleLines()
 	for i := v.scrollOffset; i < len(v.lines) && i < v.scrollOffset+visibleLines; i++ {
-		b.WriteString(v.styles.Normal.Render(v.lines[i]))
+		b.WriteString(v.styles.Highlight(v.lines[i], v.terms, v.styles.Normal))
 		b.WriteString("\n")
 	}
 
@@ -267,7 +372,15 @@ func (v *View) View() string {
 
 // renderHelp renders the help footer.
 func (v *View) renderHelp() string {
-	return v.styles.Help.Render("[↑/↓/PgUp/PgDn] scroll  [g/G] top/bottom  [c] copy all  [esc] back")
+	toggle := ""
+	if v.original != "" {
+		toggle = "[r] original  "
+		if v.showOriginal {
+			toggle = "[r] normalised  "
+		}
+	}
+	return v.styles.Help.Render("[↑/↓/PgUp/PgDn] scroll  [g/G] top/bottom  [c] copy all  " + toggle +
+		"[o] open  [e] edit  [esc] back")
 }
 
 // SetDimensions sets the view dimensions.
@@ -283,11 +396,31 @@ func (v *View) Document() *domain.Document {
 	return v.document
 }
 
-// Content returns the document content.
+// Breadcrumbs returns the ancestors of the current document, outermost first.
+func (v *View) Breadcrumbs() domain.BreadcrumbTrail {
+	return v.breadcrumbs
+}
+
+// Content returns the text being shown: the normalised content, or the
+// original when it is toggled on.
 func (v *View) Content() string {
+	if v.showOriginal {
+		return v.original
+	}
 	return v.content
 }
 
+// HasOriginal reports whether the original text of the document is available.
+func (v *View) HasOriginal() bool {
+	return v.original != ""
+}
+
+// ShowingOriginal reports whether the original text is shown instead of the
+// normalised content.
+func (v *View) ShowingOriginal() bool {
+	return v.showOriginal
+}
+
 // Err returns the last error.
 func (v *View) Err() error {
 	return v.err
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -19,7 +20,10 @@ import (
 
 // MockDocumentService implements driving.DocumentService for testing.
 type MockDocumentService struct {
-	GetContentFunc func(ctx context.Context, documentID string) (string, error)
+	GetContentFunc   func(ctx context.Context, documentID string) (string, error)
+	GetOriginalFunc  func(ctx context.Context, documentID string) ([]byte, error)
+	GetAncestorsFunc func(ctx context.Context, documentID string) (domain.BreadcrumbTrail, error)
+	OpenFunc         func(ctx context.Context, documentID string) error
 }
 
 func (m *MockDocumentService) ListBySource(ctx context.Context, sourceID string) ([]domain.Document, error) {
@@ -37,19 +41,58 @@ func (m *MockDocumentService) GetContent(ctx context.Context, documentID string)
 	return "", nil
 }
 
+func (m *MockDocumentService) GetOriginal(ctx context.Context, documentID string) ([]byte, error) {
+	if m.GetOriginalFunc != nil {
+		return m.GetOriginalFunc(ctx, documentID)
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *MockDocumentService) GetChunks(ctx context.Context, documentID string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
 func (m *MockDocumentService) GetDetails(ctx context.Context, documentID string) (*driving.DocumentDetails, error) {
 	return nil, nil
 }
 
+func (m *MockDocumentService) GetAncestors(ctx context.Context, documentID string) (domain.BreadcrumbTrail, error) {
+	if m.GetAncestorsFunc != nil {
+		return m.GetAncestorsFunc(ctx, documentID)
+	}
+	return nil, nil
+}
+
 func (m *MockDocumentService) Exclude(ctx context.Context, documentID, reason string) error {
 	return nil
 }
 
+func (m *MockDocumentService) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, nil
+}
+
+func (m *MockDocumentService) RetryQuarantined(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockDocumentService) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	return len(ids), nil
+}
+
+func (m *MockDocumentService) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, nil
+}
+
 func (m *MockDocumentService) Refresh(ctx context.Context, documentID string) error {
 	return nil
 }
 
 func (m *MockDocumentService) Open(ctx context.Context, documentID string) error {
+	if m.OpenFunc != nil {
+		return m.OpenFunc(ctx, documentID)
+	}
 	return nil
 }
 
@@ -96,6 +139,119 @@ func TestView_SetDocument(t *testing.T) {
 	assert.Equal(t, "Test content", loaded.Content)
 }
 
+func TestView_SetDocument_LoadsBreadcrumbs(t *testing.T) {
+	trail := domain.BreadcrumbTrail{{Title: "docs"}, {Title: "guides"}}
+	mock := &MockDocumentService{
+		GetContentFunc: func(_ context.Context, _ string) (string, error) {
+			return "Test content", nil
+		},
+		GetAncestorsFunc: func(_ context.Context, documentID string) (domain.BreadcrumbTrail, error) {
+			assert.Equal(t, "doc-1", documentID)
+			return trail, nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock)
+	view.SetDimensions(80, 24)
+
+	cmd := view.SetDocument(&domain.Document{ID: "doc-1", Title: "setup.md"})
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+
+	assert.Equal(t, trail, view.Breadcrumbs())
+	assert.Contains(t, view.View(), "docs › guides ›")
+
+	// Switching documents clears the previous trail
+	view.SetDocument(&domain.Document{ID: "doc-2"})
+	assert.Empty(t, view.Breadcrumbs())
+}
+
+func TestView_SetDocument_BreadcrumbErrorIgnored(t *testing.T) {
+	mock := &MockDocumentService{
+		GetContentFunc: func(_ context.Context, _ string) (string, error) {
+			return "Test content", nil
+		},
+		GetAncestorsFunc: func(_ context.Context, _ string) (domain.BreadcrumbTrail, error) {
+			return nil, errors.New("lookup failed")
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock)
+
+	cmd := view.SetDocument(&domain.Document{ID: "doc-1"})
+	loaded, ok := cmd().(messages.DocumentContentLoaded)
+	require.True(t, ok)
+	assert.NoError(t, loaded.Err)
+	assert.Equal(t, "Test content", loaded.Content)
+	assert.Empty(t, loaded.Breadcrumbs)
+}
+
+func TestView_ToggleOriginal(t *testing.T) {
+	mock := &MockDocumentService{
+		GetContentFunc: func(_ context.Context, _ string) (string, error) {
+			return "Heading", nil
+		},
+		GetOriginalFunc: func(_ context.Context, documentID string) ([]byte, error) {
+			assert.Equal(t, "doc-1", documentID)
+			return []byte("<h1>Heading</h1>"), nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock)
+	view.SetDimensions(80, 24)
+
+	cmd := view.SetDocument(&domain.Document{ID: "doc-1", Title: "page.html"})
+	view.Update(cmd())
+	require.True(t, view.HasOriginal())
+	assert.Equal(t, "Heading", view.Content())
+	assert.Contains(t, view.View(), "[r] original")
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	assert.True(t, view.ShowingOriginal())
+	assert.Equal(t, "<h1>Heading</h1>", view.Content())
+	out := view.View()
+	assert.Contains(t, out, "page.html (original)")
+	assert.Contains(t, out, "[r] normalised")
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	assert.False(t, view.ShowingOriginal())
+
+	// Switching documents goes back to the normalised content
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	view.SetDocument(&domain.Document{ID: "doc-2"})
+	assert.False(t, view.ShowingOriginal())
+	assert.False(t, view.HasOriginal())
+}
+
+func TestView_ToggleOriginal_NotKept(t *testing.T) {
+	mock := &MockDocumentService{
+		GetContentFunc: func(_ context.Context, _ string) (string, error) {
+			return "Test content", nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock)
+	view.SetDimensions(80, 24)
+
+	cmd := view.SetDocument(&domain.Document{ID: "doc-1"})
+	view.Update(cmd())
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+
+	assert.False(t, view.HasOriginal())
+	assert.False(t, view.ShowingOriginal())
+	assert.NotContains(t, view.View(), "[r]")
+}
+
+func TestView_ToggleOriginal_BinaryOriginalHidden(t *testing.T) {
+	mock := &MockDocumentService{
+		GetOriginalFunc: func(_ context.Context, _ string) ([]byte, error) {
+			return []byte{0xff, 0xfe, 0x00}, nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock)
+
+	cmd := view.SetDocument(&domain.Document{ID: "doc-1"})
+	loaded, ok := cmd().(messages.DocumentContentLoaded)
+	require.True(t, ok)
+	assert.Empty(t, loaded.Original)
+}
+
 func TestView_Init(t *testing.T) {
 	view := NewView(nil, nil)
 
@@ -228,6 +384,37 @@ func TestView_Update_KeyMsg_Back(t *testing.T) {
 	assert.Equal(t, messages.ViewDocuments, changed.View)
 }
 
+func TestView_Update_KeyMsg_BackToReturnView(t *testing.T) {
+	view := NewView(nil, nil)
+	view.SetReturnView(messages.ViewSearch)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	require.NotNil(t, cmd)
+	changed, ok := cmd().(messages.ViewChanged)
+	require.True(t, ok)
+	assert.Equal(t, messages.ViewSearch, changed.View)
+	assert.True(t, changed.Keep)
+}
+
+func TestView_View_HighlightsQuery(t *testing.T) {
+	s := styles.DefaultStyles()
+	s.Match = lipgloss.NewStyle().Transform(func(text string) string { return "[" + text + "]" })
+	view := NewView(s, nil)
+	view.width = 80
+	view.height = 24
+	view.content = "Hybrid search combines rankings.\nNothing here."
+	view.wrapContent()
+
+	view.SetQuery("search")
+	output := view.View()
+	assert.Contains(t, output, "Hybrid [search] combines rankings.")
+	assert.Contains(t, output, "Nothing here.")
+
+	view.SetQuery("")
+	assert.NotContains(t, view.View(), "[search]")
+}
+
 func TestView_Update_ErrorOccurred(t *testing.T) {
 	view := NewView(nil, nil)
 
@@ -458,6 +645,59 @@ func TestView_Update_KeyMsg_CopyKey(t *testing.T) {
 	assert.Nil(t, cmd, "Copy command is a stub and should return nil")
 }
 
+func TestView_Update_KeyMsg_OpenKey(t *testing.T) {
+	var opened string
+	mock := &MockDocumentService{
+		OpenFunc: func(ctx context.Context, documentID string) error {
+			opened = documentID
+			return nil
+		},
+	}
+	view := NewView(nil, mock)
+	view.document = &domain.Document{ID: "doc-1"}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	require.NotNil(t, cmd)
+	assert.Nil(t, cmd())
+	assert.Equal(t, "doc-1", opened)
+}
+
+func TestView_Update_KeyMsg_OpenKey_Error(t *testing.T) {
+	mock := &MockDocumentService{
+		OpenFunc: func(ctx context.Context, documentID string) error {
+			return errors.New("no handler")
+		},
+	}
+	view := NewView(nil, mock)
+	view.document = &domain.Document{ID: "doc-1"}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	require.NotNil(t, cmd)
+	errMsg, ok := cmd().(messages.ErrorOccurred)
+	require.True(t, ok)
+	assert.EqualError(t, errMsg.Err, "no handler")
+}
+
+func TestView_Update_KeyMsg_EditKey_FallsBackToOpen(t *testing.T) {
+	var opened string
+	mock := &MockDocumentService{
+		OpenFunc: func(ctx context.Context, documentID string) error {
+			opened = documentID
+			return nil
+		},
+	}
+	view := NewView(nil, mock)
+	view.document = &domain.Document{ID: "doc-1", URI: "github://octocat/hello/issues/1"}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+
+	require.NotNil(t, cmd)
+	assert.Nil(t, cmd())
+	assert.Equal(t, "doc-1", opened)
+}
+
 func TestView_Update_KeyMsg_UnknownKey(t *testing.T) {
 	view := NewView(nil, nil)
 	view.width = 80
@@ -6,8 +6,10 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
@@ -16,6 +18,7 @@ import (
 // View is the document details view.
 type View struct {
 	styles *styles.Styles
+	keys   *keymap.KeyMap
 
 	details      *driving.DocumentDetails
 	scrollOffset int
@@ -29,6 +32,7 @@ type View struct {
 func NewView(s *styles.Styles) *View {
 	return &View{
 		styles: s,
+		keys:   keymap.DefaultKeyMap(),
 	}
 }
 
@@ -44,6 +48,13 @@ func (v *View) SetError(err error) {
 	v.err = err
 }
 
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
+	}
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -71,25 +82,30 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.scrollOffset > 0 {
 			v.scrollOffset--
 		}
-	case "down", "j":
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
 		maxOffset := v.maxScrollOffset()
 		if v.scrollOffset < maxOffset {
 			v.scrollOffset++
 		}
-	case "c":
-		// Copy path - stub for now
 		return v, nil
-	case "esc":
+	case key.Matches(msg, v.keys.Back):
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewDocuments}
 		}
 	}
 
+	switch msg.String() {
+	case "c":
+		// Copy path - stub for now
+		return v, nil
+	}
+
 	return v, nil
 }
 
@@ -127,8 +143,11 @@ func (v *View) buildContent() []string {
 		v.formatField("ID", v.details.ID),
 		v.formatField("Title", v.details.Title),
 		v.formatField("Source", fmt.Sprintf("%s (%s)", v.details.SourceName, v.details.SourceType)),
-		v.formatField("URI", v.details.URI),
-		v.formatField("Chunks", fmt.Sprintf("%d", v.details.ChunkCount)))
+		v.formatField("URI", v.details.URI))
+	if len(v.details.Ancestors) > 0 {
+		lines = append(lines, v.formatField("Path", v.details.Ancestors.String()))
+	}
+	lines = append(lines, v.formatField("Chunks", fmt.Sprintf("%d", v.details.ChunkCount)))
 
 	// Timestamps
 	if !v.details.CreatedAt.IsZero() {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
@@ -174,6 +175,29 @@ func TestView_View_WithDetails(t *testing.T) {
 	assert.Contains(t, output, "Test Source")
 }
 
+func TestView_View_WithAncestors(t *testing.T) {
+	view := NewView(styles.DefaultStyles())
+	view.SetDimensions(80, 24)
+	view.details = &driving.DocumentDetails{
+		ID:        "doc-1",
+		Title:     "setup.md",
+		Ancestors: domain.BreadcrumbTrail{{Title: "docs"}, {Title: "guides"}},
+	}
+
+	output := view.View()
+
+	assert.Contains(t, output, "Path")
+	assert.Contains(t, output, "docs › guides")
+}
+
+func TestView_View_WithoutAncestors(t *testing.T) {
+	view := NewView(styles.DefaultStyles())
+	view.SetDimensions(80, 24)
+	view.details = &driving.DocumentDetails{ID: "doc-1", Title: "setup.md"}
+
+	assert.NotContains(t, view.View(), "Path")
+}
+
 func TestView_View_Error(t *testing.T) {
 	s := styles.DefaultStyles()
 	view := NewView(s)
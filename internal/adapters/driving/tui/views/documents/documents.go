@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -29,6 +31,7 @@ const (
 // View is the documents list view.
 type View struct {
 	styles          *styles.Styles
+	keys            *keymap.KeyMap
 	documentService driving.DocumentService
 
 	source       *domain.Source
@@ -42,14 +45,27 @@ type View struct {
 	showingMenu  bool
 	menuSelected ActionOption
 	scrollOffset int
+
+	// Quarantine mode lists documents skipped after repeated failures.
+	showingQuarantine  bool
+	quarantined        []domain.Exclusion
+	quarantineSelected int
+	notice             string
+
+	// Marked documents can be excluded together with a shared reason.
+	marked         map[string]bool
+	showingReasons bool
+	reasonSelected int
 }
 
 // NewView creates a new documents view.
 func NewView(s *styles.Styles, documentService driving.DocumentService) *View {
 	return &View{
 		styles:          s,
+		keys:            keymap.DefaultKeyMap(),
 		documentService: documentService,
 		documents:       []domain.Document{},
+		marked:          make(map[string]bool),
 	}
 }
 
@@ -61,9 +77,22 @@ func (v *View) SetSource(source domain.Source) tea.Cmd {
 	v.scrollOffset = 0
 	v.err = nil
 	v.showingMenu = false
+	v.showingQuarantine = false
+	v.quarantined = nil
+	v.quarantineSelected = 0
+	v.notice = ""
+	v.marked = make(map[string]bool)
+	v.showingReasons = false
 	return v.loadDocuments()
 }
 
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
+	}
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -99,6 +128,12 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		if v.showingMenu {
 			return v.handleMenuKeyMsg(msg)
 		}
+		if v.showingReasons {
+			return v.handleReasonKeyMsg(msg)
+		}
+		if v.showingQuarantine {
+			return v.handleQuarantineKeyMsg(msg)
+		}
 		return v.handleKeyMsg(msg)
 
 	case messages.DocumentsLoaded:
@@ -121,12 +156,44 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		}
 		return v, nil
 
+	case messages.DocumentsExcluded:
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		v.marked = make(map[string]bool)
+		v.notice = fmt.Sprintf("%d document(s) excluded.", msg.Count)
+		cmd := v.loadDocuments()
+		return v, cmd
+
 	case messages.DocumentRefreshed:
 		if msg.Err != nil {
 			v.err = msg.Err
 		}
 		return v, nil
 
+	case messages.QuarantineLoaded:
+		v.loading = false
+		if msg.Err != nil {
+			v.err = msg.Err
+		} else {
+			v.quarantined = msg.Exclusions
+			v.err = nil
+			if v.quarantineSelected >= len(v.quarantined) {
+				v.quarantineSelected = max(len(v.quarantined)-1, 0)
+			}
+		}
+		return v, nil
+
+	case messages.QuarantineRetried:
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		v.notice = "Quarantine lifted. The document will be retried on the next sync."
+		cmd := v.loadQuarantine()
+		return v, cmd
+
 	case messages.ErrorOccurred:
 		v.err = msg.Err
 		return v, nil
@@ -137,50 +204,176 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses in list mode.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 			v.adjustScroll()
 		}
-	case "down", "j":
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
 		if v.selected < len(v.documents)-1 {
 			v.selected++
 			v.adjustScroll()
 		}
-	case "enter":
+		return v, nil
+	case key.Matches(msg, v.keys.Select):
 		if len(v.documents) > 0 {
 			v.showingMenu = true
 			v.menuSelected = ActionShowContent
 		}
-	case "esc":
+		return v, nil
+	case key.Matches(msg, v.keys.Back):
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewSourceDetail}
 		}
+	}
+
+	switch msg.String() {
+	case "o":
+		// Open the selected document in its default application
+		if v.selected < len(v.documents) {
+			return v, v.openDocument(v.documents[v.selected].ID)
+		}
+	case " ":
+		// Toggle mark on the selected document
+		if v.selected < len(v.documents) {
+			id := v.documents[v.selected].ID
+			if v.marked[id] {
+				delete(v.marked, id)
+			} else {
+				v.marked[id] = true
+			}
+		}
+	case "a":
+		// Mark all documents, or clear marks if all are marked
+		if len(v.marked) == len(v.documents) {
+			v.marked = make(map[string]bool)
+		} else {
+			for i := range v.documents {
+				v.marked[v.documents[i].ID] = true
+			}
+		}
+	case "x":
+		// Exclude marked documents (or the selected one) with a reason
+		if len(v.documents) > 0 {
+			v.showingReasons = true
+			v.reasonSelected = 0
+		}
 	case "r":
 		// Reload documents
 		v.loading = true
 		cmd := v.loadDocuments()
 		return v, cmd
+	case "q":
+		// Show quarantined documents
+		v.showingQuarantine = true
+		v.quarantineSelected = 0
+		v.notice = ""
+		v.err = nil
+		v.loading = true
+		cmd := v.loadQuarantine()
+		return v, cmd
 	}
 
 	return v, nil
 }
 
+// handleQuarantineKeyMsg handles key presses in quarantine mode.
+func (v *View) handleQuarantineKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
+	switch {
+	case key.Matches(msg, v.keys.Up):
+		if v.quarantineSelected > 0 {
+			v.quarantineSelected--
+		}
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
+		if v.quarantineSelected < len(v.quarantined)-1 {
+			v.quarantineSelected++
+		}
+		return v, nil
+	case key.Matches(msg, v.keys.Select):
+		if v.quarantineSelected < len(v.quarantined) {
+			cmd := v.retryQuarantined(v.quarantined[v.quarantineSelected].ID)
+			return v, cmd
+		}
+		return v, nil
+	case key.Matches(msg, v.keys.Back):
+		v.closeQuarantine()
+		return v, nil
+	}
+
+	switch msg.String() {
+	case "r":
+		v.loading = true
+		cmd := v.loadQuarantine()
+		return v, cmd
+	case "q":
+		v.closeQuarantine()
+	}
+
+	return v, nil
+}
+
+// closeQuarantine returns from quarantine mode to the document list.
+func (v *View) closeQuarantine() {
+	v.showingQuarantine = false
+	v.notice = ""
+	v.err = nil
+}
+
+// handleReasonKeyMsg handles key presses in the exclusion reason picker.
+func (v *View) handleReasonKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
+	reasons := domain.AllExclusionReasons()
+	switch {
+	case key.Matches(msg, v.keys.Up):
+		if v.reasonSelected > 0 {
+			v.reasonSelected--
+		}
+	case key.Matches(msg, v.keys.Down):
+		if v.reasonSelected < len(reasons)-1 {
+			v.reasonSelected++
+		}
+	case key.Matches(msg, v.keys.Select):
+		v.showingReasons = false
+		cmd := v.excludeDocuments(v.exclusionTargets(), reasons[v.reasonSelected])
+		return v, cmd
+	case key.Matches(msg, v.keys.Back):
+		v.showingReasons = false
+	}
+
+	return v, nil
+}
+
+// exclusionTargets returns the IDs of the marked documents in list order,
+// or the selected document if none are marked.
+func (v *View) exclusionTargets() []string {
+	var ids []string
+	for i := range v.documents {
+		if v.marked[v.documents[i].ID] {
+			ids = append(ids, v.documents[i].ID)
+		}
+	}
+	if len(ids) == 0 && v.selected < len(v.documents) {
+		ids = append(ids, v.documents[v.selected].ID)
+	}
+	return ids
+}
+
 // handleMenuKeyMsg handles key presses in action menu mode.
 func (v *View) handleMenuKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.menuSelected > ActionShowContent {
 			v.menuSelected--
 		}
-	case "down", "j":
+	case key.Matches(msg, v.keys.Down):
 		if v.menuSelected < ActionCancel {
 			v.menuSelected++
 		}
-	case "enter":
+	case key.Matches(msg, v.keys.Select):
 		return v.handleMenuSelect()
-	case "esc":
+	case key.Matches(msg, v.keys.Back):
 		v.showingMenu = false
 	}
 
@@ -280,6 +473,46 @@ func (v *View) excludeDocument(docID string) tea.Cmd {
 	}
 }
 
+// excludeDocuments returns a command that excludes a batch of documents.
+func (v *View) excludeDocuments(docIDs []string, reason domain.ExclusionReason) tea.Cmd {
+	return func() tea.Msg {
+		if v.documentService == nil {
+			return messages.DocumentsExcluded{Err: fmt.Errorf("document service not available")}
+		}
+
+		count, err := v.documentService.ExcludeMany(context.Background(), docIDs, reason, "excluded via TUI")
+		return messages.DocumentsExcluded{Count: count, Err: err}
+	}
+}
+
+// loadQuarantine returns a command that loads quarantined documents for the source.
+func (v *View) loadQuarantine() tea.Cmd {
+	return func() tea.Msg {
+		if v.source == nil || v.documentService == nil {
+			return messages.QuarantineLoaded{Err: fmt.Errorf("document service not available")}
+		}
+
+		exclusions, err := v.documentService.ListQuarantined(context.Background(), v.source.ID)
+		return messages.QuarantineLoaded{
+			SourceID:   v.source.ID,
+			Exclusions: exclusions,
+			Err:        err,
+		}
+	}
+}
+
+// retryQuarantined returns a command that lifts a document's quarantine.
+func (v *View) retryQuarantined(exclusionID string) tea.Cmd {
+	return func() tea.Msg {
+		if v.documentService == nil {
+			return messages.QuarantineRetried{ExclusionID: exclusionID, Err: fmt.Errorf("document service not available")}
+		}
+
+		err := v.documentService.RetryQuarantined(context.Background(), exclusionID)
+		return messages.QuarantineRetried{ExclusionID: exclusionID, Err: err}
+	}
+}
+
 // adjustScroll adjusts the scroll offset to keep the selected item visible.
 func (v *View) adjustScroll() {
 	visibleItems := v.visibleItemCount()
@@ -303,6 +536,10 @@ func (v *View) visibleItemCount() int {
 
 // View renders the documents view.
 func (v *View) View() string {
+	if v.showingQuarantine {
+		return v.renderQuarantine()
+	}
+
 	var b strings.Builder
 
 	// Title
@@ -344,6 +581,17 @@ func (v *View) View() string {
 		return b.String()
 	}
 
+	// Exclusion reason picker overlay
+	if v.showingReasons {
+		b.WriteString(v.renderReasonPicker())
+		return b.String()
+	}
+
+	if v.notice != "" {
+		b.WriteString(v.styles.Success.Render(v.notice))
+		b.WriteString("\n\n")
+	}
+
 	// Documents list
 	visibleItems := v.visibleItemCount()
 	for i := v.scrollOffset; i < len(v.documents) && i < v.scrollOffset+visibleItems; i++ {
@@ -373,6 +621,9 @@ func (v *View) renderDocument(index int, doc *domain.Document) string {
 	if index == v.selected {
 		indicator = "> "
 	}
+	if v.marked[doc.ID] {
+		indicator = indicator[:1] + "*"
+	}
 
 	title := doc.Title
 	if title == "" {
@@ -452,9 +703,92 @@ func (v *View) renderActionMenu() string {
 	return b.String()
 }
 
+// renderReasonPicker renders the exclusion reason picker overlay.
+func (v *View) renderReasonPicker() string {
+	var b strings.Builder
+
+	b.WriteString(v.styles.Subtitle.Render(fmt.Sprintf("Exclude %d document(s) as:", len(v.exclusionTargets()))))
+	b.WriteString("\n\n")
+
+	for i, reason := range domain.AllExclusionReasons() {
+		line := fmt.Sprintf("%-16s %s", reason, reason.Description())
+		if i == v.reasonSelected {
+			b.WriteString(v.styles.Selected.Render("> " + line))
+		} else {
+			b.WriteString(v.styles.Normal.Render("  " + line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(v.styles.Help.Render("[↑/↓] navigate  [enter] exclude  [esc] cancel"))
+
+	return b.String()
+}
+
+// renderQuarantine renders the list of quarantined documents.
+func (v *View) renderQuarantine() string {
+	var b strings.Builder
+
+	sourceName := "Unknown"
+	if v.source != nil {
+		sourceName = v.source.Name
+	}
+	title := fmt.Sprintf("Quarantined - %s (%d)", sourceName, len(v.quarantined))
+	b.WriteString(v.styles.Title.Render(title))
+	b.WriteString("\n\n")
+
+	switch {
+	case v.loading:
+		b.WriteString(v.styles.Muted.Render("Loading quarantined documents..."))
+		b.WriteString("\n\n")
+	case v.err != nil:
+		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Error: %s", v.err.Error())))
+		b.WriteString("\n\n")
+	case len(v.quarantined) == 0:
+		b.WriteString(v.styles.Muted.Render("No quarantined documents for this source."))
+		b.WriteString("\n\n")
+	default:
+		for i := range v.quarantined {
+			b.WriteString(v.renderQuarantined(i, &v.quarantined[i]))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	if v.notice != "" {
+		b.WriteString(v.styles.Success.Render(v.notice))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(v.styles.Help.Render("[↑/↓] navigate  [enter] retry  [r] reload  [esc] back"))
+	return b.String()
+}
+
+// renderQuarantined renders a single quarantined document with its reason.
+func (v *View) renderQuarantined(index int, exclusion *domain.Exclusion) string {
+	uri := exclusion.URI
+	maxURILen := v.width - 4
+	if maxURILen < 10 {
+		maxURILen = 10
+	}
+	if len(uri) > maxURILen {
+		uri = "..." + uri[len(uri)-maxURILen+3:]
+	}
+
+	var line string
+	if index == v.quarantineSelected {
+		line = v.styles.Selected.Render("> " + uri)
+	} else {
+		line = v.styles.Normal.Render("  " + uri)
+	}
+	return line + "\n" + v.styles.Muted.Render("    "+exclusion.Reason)
+}
+
 // renderHelp renders the help footer.
 func (v *View) renderHelp() string {
-	return v.styles.Help.Render("[↑/↓] navigate  [enter] actions  [r] reload  [esc] back")
+	return v.styles.Help.Render("[↑/↓] navigate  [enter] actions  [o] open  [space] mark  [a] all  " +
+		"[x] exclude  [r] reload  [q] quarantined  [esc] back")
 }
 
 // SetDimensions sets the view dimensions.
@@ -487,6 +821,26 @@ func (v *View) IsShowingMenu() bool {
 	return v.showingMenu
 }
 
+// IsShowingReasons returns true if the exclusion reason picker is visible.
+func (v *View) IsShowingReasons() bool {
+	return v.showingReasons
+}
+
+// MarkedCount returns the number of documents marked for bulk exclusion.
+func (v *View) MarkedCount() int {
+	return len(v.marked)
+}
+
+// IsShowingQuarantine returns true if the quarantine list is visible.
+func (v *View) IsShowingQuarantine() bool {
+	return v.showingQuarantine
+}
+
+// Quarantined returns the current list of quarantined documents.
+func (v *View) Quarantined() []domain.Exclusion {
+	return v.quarantined
+}
+
 // Err returns the last error.
 func (v *View) Err() error {
 	return v.err
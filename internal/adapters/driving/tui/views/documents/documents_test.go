@@ -18,13 +18,16 @@ import (
 
 // MockDocumentService implements driving.DocumentService for testing.
 type MockDocumentService struct {
-	ListBySourceFunc func(ctx context.Context, sourceID string) ([]domain.Document, error)
-	GetFunc          func(ctx context.Context, documentID string) (*domain.Document, error)
-	GetContentFunc   func(ctx context.Context, documentID string) (string, error)
-	GetDetailsFunc   func(ctx context.Context, documentID string) (*driving.DocumentDetails, error)
-	ExcludeFunc      func(ctx context.Context, documentID string, reason string) error
-	RefreshFunc      func(ctx context.Context, documentID string) error
-	OpenFunc         func(ctx context.Context, documentID string) error
+	ListBySourceFunc     func(ctx context.Context, sourceID string) ([]domain.Document, error)
+	GetFunc              func(ctx context.Context, documentID string) (*domain.Document, error)
+	GetContentFunc       func(ctx context.Context, documentID string) (string, error)
+	GetDetailsFunc       func(ctx context.Context, documentID string) (*driving.DocumentDetails, error)
+	ExcludeFunc          func(ctx context.Context, documentID string, reason string) error
+	ExcludeManyFunc      func(ctx context.Context, documentIDs []string, category domain.ExclusionReason, reason string) (int, error)
+	ListQuarantinedFunc  func(ctx context.Context, sourceID string) ([]domain.Exclusion, error)
+	RetryQuarantinedFunc func(ctx context.Context, exclusionID string) error
+	RefreshFunc          func(ctx context.Context, documentID string) error
+	OpenFunc             func(ctx context.Context, documentID string) error
 }
 
 func (m *MockDocumentService) ListBySource(ctx context.Context, sourceID string) ([]domain.Document, error) {
@@ -48,6 +51,14 @@ func (m *MockDocumentService) GetContent(ctx context.Context, documentID string)
 	return "", nil
 }
 
+func (m *MockDocumentService) GetOriginal(ctx context.Context, documentID string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *MockDocumentService) GetChunks(ctx context.Context, documentID string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
 func (m *MockDocumentService) GetDetails(ctx context.Context, documentID string) (*driving.DocumentDetails, error) {
 	if m.GetDetailsFunc != nil {
 		return m.GetDetailsFunc(ctx, documentID)
@@ -55,6 +66,10 @@ func (m *MockDocumentService) GetDetails(ctx context.Context, documentID string)
 	return nil, nil
 }
 
+func (m *MockDocumentService) GetAncestors(ctx context.Context, documentID string) (domain.BreadcrumbTrail, error) {
+	return nil, nil
+}
+
 func (m *MockDocumentService) Exclude(ctx context.Context, documentID, reason string) error {
 	if m.ExcludeFunc != nil {
 		return m.ExcludeFunc(ctx, documentID, reason)
@@ -62,6 +77,35 @@ func (m *MockDocumentService) Exclude(ctx context.Context, documentID, reason st
 	return nil
 }
 
+func (m *MockDocumentService) ListQuarantined(ctx context.Context, sourceID string) ([]domain.Exclusion, error) {
+	if m.ListQuarantinedFunc != nil {
+		return m.ListQuarantinedFunc(ctx, sourceID)
+	}
+	return []domain.Exclusion{}, nil
+}
+
+func (m *MockDocumentService) RetryQuarantined(ctx context.Context, exclusionID string) error {
+	if m.RetryQuarantinedFunc != nil {
+		return m.RetryQuarantinedFunc(ctx, exclusionID)
+	}
+	return nil
+}
+
+func (m *MockDocumentService) ExcludeMany(
+	ctx context.Context, documentIDs []string, category domain.ExclusionReason, reason string,
+) (int, error) {
+	if m.ExcludeManyFunc != nil {
+		return m.ExcludeManyFunc(ctx, documentIDs, category, reason)
+	}
+	return len(documentIDs), nil
+}
+
+func (m *MockDocumentService) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, nil
+}
+
 func (m *MockDocumentService) Refresh(ctx context.Context, documentID string) error {
 	if m.RefreshFunc != nil {
 		return m.RefreshFunc(ctx, documentID)
@@ -350,6 +394,34 @@ func TestView_HandleMenuSelect_OpenDocument(t *testing.T) {
 	assert.True(t, openCalled)
 }
 
+func TestView_OpenKey(t *testing.T) {
+	var opened string
+	mock := &MockDocumentService{
+		OpenFunc: func(ctx context.Context, documentID string) error {
+			opened = documentID
+			return nil
+		},
+	}
+	view := NewView(nil, mock)
+	view.documents = []domain.Document{{ID: "doc-1"}, {ID: "doc-2"}}
+	view.selected = 1
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	require.NotNil(t, cmd)
+	cmd()
+	assert.Equal(t, "doc-2", opened)
+	assert.False(t, view.showingMenu)
+}
+
+func TestView_OpenKey_NoDocuments(t *testing.T) {
+	view := NewView(nil, &MockDocumentService{})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	assert.Nil(t, cmd)
+}
+
 func TestView_HandleMenuSelect_Refresh(t *testing.T) {
 	refreshCalled := false
 	mock := &MockDocumentService{
@@ -393,6 +465,103 @@ func TestView_HandleMenuSelect_Exclude(t *testing.T) {
 	assert.True(t, excludeCalled)
 }
 
+func TestView_MarkDocuments(t *testing.T) {
+	view := NewView(nil, nil)
+	view.documents = []domain.Document{{ID: "doc-1"}, {ID: "doc-2"}, {ID: "doc-3"}}
+
+	view.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	assert.Equal(t, 1, view.MarkedCount())
+
+	// Toggling again clears the mark
+	view.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	assert.Equal(t, 0, view.MarkedCount())
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	assert.Equal(t, 3, view.MarkedCount())
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	assert.Equal(t, 0, view.MarkedCount())
+}
+
+func TestView_ExcludeMarked(t *testing.T) {
+	var gotIDs []string
+	var gotCategory domain.ExclusionReason
+	mock := &MockDocumentService{
+		ExcludeManyFunc: func(_ context.Context, ids []string, category domain.ExclusionReason, _ string) (int, error) {
+			gotIDs = ids
+			gotCategory = category
+			return len(ids), nil
+		},
+	}
+	view := NewView(nil, mock)
+	view.documents = []domain.Document{{ID: "doc-1"}, {ID: "doc-2"}, {ID: "doc-3"}}
+	view.marked["doc-3"] = true
+	view.marked["doc-1"] = true
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	require.True(t, view.IsShowingReasons())
+
+	// Select the second reason (duplicate)
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.False(t, view.IsShowingReasons())
+
+	msg, ok := cmd().(messages.DocumentsExcluded)
+	require.True(t, ok)
+	assert.Equal(t, 2, msg.Count)
+	assert.Equal(t, []string{"doc-1", "doc-3"}, gotIDs)
+	assert.Equal(t, domain.AllExclusionReasons()[1], gotCategory)
+}
+
+func TestView_ExcludeWithoutMarks_UsesSelected(t *testing.T) {
+	var gotIDs []string
+	mock := &MockDocumentService{
+		ExcludeManyFunc: func(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+			gotIDs = ids
+			return len(ids), nil
+		},
+	}
+	view := NewView(nil, mock)
+	view.documents = []domain.Document{{ID: "doc-1"}, {ID: "doc-2"}}
+	view.selected = 1
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	cmd()
+
+	assert.Equal(t, []string{"doc-2"}, gotIDs)
+}
+
+func TestView_ReasonPicker_Esc(t *testing.T) {
+	view := NewView(nil, nil)
+	view.documents = []domain.Document{{ID: "doc-1"}}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	require.True(t, view.IsShowingReasons())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, cmd)
+	assert.False(t, view.IsShowingReasons())
+}
+
+func TestView_Update_DocumentsExcluded(t *testing.T) {
+	mock := &MockDocumentService{}
+	view := NewView(nil, mock)
+	view.source = &domain.Source{ID: "src-1"}
+	view.marked["doc-1"] = true
+
+	_, cmd := view.Update(messages.DocumentsExcluded{Count: 1})
+	require.NotNil(t, cmd)
+	assert.Equal(t, 0, view.MarkedCount())
+	assert.Contains(t, view.notice, "1 document(s) excluded")
+
+	_, cmd = view.Update(messages.DocumentsExcluded{Err: errors.New("bulk failed")})
+	assert.Nil(t, cmd)
+	assert.Error(t, view.Err())
+}
+
 func TestView_HandleMenuSelect_Cancel(t *testing.T) {
 	view := NewView(nil, nil)
 	view.documents = []domain.Document{{ID: "doc-1"}}
@@ -596,3 +765,94 @@ func TestView_SelectedDocument_Empty(t *testing.T) {
 	doc := view.SelectedDocument()
 	assert.Nil(t, doc)
 }
+
+func TestView_Quarantine_Toggle(t *testing.T) {
+	mock := &MockDocumentService{
+		ListQuarantinedFunc: func(_ context.Context, sourceID string) ([]domain.Exclusion, error) {
+			assert.Equal(t, "src-1", sourceID)
+			return []domain.Exclusion{
+				{ID: "quar-1", SourceID: "src-1", URI: "/corrupt.pdf", Reason: "normalisation failed 3 times", Quarantined: true},
+			}, nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock)
+	view.SetDimensions(80, 24)
+	view.source = &domain.Source{ID: "src-1", Name: "Test Source"}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+
+	require.NotNil(t, cmd)
+	assert.True(t, view.IsShowingQuarantine())
+
+	loaded, ok := cmd().(messages.QuarantineLoaded)
+	require.True(t, ok)
+	view.Update(loaded)
+
+	require.Len(t, view.Quarantined(), 1)
+	output := view.View()
+	assert.Contains(t, output, "Quarantined - Test Source (1)")
+	assert.Contains(t, output, "/corrupt.pdf")
+	assert.Contains(t, output, "normalisation failed 3 times")
+	assert.Contains(t, output, "[enter] retry")
+
+	// Escape returns to the documents list
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, view.IsShowingQuarantine())
+}
+
+func TestView_Quarantine_Retry(t *testing.T) {
+	var retried string
+	mock := &MockDocumentService{
+		RetryQuarantinedFunc: func(_ context.Context, exclusionID string) error {
+			retried = exclusionID
+			return nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock)
+	view.source = &domain.Source{ID: "src-1"}
+	view.showingQuarantine = true
+	view.Update(messages.QuarantineLoaded{SourceID: "src-1", Exclusions: []domain.Exclusion{
+		{ID: "quar-1", URI: "/a.pdf"},
+		{ID: "quar-2", URI: "/b.pdf"},
+	}})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(messages.QuarantineRetried)
+	require.True(t, ok)
+	assert.Equal(t, "quar-2", retried)
+	assert.NoError(t, msg.Err)
+
+	// A successful retry reloads the quarantine list
+	_, cmd = view.Update(msg)
+	require.NotNil(t, cmd)
+	_, ok = cmd().(messages.QuarantineLoaded)
+	assert.True(t, ok)
+	assert.Contains(t, view.View(), "Quarantine lifted")
+}
+
+func TestView_Quarantine_RetryError(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), &MockDocumentService{})
+	view.showingQuarantine = true
+
+	_, cmd := view.Update(messages.QuarantineRetried{ExclusionID: "quar-1", Err: errors.New("retry failed")})
+
+	assert.Nil(t, cmd)
+	assert.Contains(t, view.View(), "retry failed")
+}
+
+func TestView_Quarantine_Empty(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), &MockDocumentService{})
+	view.source = &domain.Source{ID: "src-1", Name: "Test Source"}
+	view.showingQuarantine = true
+
+	view.Update(messages.QuarantineLoaded{SourceID: "src-1"})
+
+	assert.Contains(t, view.View(), "No quarantined documents for this source.")
+
+	// Enter does nothing without a selection
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+}
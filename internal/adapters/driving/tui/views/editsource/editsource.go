@@ -0,0 +1,286 @@
+// Package editsource provides the edit source configuration view for the TUI.
+package editsource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// View edits the configuration of an existing source in place.
+// The source keeps its ID, so its sync state and indexed documents are preserved.
+type View struct {
+	styles            *styles.Styles
+	sourceService     driving.SourceService
+	connectorRegistry driving.ConnectorRegistry
+
+	source *domain.Source
+
+	// Config inputs, one per connector config key
+	configKeys   []domain.ConfigKey
+	configInputs []textinput.Model
+	focusIndex   int
+
+	saving bool
+	err    error
+
+	width  int
+	height int
+	ready  bool
+}
+
+// NewView creates a new edit source view.
+func NewView(
+	s *styles.Styles,
+	sourceService driving.SourceService,
+	connectorRegistry driving.ConnectorRegistry,
+) *View {
+	return &View{
+		styles:            s,
+		sourceService:     sourceService,
+		connectorRegistry: connectorRegistry,
+	}
+}
+
+// SetSource sets the source to edit and pre-populates the inputs with its config.
+// Keys the connector declares are listed first, followed by any other keys
+// already present in the source config.
+func (v *View) SetSource(source domain.Source) {
+	v.source = &source
+	v.err = nil
+	v.saving = false
+	v.focusIndex = 0
+
+	var keys []domain.ConfigKey
+	if v.connectorRegistry != nil {
+		if connector, err := v.connectorRegistry.Get(source.Type); err == nil {
+			keys = append(keys, connector.ConfigKeys...)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(source.Config)) {
+		if !slices.ContainsFunc(keys, func(k domain.ConfigKey) bool { return k.Key == key }) {
+			keys = append(keys, domain.ConfigKey{Key: key, Label: key})
+		}
+	}
+
+	v.configKeys = keys
+	v.configInputs = make([]textinput.Model, len(keys))
+	for i, key := range keys {
+		ti := textinput.New()
+		placeholder := key.Description
+		if key.Default != "" {
+			if placeholder != "" {
+				placeholder = fmt.Sprintf("%s (default: %s)", placeholder, key.Default)
+			} else {
+				placeholder = fmt.Sprintf("default: %s", key.Default)
+			}
+		}
+		ti.Placeholder = placeholder
+		if key.Secret {
+			ti.EchoMode = textinput.EchoPassword
+		}
+		ti.SetValue(source.Config[key.Key])
+		v.configInputs[i] = ti
+	}
+}
+
+// Init initialises the view by focusing the first input.
+func (v *View) Init() tea.Cmd {
+	return v.updateFocus()
+}
+
+// Update handles messages for the edit source view.
+func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		v.width = msg.Width
+		v.height = msg.Height
+		v.ready = true
+		return v, nil
+
+	case tea.KeyMsg:
+		return v.handleKeyMsg(msg)
+
+	case messages.SourceUpdated:
+		v.saving = false
+		v.err = msg.Err
+		return v, nil
+
+	case messages.ErrorOccurred:
+		v.saving = false
+		v.err = msg.Err
+		return v, nil
+	}
+
+	return v, nil
+}
+
+// handleKeyMsg handles key presses.
+//
+//nolint:gocritic // evalOrder: bubbletea pattern returns cmd from method call
+func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		return v, func() tea.Msg {
+			return messages.ViewChanged{View: messages.ViewSourceDetail}
+		}
+	case "tab", "down":
+		if len(v.configInputs) > 0 {
+			v.focusIndex = (v.focusIndex + 1) % len(v.configInputs)
+		}
+		return v, v.updateFocus()
+	case "shift+tab", "up":
+		if len(v.configInputs) > 0 {
+			v.focusIndex = (v.focusIndex - 1 + len(v.configInputs)) % len(v.configInputs)
+		}
+		return v, v.updateFocus()
+	case "enter":
+		if v.saving || !v.validateConfig() {
+			return v, nil
+		}
+		v.saving = true
+		return v, v.saveSource()
+	default:
+		if v.focusIndex < len(v.configInputs) {
+			var cmd tea.Cmd
+			v.configInputs[v.focusIndex], cmd = v.configInputs[v.focusIndex].Update(msg)
+			return v, cmd
+		}
+	}
+	return v, nil
+}
+
+// updateFocus focuses the current input and blurs the others.
+func (v *View) updateFocus() tea.Cmd {
+	cmds := make([]tea.Cmd, len(v.configInputs))
+	for i := range v.configInputs {
+		if i == v.focusIndex {
+			cmds[i] = v.configInputs[i].Focus()
+		} else {
+			v.configInputs[i].Blur()
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// validateConfig checks that required fields are filled in.
+func (v *View) validateConfig() bool {
+	if v.source == nil {
+		return false
+	}
+
+	for i, key := range v.configKeys {
+		if key.Required && strings.TrimSpace(v.configInputs[i].Value()) == "" {
+			v.err = fmt.Errorf("required field %s is empty", key.Label)
+			return false
+		}
+	}
+	v.err = nil
+	return true
+}
+
+// Config returns the configuration currently entered in the inputs.
+// Empty values are omitted so connector defaults apply.
+func (v *View) Config() map[string]string {
+	config := make(map[string]string, len(v.configKeys))
+	for i, key := range v.configKeys {
+		if value := strings.TrimSpace(v.configInputs[i].Value()); value != "" {
+			config[key.Key] = value
+		}
+	}
+	return config
+}
+
+// saveSource returns a command that validates and saves the updated source.
+func (v *View) saveSource() tea.Cmd {
+	updated := *v.source
+	updated.Config = v.Config()
+
+	return func() tea.Msg {
+		if v.sourceService == nil {
+			return messages.SourceUpdated{Source: updated, Err: fmt.Errorf("source service not available")}
+		}
+
+		ctx := context.Background()
+		if err := v.sourceService.ValidateConfig(ctx, updated.Type, updated.Config); err != nil {
+			return messages.SourceUpdated{Source: updated, Err: err}
+		}
+
+		err := v.sourceService.Update(ctx, updated)
+		return messages.SourceUpdated{Source: updated, Err: err}
+	}
+}
+
+// View renders the edit source view.
+func (v *View) View() string {
+	if v.source == nil {
+		return v.styles.Muted.Render("No source selected")
+	}
+
+	var b strings.Builder
+
+	b.WriteString(v.styles.Title.Render(fmt.Sprintf("Edit Source: %s", v.source.Name)))
+	b.WriteString("\n\n")
+
+	if v.err != nil {
+		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Error: %s", v.err.Error())))
+		b.WriteString("\n\n")
+	}
+
+	if len(v.configKeys) == 0 {
+		b.WriteString(v.styles.Muted.Render("No configuration to edit."))
+		b.WriteString("\n\n")
+	}
+
+	for i, key := range v.configKeys {
+		label := key.Label
+		if key.Required {
+			label += " *"
+		}
+		b.WriteString(v.styles.Normal.Render(label + ":"))
+		b.WriteString("\n")
+		b.WriteString(v.configInputs[i].View())
+		b.WriteString("\n\n")
+	}
+
+	if v.saving {
+		b.WriteString(v.styles.Muted.Render("Saving..."))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(v.renderHelp())
+
+	return b.String()
+}
+
+// renderHelp renders the help footer.
+func (v *View) renderHelp() string {
+	return v.styles.Help.Render("[tab/↑/↓] navigate  [enter] save  [esc] cancel")
+}
+
+// SetDimensions sets the view dimensions.
+func (v *View) SetDimensions(width, height int) {
+	v.width = width
+	v.height = height
+	v.ready = true
+}
+
+// Source returns the source being edited.
+func (v *View) Source() *domain.Source {
+	return v.source
+}
+
+// Err returns the last error.
+func (v *View) Err() error {
+	return v.err
+}
@@ -0,0 +1,240 @@
+package editsource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// MockSourceService implements driving.SourceService for testing.
+type MockSourceService struct {
+	UpdateFunc         func(ctx context.Context, source domain.Source) error
+	ValidateConfigFunc func(ctx context.Context, connectorType string, config map[string]string) error
+	updated            []domain.Source
+}
+
+func (m *MockSourceService) Add(_ context.Context, _ domain.Source) error {
+	return nil
+}
+
+func (m *MockSourceService) Get(_ context.Context, _ string) (*domain.Source, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) List(_ context.Context) ([]domain.Source, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) Remove(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *MockSourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) Update(ctx context.Context, source domain.Source) error {
+	m.updated = append(m.updated, source)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, source)
+	}
+	return nil
+}
+
+func (m *MockSourceService) ValidateConfig(ctx context.Context, connectorType string, config map[string]string) error {
+	if m.ValidateConfigFunc != nil {
+		return m.ValidateConfigFunc(ctx, connectorType, config)
+	}
+	return nil
+}
+
+func (m *MockSourceService) Statuses(_ context.Context) ([]domain.SourceStatus, error) {
+	return nil, nil
+}
+
+// MockConnectorRegistry implements the driving.ConnectorRegistry lookups used by the view.
+type MockConnectorRegistry struct {
+	driving.ConnectorRegistry
+	connectors map[string]domain.ConnectorType
+}
+
+func (m *MockConnectorRegistry) Get(id string) (*domain.ConnectorType, error) {
+	if c, ok := m.connectors[id]; ok {
+		return &c, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func newTestRegistry() *MockConnectorRegistry {
+	return &MockConnectorRegistry{connectors: map[string]domain.ConnectorType{
+		"filesystem": {
+			ID:   "filesystem",
+			Name: "Local Filesystem",
+			ConfigKeys: []domain.ConfigKey{
+				{Key: "path", Label: "Path", Required: true},
+				{Key: "content_types", Label: "Content Types"},
+				{Key: "token", Label: "Token", Secret: true},
+			},
+		},
+	}}
+}
+
+func testSource() domain.Source {
+	return domain.Source{
+		ID:   "src-1",
+		Type: "filesystem",
+		Name: "/home/user/notes",
+		Config: map[string]string{
+			"path":   "/home/user/notes",
+			"legacy": "yes",
+		},
+	}
+}
+
+func typeText(t *testing.T, view *View, text string) {
+	t.Helper()
+	for _, r := range text {
+		view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestView_SetSource_PrePopulatesConfig(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), &MockSourceService{}, newTestRegistry())
+
+	view.SetSource(testSource())
+
+	require.Len(t, view.configInputs, 4)
+	assert.Equal(t, "path", view.configKeys[0].Key)
+	assert.Equal(t, "/home/user/notes", view.configInputs[0].Value())
+	assert.Equal(t, "content_types", view.configKeys[1].Key)
+	assert.Empty(t, view.configInputs[1].Value())
+	// Keys the connector does not declare are kept
+	assert.Equal(t, "legacy", view.configKeys[3].Key)
+	assert.Equal(t, "yes", view.configInputs[3].Value())
+
+	assert.Equal(t, map[string]string{"path": "/home/user/notes", "legacy": "yes"}, view.Config())
+}
+
+func TestView_SetSource_UnknownConnector(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, newTestRegistry())
+	source := testSource()
+	source.Type = "unknown"
+
+	view.SetSource(source)
+
+	require.Len(t, view.configInputs, 2)
+	assert.Equal(t, "legacy", view.configKeys[0].Key)
+	assert.Equal(t, "path", view.configKeys[1].Key)
+}
+
+func TestView_Save_UpdatesConfig(t *testing.T) {
+	service := &MockSourceService{}
+	view := NewView(styles.DefaultStyles(), service, newTestRegistry())
+	view.SetSource(testSource())
+	view.Init()
+
+	// Move to content types and enter a value
+	view.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText(t, view, "md,txt")
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.True(t, view.saving)
+
+	msg, ok := cmd().(messages.SourceUpdated)
+	require.True(t, ok)
+	require.NoError(t, msg.Err)
+	assert.Equal(t, "src-1", msg.Source.ID)
+	assert.Equal(t, "md,txt", msg.Source.Config["content_types"])
+	assert.Equal(t, "/home/user/notes", msg.Source.Config["path"])
+
+	require.Len(t, service.updated, 1)
+	assert.Equal(t, msg.Source, service.updated[0])
+}
+
+func TestView_Save_RequiredFieldEmpty(t *testing.T) {
+	service := &MockSourceService{}
+	view := NewView(styles.DefaultStyles(), service, newTestRegistry())
+	source := testSource()
+	source.Config = map[string]string{}
+	view.SetSource(source)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Nil(t, cmd)
+	require.Error(t, view.Err())
+	assert.Contains(t, view.Err().Error(), "Path")
+	assert.Empty(t, service.updated)
+}
+
+func TestView_Save_ValidationError(t *testing.T) {
+	service := &MockSourceService{
+		ValidateConfigFunc: func(_ context.Context, _ string, _ map[string]string) error {
+			return errors.New("path does not exist")
+		},
+	}
+	view := NewView(styles.DefaultStyles(), service, newTestRegistry())
+	view.SetSource(testSource())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	msg := cmd()
+
+	view.Update(msg)
+	require.Error(t, view.Err())
+	assert.False(t, view.saving)
+	assert.Empty(t, service.updated)
+}
+
+func TestView_Escape(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, nil)
+	view.SetSource(testSource())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	require.NotNil(t, cmd)
+	changed, ok := cmd().(messages.ViewChanged)
+	require.True(t, ok)
+	assert.Equal(t, messages.ViewSourceDetail, changed.View)
+}
+
+func TestView_View(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, newTestRegistry())
+	assert.Contains(t, view.View(), "No source selected")
+
+	view.SetSource(testSource())
+	output := view.View()
+
+	assert.Contains(t, output, "Edit Source")
+	assert.Contains(t, output, "Path *")
+	assert.Contains(t, output, "/home/user/notes")
+}
@@ -2,13 +2,17 @@
 package menu
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 // Item represents a single menu option.
@@ -21,11 +25,18 @@ type Item struct {
 // View represents the main menu view.
 type View struct {
 	styles   *styles.Styles
+	keys     *keymap.KeyMap
 	items    []Item
 	selected int
 	width    int
 	height   int
 	ready    bool
+
+	// pendingEmbeddings is the number of chunks waiting for an embedding.
+	pendingEmbeddings int
+
+	// disabled lists the subsystems safe mode started without.
+	disabled []domain.DisabledSubsystem
 }
 
 // NewView creates a new menu view.
@@ -36,6 +47,7 @@ func NewView(s *styles.Styles) *View {
 
 	return &View{
 		styles: s,
+		keys:   keymap.DefaultKeyMap(),
 		items: []Item{
 			{Label: "Search", View: messages.ViewSearch},
 			{Label: "Sources", View: messages.ViewSources},
@@ -49,6 +61,13 @@ func NewView(s *styles.Styles) *View {
 	}
 }
 
+// SetKeyMap sets the keybindings the menu responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
+	}
+}
+
 // Init initialises the menu view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -64,20 +83,20 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		return v, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
+		switch {
+		case key.Matches(msg, v.keys.Up):
 			if v.selected > 0 {
 				v.selected--
 			}
 			return v, nil
 
-		case "down", "j":
+		case key.Matches(msg, v.keys.Down):
 			if v.selected < len(v.items)-1 {
 				v.selected++
 			}
 			return v, nil
 
-		case "enter":
+		case key.Matches(msg, v.keys.Select):
 			item := v.items[v.selected]
 			if item.Quit {
 				return v, tea.Quit
@@ -86,7 +105,12 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 				return messages.ViewChanged{View: item.View}
 			}
 
-		case "q":
+		case key.Matches(msg, v.keys.OpenSearch):
+			return v, func() tea.Msg {
+				return messages.ViewChanged{View: messages.ViewSearch}
+			}
+
+		case key.Matches(msg, v.keys.Quit):
 			return v, tea.Quit
 		}
 	}
@@ -114,6 +138,19 @@ func (v *View) View() string {
 	b.WriteString(subtitle)
 	b.WriteString("\n\n")
 
+	if len(v.disabled) > 0 {
+		b.WriteString(v.renderSafeModeBanner())
+		b.WriteString("\n\n")
+	}
+
+	if v.pendingEmbeddings > 0 {
+		pending := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214")).
+			Render(fmt.Sprintf("%d embeddings pending", v.pendingEmbeddings))
+		b.WriteString(pending)
+		b.WriteString("\n\n")
+	}
+
 	// Menu items
 	for i, item := range v.items {
 		cursor := "  "
@@ -135,12 +172,26 @@ func (v *View) View() string {
 	b.WriteString("\n")
 	footer := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("241")).
-		Render("[j/k] Navigate  [Enter] Select  [q] Quit")
+		Render(fmt.Sprintf("[%s %s] Navigate  [%s] Select  [%s] Search  [%s] Quit",
+			v.keys.Up.Help().Key, v.keys.Down.Help().Key, v.keys.Select.Help().Key,
+			v.keys.OpenSearch.Help().Key, v.keys.Quit.Help().Key))
 	b.WriteString(footer)
 
 	return b.String()
 }
 
+// renderSafeModeBanner lists the subsystems that are off and why.
+func (v *View) renderSafeModeBanner() string {
+	warning := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	reason := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	lines := []string{warning.Bold(true).Render("Safe mode: running without some features")}
+	for _, disabled := range v.disabled {
+		lines = append(lines, warning.Render("  "+disabled.Name+": ")+reason.Render(disabled.Reason))
+	}
+	return strings.Join(lines, "\n")
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
@@ -152,3 +203,35 @@ func (v *View) SetDimensions(width, height int) {
 func (v *View) Selected() int {
 	return v.selected
 }
+
+// EnableTimeline adds the timeline to the menu, after the sources. It is
+// only shown when a timeline service is available.
+func (v *View) EnableTimeline() {
+	for _, item := range v.items {
+		if item.View == messages.ViewTimeline {
+			return
+		}
+	}
+	for i, item := range v.items {
+		if item.View == messages.ViewSources {
+			timeline := Item{Label: "Timeline", View: messages.ViewTimeline}
+			v.items = append(v.items[:i+1], append([]Item{timeline}, v.items[i+1:]...)...)
+			return
+		}
+	}
+}
+
+// SetDisabledSubsystems sets the subsystems listed in the safe mode banner.
+func (v *View) SetDisabledSubsystems(disabled []domain.DisabledSubsystem) {
+	v.disabled = disabled
+}
+
+// SetPendingEmbeddings sets the number of chunks waiting for an embedding.
+func (v *View) SetPendingEmbeddings(count int) {
+	v.pendingEmbeddings = count
+}
+
+// PendingEmbeddings returns the number of chunks waiting for an embedding.
+func (v *View) PendingEmbeddings() int {
+	return v.pendingEmbeddings
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 func TestNewView(t *testing.T) {
@@ -163,6 +165,43 @@ func TestView_Update_KeyMsg_Q(t *testing.T) {
 	require.NotNil(t, cmd)
 }
 
+func TestView_Update_KeyMsg_SlashOpensSearch(t *testing.T) {
+	view := NewView(nil)
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+
+	require.NotNil(t, cmd)
+	assert.Equal(t, messages.ViewChanged{View: messages.ViewSearch}, cmd())
+}
+
+func TestView_SetKeyMap(t *testing.T) {
+	km, err := keymap.FromBindings(domain.KeyBindings{
+		Up: []string{"c"}, Down: []string{"t"}, Quit: []string{"x"},
+	})
+	require.NoError(t, err)
+	view := NewView(nil)
+	view.SetKeyMap(km)
+	view.SetDimensions(80, 24)
+
+	// The default keys no longer navigate
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	assert.Equal(t, 0, view.Selected())
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	assert.Equal(t, 1, view.Selected())
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	assert.Equal(t, 0, view.Selected())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	assert.Nil(t, cmd)
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	assert.NotNil(t, cmd)
+
+	assert.Contains(t, view.View(), "[c t] Navigate")
+	assert.Contains(t, view.View(), "[x] Quit")
+}
+
 func TestView_View_NotReady(t *testing.T) {
 	view := NewView(nil)
 	view.ready = false
@@ -244,3 +283,42 @@ func TestMenuItem_Properties(t *testing.T) {
 	assert.Equal(t, "Quit", view.items[4].Label)
 	assert.True(t, view.items[4].Quit)
 }
+
+func TestView_PendingEmbeddings(t *testing.T) {
+	view := NewView(nil)
+	view.SetDimensions(80, 24)
+
+	assert.NotContains(t, view.View(), "embeddings pending")
+
+	view.SetPendingEmbeddings(3)
+
+	assert.Equal(t, 3, view.PendingEmbeddings())
+	assert.Contains(t, view.View(), "3 embeddings pending")
+}
+
+func TestView_EnableTimeline(t *testing.T) {
+	view := NewView(nil)
+	view.EnableTimeline()
+	view.EnableTimeline()
+
+	require.Len(t, view.items, 6)
+	assert.Equal(t, "Timeline", view.items[2].Label)
+	assert.Equal(t, messages.ViewTimeline, view.items[2].View)
+	assert.Equal(t, messages.ViewSettings, view.items[3].View)
+}
+
+func TestView_SafeModeBanner(t *testing.T) {
+	view := NewView(nil)
+	view.SetDimensions(80, 24)
+
+	assert.NotContains(t, view.View(), "Safe mode")
+
+	view.SetDisabledSubsystems([]domain.DisabledSubsystem{
+		{Name: "Vector search", Reason: "disabled by --safe-mode"},
+	})
+
+	output := view.View()
+	assert.Contains(t, output, "Safe mode: running without some features")
+	assert.Contains(t, output, "Vector search")
+	assert.Contains(t, output, "disabled by --safe-mode")
+}
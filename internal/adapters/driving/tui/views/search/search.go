@@ -4,13 +4,16 @@ package search
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/components/input"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/components/list"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/components/status"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/external"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
@@ -18,6 +21,15 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
+// liveSearchDelay is how long typing must pause before a live search runs.
+const liveSearchDelay = 200 * time.Millisecond
+
+// liveSearchTick fires when a live search debounce timer expires.
+// Only the tick matching the latest keystroke's sequence number runs a search.
+type liveSearchTick struct {
+	seq int
+}
+
 // ActionMenu represents a simple action selection overlay.
 type ActionMenu struct {
 	actions  []string
@@ -36,6 +48,7 @@ type View struct {
 
 	searchService driving.SearchService
 	actionService driving.ResultActionService
+	feedback      driving.FeedbackService
 	ctx           context.Context
 
 	width      int
@@ -44,6 +57,14 @@ type View struct {
 	err        error
 	focusInput bool // true = input mode (typing), false = results mode (navigating)
 	actionMenu *ActionMenu
+	sortBy     domain.SortField
+	showChunks bool
+
+	// Live search state: each keystroke bumps debounceSeq and schedules a tick
+	liveSearch    bool
+	pendingSearch bool
+	debounceSeq   int
+	lastSearch    string
 }
 
 // NewView creates a new search view.
@@ -105,6 +126,9 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		v.handleSearchCompleted(msg)
 		return v, nil
 
+	case liveSearchTick:
+		return v, v.handleLiveSearchTick(msg)
+
 	case messages.ErrorOccurred:
 		v.err = msg.Err
 		v.statusbar.SetState(status.StateError)
@@ -136,8 +160,12 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		return v.handleActionMenuKey(msg)
 	}
 
-	// Esc always signals to go back to menu
-	if msg.Type == tea.KeyEsc {
+	// Back to menu; the input only takes Esc, so typed keys reach it
+	back := key.Matches(msg, v.keymap.Back)
+	if v.focusInput {
+		back = msg.Type == tea.KeyEsc
+	}
+	if back {
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewMenu}
 		}
@@ -149,57 +177,50 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		if query == "" {
 			return v, nil
 		}
-		v.statusbar.SetState(status.StateSearching)
-		v.focusInput = false // Move to results mode after search
-		v.input.Blur()
-		cmd := v.performSearch(query)
-		return v, cmd
+		return v, v.Search(query)
 	}
 
 	// Input mode: all keys go to input
 	if v.focusInput {
 		v.input, _ = v.input.Update(msg)
-		return v, nil
+		return v, v.scheduleLiveSearch()
 	}
 
-	// Results mode: handle Enter to open action menu
-	if msg.Type == tea.KeyEnter {
+	// Results mode
+	switch {
+	case key.Matches(msg, v.keymap.Actions):
+		// Open the action menu on the selected result
 		result := v.list.SelectedResult()
 		if result != nil {
 			v.actionMenu = &ActionMenu{
-				actions:  []string{"Copy plain text", "Open Document", "Cancel"},
+				actions:  []string{"Copy plain text", "Open Document", "View content", "Cancel"},
 				selected: 0,
 				visible:  true,
 				result:   result,
 			}
 		}
-		return v, nil
-	}
-
-	// Results mode: handle navigation
-	//nolint:exhaustive // handling only relevant key types
-	switch msg.Type {
-	case tea.KeyUp:
-		v.list.MoveUp()
-		return v, nil
-	case tea.KeyDown:
-		v.list.MoveDown()
-		return v, nil
-	}
-
-	switch msg.String() {
-	case "k":
+	case key.Matches(msg, v.keymap.Up):
 		v.list.MoveUp()
-		return v, nil
-	case "j":
+	case key.Matches(msg, v.keymap.Down):
 		v.list.MoveDown()
-		return v, nil
-	case "n":
+	case key.Matches(msg, v.keymap.NewSearch):
 		// New search: clear input and focus it
 		v.focusInput = true
 		v.input.Focus()
 		v.input.SetValue("")
-		return v, nil
+	case key.Matches(msg, v.keymap.Open):
+		// Open the selected result without going through the action menu
+		return v.executeAction("Open Document", v.list.SelectedResult())
+	case key.Matches(msg, v.keymap.Edit):
+		return v.editResult(v.list.SelectedResult())
+	case key.Matches(msg, v.keymap.Sort):
+		return v, v.cycleSort()
+	case key.Matches(msg, v.keymap.Group):
+		v.SetGroupBySource(!v.list.Grouped())
+	case key.Matches(msg, v.keymap.Relevant):
+		v.rate(domain.FeedbackRelevant)
+	case key.Matches(msg, v.keymap.Irrelevant):
+		v.rate(domain.FeedbackIrrelevant)
 	}
 
 	return v, nil
@@ -207,42 +228,22 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 
 // handleActionMenuKey processes keyboard input when action menu is visible.
 func (v *View) handleActionMenuKey(msg tea.KeyMsg) (*View, tea.Cmd) {
-	//nolint:exhaustive // handling only relevant key types
-	switch msg.Type {
-	case tea.KeyUp:
+	switch {
+	case key.Matches(msg, v.keymap.Up):
 		if v.actionMenu.selected > 0 {
 			v.actionMenu.selected--
 		}
-		return v, nil
-	case tea.KeyDown:
+	case key.Matches(msg, v.keymap.Down):
 		if v.actionMenu.selected < len(v.actionMenu.actions)-1 {
 			v.actionMenu.selected++
 		}
-		return v, nil
-	case tea.KeyEnter:
+	case key.Matches(msg, v.keymap.Select):
 		action := v.actionMenu.actions[v.actionMenu.selected]
 		result := v.actionMenu.result
 		v.actionMenu = nil // Close menu
 		return v.executeAction(action, result)
-	case tea.KeyEsc:
+	case key.Matches(msg, v.keymap.Cancel):
 		v.actionMenu = nil // Close menu
-		return v, nil
-	default:
-		// Handle other keys
-	}
-
-	// Handle vim-style navigation in action menu
-	switch msg.String() {
-	case "k":
-		if v.actionMenu.selected > 0 {
-			v.actionMenu.selected--
-		}
-		return v, nil
-	case "j":
-		if v.actionMenu.selected < len(v.actionMenu.actions)-1 {
-			v.actionMenu.selected++
-		}
-		return v, nil
 	}
 
 	return v, nil
@@ -277,6 +278,12 @@ func (v *View) executeAction(action string, result *domain.SearchResult) (*View,
 		} else {
 			v.statusbar.SetMessage("Open not available")
 		}
+	case "View content":
+		doc := result.Document
+		query := v.input.Value()
+		return v, func() tea.Msg {
+			return messages.DocumentSelected{Document: doc, Query: query}
+		}
 	case "Cancel":
 		// Do nothing, menu is already closed
 	}
@@ -284,6 +291,83 @@ func (v *View) executeAction(action string, result *domain.SearchResult) (*View,
 	return v, nil
 }
 
+// editResult opens a result outside the TUI: local files in the user's
+// editor and web pages in the browser. Other results open as with
+// "Open Document", through their connector's web URL.
+func (v *View) editResult(result *domain.SearchResult) (*View, tea.Cmd) {
+	if result == nil {
+		return v, nil
+	}
+
+	cmd := external.Open(result.Document.URI)
+	if cmd == nil {
+		return v.executeAction("Open Document", result)
+	}
+	v.statusbar.SetMessage("Opening document...")
+	return v, cmd
+}
+
+// Search sets the query and starts a search for it.
+// It returns nil for an empty query.
+func (v *View) Search(query string) tea.Cmd {
+	if query == "" {
+		return nil
+	}
+	v.input.SetValue(query)
+	v.list.SetQuery(query)
+	v.statusbar.SetState(status.StateSearching)
+	v.focusInput = false // Move to results mode after search
+	v.input.Blur()
+	v.pendingSearch = false
+	v.lastSearch = query
+	return v.performSearch(query)
+}
+
+// SetLiveSearch sets whether the view searches as the user types.
+// Searches run once typing pauses; Enter still searches immediately.
+func (v *View) SetLiveSearch(enabled bool) {
+	v.liveSearch = enabled
+	v.pendingSearch = false
+}
+
+// LiveSearch returns whether searches run as the user types.
+func (v *View) LiveSearch() bool {
+	return v.liveSearch
+}
+
+// scheduleLiveSearch restarts the debounce timer after a keystroke.
+// It returns nil when live search is off.
+func (v *View) scheduleLiveSearch() tea.Cmd {
+	if !v.liveSearch {
+		return nil
+	}
+	v.debounceSeq++
+	v.pendingSearch = true
+	seq := v.debounceSeq
+	return tea.Tick(liveSearchDelay, func(time.Time) tea.Msg {
+		return liveSearchTick{seq: seq}
+	})
+}
+
+// handleLiveSearchTick runs the pending live search if no key was pressed
+// since the tick was scheduled and the query changed since the last search.
+// The input keeps focus so the user can carry on typing.
+func (v *View) handleLiveSearchTick(msg liveSearchTick) tea.Cmd {
+	if !v.pendingSearch || msg.seq != v.debounceSeq {
+		return nil
+	}
+	v.pendingSearch = false
+
+	query := v.input.Value()
+	if query == "" || query == v.lastSearch {
+		return nil
+	}
+	v.lastSearch = query
+	v.list.SetQuery(query)
+	v.statusbar.SetState(status.StateSearching)
+	return v.performSearch(query)
+}
+
 // performSearch executes a search and returns results.
 func (v *View) performSearch(query string) tea.Cmd {
 	return func() tea.Msg {
@@ -291,7 +375,12 @@ func (v *View) performSearch(query string) tea.Cmd {
 			return messages.ErrorOccurred{Err: ErrNoSearchService}
 		}
 
-		results, err := v.searchService.Search(v.ctx, query, domain.SearchOptions{})
+		opts := domain.SearchOptions{SortBy: v.sortBy}
+		if v.showChunks {
+			return v.searchChunks(query, opts)
+		}
+
+		results, err := v.searchService.Search(v.ctx, query, opts)
 		if err != nil {
 			return messages.SearchCompleted{Results: nil, Err: err}
 		}
@@ -299,6 +388,113 @@ func (v *View) performSearch(query string) tea.Cmd {
 	}
 }
 
+// searchChunks runs a chunk-level search and wraps each best chunk as a result.
+func (v *View) searchChunks(query string, opts domain.SearchOptions) tea.Msg {
+	chunks, err := v.searchService.SearchByChunk(v.ctx, query, opts)
+	if err != nil {
+		return messages.SearchCompleted{Results: nil, Err: err}
+	}
+
+	results := make([]domain.SearchResult, len(chunks))
+	for i := range chunks {
+		results[i] = domain.SearchResult{
+			Document: chunks[i].Document,
+			Chunk:    chunks[i].Chunk,
+			Score:    chunks[i].Score,
+			Feedback: chunks[i].Feedback,
+		}
+	}
+	return messages.SearchCompleted{Results: results, Err: nil}
+}
+
+// SetShowChunks sets whether searches return the best matching chunk of
+// each document rather than document-level results.
+func (v *View) SetShowChunks(show bool) {
+	v.showChunks = show
+	v.list.SetShowChunks(show)
+}
+
+// ShowChunks returns whether chunk-level results are shown.
+func (v *View) ShowChunks() bool {
+	return v.showChunks
+}
+
+// cycleSort moves to the next result ordering and re-runs the current query.
+func (v *View) cycleSort() tea.Cmd {
+	v.sortBy = v.sortBy.Next()
+	if v.sortBy == domain.SortByScore {
+		v.statusbar.SetSort("")
+	} else {
+		v.statusbar.SetSort(string(v.sortBy))
+	}
+
+	query := v.input.Value()
+	if query == "" {
+		return nil
+	}
+	v.statusbar.SetState(status.StateSearching)
+	return v.performSearch(query)
+}
+
+// SetFeedbackService sets the service that records whether results were useful.
+// Without it, the feedback keys only report that feedback is unavailable.
+func (v *View) SetFeedbackService(feedback driving.FeedbackService) {
+	v.feedback = feedback
+}
+
+// rate records feedback on the selected result for the current query.
+// Rating a result with the signal it already has removes the rating.
+func (v *View) rate(signal domain.FeedbackSignal) {
+	result := v.list.SelectedResult()
+	query := v.input.Value()
+	if result == nil || query == "" {
+		return
+	}
+	if v.feedback == nil {
+		v.statusbar.SetMessage("Feedback not available")
+		return
+	}
+
+	docID := result.Document.ID
+	if result.Feedback == signal {
+		if err := v.feedback.Unrate(v.ctx, query, docID); err != nil {
+			v.statusbar.SetMessage("Feedback: " + err.Error())
+			return
+		}
+		v.list.SetFeedback(docID, "")
+		v.statusbar.SetMessage("Feedback removed")
+		return
+	}
+
+	if err := v.feedback.Rate(v.ctx, query, docID, signal); err != nil {
+		v.statusbar.SetMessage("Feedback: " + err.Error())
+		return
+	}
+	v.list.SetFeedback(docID, signal)
+	if signal == domain.FeedbackRelevant {
+		v.statusbar.SetMessage("Marked as useful")
+	} else {
+		v.statusbar.SetMessage("Marked as not useful")
+	}
+}
+
+// SetGroupBySource sets whether results are grouped under source headers
+// instead of shown as a single ranked list.
+func (v *View) SetGroupBySource(grouped bool) {
+	v.list.SetGrouped(grouped)
+	v.statusbar.SetGrouped(grouped)
+}
+
+// GroupBySource returns whether results are grouped by source.
+func (v *View) GroupBySource() bool {
+	return v.list.Grouped()
+}
+
+// SortBy returns the current result ordering.
+func (v *View) SortBy() domain.SortField {
+	return v.sortBy
+}
+
 // handleSearchCompleted processes search results.
 func (v *View) handleSearchCompleted(msg messages.SearchCompleted) {
 	if msg.Err != nil {
@@ -313,9 +509,12 @@ func (v *View) handleSearchCompleted(msg messages.SearchCompleted) {
 	v.statusbar.SetState(status.StateResults)
 	v.statusbar.SetResultCount(len(msg.Results))
 
-	// Switch to results mode after successful search
-	v.focusInput = false
-	v.input.Blur()
+	// Switch to results mode after successful search, unless the user is
+	// still typing a live search
+	if !v.liveSearch {
+		v.focusInput = false
+		v.input.Blur()
+	}
 }
 
 // View renders the search view.
@@ -460,6 +659,8 @@ func (v *View) Reset() {
 	v.input.Focus()
 	v.input.SetValue("")
 	v.list.SetResults(nil)
+	v.pendingSearch = false
+	v.lastSearch = ""
 	v.err = nil
 	v.statusbar.SetState(status.StateReady)
 	v.statusbar.SetMessage("")
@@ -17,7 +17,10 @@ import (
 
 // MockSearchService implements driving.SearchService for testing.
 type MockSearchService struct {
-	SearchFunc func(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.SearchResult, error)
+	SearchFunc        func(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.SearchResult, error)
+	SearchByChunkFunc func(
+		ctx context.Context, query string, opts domain.SearchOptions,
+	) ([]domain.ChunkSearchResult, error)
 }
 
 func (m *MockSearchService) Search(
@@ -31,6 +34,17 @@ func (m *MockSearchService) Search(
 	return []domain.SearchResult{}, nil
 }
 
+func (m *MockSearchService) SearchByChunk(
+	ctx context.Context,
+	query string,
+	opts domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	if m.SearchByChunkFunc != nil {
+		return m.SearchByChunkFunc(ctx, query, opts)
+	}
+	return []domain.ChunkSearchResult{}, nil
+}
+
 // MockResultActionService implements driving.ResultActionService for testing.
 type MockResultActionService struct {
 	CopyToClipboardFunc func(ctx context.Context, result *domain.SearchResult) error
@@ -51,6 +65,35 @@ func (m *MockResultActionService) OpenDocument(ctx context.Context, result *doma
 	return nil
 }
 
+// MockFeedbackService implements driving.FeedbackService for testing.
+type MockFeedbackService struct {
+	Ratings map[string]domain.FeedbackSignal
+	Err     error
+}
+
+func (m *MockFeedbackService) Rate(
+	_ context.Context, query, documentID string, signal domain.FeedbackSignal,
+) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	if m.Ratings == nil {
+		m.Ratings = make(map[string]domain.FeedbackSignal)
+	}
+	m.Ratings[query+"|"+documentID] = signal
+	return nil
+}
+
+func (m *MockFeedbackService) Unrate(_ context.Context, query, documentID string) error {
+	delete(m.Ratings, query+"|"+documentID)
+	return m.Err
+}
+
+func (m *MockFeedbackService) Clear(_ context.Context) error {
+	m.Ratings = nil
+	return m.Err
+}
+
 // Helper function to create test search results.
 func testSearchResults() []domain.SearchResult {
 	return []domain.SearchResult{
@@ -239,7 +282,7 @@ func TestView_Update_KeyEnter_InResultsMode_OpensActionMenu(t *testing.T) {
 	assert.NotNil(t, view.actionMenu)
 	assert.True(t, view.actionMenu.visible)
 	assert.Equal(t, 0, view.actionMenu.selected)
-	assert.Len(t, view.actionMenu.actions, 3)
+	assert.Len(t, view.actionMenu.actions, 4)
 }
 
 func TestView_Update_KeyEnter_InResultsMode_NoResults(t *testing.T) {
@@ -562,9 +605,12 @@ func TestView_ActionMenu_NavigateDown(t *testing.T) {
 	view.Update(tea.KeyMsg{Type: tea.KeyDown})
 	assert.Equal(t, 2, view.actionMenu.selected)
 
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 3, view.actionMenu.selected)
+
 	// Try to go past last item
 	view.Update(tea.KeyMsg{Type: tea.KeyDown})
-	assert.Equal(t, 2, view.actionMenu.selected)
+	assert.Equal(t, 3, view.actionMenu.selected)
 }
 
 func TestView_ActionMenu_NavigateUp(t *testing.T) {
@@ -729,6 +775,149 @@ func TestView_ActionMenu_OpenDocument_Success(t *testing.T) {
 	assert.True(t, openCalled)
 }
 
+func TestView_OpenKey_OpensSelectedResult(t *testing.T) {
+	var opened string
+	mockAction := &MockResultActionService{
+		OpenDocumentFunc: func(ctx context.Context, result *domain.SearchResult) error {
+			opened = result.Document.Title
+			return nil
+		},
+	}
+
+	view := NewView(nil, nil, nil, mockAction)
+	view.SetDimensions(80, 24)
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+	view.focusInput = false
+	view.list.MoveDown()
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	assert.Nil(t, view.actionMenu)
+	assert.Equal(t, "Test Document 2", opened)
+}
+
+func TestView_EditKey_FallsBackToOpenDocument(t *testing.T) {
+	var opened string
+	mockAction := &MockResultActionService{
+		OpenDocumentFunc: func(ctx context.Context, result *domain.SearchResult) error {
+			opened = result.Document.Title
+			return nil
+		},
+	}
+
+	view := NewView(nil, nil, nil, mockAction)
+	view.SetDimensions(80, 24)
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+	view.focusInput = false
+
+	// Results without a file:// or web URI open through their connector
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+
+	assert.Equal(t, "Test Document 1", opened)
+}
+
+func TestView_SortKey_CyclesAndResearches(t *testing.T) {
+	var gotSort domain.SortField
+	mock := &MockSearchService{
+		SearchFunc: func(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.SearchResult, error) {
+			assert.Equal(t, "test", query)
+			gotSort = opts.SortBy
+			return testSearchResults(), nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetQuery("test")
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+	assert.Equal(t, domain.SortByDate, view.SortBy())
+	assert.Equal(t, domain.SortByDate, gotSort)
+	assert.Equal(t, "date", view.statusbar.Sort())
+
+	// Cycling back to score clears the status bar label
+	for range 3 {
+		view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	}
+	assert.Equal(t, domain.SortByScore, view.SortBy())
+	assert.Empty(t, view.statusbar.Sort())
+}
+
+func TestView_GroupKey_TogglesGrouping(t *testing.T) {
+	view := NewView(nil, nil, nil, nil)
+	view.Update(messages.SearchCompleted{Results: []domain.SearchResult{
+		{Document: domain.Document{ID: "a1"}, SourceName: "Gmail", Score: 0.9},
+		{Document: domain.Document{ID: "b1"}, SourceName: "GitHub", Score: 0.8},
+		{Document: domain.Document{ID: "a2"}, SourceName: "Gmail", Score: 0.7},
+	}})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+
+	assert.True(t, view.GroupBySource())
+	assert.True(t, view.statusbar.Grouped())
+	results := view.Results()
+	assert.Equal(t, "a2", results[1].Document.ID)
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+
+	assert.False(t, view.GroupBySource())
+	assert.Equal(t, "b1", view.Results()[1].Document.ID)
+}
+
+func TestView_ShowChunks_SearchesByChunk(t *testing.T) {
+	mock := &MockSearchService{
+		SearchFunc: func(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.SearchResult, error) {
+			t.Fatal("document search should not run in chunk mode")
+			return nil, nil
+		},
+		SearchByChunkFunc: func(
+			ctx context.Context, query string, opts domain.SearchOptions,
+		) ([]domain.ChunkSearchResult, error) {
+			return []domain.ChunkSearchResult{
+				{
+					Document: domain.Document{ID: "doc-1", Title: "Handbook"},
+					Chunk:    domain.Chunk{ID: "chunk-2", DocumentID: "doc-1", Content: "holiday policy", Position: 1},
+					Score:    0.7,
+				},
+			}, nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetDimensions(80, 24)
+	view.SetShowChunks(true)
+	assert.True(t, view.ShowChunks())
+
+	cmd := view.Search("holiday")
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+
+	results := view.Results()
+	require.Len(t, results, 1)
+	assert.Equal(t, "chunk-2", results[0].Chunk.ID)
+	assert.InDelta(t, 0.7, results[0].Score, 0.001)
+	assert.Contains(t, view.View(), "[chunk 2] holiday policy")
+}
+
+func TestView_ShowChunks_Error(t *testing.T) {
+	mock := &MockSearchService{
+		SearchByChunkFunc: func(
+			ctx context.Context, query string, opts domain.SearchOptions,
+		) ([]domain.ChunkSearchResult, error) {
+			return nil, errors.New("index closed")
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetShowChunks(true)
+
+	msg := view.Search("holiday")()
+
+	completed, ok := msg.(messages.SearchCompleted)
+	require.True(t, ok)
+	assert.Error(t, completed.Err)
+}
+
 func TestView_ActionMenu_OpenDocument_Error(t *testing.T) {
 	expectedErr := errors.New("open failed")
 	mockAction := &MockResultActionService{
@@ -936,10 +1125,25 @@ func TestView_ActionMenu_EnsuresCorrectBehavior(t *testing.T) {
 	assert.True(t, view.actionMenu.visible)
 	assert.NotNil(t, view.actionMenu.result)
 	assert.Equal(t, "Test Document 1", view.actionMenu.result.Document.Title)
-	assert.Len(t, view.actionMenu.actions, 3)
+	assert.Len(t, view.actionMenu.actions, 4)
 	assert.Equal(t, "Copy plain text", view.actionMenu.actions[0])
 	assert.Equal(t, "Open Document", view.actionMenu.actions[1])
-	assert.Equal(t, "Cancel", view.actionMenu.actions[2])
+	assert.Equal(t, "View content", view.actionMenu.actions[2])
+	assert.Equal(t, "Cancel", view.actionMenu.actions[3])
+}
+
+func TestView_ActionMenu_ViewContent(t *testing.T) {
+	view := NewView(nil, nil, nil, nil)
+	view.SetQuery("hybrid search")
+	result := &domain.SearchResult{Document: domain.Document{ID: "doc-1", Title: "Notes"}}
+
+	_, cmd := view.executeAction("View content", result)
+
+	require.NotNil(t, cmd)
+	selected, ok := cmd().(messages.DocumentSelected)
+	require.True(t, ok)
+	assert.Equal(t, "doc-1", selected.Document.ID)
+	assert.Equal(t, "hybrid search", selected.Query)
 }
 
 func TestView_ContextPropagation(t *testing.T) {
@@ -994,3 +1198,146 @@ func TestView_ActionMenu_ContextPropagation(t *testing.T) {
 
 	assert.True(t, copyCalled)
 }
+
+func TestView_FeedbackKeys_RateSelectedResult(t *testing.T) {
+	feedback := &MockFeedbackService{}
+	view := NewView(nil, nil, nil, nil)
+	view.SetFeedbackService(feedback)
+	view.SetQuery("holiday policy")
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'+'}})
+
+	assert.Equal(t, domain.FeedbackRelevant, feedback.Ratings["holiday policy|1"])
+	assert.Equal(t, domain.FeedbackRelevant, view.SelectedResult().Feedback)
+	assert.Equal(t, "Marked as useful", view.statusbar.Message())
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'-'}})
+
+	assert.Equal(t, domain.FeedbackIrrelevant, feedback.Ratings["holiday policy|1"])
+	assert.Equal(t, domain.FeedbackIrrelevant, view.SelectedResult().Feedback)
+
+	// Repeating the same signal removes it
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'-'}})
+
+	assert.Empty(t, feedback.Ratings)
+	assert.Empty(t, view.SelectedResult().Feedback)
+	assert.Equal(t, "Feedback removed", view.statusbar.Message())
+}
+
+func TestView_FeedbackKeys_Errors(t *testing.T) {
+	view := NewView(nil, nil, nil, nil)
+	view.SetQuery("holiday")
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'+'}})
+	assert.Equal(t, "Feedback not available", view.statusbar.Message())
+
+	view.SetFeedbackService(&MockFeedbackService{Err: errors.New("database locked")})
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'+'}})
+	assert.Equal(t, "Feedback: database locked", view.statusbar.Message())
+	assert.Empty(t, view.SelectedResult().Feedback)
+}
+
+func typeRune(view *View, r rune) tea.Cmd {
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	return cmd
+}
+
+func TestView_LiveSearch_Disabled(t *testing.T) {
+	view := NewView(nil, nil, &MockSearchService{}, nil)
+
+	assert.False(t, view.LiveSearch())
+	assert.Nil(t, typeRune(view, 'a'))
+	assert.Equal(t, "a", view.Query())
+}
+
+func TestView_LiveSearch_DebouncesRapidTyping(t *testing.T) {
+	var queries []string
+	mock := &MockSearchService{
+		SearchFunc: func(_ context.Context, query string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			queries = append(queries, query)
+			return testSearchResults(), nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetLiveSearch(true)
+
+	for _, r := range "abc" {
+		require.NotNil(t, typeRune(view, r))
+	}
+	assert.True(t, view.pendingSearch)
+
+	// Ticks from earlier keystrokes are stale and do nothing
+	for seq := 1; seq < view.debounceSeq; seq++ {
+		_, cmd := view.Update(liveSearchTick{seq: seq})
+		assert.Nil(t, cmd)
+	}
+
+	// Only the tick after the last keystroke searches
+	_, cmd := view.Update(liveSearchTick{seq: view.debounceSeq})
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+
+	assert.Equal(t, []string{"abc"}, queries)
+	assert.False(t, view.pendingSearch)
+	assert.Len(t, view.Results(), 2)
+	assert.True(t, view.InputFocused(), "input keeps focus while live searching")
+
+	// The same tick firing again does not search twice
+	_, cmd = view.Update(liveSearchTick{seq: view.debounceSeq})
+	assert.Nil(t, cmd)
+}
+
+func TestView_LiveSearch_SkipsUnchangedAndEmptyQueries(t *testing.T) {
+	calls := 0
+	mock := &MockSearchService{
+		SearchFunc: func(_ context.Context, _ string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			calls++
+			return nil, nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetLiveSearch(true)
+
+	typeRune(view, 'a')
+	_, cmd := view.Update(liveSearchTick{seq: view.debounceSeq})
+	require.NotNil(t, cmd)
+	cmd()
+
+	// Typing and deleting a character leaves the query unchanged
+	typeRune(view, 'b')
+	view.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	_, cmd = view.Update(liveSearchTick{seq: view.debounceSeq})
+	assert.Nil(t, cmd)
+
+	// An empty query never searches
+	view.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	_, cmd = view.Update(liveSearchTick{seq: view.debounceSeq})
+	assert.Nil(t, cmd)
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestView_LiveSearch_EnterCancelsPendingSearch(t *testing.T) {
+	calls := 0
+	mock := &MockSearchService{
+		SearchFunc: func(_ context.Context, _ string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			calls++
+			return nil, nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetLiveSearch(true)
+
+	typeRune(view, 'a')
+	seq := view.debounceSeq
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	cmd()
+	assert.False(t, view.InputFocused())
+
+	_, cmd = view.Update(liveSearchTick{seq: seq})
+	assert.Nil(t, cmd)
+	assert.Equal(t, 1, calls)
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -26,7 +28,6 @@ const (
 
 // Key constants for key handling.
 const (
-	keyDown  = "down"
 	keyEnter = "enter"
 	keyTab   = "tab"
 )
@@ -34,6 +35,7 @@ const (
 // View is the settings configuration view.
 type View struct {
 	styles          *styles.Styles
+	keys            *keymap.KeyMap
 	settingsService driving.SettingsService
 
 	// Current settings
@@ -73,6 +75,7 @@ func NewView(s *styles.Styles, settingsService driving.SettingsService) *View {
 
 	return &View{
 		styles:               s,
+		keys:                 keymap.DefaultKeyMap(),
 		settingsService:      settingsService,
 		section:              SectionOverview,
 		embeddingAPIKeyInput: embeddingAPIKeyInput,
@@ -80,6 +83,13 @@ func NewView(s *styles.Styles, settingsService driving.SettingsService) *View {
 	}
 }
 
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
+	}
+}
+
 // Init initialises the view and loads settings.
 func (v *View) Init() tea.Cmd {
 	return v.loadSettings()
@@ -136,8 +146,12 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 //
 //nolint:exhaustive // explicit default handling for escape provides better UX
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	// Global escape to go back
-	if msg.String() == "esc" {
+	// Go back; API key inputs only take Esc, so typed keys reach them
+	back := key.Matches(msg, v.keys.Back)
+	if v.focusedField != 0 && v.section != SectionOverview {
+		back = msg.Type == tea.KeyEsc
+	}
+	if back {
 		switch v.section {
 		case SectionOverview:
 			return v, func() tea.Msg {
@@ -168,16 +182,16 @@ func (v *View) handleOverviewKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 	// Overview menu: Search Mode, Embedding, LLM
 	maxItems := 3
 
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case key.Matches(msg, v.keys.Down):
 		if v.selected < maxItems-1 {
 			v.selected++
 		}
-	case keyEnter:
+	case key.Matches(msg, v.keys.Select):
 		switch v.selected {
 		case 0:
 			v.section = SectionSearchMode
@@ -196,16 +210,16 @@ func (v *View) handleOverviewKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 func (v *View) handleSearchModeKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 	modes := domain.AllSearchModes()
 
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case key.Matches(msg, v.keys.Down):
 		if v.selected < len(modes)-1 {
 			v.selected++
 		}
-	case keyEnter:
+	case key.Matches(msg, v.keys.Select):
 		if v.selected >= 0 && v.selected < len(modes) {
 			cmd := v.setSearchMode(modes[v.selected])
 			return v, cmd
@@ -239,23 +253,23 @@ func (v *View) handleEmbeddingKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 		return v, nil
 	}
 
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case key.Matches(msg, v.keys.Down):
 		if v.selected < len(providers)-1 {
 			v.selected++
 		}
-	case keyTab:
+	case msg.String() == keyTab:
 		// Tab to API key input if provider requires it
 		if v.selected >= 0 && v.selected < len(providers) && providers[v.selected].RequiresAPIKey() {
 			v.focusedField = 1
 			cmd := v.embeddingAPIKeyInput.Focus()
 			return v, cmd
 		}
-	case keyEnter:
+	case key.Matches(msg, v.keys.Select):
 		if v.selected >= 0 && v.selected < len(providers) {
 			provider := providers[v.selected]
 			if provider.RequiresAPIKey() {
@@ -297,23 +311,23 @@ func (v *View) handleLLMKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 		return v, nil
 	}
 
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case key.Matches(msg, v.keys.Down):
 		if v.selected < len(providers)-1 {
 			v.selected++
 		}
-	case keyTab:
+	case msg.String() == keyTab:
 		// Tab to API key input if provider requires it
 		if v.selected >= 0 && v.selected < len(providers) && providers[v.selected].RequiresAPIKey() {
 			v.focusedField = 1
 			cmd := v.llmAPIKeyInput.Focus()
 			return v, cmd
 		}
-	case keyEnter:
+	case key.Matches(msg, v.keys.Select):
 		if v.selected >= 0 && v.selected < len(providers) {
 			provider := providers[v.selected]
 			if provider.RequiresAPIKey() {
@@ -42,6 +42,11 @@ func (m *MockSettingsService) SetEmbeddingProvider(provider domain.AIProvider, m
 	return args.Error(0)
 }
 
+func (m *MockSettingsService) SetEmbeddingEndpoint(baseURL string, headers []string) error {
+	args := m.Called(baseURL, headers)
+	return args.Error(0)
+}
+
 func (m *MockSettingsService) SetLLMProvider(provider domain.AIProvider, model, apiKey string) error {
 	args := m.Called(provider, model, apiKey)
 	return args.Error(0)
@@ -77,6 +82,11 @@ func (m *MockSettingsService) ValidateLLMConfig() error {
 	return args.Error(0)
 }
 
+func (m *MockSettingsService) ValidateConfig() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 // Helper function to create test settings.
 func testSettings() *domain.AppSettings {
 	return &domain.AppSettings{
@@ -4,10 +4,14 @@ package sourcedetail
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -24,9 +28,13 @@ const (
 	OptionBack
 )
 
+// visibleErrors is the number of recent sync errors shown at once.
+const visibleErrors = 5
+
 // View is the source detail view.
 type View struct {
 	styles           *styles.Styles
+	keys             *keymap.KeyMap
 	sourceService    driving.SourceService
 	syncOrchestrator driving.SyncOrchestrator
 	documentService  driving.DocumentService
@@ -40,6 +48,14 @@ type View struct {
 	err      error
 	syncing  bool
 	deleting bool
+
+	// Progress of the current sync, counted from SyncProgress messages
+	docsIndexed    int
+	chunksEmbedded int
+
+	// Recent sync errors, newest first, and the first one shown
+	recentErrors []domain.SyncErrorLog
+	errorOffset  int
 }
 
 // NewView creates a new source detail view.
@@ -51,6 +67,7 @@ func NewView(
 ) *View {
 	return &View{
 		styles:           s,
+		keys:             keymap.DefaultKeyMap(),
 		sourceService:    sourceService,
 		syncOrchestrator: syncOrchestrator,
 		documentService:  documentService,
@@ -65,6 +82,31 @@ func (v *View) SetSource(source domain.Source) {
 	v.syncing = false
 	v.deleting = false
 	v.selected = OptionViewDocuments
+	v.docsIndexed = 0
+	v.chunksEmbedded = 0
+	v.recentErrors = nil
+	v.errorOffset = 0
+}
+
+// HandleProgress counts indexing progress for the displayed source.
+// Events for other sources are ignored.
+func (v *View) HandleProgress(msg messages.SyncProgress) {
+	if v.source == nil || msg.SourceID != v.source.ID {
+		return
+	}
+	switch msg.Phase {
+	case driving.IndexPhaseIndexed, driving.IndexPhaseDeleted:
+		v.docsIndexed++
+	case driving.IndexPhaseEmbedded:
+		v.chunksEmbedded++
+	}
+}
+
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
+	}
 }
 
 // Init initialises the view.
@@ -72,10 +114,15 @@ func (v *View) Init() tea.Cmd {
 	return v.loadDocCount()
 }
 
-// loadDocCount returns a command that counts documents for the source.
+// loadDocCount returns a command that counts documents for the source
+// and loads its recent sync errors.
 func (v *View) loadDocCount() tea.Cmd {
 	return func() tea.Msg {
-		if v.source == nil || v.documentService == nil {
+		if v.source == nil {
+			return nil
+		}
+		v.loadRecentErrors()
+		if v.documentService == nil {
 			return nil
 		}
 
@@ -88,6 +135,27 @@ func (v *View) loadDocCount() tea.Cmd {
 	}
 }
 
+// loadRecentErrors loads the source's recent sync errors, newest first.
+// The list is left unchanged if the status cannot be loaded.
+func (v *View) loadRecentErrors() {
+	if v.source == nil || v.sourceService == nil {
+		return
+	}
+	statuses, err := v.sourceService.Statuses(context.Background())
+	if err != nil {
+		return
+	}
+	for i := range statuses {
+		if statuses[i].SourceID != v.source.ID {
+			continue
+		}
+		recent := slices.Clone(statuses[i].RecentErrors)
+		slices.Reverse(recent)
+		v.recentErrors = recent
+		v.errorOffset = 0
+	}
+}
+
 // Update handles messages for the source detail view.
 func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -123,23 +191,42 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > OptionViewDocuments {
 			v.selected--
 		}
-	case "down", "j":
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
 		if v.selected < OptionBack {
 			v.selected++
 		}
-	case "enter":
+		return v, nil
+	case key.Matches(msg, v.keys.Select):
 		return v.handleSelect()
-	case "esc":
+	case key.Matches(msg, v.keys.Back):
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewSources}
 		}
 	}
 
+	switch msg.String() {
+	case "pgdown", "ctrl+d":
+		if v.errorOffset+visibleErrors < len(v.recentErrors) {
+			v.errorOffset++
+		}
+	case "pgup", "ctrl+u":
+		if v.errorOffset > 0 {
+			v.errorOffset--
+		}
+	case "e":
+		if v.source != nil {
+			return v, func() tea.Msg {
+				return messages.ViewChanged{View: messages.ViewEditSource}
+			}
+		}
+	}
+
 	return v, nil
 }
 
@@ -153,6 +240,8 @@ func (v *View) handleSelect() (*View, tea.Cmd) {
 			}
 		}
 	case OptionSyncNow:
+		v.docsIndexed = 0
+		v.chunksEmbedded = 0
 		cmd := v.syncSource()
 		return v, cmd
 	case OptionDeleteSource:
@@ -175,6 +264,7 @@ func (v *View) syncSource() tea.Cmd {
 
 		v.syncing = true
 		err := v.syncOrchestrator.Sync(context.Background(), v.source.ID)
+		v.loadRecentErrors()
 		if err != nil {
 			return messages.ErrorOccurred{Err: err}
 		}
@@ -183,6 +273,23 @@ func (v *View) syncSource() tea.Cmd {
 	}
 }
 
+// DocumentsIndexed returns the number of documents indexed by the current sync.
+func (v *View) DocumentsIndexed() int {
+	return v.docsIndexed
+}
+
+// progressSummary describes the progress of the current sync, if any was reported.
+func (v *View) progressSummary() string {
+	if v.docsIndexed == 0 && v.chunksEmbedded == 0 {
+		return ""
+	}
+	summary := fmt.Sprintf(" %d documents indexed", v.docsIndexed)
+	if v.chunksEmbedded > 0 {
+		summary += fmt.Sprintf(", %d chunks embedded", v.chunksEmbedded)
+	}
+	return summary
+}
+
 // deleteSource returns a command that deletes the source.
 func (v *View) deleteSource() tea.Cmd {
 	return func() tea.Msg {
@@ -221,6 +328,18 @@ func (v *View) View() string {
 	b.WriteString(v.styles.Normal.Render(fmt.Sprintf("%d", v.docCount)))
 	b.WriteString("\n\n")
 
+	// Config
+	if len(v.source.Config) > 0 {
+		b.WriteString(v.styles.Subtitle.Render("Config:"))
+		b.WriteString("\n")
+		for _, key := range slices.Sorted(maps.Keys(v.source.Config)) {
+			b.WriteString(v.styles.Muted.Render(fmt.Sprintf("  %s: ", key)))
+			b.WriteString(v.styles.Normal.Render(configValue(key, v.source.Config[key])))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
 	// Error state
 	if v.err != nil {
 		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Error: %s", v.err.Error())))
@@ -229,7 +348,7 @@ func (v *View) View() string {
 
 	// Status
 	if v.syncing {
-		b.WriteString(v.styles.Muted.Render("Syncing..."))
+		b.WriteString(v.styles.Muted.Render("Syncing..." + v.progressSummary()))
 		b.WriteString("\n\n")
 	}
 	if v.deleting {
@@ -237,6 +356,12 @@ func (v *View) View() string {
 		b.WriteString("\n\n")
 	}
 
+	// Recent sync errors
+	if len(v.recentErrors) > 0 {
+		b.WriteString(v.renderRecentErrors())
+		b.WriteString("\n")
+	}
+
 	// Menu separator
 	b.WriteString(strings.Repeat("─", minInt(40, v.width-4)))
 	b.WriteString("\n\n")
@@ -269,9 +394,37 @@ func (v *View) View() string {
 	return b.String()
 }
 
+// renderRecentErrors renders the visible part of the recent sync errors.
+func (v *View) renderRecentErrors() string {
+	var b strings.Builder
+
+	b.WriteString(v.styles.Subtitle.Render(fmt.Sprintf("Recent Errors (%d):", len(v.recentErrors))))
+	b.WriteString("\n")
+
+	end := minInt(v.errorOffset+visibleErrors, len(v.recentErrors))
+	for _, entry := range v.recentErrors[v.errorOffset:end] {
+		b.WriteString(v.styles.Muted.Render(fmt.Sprintf("  %s [%s] ",
+			entry.Timestamp.Local().Format("2006-01-02 15:04"), entry.Phase)))
+		b.WriteString(v.styles.Error.Render(entry.Message))
+		b.WriteString("\n")
+	}
+
+	if len(v.recentErrors) > visibleErrors {
+		b.WriteString(v.styles.Muted.Render(fmt.Sprintf("  %d-%d of %d  [PgUp/PgDn] scroll",
+			v.errorOffset+1, end, len(v.recentErrors))))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// RecentErrors returns the source's recent sync errors, newest first.
+func (v *View) RecentErrors() []domain.SyncErrorLog {
+	return v.recentErrors
+}
+
 // renderHelp renders the help footer.
 func (v *View) renderHelp() string {
-	return v.styles.Help.Render("[↑/↓] navigate  [enter] select  [esc] back")
+	return v.styles.Help.Render("[↑/↓] navigate  [enter] select  [e] edit  [esc] back")
 }
 
 // SetDimensions sets the view dimensions.
@@ -296,6 +449,17 @@ func (v *View) Err() error {
 	return v.err
 }
 
+// configValue returns a config value for display, masking likely secrets.
+func configValue(key, value string) string {
+	lower := strings.ToLower(key)
+	for _, marker := range []string{"key", "token", "secret", "password"} {
+		if strings.Contains(lower, marker) && value != "" {
+			return "********"
+		}
+	}
+	return value
+}
+
 func minInt(a, b int) int {
 	if a < b {
 		return a
@@ -3,7 +3,9 @@ package sourcedetail
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
@@ -35,14 +37,44 @@ func (m *MockDocumentService) GetContent(ctx context.Context, documentID string)
 	return "", nil
 }
 
+func (m *MockDocumentService) GetOriginal(ctx context.Context, documentID string) ([]byte, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *MockDocumentService) GetChunks(ctx context.Context, documentID string) ([]domain.Chunk, error) {
+	return nil, nil
+}
+
 func (m *MockDocumentService) GetDetails(ctx context.Context, documentID string) (*driving.DocumentDetails, error) {
 	return nil, nil
 }
 
+func (m *MockDocumentService) GetAncestors(ctx context.Context, documentID string) (domain.BreadcrumbTrail, error) {
+	return nil, nil
+}
+
 func (m *MockDocumentService) Exclude(ctx context.Context, documentID, reason string) error {
 	return nil
 }
 
+func (m *MockDocumentService) ListQuarantined(_ context.Context, _ string) ([]domain.Exclusion, error) {
+	return nil, nil
+}
+
+func (m *MockDocumentService) RetryQuarantined(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockDocumentService) ExcludeMany(_ context.Context, ids []string, _ domain.ExclusionReason, _ string) (int, error) {
+	return len(ids), nil
+}
+
+func (m *MockDocumentService) ExcludePattern(
+	_ context.Context, _, _ string, _ domain.ExclusionReason, _ string,
+) (int, error) {
+	return 0, nil
+}
+
 func (m *MockDocumentService) Refresh(ctx context.Context, documentID string) error {
 	return nil
 }
@@ -53,7 +85,8 @@ func (m *MockDocumentService) Open(ctx context.Context, documentID string) error
 
 // MockSourceService implements driving.SourceService for testing.
 type MockSourceService struct {
-	RemoveFunc func(ctx context.Context, id string) error
+	RemoveFunc   func(ctx context.Context, id string) error
+	StatusesFunc func(ctx context.Context) ([]domain.SourceStatus, error)
 }
 
 func (m *MockSourceService) Add(ctx context.Context, source domain.Source) error {
@@ -75,6 +108,30 @@ func (m *MockSourceService) Remove(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockSourceService) Archive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Unarchive(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *MockSourceService) Rename(_ context.Context, _, _ string) ([]domain.Source, error) {
+	return nil, nil
+}
+
 func (m *MockSourceService) Update(ctx context.Context, source domain.Source) error {
 	return nil
 }
@@ -83,6 +140,13 @@ func (m *MockSourceService) ValidateConfig(ctx context.Context, connectorType st
 	return nil
 }
 
+func (m *MockSourceService) Statuses(ctx context.Context) ([]domain.SourceStatus, error) {
+	if m.StatusesFunc != nil {
+		return m.StatusesFunc(ctx)
+	}
+	return nil, nil
+}
+
 // MockSyncOrchestrator implements driving.SyncOrchestrator for testing.
 type MockSyncOrchestrator struct {
 	SyncFunc func(ctx context.Context, sourceID string) error
@@ -99,6 +163,14 @@ func (m *MockSyncOrchestrator) SyncAll(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockSyncOrchestrator) SyncSince(ctx context.Context, sourceID string, _ time.Time) error {
+	return m.Sync(ctx, sourceID)
+}
+
+func (m *MockSyncOrchestrator) SyncAllSince(ctx context.Context, _ time.Time) error {
+	return m.SyncAll(ctx)
+}
+
 func (m *MockSyncOrchestrator) Status(ctx context.Context, sourceID string) (*driving.SyncStatus, error) {
 	return nil, nil
 }
@@ -150,6 +222,49 @@ func TestView_Init(t *testing.T) {
 	assert.Equal(t, 2, view.docCount)
 }
 
+func TestView_Init_LoadsRecentErrors(t *testing.T) {
+	failedAt := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	sourceMock := &MockSourceService{
+		StatusesFunc: func(ctx context.Context) ([]domain.SourceStatus, error) {
+			var recent []domain.SyncErrorLog
+			for i := 0; i < 7; i++ {
+				recent = append(recent, domain.SyncErrorLog{
+					Timestamp: failedAt.Add(time.Duration(i) * time.Minute),
+					Phase:     domain.SyncPhaseDocument,
+					Message:   fmt.Sprintf("file:///doc-%d.pdf: parse failed", i),
+				})
+			}
+			return []domain.SourceStatus{
+				{SourceID: "other"},
+				{SourceID: "src-1", RecentErrors: recent},
+			}, nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), sourceMock, nil, nil)
+	view.SetSource(domain.Source{ID: "src-1", Name: "Test"})
+	view.SetDimensions(80, 40)
+
+	view.Init()()
+
+	// Newest first
+	require.Len(t, view.RecentErrors(), 7)
+	assert.Equal(t, "file:///doc-6.pdf: parse failed", view.RecentErrors()[0].Message)
+
+	rendered := view.View()
+	assert.Contains(t, rendered, "Recent Errors (7):")
+	assert.Contains(t, rendered, "doc-6.pdf")
+	assert.NotContains(t, rendered, "doc-1.pdf")
+	assert.Contains(t, rendered, "1-5 of 7")
+
+	// Scroll to the oldest errors
+	view.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	view.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	view.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	rendered = view.View()
+	assert.Contains(t, rendered, "doc-0.pdf")
+	assert.Contains(t, rendered, "3-7 of 7")
+}
+
 func TestView_Update_WindowSize(t *testing.T) {
 	view := NewView(nil, nil, nil, nil)
 
@@ -279,6 +394,46 @@ func TestView_Update_KeyMsg_Escape(t *testing.T) {
 	assert.Equal(t, messages.ViewSources, changed.View)
 }
 
+func TestView_View_ShowsConfig(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, nil, nil)
+	view.SetDimensions(80, 24)
+	view.SetSource(domain.Source{
+		ID:     "src-1",
+		Name:   "Trello",
+		Config: map[string]string{"board_ids": "abc", "api_key": "s3cret"},
+	})
+
+	output := view.View()
+
+	assert.Contains(t, output, "Config:")
+	assert.Contains(t, output, "board_ids: ")
+	assert.Contains(t, output, "abc")
+	assert.NotContains(t, output, "s3cret")
+}
+
+func TestView_Update_KeyMsg_Edit(t *testing.T) {
+	view := NewView(nil, nil, nil, nil)
+	view.source = &domain.Source{ID: "src-1"}
+
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}}
+	_, cmd := view.Update(msg)
+
+	require.NotNil(t, cmd)
+	result := cmd()
+	changed, ok := result.(messages.ViewChanged)
+	assert.True(t, ok)
+	assert.Equal(t, messages.ViewEditSource, changed.View)
+}
+
+func TestView_Update_KeyMsg_Edit_NoSource(t *testing.T) {
+	view := NewView(nil, nil, nil, nil)
+
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}}
+	_, cmd := view.Update(msg)
+
+	assert.Nil(t, cmd)
+}
+
 func TestView_Update_SourceRemoved(t *testing.T) {
 	view := NewView(nil, nil, nil, nil)
 	view.source = &domain.Source{ID: "src-1"}
@@ -338,6 +493,24 @@ func TestView_View(t *testing.T) {
 	assert.Contains(t, output, "View Documents")
 }
 
+func TestView_HandleProgress(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, nil, nil)
+	view.SetSource(domain.Source{ID: "src-1", Name: "Test"})
+	view.width = 80
+	view.syncing = true
+
+	view.HandleProgress(messages.SyncProgress{SourceID: "src-1", DocumentID: "d1", Phase: driving.IndexPhaseChunked})
+	view.HandleProgress(messages.SyncProgress{SourceID: "src-1", DocumentID: "d1", Phase: driving.IndexPhaseIndexed})
+	view.HandleProgress(messages.SyncProgress{SourceID: "src-1", ChunkID: "c1", Phase: driving.IndexPhaseEmbedded})
+	view.HandleProgress(messages.SyncProgress{SourceID: "src-2", DocumentID: "d2", Phase: driving.IndexPhaseIndexed})
+
+	assert.Equal(t, 1, view.DocumentsIndexed())
+	assert.Contains(t, view.View(), "Syncing... 1 documents indexed, 1 chunks embedded")
+
+	view.SetSource(domain.Source{ID: "src-1", Name: "Test"})
+	assert.Equal(t, 0, view.DocumentsIndexed())
+}
+
 func TestView_View_Error(t *testing.T) {
 	s := styles.DefaultStyles()
 	view := NewView(s, nil, nil, nil)
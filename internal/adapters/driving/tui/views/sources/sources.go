@@ -4,30 +4,64 @@ package sources
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
+// DefaultStaleDays is how long a source can go without a successful sync
+// before the stale filter lists it.
+const DefaultStaleDays = 7
+
+// syncFilter limits the listed sources by when they last synced.
+type syncFilter int
+
+const (
+	filterNone        syncFilter = iota // every source
+	filterNeverSynced                   // sources that have never synced successfully
+	filterStale                         // sources not synced within the stale period
+)
+
 // View is the sources management view.
 type View struct {
 	styles             *styles.Styles
+	keys               *keymap.KeyMap
 	sourceService      driving.SourceService
 	credentialsService driving.CredentialsService
+	syncOrchestrator   driving.SyncOrchestrator
+	connectorRegistry  driving.ConnectorRegistry
 
 	sources            []domain.Source
 	accountIdentifiers map[string]string // sourceID -> accountIdentifier
+	statuses           map[string]domain.SourceStatus
+	syncing            map[string]bool
 	selected           int
 	width              int
 	height             int
 	ready              bool
 	err                error
 	loading            bool
+	grouped            bool // group sources by provider in a tree
+	byCollection       bool // group sources by collection in a tree
+	sortBySync         bool // least recently synced first
+	filter             syncFilter
+	staleDays          int
+
+	// Renaming edits the selected source's name in place
+	renaming    bool
+	renameID    string
+	renameInput textinput.Model
+	notice      string // warning from the last rename
 }
 
 // NewView creates a new sources view.
@@ -38,16 +72,38 @@ func NewView(
 ) *View {
 	return &View{
 		styles:             s,
+		keys:               keymap.DefaultKeyMap(),
 		sourceService:      sourceService,
 		credentialsService: credentialsService,
 		sources:            []domain.Source{},
 		accountIdentifiers: make(map[string]string),
+		statuses:           make(map[string]domain.SourceStatus),
+		syncing:            make(map[string]bool),
+		staleDays:          DefaultStaleDays,
+	}
+}
+
+// SetSyncOrchestrator sets the orchestrator used to show which sources are syncing.
+func (v *View) SetSyncOrchestrator(orchestrator driving.SyncOrchestrator) {
+	v.syncOrchestrator = orchestrator
+}
+
+// SetConnectorRegistry sets the registry used to group sources by provider.
+// Grouping is toggled with [g] once a registry is set.
+func (v *View) SetConnectorRegistry(registry driving.ConnectorRegistry) {
+	v.connectorRegistry = registry
+}
+
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
 	}
 }
 
-// Init initialises the view and loads sources.
+// Init initialises the view and loads sources with their sync states.
 func (v *View) Init() tea.Cmd {
-	return v.loadSources()
+	return tea.Batch(v.loadSources(), v.loadSyncStates())
 }
 
 // sourcesLoadedMsg extends messages.SourcesLoaded with account identifiers.
@@ -85,6 +141,33 @@ func (v *View) loadSources() tea.Cmd {
 	}
 }
 
+// loadSyncStates returns a command that loads the sync status of every source.
+func (v *View) loadSyncStates() tea.Cmd {
+	return func() tea.Msg {
+		if v.sourceService == nil {
+			return messages.SyncStatesLoaded{Err: fmt.Errorf("source service not available")}
+		}
+
+		ctx := context.Background()
+		statuses, err := v.sourceService.Statuses(ctx)
+		if err != nil {
+			return messages.SyncStatesLoaded{Err: err}
+		}
+
+		syncing := make(map[string]bool)
+		if v.syncOrchestrator != nil {
+			for i := range statuses {
+				status, err := v.syncOrchestrator.Status(ctx, statuses[i].SourceID)
+				if err == nil && status != nil && status.Running {
+					syncing[statuses[i].SourceID] = true
+				}
+			}
+		}
+
+		return messages.SyncStatesLoaded{Statuses: statuses, Syncing: syncing}
+	}
+}
+
 // fetchAccountIdentifiers retrieves account identifiers for sources with credentials.
 func (v *View) fetchAccountIdentifiers(ctx context.Context, sources []domain.Source) map[string]string {
 	accountIDs := make(map[string]string)
@@ -142,6 +225,21 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		}
 		return v, nil
 
+	case messages.SyncStatesLoaded:
+		// Rows fall back to a plain listing if statuses fail to load
+		if msg.Err != nil {
+			return v, nil
+		}
+		// Sorting and filtering depend on statuses, so the order may change
+		v.keepSelection(func() {
+			v.statuses = make(map[string]domain.SourceStatus, len(msg.Statuses))
+			for _, status := range msg.Statuses {
+				v.statuses[status.SourceID] = status
+			}
+		})
+		v.syncing = msg.Syncing
+		return v, nil
+
 	case messages.SourceRemoved:
 		if msg.Err != nil {
 			v.err = msg.Err
@@ -151,6 +249,35 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 			return v, cmd
 		}
 		return v, nil
+
+	case messages.SourceRenamed:
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		v.keepSelection(func() {
+			for i := range v.sources {
+				if v.sources[i].ID == msg.ID {
+					v.sources[i].Name = msg.Name
+				}
+			}
+		})
+		v.notice = renameNotice(msg.Name, msg.Conflicts)
+		return v, nil
+
+	case messages.SourceArchived:
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		// Keep the source selected as it moves between sections
+		for i := range v.sources {
+			if v.sources[i].ID == msg.ID {
+				v.sources[i].Archived = msg.Archived
+			}
+		}
+		v.selectSource(msg.ID)
+		return v, nil
 	}
 
 	return v, nil
@@ -158,23 +285,33 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	if v.renaming {
+		return v.handleRenameKey(msg)
+	}
+	v.notice = ""
+
+	switch {
+	case key.Matches(msg, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case "down", "j":
-		if v.selected < len(v.sources)-1 {
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
+		if v.selected < len(v.displayOrder())-1 {
 			v.selected++
 		}
-	case "enter":
+		return v, nil
+	case key.Matches(msg, v.keys.Select):
 		// Navigate to source detail
-		if len(v.sources) > 0 && v.selected < len(v.sources) {
-			source := v.sources[v.selected]
+		if source, ok := v.selectedSource(); ok {
 			return v, func() tea.Msg {
 				return messages.SourceSelected{Source: source}
 			}
 		}
+		return v, nil
+	}
+
+	switch msg.String() {
 	case "a":
 		// Add new source
 		return v, func() tea.Msg {
@@ -182,20 +319,115 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		}
 	case "d", "delete", "backspace":
 		// Delete selected source
-		if len(v.sources) > 0 && v.selected < len(v.sources) {
-			cmd := v.deleteSource(v.sources[v.selected].ID)
+		if source, ok := v.selectedSource(); ok {
+			cmd := v.deleteSource(source.ID)
 			return v, cmd
 		}
+	case "n":
+		// Rename the selected source
+		if source, ok := v.selectedSource(); ok {
+			return v, v.startRename(source)
+		}
+	case "x":
+		// Archive or unarchive the selected source
+		if source, ok := v.selectedSource(); ok {
+			return v, v.setArchived(source.ID, !source.Archived)
+		}
+	case "g":
+		// Toggle grouping by provider, keeping the selected source selected
+		if v.connectorRegistry != nil {
+			v.keepSelection(func() {
+				v.grouped = !v.grouped
+				v.byCollection = false
+			})
+		}
+	case "c":
+		// Toggle grouping by collection, keeping the selected source selected
+		v.keepSelection(func() {
+			v.byCollection = !v.byCollection
+			v.grouped = false
+		})
+	case "s":
+		// Toggle sorting by last sync, least recent first
+		v.keepSelection(func() { v.sortBySync = !v.sortBySync })
+	case "f":
+		// Cycle through all, never synced and stale sources
+		v.keepSelection(func() { v.filter = (v.filter + 1) % (filterStale + 1) })
+	case "+":
+		// Widen the stale period
+		if v.filter == filterStale {
+			v.keepSelection(func() { v.staleDays++ })
+		}
+	case "-":
+		// Narrow the stale period
+		if v.filter == filterStale && v.staleDays > 1 {
+			v.keepSelection(func() { v.staleDays-- })
+		}
 	case "r":
 		// Reload sources
 		v.loading = true
-		cmd := v.loadSources()
-		return v, cmd
+		return v, tea.Batch(v.loadSources(), v.loadSyncStates())
 	}
 
 	return v, nil
 }
 
+// startRename opens the rename prompt with the source's current name.
+func (v *View) startRename(source domain.Source) tea.Cmd {
+	v.renaming = true
+	v.renameID = source.ID
+	v.renameInput = textinput.New()
+	v.renameInput.CharLimit = 256
+	v.renameInput.SetValue(source.Name)
+	v.renameInput.CursorEnd()
+	return v.renameInput.Focus()
+}
+
+// handleRenameKey handles key presses while the rename prompt is open.
+func (v *View) handleRenameKey(msg tea.KeyMsg) (*View, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.renaming = false
+		return v, nil
+	case "enter":
+		name := strings.TrimSpace(v.renameInput.Value())
+		if name == "" {
+			// Keep the prompt open; an empty name is never valid
+			return v, nil
+		}
+		v.renaming = false
+		return v, v.renameSource(v.renameID, name)
+	}
+
+	var cmd tea.Cmd
+	v.renameInput, cmd = v.renameInput.Update(msg)
+	return v, cmd
+}
+
+// renameSource returns a command that renames a source.
+func (v *View) renameSource(id, name string) tea.Cmd {
+	return func() tea.Msg {
+		if v.sourceService == nil {
+			return messages.SourceRenamed{ID: id, Err: fmt.Errorf("source service not available")}
+		}
+
+		conflicts, err := v.sourceService.Rename(context.Background(), id, name)
+		return messages.SourceRenamed{ID: id, Name: name, Conflicts: conflicts, Err: err}
+	}
+}
+
+// renameNotice warns that a new name is shared with other sources.
+func renameNotice(name string, conflicts []domain.Source) string {
+	switch len(conflicts) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("Another source is also named %q.", name)
+	default:
+		return fmt.Sprintf("%d other sources are also named %q.", len(conflicts), name)
+	}
+}
+
 // deleteSource returns a command that deletes a source.
 func (v *View) deleteSource(id string) tea.Cmd {
 	return func() tea.Msg {
@@ -208,6 +440,54 @@ func (v *View) deleteSource(id string) tea.Cmd {
 	}
 }
 
+// setArchived returns a command that archives or unarchives a source.
+func (v *View) setArchived(id string, archived bool) tea.Cmd {
+	return func() tea.Msg {
+		if v.sourceService == nil {
+			return messages.SourceArchived{ID: id, Err: fmt.Errorf("source service not available")}
+		}
+
+		ctx := context.Background()
+		var err error
+		if archived {
+			err = v.sourceService.Archive(ctx, id)
+		} else {
+			err = v.sourceService.Unarchive(ctx, id)
+		}
+		return messages.SourceArchived{ID: id, Archived: archived, Err: err}
+	}
+}
+
+// selectedSource returns the source at the selected position, if any.
+func (v *View) selectedSource() (domain.Source, bool) {
+	order := v.displayOrder()
+	if v.selected < 0 || v.selected >= len(order) {
+		return domain.Source{}, false
+	}
+	return v.sources[order[v.selected]], true
+}
+
+// keepSelection applies a change to the display order, keeping the selected
+// source selected if it is still shown and selecting the first one otherwise.
+func (v *View) keepSelection(change func()) {
+	var selectedID string
+	if source, ok := v.selectedSource(); ok {
+		selectedID = source.ID
+	}
+	change()
+	v.selected = 0
+	v.selectSource(selectedID)
+}
+
+// selectSource moves the selection to the source with the given ID, if shown.
+func (v *View) selectSource(id string) {
+	for pos, i := range v.displayOrder() {
+		if v.sources[i].ID == id {
+			v.selected = pos
+		}
+	}
+}
+
 // View renders the sources view.
 func (v *View) View() string {
 	var b strings.Builder
@@ -240,11 +520,46 @@ func (v *View) View() string {
 		return b.String()
 	}
 
-	// Sources list
-	for i := range v.sources {
-		line := v.renderSource(i, &v.sources[i])
-		b.WriteString(line)
+	if v.renaming {
+		b.WriteString(v.styles.Normal.Render("Rename: "))
+		b.WriteString(v.renameInput.View())
+		b.WriteString("\n\n")
+	} else if v.notice != "" {
+		b.WriteString(v.styles.Warning.Render(v.notice))
+		b.WriteString("\n\n")
+	}
+
+	if label := v.filterLabel(); label != "" {
+		b.WriteString(v.styles.Muted.Render(label))
+		b.WriteString("\n\n")
+	}
+	if len(v.displayOrder()) == 0 {
+		b.WriteString(v.styles.Muted.Render("No sources match the filter."))
+		b.WriteString("\n\n")
+		b.WriteString(v.renderHelp())
+		return b.String()
+	}
+
+	// Sources list, with archived sources in their own section at the end
+	active := v.activeOrder()
+	if v.isGrouped() {
+		b.WriteString(v.renderGroups())
+	} else {
+		for pos, i := range active {
+			b.WriteString(v.renderSource(pos, &v.sources[i]))
+			b.WriteString("\n")
+		}
+	}
+	if archived := v.archivedIndices(); len(archived) > 0 {
+		if len(active) > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(v.styles.Subtitle.Render("Archived"))
 		b.WriteString("\n")
+		for n, i := range archived {
+			b.WriteString(v.renderSource(len(active)+n, &v.sources[i]))
+			b.WriteString("\n")
+		}
 	}
 
 	b.WriteString("\n")
@@ -253,15 +568,232 @@ func (v *View) View() string {
 	return b.String()
 }
 
-// renderSource renders a single source line.
+// sourceGroup is the sources of one provider or collection, as indices
+// into v.sources.
+type sourceGroup struct {
+	label   string
+	indices []int
+}
+
+// isGrouped reports whether sources are listed in a tree of groups.
+func (v *View) isGrouped() bool {
+	return v.byCollection || (v.grouped && v.connectorRegistry != nil)
+}
+
+// groupSources groups active sources by collection or by provider, as set.
+func (v *View) groupSources() []sourceGroup {
+	if v.byCollection {
+		return v.collectionGroups()
+	}
+	return v.providerGroups()
+}
+
+// collectionGroups groups active sources by collection. Collections are
+// ordered by name; sources in no collection are grouped last.
+func (v *View) collectionGroups() []sourceGroup {
+	var active []domain.Source
+	var activeIndices []int
+	for i := range v.sources {
+		if !v.sources[i].Archived && v.matchesFilter(i) {
+			active = append(active, v.sources[i])
+			activeIndices = append(activeIndices, i)
+		}
+	}
+
+	positions := make(map[string]int, len(active))
+	for n := range active {
+		positions[active[n].ID] = activeIndices[n]
+	}
+	var groups []sourceGroup
+	for _, collection := range domain.GroupCollections(active) {
+		group := sourceGroup{label: collection.Name}
+		for _, id := range collection.SourceIDs {
+			group.indices = append(group.indices, positions[id])
+		}
+		v.sortIndices(group.indices)
+		groups = append(groups, group)
+	}
+
+	ungrouped := sourceGroup{label: "No collection"}
+	for n := range active {
+		if strings.TrimSpace(active[n].Collection) == "" {
+			ungrouped.indices = append(ungrouped.indices, activeIndices[n])
+		}
+	}
+	if len(ungrouped.indices) > 0 {
+		v.sortIndices(ungrouped.indices)
+		groups = append(groups, ungrouped)
+	}
+	return groups
+}
+
+// providerGroups groups active sources by the provider of their connector type.
+// Providers are ordered by name and connectors within a provider as listed by
+// the registry. Sources of unknown connector types are grouped last.
+func (v *View) providerGroups() []sourceGroup {
+	var providers []domain.ProviderType
+	seen := make(map[domain.ProviderType]bool)
+	for _, c := range v.connectorRegistry.List() {
+		if !seen[c.ProviderType] {
+			seen[c.ProviderType] = true
+			providers = append(providers, c.ProviderType)
+		}
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].DisplayName() < providers[j].DisplayName()
+	})
+
+	// Archived sources are listed in their own section instead, and
+	// filtered out sources are not listed at all
+	assigned := make([]bool, len(v.sources))
+	for i := range v.sources {
+		assigned[i] = v.sources[i].Archived || !v.matchesFilter(i)
+	}
+	var groups []sourceGroup
+	for _, provider := range providers {
+		group := sourceGroup{label: provider.DisplayName()}
+		for _, connector := range v.connectorRegistry.ListByProvider(provider) {
+			for i := range v.sources {
+				if !assigned[i] && v.sources[i].Type == connector.ID {
+					assigned[i] = true
+					group.indices = append(group.indices, i)
+				}
+			}
+		}
+		if len(group.indices) > 0 {
+			v.sortIndices(group.indices)
+			groups = append(groups, group)
+		}
+	}
+
+	other := sourceGroup{label: "Other"}
+	for i := range v.sources {
+		if !assigned[i] {
+			other.indices = append(other.indices, i)
+		}
+	}
+	if len(other.indices) > 0 {
+		v.sortIndices(other.indices)
+		groups = append(groups, other)
+	}
+	return groups
+}
+
+// displayOrder returns the indices of v.sources in the order they are shown:
+// active sources, then archived ones. The selection is a position in this order.
+func (v *View) displayOrder() []int {
+	return append(v.activeOrder(), v.archivedIndices()...)
+}
+
+// activeOrder returns the indices of sources that are not archived, in the
+// order they are shown.
+func (v *View) activeOrder() []int {
+	order := make([]int, 0, len(v.sources))
+	if !v.isGrouped() {
+		for i := range v.sources {
+			if !v.sources[i].Archived && v.matchesFilter(i) {
+				order = append(order, i)
+			}
+		}
+		v.sortIndices(order)
+		return order
+	}
+	for _, group := range v.groupSources() {
+		order = append(order, group.indices...)
+	}
+	return order
+}
+
+// archivedIndices returns the indices of archived sources in the order they
+// are shown. Archived sources never sync, so the sync filters hide them.
+func (v *View) archivedIndices() []int {
+	if v.filter != filterNone {
+		return nil
+	}
+	var indices []int
+	for i := range v.sources {
+		if v.sources[i].Archived {
+			indices = append(indices, i)
+		}
+	}
+	v.sortIndices(indices)
+	return indices
+}
+
+// matchesFilter reports whether the source at index i passes the sync filter.
+// Sources whose status has not loaded count as never synced.
+func (v *View) matchesFilter(i int) bool {
+	status := v.statuses[v.sources[i].ID]
+	switch v.filter {
+	case filterNeverSynced:
+		return status.NeverSynced()
+	case filterStale:
+		return !status.SyncedWithin(time.Duration(v.staleDays)*24*time.Hour, time.Now())
+	default:
+		return true
+	}
+}
+
+// sortIndices orders sources by last sync when sorting is on, so those that
+// most need attention come first: never synced, then least recently synced.
+func (v *View) sortIndices(indices []int) {
+	if !v.sortBySync {
+		return
+	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return v.statuses[v.sources[indices[a]].ID].LastSync.Before(v.statuses[v.sources[indices[b]].ID].LastSync)
+	})
+}
+
+// filterLabel describes the active filter and sort order, or returns an
+// empty string if neither is set.
+func (v *View) filterLabel() string {
+	var parts []string
+	switch v.filter {
+	case filterNeverSynced:
+		parts = append(parts, "never synced")
+	case filterStale:
+		parts = append(parts, fmt.Sprintf("not synced in over %d days", v.staleDays))
+	}
+	if v.sortBySync {
+		parts = append(parts, "sorted by last sync")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "Showing: " + strings.Join(parts, " · ")
+}
+
+// renderGroups renders the sources as a tree under provider or collection headings.
+func (v *View) renderGroups() string {
+	var b strings.Builder
+	pos := 0
+	for _, group := range v.groupSources() {
+		b.WriteString(v.styles.Subtitle.Render(group.label))
+		b.WriteString("\n")
+		for n, i := range group.indices {
+			branch := "├─ "
+			if n == len(group.indices)-1 {
+				branch = "└─ "
+			}
+			b.WriteString(v.styles.Muted.Render(branch))
+			b.WriteString(v.renderSource(pos, &v.sources[i]))
+			b.WriteString("\n")
+			pos++
+		}
+	}
+	return b.String()
+}
+
+// renderSource renders a single source line at a position in the display order.
 func (v *View) renderSource(index int, source *domain.Source) string {
 	indicator := "  "
 	if index == v.selected {
 		indicator = "> "
 	}
 
-	// Format: > [type] name (account)
-	typeStr := fmt.Sprintf("[%s]", source.Type)
+	// Format: > [badge] name (account)  status  N docs · age
+	typeStr := fmt.Sprintf("[%s]", typeBadge(source.Type))
 	name := source.Name
 	if name == "" {
 		name = source.ID
@@ -272,8 +804,10 @@ func (v *View) renderSource(index int, source *domain.Source) string {
 		name = fmt.Sprintf("%s - %s", name, accountID)
 	}
 
+	details := v.renderStatus(source.ID)
+
 	// Truncate name if needed
-	maxNameLen := v.width - len(typeStr) - 12
+	maxNameLen := v.width - len(typeStr) - len(details) - 12
 	if maxNameLen < 10 {
 		maxNameLen = 10
 	}
@@ -289,13 +823,92 @@ func (v *View) renderSource(index int, source *domain.Source) string {
 			v.styles.Subtitle.Render(fmt.Sprintf("%-10s ", typeStr)) +
 			v.styles.Normal.Render(name)
 	}
+	if details != "" {
+		line += "  " + v.styles.Muted.Render(details)
+	}
 
 	return line
 }
 
+// renderStatus renders the sync indicator, document count and time since
+// the last sync. Returns an empty string until statuses have loaded.
+func (v *View) renderStatus(sourceID string) string {
+	status, ok := v.statuses[sourceID]
+	if !ok {
+		return ""
+	}
+
+	var indicator string
+	switch {
+	case v.syncing[sourceID]:
+		indicator = "🟡"
+	case status.LastError != "":
+		indicator = "🔴"
+	case status.LastSync.IsZero():
+		indicator = "⚫"
+	default:
+		indicator = "🟢"
+	}
+
+	docs := "docs"
+	if status.DocumentCount == 1 {
+		docs = "doc"
+	}
+	return fmt.Sprintf("%s %d %s · %s", indicator, status.DocumentCount, docs, timeSince(status.LastSync, time.Now()))
+}
+
+// typeBadges holds short badges for connector types with long IDs.
+var typeBadges = map[string]string{
+	"filesystem":         "fs",
+	"github":             "gh",
+	"google-drive":       "gdrive",
+	"google-calendar":    "gcal",
+	"microsoft-calendar": "mscal",
+	"obsidian-publish":   "obsidian",
+}
+
+// typeBadge returns the badge shown for a connector type.
+func typeBadge(connectorType string) string {
+	if badge, ok := typeBadges[connectorType]; ok {
+		return badge
+	}
+	return connectorType
+}
+
+// timeSince formats the time elapsed since t, e.g. "5m ago".
+func timeSince(t, now time.Time) string {
+	if t.IsZero() {
+		return "never synced"
+	}
+	elapsed := now.Sub(t)
+	switch {
+	case elapsed < time.Minute:
+		return "just now"
+	case elapsed < time.Hour:
+		return fmt.Sprintf("%dm ago", int(elapsed.Minutes()))
+	case elapsed < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(elapsed.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(elapsed.Hours()/24))
+	}
+}
+
 // renderHelp renders the help footer.
 func (v *View) renderHelp() string {
-	return v.styles.Help.Render("[a] add  [enter] details  [d] delete  [r] reload  [esc] back  [q] quit")
+	if v.renaming {
+		return v.styles.Help.Render("[enter] save  [esc] cancel")
+	}
+	keys := []string{"[a] add", "[enter] details", "[n] rename", "[d] delete", "[x] archive"}
+	if v.connectorRegistry != nil {
+		keys = append(keys, "[g] group")
+	}
+	keys = append(keys, "[c] collections")
+	keys = append(keys, "[s] sort", "[f] filter")
+	if v.filter == filterStale {
+		keys = append(keys, "[+/-] days")
+	}
+	keys = append(keys, "[r] reload", "[esc] back", "[q] quit")
+	return v.styles.Help.Render(strings.Join(keys, "  "))
 }
 
 // SetDimensions sets the view dimensions.
@@ -310,6 +923,22 @@ func (v *View) Sources() []domain.Source {
 	return v.sources
 }
 
+// Renaming reports whether the rename prompt is open, so Esc cancels the
+// rename instead of leaving the view.
+func (v *View) Renaming() bool {
+	return v.renaming
+}
+
+// Grouped reports whether sources are grouped by provider.
+func (v *View) Grouped() bool {
+	return v.grouped
+}
+
+// GroupedByCollection reports whether sources are grouped by collection.
+func (v *View) GroupedByCollection() bool {
+	return v.byCollection
+}
+
 // SelectedIndex returns the currently selected source index.
 func (v *View) SelectedIndex() int {
 	return v.selected
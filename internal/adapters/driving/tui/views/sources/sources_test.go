@@ -3,7 +3,9 @@ package sources
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
@@ -12,12 +14,18 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
 // MockSourceService implements driving.SourceService for testing.
 type MockSourceService struct {
-	ListFunc   func(ctx context.Context) ([]domain.Source, error)
-	RemoveFunc func(ctx context.Context, id string) error
+	ListFunc     func(ctx context.Context) ([]domain.Source, error)
+	RemoveFunc   func(ctx context.Context, id string) error
+	StatusesFunc func(ctx context.Context) ([]domain.SourceStatus, error)
+	ArchiveFunc  func(ctx context.Context, id string) error
+	RenameFunc   func(ctx context.Context, id, newName string) ([]domain.Source, error)
+	archived     []string
+	unarchived   []string
 }
 
 func (m *MockSourceService) Add(ctx context.Context, source domain.Source) error {
@@ -42,6 +50,38 @@ func (m *MockSourceService) Remove(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockSourceService) Archive(ctx context.Context, id string) error {
+	m.archived = append(m.archived, id)
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockSourceService) Unarchive(ctx context.Context, id string) error {
+	m.unarchived = append(m.unarchived, id)
+	return nil
+}
+
+func (m *MockSourceService) SetCollection(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *MockSourceService) Collections(_ context.Context) ([]domain.Collection, error) {
+	return nil, nil
+}
+
+func (m *MockSourceService) RenameCollection(_ context.Context, _, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *MockSourceService) Rename(ctx context.Context, id, newName string) ([]domain.Source, error) {
+	if m.RenameFunc != nil {
+		return m.RenameFunc(ctx, id, newName)
+	}
+	return nil, nil
+}
+
 func (m *MockSourceService) Update(ctx context.Context, source domain.Source) error {
 	return nil
 }
@@ -50,6 +90,13 @@ func (m *MockSourceService) ValidateConfig(ctx context.Context, connectorType st
 	return nil
 }
 
+func (m *MockSourceService) Statuses(ctx context.Context) ([]domain.SourceStatus, error) {
+	if m.StatusesFunc != nil {
+		return m.StatusesFunc(ctx)
+	}
+	return []domain.SourceStatus{}, nil
+}
+
 func TestNewView(t *testing.T) {
 	s := styles.DefaultStyles()
 	mock := &MockSourceService{}
@@ -85,11 +132,15 @@ func TestView_Init(t *testing.T) {
 	cmd := view.Init()
 
 	require.NotNil(t, cmd)
-	result := cmd()
-	loaded, ok := result.(sourcesLoadedMsg)
+	msgs := runBatch(t, cmd)
+	require.Len(t, msgs, 2)
+	loaded, ok := msgs[0].(sourcesLoadedMsg)
 	require.True(t, ok)
 	assert.Len(t, loaded.Sources, 2)
 	assert.NoError(t, loaded.Err)
+	states, ok := msgs[1].(messages.SyncStatesLoaded)
+	require.True(t, ok)
+	assert.NoError(t, states.Err)
 }
 
 func TestView_Init_NilService(t *testing.T) {
@@ -98,10 +149,14 @@ func TestView_Init_NilService(t *testing.T) {
 	cmd := view.Init()
 
 	require.NotNil(t, cmd)
-	result := cmd()
-	loaded, ok := result.(sourcesLoadedMsg)
+	msgs := runBatch(t, cmd)
+	require.Len(t, msgs, 2)
+	loaded, ok := msgs[0].(sourcesLoadedMsg)
 	require.True(t, ok)
 	assert.Error(t, loaded.Err)
+	states, ok := msgs[1].(messages.SyncStatesLoaded)
+	require.True(t, ok)
+	assert.Error(t, states.Err)
 }
 
 func TestView_Update_WindowSize(t *testing.T) {
@@ -335,8 +390,8 @@ func TestView_View_WithSources(t *testing.T) {
 	output := view.View()
 
 	assert.Contains(t, output, "Sources")
-	assert.Contains(t, output, "filesystem")
-	assert.Contains(t, output, "notion")
+	assert.Contains(t, output, "[fs]")
+	assert.Contains(t, output, "[notion]")
 	assert.Contains(t, output, "My Documents")
 	assert.Contains(t, output, "Work Notes")
 }
@@ -439,3 +494,439 @@ func TestView_DeleteSource_NilService(t *testing.T) {
 	require.True(t, ok)
 	assert.Error(t, removed.Err)
 }
+
+// runBatch runs a batched command and returns its messages in order.
+func runBatch(t *testing.T, cmd tea.Cmd) []tea.Msg {
+	t.Helper()
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	msgs := make([]tea.Msg, 0, len(batch))
+	for _, c := range batch {
+		msgs = append(msgs, c())
+	}
+	return msgs
+}
+
+// stubSyncOrchestrator reports the given sources as syncing.
+type stubSyncOrchestrator struct {
+	running map[string]bool
+}
+
+func (s *stubSyncOrchestrator) Sync(_ context.Context, _ string) error { return nil }
+func (s *stubSyncOrchestrator) SyncAll(_ context.Context) error        { return nil }
+func (s *stubSyncOrchestrator) SyncSince(_ context.Context, _ string, _ time.Time) error {
+	return nil
+}
+func (s *stubSyncOrchestrator) SyncAllSince(_ context.Context, _ time.Time) error { return nil }
+func (s *stubSyncOrchestrator) Status(_ context.Context, sourceID string) (*driving.SyncStatus, error) {
+	return &driving.SyncStatus{SourceID: sourceID, Running: s.running[sourceID]}, nil
+}
+
+func TestView_LoadSyncStates(t *testing.T) {
+	statuses := []domain.SourceStatus{
+		{SourceID: "src-1", DocumentCount: 3},
+		{SourceID: "src-2"},
+	}
+	mock := &MockSourceService{
+		StatusesFunc: func(ctx context.Context) ([]domain.SourceStatus, error) {
+			return statuses, nil
+		},
+	}
+	view := NewView(nil, mock, nil)
+	view.SetSyncOrchestrator(&stubSyncOrchestrator{running: map[string]bool{"src-2": true}})
+
+	result := view.loadSyncStates()()
+
+	loaded, ok := result.(messages.SyncStatesLoaded)
+	require.True(t, ok)
+	require.NoError(t, loaded.Err)
+	assert.Equal(t, statuses, loaded.Statuses)
+	assert.Equal(t, map[string]bool{"src-2": true}, loaded.Syncing)
+}
+
+func TestView_LoadSyncStates_Error(t *testing.T) {
+	mock := &MockSourceService{
+		StatusesFunc: func(ctx context.Context) ([]domain.SourceStatus, error) {
+			return nil, errors.New("database locked")
+		},
+	}
+	view := NewView(nil, mock, nil)
+
+	result := view.loadSyncStates()()
+
+	loaded, ok := result.(messages.SyncStatesLoaded)
+	require.True(t, ok)
+	assert.Error(t, loaded.Err)
+}
+
+func TestView_View_SyncStatus(t *testing.T) {
+	s := styles.DefaultStyles()
+	view := NewView(s, nil, nil)
+	view.SetDimensions(120, 24)
+	view.sources = []domain.Source{
+		{ID: "synced", Name: "Notes", Type: "filesystem"},
+		{ID: "syncing", Name: "Repos", Type: "github"},
+		{ID: "failed", Name: "Mail", Type: "gmail"},
+		{ID: "never", Name: "Drive", Type: "google-drive"},
+	}
+
+	view.Update(messages.SyncStatesLoaded{
+		Statuses: []domain.SourceStatus{
+			{SourceID: "synced", LastSync: time.Now().Add(-3 * time.Hour), DocumentCount: 42},
+			{SourceID: "syncing", LastSync: time.Now().Add(-2 * time.Minute), DocumentCount: 1},
+			{SourceID: "failed", LastSync: time.Now().Add(-50 * time.Hour), LastError: "token expired"},
+			{SourceID: "never"},
+		},
+		Syncing: map[string]bool{"syncing": true},
+	})
+	lines := strings.Split(view.View(), "\n")
+
+	require.GreaterOrEqual(t, len(lines), 6)
+	assert.Contains(t, lines[2], "[fs]")
+	assert.Contains(t, lines[2], "🟢 42 docs · 3h ago")
+	assert.Contains(t, lines[3], "[gh]")
+	assert.Contains(t, lines[3], "🟡 1 doc · 2m ago")
+	assert.Contains(t, lines[4], "[gmail]")
+	assert.Contains(t, lines[4], "🔴 0 docs · 2d ago")
+	assert.Contains(t, lines[5], "[gdrive]")
+	assert.Contains(t, lines[5], "⚫ 0 docs · never synced")
+}
+
+func TestView_Update_SyncStatesLoaded_ErrorKeepsSources(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, nil)
+	view.SetDimensions(80, 24)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Notes", Type: "filesystem"}}
+
+	view.Update(messages.SyncStatesLoaded{Err: errors.New("database locked")})
+
+	assert.NoError(t, view.Err())
+	assert.Contains(t, view.View(), "Notes")
+}
+
+func TestTimeSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		then     time.Time
+		expected string
+	}{
+		{time.Time{}, "never synced"},
+		{now.Add(-30 * time.Second), "just now"},
+		{now.Add(-5 * time.Minute), "5m ago"},
+		{now.Add(-90 * time.Minute), "1h ago"},
+		{now.Add(-72 * time.Hour), "3d ago"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, timeSince(tt.then, now))
+	}
+}
+
+// MockConnectorRegistry implements the driving.ConnectorRegistry lookups used for grouping.
+type MockConnectorRegistry struct {
+	driving.ConnectorRegistry
+	connectors []domain.ConnectorType
+}
+
+func (m *MockConnectorRegistry) List() []domain.ConnectorType {
+	return m.connectors
+}
+
+func (m *MockConnectorRegistry) ListByProvider(provider domain.ProviderType) []domain.ConnectorType {
+	var result []domain.ConnectorType
+	for _, c := range m.connectors {
+		if c.ProviderType == provider {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func newGroupedTestView() *View {
+	view := NewView(styles.DefaultStyles(), nil, nil)
+	view.SetConnectorRegistry(&MockConnectorRegistry{connectors: []domain.ConnectorType{
+		{ID: "filesystem", Name: "Local Filesystem", ProviderType: domain.ProviderLocal},
+		{ID: "gmail", Name: "Gmail", ProviderType: domain.ProviderGoogle},
+		{ID: "google-drive", Name: "Google Drive", ProviderType: domain.ProviderGoogle},
+	}})
+	view.sources = []domain.Source{
+		{ID: "src-1", Name: "Drive", Type: "google-drive"},
+		{ID: "src-2", Name: "Notes", Type: "filesystem"},
+		{ID: "src-3", Name: "Mail", Type: "gmail"},
+		{ID: "src-4", Name: "Legacy", Type: "custom"},
+	}
+	return view
+}
+
+func TestView_GroupByProvider(t *testing.T) {
+	view := newGroupedTestView()
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	require.True(t, view.Grouped())
+
+	// Providers by name, connectors in registry order, unknown types last
+	assert.Equal(t, []int{2, 0, 1, 3}, view.displayOrder())
+
+	output := view.View()
+	google := strings.Index(output, "Google")
+	local := strings.Index(output, "Local")
+	other := strings.Index(output, "Other")
+	assert.True(t, google >= 0 && google < local && local < other)
+	assert.Contains(t, output, "├─ ")
+	assert.Contains(t, output, "└─ ")
+}
+
+func TestView_GroupByProvider_KeepsSelection(t *testing.T) {
+	view := newGroupedTestView()
+	view.selected = 1 // src-2
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	assert.Equal(t, 2, view.selected)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	selected, ok := cmd().(messages.SourceSelected)
+	require.True(t, ok)
+	assert.Equal(t, "src-2", selected.Source.ID)
+
+	// Toggling back restores the flat order
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	assert.False(t, view.Grouped())
+	assert.Equal(t, 1, view.selected)
+}
+
+func TestView_GroupByProvider_RequiresRegistry(t *testing.T) {
+	view := NewView(nil, nil, nil)
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+
+	assert.False(t, view.Grouped())
+}
+
+func TestView_GroupByCollection(t *testing.T) {
+	view := newGroupedTestView()
+	view.sources[1].Collection = "Work"  // src-2
+	view.sources[2].Collection = "home"  // src-3
+	view.sources[3].Collection = " work" // src-4
+	view.selected = 1                    // src-2
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	require.True(t, view.GroupedByCollection())
+
+	// Collections by name, sources in no collection last
+	assert.Equal(t, []int{2, 1, 3, 0}, view.displayOrder())
+	assert.Equal(t, 1, view.selected, "src-2 stays selected")
+
+	output := view.View()
+	home := strings.Index(output, "home")
+	work := strings.Index(output, "Work")
+	none := strings.Index(output, "No collection")
+	assert.True(t, home >= 0 && home < work && work < none)
+
+	// Grouping by provider replaces grouping by collection
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	assert.False(t, view.GroupedByCollection())
+	assert.True(t, view.Grouped())
+}
+
+func TestView_ArchivedSection(t *testing.T) {
+	view := newGroupedTestView()
+	view.width = 80
+	view.sources[0].Archived = true // src-1
+
+	assert.Equal(t, []int{1, 2, 3, 0}, view.displayOrder())
+	output := view.View()
+	archived := strings.Index(output, "Archived")
+	require.GreaterOrEqual(t, archived, 0)
+	assert.Greater(t, strings.Index(output, "Drive"), archived)
+	assert.Less(t, strings.Index(output, "Notes"), archived)
+
+	// Grouping leaves archived sources in their own section
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	assert.Equal(t, []int{2, 1, 3, 0}, view.displayOrder())
+	assert.NotContains(t, view.groupSources()[0].indices, 0)
+}
+
+func TestView_Update_KeyMsg_Archive(t *testing.T) {
+	mock := &MockSourceService{}
+	view := NewView(styles.DefaultStyles(), mock, nil)
+	view.sources = []domain.Source{
+		{ID: "src-1", Name: "Drive", Type: "google-drive"},
+		{ID: "src-2", Name: "Notes", Type: "filesystem"},
+	}
+
+	// Archiving moves the source to the end, keeping it selected
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	assert.Equal(t, messages.SourceArchived{ID: "src-1", Archived: true}, msg)
+	_, _ = view.Update(msg)
+	assert.Equal(t, []string{"src-1"}, mock.archived)
+	assert.True(t, view.Sources()[0].Archived)
+	assert.Equal(t, 1, view.SelectedIndex())
+
+	// Pressing it again unarchives
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	require.NotNil(t, cmd)
+	_, _ = view.Update(cmd())
+	assert.Equal(t, []string{"src-1"}, mock.unarchived)
+	assert.False(t, view.Sources()[0].Archived)
+	assert.Equal(t, 0, view.SelectedIndex())
+}
+
+func TestView_Update_SourceArchived_Error(t *testing.T) {
+	mock := &MockSourceService{ArchiveFunc: func(context.Context, string) error { return domain.ErrNotFound }}
+	view := NewView(styles.DefaultStyles(), mock, nil)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Drive", Type: "google-drive"}}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	require.NotNil(t, cmd)
+	_, _ = view.Update(cmd())
+
+	assert.ErrorIs(t, view.Err(), domain.ErrNotFound)
+	assert.False(t, view.Sources()[0].Archived)
+}
+
+func TestView_Update_KeyMsg_Rename(t *testing.T) {
+	var renamed []string
+	mock := &MockSourceService{RenameFunc: func(_ context.Context, id, newName string) ([]domain.Source, error) {
+		renamed = append(renamed, id+"="+newName)
+		if newName == "Notes" {
+			return []domain.Source{{ID: "src-2", Name: "Notes"}}, nil
+		}
+		return nil, nil
+	}}
+	view := NewView(styles.DefaultStyles(), mock, nil)
+	view.sources = []domain.Source{
+		{ID: "src-1", Name: "/home/user/drive", Type: "filesystem"},
+		{ID: "src-2", Name: "Notes", Type: "filesystem"},
+	}
+
+	// The prompt starts with the current name
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	require.True(t, view.Renaming())
+	assert.Equal(t, "/home/user/drive", view.renameInput.Value())
+	assert.Contains(t, view.View(), "Rename:")
+
+	view.renameInput.SetValue("  Drive ")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.False(t, view.Renaming())
+	_, _ = view.Update(cmd())
+	assert.Equal(t, "Drive", view.Sources()[0].Name)
+	assert.NotContains(t, view.View(), "also named")
+
+	// A shared name is applied with a warning
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	view.renameInput.SetValue("Notes")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, _ = view.Update(cmd())
+	assert.Equal(t, "Notes", view.Sources()[0].Name)
+	assert.Contains(t, view.View(), `Another source is also named "Notes".`)
+
+	assert.Equal(t, []string{"src-1=Drive", "src-1=Notes"}, renamed)
+}
+
+func TestView_Update_KeyMsg_RenameCancelAndEmpty(t *testing.T) {
+	mock := &MockSourceService{RenameFunc: func(context.Context, string, string) ([]domain.Source, error) {
+		t.Fatal("rename should not be called")
+		return nil, nil
+	}}
+	view := NewView(styles.DefaultStyles(), mock, nil)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Drive", Type: "filesystem"}}
+
+	// Typing goes to the prompt rather than triggering actions
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	assert.Equal(t, "Drivex", view.renameInput.Value())
+	assert.Nil(t, mock.archived)
+
+	// An empty name keeps the prompt open
+	view.renameInput.SetValue("   ")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.True(t, view.Renaming())
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, view.Renaming())
+	assert.Equal(t, "Drive", view.Sources()[0].Name)
+}
+
+func TestView_Update_SourceRenamed_Error(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), &MockSourceService{}, nil)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Drive", Type: "filesystem"}}
+
+	_, _ = view.Update(messages.SourceRenamed{ID: "src-1", Name: "New", Err: domain.ErrNotFound})
+
+	assert.ErrorIs(t, view.Err(), domain.ErrNotFound)
+	assert.Equal(t, "Drive", view.Sources()[0].Name)
+}
+
+// newSyncTestView returns a view of sources synced at different times.
+func newSyncTestView() *View {
+	view := NewView(styles.DefaultStyles(), nil, nil)
+	view.SetDimensions(120, 24)
+	view.sources = []domain.Source{
+		{ID: "recent", Name: "Notes", Type: "filesystem"},
+		{ID: "never", Name: "Drive", Type: "google-drive"},
+		{ID: "stale", Name: "Mail", Type: "gmail"},
+		{ID: "archived", Name: "Old", Type: "filesystem", Archived: true},
+	}
+	view.Update(messages.SyncStatesLoaded{Statuses: []domain.SourceStatus{
+		{SourceID: "recent", LastSync: time.Now().Add(-time.Hour)},
+		{SourceID: "never"},
+		{SourceID: "stale", LastSync: time.Now().Add(-10 * 24 * time.Hour)},
+		{SourceID: "archived", LastSync: time.Now().Add(-30 * 24 * time.Hour)},
+	}})
+	return view
+}
+
+func TestView_SortByLastSync(t *testing.T) {
+	view := newSyncTestView()
+	view.selected = 2 // stale
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+
+	// Never synced first, then least recently synced
+	assert.Equal(t, []int{1, 2, 0, 3}, view.displayOrder())
+	assert.Equal(t, 1, view.SelectedIndex(), "selection follows the source")
+	assert.Contains(t, view.View(), "Showing: sorted by last sync")
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	assert.Equal(t, []int{0, 1, 2, 3}, view.displayOrder())
+}
+
+func TestView_FilterBySync(t *testing.T) {
+	view := newSyncTestView()
+	press := func(r rune) { _, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}) }
+
+	press('f')
+	assert.Equal(t, []int{1}, view.displayOrder(), "never synced, without archived sources")
+	assert.Contains(t, view.View(), "Showing: never synced")
+
+	press('f')
+	assert.Equal(t, []int{1, 2}, view.displayOrder(), "not synced in over 7 days")
+	assert.Contains(t, view.View(), "not synced in over 7 days")
+	assert.Contains(t, view.View(), "[+/-] days")
+
+	// Widening the period past the stale source leaves only the never synced one
+	for range 4 {
+		press('+')
+	}
+	assert.Equal(t, []int{1}, view.displayOrder())
+	assert.Contains(t, view.View(), "not synced in over 11 days")
+
+	press('f')
+	assert.Equal(t, []int{0, 1, 2, 3}, view.displayOrder())
+	assert.NotContains(t, view.View(), "Showing:")
+}
+
+func TestView_FilterBySync_NoMatches(t *testing.T) {
+	view := newSyncTestView()
+	view.sources = view.sources[:1] // only the recently synced source
+
+	_, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+
+	assert.Empty(t, view.displayOrder())
+	assert.Contains(t, view.View(), "No sources match the filter.")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+}
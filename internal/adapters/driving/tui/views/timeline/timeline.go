@@ -0,0 +1,350 @@
+// Package timeline provides the cross-source timeline view for the TUI.
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Window is how far back the timeline reaches.
+type Window int
+
+const (
+	WindowDay Window = iota
+	WindowWeek
+	WindowMonth
+	WindowAll
+)
+
+// windows lists the windows in the order "w" cycles through them.
+var windows = []Window{WindowDay, WindowWeek, WindowMonth, WindowAll}
+
+// String returns the label shown in the view title.
+func (w Window) String() string {
+	switch w {
+	case WindowDay:
+		return "last 24 hours"
+	case WindowWeek:
+		return "last 7 days"
+	case WindowMonth:
+		return "last 30 days"
+	case WindowAll:
+		return "all time"
+	default:
+		return "unknown"
+	}
+}
+
+// duration returns how far back the window reaches; zero for all time.
+func (w Window) duration() time.Duration {
+	switch w {
+	case WindowDay:
+		return 24 * time.Hour
+	case WindowWeek:
+		return 7 * 24 * time.Hour
+	case WindowMonth:
+		return 30 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// View is the timeline view.
+type View struct {
+	styles          *styles.Styles
+	keys            *keymap.KeyMap
+	timelineService driving.TimelineService
+
+	window       Window
+	entries      []domain.TimelineEntry
+	selected     int
+	scrollOffset int
+	width        int
+	height       int
+	ready        bool
+	err          error
+	loading      bool
+
+	// now returns the current time; replaced in tests.
+	now func() time.Time
+}
+
+// NewView creates a new timeline view.
+func NewView(s *styles.Styles, timelineService driving.TimelineService) *View {
+	if s == nil {
+		s = styles.DefaultStyles()
+	}
+	return &View{
+		styles:          s,
+		keys:            keymap.DefaultKeyMap(),
+		timelineService: timelineService,
+		window:          WindowWeek,
+		entries:         []domain.TimelineEntry{},
+		now:             time.Now,
+	}
+}
+
+// SetKeyMap sets the keybindings the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	if km != nil {
+		v.keys = km
+	}
+}
+
+// Init resets the selection and loads the timeline.
+func (v *View) Init() tea.Cmd {
+	v.selected = 0
+	v.scrollOffset = 0
+	v.err = nil
+	v.loading = true
+	return v.loadTimeline()
+}
+
+// loadTimeline returns a command that loads the entries in the window.
+func (v *View) loadTimeline() tea.Cmd {
+	opts := domain.TimelineOptions{}
+	if d := v.window.duration(); d > 0 {
+		opts.Since = v.now().Add(-d)
+	}
+	return func() tea.Msg {
+		if v.timelineService == nil {
+			return messages.TimelineLoaded{Err: fmt.Errorf("timeline service not available")}
+		}
+		entries, err := v.timelineService.Timeline(context.Background(), opts)
+		return messages.TimelineLoaded{Entries: entries, Err: err}
+	}
+}
+
+// Update handles messages for the timeline view.
+func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		v.width = msg.Width
+		v.height = msg.Height
+		v.ready = true
+		return v, nil
+
+	case tea.KeyMsg:
+		return v.handleKeyMsg(msg)
+
+	case messages.TimelineLoaded:
+		v.loading = false
+		if msg.Err != nil {
+			v.err = msg.Err
+		} else {
+			v.entries = msg.Entries
+			v.err = nil
+			if v.selected >= len(v.entries) {
+				v.selected = max(len(v.entries)-1, 0)
+			}
+			v.adjustScroll()
+		}
+		return v, nil
+
+	case messages.ErrorOccurred:
+		v.err = msg.Err
+		return v, nil
+	}
+
+	return v, nil
+}
+
+// handleKeyMsg handles keyboard input.
+func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
+	switch {
+	case key.Matches(msg, v.keys.Up):
+		if v.selected > 0 {
+			v.selected--
+			v.adjustScroll()
+		}
+		return v, nil
+	case key.Matches(msg, v.keys.Down):
+		if v.selected < len(v.entries)-1 {
+			v.selected++
+			v.adjustScroll()
+		}
+		return v, nil
+	case key.Matches(msg, v.keys.Select):
+		if v.selected < len(v.entries) {
+			doc := v.entries[v.selected].Document
+			return v, func() tea.Msg {
+				return messages.DocumentSelected{Document: doc}
+			}
+		}
+		return v, nil
+	case key.Matches(msg, v.keys.Back):
+		return v, func() tea.Msg {
+			return messages.ViewChanged{View: messages.ViewMenu}
+		}
+	}
+
+	switch msg.String() {
+	case "w":
+		// Cycle the window and reload
+		v.window = windows[(int(v.window)+1)%len(windows)]
+		return v, v.Init()
+	case "r":
+		// Reload, keeping the selection
+		v.loading = true
+		return v, v.loadTimeline()
+	}
+
+	return v, nil
+}
+
+// adjustScroll adjusts the scroll offset to keep the selected item visible.
+func (v *View) adjustScroll() {
+	visibleItems := v.visibleItemCount()
+	if v.selected < v.scrollOffset {
+		v.scrollOffset = v.selected
+	} else if v.selected >= v.scrollOffset+visibleItems {
+		v.scrollOffset = v.selected - visibleItems + 1
+	}
+}
+
+// visibleItemCount returns the number of items that can be displayed.
+func (v *View) visibleItemCount() int {
+	// Reserve lines for title, separator, help, and padding
+	reserved := 8
+	available := v.height - reserved
+	if available < 1 {
+		available = 1
+	}
+	return available
+}
+
+// View renders the timeline view.
+func (v *View) View() string {
+	var b strings.Builder
+
+	title := fmt.Sprintf("Timeline - %s (%d)", v.window, len(v.entries))
+	b.WriteString(v.styles.Title.Render(title))
+	b.WriteString("\n\n")
+
+	// Loading state
+	if v.loading {
+		b.WriteString(v.styles.Muted.Render("Loading timeline..."))
+		b.WriteString("\n\n")
+		b.WriteString(v.renderHelp())
+		return b.String()
+	}
+
+	// Error state
+	if v.err != nil {
+		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Error: %s", v.err.Error())))
+		b.WriteString("\n\n")
+		b.WriteString(v.renderHelp())
+		return b.String()
+	}
+
+	// Empty state
+	if len(v.entries) == 0 {
+		b.WriteString(v.styles.Muted.Render("No documents updated in this window."))
+		b.WriteString("\n\n")
+		b.WriteString(v.renderHelp())
+		return b.String()
+	}
+
+	// Entries list
+	visibleItems := v.visibleItemCount()
+	for i := v.scrollOffset; i < len(v.entries) && i < v.scrollOffset+visibleItems; i++ {
+		b.WriteString(v.renderEntry(i, &v.entries[i]))
+		b.WriteString("\n")
+	}
+
+	// Scroll indicator
+	if len(v.entries) > visibleItems {
+		b.WriteString("\n")
+		b.WriteString(v.styles.Muted.Render(fmt.Sprintf("  [%d-%d of %d]",
+			v.scrollOffset+1,
+			min(v.scrollOffset+visibleItems, len(v.entries)),
+			len(v.entries))))
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(v.renderHelp())
+
+	return b.String()
+}
+
+// renderEntry renders a single timeline line: when the document was
+// updated, its source and kind, and its title.
+func (v *View) renderEntry(index int, entry *domain.TimelineEntry) string {
+	indicator := "  "
+	if index == v.selected {
+		indicator = "> "
+	}
+
+	when := entry.Document.UpdatedAt.Local().Format("2006-01-02 15:04")
+
+	tag := entry.SourceName
+	if kind := entry.Kind(); kind != "" {
+		tag += " · " + kind
+	}
+	tag = "[" + tag + "]"
+
+	title := entry.Document.Title
+	if title == "" {
+		title = entry.Document.URI
+	}
+
+	// Truncate title to the space left after the time and tag
+	maxTitleLen := v.width - len(indicator) - len(when) - len([]rune(tag)) - 4
+	if maxTitleLen < 10 {
+		maxTitleLen = 10
+	}
+	if runes := []rune(title); len(runes) > maxTitleLen {
+		title = string(runes[:maxTitleLen-3]) + "..."
+	}
+
+	if index == v.selected {
+		return v.styles.Selected.Render(fmt.Sprintf("%s%s  %s  %s", indicator, when, tag, title))
+	}
+
+	return v.styles.Normal.Render(indicator) +
+		v.styles.Muted.Render(when+"  "+tag+"  ") +
+		v.styles.Normal.Render(title)
+}
+
+// renderHelp renders the help footer.
+func (v *View) renderHelp() string {
+	return v.styles.Help.Render("[↑/↓] navigate  [enter] view content  [w] window  [r] reload  [esc] back")
+}
+
+// SetDimensions sets the view dimensions.
+func (v *View) SetDimensions(width, height int) {
+	v.width = width
+	v.height = height
+	v.ready = true
+}
+
+// Entries returns the current timeline entries.
+func (v *View) Entries() []domain.TimelineEntry {
+	return v.entries
+}
+
+// SelectedIndex returns the currently selected entry index.
+func (v *View) SelectedIndex() int {
+	return v.selected
+}
+
+// Window returns the current window.
+func (v *View) Window() Window {
+	return v.window
+}
+
+// Err returns the last error that occurred.
+func (v *View) Err() error {
+	return v.err
+}
@@ -0,0 +1,138 @@
+package timeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// MockTimelineService implements driving.TimelineService for testing.
+type MockTimelineService struct {
+	entries []domain.TimelineEntry
+	err     error
+	opts    []domain.TimelineOptions
+}
+
+func (m *MockTimelineService) Timeline(_ context.Context, opts domain.TimelineOptions) ([]domain.TimelineEntry, error) {
+	m.opts = append(m.opts, opts)
+	return m.entries, m.err
+}
+
+func testEntries() []domain.TimelineEntry {
+	updated := time.Date(2025, 3, 4, 10, 30, 0, 0, time.UTC)
+	return []domain.TimelineEntry{
+		{
+			Document: domain.Document{
+				ID: "doc-1", Title: "Fix login", UpdatedAt: updated,
+				Metadata: map[string]any{domain.MetadataDocumentType: "pull_request"},
+			},
+			SourceName: "Work repos", SourceType: "github",
+		},
+		{
+			Document:   domain.Document{ID: "doc-2", Title: "notes.md", UpdatedAt: updated.Add(-time.Hour)},
+			SourceName: "Notes", SourceType: "filesystem",
+		},
+	}
+}
+
+func loadedView(t *testing.T, service *MockTimelineService) *View {
+	t.Helper()
+	view := NewView(nil, service)
+	view.SetDimensions(120, 40)
+	cmd := view.Init()
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+	return view
+}
+
+func TestView_Init_LoadsWeekByDefault(t *testing.T) {
+	now := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	service := &MockTimelineService{entries: testEntries()}
+	view := NewView(nil, service)
+	view.now = func() time.Time { return now }
+
+	msg := view.Init()()
+	loaded, ok := msg.(messages.TimelineLoaded)
+	require.True(t, ok)
+	assert.Len(t, loaded.Entries, 2)
+
+	require.Len(t, service.opts, 1)
+	assert.Equal(t, now.Add(-7*24*time.Hour), service.opts[0].Since)
+	assert.True(t, service.opts[0].Until.IsZero())
+}
+
+func TestView_WindowCycles(t *testing.T) {
+	service := &MockTimelineService{}
+	view := loadedView(t, service)
+	assert.Equal(t, WindowWeek, view.Window())
+
+	for _, want := range []Window{WindowMonth, WindowAll, WindowDay, WindowWeek} {
+		_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+		require.NotNil(t, cmd)
+		cmd()
+		assert.Equal(t, want, view.Window())
+	}
+
+	// The all time window has no lower bound
+	assert.True(t, service.opts[2].Since.IsZero())
+	assert.False(t, service.opts[3].Since.IsZero())
+}
+
+func TestView_EnterSelectsDocument(t *testing.T) {
+	view := loadedView(t, &MockTimelineService{entries: testEntries()})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, view.SelectedIndex())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	selected, ok := cmd().(messages.DocumentSelected)
+	require.True(t, ok)
+	assert.Equal(t, "doc-2", selected.Document.ID)
+}
+
+func TestView_EscReturnsToMenu(t *testing.T) {
+	view := loadedView(t, &MockTimelineService{})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.Equal(t, messages.ViewChanged{View: messages.ViewMenu}, cmd())
+}
+
+func TestView_RendersTags(t *testing.T) {
+	view := loadedView(t, &MockTimelineService{entries: testEntries()})
+
+	output := view.View()
+	assert.Contains(t, output, "Timeline - last 7 days (2)")
+	assert.Contains(t, output, "[Work repos · pull_request]")
+	assert.Contains(t, output, "[Notes · filesystem]")
+	assert.Contains(t, output, "Fix login")
+}
+
+func TestView_Error(t *testing.T) {
+	view := loadedView(t, &MockTimelineService{err: errors.New("database locked")})
+
+	assert.Error(t, view.Err())
+	assert.Contains(t, view.View(), "database locked")
+}
+
+func TestView_NilService(t *testing.T) {
+	view := NewView(nil, nil)
+	view.Update(view.Init()())
+
+	assert.Error(t, view.Err())
+}
+
+func TestView_Empty(t *testing.T) {
+	view := loadedView(t, &MockTimelineService{})
+
+	assert.Contains(t, view.View(), "No documents updated in this window.")
+}
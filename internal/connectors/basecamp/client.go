@@ -0,0 +1,215 @@
+package basecamp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+const (
+	// apiBaseURL is the Basecamp 3 API base URL; the account ID is appended.
+	apiBaseURL = "https://3.basecampapi.com"
+	// userAgent identifies the app, as Basecamp requires for every request.
+	userAgent = "Sercha (https://github.com/custodia-labs/sercha-cli)"
+)
+
+// Error types for Basecamp API responses.
+var (
+	// ErrUnauthorised indicates the access token is invalid or expired.
+	ErrUnauthorised = errors.New("basecamp: unauthorised")
+	// ErrNotFound indicates the requested project or recording does not exist.
+	ErrNotFound = errors.New("basecamp: not found")
+	// ErrRateLimited indicates the request was throttled by Basecamp.
+	ErrRateLimited = errors.New("basecamp: rate limited")
+	// ErrTooLarge indicates a download exceeded the size limit.
+	ErrTooLarge = errors.New("basecamp: file too large")
+)
+
+// Client is a minimal Basecamp 3 API client for a single account.
+type Client struct {
+	baseURL       string
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	rateLimiter   *RateLimiter
+}
+
+// NewClient creates a Basecamp client for the given account and token provider.
+func NewClient(accountID string, tokenProvider driven.TokenProvider) *Client {
+	return &Client{
+		baseURL:       apiBaseURL + "/" + accountID,
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 60 * time.Second},
+		rateLimiter:   NewRateLimiter(),
+	}
+}
+
+// Projects returns the active projects visible to the user.
+func (c *Client) Projects(ctx context.Context) ([]Project, error) {
+	return getAll[Project](ctx, c, "/projects.json")
+}
+
+// Project returns a single project by ID.
+func (c *Client) Project(ctx context.Context, projectID string) (*Project, error) {
+	var project Project
+	if _, err := c.get(ctx, c.baseURL+"/projects/"+projectID+".json", &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// Messages returns the posts on a message board.
+func (c *Client) Messages(ctx context.Context, projectID, boardID int64) ([]Recording, error) {
+	return getAll[Recording](ctx, c, fmt.Sprintf("/buckets/%d/message_boards/%d/messages.json", projectID, boardID))
+}
+
+// CampfireLines returns the lines posted in a Campfire chat.
+func (c *Client) CampfireLines(ctx context.Context, projectID, chatID int64) ([]Recording, error) {
+	return getAll[Recording](ctx, c, fmt.Sprintf("/buckets/%d/chats/%d/lines.json", projectID, chatID))
+}
+
+// Todolists returns the todo lists in a todo set.
+func (c *Client) Todolists(ctx context.Context, projectID, todosetID int64) ([]Recording, error) {
+	return getAll[Recording](ctx, c, fmt.Sprintf("/buckets/%d/todosets/%d/todolists.json", projectID, todosetID))
+}
+
+// Todos returns the pending or completed todos in a todo list.
+func (c *Client) Todos(ctx context.Context, projectID, todolistID int64, completed bool) ([]Recording, error) {
+	path := fmt.Sprintf("/buckets/%d/todolists/%d/todos.json", projectID, todolistID)
+	if completed {
+		path += "?completed=true"
+	}
+	return getAll[Recording](ctx, c, path)
+}
+
+// Vaults returns the folders inside a vault.
+func (c *Client) Vaults(ctx context.Context, projectID, vaultID int64) ([]Recording, error) {
+	return getAll[Recording](ctx, c, fmt.Sprintf("/buckets/%d/vaults/%d/vaults.json", projectID, vaultID))
+}
+
+// Documents returns the documents in a vault.
+func (c *Client) Documents(ctx context.Context, projectID, vaultID int64) ([]Recording, error) {
+	return getAll[Recording](ctx, c, fmt.Sprintf("/buckets/%d/vaults/%d/documents.json", projectID, vaultID))
+}
+
+// Uploads returns the uploaded files in a vault.
+func (c *Client) Uploads(ctx context.Context, projectID, vaultID int64) ([]Recording, error) {
+	return getAll[Recording](ctx, c, fmt.Sprintf("/buckets/%d/vaults/%d/uploads.json", projectID, vaultID))
+}
+
+// Comments returns the comments on a recording, oldest first.
+func (c *Client) Comments(ctx context.Context, projectID, recordingID int64) ([]Recording, error) {
+	return getAll[Recording](ctx, c, fmt.Sprintf("/buckets/%d/recordings/%d/comments.json", projectID, recordingID))
+}
+
+// Download fetches a file, failing with ErrTooLarge if it exceeds maxSize bytes.
+func (c *Client) Download(ctx context.Context, downloadURL string, maxSize int64) ([]byte, error) {
+	resp, err := c.do(ctx, downloadURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read download: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+// getAll fetches every page of a list endpoint, following Link headers.
+func getAll[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var all []T
+	next := c.baseURL + path
+	for next != "" {
+		var page []T
+		link, err := c.get(ctx, next, &page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		next = link
+	}
+	return all, nil
+}
+
+// get performs an authenticated GET request, decodes the JSON response into
+// out and returns the URL of the next page, if any.
+func (c *Client) get(ctx context.Context, reqURL string, out any) (string, error) {
+	resp, err := c.do(ctx, reqURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return "", fmt.Errorf("decode %s response: %w", resp.Request.URL.Path, err)
+	}
+	return nextPage(resp.Header.Get("Link")), nil
+}
+
+// do performs an authenticated GET request and checks the response status.
+// The caller must close the body of a successful response.
+func (c *Client) do(ctx context.Context, reqURL string) (*http.Response, error) {
+	token, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get token: %w", err)
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s: %w", req.URL.Path, err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return nil, ErrUnauthorised
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", req.URL.Path, ErrNotFound)
+	case http.StatusTooManyRequests:
+		secs, _ := strconv.Atoi(resp.Header.Get("Retry-After")) //nolint:errcheck // zero uses the default
+		c.rateLimiter.RecordRateLimitError(time.Duration(secs) * time.Second)
+		return nil, ErrRateLimited
+	default:
+		return nil, fmt.Errorf("request %s failed: status %d", req.URL.Path, resp.StatusCode)
+	}
+}
+
+// nextPage extracts the rel="next" URL from a Link header.
+func nextPage(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		target, params, ok := strings.Cut(part, ";")
+		if !ok || !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(target), "<>")
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package basecamp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextPage(t *testing.T) {
+	assert.Equal(t, "https://3.basecampapi.com/999/projects.json?page=2",
+		nextPage(`<https://3.basecampapi.com/999/projects.json?page=2>; rel="next"`))
+	assert.Equal(t, "https://example.com/b",
+		nextPage(`<https://example.com/a>; rel="prev", <https://example.com/b>; rel="next"`))
+	assert.Empty(t, nextPage(""))
+	assert.Empty(t, nextPage(`<https://example.com/a>; rel="prev"`))
+}
+
+func TestClient_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient("999", &mockTokenProvider{token: "token"})
+	c.baseURL = server.URL
+
+	_, err := c.Projects(context.Background())
+
+	require.ErrorIs(t, err, ErrRateLimited)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), c.rateLimiter.retryAt, 5*time.Second)
+}
+
+func TestClient_DownloadTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("0123456789"))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient("999", &mockTokenProvider{token: "token"})
+
+	data, err := c.Download(context.Background(), server.URL, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(data))
+
+	_, err = c.Download(context.Background(), server.URL, 9)
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
@@ -0,0 +1,99 @@
+package basecamp
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Content types that can be synced.
+const (
+	ContentMessages  = "messages"
+	ContentTodos     = "todos"
+	ContentDocuments = "documents"
+	ContentComments  = "comments"
+)
+
+// ErrMissingAccountID indicates the source has no Basecamp account ID configured.
+var ErrMissingAccountID = errors.New("basecamp: account_id is required")
+
+// Config holds Basecamp connector configuration.
+type Config struct {
+	// AccountID is the Basecamp account (the number in 3.basecamp.com/{id}).
+	AccountID string
+	// ProjectIDs limits syncing to specific projects. If empty, all active
+	// projects visible to the user are synced.
+	ProjectIDs []string
+	// ContentTypes lists the content to sync: messages (message board posts
+	// and Campfire lines), todos, documents, and comments. Comments are
+	// appended to the recording they belong to.
+	ContentTypes []string
+	// IncludeAttachments indexes text-readable files from Docs & Files.
+	IncludeAttachments bool
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		ContentTypes: []string{ContentMessages, ContentTodos, ContentDocuments, ContentComments},
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse account_id (required)
+	cfg.AccountID = strings.TrimSpace(source.Config["account_id"])
+	if cfg.AccountID == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	// Parse project_ids
+	if val := source.Config["project_ids"]; val != "" {
+		cfg.ProjectIDs = splitList(val)
+	}
+
+	// Parse content_types
+	if val := source.Config["content_types"]; val != "" {
+		types := splitList(strings.ToLower(val))
+		for _, t := range types {
+			if !slices.Contains(DefaultConfig().ContentTypes, t) {
+				return nil, fmt.Errorf("basecamp: unknown content type %q", t)
+			}
+		}
+		cfg.ContentTypes = types
+	}
+
+	// Parse include_attachments
+	if val := source.Config["include_attachments"]; val != "" {
+		cfg.IncludeAttachments = parseBool(val)
+	}
+
+	return cfg, nil
+}
+
+// Includes reports whether the content type is enabled.
+func (c *Config) Includes(contentType string) bool {
+	return slices.Contains(c.ContentTypes, contentType)
+}
+
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(val string) []string {
+	var out []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// parseBool accepts "true" and "1" as true; anything else is false.
+func parseBool(val string) bool {
+	val = strings.TrimSpace(strings.ToLower(val))
+	return val == "true" || val == "1"
+}
@@ -0,0 +1,72 @@
+package basecamp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+
+	assert.Empty(t, cfg.AccountID)
+	assert.Empty(t, cfg.ProjectIDs)
+	assert.Equal(t, []string{"messages", "todos", "documents", "comments"}, cfg.ContentTypes)
+	assert.False(t, cfg.IncludeAttachments)
+}
+
+func TestParseConfig_RequiresAccountID(t *testing.T) {
+	for _, val := range []string{"", "   "} {
+		source := domain.Source{Config: map[string]string{"account_id": val}}
+
+		cfg, err := ParseConfig(source)
+
+		require.ErrorIs(t, err, ErrMissingAccountID)
+		assert.Nil(t, cfg)
+	}
+}
+
+func TestParseConfig_Default(t *testing.T) {
+	source := domain.Source{Config: map[string]string{"account_id": " 999 "}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "999", cfg.AccountID)
+	assert.Empty(t, cfg.ProjectIDs)
+	assert.Equal(t, DefaultConfig().ContentTypes, cfg.ContentTypes)
+	assert.False(t, cfg.IncludeAttachments)
+}
+
+func TestParseConfig_AllOptions(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"account_id":          "999",
+		"project_ids":         "1, 2,,",
+		"content_types":       "Messages, comments",
+		"include_attachments": "true",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, cfg.ProjectIDs)
+	assert.Equal(t, []string{"messages", "comments"}, cfg.ContentTypes)
+	assert.True(t, cfg.IncludeAttachments)
+	assert.True(t, cfg.Includes(ContentMessages))
+	assert.False(t, cfg.Includes(ContentTodos))
+}
+
+func TestParseConfig_UnknownContentType(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"account_id":    "999",
+		"content_types": "messages,schedule",
+	}}
+
+	_, err := ParseConfig(source)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "schedule")
+}
@@ -0,0 +1,447 @@
+package basecamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// maxAttachmentSize is the largest upload that is downloaded and indexed (10MB).
+const maxAttachmentSize = 10 * 1024 * 1024
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Connector fetches messages, todos and documents from Basecamp projects.
+type Connector struct {
+	sourceID      string
+	config        *Config
+	tokenProvider driven.TokenProvider
+	client        *Client
+	mu            sync.Mutex
+	closed        bool
+}
+
+// New creates a new Basecamp connector.
+func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
+	return &Connector{
+		sourceID:      sourceID,
+		config:        cfg,
+		tokenProvider: tokenProvider,
+		client:        NewClient(cfg.AccountID, tokenProvider),
+	}
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "basecamp"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    true,
+		SupportsBinary:       false,
+		RequiresAuth:         true,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: true,
+		SupportsPagination:   true,
+	}
+}
+
+// Validate checks that the token can read projects in the configured account.
+func (c *Connector) Validate(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// One page is enough to prove access to the account
+	var projects []Project
+	if _, err := c.client.get(ctx, c.client.baseURL+"/projects.json", &projects); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if errors.Is(err, ErrUnauthorised) {
+			return fmt.Errorf("%w: %w", domain.ErrAuthInvalid, err)
+		}
+		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+	}
+
+	return nil
+}
+
+// FullSync fetches all enabled content from the configured projects.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	// Record the start time so edits during the sync are picked up next time
+	syncStart := time.Now()
+
+	err := c.walkRecordings(ctx, func(project *Project, rec *Recording, kind string) error {
+		doc := c.recordingDocument(ctx, project, rec, kind)
+		if doc == nil {
+			return nil
+		}
+		return c.sendDocument(ctx, docsChan, doc)
+	})
+	if err != nil {
+		return err
+	}
+
+	cursor := NewCursor()
+	cursor.SetLastSyncTime(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches recordings updated since the last sync.
+// Basecamp list endpoints only return active recordings, so trashed or
+// archived content is not detected; a full sync removes it.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	cursor, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+	if cursor.IsEmpty() {
+		return fmt.Errorf("invalid cursor, full sync required: cursor has no last sync time")
+	}
+
+	syncStart := time.Now()
+
+	err = c.walkRecordings(ctx, func(project *Project, rec *Recording, kind string) error {
+		if !rec.UpdatedAt.After(cursor.LastSyncTime) {
+			return nil
+		}
+		doc := c.recordingDocument(ctx, project, rec, kind)
+		if doc == nil {
+			return nil
+		}
+
+		changeType := domain.ChangeUpdated
+		if rec.CreatedAt.After(cursor.LastSyncTime) {
+			changeType = domain.ChangeCreated
+		}
+		return c.sendChange(ctx, changesChan, &domain.RawDocumentChange{Type: changeType, Document: *doc})
+	})
+	if err != nil {
+		return err
+	}
+
+	cursor.SetLastSyncTime(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// visitFunc is called for each recording found while walking the projects.
+type visitFunc func(project *Project, rec *Recording, kind string) error
+
+// walkRecordings calls visit for every enabled recording in the configured projects.
+func (c *Connector) walkRecordings(ctx context.Context, visit visitFunc) error {
+	projects, err := c.listProjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range projects {
+		if err := c.walkProject(ctx, &projects[i], visit); err != nil {
+			return fmt.Errorf("project %d: %w", projects[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// walkProject visits the message board, Campfire, todos and files of a project.
+func (c *Connector) walkProject(ctx context.Context, project *Project, visit visitFunc) error {
+	if c.config.Includes(ContentMessages) {
+		if boardID, ok := project.Tool("message_board"); ok {
+			messages, err := c.client.Messages(ctx, project.ID, boardID)
+			if err != nil {
+				return fmt.Errorf("list messages: %w", err)
+			}
+			if err := visitAll(ctx, project, messages, KindMessages, visit); err != nil {
+				return err
+			}
+		}
+		if chatID, ok := project.Tool("chat"); ok {
+			lines, err := c.client.CampfireLines(ctx, project.ID, chatID)
+			if err != nil {
+				return fmt.Errorf("list campfire lines: %w", err)
+			}
+			if err := visitAll(ctx, project, lines, KindCampfire, visit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if c.config.Includes(ContentTodos) {
+		if todosetID, ok := project.Tool("todoset"); ok {
+			if err := c.walkTodos(ctx, project, todosetID, visit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if c.config.Includes(ContentDocuments) || c.config.IncludeAttachments {
+		if vaultID, ok := project.Tool("vault"); ok {
+			if err := c.walkVault(ctx, project, vaultID, visit); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// walkTodos visits the pending and completed todos of every list in a todo set.
+func (c *Connector) walkTodos(ctx context.Context, project *Project, todosetID int64, visit visitFunc) error {
+	lists, err := c.client.Todolists(ctx, project.ID, todosetID)
+	if err != nil {
+		return fmt.Errorf("list todo lists: %w", err)
+	}
+
+	for i := range lists {
+		for _, completed := range []bool{false, true} {
+			todos, err := c.client.Todos(ctx, project.ID, lists[i].ID, completed)
+			if err != nil {
+				return fmt.Errorf("list todos for list %d: %w", lists[i].ID, err)
+			}
+			if err := visitAll(ctx, project, todos, KindTodos, visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// walkVault visits the documents and text-readable uploads in a Docs & Files
+// vault, descending into its folders.
+func (c *Connector) walkVault(ctx context.Context, project *Project, vaultID int64, visit visitFunc) error {
+	if c.config.Includes(ContentDocuments) {
+		docs, err := c.client.Documents(ctx, project.ID, vaultID)
+		if err != nil {
+			return fmt.Errorf("list documents: %w", err)
+		}
+		if err := visitAll(ctx, project, docs, KindDocuments, visit); err != nil {
+			return err
+		}
+	}
+
+	if c.config.IncludeAttachments {
+		uploads, err := c.client.Uploads(ctx, project.ID, vaultID)
+		if err != nil {
+			return fmt.Errorf("list uploads: %w", err)
+		}
+		readable := uploads[:0]
+		for _, u := range uploads {
+			if isTextReadable(u.ContentType) && u.ByteSize <= maxAttachmentSize {
+				readable = append(readable, u)
+			}
+		}
+		if err := visitAll(ctx, project, readable, KindAttachments, visit); err != nil {
+			return err
+		}
+	}
+
+	folders, err := c.client.Vaults(ctx, project.ID, vaultID)
+	if err != nil {
+		return fmt.Errorf("list folders: %w", err)
+	}
+	for i := range folders {
+		if err := c.walkVault(ctx, project, folders[i].ID, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// visitAll calls visit for each recording, stopping if the context is cancelled.
+func visitAll(ctx context.Context, project *Project, recs []Recording, kind string, visit visitFunc) error {
+	for i := range recs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := visit(project, &recs[i], kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listProjects returns the configured projects, or all active projects.
+func (c *Connector) listProjects(ctx context.Context) ([]Project, error) {
+	if len(c.config.ProjectIDs) == 0 {
+		projects, err := c.client.Projects(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list projects: %w", err)
+		}
+		return projects, nil
+	}
+
+	projects := make([]Project, 0, len(c.config.ProjectIDs))
+	for _, id := range c.config.ProjectIDs {
+		project, err := c.client.Project(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get project %s: %w", id, err)
+		}
+		projects = append(projects, *project)
+	}
+	return projects, nil
+}
+
+// recordingDocument converts a recording, fetching its comments or file
+// content as needed. It returns nil for uploads that cannot be downloaded.
+func (c *Connector) recordingDocument(
+	ctx context.Context, project *Project, rec *Recording, kind string,
+) *domain.RawDocument {
+	if kind == KindAttachments {
+		// Download errors are non-fatal; the file is skipped
+		content, err := c.client.Download(ctx, rec.DownloadURL, maxAttachmentSize)
+		if err != nil {
+			return nil
+		}
+		return UploadToRawDocument(rec, project, c.config.AccountID, content, c.sourceID)
+	}
+
+	// Comment errors are non-fatal; the recording is indexed without them
+	var comments []Recording
+	if c.config.Includes(ContentComments) && rec.CommentsCount > 0 {
+		comments, _ = c.client.Comments(ctx, project.ID, rec.ID) //nolint:errcheck
+	}
+	return RecordingToRawDocument(rec, kind, project, c.config.AccountID, comments, c.sourceID)
+}
+
+// isTextReadable reports whether an upload's content type is plain text
+// or a text-based format.
+func isTextReadable(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	if strings.HasPrefix(mediaType, "text/") {
+		return true
+	}
+	switch mediaType {
+	case "application/json", "application/xml", "application/x-yaml", "application/yaml":
+		return true
+	}
+	return false
+}
+
+// sendDocument sends a document to the channel.
+func (c *Connector) sendDocument(
+	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case docsChan <- *doc:
+		return nil
+	}
+}
+
+// sendChange sends a change to the channel.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	change *domain.RawDocumentChange,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- *change:
+		return nil
+	}
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for Basecamp (webhooks need a public callback URL).
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier fetches the Launchpad email for the authenticated user.
+func (c *Connector) GetAccountIdentifier(ctx context.Context, accessToken string) (string, error) {
+	auth, err := GetAuthorization(ctx, accessToken)
+	if err != nil {
+		return "", err
+	}
+	return auth.Identity.EmailAddress, nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
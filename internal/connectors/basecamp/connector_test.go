@@ -0,0 +1,389 @@
+package basecamp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct {
+	token string
+}
+
+func (p *mockTokenProvider) GetToken(_ context.Context) (string, error) { return p.token, nil }
+func (p *mockTokenProvider) AuthorizationID() string                    { return "test-auth" }
+func (p *mockTokenProvider) AuthMethod() domain.AuthMethod              { return domain.AuthMethodOAuth }
+func (p *mockTokenProvider) IsAuthenticated() bool                      { return p.token != "" }
+
+// fakeBasecamp serves canned responses keyed by request URI.
+type fakeBasecamp struct {
+	routes   map[string]any    // JSON bodies, or []byte for downloads
+	links    map[string]string // request URI of the next page, per request URI
+	requests []string
+}
+
+func (f *fakeBasecamp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.Header.Get("User-Agent") != userAgent {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	uri := r.URL.RequestURI()
+	f.requests = append(f.requests, uri)
+	body, ok := f.routes[uri]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if next, ok := f.links[uri]; ok {
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel="next"`, r.Host, next))
+	}
+	if data, ok := body.([]byte); ok {
+		_, _ = w.Write(data)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// newFakeBasecamp returns account 999 with one fully equipped project and a
+// second, empty project on the next page of the project list.
+func newFakeBasecamp(created, updated time.Time) *fakeBasecamp {
+	project := Project{ID: 1, Name: "Launch", Dock: []DockItem{
+		{ID: 10, Name: "message_board", Enabled: true},
+		{ID: 11, Name: "chat", Enabled: true},
+		{ID: 12, Name: "todoset", Enabled: true},
+		{ID: 13, Name: "vault", Enabled: true},
+		{ID: 99, Name: "schedule", Enabled: true},
+	}}
+	rec := func(id int64) Recording {
+		return Recording{ID: id, CreatedAt: created, UpdatedAt: updated, Creator: Person{Name: "Ada"}}
+	}
+
+	message := rec(100)
+	message.Subject = "Kickoff"
+	message.Content = "<div>Agenda</div>"
+	message.CommentsCount = 1
+	line := rec(101)
+	line.Content = "<div>Morning all</div>"
+	todo := rec(102)
+	todo.Content = "Book venue"
+	done := rec(103)
+	done.Content = "Pick date"
+	done.Completed = true
+	document := rec(104)
+	document.Title = "Brief"
+	document.Content = "<div>Scope</div>"
+	notes := rec(105)
+	notes.Filename = "notes.txt"
+	notes.ContentType = "text/plain"
+	notes.ByteSize = 5
+	notes.DownloadURL = "/download/105"
+	image := rec(106)
+	image.Filename = "logo.png"
+	image.ContentType = "image/png"
+	image.DownloadURL = "/download/106"
+	comment := rec(200)
+	comment.Content = "<div>Sounds good</div>"
+
+	return &fakeBasecamp{
+		routes: map[string]any{
+			"/999/projects.json":                                    []Project{project},
+			"/999/projects.json?page=2":                             []Project{{ID: 2, Name: "Empty"}},
+			"/999/projects/1.json":                                  project,
+			"/999/buckets/1/message_boards/10/messages.json":        []Recording{message},
+			"/999/buckets/1/chats/11/lines.json":                    []Recording{line},
+			"/999/buckets/1/todosets/12/todolists.json":             []Recording{{ID: 20}},
+			"/999/buckets/1/todolists/20/todos.json":                []Recording{todo},
+			"/999/buckets/1/todolists/20/todos.json?completed=true": []Recording{done},
+			"/999/buckets/1/vaults/13/documents.json":               []Recording{document},
+			"/999/buckets/1/vaults/13/uploads.json":                 []Recording{notes, image},
+			"/999/buckets/1/vaults/13/vaults.json":                  []Recording{{ID: 14}},
+			"/999/buckets/1/vaults/14/documents.json":               []Recording{},
+			"/999/buckets/1/vaults/14/uploads.json":                 []Recording{},
+			"/999/buckets/1/vaults/14/vaults.json":                  []Recording{},
+			"/999/buckets/1/recordings/100/comments.json":           []Recording{comment},
+			"/download/105":                                         []byte("notes"),
+			"/download/106":                                         []byte("png"),
+		},
+		links: map[string]string{"/999/projects.json": "/999/projects.json?page=2"},
+	}
+}
+
+func newTestConnector(t *testing.T, fake *fakeBasecamp, cfg *Config, token string) *Connector {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cfg.AccountID = "999"
+	c := New("src-1", cfg, &mockTokenProvider{token: token})
+	c.client.baseURL = server.URL + "/999"
+	// Download URLs are absolute in the API
+	for _, body := range fake.routes {
+		if recs, ok := body.([]Recording); ok {
+			for i := range recs {
+				if strings.HasPrefix(recs[i].DownloadURL, "/") {
+					recs[i].DownloadURL = server.URL + recs[i].DownloadURL
+				}
+			}
+		}
+	}
+	return c
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var out []domain.RawDocument
+	for doc := range docs {
+		out = append(out, doc)
+	}
+	return out, <-errs
+}
+
+func collectChanges(
+	changes <-chan domain.RawDocumentChange, errs <-chan error,
+) ([]domain.RawDocumentChange, error) {
+	var out []domain.RawDocumentChange
+	for change := range changes {
+		out = append(out, change)
+	}
+	return out, <-errs
+}
+
+func docURIs(docs []domain.RawDocument) []string {
+	uris := make([]string, len(docs))
+	for i := range docs {
+		uris[i] = docs[i].URI
+	}
+	return uris
+}
+
+func TestNew(t *testing.T) {
+	c := New("src-1", &Config{AccountID: "999"}, &mockTokenProvider{token: "token"})
+
+	assert.Equal(t, "basecamp", c.Type())
+	assert.Equal(t, "src-1", c.SourceID())
+	assert.True(t, c.Capabilities().SupportsIncremental)
+	assert.True(t, c.Capabilities().RequiresAuth)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("accepts valid token", func(t *testing.T) {
+		c := newTestConnector(t, newFakeBasecamp(ts, ts), DefaultConfig(), "token")
+		assert.NoError(t, c.Validate(context.Background()))
+	})
+
+	t.Run("rejects invalid token", func(t *testing.T) {
+		c := newTestConnector(t, newFakeBasecamp(ts, ts), DefaultConfig(), "bad")
+		err := c.Validate(context.Background())
+		assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+		assert.ErrorIs(t, err, ErrUnauthorised)
+	})
+
+	t.Run("fails when closed", func(t *testing.T) {
+		c := newTestConnector(t, newFakeBasecamp(ts, ts), DefaultConfig(), "token")
+		require.NoError(t, c.Close())
+		assert.ErrorIs(t, c.Validate(context.Background()), domain.ErrConnectorClosed)
+	})
+}
+
+func TestConnector_FullSync(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("syncs all content types of all projects", func(t *testing.T) {
+		fake := newFakeBasecamp(ts, ts)
+		c := newTestConnector(t, fake, DefaultConfig(), "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		cursor, decodeErr := DecodeCursor(complete.NewCursor)
+		require.NoError(t, decodeErr)
+		assert.False(t, cursor.IsEmpty())
+
+		assert.Equal(t, []string{
+			"basecamp://999/1/messages/100",
+			"basecamp://999/1/campfire/101",
+			"basecamp://999/1/todos/102",
+			"basecamp://999/1/todos/103",
+			"basecamp://999/1/documents/104",
+		}, docURIs(docs))
+		assert.Contains(t, string(docs[0].Content), "Sounds good")
+		assert.Equal(t, "Launch", docs[0].Metadata["project_name"])
+		assert.Contains(t, fake.requests, "/999/projects.json?page=2")
+		assert.NotContains(t, fake.requests, "/999/buckets/1/recordings/101/comments.json")
+	})
+
+	t.Run("indexes text attachments when enabled", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.ContentTypes = []string{ContentDocuments}
+		cfg.IncludeAttachments = true
+		c := newTestConnector(t, newFakeBasecamp(ts, ts), cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		require.Len(t, docs, 2)
+		assert.Equal(t, "basecamp://999/1/attachments/105", docs[1].URI)
+		assert.Equal(t, "text/plain", docs[1].MIMEType)
+		assert.Equal(t, "notes", string(docs[1].Content))
+	})
+
+	t.Run("syncs selected content types only", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.ContentTypes = []string{ContentTodos}
+		c := newTestConnector(t, newFakeBasecamp(ts, ts), cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		assert.Equal(t, []string{"basecamp://999/1/todos/102", "basecamp://999/1/todos/103"}, docURIs(docs))
+	})
+
+	t.Run("skips comments when disabled", func(t *testing.T) {
+		fake := newFakeBasecamp(ts, ts)
+		cfg := DefaultConfig()
+		cfg.ContentTypes = []string{ContentMessages}
+		c := newTestConnector(t, fake, cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		require.Len(t, docs, 2)
+		assert.NotContains(t, string(docs[0].Content), "Comments")
+		assert.NotContains(t, fake.requests, "/999/buckets/1/recordings/100/comments.json")
+	})
+
+	t.Run("syncs configured projects only", func(t *testing.T) {
+		fake := newFakeBasecamp(ts, ts)
+		cfg := DefaultConfig()
+		cfg.ProjectIDs = []string{"1"}
+		c := newTestConnector(t, fake, cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		assert.Len(t, docs, 5)
+		assert.NotContains(t, fake.requests, "/999/projects.json")
+	})
+
+	t.Run("fails for unknown project", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.ProjectIDs = []string{"404"}
+		c := newTestConnector(t, newFakeBasecamp(ts, ts), cfg, "token")
+
+		_, err := collectDocs(c.FullSync(context.Background()))
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestConnector_IncrementalSync(t *testing.T) {
+	lastSync := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	before := lastSync.Add(-24 * time.Hour)
+	after := lastSync.Add(time.Hour)
+
+	cursor := NewCursor()
+	cursor.SetLastSyncTime(lastSync)
+	state := domain.SyncState{SourceID: "src-1", Cursor: cursor.Encode()}
+
+	t.Run("skips recordings not updated since last sync", func(t *testing.T) {
+		c := newTestConnector(t, newFakeBasecamp(before, before), DefaultConfig(), "token")
+
+		changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		newCursor, decodeErr := DecodeCursor(complete.NewCursor)
+		require.NoError(t, decodeErr)
+		assert.True(t, newCursor.LastSyncTime.After(lastSync))
+		assert.Empty(t, changes)
+	})
+
+	t.Run("reports edited recordings as updated", func(t *testing.T) {
+		c := newTestConnector(t, newFakeBasecamp(before, after), DefaultConfig(), "token")
+
+		changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		require.Len(t, changes, 5)
+		assert.Equal(t, domain.ChangeUpdated, changes[0].Type)
+		assert.Equal(t, "basecamp://999/1/messages/100", changes[0].Document.URI)
+	})
+
+	t.Run("reports new recordings as created", func(t *testing.T) {
+		c := newTestConnector(t, newFakeBasecamp(after, after), DefaultConfig(), "token")
+
+		changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		require.Len(t, changes, 5)
+		assert.Equal(t, domain.ChangeCreated, changes[0].Type)
+	})
+
+	t.Run("requires a cursor", func(t *testing.T) {
+		c := newTestConnector(t, newFakeBasecamp(after, after), DefaultConfig(), "token")
+
+		_, err := collectChanges(c.IncrementalSync(context.Background(), domain.SyncState{}))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "full sync required")
+	})
+}
+
+func TestConnector_GetAccountIdentifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"identity":{"id":1,"email_address":"ada@example.com"}}`))
+	}))
+	t.Cleanup(server.Close)
+	original := authorizationURL
+	authorizationURL = server.URL
+	t.Cleanup(func() { authorizationURL = original })
+
+	c := New("src-1", &Config{AccountID: "999"}, &mockTokenProvider{token: "ignored"})
+
+	email, err := c.GetAccountIdentifier(context.Background(), "token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ada@example.com", email)
+}
+
+func TestIsTextReadable(t *testing.T) {
+	assert.True(t, isTextReadable("text/plain"))
+	assert.True(t, isTextReadable("text/markdown; charset=utf-8"))
+	assert.True(t, isTextReadable("application/json"))
+	assert.False(t, isTextReadable("application/pdf"))
+	assert.False(t, isTextReadable("image/png"))
+	assert.False(t, isTextReadable(""))
+}
+
+func TestConnector_Watch(t *testing.T) {
+	c := New("src-1", &Config{AccountID: "999"}, &mockTokenProvider{token: "token"})
+	_, err := c.Watch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
@@ -0,0 +1,71 @@
+package basecamp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor stores the time of the last successful sync.
+// Basecamp has no change feed, so incremental syncs compare each recording's
+// updated_at against this time.
+type Cursor struct {
+	Version      int       `json:"v"`
+	LastSyncTime time.Time `json:"last_sync"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version: CursorVersion,
+	}
+}
+
+// Encode serialises the cursor to a base64 string.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// IsEmpty returns true if the cursor has no last sync time.
+func (c *Cursor) IsEmpty() bool {
+	return c.LastSyncTime.IsZero()
+}
+
+// SetLastSyncTime updates the last sync time.
+func (c *Cursor) SetLastSyncTime(t time.Time) {
+	c.LastSyncTime = t.UTC()
+}
@@ -0,0 +1,235 @@
+package basecamp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// OAuthHandler implements OAuth operations for Basecamp.
+// Basecamp authorises through 37signals Launchpad, which needs a type
+// parameter on every request and does not support PKCE.
+type OAuthHandler struct{}
+
+// NewOAuthHandler creates a new Basecamp OAuth handler.
+func NewOAuthHandler() *OAuthHandler {
+	return &OAuthHandler{}
+}
+
+// BuildAuthURL constructs the Launchpad authorization URL.
+func (h *OAuthHandler) BuildAuthURL(
+	authProvider *domain.AuthProvider,
+	redirectURI, state, _ string, // codeChallenge unused - Launchpad doesn't support PKCE
+) string {
+	cfg := authProvider.OAuth
+	authURL := cfg.AuthURL
+	if authURL == "" {
+		authURL = defaultAuthURL
+	}
+
+	params := url.Values{
+		"type":         {"web_server"},
+		"client_id":    {cfg.ClientID},
+		"redirect_uri": {redirectURI},
+		"state":        {state},
+	}
+
+	return authURL + "?" + params.Encode()
+}
+
+// ExchangeCode exchanges an authorization code for tokens.
+func (h *OAuthHandler) ExchangeCode(
+	ctx context.Context,
+	authProvider *domain.AuthProvider,
+	code, redirectURI, _ string, // codeVerifier unused - Launchpad doesn't support PKCE
+) (*domain.OAuthToken, error) {
+	cfg := authProvider.OAuth
+	tokenURL := cfg.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+
+	resp, err := requestToken(ctx, tokenURL, url.Values{
+		"type":          {"web_server"},
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"redirect_uri":  {redirectURI},
+		"code":          {code},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.token(""), nil
+}
+
+// RefreshToken refreshes an expired access token using a refresh token.
+// Launchpad access tokens expire after two weeks.
+func (h *OAuthHandler) RefreshToken(
+	ctx context.Context,
+	authProvider *domain.AuthProvider,
+	refreshToken string,
+) (*domain.OAuthToken, error) {
+	cfg := authProvider.OAuth
+	tokenURL := cfg.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+
+	resp, err := requestToken(ctx, tokenURL, url.Values{
+		"type":          {"refresh"},
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"refresh_token": {refreshToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Launchpad does not return a new refresh token
+	return resp.token(refreshToken), nil
+}
+
+// GetUserInfo fetches the user's identity from Launchpad.
+// The identifier is the user's email.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error) {
+	auth, err := GetAuthorization(ctx, accessToken)
+	if err != nil {
+		return domain.AccountInfo{}, err
+	}
+	identity := auth.Identity
+	return domain.AccountInfo{
+		Identifier:  identity.EmailAddress,
+		DisplayName: strings.TrimSpace(identity.FirstName + " " + identity.LastName),
+		Email:       identity.EmailAddress,
+	}, nil
+}
+
+// DefaultConfig returns default OAuth URLs for Basecamp.
+func (h *OAuthHandler) DefaultConfig() driven.OAuthDefaults {
+	return driven.OAuthDefaults{
+		AuthURL:  defaultAuthURL,
+		TokenURL: defaultTokenURL,
+		Scopes:   nil, // Launchpad grants access to all of the user's accounts
+	}
+}
+
+// SetupHint returns guidance for setting up a Basecamp OAuth app.
+func (h *OAuthHandler) SetupHint() string {
+	return "Register an app at launchpad.37signals.com/integrations"
+}
+
+// Launchpad OAuth constants.
+const (
+	defaultAuthURL = "https://launchpad.37signals.com/authorization/new"
+	//nolint:gosec // G101: Not credentials, OAuth endpoint URL
+	defaultTokenURL = "https://launchpad.37signals.com/authorization/token"
+)
+
+// authorizationURL returns the user's identity and accounts. A variable so tests can override it.
+var authorizationURL = "https://launchpad.37signals.com/authorization.json"
+
+// Authorization is the Launchpad identity and the accounts it can access.
+type Authorization struct {
+	Identity struct {
+		ID           int64  `json:"id"`
+		FirstName    string `json:"first_name"`
+		LastName     string `json:"last_name"`
+		EmailAddress string `json:"email_address"`
+	} `json:"identity"`
+	Accounts []struct {
+		ID      int64  `json:"id"`
+		Name    string `json:"name"`
+		Product string `json:"product"` // bc3 for Basecamp 3 and 4
+	} `json:"accounts"`
+}
+
+// GetAuthorization fetches the Launchpad identity for an access token.
+func GetAuthorization(ctx context.Context, accessToken string) (*Authorization, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, authorizationURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("User-Agent", userAgent)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("user info request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user info failed with status %d", resp.StatusCode)
+	}
+
+	var auth Authorization
+	if err := json.NewDecoder(resp.Body).Decode(&auth); err != nil {
+		return nil, fmt.Errorf("decode user info: %w", err)
+	}
+
+	return &auth, nil
+}
+
+// tokenResponse is Launchpad's OAuth token response.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// token converts the response, keeping fallbackRefresh if none was returned.
+func (r *tokenResponse) token(fallbackRefresh string) *domain.OAuthToken {
+	token := &domain.OAuthToken{
+		AccessToken:  r.AccessToken,
+		RefreshToken: r.RefreshToken,
+		TokenType:    "Bearer",
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = fallbackRefresh
+	}
+	if r.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+	return token
+}
+
+// requestToken posts a token request to Launchpad.
+func requestToken(ctx context.Context, tokenURL string, params url.Values) (*tokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request failed with status %d", resp.StatusCode)
+	}
+
+	var tokenResp tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, fmt.Errorf("decode token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token response has no access token")
+	}
+
+	return &tokenResp, nil
+}
@@ -0,0 +1,119 @@
+package basecamp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestOAuthHandler_DefaultConfig(t *testing.T) {
+	defaults := NewOAuthHandler().DefaultConfig()
+
+	assert.Equal(t, defaultAuthURL, defaults.AuthURL)
+	assert.Equal(t, defaultTokenURL, defaults.TokenURL)
+	assert.Empty(t, defaults.Scopes)
+}
+
+func TestOAuthHandler_SetupHint(t *testing.T) {
+	assert.Contains(t, NewOAuthHandler().SetupHint(), "launchpad.37signals.com")
+}
+
+func TestOAuthHandler_BuildAuthURL(t *testing.T) {
+	authProvider := &domain.AuthProvider{
+		OAuth: &domain.OAuthProviderConfig{ClientID: "client-id"},
+	}
+
+	authURL := NewOAuthHandler().BuildAuthURL(authProvider, "http://localhost:18080/callback", "state-1", "challenge")
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "launchpad.37signals.com", parsed.Host)
+	query := parsed.Query()
+	assert.Equal(t, "web_server", query.Get("type"))
+	assert.Equal(t, "client-id", query.Get("client_id"))
+	assert.Equal(t, "http://localhost:18080/callback", query.Get("redirect_uri"))
+	assert.Equal(t, "state-1", query.Get("state"))
+	assert.Empty(t, query.Get("code_challenge"))
+}
+
+// newTokenServer returns a Launchpad token endpoint that records the form it receives.
+func newTokenServer(t *testing.T, form *url.Values, body string) *domain.AuthProvider {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		*form = r.PostForm
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return &domain.AuthProvider{OAuth: &domain.OAuthProviderConfig{
+		ClientID:     "client-id",
+		ClientSecret: "secret",
+		TokenURL:     server.URL,
+	}}
+}
+
+func TestOAuthHandler_ExchangeCode(t *testing.T) {
+	var form url.Values
+	authProvider := newTokenServer(t, &form,
+		`{"access_token":"access","refresh_token":"refresh","expires_in":1209600}`)
+
+	token, err := NewOAuthHandler().ExchangeCode(
+		context.Background(), authProvider, "code-1", "http://localhost/callback", "verifier")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, "Bearer", token.TokenType)
+	assert.False(t, token.Expiry.IsZero())
+	assert.Equal(t, "web_server", form.Get("type"))
+	assert.Equal(t, "code-1", form.Get("code"))
+	assert.Equal(t, "secret", form.Get("client_secret"))
+}
+
+func TestOAuthHandler_RefreshToken(t *testing.T) {
+	var form url.Values
+	authProvider := newTokenServer(t, &form, `{"access_token":"new-access","expires_in":1209600}`)
+
+	token, err := NewOAuthHandler().RefreshToken(context.Background(), authProvider, "refresh")
+
+	require.NoError(t, err)
+	assert.Equal(t, "new-access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, "refresh", form.Get("type"))
+	assert.Equal(t, "refresh", form.Get("refresh_token"))
+}
+
+func TestOAuthHandler_ExchangeCode_NoAccessToken(t *testing.T) {
+	var form url.Values
+	authProvider := newTokenServer(t, &form, `{"error":"invalid_grant"}`)
+
+	_, err := NewOAuthHandler().ExchangeCode(context.Background(), authProvider, "code", "uri", "")
+
+	assert.Error(t, err)
+}
+
+func TestOAuthHandler_GetUserInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"identity":{"id":1,"first_name":"Ada","last_name":"Lovelace",` +
+			`"email_address":"ada@example.com"},"accounts":[{"id":999,"name":"Acme","product":"bc3"}]}`))
+	}))
+	t.Cleanup(server.Close)
+	original := authorizationURL
+	authorizationURL = server.URL
+	t.Cleanup(func() { authorizationURL = original })
+
+	info, err := NewOAuthHandler().GetUserInfo(context.Background(), "token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ada@example.com", info.Identifier)
+	assert.Equal(t, "Ada Lovelace", info.DisplayName)
+	assert.Equal(t, "ada@example.com", info.Email)
+}
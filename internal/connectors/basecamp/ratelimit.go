@@ -0,0 +1,64 @@
+package basecamp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Rate limit configuration for the Basecamp 3 API.
+// Basecamp allows 50 requests per 10 seconds per IP; we stay below that.
+const (
+	// RequestsPerSecond is the sustained rate limit.
+	RequestsPerSecond = 4.0
+	// BurstSize is the maximum burst size.
+	BurstSize = 10
+	// defaultRetryAfter is the backoff used when a 429 has no Retry-After header.
+	defaultRetryAfter = 10 * time.Second
+)
+
+// RateLimiter provides rate limiting for Basecamp API requests.
+// It uses a token bucket algorithm with a backoff period after 429 responses.
+type RateLimiter struct {
+	mu      sync.Mutex
+	limiter *rate.Limiter
+	retryAt time.Time
+}
+
+// NewRateLimiter creates a new rate limiter for Basecamp.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(RequestsPerSecond), BurstSize),
+	}
+}
+
+// Wait blocks until a request can be made without exceeding the rate limit.
+// It also respects any backoff period set by RecordRateLimitError.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	retryAt := r.retryAt
+	r.mu.Unlock()
+
+	if time.Now().Before(retryAt) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(retryAt)):
+		}
+	}
+
+	return r.limiter.Wait(ctx)
+}
+
+// RecordRateLimitError sets a backoff period after a 429 response.
+// Basecamp sends Retry-After in seconds; zero falls back to one rate window.
+func (r *RateLimiter) RecordRateLimitError(retryAfter time.Duration) {
+	if retryAfter <= 0 {
+		retryAfter = defaultRetryAfter
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.retryAt = time.Now().Add(retryAfter)
+}
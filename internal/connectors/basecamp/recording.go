@@ -0,0 +1,224 @@
+package basecamp
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Recording kinds, used as the path segment in document URIs.
+const (
+	KindMessages    = "messages"
+	KindCampfire    = "campfire"
+	KindTodos       = "todos"
+	KindDocuments   = "documents"
+	KindAttachments = "attachments"
+)
+
+// Person is a Basecamp user.
+type Person struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	EmailAddress string `json:"email_address"`
+}
+
+// DockItem is a tool enabled on a project, such as its message board or Campfire.
+type DockItem struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"` // message_board, todoset, vault, chat, ...
+	Enabled bool   `json:"enabled"`
+}
+
+// Project is a Basecamp project (called a bucket in API paths).
+type Project struct {
+	ID     int64      `json:"id"`
+	Name   string     `json:"name"`
+	AppURL string     `json:"app_url"`
+	Dock   []DockItem `json:"dock"`
+}
+
+// Tool returns the ID of an enabled dock tool, or false if the project lacks it.
+func (p *Project) Tool(name string) (int64, bool) {
+	for _, item := range p.Dock {
+		if item.Name == name && item.Enabled {
+			return item.ID, true
+		}
+	}
+	return 0, false
+}
+
+// Recording is any Basecamp content item: a message, Campfire line, todo
+// list, todo, document, upload, vault or comment. Fields not used by a
+// given type are left empty.
+type Recording struct {
+	ID            int64     `json:"id"`
+	Type          string    `json:"type"`
+	Title         string    `json:"title"`
+	Subject       string    `json:"subject"`
+	Content       string    `json:"content"`
+	Description   string    `json:"description"`
+	Name          string    `json:"name"`
+	AppURL        string    `json:"app_url"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Creator       Person    `json:"creator"`
+	CommentsCount int       `json:"comments_count"`
+	Completed     bool      `json:"completed"`
+	DueOn         string    `json:"due_on"`
+	Assignees     []Person  `json:"assignees"`
+	Filename      string    `json:"filename"`
+	ContentType   string    `json:"content_type"`
+	ByteSize      int64     `json:"byte_size"`
+	DownloadURL   string    `json:"download_url"`
+}
+
+// ProjectURI returns the document URI for a project.
+func ProjectURI(accountID string, projectID int64) string {
+	return fmt.Sprintf("basecamp://%s/%d", accountID, projectID)
+}
+
+// RecordingURI returns the document URI for a recording.
+// URI format: basecamp://{accountId}/{projectId}/{kind}/{id}.
+func RecordingURI(accountID string, projectID int64, kind string, id int64) string {
+	return fmt.Sprintf("%s/%s/%d", ProjectURI(accountID, projectID), kind, id)
+}
+
+// RecordingToRawDocument converts a message, Campfire line, todo or document
+// to an HTML RawDocument. Comments are appended in the order given.
+func RecordingToRawDocument(
+	rec *Recording, kind string, project *Project, accountID string, comments []Recording, sourceID string,
+) *domain.RawDocument {
+	title := recordingTitle(rec, kind)
+
+	metadata := map[string]any{
+		"recording_id": rec.ID,
+		"project_id":   project.ID,
+		"project_name": project.Name,
+		"kind":         kind,
+		"title":        title,
+		"url":          rec.AppURL,
+		"author":       rec.Creator.Name,
+		"created_at":   rec.CreatedAt.Format(time.RFC3339),
+		"updated_at":   rec.UpdatedAt.Format(time.RFC3339),
+	}
+	if kind == KindTodos {
+		metadata["completed"] = rec.Completed
+		if rec.DueOn != "" {
+			metadata["due_on"] = rec.DueOn
+		}
+		if len(rec.Assignees) > 0 {
+			metadata["assignees"] = personNames(rec.Assignees)
+		}
+	}
+
+	parentURI := ProjectURI(accountID, project.ID)
+
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       RecordingURI(accountID, project.ID, kind, rec.ID),
+		MIMEType:  "text/html",
+		Content:   []byte(renderRecording(rec, kind, title, project, comments)),
+		Metadata:  metadata,
+		ParentURI: &parentURI,
+	}
+}
+
+// UploadToRawDocument converts a downloaded file from Docs & Files.
+func UploadToRawDocument(
+	upload *Recording, project *Project, accountID string, content []byte, sourceID string,
+) *domain.RawDocument {
+	parentURI := ProjectURI(accountID, project.ID)
+
+	return &domain.RawDocument{
+		SourceID: sourceID,
+		URI:      RecordingURI(accountID, project.ID, KindAttachments, upload.ID),
+		MIMEType: upload.ContentType,
+		Content:  content,
+		Metadata: map[string]any{
+			"recording_id": upload.ID,
+			"project_id":   project.ID,
+			"project_name": project.Name,
+			"kind":         KindAttachments,
+			"title":        upload.Filename,
+			"filename":     upload.Filename,
+			"size":         upload.ByteSize,
+			"url":          upload.AppURL,
+			"author":       upload.Creator.Name,
+			"created_at":   upload.CreatedAt.Format(time.RFC3339),
+			"updated_at":   upload.UpdatedAt.Format(time.RFC3339),
+		},
+		ParentURI: &parentURI,
+	}
+}
+
+// recordingTitle picks the title field used by each kind of recording.
+func recordingTitle(rec *Recording, kind string) string {
+	switch kind {
+	case KindMessages:
+		return rec.Subject
+	case KindTodos:
+		return rec.Content // A todo's content is its plain-text title
+	case KindCampfire:
+		return "Campfire: " + rec.Creator.Name
+	default:
+		return rec.Title
+	}
+}
+
+// renderRecording builds the HTML content for a recording.
+// Basecamp rich text is already HTML, so bodies are embedded as-is.
+func renderRecording(rec *Recording, kind, title string, project *Project, comments []Recording) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "<html><head><title>%s</title></head><body>\n", html.EscapeString(title))
+	fmt.Fprintf(&b, "<h1>%s</h1>\n", html.EscapeString(title))
+
+	fmt.Fprintf(&b, "<p>Project: %s", html.EscapeString(project.Name))
+	if rec.Creator.Name != "" {
+		fmt.Fprintf(&b, "<br>Author: %s", html.EscapeString(rec.Creator.Name))
+	}
+	if kind == KindTodos {
+		if len(rec.Assignees) > 0 {
+			fmt.Fprintf(&b, "<br>Assignees: %s", html.EscapeString(strings.Join(personNames(rec.Assignees), ", ")))
+		}
+		if rec.DueOn != "" {
+			fmt.Fprintf(&b, "<br>Due: %s", html.EscapeString(rec.DueOn))
+		}
+		if rec.Completed {
+			b.WriteString("<br>Completed: yes")
+		}
+	}
+	b.WriteString("</p>\n")
+
+	body := rec.Content
+	if kind == KindTodos {
+		body = rec.Description
+	}
+	if strings.TrimSpace(body) != "" {
+		fmt.Fprintf(&b, "%s\n", body)
+	}
+
+	if len(comments) > 0 {
+		b.WriteString("<h2>Comments</h2>\n")
+		for i := range comments {
+			c := &comments[i]
+			fmt.Fprintf(&b, "<p><strong>%s</strong> (%s):</p>\n%s\n",
+				html.EscapeString(c.Creator.Name), c.CreatedAt.Format("2006-01-02"), c.Content)
+		}
+	}
+
+	b.WriteString("</body></html>\n")
+	return b.String()
+}
+
+// personNames returns the names of the given people.
+func personNames(people []Person) []string {
+	names := make([]string, len(people))
+	for i, p := range people {
+		names[i] = p.Name
+	}
+	return names
+}
@@ -0,0 +1,102 @@
+package basecamp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingURI(t *testing.T) {
+	assert.Equal(t, "basecamp://999/1", ProjectURI("999", 1))
+	assert.Equal(t, "basecamp://999/1/messages/100", RecordingURI("999", 1, KindMessages, 100))
+}
+
+func TestProject_Tool(t *testing.T) {
+	project := &Project{Dock: []DockItem{
+		{ID: 10, Name: "message_board", Enabled: true},
+		{ID: 11, Name: "chat", Enabled: false},
+	}}
+
+	id, ok := project.Tool("message_board")
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), id)
+
+	_, ok = project.Tool("chat")
+	assert.False(t, ok)
+	_, ok = project.Tool("vault")
+	assert.False(t, ok)
+}
+
+func TestRecordingToRawDocument_Message(t *testing.T) {
+	ts := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	rec := &Recording{
+		ID:        100,
+		Subject:   "Kickoff & plans",
+		Content:   "<div>Agenda</div>",
+		AppURL:    "https://3.basecamp.com/999/buckets/1/messages/100",
+		CreatedAt: ts,
+		UpdatedAt: ts,
+		Creator:   Person{Name: "Ada"},
+	}
+	comment := Recording{Content: "<div>Sounds good</div>", CreatedAt: ts, Creator: Person{Name: "Grace"}}
+	project := &Project{ID: 1, Name: "Launch"}
+
+	doc := RecordingToRawDocument(rec, KindMessages, project, "999", []Recording{comment}, "src-1")
+
+	assert.Equal(t, "src-1", doc.SourceID)
+	assert.Equal(t, "basecamp://999/1/messages/100", doc.URI)
+	assert.Equal(t, "text/html", doc.MIMEType)
+	require.NotNil(t, doc.ParentURI)
+	assert.Equal(t, "basecamp://999/1", *doc.ParentURI)
+	assert.Equal(t, "Kickoff & plans", doc.Metadata["title"])
+	assert.Equal(t, rec.AppURL, doc.Metadata["url"])
+	assert.Equal(t, "Ada", doc.Metadata["author"])
+
+	content := string(doc.Content)
+	assert.Contains(t, content, "<title>Kickoff &amp; plans</title>")
+	assert.Contains(t, content, "<div>Agenda</div>")
+	assert.Contains(t, content, "<strong>Grace</strong> (2026-04-01)")
+	assert.Contains(t, content, "<div>Sounds good</div>")
+}
+
+func TestRecordingToRawDocument_Todo(t *testing.T) {
+	rec := &Recording{
+		ID:          102,
+		Content:     "Book venue",
+		Description: "<div>Near the station</div>",
+		Completed:   true,
+		DueOn:       "2026-05-01",
+		Assignees:   []Person{{Name: "Ada"}, {Name: "Grace"}},
+	}
+	project := &Project{ID: 1, Name: "Launch"}
+
+	doc := RecordingToRawDocument(rec, KindTodos, project, "999", nil, "src-1")
+
+	assert.Equal(t, "basecamp://999/1/todos/102", doc.URI)
+	assert.Equal(t, "Book venue", doc.Metadata["title"])
+	assert.Equal(t, true, doc.Metadata["completed"])
+	assert.Equal(t, "2026-05-01", doc.Metadata["due_on"])
+	assert.Equal(t, []string{"Ada", "Grace"}, doc.Metadata["assignees"])
+
+	content := string(doc.Content)
+	assert.Contains(t, content, "<div>Near the station</div>")
+	assert.Contains(t, content, "Assignees: Ada, Grace")
+	assert.Contains(t, content, "Completed: yes")
+	assert.NotContains(t, content, "Comments")
+}
+
+func TestUploadToRawDocument(t *testing.T) {
+	upload := &Recording{ID: 105, Filename: "notes.txt", ContentType: "text/plain", ByteSize: 5}
+	project := &Project{ID: 1, Name: "Launch"}
+
+	doc := UploadToRawDocument(upload, project, "999", []byte("notes"), "src-1")
+
+	assert.Equal(t, "basecamp://999/1/attachments/105", doc.URI)
+	assert.Equal(t, "text/plain", doc.MIMEType)
+	assert.Equal(t, []byte("notes"), doc.Content)
+	assert.Equal(t, "notes.txt", doc.Metadata["title"])
+	require.NotNil(t, doc.ParentURI)
+	assert.Equal(t, "basecamp://999/1", *doc.ParentURI)
+}
@@ -0,0 +1,36 @@
+package basecamp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// webPaths maps URI kinds to their path segment in Basecamp web URLs.
+var webPaths = map[string]string{
+	KindMessages:    "messages",
+	KindTodos:       "todos",
+	KindDocuments:   "documents",
+	KindAttachments: "uploads",
+}
+
+// ResolveWebURL converts a basecamp:// URI to a web URL.
+// URI format: basecamp://{accountId}/{projectId}/{kind}/{id}.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	// Priority 1: Use the app URL returned by the API
+	if u, ok := metadata["url"].(string); ok && u != "" {
+		return u
+	}
+
+	// Priority 2: Build the URL from the URI parts
+	parts := strings.Split(strings.TrimPrefix(uri, "basecamp://"), "/")
+	if len(parts) < 2 || parts[0] == "" {
+		return "https://3.basecamp.com"
+	}
+	project := fmt.Sprintf("https://3.basecamp.com/%s/buckets/%s", parts[0], parts[1])
+	if len(parts) == 4 {
+		if path, ok := webPaths[parts[2]]; ok {
+			return fmt.Sprintf("%s/%s/%s", project, path, parts[3])
+		}
+	}
+	return project
+}
@@ -0,0 +1,54 @@
+package basecamp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveWebURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		metadata map[string]any
+		want     string
+	}{
+		{
+			name:     "uses app URL from metadata",
+			uri:      "basecamp://999/1/messages/100",
+			metadata: map[string]any{"url": "https://3.basecamp.com/999/buckets/1/messages/100"},
+			want:     "https://3.basecamp.com/999/buckets/1/messages/100",
+		},
+		{
+			name: "builds message URL from URI",
+			uri:  "basecamp://999/1/messages/100",
+			want: "https://3.basecamp.com/999/buckets/1/messages/100",
+		},
+		{
+			name: "maps attachments to uploads",
+			uri:  "basecamp://999/1/attachments/105",
+			want: "https://3.basecamp.com/999/buckets/1/uploads/105",
+		},
+		{
+			name: "campfire lines resolve to the project",
+			uri:  "basecamp://999/1/campfire/101",
+			want: "https://3.basecamp.com/999/buckets/1",
+		},
+		{
+			name: "project URI",
+			uri:  "basecamp://999/1",
+			want: "https://3.basecamp.com/999/buckets/1",
+		},
+		{
+			name: "malformed URI",
+			uri:  "basecamp://",
+			want: "https://3.basecamp.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveWebURL(tt.uri, tt.metadata))
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+const (
+	// apiBaseURL is the Discord REST API v10 base URL.
+	apiBaseURL = "https://discord.com/api/v10"
+
+	// pageSize is the largest page Discord returns for messages and threads.
+	pageSize = 100
+
+	// maxRetries is how often a rate-limited request is retried.
+	maxRetries = 3
+
+	// userAgent identifies the client as Discord requires for bots.
+	userAgent = "DiscordBot (https://github.com/custodia-labs/sercha-cli, 1.0)"
+)
+
+// Error types for Discord API responses.
+var (
+	// ErrUnauthorised indicates the bot token is invalid or revoked.
+	ErrUnauthorised = errors.New("discord: unauthorised")
+	// ErrForbidden indicates the bot cannot read the requested channel.
+	ErrForbidden = errors.New("discord: missing access")
+	// ErrNotFound indicates the requested guild or channel does not exist.
+	ErrNotFound = errors.New("discord: not found")
+	// ErrRateLimited indicates the request was still throttled after retrying.
+	ErrRateLimited = errors.New("discord: rate limited")
+)
+
+// Client is a minimal Discord REST API client authenticated as a bot.
+type Client struct {
+	baseURL       string
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	rateLimiter   *RateLimiter
+}
+
+// NewClient creates a Discord client for the given bot token provider.
+func NewClient(tokenProvider driven.TokenProvider) *Client {
+	return &Client{
+		baseURL:       apiBaseURL,
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 60 * time.Second},
+		rateLimiter:   NewRateLimiter(),
+	}
+}
+
+// Me returns the bot user.
+func (c *Client) Me(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.get(ctx, "/users/@me", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Guild returns a guild the bot is a member of.
+func (c *Client) Guild(ctx context.Context, guildID string) (*Guild, error) {
+	var guild Guild
+	if err := c.get(ctx, "/guilds/"+url.PathEscape(guildID), nil, &guild); err != nil {
+		return nil, err
+	}
+	return &guild, nil
+}
+
+// GuildChannels returns all channels of a guild, excluding threads.
+func (c *Client) GuildChannels(ctx context.Context, guildID string) ([]Channel, error) {
+	var channels []Channel
+	if err := c.get(ctx, "/guilds/"+url.PathEscape(guildID)+"/channels", nil, &channels); err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// Channel returns a single channel by ID.
+func (c *Client) Channel(ctx context.Context, channelID string) (*Channel, error) {
+	var channel Channel
+	if err := c.get(ctx, "/channels/"+url.PathEscape(channelID), nil, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// ActiveThreads returns the active threads of a guild.
+func (c *Client) ActiveThreads(ctx context.Context, guildID string) ([]Channel, error) {
+	var resp struct {
+		Threads []Channel `json:"threads"`
+	}
+	if err := c.get(ctx, "/guilds/"+url.PathEscape(guildID)+"/threads/active", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Threads, nil
+}
+
+// ArchivedPublicThreads returns a page of a channel's archived public threads,
+// most recently archived first. before pages back from an archive time;
+// the zero time starts at the newest.
+func (c *Client) ArchivedPublicThreads(
+	ctx context.Context, channelID string, before time.Time,
+) (threads []Channel, hasMore bool, err error) {
+	query := url.Values{"limit": {strconv.Itoa(pageSize)}}
+	if !before.IsZero() {
+		query.Set("before", before.Format(time.RFC3339Nano))
+	}
+
+	var resp struct {
+		Threads []Channel `json:"threads"`
+		HasMore bool      `json:"has_more"`
+	}
+	path := "/channels/" + url.PathEscape(channelID) + "/threads/archived/public"
+	if err := c.get(ctx, path, query, &resp); err != nil {
+		return nil, false, err
+	}
+	return resp.Threads, resp.HasMore, nil
+}
+
+// MessagesBefore returns up to one page of messages older than the given
+// message ID, newest first. An empty ID starts at the newest message.
+func (c *Client) MessagesBefore(ctx context.Context, channelID, before string) ([]Message, error) {
+	query := url.Values{"limit": {strconv.Itoa(pageSize)}}
+	if before != "" {
+		query.Set("before", before)
+	}
+	return c.messages(ctx, channelID, query)
+}
+
+// MessagesAfter returns up to one page of the messages that follow the given
+// message ID. The page holds the oldest such messages.
+func (c *Client) MessagesAfter(ctx context.Context, channelID, after string) ([]Message, error) {
+	query := url.Values{"limit": {strconv.Itoa(pageSize)}, "after": {after}}
+	return c.messages(ctx, channelID, query)
+}
+
+// messages lists channel messages with the given paging query.
+func (c *Client) messages(ctx context.Context, channelID string, query url.Values) ([]Message, error) {
+	var messages []Message
+	if err := c.get(ctx, "/channels/"+url.PathEscape(channelID)+"/messages", query, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// get performs an authenticated GET request and decodes the JSON response into out.
+// Rate-limited requests are retried after the wait Discord asks for.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	token, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+	// Accept tokens pasted with or without the scheme
+	token = strings.TrimSpace(strings.TrimPrefix(token, "Bot "))
+
+	reqURL := c.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		status, body, retryAfter, err := c.do(ctx, reqURL, token)
+		if err != nil {
+			return fmt.Errorf("request %s: %w", path, err)
+		}
+
+		switch {
+		case status == http.StatusTooManyRequests:
+			c.rateLimiter.RecordRateLimitError(retryAfter)
+			if attempt < maxRetries {
+				continue
+			}
+			return ErrRateLimited
+		case status == http.StatusUnauthorized:
+			return ErrUnauthorised
+		case status == http.StatusForbidden:
+			return fmt.Errorf("%s: %w", path, ErrForbidden)
+		case status == http.StatusNotFound:
+			return fmt.Errorf("%s: %w", path, ErrNotFound)
+		case status != http.StatusOK:
+			return fmt.Errorf("request %s failed: status %d", path, status)
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("decode %s response: %w", path, err)
+		}
+		return nil
+	}
+}
+
+// do sends a single request, returning the status, body and any rate limit wait.
+func (c *Client) do(
+	ctx context.Context, reqURL, token string,
+) (status int, body []byte, retryAfter time.Duration, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bot "+token)
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, 0, fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), body)
+	}
+	return resp.StatusCode, body, retryAfter, nil
+}
+
+// parseRetryAfter returns the wait requested by a 429 response.
+// The JSON body has millisecond precision; the header is whole seconds.
+func parseRetryAfter(header string, body []byte) time.Duration {
+	var payload struct {
+		RetryAfter float64 `json:"retry_after"`
+	}
+	if json.Unmarshal(body, &payload) == nil && payload.RetryAfter > 0 {
+		return time.Duration(payload.RetryAfter * float64(time.Second))
+	}
+	if secs, err := strconv.ParseFloat(header, 64); err == nil && secs > 0 {
+		return time.Duration(secs * float64(time.Second))
+	}
+	return 0
+}
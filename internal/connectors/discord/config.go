@@ -0,0 +1,85 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ErrMissingGuildID indicates the source has no Discord server configured.
+var ErrMissingGuildID = errors.New("discord: guild_id is required")
+
+// Config holds Discord connector configuration.
+type Config struct {
+	// GuildID is the ID of the server (guild) to index.
+	GuildID string
+	// ChannelIDs limits syncing to specific channels. If empty, all text,
+	// announcement and forum channels the bot can read are synced.
+	ChannelIDs []string
+	// IncludeThreads also syncs active and archived public threads.
+	IncludeThreads bool
+	// MaxAgeDays skips messages older than this many days. Zero means no limit.
+	MaxAgeDays int
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		IncludeThreads: true,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse guild_id (required)
+	cfg.GuildID = strings.TrimSpace(source.Config["guild_id"])
+	if cfg.GuildID == "" {
+		return nil, ErrMissingGuildID
+	}
+
+	// Parse channel_ids
+	if val := source.Config["channel_ids"]; val != "" {
+		for _, id := range strings.Split(val, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				cfg.ChannelIDs = append(cfg.ChannelIDs, id)
+			}
+		}
+	}
+
+	// Parse include_threads
+	if val := source.Config["include_threads"]; val != "" {
+		cfg.IncludeThreads = parseBool(val)
+	}
+
+	// Parse max_age_days
+	if val := strings.TrimSpace(source.Config["max_age_days"]); val != "" {
+		days, err := strconv.Atoi(val)
+		if err != nil || days < 0 {
+			return nil, fmt.Errorf("discord: invalid max_age_days %q", val)
+		}
+		cfg.MaxAgeDays = days
+	}
+
+	return cfg, nil
+}
+
+// Cutoff returns the time before which messages are skipped,
+// or the zero time when there is no age limit.
+func (c *Config) Cutoff(now time.Time) time.Time {
+	if c.MaxAgeDays == 0 {
+		return time.Time{}
+	}
+	return now.AddDate(0, 0, -c.MaxAgeDays)
+}
+
+// parseBool accepts "true" and "1" as true; anything else is false.
+func parseBool(val string) bool {
+	val = strings.TrimSpace(strings.ToLower(val))
+	return val == "true" || val == "1"
+}
@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+
+	assert.Empty(t, cfg.GuildID)
+	assert.Empty(t, cfg.ChannelIDs)
+	assert.True(t, cfg.IncludeThreads)
+	assert.Zero(t, cfg.MaxAgeDays)
+}
+
+func TestParseConfig_RequiresGuildID(t *testing.T) {
+	for _, val := range []string{"", "   "} {
+		source := domain.Source{Config: map[string]string{"guild_id": val}}
+
+		cfg, err := ParseConfig(source)
+
+		require.ErrorIs(t, err, ErrMissingGuildID)
+		assert.Nil(t, cfg)
+	}
+}
+
+func TestParseConfig_AllFields(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"guild_id":        " 100 ",
+		"channel_ids":     "200, 300,,",
+		"include_threads": "false",
+		"max_age_days":    "30",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "100", cfg.GuildID)
+	assert.Equal(t, []string{"200", "300"}, cfg.ChannelIDs)
+	assert.False(t, cfg.IncludeThreads)
+	assert.Equal(t, 30, cfg.MaxAgeDays)
+}
+
+func TestParseConfig_InvalidMaxAge(t *testing.T) {
+	for _, val := range []string{"-1", "week"} {
+		source := domain.Source{Config: map[string]string{"guild_id": "100", "max_age_days": val}}
+
+		_, err := ParseConfig(source)
+
+		assert.Error(t, err)
+	}
+}
+
+func TestConfig_Cutoff(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, (&Config{}).Cutoff(now).IsZero())
+	assert.Equal(t, time.Date(2026, 3, 24, 12, 0, 0, 0, time.UTC), (&Config{MaxAgeDays: 7}).Cutoff(now))
+}
@@ -0,0 +1,476 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Connector fetches messages from the channels and threads of a Discord server.
+type Connector struct {
+	sourceID      string
+	config        *Config
+	tokenProvider driven.TokenProvider
+	client        *Client
+	mu            sync.Mutex
+	closed        bool
+}
+
+// emitFunc delivers a message document to the sync consumer.
+type emitFunc func(doc *domain.RawDocument) error
+
+// New creates a new Discord connector.
+func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
+	return &Connector{
+		sourceID:      sourceID,
+		config:        cfg,
+		tokenProvider: tokenProvider,
+		client:        NewClient(tokenProvider),
+	}
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "discord"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    true,
+		SupportsBinary:       false,
+		RequiresAuth:         true,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: true,
+		SupportsPagination:   true,
+	}
+}
+
+// Validate checks that the bot token is accepted and the bot is in the server.
+func (c *Connector) Validate(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, err := c.client.Me(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if errors.Is(err, ErrUnauthorised) {
+			return fmt.Errorf("%w: %w", domain.ErrAuthInvalid, err)
+		}
+		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+	}
+
+	if _, err := c.client.Guild(ctx, c.config.GuildID); err != nil {
+		return fmt.Errorf("get guild %s (is the bot a member?): %w", c.config.GuildID, err)
+	}
+
+	return nil
+}
+
+// FullSync fetches all messages from the configured channels and their threads.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	syncStart := time.Now()
+	cursor := NewCursor()
+
+	emit := func(doc *domain.RawDocument) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case docsChan <- *doc:
+			return nil
+		}
+	}
+	if err := c.syncGuild(ctx, NewCursor(), cursor, c.config.Cutoff(syncStart), emit); err != nil {
+		return err
+	}
+
+	cursor.SetSyncedAt(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches messages posted since the last sync.
+// Channels and threads that appeared since then are synced in full.
+// Edited and deleted messages are not detected; a full sync picks them up.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	previous, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+	if previous.IsEmpty() {
+		return fmt.Errorf("invalid cursor, full sync required: cursor has no sync time")
+	}
+
+	syncStart := time.Now()
+	cursor := NewCursor()
+
+	emit := func(doc *domain.RawDocument) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case changesChan <- domain.RawDocumentChange{Type: domain.ChangeCreated, Document: *doc}:
+			return nil
+		}
+	}
+	if err := c.syncGuild(ctx, previous, cursor, c.config.Cutoff(syncStart), emit); err != nil {
+		return err
+	}
+
+	cursor.SetSyncedAt(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// syncGuild emits the messages of every channel and thread newer than the
+// previous cursor and cutoff, recording the newest message IDs in next.
+func (c *Connector) syncGuild(
+	ctx context.Context, previous, next *Cursor, cutoff time.Time, emit emitFunc,
+) error {
+	guild, err := c.client.Guild(ctx, c.config.GuildID)
+	if err != nil {
+		return fmt.Errorf("get guild %s: %w", c.config.GuildID, err)
+	}
+
+	channels, err := c.listChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range channels {
+		channel := &channels[i]
+		if !channel.HasMessages() {
+			continue
+		}
+		if err := c.syncChannel(ctx, guild, channel, nil, previous, next, cutoff, emit); err != nil {
+			return err
+		}
+	}
+
+	if !c.config.IncludeThreads {
+		return nil
+	}
+
+	threads, parents, err := c.listThreads(ctx, channels, cutoff)
+	if err != nil {
+		return err
+	}
+	for i := range threads {
+		thread := &threads[i]
+		if err := c.syncChannel(ctx, guild, thread, parents[thread.ParentID], previous, next, cutoff, emit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncChannel emits the messages of one channel or thread.
+// Without a previous message ID the history is read newest first back to the
+// cutoff; otherwise only messages after that ID are read.
+func (c *Connector) syncChannel(
+	ctx context.Context, guild *Guild, channel, parent *Channel,
+	previous, next *Cursor, cutoff time.Time, emit emitFunc,
+) error {
+	lastID := previous.LastMessageID(channel.ID)
+	next.SetLastMessageID(channel.ID, lastID)
+
+	// Skip channels with nothing new, or nothing inside the age limit
+	if lastID != "" && channel.LastMessageID != "" && !snowflakeAfter(channel.LastMessageID, lastID) {
+		return nil
+	}
+	if latest, ok := snowflakeTime(channel.LastMessageID); ok && !cutoff.IsZero() && latest.Before(cutoff) {
+		return nil
+	}
+
+	send := func(msg *Message) error {
+		next.SetLastMessageID(channel.ID, msg.ID)
+		if !msg.IsIndexable() {
+			return nil
+		}
+		return emit(MessageToRawDocument(msg, guild, channel, parent, c.sourceID))
+	}
+
+	var err error
+	if lastID == "" {
+		err = c.readHistory(ctx, channel.ID, cutoff, send)
+	} else {
+		err = c.readAfter(ctx, channel.ID, lastID, send)
+	}
+
+	// Channels the bot cannot read are skipped unless they were configured
+	if errors.Is(err, ErrForbidden) && len(c.config.ChannelIDs) == 0 {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("list messages for channel %s: %w", channel.ID, err)
+	}
+	return nil
+}
+
+// readHistory pages back from the newest message until the cutoff.
+func (c *Connector) readHistory(
+	ctx context.Context, channelID string, cutoff time.Time, send func(*Message) error,
+) error {
+	before := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		messages, err := c.client.MessagesBefore(ctx, channelID, before)
+		if err != nil {
+			return err
+		}
+		for i := range messages {
+			if !cutoff.IsZero() && messages[i].Timestamp.Before(cutoff) {
+				return nil
+			}
+			if err := send(&messages[i]); err != nil {
+				return err
+			}
+		}
+		if len(messages) < pageSize {
+			return nil
+		}
+		before = messages[len(messages)-1].ID
+	}
+}
+
+// readAfter pages forward through the messages after the given ID.
+func (c *Connector) readAfter(
+	ctx context.Context, channelID, after string, send func(*Message) error,
+) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		messages, err := c.client.MessagesAfter(ctx, channelID, after)
+		if err != nil {
+			return err
+		}
+		for i := range messages {
+			if err := send(&messages[i]); err != nil {
+				return err
+			}
+			if snowflakeAfter(messages[i].ID, after) {
+				after = messages[i].ID
+			}
+		}
+		if len(messages) < pageSize {
+			return nil
+		}
+	}
+}
+
+// listChannels returns the configured channels, or all channels of the guild
+// that hold messages or threads.
+func (c *Connector) listChannels(ctx context.Context) ([]Channel, error) {
+	if len(c.config.ChannelIDs) == 0 {
+		all, err := c.client.GuildChannels(ctx, c.config.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("list channels: %w", err)
+		}
+		channels := make([]Channel, 0, len(all))
+		for _, ch := range all {
+			if ch.HasMessages() || ch.HasThreads() {
+				channels = append(channels, ch)
+			}
+		}
+		return channels, nil
+	}
+
+	channels := make([]Channel, 0, len(c.config.ChannelIDs))
+	for _, id := range c.config.ChannelIDs {
+		channel, err := c.client.Channel(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get channel %s: %w", id, err)
+		}
+		channels = append(channels, *channel)
+	}
+	return channels, nil
+}
+
+// listThreads returns the active and archived public threads of the given
+// channels, keyed to their parent channels. Archived threads closed before
+// the cutoff are skipped.
+func (c *Connector) listThreads(
+	ctx context.Context, channels []Channel, cutoff time.Time,
+) ([]Channel, map[string]*Channel, error) {
+	parents := make(map[string]*Channel)
+	for i := range channels {
+		if channels[i].HasThreads() {
+			parents[channels[i].ID] = &channels[i]
+		}
+	}
+	if len(parents) == 0 {
+		return nil, parents, nil
+	}
+
+	var threads []Channel
+	seen := make(map[string]bool)
+	add := func(thread Channel) {
+		if _, ok := parents[thread.ParentID]; ok && !seen[thread.ID] {
+			seen[thread.ID] = true
+			threads = append(threads, thread)
+		}
+	}
+
+	active, err := c.client.ActiveThreads(ctx, c.config.GuildID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list active threads: %w", err)
+	}
+	for _, thread := range active {
+		add(thread)
+	}
+
+	for _, ch := range channels {
+		if !ch.HasThreads() {
+			continue
+		}
+		archived, err := c.archivedThreads(ctx, ch.ID, cutoff)
+		if errors.Is(err, ErrForbidden) && len(c.config.ChannelIDs) == 0 {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("list archived threads for channel %s: %w", ch.ID, err)
+		}
+		for _, thread := range archived {
+			add(thread)
+		}
+	}
+
+	return threads, parents, nil
+}
+
+// archivedThreads pages through a channel's archived public threads,
+// stopping at the first thread archived before the cutoff.
+func (c *Connector) archivedThreads(ctx context.Context, channelID string, cutoff time.Time) ([]Channel, error) {
+	var all []Channel
+	var before time.Time
+	for {
+		threads, hasMore, err := c.client.ArchivedPublicThreads(ctx, channelID, before)
+		if err != nil {
+			return nil, err
+		}
+		for _, thread := range threads {
+			if thread.ThreadMetadata == nil {
+				continue
+			}
+			if !cutoff.IsZero() && thread.ThreadMetadata.ArchiveTimestamp.Before(cutoff) {
+				return all, nil
+			}
+			all = append(all, thread)
+			before = thread.ThreadMetadata.ArchiveTimestamp
+		}
+		if !hasMore || len(threads) == 0 {
+			return all, nil
+		}
+	}
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for Discord (the gateway needs a long-lived socket).
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier fetches the bot's username for the given token.
+func (c *Connector) GetAccountIdentifier(ctx context.Context, accessToken string) (string, error) {
+	client := NewClient(staticToken(accessToken))
+	client.baseURL = c.client.baseURL
+	user, err := client.Me(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.Username, nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+// staticToken is a TokenProvider for a token supplied directly.
+type staticToken string
+
+func (t staticToken) GetToken(_ context.Context) (string, error) { return string(t), nil }
+func (t staticToken) AuthorizationID() string                    { return "" }
+func (t staticToken) AuthMethod() domain.AuthMethod              { return domain.AuthMethodPAT }
+func (t staticToken) IsAuthenticated() bool                      { return t != "" }
@@ -0,0 +1,388 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct {
+	token string
+}
+
+func (p *mockTokenProvider) GetToken(_ context.Context) (string, error) { return p.token, nil }
+func (p *mockTokenProvider) AuthorizationID() string                    { return "test-auth" }
+func (p *mockTokenProvider) AuthMethod() domain.AuthMethod              { return domain.AuthMethodPAT }
+func (p *mockTokenProvider) IsAuthenticated() bool                      { return p.token != "" }
+
+// snowflakeAt returns the n-th snowflake ID created at t.
+func snowflakeAt(t time.Time, n int) string {
+	return strconv.FormatUint(uint64(t.UnixMilli()-discordEpoch)<<22+uint64(n), 10)
+}
+
+// fakeDiscord serves a minimal Discord API backed by in-memory channels.
+type fakeDiscord struct {
+	mu        sync.Mutex
+	channels  []Channel
+	active    []Channel
+	archived  map[string][]Channel
+	messages  map[string][]Message
+	forbidden map[string]bool
+	throttle  int
+}
+
+// post adds a message to a channel and updates its last message ID.
+func (f *fakeDiscord) post(channelID string, msg Message) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	msg.ChannelID = channelID
+	f.messages[channelID] = append(f.messages[channelID], msg)
+	for _, list := range [][]Channel{f.channels, f.active} {
+		for i := range list {
+			if list[i].ID == channelID {
+				list[i].LastMessageID = msg.ID
+			}
+		}
+	}
+}
+
+func (f *fakeDiscord) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bot token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if f.throttle > 0 {
+		f.throttle--
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"retry_after": 0.01, "global": false}`))
+		return
+	}
+
+	path := r.URL.Path
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	var body any
+	switch {
+	case path == "/users/@me":
+		body = User{ID: "1", Username: "sercha-bot"}
+	case path == "/guilds/100":
+		body = Guild{ID: "100", Name: "Devs"}
+	case path == "/guilds/100/channels":
+		body = f.channels
+	case path == "/guilds/100/threads/active":
+		body = map[string]any{"threads": f.active}
+	case len(parts) == 2 && parts[0] == "channels":
+		for _, ch := range f.channels {
+			if ch.ID == parts[1] {
+				body = ch
+			}
+		}
+	case len(parts) == 3 && parts[2] == "messages":
+		if f.forbidden[parts[1]] {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body = f.page(parts[1], r.URL.Query().Get("before"), r.URL.Query().Get("after"))
+	case strings.HasSuffix(path, "/threads/archived/public"):
+		body = map[string]any{"threads": f.archived[parts[1]], "has_more": false}
+	}
+
+	if body == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// page returns up to pageSize messages before or after an ID, newest first.
+func (f *fakeDiscord) page(channelID, before, after string) []Message {
+	var matched []Message
+	for _, msg := range f.messages[channelID] {
+		if before != "" && !snowflakeAfter(before, msg.ID) {
+			continue
+		}
+		if after != "" && !snowflakeAfter(msg.ID, after) {
+			continue
+		}
+		matched = append(matched, msg)
+	}
+	sort.Slice(matched, func(i, j int) bool { return snowflakeAfter(matched[i].ID, matched[j].ID) })
+	if len(matched) <= pageSize {
+		return matched
+	}
+	if after != "" {
+		// Discord returns the oldest page after the given ID
+		return matched[len(matched)-pageSize:]
+	}
+	return matched[:pageSize]
+}
+
+var testStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newFakeDiscord returns a server with a text channel with a thread, a forum
+// with an archived thread, a voice channel and a channel the bot cannot read.
+func newFakeDiscord() *fakeDiscord {
+	f := &fakeDiscord{
+		channels: []Channel{
+			{ID: "200", Type: ChannelTypeText, Name: "general"},
+			{ID: "201", Type: 2, Name: "voice"},
+			{ID: "202", Type: ChannelTypeForum, Name: "help"},
+			{ID: "203", Type: ChannelTypeText, Name: "staff"},
+		},
+		active: []Channel{
+			{ID: "250", Type: ChannelTypePublicThread, Name: "storage", ParentID: "200"},
+			{ID: "299", Type: ChannelTypePublicThread, Name: "elsewhere", ParentID: "999"},
+		},
+		archived: map[string][]Channel{
+			"202": {{
+				ID: "260", Type: ChannelTypePublicThread, Name: "install fails", ParentID: "202",
+				ThreadMetadata: &ThreadMetadata{Archived: true, ArchiveTimestamp: testStart.Add(2 * time.Hour)},
+			}},
+		},
+		messages:  map[string][]Message{},
+		forbidden: map[string]bool{"203": true},
+	}
+	author := User{ID: "2", Username: "ada"}
+	f.post("200", Message{ID: snowflakeAt(testStart, 1), Author: author, Content: "hello", Timestamp: testStart})
+	f.post("200", Message{ID: snowflakeAt(testStart, 2), Type: 7, Author: author, Timestamp: testStart})
+	f.post("250", Message{ID: snowflakeAt(testStart, 3), Author: author, Content: "use sqlite", Timestamp: testStart})
+	f.post("260", Message{ID: snowflakeAt(testStart, 4), Author: author, Content: "try again", Timestamp: testStart})
+	return f
+}
+
+func newTestConnector(t *testing.T, fake *fakeDiscord, cfg *Config, token string) *Connector {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	if cfg.GuildID == "" {
+		cfg.GuildID = "100"
+	}
+	c := New("src-1", cfg, &mockTokenProvider{token: token})
+	c.client.baseURL = server.URL
+	// No proactive throttling against the fake server
+	c.client.rateLimiter.limiter = rate.NewLimiter(rate.Inf, 1)
+	return c
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var out []domain.RawDocument
+	for doc := range docs {
+		out = append(out, doc)
+	}
+	return out, <-errs
+}
+
+func collectChanges(
+	changes <-chan domain.RawDocumentChange, errs <-chan error,
+) ([]domain.RawDocumentChange, error) {
+	var out []domain.RawDocumentChange
+	for change := range changes {
+		out = append(out, change)
+	}
+	return out, <-errs
+}
+
+func docURIs(docs []domain.RawDocument) []string {
+	uris := make([]string, len(docs))
+	for i, doc := range docs {
+		uris[i] = doc.URI
+	}
+	return uris
+}
+
+func TestNew(t *testing.T) {
+	c := New("src-1", &Config{GuildID: "100"}, &mockTokenProvider{token: "token"})
+
+	assert.Equal(t, "discord", c.Type())
+	assert.Equal(t, "src-1", c.SourceID())
+	assert.True(t, c.Capabilities().SupportsIncremental)
+	assert.True(t, c.Capabilities().RequiresAuth)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	t.Run("accepts valid token", func(t *testing.T) {
+		c := newTestConnector(t, newFakeDiscord(), DefaultConfig(), "token")
+		assert.NoError(t, c.Validate(context.Background()))
+	})
+
+	t.Run("accepts token with Bot prefix", func(t *testing.T) {
+		c := newTestConnector(t, newFakeDiscord(), DefaultConfig(), "Bot token")
+		assert.NoError(t, c.Validate(context.Background()))
+	})
+
+	t.Run("rejects invalid token", func(t *testing.T) {
+		c := newTestConnector(t, newFakeDiscord(), DefaultConfig(), "bad")
+		err := c.Validate(context.Background())
+		assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+		assert.ErrorIs(t, err, ErrUnauthorised)
+	})
+
+	t.Run("rejects unknown guild", func(t *testing.T) {
+		c := newTestConnector(t, newFakeDiscord(), &Config{GuildID: "999"}, "token")
+		assert.ErrorIs(t, c.Validate(context.Background()), ErrNotFound)
+	})
+
+	t.Run("fails when closed", func(t *testing.T) {
+		c := newTestConnector(t, newFakeDiscord(), DefaultConfig(), "token")
+		require.NoError(t, c.Close())
+		assert.ErrorIs(t, c.Validate(context.Background()), domain.ErrConnectorClosed)
+	})
+}
+
+func TestConnector_FullSync(t *testing.T) {
+	t.Run("syncs readable channels and threads", func(t *testing.T) {
+		c := newTestConnector(t, newFakeDiscord(), DefaultConfig(), "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		cursor, decodeErr := DecodeCursor(complete.NewCursor)
+		require.NoError(t, decodeErr)
+		assert.False(t, cursor.IsEmpty())
+		assert.Equal(t, snowflakeAt(testStart, 2), cursor.LastMessageID("200"))
+
+		assert.Equal(t, []string{
+			"discord://100/200/" + snowflakeAt(testStart, 1),
+			"discord://100/250/" + snowflakeAt(testStart, 3),
+			"discord://100/260/" + snowflakeAt(testStart, 4),
+		}, docURIs(docs))
+		assert.Equal(t, "general", docs[1].Metadata["parent_channel_name"])
+		assert.Equal(t, "help", docs[2].Metadata["parent_channel_name"])
+	})
+
+	t.Run("skips threads when disabled", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.IncludeThreads = false
+		c := newTestConnector(t, newFakeDiscord(), cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		assert.Equal(t, []string{"discord://100/200/" + snowflakeAt(testStart, 1)}, docURIs(docs))
+	})
+
+	t.Run("pages through long histories", func(t *testing.T) {
+		fake := newFakeDiscord()
+		for i := 0; i < 150; i++ {
+			ts := testStart.Add(time.Duration(i+1) * time.Minute)
+			fake.post("200", Message{ID: snowflakeAt(ts, 0), Content: "msg", Timestamp: ts})
+		}
+		cfg := DefaultConfig()
+		cfg.ChannelIDs = []string{"200"}
+		cfg.IncludeThreads = false
+		c := newTestConnector(t, fake, cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		assert.Len(t, docs, 151)
+	})
+
+	t.Run("skips messages older than max age", func(t *testing.T) {
+		fake := newFakeDiscord()
+		recent := time.Now().Add(-time.Hour)
+		fake.post("200", Message{ID: snowflakeAt(recent, 0), Content: "recent", Timestamp: recent})
+		cfg := DefaultConfig()
+		cfg.MaxAgeDays = 7
+		c := newTestConnector(t, fake, cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		assert.Equal(t, []string{"discord://100/200/" + snowflakeAt(recent, 0)}, docURIs(docs))
+	})
+
+	t.Run("fails for configured channel the bot cannot read", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.ChannelIDs = []string{"203"}
+		c := newTestConnector(t, newFakeDiscord(), cfg, "token")
+
+		_, err := collectDocs(c.FullSync(context.Background()))
+
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("retries rate limited requests", func(t *testing.T) {
+		fake := newFakeDiscord()
+		fake.throttle = 2
+		c := newTestConnector(t, fake, DefaultConfig(), "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		assert.Len(t, docs, 3)
+	})
+}
+
+func TestConnector_IncrementalSync(t *testing.T) {
+	fake := newFakeDiscord()
+	c := newTestConnector(t, fake, DefaultConfig(), "token")
+	_, err := collectDocs(c.FullSync(context.Background()))
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	state := domain.SyncState{SourceID: "src-1", Cursor: complete.NewCursor}
+
+	t.Run("emits messages posted since the last sync", func(t *testing.T) {
+		later := testStart.Add(24 * time.Hour)
+		fake.post("250", Message{ID: snowflakeAt(later, 1), Content: "follow-up", Timestamp: later})
+
+		changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+		var done *driven.SyncComplete
+		require.ErrorAs(t, err, &done)
+		require.Len(t, changes, 1)
+		assert.Equal(t, domain.ChangeCreated, changes[0].Type)
+		assert.Equal(t, "discord://100/250/"+snowflakeAt(later, 1), changes[0].Document.URI)
+
+		cursor, decodeErr := DecodeCursor(done.NewCursor)
+		require.NoError(t, decodeErr)
+		assert.Equal(t, snowflakeAt(later, 1), cursor.LastMessageID("250"))
+		assert.Equal(t, snowflakeAt(testStart, 2), cursor.LastMessageID("200"))
+	})
+
+	t.Run("requires a cursor", func(t *testing.T) {
+		_, err := collectChanges(c.IncrementalSync(context.Background(), domain.SyncState{}))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "full sync required")
+	})
+}
+
+func TestConnector_GetAccountIdentifier(t *testing.T) {
+	c := newTestConnector(t, newFakeDiscord(), DefaultConfig(), "ignored")
+
+	username, err := c.GetAccountIdentifier(context.Background(), "token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "sercha-bot", username)
+}
+
+func TestConnector_Watch(t *testing.T) {
+	c := New("src-1", &Config{GuildID: "100"}, &mockTokenProvider{token: "token"})
+	_, err := c.Watch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
@@ -0,0 +1,90 @@
+package discord
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor stores the newest message ID synced in each channel and thread.
+// Message IDs are snowflakes that increase over time, so incremental syncs
+// fetch only messages after the stored ID.
+type Cursor struct {
+	Version  int               `json:"v"`
+	SyncedAt time.Time         `json:"synced_at"`
+	Channels map[string]string `json:"channels,omitempty"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version:  CursorVersion,
+		Channels: make(map[string]string),
+	}
+}
+
+// Encode serialises the cursor to a base64 string.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Channels == nil {
+		cursor.Channels = make(map[string]string)
+	}
+
+	return &cursor, nil
+}
+
+// IsEmpty returns true if the cursor was not written by a completed sync.
+func (c *Cursor) IsEmpty() bool {
+	return c.SyncedAt.IsZero()
+}
+
+// LastMessageID returns the newest synced message ID of a channel, if any.
+func (c *Cursor) LastMessageID(channelID string) string {
+	return c.Channels[channelID]
+}
+
+// SetLastMessageID records the newest synced message ID of a channel.
+// Older IDs never replace newer ones.
+func (c *Cursor) SetLastMessageID(channelID, messageID string) {
+	if messageID == "" || !snowflakeAfter(messageID, c.Channels[channelID]) {
+		return
+	}
+	c.Channels[channelID] = messageID
+}
+
+// SetSyncedAt records when the sync started.
+func (c *Cursor) SetSyncedAt(t time.Time) {
+	c.SyncedAt = t.UTC()
+}
@@ -0,0 +1,54 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := NewCursor()
+	cursor.SetSyncedAt(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	cursor.SetLastMessageID("200", "1000")
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	assert.False(t, decoded.IsEmpty())
+	assert.Equal(t, "1000", decoded.LastMessageID("200"))
+	assert.Empty(t, decoded.LastMessageID("300"))
+}
+
+func TestCursor_SetLastMessageIDKeepsNewest(t *testing.T) {
+	cursor := NewCursor()
+
+	cursor.SetLastMessageID("200", "1000")
+	cursor.SetLastMessageID("200", "999")
+	cursor.SetLastMessageID("200", "")
+	assert.Equal(t, "1000", cursor.LastMessageID("200"))
+
+	cursor.SetLastMessageID("200", "1001")
+	assert.Equal(t, "1001", cursor.LastMessageID("200"))
+}
+
+func TestDecodeCursor(t *testing.T) {
+	t.Run("empty string gives empty cursor", func(t *testing.T) {
+		cursor, err := DecodeCursor("")
+		require.NoError(t, err)
+		assert.True(t, cursor.IsEmpty())
+	})
+
+	t.Run("rejects garbage", func(t *testing.T) {
+		_, err := DecodeCursor("not base64!")
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("rejects newer versions", func(t *testing.T) {
+		cursor := NewCursor()
+		cursor.Version = CursorVersion + 1
+		_, err := DecodeCursor(cursor.Encode())
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,42 @@
+// Package discord provides a Connector for the messages of a Discord server.
+//
+// The connector authenticates as a bot, using a token from an application
+// registered in the Discord Developer Portal. The bot must be invited to the
+// server and needs the Message Content privileged intent, without which
+// Discord returns messages with empty content.
+//
+// # Configuration
+//
+//   - guild_id: ID of the server to index (required)
+//   - channel_ids: comma-separated channel IDs. Default: every text,
+//     announcement and forum channel the bot can read.
+//   - include_threads: also index active and archived public threads
+//     (true/false). Default: true.
+//   - max_age_days: skip messages older than this many days. Default: 0 (no limit).
+//
+// # Sync Operations
+//
+// Full sync pages through each channel's history with the REST API v10
+// messages endpoint, newest first, using the before parameter. Threads are
+// listed from the guild's active threads and each channel's archived public
+// threads, and their messages are read the same way.
+//
+// Message IDs are snowflakes that grow over time, so the cursor stores the
+// newest message ID per channel and thread. Incremental sync reads only the
+// messages after it, and channels whose last message has not changed are
+// skipped without reading their history.
+//
+// # Document Structure
+//
+// Each message is a Markdown document with the URI
+// discord://{guildId}/{channelId}/{messageId}. Thread messages use the
+// thread's ID as the channel ID. System messages (joins, pins, boosts) and
+// messages without text or attachments are skipped.
+//
+// # Limitations
+//
+//   - Edited and deleted messages are only picked up by a full sync
+//   - Private threads are not indexed
+//   - Attachment contents are not indexed, only their file names
+//   - Watch mode is not supported (the gateway needs a long-lived connection)
+package discord
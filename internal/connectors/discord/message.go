@@ -0,0 +1,212 @@
+package discord
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// discordEpoch is the start of Discord snowflake timestamps (2015-01-01 UTC),
+// in Unix milliseconds.
+const discordEpoch = 1420070400000
+
+// Channel types that are synced.
+// See https://discord.com/developers/docs/resources/channel#channel-object-channel-types.
+const (
+	ChannelTypeText               = 0
+	ChannelTypeAnnouncement       = 5
+	ChannelTypeAnnouncementThread = 10
+	ChannelTypePublicThread       = 11
+	ChannelTypePrivateThread      = 12
+	ChannelTypeForum              = 15
+)
+
+// Message types that carry user content. System messages (joins, pins,
+// boosts) are skipped.
+const (
+	MessageTypeDefault = 0
+	MessageTypeReply   = 19
+)
+
+// User is a Discord user or bot account.
+type User struct {
+	ID         string `json:"id"`
+	Username   string `json:"username"`
+	GlobalName string `json:"global_name"`
+}
+
+// DisplayName returns the user's display name, falling back to the username.
+func (u User) DisplayName() string {
+	if u.GlobalName != "" {
+		return u.GlobalName
+	}
+	return u.Username
+}
+
+// Guild is a Discord server.
+type Guild struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ThreadMetadata holds the archive state of a thread.
+type ThreadMetadata struct {
+	Archived         bool      `json:"archived"`
+	ArchiveTimestamp time.Time `json:"archive_timestamp"`
+}
+
+// Channel is a guild channel or thread.
+type Channel struct {
+	ID             string          `json:"id"`
+	Type           int             `json:"type"`
+	GuildID        string          `json:"guild_id"`
+	Name           string          `json:"name"`
+	ParentID       string          `json:"parent_id"`
+	LastMessageID  string          `json:"last_message_id"`
+	ThreadMetadata *ThreadMetadata `json:"thread_metadata,omitempty"`
+}
+
+// IsThread reports whether the channel is a thread.
+func (c *Channel) IsThread() bool {
+	return c.Type == ChannelTypeAnnouncementThread || c.Type == ChannelTypePublicThread ||
+		c.Type == ChannelTypePrivateThread
+}
+
+// HasMessages reports whether messages can be listed in the channel directly.
+// Forum channels hold only threads.
+func (c *Channel) HasMessages() bool {
+	return c.Type == ChannelTypeText || c.Type == ChannelTypeAnnouncement || c.IsThread()
+}
+
+// HasThreads reports whether the channel can contain threads.
+func (c *Channel) HasThreads() bool {
+	return c.Type == ChannelTypeText || c.Type == ChannelTypeAnnouncement || c.Type == ChannelTypeForum
+}
+
+// Attachment is a file attached to a message.
+type Attachment struct {
+	Filename string `json:"filename"`
+	URL      string `json:"url"`
+}
+
+// Message is a message in a channel or thread.
+type Message struct {
+	ID              string       `json:"id"`
+	ChannelID       string       `json:"channel_id"`
+	Type            int          `json:"type"`
+	Author          User         `json:"author"`
+	Content         string       `json:"content"`
+	Timestamp       time.Time    `json:"timestamp"`
+	EditedTimestamp *time.Time   `json:"edited_timestamp"`
+	Attachments     []Attachment `json:"attachments"`
+}
+
+// IsIndexable reports whether the message carries user content worth indexing.
+func (m *Message) IsIndexable() bool {
+	if m.Type != MessageTypeDefault && m.Type != MessageTypeReply {
+		return false
+	}
+	return strings.TrimSpace(m.Content) != "" || len(m.Attachments) > 0
+}
+
+// ChannelURI returns the URI of a channel or thread.
+func ChannelURI(guildID, channelID string) string {
+	return fmt.Sprintf("discord://%s/%s", guildID, channelID)
+}
+
+// MessageURI returns the document URI for a message.
+func MessageURI(guildID, channelID, messageID string) string {
+	return fmt.Sprintf("discord://%s/%s/%s", guildID, channelID, messageID)
+}
+
+// MessageToRawDocument converts a message to a markdown RawDocument.
+// parent is the channel the thread belongs to, or nil for channel messages.
+func MessageToRawDocument(
+	msg *Message, guild *Guild, channel, parent *Channel, sourceID string,
+) *domain.RawDocument {
+	author := msg.Author.DisplayName()
+	metadata := map[string]any{
+		"guild_id":     guild.ID,
+		"guild_name":   guild.Name,
+		"channel_id":   channel.ID,
+		"channel_name": channel.Name,
+		"message_id":   msg.ID,
+		"author":       author,
+		"author_id":    msg.Author.ID,
+		"title":        fmt.Sprintf("%s in #%s", author, channel.Name),
+		"timestamp":    msg.Timestamp.Format(time.RFC3339),
+		"url":          "https://discord.com/channels/" + strings.Join([]string{guild.ID, channel.ID, msg.ID}, "/"),
+	}
+	if msg.EditedTimestamp != nil {
+		metadata["edited_at"] = msg.EditedTimestamp.Format(time.RFC3339)
+	}
+	if parent != nil {
+		metadata["thread"] = true
+		metadata["parent_channel_id"] = parent.ID
+		metadata["parent_channel_name"] = parent.Name
+	}
+
+	parentURI := ChannelURI(guild.ID, channel.ID)
+
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       MessageURI(guild.ID, channel.ID, msg.ID),
+		MIMEType:  "text/markdown",
+		Content:   []byte(renderMessage(msg, guild, channel, parent)),
+		Metadata:  metadata,
+		ParentURI: &parentURI,
+	}
+}
+
+// renderMessage builds the markdown content for a message.
+func renderMessage(msg *Message, guild *Guild, channel, parent *Channel) string {
+	var b strings.Builder
+
+	location := "#" + channel.Name
+	if parent != nil {
+		location = fmt.Sprintf("#%s > %s", parent.Name, channel.Name)
+	}
+	fmt.Fprintf(&b, "**%s** in %s (%s)\n", msg.Author.DisplayName(), location,
+		msg.Timestamp.Format("2006-01-02 15:04"))
+	fmt.Fprintf(&b, "Server: %s\n", guild.Name)
+
+	if content := strings.TrimSpace(msg.Content); content != "" {
+		fmt.Fprintf(&b, "\n%s\n", content)
+	}
+
+	if len(msg.Attachments) > 0 {
+		names := make([]string, len(msg.Attachments))
+		for i, a := range msg.Attachments {
+			names[i] = a.Filename
+		}
+		fmt.Fprintf(&b, "\nAttachments: %s\n", strings.Join(names, ", "))
+	}
+
+	return b.String()
+}
+
+// snowflakeTime returns the creation time embedded in a Discord snowflake ID.
+func snowflakeTime(id string) (time.Time, bool) {
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(n>>22) + discordEpoch), true
+}
+
+// snowflakeAfter reports whether snowflake a is newer than b.
+// Any valid ID is newer than an empty or invalid one.
+func snowflakeAfter(a, b string) bool {
+	x, err := strconv.ParseUint(a, 10, 64)
+	if err != nil {
+		return false
+	}
+	y, err := strconv.ParseUint(b, 10, 64)
+	if err != nil {
+		return true
+	}
+	return x > y
+}
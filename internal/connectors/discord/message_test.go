@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageToRawDocument(t *testing.T) {
+	edited := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	msg := &Message{
+		ID:              "300",
+		Author:          User{ID: "1", Username: "ada", GlobalName: "Ada"},
+		Content:         "We decided to use SQLite.",
+		Timestamp:       time.Date(2026, 1, 2, 9, 30, 0, 0, time.UTC),
+		EditedTimestamp: &edited,
+		Attachments:     []Attachment{{Filename: "notes.txt"}},
+	}
+	guild := &Guild{ID: "100", Name: "Devs"}
+	thread := &Channel{ID: "250", Name: "storage", Type: ChannelTypePublicThread}
+	parent := &Channel{ID: "200", Name: "architecture"}
+
+	doc := MessageToRawDocument(msg, guild, thread, parent, "src-1")
+
+	assert.Equal(t, "discord://100/250/300", doc.URI)
+	assert.Equal(t, "text/markdown", doc.MIMEType)
+	require.NotNil(t, doc.ParentURI)
+	assert.Equal(t, "discord://100/250", *doc.ParentURI)
+	assert.Equal(t, "Ada", doc.Metadata["author"])
+	assert.Equal(t, "200", doc.Metadata["parent_channel_id"])
+	assert.Equal(t, "https://discord.com/channels/100/250/300", doc.Metadata["url"])
+	assert.Equal(t, "2026-01-02T10:00:00Z", doc.Metadata["edited_at"])
+
+	content := string(doc.Content)
+	assert.Contains(t, content, "**Ada** in #architecture > storage (2026-01-02 09:30)")
+	assert.Contains(t, content, "We decided to use SQLite.")
+	assert.Contains(t, content, "Attachments: notes.txt")
+}
+
+func TestMessage_IsIndexable(t *testing.T) {
+	assert.True(t, (&Message{Content: "hi"}).IsIndexable())
+	assert.True(t, (&Message{Type: MessageTypeReply, Content: "hi"}).IsIndexable())
+	assert.True(t, (&Message{Attachments: []Attachment{{Filename: "a.png"}}}).IsIndexable())
+	assert.False(t, (&Message{Content: "  "}).IsIndexable())
+	assert.False(t, (&Message{Type: 7, Content: "joined"}).IsIndexable())
+}
+
+func TestSnowflakeTime(t *testing.T) {
+	// Example snowflake from the Discord API reference
+	created, ok := snowflakeTime("175928847299117063")
+	require.True(t, ok)
+	assert.Equal(t, time.UnixMilli(1462015105796), created)
+
+	_, ok = snowflakeTime("abc")
+	assert.False(t, ok)
+}
+
+func TestSnowflakeAfter(t *testing.T) {
+	assert.True(t, snowflakeAfter("1001", "1000"))
+	assert.False(t, snowflakeAfter("999", "1000"))
+	assert.True(t, snowflakeAfter("1", ""))
+	assert.False(t, snowflakeAfter("", "1"))
+}
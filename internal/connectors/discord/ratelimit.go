@@ -0,0 +1,63 @@
+package discord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Rate limit configuration for the Discord API.
+// Bots may make 50 requests per second globally, with tighter per-route
+// buckets; we stay well below both.
+const (
+	// RequestsPerSecond is the sustained rate limit.
+	RequestsPerSecond = 5.0
+	// BurstSize is the maximum burst size.
+	BurstSize = 5
+)
+
+// RateLimiter provides rate limiting for Discord API requests.
+// It uses a token bucket algorithm with a backoff period after 429 responses.
+type RateLimiter struct {
+	mu      sync.Mutex
+	limiter *rate.Limiter
+	retryAt time.Time
+}
+
+// NewRateLimiter creates a new rate limiter for Discord.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(RequestsPerSecond), BurstSize),
+	}
+}
+
+// Wait blocks until a request can be made without exceeding the rate limit.
+// It also respects any backoff period set by RecordRateLimitError.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	retryAt := r.retryAt
+	r.mu.Unlock()
+
+	if time.Now().Before(retryAt) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(retryAt)):
+		}
+	}
+
+	return r.limiter.Wait(ctx)
+}
+
+// RecordRateLimitError sets a backoff period after a 429 response.
+// Discord reports how long to wait; a missing value backs off for one second.
+func (r *RateLimiter) RecordRateLimitError(retryAfter time.Duration) {
+	if retryAfter <= 0 {
+		retryAfter = time.Second
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.retryAt = time.Now().Add(retryAfter)
+}
@@ -0,0 +1,14 @@
+package discord
+
+import "strings"
+
+// ResolveWebURL converts a discord:// URI to a web URL.
+// URI format: discord://{guildId}/{channelId}/{messageId}.
+// Discord links to guilds, channels and messages share the same path layout.
+func ResolveWebURL(uri string, _ map[string]any) string {
+	rest, ok := strings.CutPrefix(uri, "discord://")
+	if !ok || rest == "" {
+		return ""
+	}
+	return "https://discord.com/channels/" + rest
+}
@@ -0,0 +1,42 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveWebURL(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+		want string
+	}{
+		{
+			name: "message",
+			uri:  "discord://100/200/300",
+			want: "https://discord.com/channels/100/200/300",
+		},
+		{
+			name: "channel",
+			uri:  "discord://100/200",
+			want: "https://discord.com/channels/100/200",
+		},
+		{
+			name: "non-discord URI",
+			uri:  "trello://b1/c1",
+			want: "",
+		},
+		{
+			name: "prefix only",
+			uri:  "discord://",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveWebURL(tt.uri, nil))
+		})
+	}
+}
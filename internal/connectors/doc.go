@@ -1,6 +1,6 @@
 // Package connectors provides implementations of the Connector interface
 // for various document sources. Each connector knows how to fetch documents
-// from a specific source type (filesystem, Notion, etc.).
+// from a specific source type (filesystem, Notion, Trello, etc.).
 //
 // Connectors are registered with the ConnectorFactory at startup.
 package connectors
@@ -119,13 +119,18 @@ func (h *OAuthHandler) RefreshToken(
 	}, nil
 }
 
-// GetUserInfo fetches the user's email from Dropbox.
-func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (string, error) {
+// GetUserInfo fetches the user's account details from Dropbox.
+// The identifier is the user's email.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error) {
 	userInfo, err := GetUserInfo(ctx, accessToken)
 	if err != nil {
-		return "", err
+		return domain.AccountInfo{}, err
 	}
-	return userInfo.Email, nil
+	return domain.AccountInfo{
+		Identifier:  userInfo.Email,
+		DisplayName: userInfo.Name.DisplayName,
+		Email:       userInfo.Email,
+	}, nil
 }
 
 // DefaultConfig returns default OAuth URLs and scopes for Dropbox.
@@ -3,8 +3,12 @@ package connectors
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"sync"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/basecamp"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/discord"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/dropbox"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/filesystem"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/github"
@@ -12,13 +16,19 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/connectors/google/calendar"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/google/drive"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/google/gmail"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/httpcache"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft"
 	mscalendar "github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/calendar"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/onedrive"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/outlook"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/notion"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/obsidianpublish"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/slack"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/sqlite"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/trello"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
 // Ensure Factory implements the interface.
@@ -30,12 +40,33 @@ type TokenProviderFactory interface {
 	CreateTokenProvider(ctx context.Context, source *domain.Source) (driven.TokenProvider, error)
 }
 
+// loggerSetter is implemented by connectors that accept an injected logger.
+type loggerSetter interface {
+	SetLogger(log *slog.Logger)
+}
+
+// mimeTypesSetter is implemented by connectors that only emit documents
+// a normaliser can handle, such as email attachments.
+type mimeTypesSetter interface {
+	SetSupportedMIMETypes(types []string)
+}
+
+// transportSetter is implemented by connectors whose API requests can be
+// routed through a custom HTTP transport, such as the HTTP cache.
+type transportSetter interface {
+	SetTransport(rt http.RoundTripper)
+}
+
 // Factory creates connectors based on source configuration.
 type Factory struct {
 	mu                   sync.RWMutex
 	builders             map[string]driven.ConnectorBuilder
 	oauthHandlers        map[string]OAuthHandler
+	versions             map[string]string
 	tokenProviderFactory TokenProviderFactory
+	log                  *slog.Logger
+	mimeTypes            []string
+	httpCache            *httpcache.Cache
 }
 
 // NewFactory creates a new connector factory with default builders registered.
@@ -44,7 +75,9 @@ func NewFactory(tokenProviderFactory TokenProviderFactory) *Factory {
 	f := &Factory{
 		builders:             make(map[string]driven.ConnectorBuilder),
 		oauthHandlers:        make(map[string]OAuthHandler),
+		versions:             make(map[string]string),
 		tokenProviderFactory: tokenProviderFactory,
+		log:                  logger.Slog(),
 	}
 	f.registerDefaultBuilders()
 	f.registerOAuthHandlers()
@@ -54,11 +87,19 @@ func NewFactory(tokenProviderFactory TokenProviderFactory) *Factory {
 // registerDefaultBuilders registers all built-in connector builders.
 func (f *Factory) registerDefaultBuilders() {
 	f.Register("filesystem", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
-		path, ok := source.Config["path"]
-		if !ok {
-			return nil, fmt.Errorf("filesystem source requires 'path' config")
+		cfg, err := filesystem.ParseConfig(source)
+		if err != nil {
+			return nil, err
 		}
-		return filesystem.New(source.ID, path), nil
+		return filesystem.NewWithConfig(source.ID, cfg), nil
+	})
+
+	f.Register("sqlite", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		cfg, err := sqlite.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("sqlite config: %w", err)
+		}
+		return sqlite.New(source.ID, cfg), nil
 	})
 
 	f.Register("github", func(source domain.Source, tokenProvider driven.TokenProvider) (driven.Connector, error) {
@@ -68,6 +109,7 @@ func (f *Factory) registerDefaultBuilders() {
 		}
 		return github.New(source.ID, cfg, tokenProvider), nil
 	})
+	f.RegisterVersion("github", github.Version)
 
 	f.Register("google-drive", func(
 		source domain.Source, tokenProvider driven.TokenProvider,
@@ -146,6 +188,54 @@ func (f *Factory) registerDefaultBuilders() {
 		}
 		return notion.New(source.ID, cfg, tokenProvider), nil
 	})
+
+	f.Register("trello", func(
+		source domain.Source, tokenProvider driven.TokenProvider,
+	) (driven.Connector, error) {
+		cfg, err := trello.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("trello config: %w", err)
+		}
+		return trello.New(source.ID, cfg, tokenProvider), nil
+	})
+
+	f.Register("basecamp", func(
+		source domain.Source, tokenProvider driven.TokenProvider,
+	) (driven.Connector, error) {
+		cfg, err := basecamp.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("basecamp config: %w", err)
+		}
+		return basecamp.New(source.ID, cfg, tokenProvider), nil
+	})
+
+	f.Register("discord", func(
+		source domain.Source, tokenProvider driven.TokenProvider,
+	) (driven.Connector, error) {
+		cfg, err := discord.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("discord config: %w", err)
+		}
+		return discord.New(source.ID, cfg, tokenProvider), nil
+	})
+
+	f.Register("slack", func(
+		source domain.Source, tokenProvider driven.TokenProvider,
+	) (driven.Connector, error) {
+		cfg, err := slack.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("slack config: %w", err)
+		}
+		return slack.New(source.ID, cfg, tokenProvider), nil
+	})
+
+	f.Register("obsidian-publish", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		cfg, err := obsidianpublish.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("obsidian-publish config: %w", err)
+		}
+		return obsidianpublish.New(source.ID, cfg), nil
+	})
 }
 
 // registerOAuthHandlers registers OAuth handlers for all connector types that support OAuth.
@@ -170,6 +260,12 @@ func (f *Factory) registerOAuthHandlers() {
 
 	// Notion OAuth handler
 	f.RegisterOAuthHandler("notion", notion.NewOAuthHandler())
+
+	// Basecamp OAuth handler
+	f.RegisterOAuthHandler("basecamp", basecamp.NewOAuthHandler())
+
+	// Slack OAuth handler
+	f.RegisterOAuthHandler("slack", slack.NewOAuthHandler())
 }
 
 // Create instantiates a connector for the given source.
@@ -188,7 +284,65 @@ func (f *Factory) Create(ctx context.Context, source domain.Source) (driven.Conn
 		return nil, fmt.Errorf("create token provider for source %s: %w", source.ID, err)
 	}
 
-	return builder(source, tokenProvider)
+	connector, err := builder(source, tokenProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// Give connectors that log a logger scoped to this source
+	if ls, ok := connector.(loggerSetter); ok {
+		f.mu.RLock()
+		log := f.log
+		f.mu.RUnlock()
+		ls.SetLogger(log.With("connector", source.Type, "source_id", source.ID))
+	}
+
+	if ms, ok := connector.(mimeTypesSetter); ok {
+		f.mu.RLock()
+		types := f.mimeTypes
+		f.mu.RUnlock()
+		ms.SetSupportedMIMETypes(types)
+	}
+
+	// Route API requests through the HTTP cache, keyed by source
+	if ts, ok := connector.(transportSetter); ok {
+		f.mu.RLock()
+		cache := f.httpCache
+		f.mu.RUnlock()
+		if cache != nil {
+			ts.SetTransport(cache.Transport(source.ID, nil))
+		}
+	}
+
+	return connector, nil
+}
+
+// SetLogger sets the structured logger passed to connectors.
+// Defaults to the shared application logger.
+func (f *Factory) SetLogger(log *slog.Logger) {
+	if log == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.log = log
+}
+
+// SetSupportedMIMETypes sets the MIME types that have a normaliser.
+// Connectors that embed files, such as Gmail attachments, only emit these types.
+func (f *Factory) SetSupportedMIMETypes(types []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.mimeTypes = types
+}
+
+// SetHTTPCache sets the on-disk cache used for connector GET requests.
+// Connectors created afterwards revalidate cached responses with conditional
+// requests; nil disables caching.
+func (f *Factory) SetHTTPCache(cache *httpcache.Cache) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.httpCache = cache
 }
 
 // Register adds a connector builder for the given type.
@@ -209,6 +363,20 @@ func (f *Factory) SupportedTypes() []string {
 	return types
 }
 
+// RegisterVersion sets the sync state schema version of a connector type.
+func (f *Factory) RegisterVersion(connectorType, version string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.versions[connectorType] = version
+}
+
+// Version returns the sync state schema version of a connector type.
+func (f *Factory) Version(connectorType string) string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.versions[connectorType]
+}
+
 // RegisterOAuthHandler adds an OAuth handler for a connector type.
 func (f *Factory) RegisterOAuthHandler(connectorType string, handler OAuthHandler) {
 	f.mu.Lock()
@@ -264,17 +432,17 @@ func (f *Factory) RefreshToken(
 	return handler.RefreshToken(ctx, authProvider, refreshToken)
 }
 
-// GetUserInfo fetches the account identifier (email/username) for a connector type.
+// GetUserInfo fetches the authenticated account's details for a connector type.
 func (f *Factory) GetUserInfo(
 	ctx context.Context,
 	connectorType string,
 	accessToken string,
-) (string, error) {
+) (domain.AccountInfo, error) {
 	f.mu.RLock()
 	handler, ok := f.oauthHandlers[connectorType]
 	f.mu.RUnlock()
 	if !ok {
-		return "", fmt.Errorf("no OAuth handler for connector type: %s", connectorType)
+		return domain.AccountInfo{}, fmt.Errorf("no OAuth handler for connector type: %s", connectorType)
 	}
 	return handler.GetUserInfo(ctx, accessToken)
 }
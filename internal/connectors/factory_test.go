@@ -1,14 +1,18 @@
 package connectors
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/httpcache"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
@@ -320,8 +324,9 @@ func TestFactory_SupportedTypes(t *testing.T) {
 		supportedTypes := factory.SupportedTypes()
 
 		// All default connectors: filesystem, github, google-drive, gmail, google-calendar,
-		// outlook, onedrive, microsoft-calendar, dropbox, notion
-		assert.Len(t, supportedTypes, 10)
+		// outlook, onedrive, microsoft-calendar, dropbox, notion, trello, basecamp, discord,
+		// slack, sqlite, obsidian-publish
+		assert.Len(t, supportedTypes, 16)
 		assert.Contains(t, supportedTypes, "filesystem")
 		assert.Contains(t, supportedTypes, "github")
 		assert.Contains(t, supportedTypes, "google-drive")
@@ -332,6 +337,12 @@ func TestFactory_SupportedTypes(t *testing.T) {
 		assert.Contains(t, supportedTypes, "microsoft-calendar")
 		assert.Contains(t, supportedTypes, "dropbox")
 		assert.Contains(t, supportedTypes, "notion")
+		assert.Contains(t, supportedTypes, "trello")
+		assert.Contains(t, supportedTypes, "basecamp")
+		assert.Contains(t, supportedTypes, "discord")
+		assert.Contains(t, supportedTypes, "slack")
+		assert.Contains(t, supportedTypes, "sqlite")
+		assert.Contains(t, supportedTypes, "obsidian-publish")
 	})
 
 	t.Run("returns empty slice for factory with no builders", func(t *testing.T) {
@@ -382,6 +393,17 @@ func TestFactory_SupportedTypes(t *testing.T) {
 	})
 }
 
+func TestFactory_Version(t *testing.T) {
+	factory := NewFactory(&mockTokenProviderFactory{})
+
+	assert.Equal(t, "2.0.0", factory.Version("github"))
+	assert.Empty(t, factory.Version("filesystem"))
+	assert.Empty(t, factory.Version("nonexistent"))
+
+	factory.RegisterVersion("filesystem", "1.1.0")
+	assert.Equal(t, "1.1.0", factory.Version("filesystem"))
+}
+
 func TestFactory_ConcurrentCreateAndRegister(t *testing.T) {
 	t.Run("concurrent create and register operations", func(t *testing.T) {
 		ctx := context.Background()
@@ -437,3 +459,97 @@ func TestFactory_ConcurrentCreateAndRegister(t *testing.T) {
 		assert.GreaterOrEqual(t, len(supportedTypes), 6) // At least filesystem + 5 custom types
 	})
 }
+
+// loggingMockConnector records the logger injected by the factory.
+type loggingMockConnector struct {
+	mockConnector
+	log *slog.Logger
+}
+
+func (m *loggingMockConnector) SetLogger(log *slog.Logger) {
+	m.log = log
+}
+
+func TestFactory_Create_InjectsLogger(t *testing.T) {
+	var buf bytes.Buffer
+	factory := NewFactory(&mockTokenProviderFactory{})
+	factory.SetLogger(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	var created *loggingMockConnector
+	factory.Register("logging", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		created = &loggingMockConnector{mockConnector: mockConnector{sourceID: source.ID, connType: "logging"}}
+		return created, nil
+	})
+
+	_, err := factory.Create(context.Background(), domain.Source{ID: "src-1", Type: "logging"})
+	require.NoError(t, err)
+	require.NotNil(t, created.log)
+
+	created.log.Info("hello")
+	assert.Contains(t, buf.String(), "connector=logging")
+	assert.Contains(t, buf.String(), "source_id=src-1")
+}
+
+func TestFactory_SetLogger_IgnoresNil(t *testing.T) {
+	factory := NewFactory(&mockTokenProviderFactory{})
+
+	factory.SetLogger(nil)
+
+	assert.NotNil(t, factory.log)
+}
+
+// mimeTypesMockConnector records the MIME types passed by the factory.
+type mimeTypesMockConnector struct {
+	mockConnector
+	types []string
+}
+
+func (m *mimeTypesMockConnector) SetSupportedMIMETypes(types []string) {
+	m.types = types
+}
+
+func TestFactory_Create_InjectsSupportedMIMETypes(t *testing.T) {
+	factory := NewFactory(&mockTokenProviderFactory{})
+	factory.SetSupportedMIMETypes([]string{"application/pdf", "text/plain"})
+
+	var created *mimeTypesMockConnector
+	factory.Register("attachments", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		created = &mimeTypesMockConnector{mockConnector: mockConnector{sourceID: source.ID, connType: "attachments"}}
+		return created, nil
+	})
+
+	_, err := factory.Create(context.Background(), domain.Source{ID: "src-1", Type: "attachments"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"application/pdf", "text/plain"}, created.types)
+}
+
+// transportMockConnector records the HTTP transport passed by the factory.
+type transportMockConnector struct {
+	mockConnector
+	transport http.RoundTripper
+}
+
+func (m *transportMockConnector) SetTransport(rt http.RoundTripper) {
+	m.transport = rt
+}
+
+func TestFactory_Create_InjectsHTTPCacheTransport(t *testing.T) {
+	factory := NewFactory(&mockTokenProviderFactory{})
+
+	var created *transportMockConnector
+	factory.Register("cached", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		created = &transportMockConnector{mockConnector: mockConnector{sourceID: source.ID, connType: "cached"}}
+		return created, nil
+	})
+
+	// Without a cache the connector keeps its default transport
+	_, err := factory.Create(context.Background(), domain.Source{ID: "src-1", Type: "cached"})
+	require.NoError(t, err)
+	assert.Nil(t, created.transport)
+
+	factory.SetHTTPCache(httpcache.New(t.TempDir(), 1<<20))
+	_, err = factory.Create(context.Background(), domain.Source{ID: "src-1", Type: "cached"})
+	require.NoError(t, err)
+	assert.NotNil(t, created.transport)
+	assert.NotEqual(t, http.DefaultTransport, created.transport)
+}
@@ -0,0 +1,98 @@
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Config holds filesystem connector configuration.
+type Config struct {
+	// Path is the root directory to index.
+	Path string
+	// Paths lists further root directories indexed as part of the same source.
+	Paths []string
+	// SkipLocked skips files that are locked or still being written (default: true).
+	SkipLocked bool
+	// MetadataOnly indexes file names, paths and metadata without reading
+	// file contents (default: false).
+	MetadataOnly bool
+	// Concurrency is the number of files read at once during a full sync
+	// (default: DefaultConcurrency).
+	Concurrency int
+}
+
+// DefaultConcurrency is the default number of files read at once during a
+// full sync. Reads are I/O-bound, so a few workers keep fast storage busy
+// without flooding slow disks.
+const DefaultConcurrency = 4
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		SkipLocked:  true,
+		Concurrency: DefaultConcurrency,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	path, ok := source.Config["path"]
+	cfg.Paths = splitPaths(source.Config["paths"])
+	if !ok && len(cfg.Paths) == 0 {
+		return nil, errors.New("filesystem source requires 'path' config (or 'paths')")
+	}
+	cfg.Path = path
+
+	// Parse skip_locked
+	switch val := source.Config["skip_locked"]; val {
+	case "":
+	case "true", "1":
+		cfg.SkipLocked = true
+	case "false", "0":
+		cfg.SkipLocked = false
+	default:
+		return nil, fmt.Errorf("filesystem skip_locked must be true or false, got %q", val)
+	}
+
+	// Parse metadata_only
+	if val := source.Config["metadata_only"]; val != "" {
+		cfg.MetadataOnly = val == "true" || val == "1"
+	}
+
+	// Parse concurrency
+	if val := source.Config["concurrency"]; val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("filesystem concurrency must be a positive number, got %q", val)
+		}
+		cfg.Concurrency = n
+	}
+
+	return cfg, nil
+}
+
+// Roots returns every configured root directory, Path first.
+func (c *Config) Roots() []string {
+	var roots []string
+	if path := strings.TrimSpace(c.Path); path != "" {
+		roots = append(roots, path)
+	}
+	return append(roots, c.Paths...)
+}
+
+// splitPaths parses a comma-separated list of directories.
+func splitPaths(value string) []string {
+	var paths []string
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
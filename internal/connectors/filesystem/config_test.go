@@ -0,0 +1,116 @@
+package filesystem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+
+	assert.Equal(t, "", cfg.Path)
+	assert.True(t, cfg.SkipLocked)
+}
+
+func TestParseConfig(t *testing.T) {
+	t.Run("requires path", func(t *testing.T) {
+		_, err := ParseConfig(domain.Source{Config: map[string]string{}})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "filesystem source requires 'path' config")
+	})
+
+	t.Run("defaults skip_locked to true", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{"path": "/tmp"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, "/tmp", cfg.Path)
+		assert.True(t, cfg.SkipLocked)
+	})
+
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"true", true},
+		{"1", true},
+		{"false", false},
+		{"0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run("skip_locked="+tt.value, func(t *testing.T) {
+			cfg, err := ParseConfig(domain.Source{Config: map[string]string{
+				"path":        "/tmp",
+				"skip_locked": tt.value,
+			}})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.SkipLocked)
+		})
+	}
+
+	t.Run("rejects invalid skip_locked", func(t *testing.T) {
+		_, err := ParseConfig(domain.Source{Config: map[string]string{"path": "/tmp", "skip_locked": "yes"}})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "skip_locked")
+	})
+
+	t.Run("parses metadata_only", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{
+			"path":          "/tmp",
+			"metadata_only": "true",
+		}})
+
+		require.NoError(t, err)
+		assert.True(t, cfg.MetadataOnly)
+		assert.False(t, DefaultConfig().MetadataOnly)
+	})
+
+	t.Run("parses concurrency", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{"path": "/tmp", "concurrency": "16"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, 16, cfg.Concurrency)
+		assert.Equal(t, DefaultConcurrency, DefaultConfig().Concurrency)
+	})
+
+	for _, value := range []string{"0", "-2", "many"} {
+		t.Run("rejects concurrency="+value, func(t *testing.T) {
+			_, err := ParseConfig(domain.Source{Config: map[string]string{"path": "/tmp", "concurrency": value}})
+
+			assert.ErrorContains(t, err, "concurrency")
+		})
+	}
+}
+
+func TestParseConfig_Paths(t *testing.T) {
+	t.Run("merges path and paths", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{
+			"path":  "/docs",
+			"paths": " /projects , ,/notes",
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/docs", "/projects", "/notes"}, cfg.Roots())
+	})
+
+	t.Run("accepts paths without path", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{"paths": "/docs,/projects"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/docs", "/projects"}, cfg.Roots())
+	})
+
+	t.Run("single path is one root", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{"path": "/docs"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/docs"}, cfg.Roots())
+	})
+}
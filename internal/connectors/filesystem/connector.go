@@ -1,14 +1,18 @@
 package filesystem
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"log/slog"
 	"mime"
+	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -22,53 +26,262 @@ import (
 // Ensure Connector implements the interface.
 var _ driven.Connector = (*Connector)(nil)
 
+// Read retry settings for files that are still being written.
+const (
+	maxReadAttempts = 3
+	readRetryDelay  = 100 * time.Millisecond
+)
+
+var (
+	// errFileLocked is returned when another process holds a lock on the file.
+	errFileLocked = errors.New("file is locked by another process")
+
+	// errFileInProgress is returned when a file keeps changing while being read.
+	errFileInProgress = errors.New("file is still being written")
+
+	// errNotRegular is returned for named pipes, sockets and devices, whose
+	// reads can block or never end.
+	errNotRegular = errors.New("not a regular file")
+)
+
+// afterStat is called between stat and read; tests use it to simulate concurrent writers.
+var afterStat = func(string) {}
+
 // Connector reads documents from the local filesystem.
 type Connector struct {
-	sourceID string
-	rootPath string
-	watcher  *fsnotify.Watcher
-	mu       sync.Mutex
-	closed   bool
+	sourceID     string
+	roots        []string
+	skipLocked   bool
+	metadataOnly bool
+	concurrency  int
+	log          *slog.Logger
+	watcher      *fsnotify.Watcher
+	watchIgnore  *ignoreRules
+	mu           sync.Mutex
+	closed       bool
 }
 
+// New creates a filesystem connector for rootPath using the default configuration.
 func New(sourceID, rootPath string) *Connector {
+	cfg := DefaultConfig()
+	cfg.Path = rootPath
+	return NewWithConfig(sourceID, cfg)
+}
+
+// NewWithConfig creates a filesystem connector from a parsed configuration.
+func NewWithConfig(sourceID string, cfg *Config) *Connector {
 	fail := func(msg string) *Connector {
 		fmt.Println("Error:", msg)
 		fmt.Println("Please provide a valid directory path and retry.")
 		return &Connector{
-			sourceID: sourceID,
-			rootPath: "",
+			sourceID:     sourceID,
+			skipLocked:   cfg.SkipLocked,
+			metadataOnly: cfg.MetadataOnly,
+			concurrency:  cfg.Concurrency,
+			log:          slog.New(slog.DiscardHandler),
 		}
 	}
 
-	rootPath = strings.TrimSpace(rootPath)
-	if rootPath == "" {
+	configured := cfg.Roots()
+	if len(configured) == 0 {
 		return fail("filesystem connector root path is empty")
 	}
 
-	// Expand "~"
-	if rootPath == "~" || strings.HasPrefix(rootPath, "~/") {
+	roots := make([]string, 0, len(configured))
+	for _, root := range configured {
+		resolved, err := resolveRoot(root)
+		if err != nil {
+			return fail(err.Error())
+		}
+		roots = append(roots, resolved)
+	}
+
+	return &Connector{
+		sourceID:     sourceID,
+		roots:        dedupeRoots(roots),
+		skipLocked:   cfg.SkipLocked,
+		metadataOnly: cfg.MetadataOnly,
+		concurrency:  cfg.Concurrency,
+		log:          slog.New(slog.DiscardHandler),
+	}
+}
+
+// resolveRoot expands a root path and returns its clean absolute form.
+func resolveRoot(rootPath string) (string, error) {
+	expanded, err := expandPath(rootPath)
+	if err != nil {
+		return "", err
+	}
+
+	absPath, err := filepath.Abs(expanded)
+	if err != nil {
+		return "", fmt.Errorf("invalid root path %q", rootPath)
+	}
+	return filepath.Clean(absPath), nil
+}
+
+// expandPath expands a leading "~" to the home directory and $VAR or
+// ${VAR} to the value of the environment variable (see expandEnv). A "~"
+// anywhere else is kept literally.
+func expandPath(raw string) (string, error) {
+	path := raw
+	if path == "~" || strings.HasPrefix(path, "~/") {
 		home, err := os.UserHomeDir()
 		if err != nil {
-			return fail("failed to resolve home directory")
+			return "", fmt.Errorf("failed to resolve home directory for %q: %w", raw, err)
 		}
+		path = home + path[1:]
+	}
+	return expandEnv(path), nil
+}
 
-		if rootPath == "~" {
-			rootPath = home
+// expandEnv replaces $NAME and ${NAME} with the values of set environment
+// variables. A reference to an unset variable is kept literally rather than
+// dropped, so "$UNSET/docs" does not become "/docs" and a path such as
+// "/mnt/c/$Recycle.Bin" resolves as written. "$$" is a literal "$".
+func expandEnv(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] != '$' || i+1 == len(path) {
+			b.WriteByte(path[i])
+			continue
+		}
+		if path[i+1] == '$' {
+			b.WriteByte('$')
+			i++
+			continue
+		}
+		name, end := envReference(path, i+1)
+		if name == "" {
+			b.WriteByte('$')
+			continue
+		}
+		if value, ok := os.LookupEnv(name); ok {
+			b.WriteString(value)
 		} else {
-			rootPath = filepath.Join(home, rootPath[2:])
+			b.WriteString(path[i:end])
 		}
+		i = end - 1
 	}
+	return b.String()
+}
 
-	// Convert to absolute path and clean
-	absPath, err := filepath.Abs(rootPath)
+// envReference returns the variable name referenced at path[i:], written as
+// NAME or {NAME}, and the offset just past the reference. The name is empty
+// if path[i:] does not start with a well-formed reference.
+func envReference(path string, i int) (name string, end int) {
+	if path[i] == '{' {
+		closing := strings.IndexByte(path[i:], '}')
+		if closing < 0 || !isEnvName(path[i+1:i+closing]) {
+			return "", i
+		}
+		return path[i+1 : i+closing], i + closing + 1
+	}
+	end = i
+	for end < len(path) && isEnvNameByte(path[end]) {
+		end++
+	}
+	if !isEnvName(path[i:end]) {
+		return "", i
+	}
+	return path[i:end], end
+}
+
+// isEnvName reports whether name is a well-formed variable name: letters,
+// digits and underscores, not starting with a digit.
+func isEnvName(name string) bool {
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isEnvNameByte(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isEnvNameByte reports whether c can appear in a variable name.
+func isEnvNameByte(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// dedupeRoots drops repeated roots and roots nested inside another root,
+// so that no file is walked twice.
+func dedupeRoots(roots []string) []string {
+	var kept []string
+	for i, root := range roots {
+		covered := false
+		for j, other := range roots {
+			if i == j {
+				continue
+			}
+			if (root == other && j < i) || (root != other && isWithin(root, other)) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			kept = append(kept, root)
+		}
+	}
+	return kept
+}
+
+// isWithin reports whether path is inside (or equal to) root.
+func isWithin(path, root string) bool {
+	rel, err := filepath.Rel(root, path)
 	if err != nil {
-		return fail(fmt.Sprintf("invalid root path %q", rootPath))
+		return false
 	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
 
-	return &Connector{
-		sourceID: sourceID,
-		rootPath: filepath.Clean(absPath),
+// rootFor returns the root that contains path, or "" if none does.
+func (c *Connector) rootFor(path string) string {
+	for _, root := range c.roots {
+		if isWithin(path, root) {
+			return root
+		}
+	}
+	return ""
+}
+
+// checkRoot verifies that a root path exists and is a directory.
+func checkRoot(rootPath string) error {
+	info, err := os.Stat(rootPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("root path does not exist: %s", rootPath)
+		}
+		if os.IsPermission(err) {
+			return fmt.Errorf("permission denied accessing root path: %s", rootPath)
+		}
+		return fmt.Errorf("failed to access root path: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("root path is not a directory: %s", rootPath)
+	}
+	return nil
+}
+
+// checkRoots verifies every root path.
+func (c *Connector) checkRoots() error {
+	if len(c.roots) == 0 {
+		return errors.New("no root path configured")
+	}
+	for _, root := range c.roots {
+		if err := checkRoot(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetLogger sets the structured logger for this connector.
+func (c *Connector) SetLogger(log *slog.Logger) {
+	if log != nil {
+		c.log = log
 	}
 }
 
@@ -108,7 +321,7 @@ func (c *Connector) Capabilities() driven.ConnectorCapabilities {
 }
 
 // Validate checks if the filesystem connector is properly configured.
-// For filesystem, this verifies the root path exists and is readable.
+// For filesystem, this verifies every root path exists and is readable.
 func (c *Connector) Validate(ctx context.Context) error {
 	// Check context cancellation
 	select {
@@ -117,28 +330,11 @@ func (c *Connector) Validate(ctx context.Context) error {
 	default:
 	}
 
-	// Verify root path exists
-	fmt.Print(c.rootPath)
-	info, err := os.Stat(c.rootPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("root path does not exist: %s", c.rootPath)
-		}
-		if os.IsPermission(err) {
-			return fmt.Errorf("permission denied accessing root path: %s", c.rootPath)
-		}
-		return fmt.Errorf("failed to access root path: %w", err)
-	}
-
-	if !info.IsDir() {
-		return fmt.Errorf("root path is not a directory: %s", c.rootPath)
-	}
-
-	return nil
+	return c.checkRoots()
 }
 
 // FullSync performs a full synchronisation of all documents.
-// It walks the entire directory tree and emits RawDocuments for each file.
+// It walks the directory tree of each root and emits RawDocuments for each file.
 //
 //nolint:gocognit // Sync function with goroutine and channel coordination
 func (c *Connector) FullSync(ctx context.Context) (docs <-chan domain.RawDocument, errs <-chan error) {
@@ -149,112 +345,260 @@ func (c *Connector) FullSync(ctx context.Context) (docs <-chan domain.RawDocumen
 		defer close(docsChan)
 		defer close(errsChan)
 
-		// Verify root path exists
-		info, err := os.Stat(c.rootPath)
-		if err != nil {
-			if os.IsNotExist(err) {
-				errsChan <- fmt.Errorf("root path does not exist: %s", c.rootPath)
-			} else {
-				errsChan <- fmt.Errorf("failed to stat root path: %w", err)
-			}
-			return
-		}
-		if !info.IsDir() {
-			errsChan <- fmt.Errorf("root path is not a directory: %s", c.rootPath)
+		if err := c.checkRoots(); err != nil {
+			errsChan <- err
 			return
 		}
 
-		// Walk the directory tree
-		err = filepath.WalkDir(c.rootPath, func(path string, d fs.DirEntry, walkErr error) error {
-			// Check for context cancellation
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
+		rules := newIgnoreRules(c.log.Debug)
+		for _, root := range c.roots {
+			if err := c.walkFull(ctx, root, rules, docsChan); err != nil {
+				// Cancellation and deadline expiry are reported by the caller's context.
+				if ctx.Err() == nil {
+					errsChan <- fmt.Errorf("walk error: %w", err)
+				}
+				return
 			}
+		}
+	}()
 
-			if walkErr != nil {
-				// Log error but continue walking
-				return nil
-			}
+	return docsChan, errsChan
+}
 
-			// Skip directories
-			if d.IsDir() {
-				return nil
-			}
+// walkFull walks one root and sends a RawDocument for each file not ignored.
+// A pool of workers reads files while the walk enumerates paths. The walk
+// blocks once the workers fall behind, so at most a few paths are queued.
+func (c *Connector) walkFull(
+	ctx context.Context, root string, rules *ignoreRules, docsChan chan<- domain.RawDocument,
+) error {
+	workers := max(c.concurrency, 1)
+	paths := make(chan string, workers)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.readFiles(ctx, root, paths, docsChan)
+		}()
+	}
 
-			// Skip hidden files and directories
-			if isHidden(path) {
-				return nil
-			}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+		// Check for context cancellation
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
 
-			// Read file content
-			rawDoc, err := c.readFile(path)
-			if err != nil {
-				// Skip files we can't read
-				return nil
-			}
+		if walkErr != nil {
+			// Log error but continue walking
+			return nil
+		}
 
-			// Send document to channel
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case docsChan <- *rawDoc:
+		// Skip directories, and ignored directories' contents where possible
+		if d.IsDir() {
+			if _, skipDir := rules.match(root, path, true); skipDir {
+				return filepath.SkipDir
 			}
+			return nil
+		}
 
+		// Skip hidden and ignored files
+		if isHidden(path) {
 			return nil
-		})
+		}
+		if ignored, _ := rules.match(root, path, false); ignored {
+			return nil
+		}
 
-		if err != nil && !errors.Is(err, context.Canceled) {
-			errsChan <- fmt.Errorf("walk error: %w", err)
+		// Hand the file to a worker
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case paths <- path:
 		}
-	}()
 
-	return docsChan, errsChan
-}
+		return nil
+	})
 
-// readFile reads a file and creates a RawDocument.
-func (c *Connector) readFile(path string) (*domain.RawDocument, error) {
-	content, err := os.ReadFile(path)
+	close(paths)
+	wg.Wait()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
+		return err
 	}
+	// Workers stop sending once cancelled, so report it even if the walk finished
+	return ctx.Err()
+}
 
-	info, err := os.Stat(path)
+// readFiles reads each path from paths and sends the document to docsChan
+// until paths is closed or ctx is cancelled.
+func (c *Connector) readFiles(
+	ctx context.Context, root string, paths <-chan string, docsChan chan<- domain.RawDocument,
+) {
+	for path := range paths {
+		rawDoc, err := c.readFile(root, path)
+		if err != nil {
+			// Skip files we can't read
+			c.log.Debug("skipping file", "path", path, "reason", err)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case docsChan <- *rawDoc:
+		}
+	}
+}
+
+// readFile reads a file under root and creates a RawDocument.
+// In metadata-only mode the file is stat'ed but its content is not read.
+func (c *Connector) readFile(root, path string) (*domain.RawDocument, error) {
+	var content []byte
+	var info os.FileInfo
+	var err error
+
+	if stat, statErr := os.Stat(path); statErr == nil && !stat.Mode().IsRegular() {
+		return nil, fmt.Errorf("%w: %s", errNotRegular, path)
+	}
+
+	switch {
+	case c.metadataOnly:
+		info, err = os.Stat(path)
+		if err != nil {
+			err = fmt.Errorf("failed to stat file: %w", err)
+		}
+	case c.skipLocked:
+		content, info, err = readStableFile(path)
+	default:
+		content, info, err = readFileAndStat(path)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to stat file: %w", err)
+		return nil, err
 	}
 
 	// Determine parent URI (directory containing the file)
 	parentPath := filepath.Dir(path)
 	var parentURI *string
-	if parentPath != c.rootPath {
+	if parentPath != root {
 		parentURI = &parentPath
 	}
 
+	metadata := map[string]any{
+		"filename":      filepath.Base(path),
+		"extension":     strings.TrimPrefix(filepath.Ext(path), "."),
+		"size":          info.Size(),
+		"modified":      info.ModTime().Format(time.RFC3339),
+		"modified_unix": info.ModTime().Unix(),
+	}
+	if c.metadataOnly {
+		metadata[domain.MetadataMetadataOnly] = true
+	}
+
 	return &domain.RawDocument{
 		SourceID:  c.sourceID,
 		URI:       path,
-		MIMEType:  detectMIMEType(path),
+		MIMEType:  detectMIMEType(path, content),
 		Content:   content,
 		ParentURI: parentURI,
-		Metadata: map[string]any{
-			"filename":      filepath.Base(path),
-			"extension":     strings.TrimPrefix(filepath.Ext(path), "."),
-			"size":          info.Size(),
-			"modified":      info.ModTime().Format(time.RFC3339),
-			"modified_unix": info.ModTime().Unix(),
-		},
+		Metadata:  metadata,
 	}, nil
 }
 
+// readFileAndStat reads a file without any in-progress or lock detection.
+func readFileAndStat(path string) ([]byte, os.FileInfo, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	return content, info, nil
+}
+
+// readStableFile reads a file, skipping it if locked and retrying while its
+// size changes between stat and read (i.e. it is still being written).
+func readStableFile(path string) ([]byte, os.FileInfo, error) {
+	for attempt := 1; attempt <= maxReadAttempts; attempt++ {
+		content, info, err := readFileOnce(path)
+		if err == nil || !errors.Is(err, errFileInProgress) {
+			return content, info, err
+		}
+		if attempt < maxReadAttempts {
+			time.Sleep(readRetryDelay)
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: %s", errFileInProgress, path)
+}
+
+// readFileOnce performs a single locked-aware read attempt.
+func readFileOnce(path string) ([]byte, os.FileInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	if isLocked(f) {
+		return nil, nil, fmt.Errorf("%w: %s", errFileLocked, path)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	afterStat(path)
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	if int64(len(content)) != info.Size() {
+		return nil, nil, errFileInProgress
+	}
+
+	return content, info, nil
+}
+
 // detectMIMEType returns the MIME type for a file based on its extension.
 // Code and text file extensions are checked first because system MIME databases
 // often map these to incorrect types (e.g., .ts to video/mp2t, .rs to RLS services).
+// Files without an extension, or whose extension maps to plain text, are
+// identified from their name and content (see sniffContent), and recognised
+// as JSON when their whole content is a JSON object or array. Content may be
+// nil, as in metadata-only mode.
+func detectMIMEType(path string, content []byte) string {
+	if mimeType := mimeTypeByExtension(filepath.Ext(path)); mimeType != "text/plain" {
+		return mimeType
+	}
+	mimeType := sniffContent(filepath.Base(path), content)
+	if mimeType == "text/plain" && isJSONDocument(content) {
+		return "application/json"
+	}
+	return mimeType
+}
+
+// isJSONDocument reports whether content is a valid JSON object or array.
+func isJSONDocument(content []byte) bool {
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+		return false
+	}
+	return json.Valid(trimmed)
+}
+
+// mimeTypeByExtension returns the MIME type for a file extension,
+// or text/plain when there is none.
 //
 //nolint:gocyclo // Switch statement with many cases for file extensions
-func detectMIMEType(path string) string {
-	ext := filepath.Ext(path)
+func mimeTypeByExtension(ext string) string {
 	if ext == "" {
 		return "text/plain"
 	}
@@ -264,6 +608,8 @@ func detectMIMEType(path string) string {
 	switch strings.ToLower(ext) {
 	case ".md", ".markdown":
 		return "text/markdown"
+	case ".tex", ".latex":
+		return "text/x-latex"
 	case ".go":
 		return "text/x-go"
 	case ".py":
@@ -301,6 +647,43 @@ func detectMIMEType(path string) string {
 	return "application/octet-stream"
 }
 
+// sniffLen is how many leading bytes of a file are used to sniff its type.
+const sniffLen = 512
+
+// knownFilenames maps common extensionless file names to their MIME types.
+var knownFilenames = map[string]string{
+	"Dockerfile":    "text/x-dockerfile",
+	"Containerfile": "text/x-dockerfile",
+	"Makefile":      "text/x-makefile",
+	"makefile":      "text/x-makefile",
+	"GNUmakefile":   "text/x-makefile",
+	"Gemfile":       "text/x-ruby",
+	"Rakefile":      "text/x-ruby",
+	"Vagrantfile":   "text/x-ruby",
+}
+
+// sniffContent identifies a file the extension lookup could not, first by
+// its name (e.g. Dockerfile, Makefile) and then from the magic bytes at the
+// start of its content. Binary content is reported as
+// application/octet-stream rather than plain text.
+func sniffContent(filename string, firstBytes []byte) string {
+	if mimeType, ok := knownFilenames[filename]; ok {
+		return mimeType
+	}
+
+	if len(firstBytes) > sniffLen {
+		firstBytes = firstBytes[:sniffLen]
+	}
+	mimeType := http.DetectContentType(firstBytes)
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	if mimeType == "text/xml" {
+		return "application/xml" // Normalised as in mimeTypeByExtension
+	}
+	return mimeType
+}
+
 // isHidden returns true if the path contains hidden files/directories (starting with .)
 func isHidden(path string) bool {
 	parts := strings.Split(path, string(filepath.Separator))
@@ -313,10 +696,14 @@ func isHidden(path string) bool {
 }
 
 // IncrementalSync syncs changes since the last sync state.
-// The cursor is a Unix timestamp in nanoseconds representing the last sync time.
-// Only files modified after this time are included.
+// The cursor records the last sync time of each root (see parseCursor).
+// Only files modified after their root's time are included; a root without
+// a recorded time is walked in full. A non-zero state.Since replaces the
+// cursor time of every root. Files skipped because they were locked or still
+// being written hold their root's time back to their modification time, so
+// the next sync reads them again.
 //
-//nolint:gocognit,gocyclo // Sync function with goroutine and channel coordination
+//nolint:gocognit // Sync function with goroutine and channel coordination
 func (c *Connector) IncrementalSync(
 	ctx context.Context, state domain.SyncState,
 ) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
@@ -327,110 +714,121 @@ func (c *Connector) IncrementalSync(
 		defer close(changesChan)
 		defer close(errsChan)
 
-		// Parse cursor as Unix timestamp (nanoseconds)
-		var sinceTime time.Time
-		if state.Cursor != "" {
-			nanos, err := strconv.ParseInt(state.Cursor, 10, 64)
-			if err != nil {
-				errsChan <- fmt.Errorf("invalid cursor format: %w", err)
-				return
-			}
-			sinceTime = time.Unix(0, nanos)
-		} else {
-			// No cursor means we treat it like a full sync
-			sinceTime = time.Time{}
-		}
-
-		// Verify root path exists
-		info, err := os.Stat(c.rootPath)
+		sinceTimes, err := c.parseCursor(state.Cursor)
 		if err != nil {
-			if os.IsNotExist(err) {
-				errsChan <- fmt.Errorf("root path does not exist: %s", c.rootPath)
-			} else {
-				errsChan <- fmt.Errorf("failed to stat root path: %w", err)
-			}
+			errsChan <- err
 			return
 		}
-		if !info.IsDir() {
-			errsChan <- fmt.Errorf("root path is not a directory: %s", c.rootPath)
-			return
+		if !state.Since.IsZero() {
+			for _, root := range c.roots {
+				sinceTimes[root] = state.Since
+			}
 		}
 
-		// Track files we've seen (for detecting deletions)
-		currentFiles := make(map[string]struct{})
+		if err := c.checkRoots(); err != nil {
+			errsChan <- err
+			return
+		}
 
-		// Walk the directory tree
-		err = filepath.WalkDir(c.rootPath, func(path string, d fs.DirEntry, walkErr error) error {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
+		rules := newIgnoreRules(c.log.Debug)
+		syncedAt := make(map[string]time.Time, len(c.roots))
+		for _, root := range c.roots {
+			skipped, err := c.walkIncremental(ctx, root, sinceTimes[root], rules, changesChan)
+			// Cancellation and deadline expiry are reported by the caller's context.
+			if ctx.Err() != nil {
+				return
 			}
-
-			if walkErr != nil {
-				return nil
+			if err != nil {
+				errsChan <- fmt.Errorf("walk error: %w", err)
+				return
 			}
-
-			if d.IsDir() {
-				return nil
+			syncedAt[root] = time.Now()
+			// Keep locked and in-progress files within the next sync's range
+			if !skipped.IsZero() && skipped.Before(syncedAt[root]) {
+				syncedAt[root] = skipped
 			}
+		}
 
-			if isHidden(path) {
-				return nil
-			}
+		// Send SyncComplete with the new cursor
+		errsChan <- &driven.SyncComplete{
+			NewCursor: c.encodeCursor(syncedAt),
+		}
+	}()
 
-			// Track this file
-			currentFiles[path] = struct{}{}
+	return changesChan, errsChan
+}
 
-			// Get file info
-			fileInfo, err := d.Info()
-			if err != nil {
-				return nil
-			}
+// walkIncremental walks one root and sends a change for each file not
+// ignored and modified since sinceTime (every file if sinceTime is zero).
+// It returns the earliest modification time of the files skipped because
+// they were locked or still being written, or zero if there were none.
+func (c *Connector) walkIncremental(
+	ctx context.Context, root string, sinceTime time.Time, rules *ignoreRules,
+	changesChan chan<- domain.RawDocumentChange,
+) (time.Time, error) {
+	var skipped time.Time
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
 
-			// Skip files not modified since last sync
-			if !sinceTime.IsZero() && fileInfo.ModTime().Before(sinceTime) {
-				return nil
-			}
+		if walkErr != nil {
+			return nil
+		}
 
-			// Read file content
-			rawDoc, err := c.readFile(path)
-			if err != nil {
-				return nil
+		if d.IsDir() {
+			if _, skipDir := rules.match(root, path, true); skipDir {
+				return filepath.SkipDir
 			}
+			return nil
+		}
 
-			// Determine change type
-			changeType := domain.ChangeUpdated
-			if sinceTime.IsZero() || fileInfo.ModTime().After(sinceTime.Add(-time.Second)) {
-				// Could be new or updated - we can't tell for certain without tracking state
-				changeType = domain.ChangeUpdated
-			}
+		if isHidden(path) {
+			return nil
+		}
+		if ignored, _ := rules.match(root, path, false); ignored {
+			return nil
+		}
 
-			// Send change to channel
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case changesChan <- domain.RawDocumentChange{
-				Type:     changeType,
-				Document: *rawDoc,
-			}:
-			}
+		// Get file info
+		fileInfo, err := d.Info()
+		if err != nil {
+			return nil
+		}
 
+		// Skip files not modified since last sync
+		if !sinceTime.IsZero() && fileInfo.ModTime().Before(sinceTime) {
 			return nil
-		})
+		}
 
-		if err != nil && !errors.Is(err, context.Canceled) {
-			errsChan <- fmt.Errorf("walk error: %w", err)
-			return
+		// Read file content
+		rawDoc, err := c.readFile(root, path)
+		if err != nil {
+			c.log.Debug("skipping file", "path", path, "reason", err)
+			if errors.Is(err, errFileLocked) || errors.Is(err, errFileInProgress) {
+				if modTime := fileInfo.ModTime(); skipped.IsZero() || modTime.Before(skipped) {
+					skipped = modTime
+				}
+			}
+			return nil
 		}
 
-		// Send SyncComplete with new cursor (current time in nanoseconds)
-		errsChan <- &driven.SyncComplete{
-			NewCursor: strconv.FormatInt(time.Now().UnixNano(), 10),
+		// Send change to channel. New and modified files cannot be told apart
+		// without tracking state, so both are reported as updates.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case changesChan <- domain.RawDocumentChange{
+			Type:     domain.ChangeUpdated,
+			Document: *rawDoc,
+		}:
 		}
-	}()
 
-	return changesChan, errsChan
+		return nil
+	})
+	return skipped, err
 }
 
 // Watch monitors for real-time document changes using fsnotify.
@@ -445,14 +843,10 @@ func (c *Connector) Watch(ctx context.Context) (<-chan domain.RawDocumentChange,
 		return nil, fmt.Errorf("connector is closed")
 	}
 
-	// Verify root path exists
-	info, err := os.Stat(c.rootPath)
-	if err != nil {
+	// Verify every root path exists
+	if err := c.checkRoots(); err != nil {
 		return nil, fmt.Errorf("root path error: %w", err)
 	}
-	if !info.IsDir() {
-		return nil, fmt.Errorf("root path is not a directory: %s", c.rootPath)
-	}
 
 	// Create watcher
 	watcher, err := fsnotify.NewWatcher()
@@ -460,25 +854,31 @@ func (c *Connector) Watch(ctx context.Context) (<-chan domain.RawDocumentChange,
 		return nil, fmt.Errorf("failed to create watcher: %w", err)
 	}
 	c.watcher = watcher
+	c.watchIgnore = newIgnoreRules(c.log.Debug)
 
-	// Add all directories recursively
-	err = filepath.WalkDir(c.rootPath, func(path string, d fs.DirEntry, walkErr error) error {
-		if walkErr != nil {
-			return nil
-		}
-		if d.IsDir() {
-			if isHidden(path) {
-				return filepath.SkipDir
+	// Add all directories of every root recursively
+	for _, root := range c.roots {
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return nil
 			}
-			if err := watcher.Add(path); err != nil {
-				return nil // Continue even if we can't watch a directory
+			if d.IsDir() {
+				if isHidden(path) {
+					return filepath.SkipDir
+				}
+				if _, skipDir := c.watchIgnore.match(root, path, true); skipDir {
+					return filepath.SkipDir
+				}
+				if err := watcher.Add(path); err != nil {
+					return nil // Continue even if we can't watch a directory
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			watcher.Close()
+			return nil, fmt.Errorf("failed to add directories to watcher: %w", err)
 		}
-		return nil
-	})
-	if err != nil {
-		watcher.Close()
-		return nil, fmt.Errorf("failed to add directories to watcher: %w", err)
 	}
 
 	changesChan := make(chan domain.RawDocumentChange)
@@ -509,7 +909,9 @@ func (c *Connector) Watch(ctx context.Context) (<-chan domain.RawDocumentChange,
 				// If a new directory was created, add it to the watcher
 				if event.Op&fsnotify.Create != 0 {
 					if info, err := os.Stat(event.Name); err == nil && info.IsDir() && !isHidden(event.Name) {
-						_ = watcher.Add(event.Name) //nolint:errcheck // best-effort directory watching
+						if _, skipDir := c.watchIgnore.match(c.rootFor(event.Name), event.Name, true); !skipDir {
+							_ = watcher.Add(event.Name) //nolint:errcheck // best-effort directory watching
+						}
 					}
 				}
 
@@ -530,6 +932,12 @@ func (c *Connector) Watch(ctx context.Context) (<-chan domain.RawDocumentChange,
 func (c *Connector) handleFsEvent(event fsnotify.Event) *domain.RawDocumentChange {
 	path := event.Name
 
+	// Re-read ignore files after one changes
+	if filepath.Base(path) == IgnoreFileName {
+		c.watchIgnore.reset()
+		return nil
+	}
+
 	// Skip hidden files
 	if isHidden(path) {
 		return nil
@@ -540,6 +948,11 @@ func (c *Connector) handleFsEvent(event fsnotify.Event) *domain.RawDocumentChang
 		return nil
 	}
 
+	// Skip ignored files
+	if ignored, _ := c.watchIgnore.match(c.rootFor(path), path, false); ignored {
+		return nil
+	}
+
 	switch {
 	case event.Op&fsnotify.Remove != 0 || event.Op&fsnotify.Rename != 0:
 		// File was deleted or renamed (treat rename as delete + create)
@@ -553,7 +966,7 @@ func (c *Connector) handleFsEvent(event fsnotify.Event) *domain.RawDocumentChang
 
 	case event.Op&fsnotify.Create != 0:
 		// New file created
-		rawDoc, err := c.readFile(path)
+		rawDoc, err := c.readFile(c.rootFor(path), path)
 		if err != nil {
 			return nil
 		}
@@ -564,7 +977,7 @@ func (c *Connector) handleFsEvent(event fsnotify.Event) *domain.RawDocumentChang
 
 	case event.Op&fsnotify.Write != 0:
 		// File was modified
-		rawDoc, err := c.readFile(path)
+		rawDoc, err := c.readFile(c.rootFor(path), path)
 		if err != nil {
 			return nil
 		}
@@ -1,8 +1,10 @@
 package filesystem
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -26,7 +28,7 @@ func TestNew(t *testing.T) {
 
 		require.NotNil(t, connector)
 		assert.Equal(t, sourceID, connector.sourceID)
-		assert.Equal(t, rootPath, connector.rootPath)
+		assert.Equal(t, []string{rootPath}, connector.roots)
 	})
 
 	t.Run("creates connector with empty strings", func(t *testing.T) {
@@ -34,13 +36,91 @@ func TestNew(t *testing.T) {
 
 		require.NotNil(t, connector)
 		assert.Equal(t, "", connector.sourceID)
-		assert.Equal(t, "", connector.rootPath)
+		assert.Empty(t, connector.roots)
 	})
 
 	t.Run("implements Connector interface", func(t *testing.T) {
 		connector := New("test", "/tmp")
 		var _ driven.Connector = connector
 	})
+
+	t.Run("expands home directory in root", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		connector := New("test", "~/Documents")
+
+		assert.Equal(t, []string{filepath.Join(home, "Documents")}, connector.roots)
+	})
+}
+
+func TestExpandPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("SERCHA_TEST_DIR", "notes")
+
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"home", "~", home},
+		{"home subdirectory", "~/sub/dir", filepath.Join(home, "sub", "dir")},
+		{"HOME variable", "$HOME/x", filepath.Join(home, "x")},
+		{"braced variable", "/data/${SERCHA_TEST_DIR}", "/data/notes"},
+		{"tilde in the middle", "/tmp/a~b/~/c", "/tmp/a~b/~/c"},
+		{"tilde user form", "~other/x", "~other/x"},
+		{"absolute path", "/var/lib/sercha", "/var/lib/sercha"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandPath(tt.raw)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandPath_LiteralDollar(t *testing.T) {
+	t.Setenv("SERCHA_TEST_DIR", "notes")
+	t.Setenv("SERCHA_TEST_EMPTY", "")
+	t.Setenv("SERCHA_TEST_UNSET", "") // Restored after the test
+	require.NoError(t, os.Unsetenv("SERCHA_TEST_UNSET"))
+	t.Setenv("Recycle", "") // Restored after the test
+	require.NoError(t, os.Unsetenv("Recycle"))
+
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"unset variable kept", "$SERCHA_TEST_UNSET/docs", "$SERCHA_TEST_UNSET/docs"},
+		{"unset braced variable kept", "/data/${SERCHA_TEST_UNSET}", "/data/${SERCHA_TEST_UNSET}"},
+		{"recycle bin", "/mnt/c/$Recycle.Bin", "/mnt/c/$Recycle.Bin"},
+		{"dollar before non-name", "/data/$1price/$-x/end$", "/data/$1price/$-x/end$"},
+		{"unclosed brace", "/data/${SERCHA_TEST_DIR", "/data/${SERCHA_TEST_DIR"},
+		{"escaped dollar", "/data/$$SERCHA_TEST_DIR", "/data/$SERCHA_TEST_DIR"},
+		{"empty variable", "/data$SERCHA_TEST_EMPTY/docs", "/data/docs"},
+		{"set variable in word", "/data/x$SERCHA_TEST_DIR", "/data/xnotes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandPath(tt.raw)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandPath_NoHome(t *testing.T) {
+	t.Setenv("HOME", "")
+
+	_, err := expandPath("~/Documents")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve home directory")
 }
 
 func TestConnector_Type(t *testing.T) {
@@ -210,6 +290,31 @@ func TestConnector_FullSync(t *testing.T) {
 		}
 	})
 
+	t.Run("stops cleanly when deadline expires", func(t *testing.T) {
+		tempDir, err := os.MkdirTemp("", "sercha-test-deadline-*")
+		require.NoError(t, err)
+		defer os.RemoveAll(tempDir)
+
+		for i := range 5 {
+			name := filepath.Join(tempDir, fmt.Sprintf("file%d.txt", i))
+			require.NoError(t, os.WriteFile(name, []byte("content"), 0644))
+		}
+
+		connector := New("test-source", tempDir)
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		docsChan, errsChan := connector.FullSync(ctx)
+
+		// Channels close without reporting the expired deadline as a walk error
+		for range docsChan {
+		}
+		for err := range errsChan {
+			t.Errorf("unexpected error: %v", err)
+		}
+		assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	})
+
 	t.Run("includes file metadata", func(t *testing.T) {
 		tempDir, err := os.MkdirTemp("", "sercha-test-meta-*")
 		require.NoError(t, err)
@@ -249,6 +354,7 @@ func TestConnector_FullSync(t *testing.T) {
 			"file.go":   "text/x-go",
 			"file.py":   "text/x-python",
 			"file.json": "application/json",
+			"Makefile":  "text/x-makefile",
 		}
 
 		for name := range files {
@@ -316,6 +422,38 @@ func TestConnector_IncrementalSync(t *testing.T) {
 		}
 	})
 
+	t.Run("since overrides cursor", func(t *testing.T) {
+		tempDir := t.TempDir()
+
+		now := time.Now()
+		oldFile := filepath.Join(tempDir, "old.txt")
+		recentFile := filepath.Join(tempDir, "recent.txt")
+		require.NoError(t, os.WriteFile(oldFile, []byte("old content"), 0644))
+		require.NoError(t, os.WriteFile(recentFile, []byte("recent content"), 0644))
+		require.NoError(t, os.Chtimes(oldFile, now.Add(-48*time.Hour), now.Add(-48*time.Hour)))
+		require.NoError(t, os.Chtimes(recentFile, now.Add(-time.Hour), now.Add(-time.Hour)))
+
+		connector := New("test-source", tempDir)
+		// The cursor alone would skip both files
+		syncState := domain.SyncState{
+			SourceID: "test-source",
+			Cursor:   fmt.Sprintf("%d", now.UnixNano()),
+			Since:    now.Add(-24 * time.Hour),
+		}
+
+		changesChan, errsChan := connector.IncrementalSync(context.Background(), syncState)
+
+		var changes []domain.RawDocumentChange
+		for change := range changesChan {
+			changes = append(changes, change)
+		}
+		for range errsChan {
+		}
+
+		require.Len(t, changes, 1)
+		assert.Contains(t, changes[0].Document.URI, "recent.txt")
+	})
+
 	t.Run("handles empty cursor like full sync", func(t *testing.T) {
 		tempDir, err := os.MkdirTemp("", "sercha-test-incr-empty-*")
 		require.NoError(t, err)
@@ -418,6 +556,33 @@ func TestConnector_IncrementalSync(t *testing.T) {
 		for range errsChan {
 		}
 	})
+
+	t.Run("stops cleanly when deadline expires", func(t *testing.T) {
+		tempDir, err := os.MkdirTemp("", "sercha-test-incr-deadline-*")
+		require.NoError(t, err)
+		defer os.RemoveAll(tempDir)
+
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "new.txt"), []byte("content"), 0644))
+
+		connector := New("test-source", tempDir)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		<-ctx.Done()
+
+		syncState := domain.SyncState{
+			SourceID: "test-source",
+			Cursor:   "0",
+		}
+
+		changesChan, errsChan := connector.IncrementalSync(ctx, syncState)
+
+		// Channels close without a walk error or a SyncComplete cursor
+		for range changesChan {
+		}
+		for err := range errsChan {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
 }
 
 func TestConnector_Watch(t *testing.T) {
@@ -638,7 +803,7 @@ func TestConnector_IntegrationWithTempDir(t *testing.T) {
 		// Verify connector properties
 		assert.Equal(t, "filesystem", connector.Type())
 		assert.Equal(t, "test-source", connector.SourceID())
-		assert.Equal(t, tempDir, connector.rootPath)
+		assert.Equal(t, []string{tempDir}, connector.roots)
 
 		// Verify capabilities
 		caps := connector.Capabilities()
@@ -698,7 +863,7 @@ func TestConnector_IntegrationWithTempDir(t *testing.T) {
 		connector := New("test-source", nonExistentPath)
 		require.NotNil(t, connector)
 
-		assert.Equal(t, nonExistentPath, connector.rootPath)
+		assert.Equal(t, []string{nonExistentPath}, connector.roots)
 		assert.Equal(t, "test-source", connector.SourceID())
 
 		err := connector.Close()
@@ -718,7 +883,7 @@ func TestConnector_IntegrationWithTempDir(t *testing.T) {
 		connector := New("special-source", specialDir)
 		require.NotNil(t, connector)
 
-		assert.Equal(t, specialDir, connector.rootPath)
+		assert.Equal(t, []string{specialDir}, connector.roots)
 
 		err = connector.Close()
 		assert.NoError(t, err)
@@ -800,7 +965,7 @@ func TestConnector_EdgeCases(t *testing.T) {
 		connector := New("test-source", longPath)
 		require.NotNil(t, connector)
 
-		assert.Equal(t, longPath, connector.rootPath)
+		assert.Equal(t, []string{longPath}, connector.roots)
 	})
 
 	t.Run("handles unicode in source ID", func(t *testing.T) {
@@ -823,7 +988,7 @@ func TestConnector_EdgeCases(t *testing.T) {
 		if err == nil {
 			connector := New("test-source", unicodeDir)
 			require.NotNil(t, connector)
-			assert.Equal(t, unicodeDir, connector.rootPath)
+			assert.Equal(t, []string{unicodeDir}, connector.roots)
 		}
 		// Skip if OS doesn't support unicode paths
 	})
@@ -922,6 +1087,8 @@ func TestDetectMIMEType(t *testing.T) {
 		// Custom fallback types
 		{"doc.md", "text/markdown"},
 		{"doc.markdown", "text/markdown"},
+		{"paper.tex", "text/x-latex"},
+		{"paper.latex", "text/x-latex"},
 		{"code.go", "text/x-go"},
 		{"script.py", "text/x-python"},
 		{"lib.rs", "text/x-rust"},
@@ -963,7 +1130,7 @@ func TestDetectMIMEType(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.filename, func(t *testing.T) {
-			mimeType := detectMIMEType(tt.filename)
+			mimeType := detectMIMEType(tt.filename, nil)
 			assert.Equal(t, tt.expectedMIME, mimeType)
 		})
 	}
@@ -972,7 +1139,7 @@ func TestDetectMIMEType(t *testing.T) {
 		// Test with files that might have charset
 		testFiles := []string{"file.html", "file.css", "file.js"}
 		for _, file := range testFiles {
-			mimeType := detectMIMEType(file)
+			mimeType := detectMIMEType(file, nil)
 			// Should not contain charset parameter
 			assert.NotContains(t, mimeType, "charset")
 			assert.NotContains(t, mimeType, ";")
@@ -980,6 +1147,50 @@ func TestDetectMIMEType(t *testing.T) {
 	})
 }
 
+// TestSniffContent tests content sniffing for files the extension lookup cannot identify.
+func TestSniffContent(t *testing.T) {
+	tests := []struct {
+		name         string
+		filename     string
+		content      []byte
+		expectedMIME string
+	}{
+		{"dockerfile", "Dockerfile", []byte("FROM golang:1.24\n"), "text/x-dockerfile"},
+		{"makefile", "Makefile", []byte("build:\n\tgo build ./...\n"), "text/x-makefile"},
+		{"gemfile", "Gemfile", []byte("source 'https://rubygems.org'\n"), "text/x-ruby"},
+		{"plain text", "README", []byte("Read me first.\n"), "text/plain"},
+		{"no content", "LICENSE", nil, "text/plain"},
+		{"pdf magic", "report", []byte("%PDF-1.7\n"), "application/pdf"},
+		{"png magic", "logo", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), "image/png"},
+		{"html", "index", []byte("<!DOCTYPE html><html></html>"), "text/html"},
+		{"xml", "feed", []byte("<?xml version=\"1.0\"?><rss/>"), "application/xml"},
+		{"binary", "blob", []byte{0x00, 0x01, 0x02, 0x03}, "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMIME, sniffContent(tt.filename, tt.content))
+		})
+	}
+
+	t.Run("only the first bytes are sniffed", func(t *testing.T) {
+		content := append(bytes.Repeat([]byte("a"), sniffLen), 0x00)
+		assert.Equal(t, "text/plain", sniffContent("data", content))
+	})
+}
+
+// TestDetectMIMEType_Sniffing tests that content is sniffed only when the extension gives plain text.
+func TestDetectMIMEType_Sniffing(t *testing.T) {
+	assert.Equal(t, "text/x-makefile", detectMIMEType("/repo/Makefile", []byte("all:\n")))
+	assert.Equal(t, "application/pdf", detectMIMEType("/docs/scan", []byte("%PDF-1.4")))
+	assert.Equal(t, "text/x-go", detectMIMEType("/repo/main.go", []byte("%PDF-1.4")))
+	assert.Equal(t, "text/plain", detectMIMEType("/docs/notes.txt", []byte("notes")))
+	assert.Equal(t, "application/json", detectMIMEType("/repo/composer", []byte(`{"semi": false}`)))
+	assert.Equal(t, "application/json", detectMIMEType("/dumps/export", []byte(" [1, 2]\n")))
+	assert.Equal(t, "text/plain", detectMIMEType("/dumps/partial", []byte(`{"cut": `)))
+	assert.Equal(t, "text/plain", detectMIMEType("/docs/answer", []byte("42")))
+}
+
 // TestIsHidden tests the isHidden function with various path scenarios.
 func TestIsHidden(t *testing.T) {
 	tests := []struct {
@@ -1473,3 +1684,336 @@ func TestConnector_IncrementalSync_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+// appendOnStat makes the file grow after each stat, like a writer that has not finished.
+// It stops growing the file once the given number of appends has been made.
+func appendOnStat(t *testing.T, path string, appends int) {
+	t.Helper()
+	calls := 0
+	afterStat = func(p string) {
+		if p != path || calls >= appends {
+			return
+		}
+		calls++
+		f, err := os.OpenFile(p, os.O_APPEND|os.O_WRONLY, 0644)
+		require.NoError(t, err)
+		_, err = f.WriteString(" more")
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+	t.Cleanup(func() { afterStat = func(string) {} })
+}
+
+func TestConnector_readFile_InProgress(t *testing.T) {
+	t.Run("retries until file stops growing", func(t *testing.T) {
+		tempDir := t.TempDir()
+		path := filepath.Join(tempDir, "download.txt")
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+		appendOnStat(t, path, maxReadAttempts-1)
+
+		connector := New("test-source", tempDir)
+		doc, err := connector.readFile(tempDir, path)
+
+		require.NoError(t, err)
+		assert.Equal(t, "data more more", string(doc.Content))
+		assert.Equal(t, int64(len(doc.Content)), doc.Metadata["size"])
+	})
+
+	t.Run("skips file still growing after all attempts", func(t *testing.T) {
+		tempDir := t.TempDir()
+		path := filepath.Join(tempDir, "download.txt")
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+		appendOnStat(t, path, maxReadAttempts)
+
+		connector := New("test-source", tempDir)
+		_, err := connector.readFile(tempDir, path)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errFileInProgress)
+	})
+
+	t.Run("does not retry when skip_locked is disabled", func(t *testing.T) {
+		tempDir := t.TempDir()
+		path := filepath.Join(tempDir, "download.txt")
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+		appendOnStat(t, path, maxReadAttempts)
+
+		cfg := DefaultConfig()
+		cfg.Path = tempDir
+		cfg.SkipLocked = false
+		connector := NewWithConfig("test-source", cfg)
+		doc, err := connector.readFile(tempDir, path)
+
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(doc.Content))
+	})
+}
+
+func TestConnector_readFile_MetadataOnly(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "report.pdf")
+	require.NoError(t, os.WriteFile(path, []byte("%PDF-1.4 data"), 0644))
+
+	cfg := DefaultConfig()
+	cfg.Path = tempDir
+	cfg.MetadataOnly = true
+	connector := NewWithConfig("test-source", cfg)
+	doc, err := connector.readFile(tempDir, path)
+
+	require.NoError(t, err)
+	assert.Empty(t, doc.Content)
+	assert.Equal(t, "report.pdf", doc.Metadata["filename"])
+	assert.Equal(t, int64(13), doc.Metadata["size"])
+	assert.True(t, domain.IsMetadataOnly(doc.Metadata))
+}
+
+func TestConnector_FullSync_LogsSkippedFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "download.txt")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "done.txt"), []byte("done"), 0644))
+	appendOnStat(t, path, maxReadAttempts)
+
+	var buf bytes.Buffer
+	connector := New("test-source", tempDir)
+	connector.SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	docsChan, errsChan := connector.FullSync(context.Background())
+	var docs []domain.RawDocument
+	for doc := range docsChan {
+		docs = append(docs, doc)
+	}
+	for err := range errsChan {
+		require.NoError(t, err)
+	}
+
+	require.Len(t, docs, 1)
+	assert.Contains(t, buf.String(), "skipping file")
+	assert.Contains(t, buf.String(), "download.txt")
+}
+
+// writeFileTree creates files of size bytes spread over nested directories.
+func writeFileTree(tb testing.TB, root string, files, size int) {
+	tb.Helper()
+	content := bytes.Repeat([]byte("x"), size)
+	for i := range files {
+		dir := filepath.Join(root, fmt.Sprintf("dir%d", i%10), fmt.Sprintf("sub%d", i%7))
+		require.NoError(tb, os.MkdirAll(dir, 0755))
+		require.NoError(tb, os.WriteFile(filepath.Join(dir, fmt.Sprintf("file%d.txt", i)), content, 0644))
+	}
+}
+
+// drainFullSync runs a full sync and returns the URIs of every document.
+func drainFullSync(tb testing.TB, connector *Connector) []string {
+	tb.Helper()
+	docsChan, errsChan := connector.FullSync(context.Background())
+	var uris []string
+	for doc := range docsChan {
+		uris = append(uris, doc.URI)
+	}
+	for err := range errsChan {
+		require.NoError(tb, err)
+	}
+	return uris
+}
+
+func TestConnector_FullSync_Concurrency(t *testing.T) {
+	tempDir := t.TempDir()
+	writeFileTree(t, tempDir, 200, 64)
+
+	serialCfg := DefaultConfig()
+	serialCfg.Path = tempDir
+	serialCfg.Concurrency = 1
+	serial := drainFullSync(t, NewWithConfig("test-source", serialCfg))
+
+	concurrentCfg := DefaultConfig()
+	concurrentCfg.Path = tempDir
+	concurrentCfg.Concurrency = 8
+	concurrent := drainFullSync(t, NewWithConfig("test-source", concurrentCfg))
+
+	require.Len(t, serial, 200)
+	assert.ElementsMatch(t, serial, concurrent, "every file is sent exactly once")
+}
+
+func TestConnector_FullSync_CancelWhileWorkersBlocked(t *testing.T) {
+	tempDir := t.TempDir()
+	writeFileTree(t, tempDir, 50, 16)
+
+	cfg := DefaultConfig()
+	cfg.Path = tempDir
+	cfg.Concurrency = 4
+	connector := NewWithConfig("test-source", cfg)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	docsChan, errsChan := connector.FullSync(ctx)
+
+	// Take one document, leaving the workers blocked on the channel
+	<-docsChan
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range docsChan {
+		}
+		for err := range errsChan {
+			assert.NoError(t, err, "cancellation is reported by the context")
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("full sync did not stop after cancellation")
+	}
+}
+
+// BenchmarkFullSync compares serial and concurrent reads of a large tree.
+// Files served from the page cache only gain with spare CPUs, so a second
+// case adds a per-file delay standing in for the latency of real storage.
+// Run with -benchtime=5x; the tree is created once.
+func BenchmarkFullSync(b *testing.B) {
+	const files = 2000
+	tempDir := b.TempDir()
+	writeFileTree(b, tempDir, files, 16*1024)
+
+	for _, latency := range []time.Duration{0, 100 * time.Microsecond} {
+		for _, concurrency := range []int{1, 4, 16} {
+			name := fmt.Sprintf("latency=%s/concurrency=%d", latency, concurrency)
+			b.Run(name, func(b *testing.B) {
+				if latency > 0 {
+					original := afterStat
+					afterStat = func(string) { time.Sleep(latency) }
+					b.Cleanup(func() { afterStat = original })
+				}
+
+				cfg := DefaultConfig()
+				cfg.Path = tempDir
+				cfg.Concurrency = concurrency
+				connector := NewWithConfig("bench-source", cfg)
+
+				b.ResetTimer()
+				for range b.N {
+					if uris := drainFullSync(b, connector); len(uris) != files {
+						b.Fatalf("expected %d documents, got %d", files, len(uris))
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestNewWithConfig_MultipleRoots(t *testing.T) {
+	t.Run("keeps every root", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Path = "/tmp/docs"
+		cfg.Paths = []string{"/tmp/projects"}
+
+		connector := NewWithConfig("test-source", cfg)
+
+		assert.Equal(t, []string{"/tmp/docs", "/tmp/projects"}, connector.roots)
+	})
+
+	t.Run("drops duplicate and nested roots", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Paths = []string{"/tmp/docs/sub", "/tmp/docs", "/tmp/docs/", "/tmp/docs-other"}
+
+		connector := NewWithConfig("test-source", cfg)
+
+		assert.Equal(t, []string{"/tmp/docs", "/tmp/docs-other"}, connector.roots)
+	})
+}
+
+// newMultiRootConnector creates two roots with one file each.
+func newMultiRootConnector(t *testing.T) (connector *Connector, rootA, rootB string) {
+	t.Helper()
+	rootA, rootB = t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(rootA, "a.txt"), []byte("from a"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(rootB, "b.txt"), []byte("from b"), 0644))
+
+	cfg := DefaultConfig()
+	cfg.Path = rootA
+	cfg.Paths = []string{rootB}
+	return NewWithConfig("test-source", cfg), rootA, rootB
+}
+
+func TestConnector_MultipleRoots_FullSync(t *testing.T) {
+	connector, rootA, rootB := newMultiRootConnector(t)
+
+	docsChan, errsChan := connector.FullSync(context.Background())
+
+	var uris []string
+	for doc := range docsChan {
+		uris = append(uris, doc.URI)
+		assert.Nil(t, doc.ParentURI, "files directly under a root have no parent")
+	}
+	for err := range errsChan {
+		require.NoError(t, err)
+	}
+	assert.ElementsMatch(t, []string{filepath.Join(rootA, "a.txt"), filepath.Join(rootB, "b.txt")}, uris)
+}
+
+func TestConnector_MultipleRoots_MissingRootFails(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Path = t.TempDir()
+	cfg.Paths = []string{"/non/existent/path"}
+	connector := NewWithConfig("test-source", cfg)
+
+	require.Error(t, connector.Validate(context.Background()))
+
+	docsChan, errsChan := connector.FullSync(context.Background())
+	for range docsChan {
+	}
+	err := <-errsChan
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not exist")
+}
+
+func TestConnector_MultipleRoots_IncrementalSync(t *testing.T) {
+	connector, rootA, rootB := newMultiRootConnector(t)
+
+	// rootA was synced after its file was written; rootB was added since.
+	cursor := fmt.Sprintf(`{%q:%d}`, rootA, time.Now().Add(time.Hour).UnixNano())
+	changesChan, errsChan := connector.IncrementalSync(context.Background(), domain.SyncState{Cursor: cursor})
+
+	var uris []string
+	for change := range changesChan {
+		uris = append(uris, change.Document.URI)
+	}
+	var newCursor string
+	for err := range errsChan {
+		if complete, ok := err.(*driven.SyncComplete); ok {
+			newCursor = complete.NewCursor
+			continue
+		}
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{filepath.Join(rootB, "b.txt")}, uris)
+	times, err := connector.parseCursor(newCursor)
+	require.NoError(t, err)
+	assert.False(t, times[rootA].IsZero())
+	assert.False(t, times[rootB].IsZero())
+}
+
+func TestConnector_MultipleRoots_Watch(t *testing.T) {
+	connector, _, rootB := newMultiRootConnector(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer connector.Close()
+
+	changesChan, err := connector.Watch(ctx)
+	require.NoError(t, err)
+
+	newFile := filepath.Join(rootB, "new.txt")
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = os.WriteFile(newFile, []byte("new"), 0644)
+	}()
+
+	select {
+	case change := <-changesChan:
+		assert.Equal(t, newFile, change.Document.URI)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("timeout waiting for event in second root")
+	}
+}
@@ -0,0 +1,57 @@
+package filesystem
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// parseCursor decodes an incremental sync cursor into per-root sync times.
+//
+// A single-root source stores a Unix timestamp in nanoseconds, which applies
+// to every root (so cursors written before a source gained more roots stay
+// valid). A multi-root source stores a JSON object mapping each root to its
+// own nanosecond timestamp. Roots missing from the cursor get the zero time.
+func (c *Connector) parseCursor(cursor string) (map[string]time.Time, error) {
+	times := make(map[string]time.Time, len(c.roots))
+	if cursor == "" {
+		return times, nil
+	}
+
+	if nanos, err := strconv.ParseInt(cursor, 10, 64); err == nil {
+		for _, root := range c.roots {
+			times[root] = time.Unix(0, nanos)
+		}
+		return times, nil
+	}
+
+	var perRoot map[string]int64
+	if err := json.Unmarshal([]byte(cursor), &perRoot); err != nil {
+		return nil, fmt.Errorf("invalid cursor format: %w", err)
+	}
+	for _, root := range c.roots {
+		if nanos, ok := perRoot[root]; ok {
+			times[root] = time.Unix(0, nanos)
+		}
+	}
+	return times, nil
+}
+
+// encodeCursor encodes per-root sync times in the format read by parseCursor.
+func (c *Connector) encodeCursor(times map[string]time.Time) string {
+	if len(c.roots) == 1 {
+		return strconv.FormatInt(times[c.roots[0]].UnixNano(), 10)
+	}
+
+	perRoot := make(map[string]int64, len(times))
+	for root, t := range times {
+		perRoot[root] = t.UnixNano()
+	}
+	data, err := json.Marshal(perRoot)
+	if err != nil {
+		// A map of strings to integers always marshals.
+		return ""
+	}
+	return string(data)
+}
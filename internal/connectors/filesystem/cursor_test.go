@@ -0,0 +1,71 @@
+package filesystem
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCursor(t *testing.T) {
+	c := &Connector{roots: []string{"/a", "/b"}}
+	ts := time.Unix(0, 1700000000000000000)
+
+	t.Run("empty cursor", func(t *testing.T) {
+		times, err := c.parseCursor("")
+
+		require.NoError(t, err)
+		assert.Empty(t, times)
+	})
+
+	t.Run("plain timestamp applies to every root", func(t *testing.T) {
+		times, err := c.parseCursor(strconv.FormatInt(ts.UnixNano(), 10))
+
+		require.NoError(t, err)
+		assert.True(t, times["/a"].Equal(ts))
+		assert.True(t, times["/b"].Equal(ts))
+	})
+
+	t.Run("per-root timestamps", func(t *testing.T) {
+		times, err := c.parseCursor(`{"/a":1700000000000000000,"/removed":5}`)
+
+		require.NoError(t, err)
+		assert.True(t, times["/a"].Equal(ts))
+		assert.True(t, times["/b"].IsZero())
+		assert.NotContains(t, times, "/removed")
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := c.parseCursor("not-a-cursor")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid cursor format")
+	})
+}
+
+func TestEncodeCursor(t *testing.T) {
+	ts := time.Unix(0, 1700000000000000000)
+
+	t.Run("single root keeps plain timestamp", func(t *testing.T) {
+		c := &Connector{roots: []string{"/a"}}
+
+		assert.Equal(t, "1700000000000000000", c.encodeCursor(map[string]time.Time{"/a": ts}))
+	})
+
+	t.Run("multiple roots round trip", func(t *testing.T) {
+		c := &Connector{roots: []string{"/a", "/b"}}
+		later := ts.Add(time.Minute)
+
+		cursor := c.encodeCursor(map[string]time.Time{"/a": ts, "/b": later})
+
+		var perRoot map[string]int64
+		require.NoError(t, json.Unmarshal([]byte(cursor), &perRoot))
+		times, err := c.parseCursor(cursor)
+		require.NoError(t, err)
+		assert.True(t, times["/a"].Equal(ts))
+		assert.True(t, times["/b"].Equal(later))
+	})
+}
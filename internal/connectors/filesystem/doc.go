@@ -1,4 +1,36 @@
 // Package filesystem provides a Connector implementation for local
 // filesystem directories. It watches for file changes and syncs
 // document content.
+//
+// A source may span several root directories: "path" names the first and
+// "paths" lists more, comma-separated. Each root is walked and watched, and
+// the incremental cursor records a sync time per root. A leading "~" in a
+// root expands to the home directory, and $VAR or ${VAR} to the environment
+// variable if it is set. Other "$" signs are kept, and "$$" is a literal "$".
+//
+// With "metadata_only" set, files are stat'ed but not read: documents carry
+// the name, path and file metadata with empty content, so they can be found
+// by name without the storage and embedding cost of their contents.
+//
+// A full sync reads files with a small pool of workers while the walk lists
+// paths; "concurrency" sets the pool size. Documents are sent in the order
+// reads finish rather than walk order.
+//
+// A ".sercha-ignore" file in any directory lists .gitignore-style patterns
+// (see package gitignore) of paths to skip, relative to that directory, so
+// the owners of a directory can control what is indexed without changing
+// the source's config. Filters apply in this order:
+//
+//  1. Hidden files and directories are always skipped, and cannot be
+//     included again by an ignore file.
+//  2. Ignore files are applied from the root down; a match in a deeper
+//     ignore file overrides the shallower ones, so "!pattern" there can
+//     include files again.
+//  3. Exclusions added with "sercha exclusion" or "sercha document exclude"
+//     are applied by the sync to everything the connector sends, so they
+//     win over ignore files.
+//
+// Ignore files are read afresh on each sync, and reloaded when one changes
+// while watching. Files already indexed stay indexed when they are ignored
+// later; exclude them to remove them.
 package filesystem
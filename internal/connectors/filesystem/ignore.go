@@ -0,0 +1,115 @@
+package filesystem
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/gitignore"
+)
+
+// IgnoreFileName is the name of the file listing .gitignore-style patterns
+// of paths to skip. It is read from each directory under a root, and its
+// patterns are relative to the directory holding it.
+const IgnoreFileName = ".sercha-ignore"
+
+// ignoreRules holds the ignore files found under the roots, read once per
+// directory. A new set of rules is used for each sync, so edits to ignore
+// files take effect on the next one.
+type ignoreRules struct {
+	mu   sync.Mutex
+	sets map[string]*gitignore.PatternSet // By directory; nil when it has no ignore file
+	log  func(msg string, args ...any)
+}
+
+// newIgnoreRules creates rules that read ignore files on first use.
+// Unreadable ignore files are reported to log and treated as empty.
+func newIgnoreRules(log func(msg string, args ...any)) *ignoreRules {
+	return &ignoreRules{
+		sets: make(map[string]*gitignore.PatternSet),
+		log:  log,
+	}
+}
+
+// match reports whether path under root is ignored. An ignore file applies
+// to everything beneath its directory, and a match in a deeper ignore file
+// overrides the shallower ones. For an ignored directory, skipDir reports
+// whether no negated pattern could include anything beneath it again, so a
+// walk can skip it. Nil rules ignore nothing.
+func (r *ignoreRules) match(root, path string, isDir bool) (ignored, skipDir bool) {
+	if r == nil || !isWithin(path, root) || path == root {
+		return false, false
+	}
+
+	negation := false
+	dir := root
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false, false
+	}
+	parts := strings.Split(rel, string(filepath.Separator))
+
+	for i := range parts {
+		if set := r.set(dir); !set.Empty() {
+			relPath := filepath.ToSlash(filepath.Join(parts[i:]...))
+			if excluded, matched := set.Result(relPath, isDir); matched {
+				ignored = excluded
+			}
+			negation = negation || set.HasNegation()
+		}
+		dir = filepath.Join(dir, parts[i])
+	}
+
+	return ignored, ignored && isDir && !negation
+}
+
+// set returns the patterns of the ignore file in dir, reading it on first use.
+func (r *ignoreRules) set(dir string) *gitignore.PatternSet {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if set, ok := r.sets[dir]; ok {
+		return set
+	}
+
+	set, err := readIgnoreFile(filepath.Join(dir, IgnoreFileName))
+	if err != nil && r.log != nil {
+		r.log("failed to read ignore file", "dir", dir, "error", err)
+	}
+	r.sets[dir] = set
+	return set
+}
+
+// reset forgets every ignore file read, so they are read again.
+func (r *ignoreRules) reset() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sets = make(map[string]*gitignore.PatternSet)
+}
+
+// readIgnoreFile parses an ignore file. A missing file has no patterns.
+func readIgnoreFile(path string) (*gitignore.PatternSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return gitignore.Parse(lines), nil
+}
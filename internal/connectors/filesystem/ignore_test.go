@@ -0,0 +1,199 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// writeTree creates files under root, creating directories as needed.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+// syncedFiles runs a full sync and returns the synced paths relative to the root.
+func syncedFiles(t *testing.T, connector *Connector) []string {
+	t.Helper()
+	docsChan, errsChan := connector.FullSync(context.Background())
+
+	var files []string
+	for doc := range docsChan {
+		rel, err := filepath.Rel(connector.roots[0], doc.URI)
+		require.NoError(t, err)
+		files = append(files, filepath.ToSlash(rel))
+	}
+	for err := range errsChan {
+		require.NoError(t, err)
+	}
+	sort.Strings(files)
+	return files
+}
+
+func TestIgnoreRules_Match(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		IgnoreFileName:                    "*.log\nbuild/\n/secret.txt\n",
+		"docs/" + IgnoreFileName:          "drafts/\n!keep.log\n",
+		"vendor/lib/" + IgnoreFileName:    "*.go\n",
+		"docs/drafts/idea.md":             "",
+		"docs/keep.log":                   "",
+		"docs/other.log":                  "",
+		"docs/guide.md":                   "",
+		"build/out.txt":                   "",
+		"secret.txt":                      "",
+		"nested/secret.txt":               "",
+		"vendor/lib/lib.go":               "",
+		"vendor/main.go":                  "",
+		"docs/drafts/" + IgnoreFileName:   "",
+		"app.log":                         "",
+		"nested/deeper/" + IgnoreFileName: "!*.log\n",
+	})
+	rules := newIgnoreRules(nil)
+
+	tests := []struct {
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{"app.log", false, true},
+		{"docs/other.log", false, true},
+		{"docs/keep.log", false, false},
+		{"docs/guide.md", false, false},
+		{"docs/drafts", true, true},
+		{"docs/drafts/idea.md", false, true},
+		{"build", true, true},
+		{"build/out.txt", false, true},
+		{"secret.txt", false, true},
+		{"nested/secret.txt", false, false},
+		{"vendor/lib/lib.go", false, true},
+		{"vendor/main.go", false, false},
+		{"nested/deeper/app.log", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			ignored, _ := rules.match(root, filepath.Join(root, filepath.FromSlash(tt.path)), tt.isDir)
+			assert.Equal(t, tt.ignored, ignored)
+		})
+	}
+
+	t.Run("directories are skipped unless a negation applies", func(t *testing.T) {
+		_, skipDir := rules.match(root, filepath.Join(root, "build"), true)
+		assert.True(t, skipDir)
+
+		_, skipDir = rules.match(root, filepath.Join(root, "docs", "drafts"), true)
+		assert.False(t, skipDir, "docs/.sercha-ignore has a negated pattern")
+	})
+
+	t.Run("root and paths outside it are never ignored", func(t *testing.T) {
+		ignored, _ := rules.match(root, root, true)
+		assert.False(t, ignored)
+		ignored, _ = rules.match(root, filepath.Join(t.TempDir(), "app.log"), false)
+		assert.False(t, ignored)
+	})
+
+	t.Run("nil rules ignore nothing", func(t *testing.T) {
+		var none *ignoreRules
+		ignored, _ := none.match(root, filepath.Join(root, "app.log"), false)
+		assert.False(t, ignored)
+	})
+}
+
+func TestIgnoreRules_Reset(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{IgnoreFileName: "*.log\n"})
+	rules := newIgnoreRules(nil)
+	path := filepath.Join(root, "app.log")
+
+	ignored, _ := rules.match(root, path, false)
+	require.True(t, ignored)
+
+	writeTree(t, root, map[string]string{IgnoreFileName: "*.tmp\n"})
+	ignored, _ = rules.match(root, path, false)
+	assert.True(t, ignored, "ignore files are cached until reset")
+
+	rules.reset()
+	ignored, _ = rules.match(root, path, false)
+	assert.False(t, ignored)
+}
+
+func TestConnector_FullSync_IgnoreFile(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		IgnoreFileName:          "node_modules/\n*.log\n",
+		"notes.md":              "notes",
+		"debug.log":             "log",
+		"node_modules/pkg/a.js": "js",
+		"web/" + IgnoreFileName: "dist/\n",
+		"web/index.html":        "html",
+		"web/dist/bundle.js":    "js",
+		"web/node_modules/b.js": "js",
+	})
+	connector := New("test-source", root)
+
+	assert.Equal(t, []string{"notes.md", "web/index.html"}, syncedFiles(t, connector))
+
+	// Edits take effect on the next sync
+	writeTree(t, root, map[string]string{IgnoreFileName: "node_modules/\n"})
+	assert.Equal(t, []string{"debug.log", "notes.md", "web/index.html"}, syncedFiles(t, connector))
+}
+
+func TestConnector_IncrementalSync_IgnoreFile(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		IgnoreFileName: "*.log\n",
+		"notes.md":     "notes",
+		"debug.log":    "log",
+	})
+	connector := New("test-source", root)
+
+	changesChan, errsChan := connector.IncrementalSync(context.Background(), domain.SyncState{})
+
+	var files []string
+	for change := range changesChan {
+		files = append(files, filepath.Base(change.Document.URI))
+	}
+	for err := range errsChan {
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+	}
+	assert.Equal(t, []string{"notes.md"}, files)
+}
+
+func TestHandleFsEvent_IgnoreFile(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		IgnoreFileName: "*.log\n",
+		"debug.log":    "log",
+	})
+	connector := New("test-source", root)
+	connector.watchIgnore = newIgnoreRules(nil)
+	logPath := filepath.Join(connector.roots[0], "debug.log")
+
+	change := connector.handleFsEvent(fsnotify.Event{Name: logPath, Op: fsnotify.Write})
+	assert.Nil(t, change, "ignored files are skipped")
+
+	// Changing the ignore file reloads it
+	writeTree(t, root, map[string]string{IgnoreFileName: "*.tmp\n"})
+	change = connector.handleFsEvent(fsnotify.Event{
+		Name: filepath.Join(connector.roots[0], IgnoreFileName), Op: fsnotify.Write,
+	})
+	assert.Nil(t, change, "ignore files are not indexed")
+
+	change = connector.handleFsEvent(fsnotify.Event{Name: logPath, Op: fsnotify.Write})
+	require.NotNil(t, change)
+	assert.Equal(t, logPath, change.Document.URI)
+}
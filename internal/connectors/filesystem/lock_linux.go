@@ -0,0 +1,20 @@
+//go:build linux
+
+package filesystem
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// isLocked reports whether another process holds an exclusive lock on the file.
+// It briefly takes a non-blocking shared flock and releases it straight away.
+func isLocked(f *os.File) bool {
+	fd := int(f.Fd()) //nolint:gosec // G115: file descriptors always fit in int
+	if err := syscall.Flock(fd, syscall.LOCK_SH|syscall.LOCK_NB); err != nil {
+		return errors.Is(err, syscall.EWOULDBLOCK)
+	}
+	_ = syscall.Flock(fd, syscall.LOCK_UN) //nolint:errcheck // lock is released on close anyway
+	return false
+}
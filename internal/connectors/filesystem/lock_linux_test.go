@@ -0,0 +1,143 @@
+//go:build linux
+
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// lockFile takes an exclusive flock on a separate file description,
+// which behaves like a lock held by another process.
+func lockFile(t *testing.T, path string) {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	require.NoError(t, syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB))
+	t.Cleanup(func() { f.Close() })
+}
+
+func TestConnector_readFile_Locked(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "locked.txt")
+	require.NoError(t, os.WriteFile(path, []byte("partial"), 0644))
+	lockFile(t, path)
+
+	t.Run("skips locked file", func(t *testing.T) {
+		connector := New("test-source", tempDir)
+
+		_, err := connector.readFile(tempDir, path)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errFileLocked)
+	})
+
+	t.Run("reads locked file when skip_locked is disabled", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Path = tempDir
+		cfg.SkipLocked = false
+		connector := NewWithConfig("test-source", cfg)
+
+		doc, err := connector.readFile(tempDir, path)
+
+		require.NoError(t, err)
+		assert.Equal(t, []byte("partial"), doc.Content)
+	})
+
+	t.Run("full sync omits locked file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "ready.txt"), []byte("done"), 0644))
+		connector := New("test-source", tempDir)
+
+		docsChan, errsChan := connector.FullSync(context.Background())
+
+		var uris []string
+		for doc := range docsChan {
+			uris = append(uris, doc.URI)
+		}
+		for range errsChan {
+		}
+
+		assert.Equal(t, []string{filepath.Join(tempDir, "ready.txt")}, uris)
+	})
+}
+
+// incrementalURIs runs an incremental sync and returns the changed URIs and the new cursor.
+func incrementalURIs(t *testing.T, connector *Connector, cursor string) ([]string, string) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	changesChan, errsChan := connector.IncrementalSync(ctx, domain.SyncState{Cursor: cursor})
+
+	var uris []string
+	for change := range changesChan {
+		uris = append(uris, change.Document.URI)
+	}
+	complete, ok := driven.IsSyncComplete(<-errsChan)
+	require.True(t, ok, "sync should complete before the timeout")
+	return uris, complete.NewCursor
+}
+
+func TestConnector_IncrementalSync_LockedFileRetried(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "locked.txt")
+	require.NoError(t, os.WriteFile(path, []byte("partial"), 0644))
+	modTime := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	require.NoError(t, syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB))
+
+	connector := New("test-source", tempDir)
+	since := strconv.FormatInt(modTime.Add(-time.Minute).UnixNano(), 10)
+
+	uris, cursor := incrementalURIs(t, connector, since)
+
+	assert.Empty(t, uris)
+	assert.Equal(t, strconv.FormatInt(modTime.UnixNano(), 10), cursor,
+		"cursor must not pass the skipped file")
+
+	require.NoError(t, f.Close())
+	uris, _ = incrementalURIs(t, connector, cursor)
+
+	assert.Equal(t, []string{path}, uris)
+}
+
+func TestConnector_Sync_SkipsNamedPipeWithOpenWriter(t *testing.T) {
+	tempDir := t.TempDir()
+	pipe := filepath.Join(tempDir, "feed.log")
+	require.NoError(t, syscall.Mkfifo(pipe, 0644))
+	// Hold a writer open so a read of the pipe would never reach EOF
+	writer, err := os.OpenFile(pipe, os.O_RDWR, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { writer.Close() })
+	_, err = writer.WriteString("streaming")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "ready.txt"), []byte("done"), 0644))
+
+	connector := New("test-source", tempDir)
+
+	t.Run("readFile", func(t *testing.T) {
+		_, err := connector.readFile(tempDir, pipe)
+
+		assert.ErrorIs(t, err, errNotRegular)
+	})
+
+	t.Run("incremental sync", func(t *testing.T) {
+		uris, _ := incrementalURIs(t, connector, "")
+
+		assert.Equal(t, []string{filepath.Join(tempDir, "ready.txt")}, uris)
+	})
+}
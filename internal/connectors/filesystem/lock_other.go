@@ -0,0 +1,10 @@
+//go:build !linux
+
+package filesystem
+
+import "os"
+
+// isLocked always reports false on platforms without flock support.
+func isLocked(_ *os.File) bool {
+	return false
+}
@@ -0,0 +1,241 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+const (
+	// DefaultMaxAttachmentSize is the default size limit for indexed attachments (1MB).
+	DefaultMaxAttachmentSize = 1024 * 1024
+
+	// maxAttachmentsPerItem bounds the attachments and gists fetched for one
+	// issue or pull request, so a single noisy thread cannot use up the rate limit.
+	maxAttachmentsPerItem = 20
+)
+
+var (
+	// attachmentURLPattern matches files uploaded to issue and pull request
+	// threads. Images are uploaded to /assets/ paths and never match.
+	attachmentURLPattern = regexp.MustCompile(
+		`https://github\.com/(?:user-attachments|[\w.-]+/[\w.-]+)/files/(\d+)/([^\s()\[\]<>"'` + "`" + `]+)`)
+
+	// gistURLPattern matches links to gists, with or without the owner.
+	gistURLPattern = regexp.MustCompile(`https://gist\.github\.com/(?:[\w-]+/)?([0-9a-fA-F]{20,32})\b`)
+)
+
+// textAttachmentTypes maps the extensions of text attachments to their MIME type.
+// Other attachments (images, archives, binaries) are skipped.
+var textAttachmentTypes = map[string]string{
+	".txt":      "text/plain",
+	".log":      "text/plain",
+	".out":      "text/plain",
+	".json":     "text/plain",
+	".yaml":     "text/plain",
+	".yml":      "text/plain",
+	".toml":     "text/plain",
+	".xml":      "text/plain",
+	".csv":      "text/plain",
+	".diff":     "text/plain",
+	".patch":    "text/plain",
+	".md":       "text/markdown",
+	".markdown": "text/markdown",
+}
+
+// threadText is the text of an issue or pull request thread.
+// It decodes both IssueContent and PRContent.
+type threadText struct {
+	Body     string           `json:"body"`
+	Comments []CommentContent `json:"comments"`
+	Reviews  []ReviewContent  `json:"reviews"`
+}
+
+// attachmentLink is a text attachment referenced from a thread.
+type attachmentLink struct {
+	ID       string
+	Filename string
+	URL      string
+	MIMEType string
+}
+
+// FetchAttachments returns child documents for the text attachments and gists
+// linked from an issue or pull request document. Attachments that are binary,
+// larger than maxSize or cannot be fetched are skipped.
+func FetchAttachments(
+	ctx context.Context, client *Client, parent domain.RawDocument, maxSize int64,
+) []domain.RawDocument {
+	var thread threadText
+	if err := json.Unmarshal(parent.Content, &thread); err != nil {
+		return nil
+	}
+	texts := []string{thread.Body}
+	for _, c := range thread.Comments {
+		texts = append(texts, c.Body)
+	}
+	for _, r := range thread.Reviews {
+		texts = append(texts, r.Body)
+	}
+
+	attachments, gistIDs := findAttachmentLinks(texts)
+	if n := len(attachments) + len(gistIDs); n > maxAttachmentsPerItem {
+		if len(attachments) > maxAttachmentsPerItem {
+			attachments = attachments[:maxAttachmentsPerItem]
+		}
+		gistIDs = gistIDs[:maxAttachmentsPerItem-len(attachments)]
+	}
+
+	var docs []domain.RawDocument
+	for _, link := range attachments {
+		if ctx.Err() != nil {
+			return docs
+		}
+		content, err := client.DownloadAttachment(ctx, link.URL, maxSize)
+		if err != nil || !isText(content) {
+			continue
+		}
+		docs = append(docs, buildAttachmentDocument(parent, link, content))
+	}
+
+	for _, id := range gistIDs {
+		if ctx.Err() != nil {
+			return docs
+		}
+		gist, err := client.GetGist(ctx, id)
+		if err != nil {
+			continue
+		}
+		content := buildGistContent(gist)
+		if gistSize(content) > maxSize {
+			continue
+		}
+		contentJSON, err := json.Marshal(content)
+		if err != nil {
+			continue
+		}
+		docs = append(docs, buildLinkedGistDocument(parent, gist.GetHTMLURL(), content, contentJSON))
+	}
+
+	return docs
+}
+
+// findAttachmentLinks returns the distinct text attachments and gist IDs
+// linked from the given texts, in the order they appear.
+func findAttachmentLinks(texts []string) ([]attachmentLink, []string) {
+	var attachments []attachmentLink
+	var gistIDs []string
+	seen := make(map[string]bool)
+
+	for _, text := range texts {
+		for _, m := range attachmentURLPattern.FindAllStringSubmatch(text, -1) {
+			link := strings.TrimRight(m[0], ".,;:!?")
+			filename := path.Base(strings.TrimRight(m[2], ".,;:!?"))
+			if unescaped, err := url.PathUnescape(filename); err == nil {
+				filename = unescaped
+			}
+			mimeType, ok := textAttachmentTypes[strings.ToLower(path.Ext(filename))]
+			if !ok || seen[link] {
+				continue
+			}
+			seen[link] = true
+			attachments = append(attachments, attachmentLink{
+				ID:       m[1],
+				Filename: filename,
+				URL:      link,
+				MIMEType: mimeType,
+			})
+		}
+		for _, m := range gistURLPattern.FindAllStringSubmatch(text, -1) {
+			id := strings.ToLower(m[1])
+			if seen["gist:"+id] {
+				continue
+			}
+			seen["gist:"+id] = true
+			gistIDs = append(gistIDs, id)
+		}
+	}
+
+	return attachments, gistIDs
+}
+
+// isText reports whether downloaded content is text rather than a mislabelled binary.
+func isText(content []byte) bool {
+	return utf8.Valid(content) && !bytes.ContainsRune(content, 0)
+}
+
+// gistSize returns the total size of a gist's files.
+func gistSize(content GistContent) int64 {
+	var size int64
+	for _, f := range content.Files {
+		size += int64(len(f.Content))
+	}
+	return size
+}
+
+// buildAttachmentDocument creates the child document for an attachment.
+func buildAttachmentDocument(parent domain.RawDocument, link attachmentLink, content []byte) domain.RawDocument {
+	parentURI := parent.URI
+	metadata := childMetadata(parent, "attachment")
+	metadata["filename"] = link.Filename
+	metadata["title"] = link.Filename
+	metadata["size"] = len(content)
+	metadata["html_url"] = link.URL
+
+	return domain.RawDocument{
+		SourceID:  parent.SourceID,
+		URI:       fmt.Sprintf("%s/attachments/%s/%s", parent.URI, link.ID, link.Filename),
+		MIMEType:  link.MIMEType,
+		Content:   content,
+		ParentURI: &parentURI,
+		Metadata:  metadata,
+	}
+}
+
+// buildLinkedGistDocument creates the child document for a gist linked from a thread.
+func buildLinkedGistDocument(
+	parent domain.RawDocument, htmlURL string, content GistContent, contentJSON []byte,
+) domain.RawDocument {
+	parentURI := parent.URI
+	filenames := make([]string, len(content.Files))
+	for i, f := range content.Files {
+		filenames[i] = f.Filename
+	}
+	metadata := childMetadata(parent, "linked_gist")
+	metadata["gist_id"] = content.ID
+	metadata["description"] = content.Description
+	metadata["files"] = filenames
+	metadata["html_url"] = htmlURL
+	metadata["updated_at"] = content.UpdatedAt.Format(time.RFC3339)
+
+	return domain.RawDocument{
+		SourceID:  parent.SourceID,
+		URI:       fmt.Sprintf("%s/gists/%s", parent.URI, content.ID),
+		MIMEType:  MIMETypeGitHubGist,
+		Content:   contentJSON,
+		ParentURI: &parentURI,
+		Metadata:  metadata,
+	}
+}
+
+// childMetadata returns the metadata a child document inherits from its thread.
+func childMetadata(parent domain.RawDocument, docType string) map[string]any {
+	metadata := map[string]any{"type": docType}
+	for _, key := range []string{"owner", "repo", "number"} {
+		if v, ok := parent.Metadata[key]; ok {
+			metadata[key] = v
+		}
+	}
+	if v, ok := parent.Metadata["type"]; ok {
+		metadata["parent_type"] = v
+	}
+	return metadata
+}
@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v80/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+const linkedGistID = "aa5a315d61ae9438b18d"
+
+// redirectTransport sends every request to a test server, keeping the path.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (rt redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = rt.target.Scheme
+	req.URL.Host = rt.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newAttachmentTestClient returns a client whose requests, including those to
+// github.com attachment URLs, are served by a fake server.
+func newAttachmentTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user-attachments/files/1/crash.log", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("panic: nil map"))
+	})
+	mux.HandleFunc("/octo/repo/files/2/trace.txt", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("bad\x00binary"))
+	})
+	mux.HandleFunc("/user-attachments/files/3/big.json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", 64)))
+	})
+	mux.HandleFunc("/gists/"+linkedGistID, func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id": linkedGistID, "description": "Repro",
+			"html_url": "https://gist.github.com/octo/" + linkedGistID,
+			"files":    map[string]any{"repro.sh": map[string]any{"filename": "repro.sh", "content": "make test"}},
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client := NewClientWithHTTPClient(nil)
+	client.gh = gh.NewClient(&http.Client{Transport: redirectTransport{target: target}})
+	client.gh.BaseURL = target
+	// No proactive throttling against the fake server
+	client.rateLimiter.bucket = rate.NewLimiter(rate.Inf, 1)
+	return client
+}
+
+func issueDocument(t *testing.T, content IssueContent) domain.RawDocument {
+	t.Helper()
+	data, err := json.Marshal(content)
+	require.NoError(t, err)
+	return domain.RawDocument{
+		SourceID: "src",
+		URI:      "github://octo/repo/issues/7",
+		Content:  data,
+		Metadata: map[string]any{"type": "issue", "owner": "octo", "repo": "repo", "number": 7},
+	}
+}
+
+func TestFindAttachmentLinks(t *testing.T) {
+	texts := []string{
+		"Log: [crash.log](https://github.com/user-attachments/files/1/crash.log).\n" +
+			"![screenshot](https://github.com/user-attachments/assets/5f6e)",
+		"Same log https://github.com/user-attachments/files/1/crash.log and " +
+			"https://github.com/octo/repo/files/9/build.zip and " +
+			"https://gist.github.com/octo/" + strings.ToUpper(linkedGistID),
+		"again https://gist.github.com/" + linkedGistID,
+	}
+
+	attachments, gistIDs := findAttachmentLinks(texts)
+
+	require.Len(t, attachments, 1)
+	assert.Equal(t, attachmentLink{
+		ID:       "1",
+		Filename: "crash.log",
+		URL:      "https://github.com/user-attachments/files/1/crash.log",
+		MIMEType: "text/plain",
+	}, attachments[0])
+	assert.Equal(t, []string{linkedGistID}, gistIDs)
+}
+
+func TestFetchAttachments(t *testing.T) {
+	client := newAttachmentTestClient(t)
+	parent := issueDocument(t, IssueContent{
+		Number: 7,
+		Body:   "See https://github.com/user-attachments/files/1/crash.log",
+		Comments: []CommentContent{
+			{Body: "Mislabelled: https://github.com/octo/repo/files/2/trace.txt"},
+			{Body: "Too big: https://github.com/user-attachments/files/3/big.json"},
+			{Body: "Repro in https://gist.github.com/octo/" + linkedGistID},
+		},
+	})
+
+	docs := FetchAttachments(context.Background(), client, parent, 32)
+
+	require.Len(t, docs, 2)
+
+	attachment := docs[0]
+	assert.Equal(t, "github://octo/repo/issues/7/attachments/1/crash.log", attachment.URI)
+	assert.Equal(t, "text/plain", attachment.MIMEType)
+	assert.Equal(t, "panic: nil map", string(attachment.Content))
+	require.NotNil(t, attachment.ParentURI)
+	assert.Equal(t, parent.URI, *attachment.ParentURI)
+	assert.Equal(t, "src", attachment.SourceID)
+	assert.Equal(t, "attachment", attachment.Metadata["type"])
+	assert.Equal(t, "issue", attachment.Metadata["parent_type"])
+	assert.Equal(t, 7, attachment.Metadata["number"])
+
+	gist := docs[1]
+	assert.Equal(t, "github://octo/repo/issues/7/gists/"+linkedGistID, gist.URI)
+	assert.Equal(t, MIMETypeGitHubGist, gist.MIMEType)
+	assert.Equal(t, "https://gist.github.com/octo/"+linkedGistID, gist.Metadata["html_url"])
+	require.NotNil(t, gist.ParentURI)
+	assert.Equal(t, parent.URI, *gist.ParentURI)
+}
+
+func TestFetchAttachments_NoLinks(t *testing.T) {
+	parent := issueDocument(t, IssueContent{Number: 7, Body: "No attachments here"})
+
+	assert.Empty(t, FetchAttachments(context.Background(), NewClientWithHTTPClient(nil), parent, DefaultMaxAttachmentSize))
+}
+
+func TestDownloadAttachment_TooLarge(t *testing.T) {
+	client := newAttachmentTestClient(t)
+
+	_, err := client.DownloadAttachment(context.Background(),
+		"https://github.com/user-attachments/files/3/big.json", 10)
+
+	assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+}
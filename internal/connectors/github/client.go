@@ -11,6 +11,7 @@ import (
 	gh "github.com/google/go-github/v80/github"
 	"golang.org/x/oauth2"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
@@ -30,6 +31,7 @@ type Client struct {
 	gh            *gh.Client
 	tokenProvider driven.TokenProvider
 	rateLimiter   *RateLimiter
+	transport     http.RoundTripper
 }
 
 // NewClient creates a new GitHub API client with a token provider.
@@ -40,6 +42,12 @@ func NewClient(tokenProvider driven.TokenProvider) *Client {
 	}
 }
 
+// SetTransport sets the base HTTP transport, such as a per-source HTTP cache.
+// It must be called before the first request.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	c.transport = rt
+}
+
 // ensureClient initializes the go-github client if not already done.
 // This is called lazily so we can get the token when needed.
 func (c *Client) ensureClient(ctx context.Context) error {
@@ -47,21 +55,49 @@ func (c *Client) ensureClient(ctx context.Context) error {
 		return nil
 	}
 
-	token, err := c.tokenProvider.GetToken(ctx)
-	if err != nil {
+	// Fetch the first token up front so authentication errors surface here
+	if _, err := c.tokenProvider.GetToken(ctx); err != nil {
 		return fmt.Errorf("get token: %w", err)
 	}
 
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: token},
-	)
-	tc := oauth2.NewClient(ctx, ts)
-	tc.Timeout = DefaultTimeout
+	// The token source is used directly rather than through oauth2.NewClient,
+	// which would reuse the first token until it expires by its own clock
+	tc := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: &providerTokenSource{ctx: context.WithoutCancel(ctx), provider: c.tokenProvider},
+			Base:   c.transport, // nil uses http.DefaultTransport
+		},
+		Timeout: DefaultTimeout,
+	}
 	c.gh = gh.NewClient(tc)
 
 	return nil
 }
 
+// providerTokenSource asks the token provider for a token on every request,
+// so tokens the provider refreshes, such as hour-long GitHub App
+// installation tokens, are picked up during long syncs.
+type providerTokenSource struct {
+	ctx      context.Context
+	provider driven.TokenProvider
+}
+
+// Token returns the provider's current token.
+func (s *providerTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.provider.GetToken(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get token: %w", err)
+	}
+	return &oauth2.Token{AccessToken: token}, nil
+}
+
+// isAppInstallation reports whether the client authenticates as a GitHub App
+// installation. Installation tokens act for the installation rather than a
+// user, so user endpoints such as /user and gists are unavailable.
+func (c *Client) isAppInstallation() bool {
+	return c.tokenProvider != nil && c.tokenProvider.AuthMethod() == domain.AuthMethodGitHubApp
+}
+
 // GitHub returns the underlying go-github client.
 // Caller should call ensureClient first.
 func (c *Client) GitHub() *gh.Client {
@@ -70,10 +106,14 @@ func (c *Client) GitHub() *gh.Client {
 
 // ListAllAccessibleRepos returns ALL repositories the authenticated user can access.
 // This includes: owned repos, collaborator repos, and organization member repos.
+// A GitHub App installation accesses the repositories it is installed on.
 func (c *Client) ListAllAccessibleRepos(ctx context.Context) ([]*gh.Repository, error) {
 	if err := c.ensureClient(ctx); err != nil {
 		return nil, err
 	}
+	if c.isAppInstallation() {
+		return c.listInstallationRepos(ctx)
+	}
 
 	var allRepos []*gh.Repository
 
@@ -117,6 +157,41 @@ func (c *Client) ListAllAccessibleRepos(ctx context.Context) ([]*gh.Repository,
 	return allRepos, nil
 }
 
+// listInstallationRepos returns the repositories a GitHub App installation
+// can access.
+func (c *Client) listInstallationRepos(ctx context.Context) ([]*gh.Repository, error) {
+	var allRepos []*gh.Repository
+	opts := &gh.ListOptions{PerPage: 100}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return allRepos, ctx.Err()
+		default:
+		}
+
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limit wait: %w", err)
+		}
+
+		list, resp, err := c.gh.Apps.ListRepos(ctx, opts)
+		if err != nil {
+			return nil, c.wrapError(err, "list installation repos")
+		}
+
+		c.updateRateLimitFromResponse(resp)
+
+		allRepos = append(allRepos, list.Repositories...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allRepos, nil
+}
+
 // GetRepository fetches a single repository.
 func (c *Client) GetRepository(ctx context.Context, owner, repo string) (*gh.Repository, error) {
 	if err := c.ensureClient(ctx); err != nil {
@@ -226,6 +301,46 @@ func (c *Client) DownloadContents(ctx context.Context, owner, repo, path, ref st
 	return rc, nil
 }
 
+// DownloadAttachment downloads a file uploaded to an issue or pull request.
+// It returns ErrAttachmentTooLarge if the file is larger than maxSize bytes.
+func (c *Client) DownloadAttachment(ctx context.Context, url string, maxSize int64) ([]byte, error) {
+	if err := c.ensureClient(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("build attachment request: %w", err)
+	}
+
+	// Attachments redirect to signed storage URLs outside the API
+	resp, err := c.gh.Client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download attachment: unexpected status %d", resp.StatusCode)
+	}
+	if resp.ContentLength > maxSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read attachment: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrAttachmentTooLarge
+	}
+	return data, nil
+}
+
 // ListIssues lists issues for a repository.
 func (c *Client) ListIssues(
 	ctx context.Context, owner, repo string, opts *gh.IssueListByRepoOptions,
@@ -302,6 +417,167 @@ func (c *Client) ListPullRequests(
 	return allPRs, nil
 }
 
+// ListCommits lists the commits of a repository.
+func (c *Client) ListCommits(
+	ctx context.Context, owner, repo string, opts *gh.CommitsListOptions,
+) ([]*gh.RepositoryCommit, error) {
+	if err := c.ensureClient(ctx); err != nil {
+		return nil, err
+	}
+
+	var allCommits []*gh.RepositoryCommit
+
+	for {
+		select {
+		case <-ctx.Done():
+			return allCommits, ctx.Err()
+		default:
+		}
+
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limit wait: %w", err)
+		}
+
+		commits, resp, err := c.gh.Repositories.ListCommits(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, c.wrapError(err, "list commits")
+		}
+
+		c.updateRateLimitFromResponse(resp)
+		allCommits = append(allCommits, commits...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allCommits, nil
+}
+
+// GetHeadCommitSHA returns the SHA of the latest commit on a branch with a
+// single request. Returns an empty string if the branch has no commits.
+func (c *Client) GetHeadCommitSHA(ctx context.Context, owner, repo, branch string) (string, error) {
+	if err := c.ensureClient(ctx); err != nil {
+		return "", err
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return "", fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	opts := &gh.CommitsListOptions{SHA: branch, ListOptions: gh.ListOptions{PerPage: 1}}
+	commits, resp, err := c.gh.Repositories.ListCommits(ctx, owner, repo, opts)
+	if err != nil {
+		return "", c.wrapError(err, "get head commit")
+	}
+
+	c.updateRateLimitFromResponse(resp)
+	if len(commits) == 0 {
+		return "", nil
+	}
+	return commits[0].GetSHA(), nil
+}
+
+// GetCommit fetches a single commit with its changed files and patches.
+func (c *Client) GetCommit(ctx context.Context, owner, repo, sha string) (*gh.RepositoryCommit, error) {
+	if err := c.ensureClient(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	commit, resp, err := c.gh.Repositories.GetCommit(ctx, owner, repo, sha, nil)
+	if err != nil {
+		return nil, c.wrapError(err, "get commit")
+	}
+
+	c.updateRateLimitFromResponse(resp)
+	return commit, nil
+}
+
+// ListGists lists all gists, public and secret, of the authenticated user.
+func (c *Client) ListGists(ctx context.Context, opts *gh.GistListOptions) ([]*gh.Gist, error) {
+	return c.listGists(ctx, opts, "list gists", func(opts *gh.GistListOptions) ([]*gh.Gist, *gh.Response, error) {
+		return c.gh.Gists.List(ctx, "", opts)
+	})
+}
+
+// ListStarredGists lists the gists starred by the authenticated user.
+func (c *Client) ListStarredGists(ctx context.Context, opts *gh.GistListOptions) ([]*gh.Gist, error) {
+	return c.listGists(ctx, opts, "list starred gists", func(opts *gh.GistListOptions) ([]*gh.Gist, *gh.Response, error) {
+		return c.gh.Gists.ListStarred(ctx, opts)
+	})
+}
+
+// listGists follows pagination for a gist listing endpoint.
+// The client is initialised before list is first called.
+func (c *Client) listGists(
+	ctx context.Context, opts *gh.GistListOptions, operation string,
+	list func(*gh.GistListOptions) ([]*gh.Gist, *gh.Response, error),
+) ([]*gh.Gist, error) {
+	if err := c.ensureClient(ctx); err != nil {
+		return nil, err
+	}
+
+	var allGists []*gh.Gist
+
+	for {
+		select {
+		case <-ctx.Done():
+			return allGists, ctx.Err()
+		default:
+		}
+
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limit wait: %w", err)
+		}
+
+		gists, resp, err := list(opts)
+		if err != nil {
+			return nil, c.wrapError(err, operation)
+		}
+
+		c.updateRateLimitFromResponse(resp)
+		allGists = append(allGists, gists...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+
+	return allGists, nil
+}
+
+// GetGist retrieves a single gist with its file contents.
+func (c *Client) GetGist(ctx context.Context, id string) (*GistDetail, error) {
+	if err := c.ensureClient(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	// go-github's Gists.Get drops fork_of, so decode the response ourselves
+	req, err := c.gh.NewRequest(http.MethodGet, "gists/"+id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build gist request: %w", err)
+	}
+
+	var gist GistDetail
+	resp, err := c.gh.Do(ctx, req, &gist)
+	if err != nil {
+		return nil, c.wrapError(err, "get gist")
+	}
+
+	c.updateRateLimitFromResponse(resp)
+	return &gist, nil
+}
+
 // RateLimit returns the current rate limit status.
 func (c *Client) RateLimit(ctx context.Context) (*gh.RateLimits, error) {
 	if err := c.ensureClient(ctx); err != nil {
@@ -367,7 +643,14 @@ func (c *Client) ValidateCredentials(ctx context.Context) error {
 		return fmt.Errorf("rate limit wait: %w", err)
 	}
 
-	_, resp, err := c.gh.Users.Get(ctx, "")
+	// Installation tokens cannot read /user, so check the installation's repositories
+	var resp *gh.Response
+	var err error
+	if c.isAppInstallation() {
+		_, resp, err = c.gh.Apps.ListRepos(ctx, &gh.ListOptions{PerPage: 1})
+	} else {
+		_, resp, err = c.gh.Users.Get(ctx, "")
+	}
 	if err != nil {
 		return c.wrapError(err, "validate credentials")
 	}
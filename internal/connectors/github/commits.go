@@ -0,0 +1,186 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gh "github.com/google/go-github/v80/github"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+const (
+	// MIMETypeGitHubCommit is the custom MIME type for GitHub commits.
+	MIMETypeGitHubCommit = "application/vnd.github.commit+json"
+
+	// DefaultCommitHistoryDays is how far back the first sync indexes commits.
+	DefaultCommitHistoryDays = 365
+
+	// maxCommitDiffSize bounds the patch text kept for one commit (64KB).
+	// Patches beyond the limit are dropped and the content is marked truncated.
+	maxCommitDiffSize = 64 * 1024
+)
+
+// CommitContent is the JSON structure for the commit RawDocument content.
+type CommitContent struct {
+	SHA         string              `json:"sha"`
+	Message     string              `json:"message"`
+	Author      string              `json:"author"`
+	AuthorLogin string              `json:"author_login,omitempty"`
+	Date        time.Time           `json:"date"`
+	Files       []CommitFileContent `json:"files,omitempty"`
+	Truncated   bool                `json:"truncated,omitempty"`
+}
+
+// CommitFileContent is a file changed by a commit, with its patch when diffs are indexed.
+type CommitFileContent struct {
+	Filename  string `json:"filename"`
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Patch     string `json:"patch,omitempty"`
+}
+
+// FetchCommits retrieves the commits on a repository's default branch made
+// after since. When since is zero, history is bounded by cfg.CommitHistoryDays.
+// Diffs are fetched per commit only when cfg.IncludeCommitDiffs is set.
+func FetchCommits(
+	ctx context.Context, client *Client, repo *gh.Repository, since time.Time, cfg *Config,
+) ([]domain.RawDocument, time.Time, error) {
+	owner := repo.GetOwner().GetLogin()
+	name := repo.GetName()
+
+	listSince := since
+	if listSince.IsZero() && cfg.CommitHistoryDays > 0 {
+		listSince = time.Now().AddDate(0, 0, -cfg.CommitHistoryDays)
+	}
+
+	opts := &gh.CommitsListOptions{
+		SHA:         repo.GetDefaultBranch(),
+		Since:       listSince,
+		ListOptions: gh.ListOptions{PerPage: 100},
+	}
+
+	commits, err := client.ListCommits(ctx, owner, name, opts)
+	if err != nil {
+		if isEmptyRepository(err) {
+			return nil, since, nil
+		}
+		return nil, since, fmt.Errorf("list commits: %w", err)
+	}
+
+	docs := make([]domain.RawDocument, 0, len(commits))
+	var latestCommit time.Time
+
+	for _, commit := range commits {
+		// The API includes commits made at exactly since
+		committedAt := commit.GetCommit().GetCommitter().GetDate().Time
+		if !since.IsZero() && !committedAt.After(since) {
+			continue
+		}
+		if committedAt.After(latestCommit) {
+			latestCommit = committedAt
+		}
+
+		if cfg.IncludeCommitDiffs {
+			// The listing omits changed files. Errors are non-fatal.
+			detail, detailErr := client.GetCommit(ctx, owner, name, commit.GetSHA())
+			if detailErr != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return nil, since, ctxErr
+				}
+			} else {
+				commit = detail
+			}
+		}
+
+		content := buildCommitContent(commit, cfg.IncludeCommitDiffs)
+		contentJSON, jsonErr := json.Marshal(content)
+		if jsonErr != nil {
+			continue
+		}
+
+		docs = append(docs, buildCommitDocument(owner, name, commit, &content, contentJSON))
+	}
+
+	return docs, latestCommit, nil
+}
+
+// buildCommitContent creates the CommitContent structure. Patches are kept
+// only with includeDiffs, up to maxCommitDiffSize in total.
+func buildCommitContent(commit *gh.RepositoryCommit, includeDiffs bool) CommitContent {
+	content := CommitContent{
+		SHA:         commit.GetSHA(),
+		Message:     commit.GetCommit().GetMessage(),
+		Author:      commit.GetCommit().GetAuthor().GetName(),
+		AuthorLogin: commit.GetAuthor().GetLogin(),
+		Date:        commit.GetCommit().GetAuthor().GetDate().Time,
+	}
+
+	var diffSize int
+	for _, f := range commit.Files {
+		file := CommitFileContent{
+			Filename:  f.GetFilename(),
+			Status:    f.GetStatus(),
+			Additions: f.GetAdditions(),
+			Deletions: f.GetDeletions(),
+		}
+		if includeDiffs {
+			patch := f.GetPatch()
+			if diffSize+len(patch) <= maxCommitDiffSize {
+				file.Patch = patch
+				diffSize += len(patch)
+			} else {
+				content.Truncated = true
+			}
+		}
+		content.Files = append(content.Files, file)
+	}
+
+	return content
+}
+
+// buildCommitDocument creates a RawDocument from a commit.
+func buildCommitDocument(
+	owner, name string, commit *gh.RepositoryCommit, content *CommitContent, contentJSON []byte,
+) domain.RawDocument {
+	return domain.RawDocument{
+		SourceID: "", // Will be set by connector.
+		URI:      buildCommitURI(owner, name, content.SHA),
+		MIMEType: MIMETypeGitHubCommit,
+		Content:  contentJSON,
+		Metadata: map[string]any{
+			"type":         "commit",
+			"owner":        owner,
+			"repo":         name,
+			"sha":          content.SHA,
+			"title":        commitSubject(content.Message),
+			"author":       content.Author,
+			"author_login": content.AuthorLogin,
+			"html_url":     commit.GetHTMLURL(),
+			"date":         content.Date.Format(time.RFC3339),
+		},
+	}
+}
+
+// commitSubject returns the first line of a commit message.
+func commitSubject(message string) string {
+	subject, _, _ := strings.Cut(message, "\n")
+	return strings.TrimSpace(subject)
+}
+
+// isEmptyRepository reports whether the API rejected a commit listing
+// because the repository has no commits yet.
+func isEmptyRepository(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == 409
+}
+
+// buildCommitURI creates a URI for a commit.
+func buildCommitURI(owner, repo, sha string) string {
+	return fmt.Sprintf("github://%s/%s/commit/%s", owner, repo, sha)
+}
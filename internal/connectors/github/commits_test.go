@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v80/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// newCommitTestClient returns a client backed by a fake commits API for
+// octocat/hello. It records the since parameter of each listing.
+func newCommitTestClient(t *testing.T, listedSince *[]string) *Client {
+	t.Helper()
+
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	commit := func(sha, message string, date time.Time) map[string]any {
+		signature := map[string]any{"name": "Mona Lisa", "date": date.Format(time.RFC3339)}
+		return map[string]any{
+			"sha":      sha,
+			"html_url": "https://github.com/octocat/hello/commit/" + sha,
+			"author":   map[string]any{"login": "mona"},
+			"commit":   map[string]any{"message": message, "author": signature, "committer": signature},
+		}
+	}
+	commits := []map[string]any{
+		commit("bbb222", "Switch search to BM25\n\nThe old ranking ignored length.", newer),
+		commit("aaa111", "Initial commit", older),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/octocat/hello/commits", func(w http.ResponseWriter, r *http.Request) {
+		if listedSince != nil {
+			*listedSince = append(*listedSince, r.URL.Query().Get("since"))
+		}
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+	mux.HandleFunc("/repos/octocat/hello/commits/", func(w http.ResponseWriter, r *http.Request) {
+		sha := strings.TrimPrefix(r.URL.Path, "/repos/octocat/hello/commits/")
+		for _, c := range commits {
+			if c["sha"] != sha {
+				continue
+			}
+			detail := map[string]any{}
+			for k, v := range c {
+				detail[k] = v
+			}
+			detail["files"] = []any{
+				map[string]any{
+					"filename": "search.go", "status": "modified", "additions": 2, "deletions": 1,
+					"patch": "@@ -1 +1,2 @@\n-tfidf\n+bm25\n+k1",
+				},
+				map[string]any{
+					"filename": "big.txt", "status": "added", "additions": 1,
+					"patch": strings.Repeat("+", maxCommitDiffSize),
+				},
+			}
+			_ = json.NewEncoder(w).Encode(detail)
+			return
+		}
+		http.NotFound(w, r)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.gh = gh.NewClient(nil)
+	client.gh.BaseURL = baseURL
+	// No proactive throttling against the fake server
+	client.rateLimiter.bucket = rate.NewLimiter(rate.Inf, 1)
+	return client
+}
+
+// commitTestRepo returns the repository served by newCommitTestClient.
+func commitTestRepo() *gh.Repository {
+	return &gh.Repository{
+		Name:          gh.Ptr("hello"),
+		Owner:         &gh.User{Login: gh.Ptr("octocat")},
+		DefaultBranch: gh.Ptr("main"),
+	}
+}
+
+func TestFetchCommits(t *testing.T) {
+	t.Run("first sync bounds history and builds documents", func(t *testing.T) {
+		var listedSince []string
+		client := newCommitTestClient(t, &listedSince)
+		cfg := &Config{CommitHistoryDays: 30}
+
+		docs, latest, err := FetchCommits(context.Background(), client, commitTestRepo(), time.Time{}, cfg)
+
+		require.NoError(t, err)
+		require.Len(t, docs, 2)
+		assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), latest)
+		require.Len(t, listedSince, 1)
+		bound, err := time.Parse(time.RFC3339, listedSince[0])
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, -30), bound, time.Minute)
+
+		doc := docs[0]
+		assert.Equal(t, "github://octocat/hello/commit/bbb222", doc.URI)
+		assert.Equal(t, MIMETypeGitHubCommit, doc.MIMEType)
+		assert.Equal(t, "commit", doc.Metadata["type"])
+		assert.Equal(t, "Switch search to BM25", doc.Metadata["title"])
+		assert.Equal(t, "mona", doc.Metadata["author_login"])
+
+		var content CommitContent
+		require.NoError(t, json.Unmarshal(doc.Content, &content))
+		assert.Equal(t, "Mona Lisa", content.Author)
+		assert.Contains(t, content.Message, "ignored length")
+		assert.Empty(t, content.Files)
+	})
+
+	t.Run("incremental sync skips commits not after since", func(t *testing.T) {
+		var listedSince []string
+		client := newCommitTestClient(t, &listedSince)
+		since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		docs, _, err := FetchCommits(context.Background(), client, commitTestRepo(), since, &Config{})
+
+		require.NoError(t, err)
+		require.Len(t, docs, 1)
+		assert.Equal(t, "github://octocat/hello/commit/bbb222", docs[0].URI)
+		assert.Equal(t, []string{"2024-01-01T00:00:00Z"}, listedSince)
+	})
+
+	t.Run("includes diffs up to the size limit", func(t *testing.T) {
+		client := newCommitTestClient(t, nil)
+		cfg := &Config{IncludeCommitDiffs: true}
+
+		docs, _, err := FetchCommits(context.Background(), client, commitTestRepo(), time.Time{}, cfg)
+
+		require.NoError(t, err)
+		require.Len(t, docs, 2)
+		var content CommitContent
+		require.NoError(t, json.Unmarshal(docs[0].Content, &content))
+		require.Len(t, content.Files, 2)
+		assert.Contains(t, content.Files[0].Patch, "+bm25")
+		assert.Empty(t, content.Files[1].Patch)
+		assert.True(t, content.Truncated)
+	})
+}
+
+func TestCommitSubject(t *testing.T) {
+	assert.Equal(t, "Fix bug", commitSubject("Fix bug\n\nDetails here"))
+	assert.Equal(t, "Single line", commitSubject("Single line"))
+}
+
+func TestBuildCommitURI(t *testing.T) {
+	assert.Equal(t, "github://octocat/hello/commit/abc123", buildCommitURI("octocat", "hello", "abc123"))
+}
@@ -1,8 +1,10 @@
 package github
 
 import (
+	"strconv"
 	"strings"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/gitignore"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
@@ -10,13 +12,25 @@ import (
 type ContentType string
 
 const (
-	ContentFiles  ContentType = "files"
-	ContentIssues ContentType = "issues"
-	ContentPRs    ContentType = "prs"
-	ContentWikis  ContentType = "wikis"
+	ContentFiles   ContentType = "files"
+	ContentIssues  ContentType = "issues"
+	ContentPRs     ContentType = "prs"
+	ContentWikis   ContentType = "wikis"
+	ContentGists   ContentType = "gists"
+	ContentCommits ContentType = "commits"
 )
 
-// AllContentTypes returns all supported content types.
+// States of issues and pull requests to index, as accepted by the issue_state
+// and pr_state config keys and the GitHub API's state parameter.
+const (
+	StateOpen   = "open"
+	StateClosed = "closed"
+	StateAll    = "all"
+)
+
+// AllContentTypes returns the repository content types indexed by default.
+// Gists belong to the account rather than a repository and are opt-in.
+// Commits are opt-in because long histories are expensive to fetch.
 func AllContentTypes() []ContentType {
 	return []ContentType{ContentFiles, ContentIssues, ContentPRs, ContentWikis}
 }
@@ -27,17 +41,67 @@ type Config struct {
 	// Default: all types (files, issues, prs, wikis)
 	ContentTypes []ContentType
 
-	// FilePatterns are glob patterns for file filtering.
+	// FilePatterns are the .gitignore-style patterns for file filtering, as configured.
 	// Default: all files
 	FilePatterns []string
+
+	// PatternSet is the parsed form of FilePatterns.
+	PatternSet *gitignore.PatternSet
+
+	// IncludeStarredGists also indexes starred and forked gists.
+	// Only used when gists are enabled. Default: false
+	IncludeStarredGists bool
+
+	// IssueState selects the issues indexed by state: open, closed or all.
+	// Default: StateAll
+	IssueState string
+
+	// PRState selects the pull requests indexed by state: open, closed or all.
+	// Merged pull requests are closed. Default: StateAll
+	PRState string
+
+	// IncludeAttachments also indexes text attachments and gists linked from
+	// issues and pull requests, as child documents of the thread. Default: false
+	IncludeAttachments bool
+
+	// MaxAttachmentSize is the largest attachment or linked gist indexed, in bytes.
+	// Default: DefaultMaxAttachmentSize (1MB)
+	MaxAttachmentSize int64
+
+	// CommitHistoryDays bounds how far back the first sync indexes commits.
+	// Only used when commits are enabled. Default: DefaultCommitHistoryDays (365)
+	CommitHistoryDays int
+
+	// IncludeCommitDiffs also indexes the patch of each commit, up to 64KB.
+	// Costs one extra API request per commit. Default: false
+	IncludeCommitDiffs bool
+
+	// MetadataOnly indexes repository files by name, path and metadata
+	// without fetching their contents. Other content types are unaffected.
+	// Default: false
+	MetadataOnly bool
+
+	// SupportsContentType reports whether a file's MIME type can be
+	// normalised. Files of other types are emitted without fetching their
+	// contents. Set by the sync; nil fetches every file.
+	SupportsContentType func(mimeType string) bool
+}
+
+// fetchesContent reports whether the contents of a file of mimeType are fetched.
+func (c *Config) fetchesContent(mimeType string) bool {
+	return c.SupportsContentType == nil || c.SupportsContentType(mimeType)
 }
 
 // ParseConfig parses a source's config map into a Config struct.
 // All fields are optional - by default indexes all accessible repos with all content types.
 func ParseConfig(source domain.Source) (*Config, error) {
 	cfg := &Config{
-		ContentTypes: AllContentTypes(), // Default to all content types
-		FilePatterns: []string{},        // Empty = all files
+		ContentTypes:      AllContentTypes(), // Default to all content types
+		FilePatterns:      []string{},        // Empty = all files
+		IssueState:        StateAll,
+		PRState:           StateAll,
+		MaxAttachmentSize: DefaultMaxAttachmentSize,
+		CommitHistoryDays: DefaultCommitHistoryDays,
 	}
 
 	// Parse content_types (optional)
@@ -53,6 +117,57 @@ func ParseConfig(source domain.Source) (*Config, error) {
 	if patterns, ok := source.Config["file_patterns"]; ok && patterns != "" {
 		cfg.FilePatterns = parsePatterns(patterns)
 	}
+	cfg.PatternSet = gitignore.Parse(cfg.FilePatterns)
+
+	// Parse issue_state and pr_state (optional)
+	if val, ok := source.Config["issue_state"]; ok && val != "" {
+		state, err := parseState(val)
+		if err != nil {
+			return nil, err
+		}
+		cfg.IssueState = state
+	}
+	if val, ok := source.Config["pr_state"]; ok && val != "" {
+		state, err := parseState(val)
+		if err != nil {
+			return nil, err
+		}
+		cfg.PRState = state
+	}
+
+	// Parse include_starred_gists (optional)
+	if val, ok := source.Config["include_starred_gists"]; ok {
+		cfg.IncludeStarredGists = val == "true" || val == "1"
+	}
+
+	// Parse include_attachments (optional)
+	if val, ok := source.Config["include_attachments"]; ok {
+		cfg.IncludeAttachments = val == "true" || val == "1"
+	}
+
+	// Parse max_attachment_size (optional)
+	if val, ok := source.Config["max_attachment_size"]; ok && val != "" {
+		if size, err := strconv.ParseInt(val, 10, 64); err == nil && size > 0 {
+			cfg.MaxAttachmentSize = size
+		}
+	}
+
+	// Parse commit_history_days (optional)
+	if val, ok := source.Config["commit_history_days"]; ok && val != "" {
+		if days, err := strconv.Atoi(val); err == nil && days > 0 {
+			cfg.CommitHistoryDays = days
+		}
+	}
+
+	// Parse include_commit_diffs (optional)
+	if val, ok := source.Config["include_commit_diffs"]; ok {
+		cfg.IncludeCommitDiffs = val == "true" || val == "1"
+	}
+
+	// Parse metadata_only (optional)
+	if val, ok := source.Config["metadata_only"]; ok {
+		cfg.MetadataOnly = val == "true" || val == "1"
+	}
 
 	return cfg, nil
 }
@@ -62,10 +177,12 @@ func parseContentTypes(s string) ([]ContentType, error) {
 	parts := strings.Split(s, ",")
 	types := make([]ContentType, 0, len(parts))
 	valid := map[string]ContentType{
-		"files":  ContentFiles,
-		"issues": ContentIssues,
-		"prs":    ContentPRs,
-		"wikis":  ContentWikis,
+		"files":   ContentFiles,
+		"issues":  ContentIssues,
+		"prs":     ContentPRs,
+		"wikis":   ContentWikis,
+		"gists":   ContentGists,
+		"commits": ContentCommits,
 	}
 
 	for _, part := range parts {
@@ -86,6 +203,31 @@ func parseContentTypes(s string) ([]ContentType, error) {
 	return types, nil
 }
 
+// parseState parses an issue or pull request state filter.
+func parseState(s string) (string, error) {
+	switch state := strings.TrimSpace(strings.ToLower(s)); state {
+	case StateOpen, StateClosed, StateAll:
+		return state, nil
+	default:
+		return "", ErrConfigInvalidState
+	}
+}
+
+// stateParam returns the API state parameter listing the items a state
+// filter selects. An unset filter selects every state.
+func stateParam(filter string) string {
+	if filter == "" {
+		return StateAll
+	}
+	return filter
+}
+
+// matchesState reports whether an issue or pull request in state is indexed
+// under the filter.
+func matchesState(filter, state string) bool {
+	return filter == "" || filter == StateAll || filter == state
+}
+
 // parsePatterns parses a comma-separated glob patterns string.
 func parsePatterns(s string) []string {
 	parts := strings.Split(s, ",")
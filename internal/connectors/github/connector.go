@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -33,6 +34,22 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetTransport sets the HTTP transport used for GitHub API requests,
+// such as a per-source HTTP cache.
+func (c *Connector) SetTransport(rt http.RoundTripper) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.client.SetTransport(rt)
+}
+
+// SetContentTypeFilter sets the check for whether a file's MIME type can be
+// normalised. Files that cannot are emitted without fetching their blobs.
+func (c *Connector) SetContentTypeFilter(supported func(mimeType string) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.config.SupportsContentType = supported
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "github"
@@ -47,7 +64,7 @@ func (c *Connector) SourceID() string {
 func (c *Connector) Capabilities() driven.ConnectorCapabilities {
 	return driven.ConnectorCapabilities{
 		SupportsIncremental:  true,
-		SupportsWatch:        false, // No webhooks in CLI
+		SupportsWatch:        false, // Webhooks arrive through sercha serve instead
 		SupportsHierarchy:    true,  // Files have directories
 		SupportsBinary:       false, // Text only
 		RequiresAuth:         true,
@@ -77,6 +94,9 @@ func (c *Connector) Validate(ctx context.Context) error {
 
 	// Validate credentials by making an API call
 	if err := c.client.ValidateCredentials(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		if IsUnauthorized(err) {
 			return domain.ErrAuthInvalid
 		}
@@ -147,9 +167,10 @@ func (c *Connector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-
 
 			// Fetch issues if enabled.
 			if c.config.HasContentType(ContentIssues) {
-				docs, latestUpdate, err := FetchIssues(ctx, c.client, repo, time.Time{})
+				docs, _, latestUpdate, err := FetchIssues(ctx, c.client, repo, time.Time{}, c.config, false)
 				if err == nil || IsNotFound(err) {
 					repoCursor.IssuesSince = latestUpdate
+					docs = c.withAttachments(ctx, docs)
 					for _, doc := range docs {
 						doc.SourceID = c.sourceID
 						select {
@@ -163,9 +184,10 @@ func (c *Connector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-
 
 			// Fetch PRs if enabled.
 			if c.config.HasContentType(ContentPRs) {
-				docs, latestUpdate, err := FetchPullRequests(ctx, c.client, repo, time.Time{})
+				docs, _, latestUpdate, err := FetchPullRequests(ctx, c.client, repo, time.Time{}, c.config, false)
 				if err == nil || IsNotFound(err) {
 					repoCursor.PRsSince = latestUpdate
+					docs = c.withAttachments(ctx, docs)
 					for _, doc := range docs {
 						doc.SourceID = c.sourceID
 						select {
@@ -179,7 +201,7 @@ func (c *Connector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-
 
 			// Fetch wiki if enabled.
 			if c.config.HasContentType(ContentWikis) {
-				docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo)
+				docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo, "")
 				if err == nil {
 					repoCursor.WikiCommitSHA = wikiSHA
 					for _, doc := range docs {
@@ -193,13 +215,47 @@ func (c *Connector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-
 				}
 			}
 
+			// Fetch commits if enabled.
+			if c.config.HasContentType(ContentCommits) {
+				docs, latestCommit, err := FetchCommits(ctx, c.client, repo, time.Time{}, c.config)
+				if err == nil || IsNotFound(err) {
+					repoCursor.CommitsSince = latestCommit
+					for _, doc := range docs {
+						doc.SourceID = c.sourceID
+						select {
+						case <-ctx.Done():
+							return
+						case docsChan <- doc:
+						}
+					}
+				}
+			}
+
 			// Save repo cursor.
 			cursor.SetRepoCursor(owner, name, &repoCursor)
 		}
 
-		// Send completion with cursor
+		// Fetch gists if enabled (account-level, not per repository).
+		// GitHub App installations have no account gists.
+		if c.config.HasContentType(ContentGists) && !c.client.isAppInstallation() {
+			docs, latestUpdate, err := FetchGists(ctx, c.client, time.Time{}, c.config.IncludeStarredGists)
+			if err == nil {
+				cursor.GistsSince = latestUpdate
+				for _, doc := range docs {
+					doc.SourceID = c.sourceID
+					select {
+					case <-ctx.Done():
+						return
+					case docsChan <- doc:
+					}
+				}
+			}
+		}
+
+		// Send completion with one sub-cursor per repository
 		errsChan <- &driven.SyncComplete{
-			NewCursor: cursor.Encode(),
+			NewCursor:     cursor.EncodeHeader(),
+			NewSubCursors: cursor.SubCursors(),
 		}
 	}()
 
@@ -207,6 +263,10 @@ func (c *Connector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-
 }
 
 // IncrementalSync fetches only changes since the last sync.
+// A non-zero state.Since replaces the stored times for issues, pull requests,
+// commits and gists. Files and wikis are keyed on SHAs and ignore it.
+// A non-empty state.Scope limits the sync to the named owner/repo
+// repositories and skips gists.
 func (c *Connector) IncrementalSync(
 	ctx context.Context, state domain.SyncState,
 ) (<-chan domain.RawDocumentChange, <-chan error) {
@@ -225,8 +285,8 @@ func (c *Connector) IncrementalSync(
 		}
 		c.mu.Unlock()
 
-		// Decode cursor
-		cursor, err := DecodeCursor(state.Cursor)
+		// Decode cursor (migrates version 1 cursor blobs)
+		cursor, err := CursorFromState(state)
 		if err != nil {
 			errsChan <- fmt.Errorf("decode cursor: %w", err)
 			return
@@ -241,6 +301,10 @@ func (c *Connector) IncrementalSync(
 
 		repos = FilterRepos(repos, false, false)
 
+		// A scoped sync, such as one started by a webhook, only visits the
+		// named repositories
+		repos = ScopeRepos(repos, state.Scope)
+
 		// Sync each repository.
 		for _, repo := range repos {
 			select {
@@ -278,13 +342,29 @@ func (c *Connector) IncrementalSync(
 				}
 			}
 
-			// Fetch updated issues if enabled.
+			// Fetch updated issues if enabled. Issues that left the selected
+			// state are removed. New comments are appended to the indexed
+			// documents unless the start time was overridden, as the indexed
+			// documents may already hold comments after it.
+			appendComments := state.Since.IsZero()
 			if c.config.HasContentType(ContentIssues) {
-				docs, latestUpdate, err := FetchIssues(ctx, c.client, repo, repoCursor.IssuesSince)
+				docs, removed, latestUpdate, err := FetchIssues(ctx, c.client, repo,
+					sinceOverride(repoCursor.IssuesSince, state.Since), c.config, appendComments)
 				if err == nil {
-					if !latestUpdate.IsZero() {
+					if latestUpdate.After(repoCursor.IssuesSince) {
 						repoCursor.IssuesSince = latestUpdate
 					}
+					for _, uri := range removed {
+						select {
+						case <-ctx.Done():
+							return
+						case changesChan <- domain.RawDocumentChange{
+							Type:     domain.ChangeDeleted,
+							Document: domain.RawDocument{SourceID: c.sourceID, URI: uri},
+						}:
+						}
+					}
+					docs = c.withAttachments(ctx, docs)
 					for _, doc := range docs {
 						doc.SourceID = c.sourceID
 						select {
@@ -299,13 +379,25 @@ func (c *Connector) IncrementalSync(
 				}
 			}
 
-			// Fetch updated PRs if enabled.
+			// Fetch updated PRs if enabled, removing those that left the selected state.
 			if c.config.HasContentType(ContentPRs) {
-				docs, latestUpdate, err := FetchPullRequests(ctx, c.client, repo, repoCursor.PRsSince)
+				docs, removed, latestUpdate, err := FetchPullRequests(ctx, c.client, repo,
+					sinceOverride(repoCursor.PRsSince, state.Since), c.config, appendComments)
 				if err == nil {
-					if !latestUpdate.IsZero() {
+					if latestUpdate.After(repoCursor.PRsSince) {
 						repoCursor.PRsSince = latestUpdate
 					}
+					for _, uri := range removed {
+						select {
+						case <-ctx.Done():
+							return
+						case changesChan <- domain.RawDocumentChange{
+							Type:     domain.ChangeDeleted,
+							Document: domain.RawDocument{SourceID: c.sourceID, URI: uri},
+						}:
+						}
+					}
+					docs = c.withAttachments(ctx, docs)
 					for _, doc := range docs {
 						doc.SourceID = c.sourceID
 						select {
@@ -322,8 +414,9 @@ func (c *Connector) IncrementalSync(
 
 			// Fetch updated wiki if enabled.
 			if c.config.HasContentType(ContentWikis) {
-				docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo)
-				if err == nil && wikiSHA != repoCursor.WikiCommitSHA {
+				// Unchanged wikis cost one request and return no pages
+				docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo, repoCursor.WikiCommitSHA)
+				if err == nil {
 					repoCursor.WikiCommitSHA = wikiSHA
 					for _, doc := range docs {
 						doc.SourceID = c.sourceID
@@ -339,20 +432,91 @@ func (c *Connector) IncrementalSync(
 				}
 			}
 
+			// Fetch new commits if enabled.
+			if c.config.HasContentType(ContentCommits) {
+				since := sinceOverride(repoCursor.CommitsSince, state.Since)
+				docs, latestCommit, err := FetchCommits(ctx, c.client, repo, since, c.config)
+				if err == nil {
+					if latestCommit.After(repoCursor.CommitsSince) {
+						repoCursor.CommitsSince = latestCommit
+					}
+					for _, doc := range docs {
+						doc.SourceID = c.sourceID
+						select {
+						case <-ctx.Done():
+							return
+						case changesChan <- domain.RawDocumentChange{
+							Type:     domain.ChangeUpdated,
+							Document: doc,
+						}:
+						}
+					}
+				}
+			}
+
 			// Update repo cursor.
 			cursor.SetRepoCursor(owner, name, &repoCursor)
 		}
 
-		// Send completion with updated cursor
+		// Fetch updated gists if enabled. Gists belong to the account, so
+		// scoped syncs and GitHub App installations leave them alone.
+		if c.config.HasContentType(ContentGists) && len(state.Scope) == 0 && !c.client.isAppInstallation() {
+			since := sinceOverride(cursor.GistsSince, state.Since)
+			docs, latestUpdate, err := FetchGists(ctx, c.client, since, c.config.IncludeStarredGists)
+			if err == nil {
+				if latestUpdate.After(cursor.GistsSince) {
+					cursor.GistsSince = latestUpdate
+				}
+				for _, doc := range docs {
+					doc.SourceID = c.sourceID
+					select {
+					case <-ctx.Done():
+						return
+					case changesChan <- domain.RawDocumentChange{
+						Type:     domain.ChangeUpdated,
+						Document: doc,
+					}:
+					}
+				}
+			}
+		}
+
+		// Send completion with updated sub-cursors
 		errsChan <- &driven.SyncComplete{
-			NewCursor: cursor.Encode(),
+			NewCursor:     cursor.EncodeHeader(),
+			NewSubCursors: cursor.SubCursors(),
 		}
 	}()
 
 	return changesChan, errsChan
 }
 
-// Watch is not supported for GitHub (no webhooks in CLI).
+// sinceOverride returns override when set, otherwise the stored time.
+func sinceOverride(stored, override time.Time) time.Time {
+	if override.IsZero() {
+		return stored
+	}
+	return override
+}
+
+// withAttachments returns the issue or pull request documents with the
+// documents for their attachments and linked gists after each one, when
+// attachments are enabled.
+func (c *Connector) withAttachments(ctx context.Context, docs []domain.RawDocument) []domain.RawDocument {
+	if !c.config.IncludeAttachments {
+		return docs
+	}
+
+	withChildren := make([]domain.RawDocument, 0, len(docs))
+	for _, doc := range docs {
+		withChildren = append(withChildren, doc)
+		withChildren = append(withChildren, FetchAttachments(ctx, c.client, doc, c.config.MaxAttachmentSize)...)
+	}
+	return withChildren
+}
+
+// Watch is not supported for GitHub. Webhooks are received by sercha serve,
+// which starts a scoped incremental sync instead.
 func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
 	return nil, domain.ErrNotImplemented
 }
@@ -3,8 +3,11 @@ package github
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
@@ -12,6 +15,7 @@ import (
 	gh "github.com/google/go-github/v80/github"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
@@ -39,6 +43,62 @@ func (p *mockTokenProvider) IsAuthenticated() bool {
 	return p.token != ""
 }
 
+// appTokenProvider hands out a new installation token on every call, as a
+// GitHub App provider does when its cached token expires.
+type appTokenProvider struct {
+	calls int
+}
+
+func (p *appTokenProvider) GetToken(_ context.Context) (string, error) {
+	p.calls++
+	return fmt.Sprintf("ghs_%d", p.calls), nil
+}
+
+func (p *appTokenProvider) AuthorizationID() string {
+	return "test-app"
+}
+
+func (p *appTokenProvider) AuthMethod() domain.AuthMethod {
+	return domain.AuthMethodGitHubApp
+}
+
+func (p *appTokenProvider) IsAuthenticated() bool {
+	return true
+}
+
+func TestClient_AppInstallation(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		if r.URL.Path != "/installation/repositories" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"total_count":  1,
+			"repositories": []map[string]any{{"full_name": "octo/repo", "name": "repo"}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	target, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	client := NewClient(&appTokenProvider{})
+	client.SetTransport(redirectTransport{target: target})
+	require.NoError(t, client.ensureClient(context.Background()))
+	client.gh.BaseURL = target
+	client.rateLimiter.bucket = rate.NewLimiter(rate.Inf, 1)
+
+	require.NoError(t, client.ValidateCredentials(context.Background()))
+	repos, err := client.ListAllAccessibleRepos(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, repos, 1)
+	assert.Equal(t, "octo/repo", repos[0].GetFullName())
+	// Each request asks the provider for its current token
+	assert.Equal(t, []string{"Bearer ghs_2", "Bearer ghs_3"}, tokens)
+}
+
 func TestNew(t *testing.T) {
 	t.Run("creates connector with valid parameters", func(t *testing.T) {
 		cfg := &Config{
@@ -151,6 +211,7 @@ func TestParseConfig(t *testing.T) {
 		assert.Contains(t, cfg.ContentTypes, ContentPRs)
 		assert.Contains(t, cfg.FilePatterns, "*.go")
 		assert.Contains(t, cfg.FilePatterns, "*.md")
+		assert.False(t, cfg.PatternSet.Empty())
 	})
 
 	t.Run("parses minimal config with defaults", func(t *testing.T) {
@@ -199,6 +260,144 @@ func TestParseConfig(t *testing.T) {
 		assert.Contains(t, cfg.ContentTypes, ContentWikis)
 	})
 
+	t.Run("parses gists with starred gists", func(t *testing.T) {
+		source := domain.Source{
+			ID:   "test-source",
+			Type: "github",
+			Config: map[string]string{
+				"content_types":         "gists",
+				"include_starred_gists": "true",
+			},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.Equal(t, []ContentType{ContentGists}, cfg.ContentTypes)
+		assert.True(t, cfg.IncludeStarredGists)
+	})
+
+	t.Run("gists are opt-in", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{ID: "test-source", Type: "github"})
+
+		require.NoError(t, err)
+		assert.False(t, cfg.HasContentType(ContentGists))
+		assert.False(t, cfg.IncludeStarredGists)
+	})
+
+	t.Run("parses issue and PR states", func(t *testing.T) {
+		source := domain.Source{
+			ID:   "test-source",
+			Type: "github",
+			Config: map[string]string{
+				"issue_state": "open",
+				"pr_state":    " Closed ",
+			},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.Equal(t, StateOpen, cfg.IssueState)
+		assert.Equal(t, StateClosed, cfg.PRState)
+	})
+
+	t.Run("indexes all states by default", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{ID: "test-source", Type: "github"})
+
+		require.NoError(t, err)
+		assert.Equal(t, StateAll, cfg.IssueState)
+		assert.Equal(t, StateAll, cfg.PRState)
+	})
+
+	t.Run("rejects invalid state", func(t *testing.T) {
+		source := domain.Source{
+			ID:     "test-source",
+			Type:   "github",
+			Config: map[string]string{"pr_state": "merged"},
+		}
+
+		_, err := ParseConfig(source)
+
+		assert.ErrorIs(t, err, ErrConfigInvalidState)
+	})
+
+	t.Run("parses attachment options", func(t *testing.T) {
+		source := domain.Source{
+			ID:   "test-source",
+			Type: "github",
+			Config: map[string]string{
+				"include_attachments": "true",
+				"max_attachment_size": "2048",
+			},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.True(t, cfg.IncludeAttachments)
+		assert.Equal(t, int64(2048), cfg.MaxAttachmentSize)
+	})
+
+	t.Run("attachments are opt-in with a 1MB limit", func(t *testing.T) {
+		source := domain.Source{
+			ID:     "test-source",
+			Type:   "github",
+			Config: map[string]string{"max_attachment_size": "-1"},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.False(t, cfg.IncludeAttachments)
+		assert.Equal(t, int64(DefaultMaxAttachmentSize), cfg.MaxAttachmentSize)
+	})
+
+	t.Run("parses commit options", func(t *testing.T) {
+		source := domain.Source{
+			ID:   "test-source",
+			Type: "github",
+			Config: map[string]string{
+				"content_types":        "commits",
+				"commit_history_days":  "30",
+				"include_commit_diffs": "true",
+			},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.Equal(t, []ContentType{ContentCommits}, cfg.ContentTypes)
+		assert.Equal(t, 30, cfg.CommitHistoryDays)
+		assert.True(t, cfg.IncludeCommitDiffs)
+	})
+
+	t.Run("parses metadata_only", func(t *testing.T) {
+		source := domain.Source{
+			Config: map[string]string{"metadata_only": "1"},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.True(t, cfg.MetadataOnly)
+	})
+
+	t.Run("commits are opt-in with a year of history", func(t *testing.T) {
+		source := domain.Source{
+			ID:     "test-source",
+			Type:   "github",
+			Config: map[string]string{"commit_history_days": "0"},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.False(t, cfg.HasContentType(ContentCommits))
+		assert.False(t, cfg.IncludeCommitDiffs)
+		assert.Equal(t, DefaultCommitHistoryDays, cfg.CommitHistoryDays)
+	})
+
 	t.Run("returns error for invalid content types", func(t *testing.T) {
 		source := domain.Source{
 			ID:   "test-source",
@@ -216,6 +415,28 @@ func TestParseConfig(t *testing.T) {
 	})
 }
 
+func TestScopeRepos(t *testing.T) {
+	repo := func(owner, name string) *gh.Repository {
+		return &gh.Repository{Name: gh.Ptr(name), Owner: &gh.User{Login: gh.Ptr(owner)}}
+	}
+	repos := []*gh.Repository{repo("octocat", "hello"), repo("octocat", "world"), repo("acme", "hello")}
+
+	t.Run("empty scope keeps every repository", func(t *testing.T) {
+		assert.Len(t, ScopeRepos(repos, nil), 3)
+	})
+
+	t.Run("keeps named repositories ignoring case", func(t *testing.T) {
+		scoped := ScopeRepos(repos, []string{"Octocat/Hello"})
+		require.Len(t, scoped, 1)
+		assert.Equal(t, "octocat", scoped[0].GetOwner().GetLogin())
+		assert.Equal(t, "hello", scoped[0].GetName())
+	})
+
+	t.Run("unknown repository keeps none", func(t *testing.T) {
+		assert.Empty(t, ScopeRepos(repos, []string{"octocat/missing"}))
+	})
+}
+
 func TestCursor(t *testing.T) {
 	t.Run("encodes and decodes cursor", func(t *testing.T) {
 		original := &Cursor{
@@ -285,26 +506,97 @@ func TestCursor(t *testing.T) {
 	})
 }
 
-func TestMatchesPatterns(t *testing.T) {
-	t.Run("matches with empty patterns", func(t *testing.T) {
-		assert.True(t, matchesPatterns("any/path.go", nil))
-		assert.True(t, matchesPatterns("any/path.go", []string{}))
-		assert.True(t, matchesPatterns("any/path.go", []string{"*"}))
+func TestCursorFromState(t *testing.T) {
+	t.Run("migrates version 1 cursor blob", func(t *testing.T) {
+		legacy := &Cursor{
+			Version: 1,
+			Repos: map[string]RepoCursor{
+				"myorg/myrepo": {FilesTreeSHA: "abc123"},
+			},
+		}
+
+		cursor, err := CursorFromState(domain.SyncState{Cursor: legacy.Encode()})
+
+		require.NoError(t, err)
+		assert.Equal(t, CursorVersion, cursor.Version)
+		assert.Equal(t, "abc123", cursor.GetRepoCursor("myorg", "myrepo").FilesTreeSHA)
+
+		// Written back as a header plus one sub-cursor per repository
+		header, err := DecodeCursor(cursor.EncodeHeader())
+		require.NoError(t, err)
+		assert.Equal(t, CursorVersion, header.Version)
+		assert.Empty(t, header.Repos)
+		assert.Contains(t, cursor.SubCursors(), "myorg/myrepo")
+	})
+
+	t.Run("reads sub-cursors", func(t *testing.T) {
+		original := NewCursor()
+		original.UpdateFilesTreeSHA("myorg", "a", "sha-a")
+		original.UpdateIssuesSince("myorg", "b", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		cursor, err := CursorFromState(domain.SyncState{
+			Cursor:     original.EncodeHeader(),
+			SubCursors: original.SubCursors(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, original.Repos, cursor.Repos)
 	})
 
-	t.Run("matches extension patterns", func(t *testing.T) {
-		patterns := []string{"*.go", "*.md"}
+	t.Run("sub-cursors override legacy blob", func(t *testing.T) {
+		legacy := &Cursor{Version: 1, Repos: map[string]RepoCursor{"myorg/myrepo": {FilesTreeSHA: "old"}}}
+		current := NewCursor()
+		current.UpdateFilesTreeSHA("myorg", "myrepo", "new")
+
+		cursor, err := CursorFromState(domain.SyncState{
+			Cursor:     legacy.Encode(),
+			SubCursors: current.SubCursors(),
+		})
 
-		assert.True(t, matchesPatterns("cmd/main.go", patterns))
-		assert.True(t, matchesPatterns("README.md", patterns))
-		assert.False(t, matchesPatterns("package.json", patterns))
+		require.NoError(t, err)
+		assert.Equal(t, "new", cursor.GetRepoCursor("myorg", "myrepo").FilesTreeSHA)
 	})
 
-	t.Run("matches against full path", func(t *testing.T) {
-		patterns := []string{"cmd/*"}
+	t.Run("rejects invalid sub-cursor", func(t *testing.T) {
+		cursor, err := CursorFromState(domain.SyncState{
+			SubCursors: map[string]string{"myorg/myrepo": "not json"},
+		})
 
-		assert.True(t, matchesPatterns("cmd/main.go", patterns))
-		assert.False(t, matchesPatterns("internal/main.go", patterns))
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+		assert.Nil(t, cursor)
+	})
+}
+
+func TestShouldIndexFile(t *testing.T) {
+	t.Run("indexes all text files without patterns", func(t *testing.T) {
+		assert.True(t, shouldIndexFile("any/path.go", &Config{}))
+		assert.False(t, shouldIndexFile("assets/logo.png", &Config{}))
+	})
+
+	t.Run("applies parsed file patterns", func(t *testing.T) {
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{
+			"file_patterns": "*.log,vendor/",
+		}})
+		require.NoError(t, err)
+
+		assert.True(t, shouldIndexFile("cmd/main.go", cfg))
+		assert.False(t, shouldIndexFile("logs/app.log", cfg))
+		assert.False(t, shouldIndexFile("vendor/lib/lib.go", cfg))
+	})
+
+	t.Run("leaves binary files to the content type check", func(t *testing.T) {
+		supported := func(mimeType string) bool { return mimeType == "application/pdf" }
+		cfg, err := ParseConfig(domain.Source{Config: map[string]string{"file_patterns": "*.log"}})
+		require.NoError(t, err)
+		cfg.SupportsContentType = supported
+
+		assert.True(t, shouldIndexFile("docs/spec.pdf", cfg))
+		assert.True(t, shouldIndexFile("firmware.bin", cfg))
+		assert.False(t, shouldIndexFile("logs/app.log", cfg))
+
+		// Metadata-only sources never fetch content, so binary files stay skipped
+		cfg.MetadataOnly = true
+		assert.False(t, shouldIndexFile("firmware.bin", cfg))
 	})
 }
 
@@ -347,6 +639,10 @@ func TestDetectFileMIMEType(t *testing.T) {
 		assert.Equal(t, "text/plain", detectFileMIMEType("file.unknown"))
 		assert.Equal(t, "text/plain", detectFileMIMEType("Makefile"))
 	})
+
+	t.Run("returns octet-stream for unknown binary extensions", func(t *testing.T) {
+		assert.Equal(t, "application/octet-stream", detectFileMIMEType("cache.dat"))
+	})
 }
 
 func TestBuildFileURI(t *testing.T) {
@@ -392,6 +688,19 @@ func TestRateLimiter(t *testing.T) {
 		assert.Equal(t, 5000, rl.Limit())
 	})
 
+	t.Run("scales throttle with installation limits", func(t *testing.T) {
+		rl := NewRateLimiter()
+
+		rl.UpdateFromResponse(&http.Response{Header: http.Header{"X-Ratelimit-Limit": []string{"15000"}}})
+
+		assert.Equal(t, 15000, rl.Limit())
+		assert.InDelta(t, 3*ProactiveRate, float64(rl.bucket.Limit()), 0.001)
+
+		rl.UpdateFromResponse(&http.Response{Header: http.Header{"X-Ratelimit-Limit": []string{"60"}}})
+
+		assert.InDelta(t, ProactiveRate, float64(rl.bucket.Limit()), 0.001)
+	})
+
 	t.Run("wait respects context cancellation", func(t *testing.T) {
 		rl := NewRateLimiter()
 		ctx, cancel := context.WithCancel(context.Background())
@@ -464,6 +773,17 @@ func TestConfig_HasContentType(t *testing.T) {
 	}
 }
 
+func TestConnector_SetContentTypeFilter(t *testing.T) {
+	cfg := &Config{}
+	c := New("src-1", cfg, nil)
+	assert.True(t, cfg.fetchesContent("application/octet-stream"), "fetches everything without a filter")
+
+	c.SetContentTypeFilter(func(mimeType string) bool { return mimeType == "text/plain" })
+
+	assert.True(t, cfg.fetchesContent("text/plain"))
+	assert.False(t, cfg.fetchesContent("application/octet-stream"))
+}
+
 // Tests for Client.GitHub
 func TestClient_GitHub(t *testing.T) {
 	t.Run("returns nil when client not initialized", func(t *testing.T) {
@@ -988,3 +1308,128 @@ func TestConnector_Watch(t *testing.T) {
 		assert.ErrorIs(t, err, domain.ErrNotImplemented)
 	})
 }
+
+// newSlowTestConnector returns a connector whose API server only responds
+// once the request is cancelled.
+func newSlowTestConnector(t *testing.T) *Connector {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+
+	connector := New("test-source", &Config{ContentTypes: AllContentTypes()}, &mockTokenProvider{token: "test-token"})
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	connector.client.gh = gh.NewClient(nil)
+	connector.client.gh.BaseURL = baseURL
+	return connector
+}
+
+// Tests for deadline handling through the HTTP client
+func TestConnector_Deadlines(t *testing.T) {
+	t.Run("validate returns deadline error rather than auth error", func(t *testing.T) {
+		connector := newSlowTestConnector(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := connector.Validate(ctx)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotErrorIs(t, err, domain.ErrAuthRequired)
+	})
+
+	t.Run("full sync stops and closes channels at deadline", func(t *testing.T) {
+		connector := newSlowTestConnector(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		docsChan, errsChan := connector.FullSync(ctx)
+
+		done := make(chan struct{})
+		var errs []error
+		go func() {
+			defer close(done)
+			for range docsChan {
+			}
+			for err := range errsChan {
+				errs = append(errs, err)
+			}
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("channels did not close after deadline")
+		}
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], context.DeadlineExceeded)
+	})
+}
+
+func TestSinceOverride(t *testing.T) {
+	stored := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	override := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, stored, sinceOverride(stored, time.Time{}))
+	assert.Equal(t, override, sinceOverride(stored, override))
+}
+
+func TestMatchesState(t *testing.T) {
+	assert.True(t, matchesState(StateAll, "closed"))
+	assert.True(t, matchesState("", "open"))
+	assert.True(t, matchesState(StateOpen, "open"))
+	assert.False(t, matchesState(StateOpen, "closed"))
+	assert.False(t, matchesState(StateClosed, "open"))
+}
+
+func TestStateParam(t *testing.T) {
+	assert.Equal(t, StateAll, stateParam(""))
+	assert.Equal(t, StateOpen, stateParam(StateOpen))
+}
+
+func TestAppendsComments(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	before := since.Add(-time.Hour)
+	after := since.Add(time.Hour)
+
+	assert.True(t, appendsComments(true, since, before))
+	assert.False(t, appendsComments(true, since, after), "new items are fetched in full")
+	assert.False(t, appendsComments(true, time.Time{}, before), "full syncs fetch everything")
+	assert.False(t, appendsComments(false, since, before))
+}
+
+// recordingTransport records requests before passing them on.
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestConnector_SetTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"login":"octocat"}`))
+	}))
+	defer server.Close()
+
+	rt := &recordingTransport{}
+	connector := New("test-source", &Config{}, &mockTokenProvider{token: "test-token"})
+	connector.SetTransport(rt)
+
+	require.NoError(t, connector.client.ensureClient(context.Background()))
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	connector.client.gh.BaseURL = baseURL
+
+	_, _, err = connector.client.gh.Users.Get(context.Background(), "")
+	require.NoError(t, err)
+
+	// Requests go through the transport with the token already applied
+	require.Len(t, rt.requests, 1)
+	assert.Equal(t, "Bearer test-token", rt.requests[0].Header.Get("Authorization"))
+}
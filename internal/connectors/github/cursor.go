@@ -3,11 +3,21 @@ package github
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 // CursorVersion is the current cursor schema version.
-const CursorVersion = 1
+// Version 1 encoded every repository in the cursor blob; version 2 stores
+// each repository as a sync state sub-cursor keyed by owner/repo.
+const CursorVersion = 2
+
+// Version is the schema version of the connector's sync state. Bump it when
+// the cursor changes in a way DecodeCursor cannot migrate; sources synced
+// with another version are fully resynced.
+const Version = "2.0.0"
 
 // Cursor tracks sync state across multiple repositories and content types.
 type Cursor struct {
@@ -15,7 +25,12 @@ type Cursor struct {
 	Version int `json:"v"`
 
 	// Repos maps repository full name (owner/repo) to its cursor state.
-	Repos map[string]RepoCursor `json:"repos"`
+	// Only encoded by version 1 cursors.
+	Repos map[string]RepoCursor `json:"repos,omitempty"`
+
+	// GistsSince is the timestamp of the last updated gist.
+	// Gists belong to the account, so they are tracked in the header.
+	GistsSince time.Time `json:"gists_since,omitempty"`
 }
 
 // RepoCursor tracks sync state for a single repository.
@@ -31,6 +46,9 @@ type RepoCursor struct {
 
 	// WikiCommitSHA is the last indexed wiki commit SHA.
 	WikiCommitSHA string `json:"wiki_sha,omitempty"`
+
+	// CommitsSince is the commit date of the last indexed commit.
+	CommitsSince time.Time `json:"commits_since,omitempty"`
 }
 
 // NewCursor creates a new empty cursor.
@@ -78,6 +96,47 @@ func DecodeCursor(s string) (*Cursor, error) {
 	return &cursor, nil
 }
 
+// CursorFromState rebuilds the cursor from a sync state.
+// Repository state is read from the sub-cursors. Version 1 cursors kept it in
+// the Cursor blob instead; those repositories are loaded from the blob and
+// written back as sub-cursors when the sync completes.
+func CursorFromState(state domain.SyncState) (*Cursor, error) {
+	cursor, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, sub := range state.SubCursors {
+		var rc RepoCursor
+		if err := json.Unmarshal([]byte(sub), &rc); err != nil {
+			return nil, fmt.Errorf("%w: repository %s", ErrInvalidCursor, name)
+		}
+		cursor.Repos[name] = rc
+	}
+
+	cursor.Version = CursorVersion
+	return cursor, nil
+}
+
+// EncodeHeader serializes the cursor without repository state.
+// Repository state is returned separately by SubCursors.
+func (c *Cursor) EncodeHeader() string {
+	return (&Cursor{Version: c.Version, GistsSince: c.GistsSince}).Encode()
+}
+
+// SubCursors returns each repository's cursor as JSON, keyed by owner/repo.
+func (c *Cursor) SubCursors() map[string]string {
+	subs := make(map[string]string, len(c.Repos))
+	for name, rc := range c.Repos {
+		data, err := json.Marshal(rc)
+		if err != nil {
+			continue
+		}
+		subs[name] = string(data)
+	}
+	return subs
+}
+
 // GetRepoCursor returns the cursor for a specific repository.
 func (c *Cursor) GetRepoCursor(owner, repo string) RepoCursor {
 	if c.Repos == nil {
@@ -122,6 +181,13 @@ func (c *Cursor) UpdateWikiCommitSHA(owner, repo, sha string) {
 	c.SetRepoCursor(owner, repo, &rc)
 }
 
+// UpdateCommitsSince updates the commits timestamp for a repository.
+func (c *Cursor) UpdateCommitsSince(owner, repo string, t time.Time) {
+	rc := c.GetRepoCursor(owner, repo)
+	rc.CommitsSince = t
+	c.SetRepoCursor(owner, repo, &rc)
+}
+
 // RepoFullName returns the full repository name.
 func RepoFullName(owner, repo string) string {
 	return owner + "/" + repo
@@ -3,7 +3,8 @@
 // This connector indexes all repositories accessible to the authenticated user,
 // including owned repositories, collaborator repositories, and organisation
 // member repositories. Content types indexed include repository files, issues,
-// pull requests, and wiki pages.
+// pull requests, and wiki pages, and optionally commit messages and the
+// user's gists.
 //
 // # Architecture
 //
@@ -17,7 +18,7 @@
 //
 // # Authentication
 //
-// Two authentication methods are supported:
+// Three authentication methods are supported:
 //
 //   - Personal Access Tokens (PAT): classic or fine-grained tokens created at
 //     github.com/settings/tokens. Requires 'repo' scope for private repositories.
@@ -25,7 +26,13 @@
 //   - OAuth App: tokens obtained via the OAuth 2.0 authorisation code flow.
 //     The application must be registered at github.com/settings/developers.
 //
-// Both methods provide 5,000 API requests per hour for authenticated users.
+//   - GitHub App: hour-long installation tokens minted from the App's private
+//     key, which the token provider renews as they expire. The connector
+//     indexes the repositories the App is installed on. Gists belong to user
+//     accounts, so they are not indexed.
+//
+// PATs and OAuth tokens provide 5,000 API requests per hour. GitHub App
+// installations get per-installation limits of up to 15,000 per hour.
 // Unauthenticated requests are limited to 60 per hour and are not supported.
 //
 // # Configuration
@@ -33,10 +40,40 @@
 // Source configuration accepts the following keys:
 //
 //   - content_types: comma-separated list of content to index.
-//     Valid values: files, issues, prs, wikis. Default: all types.
+//     Valid values: files, issues, prs, wikis, gists, commits.
+//     Default: files, issues, prs, wikis. Gists and commits must be enabled
+//     explicitly.
+//
+//   - file_patterns: comma-separated .gitignore-style patterns excluding
+//     repository files. A "!" prefix includes matching files again and the
+//     last matching pattern wins, so "*.log,!vendor/**" skips log files
+//     outside vendor, and "*,!docs/**/*.md" indexes only Markdown files
+//     under docs. Default: all files.
+//
+//   - issue_state, pr_state: index only open or closed issues and pull
+//     requests (open, closed, all). Default: all. Merged pull requests are
+//     closed. Filtering to open skips the closed history of long-lived
+//     repositories on the first sync.
+//
+//   - include_starred_gists: also index starred gists and forked gists
+//     (true/false). Default: false. Only used when gists are enabled.
+//
+//   - include_attachments: also index text attachments and gists linked from
+//     issues and pull requests (true/false). Default: false.
+//
+//   - max_attachment_size: largest attachment or linked gist to index, in
+//     bytes. Default: 1048576 (1MB).
 //
-//   - file_patterns: comma-separated glob patterns for file filtering.
-//     Example: "*.go,*.md". Default: all files.
+//   - commit_history_days: how many days of commit history the first sync
+//     indexes. Default: 365. Only used when commits are enabled.
+//
+//   - include_commit_diffs: also index the patch of each commit, up to 64KB
+//     per commit (true/false). Default: false. Costs one request per commit.
+//
+//   - metadata_only: index repository files by name, path and metadata
+//     without downloading their contents (true/false). Default: false. The
+//     1MB file size limit does not apply. Issues, PRs, wikis, commits and
+//     gists are still indexed in full.
 //
 // No repository specification is required. The connector automatically
 // discovers and indexes all repositories accessible to the authenticated user.
@@ -47,7 +84,8 @@
 //
 //  1. Proactive throttling: a token bucket algorithm limits requests to
 //     approximately 1.2 requests per second, staying well under the 5,000/hour
-//     limit whilst maximising throughput.
+//     limit whilst maximising throughput. The rate scales up when
+//     X-RateLimit-Limit reports a higher limit, as for GitHub App installations.
 //
 //  2. Reactive handling: the connector monitors X-RateLimit-Remaining and
 //     X-RateLimit-Reset headers. When limits are exhausted, it waits until
@@ -64,16 +102,54 @@
 //  2. Retrieves blob content for each file matching configured patterns
 //  3. Fetches issues and pull requests with their comments
 //  4. Retrieves wiki pages if the repository has a wiki
+//  5. Lists commits on the default branch when commits are enabled
+//
+// When gists are enabled, the user's public and secret gists are listed and
+// each gist is fetched with its file contents. Forks are skipped unless
+// include_starred_gists is set.
+//
+// When include_attachments is set, the bodies, comments and reviews of each
+// issue and pull request are scanned for uploaded files and gist links. Text
+// attachments (logs, patches, JSON, YAML, Markdown and similar) and linked
+// gists are emitted as child documents of the thread. Images and other binary
+// files are skipped, as is anything over max_attachment_size. At most 20
+// attachments are fetched per thread, and each download counts against the
+// rate limiter.
 //
 // Incremental sync uses cursors to track sync state. The cursor stores:
 //
 //   - Tree SHA: detects file changes by comparing against the current HEAD
-//   - Timestamps: filters issues and PRs updated since the last sync
-//   - Wiki SHA: tracks wiki repository changes
+//   - Timestamps: filters issues and PRs updated since the last sync, and
+//     commits made since the last indexed commit (passed as the Commits
+//     API's since parameter)
+//   - Wiki SHA: the head commit of the wiki repository, checked with one
+//     request per repository so unchanged wikis are skipped
+//   - Gists timestamp: filters gists updated since the last sync
 //
 // Each repository maintains independent cursor state, enabling partial syncs
 // to resume from where they left off.
 //
+// With issue_state or pr_state set, a full sync lists only issues and PRs in
+// that state, and the cursor records the latest update among them. Incremental
+// syncs list everything updated since the cursor in every state, so state
+// changes are seen: an issue closed since the last sync is removed from the
+// index when filtering to open, and one reopened is indexed again. Narrowing
+// the filter on an indexed source removes the excluded issues and PRs only
+// as each is next updated.
+//
+// Issues and PRs updated since the last sync but created before it are not
+// refetched with all their comments. Only comments created since then are
+// fetched (the comments API's since parameter), and the document is marked
+// partial so the normaliser appends them to the indexed one. Edits to
+// earlier comments are picked up by the next full sync. Reviews are always
+// fetched in full. Comments are fetched in full when filtering by state, as
+// an item entering the selected state was not indexed before.
+//
+// A sync run with --since (SyncState.Since) replaces the stored timestamps for
+// issues, pull requests, commits and gists, and fetches comments in full.
+// Files and wikis are compared by SHA, so --since does not apply to them;
+// they sync only when their SHA has changed.
+//
 // # Document Structure
 //
 // Documents are emitted with the following URI patterns:
@@ -82,6 +158,11 @@
 //   - Issues: github://{owner}/{repo}/issues/{number}
 //   - Pull Requests: github://{owner}/{repo}/pull/{number}
 //   - Wiki Pages: github://{owner}/{repo}/wiki/{page}
+//   - Commits: github://{owner}/{repo}/commit/{sha}
+//   - Gists: github://gists/{gistID}
+//   - Attachments: github://{owner}/{repo}/issues/{number}/attachments/{fileID}/{filename}
+//   - Linked Gists: github://{owner}/{repo}/issues/{number}/gists/{gistID}
+//     (pull/{number} for pull requests)
 //
 // Metadata includes repository information, file paths, issue/PR state,
 // labels, and timestamps.
@@ -99,7 +180,12 @@
 //
 //   - Binary files are not indexed (text content only)
 //   - File size limit: 1MB per file (GitHub API constraint)
-//   - Watch mode is not supported (no webhook integration in CLI)
+//   - Watch mode is not supported; instead 'sercha serve' can receive GitHub
+//     webhooks and sync just the repository an event names
+//   - Deleted gists are not detected by incremental sync
+//   - Commits are indexed from the default branch only; history older than
+//     commit_history_days is skipped, and rewritten history is not removed
+//   - Attachments are re-fetched only when their issue or PR is updated
 //   - Private repository access requires appropriate token scopes
 //
 // # Example Usage
@@ -11,6 +11,9 @@ var (
 	// ErrConfigInvalidContentType indicates an invalid content type was specified.
 	ErrConfigInvalidContentType = errors.New("github: invalid content type")
 
+	// ErrConfigInvalidState indicates an invalid issue or pull request state was specified.
+	ErrConfigInvalidState = errors.New("github: invalid state, expected open, closed or all")
+
 	// ErrRepoNotFound indicates the repository was not found or is not accessible.
 	ErrRepoNotFound = errors.New("github: repository not found")
 
@@ -22,6 +25,9 @@ var (
 
 	// ErrInvalidCursor indicates the cursor format is invalid.
 	ErrInvalidCursor = errors.New("github: invalid cursor format")
+
+	// ErrAttachmentTooLarge indicates an attachment exceeds the configured size limit.
+	ErrAttachmentTooLarge = errors.New("github: attachment too large")
 )
 
 // RateLimitError represents a rate limit exceeded error with reset time.
@@ -14,6 +14,8 @@ import (
 )
 
 // FetchFiles retrieves all files from a repository and converts them to RawDocuments.
+// With cfg.MetadataOnly, blobs are not fetched and documents have empty content.
+// Blobs whose type cfg does not support are not fetched either.
 func FetchFiles(
 	ctx context.Context, client *Client, repo *gh.Repository, cfg *Config,
 ) ([]domain.RawDocument, string, error) {
@@ -36,47 +38,51 @@ func FetchFiles(
 
 		path := entry.GetPath()
 
-		// Check if file matches patterns
-		if !matchesPatterns(path, cfg.FilePatterns) {
+		// Apply file patterns and skip binary files the sync cannot check
+		if !shouldIndexFile(path, cfg) {
 			continue
 		}
 
-		// Skip binary files by extension
-		if isBinaryExtension(path) {
-			continue
-		}
-
-		// Skip large files (> 1MB)
-		if entry.GetSize() > 1024*1024 {
-			continue
+		metadata := map[string]any{
+			"type":   "file",
+			"owner":  owner,
+			"repo":   name,
+			"branch": branch,
+			"path":   path,
+			"sha":    entry.GetSHA(),
+			"size":   entry.GetSize(),
+			"html_url": fmt.Sprintf(
+				"https://github.com/%s/%s/blob/%s/%s",
+				owner, name, branch, path,
+			),
 		}
 
-		// Fetch blob content
-		content, err := fetchBlobContent(ctx, client, owner, name, entry.GetSHA())
-		if err != nil {
-			// Skip files we can't read
-			continue
+		// Metadata-only and unsupported files are never downloaded, so the size limit does not apply
+		mimeType := detectFileMIMEType(path)
+		var content []byte
+		if cfg.MetadataOnly {
+			metadata[domain.MetadataMetadataOnly] = true
+		} else if cfg.fetchesContent(mimeType) {
+			// Skip large files (> 1MB)
+			if entry.GetSize() > 1024*1024 {
+				continue
+			}
+
+			// Fetch blob content
+			content, err = fetchBlobContent(ctx, client, owner, name, entry.GetSHA())
+			if err != nil {
+				// Skip files we can't read
+				continue
+			}
 		}
 
 		// Create RawDocument
 		doc := domain.RawDocument{
 			SourceID: "", // Will be set by connector
 			URI:      buildFileURI(owner, name, branch, path),
-			MIMEType: detectFileMIMEType(path),
+			MIMEType: mimeType,
 			Content:  content,
-			Metadata: map[string]any{
-				"type":   "file",
-				"owner":  owner,
-				"repo":   name,
-				"branch": branch,
-				"path":   path,
-				"sha":    entry.GetSHA(),
-				"size":   entry.GetSize(),
-				"html_url": fmt.Sprintf(
-					"https://github.com/%s/%s/blob/%s/%s",
-					owner, name, branch, path,
-				),
-			},
+			Metadata: metadata,
 		}
 		docs = append(docs, doc)
 	}
@@ -140,27 +146,24 @@ func detectFileMIMEType(path string) string {
 		return mimeType
 	}
 
+	if isBinaryExtension(path) {
+		return "application/octet-stream"
+	}
 	return "text/plain"
 }
 
-// matchesPatterns checks if a path matches any of the glob patterns.
-func matchesPatterns(path string, patterns []string) bool {
-	if len(patterns) == 0 {
-		return true
+// shouldIndexFile reports whether a repository file is indexed: it must not
+// be excluded by the file patterns and must not have a binary extension.
+// When the sync checks content types, binary files are left to that check,
+// so the ones with a normaliser are indexed and the rest are reported as skipped.
+func shouldIndexFile(path string, cfg *Config) bool {
+	if cfg.PatternSet.Match(path) {
+		return false
 	}
-
-	for _, pattern := range patterns {
-		matched, err := filepath.Match(pattern, filepath.Base(path))
-		if err == nil && matched {
-			return true
-		}
-		// Also try matching against full path
-		matched, err = filepath.Match(pattern, path)
-		if err == nil && matched {
-			return true
-		}
+	if cfg.SupportsContentType != nil && !cfg.MetadataOnly {
+		return true
 	}
-	return false
+	return !isBinaryExtension(path)
 }
 
 // isBinaryExtension checks if a file extension indicates a binary file.
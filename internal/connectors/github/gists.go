@@ -0,0 +1,172 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	gh "github.com/google/go-github/v80/github"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// MIMETypeGitHubGist is the custom MIME type for GitHub gists.
+const MIMETypeGitHubGist = "application/vnd.github.gist+json"
+
+// GistDetail is a single gist as returned by GET /gists/{id}.
+// ForkOf is set when the gist is a fork of another user's gist.
+type GistDetail struct {
+	gh.Gist
+	ForkOf *gh.Gist `json:"fork_of,omitempty"`
+}
+
+// GistContent is the JSON structure for the gist RawDocument content.
+type GistContent struct {
+	ID          string            `json:"id"`
+	Description string            `json:"description"`
+	Owner       string            `json:"owner"`
+	Public      bool              `json:"public"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	Files       []GistFileContent `json:"files"`
+}
+
+// GistFileContent represents a file in the gist content.
+type GistFileContent struct {
+	Filename string `json:"filename"`
+	Language string `json:"language,omitempty"`
+	Content  string `json:"content"`
+}
+
+// FetchGists retrieves the authenticated user's gists updated after since.
+// Starred gists and forks are only included when includeStarred is true.
+func FetchGists(
+	ctx context.Context, client *Client, since time.Time, includeStarred bool,
+) ([]domain.RawDocument, time.Time, error) {
+	opts := &gh.GistListOptions{
+		Since:       since,
+		ListOptions: gh.ListOptions{PerPage: 100},
+	}
+
+	gists, err := client.ListGists(ctx, opts)
+	if err != nil {
+		return nil, since, fmt.Errorf("list gists: %w", err)
+	}
+
+	if includeStarred {
+		starredOpts := &gh.GistListOptions{
+			Since:       since,
+			ListOptions: gh.ListOptions{PerPage: 100},
+		}
+		starred, err := client.ListStarredGists(ctx, starredOpts)
+		if err != nil {
+			return nil, since, fmt.Errorf("list starred gists: %w", err)
+		}
+		gists = append(gists, starred...)
+	}
+
+	docs := make([]domain.RawDocument, 0, len(gists))
+	seen := make(map[string]bool, len(gists))
+	var latestUpdate time.Time
+
+	for _, listed := range gists {
+		id := listed.GetID()
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		// The API includes gists updated at exactly since
+		updatedAt := listed.GetUpdatedAt().Time
+		if !since.IsZero() && !updatedAt.After(since) {
+			continue
+		}
+
+		// The listing omits file contents and fork details.
+		gist, err := client.GetGist(ctx, id)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, since, ctxErr
+			}
+			continue
+		}
+
+		if gist.ForkOf != nil && !includeStarred {
+			continue
+		}
+
+		if updatedAt.After(latestUpdate) {
+			latestUpdate = updatedAt
+		}
+
+		content := buildGistContent(gist)
+		contentJSON, jsonErr := json.Marshal(content)
+		if jsonErr != nil {
+			continue
+		}
+
+		filenames := make([]string, len(content.Files))
+		for i, f := range content.Files {
+			filenames[i] = f.Filename
+		}
+
+		doc := domain.RawDocument{
+			SourceID: "", // Will be set by connector
+			URI:      buildGistURI(id),
+			MIMEType: MIMETypeGitHubGist,
+			Content:  contentJSON,
+			Metadata: map[string]any{
+				"type":        "gist",
+				"gist_id":     id,
+				"description": content.Description,
+				"owner":       content.Owner,
+				"public":      content.Public,
+				"fork":        gist.ForkOf != nil,
+				"files":       filenames,
+				"comments":    gist.GetComments(),
+				"html_url":    gist.GetHTMLURL(),
+				"created_at":  content.CreatedAt.Format(time.RFC3339),
+				"updated_at":  content.UpdatedAt.Format(time.RFC3339),
+			},
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, latestUpdate, nil
+}
+
+// buildGistContent creates the GistContent structure with files sorted by name.
+func buildGistContent(gist *GistDetail) GistContent {
+	files := make([]GistFileContent, 0, len(gist.Files))
+	for name, f := range gist.Files {
+		filename := f.GetFilename()
+		if filename == "" {
+			filename = string(name)
+		}
+		files = append(files, GistFileContent{
+			Filename: filename,
+			Language: f.GetLanguage(),
+			Content:  f.GetContent(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Filename < files[j].Filename
+	})
+
+	return GistContent{
+		ID:          gist.GetID(),
+		Description: gist.GetDescription(),
+		Owner:       gist.GetOwner().GetLogin(),
+		Public:      gist.GetPublic(),
+		CreatedAt:   gist.GetCreatedAt().Time,
+		UpdatedAt:   gist.GetUpdatedAt().Time,
+		Files:       files,
+	}
+}
+
+// buildGistURI creates a URI for a gist.
+func buildGistURI(id string) string {
+	return "github://gists/" + id
+}
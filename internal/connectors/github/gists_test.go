@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v80/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// newGistTestClient returns a client backed by a fake gists API.
+// Gist "own" is the user's, "fork" is a fork and "star" is only starred.
+func newGistTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	listed := func(id string, updated time.Time) map[string]any {
+		return map[string]any{"id": id, "updated_at": updated.Format(time.RFC3339)}
+	}
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	details := map[string]map[string]any{
+		"own": {
+			"id": "own", "description": "Shell helpers", "public": false,
+			"owner":      map[string]any{"login": "octocat"},
+			"html_url":   "https://gist.github.com/own",
+			"created_at": older.Format(time.RFC3339), "updated_at": newer.Format(time.RFC3339),
+			"files": map[string]any{
+				"z.sh":      map[string]any{"filename": "z.sh", "language": "Shell", "content": "echo z"},
+				"README.md": map[string]any{"filename": "README.md", "language": "Markdown", "content": "# Notes"},
+			},
+		},
+		"fork": {
+			"id": "fork", "updated_at": older.Format(time.RFC3339),
+			"fork_of": map[string]any{"id": "upstream"},
+			"files":   map[string]any{"a.go": map[string]any{"filename": "a.go", "content": "package a"}},
+		},
+		"star": {
+			"id": "star", "updated_at": older.Format(time.RFC3339),
+			"owner": map[string]any{"login": "someone"},
+			"files": map[string]any{"b.py": map[string]any{"filename": "b.py", "content": "print(1)"}},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gists", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]any{listed("own", newer), listed("fork", older)})
+	})
+	mux.HandleFunc("/gists/starred", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]any{listed("star", older), listed("own", newer)})
+	})
+	mux.HandleFunc("/gists/", func(w http.ResponseWriter, r *http.Request) {
+		detail, ok := details[r.URL.Path[len("/gists/"):]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(detail)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.gh = gh.NewClient(nil)
+	client.gh.BaseURL = baseURL
+	// No proactive throttling against the fake server
+	client.rateLimiter.bucket = rate.NewLimiter(rate.Inf, 1)
+	return client
+}
+
+// gistURIs returns the URIs of the fetched documents.
+func gistURIs(t *testing.T, client *Client, since time.Time, includeStarred bool) ([]string, time.Time) {
+	t.Helper()
+	docs, latest, err := FetchGists(context.Background(), client, since, includeStarred)
+	require.NoError(t, err)
+
+	uris := make([]string, len(docs))
+	for i, doc := range docs {
+		assert.Equal(t, MIMETypeGitHubGist, doc.MIMEType)
+		uris[i] = doc.URI
+	}
+	return uris, latest
+}
+
+func TestFetchGists(t *testing.T) {
+	t.Run("excludes forks by default", func(t *testing.T) {
+		uris, latest := gistURIs(t, newGistTestClient(t), time.Time{}, false)
+
+		assert.Equal(t, []string{"github://gists/own"}, uris)
+		assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), latest)
+	})
+
+	t.Run("includes starred and forked gists when enabled", func(t *testing.T) {
+		uris, _ := gistURIs(t, newGistTestClient(t), time.Time{}, true)
+
+		assert.ElementsMatch(t, []string{"github://gists/own", "github://gists/fork", "github://gists/star"}, uris)
+	})
+
+	t.Run("skips gists not updated after since", func(t *testing.T) {
+		since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		uris, latest := gistURIs(t, newGistTestClient(t), since, true)
+
+		assert.Empty(t, uris)
+		assert.True(t, latest.IsZero())
+	})
+
+	t.Run("builds content with files sorted by name", func(t *testing.T) {
+		docs, _, err := FetchGists(context.Background(), newGistTestClient(t), time.Time{}, false)
+		require.NoError(t, err)
+		require.Len(t, docs, 1)
+
+		var content GistContent
+		require.NoError(t, json.Unmarshal(docs[0].Content, &content))
+		assert.Equal(t, "Shell helpers", content.Description)
+		assert.Equal(t, "octocat", content.Owner)
+		assert.False(t, content.Public)
+		require.Len(t, content.Files, 2)
+		assert.Equal(t, "README.md", content.Files[0].Filename)
+		assert.Equal(t, "echo z", content.Files[1].Content)
+		assert.Equal(t, "gist", docs[0].Metadata["type"])
+		assert.Equal(t, false, docs[0].Metadata["fork"])
+	})
+}
+
+func TestBuildGistURI(t *testing.T) {
+	assert.Equal(t, "github://gists/abc123", buildGistURI("abc123"))
+}
@@ -36,23 +36,34 @@ type CommentContent struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
-// FetchIssues retrieves all issues (excluding PRs) from a repository.
+// FetchIssues retrieves the issues (excluding PRs) of a repository in the
+// state cfg.IssueState selects. With a non-zero since, issues updated after it
+// are listed in every state so that state changes are seen: the URIs of those
+// no longer in the selected state are returned as removed.
+//
+// With appendComments set, issues created before since were indexed by an
+// earlier sync: only their comments created after since are fetched, and
+// their documents are marked partial so the comments are appended. This
+// applies only when every state is indexed, as an issue entering the selected
+// state has not been indexed before.
 func FetchIssues(
-	ctx context.Context, client *Client, repo *gh.Repository, since time.Time,
-) ([]domain.RawDocument, time.Time, error) {
+	ctx context.Context, client *Client, repo *gh.Repository, since time.Time, cfg *Config, appendComments bool,
+) ([]domain.RawDocument, []string, time.Time, error) {
 	if !repo.GetHasIssues() {
-		return nil, since, nil
+		return nil, nil, since, nil
 	}
 
 	owner := repo.GetOwner().GetLogin()
 	name := repo.GetName()
 
 	docs := make([]domain.RawDocument, 0)
+	var removed []string
 	var latestUpdate time.Time
+	appendComments = appendComments && stateParam(cfg.IssueState) == StateAll
 
 	// Build query options
 	opts := &gh.IssueListByRepoOptions{
-		State:     "all",
+		State:     stateParam(cfg.IssueState),
 		Sort:      "updated",
 		Direction: "asc",
 		ListOptions: gh.ListOptions{
@@ -61,11 +72,12 @@ func FetchIssues(
 	}
 	if !since.IsZero() {
 		opts.Since = since
+		opts.State = StateAll
 	}
 
 	issues, err := client.ListIssues(ctx, owner, name, opts)
 	if err != nil {
-		return nil, since, fmt.Errorf("list issues: %w", err)
+		return nil, nil, since, fmt.Errorf("list issues: %w", err)
 	}
 
 	for _, issue := range issues {
@@ -79,8 +91,19 @@ func FetchIssues(
 			latestUpdate = issue.GetUpdatedAt().Time
 		}
 
-		// Fetch comments.
-		comments, commErr := FetchIssueComments(ctx, client, owner, name, issue.GetNumber())
+		// Issues that left the selected state leave the index.
+		if !matchesState(cfg.IssueState, issue.GetState()) {
+			removed = append(removed, buildIssueURI(owner, name, issue.GetNumber()))
+			continue
+		}
+
+		// Fetch comments, only the new ones for issues indexed before.
+		partial := appendsComments(appendComments, since, issue.GetCreatedAt().Time)
+		var commentsSince time.Time
+		if partial {
+			commentsSince = since
+		}
+		comments, commErr := FetchIssueComments(ctx, client, owner, name, issue.GetNumber(), commentsSince)
 		if commErr != nil {
 			comments = nil
 		}
@@ -125,15 +148,26 @@ func FetchIssues(
 				"updated_at": issue.GetUpdatedAt().Format(time.RFC3339),
 			},
 		}
+		if partial {
+			doc.Metadata[domain.MetadataPartial] = true
+		}
 		docs = append(docs, doc)
 	}
 
-	return docs, latestUpdate, nil
+	return docs, removed, latestUpdate, nil
 }
 
-// FetchIssueComments retrieves all comments for an issue.
+// appendsComments reports whether an issue or PR created at createdAt gets
+// only its new comments, appended to the document indexed by an earlier sync.
+func appendsComments(appendComments bool, since, createdAt time.Time) bool {
+	return appendComments && !since.IsZero() && createdAt.Before(since)
+}
+
+// FetchIssueComments retrieves all comments for an issue, or with a non-zero
+// since only those created after it. Edits to earlier comments are left to
+// the next full sync.
 func FetchIssueComments(
-	ctx context.Context, client *Client, owner, repo string, issueNumber int,
+	ctx context.Context, client *Client, owner, repo string, issueNumber int, since time.Time,
 ) ([]*gh.IssueComment, error) {
 	if err := client.ensureClient(ctx); err != nil {
 		return nil, err
@@ -144,6 +178,10 @@ func FetchIssueComments(
 	opts := &gh.IssueListCommentsOptions{
 		ListOptions: gh.ListOptions{PerPage: 100},
 	}
+	if !since.IsZero() {
+		// The API filters by last update, which includes edited comments
+		opts.Since = &since
+	}
 
 	for {
 		select {
@@ -162,7 +200,11 @@ func FetchIssueComments(
 		}
 
 		client.updateRateLimitFromResponse(resp)
-		allComments = append(allComments, comments...)
+		for _, comment := range comments {
+			if since.IsZero() || comment.GetCreatedAt().Time.After(since) {
+				allComments = append(allComments, comment)
+			}
+		}
 
 		if resp.NextPage == 0 {
 			break
@@ -101,12 +101,12 @@ func (h *OAuthHandler) RefreshToken(
 	}, nil
 }
 
-// GetUserInfo fetches the user's login from GitHub.
-func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (string, error) {
-	req, err := http.NewRequestWithContext(
-		ctx, http.MethodGet, "https://api.github.com/user", http.NoBody)
+// GetUserInfo fetches the user's account details from GitHub.
+// The identifier is the user's login (username).
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userInfoURL, http.NoBody)
 	if err != nil {
-		return "", fmt.Errorf("create request: %w", err)
+		return domain.AccountInfo{}, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 	req.Header.Set("Accept", "application/vnd.github+json")
@@ -114,22 +114,30 @@ func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (str
 	client := &http.Client{Timeout: 10 * time.Second}
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("fetch user info: %w", err)
+		return domain.AccountInfo{}, fmt.Errorf("fetch user info: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("user info request failed with status %d", resp.StatusCode)
+		return domain.AccountInfo{}, fmt.Errorf("user info request failed with status %d", resp.StatusCode)
 	}
 
 	var userInfo struct {
-		Login string `json:"login"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		Email     string `json:"email"`
+		AvatarURL string `json:"avatar_url"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		return "", fmt.Errorf("decode user info: %w", err)
+		return domain.AccountInfo{}, fmt.Errorf("decode user info: %w", err)
 	}
 
-	return userInfo.Login, nil
+	return domain.AccountInfo{
+		Identifier:  userInfo.Login,
+		DisplayName: userInfo.Name,
+		Email:       userInfo.Email,
+		AvatarURL:   userInfo.AvatarURL,
+	}, nil
 }
 
 // DefaultConfig returns default OAuth URLs and scopes for GitHub.
@@ -153,6 +161,10 @@ const (
 	defaultTokenURL = "https://github.com/login/oauth/access_token"
 )
 
+// userInfoURL is the GitHub endpoint for the authenticated user.
+// A variable so tests can point it at a local server.
+var userInfoURL = "https://api.github.com/user"
+
 // defaultScopes are the default OAuth scopes for GitHub.
 var defaultScopes = []string{"repo", "read:user"}
 
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withUserInfoServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := userInfoURL
+	userInfoURL = server.URL
+	t.Cleanup(func() { userInfoURL = original })
+}
+
+func TestOAuthHandler_GetUserInfo_ReturnsUsername(t *testing.T) {
+	withUserInfoServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{
+			"login": "octocat",
+			"name": "The Octocat",
+			"email": "octocat@github.com",
+			"avatar_url": "https://github.com/images/octocat.png"
+		}`))
+	})
+
+	account, err := NewOAuthHandler().GetUserInfo(context.Background(), "test-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "octocat", account.Identifier)
+	assert.Equal(t, "The Octocat", account.DisplayName)
+	assert.Equal(t, "octocat@github.com", account.Email)
+	assert.Equal(t, "https://github.com/images/octocat.png", account.AvatarURL)
+}
+
+func TestOAuthHandler_GetUserInfo_NoNameFallsBackToUsername(t *testing.T) {
+	withUserInfoServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"login": "octocat", "name": null, "email": null}`))
+	})
+
+	account, err := NewOAuthHandler().GetUserInfo(context.Background(), "test-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "octocat", account.Label())
+}
+
+func TestOAuthHandler_GetUserInfo_ErrorStatus(t *testing.T) {
+	withUserInfoServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := NewOAuthHandler().GetUserInfo(context.Background(), "bad-token")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+}
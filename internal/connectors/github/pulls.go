@@ -45,19 +45,30 @@ type ReviewContent struct {
 	SubmittedAt time.Time `json:"submitted_at"`
 }
 
-// FetchPullRequests retrieves all pull requests from a repository.
+// FetchPullRequests retrieves the pull requests of a repository in the state
+// cfg.PRState selects. With a non-zero since, pull requests updated after it
+// are listed in every state so that state changes are seen: the URIs of those
+// no longer in the selected state are returned as removed.
+//
+// With appendComments set, pull requests created before since were indexed
+// by an earlier sync: only their comments created after since are fetched,
+// and their documents are marked partial so the comments are appended. As
+// for issues, this applies only when every state is indexed. Reviews are
+// always fetched in full.
 func FetchPullRequests(
-	ctx context.Context, client *Client, repo *gh.Repository, since time.Time,
-) ([]domain.RawDocument, time.Time, error) {
+	ctx context.Context, client *Client, repo *gh.Repository, since time.Time, cfg *Config, appendComments bool,
+) ([]domain.RawDocument, []string, time.Time, error) {
 	owner := repo.GetOwner().GetLogin()
 	name := repo.GetName()
 
 	docs := make([]domain.RawDocument, 0)
+	var removed []string
 	var latestUpdate time.Time
+	appendComments = appendComments && stateParam(cfg.PRState) == StateAll
 
 	// Build query options
 	opts := &gh.PullRequestListOptions{
-		State:     "all",
+		State:     stateParam(cfg.PRState),
 		Sort:      "updated",
 		Direction: "asc",
 		ListOptions: gh.ListOptions{
@@ -65,9 +76,13 @@ func FetchPullRequests(
 		},
 	}
 
+	if !since.IsZero() {
+		opts.State = StateAll
+	}
+
 	prs, err := client.ListPullRequests(ctx, owner, name, opts)
 	if err != nil {
-		return nil, since, fmt.Errorf("list pull requests: %w", err)
+		return nil, nil, since, fmt.Errorf("list pull requests: %w", err)
 	}
 
 	for _, pr := range prs {
@@ -81,8 +96,19 @@ func FetchPullRequests(
 			latestUpdate = pr.GetUpdatedAt().Time
 		}
 
+		// Pull requests that left the selected state leave the index.
+		if !matchesState(cfg.PRState, pr.GetState()) {
+			removed = append(removed, buildPRURI(owner, name, pr.GetNumber()))
+			continue
+		}
+
 		// Fetch comments and reviews. Errors are non-fatal.
-		comments, commErr := FetchIssueComments(ctx, client, owner, name, pr.GetNumber())
+		partial := appendsComments(appendComments, since, pr.GetCreatedAt().Time)
+		var commentsSince time.Time
+		if partial {
+			commentsSince = since
+		}
+		comments, commErr := FetchIssueComments(ctx, client, owner, name, pr.GetNumber(), commentsSince)
 		if commErr != nil {
 			comments = nil
 		}
@@ -99,10 +125,13 @@ func FetchPullRequests(
 		}
 
 		doc := buildPRDocument(owner, name, pr, contentJSON)
+		if partial {
+			doc.Metadata[domain.MetadataPartial] = true
+		}
 		docs = append(docs, doc)
 	}
 
-	return docs, latestUpdate, nil
+	return docs, removed, latestUpdate, nil
 }
 
 // buildPRDocument creates a RawDocument from a pull request.
@@ -15,6 +15,8 @@ const (
 	GitHubRateLimit = 5000
 
 	// ProactiveRate is the proactive throttle rate (~1.2 req/sec = 4320/hr).
+	// It scales up with higher limits, such as the per-installation limits
+	// of GitHub App installation tokens (up to 15000/hour).
 	ProactiveRate = 1.2
 
 	// MinBuffer is the minimum remaining requests before waiting for reset.
@@ -97,8 +99,9 @@ func (r *RateLimiter) UpdateFromResponse(resp *http.Response) {
 
 	// Parse X-RateLimit-Limit
 	if limit := resp.Header.Get(HeaderRateLimit); limit != "" {
-		if val, err := strconv.Atoi(limit); err == nil {
+		if val, err := strconv.Atoi(limit); err == nil && val != r.limit {
 			r.limit = val
+			r.bucket.SetLimit(proactiveRate(val))
 		}
 	}
 
@@ -110,6 +113,16 @@ func (r *RateLimiter) UpdateFromResponse(resp *http.Response) {
 	}
 }
 
+// proactiveRate returns the throttle rate for an hourly limit, keeping the
+// same headroom as ProactiveRate does for GitHubRateLimit. Lower limits keep
+// ProactiveRate and rely on the reactive check.
+func proactiveRate(limit int) rate.Limit {
+	if limit <= GitHubRateLimit {
+		return rate.Limit(ProactiveRate)
+	}
+	return rate.Limit(ProactiveRate * float64(limit) / GitHubRateLimit)
+}
+
 // CheckRateLimit checks if the response indicates rate limiting.
 // Returns a RateLimitError if rate limited, nil otherwise.
 func (r *RateLimiter) CheckRateLimit(resp *http.Response) error {
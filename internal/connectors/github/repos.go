@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"strings"
 
 	gh "github.com/google/go-github/v80/github"
 )
@@ -31,6 +32,25 @@ func FilterRepos(repos []*gh.Repository, includeArchived, includeForks bool) []*
 	return filtered
 }
 
+// ScopeRepos returns the repositories named in scope as owner/repo,
+// ignoring case. An empty scope keeps every repository.
+func ScopeRepos(repos []*gh.Repository, scope []string) []*gh.Repository {
+	if len(scope) == 0 {
+		return repos
+	}
+	scoped := make([]*gh.Repository, 0, len(scope))
+	for _, r := range repos {
+		name := r.GetOwner().GetLogin() + "/" + r.GetName()
+		for _, s := range scope {
+			if strings.EqualFold(name, s) {
+				scoped = append(scoped, r)
+				break
+			}
+		}
+	}
+	return scoped
+}
+
 // GetTree retrieves the full tree for a repository at a given ref.
 // Uses recursive=1 to get all files in one call.
 func GetTree(ctx context.Context, client *Client, owner, repo, ref string) (*gh.Tree, error) {
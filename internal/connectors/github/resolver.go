@@ -1,10 +1,29 @@
 package github
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
+
+// threadChildPattern matches the URI of an attachment or gist linked from an
+// issue or pull request, capturing the thread URI.
+var threadChildPattern = regexp.MustCompile(`^(github://[^/]+/[^/]+/(?:issues|pull)/\d+)/(?:attachments|gists)/`)
 
 // ResolveWebURL converts a GitHub URI to a web URL.
 // github://owner/repo/blob/branch/path -> https://github.com/owner/repo/blob/branch/path
-func ResolveWebURL(uri string, _ map[string]any) string {
+// github://gists/{id} -> https://gist.github.com/{id}
+// Attachments and gists linked from an issue or pull request resolve to their
+// html_url, or to the thread when it is missing.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	if id, ok := strings.CutPrefix(uri, "github://gists/"); ok {
+		return "https://gist.github.com/" + id
+	}
+	if m := threadChildPattern.FindStringSubmatch(uri); m != nil {
+		if htmlURL, ok := metadata["html_url"].(string); ok && htmlURL != "" {
+			return htmlURL
+		}
+		uri = m[1]
+	}
 	if strings.HasPrefix(uri, "github://") {
 		return "https://github.com/" + strings.TrimPrefix(uri, "github://")
 	}
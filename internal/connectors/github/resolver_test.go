@@ -37,6 +37,12 @@ func TestResolveWebURL(t *testing.T) {
 			metadata: nil,
 			want:     "https://github.com/owner/repo/wiki/Page-Name",
 		},
+		{
+			name:     "github:// gist URI converts to gist web URL",
+			uri:      "github://gists/aa5a315d61ae9438b18d",
+			metadata: nil,
+			want:     "https://gist.github.com/aa5a315d61ae9438b18d",
+		},
 		{
 			name:     "github:// root repo URI",
 			uri:      "github://owner/repo",
@@ -67,6 +73,18 @@ func TestResolveWebURL(t *testing.T) {
 			metadata: map[string]any{"web_link": "should-be-ignored"},
 			want:     "https://github.com/owner/repo/blob/main/file.go",
 		},
+		{
+			name:     "attachment URI uses html_url",
+			uri:      "github://owner/repo/issues/123/attachments/42/crash.log",
+			metadata: map[string]any{"html_url": "https://github.com/user-attachments/files/42/crash.log"},
+			want:     "https://github.com/user-attachments/files/42/crash.log",
+		},
+		{
+			name:     "linked gist URI without html_url falls back to the thread",
+			uri:      "github://owner/repo/pull/456/gists/aa5a315d61ae9438b18d",
+			metadata: nil,
+			want:     "https://github.com/owner/repo/pull/456",
+		},
 		{
 			name:     "github:// prefix only",
 			uri:      "github://",
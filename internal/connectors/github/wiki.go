@@ -11,30 +11,45 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
+// wikiBranch is the branch GitHub keeps wiki pages on.
+const wikiBranch = "master"
+
 // FetchWikiPages retrieves wiki pages from a repository.
-// Note: GitHub's REST API has limited wiki support. Wiki pages are accessed
-// via the repo's wiki git repository at {repo}.wiki.git.
-// For simplicity, we fetch the wiki page list and content via API where available.
-func FetchWikiPages(ctx context.Context, client *Client, repo *gh.Repository) ([]domain.RawDocument, string, error) {
+// GitHub's REST API has no wiki endpoints, but the wiki is a separate git
+// repository, {repo}.wiki, which the git data API can read.
+//
+// The latest wiki commit is checked first with a single request. When it
+// equals lastSHA the wiki is unchanged and no pages are fetched; pass an
+// empty lastSHA to always fetch. The returned SHA is the wiki's head commit.
+func FetchWikiPages(
+	ctx context.Context, client *Client, repo *gh.Repository, lastSHA string,
+) ([]domain.RawDocument, string, error) {
 	if !repo.GetHasWiki() {
 		return nil, "", ErrWikiDisabled
 	}
 
 	owner := repo.GetOwner().GetLogin()
 	name := repo.GetName()
-
-	// GitHub doesn't have a direct API for wiki pages
-	// The wiki is a separate git repository: https://github.com/{owner}/{repo}.wiki.git
-	// We can try to access it via the repos API but it's limited
-
-	// First, check if wiki has any pages by trying to get the wiki git tree
-	// Wiki repositories are accessed as {owner}/{repo}.wiki
 	wikiRepoName := name + ".wiki"
 
-	// Try to get the tree for the wiki repository
-	tree, err := client.GetTree(ctx, owner, wikiRepoName, "master")
+	headSHA, err := client.GetHeadCommitSHA(ctx, owner, wikiRepoName, wikiBranch)
 	if err != nil {
 		// Wiki might not exist or be empty
+		if IsNotFound(err) || IsForbidden(err) || isEmptyRepository(err) {
+			return nil, "", ErrWikiDisabled
+		}
+		return nil, "", err
+	}
+	if headSHA == "" {
+		return nil, "", ErrWikiDisabled
+	}
+	if headSHA == lastSHA {
+		return nil, headSHA, nil
+	}
+
+	// Read the page list at the head commit
+	tree, err := client.GetTree(ctx, owner, wikiRepoName, headSHA)
+	if err != nil {
 		if IsNotFound(err) || IsForbidden(err) {
 			return nil, "", ErrWikiDisabled
 		}
@@ -83,7 +98,7 @@ func FetchWikiPages(ctx context.Context, client *Client, repo *gh.Repository) ([
 		docs = append(docs, doc)
 	}
 
-	return docs, tree.GetSHA(), nil
+	return docs, headSHA, nil
 }
 
 // fetchWikiBlobContent fetches the content of a wiki blob and decodes it.
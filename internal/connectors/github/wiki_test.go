@@ -0,0 +1,113 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	gh "github.com/google/go-github/v80/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// newWikiTestClient returns a client backed by a fake git API for the
+// octocat/hello wiki at head commit wiki222. It counts tree requests.
+func newWikiTestClient(t *testing.T, treeRequests *int) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/octocat/hello.wiki/commits", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "master", r.URL.Query().Get("sha"))
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		_ = json.NewEncoder(w).Encode([]map[string]any{{"sha": "wiki222"}})
+	})
+	mux.HandleFunc("/repos/octocat/hello.wiki/git/trees/wiki222", func(w http.ResponseWriter, _ *http.Request) {
+		*treeRequests++
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"sha": "tree333",
+			"tree": []map[string]any{
+				{"path": "Home.md", "type": "blob", "sha": "blob1"},
+				{"path": "logo.png", "type": "blob", "sha": "blob2"},
+			},
+		})
+	})
+	mux.HandleFunc("/repos/octocat/hello.wiki/git/blobs/blob1", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"content":  base64.StdEncoding.EncodeToString([]byte("# Welcome")),
+			"encoding": "base64",
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.gh = gh.NewClient(nil)
+	client.gh.BaseURL = baseURL
+	// No proactive throttling against the fake server
+	client.rateLimiter.bucket = rate.NewLimiter(rate.Inf, 1)
+	return client
+}
+
+// wikiTestRepo returns the repository served by newWikiTestClient.
+func wikiTestRepo() *gh.Repository {
+	return &gh.Repository{
+		Name:    gh.Ptr("hello"),
+		Owner:   &gh.User{Login: gh.Ptr("octocat")},
+		HasWiki: gh.Ptr(true),
+	}
+}
+
+func TestFetchWikiPages(t *testing.T) {
+	t.Run("fetches pages at the head commit", func(t *testing.T) {
+		var treeRequests int
+		client := newWikiTestClient(t, &treeRequests)
+
+		docs, sha, err := FetchWikiPages(context.Background(), client, wikiTestRepo(), "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "wiki222", sha)
+		assert.Equal(t, 1, treeRequests)
+		require.Len(t, docs, 1)
+		assert.Equal(t, "github://octocat/hello/wiki/Home", docs[0].URI)
+		assert.Equal(t, "# Welcome", string(docs[0].Content))
+	})
+
+	t.Run("skips the wiki when the head commit is unchanged", func(t *testing.T) {
+		var treeRequests int
+		client := newWikiTestClient(t, &treeRequests)
+
+		docs, sha, err := FetchWikiPages(context.Background(), client, wikiTestRepo(), "wiki222")
+
+		require.NoError(t, err)
+		assert.Equal(t, "wiki222", sha)
+		assert.Empty(t, docs)
+		assert.Zero(t, treeRequests)
+	})
+
+	t.Run("refetches when the head commit has moved", func(t *testing.T) {
+		var treeRequests int
+		client := newWikiTestClient(t, &treeRequests)
+
+		docs, sha, err := FetchWikiPages(context.Background(), client, wikiTestRepo(), "wiki111")
+
+		require.NoError(t, err)
+		assert.Equal(t, "wiki222", sha)
+		assert.Len(t, docs, 1)
+	})
+
+	t.Run("returns ErrWikiDisabled without a wiki", func(t *testing.T) {
+		repo := wikiTestRepo()
+		repo.HasWiki = gh.Ptr(false)
+
+		_, _, err := FetchWikiPages(context.Background(), NewClientWithHTTPClient(nil), repo, "")
+
+		assert.ErrorIs(t, err, ErrWikiDisabled)
+	})
+}
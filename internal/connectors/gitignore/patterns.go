@@ -0,0 +1,168 @@
+// Package gitignore matches file paths against .gitignore-style patterns,
+// as used by the GitHub connector's file_patterns and the filesystem
+// connector's .sercha-ignore files.
+package gitignore
+
+import (
+	"path"
+	"strings"
+)
+
+// PatternSet is an ordered list of .gitignore-style patterns for
+// slash-separated file paths relative to a root. A pattern excludes the files
+// it matches and a pattern prefixed with "!" includes them again; when several
+// patterns match a path, the last one wins.
+//
+// Supported syntax:
+//   - "*.log" without a slash matches a file or directory name at any depth.
+//   - "docs/*.md" with a slash is anchored to the root;
+//     a leading "/" anchors a pattern without other slashes.
+//   - "**" matches any number of directories: "**/test", "vendor/**",
+//     "docs/**/*.md".
+//   - "build/" with a trailing slash matches directories only.
+//   - A pattern matching a directory matches every file beneath it.
+//   - Lines starting with "#" are comments; "\#" and "\!" match literally.
+//
+// Unlike git, a file beneath an excluded directory can be included again,
+// since paths are matched one by one rather than during a directory walk.
+type PatternSet struct {
+	patterns []pattern
+}
+
+// pattern is a single parsed rule of a PatternSet.
+type pattern struct {
+	segments []string
+	negate   bool
+	dirOnly  bool
+}
+
+// Parse parses .gitignore-style patterns in order.
+// Blank lines and comments are skipped.
+func Parse(lines []string) *PatternSet {
+	set := &PatternSet{}
+	for _, line := range lines {
+		if p, ok := parsePattern(line); ok {
+			set.patterns = append(set.patterns, p)
+		}
+	}
+	return set
+}
+
+// parsePattern parses one pattern line, reporting false for blanks and comments.
+func parsePattern(line string) (pattern, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return pattern{}, false
+	}
+
+	var p pattern
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\`) {
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	// A slash anywhere but the end anchors the pattern to the root
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimLeft(line, "/")
+	if line == "" {
+		return pattern{}, false
+	}
+
+	p.segments = strings.Split(line, "/")
+	if !anchored {
+		p.segments = append([]string{"**"}, p.segments...)
+	}
+	return p, true
+}
+
+// Empty reports whether the set has no patterns.
+func (s *PatternSet) Empty() bool {
+	return s == nil || len(s.patterns) == 0
+}
+
+// HasNegation reports whether any pattern includes paths again with "!".
+func (s *PatternSet) HasNegation() bool {
+	if s.Empty() {
+		return false
+	}
+	for _, p := range s.patterns {
+		if p.negate {
+			return true
+		}
+	}
+	return false
+}
+
+// Match reports whether a slash-separated file path is excluded by the set.
+// A nil or empty set excludes nothing.
+func (s *PatternSet) Match(filePath string) bool {
+	excluded, _ := s.Result(filePath, false)
+	return excluded
+}
+
+// Result reports whether a slash-separated path is excluded by the set, and
+// whether any pattern matched it at all, so that a caller combining nested
+// sets can let an unmatched path fall through to the enclosing set. isDir
+// marks the path as a directory, which directory-only patterns also match.
+func (s *PatternSet) Result(filePath string, isDir bool) (excluded, matched bool) {
+	if s.Empty() {
+		return false, false
+	}
+
+	parts := strings.Split(strings.Trim(filePath, "/"), "/")
+	for _, p := range s.patterns {
+		if p.matches(parts, isDir) {
+			excluded = !p.negate
+			matched = true
+		}
+	}
+	return excluded, matched
+}
+
+// matches reports whether the pattern matches the path or one of its parent
+// directories. Directory-only patterns match directories only.
+func (p pattern) matches(parts []string, isDir bool) bool {
+	last := len(parts)
+	if p.dirOnly && !isDir {
+		last--
+	}
+	for n := 1; n <= last; n++ {
+		if matchSegments(p.segments, parts[:n]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSegments matches pattern segments against path segments,
+// with "**" matching zero or more path segments.
+func matchSegments(segments, parts []string) bool {
+	if len(segments) == 0 {
+		return len(parts) == 0
+	}
+
+	if segments[0] == "**" {
+		for i := 0; i <= len(parts); i++ {
+			if matchSegments(segments[1:], parts[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(parts) == 0 {
+		return false
+	}
+	matched, err := path.Match(segments[0], parts[0])
+	if err != nil || !matched {
+		return false
+	}
+	return matchSegments(segments[1:], parts[1:])
+}
@@ -0,0 +1,99 @@
+package gitignore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatternSet_Match(t *testing.T) {
+	t.Run("empty set excludes nothing", func(t *testing.T) {
+		assert.False(t, Parse(nil).Match("main.go"))
+		assert.False(t, Parse([]string{"", "# comment"}).Match("main.go"))
+
+		var set *PatternSet
+		assert.False(t, set.Match("main.go"))
+	})
+
+	t.Run("name patterns match at any depth", func(t *testing.T) {
+		set := Parse([]string{"*.log"})
+
+		assert.True(t, set.Match("app.log"))
+		assert.True(t, set.Match("logs/2024/app.log"))
+		assert.False(t, set.Match("app.go"))
+	})
+
+	t.Run("patterns with a slash are anchored", func(t *testing.T) {
+		set := Parse([]string{"docs/*.md", "/README.md"})
+
+		assert.True(t, set.Match("docs/guide.md"))
+		assert.False(t, set.Match("api/docs/guide.md"))
+		assert.False(t, set.Match("docs/api/guide.md"))
+		assert.True(t, set.Match("README.md"))
+		assert.False(t, set.Match("cmd/README.md"))
+	})
+
+	t.Run("directory patterns match files beneath", func(t *testing.T) {
+		set := Parse([]string{"node_modules/", "build"})
+
+		assert.True(t, set.Match("node_modules/lib/index.js"))
+		assert.True(t, set.Match("web/node_modules/lib/index.js"))
+		assert.False(t, set.Match("node_modules"), "trailing slash matches directories only")
+		assert.True(t, set.Match("build/out.txt"))
+		assert.True(t, set.Match("build"))
+	})
+
+	t.Run("double star matches any number of directories", func(t *testing.T) {
+		set := Parse([]string{"*", "!docs/**/*.md"})
+
+		assert.False(t, set.Match("docs/index.md"))
+		assert.False(t, set.Match("docs/api/v1/index.md"))
+		assert.True(t, set.Match("docs/api/v1/index.txt"))
+		assert.True(t, set.Match("guide.md"))
+	})
+
+	t.Run("negation includes files again and the last match wins", func(t *testing.T) {
+		set := Parse([]string{"vendor/", "*.log", "!vendor/**"})
+
+		assert.False(t, set.Match("vendor/github.com/lib/lib.go"))
+		assert.False(t, set.Match("vendor/debug.log"))
+		assert.True(t, set.Match("debug.log"))
+
+		set = Parse([]string{"!vendor/**", "vendor/"})
+		assert.True(t, set.Match("vendor/lib.go"))
+	})
+
+	t.Run("escaped prefixes match literally", func(t *testing.T) {
+		set := Parse([]string{`\!important.txt`, `\#notes.md`})
+
+		assert.True(t, set.Match("!important.txt"))
+		assert.True(t, set.Match("#notes.md"))
+		assert.False(t, set.Match("important.txt"))
+	})
+}
+
+func TestPatternSet_Result(t *testing.T) {
+	set := Parse([]string{"build/", "*.log", "!keep.log"})
+
+	excluded, matched := set.Result("build", true)
+	assert.True(t, excluded)
+	assert.True(t, matched)
+
+	excluded, matched = set.Result("build", false)
+	assert.False(t, excluded, "directory patterns only match directories")
+	assert.False(t, matched)
+
+	excluded, matched = set.Result("logs/keep.log", false)
+	assert.False(t, excluded)
+	assert.True(t, matched, "a negated match is still a match")
+
+	_, matched = set.Result("main.go", false)
+	assert.False(t, matched)
+}
+
+func TestPatternSet_HasNegation(t *testing.T) {
+	var set *PatternSet
+	assert.False(t, set.HasNegation())
+	assert.False(t, Parse([]string{"*.log", `\!literal`}).HasNegation())
+	assert.True(t, Parse([]string{"*.log", "!keep.log"}).HasNegation())
+}
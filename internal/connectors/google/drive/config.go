@@ -30,6 +30,8 @@ type Config struct {
 	MimeTypeFilter []string
 	// FolderIDs limits syncing to specific folders (optional).
 	FolderIDs []string
+	// DriveID syncs a Shared Drive instead of My Drive (optional).
+	DriveID string
 	// MaxResults is the page size for API requests.
 	MaxResults int64
 }
@@ -74,6 +76,9 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		}
 	}
 
+	// Parse drive_id
+	cfg.DriveID = strings.TrimSpace(source.Config["drive_id"])
+
 	// Parse max_results
 	if val := source.Config["max_results"]; val != "" {
 		if n, err := strconv.ParseInt(val, 10, 64); err == nil && n > 0 {
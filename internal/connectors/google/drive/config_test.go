@@ -159,6 +159,19 @@ func TestParseConfig_FolderIDs(t *testing.T) {
 	}
 }
 
+func TestParseConfig_DriveID(t *testing.T) {
+	source := domain.Source{
+		ID:     "test-source",
+		Type:   "google-drive",
+		Config: map[string]string{"drive_id": " shared-1 "},
+	}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "shared-1", cfg.DriveID)
+}
+
 func TestParseConfig_MaxResults(t *testing.T) {
 	tests := []struct {
 		name     string
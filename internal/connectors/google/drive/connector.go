@@ -3,6 +3,7 @@ package drive
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 
 	"google.golang.org/api/drive/v3"
@@ -22,6 +23,7 @@ type Connector struct {
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *google.RateLimiter
+	transport     http.RoundTripper
 	mu            sync.Mutex
 	closed        bool
 }
@@ -36,6 +38,14 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetTransport sets the HTTP transport used for Drive API requests,
+// such as a per-source HTTP cache.
+func (c *Connector) SetTransport(rt http.RoundTripper) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.transport = rt
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "google-drive"
@@ -76,13 +86,16 @@ func (c *Connector) Validate(ctx context.Context) error {
 	}
 
 	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewDriveService(ctx, ts)
+	svc, err := google.NewDriveService(ctx, ts, c.transport)
 	if err != nil {
 		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
 	}
 
 	_, err = svc.About.Get().Fields("user").Context(ctx).Do()
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		if google.IsUnauthorized(err) {
 			return domain.ErrAuthInvalid
 		}
@@ -115,35 +128,40 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 	}
 
 	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewDriveService(ctx, ts)
+	svc, err := google.NewDriveService(ctx, ts, c.transport)
 	if err != nil {
 		return fmt.Errorf("create drive service: %w", err)
 	}
 
-	startTokenResp, err := svc.Changes.GetStartPageToken().Context(ctx).Do()
+	// Take the token before listing so changes made during the sync are
+	// picked up by the next incremental sync
+	startPageToken, err := c.startPageToken(ctx, svc)
 	if err != nil {
-		return fmt.Errorf("get start page token: %w", google.WrapError(err))
+		return err
 	}
 
 	cursor := NewCursor()
-	cursor.StartPageToken = startTokenResp.StartPageToken
+	cursor.StartPageToken = startPageToken
 
-	if err := c.fetchAllFiles(ctx, svc, docsChan); err != nil {
+	sendDoc := func(doc *domain.RawDocument) error {
+		return c.sendDocument(ctx, docsChan, doc)
+	}
+	if err := c.fetchAllFiles(ctx, svc, sendDoc); err != nil {
 		return err
 	}
 
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
-// fetchAllFiles fetches all files matching the config.
+// fetchAllFiles fetches all files matching the config and passes each to emit.
 func (c *Connector) fetchAllFiles(
-	ctx context.Context, svc *drive.Service, docsChan chan<- domain.RawDocument,
+	ctx context.Context, svc *drive.Service, emit func(*domain.RawDocument) error,
 ) error {
 	var pageToken string
 
 	for {
 		if err := ctx.Err(); err != nil {
-			return nil
+			return err
 		}
 
 		if err := c.rateLimiter.Wait(ctx); err != nil {
@@ -155,7 +173,7 @@ func (c *Connector) fetchAllFiles(
 			return fmt.Errorf("list files: %w", google.WrapError(err))
 		}
 
-		if err := c.processFiles(ctx, svc, files.Files, docsChan); err != nil {
+		if err := c.processFiles(ctx, svc, files.Files, emit); err != nil {
 			return err
 		}
 
@@ -170,7 +188,8 @@ func (c *Connector) fetchAllFiles(
 
 // listFiles creates and executes a file list request.
 func (c *Connector) listFiles(ctx context.Context, svc *drive.Service, pageToken string) (*drive.FileList, error) {
-	const fileFields = "nextPageToken, files(id, name, mimeType, modifiedTime, size, parents, webViewLink, trashed)"
+	const fileFields = "nextPageToken, files(id, name, mimeType, modifiedTime, size, parents, webViewLink, trashed, " +
+		"owners(displayName, emailAddress), lastModifyingUser(displayName, emailAddress))"
 	req := svc.Files.List().
 		PageSize(c.config.MaxResults).
 		Fields(googleapi.Field(fileFields))
@@ -183,9 +202,30 @@ func (c *Connector) listFiles(ctx context.Context, svc *drive.Service, pageToken
 		req = req.Q("(" + buildFolderQuery(c.config.FolderIDs) + ")")
 	}
 
+	if c.config.DriveID != "" {
+		req = req.DriveId(c.config.DriveID).
+			Corpora("drive").
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true)
+	}
+
 	return req.Context(ctx).Do()
 }
 
+// startPageToken returns the token marking the current end of the change log.
+func (c *Connector) startPageToken(ctx context.Context, svc *drive.Service) (string, error) {
+	req := svc.Changes.GetStartPageToken()
+	if c.config.DriveID != "" {
+		req = req.DriveId(c.config.DriveID).SupportsAllDrives(true)
+	}
+
+	resp, err := req.Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("get start page token: %w", google.WrapError(err))
+	}
+	return resp.StartPageToken, nil
+}
+
 // buildFolderQuery builds a Drive query for specific folders.
 func buildFolderQuery(folderIDs []string) string {
 	if len(folderIDs) == 0 {
@@ -198,9 +238,9 @@ func buildFolderQuery(folderIDs []string) string {
 	return result
 }
 
-// processFiles converts files to documents and sends them to the channel.
+// processFiles converts files to documents and passes them to emit.
 func (c *Connector) processFiles(
-	ctx context.Context, svc *drive.Service, files []*drive.File, docsChan chan<- domain.RawDocument,
+	ctx context.Context, svc *drive.Service, files []*drive.File, emit func(*domain.RawDocument) error,
 ) error {
 	for _, file := range files {
 		if !ShouldSyncFile(file, c.config) {
@@ -212,7 +252,7 @@ func (c *Connector) processFiles(
 			continue
 		}
 
-		if err := c.sendDocument(ctx, docsChan, rawDoc); err != nil {
+		if err := emit(rawDoc); err != nil {
 			return err
 		}
 	}
@@ -264,12 +304,23 @@ func (c *Connector) runIncrementalSync(
 	}
 
 	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewDriveService(ctx, ts)
+	svc, err := google.NewDriveService(ctx, ts, c.transport)
 	if err != nil {
 		return fmt.Errorf("create drive service: %w", err)
 	}
 
+	return c.syncChanges(ctx, svc, cursor, changesChan)
+}
+
+// syncChanges replays the change log from the cursor's page token.
+// If Drive no longer accepts the token, every file is re-sent instead.
+func (c *Connector) syncChanges(
+	ctx context.Context, svc *drive.Service, cursor *Cursor, changesChan chan<- domain.RawDocumentChange,
+) error {
 	newStartPageToken, err := c.processChanges(ctx, svc, cursor.StartPageToken, changesChan)
+	if google.IsSyncTokenExpired(err) {
+		newStartPageToken, err = c.resync(ctx, svc, changesChan)
+	}
 	if err != nil {
 		return err
 	}
@@ -278,6 +329,26 @@ func (c *Connector) runIncrementalSync(
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
+// resync re-sends every file as an update and returns a fresh start page token.
+// Files deleted while the old token was valid cannot be detected this way.
+func (c *Connector) resync(
+	ctx context.Context, svc *drive.Service, changesChan chan<- domain.RawDocumentChange,
+) (string, error) {
+	startPageToken, err := c.startPageToken(ctx, svc)
+	if err != nil {
+		return "", err
+	}
+
+	sendUpdate := func(doc *domain.RawDocument) error {
+		return c.sendChange(ctx, changesChan, domain.ChangeUpdated, doc)
+	}
+	if err := c.fetchAllFiles(ctx, svc, sendUpdate); err != nil {
+		return "", err
+	}
+
+	return startPageToken, nil
+}
+
 // processChanges fetches and processes all changes.
 func (c *Connector) processChanges(
 	ctx context.Context,
@@ -289,7 +360,7 @@ func (c *Connector) processChanges(
 
 	for {
 		if err := ctx.Err(); err != nil {
-			return "", nil
+			return "", err
 		}
 
 		if err := c.rateLimiter.Wait(ctx); err != nil {
@@ -320,13 +391,21 @@ func (c *Connector) listChanges(
 	ctx context.Context, svc *drive.Service, pageToken string,
 ) (*drive.ChangeList, error) {
 	const changesFields = "nextPageToken, newStartPageToken, " +
-		"changes(fileId, removed, file(id, name, mimeType, modifiedTime, size, parents, webViewLink, trashed))"
+		"changes(changeType, fileId, removed, " +
+		"file(id, name, mimeType, modifiedTime, size, parents, webViewLink, trashed, " +
+		"owners(displayName, emailAddress), lastModifyingUser(displayName, emailAddress)))"
 
-	return svc.Changes.List(pageToken).
+	req := svc.Changes.List(pageToken).
 		Fields(googleapi.Field(changesFields)).
-		PageSize(c.config.MaxResults).
-		Context(ctx).
-		Do()
+		PageSize(c.config.MaxResults)
+
+	if c.config.DriveID != "" {
+		req = req.DriveId(c.config.DriveID).
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true)
+	}
+
+	return req.Context(ctx).Do()
 }
 
 // processChangeList processes a batch of changes.
@@ -351,6 +430,11 @@ func (c *Connector) processChange(
 	change *drive.Change,
 	changesChan chan<- domain.RawDocumentChange,
 ) error {
+	// Shared Drive changes (renames, membership) carry no file
+	if change.ChangeType == "drive" {
+		return nil
+	}
+
 	if change.Removed || change.File == nil || change.File.Trashed {
 		return c.sendDeletion(ctx, change.FileId, changesChan)
 	}
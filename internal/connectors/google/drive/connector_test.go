@@ -2,12 +2,19 @@ package drive
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 // mockTokenProvider implements driven.TokenProvider for testing.
@@ -198,3 +205,167 @@ func TestBuildFolderQuery(t *testing.T) {
 		})
 	}
 }
+
+// fakeDrive serves the Drive changes and files endpoints from canned responses.
+type fakeDrive struct {
+	mu          sync.Mutex
+	changes     map[string]*drive.ChangeList // keyed by pageToken
+	files       *drive.FileList
+	startToken  string
+	expired     bool
+	driveIDs    []string
+	listedFiles bool
+}
+
+func (f *fakeDrive) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.driveIDs = append(f.driveIDs, r.URL.Query().Get("driveId"))
+
+	var body any
+	switch r.URL.Path {
+	case "/changes/startPageToken":
+		body = &drive.StartPageToken{StartPageToken: f.startToken}
+	case "/changes":
+		if f.expired {
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"error": {"code": 410, "message": "token expired"}}`))
+			return
+		}
+		body = f.changes[r.URL.Query().Get("pageToken")]
+	case "/files":
+		f.listedFiles = true
+		body = f.files
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newFakeDriveService(t *testing.T, handler http.Handler) *drive.Service {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithEndpoint(server.URL+"/"), option.WithHTTPClient(server.Client()))
+	require.NoError(t, err)
+	return svc
+}
+
+// runSyncChanges runs syncChanges from the given token and collects its output.
+func runSyncChanges(
+	t *testing.T, conn *Connector, svc *drive.Service, token string,
+) ([]domain.RawDocumentChange, error) {
+	t.Helper()
+	cursor := NewCursor()
+	cursor.StartPageToken = token
+
+	changesChan := make(chan domain.RawDocumentChange)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(changesChan)
+		errChan <- conn.syncChanges(context.Background(), svc, cursor, changesChan)
+	}()
+
+	var changes []domain.RawDocumentChange
+	for change := range changesChan {
+		changes = append(changes, change)
+	}
+	return changes, <-errChan
+}
+
+func TestConnector_SyncChanges(t *testing.T) {
+	fake := &fakeDrive{changes: map[string]*drive.ChangeList{
+		"token-1": {
+			NextPageToken: "token-2",
+			Changes: []*drive.Change{
+				{ChangeType: "file", FileId: "f1", File: &drive.File{Id: "f1", Name: "a.png", MimeType: "image/png"}},
+				{ChangeType: "file", FileId: "f2", Removed: true},
+			},
+		},
+		"token-2": {
+			NewStartPageToken: "token-3",
+			Changes: []*drive.Change{
+				{ChangeType: "file", FileId: "f3", File: &drive.File{Id: "f3", MimeType: "image/png", Trashed: true}},
+				{ChangeType: "drive", DriveId: "shared-1"},
+			},
+		},
+	}}
+	conn := New("source-123", DefaultConfig(), nil)
+
+	changes, err := runSyncChanges(t, conn, newFakeDriveService(t, fake), "token-1")
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 3)
+	assert.Equal(t, domain.ChangeUpdated, changes[0].Type)
+	assert.Equal(t, "gdrive://files/f1", changes[0].Document.URI)
+	assert.Equal(t, domain.ChangeDeleted, changes[1].Type)
+	assert.Equal(t, "gdrive://files/f2", changes[1].Document.URI)
+	assert.Equal(t, domain.ChangeDeleted, changes[2].Type)
+	assert.Equal(t, "gdrive://files/f3", changes[2].Document.URI)
+	assert.False(t, fake.listedFiles)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", cursor.StartPageToken)
+}
+
+func TestConnector_SyncChanges_ExpiredTokenResyncs(t *testing.T) {
+	fake := &fakeDrive{
+		expired:    true,
+		startToken: "fresh-token",
+		files: &drive.FileList{Files: []*drive.File{
+			{Id: "f1", Name: "a.png", MimeType: "image/png"},
+			{Id: "folder", MimeType: MimeTypeFolder},
+		}},
+	}
+	conn := New("source-123", DefaultConfig(), nil)
+
+	changes, err := runSyncChanges(t, conn, newFakeDriveService(t, fake), "stale-token")
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 1)
+	assert.Equal(t, domain.ChangeUpdated, changes[0].Type)
+	assert.Equal(t, "gdrive://files/f1", changes[0].Document.URI)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, "fresh-token", cursor.StartPageToken)
+}
+
+func TestConnector_SyncChanges_SharedDrive(t *testing.T) {
+	fake := &fakeDrive{
+		expired:    true,
+		startToken: "fresh-token",
+		files:      &drive.FileList{},
+	}
+	cfg := DefaultConfig()
+	cfg.DriveID = "shared-1"
+	conn := New("source-123", cfg, nil)
+
+	_, err := runSyncChanges(t, conn, newFakeDriveService(t, fake), "stale-token")
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	// changes.list, changes.getStartPageToken and files.list all pass the drive
+	assert.Equal(t, []string{"shared-1", "shared-1", "shared-1"}, fake.driveIDs)
+}
+
+func TestConnector_SyncChanges_Cancelled(t *testing.T) {
+	conn := New("source-123", DefaultConfig(), nil)
+	svc := newFakeDriveService(t, &fakeDrive{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cursor := NewCursor()
+	cursor.StartPageToken = "token-1"
+	err := conn.syncChanges(ctx, svc, cursor, make(chan domain.RawDocumentChange))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "token-1", cursor.StartPageToken)
+}
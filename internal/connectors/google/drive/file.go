@@ -52,7 +52,7 @@ func FileToRawDocument(
 	// Build path from parents (simplified - just using first parent)
 	path := buildFilePath(file)
 
-	return &domain.RawDocument{
+	doc := &domain.RawDocument{
 		SourceID: sourceID,
 		URI:      fmt.Sprintf("gdrive://files/%s", file.Id),
 		MIMEType: mimeType,
@@ -65,7 +65,26 @@ func FileToRawDocument(
 			"web_link":      file.WebViewLink,
 			"modified_time": file.ModifiedTime,
 		},
-	}, nil
+	}
+	domain.SetAuthorMetadata(doc.Metadata, fileAuthor(file))
+	return doc, nil
+}
+
+// fileAuthor returns the owner of a file, identified by email address.
+// Files in shared drives have no owner, so the last person to modify
+// them is used instead.
+func fileAuthor(file *drive.File) domain.Author {
+	user := file.LastModifyingUser
+	if len(file.Owners) > 0 {
+		user = file.Owners[0]
+	}
+	if user == nil {
+		return domain.Author{}
+	}
+	return domain.Author{
+		Name:       strings.TrimSpace(user.DisplayName),
+		Identifier: strings.ToLower(user.EmailAddress),
+	}
 }
 
 // fetchFileContent retrieves the content of a file.
@@ -90,7 +109,7 @@ func fetchFileContent(ctx context.Context, svc *drive.Service, file *drive.File)
 	}
 
 	// Download regular file content
-	resp, err := svc.Files.Get(file.Id).Context(ctx).Download()
+	resp, err := svc.Files.Get(file.Id).SupportsAllDrives(true).Context(ctx).Download()
 	if err != nil {
 		return nil, "", fmt.Errorf("download file: %w", err)
 	}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/api/drive/v3"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 func TestShouldSyncFile(t *testing.T) {
@@ -257,3 +259,36 @@ func TestBuildFilePath(t *testing.T) {
 		})
 	}
 }
+
+func TestFileAuthor(t *testing.T) {
+	alice := &drive.User{DisplayName: "Alice Smith", EmailAddress: "Alice@Example.com"}
+	bob := &drive.User{DisplayName: "Bob", EmailAddress: "bob@example.com"}
+
+	tests := []struct {
+		name     string
+		file     *drive.File
+		expected domain.Author
+	}{
+		{
+			name:     "owner",
+			file:     &drive.File{Owners: []*drive.User{alice}, LastModifyingUser: bob},
+			expected: domain.Author{Name: "Alice Smith", Identifier: "alice@example.com"},
+		},
+		{
+			name:     "shared drive file falls back to last modifier",
+			file:     &drive.File{LastModifyingUser: bob},
+			expected: domain.Author{Name: "Bob", Identifier: "bob@example.com"},
+		},
+		{
+			name:     "no users",
+			file:     &drive.File{},
+			expected: domain.Author{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, fileAuthor(tt.file))
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package gmail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"net/url"
+	"path"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// extensionTypes maps attachment extensions to MIME types for attachments
+// sent as application/octet-stream. mime.TypeByExtension depends on the
+// system's MIME tables, which often lack Office formats.
+var extensionTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".txt":  "text/plain",
+	".md":   "text/markdown",
+	".csv":  "text/csv",
+	".html": "text/html",
+	".htm":  "text/html",
+	".ics":  "text/calendar",
+	".eml":  "message/rfc822",
+}
+
+// Attachment is a file attached to an email.
+type Attachment struct {
+	// Filename is the attachment's file name.
+	Filename string
+	// MIMEType is the attachment's media type, without parameters.
+	MIMEType string
+	// Content is the decoded attachment body.
+	Content []byte
+}
+
+// ExtractAttachments returns the attachments of a raw RFC 2822 message
+// for which keep returns true. Attachments larger than maxSize bytes are
+// skipped; a maxSize of zero or less means no limit.
+func ExtractAttachments(raw []byte, keep func(mimeType string) bool, maxSize int64) ([]Attachment, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("read message: %w", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, nil // Single-part messages have no attachments
+	}
+
+	var attachments []Attachment
+	seen := make(map[string]int)
+	walkParts(msg.Body, params["boundary"], func(header textproto.MIMEHeader, body io.Reader) {
+		filename := partFilename(header)
+		if filename == "" {
+			return // Message body, not an attachment
+		}
+
+		mimeType := partMIMEType(header, filename)
+		if !keep(mimeType) {
+			return
+		}
+
+		content, ok := readPart(header, body, maxSize)
+		if !ok {
+			return
+		}
+
+		// Two attachments with the same name would share a URI
+		seen[filename]++
+		if n := seen[filename]; n > 1 {
+			ext := path.Ext(filename)
+			filename = fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(filename, ext), n, ext)
+		}
+
+		attachments = append(attachments, Attachment{
+			Filename: filename,
+			MIMEType: mimeType,
+			Content:  content,
+		})
+	})
+
+	return attachments, nil
+}
+
+// walkParts calls visit for every leaf part of a multipart body,
+// descending into nested multipart parts. Malformed parts end the walk.
+func walkParts(r io.Reader, boundary string, visit func(textproto.MIMEHeader, io.Reader)) {
+	if boundary == "" {
+		return
+	}
+
+	mr := multipart.NewReader(r, boundary)
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			return
+		}
+
+		mediaType, params, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err == nil && strings.HasPrefix(mediaType, "multipart/") {
+			walkParts(part, params["boundary"], visit)
+		} else {
+			visit(part.Header, part)
+		}
+		part.Close()
+	}
+}
+
+// partFilename returns the attachment file name from Content-Disposition,
+// falling back to the Content-Type name parameter.
+func partFilename(header textproto.MIMEHeader) string {
+	if _, params, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil {
+		if name := params["filename"]; name != "" {
+			return path.Base(decodeHeader(name))
+		}
+	}
+	if _, params, err := mime.ParseMediaType(header.Get("Content-Type")); err == nil {
+		if name := params["name"]; name != "" {
+			return path.Base(decodeHeader(name))
+		}
+	}
+	return ""
+}
+
+// partMIMEType returns the part's media type, guessing from the file
+// extension when the sender used a generic type.
+func partMIMEType(header textproto.MIMEHeader, filename string) string {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err == nil && mediaType != "application/octet-stream" {
+		return mediaType
+	}
+
+	ext := strings.ToLower(path.Ext(filename))
+	if t, ok := extensionTypes[ext]; ok {
+		return t
+	}
+	if t, _, err := mime.ParseMediaType(mime.TypeByExtension(ext)); err == nil {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// readPart reads and decodes a part body, reporting false if it is
+// unreadable or larger than maxSize.
+// multipart.Reader already decodes quoted-printable; base64 is decoded here.
+func readPart(header textproto.MIMEHeader, body io.Reader, maxSize int64) ([]byte, bool) {
+	if strings.EqualFold(strings.TrimSpace(header.Get("Content-Transfer-Encoding")), "base64") {
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	if maxSize > 0 {
+		body = io.LimitReader(body, maxSize+1)
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil, false
+	}
+	if maxSize > 0 && int64(len(content)) > maxSize {
+		return nil, false
+	}
+	return content, true
+}
+
+// decodeHeader decodes RFC 2047 encoded words, returning the input if it has none.
+func decodeHeader(s string) string {
+	decoded, err := new(mime.WordDecoder).DecodeHeader(s)
+	if err != nil {
+		return s
+	}
+	return decoded
+}
+
+// AttachmentToRawDocument converts an attachment to a RawDocument whose
+// parent is the message it was attached to.
+func AttachmentToRawDocument(msg *gmail.Message, att *Attachment, sourceID string) *domain.RawDocument {
+	parentURI := messageURI(msg.Id)
+
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       attachmentURI(msg.Id, att.Filename),
+		MIMEType:  att.MIMEType,
+		Content:   att.Content,
+		ParentURI: &parentURI,
+		Metadata: map[string]any{
+			"message_id":    msg.Id,
+			"thread_id":     msg.ThreadId,
+			"title":         att.Filename,
+			"filename":      att.Filename,
+			"size":          len(att.Content),
+			"internal_date": msg.InternalDate,
+		},
+	}
+}
+
+// messageURI builds the document URI for a message.
+func messageURI(messageID string) string {
+	return fmt.Sprintf("gmail://messages/%s", messageID)
+}
+
+// attachmentURI builds the document URI for an attachment.
+// URI format: gmail://messages/{id}/attachments/{filename}.
+func attachmentURI(messageID, filename string) string {
+	return fmt.Sprintf("%s/attachments/%s", messageURI(messageID), url.PathEscape(filename))
+}
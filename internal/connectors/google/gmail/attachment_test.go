@@ -0,0 +1,156 @@
+package gmail
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/gmail/v1"
+)
+
+// rawMessage joins lines into an RFC 2822 message with CRLF line endings.
+func rawMessage(lines ...string) []byte {
+	return []byte(strings.Join(lines, "\r\n"))
+}
+
+func testMessageWithAttachments() []byte {
+	pdf := base64.StdEncoding.EncodeToString([]byte("%PDF-1.4 report"))
+	return rawMessage(
+		"From: alice@example.com",
+		"Subject: Quarterly report",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="outer"`,
+		"",
+		"--outer",
+		`Content-Type: multipart/alternative; boundary="inner"`,
+		"",
+		"--inner",
+		"Content-Type: text/plain",
+		"",
+		"See attached.",
+		"--inner",
+		"Content-Type: text/html",
+		"",
+		"<p>See attached.</p>",
+		"--inner--",
+		"--outer",
+		`Content-Type: application/pdf; name="report.pdf"`,
+		`Content-Disposition: attachment; filename="report.pdf"`,
+		"Content-Transfer-Encoding: base64",
+		"",
+		pdf,
+		"--outer",
+		"Content-Type: application/octet-stream",
+		`Content-Disposition: attachment; filename="notes.md"`,
+		"",
+		"# Notes",
+		"--outer",
+		`Content-Type: image/png; name="logo.png"`,
+		"Content-Transfer-Encoding: base64",
+		"",
+		base64.StdEncoding.EncodeToString([]byte("png")),
+		"--outer--",
+	)
+}
+
+func keepAll(string) bool { return true }
+
+func TestExtractAttachments(t *testing.T) {
+	attachments, err := ExtractAttachments(testMessageWithAttachments(), keepAll, 0)
+
+	require.NoError(t, err)
+	require.Len(t, attachments, 3)
+
+	assert.Equal(t, "report.pdf", attachments[0].Filename)
+	assert.Equal(t, "application/pdf", attachments[0].MIMEType)
+	assert.Equal(t, "%PDF-1.4 report", string(attachments[0].Content))
+
+	assert.Equal(t, "notes.md", attachments[1].Filename)
+	assert.Equal(t, "text/markdown", attachments[1].MIMEType)
+	assert.Equal(t, "# Notes", string(attachments[1].Content))
+
+	assert.Equal(t, "logo.png", attachments[2].Filename)
+	assert.Equal(t, "image/png", attachments[2].MIMEType)
+}
+
+func TestExtractAttachments_FiltersByMIMEType(t *testing.T) {
+	keep := func(mimeType string) bool { return mimeType == "application/pdf" }
+
+	attachments, err := ExtractAttachments(testMessageWithAttachments(), keep, 0)
+
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, "report.pdf", attachments[0].Filename)
+}
+
+func TestExtractAttachments_SkipsOversized(t *testing.T) {
+	attachments, err := ExtractAttachments(testMessageWithAttachments(), keepAll, 10)
+
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+	assert.Equal(t, "notes.md", attachments[0].Filename)
+	assert.Equal(t, "logo.png", attachments[1].Filename)
+}
+
+func TestExtractAttachments_DuplicateFilenames(t *testing.T) {
+	raw := rawMessage(
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="b"`,
+		"",
+		"--b",
+		`Content-Disposition: attachment; filename="notes.txt"`,
+		"",
+		"first",
+		"--b",
+		`Content-Disposition: attachment; filename="notes.txt"`,
+		"",
+		"second",
+		"--b--",
+	)
+
+	attachments, err := ExtractAttachments(raw, keepAll, 0)
+
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+	assert.Equal(t, "notes.txt", attachments[0].Filename)
+	assert.Equal(t, "notes (2).txt", attachments[1].Filename)
+}
+
+func TestExtractAttachments_SinglePart(t *testing.T) {
+	raw := rawMessage(
+		"Subject: Hello",
+		"Content-Type: text/plain",
+		"",
+		"Just text.",
+	)
+
+	attachments, err := ExtractAttachments(raw, keepAll, 0)
+
+	require.NoError(t, err)
+	assert.Empty(t, attachments)
+}
+
+func TestExtractAttachments_InvalidMessage(t *testing.T) {
+	_, err := ExtractAttachments([]byte("not a message"), keepAll, 0)
+
+	assert.Error(t, err)
+}
+
+func TestAttachmentToRawDocument(t *testing.T) {
+	msg := &gmail.Message{Id: "msg123", ThreadId: "thread456", InternalDate: 1700000000000}
+	att := &Attachment{Filename: "Q3 report.pdf", MIMEType: "application/pdf", Content: []byte("pdf")}
+
+	doc := AttachmentToRawDocument(msg, att, "source-1")
+
+	assert.Equal(t, "source-1", doc.SourceID)
+	assert.Equal(t, "gmail://messages/msg123/attachments/Q3%20report.pdf", doc.URI)
+	assert.Equal(t, "application/pdf", doc.MIMEType)
+	assert.Equal(t, []byte("pdf"), doc.Content)
+	require.NotNil(t, doc.ParentURI)
+	assert.Equal(t, "gmail://messages/msg123", *doc.ParentURI)
+	assert.Equal(t, "msg123", doc.Metadata["message_id"])
+	assert.Equal(t, "Q3 report.pdf", doc.Metadata["title"])
+	assert.Equal(t, 3, doc.Metadata["size"])
+}
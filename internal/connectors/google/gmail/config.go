@@ -19,6 +19,9 @@ const (
 	LabelAll LabelFilter = ""
 )
 
+// DefaultMaxFileSize is the default size limit for indexed attachments (10MB).
+const DefaultMaxFileSize = 10 * 1024 * 1024
+
 // Config holds Gmail connector configuration.
 type Config struct {
 	// LabelIDs limits syncing to specific label IDs (optional).
@@ -30,13 +33,16 @@ type Config struct {
 	MaxResults int64
 	// IncludeSpamTrash includes spam and trash if true.
 	IncludeSpamTrash bool
+	// MaxFileSize is the largest attachment, in bytes, that is indexed.
+	MaxFileSize int64
 }
 
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		LabelIDs:   []string{"INBOX"},
-		MaxResults: 100,
+		LabelIDs:    []string{"INBOX"},
+		MaxResults:  100,
+		MaxFileSize: DefaultMaxFileSize,
 	}
 }
 
@@ -64,6 +70,13 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		}
 	}
 
+	// Parse max_file_size
+	if val := source.Config["max_file_size"]; val != "" {
+		if n, err := strconv.ParseInt(val, 10, 64); err == nil && n > 0 {
+			cfg.MaxFileSize = n
+		}
+	}
+
 	// Parse include_spam_trash
 	if val := source.Config["include_spam_trash"]; val == "true" {
 		cfg.IncludeSpamTrash = true
@@ -16,6 +16,7 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, int64(100), cfg.MaxResults)
 	assert.Empty(t, cfg.Query)
 	assert.False(t, cfg.IncludeSpamTrash)
+	assert.Equal(t, int64(DefaultMaxFileSize), cfg.MaxFileSize)
 }
 
 func TestParseConfig_Defaults(t *testing.T) {
@@ -177,6 +178,33 @@ func TestParseConfig_IncludeSpamTrash(t *testing.T) {
 	}
 }
 
+func TestParseConfig_MaxFileSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected int64
+	}{
+		{"empty uses default", "", DefaultMaxFileSize},
+		{"valid value", "1048576", 1048576},
+		{"zero uses default", "0", DefaultMaxFileSize},
+		{"negative uses default", "-1", DefaultMaxFileSize},
+		{"invalid uses default", "big", DefaultMaxFileSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := domain.Source{
+				Config: map[string]string{"max_file_size": tt.value},
+			}
+
+			cfg, err := ParseConfig(source)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.MaxFileSize)
+		})
+	}
+}
+
 func TestParseConfig_AllOptions(t *testing.T) {
 	source := domain.Source{
 		Config: map[string]string{
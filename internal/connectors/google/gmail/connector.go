@@ -3,7 +3,9 @@ package gmail
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
+	"time"
 
 	"google.golang.org/api/gmail/v1"
 
@@ -23,6 +25,9 @@ type Connector struct {
 	rateLimiter   *google.RateLimiter
 	mu            sync.Mutex
 	closed        bool
+
+	// attachmentTypes are the attachment MIME types that can be normalised.
+	attachmentTypes map[string]bool
 }
 
 // New creates a new Gmail connector.
@@ -35,6 +40,18 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetSupportedMIMETypes sets the MIME types that have a normaliser.
+// Attachments of these types are indexed as documents; until it is set,
+// attachments are skipped.
+func (c *Connector) SetSupportedMIMETypes(types []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attachmentTypes = make(map[string]bool, len(types))
+	for _, t := range types {
+		c.attachmentTypes[t] = true
+	}
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "gmail"
@@ -82,6 +99,9 @@ func (c *Connector) Validate(ctx context.Context) error {
 
 	_, err = svc.Users.GetProfile("me").Context(ctx).Do()
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		if google.IsUnauthorized(err) {
 			return domain.ErrAuthInvalid
 		}
@@ -119,42 +139,57 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 		return fmt.Errorf("create gmail service: %w", err)
 	}
 
-	profile, err := svc.Users.GetProfile("me").Context(ctx).Do()
+	// Take the history ID before listing so messages arriving during the
+	// sync are picked up by the next incremental sync
+	historyID, err := c.currentHistoryID(ctx, svc)
 	if err != nil {
-		return fmt.Errorf("get profile: %w", google.WrapError(err))
+		return err
 	}
 
 	cursor := NewCursor()
-	cursor.HistoryID = profile.HistoryId
+	cursor.HistoryID = historyID
 
-	if err := c.fetchAllMessages(ctx, svc, docsChan); err != nil {
+	sendDoc := func(doc *domain.RawDocument) error {
+		return c.sendDocument(ctx, docsChan, doc)
+	}
+	if err := c.fetchAllMessages(ctx, svc, sendDoc); err != nil {
 		return err
 	}
 
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
-// fetchAllMessages fetches all messages matching the config.
+// currentHistoryID returns the mailbox's latest history ID.
+func (c *Connector) currentHistoryID(ctx context.Context, svc *gmail.Service) (uint64, error) {
+	profile, err := svc.Users.GetProfile("me").Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("get profile: %w", google.WrapError(err))
+	}
+	return profile.HistoryId, nil
+}
+
+// fetchAllMessages fetches all messages matching the config and passes each
+// document to emit.
 func (c *Connector) fetchAllMessages(
-	ctx context.Context, svc *gmail.Service, docsChan chan<- domain.RawDocument,
+	ctx context.Context, svc *gmail.Service, emit func(*domain.RawDocument) error,
 ) error {
 	var pageToken string
 
 	for {
 		if err := ctx.Err(); err != nil {
-			return nil
+			return err
 		}
 
 		if err := c.rateLimiter.Wait(ctx); err != nil {
 			return err
 		}
 
-		resp, err := c.listMessages(ctx, svc, pageToken)
+		resp, err := c.listMessages(ctx, svc, c.config.Query, pageToken)
 		if err != nil {
 			return fmt.Errorf("list messages: %w", google.WrapError(err))
 		}
 
-		if err := c.processMessageRefs(ctx, svc, resp.Messages, docsChan); err != nil {
+		if err := c.processMessageRefs(ctx, svc, resp.Messages, emit); err != nil {
 			return err
 		}
 
@@ -169,7 +204,7 @@ func (c *Connector) fetchAllMessages(
 
 // listMessages creates and executes a message list request.
 func (c *Connector) listMessages(
-	ctx context.Context, svc *gmail.Service, pageToken string,
+	ctx context.Context, svc *gmail.Service, query, pageToken string,
 ) (*gmail.ListMessagesResponse, error) {
 	req := svc.Users.Messages.List("me").
 		MaxResults(c.config.MaxResults).
@@ -178,8 +213,8 @@ func (c *Connector) listMessages(
 	if len(c.config.LabelIDs) > 0 {
 		req = req.LabelIds(c.config.LabelIDs...)
 	}
-	if c.config.Query != "" {
-		req = req.Q(c.config.Query)
+	if query != "" {
+		req = req.Q(query)
 	}
 	if pageToken != "" {
 		req = req.PageToken(pageToken)
@@ -188,12 +223,12 @@ func (c *Connector) listMessages(
 	return req.Context(ctx).Do()
 }
 
-// processMessageRefs fetches full messages and sends them to the channel.
+// processMessageRefs fetches full messages and passes their documents to emit.
 func (c *Connector) processMessageRefs(
 	ctx context.Context,
 	svc *gmail.Service,
 	refs []*gmail.Message,
-	docsChan chan<- domain.RawDocument,
+	emit func(*domain.RawDocument) error,
 ) error {
 	for _, msgRef := range refs {
 		if err := c.rateLimiter.Wait(ctx); err != nil {
@@ -209,13 +244,42 @@ func (c *Connector) processMessageRefs(
 			continue
 		}
 
-		if err := c.sendDocument(ctx, docsChan, MessageToRawDocument(msg, c.sourceID)); err != nil {
-			return err
+		for _, doc := range c.messageDocuments(msg) {
+			if err := emit(doc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// messageDocuments converts a message and its indexable attachments to documents.
+// The message comes first so it is stored before the attachments that refer to it.
+func (c *Connector) messageDocuments(msg *gmail.Message) []*domain.RawDocument {
+	doc := MessageToRawDocument(msg, c.sourceID)
+	docs := []*domain.RawDocument{doc}
+
+	c.mu.Lock()
+	types := c.attachmentTypes
+	c.mu.Unlock()
+	if len(types) == 0 {
+		return docs
+	}
+
+	keep := func(mimeType string) bool { return types[mimeType] }
+	attachments, err := ExtractAttachments(doc.Content, keep, c.config.MaxFileSize)
+	if err != nil {
+		return docs // The message is still indexed without its attachments
+	}
+	author := domain.AuthorFromMetadata(doc.Metadata)
+	for i := range attachments {
+		att := AttachmentToRawDocument(msg, &attachments[i], c.sourceID)
+		domain.SetAuthorMetadata(att.Metadata, author) // Attachments are by the message's sender
+		docs = append(docs, att)
+	}
+	return docs
+}
+
 // fetchMessage retrieves a full message by ID in raw RFC 2822 format.
 func (c *Connector) fetchMessage(ctx context.Context, svc *gmail.Service, id string) (*gmail.Message, error) {
 	return svc.Users.Messages.Get("me", id).Format("raw").Context(ctx).Do()
@@ -234,6 +298,10 @@ func (c *Connector) sendDocument(
 }
 
 // IncrementalSync fetches only changes since the last sync using History API.
+// Added messages are created, deleted ones removed and relabelled ones
+// updated. When the stored history ID has expired, every message is re-sent.
+// A non-zero state.Since instead searches for messages received after that
+// time; deletions are not detected in that mode.
 func (c *Connector) IncrementalSync(
 	ctx context.Context, state domain.SyncState,
 ) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
@@ -271,7 +339,25 @@ func (c *Connector) runIncrementalSync(
 		return fmt.Errorf("create gmail service: %w", err)
 	}
 
+	if !state.Since.IsZero() {
+		if err := c.fetchMessagesSince(ctx, svc, state.Since, changesChan); err != nil {
+			return err
+		}
+		return &driven.SyncComplete{NewCursor: cursor.Encode()}
+	}
+
+	return c.syncHistory(ctx, svc, cursor, changesChan)
+}
+
+// syncHistory replays the mailbox history from the cursor's history ID.
+// If Gmail no longer has history that old, every message is re-sent instead.
+func (c *Connector) syncHistory(
+	ctx context.Context, svc *gmail.Service, cursor *Cursor, changesChan chan<- domain.RawDocumentChange,
+) error {
 	latestHistoryID, err := c.processHistory(ctx, svc, cursor.HistoryID, changesChan)
+	if google.IsHistoryIDExpired(err) {
+		latestHistoryID, err = c.resync(ctx, svc, changesChan)
+	}
 	if err != nil {
 		return err
 	}
@@ -280,6 +366,84 @@ func (c *Connector) runIncrementalSync(
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
+// resync re-sends every message as an update and returns the current history ID.
+// Messages deleted while the old history ID was valid cannot be detected this way.
+func (c *Connector) resync(
+	ctx context.Context, svc *gmail.Service, changesChan chan<- domain.RawDocumentChange,
+) (uint64, error) {
+	historyID, err := c.currentHistoryID(ctx, svc)
+	if err != nil {
+		return 0, err
+	}
+
+	sendUpdate := func(doc *domain.RawDocument) error {
+		return c.sendChange(ctx, changesChan, domain.ChangeUpdated, doc)
+	}
+	if err := c.fetchAllMessages(ctx, svc, sendUpdate); err != nil {
+		return 0, err
+	}
+
+	return historyID, nil
+}
+
+// fetchMessagesSince sends every message received after since as an update.
+func (c *Connector) fetchMessagesSince(
+	ctx context.Context, svc *gmail.Service, since time.Time, changesChan chan<- domain.RawDocumentChange,
+) error {
+	query := sinceQuery(c.config.Query, since)
+	var pageToken string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		resp, err := c.listMessages(ctx, svc, query, pageToken)
+		if err != nil {
+			return fmt.Errorf("list messages: %w", google.WrapError(err))
+		}
+
+		for _, ref := range resp.Messages {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			msg, err := c.fetchMessage(ctx, svc, ref.Id)
+			if err != nil {
+				continue
+			}
+
+			if !ShouldSyncMessage(msg, c.config) {
+				continue
+			}
+
+			for _, doc := range c.messageDocuments(msg) {
+				if err := c.sendChange(ctx, changesChan, domain.ChangeUpdated, doc); err != nil {
+					return err
+				}
+			}
+		}
+
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			return nil
+		}
+	}
+}
+
+// sinceQuery adds a received-after filter to the configured search query.
+func sinceQuery(query string, since time.Time) string {
+	after := "after:" + strconv.FormatInt(since.Unix(), 10)
+	if query == "" {
+		return after
+	}
+	return query + " " + after
+}
+
 // processHistory fetches and processes all history records.
 func (c *Connector) processHistory(
 	ctx context.Context,
@@ -292,7 +456,7 @@ func (c *Connector) processHistory(
 
 	for {
 		if err := ctx.Err(); err != nil {
-			return 0, nil
+			return 0, err
 		}
 
 		if err := c.rateLimiter.Wait(ctx); err != nil {
@@ -384,9 +548,10 @@ func (c *Connector) processAddedMessages(
 			continue
 		}
 
-		doc := MessageToRawDocument(msg, c.sourceID)
-		if err := c.sendChange(ctx, changesChan, domain.ChangeCreated, doc); err != nil {
-			return err
+		for _, doc := range c.messageDocuments(msg) {
+			if err := c.sendChange(ctx, changesChan, domain.ChangeCreated, doc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -397,20 +562,27 @@ func (c *Connector) processDeletedMessages(
 	ctx context.Context, deleted []*gmail.HistoryMessageDeleted, changesChan chan<- domain.RawDocumentChange,
 ) error {
 	for _, d := range deleted {
-		change := domain.RawDocumentChange{
-			Type: domain.ChangeDeleted,
-			Document: domain.RawDocument{
-				SourceID: c.sourceID,
-				URI:      fmt.Sprintf("gmail://messages/%s", d.Message.Id),
-			},
-		}
-		if err := c.sendChangeRaw(ctx, changesChan, &change); err != nil {
+		if err := c.sendDeletion(ctx, changesChan, d.Message.Id); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// sendDeletion sends a deletion for a message.
+func (c *Connector) sendDeletion(
+	ctx context.Context, changesChan chan<- domain.RawDocumentChange, messageID string,
+) error {
+	change := domain.RawDocumentChange{
+		Type: domain.ChangeDeleted,
+		Document: domain.RawDocument{
+			SourceID: c.sourceID,
+			URI:      messageURI(messageID),
+		},
+	}
+	return c.sendChangeRaw(ctx, changesChan, &change)
+}
+
 // processLabelChanges handles label additions and removals as updates.
 func (c *Connector) processLabelChanges(
 	ctx context.Context,
@@ -436,7 +608,8 @@ func (c *Connector) processLabelChanges(
 	return nil
 }
 
-// sendLabelChangeUpdate fetches a message and sends it as an update.
+// sendLabelChangeUpdate fetches a message and sends it as an update, or as a
+// deletion if its new labels exclude it from the sync (e.g. moved to trash).
 func (c *Connector) sendLabelChangeUpdate(
 	ctx context.Context, svc *gmail.Service, messageID string, changesChan chan<- domain.RawDocumentChange,
 ) error {
@@ -449,6 +622,10 @@ func (c *Connector) sendLabelChangeUpdate(
 		return nil // Skip individual message errors
 	}
 
+	if !ShouldSyncMessage(msg, c.config) {
+		return c.sendDeletion(ctx, changesChan, messageID)
+	}
+
 	return c.sendChange(ctx, changesChan, domain.ChangeUpdated, MessageToRawDocument(msg, c.sourceID))
 }
 
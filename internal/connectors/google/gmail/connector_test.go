@@ -2,12 +2,25 @@ package gmail
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/google"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 // mockTokenProvider implements driven.TokenProvider for testing.
@@ -167,3 +180,222 @@ func TestConnector_IncrementalSync_InvalidCursor(t *testing.T) {
 	assert.Error(t, receivedErr)
 	assert.Contains(t, receivedErr.Error(), "invalid cursor")
 }
+
+func TestConnector_messageDocuments(t *testing.T) {
+	msg := &gmail.Message{
+		Id:  "msg123",
+		Raw: base64.URLEncoding.EncodeToString(testMessageWithAttachments()),
+	}
+
+	t.Run("skips attachments without supported types", func(t *testing.T) {
+		conn := New("source-123", DefaultConfig(), nil)
+
+		docs := conn.messageDocuments(msg)
+
+		require.Len(t, docs, 1)
+		assert.Equal(t, "gmail://messages/msg123", docs[0].URI)
+	})
+
+	t.Run("includes supported attachments after the message", func(t *testing.T) {
+		conn := New("source-123", DefaultConfig(), nil)
+		conn.SetSupportedMIMETypes([]string{"application/pdf", "text/markdown"})
+
+		docs := conn.messageDocuments(msg)
+
+		require.Len(t, docs, 3)
+		assert.Equal(t, "gmail://messages/msg123", docs[0].URI)
+		assert.Equal(t, "gmail://messages/msg123/attachments/report.pdf", docs[1].URI)
+		assert.Equal(t, "gmail://messages/msg123/attachments/notes.md", docs[2].URI)
+	})
+}
+
+// fakeGmail serves the Gmail profile, history and messages endpoints from
+// canned responses.
+type fakeGmail struct {
+	mu        sync.Mutex
+	history   map[string]*gmail.ListHistoryResponse // keyed by pageToken
+	messages  map[string]*gmail.Message
+	historyID uint64
+	expired   bool
+	listed    bool
+}
+
+func (f *fakeGmail) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var body any
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/profile"):
+		body = &gmail.Profile{HistoryId: f.historyID}
+	case strings.HasSuffix(r.URL.Path, "/history"):
+		if f.expired {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": 404, "message": "Requested entity was not found."}}`))
+			return
+		}
+		body = f.history[r.URL.Query().Get("pageToken")]
+	case strings.HasSuffix(r.URL.Path, "/messages"):
+		f.listed = true
+		ids := make([]string, 0, len(f.messages))
+		for id := range f.messages {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		resp := &gmail.ListMessagesResponse{}
+		for _, id := range ids {
+			resp.Messages = append(resp.Messages, &gmail.Message{Id: id})
+		}
+		body = resp
+	case strings.Contains(r.URL.Path, "/messages/"):
+		msg, ok := f.messages[path.Base(r.URL.Path)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body = msg
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newFakeGmailService(t *testing.T, handler http.Handler) *gmail.Service {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithEndpoint(server.URL+"/"), option.WithHTTPClient(server.Client()))
+	require.NoError(t, err)
+	return svc
+}
+
+// newTestMessage returns a raw-format message with the given labels.
+func newTestMessage(id string, labels ...string) *gmail.Message {
+	raw := rawMessage("From: alice@example.com", "Subject: Hello", "", "Hi there")
+	return &gmail.Message{Id: id, LabelIds: labels, Raw: base64.URLEncoding.EncodeToString(raw)}
+}
+
+// newHistoryTestConnector returns a connector without rate limiting.
+func newHistoryTestConnector() *Connector {
+	conn := New("source-123", DefaultConfig(), nil)
+	conn.rateLimiter = google.NewRateLimiterWithConfig(google.RateLimitConfig{RequestsPerSecond: 1000, BurstSize: 100})
+	return conn
+}
+
+// runSyncHistory runs syncHistory from the given history ID and collects its output.
+func runSyncHistory(
+	t *testing.T, conn *Connector, svc *gmail.Service, historyID uint64,
+) ([]domain.RawDocumentChange, error) {
+	t.Helper()
+	cursor := NewCursor()
+	cursor.HistoryID = historyID
+
+	changesChan := make(chan domain.RawDocumentChange)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(changesChan)
+		errChan <- conn.syncHistory(context.Background(), svc, cursor, changesChan)
+	}()
+
+	var changes []domain.RawDocumentChange
+	for change := range changesChan {
+		changes = append(changes, change)
+	}
+	return changes, <-errChan
+}
+
+func TestConnector_SyncHistory(t *testing.T) {
+	fake := &fakeGmail{
+		history: map[string]*gmail.ListHistoryResponse{
+			"": {
+				NextPageToken: "page-2",
+				HistoryId:     150,
+				History: []*gmail.History{
+					{MessagesAdded: []*gmail.HistoryMessageAdded{{Message: &gmail.Message{Id: "m1"}}}},
+					{MessagesDeleted: []*gmail.HistoryMessageDeleted{{Message: &gmail.Message{Id: "m2"}}}},
+				},
+			},
+			"page-2": {
+				HistoryId: 200,
+				History: []*gmail.History{
+					{LabelsAdded: []*gmail.HistoryLabelAdded{{Message: &gmail.Message{Id: "m3"}}}},
+					{LabelsRemoved: []*gmail.HistoryLabelRemoved{{Message: &gmail.Message{Id: "m4"}}}},
+				},
+			},
+		},
+		messages: map[string]*gmail.Message{
+			"m1": newTestMessage("m1", "INBOX"),
+			"m3": newTestMessage("m3", "TRASH"),
+			"m4": newTestMessage("m4", "INBOX"),
+		},
+	}
+	conn := newHistoryTestConnector()
+
+	changes, err := runSyncHistory(t, conn, newFakeGmailService(t, fake), 100)
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 4)
+	assert.Equal(t, domain.ChangeCreated, changes[0].Type)
+	assert.Equal(t, "gmail://messages/m1", changes[0].Document.URI)
+	assert.Equal(t, domain.ChangeDeleted, changes[1].Type)
+	assert.Equal(t, "gmail://messages/m2", changes[1].Document.URI)
+	// Moving a message to the trash removes it from the index
+	assert.Equal(t, domain.ChangeDeleted, changes[2].Type)
+	assert.Equal(t, "gmail://messages/m3", changes[2].Document.URI)
+	assert.Equal(t, domain.ChangeUpdated, changes[3].Type)
+	assert.Equal(t, "gmail://messages/m4", changes[3].Document.URI)
+	assert.False(t, fake.listed)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), cursor.HistoryID)
+}
+
+func TestConnector_SyncHistory_ExpiredHistoryIDResyncs(t *testing.T) {
+	fake := &fakeGmail{
+		expired:   true,
+		historyID: 500,
+		messages: map[string]*gmail.Message{
+			"m1": newTestMessage("m1", "INBOX"),
+			"m2": newTestMessage("m2", "SPAM"),
+		},
+	}
+	conn := newHistoryTestConnector()
+
+	changes, err := runSyncHistory(t, conn, newFakeGmailService(t, fake), 100)
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 1)
+	assert.Equal(t, domain.ChangeUpdated, changes[0].Type)
+	assert.Equal(t, "gmail://messages/m1", changes[0].Document.URI)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500), cursor.HistoryID)
+}
+
+func TestConnector_SyncHistory_Cancelled(t *testing.T) {
+	conn := newHistoryTestConnector()
+	svc := newFakeGmailService(t, &fakeGmail{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cursor := NewCursor()
+	cursor.HistoryID = 100
+	err := conn.syncHistory(ctx, svc, cursor, make(chan domain.RawDocumentChange))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, uint64(100), cursor.HistoryID)
+}
+
+func TestSinceQuery(t *testing.T) {
+	since := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "after:1767312000", sinceQuery("", since))
+	assert.Equal(t, "from:alice after:1767312000", sinceQuery("from:alice", since))
+}
@@ -1,8 +1,11 @@
 package gmail
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"net/mail"
+	"strings"
 
 	"google.golang.org/api/gmail/v1"
 
@@ -20,9 +23,9 @@ func MessageToRawDocument(msg *gmail.Message, sourceID string) *domain.RawDocume
 	}
 	parentURI := buildParentURI(msg)
 
-	return &domain.RawDocument{
+	doc := &domain.RawDocument{
 		SourceID:  sourceID,
-		URI:       fmt.Sprintf("gmail://messages/%s", msg.Id),
+		URI:       messageURI(msg.Id),
 		MIMEType:  "message/rfc822",
 		Content:   rawBytes,
 		ParentURI: parentURI,
@@ -35,6 +38,22 @@ func MessageToRawDocument(msg *gmail.Message, sourceID string) *domain.RawDocume
 			"internal_date": msg.InternalDate,
 		},
 	}
+	domain.SetAuthorMetadata(doc.Metadata, messageAuthor(rawBytes))
+	return doc
+}
+
+// messageAuthor reads the sender from the From header of a raw message.
+// The address is the identifier; an unparseable header yields no author.
+func messageAuthor(raw []byte) domain.Author {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return domain.Author{}
+	}
+	addr, err := mail.ParseAddress(msg.Header.Get("From"))
+	if err != nil {
+		return domain.Author{}
+	}
+	return domain.Author{Name: strings.TrimSpace(addr.Name), Identifier: strings.ToLower(addr.Address)}
 }
 
 // buildParentURI builds a parent URI for thread relationship.
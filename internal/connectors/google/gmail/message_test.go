@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/api/gmail/v1"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 func TestMessageToRawDocument(t *testing.T) {
@@ -78,6 +80,32 @@ func TestMessageToRawDocument_InvalidBase64(t *testing.T) {
 	assert.Empty(t, doc.Content)
 }
 
+func TestMessageToRawDocument_Author(t *testing.T) {
+	rawContent := "From: =?UTF-8?Q?Ren=C3=A9e?= <Renee@Example.com>\r\nSubject: Hi\r\n\r\nHello!"
+	msg := &gmail.Message{
+		Id:  "msg-123",
+		Raw: base64.URLEncoding.EncodeToString([]byte(rawContent)),
+	}
+
+	doc := MessageToRawDocument(msg, "source-abc")
+
+	assert.Equal(t,
+		domain.Author{Name: "Renée", Identifier: "renee@example.com"},
+		domain.AuthorFromMetadata(doc.Metadata))
+}
+
+func TestMessageToRawDocument_NoAuthor(t *testing.T) {
+	msg := &gmail.Message{
+		Id:  "msg-123",
+		Raw: base64.URLEncoding.EncodeToString([]byte("Subject: Hi\r\n\r\nHello!")),
+	}
+
+	doc := MessageToRawDocument(msg, "source-abc")
+
+	assert.NotContains(t, doc.Metadata, domain.MetadataAuthorName)
+	assert.NotContains(t, doc.Metadata, domain.MetadataAuthorID)
+}
+
 func TestShouldSyncMessage(t *testing.T) {
 	tests := []struct {
 		name     string
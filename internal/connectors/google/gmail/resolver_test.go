@@ -25,6 +25,12 @@ func TestResolveWebURL(t *testing.T) {
 			metadata: nil,
 			want:     "https://mail.google.com/mail/u/0/#all/18f1234567890abcdef",
 		},
+		{
+			name:     "attachment resolves to its message",
+			uri:      "gmail://messages/18abc123def456/attachments/report.pdf",
+			metadata: nil,
+			want:     "https://mail.google.com/mail/u/0/#all/18abc123def456",
+		},
 		{
 			name:     "non-gmail URI returns empty",
 			uri:      "https://mail.google.com/mail/u/0/#all/123",
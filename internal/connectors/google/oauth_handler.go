@@ -110,13 +110,19 @@ func (h *OAuthHandler) RefreshToken(
 	}, nil
 }
 
-// GetUserInfo fetches the user's email from Google.
-func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (string, error) {
+// GetUserInfo fetches the user's account details from Google.
+// The identifier is the user's email.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error) {
 	userInfo, err := GetUserInfo(ctx, accessToken)
 	if err != nil {
-		return "", err
+		return domain.AccountInfo{}, err
 	}
-	return userInfo.Email, nil
+	return domain.AccountInfo{
+		Identifier:  userInfo.Email,
+		DisplayName: userInfo.Name,
+		Email:       userInfo.Email,
+		AvatarURL:   userInfo.Picture,
+	}, nil
 }
 
 // DefaultConfig returns default OAuth URLs and scopes for Google.
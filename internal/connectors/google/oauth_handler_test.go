@@ -0,0 +1,51 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withUserInfoServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := userInfoURL
+	userInfoURL = server.URL
+	t.Cleanup(func() { userInfoURL = original })
+}
+
+func TestOAuthHandler_GetUserInfo_ReturnsEmail(t *testing.T) {
+	withUserInfoServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{
+			"email": "user@example.com",
+			"verified_email": true,
+			"name": "Example User",
+			"picture": "https://lh3.googleusercontent.com/a/photo.jpg"
+		}`))
+	})
+
+	account, err := NewOAuthHandler().GetUserInfo(context.Background(), "test-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", account.Identifier)
+	assert.Equal(t, "user@example.com", account.Email)
+	assert.Equal(t, "Example User", account.DisplayName)
+	assert.Equal(t, "https://lh3.googleusercontent.com/a/photo.jpg", account.AvatarURL)
+}
+
+func TestOAuthHandler_GetUserInfo_ErrorStatus(t *testing.T) {
+	withUserInfoServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := NewOAuthHandler().GetUserInfo(context.Background(), "bad-token")
+
+	require.Error(t, err)
+}
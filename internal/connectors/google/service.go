@@ -13,7 +13,9 @@ import (
 	"google.golang.org/api/option"
 )
 
-const userInfoURL = "https://www.googleapis.com/oauth2/v2/userinfo"
+// userInfoURL is the Google userinfo endpoint.
+// A variable so tests can point it at a local server.
+var userInfoURL = "https://www.googleapis.com/oauth2/v2/userinfo"
 
 // UserInfo contains the user's basic profile information from Google.
 type UserInfo struct {
@@ -29,8 +31,13 @@ func NewGmailService(ctx context.Context, ts oauth2.TokenSource) (*gmail.Service
 }
 
 // NewDriveService creates a Google Drive API service using the provided TokenSource.
-func NewDriveService(ctx context.Context, ts oauth2.TokenSource) (*drive.Service, error) {
-	return drive.NewService(ctx, option.WithTokenSource(ts))
+// If base is non-nil, authorised requests are sent through it, e.g. an HTTP cache.
+func NewDriveService(ctx context.Context, ts oauth2.TokenSource, base http.RoundTripper) (*drive.Service, error) {
+	if base == nil {
+		return drive.NewService(ctx, option.WithTokenSource(ts))
+	}
+	client := &http.Client{Transport: &oauth2.Transport{Source: ts, Base: base}}
+	return drive.NewService(ctx, option.WithHTTPClient(client))
 }
 
 // NewCalendarService creates a Google Calendar API service using the provided TokenSource.
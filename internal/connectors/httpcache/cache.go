@@ -0,0 +1,272 @@
+package httpcache
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HeaderFromCache is set on responses answered from disk after a 304 reply.
+const HeaderFromCache = "X-From-Cache"
+
+// entryExt is the file extension of stored responses.
+const entryExt = ".resp"
+
+// Cache stores validated HTTP responses on disk, one directory per source.
+type Cache struct {
+	dir      string
+	maxBytes int64
+
+	// mu serialises writes and eviction; reads rely on atomic renames.
+	mu sync.Mutex
+}
+
+// New creates a cache rooted at dir.
+// maxBytes bounds the size of each source's cache; zero or less disables caching.
+func New(dir string, maxBytes int64) *Cache {
+	return &Cache{
+		dir:      dir,
+		maxBytes: maxBytes,
+	}
+}
+
+// Transport returns a RoundTripper that caches GET requests for one source.
+// If base is nil, http.DefaultTransport is used.
+func (c *Cache) Transport(sourceID string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if c == nil || c.maxBytes <= 0 {
+		return base
+	}
+	return &transport{
+		cache: c,
+		dir:   filepath.Join(c.dir, hashKey(sourceID)),
+		base:  base,
+	}
+}
+
+// transport is a caching http.RoundTripper scoped to a single source.
+type transport struct {
+	cache *Cache
+	dir   string
+	base  http.RoundTripper
+}
+
+// RoundTrip sends the request, revalidating any cached response for it.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !cacheable(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	path := t.entryPath(req)
+	cached := t.load(path, req)
+	if cached == nil {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		return t.store(path, resp)
+	}
+
+	conditional := req.Clone(req.Context())
+	if etag := cached.Header.Get("ETag"); etag != "" {
+		conditional.Header.Set("If-None-Match", etag)
+	}
+	if modified := cached.Header.Get("Last-Modified"); modified != "" {
+		conditional.Header.Set("If-Modified-Since", modified)
+	}
+
+	resp, err := t.base.RoundTrip(conditional)
+	if err != nil {
+		cached.Body.Close()
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusNotModified {
+		cached.Body.Close()
+		return t.store(path, resp)
+	}
+
+	// Not modified: serve the stored body with the fresh headers,
+	// which carry current values such as rate limit counters.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	for name, values := range resp.Header {
+		switch name {
+		case "Content-Length", "Content-Encoding", "Transfer-Encoding":
+			continue
+		}
+		cached.Header[name] = values
+	}
+	cached.Header.Set(HeaderFromCache, "1")
+	t.touch(path)
+	return cached, nil
+}
+
+// cacheable reports whether a request may be answered from the cache.
+// Requests that are already conditional or partial are passed through.
+func cacheable(req *http.Request) bool {
+	return req.Method == http.MethodGet &&
+		req.Header.Get("Range") == "" &&
+		req.Header.Get("If-None-Match") == "" &&
+		req.Header.Get("If-Modified-Since") == ""
+}
+
+// storable reports whether a response has a validator and may be kept.
+func storable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	if strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	return resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+// entryPath returns the file for a request. The Accept header is part of
+// the key because APIs such as GitHub return different bodies per media type.
+func (t *transport) entryPath(req *http.Request) string {
+	return filepath.Join(t.dir, hashKey(req.URL.String()+"\n"+req.Header.Get("Accept"))+entryExt)
+}
+
+// load reads a stored response, or returns nil if there is none.
+func (t *transport) load(path string, req *http.Request) *http.Response {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		// Unreadable entry; drop it and fetch afresh.
+		_ = os.Remove(path)
+		return nil
+	}
+	return resp
+}
+
+// store saves a response if it can be revalidated later and returns a
+// response whose body is still readable by the caller.
+// Cache write failures are ignored: the cache is only an optimisation.
+func (t *transport) store(path string, resp *http.Response) (*http.Response, error) {
+	if !storable(resp) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, t.cache.maxBytes+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if int64(len(body)) > t.cache.maxBytes {
+		// Too large to cache; hand back the buffered prefix and the rest of the stream.
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// DumpResponse restores resp.Body after reading it.
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return resp, nil
+	}
+
+	t.cache.mu.Lock()
+	defer t.cache.mu.Unlock()
+	if err := writeFile(path, dump); err != nil {
+		return resp, nil
+	}
+	t.evict()
+	return resp, nil
+}
+
+// touch marks an entry as recently used for eviction.
+func (t *transport) touch(path string) {
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+}
+
+// evict removes the least recently used entries until the source's cache
+// fits in the size bound (caller must hold cache.mu).
+func (t *transport) evict() {
+	entries, err := os.ReadDir(t.dir)
+	if err != nil {
+		return
+	}
+
+	type entry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	files := make([]entry, 0, len(entries))
+	var total int64
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != entryExt {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, entry{
+			path:    filepath.Join(t.dir, e.Name()),
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		})
+		total += info.Size()
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+	for _, f := range files {
+		if total <= t.cache.maxBytes {
+			return
+		}
+		if err := os.Remove(f.path); err == nil {
+			total -= f.size
+		}
+	}
+}
+
+// writeFile atomically replaces path with data.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// hashKey returns a filesystem-safe name for an arbitrary key.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
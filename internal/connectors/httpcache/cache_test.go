@@ -0,0 +1,199 @@
+package httpcache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// etagServer serves body with an ETag and answers matching conditional requests with 304.
+func etagServer(t *testing.T, body string, hits, notModified *int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func get(t *testing.T, client *http.Client, url string) (*http.Response, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(data)
+}
+
+func TestTransport_RevalidatesWithETag(t *testing.T) {
+	var hits, notModified int32
+	srv := etagServer(t, "hello", &hits, &notModified)
+	cache := New(t.TempDir(), 1<<20)
+	client := &http.Client{Transport: cache.Transport("src-1", nil)}
+
+	resp, body := get(t, client, srv.URL+"/repo")
+	assert.Equal(t, "hello", body)
+	assert.Empty(t, resp.Header.Get(HeaderFromCache))
+
+	resp, body = get(t, client, srv.URL+"/repo")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, "1", resp.Header.Get(HeaderFromCache))
+	assert.Equal(t, "4999", resp.Header.Get("X-RateLimit-Remaining"))
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+}
+
+func TestTransport_LastModified(t *testing.T) {
+	const modified = "Wed, 21 Oct 2015 07:28:00 GMT"
+	var notModified int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == modified {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", modified)
+		_, _ = io.WriteString(w, "doc")
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: New(t.TempDir(), 1<<20).Transport("src", nil)}
+
+	get(t, client, srv.URL)
+	_, body := get(t, client, srv.URL)
+
+	assert.Equal(t, "doc", body)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+}
+
+func TestTransport_ChangedResourceReplacesEntry(t *testing.T) {
+	version := "v1"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"` + version + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = io.WriteString(w, "body-"+version)
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: New(t.TempDir(), 1<<20).Transport("src", nil)}
+
+	get(t, client, srv.URL)
+	version = "v2"
+	_, body := get(t, client, srv.URL)
+	assert.Equal(t, "body-v2", body)
+
+	resp, body := get(t, client, srv.URL)
+	assert.Equal(t, "body-v2", body)
+	assert.Equal(t, "1", resp.Header.Get(HeaderFromCache))
+}
+
+func TestTransport_SourcesAreIsolated(t *testing.T) {
+	var hits, notModified int32
+	srv := etagServer(t, "secret", &hits, &notModified)
+	cache := New(t.TempDir(), 1<<20)
+
+	get(t, &http.Client{Transport: cache.Transport("src-a", nil)}, srv.URL)
+	resp, _ := get(t, &http.Client{Transport: cache.Transport("src-b", nil)}, srv.URL)
+
+	assert.Empty(t, resp.Header.Get(HeaderFromCache))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&notModified))
+}
+
+func TestTransport_SkipsUncacheableRequests(t *testing.T) {
+	var hits, notModified int32
+	srv := etagServer(t, "hello", &hits, &notModified)
+	client := &http.Client{Transport: New(t.TempDir(), 1<<20).Transport("src", nil)}
+
+	for range 2 {
+		resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("x"))
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&notModified))
+}
+
+func TestTransport_NoValidatorNotStored(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "plain")
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+	client := &http.Client{Transport: New(dir, 1<<20).Transport("src", nil)}
+
+	_, body := get(t, client, srv.URL)
+
+	assert.Equal(t, "plain", body)
+	entries, _ := filepath.Glob(filepath.Join(dir, "*", "*"+entryExt))
+	assert.Empty(t, entries)
+}
+
+func TestTransport_LargeBodyStreamedNotStored(t *testing.T) {
+	large := strings.Repeat("x", 2048)
+	var hits, notModified int32
+	srv := etagServer(t, large, &hits, &notModified)
+	dir := t.TempDir()
+	client := &http.Client{Transport: New(dir, 1024).Transport("src", nil)}
+
+	_, body := get(t, client, srv.URL)
+
+	assert.Equal(t, large, body)
+	entries, _ := filepath.Glob(filepath.Join(dir, "*", "*"+entryExt))
+	assert.Empty(t, entries)
+}
+
+func TestTransport_EvictsToBound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"`+r.URL.Path+`"`)
+		_, _ = io.WriteString(w, strings.Repeat("y", 400))
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+	const bound = 1500
+	client := &http.Client{Transport: New(dir, bound).Transport("src", nil)}
+
+	for _, path := range []string{"/a", "/b", "/c", "/d", "/e"} {
+		get(t, client, srv.URL+path)
+	}
+
+	entries, err := filepath.Glob(filepath.Join(dir, "*", "*"+entryExt))
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	var total int64
+	for _, e := range entries {
+		info, err := os.Stat(e)
+		require.NoError(t, err)
+		total += info.Size()
+	}
+	assert.LessOrEqual(t, total, int64(bound))
+	assert.Less(t, len(entries), 5)
+}
+
+func TestTransport_DisabledReturnsBase(t *testing.T) {
+	base := http.DefaultTransport
+	assert.Equal(t, base, New(t.TempDir(), 0).Transport("src", base))
+
+	var nilCache *Cache
+	assert.Equal(t, base, nilCache.Transport("src", nil))
+}
@@ -0,0 +1,17 @@
+// Package httpcache provides an on-disk HTTP cache for connector GET requests.
+//
+// Responses carrying an ETag or Last-Modified validator are stored per source.
+// Later requests for the same resource are sent as conditional requests
+// (If-None-Match / If-Modified-Since); a 304 Not Modified reply is answered
+// from disk, so unchanged bodies are not downloaded again. GitHub does not
+// count conditional requests that return 304 against the rate limit.
+//
+// Usage:
+//
+//	cache := httpcache.New(dir, 100<<20)
+//	client := &http.Client{Transport: cache.Transport(sourceID, nil)}
+//
+// Each source has its own directory, bounded to the configured size by
+// evicting the least recently used entries, so cached responses never leak
+// between sources.
+package httpcache
@@ -115,13 +115,19 @@ func (h *OAuthHandler) RefreshToken(
 	}, nil
 }
 
-// GetUserInfo fetches the user's email from Microsoft Graph.
-func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (string, error) {
+// GetUserInfo fetches the user's account details from Microsoft Graph.
+// The identifier is the user's email.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error) {
 	userInfo, err := GetUserInfo(ctx, accessToken)
 	if err != nil {
-		return "", err
+		return domain.AccountInfo{}, err
 	}
-	return userInfo.GetUserEmail(), nil
+	email := userInfo.GetUserEmail()
+	return domain.AccountInfo{
+		Identifier:  email,
+		DisplayName: userInfo.DisplayName,
+		Email:       email,
+	}, nil
 }
 
 // DefaultConfig returns default OAuth URLs and scopes for Microsoft.
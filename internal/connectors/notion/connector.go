@@ -467,7 +467,7 @@ func (c *Connector) GetAccountIdentifier(ctx context.Context, accessToken string
 	if err != nil {
 		return "", err
 	}
-	return userInfo.Email, nil
+	return userInfo.Identifier(), nil
 }
 
 // Close releases resources.
@@ -109,13 +109,23 @@ func (h *OAuthHandler) RefreshToken(
 	}, nil
 }
 
-// GetUserInfo fetches the user's email from Notion.
-func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (string, error) {
+// GetUserInfo fetches the user's account details from Notion.
+// The identifier is the owner's email, or the bot name if no email is available.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error) {
 	userInfo, err := GetUserInfo(ctx, accessToken)
 	if err != nil {
-		return "", err
+		return domain.AccountInfo{}, err
 	}
-	return userInfo.Email, nil
+	info := domain.AccountInfo{
+		Identifier: userInfo.Identifier(),
+		Email:      userInfo.Email,
+		AvatarURL:  userInfo.AvatarURL,
+	}
+	if userInfo.Email == "" {
+		// Workspace-owned integrations have no owner email; show the bot's name
+		info.DisplayName = userInfo.Name
+	}
+	return info, nil
 }
 
 // DefaultConfig returns default OAuth URLs for Notion.
@@ -176,6 +186,15 @@ type UserInfo struct {
 	Email     string `json:"-"` // Extracted from person.email
 }
 
+// Identifier returns the owner's email, or the bot name when the integration
+// has no owning user.
+func (u *UserInfo) Identifier() string {
+	if u.Email != "" {
+		return u.Email
+	}
+	return u.Name
+}
+
 // GetUserInfo fetches Notion user information using the bot API.
 func GetUserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
 	// Notion doesn't have a dedicated "get current user" endpoint for OAuth
@@ -226,18 +245,12 @@ func GetUserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
 		return nil, fmt.Errorf("decode user info: %w", err)
 	}
 
-	// Extract email from bot owner (user)
-	email := botInfo.Bot.Owner.User.Person.Email
-	if email == "" {
-		// Fallback to using the workspace name or bot ID as identifier
-		email = botInfo.Name
-	}
-
+	// Email of the bot owner; empty for workspace-owned integrations
 	return &UserInfo{
 		Object: botInfo.Object,
 		ID:     botInfo.ID,
 		Name:   botInfo.Name,
-		Email:  email,
+		Email:  botInfo.Bot.Owner.User.Person.Email,
 	}, nil
 }
 
@@ -23,9 +23,9 @@ type OAuthHandler interface {
 	// RefreshToken refreshes an expired access token using a refresh token.
 	RefreshToken(ctx context.Context, authProvider *domain.AuthProvider, refreshToken string) (*domain.OAuthToken, error)
 
-	// GetUserInfo fetches the account identifier (email/username) from the provider.
+	// GetUserInfo fetches the authenticated account's details from the provider.
 	// Used to identify which account was authenticated.
-	GetUserInfo(ctx context.Context, accessToken string) (string, error)
+	GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error)
 
 	// DefaultConfig returns default OAuth URLs and scopes for this provider.
 	// Used when creating auth providers to suggest defaults.
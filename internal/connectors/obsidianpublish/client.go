@@ -0,0 +1,107 @@
+package obsidianpublish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// cachePath is the site file listing every published file and its hash.
+const cachePath = "/cache.json"
+
+// maxFileSize bounds a single fetched note.
+const maxFileSize = 10 << 20
+
+// Error types for Obsidian Publish responses.
+var (
+	// ErrNotFound indicates the site or file does not exist.
+	ErrNotFound = errors.New("obsidian-publish: not found")
+	// ErrAccessDenied indicates the site is password protected.
+	ErrAccessDenied = errors.New("obsidian-publish: access denied")
+)
+
+// CacheEntry describes a published file in cache.json.
+type CacheEntry struct {
+	// Hash changes whenever the file content changes.
+	Hash string `json:"hash"`
+}
+
+// Client fetches files from an Obsidian Publish site.
+type Client struct {
+	siteURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the site at siteURL.
+func NewClient(siteURL string) *Client {
+	return &Client{
+		siteURL:    siteURL,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// Cache returns the published files keyed by vault path.
+func (c *Client) Cache(ctx context.Context) (map[string]CacheEntry, error) {
+	body, err := c.get(ctx, cachePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var cache map[string]CacheEntry
+	if err := json.Unmarshal(body, &cache); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", cachePath, err)
+	}
+	return cache, nil
+}
+
+// File returns the Markdown source of a published file.
+func (c *Client) File(ctx context.Context, filePath string) ([]byte, error) {
+	return c.get(ctx, "/"+escapePath(filePath))
+}
+
+// get performs a GET request relative to the site URL.
+func (c *Client) get(ctx context.Context, reqPath string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.siteURL+reqPath, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s: %w", reqPath, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", reqPath, ErrNotFound)
+	case resp.StatusCode == http.StatusUnauthorized, resp.StatusCode == http.StatusForbidden:
+		return nil, ErrAccessDenied
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("request %s failed: status %d", reqPath, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if len(body) > maxFileSize {
+		return nil, fmt.Errorf("%s exceeds %d bytes", reqPath, maxFileSize)
+	}
+	return body, nil
+}
+
+// escapePath escapes each segment of a vault path for use in a URL.
+func escapePath(filePath string) string {
+	segments := strings.Split(filePath, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
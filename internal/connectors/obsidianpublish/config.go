@@ -0,0 +1,56 @@
+package obsidianpublish
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ErrMissingSiteURL indicates the source has no site URL configured.
+var ErrMissingSiteURL = errors.New("obsidian-publish: site_url is required")
+
+// Config holds Obsidian Publish connector configuration.
+type Config struct {
+	// SiteURL is the root URL of the published site, without a trailing slash.
+	SiteURL string
+	// SiteID identifies the site in document URIs.
+	SiteID string
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	raw := strings.TrimSpace(source.Config["site_url"])
+	if raw == "" {
+		return nil, ErrMissingSiteURL
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("obsidian-publish: invalid site_url %q", raw)
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	u.Path = strings.TrimRight(u.Path, "/")
+
+	cfg := &Config{
+		SiteURL: u.String(),
+		SiteID:  strings.TrimSpace(source.Config["site_id"]),
+	}
+	if cfg.SiteID == "" {
+		cfg.SiteID = defaultSiteID(u)
+	}
+	return cfg, nil
+}
+
+// defaultSiteID derives a site ID from the URL. Sites hosted on
+// publish.obsidian.md are identified by their slug, custom domains by host.
+func defaultSiteID(u *url.URL) string {
+	if u.Path != "" {
+		return path.Base(u.Path)
+	}
+	return u.Host
+}
@@ -0,0 +1,59 @@
+package obsidianpublish
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]string
+		siteURL string
+		siteID  string
+	}{
+		{
+			name:    "obsidian hosted",
+			config:  map[string]string{"site_url": "https://publish.obsidian.md/my-notes/"},
+			siteURL: "https://publish.obsidian.md/my-notes",
+			siteID:  "my-notes",
+		},
+		{
+			name:    "custom domain",
+			config:  map[string]string{"site_url": " https://notes.example.com?x=1#top "},
+			siteURL: "https://notes.example.com",
+			siteID:  "notes.example.com",
+		},
+		{
+			name:    "explicit site id",
+			config:  map[string]string{"site_url": "https://notes.example.com", "site_id": "garden"},
+			siteURL: "https://notes.example.com",
+			siteID:  "garden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseConfig(domain.Source{Config: tt.config})
+			require.NoError(t, err)
+			assert.Equal(t, tt.siteURL, cfg.SiteURL)
+			assert.Equal(t, tt.siteID, cfg.SiteID)
+		})
+	}
+}
+
+func TestParseConfig_MissingSiteURL(t *testing.T) {
+	_, err := ParseConfig(domain.Source{Config: map[string]string{}})
+	assert.ErrorIs(t, err, ErrMissingSiteURL)
+}
+
+func TestParseConfig_InvalidSiteURL(t *testing.T) {
+	for _, raw := range []string{"publish.obsidian.md/notes", "ftp://example.com", "https://"} {
+		_, err := ParseConfig(domain.Source{Config: map[string]string{"site_url": raw}})
+		assert.Error(t, err, raw)
+	}
+}
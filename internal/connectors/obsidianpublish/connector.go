@@ -0,0 +1,308 @@
+package obsidianpublish
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Connector fetches published notes from an Obsidian Publish site.
+type Connector struct {
+	sourceID string
+	config   *Config
+	client   *Client
+	mu       sync.Mutex
+	closed   bool
+}
+
+// New creates a new Obsidian Publish connector.
+func New(sourceID string, cfg *Config) *Connector {
+	return &Connector{
+		sourceID: sourceID,
+		config:   cfg,
+		client:   NewClient(cfg.SiteURL),
+	}
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "obsidian-publish"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    false,
+		SupportsBinary:       false,
+		RequiresAuth:         false,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: false,
+		SupportsPagination:   false,
+	}
+}
+
+// Validate checks that the site publishes a readable cache.json.
+func (c *Connector) Validate(ctx context.Context) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, err := c.client.Cache(ctx); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%s is not an Obsidian Publish site: %w", c.config.SiteURL, err)
+		}
+		return fmt.Errorf("read site cache: %w", err)
+	}
+	return nil
+}
+
+// FullSync fetches every published note.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	notes, err := c.listNotes(ctx)
+	if err != nil {
+		return err
+	}
+
+	cursor := NewCursor()
+	for _, note := range slices.Sorted(maps.Keys(notes)) {
+		doc, err := c.fetchNote(ctx, note)
+		if err != nil {
+			return err
+		}
+		if err := c.sendDocument(ctx, docsChan, doc); err != nil {
+			return err
+		}
+		cursor.Hashes[note] = notes[note].Hash
+	}
+
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches notes whose hash changed since the last sync
+// and reports notes no longer in cache.json as deleted.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	cursor, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+	if cursor.IsEmpty() {
+		return fmt.Errorf("invalid cursor, full sync required: cursor has no files")
+	}
+
+	notes, err := c.listNotes(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := NewCursor()
+	for _, note := range slices.Sorted(maps.Keys(notes)) {
+		hash := notes[note].Hash
+		previous, seen := cursor.Hashes[note]
+		if seen && previous == hash {
+			next.Hashes[note] = hash
+			continue
+		}
+
+		doc, err := c.fetchNote(ctx, note)
+		if err != nil {
+			return err
+		}
+		changeType := domain.ChangeUpdated
+		if !seen {
+			changeType = domain.ChangeCreated
+		}
+		change := &domain.RawDocumentChange{Type: changeType, Document: *doc}
+		if err := c.sendChange(ctx, changesChan, change); err != nil {
+			return err
+		}
+		next.Hashes[note] = hash
+	}
+
+	for _, note := range slices.Sorted(maps.Keys(cursor.Hashes)) {
+		if _, ok := notes[note]; ok {
+			continue
+		}
+		change := &domain.RawDocumentChange{
+			Type: domain.ChangeDeleted,
+			Document: domain.RawDocument{
+				SourceID: c.sourceID,
+				URI:      NoteURI(c.config.SiteID, note),
+			},
+		}
+		if err := c.sendChange(ctx, changesChan, change); err != nil {
+			return err
+		}
+	}
+
+	return &driven.SyncComplete{NewCursor: next.Encode()}
+}
+
+// listNotes returns the Markdown files in the site cache.
+// Attachments such as images and PDFs are skipped.
+func (c *Connector) listNotes(ctx context.Context) (map[string]CacheEntry, error) {
+	cache, err := c.client.Cache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read site cache: %w", err)
+	}
+
+	notes := make(map[string]CacheEntry, len(cache))
+	for filePath, entry := range cache {
+		if strings.EqualFold(path.Ext(filePath), ".md") {
+			notes[filePath] = entry
+		}
+	}
+	return notes, nil
+}
+
+// fetchNote downloads a note and converts it to a raw document.
+func (c *Connector) fetchNote(ctx context.Context, notePath string) (*domain.RawDocument, error) {
+	content, err := c.client.File(ctx, notePath)
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", notePath, err)
+	}
+
+	return &domain.RawDocument{
+		SourceID: c.sourceID,
+		URI:      NoteURI(c.config.SiteID, notePath),
+		MIMEType: "text/markdown",
+		Content:  content,
+		Metadata: map[string]any{
+			"path": notePath,
+			"site": c.config.SiteURL,
+			"url":  c.config.SiteURL + "/" + escapePath(noteSlug(notePath)),
+		},
+	}, nil
+}
+
+// NoteURI builds the document URI for a note.
+// URI format: obsidian-publish://{siteID}/{slug}.
+func NoteURI(siteID, notePath string) string {
+	return fmt.Sprintf("obsidian-publish://%s/%s", siteID, noteSlug(notePath))
+}
+
+// noteSlug converts a vault path to the slug Obsidian Publish serves it
+// under: the extension is dropped and spaces become plus signs.
+func noteSlug(notePath string) string {
+	slug := strings.TrimSuffix(notePath, path.Ext(notePath))
+	return strings.ReplaceAll(slug, " ", "+")
+}
+
+// sendDocument sends a document to the channel.
+func (c *Connector) sendDocument(
+	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case docsChan <- *doc:
+		return nil
+	}
+}
+
+// sendChange sends a change to the channel.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	change *domain.RawDocumentChange,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- *change:
+		return nil
+	}
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported; published sites have no change notifications.
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier returns empty string as published sites have no account.
+func (c *Connector) GetAccountIdentifier(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
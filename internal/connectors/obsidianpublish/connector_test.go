@@ -0,0 +1,191 @@
+package obsidianpublish
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// fakeSite serves a minimal Obsidian Publish site from in-memory files.
+type fakeSite struct {
+	mu      sync.Mutex
+	files   map[string]string
+	hashes  map[string]string
+	fetched []string
+}
+
+func (f *fakeSite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == cachePath {
+		cache := make(map[string]CacheEntry, len(f.files))
+		for p := range f.files {
+			cache[p] = CacheEntry{Hash: f.hashes[p]}
+		}
+		_ = json.NewEncoder(w).Encode(cache)
+		return
+	}
+
+	filePath := strings.TrimPrefix(r.URL.Path, "/")
+	content, ok := f.files[filePath]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	f.fetched = append(f.fetched, filePath)
+	_, _ = w.Write([]byte(content))
+}
+
+func newTestSite(t *testing.T) (*fakeSite, *Connector) {
+	t.Helper()
+	site := &fakeSite{
+		files: map[string]string{
+			"Welcome.md":           "# Welcome\n\nSee [[Daily/Day One]].",
+			"Daily/Day One.md":     "---\ntags: journal\n---\nFirst entry.",
+			"attachments/logo.png": "PNG",
+		},
+		hashes: map[string]string{
+			"Welcome.md":           "h1",
+			"Daily/Day One.md":     "h2",
+			"attachments/logo.png": "h3",
+		},
+	}
+	server := httptest.NewServer(site)
+	t.Cleanup(server.Close)
+
+	return site, New("src-1", &Config{SiteURL: server.URL, SiteID: "garden"})
+}
+
+// collectDocs drains a full sync, returning the documents and the sync error.
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var result []domain.RawDocument
+	for doc := range docs {
+		result = append(result, doc)
+	}
+	return result, <-errs
+}
+
+// collectChanges drains an incremental sync, returning the changes and the sync error.
+func collectChanges(changes <-chan domain.RawDocumentChange, errs <-chan error) ([]domain.RawDocumentChange, error) {
+	var result []domain.RawDocumentChange
+	for change := range changes {
+		result = append(result, change)
+	}
+	return result, <-errs
+}
+
+func TestConnector_Metadata(t *testing.T) {
+	c := New("src-1", &Config{SiteURL: "https://example.com", SiteID: "example.com"})
+
+	assert.Equal(t, "obsidian-publish", c.Type())
+	assert.Equal(t, "src-1", c.SourceID())
+	assert.False(t, c.Capabilities().RequiresAuth)
+	assert.True(t, c.Capabilities().SupportsIncremental)
+
+	_, err := c.Watch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+
+	id, err := c.GetAccountIdentifier(context.Background(), "")
+	require.NoError(t, err)
+	assert.Empty(t, id)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	_, c := newTestSite(t)
+	require.NoError(t, c.Validate(context.Background()))
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	missing := New("src-1", &Config{SiteURL: server.URL, SiteID: "x"})
+
+	err := missing.Validate(context.Background())
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "not an Obsidian Publish site")
+}
+
+func TestConnector_FullSync(t *testing.T) {
+	site, c := newTestSite(t)
+
+	docs, err := collectDocs(c.FullSync(context.Background()))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, docs, 2)
+	assert.Equal(t, []string{"Daily/Day One.md", "Welcome.md"}, site.fetched)
+
+	doc := docs[0]
+	assert.Equal(t, "src-1", doc.SourceID)
+	assert.Equal(t, "obsidian-publish://garden/Daily/Day+One", doc.URI)
+	assert.Equal(t, "text/markdown", doc.MIMEType)
+	assert.Equal(t, "---\ntags: journal\n---\nFirst entry.", string(doc.Content))
+	assert.Equal(t, "Daily/Day One.md", doc.Metadata["path"])
+	assert.Equal(t, c.config.SiteURL+"/Daily/Day+One", doc.Metadata["url"])
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Daily/Day One.md": "h2", "Welcome.md": "h1"}, cursor.Hashes)
+}
+
+func TestConnector_IncrementalSync(t *testing.T) {
+	site, c := newTestSite(t)
+	ctx := context.Background()
+
+	_, err := collectDocs(c.FullSync(ctx))
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+
+	// Edit one note, add one and remove one
+	site.mu.Lock()
+	site.hashes["Welcome.md"] = "h1-edited"
+	site.files["Ideas.md"] = "# Ideas"
+	site.hashes["Ideas.md"] = "h4"
+	delete(site.files, "Daily/Day One.md")
+	site.fetched = nil
+	site.mu.Unlock()
+
+	changes, err := collectChanges(c.IncrementalSync(ctx, domain.SyncState{Cursor: complete.NewCursor}))
+
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 3)
+	assert.Equal(t, []string{"Ideas.md", "Welcome.md"}, site.fetched)
+
+	assert.Equal(t, domain.ChangeCreated, changes[0].Type)
+	assert.Equal(t, "obsidian-publish://garden/Ideas", changes[0].Document.URI)
+	assert.Equal(t, domain.ChangeUpdated, changes[1].Type)
+	assert.Equal(t, "obsidian-publish://garden/Welcome", changes[1].Document.URI)
+	assert.Equal(t, domain.ChangeDeleted, changes[2].Type)
+	assert.Equal(t, "obsidian-publish://garden/Daily/Day+One", changes[2].Document.URI)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Ideas.md": "h4", "Welcome.md": "h1-edited"}, cursor.Hashes)
+}
+
+func TestConnector_IncrementalSync_RequiresCursor(t *testing.T) {
+	_, c := newTestSite(t)
+
+	_, err := collectChanges(c.IncrementalSync(context.Background(), domain.SyncState{}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "full sync required")
+}
+
+func TestConnector_Closed(t *testing.T) {
+	_, c := newTestSite(t)
+	require.NoError(t, c.Close())
+
+	assert.ErrorIs(t, c.Validate(context.Background()), domain.ErrConnectorClosed)
+	_, err := collectDocs(c.FullSync(context.Background()))
+	assert.ErrorIs(t, err, domain.ErrConnectorClosed)
+}
@@ -0,0 +1,69 @@
+package obsidianpublish
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor stores the hash of every file seen by the last sync.
+// cache.json carries a hash per file, so an incremental sync only fetches
+// files whose hash changed and reports missing files as deleted.
+type Cursor struct {
+	Version int               `json:"v"`
+	Hashes  map[string]string `json:"hashes"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version: CursorVersion,
+		Hashes:  make(map[string]string),
+	}
+}
+
+// Encode serialises the cursor to a base64 string.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Hashes == nil {
+		cursor.Hashes = make(map[string]string)
+	}
+
+	return &cursor, nil
+}
+
+// IsEmpty returns true if the cursor has no recorded files.
+func (c *Cursor) IsEmpty() bool {
+	return len(c.Hashes) == 0
+}
@@ -0,0 +1,37 @@
+package obsidianpublish
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := NewCursor()
+	cursor.Hashes["Notes/A.md"] = "abc"
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	assert.Equal(t, CursorVersion, decoded.Version)
+	assert.Equal(t, map[string]string{"Notes/A.md": "abc"}, decoded.Hashes)
+	assert.False(t, decoded.IsEmpty())
+}
+
+func TestDecodeCursor_Empty(t *testing.T) {
+	cursor, err := DecodeCursor("")
+
+	require.NoError(t, err)
+	assert.True(t, cursor.IsEmpty())
+	assert.NotNil(t, cursor.Hashes)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	_, err := DecodeCursor("not base64!")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	future := &Cursor{Version: CursorVersion + 1}
+	_, err = DecodeCursor(future.Encode())
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,6 @@
+// Package obsidianpublish provides a Connector for notes published with
+// Obsidian Publish. It reads the site's cache.json to list the published
+// files and fetches each one as Markdown, so a published vault can be
+// indexed without a local copy. Local vaults are indexed with the
+// filesystem connector instead.
+package obsidianpublish
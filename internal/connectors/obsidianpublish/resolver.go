@@ -0,0 +1,10 @@
+package obsidianpublish
+
+// ResolveWebURL returns the published page for an obsidian-publish:// URI.
+// URI format: obsidian-publish://{siteID}/{slug}.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	if u, ok := metadata["url"].(string); ok && u != "" {
+		return u
+	}
+	return uri
+}
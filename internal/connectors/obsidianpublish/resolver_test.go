@@ -0,0 +1,15 @@
+package obsidianpublish
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveWebURL(t *testing.T) {
+	uri := "obsidian-publish://my-notes/Daily/Today"
+
+	assert.Equal(t, "https://publish.obsidian.md/my-notes/Daily/Today",
+		ResolveWebURL(uri, map[string]any{"url": "https://publish.obsidian.md/my-notes/Daily/Today"}))
+	assert.Equal(t, uri, ResolveWebURL(uri, nil))
+}
@@ -0,0 +1,293 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+const (
+	// apiBaseURL is the Slack Web API base URL.
+	apiBaseURL = "https://slack.com/api"
+
+	// pageSize is the number of items requested per page. Slack recommends
+	// no more than 200.
+	pageSize = 200
+
+	// maxRetries is how often a rate-limited request is retried.
+	maxRetries = 3
+)
+
+// Error types for Slack API responses.
+var (
+	// ErrUnauthorised indicates the token is invalid, revoked or belongs to
+	// a deactivated account.
+	ErrUnauthorised = errors.New("slack: unauthorised")
+	// ErrTokenExpired indicates a rotating token expired without a refresh.
+	ErrTokenExpired = errors.New("slack: token expired")
+	// ErrMissingScope indicates the token lacks a scope the method needs.
+	ErrMissingScope = errors.New("slack: missing scope")
+	// ErrNotInChannel indicates the bot is not a member of the conversation.
+	ErrNotInChannel = errors.New("slack: not in channel")
+	// ErrNotFound indicates the requested conversation or thread does not exist.
+	ErrNotFound = errors.New("slack: not found")
+	// ErrRateLimited indicates the request was still throttled after retrying.
+	ErrRateLimited = errors.New("slack: rate limited")
+)
+
+// apiErrors maps Slack error codes to error types.
+var apiErrors = map[string]error{
+	"invalid_auth":      ErrUnauthorised,
+	"not_authed":        ErrUnauthorised,
+	"token_revoked":     ErrUnauthorised,
+	"account_inactive":  ErrUnauthorised,
+	"token_expired":     ErrTokenExpired,
+	"missing_scope":     ErrMissingScope,
+	"not_in_channel":    ErrNotInChannel,
+	"channel_not_found": ErrNotFound,
+	"thread_not_found":  ErrNotFound,
+	"ratelimited":       ErrRateLimited,
+}
+
+// Client is a minimal Slack Web API client.
+type Client struct {
+	baseURL       string
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	rateLimiter   *RateLimiter
+}
+
+// NewClient creates a Slack client for the given token provider.
+func NewClient(tokenProvider driven.TokenProvider) *Client {
+	return &Client{
+		baseURL:       apiBaseURL,
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 60 * time.Second},
+		rateLimiter:   NewRateLimiter(),
+	}
+}
+
+// AuthTest returns the workspace and user the token belongs to.
+func (c *Client) AuthTest(ctx context.Context) (*AuthInfo, error) {
+	var info AuthInfo
+	if err := c.call(ctx, "auth.test", nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// Conversations returns the unarchived conversations of the given types
+// visible to the token.
+func (c *Client) Conversations(ctx context.Context, types []string) ([]Conversation, error) {
+	var all []Conversation
+	query := url.Values{
+		"types":            {strings.Join(types, ",")},
+		"exclude_archived": {"true"},
+		"limit":            {strconv.Itoa(pageSize)},
+	}
+	err := c.paginate(ctx, "conversations.list", query, func(body []byte) error {
+		var resp struct {
+			Channels []Conversation `json:"channels"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+		all = append(all, resp.Channels...)
+		return nil
+	})
+	return all, err
+}
+
+// Conversation returns a single conversation by ID.
+func (c *Client) Conversation(ctx context.Context, channelID string) (*Conversation, error) {
+	var resp struct {
+		Channel Conversation `json:"channel"`
+	}
+	if err := c.call(ctx, "conversations.info", url.Values{"channel": {channelID}}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Channel, nil
+}
+
+// Users returns the members of the workspace.
+func (c *Client) Users(ctx context.Context) ([]User, error) {
+	var all []User
+	query := url.Values{"limit": {strconv.Itoa(pageSize)}}
+	err := c.paginate(ctx, "users.list", query, func(body []byte) error {
+		var resp struct {
+			Members []User `json:"members"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+		all = append(all, resp.Members...)
+		return nil
+	})
+	return all, err
+}
+
+// History calls send with each page of a conversation's messages newer than
+// oldest, newest first. An empty oldest reads the whole history.
+func (c *Client) History(
+	ctx context.Context, channelID, oldest string, send func([]Message) error,
+) error {
+	query := url.Values{"channel": {channelID}, "limit": {strconv.Itoa(pageSize)}}
+	if oldest != "" {
+		query.Set("oldest", oldest)
+	}
+	return c.paginate(ctx, "conversations.history", query, func(body []byte) error {
+		return sendMessages(body, send)
+	})
+}
+
+// Replies calls send with each page of a thread, oldest first. The first
+// message of the first page is the thread's parent.
+func (c *Client) Replies(
+	ctx context.Context, channelID, threadTS string, send func([]Message) error,
+) error {
+	query := url.Values{"channel": {channelID}, "ts": {threadTS}, "limit": {strconv.Itoa(pageSize)}}
+	return c.paginate(ctx, "conversations.replies", query, func(body []byte) error {
+		return sendMessages(body, send)
+	})
+}
+
+// sendMessages decodes a page of messages and passes it to send.
+func sendMessages(body []byte, send func([]Message) error) error {
+	var resp struct {
+		Messages []Message `json:"messages"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return err
+	}
+	return send(resp.Messages)
+}
+
+// paginate calls a cursor-paginated method until the last page, passing
+// each response body to page.
+func (c *Client) paginate(
+	ctx context.Context, method string, query url.Values, page func(body []byte) error,
+) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var resp struct {
+			ResponseMetadata struct {
+				NextCursor string `json:"next_cursor"`
+			} `json:"response_metadata"`
+		}
+		var body json.RawMessage
+		if err := c.call(ctx, method, query, &body); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return fmt.Errorf("decode %s response: %w", method, err)
+		}
+		if err := page(body); err != nil {
+			return err
+		}
+
+		if resp.ResponseMetadata.NextCursor == "" {
+			return nil
+		}
+		query.Set("cursor", resp.ResponseMetadata.NextCursor)
+	}
+}
+
+// call performs an authenticated Web API call and decodes the JSON response
+// into out. Rate-limited requests are retried after the wait Slack asks for.
+func (c *Client) call(ctx context.Context, method string, query url.Values, out any) error {
+	token, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+	// Accept tokens pasted with the scheme
+	token = strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+
+	reqURL := c.baseURL + "/" + method
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.rateLimiter.Wait(ctx, method); err != nil {
+			return err
+		}
+
+		status, body, retryAfter, err := c.do(ctx, reqURL, token)
+		if err != nil {
+			return fmt.Errorf("request %s: %w", method, err)
+		}
+
+		if status == http.StatusTooManyRequests {
+			c.rateLimiter.RecordRateLimitError(retryAfter)
+			if attempt < maxRetries {
+				continue
+			}
+			return ErrRateLimited
+		}
+		if status != http.StatusOK {
+			return fmt.Errorf("request %s failed: status %d", method, status)
+		}
+
+		// Slack reports errors in the body of a 200 response
+		var result struct {
+			OK    bool   `json:"ok"`
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			return fmt.Errorf("decode %s response: %w", method, err)
+		}
+		if !result.OK {
+			if known, ok := apiErrors[result.Error]; ok {
+				return fmt.Errorf("%s: %w", method, known)
+			}
+			return fmt.Errorf("%s failed: %s", method, result.Error)
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("decode %s response: %w", method, err)
+		}
+		return nil
+	}
+}
+
+// do sends a single request, returning the status, body and any rate limit wait.
+func (c *Client) do(
+	ctx context.Context, reqURL, token string,
+) (status int, body []byte, retryAfter time.Duration, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, 0, fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs > 0 {
+			retryAfter = time.Duration(secs) * time.Second
+		}
+	}
+	return resp.StatusCode, body, retryAfter, nil
+}
@@ -0,0 +1,143 @@
+package slack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Conversation types accepted by conversations.list.
+const (
+	TypePublicChannel  = "public_channel"
+	TypePrivateChannel = "private_channel"
+	TypeGroupDM        = "mpim"
+	TypeDM             = "im"
+)
+
+// DefaultRescanDays is how many days of recent messages incremental syncs
+// read again to find edits, deletions and new thread replies.
+const DefaultRescanDays = 7
+
+// allConversationTypes lists every conversation type, the default.
+var allConversationTypes = []string{TypePublicChannel, TypePrivateChannel, TypeGroupDM, TypeDM}
+
+// Config holds Slack connector configuration.
+type Config struct {
+	// ChannelIDs limits syncing to specific conversations. If empty, every
+	// conversation of ConversationTypes the bot is a member of is synced.
+	ChannelIDs []string
+	// ConversationTypes lists the conversation types to sync.
+	ConversationTypes []string
+	// IncludeThreads also syncs thread replies.
+	IncludeThreads bool
+	// MaxAgeDays skips messages older than this many days. Zero means no limit.
+	MaxAgeDays int
+	// RescanDays is how many days of recent messages incremental syncs read
+	// again to find edits, deletions and new thread replies. Zero disables it.
+	RescanDays int
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		ConversationTypes: allConversationTypes,
+		IncludeThreads:    true,
+		RescanDays:        DefaultRescanDays,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse channel_ids
+	cfg.ChannelIDs = splitList(source.Config["channel_ids"])
+
+	// Parse conversation_types
+	if types := splitList(source.Config["conversation_types"]); len(types) > 0 {
+		for _, t := range types {
+			if !isConversationType(t) {
+				return nil, fmt.Errorf("slack: invalid conversation type %q (use %s)",
+					t, strings.Join(allConversationTypes, ", "))
+			}
+		}
+		cfg.ConversationTypes = types
+	}
+
+	// Parse include_threads
+	if val := source.Config["include_threads"]; val != "" {
+		cfg.IncludeThreads = parseBool(val)
+	}
+
+	// Parse max_age_days
+	if val := strings.TrimSpace(source.Config["max_age_days"]); val != "" {
+		days, err := strconv.Atoi(val)
+		if err != nil || days < 0 {
+			return nil, fmt.Errorf("slack: invalid max_age_days %q", val)
+		}
+		cfg.MaxAgeDays = days
+	}
+
+	// Parse rescan_days
+	if val := strings.TrimSpace(source.Config["rescan_days"]); val != "" {
+		days, err := strconv.Atoi(val)
+		if err != nil || days < 0 {
+			return nil, fmt.Errorf("slack: invalid rescan_days %q", val)
+		}
+		cfg.RescanDays = days
+	}
+
+	return cfg, nil
+}
+
+// Cutoff returns the time before which messages are skipped,
+// or the zero time when there is no age limit.
+func (c *Config) Cutoff(now time.Time) time.Time {
+	if c.MaxAgeDays == 0 {
+		return time.Time{}
+	}
+	return now.AddDate(0, 0, -c.MaxAgeDays)
+}
+
+// RescanStart returns the time from which incremental syncs reread messages,
+// or the zero time when rescanning is disabled. It is never before the cutoff.
+func (c *Config) RescanStart(now time.Time) time.Time {
+	if c.RescanDays == 0 {
+		return time.Time{}
+	}
+	start := now.AddDate(0, 0, -c.RescanDays)
+	if cutoff := c.Cutoff(now); start.Before(cutoff) {
+		return cutoff
+	}
+	return start
+}
+
+// isConversationType reports whether t is a Slack conversation type.
+func isConversationType(t string) bool {
+	for _, known := range allConversationTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList parses a comma-separated list, dropping empty entries.
+func splitList(val string) []string {
+	var items []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseBool accepts "true" and "1" as true; anything else is false.
+func parseBool(val string) bool {
+	val = strings.TrimSpace(strings.ToLower(val))
+	return val == "true" || val == "1"
+}
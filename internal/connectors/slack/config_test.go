@@ -0,0 +1,79 @@
+package slack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+
+	assert.Empty(t, cfg.ChannelIDs)
+	assert.Equal(t, []string{"public_channel", "private_channel", "mpim", "im"}, cfg.ConversationTypes)
+	assert.True(t, cfg.IncludeThreads)
+	assert.Zero(t, cfg.MaxAgeDays)
+	assert.Equal(t, DefaultRescanDays, cfg.RescanDays)
+}
+
+func TestParseConfig_Empty(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{}})
+
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfig(), cfg)
+}
+
+func TestParseConfig_AllFields(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"channel_ids":        "C1, C2,,",
+		"conversation_types": "public_channel, im",
+		"include_threads":    "false",
+		"max_age_days":       "30",
+		"rescan_days":        "0",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C1", "C2"}, cfg.ChannelIDs)
+	assert.Equal(t, []string{TypePublicChannel, TypeDM}, cfg.ConversationTypes)
+	assert.False(t, cfg.IncludeThreads)
+	assert.Equal(t, 30, cfg.MaxAgeDays)
+	assert.Zero(t, cfg.RescanDays)
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := map[string]map[string]string{
+		"unknown conversation type": {"conversation_types": "public_channel,shared"},
+		"negative max age":          {"max_age_days": "-1"},
+		"non-numeric max age":       {"max_age_days": "week"},
+		"negative rescan days":      {"rescan_days": "-1"},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := ParseConfig(domain.Source{Config: config})
+
+			require.Error(t, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestConfig_Cutoff(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, (&Config{}).Cutoff(now).IsZero())
+	assert.Equal(t, now.AddDate(0, 0, -7), (&Config{MaxAgeDays: 7}).Cutoff(now))
+}
+
+func TestConfig_RescanStart(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, (&Config{}).RescanStart(now).IsZero())
+	assert.Equal(t, now.AddDate(0, 0, -7), (&Config{RescanDays: 7}).RescanStart(now))
+	assert.Equal(t, now.AddDate(0, 0, -2), (&Config{RescanDays: 7, MaxAgeDays: 2}).RescanStart(now))
+}
@@ -0,0 +1,533 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Connector fetches messages and threads from the conversations of a Slack workspace.
+type Connector struct {
+	sourceID      string
+	config        *Config
+	tokenProvider driven.TokenProvider
+	client        *Client
+	mu            sync.Mutex
+	closed        bool
+}
+
+// emitFunc delivers a message document change to the sync consumer.
+type emitFunc func(changeType domain.ChangeType, doc *domain.RawDocument) error
+
+// syncRun holds the state of one sync shared by every conversation.
+type syncRun struct {
+	workspace *AuthInfo
+	dir       Directory
+	// previous is the cursor of the last sync; next is built by this one.
+	previous *Cursor
+	next     *Cursor
+	// cutoff is the oldest message time to read, zero for no limit.
+	cutoff time.Time
+	// rescan is the start of the window of recent messages read again, zero
+	// when rescanning is disabled.
+	rescan time.Time
+	emit   emitFunc
+}
+
+// New creates a new Slack connector.
+func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
+	return &Connector{
+		sourceID:      sourceID,
+		config:        cfg,
+		tokenProvider: tokenProvider,
+		client:        NewClient(tokenProvider),
+	}
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "slack"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    true,
+		SupportsBinary:       false,
+		RequiresAuth:         true,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: true,
+		SupportsPagination:   true,
+	}
+}
+
+// Validate checks that the token is accepted and the configured
+// conversations can be read.
+func (c *Connector) Validate(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, err := c.client.AuthTest(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		switch {
+		case errors.Is(err, ErrUnauthorised):
+			return fmt.Errorf("%w: %w", domain.ErrAuthInvalid, err)
+		case errors.Is(err, ErrTokenExpired):
+			return fmt.Errorf("%w: %w", domain.ErrAuthExpired, err)
+		}
+		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+	}
+
+	for _, id := range c.config.ChannelIDs {
+		if _, err := c.client.Conversation(ctx, id); err != nil {
+			return fmt.Errorf("get conversation %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// FullSync fetches all messages from the configured conversations.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	syncStart := time.Now()
+	cursor := NewCursor()
+
+	emit := func(_ domain.ChangeType, doc *domain.RawDocument) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case docsChan <- *doc:
+			return nil
+		}
+	}
+	if err := c.syncWorkspace(ctx, NewCursor(), cursor, syncStart, emit); err != nil {
+		return err
+	}
+
+	cursor.SetSyncedAt(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches messages posted since the last sync, and reads the
+// last RescanDays of messages again to find edits, deletions and new thread
+// replies. Conversations that appeared since then are synced in full.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	previous, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+	if previous.IsEmpty() {
+		return fmt.Errorf("invalid cursor, full sync required: cursor has no sync time")
+	}
+
+	syncStart := time.Now()
+	cursor := NewCursor()
+
+	emit := func(changeType domain.ChangeType, doc *domain.RawDocument) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case changesChan <- domain.RawDocumentChange{Type: changeType, Document: *doc}:
+			return nil
+		}
+	}
+	if err := c.syncWorkspace(ctx, previous, cursor, syncStart, emit); err != nil {
+		return err
+	}
+
+	cursor.SetSyncedAt(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// syncWorkspace emits the messages of every conversation that are new or
+// changed since the previous cursor, recording the sync state in next.
+func (c *Connector) syncWorkspace(
+	ctx context.Context, previous, next *Cursor, now time.Time, emit emitFunc,
+) error {
+	workspace, err := c.client.AuthTest(ctx)
+	if err != nil {
+		return fmt.Errorf("get workspace: %w", err)
+	}
+
+	users, err := c.client.Users(ctx)
+	if err != nil {
+		return fmt.Errorf("list users: %w", err)
+	}
+
+	conversations, err := c.listConversations(ctx)
+	if err != nil {
+		return err
+	}
+
+	run := &syncRun{
+		workspace: workspace,
+		dir:       NewDirectory(users),
+		previous:  previous,
+		next:      next,
+		cutoff:    c.config.Cutoff(now),
+		rescan:    c.config.RescanStart(now),
+		emit:      emit,
+	}
+	for i := range conversations {
+		if err := c.syncConversation(ctx, run, &conversations[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncConversation emits the top-level messages of one conversation with
+// their thread replies. Without a previous timestamp the history is read
+// back to the cutoff; otherwise messages after that timestamp are read, along
+// with the rescan window.
+func (c *Connector) syncConversation(ctx context.Context, run *syncRun, conv *Conversation) error {
+	lastTS := run.previous.LastTS(conv.ID)
+	run.next.SetLastTS(conv.ID, lastTS)
+
+	oldest := lastTS
+	switch {
+	case lastTS == "":
+		if !run.cutoff.IsZero() {
+			oldest = timeTS(run.cutoff)
+		}
+	case !run.rescan.IsZero() && tsAfter(lastTS, timeTS(run.rescan)):
+		oldest = timeTS(run.rescan)
+	}
+
+	seen := make(map[string]bool)
+	err := c.client.History(ctx, conv.ID, oldest, func(messages []Message) error {
+		for i := range messages {
+			msg := &messages[i]
+			// Replies broadcast to the channel are indexed with their thread
+			if msg.IsReply() {
+				continue
+			}
+			run.next.SetLastTS(conv.ID, msg.TS)
+			seen[msg.TS] = true
+			if err := c.syncMessage(ctx, run, conv, msg, lastTS); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	// Conversations the bot cannot read are skipped unless they were configured
+	if errors.Is(err, ErrNotInChannel) && len(c.config.ChannelIDs) == 0 {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("list messages for conversation %s: %w", conv.ID, err)
+	}
+
+	return c.syncUnseen(ctx, run, conv, oldest, seen)
+}
+
+// syncMessage emits a top-level message that is new since lastTS or changed
+// since the previous sync, and records recent messages in the next cursor.
+func (c *Connector) syncMessage(
+	ctx context.Context, run *syncRun, conv *Conversation, msg *Message, lastTS string,
+) error {
+	recorded, known := run.previous.Message(conv.ID, msg.TS)
+	if !msg.IsIndexable() {
+		// Deleting a message with replies leaves a tombstone in its place
+		if known {
+			return run.emit(domain.ChangeDeleted, c.deletedMessage(run, conv, msg.TS))
+		}
+		return nil
+	}
+
+	state := c.messageState(msg)
+	if c.isRecent(run, msg.TS, state) {
+		run.next.SetMessage(conv.ID, msg.TS, state)
+	}
+
+	changeType := domain.ChangeCreated
+	if lastTS != "" && !tsAfter(msg.TS, lastTS) {
+		if known && recorded == state {
+			return nil
+		}
+		changeType = domain.ChangeUpdated
+	}
+	return c.emitThread(ctx, run, conv, msg, changeType)
+}
+
+// syncUnseen handles the recorded messages the history read did not return.
+// Those within the read range were deleted. Older threads whose replies were
+// recent are read again to find new replies.
+func (c *Connector) syncUnseen(
+	ctx context.Context, run *syncRun, conv *Conversation, oldest string, seen map[string]bool,
+) error {
+	recorded := run.previous.ConversationMessages(conv.ID)
+	for _, ts := range slices.Sorted(maps.Keys(recorded)) {
+		if seen[ts] {
+			continue
+		}
+		if tsAfter(ts, oldest) {
+			if err := run.emit(domain.ChangeDeleted, c.deletedMessage(run, conv, ts)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.recheckThread(ctx, run, conv, ts, recorded[ts]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recheckThread reads a thread started before the read range whose newest
+// reply was recent at the last sync, and emits it again if it changed.
+// Threads quiet for longer than the rescan window are no longer checked.
+func (c *Connector) recheckThread(
+	ctx context.Context, run *syncRun, conv *Conversation, ts string, recorded MessageState,
+) error {
+	if run.rescan.IsZero() || !tsAfter(recorded.LatestReply, timeTS(run.rescan)) {
+		return nil
+	}
+
+	parent, replies, err := c.readThread(ctx, conv.ID, ts)
+	if errors.Is(err, ErrNotFound) || (err == nil && (parent == nil || !parent.IsIndexable())) {
+		return run.emit(domain.ChangeDeleted, c.deletedMessage(run, conv, ts))
+	}
+	if err != nil {
+		return fmt.Errorf("list replies for message %s: %w", ts, err)
+	}
+
+	state := c.messageState(parent)
+	if c.isRecent(run, ts, state) {
+		run.next.SetMessage(conv.ID, ts, state)
+	}
+	if state == recorded {
+		return nil
+	}
+	return c.emitDocument(run, conv, parent, replies, domain.ChangeUpdated)
+}
+
+// emitThread reads the replies of a message that starts a thread and emits
+// the message with them.
+func (c *Connector) emitThread(
+	ctx context.Context, run *syncRun, conv *Conversation, msg *Message, changeType domain.ChangeType,
+) error {
+	var replies []Message
+	if c.config.IncludeThreads && msg.HasReplies() {
+		var err error
+		if _, replies, err = c.readThread(ctx, conv.ID, msg.TS); err != nil {
+			return fmt.Errorf("list replies for message %s: %w", msg.TS, err)
+		}
+	}
+	return c.emitDocument(run, conv, msg, replies, changeType)
+}
+
+// emitDocument converts a message and its replies to a document and emits it.
+func (c *Connector) emitDocument(
+	run *syncRun, conv *Conversation, msg *Message, replies []Message, changeType domain.ChangeType,
+) error {
+	thread := &Thread{Workspace: run.workspace, Conversation: conv, Message: msg, Replies: replies}
+	doc, err := ThreadToRawDocument(thread, run.dir, c.sourceID)
+	if err != nil {
+		return err
+	}
+	return run.emit(changeType, doc)
+}
+
+// readThread returns the parent message of a thread, or nil if it is not
+// returned, and its replies.
+func (c *Connector) readThread(ctx context.Context, channelID, ts string) (*Message, []Message, error) {
+	var parent *Message
+	var replies []Message
+	err := c.client.Replies(ctx, channelID, ts, func(messages []Message) error {
+		for i := range messages {
+			if messages[i].TS == ts {
+				parent = &messages[i]
+			} else {
+				replies = append(replies, messages[i])
+			}
+		}
+		return nil
+	})
+	return parent, replies, err
+}
+
+// messageState returns what the cursor records of a top-level message to
+// detect changes. Replies only count when threads are indexed.
+func (c *Connector) messageState(msg *Message) MessageState {
+	var state MessageState
+	if msg.Edited != nil {
+		state.Edited = msg.Edited.TS
+	}
+	if c.config.IncludeThreads {
+		state.LatestReply = msg.LatestReply
+		state.ReplyCount = msg.ReplyCount
+	}
+	return state
+}
+
+// isRecent reports whether a message or its newest reply falls within the
+// rescan window, so the cursor keeps its state.
+func (c *Connector) isRecent(run *syncRun, ts string, state MessageState) bool {
+	if run.rescan.IsZero() {
+		return false
+	}
+	start := timeTS(run.rescan)
+	return tsAfter(ts, start) || tsAfter(state.LatestReply, start)
+}
+
+// deletedMessage returns the document of a deleted message, identified by its URI.
+func (c *Connector) deletedMessage(run *syncRun, conv *Conversation, ts string) *domain.RawDocument {
+	return &domain.RawDocument{
+		SourceID: c.sourceID,
+		URI:      MessageURI(run.workspace.TeamID, conv.ID, ts),
+	}
+}
+
+// listConversations returns the configured conversations, or every
+// conversation of the configured types the bot can read.
+func (c *Connector) listConversations(ctx context.Context) ([]Conversation, error) {
+	if len(c.config.ChannelIDs) == 0 {
+		all, err := c.client.Conversations(ctx, c.config.ConversationTypes)
+		if err != nil {
+			return nil, fmt.Errorf("list conversations: %w", err)
+		}
+		conversations := make([]Conversation, 0, len(all))
+		for _, conv := range all {
+			if conv.IsReadable() {
+				conversations = append(conversations, conv)
+			}
+		}
+		return conversations, nil
+	}
+
+	conversations := make([]Conversation, 0, len(c.config.ChannelIDs))
+	for _, id := range c.config.ChannelIDs {
+		conv, err := c.client.Conversation(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get conversation %s: %w", id, err)
+		}
+		conversations = append(conversations, *conv)
+	}
+	return conversations, nil
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for Slack (the Events API needs a public endpoint).
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier returns the workspace host, such as acme.slack.com,
+// for the given token.
+func (c *Connector) GetAccountIdentifier(ctx context.Context, accessToken string) (string, error) {
+	client := NewClient(staticToken(accessToken))
+	client.baseURL = c.client.baseURL
+	info, err := client.AuthTest(ctx)
+	if err != nil {
+		return "", err
+	}
+	return workspaceIdentifier(info), nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+// workspaceIdentifier returns the host of the workspace URL, falling back to
+// the team ID.
+func workspaceIdentifier(info *AuthInfo) string {
+	if u, err := url.Parse(info.URL); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return info.TeamID
+}
+
+// staticToken is a TokenProvider for a token supplied directly.
+type staticToken string
+
+func (t staticToken) GetToken(_ context.Context) (string, error) { return string(t), nil }
+func (t staticToken) AuthorizationID() string                    { return "" }
+func (t staticToken) AuthMethod() domain.AuthMethod              { return domain.AuthMethodPAT }
+func (t staticToken) IsAuthenticated() bool                      { return t != "" }
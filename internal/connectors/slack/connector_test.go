@@ -0,0 +1,476 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct {
+	token string
+}
+
+func (p *mockTokenProvider) GetToken(_ context.Context) (string, error) { return p.token, nil }
+func (p *mockTokenProvider) AuthorizationID() string                    { return "test-auth" }
+func (p *mockTokenProvider) AuthMethod() domain.AuthMethod              { return domain.AuthMethodOAuth }
+func (p *mockTokenProvider) IsAuthenticated() bool                      { return p.token != "" }
+
+// fakePageSize is the page size of the fake server, small enough to page.
+const fakePageSize = 2
+
+// fakeSlack serves a minimal Slack Web API backed by in-memory conversations.
+type fakeSlack struct {
+	mu            sync.Mutex
+	conversations []Conversation
+	messages      map[string][]Message
+	replies       map[string][]Message
+	oldest        map[string]string // Last oldest parameter per conversation
+}
+
+// ts returns the n-th message timestamp after testStart.
+func ts(n int) string {
+	return strconv.FormatInt(testStart.Unix()+int64(n), 10) + ".000100"
+}
+
+var testStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newFakeSlack returns a workspace with a channel holding a thread and a
+// join event, a DM, and a channel the bot is not a member of.
+func newFakeSlack() *fakeSlack {
+	f := &fakeSlack{
+		conversations: []Conversation{
+			{ID: "C1", Name: "general", IsMember: true},
+			{ID: "C2", Name: "random"},
+			{ID: "D1", IsIM: true, User: "U2"},
+		},
+		messages: map[string][]Message{},
+		replies:  map[string][]Message{},
+		oldest:   map[string]string{},
+	}
+	f.post("C1", Message{TS: ts(1), User: "U1", Text: "deploy is done <@U2>",
+		Reactions: []Reaction{{Name: "tada", Count: 2}}})
+	f.post("C1", Message{TS: ts(2), Subtype: "channel_join", User: "U2", Text: "<@U2> has joined"})
+	f.post("C1", Message{TS: ts(3), ThreadTS: ts(3), User: "U2", Text: "what broke?", ReplyCount: 2})
+	f.post("C1", Message{TS: ts(5), ThreadTS: ts(3), Subtype: "thread_broadcast", User: "U1", Text: "fixed"})
+	f.replies[ts(3)] = []Message{
+		{TS: ts(3), ThreadTS: ts(3), User: "U2", Text: "what broke?"},
+		{TS: ts(4), ThreadTS: ts(3), User: "U1", Text: "the cache",
+			Attachments: []Attachment{{Title: "Runbook", TitleLink: "https://wiki/runbook", ServiceName: "Wiki"}}},
+		{TS: ts(5), ThreadTS: ts(3), Subtype: "thread_broadcast", User: "U1", Text: "fixed"},
+	}
+	f.post("C2", Message{TS: ts(1), User: "U1", Text: "not for the bot"})
+	f.post("D1", Message{TS: ts(6), User: "U2", Text: "hi bot", Files: []File{{Name: "notes.txt"}}})
+	return f
+}
+
+// post adds a message to a conversation.
+func (f *fakeSlack) post(channelID string, msg Message) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.messages[channelID] = append(f.messages[channelID], msg)
+}
+
+func (f *fakeSlack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.Header.Get("Authorization") != "Bearer xoxb-token" {
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": false, "error": "invalid_auth"})
+		return
+	}
+
+	query := r.URL.Query()
+	channel := query.Get("channel")
+	body := map[string]any{"ok": true}
+	switch strings.TrimPrefix(r.URL.Path, "/") {
+	case "auth.test":
+		body["url"] = "https://acme.slack.com/"
+		body["team"] = "Acme"
+		body["team_id"] = "T1"
+		body["user"] = "sercha"
+	case "users.list":
+		body["members"] = []map[string]any{
+			{"id": "U1", "name": "ann", "profile": map[string]any{"display_name": "Ann"}},
+			{"id": "U2", "name": "bob", "real_name": "Bob Smith"},
+		}
+	case "conversations.list":
+		body["channels"] = f.conversations
+	case "conversations.info":
+		found := false
+		for _, conv := range f.conversations {
+			if conv.ID == channel {
+				body["channel"] = conv
+				found = true
+			}
+		}
+		if !found {
+			body = map[string]any{"ok": false, "error": "channel_not_found"}
+		}
+	case "conversations.history":
+		if channel == "C2" {
+			body = map[string]any{"ok": false, "error": "not_in_channel"}
+			break
+		}
+		f.oldest[channel] = query.Get("oldest")
+		var matched []Message
+		for _, msg := range f.messages[channel] {
+			if tsAfter(msg.TS, query.Get("oldest")) {
+				matched = append(matched, msg)
+			}
+		}
+		sort.Slice(matched, func(i, j int) bool { return tsAfter(matched[i].TS, matched[j].TS) })
+		page(body, "messages", matched, query.Get("cursor"))
+	case "conversations.replies":
+		replies, ok := f.replies[query.Get("ts")]
+		if !ok {
+			body = map[string]any{"ok": false, "error": "thread_not_found"}
+			break
+		}
+		page(body, "messages", replies, query.Get("cursor"))
+	default:
+		body = map[string]any{"ok": false, "error": "unknown_method"}
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// page sets one page of messages in body, with the next cursor if more remain.
+func page(body map[string]any, key string, messages []Message, cursor string) {
+	start, _ := strconv.Atoi(cursor)
+	end := min(start+fakePageSize, len(messages))
+	if start > end {
+		start = end
+	}
+	body[key] = messages[start:end]
+	if end < len(messages) {
+		body["response_metadata"] = map[string]any{"next_cursor": strconv.Itoa(end)}
+	}
+}
+
+func newTestConnector(t *testing.T, fake *fakeSlack, cfg *Config, token string) *Connector {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	c := New("src-1", cfg, &mockTokenProvider{token: token})
+	c.client.baseURL = server.URL
+	// No proactive throttling against the fake server
+	for tier := range c.client.rateLimiter.buckets {
+		c.client.rateLimiter.buckets[tier] = rate.NewLimiter(rate.Inf, 1)
+	}
+	return c
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var out []domain.RawDocument
+	for doc := range docs {
+		out = append(out, doc)
+	}
+	return out, <-errs
+}
+
+func collectChanges(
+	changes <-chan domain.RawDocumentChange, errs <-chan error,
+) ([]domain.RawDocumentChange, error) {
+	var out []domain.RawDocumentChange
+	for change := range changes {
+		out = append(out, change)
+	}
+	return out, <-errs
+}
+
+func docURIs(docs []domain.RawDocument) []string {
+	uris := make([]string, len(docs))
+	for i, doc := range docs {
+		uris[i] = doc.URI
+	}
+	return uris
+}
+
+func TestNew(t *testing.T) {
+	c := New("src-1", DefaultConfig(), &mockTokenProvider{token: "xoxb-token"})
+
+	assert.Equal(t, "slack", c.Type())
+	assert.Equal(t, "src-1", c.SourceID())
+	assert.True(t, c.Capabilities().SupportsIncremental)
+	assert.True(t, c.Capabilities().RequiresAuth)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	t.Run("accepts valid token", func(t *testing.T) {
+		c := newTestConnector(t, newFakeSlack(), DefaultConfig(), "xoxb-token")
+		assert.NoError(t, c.Validate(context.Background()))
+	})
+
+	t.Run("rejects invalid token", func(t *testing.T) {
+		c := newTestConnector(t, newFakeSlack(), DefaultConfig(), "bad")
+		err := c.Validate(context.Background())
+		assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+		assert.ErrorIs(t, err, ErrUnauthorised)
+	})
+
+	t.Run("rejects unknown channel", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.ChannelIDs = []string{"C9"}
+		c := newTestConnector(t, newFakeSlack(), cfg, "xoxb-token")
+		assert.ErrorIs(t, c.Validate(context.Background()), ErrNotFound)
+	})
+}
+
+func TestConnector_FullSync(t *testing.T) {
+	c := newTestConnector(t, newFakeSlack(), DefaultConfig(), "xoxb-token")
+
+	docs, err := collectDocs(c.FullSync(context.Background()))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	// The join event, the broadcast reply and the channel the bot is not in are skipped
+	assert.ElementsMatch(t, []string{
+		"slack://T1/C1/" + ts(1),
+		"slack://T1/C1/" + ts(3),
+		"slack://T1/D1/" + ts(6),
+	}, docURIs(docs))
+
+	byURI := make(map[string]domain.RawDocument)
+	for _, doc := range docs {
+		assert.Equal(t, MIMETypeSlackMessage, doc.MIMEType)
+		byURI[doc.URI] = doc
+	}
+
+	var first ThreadContent
+	require.NoError(t, json.Unmarshal(byURI["slack://T1/C1/"+ts(1)].Content, &first))
+	assert.Equal(t, "Acme", first.Workspace)
+	assert.Equal(t, "#general", first.Channel)
+	assert.Equal(t, "Ann", first.Author)
+	assert.Equal(t, "deploy is done @Bob Smith", first.Text)
+	assert.Equal(t, []ReactionContent{{Name: "tada", Count: 2}}, first.Reactions)
+
+	thread := byURI["slack://T1/C1/"+ts(3)]
+	var content ThreadContent
+	require.NoError(t, json.Unmarshal(thread.Content, &content))
+	require.Len(t, content.Replies, 2)
+	assert.Equal(t, "the cache", content.Replies[0].Text)
+	assert.Equal(t, []LinkContent{{Title: "Runbook", URL: "https://wiki/runbook", Service: "Wiki"}},
+		content.Replies[0].Links)
+	assert.Equal(t, "https://acme.slack.com/archives/C1/p"+strings.ReplaceAll(ts(3), ".", ""),
+		thread.Metadata["url"])
+	assert.Equal(t, "slack://T1/C1", *thread.ParentURI)
+
+	var dm ThreadContent
+	require.NoError(t, json.Unmarshal(byURI["slack://T1/D1/"+ts(6)].Content, &dm))
+	assert.Equal(t, "DM with Bob Smith", dm.Channel)
+	assert.Equal(t, []string{"notes.txt"}, dm.Files)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, ts(3), cursor.LastTS("C1"))
+	assert.Equal(t, ts(6), cursor.LastTS("D1"))
+	assert.False(t, cursor.IsEmpty())
+}
+
+func TestConnector_FullSync_WithoutThreads(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.IncludeThreads = false
+	c := newTestConnector(t, newFakeSlack(), cfg, "xoxb-token")
+
+	docs, err := collectDocs(c.FullSync(context.Background()))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	for _, doc := range docs {
+		var content ThreadContent
+		require.NoError(t, json.Unmarshal(doc.Content, &content))
+		assert.Empty(t, content.Replies)
+	}
+}
+
+func TestConnector_FullSync_ConfiguredChannelNotJoined(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ChannelIDs = []string{"C2"}
+	c := newTestConnector(t, newFakeSlack(), cfg, "xoxb-token")
+
+	_, err := collectDocs(c.FullSync(context.Background()))
+
+	assert.ErrorIs(t, err, ErrNotInChannel)
+}
+
+func TestConnector_IncrementalSync(t *testing.T) {
+	fake := newFakeSlack()
+	c := newTestConnector(t, fake, DefaultConfig(), "xoxb-token")
+
+	_, err := collectDocs(c.FullSync(context.Background()))
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+
+	fake.post("C1", Message{TS: ts(10), User: "U1", Text: "release notes"})
+	fake.conversations = append(fake.conversations, Conversation{ID: "C3", Name: "new", IsMember: true})
+	fake.post("C3", Message{TS: ts(1), User: "U2", Text: "first"})
+
+	changes, err := collectChanges(c.IncrementalSync(context.Background(),
+		domain.SyncState{SourceID: "src-1", Cursor: complete.NewCursor}))
+
+	require.ErrorAs(t, err, &complete)
+	uris := make([]string, len(changes))
+	for i, change := range changes {
+		assert.Equal(t, domain.ChangeCreated, change.Type)
+		uris[i] = change.Document.URI
+	}
+	assert.ElementsMatch(t, []string{"slack://T1/C1/" + ts(10), "slack://T1/C3/" + ts(1)}, uris)
+	assert.Equal(t, ts(3), fake.oldest["C1"])
+	assert.Empty(t, fake.oldest["C3"])
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, ts(10), cursor.LastTS("C1"))
+	assert.Equal(t, ts(6), cursor.LastTS("D1"))
+}
+
+// changeTypes maps the URI of each change to its type.
+func changeTypes(changes []domain.RawDocumentChange) map[string]domain.ChangeType {
+	types := make(map[string]domain.ChangeType, len(changes))
+	for _, change := range changes {
+		types[change.Document.URI] = change.Type
+	}
+	return types
+}
+
+func TestConnector_IncrementalSync_Rescan(t *testing.T) {
+	fake := newFakeSlack()
+	cfg := DefaultConfig()
+	cfg.RescanDays = 3650
+	c := newTestConnector(t, fake, cfg, "xoxb-token")
+
+	_, err := collectDocs(c.FullSync(context.Background()))
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+
+	// Edit a message, reply to the thread and delete the DM
+	fake.messages["C1"][0].Text = "deploy is done, <@U2> please check"
+	fake.messages["C1"][0].Edited = &struct {
+		TS string `json:"ts"`
+	}{TS: ts(20)}
+	fake.messages["C1"][2].ReplyCount = 3
+	fake.messages["C1"][2].LatestReply = ts(21)
+	fake.replies[ts(3)] = append(fake.replies[ts(3)],
+		Message{TS: ts(21), ThreadTS: ts(3), User: "U2", Text: "thanks"})
+	fake.messages["D1"] = nil
+
+	changes, err := collectChanges(c.IncrementalSync(context.Background(),
+		domain.SyncState{SourceID: "src-1", Cursor: complete.NewCursor}))
+
+	require.ErrorAs(t, err, &complete)
+	assert.Equal(t, map[string]domain.ChangeType{
+		"slack://T1/C1/" + ts(1): domain.ChangeUpdated,
+		"slack://T1/C1/" + ts(3): domain.ChangeUpdated,
+		"slack://T1/D1/" + ts(6): domain.ChangeDeleted,
+	}, changeTypes(changes))
+	for _, change := range changes {
+		if change.Document.URI == "slack://T1/C1/"+ts(3) {
+			assert.Contains(t, string(change.Document.Content), "thanks")
+		}
+	}
+	assert.True(t, tsAfter(ts(3), fake.oldest["C1"]), "the rescan reads back past the last ts")
+
+	// Nothing changed since, so the next sync emits nothing
+	changes, err = collectChanges(c.IncrementalSync(context.Background(),
+		domain.SyncState{SourceID: "src-1", Cursor: complete.NewCursor}))
+	require.ErrorAs(t, err, &complete)
+	assert.Empty(t, changes)
+}
+
+func TestConnector_IncrementalSync_OldThreadReply(t *testing.T) {
+	fake := newFakeSlack()
+	recent := timeTS(time.Now().Add(-time.Hour))
+	fake.messages["C1"][2].LatestReply = recent
+	fake.replies[ts(3)][0].LatestReply = recent
+	c := newTestConnector(t, fake, DefaultConfig(), "xoxb-token")
+
+	_, err := collectDocs(c.FullSync(context.Background()))
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+
+	// The thread started before the rescan window gets a new reply
+	latest := timeTS(time.Now())
+	fake.messages["C1"][2].ReplyCount = 3
+	fake.messages["C1"][2].LatestReply = latest
+	fake.replies[ts(3)][0].ReplyCount = 3
+	fake.replies[ts(3)][0].LatestReply = latest
+	fake.replies[ts(3)] = append(fake.replies[ts(3)],
+		Message{TS: latest, ThreadTS: ts(3), User: "U2", Text: "it broke again"})
+
+	changes, err := collectChanges(c.IncrementalSync(context.Background(),
+		domain.SyncState{SourceID: "src-1", Cursor: complete.NewCursor}))
+
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 1)
+	assert.Equal(t, domain.ChangeUpdated, changes[0].Type)
+	assert.Equal(t, "slack://T1/C1/"+ts(3), changes[0].Document.URI)
+	assert.Contains(t, string(changes[0].Document.Content), "it broke again")
+	assert.Equal(t, ts(3), fake.oldest["C1"])
+
+	// Deleting the thread's parent removes the document
+	delete(fake.replies, ts(3))
+
+	changes, err = collectChanges(c.IncrementalSync(context.Background(),
+		domain.SyncState{SourceID: "src-1", Cursor: complete.NewCursor}))
+
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 1)
+	assert.Equal(t, domain.ChangeDeleted, changes[0].Type)
+	assert.Equal(t, "slack://T1/C1/"+ts(3), changes[0].Document.URI)
+}
+
+func TestConnector_IncrementalSync_RequiresCursor(t *testing.T) {
+	c := newTestConnector(t, newFakeSlack(), DefaultConfig(), "xoxb-token")
+
+	_, err := collectChanges(c.IncrementalSync(context.Background(), domain.SyncState{}))
+
+	assert.ErrorContains(t, err, "full sync required")
+}
+
+func TestConnector_MaxAgeDays(t *testing.T) {
+	fake := newFakeSlack()
+	cfg := DefaultConfig()
+	cfg.MaxAgeDays = 7
+	c := newTestConnector(t, fake, cfg, "xoxb-token")
+
+	_, err := collectDocs(c.FullSync(context.Background()))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	oldest := tsTime(fake.oldest["C1"])
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -7), oldest, time.Minute)
+}
+
+func TestConnector_Closed(t *testing.T) {
+	c := newTestConnector(t, newFakeSlack(), DefaultConfig(), "xoxb-token")
+	require.NoError(t, c.Close())
+
+	assert.ErrorIs(t, c.Validate(context.Background()), domain.ErrConnectorClosed)
+	_, err := collectDocs(c.FullSync(context.Background()))
+	assert.ErrorIs(t, err, domain.ErrConnectorClosed)
+}
+
+func TestConnector_GetAccountIdentifier(t *testing.T) {
+	c := newTestConnector(t, newFakeSlack(), DefaultConfig(), "")
+
+	id, err := c.GetAccountIdentifier(context.Background(), "xoxb-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme.slack.com", id)
+}
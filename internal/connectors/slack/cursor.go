@@ -0,0 +1,127 @@
+package slack
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor stores the newest top-level message timestamp synced in each
+// conversation. Timestamps increase over time, so incremental syncs pass the
+// stored one as the oldest parameter of conversations.history.
+//
+// It also records the state of recent top-level messages and of threads
+// with recent replies, so a rescan can tell which of them changed.
+type Cursor struct {
+	Version  int                                `json:"v"`
+	SyncedAt time.Time                          `json:"synced_at"`
+	Channels map[string]string                  `json:"channels,omitempty"`
+	Messages map[string]map[string]MessageState `json:"messages,omitempty"`
+}
+
+// MessageState is what the cursor remembers of a top-level message.
+type MessageState struct {
+	// Edited is the timestamp of the last edit.
+	Edited string `json:"edited,omitempty"`
+	// LatestReply is the timestamp of the newest thread reply.
+	LatestReply string `json:"latest_reply,omitempty"`
+	// ReplyCount is the number of thread replies.
+	ReplyCount int `json:"reply_count,omitempty"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version:  CursorVersion,
+		Channels: make(map[string]string),
+		Messages: make(map[string]map[string]MessageState),
+	}
+}
+
+// Encode serialises the cursor to a base64 string.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Channels == nil {
+		cursor.Channels = make(map[string]string)
+	}
+	if cursor.Messages == nil {
+		cursor.Messages = make(map[string]map[string]MessageState)
+	}
+
+	return &cursor, nil
+}
+
+// IsEmpty returns true if the cursor was not written by a completed sync.
+func (c *Cursor) IsEmpty() bool {
+	return c.SyncedAt.IsZero()
+}
+
+// LastTS returns the newest synced message timestamp of a conversation, if any.
+func (c *Cursor) LastTS(channelID string) string {
+	return c.Channels[channelID]
+}
+
+// SetLastTS records the newest synced message timestamp of a conversation.
+// Older timestamps never replace newer ones.
+func (c *Cursor) SetLastTS(channelID, ts string) {
+	if ts == "" || !tsAfter(ts, c.Channels[channelID]) {
+		return
+	}
+	c.Channels[channelID] = ts
+}
+
+// Message returns the recorded state of a top-level message, if any.
+func (c *Cursor) Message(channelID, ts string) (MessageState, bool) {
+	state, ok := c.Messages[channelID][ts]
+	return state, ok
+}
+
+// ConversationMessages returns the recorded messages of a conversation by timestamp.
+func (c *Cursor) ConversationMessages(channelID string) map[string]MessageState {
+	return c.Messages[channelID]
+}
+
+// SetMessage records the state of a top-level message.
+func (c *Cursor) SetMessage(channelID, ts string, state MessageState) {
+	if c.Messages[channelID] == nil {
+		c.Messages[channelID] = make(map[string]MessageState)
+	}
+	c.Messages[channelID][ts] = state
+}
+
+// SetSyncedAt records when the sync started.
+func (c *Cursor) SetSyncedAt(t time.Time) {
+	c.SyncedAt = t.UTC()
+}
@@ -0,0 +1,62 @@
+package slack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	cursor := NewCursor()
+	cursor.SetLastTS("C1", "1767225600.000100")
+	cursor.SetSyncedAt(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	assert.Equal(t, "1767225600.000100", decoded.LastTS("C1"))
+	assert.False(t, decoded.IsEmpty())
+}
+
+func TestCursor_Messages(t *testing.T) {
+	cursor := NewCursor()
+	state := MessageState{Edited: "1767225700.000100", LatestReply: "1767225800.000100", ReplyCount: 2}
+	cursor.SetMessage("C1", "1767225600.000100", state)
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	got, ok := decoded.Message("C1", "1767225600.000100")
+	require.True(t, ok)
+	assert.Equal(t, state, got)
+	assert.Len(t, decoded.ConversationMessages("C1"), 1)
+	_, ok = decoded.Message("C2", "1767225600.000100")
+	assert.False(t, ok)
+}
+
+func TestDecodeCursor_Empty(t *testing.T) {
+	cursor, err := DecodeCursor("")
+
+	require.NoError(t, err)
+	assert.True(t, cursor.IsEmpty())
+	assert.Empty(t, cursor.LastTS("C1"))
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, s := range []string{"not base64!", "bm90IGpzb24=", "eyJ2Ijo5OX0="} {
+		_, err := DecodeCursor(s)
+		assert.ErrorIs(t, err, ErrInvalidCursor, s)
+	}
+}
+
+func TestCursor_SetLastTS_KeepsNewest(t *testing.T) {
+	cursor := NewCursor()
+
+	cursor.SetLastTS("C1", "1767225600.000200")
+	cursor.SetLastTS("C1", "1767225600.000100")
+	cursor.SetLastTS("C1", "")
+
+	assert.Equal(t, "1767225600.000200", cursor.LastTS("C1"))
+}
@@ -0,0 +1,59 @@
+// Package slack provides a Connector for the messages of a Slack workspace.
+//
+// The connector authenticates with a bot token (xoxb-), obtained through
+// Slack's OAuth v2 flow or pasted from an app's OAuth & Permissions page.
+// The app needs the channels:history, channels:read, groups:history,
+// groups:read, im:history, im:read, mpim:history, mpim:read and users:read
+// bot scopes.
+//
+// # Configuration
+//
+//   - channel_ids: comma-separated conversation IDs. Default: every
+//     conversation of the configured types the bot is a member of.
+//   - conversation_types: comma-separated Slack conversation types to index,
+//     from public_channel, private_channel, mpim and im. Default: all four.
+//   - include_threads: also index thread replies (true/false). Default: true.
+//   - max_age_days: skip messages older than this many days. Default: 0 (no limit).
+//   - rescan_days: days of recent messages incremental sync reads again to
+//     find edits, deletions and new thread replies. Default: 7 (0 disables).
+//
+// # Sync Operations
+//
+// Full sync pages through each conversation's history with
+// conversations.history, newest first. Messages that start a thread have
+// their replies read with conversations.replies.
+//
+// Message timestamps (ts) grow over time, so the cursor stores the newest
+// top-level ts per conversation. Incremental sync passes it as the oldest
+// parameter of conversations.history and reads the messages after it, or
+// the last rescan_days of messages if that reaches further back.
+// Conversations that appeared since the last sync are read in full.
+//
+// The cursor also records the edit time and latest reply of every top-level
+// message posted or replied to within the rescan window. A reread message
+// whose record differs is emitted as updated, and a recorded message that is
+// missing or replaced by a tombstone is deleted. Threads started before the
+// window but replied to within it are read again with conversations.replies.
+//
+// Requests are throttled with a token bucket per Slack rate limit tier:
+// conversations.history and conversations.replies are Tier 3 (50 requests
+// a minute), conversations.list and users.list Tier 2 (20 a minute).
+//
+// # Document Structure
+//
+// Each top-level message is a document with the MIME type
+// application/vnd.slack.message+json and the URI
+// slack://{workspaceId}/{channelId}/{ts}. The JSON content holds the
+// message, its reactions, link unfurls and file names, and its thread
+// replies. User mentions are resolved to display names. Join, leave and
+// other channel events are skipped.
+//
+// # Limitations
+//
+//   - Edits and deletions older than rescan_days, new replies to threads
+//     quiet for longer than that, and edits to replies are only picked up
+//     by a full sync
+//   - A bot token reads only the direct messages sent to the bot
+//   - File contents are not indexed, only their names
+//   - Watch mode is not supported (the Events API needs a public endpoint)
+package slack
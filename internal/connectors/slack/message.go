@@ -0,0 +1,419 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// MIMETypeSlackMessage is the custom MIME type for Slack messages and their threads.
+const MIMETypeSlackMessage = "application/vnd.slack.message+json"
+
+// AuthInfo is the workspace and user a token belongs to.
+type AuthInfo struct {
+	URL    string `json:"url"`
+	Team   string `json:"team"`
+	User   string `json:"user"`
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+	BotID  string `json:"bot_id"`
+}
+
+// User is a member of a workspace.
+type User struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	RealName string `json:"real_name"`
+	Profile  struct {
+		DisplayName string `json:"display_name"`
+		RealName    string `json:"real_name"`
+	} `json:"profile"`
+}
+
+// DisplayName returns the user's display name, falling back to the real
+// name and then the username.
+func (u *User) DisplayName() string {
+	for _, name := range []string{u.Profile.DisplayName, u.Profile.RealName, u.RealName} {
+		if name != "" {
+			return name
+		}
+	}
+	return u.Name
+}
+
+// Conversation is a channel, private channel, group DM or DM.
+type Conversation struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+	IsIM      bool   `json:"is_im"`
+	IsMPIM    bool   `json:"is_mpim"`
+	IsMember  bool   `json:"is_member"`
+	User      string `json:"user"` // The other member of a DM
+}
+
+// Type returns the conversation type as used by conversations.list.
+func (c *Conversation) Type() string {
+	switch {
+	case c.IsIM:
+		return TypeDM
+	case c.IsMPIM:
+		return TypeGroupDM
+	case c.IsPrivate:
+		return TypePrivateChannel
+	default:
+		return TypePublicChannel
+	}
+}
+
+// IsReadable reports whether the bot can read the conversation's history.
+// The bot is always a member of its DMs and group DMs.
+func (c *Conversation) IsReadable() bool {
+	return c.IsMember || c.IsIM || c.IsMPIM
+}
+
+// Reaction is an emoji reaction to a message.
+type Reaction struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// Attachment is a legacy attachment, which Slack uses for link unfurls.
+type Attachment struct {
+	Title       string `json:"title"`
+	TitleLink   string `json:"title_link"`
+	FromURL     string `json:"from_url"`
+	OriginalURL string `json:"original_url"`
+	Text        string `json:"text"`
+	Fallback    string `json:"fallback"`
+	ServiceName string `json:"service_name"`
+}
+
+// File is a file shared in a message.
+type File struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// Message is a message in a conversation or thread.
+type Message struct {
+	Type        string       `json:"type"`
+	Subtype     string       `json:"subtype"`
+	TS          string       `json:"ts"`
+	ThreadTS    string       `json:"thread_ts"`
+	User        string       `json:"user"`
+	Username    string       `json:"username"`
+	Text        string       `json:"text"`
+	ReplyCount  int          `json:"reply_count"`
+	LatestReply string       `json:"latest_reply"`
+	Reactions   []Reaction   `json:"reactions"`
+	Attachments []Attachment `json:"attachments"`
+	Files       []File       `json:"files"`
+	Edited      *struct {
+		TS string `json:"ts"`
+	} `json:"edited"`
+	BotProfile *struct {
+		Name string `json:"name"`
+	} `json:"bot_profile"`
+}
+
+// indexableSubtypes are the message subtypes that carry user content.
+// Joins, leaves, topic changes and other channel events are skipped.
+var indexableSubtypes = map[string]bool{
+	"":                 true,
+	"bot_message":      true,
+	"file_share":       true,
+	"me_message":       true,
+	"thread_broadcast": true,
+}
+
+// IsIndexable reports whether the message carries user content worth indexing.
+func (m *Message) IsIndexable() bool {
+	if !indexableSubtypes[m.Subtype] {
+		return false
+	}
+	return strings.TrimSpace(m.Text) != "" || len(m.Files) > 0 || len(m.Attachments) > 0
+}
+
+// IsReply reports whether the message is a thread reply rather than the
+// start of a thread or a standalone message.
+func (m *Message) IsReply() bool {
+	return m.ThreadTS != "" && m.ThreadTS != m.TS
+}
+
+// HasReplies reports whether the message starts a thread.
+func (m *Message) HasReplies() bool {
+	return m.ReplyCount > 0 && !m.IsReply()
+}
+
+// Directory maps user IDs to display names.
+type Directory map[string]string
+
+// NewDirectory builds a directory from the members of a workspace.
+func NewDirectory(users []User) Directory {
+	dir := make(Directory, len(users))
+	for i := range users {
+		dir[users[i].ID] = users[i].DisplayName()
+	}
+	return dir
+}
+
+// Name returns the display name of a user, or the ID if it is unknown.
+func (d Directory) Name(userID string) string {
+	if name, ok := d[userID]; ok && name != "" {
+		return name
+	}
+	return userID
+}
+
+// author returns the name of the user or bot that posted a message.
+func (d Directory) author(msg *Message) string {
+	switch {
+	case msg.User != "":
+		return d.Name(msg.User)
+	case msg.BotProfile != nil && msg.BotProfile.Name != "":
+		return msg.BotProfile.Name
+	case msg.Username != "":
+		return msg.Username
+	}
+	return "unknown"
+}
+
+// userMention matches a user mention such as <@U024BE7LH>.
+var userMention = regexp.MustCompile(`<@([UW][A-Z0-9]+)(?:\|[^>]*)?>`)
+
+// resolveMentions replaces user mentions with the users' display names.
+func (d Directory) resolveMentions(text string) string {
+	return userMention.ReplaceAllStringFunc(text, func(mention string) string {
+		return "@" + d.Name(userMention.FindStringSubmatch(mention)[1])
+	})
+}
+
+// ConversationName returns a readable name for a conversation, such as
+// "#general", "DM with Ann" or "Group DM with ann, bob".
+func ConversationName(conv *Conversation, dir Directory) string {
+	switch {
+	case conv.IsIM:
+		return "DM with " + dir.Name(conv.User)
+	case conv.IsMPIM:
+		return "Group DM with " + groupDMMembers(conv.Name)
+	default:
+		return "#" + conv.Name
+	}
+}
+
+// groupDMMembers lists the members from a group DM name, which Slack forms
+// as mpdm-{user}--{user}-{n}.
+func groupDMMembers(name string) string {
+	name = strings.TrimPrefix(name, "mpdm-")
+	if i := strings.LastIndex(name, "-"); i > 0 && !strings.HasSuffix(name[:i], "-") {
+		name = name[:i]
+	}
+	return strings.Join(strings.Split(name, "--"), ", ")
+}
+
+// ThreadContent is the JSON content of a message document: the message and
+// its thread replies.
+type ThreadContent struct {
+	Workspace string `json:"workspace"`
+	Channel   string `json:"channel"`
+	PostContent
+	Replies []PostContent `json:"replies,omitempty"`
+}
+
+// PostContent is a message or thread reply.
+type PostContent struct {
+	Author    string            `json:"author"`
+	AuthorID  string            `json:"author_id,omitempty"`
+	Text      string            `json:"text"`
+	Timestamp time.Time         `json:"timestamp"`
+	Edited    bool              `json:"edited,omitempty"`
+	Reactions []ReactionContent `json:"reactions,omitempty"`
+	Links     []LinkContent     `json:"links,omitempty"`
+	Files     []string          `json:"files,omitempty"`
+}
+
+// ReactionContent is an emoji reaction and how many people added it.
+type ReactionContent struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// LinkContent is an unfurled link.
+type LinkContent struct {
+	Title   string `json:"title,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Text    string `json:"text,omitempty"`
+	Service string `json:"service,omitempty"`
+}
+
+// Thread is a top-level message with its conversation and replies.
+type Thread struct {
+	Workspace    *AuthInfo
+	Conversation *Conversation
+	Message      *Message
+	Replies      []Message
+}
+
+// ThreadToRawDocument converts a message and its thread replies to a
+// RawDocument with Slack message JSON content.
+func ThreadToRawDocument(thread *Thread, dir Directory, sourceID string) (*domain.RawDocument, error) {
+	workspace, conv, msg := thread.Workspace, thread.Conversation, thread.Message
+	channelName := ConversationName(conv, dir)
+
+	content := ThreadContent{
+		Workspace:   workspace.Team,
+		Channel:     channelName,
+		PostContent: postContent(msg, dir),
+	}
+	for i := range thread.Replies {
+		if thread.Replies[i].IsIndexable() {
+			content.Replies = append(content.Replies, postContent(&thread.Replies[i], dir))
+		}
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("encode message %s: %w", msg.TS, err)
+	}
+
+	metadata := map[string]any{
+		"workspace_id":   workspace.TeamID,
+		"workspace_name": workspace.Team,
+		"channel_id":     conv.ID,
+		"channel_name":   channelName,
+		"channel_type":   conv.Type(),
+		"ts":             msg.TS,
+		"author":         content.Author,
+		"author_id":      msg.User,
+		"title":          fmt.Sprintf("%s in %s", content.Author, channelName),
+		"timestamp":      content.Timestamp.Format(time.RFC3339),
+		"reply_count":    len(content.Replies),
+	}
+	if permalink := Permalink(workspace.URL, conv.ID, msg.TS); permalink != "" {
+		metadata["url"] = permalink
+	}
+
+	parentURI := ConversationURI(workspace.TeamID, conv.ID)
+
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       MessageURI(workspace.TeamID, conv.ID, msg.TS),
+		MIMEType:  MIMETypeSlackMessage,
+		Content:   data,
+		Metadata:  metadata,
+		ParentURI: &parentURI,
+	}, nil
+}
+
+// postContent converts a message to its JSON content.
+func postContent(msg *Message, dir Directory) PostContent {
+	post := PostContent{
+		Author:    dir.author(msg),
+		AuthorID:  msg.User,
+		Text:      dir.resolveMentions(msg.Text),
+		Timestamp: tsTime(msg.TS),
+		Edited:    msg.Edited != nil,
+	}
+	for _, r := range msg.Reactions {
+		post.Reactions = append(post.Reactions, ReactionContent(r))
+	}
+	for _, a := range msg.Attachments {
+		link := LinkContent{
+			Title:   a.Title,
+			URL:     firstNonEmpty(a.TitleLink, a.FromURL, a.OriginalURL),
+			Text:    firstNonEmpty(a.Text, a.Fallback),
+			Service: a.ServiceName,
+		}
+		if link != (LinkContent{}) {
+			post.Links = append(post.Links, link)
+		}
+	}
+	for _, f := range msg.Files {
+		if name := firstNonEmpty(f.Title, f.Name); name != "" {
+			post.Files = append(post.Files, name)
+		}
+	}
+	return post
+}
+
+// ConversationURI returns the URI of a conversation.
+func ConversationURI(workspaceID, channelID string) string {
+	return fmt.Sprintf("slack://%s/%s", workspaceID, channelID)
+}
+
+// MessageURI returns the document URI for a message.
+func MessageURI(workspaceID, channelID, ts string) string {
+	return fmt.Sprintf("slack://%s/%s/%s", workspaceID, channelID, ts)
+}
+
+// Permalink returns the web link to a message in a workspace, such as
+// https://acme.slack.com/archives/C024BE91L/p1355517523000008.
+func Permalink(workspaceURL, channelID, ts string) string {
+	if workspaceURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/archives/%s/p%s",
+		strings.TrimSuffix(workspaceURL, "/"), channelID, strings.ReplaceAll(ts, ".", ""))
+}
+
+// tsTime returns the time of a message timestamp such as "1355517523.000008".
+func tsTime(ts string) time.Time {
+	secs, micros, ok := parseTS(ts)
+	if !ok {
+		return time.Time{}
+	}
+	return time.Unix(secs, micros*int64(time.Microsecond)).UTC()
+}
+
+// tsAfter reports whether message timestamp a is newer than b.
+// Any valid timestamp is newer than an empty or invalid one.
+func tsAfter(a, b string) bool {
+	as, am, ok := parseTS(a)
+	if !ok {
+		return false
+	}
+	bs, bm, ok := parseTS(b)
+	if !ok {
+		return true
+	}
+	return as > bs || (as == bs && am > bm)
+}
+
+// timeTS formats a time as a message timestamp, for the oldest parameter.
+func timeTS(t time.Time) string {
+	return fmt.Sprintf("%d.%06d", t.Unix(), t.Nanosecond()/int(time.Microsecond))
+}
+
+// parseTS splits a message timestamp into seconds and microseconds.
+func parseTS(ts string) (secs, micros int64, ok bool) {
+	whole, frac, _ := strings.Cut(ts, ".")
+	secs, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if frac != "" {
+		if len(frac) > 6 {
+			frac = frac[:6]
+		}
+		frac += strings.Repeat("0", 6-len(frac))
+		if micros, err = strconv.ParseInt(frac, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+	return secs, micros, true
+}
+
+// firstNonEmpty returns the first non-empty string.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package slack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTSAfter(t *testing.T) {
+	assert.True(t, tsAfter("1767225600.000200", "1767225600.000100"))
+	assert.True(t, tsAfter("1767225601.000000", "1767225600.999999"))
+	assert.False(t, tsAfter("1767225600.000100", "1767225600.000100"))
+	assert.True(t, tsAfter("1767225600.000100", ""))
+	assert.False(t, tsAfter("", "1767225600.000100"))
+}
+
+func TestTSTime(t *testing.T) {
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 100000, time.UTC), tsTime("1767225600.000100"))
+	assert.True(t, tsTime("invalid").IsZero())
+	assert.Equal(t, "1767225600.000100", timeTS(tsTime("1767225600.000100")))
+}
+
+func TestMessage_IsIndexable(t *testing.T) {
+	assert.True(t, (&Message{Text: "hello"}).IsIndexable())
+	assert.True(t, (&Message{Subtype: "bot_message", Text: "build passed"}).IsIndexable())
+	assert.True(t, (&Message{Subtype: "file_share", Files: []File{{Name: "a.pdf"}}}).IsIndexable())
+	assert.False(t, (&Message{Subtype: "channel_join", Text: "<@U1> has joined"}).IsIndexable())
+	assert.False(t, (&Message{Text: "  "}).IsIndexable())
+}
+
+func TestMessage_Threads(t *testing.T) {
+	parent := &Message{TS: "1.000001", ThreadTS: "1.000001", ReplyCount: 2}
+	reply := &Message{TS: "2.000001", ThreadTS: "1.000001"}
+
+	assert.True(t, parent.HasReplies())
+	assert.False(t, parent.IsReply())
+	assert.True(t, reply.IsReply())
+	assert.False(t, reply.HasReplies())
+}
+
+func TestConversationName(t *testing.T) {
+	dir := Directory{"U2": "Bob"}
+
+	assert.Equal(t, "#general", ConversationName(&Conversation{Name: "general"}, dir))
+	assert.Equal(t, "DM with Bob", ConversationName(&Conversation{IsIM: true, User: "U2"}, dir))
+	assert.Equal(t, "DM with U3", ConversationName(&Conversation{IsIM: true, User: "U3"}, dir))
+	assert.Equal(t, "Group DM with ann-lee, bob, carol",
+		ConversationName(&Conversation{IsMPIM: true, Name: "mpdm-ann-lee--bob--carol-1"}, dir))
+}
+
+func TestDirectory_ResolveMentions(t *testing.T) {
+	dir := Directory{"U1": "Ann"}
+
+	assert.Equal(t, "ping @Ann and @U9", dir.resolveMentions("ping <@U1> and <@U9|old>"))
+	assert.Equal(t, "see <#C1|general>", dir.resolveMentions("see <#C1|general>"))
+}
+
+func TestPermalink(t *testing.T) {
+	assert.Equal(t, "https://acme.slack.com/archives/C1/p1355517523000008",
+		Permalink("https://acme.slack.com/", "C1", "1355517523.000008"))
+	assert.Empty(t, Permalink("", "C1", "1355517523.000008"))
+}
@@ -0,0 +1,217 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// OAuthHandler implements OAuth operations for Slack.
+// Slack's OAuth v2 flow installs the app as a bot: the token it returns is a
+// bot token (xoxb-). Scopes are comma-separated, errors come back in the
+// body of 200 responses, and PKCE is not supported.
+type OAuthHandler struct {
+	apiURL string
+}
+
+// NewOAuthHandler creates a new Slack OAuth handler.
+func NewOAuthHandler() *OAuthHandler {
+	return &OAuthHandler{apiURL: apiBaseURL}
+}
+
+// BuildAuthURL constructs the Slack OAuth authorization URL.
+func (h *OAuthHandler) BuildAuthURL(
+	authProvider *domain.AuthProvider,
+	redirectURI, state, _ string, // codeChallenge unused - Slack doesn't support PKCE
+) string {
+	cfg := authProvider.OAuth
+	authURL := cfg.AuthURL
+	if authURL == "" {
+		authURL = defaultAuthURL
+	}
+
+	// Use default scopes if none configured
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	params := url.Values{
+		"client_id":    {cfg.ClientID},
+		"redirect_uri": {redirectURI},
+		"state":        {state},
+		// Slack uses comma-separated bot scopes
+		"scope": {strings.Join(scopes, ",")},
+	}
+
+	return authURL + "?" + params.Encode()
+}
+
+// ExchangeCode exchanges an authorization code for a bot token.
+func (h *OAuthHandler) ExchangeCode(
+	ctx context.Context,
+	authProvider *domain.AuthProvider,
+	code, redirectURI, _ string, // codeVerifier unused - Slack doesn't support PKCE
+) (*domain.OAuthToken, error) {
+	cfg := authProvider.OAuth
+	tokenURL := cfg.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+
+	resp, err := requestToken(ctx, tokenURL, url.Values{
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.token(""), nil
+}
+
+// RefreshToken refreshes an expired access token using a refresh token.
+// Slack only issues refresh tokens to apps with token rotation enabled;
+// other bot tokens do not expire.
+func (h *OAuthHandler) RefreshToken(
+	ctx context.Context,
+	authProvider *domain.AuthProvider,
+	refreshToken string,
+) (*domain.OAuthToken, error) {
+	cfg := authProvider.OAuth
+	tokenURL := cfg.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+
+	resp, err := requestToken(ctx, tokenURL, url.Values{
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.token(refreshToken), nil
+}
+
+// GetUserInfo fetches the workspace the bot token was issued for.
+// The identifier is the workspace host, such as acme.slack.com.
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (domain.AccountInfo, error) {
+	client := NewClient(staticToken(accessToken))
+	client.baseURL = h.apiURL
+	info, err := client.AuthTest(ctx)
+	if err != nil {
+		return domain.AccountInfo{}, err
+	}
+	return domain.AccountInfo{
+		Identifier:  workspaceIdentifier(info),
+		DisplayName: info.Team,
+	}, nil
+}
+
+// DefaultConfig returns default OAuth URLs and bot scopes for Slack.
+func (h *OAuthHandler) DefaultConfig() driven.OAuthDefaults {
+	return driven.OAuthDefaults{
+		AuthURL:  defaultAuthURL,
+		TokenURL: defaultTokenURL,
+		Scopes:   defaultScopes,
+	}
+}
+
+// SetupHint returns guidance for setting up a Slack OAuth app.
+func (h *OAuthHandler) SetupHint() string {
+	return "Create an app at api.slack.com/apps and add the bot scopes under OAuth & Permissions"
+}
+
+// Slack OAuth constants.
+const (
+	defaultAuthURL = "https://slack.com/oauth/v2/authorize"
+	//nolint:gosec // G101: Not credentials, OAuth endpoint URL
+	defaultTokenURL = "https://slack.com/api/oauth.v2.access"
+)
+
+// defaultScopes are the bot scopes needed to read conversations and resolve
+// user names.
+var defaultScopes = []string{
+	"channels:history",
+	"channels:read",
+	"groups:history",
+	"groups:read",
+	"im:history",
+	"im:read",
+	"mpim:history",
+	"mpim:read",
+	"users:read",
+}
+
+// tokenResponse is Slack's oauth.v2.access response.
+type tokenResponse struct {
+	OK           bool   `json:"ok"`
+	Error        string `json:"error"`
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int    `json:"expires_in,omitempty"`
+}
+
+// token converts the response, keeping fallbackRefresh if none was returned.
+func (r *tokenResponse) token(fallbackRefresh string) *domain.OAuthToken {
+	token := &domain.OAuthToken{
+		AccessToken:  r.AccessToken,
+		RefreshToken: r.RefreshToken,
+		TokenType:    "Bearer",
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = fallbackRefresh
+	}
+	if r.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+	return token
+}
+
+// requestToken posts a token request to oauth.v2.access.
+func requestToken(ctx context.Context, tokenURL string, params url.Values) (*tokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request failed with status %d", resp.StatusCode)
+	}
+
+	var tokenResp tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, fmt.Errorf("decode token response: %w", err)
+	}
+	if !tokenResp.OK {
+		return nil, fmt.Errorf("token request failed: %s", tokenResp.Error)
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token response has no access token")
+	}
+
+	return &tokenResp, nil
+}
@@ -0,0 +1,116 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestOAuthHandler_DefaultConfig(t *testing.T) {
+	defaults := NewOAuthHandler().DefaultConfig()
+
+	assert.Equal(t, defaultAuthURL, defaults.AuthURL)
+	assert.Equal(t, defaultTokenURL, defaults.TokenURL)
+	assert.Contains(t, defaults.Scopes, "channels:history")
+	assert.Contains(t, defaults.Scopes, "users:read")
+}
+
+func TestOAuthHandler_SetupHint(t *testing.T) {
+	assert.Contains(t, NewOAuthHandler().SetupHint(), "api.slack.com/apps")
+}
+
+func TestOAuthHandler_BuildAuthURL(t *testing.T) {
+	authProvider := &domain.AuthProvider{
+		OAuth: &domain.OAuthProviderConfig{ClientID: "client-id"},
+	}
+
+	authURL := NewOAuthHandler().BuildAuthURL(authProvider, "http://localhost:18080/callback", "state-1", "challenge")
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "slack.com", parsed.Host)
+	query := parsed.Query()
+	assert.Equal(t, "client-id", query.Get("client_id"))
+	assert.Equal(t, "http://localhost:18080/callback", query.Get("redirect_uri"))
+	assert.Equal(t, "state-1", query.Get("state"))
+	assert.Contains(t, query.Get("scope"), "channels:history,channels:read")
+	assert.Empty(t, query.Get("code_challenge"))
+}
+
+// newTokenServer returns an oauth.v2.access endpoint that records the form it receives.
+func newTokenServer(t *testing.T, form *url.Values, body string) *domain.AuthProvider {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		*form = r.PostForm
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return &domain.AuthProvider{OAuth: &domain.OAuthProviderConfig{
+		ClientID:     "client-id",
+		ClientSecret: "secret",
+		TokenURL:     server.URL,
+	}}
+}
+
+func TestOAuthHandler_ExchangeCode(t *testing.T) {
+	var form url.Values
+	authProvider := newTokenServer(t, &form,
+		`{"ok":true,"access_token":"xoxb-1","token_type":"bot","team":{"id":"T1","name":"Acme"}}`)
+
+	token, err := NewOAuthHandler().ExchangeCode(context.Background(), authProvider,
+		"code-1", "http://localhost/callback", "verifier")
+
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-1", token.AccessToken)
+	assert.Empty(t, token.RefreshToken)
+	assert.True(t, token.Expiry.IsZero())
+	assert.Equal(t, "code-1", form.Get("code"))
+	assert.Equal(t, "secret", form.Get("client_secret"))
+}
+
+func TestOAuthHandler_ExchangeCode_Error(t *testing.T) {
+	var form url.Values
+	authProvider := newTokenServer(t, &form, `{"ok":false,"error":"invalid_code"}`)
+
+	_, err := NewOAuthHandler().ExchangeCode(context.Background(), authProvider,
+		"code-1", "http://localhost/callback", "")
+
+	assert.ErrorContains(t, err, "invalid_code")
+}
+
+func TestOAuthHandler_RefreshToken(t *testing.T) {
+	var form url.Values
+	authProvider := newTokenServer(t, &form,
+		`{"ok":true,"access_token":"xoxe.xoxb-2","refresh_token":"xoxe-2","expires_in":43200}`)
+
+	token, err := NewOAuthHandler().RefreshToken(context.Background(), authProvider, "xoxe-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "xoxe.xoxb-2", token.AccessToken)
+	assert.Equal(t, "xoxe-2", token.RefreshToken)
+	assert.False(t, token.Expiry.IsZero())
+	assert.Equal(t, "refresh_token", form.Get("grant_type"))
+	assert.Equal(t, "xoxe-1", form.Get("refresh_token"))
+}
+
+func TestOAuthHandler_GetUserInfo(t *testing.T) {
+	server := httptest.NewServer(newFakeSlack())
+	t.Cleanup(server.Close)
+	handler := NewOAuthHandler()
+	handler.apiURL = server.URL
+
+	info, err := handler.GetUserInfo(context.Background(), "xoxb-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme.slack.com", info.Identifier)
+	assert.Equal(t, "Acme", info.DisplayName)
+}
@@ -0,0 +1,86 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Tier is a Slack Web API rate limit tier.
+// See https://api.slack.com/apis/rate-limits.
+type Tier int
+
+// Rate limit tiers and their documented minimum requests per minute.
+const (
+	Tier2 Tier = 20
+	Tier3 Tier = 50
+	Tier4 Tier = 100
+)
+
+// methodTiers maps the Web API methods the connector calls to their tier.
+var methodTiers = map[string]Tier{
+	"auth.test":             Tier4,
+	"conversations.info":    Tier3,
+	"conversations.list":    Tier2,
+	"conversations.history": Tier3,
+	"conversations.replies": Tier3,
+	"users.list":            Tier2,
+}
+
+// BurstSize is the maximum burst size of each tier's bucket.
+const BurstSize = 3
+
+// RateLimiter provides rate limiting for Slack API requests.
+// Each tier has its own token bucket, as Slack counts requests per method,
+// with a shared backoff period after 429 responses.
+type RateLimiter struct {
+	mu      sync.Mutex
+	buckets map[Tier]*rate.Limiter
+	retryAt time.Time
+}
+
+// NewRateLimiter creates a new rate limiter for Slack.
+func NewRateLimiter() *RateLimiter {
+	buckets := make(map[Tier]*rate.Limiter)
+	for _, tier := range []Tier{Tier2, Tier3, Tier4} {
+		buckets[tier] = rate.NewLimiter(rate.Limit(float64(tier)/60), BurstSize)
+	}
+	return &RateLimiter{buckets: buckets}
+}
+
+// Wait blocks until a request to the method can be made without exceeding
+// its tier's rate limit. It also respects any backoff period set by
+// RecordRateLimitError. Unknown methods are limited as Tier 2.
+func (r *RateLimiter) Wait(ctx context.Context, method string) error {
+	r.mu.Lock()
+	retryAt := r.retryAt
+	tier, ok := methodTiers[method]
+	if !ok {
+		tier = Tier2
+	}
+	bucket := r.buckets[tier]
+	r.mu.Unlock()
+
+	if time.Now().Before(retryAt) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(retryAt)):
+		}
+	}
+
+	return bucket.Wait(ctx)
+}
+
+// RecordRateLimitError sets a backoff period after a 429 response.
+// Slack reports how long to wait; a missing value backs off for one second.
+func (r *RateLimiter) RecordRateLimitError(retryAfter time.Duration) {
+	if retryAfter <= 0 {
+		retryAfter = time.Second
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.retryAt = time.Now().Add(retryAfter)
+}
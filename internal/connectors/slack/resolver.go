@@ -0,0 +1,23 @@
+package slack
+
+import "strings"
+
+// ResolveWebURL converts a slack:// URI to a web URL.
+// URI format: slack://{workspaceId}/{channelId}/{ts}.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	// Priority 1: Use the permalink built from the workspace URL
+	if u, ok := metadata["url"].(string); ok && u != "" {
+		return u
+	}
+
+	// Priority 2: Open the conversation in the web client
+	rest, ok := strings.CutPrefix(uri, "slack://")
+	if !ok || rest == "" {
+		return ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) < 2 {
+		return "https://app.slack.com/client/" + parts[0]
+	}
+	return "https://app.slack.com/client/" + parts[0] + "/" + parts[1]
+}
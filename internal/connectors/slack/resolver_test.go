@@ -0,0 +1,49 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveWebURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		metadata map[string]any
+		want     string
+	}{
+		{
+			name:     "permalink from metadata",
+			uri:      "slack://T1/C1/1355517523.000008",
+			metadata: map[string]any{"url": "https://acme.slack.com/archives/C1/p1355517523000008"},
+			want:     "https://acme.slack.com/archives/C1/p1355517523000008",
+		},
+		{
+			name: "message without permalink",
+			uri:  "slack://T1/C1/1355517523.000008",
+			want: "https://app.slack.com/client/T1/C1",
+		},
+		{
+			name: "workspace",
+			uri:  "slack://T1",
+			want: "https://app.slack.com/client/T1",
+		},
+		{
+			name: "non-slack URI",
+			uri:  "discord://100/200",
+			want: "",
+		},
+		{
+			name: "prefix only",
+			uri:  "slack://",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveWebURL(tt.uri, tt.metadata))
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Supported database types.
+const (
+	DBTypeSQLite = "sqlite"
+	DBTypeDuckDB = "duckdb"
+)
+
+var (
+	// ErrMissingPath indicates the source has no database path configured.
+	ErrMissingPath = errors.New("sqlite source requires 'path' config")
+	// ErrInvalidDBType indicates db_type is neither "sqlite" nor "duckdb".
+	ErrInvalidDBType = errors.New("db_type must be 'sqlite' or 'duckdb'")
+	// ErrQueryTables indicates a query override was combined with several tables.
+	ErrQueryTables = errors.New("a 'query' override can name at most one table")
+)
+
+// DefaultIDColumn is the ID column used when none is configured.
+const DefaultIDColumn = "id"
+
+// Config holds SQLite connector configuration.
+type Config struct {
+	// Path is the database file to read.
+	Path string
+	// DBType is DBTypeSQLite or DBTypeDuckDB.
+	DBType string
+	// Tables lists the tables to read rows from. Empty means every table.
+	// When Query is set the single table only names the rows in document URIs.
+	Tables []string
+	// Query is an optional SELECT statement used instead of reading tables.
+	Query string
+	// IDColumn holds the unique row identifier (default: "id").
+	IDColumn string
+	// TitleColumn holds the document title (optional).
+	TitleColumn string
+	// BodyColumn holds the document body (optional). When empty, every other
+	// column is rendered into the body.
+	BodyColumn string
+	// TextColumns maps a table name to the columns whose values, joined with
+	// spaces, form the document body. The "" entry applies to tables without
+	// their own list. A list takes precedence over BodyColumn.
+	TextColumns map[string][]string
+	// TimestampColumn holds the last-modified time (optional). When set it is
+	// used as the incremental sync cursor.
+	TimestampColumn string
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		DBType:      DBTypeSQLite,
+		TextColumns: make(map[string][]string),
+		IDColumn:    DefaultIDColumn,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	cfg.Path = expandHome(configValue(source, "path", "db_path"))
+	if cfg.Path == "" {
+		return nil, ErrMissingPath
+	}
+
+	switch dbType := strings.ToLower(strings.TrimSpace(source.Config["db_type"])); dbType {
+	case "":
+		// Infer from the file extension
+		if strings.EqualFold(filepath.Ext(cfg.Path), ".duckdb") {
+			cfg.DBType = DBTypeDuckDB
+		}
+	case DBTypeSQLite, DBTypeDuckDB:
+		cfg.DBType = dbType
+	default:
+		return nil, fmt.Errorf("%w, got %q", ErrInvalidDBType, dbType)
+	}
+
+	cfg.Tables = splitList(configValue(source, "table", "tables"), ",")
+	cfg.Query = strings.TrimSpace(source.Config["query"])
+	if cfg.Query != "" && len(cfg.Tables) > 1 {
+		return nil, ErrQueryTables
+	}
+
+	textColumns, err := parseTextColumns(source.Config["text_columns"])
+	if err != nil {
+		return nil, err
+	}
+	cfg.TextColumns = textColumns
+
+	if val := strings.TrimSpace(source.Config["id_column"]); val != "" {
+		cfg.IDColumn = val
+	}
+	cfg.TitleColumn = strings.TrimSpace(source.Config["title_column"])
+	cfg.BodyColumn = strings.TrimSpace(source.Config["body_column"])
+	cfg.TimestampColumn = configValue(source, "timestamp_column", "updated_at_column")
+
+	return cfg, nil
+}
+
+// configValue returns the first non-empty value among a key and its aliases.
+func configValue(source domain.Source, keys ...string) string {
+	for _, key := range keys {
+		if val := strings.TrimSpace(source.Config[key]); val != "" {
+			return val
+		}
+	}
+	return ""
+}
+
+// parseTextColumns parses per-table column lists of the form
+// "notes:title,body; tasks:summary". A list without a table prefix, such as
+// "title,body", applies to every table without its own list.
+func parseTextColumns(value string) (map[string][]string, error) {
+	result := make(map[string][]string)
+	for _, entry := range splitList(value, ";") {
+		table := ""
+		columns := entry
+		if i := strings.Index(entry, ":"); i >= 0 {
+			table = strings.TrimSpace(entry[:i])
+			columns = entry[i+1:]
+			if table == "" {
+				return nil, fmt.Errorf("text_columns entry %q has an empty table name", entry)
+			}
+		}
+		cols := splitList(columns, ",")
+		if len(cols) == 0 {
+			return nil, fmt.Errorf("text_columns entry %q lists no columns", entry)
+		}
+		result[table] = append(result[table], cols...)
+	}
+	return result, nil
+}
+
+// TextColumnsFor returns the text columns configured for a table, or nil
+// if none apply.
+func (c *Config) TextColumnsFor(table string) []string {
+	for name, cols := range c.TextColumns {
+		if name != "" && strings.EqualFold(name, table) {
+			return cols
+		}
+	}
+	return c.TextColumns[""]
+}
+
+// QueryName returns the name used for rows of the query override.
+func (c *Config) QueryName() string {
+	if len(c.Tables) == 1 {
+		return c.Tables[0]
+	}
+	return "query"
+}
+
+// splitList splits a separated list, dropping empty entries.
+func splitList(value, sep string) []string {
+	var out []string
+	for _, part := range strings.Split(value, sep) {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// expandHome expands a leading "~" to the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
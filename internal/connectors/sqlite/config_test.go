@@ -0,0 +1,157 @@
+package sqlite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestParseConfig(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"path":             "/data/notes.db",
+		"table":            "notes",
+		"title_column":     "title",
+		"body_column":      "body",
+		"timestamp_column": "updated_at",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "/data/notes.db", cfg.Path)
+	assert.Equal(t, DBTypeSQLite, cfg.DBType)
+	assert.Equal(t, []string{"notes"}, cfg.Tables)
+	assert.Equal(t, DefaultIDColumn, cfg.IDColumn)
+	assert.Equal(t, "title", cfg.TitleColumn)
+	assert.Equal(t, "body", cfg.BodyColumn)
+	assert.Equal(t, "updated_at", cfg.TimestampColumn)
+}
+
+func TestParseConfig_Query(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"path":      "/data/notes.db",
+		"query":     "SELECT * FROM notes WHERE archived = 0",
+		"id_column": "note_id",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "note_id", cfg.IDColumn)
+	assert.Equal(t, "query", cfg.QueryName())
+
+	cfg.Tables = []string{"notes"}
+	assert.Equal(t, "notes", cfg.QueryName())
+}
+
+func TestParseConfig_Tables(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"path":         "/data/work.db",
+		"table":        "notes, tasks,",
+		"text_columns": "notes:title,body; tasks:summary",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"notes", "tasks"}, cfg.Tables)
+	assert.Equal(t, []string{"title", "body"}, cfg.TextColumnsFor("notes"))
+	assert.Equal(t, []string{"summary"}, cfg.TextColumnsFor("TASKS"))
+	assert.Nil(t, cfg.TextColumnsFor("other"))
+
+	cfg, err = ParseConfig(domain.Source{Config: map[string]string{"path": "/data/work.db"}})
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Tables)
+}
+
+func TestParseConfig_DefaultTextColumns(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"path":         "/data/work.db",
+		"text_columns": "title, body; tasks:summary",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"title", "body"}, cfg.TextColumnsFor("notes"))
+	assert.Equal(t, []string{"summary"}, cfg.TextColumnsFor("tasks"))
+}
+
+func TestParseConfig_AliasKeys(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"db_path":           "/data/work.db",
+		"db_type":           "sqlite",
+		"tables":            "notes, tasks",
+		"text_columns":      "notes:title,body",
+		"updated_at_column": "modified",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "/data/work.db", cfg.Path)
+	assert.Equal(t, []string{"notes", "tasks"}, cfg.Tables)
+	assert.Equal(t, "modified", cfg.TimestampColumn)
+
+	// The primary key wins when both are set
+	cfg, err = ParseConfig(domain.Source{Config: map[string]string{"path": "/a.db", "db_path": "/b.db"}})
+	require.NoError(t, err)
+	assert.Equal(t, "/a.db", cfg.Path)
+}
+
+func TestParseConfig_DBType(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]string
+		want   string
+	}{
+		{"explicit duckdb", map[string]string{"path": "/data/a.db", "db_type": "DuckDB"}, DBTypeDuckDB},
+		{"inferred duckdb", map[string]string{"path": "/data/a.duckdb"}, DBTypeDuckDB},
+		{"default sqlite", map[string]string{"path": "/data/a.sqlite3"}, DBTypeSQLite},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseConfig(domain.Source{Config: tt.config})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cfg.DBType)
+		})
+	}
+}
+
+func TestParseConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]string
+		wantErr error
+	}{
+		{"missing path", map[string]string{"table": "notes"}, ErrMissingPath},
+		{"invalid type", map[string]string{"path": "/a.db", "db_type": "postgres"}, ErrInvalidDBType},
+		{"query with tables", map[string]string{"path": "/a.db", "table": "a,b", "query": "SELECT 1"}, ErrQueryTables},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig(domain.Source{Config: tt.config})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := ParseConfig(domain.Source{Config: map[string]string{"path": "/a.db", "text_columns": ":title"}})
+	assert.Error(t, err)
+	_, err = ParseConfig(domain.Source{Config: map[string]string{"path": "/a.db", "text_columns": "notes:"}})
+	assert.Error(t, err)
+}
+
+func TestParseConfig_ExpandsHome(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{"path": "~/notes.db", "table": "notes"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, "notes.db"), cfg.Path)
+}
@@ -0,0 +1,441 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Helper columns appended to every query to read the timestamp as SQLite stores it.
+const (
+	tsTypeColumn  = "_sercha_ts_type"
+	tsValueColumn = "_sercha_ts_value"
+)
+
+// target is a named row set: a table or the query override.
+type target struct {
+	name  string
+	query string
+}
+
+// Connector reads rows from a local SQLite or DuckDB database, one document
+// per row. The database is opened read-only.
+type Connector struct {
+	sourceID string
+	config   *Config
+	log      *slog.Logger
+	mu       sync.Mutex
+	closed   bool
+}
+
+// New creates a new SQLite connector.
+func New(sourceID string, cfg *Config) *Connector {
+	return &Connector{
+		sourceID: sourceID,
+		config:   cfg,
+		log:      slog.New(slog.DiscardHandler),
+	}
+}
+
+// SetLogger sets the structured logger for this connector.
+func (c *Connector) SetLogger(log *slog.Logger) {
+	if log != nil {
+		c.log = log
+	}
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "sqlite"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+// Incremental sync requires a timestamp column.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	incremental := c.config.TimestampColumn != ""
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  incremental,
+		SupportsWatch:        false,
+		SupportsHierarchy:    false,
+		SupportsBinary:       false,
+		RequiresAuth:         false,
+		SupportsValidation:   true,
+		SupportsCursorReturn: incremental,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: false,
+		SupportsPagination:   false,
+	}
+}
+
+// Validate checks that the database can be opened and that every table has
+// the configured ID, timestamp and text columns.
+func (c *Connector) Validate(ctx context.Context) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	db, err := c.open()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	targets, err := c.targets(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range targets {
+		if err := c.validateTarget(ctx, db, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTarget checks the configured columns exist in one table.
+func (c *Connector) validateTarget(ctx context.Context, db *sql.DB, t target) error {
+	rows, err := db.QueryContext(ctx, "SELECT * FROM ("+t.query+") AS src LIMIT 0")
+	if err != nil {
+		return fmt.Errorf("query %s: %w", t.name, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("read columns: %w", err)
+	}
+
+	row := Row{Columns: columns, Values: make([]any, len(columns))}
+	if _, ok := row.Get(c.config.IDColumn); !ok {
+		return fmt.Errorf("id column %q not found in %s", c.config.IDColumn, t.name)
+	}
+	if c.config.TimestampColumn != "" {
+		if _, ok := row.Get(c.config.TimestampColumn); !ok {
+			return fmt.Errorf("timestamp column %q not found in %s", c.config.TimestampColumn, t.name)
+		}
+	}
+	for _, col := range c.config.TextColumnsFor(t.name) {
+		if _, ok := row.Get(col); !ok {
+			return fmt.Errorf("text column %q not found in %s", col, t.name)
+		}
+	}
+	return nil
+}
+
+// FullSync reads every row of every table.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runSync(ctx, NewCursor(), func(doc *domain.RawDocument) error {
+			return c.sendDocument(ctx, docsChan, doc)
+		})
+	}()
+
+	return docsChan, errsChan
+}
+
+// IncrementalSync reads rows whose timestamp is at or after the cursor.
+// Rows sharing the last timestamp are read again, so rows written within the
+// same timestamp as the previous sync are not missed. Deleted rows are only
+// removed by a full sync.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+
+		cursor, err := DecodeCursor(state.Cursor)
+		if err != nil {
+			errsChan <- fmt.Errorf("invalid cursor, full sync required: %w", err)
+			return
+		}
+
+		errsChan <- c.runSync(ctx, cursor, func(doc *domain.RawDocument) error {
+			change := domain.RawDocumentChange{Type: domain.ChangeUpdated, Document: *doc}
+			return c.sendChange(ctx, changesChan, &change)
+		})
+	}()
+
+	return changesChan, errsChan
+}
+
+// runSync reads rows after cursor from every table and passes each document to emit.
+func (c *Connector) runSync(ctx context.Context, cursor *Cursor, emit func(*domain.RawDocument) error) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	db, err := c.open()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	targets, err := c.targets(ctx, db)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 1 {
+		cursor.Adopt(targets[0].name)
+	}
+
+	for _, t := range targets {
+		if err := c.syncTarget(ctx, db, t, cursor, emit); err != nil {
+			return err
+		}
+	}
+
+	if c.config.TimestampColumn == "" {
+		return &driven.SyncComplete{}
+	}
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// syncTarget reads the rows of one table after its cursor position.
+//
+//nolint:gocognit // Row scanning with cursor tracking
+func (c *Connector) syncTarget(
+	ctx context.Context, db *sql.DB, t target, cursor *Cursor, emit func(*domain.RawDocument) error,
+) error {
+	query, args, err := c.syncQuery(t, cursor)
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("query %s: %w", t.name, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("read columns: %w", err)
+	}
+
+	// The timestamp helper columns are last and not part of the row
+	dataColumns := len(columns)
+	if c.config.TimestampColumn != "" {
+		dataColumns -= 2
+	}
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		values := make([]any, len(columns))
+		ptrs := make([]any, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return fmt.Errorf("scan row: %w", err)
+		}
+
+		row := &Row{Columns: columns[:dataColumns], Values: values[:dataColumns]}
+		if c.config.TimestampColumn != "" {
+			cursor.Set(t.name, formatValue(values[dataColumns]), formatValue(values[dataColumns+1]))
+		}
+
+		doc, ok := RowToRawDocument(row, t.name, c.config, c.sourceID)
+		if !ok {
+			c.log.Debug("skipping row without id", "table", t.name, "column", c.config.IDColumn)
+			continue
+		}
+		if err := emit(doc); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read rows: %w", err)
+	}
+	return nil
+}
+
+// syncQuery builds the row query for a table, filtered by its cursor position.
+func (c *Connector) syncQuery(t target, cursor *Cursor) (string, []any, error) {
+	ts := c.config.TimestampColumn
+	if ts == "" {
+		return "SELECT * FROM (" + t.query + ") AS src", nil, nil
+	}
+
+	col := quoteIdent(ts)
+	query := fmt.Sprintf("SELECT *, typeof(%s) AS %s, CAST(%s AS TEXT) AS %s FROM (%s) AS src",
+		col, tsTypeColumn, col, tsValueColumn, t.query)
+
+	var args []any
+	if pos, ok := cursor.Get(t.name); ok {
+		arg, err := pos.Arg()
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid cursor, full sync required: %w", err)
+		}
+		query += " WHERE " + col + " >= ?"
+		args = append(args, arg)
+	}
+
+	return query + " ORDER BY " + col, args, nil
+}
+
+// targets returns the row sets to read: the query override, the configured
+// tables, or every table in the database.
+func (c *Connector) targets(ctx context.Context, db *sql.DB) ([]target, error) {
+	if c.config.Query != "" {
+		query := strings.TrimSuffix(strings.TrimSpace(c.config.Query), ";")
+		return []target{{name: c.config.QueryName(), query: query}}, nil
+	}
+
+	tables := c.config.Tables
+	if len(tables) == 0 {
+		var err error
+		if tables, err = c.listTables(ctx, db); err != nil {
+			return nil, err
+		}
+		if len(tables) == 0 {
+			return nil, fmt.Errorf("database has no tables: %s", c.config.Path)
+		}
+	}
+
+	targets := make([]target, len(tables))
+	for i, table := range tables {
+		targets[i] = target{name: table, query: "SELECT * FROM " + quoteIdent(table)}
+	}
+	return targets, nil
+}
+
+// listTables returns the name of every user table.
+func (c *Connector) listTables(ctx context.Context, db *sql.DB) ([]string, error) {
+	d, err := dialectFor(c.config.DBType)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, d.listTables)
+	if err != nil {
+		return nil, fmt.Errorf("list tables: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("list tables: %w", err)
+		}
+		tables = append(tables, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list tables: %w", err)
+	}
+	return tables, nil
+}
+
+// open opens the database read-only.
+func (c *Connector) open() (*sql.DB, error) {
+	d, err := dialectFor(c.config.DBType)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(c.config.Path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("database does not exist: %s", c.config.Path)
+		}
+		return nil, fmt.Errorf("failed to access database: %w", err)
+	}
+
+	db, err := sql.Open(d.driver, d.dsn(c.config.Path))
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	return db, nil
+}
+
+// quoteIdent quotes an SQL identifier.
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// sendDocument sends a document to the channel.
+func (c *Connector) sendDocument(
+	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case docsChan <- *doc:
+		return nil
+	}
+}
+
+// sendChange sends a change to the channel.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	change *domain.RawDocumentChange,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- *change:
+		return nil
+	}
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for local databases.
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier returns an empty string; local databases have no account.
+func (c *Connector) GetAccountIdentifier(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
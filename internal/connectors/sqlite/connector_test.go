@@ -0,0 +1,266 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// createTestDB creates a notes database and returns its path and a writable handle.
+func createTestDB(t *testing.T) (string, *sql.DB) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "notes.db")
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`
+		CREATE TABLE notes (id INTEGER PRIMARY KEY, title TEXT, body TEXT, updated_at INTEGER);
+		INSERT INTO notes VALUES (1, 'First', 'alpha', 100);
+		INSERT INTO notes VALUES (2, 'Second', 'beta', 200);
+		INSERT INTO notes VALUES (3, NULL, 'gamma', NULL);
+	`)
+	require.NoError(t, err)
+	return path, db
+}
+
+func testConfig(path string) *Config {
+	return &Config{
+		Path:            path,
+		Tables:          []string{"notes"},
+		IDColumn:        "id",
+		TitleColumn:     "title",
+		BodyColumn:      "body",
+		TimestampColumn: "updated_at",
+	}
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var out []domain.RawDocument
+	for doc := range docs {
+		out = append(out, doc)
+	}
+	return out, <-errs
+}
+
+func collectChanges(changes <-chan domain.RawDocumentChange, errs <-chan error) ([]domain.RawDocumentChange, error) {
+	var out []domain.RawDocumentChange
+	for change := range changes {
+		out = append(out, change)
+	}
+	return out, <-errs
+}
+
+func TestConnector_Capabilities(t *testing.T) {
+	c := New("src-1", testConfig("notes.db"))
+	assert.Equal(t, "sqlite", c.Type())
+	assert.True(t, c.Capabilities().SupportsIncremental)
+	assert.False(t, c.Capabilities().RequiresAuth)
+
+	cfg := testConfig("notes.db")
+	cfg.TimestampColumn = ""
+	assert.False(t, New("src-1", cfg).Capabilities().SupportsIncremental)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	path, _ := createTestDB(t)
+
+	t.Run("valid configuration", func(t *testing.T) {
+		assert.NoError(t, New("src-1", testConfig(path)).Validate(context.Background()))
+	})
+
+	t.Run("missing database", func(t *testing.T) {
+		err := New("src-1", testConfig(filepath.Join(t.TempDir(), "nope.db"))).Validate(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not exist")
+	})
+
+	t.Run("missing id column", func(t *testing.T) {
+		cfg := testConfig(path)
+		cfg.IDColumn = "uuid"
+		err := New("src-1", cfg).Validate(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `id column "uuid"`)
+	})
+
+	t.Run("missing timestamp column", func(t *testing.T) {
+		cfg := testConfig(path)
+		cfg.TimestampColumn = "modified"
+		err := New("src-1", cfg).Validate(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `timestamp column "modified"`)
+	})
+
+	t.Run("missing table", func(t *testing.T) {
+		cfg := testConfig(path)
+		cfg.Tables = []string{"missing"}
+		assert.Error(t, New("src-1", cfg).Validate(context.Background()))
+	})
+
+	t.Run("missing text column", func(t *testing.T) {
+		cfg := testConfig(path)
+		cfg.TextColumns = map[string][]string{"notes": {"details"}}
+		err := New("src-1", cfg).Validate(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `text column "details" not found in notes`)
+	})
+
+	t.Run("unsupported database type", func(t *testing.T) {
+		cfg := testConfig(path)
+		cfg.DBType = DBTypeDuckDB
+		assert.ErrorIs(t, New("src-1", cfg).Validate(context.Background()), ErrUnsupportedDBType)
+	})
+}
+
+func TestConnector_FullSync(t *testing.T) {
+	path, _ := createTestDB(t)
+	c := New("src-1", testConfig(path))
+
+	docs, err := collectDocs(c.FullSync(context.Background()))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	cursor, decodeErr := DecodeCursor(complete.NewCursor)
+	require.NoError(t, decodeErr)
+	notes, ok := cursor.Get("notes")
+	require.True(t, ok)
+	assert.Equal(t, TableCursor{Type: "integer", Value: "200"}, notes)
+
+	require.Len(t, docs, 3)
+	// Ordered by timestamp: NULL first
+	assert.Equal(t, "db://notes.db/notes/3", docs[0].URI)
+	assert.Equal(t, "notes 3", docs[0].Metadata["title"])
+	assert.Equal(t, "# First\n\nalpha\n", string(docs[1].Content))
+	assert.Equal(t, "200", docs[2].Metadata["timestamp"])
+}
+
+func TestConnector_FullSync_AllTables(t *testing.T) {
+	path, db := createTestDB(t)
+	_, err := db.Exec(`
+		CREATE TABLE tasks (id INTEGER PRIMARY KEY, summary TEXT, updated_at INTEGER);
+		INSERT INTO tasks VALUES (1, 'Write report', 50);
+	`)
+	require.NoError(t, err)
+	cfg := testConfig(path)
+	cfg.Tables = nil
+	cfg.TextColumns = map[string][]string{"tasks": {"summary"}}
+	c := New("src-1", cfg)
+
+	docs, err := collectDocs(c.FullSync(context.Background()))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	cursor, decodeErr := DecodeCursor(complete.NewCursor)
+	require.NoError(t, decodeErr)
+	tasks, ok := cursor.Get("tasks")
+	require.True(t, ok)
+	assert.Equal(t, "50", tasks.Value)
+
+	// Tables in name order
+	require.Len(t, docs, 4)
+	assert.Equal(t, "db://notes.db/notes/3", docs[0].URI)
+	assert.Equal(t, "db://notes.db/tasks/1", docs[3].URI)
+	assert.Equal(t, "# tasks 1\n\nWrite report\n", string(docs[3].Content))
+}
+
+func TestConnector_FullSync_Query(t *testing.T) {
+	path, _ := createTestDB(t)
+	cfg := &Config{Path: path, Query: "SELECT id, body FROM notes WHERE id > 1;", IDColumn: "id"}
+	c := New("src-1", cfg)
+
+	docs, err := collectDocs(c.FullSync(context.Background()))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	assert.Empty(t, complete.NewCursor)
+	require.Len(t, docs, 2)
+	assert.Equal(t, "db://notes.db/query/2", docs[0].URI)
+	assert.Contains(t, string(docs[0].Content), "- **body**: beta")
+}
+
+func TestConnector_IncrementalSync(t *testing.T) {
+	path, db := createTestDB(t)
+	c := New("src-1", testConfig(path))
+
+	cursor := NewCursor()
+	cursor.Set("notes", "integer", "200")
+	state := domain.SyncState{SourceID: "src-1", Cursor: cursor.Encode()}
+
+	_, err := db.Exec(`INSERT INTO notes VALUES (4, 'Fourth', 'delta', 300)`)
+	require.NoError(t, err)
+
+	changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	newCursor, decodeErr := DecodeCursor(complete.NewCursor)
+	require.NoError(t, decodeErr)
+	notes, _ := newCursor.Get("notes")
+	assert.Equal(t, "300", notes.Value)
+
+	// The row at the previous cursor is re-read along with the new row
+	require.Len(t, changes, 2)
+	assert.Equal(t, "db://notes.db/notes/2", changes[0].Document.URI)
+	assert.Equal(t, domain.ChangeUpdated, changes[1].Type)
+	assert.Equal(t, "db://notes.db/notes/4", changes[1].Document.URI)
+}
+
+func TestConnector_IncrementalSync_Version1Cursor(t *testing.T) {
+	path, _ := createTestDB(t)
+	c := New("src-1", testConfig(path))
+	state := domain.SyncState{Cursor: "eyJ2IjoxLCJ0eXBlIjoiaW50ZWdlciIsInZhbHVlIjoiMjAwIn0="} // {"v":1,"type":"integer","value":"200"}
+
+	changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "db://notes.db/notes/2", changes[0].Document.URI)
+}
+
+func TestConnector_IncrementalSync_InvalidCursor(t *testing.T) {
+	path, _ := createTestDB(t)
+	c := New("src-1", testConfig(path))
+
+	_, err := collectChanges(c.IncrementalSync(context.Background(), domain.SyncState{Cursor: "!!!"}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "full sync required")
+}
+
+func TestConnector_ReadOnly(t *testing.T) {
+	path, db := createTestDB(t)
+	cfg := testConfig(path)
+	cfg.TimestampColumn = ""
+	cfg.Query = "DELETE FROM notes RETURNING id, body"
+	c := New("src-1", cfg)
+
+	_, err := collectDocs(c.FullSync(context.Background()))
+	require.Error(t, err)
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM notes").Scan(&count))
+	assert.Equal(t, 3, count)
+}
+
+func TestConnector_Closed(t *testing.T) {
+	path, _ := createTestDB(t)
+	c := New("src-1", testConfig(path))
+	require.NoError(t, c.Close())
+
+	assert.ErrorIs(t, c.Validate(context.Background()), domain.ErrConnectorClosed)
+	_, err := collectDocs(c.FullSync(context.Background()))
+	assert.ErrorIs(t, err, domain.ErrConnectorClosed)
+}
+
+func TestResolveWebURL(t *testing.T) {
+	assert.Equal(t, "/data/notes.db", ResolveWebURL("db://notes.db/notes/1", map[string]any{"database": "/data/notes.db"}))
+	assert.Equal(t, "db://notes.db/notes/1", ResolveWebURL("db://notes.db/notes/1", nil))
+}
@@ -0,0 +1,129 @@
+package sqlite
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// CursorVersion is the current cursor format version.
+// Version 1 held a single position for the one configured table.
+const CursorVersion = 2
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor stores the largest timestamp column value seen so far in each table.
+type Cursor struct {
+	Version int                    `json:"v"`
+	Tables  map[string]TableCursor `json:"tables,omitempty"`
+
+	// Type and Value hold the position of a version 1 cursor until it is
+	// assigned to a table by Adopt.
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// TableCursor is the position of a single table.
+// The value's type (as reported by the database's typeof) is kept so it can
+// be bound back with the same type; SQLite orders values by storage class first.
+type TableCursor struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version: CursorVersion,
+		Tables:  make(map[string]TableCursor),
+	}
+}
+
+// Encode serialises the cursor to a base64 string.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Tables == nil {
+		cursor.Tables = make(map[string]TableCursor)
+	}
+	cursor.Version = CursorVersion
+
+	return &cursor, nil
+}
+
+// Adopt assigns the position of a version 1 cursor to the given table, the
+// only one such a cursor could have come from.
+func (c *Cursor) Adopt(table string) {
+	if c.Type == "" {
+		return
+	}
+	if _, ok := c.Tables[table]; !ok {
+		c.Set(table, c.Type, c.Value)
+	}
+	c.Type, c.Value = "", ""
+}
+
+// Get returns a table's position and whether one has been recorded.
+func (c *Cursor) Get(table string) (TableCursor, bool) {
+	tc, ok := c.Tables[table]
+	return tc, ok
+}
+
+// Set records a timestamp value for a table. NULL and BLOB values are ignored.
+func (c *Cursor) Set(table, valueType, value string) {
+	valueType = strings.ToLower(valueType)
+	switch valueType {
+	case "", "null", "blob":
+		return
+	}
+	c.Tables[table] = TableCursor{Type: valueType, Value: value}
+}
+
+// Arg returns the value as a query argument. Integers and reals are bound as
+// numbers; anything else, such as text or timestamps, is bound as text and
+// converted by the database.
+func (t TableCursor) Arg() (any, error) {
+	switch t.Type {
+	case "integer", "bigint":
+		n, err := strconv.ParseInt(t.Value, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return n, nil
+	case "real", "double":
+		f, err := strconv.ParseFloat(t.Value, 64)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return f, nil
+	default:
+		return t.Value, nil
+	}
+}
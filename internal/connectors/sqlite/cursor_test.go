@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := NewCursor()
+	cursor.Set("notes", "integer", "200")
+	cursor.Set("tasks", "TIMESTAMP", "2024-05-01 10:00:00")
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	notes, ok := decoded.Get("notes")
+	require.True(t, ok)
+	arg, err := notes.Arg()
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), arg)
+
+	tasks, ok := decoded.Get("tasks")
+	require.True(t, ok)
+	arg, err = tasks.Arg()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-05-01 10:00:00", arg)
+}
+
+func TestCursor_SetIgnoresNull(t *testing.T) {
+	cursor := NewCursor()
+	cursor.Set("notes", "null", "")
+	cursor.Set("notes", "blob", "x")
+
+	_, ok := cursor.Get("notes")
+	assert.False(t, ok)
+}
+
+func TestCursor_AdoptVersion1(t *testing.T) {
+	cursor, err := DecodeCursor("eyJ2IjoxLCJ0eXBlIjoiaW50ZWdlciIsInZhbHVlIjoiMjAwIn0=") // {"v":1,"type":"integer","value":"200"}
+	require.NoError(t, err)
+
+	cursor.Adopt("notes")
+
+	notes, ok := cursor.Get("notes")
+	require.True(t, ok)
+	assert.Equal(t, TableCursor{Type: "integer", Value: "200"}, notes)
+	assert.Empty(t, cursor.Type)
+	assert.Equal(t, CursorVersion, cursor.Version)
+}
+
+func TestTableCursor_Arg(t *testing.T) {
+	arg, err := TableCursor{Type: "real", Value: "1.5"}.Arg()
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, arg, 0)
+
+	arg, err = TableCursor{Type: "text", Value: "2026-01-01"}.Arg()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-01-01", arg)
+
+	_, err = TableCursor{Type: "integer", Value: "abc"}.Arg()
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	_, err := DecodeCursor("!!!")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = DecodeCursor("eyJ2Ijo5OX0=") // {"v":99}
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
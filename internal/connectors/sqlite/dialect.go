@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+
+	_ "modernc.org/sqlite" // SQLite driver
+)
+
+// ErrUnsupportedDBType indicates no dialect is registered for the database type.
+var ErrUnsupportedDBType = errors.New("database type not supported by this build")
+
+// dialect describes how to open and inspect one kind of database.
+type dialect struct {
+	// driver is the database/sql driver name.
+	driver string
+	// dsn returns a read-only data source name for a database file.
+	dsn func(path string) string
+	// listTables is a query returning the name of every user table.
+	listTables string
+}
+
+var (
+	dialectsMu sync.RWMutex
+	dialects   = map[string]dialect{
+		DBTypeSQLite: {
+			driver: "sqlite",
+			dsn: func(path string) string {
+				return "file:" + (&url.URL{Path: path}).EscapedPath() +
+					"?mode=ro&_pragma=query_only(1)&_pragma=busy_timeout(5000)"
+			},
+			listTables: "SELECT name FROM sqlite_master " +
+				"WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name",
+		},
+	}
+)
+
+// registerDialect makes a database type available.
+func registerDialect(dbType string, d dialect) {
+	dialectsMu.Lock()
+	defer dialectsMu.Unlock()
+	dialects[dbType] = d
+}
+
+// dialectFor returns the dialect for a database type. An empty type is SQLite.
+func dialectFor(dbType string) (dialect, error) {
+	if dbType == "" {
+		dbType = DBTypeSQLite
+	}
+	dialectsMu.RLock()
+	defer dialectsMu.RUnlock()
+	d, ok := dialects[dbType]
+	if !ok {
+		return dialect{}, fmt.Errorf("%w: %s", ErrUnsupportedDBType, dbType)
+	}
+	return d, nil
+}
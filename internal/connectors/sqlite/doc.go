@@ -0,0 +1,23 @@
+// Package sqlite provides a Connector for local SQLite database files.
+// Every row of the configured tables (or of a custom query) becomes one
+// document, whose body is taken from the configured body or text columns.
+//
+// # Configuration
+//
+//   - path (alias db_path): the database file, opened read-only. Required.
+//   - db_type: sqlite or duckdb. Default: from the file extension.
+//   - table (alias tables): comma-separated tables to read. Default: every table.
+//   - query: a SELECT statement read instead of the tables.
+//   - id_column: the unique row ID. Default: "id".
+//   - title_column, body_column: the document title and body (optional).
+//   - text_columns: per-table columns joined with spaces to form the body,
+//     as "notes:title,body; tasks:summary". A list without a table prefix
+//     applies to every table.
+//   - timestamp_column (alias updated_at_column): the last-modified time,
+//     used as the incremental sync cursor (optional).
+//
+// Database types are opened through a registry of dialects, of which only
+// SQLite is built in. DuckDB files are recognised by db_type or their
+// ".duckdb" extension but have no backend yet, so such sources fail
+// validation with ErrUnsupportedDBType.
+package sqlite
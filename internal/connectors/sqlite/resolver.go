@@ -0,0 +1,10 @@
+package sqlite
+
+// ResolveWebURL returns the database file path for a db:// URI.
+// Rows have no URL of their own, so the database file is opened instead.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	if path, ok := metadata["database"].(string); ok && path != "" {
+		return path
+	}
+	return uri
+}
@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Row is a single result row with its column names.
+type Row struct {
+	Columns []string
+	Values  []any
+}
+
+// Get returns the value of a column and whether the column exists.
+func (r *Row) Get(column string) (any, bool) {
+	for i, c := range r.Columns {
+		if strings.EqualFold(c, column) {
+			return r.Values[i], true
+		}
+	}
+	return nil, false
+}
+
+// RowURI returns the document URI for a row.
+func RowURI(dbPath, name, id string) string {
+	return fmt.Sprintf("db://%s/%s/%s",
+		url.PathEscape(filepath.Base(dbPath)), url.PathEscape(name), url.PathEscape(id))
+}
+
+// RowToRawDocument converts a row of the named table to a markdown RawDocument.
+// Returns false if the row has no ID value.
+func RowToRawDocument(row *Row, table string, cfg *Config, sourceID string) (*domain.RawDocument, bool) {
+	idVal, _ := row.Get(cfg.IDColumn)
+	id := formatValue(idVal)
+	if id == "" {
+		return nil, false
+	}
+
+	title := ""
+	if cfg.TitleColumn != "" {
+		v, _ := row.Get(cfg.TitleColumn)
+		title = strings.TrimSpace(formatValue(v))
+	}
+	if title == "" {
+		title = fmt.Sprintf("%s %s", table, id)
+	}
+
+	metadata := map[string]any{
+		"title":    title,
+		"database": cfg.Path,
+		"table":    table,
+		"row_id":   id,
+	}
+	if cfg.TimestampColumn != "" {
+		if v, ok := row.Get(cfg.TimestampColumn); ok && v != nil {
+			metadata["timestamp"] = formatValue(v)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	if body, ok := bodyValue(row, table, cfg); ok {
+		b.WriteString(body)
+		b.WriteString("\n")
+	} else {
+		writeColumns(&b, row, cfg)
+	}
+
+	return &domain.RawDocument{
+		SourceID: sourceID,
+		URI:      RowURI(cfg.Path, table, id),
+		MIMEType: "text/markdown",
+		Content:  []byte(b.String()),
+		Metadata: metadata,
+	}, true
+}
+
+// bodyValue returns the table's text column values joined with spaces, or
+// the body column value, if either is configured and present.
+func bodyValue(row *Row, table string, cfg *Config) (string, bool) {
+	if cols := cfg.TextColumnsFor(table); len(cols) > 0 {
+		var parts []string
+		for _, col := range cols {
+			v, _ := row.Get(col)
+			if text := strings.TrimSpace(formatValue(v)); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		return strings.Join(parts, " "), true
+	}
+	if cfg.BodyColumn == "" {
+		return "", false
+	}
+	v, ok := row.Get(cfg.BodyColumn)
+	if !ok {
+		return "", false
+	}
+	return formatValue(v), true
+}
+
+// writeColumns renders every non-empty column other than the ID and title.
+func writeColumns(b *strings.Builder, row *Row, cfg *Config) {
+	for i, col := range row.Columns {
+		if strings.EqualFold(col, cfg.IDColumn) || strings.EqualFold(col, cfg.TitleColumn) {
+			continue
+		}
+		if v := formatValue(row.Values[i]); v != "" {
+			fmt.Fprintf(b, "- **%s**: %s\n", col, v)
+		}
+	}
+}
+
+// formatValue converts a scanned column value to text.
+func formatValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		// Binary blobs are not indexable text
+		if !utf8.Valid(val) {
+			return ""
+		}
+		return string(val)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case time.Time:
+		return val.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(val)
+	}
+}
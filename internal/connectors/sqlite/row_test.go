@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowURI(t *testing.T) {
+	assert.Equal(t, "db://notes.db/notes/42", RowURI("/data/notes.db", "notes", "42"))
+	assert.Equal(t, "db://notes.db/my%20notes/a%2Fb", RowURI("/data/notes.db", "my notes", "a/b"))
+}
+
+func TestRowToRawDocument(t *testing.T) {
+	cfg := &Config{Path: "/data/notes.db", IDColumn: "id", TitleColumn: "title", BodyColumn: "body"}
+	row := &Row{
+		Columns: []string{"id", "title", "body"},
+		Values:  []any{int64(7), "Groceries", []byte("milk, eggs")},
+	}
+
+	doc, ok := RowToRawDocument(row, "notes", cfg, "src-1")
+
+	require.True(t, ok)
+	assert.Equal(t, "db://notes.db/notes/7", doc.URI)
+	assert.Equal(t, "text/markdown", doc.MIMEType)
+	assert.Equal(t, "# Groceries\n\nmilk, eggs\n", string(doc.Content))
+	assert.Equal(t, "Groceries", doc.Metadata["title"])
+	assert.Equal(t, "7", doc.Metadata["row_id"])
+}
+
+func TestRowToRawDocument_SchemaLess(t *testing.T) {
+	cfg := &Config{Path: "/data/notes.db", IDColumn: "id", TitleColumn: "missing", BodyColumn: "missing"}
+	row := &Row{
+		Columns: []string{"ID", "tag", "score", "empty", "blob"},
+		Values:  []any{"n1", "work", 1.5, nil, []byte{0xff, 0xfe}},
+	}
+
+	doc, ok := RowToRawDocument(row, "notes", cfg, "src-1")
+
+	require.True(t, ok)
+	assert.Equal(t, "notes n1", doc.Metadata["title"])
+	content := string(doc.Content)
+	assert.Contains(t, content, "# notes n1")
+	assert.Contains(t, content, "- **tag**: work")
+	assert.Contains(t, content, "- **score**: 1.5")
+	assert.NotContains(t, content, "empty")
+	assert.NotContains(t, content, "blob")
+	assert.NotContains(t, content, "**ID**")
+}
+
+func TestRowToRawDocument_MissingID(t *testing.T) {
+	cfg := &Config{Path: "/data/notes.db", IDColumn: "id"}
+	row := &Row{Columns: []string{"id", "body"}, Values: []any{nil, "text"}}
+
+	_, ok := RowToRawDocument(row, "notes", cfg, "src-1")
+
+	assert.False(t, ok)
+}
+
+func TestRowToRawDocument_TextColumns(t *testing.T) {
+	cfg := &Config{
+		Path:        "/data/notes.db",
+		IDColumn:    "id",
+		BodyColumn:  "body",
+		TextColumns: map[string][]string{"notes": {"title", "body", "missing"}},
+	}
+	row := &Row{
+		Columns: []string{"id", "title", "body", "tag"},
+		Values:  []any{int64(7), "Groceries", "milk, eggs", "home"},
+	}
+
+	doc, ok := RowToRawDocument(row, "notes", cfg, "src-1")
+
+	require.True(t, ok)
+	assert.Equal(t, "# notes 7\n\nGroceries milk, eggs\n", string(doc.Content))
+}
@@ -0,0 +1,161 @@
+package trello
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Member is a Trello member (user account).
+type Member struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
+
+// Board is a Trello board.
+type Board struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Label is a label attached to a card.
+type Label struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// CheckItem is a single item in a checklist.
+type CheckItem struct {
+	Name  string `json:"name"`
+	State string `json:"state"` // "complete" or "incomplete"
+}
+
+// Checklist is a named list of check items on a card.
+type Checklist struct {
+	Name       string      `json:"name"`
+	CheckItems []CheckItem `json:"checkItems"`
+}
+
+// Card is a Trello card.
+type Card struct {
+	ID               string      `json:"id"`
+	BoardID          string      `json:"idBoard"`
+	Name             string      `json:"name"`
+	Desc             string      `json:"desc"`
+	Due              *time.Time  `json:"due"`
+	Labels           []Label     `json:"labels"`
+	URL              string      `json:"url"`
+	Closed           bool        `json:"closed"`
+	DateLastActivity time.Time   `json:"dateLastActivity"`
+	Checklists       []Checklist `json:"checklists"`
+}
+
+// Action is a Trello action; only comment actions are fetched.
+type Action struct {
+	Date          time.Time `json:"date"`
+	MemberCreator Member    `json:"memberCreator"`
+	Data          struct {
+		Text string `json:"text"`
+	} `json:"data"`
+}
+
+// CardURI returns the document URI for a card.
+func CardURI(boardID, cardID string) string {
+	return fmt.Sprintf("trello://%s/%s", boardID, cardID)
+}
+
+// CardToRawDocument converts a card to a markdown RawDocument.
+// Checklists are rendered when present on the card; comments are appended
+// in the order given.
+func CardToRawDocument(card *Card, board *Board, comments []Action, sourceID string) *domain.RawDocument {
+	labels := make([]string, 0, len(card.Labels))
+	for _, l := range card.Labels {
+		if name := labelName(l); name != "" {
+			labels = append(labels, name)
+		}
+	}
+
+	metadata := map[string]any{
+		"card_id":       card.ID,
+		"board_id":      board.ID,
+		"board_name":    board.Name,
+		"title":         card.Name,
+		"url":           card.URL,
+		"closed":        card.Closed,
+		"last_activity": card.DateLastActivity.Format(time.RFC3339),
+	}
+	if len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if card.Due != nil {
+		metadata["due"] = card.Due.Format(time.RFC3339)
+	}
+
+	parentURI := fmt.Sprintf("trello://%s", board.ID)
+
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       CardURI(board.ID, card.ID),
+		MIMEType:  "text/markdown",
+		Content:   []byte(renderCard(card, board, labels, comments)),
+		Metadata:  metadata,
+		ParentURI: &parentURI,
+	}
+}
+
+// renderCard builds the markdown content for a card.
+func renderCard(card *Card, board *Board, labels []string, comments []Action) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", card.Name)
+	fmt.Fprintf(&b, "Board: %s\n", board.Name)
+	if len(labels) > 0 {
+		fmt.Fprintf(&b, "Labels: %s\n", strings.Join(labels, ", "))
+	}
+	if card.Due != nil {
+		fmt.Fprintf(&b, "Due: %s\n", card.Due.Format("2006-01-02 15:04"))
+	}
+	if card.Closed {
+		b.WriteString("Archived: yes\n")
+	}
+
+	if desc := strings.TrimSpace(card.Desc); desc != "" {
+		fmt.Fprintf(&b, "\n%s\n", desc)
+	}
+
+	for _, cl := range card.Checklists {
+		fmt.Fprintf(&b, "\n## %s\n\n", cl.Name)
+		for _, item := range cl.CheckItems {
+			mark := " "
+			if item.State == "complete" {
+				mark = "x"
+			}
+			fmt.Fprintf(&b, "- [%s] %s\n", mark, item.Name)
+		}
+	}
+
+	if len(comments) > 0 {
+		b.WriteString("\n## Comments\n")
+		for _, c := range comments {
+			author := c.MemberCreator.FullName
+			if author == "" {
+				author = c.MemberCreator.Username
+			}
+			fmt.Fprintf(&b, "\n**%s** (%s):\n%s\n", author, c.Date.Format("2006-01-02"), c.Data.Text)
+		}
+	}
+
+	return b.String()
+}
+
+// labelName returns the label name, falling back to its colour for unnamed labels.
+func labelName(l Label) string {
+	if l.Name != "" {
+		return l.Name
+	}
+	return l.Color
+}
@@ -0,0 +1,75 @@
+package trello
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardURI(t *testing.T) {
+	assert.Equal(t, "trello://b1/c1", CardURI("b1", "c1"))
+}
+
+func TestCardToRawDocument(t *testing.T) {
+	due := time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC)
+	card := &Card{
+		ID:               "c1",
+		Name:             "Ship release",
+		Desc:             "Cut the release branch.",
+		Due:              &due,
+		Labels:           []Label{{Name: "urgent"}, {Color: "green"}},
+		URL:              "https://trello.com/c/abc/1-ship-release",
+		DateLastActivity: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+		Checklists: []Checklist{{
+			Name: "Steps",
+			CheckItems: []CheckItem{
+				{Name: "Tag", State: "complete"},
+				{Name: "Announce", State: "incomplete"},
+			},
+		}},
+	}
+	board := &Board{ID: "b1", Name: "Roadmap"}
+	comment := Action{Date: time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)}
+	comment.MemberCreator.FullName = "Ada"
+	comment.Data.Text = "Looks good"
+
+	doc := CardToRawDocument(card, board, []Action{comment}, "src-1")
+
+	assert.Equal(t, "src-1", doc.SourceID)
+	assert.Equal(t, "trello://b1/c1", doc.URI)
+	assert.Equal(t, "text/markdown", doc.MIMEType)
+	require.NotNil(t, doc.ParentURI)
+	assert.Equal(t, "trello://b1", *doc.ParentURI)
+
+	content := string(doc.Content)
+	assert.Contains(t, content, "# Ship release")
+	assert.Contains(t, content, "Board: Roadmap")
+	assert.Contains(t, content, "Labels: urgent, green")
+	assert.Contains(t, content, "Due: 2026-05-01 09:30")
+	assert.Contains(t, content, "Cut the release branch.")
+	assert.Contains(t, content, "## Steps")
+	assert.Contains(t, content, "- [x] Tag")
+	assert.Contains(t, content, "- [ ] Announce")
+	assert.Contains(t, content, "**Ada** (2026-04-02):\nLooks good")
+
+	assert.Equal(t, "Ship release", doc.Metadata["title"])
+	assert.Equal(t, "Roadmap", doc.Metadata["board_name"])
+	assert.Equal(t, card.URL, doc.Metadata["url"])
+	assert.Equal(t, []string{"urgent", "green"}, doc.Metadata["labels"])
+	assert.Equal(t, "2026-05-01T09:30:00Z", doc.Metadata["due"])
+}
+
+func TestCardToRawDocument_Minimal(t *testing.T) {
+	card := &Card{ID: "c1", Name: "Idea", Closed: true}
+
+	doc := CardToRawDocument(card, &Board{ID: "b1", Name: "Inbox"}, nil, "src-1")
+
+	content := string(doc.Content)
+	assert.Contains(t, content, "Archived: yes")
+	assert.NotContains(t, content, "Labels:")
+	assert.NotContains(t, content, "## Comments")
+	assert.NotContains(t, doc.Metadata, "labels")
+	assert.NotContains(t, doc.Metadata, "due")
+}
@@ -0,0 +1,160 @@
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// apiBaseURL is the Trello REST API v1 base URL.
+const apiBaseURL = "https://api.trello.com/1"
+
+// Error types for Trello API responses.
+var (
+	// ErrUnauthorised indicates the API key or token is invalid or revoked.
+	ErrUnauthorised = errors.New("trello: unauthorised")
+	// ErrNotFound indicates the requested board or card does not exist.
+	ErrNotFound = errors.New("trello: not found")
+	// ErrRateLimited indicates the request was throttled by Trello.
+	ErrRateLimited = errors.New("trello: rate limited")
+)
+
+// Client is a minimal Trello REST API client.
+type Client struct {
+	baseURL       string
+	apiKey        string
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	rateLimiter   *RateLimiter
+}
+
+// NewClient creates a Trello client for the given API key and token provider.
+func NewClient(apiKey string, tokenProvider driven.TokenProvider) *Client {
+	return &Client{
+		baseURL:       apiBaseURL,
+		apiKey:        apiKey,
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: 60 * time.Second},
+		rateLimiter:   NewRateLimiter(),
+	}
+}
+
+// Me returns the authenticated member.
+func (c *Client) Me(ctx context.Context) (*Member, error) {
+	var member Member
+	query := url.Values{"fields": {"username,fullName"}}
+	if err := c.get(ctx, "/members/me", query, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// Boards returns the open boards of the authenticated member.
+func (c *Client) Boards(ctx context.Context) ([]Board, error) {
+	var boards []Board
+	query := url.Values{"fields": {"name,url"}, "filter": {"open"}}
+	if err := c.get(ctx, "/members/me/boards", query, &boards); err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// Board returns a single board by ID.
+func (c *Client) Board(ctx context.Context, boardID string) (*Board, error) {
+	var board Board
+	query := url.Values{"fields": {"name,url"}}
+	if err := c.get(ctx, "/boards/"+url.PathEscape(boardID), query, &board); err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// BoardCards returns the cards on a board.
+// filter is "open" or "all"; checklists are embedded when withChecklists is set.
+func (c *Client) BoardCards(ctx context.Context, boardID, filter string, withChecklists bool) ([]Card, error) {
+	query := url.Values{
+		"fields": {"name,desc,due,labels,url,closed,idBoard,dateLastActivity"},
+		"filter": {filter},
+	}
+	if withChecklists {
+		query.Set("checklists", "all")
+	}
+
+	var cards []Card
+	if err := c.get(ctx, "/boards/"+url.PathEscape(boardID)+"/cards", query, &cards); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// CardComments returns the comment actions on a card, newest first.
+func (c *Client) CardComments(ctx context.Context, cardID string) ([]Action, error) {
+	query := url.Values{"filter": {"commentCard"}, "limit": {"1000"}}
+
+	var actions []Action
+	if err := c.get(ctx, "/cards/"+url.PathEscape(cardID)+"/actions", query, &actions); err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+// get performs an authenticated GET request and decodes the JSON response into out.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	token, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	reqURL := c.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	// Header auth keeps the key and token out of request URLs and logs
+	req.Header.Set("Authorization",
+		fmt.Sprintf("OAuth oauth_consumer_key=%q, oauth_token=%q", c.apiKey, token))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorised
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%s: %w", path, ErrNotFound)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		c.rateLimiter.RecordRateLimitError()
+		return ErrRateLimited
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("request %s failed: status %d", path, resp.StatusCode)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode %s response: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package trello
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ErrMissingAPIKey indicates the source has no Trello API key configured.
+var ErrMissingAPIKey = errors.New("trello: api_key is required")
+
+// Config holds Trello connector configuration.
+type Config struct {
+	// APIKey is the Trello developer API key the user token was issued for.
+	APIKey string
+	// BoardIDs limits syncing to specific boards. If empty, all open boards
+	// of the authenticated member are synced.
+	BoardIDs []string
+	// IncludeArchived includes archived (closed) cards.
+	IncludeArchived bool
+	// IncludeChecklists appends card checklists to the card content.
+	IncludeChecklists bool
+	// IncludeComments fetches card comments (one additional API call per card).
+	IncludeComments bool
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		IncludeChecklists: true,
+		IncludeComments:   true,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse api_key (required)
+	cfg.APIKey = strings.TrimSpace(source.Config["api_key"])
+	if cfg.APIKey == "" {
+		return nil, ErrMissingAPIKey
+	}
+
+	// Parse board_ids
+	if val := source.Config["board_ids"]; val != "" {
+		for _, id := range strings.Split(val, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				cfg.BoardIDs = append(cfg.BoardIDs, id)
+			}
+		}
+	}
+
+	// Parse boolean flags
+	if val := source.Config["include_archived"]; val != "" {
+		cfg.IncludeArchived = parseBool(val)
+	}
+	if val := source.Config["include_checklists"]; val != "" {
+		cfg.IncludeChecklists = parseBool(val)
+	}
+	if val := source.Config["include_comments"]; val != "" {
+		cfg.IncludeComments = parseBool(val)
+	}
+
+	return cfg, nil
+}
+
+// parseBool accepts "true" and "1" as true; anything else is false.
+func parseBool(val string) bool {
+	val = strings.TrimSpace(strings.ToLower(val))
+	return val == "true" || val == "1"
+}
@@ -0,0 +1,72 @@
+package trello
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+
+	assert.Empty(t, cfg.APIKey)
+	assert.Empty(t, cfg.BoardIDs)
+	assert.False(t, cfg.IncludeArchived)
+	assert.True(t, cfg.IncludeChecklists)
+	assert.True(t, cfg.IncludeComments)
+}
+
+func TestParseConfig_RequiresAPIKey(t *testing.T) {
+	for _, val := range []string{"", "   "} {
+		source := domain.Source{Config: map[string]string{"api_key": val}}
+
+		cfg, err := ParseConfig(source)
+
+		require.ErrorIs(t, err, ErrMissingAPIKey)
+		assert.Nil(t, cfg)
+	}
+}
+
+func TestParseConfig_Default(t *testing.T) {
+	source := domain.Source{Config: map[string]string{"api_key": " key "}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "key", cfg.APIKey)
+	assert.Empty(t, cfg.BoardIDs)
+	assert.False(t, cfg.IncludeArchived)
+	assert.True(t, cfg.IncludeChecklists)
+	assert.True(t, cfg.IncludeComments)
+}
+
+func TestParseConfig_BoardIDs(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"api_key":   "key",
+		"board_ids": "b1, b2,,b3 ",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b1", "b2", "b3"}, cfg.BoardIDs)
+}
+
+func TestParseConfig_Flags(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"api_key":            "key",
+		"include_archived":   "TRUE",
+		"include_checklists": "false",
+		"include_comments":   "0",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.True(t, cfg.IncludeArchived)
+	assert.False(t, cfg.IncludeChecklists)
+	assert.False(t, cfg.IncludeComments)
+}
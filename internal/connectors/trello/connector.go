@@ -0,0 +1,349 @@
+package trello
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Connector fetches cards from Trello boards.
+type Connector struct {
+	sourceID      string
+	config        *Config
+	tokenProvider driven.TokenProvider
+	client        *Client
+	mu            sync.Mutex
+	closed        bool
+}
+
+// New creates a new Trello connector.
+func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
+	return &Connector{
+		sourceID:      sourceID,
+		config:        cfg,
+		tokenProvider: tokenProvider,
+		client:        NewClient(cfg.APIKey, tokenProvider),
+	}
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "trello"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    true,
+		SupportsBinary:       false,
+		RequiresAuth:         true,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: true,
+		SupportsPagination:   false,
+	}
+}
+
+// Validate checks that the API key and token are accepted by Trello.
+func (c *Connector) Validate(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, err := c.client.Me(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if errors.Is(err, ErrUnauthorised) {
+			return fmt.Errorf("%w: %w", domain.ErrAuthInvalid, err)
+		}
+		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+	}
+
+	return nil
+}
+
+// FullSync fetches all cards from the configured boards.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	// Record the start time so activity during the sync is picked up next time
+	syncStart := time.Now()
+
+	boards, err := c.listBoards(ctx)
+	if err != nil {
+		return err
+	}
+
+	filter := "open"
+	if c.config.IncludeArchived {
+		filter = "all"
+	}
+
+	for i := range boards {
+		board := &boards[i]
+		cards, err := c.client.BoardCards(ctx, board.ID, filter, c.config.IncludeChecklists)
+		if err != nil {
+			return fmt.Errorf("list cards for board %s: %w", board.ID, err)
+		}
+
+		for j := range cards {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			doc := c.cardDocument(ctx, &cards[j], board)
+			if err := c.sendDocument(ctx, docsChan, doc); err != nil {
+				return err
+			}
+		}
+	}
+
+	cursor := NewCursor()
+	cursor.SetLastSyncTime(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches cards with activity since the last sync.
+// Archiving a card is reported as a deletion unless archived cards are included.
+// Cards deleted outright are not detected; a full sync removes them.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	cursor, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+	if cursor.IsEmpty() {
+		return fmt.Errorf("invalid cursor, full sync required: cursor has no last sync time")
+	}
+
+	syncStart := time.Now()
+
+	boards, err := c.listBoards(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range boards {
+		board := &boards[i]
+		// Always fetch archived cards so newly archived ones can be removed
+		cards, err := c.client.BoardCards(ctx, board.ID, "all", c.config.IncludeChecklists)
+		if err != nil {
+			return fmt.Errorf("list cards for board %s: %w", board.ID, err)
+		}
+
+		for j := range cards {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			card := &cards[j]
+			if !card.DateLastActivity.After(cursor.LastSyncTime) {
+				continue
+			}
+			if err := c.sendChange(ctx, changesChan, c.cardChange(ctx, card, board, cursor.LastSyncTime)); err != nil {
+				return err
+			}
+		}
+	}
+
+	cursor.SetLastSyncTime(syncStart)
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// listBoards returns the configured boards, or all open boards of the member.
+func (c *Connector) listBoards(ctx context.Context) ([]Board, error) {
+	if len(c.config.BoardIDs) == 0 {
+		boards, err := c.client.Boards(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list boards: %w", err)
+		}
+		return boards, nil
+	}
+
+	boards := make([]Board, 0, len(c.config.BoardIDs))
+	for _, id := range c.config.BoardIDs {
+		board, err := c.client.Board(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get board %s: %w", id, err)
+		}
+		boards = append(boards, *board)
+	}
+	return boards, nil
+}
+
+// cardChange builds the change for a card with activity since lastSync.
+func (c *Connector) cardChange(
+	ctx context.Context, card *Card, board *Board, lastSync time.Time,
+) *domain.RawDocumentChange {
+	if card.Closed && !c.config.IncludeArchived {
+		return &domain.RawDocumentChange{
+			Type: domain.ChangeDeleted,
+			Document: domain.RawDocument{
+				SourceID: c.sourceID,
+				URI:      CardURI(board.ID, card.ID),
+			},
+		}
+	}
+
+	changeType := domain.ChangeUpdated
+	if created, ok := cardCreatedAt(card.ID); ok && created.After(lastSync) {
+		changeType = domain.ChangeCreated
+	}
+	return &domain.RawDocumentChange{
+		Type:     changeType,
+		Document: *c.cardDocument(ctx, card, board),
+	}
+}
+
+// cardDocument converts a card, fetching its comments if enabled.
+func (c *Connector) cardDocument(ctx context.Context, card *Card, board *Board) *domain.RawDocument {
+	// Comment errors are non-fatal; the card is indexed without them
+	var comments []Action
+	if c.config.IncludeComments {
+		comments, _ = c.client.CardComments(ctx, card.ID) //nolint:errcheck
+	}
+	return CardToRawDocument(card, board, comments, c.sourceID)
+}
+
+// cardCreatedAt extracts the creation time embedded in a Trello object ID.
+// Like MongoDB ObjectIDs, the first 8 hex digits are a Unix timestamp.
+func cardCreatedAt(id string) (time.Time, bool) {
+	if len(id) < 8 {
+		return time.Time{}, false
+	}
+	secs, err := strconv.ParseInt(id[:8], 16, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, 0), true
+}
+
+// sendDocument sends a document to the channel.
+func (c *Connector) sendDocument(
+	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case docsChan <- *doc:
+		return nil
+	}
+}
+
+// sendChange sends a change to the channel.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	change *domain.RawDocumentChange,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- *change:
+		return nil
+	}
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for Trello (webhooks need a public callback URL).
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier fetches the Trello username for the given token.
+func (c *Connector) GetAccountIdentifier(ctx context.Context, accessToken string) (string, error) {
+	client := NewClient(c.config.APIKey, staticToken(accessToken))
+	client.baseURL = c.client.baseURL
+	member, err := client.Me(ctx)
+	if err != nil {
+		return "", err
+	}
+	return member.Username, nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+// staticToken is a TokenProvider for a token supplied directly.
+type staticToken string
+
+func (t staticToken) GetToken(_ context.Context) (string, error) { return string(t), nil }
+func (t staticToken) AuthorizationID() string                    { return "" }
+func (t staticToken) AuthMethod() domain.AuthMethod              { return domain.AuthMethodPAT }
+func (t staticToken) IsAuthenticated() bool                      { return t != "" }
@@ -0,0 +1,307 @@
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct {
+	token string
+}
+
+func (p *mockTokenProvider) GetToken(_ context.Context) (string, error) { return p.token, nil }
+func (p *mockTokenProvider) AuthorizationID() string                    { return "test-auth" }
+func (p *mockTokenProvider) AuthMethod() domain.AuthMethod              { return domain.AuthMethodPAT }
+func (p *mockTokenProvider) IsAuthenticated() bool                      { return p.token != "" }
+
+// idAt returns the n-th Trello-style object ID created at t.
+func idAt(t time.Time, n int) string {
+	return fmt.Sprintf("%08x%016x", t.Unix(), n)
+}
+
+// fakeTrello serves a minimal Trello API backed by in-memory cards per board.
+type fakeTrello struct {
+	cards   map[string][]Card
+	filters []string
+}
+
+func (f *fakeTrello) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != `OAuth oauth_consumer_key="key", oauth_token="token"` {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := r.URL.Path
+	var body any
+	switch {
+	case path == "/members/me":
+		body = Member{ID: "m1", Username: "ada"}
+	case path == "/members/me/boards":
+		body = []Board{{ID: "b1", Name: "Roadmap"}}
+	case strings.HasSuffix(path, "/cards"):
+		boardID := strings.TrimSuffix(strings.TrimPrefix(path, "/boards/"), "/cards")
+		f.filters = append(f.filters, r.URL.Query().Get("filter"))
+		cards := f.cards[boardID]
+		if r.URL.Query().Get("filter") == "open" {
+			open := make([]Card, 0, len(cards))
+			for _, c := range cards {
+				if !c.Closed {
+					open = append(open, c)
+				}
+			}
+			cards = open
+		}
+		body = cards
+	case strings.HasPrefix(path, "/boards/"):
+		id := strings.TrimPrefix(path, "/boards/")
+		if _, ok := f.cards[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body = Board{ID: id, Name: "Board " + id}
+	case strings.HasSuffix(path, "/actions"):
+		action := Action{Date: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}
+		action.MemberCreator.Username = "ada"
+		action.Data.Text = "comment on " + strings.Split(path, "/")[2]
+		body = []Action{action}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newTestConnector(t *testing.T, fake *fakeTrello, cfg *Config, token string) *Connector {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	if cfg.APIKey == "" {
+		cfg.APIKey = "key"
+	}
+	c := New("src-1", cfg, &mockTokenProvider{token: token})
+	c.client.baseURL = server.URL
+	return c
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var out []domain.RawDocument
+	for doc := range docs {
+		out = append(out, doc)
+	}
+	return out, <-errs
+}
+
+func collectChanges(
+	changes <-chan domain.RawDocumentChange, errs <-chan error,
+) ([]domain.RawDocumentChange, error) {
+	var out []domain.RawDocumentChange
+	for change := range changes {
+		out = append(out, change)
+	}
+	return out, <-errs
+}
+
+func TestNew(t *testing.T) {
+	c := New("src-1", &Config{APIKey: "key"}, &mockTokenProvider{token: "token"})
+
+	assert.Equal(t, "trello", c.Type())
+	assert.Equal(t, "src-1", c.SourceID())
+	assert.True(t, c.Capabilities().SupportsIncremental)
+	assert.True(t, c.Capabilities().RequiresAuth)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	t.Run("accepts valid credentials", func(t *testing.T) {
+		c := newTestConnector(t, &fakeTrello{}, DefaultConfig(), "token")
+		assert.NoError(t, c.Validate(context.Background()))
+	})
+
+	t.Run("rejects invalid token", func(t *testing.T) {
+		c := newTestConnector(t, &fakeTrello{}, DefaultConfig(), "bad")
+		err := c.Validate(context.Background())
+		assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+		assert.ErrorIs(t, err, ErrUnauthorised)
+	})
+
+	t.Run("fails when closed", func(t *testing.T) {
+		c := newTestConnector(t, &fakeTrello{}, DefaultConfig(), "token")
+		require.NoError(t, c.Close())
+		assert.ErrorIs(t, c.Validate(context.Background()), domain.ErrConnectorClosed)
+	})
+}
+
+func TestConnector_FullSync(t *testing.T) {
+	activity := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := &fakeTrello{cards: map[string][]Card{
+		"b1": {
+			{ID: "c1", Name: "Open card", DateLastActivity: activity},
+			{ID: "c2", Name: "Archived card", Closed: true, DateLastActivity: activity},
+		},
+	}}
+
+	t.Run("syncs open cards of all boards", func(t *testing.T) {
+		fake.filters = nil
+		c := newTestConnector(t, fake, DefaultConfig(), "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		cursor, decodeErr := DecodeCursor(complete.NewCursor)
+		require.NoError(t, decodeErr)
+		assert.False(t, cursor.IsEmpty())
+
+		require.Len(t, docs, 1)
+		assert.Equal(t, "trello://b1/c1", docs[0].URI)
+		assert.Equal(t, "Roadmap", docs[0].Metadata["board_name"])
+		assert.Contains(t, string(docs[0].Content), "comment on c1")
+		assert.Equal(t, []string{"open"}, fake.filters)
+	})
+
+	t.Run("includes archived cards when configured", func(t *testing.T) {
+		fake.filters = nil
+		cfg := DefaultConfig()
+		cfg.IncludeArchived = true
+		cfg.IncludeComments = false
+		c := newTestConnector(t, fake, cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		require.Len(t, docs, 2)
+		assert.NotContains(t, string(docs[0].Content), "## Comments")
+		assert.Equal(t, []string{"all"}, fake.filters)
+	})
+
+	t.Run("syncs configured boards only", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.BoardIDs = []string{"b1"}
+		c := newTestConnector(t, fake, cfg, "token")
+
+		docs, err := collectDocs(c.FullSync(context.Background()))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		require.Len(t, docs, 1)
+		assert.Equal(t, "Board b1", docs[0].Metadata["board_name"])
+	})
+
+	t.Run("fails for unknown board", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.BoardIDs = []string{"missing"}
+		c := newTestConnector(t, fake, cfg, "token")
+
+		_, err := collectDocs(c.FullSync(context.Background()))
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestConnector_IncrementalSync(t *testing.T) {
+	lastSync := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	before := lastSync.Add(-24 * time.Hour)
+	after := lastSync.Add(time.Hour)
+
+	fake := &fakeTrello{cards: map[string][]Card{
+		"b1": {
+			{ID: idAt(before, 1), Name: "Unchanged", DateLastActivity: before},
+			{ID: idAt(before, 2), Name: "Edited", DateLastActivity: after},
+			{ID: idAt(after, 3), Name: "New", DateLastActivity: after},
+			{ID: "archived", Name: "Archived", Closed: true, DateLastActivity: after},
+		},
+	}}
+
+	cursor := NewCursor()
+	cursor.SetLastSyncTime(lastSync)
+	state := domain.SyncState{SourceID: "src-1", Cursor: cursor.Encode()}
+
+	t.Run("emits cards with activity since last sync", func(t *testing.T) {
+		fake.filters = nil
+		c := newTestConnector(t, fake, DefaultConfig(), "token")
+
+		changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		newCursor, decodeErr := DecodeCursor(complete.NewCursor)
+		require.NoError(t, decodeErr)
+		assert.True(t, newCursor.LastSyncTime.After(lastSync))
+
+		require.Len(t, changes, 3)
+		assert.Equal(t, domain.ChangeUpdated, changes[0].Type)
+		assert.Contains(t, string(changes[0].Document.Content), "# Edited")
+		assert.Equal(t, domain.ChangeCreated, changes[1].Type)
+		assert.Contains(t, string(changes[1].Document.Content), "# New")
+		assert.Equal(t, domain.ChangeDeleted, changes[2].Type)
+		assert.Equal(t, "trello://b1/archived", changes[2].Document.URI)
+		assert.Equal(t, []string{"all"}, fake.filters)
+	})
+
+	t.Run("keeps archived cards when configured", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.IncludeArchived = true
+		c := newTestConnector(t, fake, cfg, "token")
+
+		changes, err := collectChanges(c.IncrementalSync(context.Background(), state))
+
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		require.Len(t, changes, 3)
+		assert.Equal(t, domain.ChangeUpdated, changes[2].Type)
+		assert.Contains(t, string(changes[2].Document.Content), "Archived: yes")
+	})
+
+	t.Run("requires a cursor", func(t *testing.T) {
+		c := newTestConnector(t, fake, DefaultConfig(), "token")
+
+		_, err := collectChanges(c.IncrementalSync(context.Background(), domain.SyncState{}))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "full sync required")
+	})
+}
+
+func TestConnector_GetAccountIdentifier(t *testing.T) {
+	c := newTestConnector(t, &fakeTrello{}, DefaultConfig(), "ignored")
+
+	username, err := c.GetAccountIdentifier(context.Background(), "token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ada", username)
+}
+
+func TestCardCreatedAt(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	created, ok := cardCreatedAt(idAt(ts, 1))
+	require.True(t, ok)
+	assert.True(t, ts.Equal(created))
+
+	_, ok = cardCreatedAt("short")
+	assert.False(t, ok)
+	_, ok = cardCreatedAt("zzzzzzzz0000")
+	assert.False(t, ok)
+}
+
+func TestConnector_Watch(t *testing.T) {
+	c := New("src-1", &Config{APIKey: "key"}, &mockTokenProvider{token: "token"})
+	_, err := c.Watch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
@@ -0,0 +1,47 @@
+package trello
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	ts := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cursor := NewCursor()
+	cursor.SetLastSyncTime(ts)
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	assert.Equal(t, CursorVersion, decoded.Version)
+	assert.True(t, ts.Equal(decoded.LastSyncTime))
+	assert.False(t, decoded.IsEmpty())
+}
+
+func TestDecodeCursor_Empty(t *testing.T) {
+	cursor, err := DecodeCursor("")
+
+	require.NoError(t, err)
+	assert.True(t, cursor.IsEmpty())
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"not base64", "!!!"},
+		{"not json", "bm90IGpzb24="},
+		{"future version", "eyJ2Ijo5OX0="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeCursor(tt.input)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package trello
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Rate limit configuration for the Trello API.
+// Trello allows 100 requests per 10 seconds per token; we stay below that.
+const (
+	// RequestsPerSecond is the sustained rate limit.
+	RequestsPerSecond = 8.0
+	// BurstSize is the maximum burst size.
+	BurstSize = 10
+)
+
+// RateLimiter provides rate limiting for Trello API requests.
+// It uses a token bucket algorithm with a backoff period after 429 responses.
+type RateLimiter struct {
+	mu      sync.Mutex
+	limiter *rate.Limiter
+	retryAt time.Time
+}
+
+// NewRateLimiter creates a new rate limiter for Trello.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(RequestsPerSecond), BurstSize),
+	}
+}
+
+// Wait blocks until a request can be made without exceeding the rate limit.
+// It also respects any backoff period set by RecordRateLimitError.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	retryAt := r.retryAt
+	r.mu.Unlock()
+
+	if time.Now().Before(retryAt) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(retryAt)):
+		}
+	}
+
+	return r.limiter.Wait(ctx)
+}
+
+// RecordRateLimitError sets a backoff period after a 429 response.
+// Trello does not send Retry-After, so the backoff covers one rate window.
+func (r *RateLimiter) RecordRateLimitError() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.retryAt = time.Now().Add(10 * time.Second)
+}
@@ -0,0 +1,25 @@
+package trello
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResolveWebURL converts a trello:// URI to a web URL.
+// URI format: trello://{boardId}/{cardId}.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	// Priority 1: Use the card URL returned by the API
+	if u, ok := metadata["url"].(string); ok && u != "" {
+		return u
+	}
+
+	// Priority 2: Trello redirects card IDs to the full card URL
+	rest := strings.TrimPrefix(uri, "trello://")
+	if boardID, cardID, ok := strings.Cut(rest, "/"); ok && cardID != "" {
+		return fmt.Sprintf("https://trello.com/c/%s", cardID)
+	} else if boardID != "" {
+		return fmt.Sprintf("https://trello.com/b/%s", boardID)
+	}
+
+	return "https://trello.com"
+}
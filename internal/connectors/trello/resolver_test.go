@@ -0,0 +1,47 @@
+package trello
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveWebURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		metadata map[string]any
+		expected string
+	}{
+		{
+			name:     "card url from metadata",
+			uri:      "trello://b1/c1",
+			metadata: map[string]any{"url": "https://trello.com/c/abc/1-card"},
+			expected: "https://trello.com/c/abc/1-card",
+		},
+		{
+			name:     "card id fallback",
+			uri:      "trello://b1/c1",
+			metadata: map[string]any{},
+			expected: "https://trello.com/c/c1",
+		},
+		{
+			name:     "board uri",
+			uri:      "trello://b1",
+			metadata: nil,
+			expected: "https://trello.com/b/b1",
+		},
+		{
+			name:     "invalid uri",
+			uri:      "trello://",
+			metadata: nil,
+			expected: "https://trello.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ResolveWebURL(tt.uri, tt.metadata))
+		})
+	}
+}
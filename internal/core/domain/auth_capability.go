@@ -13,6 +13,8 @@ const (
 	AuthCapPAT AuthCapability = 1 << 0
 	// AuthCapOAuth indicates OAuth 2.0 authentication is supported.
 	AuthCapOAuth AuthCapability = 1 << 1
+	// AuthCapGitHubApp indicates GitHub App installation authentication is supported.
+	AuthCapGitHubApp AuthCapability = 1 << 2
 )
 
 // SupportsPAT returns true if PAT authentication is supported.
@@ -25,9 +27,14 @@ func (c AuthCapability) SupportsOAuth() bool {
 	return c&AuthCapOAuth != 0
 }
 
+// SupportsGitHubApp returns true if GitHub App authentication is supported.
+func (c AuthCapability) SupportsGitHubApp() bool {
+	return c&AuthCapGitHubApp != 0
+}
+
 // SupportsMultipleMethods returns true if more than one auth method is supported.
 func (c AuthCapability) SupportsMultipleMethods() bool {
-	return c.SupportsPAT() && c.SupportsOAuth()
+	return len(c.SupportedMethods()) > 1
 }
 
 // RequiresAuth returns true if any authentication is required.
@@ -45,6 +52,9 @@ func (c AuthCapability) SupportedMethods() []AuthMethod {
 	if c.SupportsOAuth() {
 		methods = append(methods, AuthMethodOAuth)
 	}
+	if c.SupportsGitHubApp() {
+		methods = append(methods, AuthMethodGitHubApp)
+	}
 	return methods
 }
 
@@ -60,5 +70,8 @@ func (c AuthCapability) String() string {
 	if c.SupportsOAuth() {
 		parts = append(parts, "oauth")
 	}
+	if c.SupportsGitHubApp() {
+		parts = append(parts, "github_app")
+	}
 	return strings.Join(parts, ",")
 }
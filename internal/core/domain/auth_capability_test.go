@@ -10,6 +10,7 @@ func TestAuthCapability_Constants(t *testing.T) {
 	assert.Equal(t, AuthCapability(0), AuthCapNone)
 	assert.Equal(t, AuthCapability(1), AuthCapPAT)
 	assert.Equal(t, AuthCapability(2), AuthCapOAuth)
+	assert.Equal(t, AuthCapability(4), AuthCapGitHubApp)
 }
 
 func TestAuthCapability_SupportsPAT(t *testing.T) {
@@ -50,6 +51,12 @@ func TestAuthCapability_SupportsOAuth(t *testing.T) {
 	}
 }
 
+func TestAuthCapability_SupportsGitHubApp(t *testing.T) {
+	assert.False(t, (AuthCapPAT | AuthCapOAuth).SupportsGitHubApp())
+	assert.True(t, AuthCapGitHubApp.SupportsGitHubApp())
+	assert.True(t, (AuthCapPAT | AuthCapGitHubApp).SupportsGitHubApp())
+}
+
 func TestAuthCapability_SupportsMultipleMethods(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -60,6 +67,8 @@ func TestAuthCapability_SupportsMultipleMethods(t *testing.T) {
 		{"pat only", AuthCapPAT, false},
 		{"oauth only", AuthCapOAuth, false},
 		{"pat and oauth", AuthCapPAT | AuthCapOAuth, true},
+		{"github app only", AuthCapGitHubApp, false},
+		{"pat and github app", AuthCapPAT | AuthCapGitHubApp, true},
 	}
 
 	for _, tt := range tests {
@@ -98,6 +107,10 @@ func TestAuthCapability_SupportedMethods(t *testing.T) {
 		{"pat only", AuthCapPAT, []AuthMethod{AuthMethodPAT}},
 		{"oauth only", AuthCapOAuth, []AuthMethod{AuthMethodOAuth}},
 		{"pat and oauth", AuthCapPAT | AuthCapOAuth, []AuthMethod{AuthMethodPAT, AuthMethodOAuth}},
+		{
+			"all", AuthCapPAT | AuthCapOAuth | AuthCapGitHubApp,
+			[]AuthMethod{AuthMethodPAT, AuthMethodOAuth, AuthMethodGitHubApp},
+		},
 	}
 
 	for _, tt := range tests {
@@ -122,6 +135,7 @@ func TestAuthCapability_String(t *testing.T) {
 		{"pat only", AuthCapPAT, "pat"},
 		{"oauth only", AuthCapOAuth, "oauth"},
 		{"pat and oauth", AuthCapPAT | AuthCapOAuth, "pat,oauth"},
+		{"all", AuthCapPAT | AuthCapOAuth | AuthCapGitHubApp, "pat,oauth,github_app"},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// Collection is a named group of sources, such as "Work" or "Personal".
+// A source belongs to at most one collection, set by Source.Collection.
+type Collection struct {
+	// Name is the collection name, as first written on one of its sources.
+	Name string
+
+	// SourceIDs lists the sources in the collection in source order.
+	SourceIDs []string
+}
+
+// InCollection returns true if the source belongs to the named collection.
+// Names are compared ignoring case and surrounding whitespace.
+func (s *Source) InCollection(name string) bool {
+	name = strings.TrimSpace(name)
+	return name != "" && strings.EqualFold(strings.TrimSpace(s.Collection), name)
+}
+
+// GroupCollections returns the collections the sources belong to, ordered by
+// name. Names differing only in case form one collection. Sources outside
+// any collection are left out.
+func GroupCollections(sources []Source) []Collection {
+	var collections []Collection
+	index := make(map[string]int)
+	for i := range sources {
+		name := strings.TrimSpace(sources[i].Collection)
+		if name == "" {
+			continue
+		}
+		key := strings.ToLower(name)
+		pos, ok := index[key]
+		if !ok {
+			pos = len(collections)
+			index[key] = pos
+			collections = append(collections, Collection{Name: name})
+		}
+		collections[pos].SourceIDs = append(collections[pos].SourceIDs, sources[i].ID)
+	}
+	sort.SliceStable(collections, func(i, j int) bool {
+		return strings.ToLower(collections[i].Name) < strings.ToLower(collections[j].Name)
+	})
+	return collections
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSource_InCollection(t *testing.T) {
+	source := Source{ID: "notes", Collection: "Work"}
+
+	assert.True(t, source.InCollection("Work"))
+	assert.True(t, source.InCollection(" work "))
+	assert.False(t, source.InCollection("Personal"))
+	assert.False(t, source.InCollection(""))
+	assert.False(t, (&Source{}).InCollection(""))
+}
+
+func TestGroupCollections(t *testing.T) {
+	sources := []Source{
+		{ID: "notes", Collection: "Work"},
+		{ID: "photos", Collection: "personal"},
+		{ID: "loose"},
+		{ID: "repo", Collection: " work"},
+	}
+
+	collections := GroupCollections(sources)
+
+	assert.Equal(t, []Collection{
+		{Name: "personal", SourceIDs: []string{"photos"}},
+		{Name: "Work", SourceIDs: []string{"notes", "repo"}},
+	}, collections)
+	assert.Empty(t, GroupCollections([]Source{{ID: "loose"}}))
+}
@@ -10,6 +10,8 @@ const (
 	AuthMethodPAT AuthMethod = "pat"
 	// AuthMethodOAuth uses OAuth 2.0 with PKCE.
 	AuthMethodOAuth AuthMethod = "oauth"
+	// AuthMethodGitHubApp uses short-lived GitHub App installation tokens.
+	AuthMethodGitHubApp AuthMethod = "github_app"
 )
 
 // ConnectorType describes a supported connector.
@@ -30,6 +32,11 @@ type ConnectorType struct {
 	AuthMethod AuthMethod
 	// ConfigKeys lists the configuration fields required by this connector.
 	ConfigKeys []ConfigKey
+	// ContentTypes lists the kinds of content the connector indexes (e.g., "issues", "emails").
+	ContentTypes []string
+	// AuthHint describes what the credentials need, such as token scopes.
+	// Empty if there is nothing beyond the auth method to note.
+	AuthHint string
 	// WebURLResolver converts document URIs to web-openable URLs.
 	// If nil, falls back to legacy URI conversion.
 	WebURLResolver WebURLResolver
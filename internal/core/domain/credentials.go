@@ -26,6 +26,10 @@ type Credentials struct {
 	// Nil for OAuth authentication.
 	PAT *PATCredentials `json:"pat,omitempty"`
 
+	// GitHubApp holds a GitHub App installation (for GitHub App authentication).
+	// Nil for other authentication methods.
+	GitHubApp *GitHubAppCredentials `json:"github_app,omitempty"`
+
 	// CreatedAt is when the credentials were created.
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt is when the credentials were last updated.
@@ -50,6 +54,22 @@ type PATCredentials struct {
 	Token string `json:"token"`
 }
 
+// GitHubAppCredentials identifies a GitHub App installation.
+// Short-lived installation tokens are minted from these as needed.
+type GitHubAppCredentials struct {
+	// AppID is the numeric ID of the GitHub App.
+	AppID int64 `json:"app_id"`
+	// InstallationID is the ID of the App's installation on an account.
+	InstallationID int64 `json:"installation_id"`
+	// PrivateKey is the App's PEM-encoded RSA private key.
+	PrivateKey string `json:"private_key"`
+}
+
+// IsComplete returns true if every field needed to mint a token is set.
+func (c *GitHubAppCredentials) IsComplete() bool {
+	return c.AppID > 0 && c.InstallationID > 0 && c.PrivateKey != ""
+}
+
 // IsExpired returns true if the OAuth access token has expired.
 func (c *OAuthCredentials) IsExpired() bool {
 	if c.Expiry.IsZero() {
@@ -66,6 +86,9 @@ func (c *Credentials) IsAuthenticated() bool {
 	if c.PAT != nil && c.PAT.Token != "" {
 		return true
 	}
+	if c.GitHubApp != nil && c.GitHubApp.IsComplete() {
+		return true
+	}
 	return false
 }
 
@@ -78,6 +101,7 @@ func (c *Credentials) NeedsRefresh() bool {
 }
 
 // GetAccessToken returns the access token (either OAuth or PAT).
+// GitHub App credentials have no stored token, so it returns "" for them.
 func (c *Credentials) GetAccessToken() string {
 	if c.OAuth != nil && c.OAuth.AccessToken != "" {
 		return c.OAuth.AccessToken
@@ -92,3 +116,26 @@ func (c *Credentials) GetAccessToken() string {
 func (c *Credentials) HasRefreshToken() bool {
 	return c.OAuth != nil && c.OAuth.RefreshToken != ""
 }
+
+// AccountInfo describes the account that completed authentication.
+// Fetched from the provider's userinfo endpoint after OAuth.
+type AccountInfo struct {
+	// Identifier is the stable account identifier stored with credentials.
+	// GitHub returns the username; Google and Microsoft return the email.
+	Identifier string
+	// DisplayName is the human-readable account name, if the provider has one.
+	DisplayName string
+	// Email is the account email address, if the provider exposes it.
+	Email string
+	// AvatarURL is the account avatar image URL, if the provider exposes it.
+	AvatarURL string
+}
+
+// Label returns the name shown for the account in source names.
+// Uses DisplayName when set, falling back to Identifier.
+func (a AccountInfo) Label() string {
+	if a.DisplayName != "" {
+		return a.DisplayName
+	}
+	return a.Identifier
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAccountInfo_Label_PrefersDisplayName tests that the display name is used when set
+func TestAccountInfo_Label_PrefersDisplayName(t *testing.T) {
+	account := AccountInfo{Identifier: "octocat", DisplayName: "The Octocat"}
+
+	assert.Equal(t, "The Octocat", account.Label())
+}
+
+// TestAccountInfo_Label_FallsBackToIdentifier tests fallback when no display name is set
+func TestAccountInfo_Label_FallsBackToIdentifier(t *testing.T) {
+	account := AccountInfo{Identifier: "user@example.com", Email: "user@example.com"}
+
+	assert.Equal(t, "user@example.com", account.Label())
+}
+
+// TestAccountInfo_Label_Empty tests that an empty account has an empty label
+func TestAccountInfo_Label_Empty(t *testing.T) {
+	assert.Empty(t, AccountInfo{}.Label())
+}
+
+// TestCredentials_IsAuthenticated_GitHubApp tests that a complete GitHub App installation authenticates
+func TestCredentials_IsAuthenticated_GitHubApp(t *testing.T) {
+	creds := Credentials{GitHubApp: &GitHubAppCredentials{AppID: 1, InstallationID: 2, PrivateKey: "key"}}
+	assert.True(t, creds.IsAuthenticated())
+	assert.Empty(t, creds.GetAccessToken())
+
+	creds.GitHubApp.InstallationID = 0
+	assert.False(t, creds.IsAuthenticated())
+}
@@ -1,6 +1,67 @@
 package domain
 
-import "time"
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+// MetadataKeywords is the document and chunk metadata key for LLM-extracted keywords.
+const MetadataKeywords = "keywords"
+
+// MetadataParentURI is the document metadata key for the URI of the parent
+// the connector reported. Breadcrumbs are rebuilt from it when the parent is
+// not linked by ParentID.
+const MetadataParentURI = "parent_uri"
+
+// MetadataMetadataOnly is the raw document and document metadata key marking
+// a document indexed by name and metadata only. Its content is not fetched,
+// and it is indexed for keyword search without embeddings.
+const MetadataMetadataOnly = "metadata_only"
+
+// MetadataPartial is the raw document metadata key marking a document that
+// holds only what was added since the last sync, such as new comments. The
+// sync pipeline appends it to the stored document instead of replacing it.
+const MetadataPartial = "partial"
+
+// MetadataAuthorName and MetadataAuthorID are the raw document and chunk
+// metadata keys for the author's display name and identifier. Connectors set
+// them when their normaliser cannot read the author from the content, and the
+// sync pipeline copies the document's author into chunk metadata under the
+// same keys for the search index.
+const (
+	MetadataAuthorName = "author_name"
+	MetadataAuthorID   = "author_id"
+)
+
+// MetadataDocumentTitle is the chunk metadata key for the title of the
+// chunk's document. The sync pipeline sets it so the search index can weight
+// title matches separately from the chunk content.
+const MetadataDocumentTitle = "document_title"
+
+// MetadataLanguage is the document and chunk metadata key for the ISO 639-1
+// code of the language the content is written in. The normaliser registry
+// detects it, and the search index picks a stemmer from it for each chunk.
+const MetadataLanguage = "language"
+
+// DocumentLanguage returns the language code stored in metadata, or an empty
+// string if the language is not known.
+func DocumentLanguage(metadata map[string]any) string {
+	lang, _ := metadata[MetadataLanguage].(string)
+	return lang
+}
+
+// IsMetadataOnly reports whether metadata marks a metadata-only document.
+func IsMetadataOnly(metadata map[string]any) bool {
+	metadataOnly, _ := metadata[MetadataMetadataOnly].(bool)
+	return metadataOnly
+}
+
+// IsPartial reports whether metadata marks a partial document.
+func IsPartial(metadata map[string]any) bool {
+	partial, _ := metadata[MetadataPartial].(bool)
+	return partial
+}
 
 // Document represents an indexed document with metadata.
 // It is the canonical representation after normalisation.
@@ -24,6 +85,9 @@ type Document struct {
 	// ParentID links to a parent document for hierarchical sources.
 	ParentID *string
 
+	// Author is who wrote the document, or the zero value when unknown.
+	Author Author
+
 	// Metadata contains arbitrary key-value pairs.
 	Metadata map[string]any
 
@@ -34,6 +98,69 @@ type Document struct {
 	UpdatedAt time.Time
 }
 
+// Author identifies the person who wrote a document.
+type Author struct {
+	// Name is the display name, such as "Alice Smith".
+	Name string
+
+	// Identifier is a stable handle for the author within its source, such
+	// as an email address or a GitHub login.
+	Identifier string
+}
+
+// AuthorFromMetadata reads the author from the standard author metadata keys.
+func AuthorFromMetadata(metadata map[string]any) Author {
+	name, _ := metadata[MetadataAuthorName].(string)
+	id, _ := metadata[MetadataAuthorID].(string)
+	return Author{Name: strings.TrimSpace(name), Identifier: strings.TrimSpace(id)}
+}
+
+// SetAuthorMetadata stores author under the standard author metadata keys.
+// An unknown author leaves metadata unchanged.
+func SetAuthorMetadata(metadata map[string]any, author Author) {
+	if author.IsZero() {
+		return
+	}
+	metadata[MetadataAuthorName] = author.Name
+	metadata[MetadataAuthorID] = author.Identifier
+}
+
+// IsZero reports whether the author is unknown.
+func (a Author) IsZero() bool {
+	return a.Name == "" && a.Identifier == ""
+}
+
+// Key returns the value the author is filtered and faceted by: the
+// identifier, or the name when there is no identifier.
+func (a Author) Key() string {
+	if a.Identifier != "" {
+		return a.Identifier
+	}
+	return a.Name
+}
+
+// String formats the author as "Name <identifier>", or whichever part is known.
+func (a Author) String() string {
+	switch {
+	case a.Name != "" && a.Identifier != "" && a.Name != a.Identifier:
+		return a.Name + " <" + a.Identifier + ">"
+	case a.Name != "":
+		return a.Name
+	default:
+		return a.Identifier
+	}
+}
+
+// Matches reports whether the author's name or identifier equals value,
+// ignoring case.
+func (a Author) Matches(value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" || a.IsZero() {
+		return false
+	}
+	return strings.EqualFold(a.Name, value) || strings.EqualFold(a.Identifier, value)
+}
+
 // Chunk represents a searchable unit within a document.
 // Documents are split into chunks for granular search results.
 type Chunk struct {
@@ -55,3 +182,38 @@ type Chunk struct {
 	// Metadata contains chunk-specific key-value pairs.
 	Metadata map[string]any
 }
+
+// Breadcrumb is one ancestor of a document in its source's hierarchy.
+type Breadcrumb struct {
+	// DocumentID is the ancestor document, or empty when the ancestor is not
+	// an indexed document (such as a directory).
+	DocumentID string
+
+	// Title is the display name of the ancestor.
+	Title string
+
+	// URI is the ancestor's location.
+	URI string
+}
+
+// NewURIBreadcrumb creates a breadcrumb for an ancestor known only by its URI,
+// titled with the last segment of the URI.
+func NewURIBreadcrumb(uri string) Breadcrumb {
+	title := path.Base(strings.TrimRight(strings.ReplaceAll(uri, "\\", "/"), "/"))
+	if title == "." || title == "/" {
+		title = uri
+	}
+	return Breadcrumb{Title: title, URI: uri}
+}
+
+// BreadcrumbTrail is a document's ancestors, outermost first.
+type BreadcrumbTrail []Breadcrumb
+
+// String joins the titles of the trail, outermost first.
+func (t BreadcrumbTrail) String() string {
+	titles := make([]string, len(t))
+	for i, crumb := range t {
+		titles[i] = crumb.Title
+	}
+	return strings.Join(titles, " › ")
+}
@@ -372,3 +372,107 @@ func TestDocument_HierarchicalStructure(t *testing.T) {
 	assert.Equal(t, parentID, *child1.ParentID)
 	assert.Equal(t, parentID, *child2.ParentID)
 }
+
+func TestNewURIBreadcrumb(t *testing.T) {
+	tests := []struct {
+		uri   string
+		title string
+	}{
+		{"/home/user/docs/notes", "notes"},
+		{"/home/user/docs/notes/", "notes"},
+		{`C:\Users\me\notes`, "notes"},
+		{"discord://guild/channel", "channel"},
+		{"/", "/"},
+	}
+
+	for _, tt := range tests {
+		crumb := NewURIBreadcrumb(tt.uri)
+		assert.Equal(t, tt.title, crumb.Title, tt.uri)
+		assert.Equal(t, tt.uri, crumb.URI)
+		assert.Empty(t, crumb.DocumentID)
+	}
+}
+
+func TestBreadcrumbTrail_String(t *testing.T) {
+	trail := BreadcrumbTrail{{Title: "Engineering"}, {Title: "Runbooks"}}
+
+	assert.Equal(t, "Engineering › Runbooks", trail.String())
+	assert.Equal(t, "", BreadcrumbTrail(nil).String())
+}
+
+// TestIsMetadataOnly tests detection of the metadata-only marker
+func TestIsMetadataOnly(t *testing.T) {
+	assert.True(t, IsMetadataOnly(map[string]any{MetadataMetadataOnly: true}))
+	assert.False(t, IsMetadataOnly(map[string]any{MetadataMetadataOnly: false}))
+	assert.False(t, IsMetadataOnly(map[string]any{MetadataMetadataOnly: "true"}))
+	assert.False(t, IsMetadataOnly(nil))
+}
+
+// TestDocumentLanguage tests reading the language code from metadata
+func TestDocumentLanguage(t *testing.T) {
+	assert.Equal(t, "fr", DocumentLanguage(map[string]any{MetadataLanguage: "fr"}))
+	assert.Empty(t, DocumentLanguage(map[string]any{MetadataLanguage: 42}))
+	assert.Empty(t, DocumentLanguage(nil))
+}
+
+// TestIsPartial tests detection of the partial document marker
+func TestIsPartial(t *testing.T) {
+	assert.True(t, IsPartial(map[string]any{MetadataPartial: true}))
+	assert.False(t, IsPartial(map[string]any{MetadataPartial: false}))
+	assert.False(t, IsPartial(map[string]any{MetadataPartial: "true"}))
+	assert.False(t, IsPartial(nil))
+}
+
+// TestAuthorFromMetadata tests reading the author from standard metadata keys
+func TestAuthorFromMetadata(t *testing.T) {
+	author := AuthorFromMetadata(map[string]any{
+		MetadataAuthorName: " Alice Smith ",
+		MetadataAuthorID:   "alice@example.com",
+	})
+	assert.Equal(t, Author{Name: "Alice Smith", Identifier: "alice@example.com"}, author)
+
+	assert.True(t, AuthorFromMetadata(nil).IsZero())
+	assert.True(t, AuthorFromMetadata(map[string]any{MetadataAuthorName: 42}).IsZero())
+}
+
+// TestSetAuthorMetadata tests that an author round-trips through metadata
+func TestSetAuthorMetadata(t *testing.T) {
+	author := Author{Name: "Alice Smith", Identifier: "alice@example.com"}
+	metadata := map[string]any{}
+
+	SetAuthorMetadata(metadata, author)
+	assert.Equal(t, author, AuthorFromMetadata(metadata))
+
+	empty := map[string]any{}
+	SetAuthorMetadata(empty, Author{})
+	assert.Empty(t, empty)
+}
+
+// TestAuthor_KeyAndString tests the facet key and display form of an author
+func TestAuthor_KeyAndString(t *testing.T) {
+	full := Author{Name: "Alice Smith", Identifier: "alice@example.com"}
+	assert.Equal(t, "alice@example.com", full.Key())
+	assert.Equal(t, "Alice Smith <alice@example.com>", full.String())
+
+	nameOnly := Author{Name: "Alice Smith"}
+	assert.Equal(t, "Alice Smith", nameOnly.Key())
+	assert.Equal(t, "Alice Smith", nameOnly.String())
+
+	login := Author{Identifier: "octocat"}
+	assert.Equal(t, "octocat", login.Key())
+	assert.Equal(t, "octocat", login.String())
+
+	same := Author{Name: "octocat", Identifier: "octocat"}
+	assert.Equal(t, "octocat", same.String())
+}
+
+// TestAuthor_Matches tests case-insensitive matching on name or identifier
+func TestAuthor_Matches(t *testing.T) {
+	author := Author{Name: "Alice Smith", Identifier: "alice@example.com"}
+
+	assert.True(t, author.Matches("alice smith"))
+	assert.True(t, author.Matches("ALICE@example.com"))
+	assert.False(t, author.Matches("alice"))
+	assert.False(t, author.Matches(""))
+	assert.False(t, Author{}.Matches("alice"))
+}
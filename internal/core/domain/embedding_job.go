@@ -0,0 +1,99 @@
+package domain
+
+import "time"
+
+// EmbeddingJobStatus is the state of a queued embedding job.
+type EmbeddingJobStatus string
+
+// Embedding job states.
+const (
+	// EmbeddingJobPending is waiting for a worker, or for its retry time
+	// after a failed attempt.
+	EmbeddingJobPending EmbeddingJobStatus = "pending"
+
+	// EmbeddingJobRunning has been claimed by a worker.
+	EmbeddingJobRunning EmbeddingJobStatus = "running"
+
+	// EmbeddingJobDone has its embedding stored and indexed.
+	EmbeddingJobDone EmbeddingJobStatus = "done"
+
+	// EmbeddingJobFailed could not be embedded in as many attempts as allowed.
+	EmbeddingJobFailed EmbeddingJobStatus = "failed"
+)
+
+// IsValid returns true if the status is recognised.
+func (s EmbeddingJobStatus) IsValid() bool {
+	switch s {
+	case EmbeddingJobPending, EmbeddingJobRunning, EmbeddingJobDone, EmbeddingJobFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// EmbeddingJob is a chunk waiting for its embedding to be generated.
+type EmbeddingJob struct {
+	// ID is the unique identifier for the job.
+	ID int64
+
+	// ChunkID is the chunk to embed.
+	ChunkID string
+
+	// Status is the current state of the job.
+	Status EmbeddingJobStatus
+
+	// Attempts is the number of failed attempts to embed the chunk.
+	Attempts int
+
+	// RetryAt is the earliest time a job that failed is claimed again.
+	// Zero for jobs that have not failed.
+	RetryAt time.Time
+
+	// CreatedAt is when the job was queued.
+	CreatedAt time.Time
+
+	// UpdatedAt is when the status last changed.
+	UpdatedAt time.Time
+}
+
+// Embedding retry backoff.
+const (
+	// EmbeddingRetryBaseDelay is the wait before retrying a chunk's first failed attempt.
+	EmbeddingRetryBaseDelay = 30 * time.Second
+
+	// EmbeddingRetryMaxDelay caps the wait between attempts.
+	EmbeddingRetryMaxDelay = time.Hour
+)
+
+// EmbeddingRetryDelay returns the wait before retrying a chunk that has
+// failed attempts times. It doubles with each failure, up to EmbeddingRetryMaxDelay.
+func EmbeddingRetryDelay(attempts int) time.Duration {
+	delay := EmbeddingRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= EmbeddingRetryMaxDelay {
+			return EmbeddingRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// EmbeddingQueueStats counts the jobs in the embedding queue.
+type EmbeddingQueueStats struct {
+	// Pending is the number of chunks waiting for an embedding, including
+	// those being embedded and those waiting to be retried.
+	Pending int
+
+	// Retrying is how many of the pending chunks are waiting out the delay
+	// before their next attempt.
+	Retrying int
+
+	// Failed is the number of chunks whose embedding was given up on.
+	// They are searchable by keyword only until they are queued again.
+	Failed int
+}
+
+// Ready returns the number of pending chunks that are not waiting to be retried.
+func (s EmbeddingQueueStats) Ready() int {
+	return s.Pending - s.Retrying
+}
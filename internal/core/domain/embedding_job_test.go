@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddingJobStatus_IsValid(t *testing.T) {
+	for _, status := range []EmbeddingJobStatus{
+		EmbeddingJobPending, EmbeddingJobRunning, EmbeddingJobDone, EmbeddingJobFailed,
+	} {
+		assert.True(t, status.IsValid(), status)
+	}
+	assert.False(t, EmbeddingJobStatus("retrying").IsValid())
+	assert.False(t, EmbeddingJobStatus("").IsValid())
+}
+
+func TestEmbeddingRetryDelay(t *testing.T) {
+	assert.Equal(t, EmbeddingRetryBaseDelay, EmbeddingRetryDelay(0))
+	assert.Equal(t, EmbeddingRetryBaseDelay, EmbeddingRetryDelay(1))
+	assert.Equal(t, 2*EmbeddingRetryBaseDelay, EmbeddingRetryDelay(2))
+	assert.Equal(t, 8*EmbeddingRetryBaseDelay, EmbeddingRetryDelay(4))
+
+	// The delay stops growing at the cap
+	assert.Equal(t, EmbeddingRetryMaxDelay, EmbeddingRetryDelay(10))
+	assert.Equal(t, time.Hour, EmbeddingRetryDelay(1000))
+}
+
+func TestEmbeddingQueueStats_Ready(t *testing.T) {
+	stats := EmbeddingQueueStats{Pending: 5, Retrying: 2, Failed: 1}
+	assert.Equal(t, 3, stats.Ready())
+	assert.Zero(t, EmbeddingQueueStats{}.Ready())
+}
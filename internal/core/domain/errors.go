@@ -1,6 +1,10 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // Domain errors represent business logic failures.
 // These are distinct from infrastructure errors.
@@ -23,6 +27,13 @@ var (
 	// ErrSyncInProgress indicates a sync is already running.
 	ErrSyncInProgress = errors.New("sync in progress")
 
+	// ErrSyncAborted indicates a strict sync stopped at the first document error.
+	ErrSyncAborted = errors.New("sync aborted")
+
+	// ErrSyncNotUndoable indicates the last sync of a source changed too
+	// many documents to be undone.
+	ErrSyncNotUndoable = errors.New("sync cannot be undone")
+
 	// ErrLLMUnavailable indicates the LLM service is not configured.
 	// Features requiring LLM (query rewriting, summarisation) are disabled.
 	ErrLLMUnavailable = errors.New("LLM service unavailable")
@@ -68,3 +79,44 @@ var (
 	// ErrAuthProviderInUse indicates an auth provider cannot be deleted because sources depend on it.
 	ErrAuthProviderInUse = errors.New("auth provider is in use by one or more sources")
 )
+
+// ConfigFieldError describes a single invalid entry in the configuration file.
+type ConfigFieldError struct {
+	// Field is the dot-notation key, e.g. "search.mode".
+	Field string
+
+	// Value is the offending value as written in the file.
+	Value string
+
+	// Message explains what is wrong and, where possible, how to fix it.
+	Message string
+}
+
+// Error returns the field and message.
+func (e ConfigFieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ConfigValidationError collects every problem found in the configuration file,
+// so users can fix them in one pass rather than one at a time.
+type ConfigValidationError struct {
+	// Path is the configuration file that was validated.
+	Path string
+
+	// Errors lists the invalid entries in key order.
+	Errors []ConfigFieldError
+}
+
+// Error summarises the problems on a single line.
+func (e *ConfigValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return fmt.Sprintf("invalid config %s: %s", e.Path, strings.Join(msgs, "; "))
+}
+
+// Unwrap allows errors.Is(err, ErrInvalidInput).
+func (e *ConfigValidationError) Unwrap() error {
+	return ErrInvalidInput
+}
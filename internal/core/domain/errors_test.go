@@ -264,3 +264,23 @@ func TestErrors_OperationErrors(t *testing.T) {
 		assert.NotEmpty(t, err.Error())
 	}
 }
+
+// TestConfigValidationError tests that every field problem is reported
+func TestConfigValidationError(t *testing.T) {
+	err := &ConfigValidationError{
+		Path: "/home/user/.sercha/config.toml",
+		Errors: []ConfigFieldError{
+			{Field: "search.mode", Value: "fast", Message: "must be one of: text_only, hybrid"},
+			{Field: "embedding.workers", Value: "-1", Message: "must be at least 0"},
+		},
+	}
+
+	assert.Equal(t,
+		"invalid config /home/user/.sercha/config.toml: search.mode: must be one of: text_only, hybrid; embedding.workers: must be at least 0",
+		err.Error())
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+
+	var target *ConfigValidationError
+	assert.True(t, errors.As(error(err), &target))
+	assert.Len(t, target.Errors, 2)
+}
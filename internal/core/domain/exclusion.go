@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Exclusion represents a document that has been excluded from syncing.
 // When a document is excluded, it will not be re-indexed during future syncs.
@@ -14,12 +17,120 @@ type Exclusion struct {
 	// DocumentID is the ID of the excluded document.
 	DocumentID string
 
-	// URI is the original location for matching on re-sync.
+	// URI is the original location for matching on re-sync. For pattern
+	// exclusions it holds the pattern, interpreted according to PatternType.
 	URI string
 
+	// PatternType selects how URI is matched against documents.
+	// Empty is treated as ExclusionPatternExact.
+	PatternType ExclusionPatternType
+
+	// Category is the structured reason for the exclusion, used for filtering
+	// and reporting. Empty for quarantined documents.
+	Category ExclusionReason
+
 	// Reason is an optional explanation for the exclusion.
 	Reason string
 
 	// ExcludedAt is when the document was excluded.
 	ExcludedAt time.Time
+
+	// Quarantined is true when the document was excluded automatically after
+	// repeated normalisation failures rather than by the user.
+	Quarantined bool
+}
+
+// Matches reports whether the exclusion applies to a document URI.
+func (e Exclusion) Matches(uri string) bool {
+	if e.PatternType == ExclusionPatternPrefix {
+		return strings.HasPrefix(uri, e.URI)
+	}
+	return uri == e.URI
+}
+
+// ExclusionPatternType selects how an exclusion is matched against URIs.
+type ExclusionPatternType string
+
+const (
+	// ExclusionPatternExact matches a single URI.
+	ExclusionPatternExact ExclusionPatternType = "exact"
+
+	// ExclusionPatternPrefix matches every URI starting with the pattern,
+	// excluding a whole subtree such as a node_modules directory.
+	ExclusionPatternPrefix ExclusionPatternType = "prefix"
+)
+
+// IsValid returns true if the pattern type is a known value.
+func (t ExclusionPatternType) IsValid() bool {
+	return t == ExclusionPatternExact || t == ExclusionPatternPrefix
+}
+
+// ParseExclusionPattern splits a pattern such as "prefix:file:///home/user/node_modules"
+// into the URI pattern and its type. Patterns without a known type prefix
+// match exactly.
+func ParseExclusionPattern(pattern string) (string, ExclusionPatternType) {
+	if rest, ok := strings.CutPrefix(pattern, string(ExclusionPatternPrefix)+":"); ok {
+		return rest, ExclusionPatternPrefix
+	}
+	return pattern, ExclusionPatternExact
+}
+
+// ExclusionReason categorises why a document was excluded.
+type ExclusionReason string
+
+const (
+	// ExclusionReasonSensitive marks documents containing sensitive information.
+	ExclusionReasonSensitive ExclusionReason = "sensitive"
+
+	// ExclusionReasonDuplicate marks documents duplicated elsewhere in the index.
+	ExclusionReasonDuplicate ExclusionReason = "duplicate"
+
+	// ExclusionReasonIrrelevant marks documents not worth searching.
+	ExclusionReasonIrrelevant ExclusionReason = "irrelevant"
+
+	// ExclusionReasonUserRequested marks documents the user excluded without a
+	// more specific reason.
+	ExclusionReasonUserRequested ExclusionReason = "user_requested"
+)
+
+// IsValid returns true if the reason is a known value.
+func (r ExclusionReason) IsValid() bool {
+	switch r {
+	case ExclusionReasonSensitive, ExclusionReasonDuplicate,
+		ExclusionReasonIrrelevant, ExclusionReasonUserRequested:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the reason.
+func (r ExclusionReason) String() string {
+	return string(r)
+}
+
+// Description returns a human-readable description of the reason.
+func (r ExclusionReason) Description() string {
+	switch r {
+	case ExclusionReasonSensitive:
+		return "Sensitive"
+	case ExclusionReasonDuplicate:
+		return "Duplicate"
+	case ExclusionReasonIrrelevant:
+		return "Irrelevant"
+	case ExclusionReasonUserRequested:
+		return "User requested"
+	default:
+		return unknownDescription
+	}
+}
+
+// AllExclusionReasons returns all exclusion reasons.
+func AllExclusionReasons() []ExclusionReason {
+	return []ExclusionReason{
+		ExclusionReasonSensitive,
+		ExclusionReasonDuplicate,
+		ExclusionReasonIrrelevant,
+		ExclusionReasonUserRequested,
+	}
 }
@@ -362,3 +362,48 @@ func TestExclusion_MinimalFields(t *testing.T) {
 	assert.NotEmpty(t, exclusion.URI)
 	assert.Empty(t, exclusion.Reason)
 }
+
+// TestExclusionReason_IsValid tests exclusion reason validation
+func TestExclusionReason_IsValid(t *testing.T) {
+	for _, reason := range AllExclusionReasons() {
+		assert.True(t, reason.IsValid(), reason)
+		assert.NotEqual(t, unknownDescription, reason.Description())
+	}
+
+	assert.False(t, ExclusionReason("").IsValid())
+	assert.False(t, ExclusionReason("other").IsValid())
+	assert.Equal(t, unknownDescription, ExclusionReason("other").Description())
+}
+
+// TestExclusionReason_String tests exclusion reason string conversion
+func TestExclusionReason_String(t *testing.T) {
+	assert.Equal(t, "sensitive", ExclusionReasonSensitive.String())
+	assert.Equal(t, "user_requested", ExclusionReasonUserRequested.String())
+	assert.Len(t, AllExclusionReasons(), 4)
+}
+
+func TestExclusion_Matches(t *testing.T) {
+	exact := Exclusion{URI: "file:///home/user/notes.md"}
+	assert.True(t, exact.Matches("file:///home/user/notes.md"))
+	assert.False(t, exact.Matches("file:///home/user/notes.md.bak"))
+
+	prefix := Exclusion{URI: "file:///home/user/node_modules", PatternType: ExclusionPatternPrefix}
+	assert.True(t, prefix.Matches("file:///home/user/node_modules/lodash/README.md"))
+	assert.False(t, prefix.Matches("file:///home/user/src/index.js"))
+}
+
+func TestParseExclusionPattern(t *testing.T) {
+	uri, patternType := ParseExclusionPattern("prefix:file:///home/user/node_modules")
+	assert.Equal(t, "file:///home/user/node_modules", uri)
+	assert.Equal(t, ExclusionPatternPrefix, patternType)
+
+	uri, patternType = ParseExclusionPattern("file:///home/user/notes.md")
+	assert.Equal(t, "file:///home/user/notes.md", uri)
+	assert.Equal(t, ExclusionPatternExact, patternType)
+}
+
+func TestExclusionPatternType_IsValid(t *testing.T) {
+	assert.True(t, ExclusionPatternExact.IsValid())
+	assert.True(t, ExclusionPatternPrefix.IsValid())
+	assert.False(t, ExclusionPatternType("regex").IsValid())
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// FeedbackSignal is a user's judgement of a search result for a query.
+type FeedbackSignal string
+
+const (
+	// FeedbackRelevant marks a result as useful for the query.
+	FeedbackRelevant FeedbackSignal = "relevant"
+
+	// FeedbackIrrelevant marks a result as not useful for the query.
+	FeedbackIrrelevant FeedbackSignal = "irrelevant"
+)
+
+// IsValid returns true if the signal is a known value.
+func (s FeedbackSignal) IsValid() bool {
+	return s == FeedbackRelevant || s == FeedbackIrrelevant
+}
+
+// Weight returns +1 for relevant and -1 for irrelevant results.
+// Unknown signals carry no weight.
+func (s FeedbackSignal) Weight() float64 {
+	switch s {
+	case FeedbackRelevant:
+		return 1
+	case FeedbackIrrelevant:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Feedback records whether a document was a useful result for a query.
+// Feedback is stored locally and only used to adjust ranking.
+type Feedback struct {
+	// Query is the normalised query the result was returned for.
+	Query string
+
+	// DocumentID is the document the feedback applies to.
+	DocumentID string
+
+	// Signal is the user's judgement.
+	Signal FeedbackSignal
+
+	// CreatedAt is when the feedback was given.
+	CreatedAt time.Time
+}
+
+// NormaliseQuery returns the form of a query used to match feedback:
+// lower case with runs of whitespace collapsed.
+func NormaliseQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedbackSignal_IsValid(t *testing.T) {
+	assert.True(t, FeedbackRelevant.IsValid())
+	assert.True(t, FeedbackIrrelevant.IsValid())
+	assert.False(t, FeedbackSignal("").IsValid())
+	assert.False(t, FeedbackSignal("meh").IsValid())
+}
+
+func TestFeedbackSignal_Weight(t *testing.T) {
+	assert.Equal(t, 1.0, FeedbackRelevant.Weight())
+	assert.Equal(t, -1.0, FeedbackIrrelevant.Weight())
+	assert.Equal(t, 0.0, FeedbackSignal("").Weight())
+}
+
+func TestNormaliseQuery(t *testing.T) {
+	assert.Equal(t, "oauth token refresh", NormaliseQuery("  OAuth\ttoken   Refresh "))
+	assert.Equal(t, "", NormaliseQuery("   "))
+}
@@ -0,0 +1,84 @@
+package domain
+
+// IntegrityIssueKind classifies a disagreement between the document store
+// and the search indexes.
+type IntegrityIssueKind string
+
+// Integrity issue kinds.
+const (
+	// IssueMissingKeyword is a stored chunk with no keyword (Xapian) entry.
+	IssueMissingKeyword IntegrityIssueKind = "missing_keyword"
+
+	// IssueMissingVector is a chunk with an embedding but no vector (HNSW) entry.
+	IssueMissingVector IntegrityIssueKind = "missing_vector"
+
+	// IssueOrphanKeyword is a keyword entry for a chunk that is no longer stored.
+	IssueOrphanKeyword IntegrityIssueKind = "orphan_keyword"
+
+	// IssueOrphanVector is a vector for a chunk that is no longer stored.
+	IssueOrphanVector IntegrityIssueKind = "orphan_vector"
+)
+
+// IsOrphan returns true for index entries without a stored chunk.
+func (k IntegrityIssueKind) IsOrphan() bool {
+	return k == IssueOrphanKeyword || k == IssueOrphanVector
+}
+
+// IntegrityIssue is a single discrepancy found by an integrity check.
+type IntegrityIssue struct {
+	// Kind is the type of discrepancy.
+	Kind IntegrityIssueKind
+
+	// ChunkID is the affected chunk.
+	ChunkID string
+
+	// DocumentID is the chunk's document. Empty for orphaned entries.
+	DocumentID string
+
+	// SourceID is the document's source. Empty for orphaned entries.
+	SourceID string
+
+	// URI is the document's location. Empty for orphaned entries.
+	URI string
+}
+
+// IntegrityReport summarises an integrity check of the indexes.
+type IntegrityReport struct {
+	// Documents is the number of non-excluded documents checked.
+	Documents int
+
+	// Chunks is the number of chunks of those documents.
+	Chunks int
+
+	// Embedded is the number of those chunks that have an embedding.
+	Embedded int
+
+	// KeywordChecked is false when the keyword index cannot list its entries.
+	KeywordChecked bool
+
+	// VectorChecked is false when there is no vector index or it cannot list its entries.
+	VectorChecked bool
+
+	// Issues lists every discrepancy found.
+	Issues []IntegrityIssue
+}
+
+// OK returns true if no discrepancies were found.
+func (r *IntegrityReport) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// DocumentIDs returns the distinct documents with missing index entries,
+// in the order they were found.
+func (r *IntegrityReport) DocumentIDs() []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, issue := range r.Issues {
+		if issue.DocumentID == "" || seen[issue.DocumentID] {
+			continue
+		}
+		seen[issue.DocumentID] = true
+		ids = append(ids, issue.DocumentID)
+	}
+	return ids
+}
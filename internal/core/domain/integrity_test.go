@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrityIssueKind_IsOrphan(t *testing.T) {
+	assert.True(t, IssueOrphanKeyword.IsOrphan())
+	assert.True(t, IssueOrphanVector.IsOrphan())
+	assert.False(t, IssueMissingKeyword.IsOrphan())
+	assert.False(t, IssueMissingVector.IsOrphan())
+}
+
+func TestIntegrityReport_DocumentIDs(t *testing.T) {
+	report := &IntegrityReport{Issues: []IntegrityIssue{
+		{Kind: IssueMissingKeyword, ChunkID: "c1", DocumentID: "doc-2"},
+		{Kind: IssueMissingVector, ChunkID: "c1", DocumentID: "doc-2"},
+		{Kind: IssueOrphanKeyword, ChunkID: "c9"},
+		{Kind: IssueMissingKeyword, ChunkID: "c3", DocumentID: "doc-1"},
+	}}
+
+	assert.False(t, report.OK())
+	assert.Equal(t, []string{"doc-2", "doc-1"}, report.DocumentIDs())
+	assert.True(t, (&IntegrityReport{}).OK())
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// KeyAction is a TUI action that can be bound to keys.
+type KeyAction string
+
+// Available key actions.
+const (
+	// KeyActionUp moves the selection up in a list.
+	KeyActionUp KeyAction = "up"
+
+	// KeyActionDown moves the selection down in a list.
+	KeyActionDown KeyAction = "down"
+
+	// KeyActionSelect confirms the selected item.
+	KeyActionSelect KeyAction = "select"
+
+	// KeyActionBack returns to the previous view or closes a menu.
+	KeyActionBack KeyAction = "back"
+
+	// KeyActionSearch opens the search view from the menu.
+	KeyActionSearch KeyAction = "search"
+
+	// KeyActionQuit exits the TUI from the menu. Ctrl+C always quits.
+	KeyActionQuit KeyAction = "quit"
+)
+
+// AllKeyActions returns every action that can be bound to keys.
+func AllKeyActions() []KeyAction {
+	return []KeyAction{
+		KeyActionUp,
+		KeyActionDown,
+		KeyActionSelect,
+		KeyActionBack,
+		KeyActionSearch,
+		KeyActionQuit,
+	}
+}
+
+// KeyBindings maps TUI actions to the keys that trigger them. Keys are
+// named as the terminal reports them, e.g. "k", "up", "enter", "esc" or
+// "ctrl+n". Actions left empty keep their default keys.
+type KeyBindings struct {
+	// Up lists the keys that move up in lists.
+	Up []string `json:"up,omitempty" jsonschema:"keys that move up in lists"`
+
+	// Down lists the keys that move down in lists.
+	Down []string `json:"down,omitempty" jsonschema:"keys that move down in lists"`
+
+	// Select lists the keys that confirm the selected item.
+	Select []string `json:"select,omitempty" jsonschema:"keys that confirm the selected item"`
+
+	// Back lists the keys that return to the previous view.
+	Back []string `json:"back,omitempty" jsonschema:"keys that return to the previous view"`
+
+	// Search lists the keys that open search from the menu.
+	Search []string `json:"search,omitempty" jsonschema:"keys that open search from the menu"`
+
+	// Quit lists the keys that exit from the menu.
+	Quit []string `json:"quit,omitempty" jsonschema:"keys that exit from the menu"`
+}
+
+// DefaultKeyBindings returns the vim-style bindings the TUI ships with.
+func DefaultKeyBindings() KeyBindings {
+	return KeyBindings{
+		Up:     []string{"up", "k"},
+		Down:   []string{"down", "j"},
+		Select: []string{"enter"},
+		Back:   []string{"esc"},
+		Search: []string{"/"},
+		Quit:   []string{"q"},
+	}
+}
+
+// Keys returns the keys bound to an action.
+func (b KeyBindings) Keys(action KeyAction) []string {
+	switch action {
+	case KeyActionUp:
+		return b.Up
+	case KeyActionDown:
+		return b.Down
+	case KeyActionSelect:
+		return b.Select
+	case KeyActionBack:
+		return b.Back
+	case KeyActionSearch:
+		return b.Search
+	case KeyActionQuit:
+		return b.Quit
+	default:
+		return nil
+	}
+}
+
+// WithDefaults returns the bindings with empty actions set to their default keys.
+func (b KeyBindings) WithDefaults() KeyBindings {
+	defaults := DefaultKeyBindings()
+	orDefault := func(keys, fallback []string) []string {
+		if len(keys) == 0 {
+			return fallback
+		}
+		return keys
+	}
+	return KeyBindings{
+		Up:     orDefault(b.Up, defaults.Up),
+		Down:   orDefault(b.Down, defaults.Down),
+		Select: orDefault(b.Select, defaults.Select),
+		Back:   orDefault(b.Back, defaults.Back),
+		Search: orDefault(b.Search, defaults.Search),
+		Quit:   orDefault(b.Quit, defaults.Quit),
+	}
+}
+
+// Validate checks the bindings, with defaults filled in, for blank keys
+// and keys bound to more than one action.
+func (b KeyBindings) Validate() error {
+	effective := b.WithDefaults()
+	bound := make(map[string]KeyAction)
+	for _, action := range AllKeyActions() {
+		keys := effective.Keys(action)
+		for i, k := range keys {
+			if k == "" {
+				return fmt.Errorf("%w: blank key bound to %s", ErrInvalidInput, action)
+			}
+			if slices.Contains(keys[:i], k) {
+				continue
+			}
+			if other, ok := bound[k]; ok {
+				return fmt.Errorf("%w: key %q is bound to both %s and %s", ErrInvalidInput, k, other, action)
+			}
+			bound[k] = action
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultKeyBindings_Valid(t *testing.T) {
+	bindings := DefaultKeyBindings()
+
+	require.NoError(t, bindings.Validate())
+	for _, action := range AllKeyActions() {
+		assert.NotEmpty(t, bindings.Keys(action), action)
+	}
+}
+
+func TestKeyBindings_WithDefaults(t *testing.T) {
+	bindings := KeyBindings{Up: []string{"ctrl+p"}, Down: []string{"ctrl+n"}}
+
+	effective := bindings.WithDefaults()
+
+	assert.Equal(t, []string{"ctrl+p"}, effective.Up)
+	assert.Equal(t, []string{"ctrl+n"}, effective.Down)
+	assert.Equal(t, DefaultKeyBindings().Select, effective.Select)
+	assert.Equal(t, DefaultKeyBindings().Quit, effective.Quit)
+}
+
+func TestKeyBindings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		bindings KeyBindings
+		wantErr  string
+	}{
+		{
+			name:     "dvorak navigation",
+			bindings: KeyBindings{Up: []string{"up", "c"}, Down: []string{"down", "t"}},
+		},
+		{
+			name:     "conflict between actions",
+			bindings: KeyBindings{Up: []string{"up"}, Down: []string{"down", "up"}},
+			wantErr:  `key "up" is bound to both up and down`,
+		},
+		{
+			name:     "conflict with a default",
+			bindings: KeyBindings{Back: []string{"q"}},
+			wantErr:  `key "q" is bound to both back and quit`,
+		},
+		{
+			name:     "repeated key within an action",
+			bindings: KeyBindings{Select: []string{"enter", "enter"}},
+		},
+		{
+			name:     "blank key",
+			bindings: KeyBindings{Search: []string{""}},
+			wantErr:  "blank key bound to search",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bindings.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestKeyBindings_Keys_Unknown(t *testing.T) {
+	assert.Nil(t, DefaultKeyBindings().Keys("jump"))
+}
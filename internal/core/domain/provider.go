@@ -4,7 +4,7 @@ package domain
 type ProviderType string
 
 const (
-	// ProviderLocal is for local filesystem sources.
+	// ProviderLocal is for sources that need no credentials, such as the local filesystem.
 	ProviderLocal ProviderType = "local"
 	// ProviderGoogle is for Google services (Drive, Gmail, Calendar).
 	ProviderGoogle ProviderType = "google"
@@ -18,4 +18,33 @@ const (
 	ProviderMicrosoft ProviderType = "microsoft"
 	// ProviderDropbox is for Dropbox file storage.
 	ProviderDropbox ProviderType = "dropbox"
+	// ProviderTrello is for Trello boards.
+	ProviderTrello ProviderType = "trello"
+	// ProviderBasecamp is for Basecamp projects.
+	ProviderBasecamp ProviderType = "basecamp"
+	// ProviderDiscord is for Discord servers.
+	ProviderDiscord ProviderType = "discord"
 )
+
+// providerDisplayNames holds the display names of known providers.
+var providerDisplayNames = map[ProviderType]string{
+	ProviderLocal:     "Local",
+	ProviderGoogle:    "Google",
+	ProviderGitHub:    "GitHub",
+	ProviderSlack:     "Slack",
+	ProviderNotion:    "Notion",
+	ProviderMicrosoft: "Microsoft",
+	ProviderDropbox:   "Dropbox",
+	ProviderTrello:    "Trello",
+	ProviderBasecamp:  "Basecamp",
+	ProviderDiscord:   "Discord",
+}
+
+// DisplayName returns the provider's name for display, or the raw
+// identifier for unknown providers.
+func (p ProviderType) DisplayName() string {
+	if name, ok := providerDisplayNames[p]; ok {
+		return name
+	}
+	return string(p)
+}
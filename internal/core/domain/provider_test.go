@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderType_DisplayName(t *testing.T) {
+	assert.Equal(t, "Google", ProviderGoogle.DisplayName())
+	assert.Equal(t, "GitHub", ProviderGitHub.DisplayName())
+	assert.Equal(t, "Local", ProviderLocal.DisplayName())
+	assert.Equal(t, "custom", ProviderType("custom").DisplayName())
+}
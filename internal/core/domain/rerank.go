@@ -0,0 +1,129 @@
+package domain
+
+import "fmt"
+
+// DefaultRerankCandidates is how many of the top search results are
+// reranked when no count is configured.
+const DefaultRerankCandidates = 20
+
+// RerankProvider identifies the service that reranks search results.
+type RerankProvider string
+
+// Available rerank providers.
+const (
+	// RerankProviderLLM asks the configured LLM to score each candidate.
+	RerankProviderLLM RerankProvider = "llm"
+
+	// RerankProviderCrossEncoder posts the candidates to a cross-encoder
+	// rerank endpoint that speaks the Cohere/Jina rerank API, as served by
+	// the llama.cpp server, Infinity and vLLM.
+	RerankProviderCrossEncoder RerankProvider = "cross_encoder"
+)
+
+// IsValid returns true if the rerank provider is recognised.
+func (p RerankProvider) IsValid() bool {
+	switch p {
+	case RerankProviderLLM, RerankProviderCrossEncoder:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation.
+func (p RerankProvider) String() string {
+	return string(p)
+}
+
+// RerankMode overrides the rerank setting for a single search.
+type RerankMode string
+
+// Available rerank modes.
+const (
+	// RerankModeDefault reranks when reranking is enabled in the settings.
+	RerankModeDefault RerankMode = ""
+
+	// RerankModeOn reranks whenever a reranker is configured, even if
+	// reranking is not enabled in the settings.
+	RerankModeOn RerankMode = "on"
+
+	// RerankModeOff keeps the fused ranking.
+	RerankModeOff RerankMode = "off"
+)
+
+// RerankSettings holds configuration for reordering the top search results
+// with a reranker after retrieval.
+type RerankSettings struct {
+	// Enabled reranks every search. Off by default because each search
+	// costs a call to the reranker and waits for it.
+	Enabled bool `json:"enabled,omitempty" jsonschema:"rerank every search; each search then waits on a reranker call"`
+
+	// Provider is the service that scores the candidates.
+	Provider RerankProvider `json:"provider,omitempty" jsonschema:"service that scores the candidates: llm or cross_encoder"`
+
+	// Endpoint is the URL of the cross-encoder rerank endpoint, such as
+	// http://localhost:8080/v1/rerank.
+	Endpoint string `json:"endpoint,omitempty" jsonschema:"URL of the cross-encoder rerank endpoint"`
+
+	// Model is the cross-encoder model name sent with each request, for
+	// servers that host more than one.
+	Model string `json:"model,omitempty" jsonschema:"cross-encoder model name, for servers that host more than one"`
+
+	// APIKey is sent as a bearer token to the cross-encoder endpoint.
+	APIKey string `json:"api_key,omitempty" jsonschema:"bearer token sent to the cross-encoder endpoint"`
+
+	// Headers are extra HTTP headers sent with every rerank request, each
+	// written as "Name: value".
+	Headers []string `json:"headers,omitempty" jsonschema:"extra HTTP headers sent with every rerank request, each written as 'Name: value'"`
+
+	// Candidates is how many of the top results are reranked.
+	Candidates int `json:"candidates,omitempty" jsonschema:"how many of the top results are reranked"`
+}
+
+// IsConfigured returns true if a reranker is set up. The LLM provider also
+// needs the LLM to be configured.
+func (r RerankSettings) IsConfigured() bool {
+	switch r.Provider {
+	case RerankProviderLLM:
+		return true
+	case RerankProviderCrossEncoder:
+		return r.Endpoint != ""
+	default:
+		return false
+	}
+}
+
+// CandidateCount returns how many results are reranked.
+// Falls back to the default when the configured value is not positive.
+func (r RerankSettings) CandidateCount() int {
+	if r.Candidates <= 0 {
+		return DefaultRerankCandidates
+	}
+	return r.Candidates
+}
+
+// RequestHeaders parses the extra headers into a map.
+// Returns an error wrapping ErrInvalidInput for a malformed entry.
+func (r RerankSettings) RequestHeaders() (map[string]string, error) {
+	return parseRequestHeaders("rerank", r.Headers)
+}
+
+// Validate checks the settings are complete enough to rerank.
+func (r RerankSettings) Validate() error {
+	if r.Provider == "" {
+		if r.Enabled {
+			return fmt.Errorf("%w: reranking is enabled but no rerank provider is set", ErrInvalidInput)
+		}
+		return nil
+	}
+	if !r.Provider.IsValid() {
+		return fmt.Errorf("%w: unknown rerank provider %q", ErrInvalidInput, r.Provider)
+	}
+	if r.Provider == RerankProviderCrossEncoder && r.Endpoint == "" {
+		return fmt.Errorf("%w: the cross_encoder rerank provider needs an endpoint", ErrInvalidInput)
+	}
+	if _, err := r.RequestHeaders(); err != nil {
+		return err
+	}
+	return nil
+}
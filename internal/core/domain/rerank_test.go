@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRerankProvider_IsValid(t *testing.T) {
+	assert.True(t, RerankProviderLLM.IsValid())
+	assert.True(t, RerankProviderCrossEncoder.IsValid())
+	assert.False(t, RerankProvider("").IsValid())
+	assert.False(t, RerankProvider("bm25").IsValid())
+}
+
+func TestRerankSettings_IsConfigured(t *testing.T) {
+	assert.False(t, RerankSettings{}.IsConfigured())
+	assert.True(t, RerankSettings{Provider: RerankProviderLLM}.IsConfigured())
+	assert.False(t, RerankSettings{Provider: RerankProviderCrossEncoder}.IsConfigured())
+	assert.True(t, RerankSettings{
+		Provider: RerankProviderCrossEncoder,
+		Endpoint: "http://localhost:8080/v1/rerank",
+	}.IsConfigured())
+}
+
+func TestRerankSettings_CandidateCount(t *testing.T) {
+	assert.Equal(t, DefaultRerankCandidates, RerankSettings{}.CandidateCount())
+	assert.Equal(t, DefaultRerankCandidates, RerankSettings{Candidates: -1}.CandidateCount())
+	assert.Equal(t, 50, RerankSettings{Candidates: 50}.CandidateCount())
+}
+
+func TestRerankSettings_RequestHeaders(t *testing.T) {
+	headers, err := RerankSettings{Headers: []string{"X-Team: search"}}.RequestHeaders()
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Team": "search"}, headers)
+}
+
+func TestRerankSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings RerankSettings
+		wantErr  string
+	}{
+		{name: "not configured", settings: RerankSettings{}},
+		{name: "llm", settings: RerankSettings{Enabled: true, Provider: RerankProviderLLM}},
+		{
+			name:     "cross encoder",
+			settings: RerankSettings{Provider: RerankProviderCrossEncoder, Endpoint: "http://localhost:8080/v1/rerank"},
+		},
+		{
+			name:     "enabled without provider",
+			settings: RerankSettings{Enabled: true},
+			wantErr:  "no rerank provider is set",
+		},
+		{
+			name:     "unknown provider",
+			settings: RerankSettings{Provider: "bm25"},
+			wantErr:  `unknown rerank provider "bm25"`,
+		},
+		{
+			name:     "cross encoder without endpoint",
+			settings: RerankSettings{Provider: RerankProviderCrossEncoder},
+			wantErr:  "needs an endpoint",
+		},
+		{
+			name:     "malformed header",
+			settings: RerankSettings{Provider: RerankProviderLLM, Headers: []string{"no-colon"}},
+			wantErr:  "rerank",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidInput)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+// DisabledSubsystem is an optional subsystem that is not running, with the
+// reason shown to the user. Safe mode starts the app without them.
+type DisabledSubsystem struct {
+	// Name is the feature the user goes without, such as "Vector search".
+	Name string
+
+	// Reason explains why it is off.
+	Reason string
+}
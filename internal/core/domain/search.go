@@ -1,5 +1,10 @@
 package domain
 
+import (
+	"sort"
+	"strings"
+)
+
 // SearchOptions configures a search query.
 type SearchOptions struct {
 	// Limit is the maximum number of results.
@@ -11,11 +16,98 @@ type SearchOptions struct {
 	// SourceIDs filters to specific sources.
 	SourceIDs []string
 
+	// Collection filters to the sources in this collection, matched by name
+	// ignoring case. Combined with SourceIDs, only sources in both are
+	// searched.
+	Collection string
+
 	// Semantic enables vector similarity search.
 	Semantic bool
 
 	// Hybrid enables combined keyword + semantic search.
 	Hybrid bool
+
+	// Mode overrides the configured search mode for this query.
+	// Modes whose services are unavailable degrade to text-only search.
+	Mode SearchMode
+
+	// SortBy orders the results. Empty sorts by score.
+	SortBy SortField
+
+	// MinScore drops vector matches with a lower cosine similarity before
+	// fusion. Zero uses the configured threshold; a negative value keeps
+	// every match.
+	MinScore float64
+
+	// Authors filters to documents written by any of these authors, matched
+	// by name or identifier ignoring case.
+	Authors []string
+
+	// Language filters to documents written in this language, given as an
+	// ISO 639-1 code such as "en" or "fr" and matched ignoring case.
+	Language string
+
+	// Rerank overrides whether the top results are reordered by the
+	// configured reranker. Empty follows the rerank setting.
+	Rerank RerankMode
+
+	// RerankCandidates is how many of the top results are reranked.
+	// Zero uses the configured count.
+	RerankCandidates int
+}
+
+// SortField is a search result ordering.
+type SortField string
+
+// Available result orderings.
+const (
+	// SortByScore orders by relevance, highest first.
+	SortByScore SortField = "score"
+
+	// SortByDate orders by document creation time, newest first.
+	SortByDate SortField = "date"
+
+	// SortByTitle orders alphabetically by document title.
+	SortByTitle SortField = "title"
+
+	// SortBySource orders alphabetically by source name.
+	SortBySource SortField = "source"
+)
+
+// SortCandidates is how many matches are fetched, in relevance order, to be
+// ordered by a field other than score. Matches beyond it are not considered.
+const SortCandidates = 1000
+
+// SortFields lists the orderings in the order the TUI cycles through them.
+var SortFields = []SortField{SortByScore, SortByDate, SortByTitle, SortBySource}
+
+// ByRelevance returns true if the field orders by score.
+func (f SortField) ByRelevance() bool {
+	return f == "" || f == SortByScore
+}
+
+// IsValid returns true if the sort field is recognised.
+func (f SortField) IsValid() bool {
+	switch f {
+	case SortByScore, SortByDate, SortByTitle, SortBySource:
+		return true
+	default:
+		return false
+	}
+}
+
+// Next returns the ordering after f, wrapping back to score.
+// Empty and unrecognised values are treated as score.
+func (f SortField) Next() SortField {
+	if !f.IsValid() {
+		f = SortByScore
+	}
+	for i, field := range SortFields {
+		if field == f {
+			return SortFields[(i+1)%len(SortFields)]
+		}
+	}
+	return SortByScore
 }
 
 // SearchResult represents a single search hit.
@@ -29,10 +121,69 @@ type SearchResult struct {
 	// Score is the relevance score.
 	Score float64
 
-	// Highlights contains snippets with matched terms.
+	// Highlights contains snippets with matched terms. Hits found only by
+	// vector similarity have the start of the matching chunk instead.
 	Highlights []string
 
 	// SourceName is the display name of the source (includes account identifier).
 	// Example: "Gmail - user@gmail.com" or "GitHub - octocat"
 	SourceName string
+
+	// Feedback is the user's judgement of this document for the query, if any.
+	Feedback FeedbackSignal
+}
+
+// ChunkSearchResult is the best matching chunk of a document.
+type ChunkSearchResult struct {
+	// Chunk is the highest-scoring chunk of the document.
+	Chunk Chunk
+
+	// Document is the parent document of the chunk.
+	Document Document
+
+	// Score is the relevance score of the chunk.
+	Score float64
+
+	// Feedback is the user's judgement of the document for the query, if any.
+	Feedback FeedbackSignal
+}
+
+// AuthorFacet counts the documents by one author in a set of results.
+type AuthorFacet struct {
+	// Author is the author, as reported by the first matching document.
+	Author Author
+
+	// Count is the number of distinct documents by the author.
+	Count int
+}
+
+// AuthorFacets counts the distinct documents of each author in results, most
+// prolific first. Documents without an author are not counted.
+func AuthorFacets(results []SearchResult) []AuthorFacet {
+	index := make(map[string]int)
+	seen := make(map[string]bool)
+	var facets []AuthorFacet
+	for i := range results {
+		doc := &results[i].Document
+		if doc.Author.IsZero() || seen[doc.ID] {
+			continue
+		}
+		seen[doc.ID] = true
+
+		key := strings.ToLower(doc.Author.Key())
+		if j, ok := index[key]; ok {
+			facets[j].Count++
+			continue
+		}
+		index[key] = len(facets)
+		facets = append(facets, AuthorFacet{Author: doc.Author, Count: 1})
+	}
+
+	sort.SliceStable(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return strings.ToLower(facets[i].Author.Key()) < strings.ToLower(facets[j].Author.Key())
+	})
+	return facets
 }
@@ -372,3 +372,50 @@ func TestSearchOptions_DuplicateSourceIDs(t *testing.T) {
 	// Duplicates are preserved in the slice (filtering is application logic)
 	assert.Len(t, opts.SourceIDs, 5)
 }
+
+// TestSortField_IsValid tests recognised sort fields
+func TestSortField_IsValid(t *testing.T) {
+	for _, field := range SortFields {
+		assert.True(t, field.IsValid(), field)
+	}
+	assert.False(t, SortField("").IsValid())
+	assert.False(t, SortField("size").IsValid())
+}
+
+// TestSortField_ByRelevance tests which fields order by score
+func TestSortField_ByRelevance(t *testing.T) {
+	assert.True(t, SortField("").ByRelevance())
+	assert.True(t, SortByScore.ByRelevance())
+	assert.False(t, SortByDate.ByRelevance())
+	assert.False(t, SortByTitle.ByRelevance())
+}
+
+// TestSortField_Next tests cycling through sort fields
+func TestSortField_Next(t *testing.T) {
+	assert.Equal(t, SortByDate, SortByScore.Next())
+	assert.Equal(t, SortByTitle, SortByDate.Next())
+	assert.Equal(t, SortBySource, SortByTitle.Next())
+	assert.Equal(t, SortByScore, SortBySource.Next())
+	assert.Equal(t, SortByDate, SortField("").Next())
+}
+
+// TestAuthorFacets tests counting distinct documents per author
+func TestAuthorFacets(t *testing.T) {
+	alice := Author{Name: "Alice", Identifier: "alice@example.com"}
+	bob := Author{Name: "Bob", Identifier: "bob@example.com"}
+	results := []SearchResult{
+		{Document: Document{ID: "doc-1", Author: alice}},
+		{Document: Document{ID: "doc-1", Author: alice}}, // second chunk of the same document
+		{Document: Document{ID: "doc-2", Author: bob}},
+		{Document: Document{ID: "doc-3", Author: Author{Identifier: "ALICE@example.com"}}},
+		{Document: Document{ID: "doc-4"}},
+	}
+
+	facets := AuthorFacets(results)
+
+	assert.Equal(t, []AuthorFacet{
+		{Author: alice, Count: 2},
+		{Author: bob, Count: 1},
+	}, facets)
+	assert.Empty(t, AuthorFacets(nil))
+}
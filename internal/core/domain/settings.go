@@ -1,5 +1,13 @@
 package domain
 
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
 const unknownDescription = "Unknown"
 
 // SearchMode defines how search operations combine different retrieval methods.
@@ -13,6 +21,9 @@ const (
 	// SearchModeHybrid combines text and semantic (vector) search.
 	SearchModeHybrid SearchMode = "hybrid"
 
+	// SearchModeVectorOnly uses only semantic (vector) search.
+	SearchModeVectorOnly SearchMode = "vector_only"
+
 	// SearchModeLLMAssisted uses text search with LLM query expansion.
 	SearchModeLLMAssisted SearchMode = "llm_assisted"
 
@@ -23,7 +34,7 @@ const (
 // IsValid returns true if the search mode is recognised.
 func (m SearchMode) IsValid() bool {
 	switch m {
-	case SearchModeTextOnly, SearchModeHybrid, SearchModeLLMAssisted, SearchModeFull:
+	case SearchModeTextOnly, SearchModeHybrid, SearchModeVectorOnly, SearchModeLLMAssisted, SearchModeFull:
 		return true
 	default:
 		return false
@@ -32,7 +43,7 @@ func (m SearchMode) IsValid() bool {
 
 // RequiresEmbedding returns true if this mode needs an embedding provider.
 func (m SearchMode) RequiresEmbedding() bool {
-	return m == SearchModeHybrid || m == SearchModeFull
+	return m == SearchModeHybrid || m == SearchModeVectorOnly || m == SearchModeFull
 }
 
 // RequiresLLM returns true if this mode needs an LLM provider.
@@ -52,6 +63,8 @@ func (m SearchMode) Description() string {
 		return "Text Only (keyword search)"
 	case SearchModeHybrid:
 		return "Hybrid (text + semantic search)"
+	case SearchModeVectorOnly:
+		return "Vector Only (semantic search)"
 	case SearchModeLLMAssisted:
 		return "LLM Assisted (text + query expansion)"
 	case SearchModeFull:
@@ -74,12 +87,16 @@ const (
 
 	// AIProviderAnthropic is Anthropic cloud API.
 	AIProviderAnthropic AIProvider = "anthropic"
+
+	// AIProviderOpenAICompatible is a self-hosted server that speaks the
+	// OpenAI embeddings API, such as the llama.cpp server running a GGUF model.
+	AIProviderOpenAICompatible AIProvider = "openai_compatible"
 )
 
 // IsValid returns true if the AI provider is recognised.
 func (p AIProvider) IsValid() bool {
 	switch p {
-	case AIProviderOllama, AIProviderOpenAI, AIProviderAnthropic:
+	case AIProviderOllama, AIProviderOpenAI, AIProviderAnthropic, AIProviderOpenAICompatible:
 		return true
 	default:
 		return false
@@ -96,6 +113,12 @@ func (p AIProvider) IsLocal() bool {
 	return p == AIProviderOllama
 }
 
+// RequiresBaseURL returns true if this provider has no default endpoint,
+// so the user must supply one.
+func (p AIProvider) RequiresBaseURL() bool {
+	return p == AIProviderOpenAICompatible
+}
+
 // String returns the string representation.
 func (p AIProvider) String() string {
 	return string(p)
@@ -110,30 +133,253 @@ func (p AIProvider) Description() string {
 		return "OpenAI (cloud)"
 	case AIProviderAnthropic:
 		return "Anthropic (cloud)"
+	case AIProviderOpenAICompatible:
+		return "OpenAI-compatible (self-hosted)"
 	default:
 		return unknownDescription
 	}
 }
 
+// Language selects how text is split into search terms.
+type Language string
+
+// Available languages.
+const (
+	// LanguageEnglish splits text on spaces and punctuation and stems English words.
+	LanguageEnglish Language = "en"
+
+	// LanguageCJK also splits Chinese, Japanese and Korean text into
+	// overlapping character bigrams, since those scripts have no spaces
+	// between words. It roughly doubles the index size for CJK text.
+	LanguageCJK Language = "cjk"
+)
+
+// IsValid returns true if the language is recognised.
+func (l Language) IsValid() bool {
+	return l == LanguageEnglish || l == LanguageCJK
+}
+
+// String returns the string representation.
+func (l Language) String() string {
+	return string(l)
+}
+
+// Code returns the ISO 639-1 code assumed for documents whose language
+// cannot be detected. CJK covers several languages and has no code.
+func (l Language) Code() string {
+	if l == LanguageCJK {
+		return ""
+	}
+	return string(LanguageEnglish)
+}
+
+// Description returns a human-readable description of the language.
+func (l Language) Description() string {
+	switch l {
+	case LanguageEnglish:
+		return "English (word stemming)"
+	case LanguageCJK:
+		return "CJK (character bigrams)"
+	default:
+		return unknownDescription
+	}
+}
+
+// IsTermRune reports whether r can be part of a search term. The keyword
+// index splits text into terms at every other character, so "start-up"
+// holds the terms "start" and "up".
+func IsTermRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+// IsCJK reports whether r belongs to a script written without spaces
+// between words. Under LanguageCJK such text is indexed as character
+// n-grams, so a term can match anywhere within it.
+func IsCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		r == 'ー' // Katakana prolonged sound mark, which Unicode files under Common
+}
+
+// DefaultHybridOverFetch is the default number of candidates fetched from each
+// engine per requested result before hybrid fusion.
+const DefaultHybridOverFetch = 3
+
+// DefaultVectorMinScore is the default cosine similarity below which vector
+// matches are dropped, so unrelated queries do not return k random documents.
+const DefaultVectorMinScore = 0.25
+
+// DefaultVectorSnippetLength is the default length, in characters, of the
+// snippet taken from the matching chunk of a vector-only hit.
+const DefaultVectorSnippetLength = 240
+
+// DefaultTitleWeight is the default weight of title matches relative to
+// body matches in keyword search.
+const DefaultTitleWeight = 2.0
+
 // SearchSettings holds search behaviour configuration.
 type SearchSettings struct {
 	// Mode is the search retrieval mode.
-	Mode SearchMode
+	Mode SearchMode `json:"mode,omitempty" jsonschema:"search retrieval mode"`
+
+	// HybridOverFetch multiplies the result limit to give the number of
+	// candidates fetched from the keyword and vector engines before fusion.
+	HybridOverFetch int `json:"hybrid_over_fetch,omitempty" jsonschema:"candidates fetched from each engine per requested result before hybrid fusion"`
+
+	// MinScore is the similarity below which vector matches are dropped
+	// before fusion. It is a cosine similarity; distances from L2 indexes
+	// are converted to the equivalent value. Zero keeps every match.
+	MinScore float64 `json:"min_score,omitempty" jsonschema:"cosine similarity below which vector matches are dropped; 0 keeps every match"`
+
+	// VectorSnippetLength is the length, in characters, of the snippet taken
+	// from the matching chunk when a result has no query terms to highlight,
+	// as with hits found only by vector similarity.
+	VectorSnippetLength int `json:"vector_snippet_length,omitempty" jsonschema:"length in characters of the snippet shown for hits found only by vector similarity"`
+
+	// TitleWeight scales the weight of query terms matching a document's
+	// title, added to the weight of body matches, so documents matching in
+	// the title rank higher. Zero ignores titles.
+	TitleWeight float64 `json:"title_weight,omitempty" jsonschema:"weight of title matches relative to body matches in keyword search; 0 ignores titles"`
+
+	// Language selects the analyzer used for indexing and queries, and the
+	// language assumed for documents whose language cannot be detected.
+	// Changing it requires a full resync to rebuild the index.
+	Language Language `json:"language,omitempty" jsonschema:"analyzer used for indexing and queries; changing it requires a full resync"`
+
+	// ShowChunks shows the best matching chunk of each document in the
+	// TUI result list instead of document-level results.
+	ShowChunks bool `json:"show_chunks,omitempty" jsonschema:"show the best matching chunk of each document in the TUI"`
+
+	// GroupBySource groups TUI search results under source headers by
+	// default instead of showing a single ranked list.
+	GroupBySource bool `json:"group_by_source,omitempty" jsonschema:"group TUI search results under source headers"`
+}
+
+// HybridOverFetchMultiplier returns the hybrid candidate multiplier.
+// Falls back to the default when the configured value is not positive.
+func (s SearchSettings) HybridOverFetchMultiplier() int {
+	if s.HybridOverFetch <= 0 {
+		return DefaultHybridOverFetch
+	}
+	return s.HybridOverFetch
+}
+
+// VectorSnippetChars returns the snippet length for vector-only hits.
+// Falls back to the default when the configured value is not positive.
+func (s SearchSettings) VectorSnippetChars() int {
+	if s.VectorSnippetLength <= 0 {
+		return DefaultVectorSnippetLength
+	}
+	return s.VectorSnippetLength
 }
 
 // EmbeddingSettings holds embedding provider configuration.
 type EmbeddingSettings struct {
 	// Provider is the embedding service provider.
-	Provider AIProvider
+	Provider AIProvider `json:"provider,omitempty" jsonschema:"embedding service provider"`
 
 	// Model is the embedding model name.
-	Model string
+	Model string `json:"model,omitempty" jsonschema:"embedding model name"`
 
-	// BaseURL is the API endpoint (for Ollama).
-	BaseURL string
+	// BaseURL is the API endpoint (for Ollama and OpenAI-compatible servers).
+	BaseURL string `json:"base_url,omitempty" jsonschema:"API endpoint (for Ollama and OpenAI-compatible servers)"`
+
+	// APIKey is the API key (for OpenAI, optional for OpenAI-compatible servers).
+	APIKey string `json:"api_key,omitempty" jsonschema:"API key (for OpenAI, optional for OpenAI-compatible servers)"`
+
+	// Headers are extra HTTP headers sent with every embedding request, each
+	// written as "Name: value". Self-hosted servers behind a proxy often need
+	// these for authentication or routing.
+	Headers []string `json:"headers,omitempty" jsonschema:"extra HTTP headers sent with every embedding request, each written as 'Name: value'"`
 
-	// APIKey is the API key (for OpenAI).
-	APIKey string
+	// Workers is the number of chunks embedded concurrently in the background.
+	Workers int `json:"workers,omitempty" jsonschema:"number of chunks embedded concurrently in the background"`
+
+	// Dimensions requests smaller vectors from a Matryoshka model, trading a
+	// little accuracy for a smaller, faster vector index. Zero keeps the
+	// model's full size. Changing it requires rebuilding the vector index.
+	Dimensions int `json:"dimensions,omitempty" jsonschema:"reduced vector size for models that support Matryoshka truncation; 0 keeps the model's full size"`
+
+	// MaxAttempts is how many times a chunk is tried before its embedding is
+	// given up on. Failed attempts are retried in the background with backoff.
+	MaxAttempts int `json:"max_attempts,omitempty" jsonschema:"times a chunk is tried before its embedding is given up on; failures are retried with backoff"`
+}
+
+// DefaultEmbeddingWorkers is the default number of background embedding workers.
+const DefaultEmbeddingWorkers = 2
+
+// WorkerCount returns the number of background embedding workers.
+// Falls back to the default when the configured value is not positive.
+func (e EmbeddingSettings) WorkerCount() int {
+	if e.Workers <= 0 {
+		return DefaultEmbeddingWorkers
+	}
+	return e.Workers
+}
+
+// DefaultEmbeddingMaxAttempts is the default number of tries for each chunk's embedding.
+const DefaultEmbeddingMaxAttempts = 5
+
+// AttemptLimit returns how many times a chunk is tried before its embedding
+// is given up on. Falls back to the default when the configured value is not positive.
+func (e EmbeddingSettings) AttemptLimit() int {
+	if e.MaxAttempts <= 0 {
+		return DefaultEmbeddingMaxAttempts
+	}
+	return e.MaxAttempts
+}
+
+// VectorDimensions returns the size of the vectors the embedding model
+// produces: the reduced size when one is set, otherwise the model's full
+// size. It returns 0 for unknown models without a reduced size.
+func (e EmbeddingSettings) VectorDimensions() int {
+	if e.Dimensions > 0 {
+		return e.Dimensions
+	}
+	return EmbeddingDimensions()[e.Model]
+}
+
+// ValidateDimensions checks that a reduced vector size can be served by the model.
+// It fails when the model does not support Matryoshka truncation or the size
+// is larger than the model's full size.
+func (e EmbeddingSettings) ValidateDimensions() error {
+	if e.Dimensions == 0 {
+		return nil
+	}
+	if e.Dimensions < 0 {
+		return fmt.Errorf("%w: embedding dimensions must not be negative", ErrInvalidInput)
+	}
+	if !SupportsDimensionReduction(e.Model) {
+		return fmt.Errorf("%w: embedding model %q does not support reduced dimensions", ErrInvalidInput, e.Model)
+	}
+	if full := EmbeddingDimensions()[e.Model]; e.Dimensions > full {
+		return fmt.Errorf("%w: embedding model %q produces at most %d dimensions, got %d",
+			ErrInvalidInput, e.Model, full, e.Dimensions)
+	}
+	return nil
+}
+
+// RequestHeaders parses Headers into a map of header name to value.
+// It fails when an entry is not written as "Name: value".
+func (e EmbeddingSettings) RequestHeaders() (map[string]string, error) {
+	return parseRequestHeaders("embedding", e.Headers)
+}
+
+// parseRequestHeaders parses "Name: value" header entries into a map.
+// kind names the settings the headers belong to in errors.
+func parseRequestHeaders(kind string, entries []string) (map[string]string, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	headers := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		name, value, ok := strings.Cut(entry, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("%w: %s header %q must be written as 'Name: value'", ErrInvalidInput, kind, entry)
+		}
+		headers[name] = strings.TrimSpace(value)
+	}
+	return headers, nil
 }
 
 // IsConfigured returns true if the embedding provider is set up.
@@ -144,22 +390,25 @@ func (e EmbeddingSettings) IsConfigured() bool {
 	if e.Provider.RequiresAPIKey() && e.APIKey == "" {
 		return false
 	}
+	if e.Provider.RequiresBaseURL() && (e.BaseURL == "" || e.Model == "") {
+		return false
+	}
 	return true
 }
 
 // LLMSettings holds LLM provider configuration.
 type LLMSettings struct {
 	// Provider is the LLM service provider.
-	Provider AIProvider
+	Provider AIProvider `json:"provider,omitempty" jsonschema:"LLM service provider"`
 
 	// Model is the LLM model name.
-	Model string
+	Model string `json:"model,omitempty" jsonschema:"LLM model name"`
 
 	// BaseURL is the API endpoint (for Ollama).
-	BaseURL string
+	BaseURL string `json:"base_url,omitempty" jsonschema:"API endpoint (for Ollama)"`
 
 	// APIKey is the API key (for OpenAI/Anthropic).
-	APIKey string
+	APIKey string `json:"api_key,omitempty" jsonschema:"API key (for OpenAI/Anthropic)"`
 }
 
 // IsConfigured returns true if the LLM provider is set up.
@@ -220,29 +469,257 @@ func (p VectorPrecision) Description() string {
 // VectorIndexSettings holds vector index configuration.
 type VectorIndexSettings struct {
 	// Enabled indicates whether vector indexing is active.
-	Enabled bool
+	Enabled bool `json:"enabled,omitempty" jsonschema:"whether vector indexing is active"`
 
 	// Dimensions is the embedding vector size.
-	Dimensions int
+	Dimensions int `json:"dimensions,omitempty" jsonschema:"embedding vector size"`
 
 	// Precision is the storage precision for vectors.
 	// Default is float16 (best balance of size vs quality).
-	Precision VectorPrecision
+	Precision VectorPrecision `json:"precision,omitempty" jsonschema:"storage precision for vectors"`
+
+	// WarmUpOnStart runs a background search after the index is loaded so its
+	// pages are in memory before the first query. Off by default.
+	WarmUpOnStart bool `json:"warm_up_on_start,omitempty" jsonschema:"pre-load the index into memory in the background at startup"`
+}
+
+// EnrichmentSettings holds LLM document enrichment configuration.
+type EnrichmentSettings struct {
+	// Enabled extracts keywords and entities from synced documents using the LLM.
+	// Off by default because every new document costs an LLM call.
+	Enabled bool `json:"enabled,omitempty" jsonschema:"extract keywords and entities from synced documents using the LLM"`
+}
+
+// AuthSettings holds authentication flow configuration.
+type AuthSettings struct {
+	// OAuthTimeoutSeconds is how long to wait for the browser OAuth callback.
+	OAuthTimeoutSeconds int `json:"oauth_timeout_seconds,omitempty" jsonschema:"seconds to wait for the browser OAuth callback"`
+}
+
+// OAuthTimeout returns the OAuth callback timeout as a duration.
+// Falls back to the default when the configured value is not positive.
+func (a AuthSettings) OAuthTimeout() time.Duration {
+	if a.OAuthTimeoutSeconds <= 0 {
+		return DefaultOAuthTimeoutSeconds * time.Second
+	}
+	return time.Duration(a.OAuthTimeoutSeconds) * time.Second
+}
+
+// DefaultOAuthTimeoutSeconds is the default OAuth callback timeout (5 minutes).
+const DefaultOAuthTimeoutSeconds = 300
+
+// Default connector operation deadlines.
+const (
+	// DefaultValidateTimeoutSeconds bounds connector validation (30 seconds).
+	DefaultValidateTimeoutSeconds = 30
+
+	// DefaultFullSyncTimeoutSeconds bounds a full sync (2 hours).
+	DefaultFullSyncTimeoutSeconds = 2 * 60 * 60
+
+	// DefaultIncrementalSyncTimeoutSeconds bounds an incremental sync (30 minutes).
+	DefaultIncrementalSyncTimeoutSeconds = 30 * 60
+)
+
+// DefaultQuarantineAfterFailures is the default number of consecutive
+// normalisation failures before a document is quarantined.
+const DefaultQuarantineAfterFailures = 3
+
+// DefaultSyncBatchSize is the default number of documents buffered before
+// a sync writes them to the document store together.
+const DefaultSyncBatchSize = 50
+
+// DefaultOriginalMaxSizeKB is the default size cap, before compression, of
+// the original bytes kept for a document (1 MiB).
+const DefaultOriginalMaxSizeKB = 1024
+
+// SyncPolicy decides how a sync handles documents that fail to index.
+type SyncPolicy string
+
+const (
+	// SyncPolicyContinue logs document errors and carries on. This is the default.
+	SyncPolicyContinue SyncPolicy = "continue"
+
+	// SyncPolicyStrict aborts the sync at the first document error, without
+	// saving the cursor, so that scripted runs fail loudly.
+	SyncPolicyStrict SyncPolicy = "strict"
+)
+
+// IsStrict reports whether the policy aborts on the first document error.
+func (p SyncPolicy) IsStrict() bool {
+	return p == SyncPolicyStrict
+}
+
+// SyncSettings holds per-operation deadlines and failure handling for connector syncs.
+// When a deadline expires the operation's context is cancelled.
+type SyncSettings struct {
+	// ValidateTimeoutSeconds is the overall deadline for connector validation.
+	ValidateTimeoutSeconds int `json:"validate_timeout_seconds,omitempty" jsonschema:"deadline in seconds for connector validation"`
+
+	// FullSyncTimeoutSeconds is the overall deadline for a full sync.
+	FullSyncTimeoutSeconds int `json:"full_timeout_seconds,omitempty" jsonschema:"deadline in seconds for a full sync"`
+
+	// IncrementalSyncTimeoutSeconds is the overall deadline for an incremental sync.
+	IncrementalSyncTimeoutSeconds int `json:"incremental_timeout_seconds,omitempty" jsonschema:"deadline in seconds for an incremental sync"`
+
+	// QuarantineAfterFailures is how many consecutive normalisation failures
+	// quarantine a document, skipping it in future syncs until retried.
+	QuarantineAfterFailures int `json:"quarantine_after_failures,omitempty" jsonschema:"consecutive normalisation failures before a document is quarantined"`
+
+	// BatchSize is how many processed documents are buffered before they are
+	// written to the document store and indexed together.
+	BatchSize int `json:"batch_size,omitempty" jsonschema:"documents buffered before a sync writes them to the store"`
+
+	// KeepOriginals stores each document's original bytes, compressed,
+	// alongside its normalised text so the original can be shown later.
+	// It is off by default because it roughly doubles storage.
+	KeepOriginals bool `json:"keep_originals,omitempty" jsonschema:"store each document's original bytes, compressed, so the original can be shown"`
+
+	// OriginalMaxSizeKB caps the size of a kept original before compression.
+	// Larger documents keep only their normalised text.
+	OriginalMaxSizeKB int `json:"original_max_size_kb,omitempty" jsonschema:"largest original in KB, before compression, that is kept"`
+
+	// MinContentLength skips documents whose normalised content, ignoring
+	// surrounding whitespace, has fewer characters, such as one-line configs
+	// and empty READMEs. Zero indexes every document.
+	MinContentLength int `json:"min_content_length,omitempty" jsonschema:"documents with fewer characters of normalised content are not indexed; 0 indexes all"`
+
+	// ShortAsMetadataOnly indexes documents shorter than MinContentLength by
+	// name and metadata instead of skipping them.
+	ShortAsMetadataOnly bool `json:"short_as_metadata_only,omitempty" jsonschema:"index documents shorter than min_content_length by name and metadata instead of skipping them"`
+}
+
+// ValidateTimeout returns the validation deadline as a duration.
+// Falls back to the default when the configured value is not positive.
+func (s SyncSettings) ValidateTimeout() time.Duration {
+	return secondsOrDefault(s.ValidateTimeoutSeconds, DefaultValidateTimeoutSeconds)
+}
+
+// FullSyncTimeout returns the full sync deadline as a duration.
+// Falls back to the default when the configured value is not positive.
+func (s SyncSettings) FullSyncTimeout() time.Duration {
+	return secondsOrDefault(s.FullSyncTimeoutSeconds, DefaultFullSyncTimeoutSeconds)
+}
+
+// IncrementalSyncTimeout returns the incremental sync deadline as a duration.
+// Falls back to the default when the configured value is not positive.
+func (s SyncSettings) IncrementalSyncTimeout() time.Duration {
+	return secondsOrDefault(s.IncrementalSyncTimeoutSeconds, DefaultIncrementalSyncTimeoutSeconds)
+}
+
+// QuarantineThreshold returns the number of consecutive failures that quarantine a document.
+// Falls back to the default when the configured value is not positive.
+func (s SyncSettings) QuarantineThreshold() int {
+	if s.QuarantineAfterFailures <= 0 {
+		return DefaultQuarantineAfterFailures
+	}
+	return s.QuarantineAfterFailures
+}
+
+// DocumentBatchSize returns the number of documents written to the store together.
+// Falls back to the default when the configured value is not positive.
+func (s SyncSettings) DocumentBatchSize() int {
+	if s.BatchSize <= 0 {
+		return DefaultSyncBatchSize
+	}
+	return s.BatchSize
+}
+
+// KeepsOriginal reports whether the original bytes of a document of the
+// given size are kept. Falls back to the default cap when the configured
+// value is not positive.
+func (s SyncSettings) KeepsOriginal(size int) bool {
+	if !s.KeepOriginals || size == 0 {
+		return false
+	}
+	maxKB := s.OriginalMaxSizeKB
+	if maxKB <= 0 {
+		maxKB = DefaultOriginalMaxSizeKB
+	}
+	return size <= maxKB*1024
+}
+
+// TooShort reports whether normalised content is shorter than the minimum
+// length, counted in characters after trimming surrounding whitespace.
+func (s SyncSettings) TooShort(content string) bool {
+	if s.MinContentLength <= 0 {
+		return false
+	}
+	return utf8.RuneCountInString(strings.TrimSpace(content)) < s.MinContentLength
+}
+
+func secondsOrDefault(seconds, defaultSeconds int) time.Duration {
+	if seconds <= 0 {
+		seconds = defaultSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// DefaultHTTPCacheMaxSizeMB is the default size bound of each source's HTTP cache.
+const DefaultHTTPCacheMaxSizeMB = 100
+
+// HTTPCacheSettings holds the on-disk cache for connector GET requests.
+// Cached responses are revalidated with ETag/Last-Modified so unchanged
+// resources are not downloaded again.
+type HTTPCacheSettings struct {
+	// Enabled turns on conditional request caching for connectors.
+	Enabled bool `json:"enabled,omitempty" jsonschema:"cache connector GET responses and revalidate them with conditional requests"`
+
+	// MaxSizeMB bounds the cache of each source in megabytes.
+	MaxSizeMB int `json:"max_size_mb,omitempty" jsonschema:"size bound in megabytes of each source's HTTP cache"`
+}
+
+// MaxSizeBytes returns the per-source size bound in bytes.
+// Falls back to the default when the configured value is not positive.
+func (h HTTPCacheSettings) MaxSizeBytes() int64 {
+	mb := h.MaxSizeMB
+	if mb <= 0 {
+		mb = DefaultHTTPCacheMaxSizeMB
+	}
+	return int64(mb) << 20
+}
+
+// TUISettings holds terminal UI behaviour.
+type TUISettings struct {
+	// LiveSearch runs searches as the user types, once typing pauses,
+	// instead of only when Enter is pressed.
+	LiveSearch bool `json:"live_search,omitempty" jsonschema:"search as you type in the TUI search view"`
+
+	// Keys maps navigation actions to keys. Actions left unset keep their
+	// default keys.
+	Keys KeyBindings `json:"keys,omitempty" jsonschema:"TUI key bindings by action"`
 }
 
 // AppSettings holds all application settings.
 type AppSettings struct {
 	// Search holds search behaviour settings.
-	Search SearchSettings
+	Search SearchSettings `json:"search,omitempty" jsonschema:"search behaviour settings"`
 
 	// Embedding holds embedding provider settings.
-	Embedding EmbeddingSettings
+	Embedding EmbeddingSettings `json:"embedding,omitempty" jsonschema:"embedding provider settings"`
 
 	// LLM holds LLM provider settings.
-	LLM LLMSettings
+	LLM LLMSettings `json:"llm,omitempty" jsonschema:"LLM provider settings"`
 
 	// VectorIndex holds vector index settings.
-	VectorIndex VectorIndexSettings
+	VectorIndex VectorIndexSettings `json:"vector_index,omitempty" jsonschema:"vector index settings"`
+
+	// Enrichment holds document enrichment settings.
+	Enrichment EnrichmentSettings `json:"enrichment,omitempty" jsonschema:"document enrichment settings"`
+
+	// Rerank holds search result reranking settings.
+	Rerank RerankSettings `json:"rerank,omitempty" jsonschema:"reordering of the top search results by a reranker"`
+
+	// Auth holds authentication flow settings.
+	Auth AuthSettings `json:"auth,omitempty" jsonschema:"authentication flow settings"`
+
+	// Sync holds connector sync deadlines.
+	Sync SyncSettings `json:"sync,omitempty" jsonschema:"connector sync deadlines and failure handling"`
+
+	// HTTPCache holds the connector HTTP cache settings.
+	HTTPCache HTTPCacheSettings `json:"http_cache,omitempty" jsonschema:"on-disk cache for connector HTTP requests"`
+
+	// TUI holds terminal UI settings.
+	TUI TUISettings `json:"tui,omitempty" jsonschema:"terminal UI settings"`
 }
 
 // DefaultAppSettings returns settings with sensible defaults.
@@ -251,7 +728,12 @@ type AppSettings struct {
 func DefaultAppSettings() AppSettings {
 	return AppSettings{
 		Search: SearchSettings{
-			Mode: SearchModeTextOnly,
+			Mode:                SearchModeTextOnly,
+			HybridOverFetch:     DefaultHybridOverFetch,
+			MinScore:            DefaultVectorMinScore,
+			VectorSnippetLength: DefaultVectorSnippetLength,
+			TitleWeight:         DefaultTitleWeight,
+			Language:            LanguageEnglish,
 		},
 		// Embedding is left unconfigured - user must set up via settings wizard
 		Embedding: EmbeddingSettings{},
@@ -262,6 +744,27 @@ func DefaultAppSettings() AppSettings {
 			Dimensions: 768,                    // nomic-embed-text default
 			Precision:  VectorPrecisionFloat16, // Best balance of size vs quality
 		},
+		Enrichment: EnrichmentSettings{
+			Enabled: false,
+		},
+		Rerank: RerankSettings{
+			Candidates: DefaultRerankCandidates,
+		},
+		Auth: AuthSettings{
+			OAuthTimeoutSeconds: DefaultOAuthTimeoutSeconds,
+		},
+		Sync: SyncSettings{
+			ValidateTimeoutSeconds:        DefaultValidateTimeoutSeconds,
+			FullSyncTimeoutSeconds:        DefaultFullSyncTimeoutSeconds,
+			IncrementalSyncTimeoutSeconds: DefaultIncrementalSyncTimeoutSeconds,
+			QuarantineAfterFailures:       DefaultQuarantineAfterFailures,
+			BatchSize:                     DefaultSyncBatchSize,
+			OriginalMaxSizeKB:             DefaultOriginalMaxSizeKB,
+		},
+		HTTPCache: HTTPCacheSettings{
+			Enabled:   true,
+			MaxSizeMB: DefaultHTTPCacheMaxSizeMB,
+		},
 	}
 }
 
@@ -270,6 +773,7 @@ func AllSearchModes() []SearchMode {
 	return []SearchMode{
 		SearchModeTextOnly,
 		SearchModeHybrid,
+		SearchModeVectorOnly,
 		SearchModeLLMAssisted,
 		SearchModeFull,
 	}
@@ -280,6 +784,7 @@ func AllEmbeddingProviders() []AIProvider {
 	return []AIProvider{
 		AIProviderOllama,
 		AIProviderOpenAI,
+		AIProviderOpenAICompatible,
 	}
 }
 
@@ -323,6 +828,47 @@ func EmbeddingDimensions() map[string]int {
 	}
 }
 
+// EmbeddingMaxTokens returns the maximum input length in tokens for known
+// models. Chunks sized in tokens are capped at this limit.
+func EmbeddingMaxTokens() map[string]int {
+	return map[string]int{
+		// Ollama models
+		"nomic-embed-text":  8192,
+		"mxbai-embed-large": 512,
+		"all-minilm":        256,
+		// OpenAI models
+		"text-embedding-3-small": 8191,
+		"text-embedding-3-large": 8191,
+		"text-embedding-ada-002": 8191,
+	}
+}
+
+// matryoshkaModels lists the known models trained with Matryoshka
+// Representation Learning, whose vectors keep working when truncated.
+var matryoshkaModels = map[string]bool{
+	// OpenAI returns reduced vectors itself via the API's dimensions parameter
+	"text-embedding-3-small": true,
+	"text-embedding-3-large": true,
+	// Ollama returns full vectors, which are truncated and re-normalised locally
+	"nomic-embed-text":  true,
+	"mxbai-embed-large": true,
+}
+
+// SupportsDimensionReduction reports whether an embedding model can produce
+// vectors smaller than its full size. text-embedding-ada-002 and all-minilm
+// cannot.
+func SupportsDimensionReduction(model string) bool {
+	return matryoshkaModels[model]
+}
+
+// AllLanguages returns all available search languages.
+func AllLanguages() []Language {
+	return []Language{
+		LanguageEnglish,
+		LanguageCJK,
+	}
+}
+
 // AllVectorPrecisions returns all available vector precision options.
 func AllVectorPrecisions() []VectorPrecision {
 	return []VectorPrecision{
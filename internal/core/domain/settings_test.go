@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,6 +25,11 @@ func TestSearchMode_IsValid(t *testing.T) {
 			mode:     SearchModeHybrid,
 			expected: true,
 		},
+		{
+			name:     "vector_only is valid",
+			mode:     SearchModeVectorOnly,
+			expected: true,
+		},
 		{
 			name:     "llm_assisted is valid",
 			mode:     SearchModeLLMAssisted,
@@ -76,6 +82,11 @@ func TestSearchMode_RequiresEmbedding(t *testing.T) {
 			mode:     SearchModeHybrid,
 			expected: true,
 		},
+		{
+			name:     "vector_only requires embedding",
+			mode:     SearchModeVectorOnly,
+			expected: true,
+		},
 		{
 			name:     "llm_assisted does not require embedding",
 			mode:     SearchModeLLMAssisted,
@@ -202,6 +213,11 @@ func TestSearchMode_Description(t *testing.T) {
 			mode:     SearchModeHybrid,
 			expected: "Hybrid (text + semantic search)",
 		},
+		{
+			name:     "vector_only description",
+			mode:     SearchModeVectorOnly,
+			expected: "Vector Only (semantic search)",
+		},
 		{
 			name:     "llm_assisted description",
 			mode:     SearchModeLLMAssisted,
@@ -254,6 +270,11 @@ func TestAIProvider_IsValid(t *testing.T) {
 			provider: AIProviderAnthropic,
 			expected: true,
 		},
+		{
+			name:     "openai compatible is valid",
+			provider: AIProviderOpenAICompatible,
+			expected: true,
+		},
 		{
 			name:     "empty string is invalid",
 			provider: AIProvider(""),
@@ -353,6 +374,13 @@ func TestAIProvider_IsLocal(t *testing.T) {
 	}
 }
 
+// TestAIProvider_RequiresBaseURL tests which providers need an endpoint
+func TestAIProvider_RequiresBaseURL(t *testing.T) {
+	assert.True(t, AIProviderOpenAICompatible.RequiresBaseURL())
+	assert.False(t, AIProviderOllama.RequiresBaseURL())
+	assert.False(t, AIProviderOpenAI.RequiresBaseURL())
+}
+
 // TestAIProvider_String tests string representation
 func TestAIProvider_String(t *testing.T) {
 	tests := []struct {
@@ -412,6 +440,11 @@ func TestAIProvider_Description(t *testing.T) {
 			provider: AIProviderAnthropic,
 			expected: "Anthropic (cloud)",
 		},
+		{
+			name:     "openai compatible description",
+			provider: AIProviderOpenAICompatible,
+			expected: "OpenAI-compatible (self-hosted)",
+		},
 		{
 			name:     "unknown returns Unknown",
 			provider: AIProvider("unknown"),
@@ -491,6 +524,31 @@ func TestEmbeddingSettings_IsConfigured(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "openai compatible without API key is valid",
+			settings: EmbeddingSettings{
+				Provider: AIProviderOpenAICompatible,
+				Model:    "nomic-embed-text-v1.5.Q8_0",
+				BaseURL:  "http://localhost:8080/v1",
+			},
+			expected: true,
+		},
+		{
+			name: "openai compatible without base URL",
+			settings: EmbeddingSettings{
+				Provider: AIProviderOpenAICompatible,
+				Model:    "nomic-embed-text-v1.5.Q8_0",
+			},
+			expected: false,
+		},
+		{
+			name: "openai compatible without model",
+			settings: EmbeddingSettings{
+				Provider: AIProviderOpenAICompatible,
+				BaseURL:  "http://localhost:8080/v1",
+			},
+			expected: false,
+		},
 		{
 			name:     "empty settings",
 			settings: EmbeddingSettings{},
@@ -622,15 +680,148 @@ func TestDefaultAppSettings(t *testing.T) {
 	// Test vector index settings
 	assert.False(t, settings.VectorIndex.Enabled)
 	assert.Equal(t, 768, settings.VectorIndex.Dimensions)
+
+	// Test auth settings
+	assert.Equal(t, 300, settings.Auth.OAuthTimeoutSeconds)
+
+	// Test sync deadlines
+	assert.Equal(t, 30*time.Second, settings.Sync.ValidateTimeout())
+	assert.Equal(t, 2*time.Hour, settings.Sync.FullSyncTimeout())
+	assert.Equal(t, 30*time.Minute, settings.Sync.IncrementalSyncTimeout())
+	assert.Equal(t, 3, settings.Sync.QuarantineThreshold())
+
+	// Test HTTP cache
+	assert.True(t, settings.HTTPCache.Enabled)
+	assert.Equal(t, int64(100<<20), settings.HTTPCache.MaxSizeBytes())
+
+	// Test hybrid over-fetch
+	assert.Equal(t, 3, settings.Search.HybridOverFetchMultiplier())
+	assert.Equal(t, DefaultVectorMinScore, settings.Search.MinScore)
+	assert.Equal(t, DefaultTitleWeight, settings.Search.TitleWeight)
+
+	// Test language
+	assert.Equal(t, LanguageEnglish, settings.Search.Language)
+}
+
+// TestLanguage_IsValid tests language validation
+func TestLanguage_IsValid(t *testing.T) {
+	assert.True(t, LanguageEnglish.IsValid())
+	assert.True(t, LanguageCJK.IsValid())
+	assert.False(t, Language("fr").IsValid())
+	assert.False(t, Language("").IsValid())
+}
+
+// TestLanguage_Description tests language descriptions
+func TestLanguage_Description(t *testing.T) {
+	assert.Equal(t, "English (word stemming)", LanguageEnglish.Description())
+	assert.Equal(t, "CJK (character bigrams)", LanguageCJK.Description())
+	assert.Equal(t, "Unknown", Language("fr").Description())
+}
+
+// TestLanguage_Code tests the fallback language codes
+func TestLanguage_Code(t *testing.T) {
+	assert.Equal(t, "en", LanguageEnglish.Code())
+	assert.Equal(t, "en", Language("").Code())
+	assert.Empty(t, LanguageCJK.Code())
+}
+
+// TestIsTermRune tests which characters belong to search terms
+func TestIsTermRune(t *testing.T) {
+	for _, r := range "aZé9_東" {
+		assert.True(t, IsTermRune(r), string(r))
+	}
+	for _, r := range " -.'\"(" {
+		assert.False(t, IsTermRune(r), string(r))
+	}
+}
+
+// TestIsCJK tests detection of scripts without word spacing
+func TestIsCJK(t *testing.T) {
+	for _, r := range "東ひカー한" {
+		assert.True(t, IsCJK(r), string(r))
+	}
+	for _, r := range "aé9-" {
+		assert.False(t, IsCJK(r), string(r))
+	}
+}
+
+// TestSearchSettings_HybridOverFetchMultiplier tests the over-fetch fallback
+func TestSearchSettings_HybridOverFetchMultiplier(t *testing.T) {
+	assert.Equal(t, 5, SearchSettings{HybridOverFetch: 5}.HybridOverFetchMultiplier())
+	assert.Equal(t, DefaultHybridOverFetch, SearchSettings{}.HybridOverFetchMultiplier())
+	assert.Equal(t, DefaultHybridOverFetch, SearchSettings{HybridOverFetch: -2}.HybridOverFetchMultiplier())
+}
+
+// TestAuthSettings_OAuthTimeout tests conversion of the OAuth timeout to a duration
+func TestAuthSettings_OAuthTimeout(t *testing.T) {
+	assert.Equal(t, 90*time.Second, AuthSettings{OAuthTimeoutSeconds: 90}.OAuthTimeout())
+	assert.Equal(t, 5*time.Minute, AuthSettings{}.OAuthTimeout())
+	assert.Equal(t, 5*time.Minute, AuthSettings{OAuthTimeoutSeconds: -1}.OAuthTimeout())
+}
+
+// TestSyncSettings_Timeouts tests conversion of sync deadlines to durations
+func TestSyncSettings_Timeouts(t *testing.T) {
+	s := SyncSettings{
+		ValidateTimeoutSeconds:        10,
+		FullSyncTimeoutSeconds:        600,
+		IncrementalSyncTimeoutSeconds: 120,
+	}
+	assert.Equal(t, 10*time.Second, s.ValidateTimeout())
+	assert.Equal(t, 10*time.Minute, s.FullSyncTimeout())
+	assert.Equal(t, 2*time.Minute, s.IncrementalSyncTimeout())
+
+	// Non-positive values fall back to defaults
+	assert.Equal(t, 5, SyncSettings{QuarantineAfterFailures: 5}.QuarantineThreshold())
+	assert.Equal(t, 3, SyncSettings{QuarantineAfterFailures: -1}.QuarantineThreshold())
+	assert.Equal(t, 10, SyncSettings{BatchSize: 10}.DocumentBatchSize())
+	assert.Equal(t, DefaultSyncBatchSize, SyncSettings{}.DocumentBatchSize())
+	s = SyncSettings{FullSyncTimeoutSeconds: -1}
+	assert.Equal(t, 30*time.Second, s.ValidateTimeout())
+	assert.Equal(t, 2*time.Hour, s.FullSyncTimeout())
+	assert.Equal(t, 30*time.Minute, s.IncrementalSyncTimeout())
+}
+
+func TestSyncSettings_KeepsOriginal(t *testing.T) {
+	assert.False(t, SyncSettings{}.KeepsOriginal(10), "off by default")
+	assert.False(t, DefaultAppSettings().Sync.KeepsOriginal(10))
+
+	s := SyncSettings{KeepOriginals: true, OriginalMaxSizeKB: 2}
+	assert.True(t, s.KeepsOriginal(2048))
+	assert.False(t, s.KeepsOriginal(2049))
+	assert.False(t, s.KeepsOriginal(0), "nothing to keep")
+
+	// Non-positive caps fall back to the default
+	s = SyncSettings{KeepOriginals: true}
+	assert.True(t, s.KeepsOriginal(DefaultOriginalMaxSizeKB*1024))
+	assert.False(t, s.KeepsOriginal(DefaultOriginalMaxSizeKB*1024+1))
+}
+
+func TestSyncSettings_TooShort(t *testing.T) {
+	assert.False(t, SyncSettings{}.TooShort(""), "no minimum by default")
+	assert.False(t, DefaultAppSettings().Sync.TooShort("x"))
+
+	s := SyncSettings{MinContentLength: 5}
+	assert.True(t, s.TooShort(""))
+	assert.True(t, s.TooShort("  abcd \n"), "surrounding whitespace is not counted")
+	assert.False(t, s.TooShort("abcde"))
+	assert.False(t, s.TooShort("héllo"), "counts characters, not bytes")
+	assert.True(t, s.TooShort("héll"))
+}
+
+func TestSearchSettings_VectorSnippetChars(t *testing.T) {
+	assert.Equal(t, 120, SearchSettings{VectorSnippetLength: 120}.VectorSnippetChars())
+	assert.Equal(t, DefaultVectorSnippetLength, SearchSettings{}.VectorSnippetChars())
+	assert.Equal(t, DefaultVectorSnippetLength, SearchSettings{VectorSnippetLength: -5}.VectorSnippetChars())
 }
 
 // TestAllSearchModes tests complete list of search modes
 func TestAllSearchModes(t *testing.T) {
 	modes := AllSearchModes()
 
-	require.Len(t, modes, 4)
+	require.Len(t, modes, 5)
 	assert.Contains(t, modes, SearchModeTextOnly)
 	assert.Contains(t, modes, SearchModeHybrid)
+	assert.Contains(t, modes, SearchModeVectorOnly)
 	assert.Contains(t, modes, SearchModeLLMAssisted)
 	assert.Contains(t, modes, SearchModeFull)
 
@@ -644,9 +835,10 @@ func TestAllSearchModes(t *testing.T) {
 func TestAllEmbeddingProviders(t *testing.T) {
 	providers := AllEmbeddingProviders()
 
-	require.Len(t, providers, 2)
+	require.Len(t, providers, 3)
 	assert.Contains(t, providers, AIProviderOllama)
 	assert.Contains(t, providers, AIProviderOpenAI)
+	assert.Contains(t, providers, AIProviderOpenAICompatible)
 	assert.NotContains(t, providers, AIProviderAnthropic, "Anthropic should not be in embedding providers")
 
 	// Verify all providers are valid
@@ -711,6 +903,88 @@ func TestEmbeddingDimensions(t *testing.T) {
 	assert.False(t, exists)
 }
 
+// TestEmbeddingMaxTokens tests embedding model input limits
+func TestEmbeddingMaxTokens(t *testing.T) {
+	limits := EmbeddingMaxTokens()
+
+	assert.Equal(t, 8192, limits["nomic-embed-text"])
+	assert.Equal(t, 512, limits["mxbai-embed-large"])
+	assert.Equal(t, 256, limits["all-minilm"])
+	assert.Equal(t, 8191, limits["text-embedding-3-small"])
+
+	// Every model with known dimensions has a known limit
+	for model := range EmbeddingDimensions() {
+		assert.Positive(t, limits[model], model)
+	}
+}
+
+// TestSupportsDimensionReduction tests which models accept reduced dimensions
+func TestSupportsDimensionReduction(t *testing.T) {
+	assert.True(t, SupportsDimensionReduction("text-embedding-3-small"))
+	assert.True(t, SupportsDimensionReduction("text-embedding-3-large"))
+	assert.True(t, SupportsDimensionReduction("nomic-embed-text"))
+	assert.False(t, SupportsDimensionReduction("text-embedding-ada-002"))
+	assert.False(t, SupportsDimensionReduction("all-minilm"))
+	assert.False(t, SupportsDimensionReduction("unknown-model"))
+}
+
+// TestEmbeddingSettings_VectorDimensions tests the effective vector size
+func TestEmbeddingSettings_VectorDimensions(t *testing.T) {
+	assert.Equal(t, 1536, EmbeddingSettings{Model: "text-embedding-3-small"}.VectorDimensions())
+	assert.Equal(t, 512, EmbeddingSettings{Model: "text-embedding-3-small", Dimensions: 512}.VectorDimensions())
+	assert.Equal(t, 0, EmbeddingSettings{Model: "unknown-model"}.VectorDimensions())
+}
+
+// TestEmbeddingSettings_ValidateDimensions tests reduced dimension validation
+func TestEmbeddingSettings_AttemptLimit(t *testing.T) {
+	assert.Equal(t, 3, EmbeddingSettings{MaxAttempts: 3}.AttemptLimit())
+	assert.Equal(t, DefaultEmbeddingMaxAttempts, EmbeddingSettings{}.AttemptLimit())
+	assert.Equal(t, DefaultEmbeddingMaxAttempts, EmbeddingSettings{MaxAttempts: -1}.AttemptLimit())
+}
+
+func TestEmbeddingSettings_ValidateDimensions(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings EmbeddingSettings
+		wantErr  bool
+	}{
+		{"full size", EmbeddingSettings{Model: "all-minilm"}, false},
+		{"reduced openai", EmbeddingSettings{Model: "text-embedding-3-large", Dimensions: 256}, false},
+		{"reduced nomic", EmbeddingSettings{Model: "nomic-embed-text", Dimensions: 256}, false},
+		{"equal to full size", EmbeddingSettings{Model: "nomic-embed-text", Dimensions: 768}, false},
+		{"larger than full size", EmbeddingSettings{Model: "nomic-embed-text", Dimensions: 1024}, true},
+		{"unsupported model", EmbeddingSettings{Model: "text-embedding-ada-002", Dimensions: 512}, true},
+		{"negative", EmbeddingSettings{Model: "nomic-embed-text", Dimensions: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.ValidateDimensions()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestEmbeddingSettings_RequestHeaders tests parsing of extra request headers
+func TestEmbeddingSettings_RequestHeaders(t *testing.T) {
+	headers, err := EmbeddingSettings{}.RequestHeaders()
+	require.NoError(t, err)
+	assert.Nil(t, headers)
+
+	headers, err = EmbeddingSettings{Headers: []string{"X-Api-Key: secret", "X-Route:gpu:0"}}.RequestHeaders()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Api-Key": "secret", "X-Route": "gpu:0"}, headers)
+
+	for _, bad := range []string{"no-colon", ": value", "Bad Name: value"} {
+		_, err := EmbeddingSettings{Headers: []string{bad}}.RequestHeaders()
+		assert.ErrorIs(t, err, ErrInvalidInput, bad)
+	}
+}
+
 // TestSearchSettings_Fields tests SearchSettings structure
 func TestSearchSettings_Fields(t *testing.T) {
 	settings := SearchSettings{
@@ -809,3 +1083,17 @@ func TestUnknownDescription_Constant(t *testing.T) {
 	assert.Equal(t, unknownDescription, SearchMode("invalid").Description())
 	assert.Equal(t, unknownDescription, AIProvider("invalid").Description())
 }
+
+// TestHTTPCacheSettings_MaxSizeBytes tests conversion of the cache bound to bytes
+func TestHTTPCacheSettings_MaxSizeBytes(t *testing.T) {
+	assert.Equal(t, int64(25<<20), HTTPCacheSettings{MaxSizeMB: 25}.MaxSizeBytes())
+	assert.Equal(t, int64(DefaultHTTPCacheMaxSizeMB<<20), HTTPCacheSettings{}.MaxSizeBytes())
+	assert.Equal(t, int64(DefaultHTTPCacheMaxSizeMB<<20), HTTPCacheSettings{MaxSizeMB: -4}.MaxSizeBytes())
+}
+
+// TestSyncPolicy_IsStrict tests the strict sync policy check
+func TestSyncPolicy_IsStrict(t *testing.T) {
+	assert.True(t, SyncPolicyStrict.IsStrict())
+	assert.False(t, SyncPolicyContinue.IsStrict())
+	assert.False(t, SyncPolicy("").IsStrict())
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -34,6 +35,14 @@ type Source struct {
 	// Empty string for no-auth connectors.
 	CredentialsID string
 
+	// Archived pauses syncing while keeping the source's documents searchable.
+	// Archived sources are skipped by scheduled syncs and sync --all.
+	Archived bool
+
+	// Collection is the name of the collection the source is grouped in,
+	// such as "Work". Empty when the source is in no collection.
+	Collection string
+
 	// CreatedAt is when the source was created.
 	CreatedAt time.Time
 
@@ -51,6 +60,47 @@ func (s *Source) DisplayName(accountIdentifier string) string {
 	return s.Name
 }
 
+// SourceConfigRetain is the source config key setting how long documents are
+// kept, such as "180d". Documents not updated within the window are pruned.
+const SourceConfigRetain = "retain"
+
+// Retention returns the source's retention window, or zero when documents
+// are kept indefinitely.
+func (s *Source) Retention() (time.Duration, error) {
+	value := strings.TrimSpace(s.Config[SourceConfigRetain])
+	if value == "" {
+		return 0, nil
+	}
+	return ParseRetention(value)
+}
+
+// ParseRetention parses a retention window given in days, weeks or years
+// ("180d", "4w", "1y") or as a duration ("720h"). A year is 365 days.
+func ParseRetention(value string) (time.Duration, error) {
+	invalid := fmt.Errorf("%w: retention %q: use a duration like 180d, 4w or 1y", ErrInvalidInput, value)
+
+	units := map[string]time.Duration{
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+		"y": 365 * 24 * time.Hour,
+	}
+	for suffix, unit := range units {
+		if n, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count <= 0 {
+				return 0, invalid
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, invalid
+	}
+	return d, nil
+}
+
 // SyncState tracks the synchronisation progress for a source.
 type SyncState struct {
 	// SourceID links to the Source being synced.
@@ -59,6 +109,110 @@ type SyncState struct {
 	// Cursor is an opaque token for incremental sync.
 	Cursor string
 
+	// SubCursors holds independent cursors for sub-resources of the source,
+	// keyed by a connector-defined name (e.g. "owner/repo" for GitHub).
+	// Values are opaque to everything but the connector.
+	SubCursors map[string]string
+
+	// ConnectorVersion is the connector's sync state schema version when
+	// the cursors were saved. Cursors saved by another version are
+	// discarded and the source is fully resynced. Empty for states saved
+	// before versioning.
+	ConnectorVersion string
+
 	// LastSync is when the last successful sync completed.
 	LastSync time.Time
+
+	// LastError is the error from the most recent sync attempt.
+	// Empty when that attempt succeeded.
+	LastError string
+
+	// RecentErrors holds the latest errors from syncs of the source, oldest
+	// first, including documents that failed during otherwise successful
+	// syncs. At most MaxRecentSyncErrors are kept.
+	RecentErrors []SyncErrorLog
+
+	// Since overrides the cursor for a single incremental sync, asking the
+	// connector for items changed after this time. It is never persisted.
+	// Connectors that cannot filter by time ignore it.
+	Since time.Time
+
+	// Scope limits a single incremental sync to the named sub-resources,
+	// keyed like SubCursors (e.g. "owner/repo" for GitHub). It is never
+	// persisted. Connectors that cannot scope a sync ignore it.
+	Scope []string
+}
+
+// MaxRecentSyncErrors is the number of errors kept in SyncState.RecentErrors.
+const MaxRecentSyncErrors = 10
+
+// Sync error phases.
+const (
+	// SyncPhaseValidation is the connector check before a sync starts.
+	SyncPhaseValidation = "validation"
+
+	// SyncPhaseDocument is the processing of a single document.
+	SyncPhaseDocument = "document"
+
+	// SyncPhaseSync is the sync as a whole, such as a connector failure or timeout.
+	SyncPhaseSync = "sync"
+
+	// SyncPhaseSkipped summarises the documents a sync skipped because no
+	// normaliser supports their type or their content is too short.
+	SyncPhaseSkipped = "skipped"
+)
+
+// SyncErrorLog records one error from a sync.
+type SyncErrorLog struct {
+	// Timestamp is when the error occurred.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Phase is the part of the sync that failed, such as SyncPhaseDocument.
+	Phase string `json:"phase"`
+
+	// Message describes the error.
+	Message string `json:"message"`
+}
+
+// SourceStatus summarises the sync health of a source for display.
+type SourceStatus struct {
+	// SourceID identifies the source.
+	SourceID string
+
+	// LastSync is when the last successful sync completed.
+	// Zero if the source has never synced successfully.
+	LastSync time.Time
+
+	// LastError is the error from the most recent sync attempt, if it failed.
+	LastError string
+
+	// DocumentCount is the number of indexed documents for the source.
+	DocumentCount int
+
+	// RecentErrors holds the latest sync errors, oldest first.
+	RecentErrors []SyncErrorLog
+}
+
+// NeverSynced returns true if the source has not yet synced successfully.
+func (s SourceStatus) NeverSynced() bool {
+	return s.LastSync.IsZero()
+}
+
+// SyncedWithin returns true if the last successful sync completed less than d before now.
+func (s SourceStatus) SyncedWithin(d time.Duration, now time.Time) bool {
+	return !s.NeverSynced() && now.Sub(s.LastSync) < d
+}
+
+// HasCursor returns true if any incremental sync state has been recorded.
+func (s *SyncState) HasCursor() bool {
+	return s.Cursor != "" || len(s.SubCursors) > 0
+}
+
+// AddErrors appends entries to RecentErrors, dropping the oldest beyond
+// MaxRecentSyncErrors.
+func (s *SyncState) AddErrors(entries ...SyncErrorLog) {
+	s.RecentErrors = append(s.RecentErrors, entries...)
+	if excess := len(s.RecentErrors) - MaxRecentSyncErrors; excess > 0 {
+		s.RecentErrors = append([]SyncErrorLog(nil), s.RecentErrors[excess:]...)
+	}
 }
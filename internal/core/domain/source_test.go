@@ -1,10 +1,12 @@
 package domain
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestSource_Fields tests Source structure fields
@@ -319,3 +321,87 @@ func TestSyncState_CursorFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncState_HasCursor(t *testing.T) {
+	assert.False(t, (&SyncState{}).HasCursor())
+	assert.True(t, (&SyncState{Cursor: "c"}).HasCursor())
+	assert.True(t, (&SyncState{SubCursors: map[string]string{"a": "b"}}).HasCursor())
+	assert.False(t, (&SyncState{SubCursors: map[string]string{}}).HasCursor())
+}
+
+func TestSyncState_AddErrors(t *testing.T) {
+	var state SyncState
+	for i := 0; i < MaxRecentSyncErrors+3; i++ {
+		state.AddErrors(SyncErrorLog{Phase: SyncPhaseDocument, Message: fmt.Sprintf("error %d", i)})
+	}
+
+	require.Len(t, state.RecentErrors, MaxRecentSyncErrors)
+	assert.Equal(t, "error 3", state.RecentErrors[0].Message)
+	assert.Equal(t, "error 12", state.RecentErrors[MaxRecentSyncErrors-1].Message)
+
+	state.AddErrors()
+	assert.Len(t, state.RecentErrors, MaxRecentSyncErrors)
+}
+
+// TestParseRetention tests parsing of retention windows
+func TestParseRetention(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		value    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{value: "180d", expected: 180 * day},
+		{value: "4w", expected: 28 * day},
+		{value: "1y", expected: 365 * day},
+		{value: "720h", expected: 720 * time.Hour},
+		{value: "0d", wantErr: true},
+		{value: "-5d", wantErr: true},
+		{value: "xd", wantErr: true},
+		{value: "-1h", wantErr: true},
+		{value: "six months", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRetention(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+// TestSource_Retention tests reading the retention window from source config
+func TestSource_Retention(t *testing.T) {
+	retention, err := (&Source{}).Retention()
+	require.NoError(t, err)
+	assert.Zero(t, retention)
+
+	source := Source{Config: map[string]string{SourceConfigRetain: "30d"}}
+	retention, err = source.Retention()
+	require.NoError(t, err)
+	assert.Equal(t, 30*24*time.Hour, retention)
+
+	source.Config[SourceConfigRetain] = "soon"
+	_, err = source.Retention()
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestSourceStatus_SyncedWithin(t *testing.T) {
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+
+	never := SourceStatus{SourceID: "never"}
+	assert.True(t, never.NeverSynced())
+	assert.False(t, never.SyncedWithin(week, now))
+
+	recent := SourceStatus{SourceID: "recent", LastSync: now.Add(-2 * 24 * time.Hour)}
+	assert.False(t, recent.NeverSynced())
+	assert.True(t, recent.SyncedWithin(week, now))
+
+	stale := SourceStatus{SourceID: "stale", LastSync: now.Add(-10 * 24 * time.Hour)}
+	assert.False(t, stale.SyncedWithin(week, now))
+}
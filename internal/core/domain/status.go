@@ -0,0 +1,76 @@
+package domain
+
+import "strings"
+
+// SystemStatus summarises the sources and indexes at a glance.
+type SystemStatus struct {
+	// Sources is the number of configured sources, archived ones included.
+	Sources int
+
+	// ArchivedSources is how many of the sources are archived.
+	ArchivedSources int
+
+	// FailingSources is how many sources failed their last sync attempt.
+	FailingSources int
+
+	// AuthIssues lists the sources whose credentials need attention.
+	AuthIssues []SourceAuthIssue
+
+	// Documents is the number of indexed documents.
+	Documents int
+
+	// KeywordChunks is the number of chunks in the keyword index, or -1 if
+	// the index cannot count them.
+	KeywordChunks int
+
+	// VectorChunks is the number of chunks in the vector index, or -1 if
+	// embeddings are disabled or the index cannot count them.
+	VectorChunks int
+}
+
+// AuthIssue describes why a source's credentials need attention.
+type AuthIssue string
+
+const (
+	// AuthIssueNone means the credentials look usable.
+	AuthIssueNone AuthIssue = ""
+	// AuthIssueMissing means the connector needs credentials the source lacks.
+	AuthIssueMissing AuthIssue = "missing"
+	// AuthIssueInvalid means the credentials are incomplete or were rejected.
+	AuthIssueInvalid AuthIssue = "invalid"
+	// AuthIssueExpired means the credentials expired and cannot be refreshed.
+	AuthIssueExpired AuthIssue = "expired"
+)
+
+// SourceAuthIssue names a source whose credentials need attention.
+type SourceAuthIssue struct {
+	SourceID   string
+	SourceName string
+	Issue      AuthIssue
+}
+
+// CheckCredentials reports what, if anything, is wrong with the credentials
+// of a source. creds is nil for sources without credentials, and lastError
+// is the error from the source's last sync attempt, which shows credentials
+// the provider rejected.
+func CheckCredentials(requiresAuth bool, creds *Credentials, lastError string) AuthIssue {
+	if !requiresAuth {
+		return AuthIssueNone
+	}
+	if creds == nil {
+		return AuthIssueMissing
+	}
+	if !creds.IsAuthenticated() {
+		return AuthIssueInvalid
+	}
+	if creds.OAuth != nil && creds.OAuth.IsExpired() && !creds.HasRefreshToken() {
+		return AuthIssueExpired
+	}
+	switch {
+	case strings.Contains(lastError, ErrAuthExpired.Error()):
+		return AuthIssueExpired
+	case strings.Contains(lastError, ErrAuthInvalid.Error()):
+		return AuthIssueInvalid
+	}
+	return AuthIssueNone
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCredentials(t *testing.T) {
+	pat := &Credentials{PAT: &PATCredentials{Token: "ghp_token"}}
+	expired := &Credentials{OAuth: &OAuthCredentials{AccessToken: "at", Expiry: time.Now().Add(-time.Hour)}}
+	refreshable := &Credentials{OAuth: &OAuthCredentials{
+		AccessToken: "at", RefreshToken: "rt", Expiry: time.Now().Add(-time.Hour),
+	}}
+
+	tests := []struct {
+		name         string
+		requiresAuth bool
+		creds        *Credentials
+		lastError    string
+		want         AuthIssue
+	}{
+		{"no auth needed", false, nil, "", AuthIssueNone},
+		{"missing", true, nil, "", AuthIssueMissing},
+		{"incomplete", true, &Credentials{PAT: &PATCredentials{}}, "", AuthIssueInvalid},
+		{"valid", true, pat, "", AuthIssueNone},
+		{"expired without refresh token", true, expired, "", AuthIssueExpired},
+		{"expired with refresh token", true, refreshable, "", AuthIssueNone},
+		{"rejected by provider", true, pat, "validate: " + ErrAuthInvalid.Error(), AuthIssueInvalid},
+		{"refresh failed", true, refreshable, ErrAuthExpired.Error(), AuthIssueExpired},
+		{"other sync error", true, pat, "connection refused", AuthIssueNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CheckCredentials(tt.requiresAuth, tt.creds, tt.lastError))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package domain
+
+import "time"
+
+// SyncChangeKind is how a sync changed a stored document.
+type SyncChangeKind string
+
+// Available sync change kinds.
+const (
+	// SyncChangeCreated is a document the sync stored for the first time.
+	SyncChangeCreated SyncChangeKind = "created"
+
+	// SyncChangeUpdated is a stored document the sync replaced.
+	SyncChangeUpdated SyncChangeKind = "updated"
+
+	// SyncChangeDeleted is a stored document the sync deleted.
+	SyncChangeDeleted SyncChangeKind = "deleted"
+)
+
+// MaxSyncChangeLogEntries is the number of document changes a change log
+// holds. Syncs that change more documents cannot be undone.
+const MaxSyncChangeLogEntries = 1000
+
+// SyncChange records one document changed by a sync, along with the
+// document as it was before so the change can be reversed.
+type SyncChange struct {
+	// Kind is how the document was changed.
+	Kind SyncChangeKind
+
+	// DocumentID identifies the changed document.
+	DocumentID string
+
+	// Previous is the document before the change. Nil for created documents.
+	Previous *Document
+
+	// PreviousChunks are the chunks of the document before the change.
+	PreviousChunks []Chunk
+}
+
+// SyncChangeLog records the document changes made by the most recent sync
+// of a source and the sync state before it, so the sync can be undone.
+type SyncChangeLog struct {
+	// SourceID identifies the synced source.
+	SourceID string
+
+	// StartedAt is when the sync started.
+	StartedAt time.Time
+
+	// PreviousState is the sync state before the sync. Nil when the source
+	// had never synced.
+	PreviousState *SyncState
+
+	// Changes lists the changed documents in the order they were first changed.
+	Changes []SyncChange
+
+	// Truncated is set once the sync changed more than
+	// MaxSyncChangeLogEntries documents. Its changes are dropped and the
+	// sync cannot be undone.
+	Truncated bool
+}
+
+// Record adds a change to the log. Only the first change to a document is
+// kept, as it holds the document as it was before the sync.
+func (l *SyncChangeLog) Record(change SyncChange) {
+	if l.Truncated || l.Contains(change.DocumentID) {
+		return
+	}
+	if len(l.Changes) >= MaxSyncChangeLogEntries {
+		l.Changes = nil
+		l.Truncated = true
+		return
+	}
+	l.Changes = append(l.Changes, change)
+}
+
+// Contains reports whether the log holds a change to the document.
+func (l *SyncChangeLog) Contains(documentID string) bool {
+	for i := range l.Changes {
+		if l.Changes[i].DocumentID == documentID {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of changes of a kind.
+func (l *SyncChangeLog) Count(kind SyncChangeKind) int {
+	n := 0
+	for i := range l.Changes {
+		if l.Changes[i].Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// SyncUndoResult summarises an undone sync.
+type SyncUndoResult struct {
+	// Removed is the number of documents created by the sync that were deleted.
+	Removed int
+
+	// Restored is the number of documents updated or deleted by the sync
+	// that were put back as they were.
+	Restored int
+
+	// StartedAt is when the undone sync started.
+	StartedAt time.Time
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncChangeLog_Record(t *testing.T) {
+	log := &SyncChangeLog{SourceID: "src-1"}
+	previous := &Document{ID: "doc-2", Content: "before"}
+
+	log.Record(SyncChange{Kind: SyncChangeCreated, DocumentID: "doc-1"})
+	log.Record(SyncChange{Kind: SyncChangeUpdated, DocumentID: "doc-2", Previous: previous})
+	log.Record(SyncChange{Kind: SyncChangeDeleted, DocumentID: "doc-3", Previous: &Document{ID: "doc-3"}})
+
+	// Later changes to the same document keep the first
+	log.Record(SyncChange{Kind: SyncChangeDeleted, DocumentID: "doc-1", Previous: &Document{ID: "doc-1"}})
+	log.Record(SyncChange{Kind: SyncChangeUpdated, DocumentID: "doc-2", Previous: &Document{ID: "doc-2", Content: "during"}})
+
+	assert.Len(t, log.Changes, 3)
+	assert.Equal(t, SyncChangeCreated, log.Changes[0].Kind)
+	assert.Equal(t, "before", log.Changes[1].Previous.Content)
+	assert.True(t, log.Contains("doc-3"))
+	assert.False(t, log.Contains("doc-4"))
+	assert.Equal(t, 1, log.Count(SyncChangeCreated))
+	assert.Equal(t, 1, log.Count(SyncChangeUpdated))
+	assert.Equal(t, 1, log.Count(SyncChangeDeleted))
+}
+
+func TestSyncChangeLog_RecordTruncates(t *testing.T) {
+	log := &SyncChangeLog{}
+	for i := range MaxSyncChangeLogEntries {
+		log.Record(SyncChange{Kind: SyncChangeCreated, DocumentID: fmt.Sprintf("doc-%d", i)})
+	}
+	assert.False(t, log.Truncated)
+	assert.Len(t, log.Changes, MaxSyncChangeLogEntries)
+
+	log.Record(SyncChange{Kind: SyncChangeCreated, DocumentID: "one-too-many"})
+	assert.True(t, log.Truncated)
+	assert.Empty(t, log.Changes)
+
+	// Nothing more is recorded once truncated
+	log.Record(SyncChange{Kind: SyncChangeCreated, DocumentID: "doc-x"})
+	assert.Empty(t, log.Changes)
+}
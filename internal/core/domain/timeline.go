@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// DefaultTimelineLimit is the number of documents shown in the timeline when
+// no limit is given.
+const DefaultTimelineLimit = 200
+
+// MetadataDocumentType is the raw document and document metadata key some
+// connectors use for the kind of item a document holds, such as "issue" or
+// "commit".
+const MetadataDocumentType = "type"
+
+// TimelineOptions selects the documents in the timeline.
+type TimelineOptions struct {
+	// Since excludes documents last updated before it. Zero means no bound.
+	Since time.Time
+
+	// Until excludes documents last updated at or after it. Zero means no bound.
+	Until time.Time
+
+	// SourceIDs restricts the timeline to these sources. Empty means all.
+	SourceIDs []string
+
+	// Limit is the maximum number of documents returned.
+	// Zero or less means DefaultTimelineLimit.
+	Limit int
+}
+
+// EffectiveLimit returns the limit to apply, falling back to the default.
+func (o TimelineOptions) EffectiveLimit() int {
+	if o.Limit <= 0 {
+		return DefaultTimelineLimit
+	}
+	return o.Limit
+}
+
+// TimelineEntry is a document in the timeline, tagged with its source.
+type TimelineEntry struct {
+	// Document is the indexed document.
+	Document Document
+
+	// SourceName is the display name of the document's source.
+	SourceName string
+
+	// SourceType is the connector type of the document's source.
+	SourceType string
+}
+
+// Kind returns the kind of item the entry holds: the document type the
+// connector reported, or the source type when it reported none.
+func (e TimelineEntry) Kind() string {
+	if kind, ok := e.Document.Metadata[MetadataDocumentType].(string); ok && kind != "" {
+		return kind
+	}
+	return e.SourceType
+}
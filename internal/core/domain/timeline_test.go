@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTimelineOptionsEffectiveLimit tests the default timeline limit
+func TestTimelineOptionsEffectiveLimit(t *testing.T) {
+	assert.Equal(t, DefaultTimelineLimit, TimelineOptions{}.EffectiveLimit())
+	assert.Equal(t, DefaultTimelineLimit, TimelineOptions{Limit: -1}.EffectiveLimit())
+	assert.Equal(t, 25, TimelineOptions{Limit: 25}.EffectiveLimit())
+}
+
+// TestTimelineEntryKind tests the kind tag of timeline entries
+func TestTimelineEntryKind(t *testing.T) {
+	entry := TimelineEntry{
+		Document:   Document{Metadata: map[string]any{MetadataDocumentType: "commit"}},
+		SourceType: "github",
+	}
+	assert.Equal(t, "commit", entry.Kind())
+
+	entry.Document.Metadata = map[string]any{MetadataDocumentType: 42}
+	assert.Equal(t, "github", entry.Kind())
+
+	entry.Document.Metadata = nil
+	assert.Equal(t, "github", entry.Kind())
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// WarmUpState describes whether the vector index has been pre-loaded into memory.
+type WarmUpState string
+
+// Warm-up states.
+const (
+	// WarmUpUnavailable means no vector index is loaded.
+	WarmUpUnavailable WarmUpState = "unavailable"
+
+	// WarmUpDisabled means the index is loaded but warm-up was not requested.
+	WarmUpDisabled WarmUpState = "disabled"
+
+	// WarmUpRunning means the warm-up search is in progress.
+	WarmUpRunning WarmUpState = "running"
+
+	// WarmUpDone means the index pages have been loaded.
+	WarmUpDone WarmUpState = "warm"
+
+	// WarmUpFailed means the warm-up search returned an error.
+	WarmUpFailed WarmUpState = "failed"
+)
+
+// WarmUpStatus reports the progress of a vector index warm-up.
+type WarmUpStatus struct {
+	// State is the current warm-up state.
+	State WarmUpState
+
+	// Duration is how long the warm-up took. Zero until it finishes.
+	Duration time.Duration
+
+	// Error describes why the warm-up failed. Empty unless State is WarmUpFailed.
+	Error string
+}
+
+// IsWarm returns true if the index pages have been loaded.
+func (s WarmUpStatus) IsWarm() bool {
+	return s.State == WarmUpDone
+}
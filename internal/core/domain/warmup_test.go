@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarmUpStatus_IsWarm(t *testing.T) {
+	assert.True(t, WarmUpStatus{State: WarmUpDone}.IsWarm())
+	assert.False(t, WarmUpStatus{State: WarmUpRunning}.IsWarm())
+	assert.False(t, WarmUpStatus{State: WarmUpFailed, Error: "closed"}.IsWarm())
+	assert.False(t, WarmUpStatus{}.IsWarm())
+}
@@ -0,0 +1,11 @@
+package driven
+
+import "context"
+
+// ChunkLister is implemented by search engines and vector indexes that can
+// list the chunks they hold. It lets integrity checks compare an index with
+// the document store; indexes without it are skipped by those checks.
+type ChunkLister interface {
+	// ChunkIDs returns the ID of every chunk in the index, in no particular order.
+	ChunkIDs(ctx context.Context) ([]string, error)
+}
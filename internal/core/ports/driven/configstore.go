@@ -31,8 +31,14 @@ type ConfigStore interface {
 	Save() error
 
 	// Load reads configuration from storage.
+	// Returns *domain.ConfigValidationError if the stored values are invalid;
+	// they are still loaded so callers can fall back to defaults.
 	Load() error
 
+	// Validate returns the problems found when the configuration was last loaded.
+	// Returns nil if the configuration is valid.
+	Validate() error
+
 	// Path returns the configuration file path.
 	Path() string
 }
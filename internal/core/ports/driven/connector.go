@@ -99,10 +99,20 @@ type ConnectorCapabilities struct {
 	SupportsPagination bool
 }
 
+// ContentTypeAware is implemented by connectors that can skip fetching the
+// content of documents no normaliser supports. Such documents are still
+// emitted, without content, so the sync records them as skipped.
+type ContentTypeAware interface {
+	// SetContentTypeFilter sets the check for whether a MIME type is supported.
+	SetContentTypeFilter(supported func(mimeType string) bool)
+}
+
 // SyncComplete is sent on the error channel when sync completes successfully.
 // Carries the new cursor state for incremental sync.
 type SyncComplete struct {
 	NewCursor string
+	// NewSubCursors replaces the source's sub-resource cursors (see domain.SyncState.SubCursors).
+	NewSubCursors map[string]string
 }
 
 // Error implements the error interface.
@@ -19,6 +19,8 @@
 //   - AuthorizationStore: Authorization/credentials persistence
 //   - ConfigStore: Application configuration
 //   - SearchEngine: Full-text search (Xapian). BM25 keyword search is always required.
+//     Only safe mode starts without it, when it fails to open; syncing, pruning
+//     and index repair then return domain.ErrSearchUnavailable.
 //
 // # Optional Interfaces
 //
@@ -27,6 +29,8 @@
 //   - VectorIndex: Vector storage/search (HNSWlib). Only enabled when EmbeddingService is configured.
 //   - EmbeddingService: Generates vector embeddings. Without it, VectorIndex is also disabled.
 //   - LLMService: Language model operations. Without it, query rewriting/summarisation is disabled.
+//   - ChunkLister: Lists the chunks in a SearchEngine or VectorIndex. Without it, integrity checks skip that index.
+//   - SyncChangeLogStore: Change logs of the last sync of each source. Without it, syncs cannot be undone.
 //
 // # Import Rules
 //
@@ -13,20 +13,60 @@ type DocumentStore interface {
 	SaveDocument(ctx context.Context, doc *domain.Document) error
 
 	// SaveChunks stores chunks for a document.
+	// Chunks are upserted by ID; existing chunks not in the batch are kept.
 	SaveChunks(ctx context.Context, chunks []domain.Chunk) error
 
+	// SaveBatch stores or updates several documents and their chunks in a
+	// single write. Either everything in the batch is saved or nothing is.
+	// Chunks are upserted as in SaveChunks.
+	SaveBatch(ctx context.Context, docs []domain.Document, chunks []domain.Chunk) error
+
 	// GetDocument retrieves a document by ID.
 	GetDocument(ctx context.Context, id string) (*domain.Document, error)
 
-	// GetChunks retrieves all chunks for a document.
+	// GetDocumentByURI retrieves the most recently updated document with the
+	// URI in a source. Returns domain.ErrNotFound if there is none.
+	GetDocumentByURI(ctx context.Context, sourceID, uri string) (*domain.Document, error)
+
+	// GetChunks retrieves all chunks for a document, ordered by position.
 	GetChunks(ctx context.Context, documentID string) ([]domain.Chunk, error)
 
 	// GetChunk retrieves a specific chunk by ID.
 	GetChunk(ctx context.Context, id string) (*domain.Chunk, error)
 
+	// UpdateChunkEmbedding sets the embedding of an existing chunk and leaves
+	// the rest of the chunk as stored. The chunk is never created: returns
+	// domain.ErrNotFound if it does not exist.
+	UpdateChunkEmbedding(ctx context.Context, chunkID string, embedding []float32) error
+
 	// DeleteDocument removes a document and its chunks.
 	DeleteDocument(ctx context.Context, id string) error
 
-	// ListDocuments returns documents for a source.
+	// ListDocuments returns documents for a source in insertion order.
 	ListDocuments(ctx context.Context, sourceID string) ([]domain.Document, error)
+
+	// ListDocumentsPaginated returns up to limit documents for a source in
+	// insertion order, starting at offset, with the source's total document count.
+	// A limit of zero or less returns every document from offset.
+	ListDocumentsPaginated(
+		ctx context.Context, sourceID string, offset, limit int,
+	) ([]domain.Document, int64, error)
+
+	// ListRecentDocuments returns documents across sources last updated
+	// within the options' window, most recently updated first, up to the
+	// options' effective limit.
+	ListRecentDocuments(ctx context.Context, opts domain.TimelineOptions) ([]domain.Document, error)
+
+	// CountBySource returns the number of documents stored for a source.
+	CountBySource(ctx context.Context, sourceID string) (int, error)
+
+	// SaveOriginal stores the original bytes a document was normalised from,
+	// replacing any stored before; nil content removes them. Saving the
+	// document again with different content also removes them, so a stale
+	// original is never shown. Returns domain.ErrNotFound for unknown documents.
+	SaveOriginal(ctx context.Context, documentID string, content []byte) error
+
+	// GetOriginal retrieves the original bytes of a document.
+	// Returns domain.ErrNotFound if none are stored.
+	GetOriginal(ctx context.Context, documentID string) ([]byte, error)
 }
@@ -0,0 +1,38 @@
+package driven
+
+import (
+	"context"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// EmbeddingJobStore persists the background embedding queue.
+// Jobs survive restarts, so chunks saved during sync are embedded later
+// even if the process exits first.
+type EmbeddingJobStore interface {
+	// Enqueue adds a pending job for each chunk.
+	// Chunks that already have a pending job are not queued twice.
+	Enqueue(ctx context.Context, chunkIDs []string) error
+
+	// Claim marks up to limit of the oldest pending jobs as running and returns them.
+	// Jobs waiting to be retried are not claimed before their RetryAt.
+	Claim(ctx context.Context, limit int) ([]domain.EmbeddingJob, error)
+
+	// SetStatus updates the status of a job.
+	SetStatus(ctx context.Context, jobID int64, status domain.EmbeddingJobStatus) error
+
+	// Retry returns a job whose attempt failed to the queue, counting the
+	// attempt. The job is not claimed again before retryAt.
+	Retry(ctx context.Context, jobID int64, retryAt time.Time) error
+
+	// CountPending returns the number of pending and running jobs.
+	CountPending(ctx context.Context) (int, error)
+
+	// Stats counts the pending, retrying and failed jobs.
+	Stats(ctx context.Context) (domain.EmbeddingQueueStats, error)
+
+	// ResetRunning returns running jobs to pending.
+	// Used on startup to recover jobs claimed by a process that exited.
+	ResetRunning(ctx context.Context) error
+}
@@ -9,9 +9,13 @@ import (
 // ExclusionStore persists document exclusions.
 // Excluded documents are skipped during re-sync operations.
 type ExclusionStore interface {
-	// Add creates a new exclusion.
+	// Add creates a new exclusion. Exclusion IDs are unique.
 	Add(ctx context.Context, exclusion *domain.Exclusion) error
 
+	// AddMany creates several exclusions atomically.
+	// Either all exclusions are stored or none are.
+	AddMany(ctx context.Context, exclusions []*domain.Exclusion) error
+
 	// Remove deletes an exclusion by ID.
 	Remove(ctx context.Context, id string) error
 
@@ -23,4 +27,11 @@ type ExclusionStore interface {
 
 	// List returns all exclusions.
 	List(ctx context.Context) ([]domain.Exclusion, error)
+
+	// RecordFailure records a processing failure for a URI and returns the
+	// number of consecutive failures, including this one.
+	RecordFailure(ctx context.Context, sourceID, uri, reason string) (int, error)
+
+	// ClearFailures resets the consecutive failure count for a URI.
+	ClearFailures(ctx context.Context, sourceID, uri string) error
 }
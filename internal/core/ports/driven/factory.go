@@ -36,6 +36,11 @@ type ConnectorFactory interface {
 	// SupportedTypes returns all registered connector types.
 	SupportedTypes() []string
 
+	// Version returns the schema version of a connector type's sync state,
+	// such as "2.0.0". It changes when the connector's cursor format does.
+	// Returns empty string for unversioned or unknown connector types.
+	Version(connectorType string) string
+
 	// === OAuth Methods ===
 
 	// BuildAuthURL constructs the OAuth authorization URL for a connector type.
@@ -51,10 +56,10 @@ type ConnectorFactory interface {
 	// Returns error if the connector type doesn't support OAuth.
 	RefreshToken(ctx context.Context, connectorType string, authProvider *domain.AuthProvider, refreshToken string) (*domain.OAuthToken, error)
 
-	// GetUserInfo fetches the account identifier (email/username) for a connector type.
+	// GetUserInfo fetches the authenticated account's details for a connector type.
 	// Used to identify which account was authenticated.
 	// Returns error if the connector type doesn't support OAuth.
-	GetUserInfo(ctx context.Context, connectorType string, accessToken string) (string, error)
+	GetUserInfo(ctx context.Context, connectorType string, accessToken string) (domain.AccountInfo, error)
 
 	// GetDefaultOAuthConfig returns default OAuth URLs and scopes for a connector type.
 	// Returns nil if the connector type doesn't support OAuth.
@@ -0,0 +1,26 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// FeedbackStore persists relevance feedback on search results.
+// Each query and document pair holds at most one signal.
+type FeedbackStore interface {
+	// Record saves feedback, replacing any earlier signal for the same
+	// query and document.
+	Record(ctx context.Context, feedback *domain.Feedback) error
+
+	// Remove deletes the feedback for a query and document.
+	// Removing feedback that does not exist is not an error.
+	Remove(ctx context.Context, query, documentID string) error
+
+	// ForDocuments returns all feedback given on the listed documents,
+	// for any query.
+	ForDocuments(ctx context.Context, documentIDs []string) ([]domain.Feedback, error)
+
+	// Clear deletes all feedback.
+	Clear(ctx context.Context) error
+}
@@ -1,7 +1,10 @@
 // Package driven provides interfaces for infrastructure adapters (secondary/outbound ports).
 package driven
 
-import "context"
+import (
+	"context"
+	"strings"
+)
 
 // LLMService provides language model operations for query and document understanding.
 // This is an optional service - when nil, features degrade gracefully to keyword-only search.
@@ -25,6 +28,10 @@ type LLMService interface {
 	// Summarise creates a summary of document content.
 	Summarise(ctx context.Context, content string, maxLength int) (string, error)
 
+	// ExtractKeywords returns up to maxKeywords keyphrases and named entities
+	// that describe the content, including concepts it discusses without naming.
+	ExtractKeywords(ctx context.Context, content string, maxKeywords int) ([]string, error)
+
 	// ModelName returns the name of the LLM model being used.
 	ModelName() string
 
@@ -65,3 +72,32 @@ type ChatOptions struct {
 	// Temperature controls randomness (0.0 = deterministic, 1.0 = creative).
 	Temperature float64
 }
+
+// ParseKeywordList splits an LLM keyword response into a clean, de-duplicated list.
+// Keywords may be separated by commas or newlines and may carry list markers.
+func ParseKeywordList(response string, maxKeywords int) []string {
+	fields := strings.FieldsFunc(response, func(r rune) bool {
+		return r == ',' || r == '\n' || r == ';'
+	})
+
+	seen := make(map[string]bool, len(fields))
+	keywords := make([]string, 0, len(fields))
+	for _, field := range fields {
+		keyword := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(field), "-*•0123456789.)"))
+		keyword = strings.Trim(keyword, "\"'`")
+		if keyword == "" {
+			continue
+		}
+		key := strings.ToLower(keyword)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keywords = append(keywords, keyword)
+		if maxKeywords > 0 && len(keywords) >= maxKeywords {
+			break
+		}
+	}
+
+	return keywords
+}
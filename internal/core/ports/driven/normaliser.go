@@ -26,6 +26,16 @@ type Normaliser interface {
 	Normalise(ctx context.Context, raw *domain.RawDocument) (*NormaliseResult, error)
 }
 
+// AppendingNormaliser is implemented by normalisers that can add a partial
+// raw document (see domain.MetadataPartial) to a document they produced
+// earlier, keeping the existing content rather than rebuilding it.
+type AppendingNormaliser interface {
+	Normaliser
+
+	// Append merges the partial raw document into the existing document.
+	Append(ctx context.Context, existing *domain.Document, raw *domain.RawDocument) (*NormaliseResult, error)
+}
+
 // NormaliseResult contains the output of normalisation.
 // Note: Normalisation only produces a Document with Content.
 // Chunking is handled by the PostProcessor pipeline.
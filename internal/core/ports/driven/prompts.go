@@ -26,6 +26,14 @@ const (
 	// The prompt template expects %d (max length) and %s (content) placeholders.
 	PromptSummarise = "summarise"
 
+	// PromptExtractKeywords extracts keyphrases and entities for document enrichment.
+	// The prompt template expects %d (max keywords) and %s (content) placeholders.
+	PromptExtractKeywords = "extract_keywords"
+
+	// PromptRerank rates search candidates for relevance to a query.
+	// The prompt template expects %s (query) and %s (numbered passages) placeholders.
+	PromptRerank = "rerank"
+
 	// PromptChatSystem is the system prompt for conversational search mode.
 	// This prompt has no format placeholders.
 	PromptChatSystem = "chat_system"
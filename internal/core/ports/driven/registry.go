@@ -14,9 +14,18 @@ type NormaliserRegistry interface {
 	// Selection priority: connector-specific > MIME-specific > fallback.
 	Normalise(ctx context.Context, raw *domain.RawDocument) (*NormaliseResult, error)
 
+	// Append merges a partial raw document into an existing document using
+	// the best matching normaliser that implements AppendingNormaliser.
+	// Returns an error wrapping domain.ErrNotImplemented when none does.
+	Append(ctx context.Context, existing *domain.Document, raw *domain.RawDocument) (*NormaliseResult, error)
+
 	// Register adds a normaliser to the registry.
 	Register(normaliser Normaliser)
 
 	// SupportedMIMETypes returns all MIME types that can be normalised.
 	SupportedMIMETypes() []string
+
+	// Supports reports whether a normaliser is registered for the MIME type.
+	// Connectors use it to skip fetching content that could not be normalised.
+	Supports(mimeType string) bool
 }
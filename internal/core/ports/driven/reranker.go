@@ -0,0 +1,17 @@
+package driven
+
+import "context"
+
+// Reranker scores how relevant search candidates are to a query, so search
+// can reorder its top results after retrieval. This is an optional service -
+// when nil, results keep their fused ranking.
+//
+// Implementations may include:
+//   - An LLM prompted to rate each candidate
+//   - A cross-encoder served behind a rerank endpoint
+type Reranker interface {
+	// Rerank returns a relevance score for each passage, in the order given.
+	// Higher scores are more relevant. Scores are only comparable within
+	// a single call.
+	Rerank(ctx context.Context, query string, passages []string) ([]float64, error)
+}
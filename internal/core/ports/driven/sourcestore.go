@@ -17,6 +17,6 @@ type SourceStore interface {
 	// Delete removes a source.
 	Delete(ctx context.Context, id string) error
 
-	// List returns all configured sources.
+	// List returns all configured sources in insertion order.
 	List(ctx context.Context) ([]domain.Source, error)
 }
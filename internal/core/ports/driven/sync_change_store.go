@@ -0,0 +1,22 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// SyncChangeLogStore persists the change log of each source's most recent
+// sync, so that sync can be undone. It holds at most one log per source.
+type SyncChangeLogStore interface {
+	// Save stores a log, replacing the source's previous log.
+	Save(ctx context.Context, log *domain.SyncChangeLog) error
+
+	// Get retrieves the log for a source.
+	// Returns domain.ErrNotFound if there is none.
+	Get(ctx context.Context, sourceID string) (*domain.SyncChangeLog, error)
+
+	// Delete removes the log for a source.
+	// Deleting a log that does not exist is not an error.
+	Delete(ctx context.Context, sourceID string) error
+}
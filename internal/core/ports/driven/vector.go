@@ -14,6 +14,11 @@ type VectorIndex interface {
 	// Search finds the k nearest neighbours to the query vector.
 	Search(ctx context.Context, query []float32, k int) ([]VectorHit, error)
 
+	// SearchWithFilter finds the k nearest neighbours among the candidate chunk IDs.
+	// Vectors outside the candidate set are never returned, so callers can
+	// pre-filter by metadata instead of over-fetching and discarding hits.
+	SearchWithFilter(ctx context.Context, query []float32, k int, candidates []string) ([]VectorHit, error)
+
 	// Close releases resources.
 	Close() error
 }
@@ -24,5 +29,29 @@ type VectorHit struct {
 	ChunkID string
 
 	// Similarity is the cosine similarity score (0-1).
+	// Indexes using VectorMetricL2 leave it unset and report Distance.
 	Similarity float64
+
+	// Distance is the Euclidean distance to the query vector, reported by
+	// indexes using VectorMetricL2.
+	Distance float64
+}
+
+// VectorMetric is the measure a vector index compares embeddings by.
+type VectorMetric string
+
+const (
+	// VectorMetricCosine ranks hits by cosine similarity, highest first.
+	VectorMetricCosine VectorMetric = "cosine"
+
+	// VectorMetricL2 ranks hits by Euclidean distance, lowest first.
+	VectorMetricL2 VectorMetric = "l2"
+)
+
+// MetricReporter is implemented by vector indexes that report the metric
+// their hits are scored with. Indexes without it are assumed to use
+// VectorMetricCosine.
+type MetricReporter interface {
+	// Metric returns the metric the index compares embeddings by.
+	Metric() VectorMetric
 }
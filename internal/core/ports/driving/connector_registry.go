@@ -30,6 +30,11 @@ type ConnectorRegistry interface {
 	// Returns empty slice if provider has no connectors.
 	GetConnectorsForProvider(provider domain.ProviderType) []domain.ConnectorType
 
+	// ListByProvider returns the connector types of a provider ordered by name,
+	// for listing a provider's connectors together.
+	// Returns empty slice if provider has no connectors.
+	ListByProvider(providerType domain.ProviderType) []domain.ConnectorType
+
 	// ValidateConfig validates configuration for a connector type.
 	// Returns ErrNotFound if connector doesn't exist, ErrInvalidInput if validation fails.
 	ValidateConfig(connectorID string, config map[string]string) error
@@ -45,9 +50,9 @@ type ConnectorRegistry interface {
 	// Includes provider-specific parameters (e.g., access_type=offline for Google).
 	BuildAuthURL(connectorType string, authProvider *domain.AuthProvider, redirectURI, state, codeChallenge string) (string, error)
 
-	// GetUserInfo fetches the account identifier (email/username) for a connector type.
+	// GetUserInfo fetches the authenticated account's details for a connector type.
 	// Used to identify which account was authenticated.
-	GetUserInfo(ctx context.Context, connectorType string, accessToken string) (string, error)
+	GetUserInfo(ctx context.Context, connectorType string, accessToken string) (domain.AccountInfo, error)
 
 	// GetSetupHint returns guidance text for setting up OAuth/PAT with a provider.
 	// Returns empty string if no hint is available.
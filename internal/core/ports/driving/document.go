@@ -18,12 +18,40 @@ type DocumentService interface {
 	// GetContent returns the concatenated content of all chunks.
 	GetContent(ctx context.Context, documentID string) (string, error)
 
+	// GetOriginal returns the original bytes the document was normalised from.
+	// Returns domain.ErrNotFound unless originals were kept when it was synced.
+	GetOriginal(ctx context.Context, documentID string) ([]byte, error)
+
+	// GetChunks returns the document's chunks ordered by position.
+	GetChunks(ctx context.Context, documentID string) ([]domain.Chunk, error)
+
 	// GetDetails returns connector-agnostic metadata for display.
 	GetDetails(ctx context.Context, documentID string) (*DocumentDetails, error)
 
+	// GetAncestors returns the document's ancestors, outermost first.
+	// Documents from sources without a hierarchy have none.
+	GetAncestors(ctx context.Context, documentID string) (domain.BreadcrumbTrail, error)
+
 	// Exclude removes a document and marks it to skip during re-sync.
 	Exclude(ctx context.Context, documentID, reason string) error
 
+	// ExcludeMany excludes several documents in one batch with a structured reason.
+	// Returns the number of documents excluded.
+	ExcludeMany(ctx context.Context, documentIDs []string, category domain.ExclusionReason, reason string) (int, error)
+
+	// ExcludePattern excludes every document of a source whose URI matches a
+	// pattern such as "prefix:file:///home/user/node_modules", now and on
+	// future syncs. Returns the number of indexed documents removed.
+	ExcludePattern(
+		ctx context.Context, sourceID, pattern string, category domain.ExclusionReason, reason string,
+	) (int, error)
+
+	// ListQuarantined returns documents quarantined after repeated failures for a source.
+	ListQuarantined(ctx context.Context, sourceID string) ([]domain.Exclusion, error)
+
+	// RetryQuarantined lifts a quarantine so the document is processed on the next sync.
+	RetryQuarantined(ctx context.Context, exclusionID string) error
+
 	// Refresh re-syncs a single document from its source.
 	Refresh(ctx context.Context, documentID string) error
 
@@ -62,4 +90,7 @@ type DocumentDetails struct {
 
 	// Metadata contains flattened key-value pairs for display.
 	Metadata map[string]string
+
+	// Ancestors is where the document sits in its source, outermost first.
+	Ancestors domain.BreadcrumbTrail
 }
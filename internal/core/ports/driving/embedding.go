@@ -0,0 +1,25 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// EmbeddingQueue processes chunks queued for embedding in the background.
+type EmbeddingQueue interface {
+	// Start processes queued embeddings.
+	// Blocks until context is cancelled.
+	Start(ctx context.Context) error
+
+	// Pending returns the number of chunks waiting for an embedding.
+	Pending(ctx context.Context) (int, error)
+
+	// Stats counts the chunks waiting for an embedding, waiting to be
+	// retried after a failure and given up on.
+	Stats(ctx context.Context) (domain.EmbeddingQueueStats, error)
+
+	// Wait processes queued embeddings and blocks until the queue is empty.
+	// Chunks waiting to be retried are left to the background worker.
+	Wait(ctx context.Context) error
+}
@@ -0,0 +1,21 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// FeedbackService records whether search results were useful.
+// Feedback is kept locally and boosts or penalises documents in later
+// searches for similar queries.
+type FeedbackService interface {
+	// Rate records a signal for a document returned for a query.
+	Rate(ctx context.Context, query, documentID string, signal domain.FeedbackSignal) error
+
+	// Unrate removes the feedback for a document returned for a query.
+	Unrate(ctx context.Context, query, documentID string) error
+
+	// Clear deletes all recorded feedback.
+	Clear(ctx context.Context) error
+}
@@ -0,0 +1,20 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// IntegrityService checks that the keyword and vector indexes agree with
+// the document store.
+type IntegrityService interface {
+	// Verify cross-checks every non-excluded document against the indexes
+	// and reports missing and orphaned index entries.
+	Verify(ctx context.Context) (*domain.IntegrityReport, error)
+
+	// Repair fixes the issues in a report: documents with missing entries
+	// are reindexed from the document store and orphaned entries are deleted.
+	// Returns the number of issues repaired.
+	Repair(ctx context.Context, report *domain.IntegrityReport) (int, error)
+}
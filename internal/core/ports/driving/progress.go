@@ -0,0 +1,42 @@
+package driving
+
+// Phases reported by IndexProgressReporter.OnDocumentProcessed.
+const (
+	// IndexPhaseNormalised means the document was converted to text.
+	IndexPhaseNormalised = "normalised"
+
+	// IndexPhaseChunked means the document was split into chunks.
+	IndexPhaseChunked = "chunked"
+
+	// IndexPhaseStored means the document and its chunks were saved.
+	IndexPhaseStored = "stored"
+
+	// IndexPhaseIndexed means the chunks were added to the search indexes.
+	IndexPhaseIndexed = "indexed"
+
+	// IndexPhaseDeleted means the document was removed from the index.
+	IndexPhaseDeleted = "deleted"
+
+	// IndexPhaseEmbedded labels OnChunkEmbedded events for consumers that
+	// merge both kinds of event into one stream.
+	IndexPhaseEmbedded = "embedded"
+)
+
+// IndexProgressReporter receives progress events while documents are indexed.
+// Calls are made from the syncing goroutine, so implementations must return
+// quickly and be safe for concurrent use when several sources sync at once.
+type IndexProgressReporter interface {
+	// OnDocumentProcessed is called when a document completes a phase.
+	OnDocumentProcessed(sourceID, docID, phase string)
+
+	// OnChunkEmbedded is called when a chunk's embedding is generated during sync.
+	// Chunks queued for the background embedding worker are not reported.
+	OnChunkEmbedded(sourceID, chunkID string)
+}
+
+// ProgressReportingSync is implemented by sync orchestrators that can report
+// indexing progress as it happens.
+type ProgressReportingSync interface {
+	// SetProgressReporter sets the reporter that receives progress events.
+	SetProgressReporter(reporter IndexProgressReporter)
+}
@@ -10,4 +10,8 @@ import (
 type SearchService interface {
 	// Search performs hybrid search across all indexed documents.
 	Search(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.SearchResult, error)
+
+	// SearchByChunk performs the same search but returns the highest-scoring
+	// chunk of each matching document, so callers can show which part matched.
+	SearchByChunk(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.ChunkSearchResult, error)
 }
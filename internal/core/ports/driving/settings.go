@@ -16,6 +16,10 @@ type SettingsService interface {
 	// SetEmbeddingProvider configures the embedding provider.
 	SetEmbeddingProvider(provider domain.AIProvider, model, apiKey string) error
 
+	// SetEmbeddingEndpoint sets the endpoint and extra request headers of the
+	// embedding provider. Headers are written as "Name: value".
+	SetEmbeddingEndpoint(baseURL string, headers []string) error
+
 	// SetLLMProvider configures the LLM provider.
 	SetLLMProvider(provider domain.AIProvider, model, apiKey string) error
 
@@ -36,4 +40,8 @@ type SettingsService interface {
 
 	// ValidateLLMConfig validates the current LLM configuration by pinging the provider.
 	ValidateLLMConfig() error
+
+	// ValidateConfig checks the configuration file against its schema.
+	// Returns *domain.ConfigValidationError listing every invalid entry.
+	ValidateConfig() error
 }
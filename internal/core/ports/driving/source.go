@@ -23,7 +23,34 @@ type SourceService interface {
 	// Remove deletes a source and its indexed data.
 	Remove(ctx context.Context, id string) error
 
+	// Rename changes the display name of a source. It returns the other
+	// sources that already have the name, which are still renamed to, so
+	// callers can warn that the name no longer identifies one source.
+	Rename(ctx context.Context, id, newName string) ([]domain.Source, error)
+
+	// Archive stops scheduled and sync --all syncs of a source while keeping
+	// its documents searchable.
+	Archive(ctx context.Context, id string) error
+
+	// Unarchive returns an archived source to scheduled syncs.
+	Unarchive(ctx context.Context, id string) error
+
+	// SetCollection moves a source into the named collection, or out of any
+	// collection when the name is empty.
+	SetCollection(ctx context.Context, id, collection string) error
+
+	// Collections returns the collections the sources are grouped in.
+	Collections(ctx context.Context) ([]domain.Collection, error)
+
+	// RenameCollection renames a collection on every source in it and
+	// returns how many sources were moved. Renaming to the name of another
+	// collection merges the two.
+	RenameCollection(ctx context.Context, name, newName string) (int, error)
+
 	// ValidateConfig validates source configuration for a connector type.
 	// Returns an error if required fields are missing or invalid.
 	ValidateConfig(ctx context.Context, connectorType string, config map[string]string) error
+
+	// Statuses returns the sync status and document count of every source.
+	Statuses(ctx context.Context) ([]domain.SourceStatus, error)
 }
@@ -0,0 +1,14 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// StatusService summarises the sources and indexes for a status overview.
+type StatusService interface {
+	// Status counts the sources, documents and indexed chunks, and checks
+	// every source's credentials. It changes nothing.
+	Status(ctx context.Context) (*domain.SystemStatus, error)
+}
@@ -1,6 +1,11 @@
 package driving
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
 
 // SyncOrchestrator coordinates document synchronisation from sources.
 type SyncOrchestrator interface {
@@ -10,10 +15,102 @@ type SyncOrchestrator interface {
 	// SyncAll triggers synchronisation for all configured sources.
 	SyncAll(ctx context.Context) error
 
+	// SyncSince synchronises a source, fetching items changed after since
+	// instead of after the stored cursor. The stored cursor is not moved back.
+	SyncSince(ctx context.Context, sourceID string, since time.Time) error
+
+	// SyncAllSince runs SyncSince for all configured sources.
+	SyncAllSince(ctx context.Context, since time.Time) error
+
 	// Status returns sync status for a source.
 	Status(ctx context.Context, sourceID string) (*SyncStatus, error)
 }
 
+// PolicyConfigurableSync is implemented by sync orchestrators whose handling
+// of document errors can be chosen per run.
+type PolicyConfigurableSync interface {
+	// SetSyncPolicy sets the error policy for syncs started after the call.
+	SetSyncPolicy(policy domain.SyncPolicy)
+}
+
+// PruningSync is implemented by sync orchestrators that can delete documents
+// older than a source's retention window, set with the "retain" source config.
+type PruningSync interface {
+	// Prune deletes the source's documents not updated within its retention
+	// window and returns how many were deleted. It fails for sources
+	// without a retention window.
+	Prune(ctx context.Context, sourceID string) (int, error)
+
+	// PruneAll prunes every source with a retention window.
+	PruneAll(ctx context.Context) (int, error)
+}
+
+// UndoableSync is implemented by sync orchestrators that log the changes of
+// each source's last sync so it can be reversed.
+type UndoableSync interface {
+	// UndoLastSync deletes the documents the source's last sync created,
+	// restores those it updated or deleted, and moves its sync position
+	// back. It fails with domain.ErrNotFound when there is no sync to undo
+	// and domain.ErrSyncNotUndoable when the sync changed too many documents.
+	UndoLastSync(ctx context.Context, sourceID string) (*domain.SyncUndoResult, error)
+}
+
+// ScopedSync is implemented by sync orchestrators that can sync part of a
+// source, such as one repository of a GitHub source.
+type ScopedSync interface {
+	// SyncScope runs an incremental sync of a source limited to the named
+	// sub-resources (see domain.SyncState.Scope). Cursors of sub-resources
+	// outside the scope are kept. Sources without a stored cursor run a
+	// full sync as usual.
+	SyncScope(ctx context.Context, sourceID string, scope []string) error
+}
+
+// PreviewingSync is implemented by sync orchestrators that can show what a
+// sync would change without storing anything.
+type PreviewingSync interface {
+	// DryRun fetches a source's changes as a sync would and calls fn with the
+	// change to each document instead of indexing it. Nothing is stored and
+	// the sync position is not moved. Unchanged documents are reported too.
+	DryRun(ctx context.Context, sourceID string, fn func(*DocumentDiff) error) error
+
+	// DiffDocument compares an incoming document with the stored document
+	// of the same URI.
+	DiffDocument(ctx context.Context, raw *domain.RawDocument) (*DocumentDiff, error)
+}
+
+// DocumentChange is how a sync would change a stored document.
+type DocumentChange string
+
+const (
+	// DocumentNew is a document that is not stored yet.
+	DocumentNew DocumentChange = "new"
+
+	// DocumentChanged is a stored document whose content would change.
+	DocumentChanged DocumentChange = "changed"
+
+	// DocumentUnchanged is a stored document whose content would not change.
+	DocumentUnchanged DocumentChange = "unchanged"
+
+	// DocumentDeleted is a stored document that would be deleted.
+	DocumentDeleted DocumentChange = "deleted"
+)
+
+// DocumentDiff describes the change a sync would make to one document.
+type DocumentDiff struct {
+	// SourceID identifies the source.
+	SourceID string
+
+	// URI identifies the document within the source.
+	URI string
+
+	// Change is how the document would change.
+	Change DocumentChange
+
+	// Diff is a unified diff from the stored to the incoming content.
+	// Empty when the content is unchanged.
+	Diff string
+}
+
 // SyncStatus represents the current state of a sync operation.
 type SyncStatus struct {
 	// SourceID identifies the source.
@@ -27,4 +124,13 @@ type SyncStatus struct {
 
 	// ErrorCount is the number of errors encountered.
 	ErrorCount int
+
+	// DocumentsSkipped is the count of documents skipped because no
+	// normaliser supports their type. They are not counted as errors.
+	DocumentsSkipped int
+
+	// DocumentsTooShort is the count of documents whose content is shorter
+	// than the configured minimum length. They are skipped, or indexed by
+	// name only, and are not counted in DocumentsSkipped.
+	DocumentsTooShort int
 }
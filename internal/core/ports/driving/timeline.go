@@ -0,0 +1,15 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// TimelineService lists recently updated documents across every source,
+// as a chronological feed distinct from search.
+type TimelineService interface {
+	// Timeline returns the documents last updated within the options'
+	// window, most recently updated first, tagged with their source.
+	Timeline(ctx context.Context, opts domain.TimelineOptions) ([]domain.TimelineEntry, error)
+}
@@ -0,0 +1,21 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// IndexWarmer pre-loads the vector index into memory so the first search is fast.
+type IndexWarmer interface {
+	// Start begins the warm-up in the background and returns immediately.
+	// Does nothing if there is no vector index or a warm-up has already started.
+	Start(ctx context.Context)
+
+	// Wait blocks until a started warm-up finishes or the context is done.
+	// Returns immediately if no warm-up was started.
+	Wait(ctx context.Context) error
+
+	// Status reports the warm-up state.
+	Status() domain.WarmUpStatus
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+	"github.com/custodia-labs/sercha-cli/internal/platform"
 )
 
 // Operating system identifiers.
@@ -60,7 +61,7 @@ func (s *ResultActionService) OpenDocument(ctx context.Context, result *domain.S
 	openableURL := s.resolveWebURL(ctx, &result.Document)
 
 	// Open the resolved URL
-	return openURL(openableURL)
+	return platform.OpenURI(openableURL)
 }
 
 // resolveWebURL converts a document URI to an openable URL using the connector's resolver.
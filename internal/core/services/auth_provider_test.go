@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestAuthProviderService_SaveGetList(t *testing.T) {
+	service := NewAuthProviderService(memory.NewAuthProviderStore(), memory.NewSourceStore())
+	ctx := context.Background()
+
+	require.NoError(t, service.Save(ctx, domain.AuthProvider{ID: "ap-1", ProviderType: domain.ProviderGitHub}))
+	require.NoError(t, service.Save(ctx, domain.AuthProvider{ID: "ap-2", ProviderType: domain.ProviderGoogle}))
+
+	provider, err := service.Get(ctx, "ap-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProviderGitHub, provider.ProviderType)
+
+	all, err := service.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	google, err := service.ListByProvider(ctx, domain.ProviderGoogle)
+	require.NoError(t, err)
+	require.Len(t, google, 1)
+	assert.Equal(t, "ap-2", google[0].ID)
+
+	assert.ErrorIs(t, service.Save(ctx, domain.AuthProvider{}), domain.ErrInvalidInput)
+}
+
+func TestAuthProviderService_Delete(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	service := NewAuthProviderService(memory.NewAuthProviderStore(), sourceStore)
+	ctx := context.Background()
+
+	require.NoError(t, service.Save(ctx, domain.AuthProvider{ID: "ap-1"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", AuthProviderID: "ap-1"}))
+
+	// Providers used by a source cannot be deleted
+	assert.ErrorIs(t, service.Delete(ctx, "ap-1"), domain.ErrAuthProviderInUse)
+
+	require.NoError(t, sourceStore.Delete(ctx, "src-1"))
+	require.NoError(t, service.Delete(ctx, "ap-1"))
+
+	_, err := service.Get(ctx, "ap-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestAuthProviderService_NilStore(t *testing.T) {
+	service := NewAuthProviderService(nil, nil)
+
+	assert.ErrorIs(t, service.Save(context.Background(), domain.AuthProvider{ID: "ap-1"}), domain.ErrNotImplemented)
+	assert.ErrorIs(t, service.Delete(context.Background(), "ap-1"), domain.ErrNotImplemented)
+}
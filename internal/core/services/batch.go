@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// pendingDocument is a normalised and chunked document waiting in a batch
+// to be saved to the document store and indexed.
+type pendingDocument struct {
+	uri          string // URI reported by the connector
+	doc          domain.Document
+	chunks       []domain.Chunk
+	original     []byte // raw bytes to keep, nil unless originals are kept
+	metadataOnly bool
+	tooShort     bool // indexed by name only for being shorter than the minimum length
+}
+
+// documentBatch buffers the documents of a single sync until they are
+// written to the document store together. It is owned by the goroutine
+// running the sync, so it needs no locking.
+//
+// The batch is flushed on that goroutine as soon as it is full, so a slow
+// store stops the sync from reading further documents and the connector
+// blocks on its channel instead of the buffer growing past its size.
+type documentBatch struct {
+	source  *domain.Source
+	status  *driving.SyncStatus
+	size    int
+	pending []pendingDocument
+}
+
+// newDocumentBatch creates an empty batch sized by the sync settings.
+func (o *SyncOrchestrator) newDocumentBatch(source *domain.Source, status *driving.SyncStatus) *documentBatch {
+	size := o.syncSettings.DocumentBatchSize()
+	return &documentBatch{
+		source:  source,
+		status:  status,
+		size:    size,
+		pending: make([]pendingDocument, 0, size),
+	}
+}
+
+// addToBatch prepares a document and adds it to the batch, flushing the batch
+// once it is full. Documents that fail to prepare are counted as failures.
+// It returns an error only when the sync must stop.
+func (o *SyncOrchestrator) addToBatch(ctx context.Context, batch *documentBatch, raw *domain.RawDocument) error {
+	pending, err := o.prepareDocument(ctx, batch.source, raw)
+	if err != nil {
+		return o.documentFailed(batch.status, raw.URI, err)
+	}
+	if pending == nil {
+		batch.status.DocumentsProcessed++ // Excluded
+		return nil
+	}
+	if pending.tooShort {
+		batch.status.DocumentsTooShort++
+		o.countTooShort(batch.source.ID)
+	}
+
+	batch.pending = append(batch.pending, *pending)
+	if len(batch.pending) >= batch.size {
+		return o.flushBatch(ctx, batch)
+	}
+	return nil
+}
+
+// flushBatch saves the buffered documents and their chunks in one write, then
+// indexes them. If the batch cannot be saved, each document is saved on its
+// own so that one bad document does not fail the rest. Failed documents are
+// handled by the sync policy; an error is returned only when the sync must stop.
+func (o *SyncOrchestrator) flushBatch(ctx context.Context, batch *documentBatch) error {
+	if len(batch.pending) == 0 {
+		return nil
+	}
+	defer func() { batch.pending = batch.pending[:0] }()
+
+	sourceID := batch.source.ID
+	docs := make([]domain.Document, len(batch.pending))
+	var chunks []domain.Chunk
+	for i := range batch.pending {
+		docs[i] = batch.pending[i].doc
+		chunks = append(chunks, batch.pending[i].chunks...)
+	}
+
+	if err := o.recordSaves(ctx, sourceID, docs); err != nil {
+		o.log.Warn("failed to log batch for undo", "source_id", sourceID, "error", err)
+	}
+
+	saved := true
+	if err := o.docStore.SaveBatch(ctx, docs, chunks); err != nil {
+		o.log.Warn("failed to save batch, saving documents one at a time",
+			"source_id", sourceID, "documents", len(docs), "error", err)
+		saved = false
+	} else {
+		for i := range docs {
+			o.progress.OnDocumentProcessed(sourceID, docs[i].ID, driving.IndexPhaseStored)
+		}
+	}
+
+	for i := range batch.pending {
+		pending := &batch.pending[i]
+		if !saved {
+			if err := o.saveDocument(ctx, sourceID, pending); err != nil {
+				if abortErr := o.documentFailed(batch.status, pending.uri, err); abortErr != nil {
+					return abortErr
+				}
+				continue
+			}
+		}
+		o.saveOriginal(ctx, pending)
+		if err := o.indexDocument(ctx, sourceID, pending); err != nil {
+			if abortErr := o.documentFailed(batch.status, pending.uri, err); abortErr != nil {
+				return abortErr
+			}
+			continue
+		}
+		batch.status.DocumentsProcessed++
+	}
+	return nil
+}
+
+// abortBatch ends a sync stopped by cause, a cancellation or connector error.
+// Under the continue policy the buffered documents are still flushed, so the
+// work already done is kept; under the strict policy they are discarded along
+// with the rest of the sync. Either way the cursor is not saved.
+func (o *SyncOrchestrator) abortBatch(ctx context.Context, batch *documentBatch, cause error) error {
+	if o.syncPolicy.IsStrict() {
+		batch.pending = batch.pending[:0]
+		return cause
+	}
+	// The sync's context may already be done, so flush without it
+	if err := o.flushBatch(context.WithoutCancel(ctx), batch); err != nil {
+		return errors.Join(cause, err)
+	}
+	return cause
+}
+
+// saveDocument saves a single document and its chunks.
+func (o *SyncOrchestrator) saveDocument(ctx context.Context, sourceID string, pending *pendingDocument) error {
+	if err := o.docStore.SaveDocument(ctx, &pending.doc); err != nil {
+		return fmt.Errorf("save document: %w", err)
+	}
+	if err := o.docStore.SaveChunks(ctx, pending.chunks); err != nil {
+		return fmt.Errorf("save chunks: %w", err)
+	}
+	o.progress.OnDocumentProcessed(sourceID, pending.doc.ID, driving.IndexPhaseStored)
+	return nil
+}
+
+// saveOriginal keeps the raw bytes of a saved document. The original is only
+// used to show the document as it was, so failing to store it is logged
+// rather than failing the document.
+func (o *SyncOrchestrator) saveOriginal(ctx context.Context, pending *pendingDocument) {
+	if pending.original == nil {
+		return
+	}
+	if err := o.docStore.SaveOriginal(ctx, pending.doc.ID, pending.original); err != nil {
+		o.log.Warn("failed to save document original", "document_id", pending.doc.ID, "error", err)
+	}
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// batchRecordingDocStore records the size of each batch saved.
+type batchRecordingDocStore struct {
+	*memory.DocumentStore
+	batches  []int
+	batchErr error
+}
+
+func (s *batchRecordingDocStore) SaveBatch(ctx context.Context, docs []domain.Document, chunks []domain.Chunk) error {
+	s.batches = append(s.batches, len(docs))
+	if s.batchErr != nil {
+		return s.batchErr
+	}
+	return s.DocumentStore.SaveBatch(ctx, docs, chunks)
+}
+
+// rawDocuments returns n plain text documents for src-1.
+func rawDocuments(n int) []domain.RawDocument {
+	docs := make([]domain.RawDocument, n)
+	for i := range docs {
+		docs[i] = domain.RawDocument{
+			SourceID: "src-1",
+			URI:      fmt.Sprintf("doc-%d.txt", i),
+			MIMEType: "text/plain",
+			Content:  []byte("content"),
+		}
+	}
+	return docs
+}
+
+// newBatchOrchestrator returns an orchestrator syncing connector as src-1 into docStore.
+func newBatchOrchestrator(t *testing.T, connector *syncMockConnector, docStore driven.DocumentStore) *SyncOrchestrator {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	require.NoError(t, sourceStore.Save(context.Background(), domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	connector.sourceID = "src-1"
+	connector.connType = "mock"
+	factory := newSyncMockConnectorFactory()
+	factory.connectors["src-1"] = connector
+
+	return NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+}
+
+func TestSyncOrchestrator_Sync_FlushesInBatches(t *testing.T) {
+	ctx := context.Background()
+	docStore := &batchRecordingDocStore{DocumentStore: memory.NewDocumentStore()}
+	orchestrator := newBatchOrchestrator(t, &syncMockConnector{fullSyncDocs: rawDocuments(5)}, docStore)
+	orchestrator.SetSyncSettings(domain.SyncSettings{BatchSize: 2})
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, []int{2, 2, 1}, docStore.batches)
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+	chunks, err := docStore.GetChunks(ctx, "src-1-doc-doc-4.txt")
+	require.NoError(t, err)
+	assert.Len(t, chunks, 1)
+}
+
+func TestSyncOrchestrator_Sync_BatchSaveFallsBackToSingleDocuments(t *testing.T) {
+	ctx := context.Background()
+	docStore := &batchRecordingDocStore{
+		DocumentStore: memory.NewDocumentStore(),
+		batchErr:      errors.New("database is locked"),
+	}
+	orchestrator := newBatchOrchestrator(t, &syncMockConnector{fullSyncDocs: rawDocuments(3)}, docStore)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, []int{3}, docStore.batches)
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestSyncOrchestrator_Sync_ConnectorErrorWithPartialBatch(t *testing.T) {
+	ctx := context.Background()
+	connectorErr := errors.New("rate limited")
+
+	t.Run("continue policy flushes the buffered documents", func(t *testing.T) {
+		docStore := memory.NewDocumentStore()
+		connector := &syncMockConnector{
+			fullSyncDocs: rawDocuments(3), hangAfterDocs: true, failAfterDocs: connectorErr,
+		}
+		orchestrator := newBatchOrchestrator(t, connector, docStore)
+
+		err := orchestrator.Sync(ctx, "src-1")
+
+		require.ErrorIs(t, err, connectorErr)
+		count, err := docStore.CountBySource(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("strict policy discards the buffered documents", func(t *testing.T) {
+		docStore := memory.NewDocumentStore()
+		connector := &syncMockConnector{
+			fullSyncDocs: rawDocuments(3), hangAfterDocs: true, failAfterDocs: connectorErr,
+		}
+		orchestrator := newBatchOrchestrator(t, connector, docStore)
+		orchestrator.SetSyncPolicy(domain.SyncPolicyStrict)
+
+		err := orchestrator.Sync(ctx, "src-1")
+
+		require.ErrorIs(t, err, connectorErr)
+		count, err := docStore.CountBySource(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
+
+func TestSyncOrchestrator_Sync_DeadlineFlushesPartialBatch(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	connector := &syncMockConnector{fullSyncDocs: rawDocuments(2), hangAfterDocs: true}
+	orchestrator := newBatchOrchestrator(t, connector, docStore)
+	orchestrator.SetSyncSettings(domain.SyncSettings{FullSyncTimeoutSeconds: 1})
+
+	err := orchestrator.Sync(ctx, "src-1")
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSyncOrchestrator_Sync_DeleteFlushesEarlierChanges(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	connector := &syncMockConnector{
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true},
+		incSyncDocs: []domain.RawDocumentChange{
+			{Type: domain.ChangeCreated, Document: rawDocuments(1)[0]},
+			{Type: domain.ChangeCreated, Document: domain.RawDocument{
+				SourceID: "src-1", URI: "kept.txt", MIMEType: "text/plain", Content: []byte("kept"),
+			}},
+			{Type: domain.ChangeDeleted, Document: domain.RawDocument{SourceID: "src-1", URI: "doc-0.txt"}},
+		},
+	}
+	orchestrator := newBatchOrchestrator(t, connector, docStore)
+	syncStore := memory.NewSyncStateStore()
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1"}))
+	orchestrator.syncStore = syncStore
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "kept.txt", docs[0].URI)
+}
+
+func TestSyncOrchestrator_Sync_KeepsOriginals(t *testing.T) {
+	ctx := context.Background()
+	raws := rawDocuments(2)
+	raws[1].Content = make([]byte, 2048)
+	docStore := memory.NewDocumentStore()
+	orchestrator := newBatchOrchestrator(t, &syncMockConnector{fullSyncDocs: raws}, docStore)
+	orchestrator.SetSyncSettings(domain.SyncSettings{KeepOriginals: true, OriginalMaxSizeKB: 1})
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	original, err := docStore.GetOriginal(ctx, "src-1-doc-doc-0.txt")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("content"), original)
+	_, err = docStore.GetOriginal(ctx, "src-1-doc-doc-1.txt")
+	assert.ErrorIs(t, err, domain.ErrNotFound, "originals over the cap are not kept")
+}
+
+func TestSyncOrchestrator_Sync_OriginalsOffByDefault(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	orchestrator := newBatchOrchestrator(t, &syncMockConnector{fullSyncDocs: rawDocuments(1)}, docStore)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	_, err := docStore.GetOriginal(ctx, "src-1-doc-doc-0.txt")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSyncOrchestrator_Sync_SkipsShortDocuments(t *testing.T) {
+	ctx := context.Background()
+	raws := rawDocuments(2)
+	raws[1].Content = []byte(" x \n")
+	docStore := memory.NewDocumentStore()
+	// Indexed while it was longer
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{
+		ID: "stale", SourceID: "src-1", URI: "doc-1.txt", Content: "older, longer content",
+	}))
+	orchestrator := newBatchOrchestrator(t, &syncMockConnector{fullSyncDocs: raws}, docStore)
+	orchestrator.SetSyncSettings(domain.SyncSettings{MinContentLength: 5})
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "doc-0.txt", docs[0].URI)
+
+	state, err := orchestrator.syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	require.NotEmpty(t, state.RecentErrors)
+	last := state.RecentErrors[len(state.RecentErrors)-1]
+	assert.Equal(t, domain.SyncPhaseSkipped, last.Phase)
+	assert.Equal(t, "1 document skipped: shorter than 5 characters", last.Message)
+	assert.Empty(t, state.LastError)
+}
+
+func TestSyncOrchestrator_Sync_IndexesShortDocumentsByName(t *testing.T) {
+	ctx := context.Background()
+	raws := rawDocuments(3)
+	raws[1].Content = []byte("x")
+	raws[2].Content = nil
+	docStore := memory.NewDocumentStore()
+	orchestrator := newBatchOrchestrator(t, &syncMockConnector{fullSyncDocs: raws}, docStore)
+	orchestrator.SetSyncSettings(domain.SyncSettings{MinContentLength: 5, ShortAsMetadataOnly: true})
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	doc, err := docStore.GetDocument(ctx, "src-1-doc-doc-1.txt")
+	require.NoError(t, err)
+	assert.True(t, domain.IsMetadataOnly(doc.Metadata))
+	assert.Empty(t, doc.Content)
+	chunks, err := docStore.GetChunks(ctx, doc.ID)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "doc-1.txt\ndoc-1.txt", chunks[0].Content)
+
+	doc, err = docStore.GetDocument(ctx, "src-1-doc-doc-0.txt")
+	require.NoError(t, err)
+	assert.False(t, domain.IsMetadataOnly(doc.Metadata))
+
+	state, err := orchestrator.syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	require.NotEmpty(t, state.RecentErrors)
+	assert.Equal(t, "2 documents indexed by name only: shorter than 5 characters",
+		state.RecentErrors[len(state.RecentErrors)-1].Message)
+}
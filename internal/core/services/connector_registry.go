@@ -2,7 +2,10 @@ package services
 
 import (
 	"context"
+	"sort"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/basecamp"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/discord"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/dropbox"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/filesystem"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/github"
@@ -13,6 +16,10 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/onedrive"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/outlook"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/notion"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/obsidianpublish"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/slack"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/sqlite"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/trello"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
@@ -39,6 +46,7 @@ func NewConnectorRegistry(connectorFactory driven.ConnectorFactory) *ConnectorRe
 
 func (r *ConnectorRegistry) registerBuiltinConnectors() {
 	r.registerFilesystem()
+	r.registerSQLite()
 	r.registerGitHub()
 	r.registerGoogleDrive()
 	r.registerGmail()
@@ -48,6 +56,11 @@ func (r *ConnectorRegistry) registerBuiltinConnectors() {
 	r.registerMicrosoftCalendar()
 	r.registerDropbox()
 	r.registerNotion()
+	r.registerTrello()
+	r.registerBasecamp()
+	r.registerDiscord()
+	r.registerSlack()
+	r.registerObsidianPublish()
 }
 
 func (r *ConnectorRegistry) registerFilesystem() {
@@ -59,6 +72,7 @@ func (r *ConnectorRegistry) registerFilesystem() {
 		AuthCapability: domain.AuthCapNone,
 		AuthMethod:     domain.AuthMethodNone,
 		ConfigKeys:     filesystemConfigKeys(),
+		ContentTypes:   []string{"files"},
 		WebURLResolver: filesystem.ResolveWebURL,
 	}
 }
@@ -71,11 +85,97 @@ func filesystemConfigKeys() []domain.ConfigKey {
 			Description: "Path to the directory to index",
 			Required:    true,
 		},
+		{
+			Key:         "paths",
+			Label:       "Additional Paths",
+			Description: "More directories to index as part of this source, comma-separated (optional)",
+		},
 		{
 			Key:         "patterns",
 			Label:       "File Patterns",
 			Description: "Glob patterns to match (e.g., *.md,*.txt)",
 		},
+		{
+			Key:         "skip_locked",
+			Label:       "Skip Locked Files",
+			Description: "Skip files that are locked or still being written (true/false, default: true)",
+		},
+		{
+			Key:         "metadata_only",
+			Label:       "Metadata Only",
+			Description: "Index file names and paths without file contents or embeddings (true/false, default: false)",
+		},
+		{
+			Key:         "concurrency",
+			Label:       "Concurrency",
+			Description: "Number of files read at once during a full sync (default: 4)",
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerSQLite() {
+	r.connectors["sqlite"] = domain.ConnectorType{
+		ID:             "sqlite",
+		Name:           "SQLite Database",
+		Description:    "Index rows from the tables of a local SQLite database",
+		ProviderType:   domain.ProviderLocal,
+		AuthCapability: domain.AuthCapNone,
+		AuthMethod:     domain.AuthMethodNone,
+		ConfigKeys:     sqliteConfigKeys(),
+		ContentTypes:   []string{"rows"},
+		WebURLResolver: sqlite.ResolveWebURL,
+	}
+}
+
+func sqliteConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "path",
+			Label:       "Database Path",
+			Description: "Path to the database file (opened read-only)",
+			Required:    true,
+		},
+		{
+			Key:         "db_type",
+			Label:       "Database Type",
+			Description: "sqlite (default: from the file extension; duckdb is not supported yet)",
+		},
+		{
+			Key:         "table",
+			Label:       "Tables",
+			Description: "Comma-separated tables to index (default: all tables)",
+		},
+		{
+			Key:         "query",
+			Label:       "Query",
+			Description: "SELECT statement to index instead of a whole table (optional)",
+		},
+		{
+			Key:         "id_column",
+			Label:       "ID Column",
+			Description: "Column holding the unique row ID",
+			Default:     sqlite.DefaultIDColumn,
+		},
+		{
+			Key:         "title_column",
+			Label:       "Title Column",
+			Description: "Column holding the document title (optional)",
+		},
+		{
+			Key:         "body_column",
+			Label:       "Body Column",
+			Description: "Column holding the document body (optional, defaults to all columns)",
+		},
+		{
+			Key:         "text_columns",
+			Label:       "Text Columns",
+			Description: "Columns joined into the body per table, e.g. notes:title,body; tasks:summary (optional)",
+		},
+		{
+			Key:         "timestamp_column",
+			Label:       "Timestamp Column",
+			Description: "Last-modified column used for incremental sync (optional)",
+		},
 	}
 }
 
@@ -85,9 +185,11 @@ func (r *ConnectorRegistry) registerGitHub() {
 		Name:           "GitHub",
 		Description:    "Index repositories, issues, PRs, and wikis from GitHub",
 		ProviderType:   domain.ProviderGitHub,
-		AuthCapability: domain.AuthCapPAT | domain.AuthCapOAuth,
+		AuthCapability: domain.AuthCapPAT | domain.AuthCapOAuth | domain.AuthCapGitHubApp,
 		AuthMethod:     domain.AuthMethodPAT,
 		ConfigKeys:     githubConfigKeys(),
+		ContentTypes:   []string{"files", "issues", "prs", "wikis", "gists", "commits"},
+		AuthHint:       "Requires: repo scope on GitHub token for private repositories",
 		WebURLResolver: github.ResolveWebURL,
 	}
 }
@@ -97,14 +199,57 @@ func githubConfigKeys() []domain.ConfigKey {
 		{
 			Key:         "content_types",
 			Label:       "Content Types",
-			Description: "Content to index: files,issues,prs,wikis",
+			Description: "Content to index: files,issues,prs,wikis,gists,commits",
 			Default:     "files",
 		},
 		{
 			Key:         "file_patterns",
 			Label:       "File Patterns",
-			Description: "Glob patterns for files to include",
-			Default:     "*",
+			Description: ".gitignore-style patterns for files to skip; prefix with ! to include (e.g. *.log,!vendor/**)",
+		},
+		{
+			Key:         "issue_state",
+			Label:       "Issue State",
+			Description: "Issues to index by state: open, closed or all",
+			Default:     "all",
+		},
+		{
+			Key:         "pr_state",
+			Label:       "PR State",
+			Description: "Pull requests to index by state: open, closed or all (merged PRs are closed)",
+			Default:     "all",
+		},
+		{
+			Key:         "include_starred_gists",
+			Label:       "Include Starred Gists",
+			Description: "Also index starred and forked gists (true/false, default: false)",
+		},
+		{
+			Key:         "include_attachments",
+			Label:       "Include Attachments",
+			Description: "Also index text attachments and gists linked from issues and PRs (true/false, default: false)",
+		},
+		{
+			Key:         "max_attachment_size",
+			Label:       "Max Attachment Size",
+			Description: "Largest attachment or linked gist to index, in bytes",
+			Default:     "1048576",
+		},
+		{
+			Key:         "commit_history_days",
+			Label:       "Commit History Days",
+			Description: "Days of commit history indexed on the first sync",
+			Default:     "365",
+		},
+		{
+			Key:         "include_commit_diffs",
+			Label:       "Include Commit Diffs",
+			Description: "Also index commit patches, up to 64KB per commit (true/false, default: false)",
+		},
+		{
+			Key:         "metadata_only",
+			Label:       "Metadata Only",
+			Description: "Index repository file names and paths without file contents (true/false, default: false)",
 		},
 	}
 }
@@ -118,6 +263,8 @@ func (r *ConnectorRegistry) registerGoogleDrive() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     driveConfigKeys(),
+		ContentTypes:   []string{"files", "docs", "sheets"},
+		AuthHint:       "Requires: drive.readonly scope on Google OAuth app",
 		WebURLResolver: drive.ResolveWebURL,
 	}
 }
@@ -140,6 +287,11 @@ func driveConfigKeys() []domain.ConfigKey {
 			Label:       "MIME Types",
 			Description: "Filter by MIME types (optional)",
 		},
+		{
+			Key:         "drive_id",
+			Label:       "Shared Drive ID",
+			Description: "Sync a Shared Drive instead of My Drive (optional)",
+		},
 	}
 }
 
@@ -152,6 +304,8 @@ func (r *ConnectorRegistry) registerGmail() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     gmailConfigKeys(),
+		ContentTypes:   []string{"emails", "attachments"},
+		AuthHint:       "Requires: gmail.readonly scope on Google OAuth app",
 		WebURLResolver: gmail.ResolveWebURL,
 	}
 }
@@ -175,6 +329,12 @@ func gmailConfigKeys() []domain.ConfigKey {
 			Description: "Include spam and trash (true/false)",
 			Default:     "false",
 		},
+		{
+			Key:         "max_file_size",
+			Label:       "Max Attachment Size",
+			Description: "Largest attachment to index, in bytes",
+			Default:     "10485760",
+		},
 	}
 }
 
@@ -187,6 +347,8 @@ func (r *ConnectorRegistry) registerGoogleCalendar() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     calendarConfigKeys(),
+		ContentTypes:   []string{"events"},
+		AuthHint:       "Requires: calendar.readonly scope on Google OAuth app",
 		WebURLResolver: calendar.ResolveWebURL,
 	}
 }
@@ -216,6 +378,8 @@ func (r *ConnectorRegistry) registerOutlook() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     outlookConfigKeys(),
+		ContentTypes:   []string{"emails"},
+		AuthHint:       "Requires: Mail.Read permission on Microsoft app registration",
 		WebURLResolver: outlook.ResolveWebURL,
 	}
 }
@@ -244,6 +408,8 @@ func (r *ConnectorRegistry) registerOneDrive() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     onedriveConfigKeys(),
+		ContentTypes:   []string{"files"},
+		AuthHint:       "Requires: Files.Read permission on Microsoft app registration",
 		WebURLResolver: onedrive.ResolveWebURL,
 	}
 }
@@ -267,6 +433,8 @@ func (r *ConnectorRegistry) registerMicrosoftCalendar() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     msCalendarConfigKeys(),
+		ContentTypes:   []string{"events"},
+		AuthHint:       "Requires: Calendars.Read permission on Microsoft app registration",
 		WebURLResolver: mscalendar.ResolveWebURL,
 	}
 }
@@ -290,6 +458,8 @@ func (r *ConnectorRegistry) registerDropbox() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     dropboxConfigKeys(),
+		ContentTypes:   []string{"files"},
+		AuthHint:       "Requires: files.metadata.read and files.content.read scopes on Dropbox app",
 		WebURLResolver: dropbox.ResolveWebURL,
 	}
 }
@@ -324,6 +494,8 @@ func (r *ConnectorRegistry) registerNotion() {
 		AuthCapability: domain.AuthCapOAuth,
 		AuthMethod:     domain.AuthMethodOAuth,
 		ConfigKeys:     notionConfigKeys(),
+		ContentTypes:   []string{"pages", "databases", "comments"},
+		AuthHint:       "Requires: pages shared with the Notion integration",
 		WebURLResolver: notion.ResolveWebURL,
 	}
 }
@@ -357,6 +529,221 @@ func notionConfigKeys() []domain.ConfigKey {
 	}
 }
 
+func (r *ConnectorRegistry) registerBasecamp() {
+	r.connectors["basecamp"] = domain.ConnectorType{
+		ID:             "basecamp",
+		Name:           "Basecamp",
+		Description:    "Index messages, todos and documents from Basecamp projects",
+		ProviderType:   domain.ProviderBasecamp,
+		AuthCapability: domain.AuthCapOAuth,
+		AuthMethod:     domain.AuthMethodOAuth,
+		ConfigKeys:     basecampConfigKeys(),
+		ContentTypes:   []string{"messages", "todos", "documents", "comments"},
+		AuthHint:       "Requires: Basecamp OAuth app with access to the account",
+		WebURLResolver: basecamp.ResolveWebURL,
+	}
+}
+
+func basecampConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "account_id",
+			Label:       "Account ID",
+			Description: "Basecamp account ID (the number in 3.basecamp.com/{id})",
+			Required:    true,
+		},
+		{
+			Key:         "project_ids",
+			Label:       "Project IDs",
+			Description: "Comma-separated project IDs to sync (optional, defaults to all projects)",
+		},
+		{
+			Key:         "content_types",
+			Label:       "Content Types",
+			Description: "Content to sync: messages,todos,documents,comments",
+			Default:     "messages,todos,documents,comments",
+		},
+		{
+			Key:         "include_attachments",
+			Label:       "Include Attachments",
+			Description: "Index text files from Docs & Files (true/false)",
+			Default:     "false",
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerTrello() {
+	r.connectors["trello"] = domain.ConnectorType{
+		ID:             "trello",
+		Name:           "Trello",
+		Description:    "Index cards from Trello boards",
+		ProviderType:   domain.ProviderTrello,
+		AuthCapability: domain.AuthCapPAT,
+		AuthMethod:     domain.AuthMethodPAT,
+		ConfigKeys:     trelloConfigKeys(),
+		ContentTypes:   []string{"cards", "checklists", "comments"},
+		AuthHint:       "Requires: Trello API key and a token issued for it",
+		WebURLResolver: trello.ResolveWebURL,
+	}
+}
+
+func trelloConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "api_key",
+			Label:       "API Key",
+			Description: "Trello API key the token was issued for",
+			Required:    true,
+			Secret:      true,
+		},
+		{
+			Key:         "board_ids",
+			Label:       "Board IDs",
+			Description: "Comma-separated board IDs to sync (optional, defaults to all open boards)",
+		},
+		{
+			Key:         "include_archived",
+			Label:       "Include Archived",
+			Description: "Include archived cards (true/false)",
+			Default:     "false",
+		},
+		{
+			Key:         "include_checklists",
+			Label:       "Include Checklists",
+			Description: "Append card checklists to the content (true/false)",
+			Default:     "true",
+		},
+		{
+			Key:         "include_comments",
+			Label:       "Include Comments",
+			Description: "Fetch card comments (true/false)",
+			Default:     "true",
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerDiscord() {
+	r.connectors["discord"] = domain.ConnectorType{
+		ID:             "discord",
+		Name:           "Discord",
+		Description:    "Index messages and threads from a Discord server",
+		ProviderType:   domain.ProviderDiscord,
+		AuthCapability: domain.AuthCapPAT,
+		AuthMethod:     domain.AuthMethodPAT,
+		ConfigKeys:     discordConfigKeys(),
+		ContentTypes:   []string{"messages", "threads"},
+		AuthHint:       "Requires: bot token with the Message Content intent, bot invited to the server",
+		WebURLResolver: discord.ResolveWebURL,
+	}
+}
+
+func discordConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "guild_id",
+			Label:       "Server ID",
+			Description: "ID of the Discord server (guild) to index",
+			Required:    true,
+		},
+		{
+			Key:         "channel_ids",
+			Label:       "Channel IDs",
+			Description: "Comma-separated channel IDs to sync (optional, defaults to all readable channels)",
+		},
+		{
+			Key:         "include_threads",
+			Label:       "Include Threads",
+			Description: "Index active and archived public threads (true/false)",
+			Default:     "true",
+		},
+		{
+			Key:         "max_age_days",
+			Label:       "Max Age (days)",
+			Description: "Skip messages older than this many days (0 for no limit)",
+			Default:     "0",
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerSlack() {
+	r.connectors["slack"] = domain.ConnectorType{
+		ID:             "slack",
+		Name:           "Slack",
+		Description:    "Index messages, threads and DMs from a Slack workspace",
+		ProviderType:   domain.ProviderSlack,
+		AuthCapability: domain.AuthCapOAuth | domain.AuthCapPAT,
+		AuthMethod:     domain.AuthMethodOAuth,
+		ConfigKeys:     slackConfigKeys(),
+		ContentTypes:   []string{"messages", "threads", "dms"},
+		AuthHint:       "Requires: bot token with the history and read scopes, bot invited to private channels",
+		WebURLResolver: slack.ResolveWebURL,
+	}
+}
+
+func slackConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "channel_ids",
+			Label:       "Channel IDs",
+			Description: "Comma-separated conversation IDs to sync (optional, defaults to all readable conversations)",
+		},
+		{
+			Key:         "conversation_types",
+			Label:       "Conversation Types",
+			Description: "Comma-separated types to sync: public_channel, private_channel, mpim, im",
+			Default:     "public_channel,private_channel,mpim,im",
+		},
+		{
+			Key:         "include_threads",
+			Label:       "Include Threads",
+			Description: "Index thread replies with their parent message (true/false)",
+			Default:     "true",
+		},
+		{
+			Key:         "max_age_days",
+			Label:       "Max Age (days)",
+			Description: "Skip messages older than this many days (0 for no limit)",
+			Default:     "0",
+		},
+		{
+			Key:         "rescan_days",
+			Label:       "Rescan (days)",
+			Description: "Days of recent messages to re-read for edits, deletions and new thread replies (0 to disable)",
+			Default:     "7",
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerObsidianPublish() {
+	r.connectors["obsidian-publish"] = domain.ConnectorType{
+		ID:             "obsidian-publish",
+		Name:           "Obsidian Publish",
+		Description:    "Index notes published to an Obsidian Publish site",
+		ProviderType:   domain.ProviderLocal,
+		AuthCapability: domain.AuthCapNone,
+		AuthMethod:     domain.AuthMethodNone,
+		ConfigKeys:     obsidianPublishConfigKeys(),
+		ContentTypes:   []string{"notes"},
+		WebURLResolver: obsidianpublish.ResolveWebURL,
+	}
+}
+
+func obsidianPublishConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "site_url",
+			Label:       "Site URL",
+			Description: "URL of the published site (e.g., https://publish.obsidian.md/my-notes)",
+			Required:    true,
+		},
+		{
+			Key:         "site_id",
+			Label:       "Site ID",
+			Description: "Identifier used in document URIs (optional, defaults to the site slug or host)",
+		},
+	}
+}
+
 // List returns all available connector types.
 func (r *ConnectorRegistry) List() []domain.ConnectorType {
 	result := make([]domain.ConnectorType, 0, len(r.connectors))
@@ -377,6 +764,18 @@ func (r *ConnectorRegistry) GetConnectorsForProvider(provider domain.ProviderTyp
 	return result
 }
 
+// ListByProvider returns the connector types of a provider sorted by name.
+func (r *ConnectorRegistry) ListByProvider(providerType domain.ProviderType) []domain.ConnectorType {
+	result := r.GetConnectorsForProvider(providerType)
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
 // Get returns a specific connector type by ID.
 func (r *ConnectorRegistry) Get(id string) (*domain.ConnectorType, error) {
 	c, ok := r.connectors[id]
@@ -401,6 +800,13 @@ func (r *ConnectorRegistry) ValidateConfig(connectorID string, config map[string
 			}
 		}
 	}
+
+	// Any source may set a retention window
+	if retain := config[domain.SourceConfigRetain]; retain != "" {
+		if _, err := domain.ParseRetention(retain); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -442,14 +848,14 @@ func (r *ConnectorRegistry) BuildAuthURL(
 	return r.connectorFactory.BuildAuthURL(connectorType, authProvider, redirectURI, state, codeChallenge)
 }
 
-// GetUserInfo fetches the account identifier (email/username) for a connector type.
+// GetUserInfo fetches the authenticated account's details for a connector type.
 func (r *ConnectorRegistry) GetUserInfo(
 	ctx context.Context,
 	connectorType string,
 	accessToken string,
-) (string, error) {
+) (domain.AccountInfo, error) {
 	if r.connectorFactory == nil {
-		return "", domain.ErrNotFound
+		return domain.AccountInfo{}, domain.ErrNotFound
 	}
 	return r.connectorFactory.GetUserInfo(ctx, connectorType, accessToken)
 }
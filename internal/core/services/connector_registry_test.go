@@ -26,6 +26,10 @@ func (m *mockConnectorFactory) SupportedTypes() []string {
 	return nil
 }
 
+func (m *mockConnectorFactory) Version(_ string) string {
+	return ""
+}
+
 func (m *mockConnectorFactory) BuildAuthURL(_ string, _ *domain.AuthProvider, _, _, _ string) (string, error) {
 	return "", nil
 }
@@ -38,8 +42,8 @@ func (m *mockConnectorFactory) RefreshToken(_ context.Context, _ string, _ *doma
 	return nil, nil
 }
 
-func (m *mockConnectorFactory) GetUserInfo(_ context.Context, _ string, _ string) (string, error) {
-	return "", nil
+func (m *mockConnectorFactory) GetUserInfo(_ context.Context, _ string, _ string) (domain.AccountInfo, error) {
+	return domain.AccountInfo{}, nil
 }
 
 func (m *mockConnectorFactory) GetDefaultOAuthConfig(_ string) *driven.OAuthDefaults {
@@ -70,8 +74,9 @@ func TestConnectorRegistry_List(t *testing.T) {
 	connectors := registry.List()
 
 	// All built-in connectors: filesystem, github, google-drive, gmail, google-calendar,
-	// outlook, onedrive, microsoft-calendar, dropbox, notion
-	assert.Len(t, connectors, 10)
+	// outlook, onedrive, microsoft-calendar, dropbox, notion, trello, basecamp, discord,
+	// slack, sqlite, obsidian-publish
+	assert.Len(t, connectors, 16)
 
 	// Verify all expected connectors are present
 	ids := make(map[string]bool)
@@ -88,6 +93,12 @@ func TestConnectorRegistry_List(t *testing.T) {
 	assert.True(t, ids["microsoft-calendar"])
 	assert.True(t, ids["dropbox"])
 	assert.True(t, ids["notion"])
+	assert.True(t, ids["trello"])
+	assert.True(t, ids["basecamp"])
+	assert.True(t, ids["discord"])
+	assert.True(t, ids["slack"])
+	assert.True(t, ids["sqlite"])
+	assert.True(t, ids["obsidian-publish"])
 }
 
 func TestConnectorRegistry_Get_Filesystem(t *testing.T) {
@@ -100,7 +111,7 @@ func TestConnectorRegistry_Get_Filesystem(t *testing.T) {
 	assert.Equal(t, "filesystem", connector.ID)
 	assert.Equal(t, "Local Filesystem", connector.Name)
 	assert.Equal(t, domain.AuthCapNone, connector.AuthCapability)
-	assert.Len(t, connector.ConfigKeys, 2) // path and patterns
+	assert.Len(t, connector.ConfigKeys, 6) // path, paths, patterns, skip_locked, metadata_only and concurrency
 }
 
 func TestConnectorRegistry_Get_GitHub(t *testing.T) {
@@ -112,12 +123,48 @@ func TestConnectorRegistry_Get_GitHub(t *testing.T) {
 	require.NotNil(t, connector)
 	assert.Equal(t, "github", connector.ID)
 	assert.Equal(t, "GitHub", connector.Name)
-	// GitHub supports both PAT and OAuth
+	// GitHub supports PAT, OAuth and GitHub App installations
 	assert.True(t, connector.AuthCapability.SupportsPAT())
 	assert.True(t, connector.AuthCapability.SupportsOAuth())
+	assert.True(t, connector.AuthCapability.SupportsGitHubApp())
 	assert.True(t, connector.AuthCapability.SupportsMultipleMethods())
 	// No required config keys for GitHub - indexes all accessible repos
-	assert.Len(t, connector.ConfigKeys, 2) // content_types, file_patterns
+	// content_types, file_patterns, issue_state, pr_state, include_starred_gists, include_attachments,
+	// max_attachment_size, commit_history_days, include_commit_diffs, metadata_only
+	assert.Len(t, connector.ConfigKeys, 10)
+}
+
+func TestConnectorRegistry_ListDescribesEveryConnector(t *testing.T) {
+	registry := NewConnectorRegistry(nil)
+
+	for _, c := range registry.List() {
+		assert.NotEmpty(t, c.Description, c.ID)
+		assert.NotEmpty(t, c.ContentTypes, c.ID)
+		if !c.RequiresAuth() {
+			assert.Empty(t, c.AuthHint, c.ID)
+		}
+	}
+
+	github, err := registry.Get("github")
+	require.NoError(t, err)
+	assert.Contains(t, github.AuthHint, "repo scope")
+}
+
+func TestConnectorRegistry_ListByProvider(t *testing.T) {
+	registry := NewConnectorRegistry(nil)
+
+	google := registry.ListByProvider(domain.ProviderGoogle)
+
+	require.Len(t, google, 3)
+	ids := make([]string, len(google))
+	for i, c := range google {
+		assert.Equal(t, domain.ProviderGoogle, c.ProviderType)
+		ids[i] = c.ID
+	}
+	// Ordered by name: Gmail, Google Calendar, Google Drive
+	assert.Equal(t, []string{"gmail", "google-calendar", "google-drive"}, ids)
+
+	assert.Empty(t, registry.ListByProvider(domain.ProviderType("unknown")))
 }
 
 func TestConnectorRegistry_Get_NotFound(t *testing.T) {
@@ -178,6 +225,16 @@ func TestConnectorRegistry_ValidateConfig_GitHub_WithOptionalFilters(t *testing.
 	assert.NoError(t, err)
 }
 
+func TestConnectorRegistry_ValidateConfig_Retention(t *testing.T) {
+	registry := NewConnectorRegistry(nil)
+
+	err := registry.ValidateConfig("gmail", map[string]string{"retain": "180d"})
+	assert.NoError(t, err)
+
+	err = registry.ValidateConfig("gmail", map[string]string{"retain": "six months"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
 func TestConnectorRegistry_ValidateConfig_NonExistent(t *testing.T) {
 	registry := NewConnectorRegistry(nil)
 
@@ -227,6 +284,8 @@ func TestConnectorRegistry_GitHubConfigKeys(t *testing.T) {
 	// GitHub only has optional filtering keys - no required owner/repo
 	assert.False(t, keys["content_types"].Required)
 	assert.False(t, keys["file_patterns"].Required)
+	assert.Equal(t, "all", keys["issue_state"].Default)
+	assert.Equal(t, "all", keys["pr_state"].Default)
 }
 
 func TestConnectorRegistry_GitHubAuthCapability(t *testing.T) {
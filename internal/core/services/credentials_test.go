@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestCredentialsService_Lifecycle(t *testing.T) {
+	service := NewCredentialsService(memory.NewCredentialsStore())
+	ctx := context.Background()
+
+	creds := domain.Credentials{ID: "cred-1", SourceID: "src-1", PAT: &domain.PATCredentials{Token: "token"}}
+	require.NoError(t, service.Save(ctx, creds))
+
+	got, err := service.Get(ctx, "cred-1")
+	require.NoError(t, err)
+	assert.Equal(t, "token", got.PAT.Token)
+
+	bySource, err := service.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	require.NotNil(t, bySource)
+	assert.Equal(t, "cred-1", bySource.ID)
+
+	require.NoError(t, service.Delete(ctx, "cred-1"))
+	bySource, err = service.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Nil(t, bySource)
+}
+
+func TestCredentialsService_InvalidInput(t *testing.T) {
+	service := NewCredentialsService(memory.NewCredentialsStore())
+
+	assert.ErrorIs(t, service.Save(context.Background(), domain.Credentials{SourceID: "src-1"}), domain.ErrInvalidInput)
+}
+
+func TestCredentialsService_NilStore(t *testing.T) {
+	service := NewCredentialsService(nil)
+
+	_, err := service.Get(context.Background(), "cred-1")
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
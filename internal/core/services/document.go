@@ -2,10 +2,11 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"os/exec"
-	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -13,11 +14,15 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+	"github.com/custodia-labs/sercha-cli/internal/platform"
 )
 
 // Ensure DocumentService implements the interface.
 var _ driving.DocumentService = (*DocumentService)(nil)
 
+// maxAncestorDepth bounds the breadcrumb trail of deeply nested documents.
+const maxAncestorDepth = 32
+
 // Sentinel errors for stub implementations.
 var ErrRefreshNotImplemented = errors.New("document refresh not yet implemented")
 
@@ -95,6 +100,38 @@ func (s *DocumentService) GetContent(ctx context.Context, documentID string) (st
 	return builder.String(), nil
 }
 
+// GetOriginal returns the original bytes the document was normalised from.
+func (s *DocumentService) GetOriginal(ctx context.Context, documentID string) ([]byte, error) {
+	if s.docStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	return s.docStore.GetOriginal(ctx, documentID)
+}
+
+// GetChunks returns the document's chunks ordered by position.
+// Used to inspect how a document was chunked and embedded.
+func (s *DocumentService) GetChunks(ctx context.Context, documentID string) ([]domain.Chunk, error) {
+	if s.docStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	// Verify document exists
+	if _, err := s.docStore.GetDocument(ctx, documentID); err != nil {
+		return nil, err
+	}
+
+	chunks, err := s.docStore.GetChunks(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].Position < chunks[j].Position
+	})
+
+	return chunks, nil
+}
+
 // GetDetails returns connector-agnostic metadata for display.
 func (s *DocumentService) GetDetails(ctx context.Context, documentID string) (*driving.DocumentDetails, error) {
 	if s.docStore == nil {
@@ -130,6 +167,9 @@ func (s *DocumentService) GetDetails(ctx context.Context, documentID string) (*d
 		metadata[key] = fmt.Sprintf("%v", value)
 	}
 
+	// Ancestors are best-effort; details are still shown without them
+	ancestors, _ := s.GetAncestors(ctx, documentID)
+
 	return &driving.DocumentDetails{
 		ID:         doc.ID,
 		SourceID:   doc.SourceID,
@@ -141,9 +181,93 @@ func (s *DocumentService) GetDetails(ctx context.Context, documentID string) (*d
 		CreatedAt:  doc.CreatedAt,
 		UpdatedAt:  doc.UpdatedAt,
 		Metadata:   metadata,
+		Ancestors:  ancestors,
 	}, nil
 }
 
+// GetAncestors returns the document's ancestors, outermost first.
+// Parents are followed by ParentID, falling back to a document in the same
+// source whose URI is the recorded parent URI. A parent URI that matches no
+// document (such as a directory) ends the trail as a URI-only breadcrumb.
+func (s *DocumentService) GetAncestors(ctx context.Context, documentID string) (domain.BreadcrumbTrail, error) {
+	if s.docStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	doc, err := s.docStore.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var trail domain.BreadcrumbTrail
+	var byURI map[string]*domain.Document
+	seen := map[string]bool{doc.ID: true}
+
+	for len(trail) < maxAncestorDepth {
+		parent, err := s.parentByID(ctx, doc)
+		if err != nil {
+			return nil, err
+		}
+
+		parentURI, _ := doc.Metadata[domain.MetadataParentURI].(string)
+		if parent == nil && parentURI != "" {
+			if byURI == nil {
+				if byURI, err = s.documentsByURI(ctx, doc.SourceID); err != nil {
+					return nil, err
+				}
+			}
+			parent = byURI[parentURI]
+		}
+
+		if parent == nil {
+			if parentURI != "" {
+				trail = append(trail, domain.NewURIBreadcrumb(parentURI))
+			}
+			break
+		}
+		if seen[parent.ID] {
+			break // Cycle in the hierarchy
+		}
+		seen[parent.ID] = true
+
+		title := parent.Title
+		if title == "" {
+			title = domain.NewURIBreadcrumb(parent.URI).Title
+		}
+		trail = append(trail, domain.Breadcrumb{DocumentID: parent.ID, Title: title, URI: parent.URI})
+		doc = parent
+	}
+
+	slices.Reverse(trail)
+	return trail, nil
+}
+
+// parentByID returns the document's parent by ParentID, or nil if it has none
+// or the parent was deleted.
+func (s *DocumentService) parentByID(ctx context.Context, doc *domain.Document) (*domain.Document, error) {
+	if doc.ParentID == nil || *doc.ParentID == "" {
+		return nil, nil
+	}
+	parent, err := s.docStore.GetDocument(ctx, *doc.ParentID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	return parent, err
+}
+
+// documentsByURI indexes a source's documents by URI.
+func (s *DocumentService) documentsByURI(ctx context.Context, sourceID string) (map[string]*domain.Document, error) {
+	docs, err := s.docStore.ListDocuments(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("list documents: %w", err)
+	}
+	byURI := make(map[string]*domain.Document, len(docs))
+	for i := range docs {
+		byURI[docs[i].URI] = &docs[i]
+	}
+	return byURI, nil
+}
+
 // Exclude removes a document and marks it to skip during re-sync.
 func (s *DocumentService) Exclude(ctx context.Context, documentID, reason string) error {
 	if s.docStore == nil {
@@ -163,6 +287,7 @@ func (s *DocumentService) Exclude(ctx context.Context, documentID, reason string
 			SourceID:   doc.SourceID,
 			DocumentID: documentID,
 			URI:        doc.URI,
+			Category:   domain.ExclusionReasonUserRequested,
 			Reason:     reason,
 			ExcludedAt: time.Now(),
 		}
@@ -175,6 +300,166 @@ func (s *DocumentService) Exclude(ctx context.Context, documentID, reason string
 	return s.docStore.DeleteDocument(ctx, documentID)
 }
 
+// ExcludeMany removes several documents and marks them to skip during re-sync.
+// The exclusions are stored in a single batch. Documents that no longer exist
+// are skipped. Returns the number of documents excluded.
+func (s *DocumentService) ExcludeMany(
+	ctx context.Context, documentIDs []string, category domain.ExclusionReason, reason string,
+) (int, error) {
+	if s.docStore == nil {
+		return 0, domain.ErrNotImplemented
+	}
+	if !category.IsValid() {
+		return 0, fmt.Errorf("%w: unknown exclusion reason %q", domain.ErrInvalidInput, category)
+	}
+
+	// Capture URIs before the documents are deleted
+	now := time.Now()
+	exclusions := make([]*domain.Exclusion, 0, len(documentIDs))
+	for _, documentID := range documentIDs {
+		doc, err := s.docStore.GetDocument(ctx, documentID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+			return 0, err
+		}
+		exclusions = append(exclusions, &domain.Exclusion{
+			ID:         fmt.Sprintf("excl-%s", documentID),
+			SourceID:   doc.SourceID,
+			DocumentID: documentID,
+			URI:        doc.URI,
+			Category:   category,
+			Reason:     reason,
+			ExcludedAt: now,
+		})
+	}
+
+	if s.exclusionStore != nil {
+		if err := s.exclusionStore.AddMany(ctx, exclusions); err != nil {
+			return 0, fmt.Errorf("failed to add exclusions: %w", err)
+		}
+	}
+
+	for i, exclusion := range exclusions {
+		if err := s.docStore.DeleteDocument(ctx, exclusion.DocumentID); err != nil {
+			return i, fmt.Errorf("delete document %s: %w", exclusion.DocumentID, err)
+		}
+	}
+
+	return len(exclusions), nil
+}
+
+// ExcludePattern excludes every document of a source whose URI matches a pattern
+// and removes the matching documents already indexed. The pattern is a URI,
+// optionally prefixed with its type (see domain.ParseExclusionPattern).
+func (s *DocumentService) ExcludePattern(
+	ctx context.Context, sourceID, pattern string, category domain.ExclusionReason, reason string,
+) (int, error) {
+	if s.docStore == nil || s.exclusionStore == nil {
+		return 0, domain.ErrNotImplemented
+	}
+	if !category.IsValid() {
+		return 0, fmt.Errorf("%w: unknown exclusion reason %q", domain.ErrInvalidInput, category)
+	}
+	uri, patternType := domain.ParseExclusionPattern(pattern)
+	if uri == "" {
+		return 0, fmt.Errorf("%w: empty exclusion pattern", domain.ErrInvalidInput)
+	}
+	if s.sourceStore != nil {
+		if _, err := s.sourceStore.Get(ctx, sourceID); err != nil {
+			return 0, err
+		}
+	}
+
+	// The ID is derived from the pattern so the same pattern cannot be added twice
+	sum := sha256.Sum256([]byte(sourceID + "\x00" + string(patternType) + "\x00" + uri))
+	exclusion := &domain.Exclusion{
+		ID:          fmt.Sprintf("excl-%s-%s", patternType, hex.EncodeToString(sum[:8])),
+		SourceID:    sourceID,
+		URI:         uri,
+		PatternType: patternType,
+		Category:    category,
+		Reason:      reason,
+		ExcludedAt:  time.Now(),
+	}
+	if err := s.exclusionStore.Add(ctx, exclusion); err != nil {
+		return 0, fmt.Errorf("failed to add exclusion: %w", err)
+	}
+
+	docs, err := s.docStore.ListDocuments(ctx, sourceID)
+	if err != nil {
+		return 0, fmt.Errorf("list documents: %w", err)
+	}
+	removed := 0
+	for i := range docs {
+		if !exclusion.Matches(docs[i].URI) {
+			continue
+		}
+		if err := s.docStore.DeleteDocument(ctx, docs[i].ID); err != nil {
+			return removed, fmt.Errorf("delete document %s: %w", docs[i].ID, err)
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+// ListQuarantined returns documents quarantined after repeated failures for a source.
+func (s *DocumentService) ListQuarantined(ctx context.Context, sourceID string) ([]domain.Exclusion, error) {
+	if s.exclusionStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	exclusions, err := s.exclusionStore.GetBySourceID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	quarantined := make([]domain.Exclusion, 0, len(exclusions))
+	for i := range exclusions {
+		if exclusions[i].Quarantined {
+			quarantined = append(quarantined, exclusions[i])
+		}
+	}
+
+	// Most recently quarantined first
+	sort.Slice(quarantined, func(i, j int) bool {
+		return quarantined[i].ExcludedAt.After(quarantined[j].ExcludedAt)
+	})
+
+	return quarantined, nil
+}
+
+// RetryQuarantined lifts a quarantine so the document is processed on the next sync.
+// The failure count is reset, so the document gets the full number of attempts again.
+func (s *DocumentService) RetryQuarantined(ctx context.Context, exclusionID string) error {
+	if s.exclusionStore == nil {
+		return domain.ErrNotImplemented
+	}
+
+	exclusions, err := s.exclusionStore.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range exclusions {
+		exclusion := exclusions[i]
+		if exclusion.ID != exclusionID || !exclusion.Quarantined {
+			continue
+		}
+		if err := s.exclusionStore.ClearFailures(ctx, exclusion.SourceID, exclusion.URI); err != nil {
+			return fmt.Errorf("failed to clear failures: %w", err)
+		}
+		if err := s.exclusionStore.Remove(ctx, exclusion.ID); err != nil {
+			return fmt.Errorf("failed to remove quarantine: %w", err)
+		}
+		return nil
+	}
+
+	return domain.ErrNotFound
+}
+
 // Refresh re-syncs a single document from its source.
 // TODO: Implement when sync infrastructure supports single-document refresh.
 func (s *DocumentService) Refresh(_ context.Context, _ string) error {
@@ -197,7 +482,7 @@ func (s *DocumentService) Open(ctx context.Context, documentID string) error {
 	openableURL := s.resolveWebURL(ctx, doc)
 
 	// Open the resolved URL using the OS-specific command
-	return openURL(openableURL)
+	return platform.OpenURI(openableURL)
 }
 
 // resolveWebURL converts a document URI to an openable URL using the connector's resolver.
@@ -224,24 +509,6 @@ func (s *DocumentService) tryConnectorResolver(ctx context.Context, doc *domain.
 	return connectorType.WebURLResolver(doc.URI, doc.Metadata)
 }
 
-// openURL opens a URL/path using the system default handler.
-func openURL(url string) error {
-	var cmd *exec.Cmd
-
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", url)
-	case "linux":
-		cmd = exec.Command("xdg-open", url)
-	case "windows":
-		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
-	default:
-		return fmt.Errorf("unsupported platform: %s", runtime.GOOS)
-	}
-
-	return cmd.Start()
-}
-
 // convertToOpenableURL converts internal URIs to browser-openable URLs.
 func convertToOpenableURL(uri string) string {
 	// GitHub URIs: github://owner/repo/blob/branch/path -> https://github.com/owner/repo/blob/branch/path
@@ -255,10 +522,7 @@ func convertToOpenableURL(uri string) string {
 	// GitHub PR URIs: github://owner/repo/pull/123 -> https://github.com/owner/repo/pull/123
 	// (Already handled by the above rule)
 
-	// File URIs: file:///path/to/file -> /path/to/file (for local opening)
-	if strings.HasPrefix(uri, "file://") {
-		return strings.TrimPrefix(uri, "file://")
-	}
+	// File URIs pass through; platform.OpenURI decodes them to local paths
 
 	// HTTP/HTTPS URLs: pass through as-is
 	if strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://") {
@@ -68,6 +68,54 @@ func TestDocumentService_GetContent(t *testing.T) {
 	assert.Contains(t, content, "Second paragraph.")
 }
 
+func TestDocumentService_GetOriginal(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	ctx := context.Background()
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1"})
+
+	_, err := svc.GetOriginal(ctx, "doc-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, docStore.SaveOriginal(ctx, "doc-1", []byte("# Original")))
+	original, err := svc.GetOriginal(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("# Original"), original)
+}
+
+func TestDocumentService_GetChunks(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1"})
+	_ = docStore.SaveChunks(ctx, []domain.Chunk{
+		{ID: "chunk-2", DocumentID: "doc-1", Content: "Second paragraph.", Position: 1},
+		{ID: "chunk-1", DocumentID: "doc-1", Content: "First paragraph.", Position: 0, Embedding: []float32{0.6, 0.8}},
+	})
+
+	chunks, err := svc.GetChunks(ctx, "doc-1")
+	require.NoError(t, err)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, "chunk-1", chunks[0].ID)
+	assert.Equal(t, []float32{0.6, 0.8}, chunks[0].Embedding)
+	assert.Equal(t, "chunk-2", chunks[1].ID)
+}
+
+func TestDocumentService_GetChunks_NotFound(t *testing.T) {
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, nil, nil)
+
+	_, err := svc.GetChunks(context.Background(), "unknown-doc")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestDocumentService_GetChunks_NilStore(t *testing.T) {
+	svc := NewDocumentService(nil, nil, nil, nil)
+
+	_, err := svc.GetChunks(context.Background(), "doc-1")
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
 func TestDocumentService_GetDetails(t *testing.T) {
 	docStore := memory.NewDocumentStore()
 	sourceStore := memory.NewSourceStore()
@@ -124,6 +172,134 @@ func TestDocumentService_Exclude(t *testing.T) {
 	assert.True(t, excluded)
 }
 
+func TestDocumentService_ExcludeMany(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(docStore, nil, exclusionStore, nil)
+	ctx := context.Background()
+
+	for _, id := range []string{"doc-1", "doc-2", "doc-3"} {
+		_ = docStore.SaveDocument(ctx, &domain.Document{ID: id, SourceID: "src-1", URI: "/path/" + id})
+	}
+
+	count, err := svc.ExcludeMany(ctx, []string{"doc-1", "doc-2", "missing"}, domain.ExclusionReasonDuplicate, "copies")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Excluded documents are deleted, others are kept
+	_, err = docStore.GetDocument(ctx, "doc-1")
+	assert.Error(t, err)
+	_, err = docStore.GetDocument(ctx, "doc-3")
+	assert.NoError(t, err)
+
+	exclusions, err := exclusionStore.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, exclusions, 2)
+	for _, e := range exclusions {
+		assert.Equal(t, domain.ExclusionReasonDuplicate, e.Category)
+		assert.Equal(t, "copies", e.Reason)
+	}
+}
+
+func TestDocumentService_ExcludeMany_InvalidReason(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, memory.NewExclusionStore(), nil)
+	ctx := context.Background()
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "/path/doc-1"})
+
+	_, err := svc.ExcludeMany(ctx, []string{"doc-1"}, "other", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = docStore.GetDocument(ctx, "doc-1")
+	assert.NoError(t, err)
+}
+
+func TestDocumentService_ExcludePattern_Prefix(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(docStore, nil, exclusionStore, nil)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "file:///repo/node_modules/a.js"})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-1", URI: "file:///repo/node_modules/b/c.js"})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-3", SourceID: "src-1", URI: "file:///repo/main.go"})
+
+	removed, err := svc.ExcludePattern(ctx, "src-1", "prefix:file:///repo/node_modules/",
+		domain.ExclusionReasonIrrelevant, "dependencies")
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	_, err = docStore.GetDocument(ctx, "doc-1")
+	assert.Error(t, err)
+	_, err = docStore.GetDocument(ctx, "doc-3")
+	assert.NoError(t, err)
+
+	// Documents added later under the prefix are excluded too
+	excluded, err := exclusionStore.IsExcluded(ctx, "src-1", "file:///repo/node_modules/new.js")
+	require.NoError(t, err)
+	assert.True(t, excluded)
+	excluded, err = exclusionStore.IsExcluded(ctx, "src-1", "file:///repo/README.md")
+	require.NoError(t, err)
+	assert.False(t, excluded)
+
+	exclusions, err := exclusionStore.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, exclusions, 1)
+	assert.Equal(t, domain.ExclusionPatternPrefix, exclusions[0].PatternType)
+	assert.Equal(t, "file:///repo/node_modules/", exclusions[0].URI)
+}
+
+func TestDocumentService_ExcludePattern_Exact(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(docStore, nil, exclusionStore, nil)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "file:///repo/a.txt"})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-1", URI: "file:///repo/a.txt.bak"})
+
+	removed, err := svc.ExcludePattern(ctx, "src-1", "file:///repo/a.txt", domain.ExclusionReasonUserRequested, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	_, err = docStore.GetDocument(ctx, "doc-2")
+	assert.NoError(t, err)
+}
+
+func TestDocumentService_ExcludePattern_InvalidInput(t *testing.T) {
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, memory.NewExclusionStore(), nil)
+	ctx := context.Background()
+
+	_, err := svc.ExcludePattern(ctx, "src-1", "prefix:", domain.ExclusionReasonUserRequested, "")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.ExcludePattern(ctx, "src-1", "prefix:/tmp", "other", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestDocumentService_ExcludePattern_UnknownSource(t *testing.T) {
+	svc := NewDocumentService(memory.NewDocumentStore(), memory.NewSourceStore(), memory.NewExclusionStore(), nil)
+
+	_, err := svc.ExcludePattern(context.Background(), "missing", "prefix:/tmp",
+		domain.ExclusionReasonUserRequested, "")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestDocumentService_Exclude_SetsUserRequested(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(docStore, nil, exclusionStore, nil)
+	ctx := context.Background()
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "/path/doc-1"})
+
+	require.NoError(t, svc.Exclude(ctx, "doc-1", "user excluded"))
+
+	exclusions, err := exclusionStore.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, exclusions, 1)
+	assert.Equal(t, domain.ExclusionReasonUserRequested, exclusions[0].Category)
+}
+
 func TestDocumentService_Exclude_NonExistentDocument(t *testing.T) {
 	docStore := memory.NewDocumentStore()
 	exclusionStore := memory.NewExclusionStore()
@@ -225,6 +401,85 @@ func TestDocumentService_Exclude_VerifiesExclusionID(t *testing.T) {
 	assert.True(t, excluded)
 }
 
+func TestDocumentService_ListQuarantined(t *testing.T) {
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, exclusionStore, nil)
+	ctx := context.Background()
+
+	now := time.Now()
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{
+		ID: "excl-user", SourceID: "src-1", URI: "/user.txt", ExcludedAt: now,
+	}))
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{
+		ID: "quar-old", SourceID: "src-1", URI: "/old.pdf", ExcludedAt: now.Add(-time.Hour), Quarantined: true,
+	}))
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{
+		ID: "quar-new", SourceID: "src-1", URI: "/new.pdf", ExcludedAt: now, Quarantined: true,
+	}))
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{
+		ID: "quar-other", SourceID: "src-2", URI: "/other.pdf", ExcludedAt: now, Quarantined: true,
+	}))
+
+	quarantined, err := svc.ListQuarantined(ctx, "src-1")
+
+	require.NoError(t, err)
+	require.Len(t, quarantined, 2)
+	assert.Equal(t, "quar-new", quarantined[0].ID)
+	assert.Equal(t, "quar-old", quarantined[1].ID)
+}
+
+func TestDocumentService_RetryQuarantined(t *testing.T) {
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, exclusionStore, nil)
+	ctx := context.Background()
+
+	_, _ = exclusionStore.RecordFailure(ctx, "src-1", "/bad.pdf", "corrupt")
+	_, _ = exclusionStore.RecordFailure(ctx, "src-1", "/bad.pdf", "corrupt")
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{
+		ID: "quar-1", SourceID: "src-1", URI: "/bad.pdf", ExcludedAt: time.Now(), Quarantined: true,
+	}))
+
+	err := svc.RetryQuarantined(ctx, "quar-1")
+	require.NoError(t, err)
+
+	// No longer excluded
+	excluded, err := exclusionStore.IsExcluded(ctx, "src-1", "/bad.pdf")
+	require.NoError(t, err)
+	assert.False(t, excluded)
+
+	// Failure count starts again from one
+	failures, err := exclusionStore.RecordFailure(ctx, "src-1", "/bad.pdf", "corrupt")
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+}
+
+func TestDocumentService_RetryQuarantined_NotFound(t *testing.T) {
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, exclusionStore, nil)
+	ctx := context.Background()
+
+	// User exclusions cannot be lifted through retry
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{
+		ID: "excl-user", SourceID: "src-1", URI: "/user.txt", ExcludedAt: time.Now(),
+	}))
+
+	assert.ErrorIs(t, svc.RetryQuarantined(ctx, "excl-user"), domain.ErrNotFound)
+	assert.ErrorIs(t, svc.RetryQuarantined(ctx, "missing"), domain.ErrNotFound)
+
+	excluded, err := exclusionStore.IsExcluded(ctx, "src-1", "/user.txt")
+	require.NoError(t, err)
+	assert.True(t, excluded)
+}
+
+func TestDocumentService_Quarantine_WithoutExclusionStore(t *testing.T) {
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, nil, nil)
+	ctx := context.Background()
+
+	_, err := svc.ListQuarantined(ctx, "src-1")
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+	assert.ErrorIs(t, svc.RetryQuarantined(ctx, "quar-1"), domain.ErrNotImplemented)
+}
+
 func TestDocumentService_Refresh_NotImplemented(t *testing.T) {
 	svc := NewDocumentService(nil, nil, nil, nil)
 	ctx := context.Background()
@@ -446,9 +701,9 @@ func TestConvertToOpenableURL(t *testing.T) {
 			expected: "https://github.com/owner/repo/pull/456",
 		},
 		{
-			name:     "File URI",
+			name:     "File URI passthrough",
 			uri:      "file:///path/to/local/file.txt",
-			expected: "/path/to/local/file.txt",
+			expected: "file:///path/to/local/file.txt",
 		},
 		{
 			name:     "HTTP URL passthrough",
@@ -474,3 +729,90 @@ func TestConvertToOpenableURL(t *testing.T) {
 		})
 	}
 }
+
+func TestDocumentService_GetAncestors_ParentID(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	ctx := context.Background()
+
+	rootID, pageID := "root", "page"
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: rootID, SourceID: "src-1", Title: "Workspace"})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: pageID, SourceID: "src-1", Title: "Roadmap", ParentID: &rootID})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "leaf", SourceID: "src-1", Title: "Q3", ParentID: &pageID})
+
+	trail, err := svc.GetAncestors(ctx, "leaf")
+	require.NoError(t, err)
+	require.Len(t, trail, 2)
+	assert.Equal(t, "root", trail[0].DocumentID)
+	assert.Equal(t, "Workspace › Roadmap", trail.String())
+}
+
+func TestDocumentService_GetAncestors_ParentURI(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{
+		ID: "issue", SourceID: "src-1", Title: "Crash on start", URI: "github://acme/app/issues/7",
+	})
+	_ = docStore.SaveDocument(ctx, &domain.Document{
+		ID: "log", SourceID: "src-1", Title: "crash.log", URI: "github://acme/app/issues/7/attachments/1/crash.log",
+		Metadata: map[string]any{domain.MetadataParentURI: "github://acme/app/issues/7"},
+	})
+
+	trail, err := svc.GetAncestors(ctx, "log")
+	require.NoError(t, err)
+	require.Len(t, trail, 1)
+	assert.Equal(t, "issue", trail[0].DocumentID)
+	assert.Equal(t, "Crash on start", trail[0].Title)
+}
+
+func TestDocumentService_GetAncestors_UnresolvedParentURI(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{
+		ID: "doc-1", SourceID: "src-1", URI: "/home/user/notes/todo.md",
+		Metadata: map[string]any{domain.MetadataParentURI: "/home/user/notes"},
+	})
+
+	trail, err := svc.GetAncestors(ctx, "doc-1")
+	require.NoError(t, err)
+	require.Len(t, trail, 1)
+	assert.Empty(t, trail[0].DocumentID)
+	assert.Equal(t, "notes", trail[0].Title)
+}
+
+func TestDocumentService_GetAncestors_NoHierarchy(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", Title: "Flat"})
+
+	trail, err := svc.GetAncestors(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.Empty(t, trail)
+}
+
+func TestDocumentService_GetAncestors_Cycle(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	ctx := context.Background()
+
+	aID, bID := "a", "b"
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: aID, SourceID: "src-1", Title: "A", ParentID: &bID})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: bID, SourceID: "src-1", Title: "B", ParentID: &aID})
+
+	trail, err := svc.GetAncestors(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "B", trail.String())
+}
+
+func TestDocumentService_GetAncestors_NotFound(t *testing.T) {
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, nil, nil)
+
+	_, err := svc.GetAncestors(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// reuseEmbeddings copies stored embeddings onto the chunks of a re-synced
+// document whose text is unchanged, so only edited chunks are embedded again.
+// Chunks are matched to the stored version of the document by content hash;
+// the stored version is looked up by URI, as a re-synced document may get a
+// new ID. Embeddings of another size, left by a previous model, are not
+// reused. A failed lookup only means every chunk is embedded.
+// Returns the number of chunks given a stored embedding.
+func (o *SyncOrchestrator) reuseEmbeddings(ctx context.Context, sourceID, uri string, chunks []domain.Chunk) int {
+	previous, err := o.docStore.GetDocumentByURI(ctx, sourceID, uri)
+	if err != nil {
+		if !errors.Is(err, domain.ErrNotFound) {
+			o.log.Debug("failed to get stored document for embedding reuse", "uri", uri, "error", err)
+		}
+		return 0
+	}
+	stored, err := o.docStore.GetChunks(ctx, previous.ID)
+	if err != nil {
+		o.log.Debug("failed to get stored chunks for embedding reuse", "uri", uri, "error", err)
+		return 0
+	}
+
+	dims := o.embeddingService.Dimensions()
+	embeddings := make(map[string][]float32, len(stored))
+	for i := range stored {
+		embedding := stored[i].Embedding
+		if len(embedding) == 0 || (dims > 0 && len(embedding) != dims) {
+			continue
+		}
+		embeddings[contentHash(stored[i].Content)] = embedding
+	}
+	if len(embeddings) == 0 {
+		return 0
+	}
+
+	reused := 0
+	for i := range chunks {
+		if embedding, ok := embeddings[contentHash(chunks[i].Content)]; ok {
+			chunks[i].Embedding = embedding
+			reused++
+		}
+	}
+	if reused > 0 {
+		o.log.Debug("reused embeddings of unchanged chunks", "uri", uri, "reused", reused, "chunks", len(chunks))
+	}
+	return reused
+}
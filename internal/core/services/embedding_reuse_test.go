@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	stdsync "sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// paragraphPipeline chunks a document by paragraph, like the chunker does
+// for prose, giving each chunk a new ID as the chunker does.
+type paragraphPipeline struct {
+	mu     stdsync.Mutex
+	nextID int
+}
+
+func (p *paragraphPipeline) Process(_ context.Context, doc *domain.Document) ([]domain.Chunk, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var chunks []domain.Chunk
+	for i, paragraph := range strings.Split(doc.Content, "\n\n") {
+		p.nextID++
+		chunks = append(chunks, domain.Chunk{
+			ID:         fmt.Sprintf("chunk-%d", p.nextID),
+			DocumentID: doc.ID,
+			Content:    paragraph,
+			Position:   i,
+		})
+	}
+	return chunks, nil
+}
+
+// versionedRegistry gives every normalised document a new ID, as the
+// normalisers do, so a re-synced document is stored under another ID.
+type versionedRegistry struct {
+	syncMockNormaliserRegistry
+	version int
+}
+
+func (r *versionedRegistry) Normalise(ctx context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	result, err := r.syncMockNormaliserRegistry.Normalise(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+	r.version++
+	result.Document.ID = fmt.Sprintf("%s-v%d", result.Document.ID, r.version)
+	return result, nil
+}
+
+// countingEmbeddingService counts the texts it embeds.
+type countingEmbeddingService struct {
+	syncMockEmbeddingService
+	mu    stdsync.Mutex
+	calls int
+}
+
+func (e *countingEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	e.mu.Lock()
+	e.calls++
+	e.mu.Unlock()
+	return e.syncMockEmbeddingService.Embed(ctx, text)
+}
+
+func (e *countingEmbeddingService) embedCalls() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls
+}
+
+// largeDocument returns the content of a document of n paragraphs, with
+// paragraph edited replaced.
+func largeDocument(n, edited int) []byte {
+	paragraphs := make([]string, n)
+	for i := range paragraphs {
+		paragraphs[i] = fmt.Sprintf("Paragraph %d of the handbook.", i)
+	}
+	if edited >= 0 {
+		paragraphs[edited] = "This paragraph was rewritten."
+	}
+	return []byte(strings.Join(paragraphs, "\n\n"))
+}
+
+// newReuseOrchestrator creates an orchestrator syncing one large document
+// from source src-1.
+func newReuseOrchestrator(
+	t *testing.T, docStore *memory.DocumentStore, embeddingService driven.EmbeddingService,
+	vectorIndex *syncMockVectorIndex,
+) (*SyncOrchestrator, *syncMockConnector) {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	require.NoError(t, sourceStore.Save(context.Background(), domain.Source{ID: "src-1", Name: "Docs", Type: "mock"}))
+	connector := &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "handbook.txt", MIMEType: "text/plain", Content: largeDocument(50, -1)},
+		},
+	}
+	factory := newSyncMockConnectorFactory()
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &versionedRegistry{}, &paragraphPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, embeddingService,
+	)
+	return orchestrator, connector
+}
+
+func TestSyncOrchestrator_Sync_ReusesEmbeddingsOfUnchangedChunks(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	embeddingService := &countingEmbeddingService{}
+	vectorIndex := newSyncMockVectorIndex()
+	orchestrator, connector := newReuseOrchestrator(t, docStore, embeddingService, vectorIndex)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, 50, embeddingService.embedCalls())
+
+	// Editing one paragraph of the 50 embeds only that paragraph
+	connector.fullSyncDocs[0].Content = largeDocument(50, 17)
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, 51, embeddingService.embedCalls())
+
+	doc, err := docStore.GetDocumentByURI(ctx, "src-1", "handbook.txt")
+	require.NoError(t, err)
+	chunks, err := docStore.GetChunks(ctx, doc.ID)
+	require.NoError(t, err)
+	require.Len(t, chunks, 50)
+	for _, chunk := range chunks {
+		assert.NotNil(t, chunk.Embedding, chunk.Content)
+		assert.Contains(t, vectorIndex.vectors, chunk.ID)
+	}
+}
+
+func TestSyncOrchestrator_Sync_ReembedsEmbeddingsOfAnotherSize(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	embeddingService := &countingEmbeddingService{}
+	orchestrator, connector := newReuseOrchestrator(t, docStore, embeddingService, newSyncMockVectorIndex())
+
+	// Embeddings stored by a model with other dimensions
+	stale := domain.Document{ID: "old", SourceID: "src-1", URI: "handbook.txt"}
+	require.NoError(t, docStore.SaveDocument(ctx, &stale))
+	stored, err := (&paragraphPipeline{}).Process(ctx, &domain.Document{
+		ID: "old", Content: string(connector.fullSyncDocs[0].Content),
+	})
+	require.NoError(t, err)
+	for i := range stored {
+		stored[i].ID = "old-" + stored[i].ID
+		stored[i].Embedding = []float32{1, 2}
+	}
+	require.NoError(t, docStore.SaveChunks(ctx, stored))
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, 50, embeddingService.embedCalls())
+}
+
+func TestSyncOrchestrator_Sync_QueuesOnlyChangedChunks(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	embeddingService := &countingEmbeddingService{}
+	vectorIndex := newSyncMockVectorIndex()
+	queue := newMockEmbeddingJobStore()
+	orchestrator, connector := newReuseOrchestrator(t, docStore, embeddingService, vectorIndex)
+	orchestrator.SetEmbeddingQueue(queue)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	worker := NewEmbeddingWorker(queue, docStore, embeddingService, vectorIndex, 4)
+	require.NoError(t, worker.Wait(ctx))
+	assert.Equal(t, 50, embeddingService.embedCalls())
+
+	connector.fullSyncDocs[0].Content = largeDocument(50, 3)
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	// Reused embeddings are indexed at once; only the edit is queued
+	pending, err := queue.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pending)
+	require.NoError(t, worker.Wait(ctx))
+	assert.Equal(t, 51, embeddingService.embedCalls())
+}
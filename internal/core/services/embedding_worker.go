@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
+)
+
+// Ensure EmbeddingWorker implements the interface.
+var _ driving.EmbeddingQueue = (*EmbeddingWorker)(nil)
+
+// defaultEmbeddingPollInterval is how long an idle worker waits before
+// checking the queue again.
+const defaultEmbeddingPollInterval = 2 * time.Second
+
+// EmbeddingWorker generates embeddings for chunks queued during sync.
+//
+// Sync stores chunks and queues them instead of waiting on the embedding
+// provider, which is the slowest step of indexing. The worker claims queued
+// jobs, embeds the chunk, saves the embedding and adds it to the vector index.
+// Chunks that fail to embed are retried with backoff, so a provider outage
+// heals without a resync, until they have been tried maxAttempts times.
+type EmbeddingWorker struct {
+	jobs             driven.EmbeddingJobStore
+	docStore         driven.DocumentStore
+	embeddingService driven.EmbeddingService
+	vectorIndex      driven.VectorIndex
+	concurrency      int
+	maxAttempts      int
+	pollInterval     time.Duration
+	log              *slog.Logger
+}
+
+// NewEmbeddingWorker creates an embedding worker.
+// The vectorIndex parameter is optional (can be nil).
+func NewEmbeddingWorker(
+	jobs driven.EmbeddingJobStore,
+	docStore driven.DocumentStore,
+	embeddingService driven.EmbeddingService,
+	vectorIndex driven.VectorIndex,
+	concurrency int,
+) *EmbeddingWorker {
+	if concurrency <= 0 {
+		concurrency = domain.DefaultEmbeddingWorkers
+	}
+
+	return &EmbeddingWorker{
+		jobs:             jobs,
+		docStore:         docStore,
+		embeddingService: embeddingService,
+		vectorIndex:      vectorIndex,
+		concurrency:      concurrency,
+		maxAttempts:      domain.DefaultEmbeddingMaxAttempts,
+		pollInterval:     defaultEmbeddingPollInterval,
+		log:              logger.Slog(),
+	}
+}
+
+// SetLogger sets the structured logger used for worker events.
+func (w *EmbeddingWorker) SetLogger(log *slog.Logger) {
+	if log != nil {
+		w.log = log
+	}
+}
+
+// SetPollInterval sets how long an idle worker waits before checking the queue again.
+func (w *EmbeddingWorker) SetPollInterval(interval time.Duration) {
+	if interval > 0 {
+		w.pollInterval = interval
+	}
+}
+
+// SetMaxAttempts sets how many times a chunk is tried before its embedding is given up on.
+func (w *EmbeddingWorker) SetMaxAttempts(attempts int) {
+	if attempts > 0 {
+		w.maxAttempts = attempts
+	}
+}
+
+// Start processes queued embeddings until the context is cancelled.
+// Jobs left running by a previous process are returned to the queue first.
+func (w *EmbeddingWorker) Start(ctx context.Context) error {
+	if err := w.jobs.ResetRunning(ctx); err != nil {
+		w.log.Warn("embedding worker: failed to reset running jobs", "error", err)
+	}
+
+	for {
+		processed, err := w.processBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			w.log.Warn("embedding worker: failed to claim jobs", "error", err)
+		}
+		if processed > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// Pending returns the number of chunks waiting for an embedding.
+func (w *EmbeddingWorker) Pending(ctx context.Context) (int, error) {
+	count, err := w.jobs.CountPending(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count pending embeddings: %w", err)
+	}
+	return count, nil
+}
+
+// Stats counts the chunks waiting for an embedding, waiting to be retried
+// and given up on.
+func (w *EmbeddingWorker) Stats(ctx context.Context) (domain.EmbeddingQueueStats, error) {
+	stats, err := w.jobs.Stats(ctx)
+	if err != nil {
+		return domain.EmbeddingQueueStats{}, fmt.Errorf("count embeddings: %w", err)
+	}
+	return stats, nil
+}
+
+// Wait processes queued embeddings and blocks until the queue is empty.
+// Jobs claimed by another process are waited for rather than processed.
+// Chunks waiting to be retried after a failure are left to the background worker.
+func (w *EmbeddingWorker) Wait(ctx context.Context) error {
+	if err := w.jobs.ResetRunning(ctx); err != nil {
+		return fmt.Errorf("reset running embeddings: %w", err)
+	}
+
+	for {
+		processed, err := w.processBatch(ctx)
+		if err != nil {
+			return fmt.Errorf("process embeddings: %w", err)
+		}
+		if processed > 0 {
+			continue
+		}
+
+		stats, err := w.Stats(ctx)
+		if err != nil {
+			return err
+		}
+		if stats.Ready() == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// processBatch claims up to one job per worker and processes them concurrently.
+// Returns the number of jobs claimed.
+func (w *EmbeddingWorker) processBatch(ctx context.Context) (int, error) {
+	jobs, err := w.jobs.Claim(ctx, w.concurrency)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for i := range jobs {
+		wg.Add(1)
+		go func(job domain.EmbeddingJob) {
+			defer wg.Done()
+			w.process(ctx, job)
+		}(jobs[i])
+	}
+	wg.Wait()
+
+	return len(jobs), nil
+}
+
+// process embeds one chunk and records the job outcome.
+// A failed attempt is retried after a backoff delay until the chunk has
+// been tried maxAttempts times.
+func (w *EmbeddingWorker) process(ctx context.Context, job domain.EmbeddingJob) {
+	status := domain.EmbeddingJobDone
+	if err := w.embed(ctx, job.ChunkID); err != nil {
+		status = domain.EmbeddingJobFailed
+		if ctx.Err() != nil {
+			// Interrupted rather than failed, so the next worker retries it
+			status = domain.EmbeddingJobPending
+		} else if attempts := job.Attempts + 1; attempts < w.maxAttempts {
+			delay := domain.EmbeddingRetryDelay(attempts)
+			w.log.Warn("embedding worker: failed to embed chunk, will retry",
+				"chunk_id", job.ChunkID, "attempt", attempts, "retry_in", delay, "error", err)
+			if err := w.jobs.Retry(ctx, job.ID, time.Now().Add(delay)); err != nil {
+				w.log.Warn("embedding worker: failed to update job", "job_id", job.ID, "error", err)
+			}
+			return
+		} else {
+			w.log.Warn("embedding worker: failed to embed chunk, giving up",
+				"chunk_id", job.ChunkID, "attempts", attempts, "error", err)
+		}
+	}
+
+	// Record the outcome even when the context was cancelled mid-job
+	if err := w.jobs.SetStatus(context.WithoutCancel(ctx), job.ID, status); err != nil {
+		w.log.Warn("embedding worker: failed to update job", "job_id", job.ID, "error", err)
+	}
+}
+
+// embed generates, stores and indexes the embedding for a chunk.
+// Chunks deleted since they were queued are skipped.
+func (w *EmbeddingWorker) embed(ctx context.Context, chunkID string) error {
+	chunk, err := w.docStore.GetChunk(ctx, chunkID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			w.log.Debug("embedding worker: chunk no longer exists", "chunk_id", chunkID)
+			return nil
+		}
+		return fmt.Errorf("get chunk: %w", err)
+	}
+
+	embedding, err := w.embeddingService.Embed(ctx, chunk.Content)
+	if err != nil {
+		return fmt.Errorf("embed chunk: %w", err)
+	}
+
+	// Only the embedding is written, so a chunk deleted or re-saved while it
+	// was being embedded is neither recreated nor reverted
+	if err := w.docStore.UpdateChunkEmbedding(ctx, chunkID, embedding); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			w.log.Debug("embedding worker: chunk deleted while embedding", "chunk_id", chunkID)
+			return nil
+		}
+		return fmt.Errorf("save embedding: %w", err)
+	}
+
+	if w.vectorIndex != nil {
+		if err := w.vectorIndex.Add(ctx, chunk.ID, embedding); err != nil {
+			return fmt.Errorf("add vector: %w", err)
+		}
+	}
+
+	return nil
+}
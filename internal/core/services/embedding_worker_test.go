@@ -0,0 +1,368 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockEmbeddingJobStore implements driven.EmbeddingJobStore in memory.
+type mockEmbeddingJobStore struct {
+	mu       sync.Mutex
+	jobs     map[int64]*domain.EmbeddingJob
+	nextID   int64
+	claimErr error
+}
+
+func newMockEmbeddingJobStore() *mockEmbeddingJobStore {
+	return &mockEmbeddingJobStore{jobs: make(map[int64]*domain.EmbeddingJob)}
+}
+
+func (m *mockEmbeddingJobStore) Enqueue(_ context.Context, chunkIDs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range chunkIDs {
+		m.nextID++
+		m.jobs[m.nextID] = &domain.EmbeddingJob{ID: m.nextID, ChunkID: id, Status: domain.EmbeddingJobPending}
+	}
+	return nil
+}
+
+func (m *mockEmbeddingJobStore) Claim(_ context.Context, limit int) ([]domain.EmbeddingJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.claimErr != nil {
+		return nil, m.claimErr
+	}
+
+	ids := make([]int64, 0, len(m.jobs))
+	now := time.Now()
+	for id, job := range m.jobs {
+		if job.Status == domain.EmbeddingJobPending && !job.RetryAt.After(now) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var claimed []domain.EmbeddingJob
+	for _, id := range ids {
+		if len(claimed) == limit {
+			break
+		}
+		m.jobs[id].Status = domain.EmbeddingJobRunning
+		claimed = append(claimed, *m.jobs[id])
+	}
+	return claimed, nil
+}
+
+func (m *mockEmbeddingJobStore) SetStatus(_ context.Context, jobID int64, status domain.EmbeddingJobStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	job.Status = status
+	return nil
+}
+
+func (m *mockEmbeddingJobStore) Retry(_ context.Context, jobID int64, retryAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	job.Status = domain.EmbeddingJobPending
+	job.Attempts++
+	job.RetryAt = retryAt
+	return nil
+}
+
+func (m *mockEmbeddingJobStore) Stats(_ context.Context) (domain.EmbeddingQueueStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var stats domain.EmbeddingQueueStats
+	for _, job := range m.jobs {
+		switch job.Status {
+		case domain.EmbeddingJobPending:
+			stats.Pending++
+			if job.RetryAt.After(time.Now()) {
+				stats.Retrying++
+			}
+		case domain.EmbeddingJobRunning:
+			stats.Pending++
+		case domain.EmbeddingJobFailed:
+			stats.Failed++
+		}
+	}
+	return stats, nil
+}
+
+func (m *mockEmbeddingJobStore) CountPending(_ context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := 0
+	for _, job := range m.jobs {
+		if job.Status == domain.EmbeddingJobPending || job.Status == domain.EmbeddingJobRunning {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockEmbeddingJobStore) ResetRunning(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, job := range m.jobs {
+		if job.Status == domain.EmbeddingJobRunning {
+			job.Status = domain.EmbeddingJobPending
+		}
+	}
+	return nil
+}
+
+// statuses returns the status of each queued chunk.
+func (m *mockEmbeddingJobStore) statuses() map[string]domain.EmbeddingJobStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	statuses := make(map[string]domain.EmbeddingJobStatus, len(m.jobs))
+	for _, job := range m.jobs {
+		statuses[job.ChunkID] = job.Status
+	}
+	return statuses
+}
+
+// setupEmbeddingChunks saves a document with the given chunks.
+func setupEmbeddingChunks(t *testing.T, chunkIDs ...string) *memory.DocumentStore {
+	t.Helper()
+	store := memory.NewDocumentStore()
+	ctx := context.Background()
+	require.NoError(t, store.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1"}))
+
+	chunks := make([]domain.Chunk, len(chunkIDs))
+	for i, id := range chunkIDs {
+		chunks[i] = domain.Chunk{ID: id, DocumentID: "doc-1", Content: "content " + id, Position: i}
+	}
+	require.NoError(t, store.SaveChunks(ctx, chunks))
+	return store
+}
+
+func TestEmbeddingWorker_Wait_ProcessesQueue(t *testing.T) {
+	ctx := context.Background()
+	docStore := setupEmbeddingChunks(t, "chunk-1", "chunk-2", "chunk-3")
+	jobs := newMockEmbeddingJobStore()
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-1", "chunk-2", "chunk-3"}))
+	vectorIndex := newSyncMockVectorIndex()
+	embedding := []float32{0.1, 0.2}
+
+	worker := NewEmbeddingWorker(jobs, docStore, &mockEmbeddingService{embedding: embedding}, vectorIndex, 2)
+
+	require.NoError(t, worker.Wait(ctx))
+
+	pending, err := worker.Pending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, pending)
+	assert.Len(t, vectorIndex.vectors, 3)
+
+	chunk, err := docStore.GetChunk(ctx, "chunk-2")
+	require.NoError(t, err)
+	assert.Equal(t, embedding, chunk.Embedding)
+	assert.Equal(t, "content chunk-2", chunk.Content)
+
+	for id, status := range jobs.statuses() {
+		assert.Equal(t, domain.EmbeddingJobDone, status, id)
+	}
+}
+
+func TestEmbeddingWorker_EmbedError_MarksFailed(t *testing.T) {
+	ctx := context.Background()
+	docStore := setupEmbeddingChunks(t, "chunk-1")
+	jobs := newMockEmbeddingJobStore()
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-1"}))
+
+	worker := NewEmbeddingWorker(jobs, docStore, &mockEmbeddingService{embedErr: errors.New("provider down")}, nil, 1)
+	// No retries left after the first attempt
+	worker.SetMaxAttempts(1)
+
+	require.NoError(t, worker.Wait(ctx))
+
+	assert.Equal(t, domain.EmbeddingJobFailed, jobs.statuses()["chunk-1"])
+}
+
+func TestEmbeddingWorker_EmbedError_RetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	docStore := setupEmbeddingChunks(t, "chunk-1")
+	jobs := newMockEmbeddingJobStore()
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-1"}))
+	embedService := &mockEmbeddingService{embedErr: errors.New("provider down")}
+
+	worker := NewEmbeddingWorker(jobs, docStore, embedService, nil, 1)
+
+	// Wait leaves the retry to the background worker instead of blocking on it
+	require.NoError(t, worker.Wait(ctx))
+
+	job := jobs.jobs[1]
+	assert.Equal(t, domain.EmbeddingJobPending, job.Status)
+	assert.Equal(t, 1, job.Attempts)
+	assert.WithinDuration(t, time.Now().Add(domain.EmbeddingRetryBaseDelay), job.RetryAt, 5*time.Second)
+
+	stats, err := worker.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.EmbeddingQueueStats{Pending: 1, Retrying: 1}, stats)
+
+	// Once the provider is back, the retry fills in the vector
+	job.RetryAt = time.Now().Add(-time.Second)
+	embedService.embedErr = nil
+	embedService.embedding = []float32{1}
+	require.NoError(t, worker.Wait(ctx))
+
+	assert.Equal(t, domain.EmbeddingJobDone, jobs.statuses()["chunk-1"])
+	chunk, err := docStore.GetChunk(ctx, "chunk-1")
+	require.NoError(t, err)
+	assert.Equal(t, []float32{1}, chunk.Embedding)
+}
+
+func TestEmbeddingWorker_EmbedError_GivesUpAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	docStore := setupEmbeddingChunks(t, "chunk-1")
+	jobs := newMockEmbeddingJobStore()
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-1"}))
+	jobs.jobs[1].Attempts = 2
+
+	worker := NewEmbeddingWorker(jobs, docStore, &mockEmbeddingService{embedErr: errors.New("provider down")}, nil, 1)
+	worker.SetMaxAttempts(3)
+
+	require.NoError(t, worker.Wait(ctx))
+
+	assert.Equal(t, domain.EmbeddingJobFailed, jobs.statuses()["chunk-1"])
+	stats, err := worker.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Failed)
+}
+
+func TestEmbeddingWorker_DeletedChunk_MarksDone(t *testing.T) {
+	ctx := context.Background()
+	jobs := newMockEmbeddingJobStore()
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-gone"}))
+	embedService := &mockEmbeddingService{embedErr: errors.New("should not be called")}
+
+	worker := NewEmbeddingWorker(jobs, memory.NewDocumentStore(), embedService, nil, 1)
+
+	require.NoError(t, worker.Wait(ctx))
+
+	assert.Equal(t, domain.EmbeddingJobDone, jobs.statuses()["chunk-gone"])
+}
+
+// deletingEmbeddingService deletes a document while its chunk is being embedded.
+type deletingEmbeddingService struct {
+	mockEmbeddingService
+	docStore *memory.DocumentStore
+	docID    string
+}
+
+func (s *deletingEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	if err := s.docStore.DeleteDocument(ctx, s.docID); err != nil {
+		return nil, err
+	}
+	return s.mockEmbeddingService.Embed(ctx, text)
+}
+
+func TestEmbeddingWorker_ChunkDeletedWhileEmbedding(t *testing.T) {
+	ctx := context.Background()
+	docStore := setupEmbeddingChunks(t, "chunk-1")
+	jobs := newMockEmbeddingJobStore()
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-1"}))
+	vectorIndex := newSyncMockVectorIndex()
+	embedService := &deletingEmbeddingService{
+		mockEmbeddingService: mockEmbeddingService{embedding: []float32{0.1}},
+		docStore:             docStore,
+		docID:                "doc-1",
+	}
+
+	worker := NewEmbeddingWorker(jobs, docStore, embedService, vectorIndex, 1)
+
+	require.NoError(t, worker.Wait(ctx))
+
+	// The chunk is neither recreated nor added to the vector index
+	_, err := docStore.GetChunk(ctx, "chunk-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Empty(t, vectorIndex.vectors)
+	assert.Equal(t, domain.EmbeddingJobDone, jobs.statuses()["chunk-1"])
+}
+
+func TestEmbeddingWorker_Wait_ClaimError(t *testing.T) {
+	jobs := newMockEmbeddingJobStore()
+	jobs.claimErr = errors.New("database locked")
+
+	worker := NewEmbeddingWorker(jobs, memory.NewDocumentStore(), &mockEmbeddingService{}, nil, 1)
+
+	err := worker.Wait(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "database locked")
+}
+
+func TestEmbeddingWorker_Wait_ResetsStaleJobs(t *testing.T) {
+	ctx := context.Background()
+	docStore := setupEmbeddingChunks(t, "chunk-1")
+	jobs := newMockEmbeddingJobStore()
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-1"}))
+	// Claimed by a process that exited before finishing
+	_, err := jobs.Claim(ctx, 1)
+	require.NoError(t, err)
+
+	worker := NewEmbeddingWorker(jobs, docStore, &mockEmbeddingService{embedding: []float32{1}}, nil, 1)
+
+	require.NoError(t, worker.Wait(ctx))
+
+	assert.Equal(t, domain.EmbeddingJobDone, jobs.statuses()["chunk-1"])
+}
+
+func TestEmbeddingWorker_Start_ProcessesUntilCancelled(t *testing.T) {
+	docStore := setupEmbeddingChunks(t, "chunk-1")
+	jobs := newMockEmbeddingJobStore()
+	vectorIndex := newSyncMockVectorIndex()
+
+	worker := NewEmbeddingWorker(jobs, docStore, &mockEmbeddingService{embedding: []float32{1}}, vectorIndex, 1)
+	worker.SetPollInterval(5 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- worker.Start(ctx) }()
+
+	// Jobs queued after the worker starts are picked up on the next poll
+	require.NoError(t, jobs.Enqueue(ctx, []string{"chunk-1"}))
+	require.Eventually(t, func() bool {
+		return jobs.statuses()["chunk-1"] == domain.EmbeddingJobDone
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop after cancellation")
+	}
+	assert.Len(t, vectorIndex.vectors, 1)
+}
+
+func TestNewEmbeddingWorker_DefaultConcurrency(t *testing.T) {
+	worker := NewEmbeddingWorker(newMockEmbeddingJobStore(), memory.NewDocumentStore(), &mockEmbeddingService{}, nil, 0)
+
+	assert.Equal(t, domain.DefaultEmbeddingWorkers, worker.concurrency)
+	assert.Equal(t, domain.DefaultEmbeddingMaxAttempts, worker.maxAttempts)
+
+	// Non-positive limits keep the default
+	worker.SetMaxAttempts(0)
+	assert.Equal(t, domain.DefaultEmbeddingMaxAttempts, worker.maxAttempts)
+}
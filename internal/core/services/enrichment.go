@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"maps"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
+)
+
+// Enrichment limits.
+const (
+	// maxEnrichmentKeywords caps the number of keywords extracted per document.
+	maxEnrichmentKeywords = 15
+
+	// maxEnrichmentContent caps the content sent to the LLM (in bytes).
+	maxEnrichmentContent = 8000
+
+	// enrichmentQueueSize is the number of documents that can wait for enrichment.
+	enrichmentQueueSize = 1024
+)
+
+// enrichmentJob is a document waiting for keyword extraction.
+type enrichmentJob struct {
+	doc domain.Document
+}
+
+// EnrichmentService extracts keywords and entities from documents using an LLM
+// and indexes them as additional searchable terms.
+//
+// Extraction runs on background workers so it does not slow down sync.
+// Results are cached by content hash, so unchanged documents are only sent
+// to the LLM once per process.
+type EnrichmentService struct {
+	llmService  driven.LLMService
+	docStore    driven.DocumentStore
+	searchIndex driven.SearchEngine
+
+	jobs      chan enrichmentJob
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+
+	mu    sync.RWMutex
+	cache map[string][]string // content hash -> keywords
+}
+
+// NewEnrichmentService creates an enrichment service and starts its workers.
+// Call Close to wait for queued documents to finish.
+func NewEnrichmentService(
+	llmService driven.LLMService,
+	docStore driven.DocumentStore,
+	searchIndex driven.SearchEngine,
+	workers int,
+) *EnrichmentService {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	s := &EnrichmentService{
+		llmService:  llmService,
+		docStore:    docStore,
+		searchIndex: searchIndex,
+		jobs:        make(chan enrichmentJob, enrichmentQueueSize),
+		cache:       make(map[string][]string),
+	}
+
+	for range workers {
+		s.wg.Add(1)
+		go s.worker()
+	}
+
+	return s
+}
+
+// Enqueue schedules a saved document for enrichment.
+// If the content was enriched before, the cached keywords are applied immediately.
+// Returns false if the queue is full and the document was skipped.
+func (s *EnrichmentService) Enqueue(ctx context.Context, doc *domain.Document) bool {
+	hash := contentHash(doc.Content)
+	if keywords, ok := s.cached(hash); ok {
+		if err := s.apply(ctx, doc.ID, hash, keywords); err != nil {
+			logger.Debug("Enrichment: failed to apply cached keywords to %s: %v", doc.ID, err)
+		}
+		return true
+	}
+
+	select {
+	case s.jobs <- enrichmentJob{doc: *doc}:
+		return true
+	default:
+		logger.Debug("Enrichment: queue full, skipping %s", doc.ID)
+		return false
+	}
+}
+
+// Close stops accepting documents and waits for queued enrichment to finish.
+func (s *EnrichmentService) Close() {
+	s.closeOnce.Do(func() {
+		close(s.jobs)
+	})
+	s.wg.Wait()
+}
+
+// worker processes enrichment jobs until the queue is closed.
+func (s *EnrichmentService) worker() {
+	defer s.wg.Done()
+
+	for job := range s.jobs {
+		if err := s.enrich(context.Background(), &job.doc); err != nil {
+			logger.Debug("Enrichment: failed for %s: %v", job.doc.ID, err)
+		}
+	}
+}
+
+// enrich extracts keywords for a document and applies them.
+func (s *EnrichmentService) enrich(ctx context.Context, doc *domain.Document) error {
+	hash := contentHash(doc.Content)
+
+	keywords, ok := s.cached(hash)
+	if !ok {
+		content := doc.Content
+		if len(content) > maxEnrichmentContent {
+			content = content[:maxEnrichmentContent]
+		}
+
+		var err error
+		keywords, err = s.llmService.ExtractKeywords(ctx, content, maxEnrichmentKeywords)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		s.cache[hash] = keywords
+		s.mu.Unlock()
+	}
+
+	logger.Debug("Enrichment: %d keywords for %s", len(keywords), doc.ID)
+	return s.apply(ctx, doc.ID, hash, keywords)
+}
+
+// apply stores keywords in the metadata of a stored document and its chunks
+// and re-indexes the chunks. The document is re-read first and skipped if it
+// was deleted or its content no longer matches the hash the keywords were
+// extracted from. Only the keywords are written; everything else is kept as
+// currently stored.
+func (s *EnrichmentService) apply(ctx context.Context, docID, hash string, keywords []string) error {
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	doc, err := s.docStore.GetDocument(ctx, docID)
+	if errors.Is(err, domain.ErrNotFound) {
+		logger.Debug("Enrichment: %s was deleted, skipping", docID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if contentHash(doc.Content) != hash {
+		logger.Debug("Enrichment: %s changed since extraction, skipping", docID)
+		return nil
+	}
+
+	chunks, err := s.docStore.GetChunks(ctx, docID)
+	if err != nil {
+		return err
+	}
+
+	doc.Metadata = withKeywords(doc.Metadata, keywords)
+	if err := s.docStore.SaveDocument(ctx, doc); err != nil {
+		return err
+	}
+
+	for i := range chunks {
+		chunks[i].Metadata = withKeywords(chunks[i].Metadata, keywords)
+	}
+	if err := s.docStore.SaveChunks(ctx, chunks); err != nil {
+		return err
+	}
+
+	if s.searchIndex == nil {
+		return nil
+	}
+	for _, chunk := range chunks {
+		if err := s.searchIndex.Index(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withKeywords returns a copy of metadata with the keywords set. The original
+// map is left untouched since the store may share it with other readers.
+func withKeywords(metadata map[string]any, keywords []string) map[string]any {
+	updated := maps.Clone(metadata)
+	if updated == nil {
+		updated = make(map[string]any, 1)
+	}
+	updated[domain.MetadataKeywords] = keywords
+	return updated
+}
+
+// cached returns keywords previously extracted for a content hash.
+func (s *EnrichmentService) cached(hash string) ([]string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keywords, ok := s.cache[hash]
+	return keywords, ok
+}
+
+// contentHash returns a stable hash of document content for caching.
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func saveEnrichmentTestDoc(t *testing.T, store *memory.DocumentStore, id, content string) *domain.Document {
+	t.Helper()
+	ctx := context.Background()
+	doc := &domain.Document{
+		ID:        id,
+		SourceID:  "src-1",
+		URI:       "file://" + id,
+		Title:     id,
+		Content:   content,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	chunks := []domain.Chunk{{ID: "chunk-" + id, DocumentID: id, Content: content}}
+	require.NoError(t, store.SaveDocument(ctx, doc))
+	require.NoError(t, store.SaveChunks(ctx, chunks))
+	return doc
+}
+
+func TestEnrichmentService_EnrichesInBackground(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	searchEngine := &mockSearchEngine{}
+	llm := &mockLLMService{keywords: []string{"kubernetes", "container orchestration"}}
+	service := NewEnrichmentService(llm, docStore, searchEngine, 2)
+	ctx := context.Background()
+
+	doc := saveEnrichmentTestDoc(t, docStore, "doc-1", "Pods are scheduled onto nodes.")
+	assert.True(t, service.Enqueue(ctx, doc))
+	service.Close()
+
+	stored, err := docStore.GetDocument(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kubernetes", "container orchestration"}, stored.Metadata[domain.MetadataKeywords])
+
+	storedChunks, err := docStore.GetChunks(ctx, "doc-1")
+	require.NoError(t, err)
+	require.Len(t, storedChunks, 1)
+	assert.Equal(t, []string{"kubernetes", "container orchestration"}, storedChunks[0].Metadata[domain.MetadataKeywords])
+
+	require.Len(t, searchEngine.indexed, 1)
+	assert.Equal(t, "chunk-doc-1", searchEngine.indexed[0].ID)
+	assert.Equal(t, []string{"kubernetes", "container orchestration"},
+		searchEngine.indexed[0].Metadata[domain.MetadataKeywords])
+}
+
+func TestEnrichmentService_CachesByContentHash(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	llm := &mockLLMService{keywords: []string{"sercha"}}
+	service := NewEnrichmentService(llm, docStore, &mockSearchEngine{}, 1)
+	ctx := context.Background()
+
+	doc := saveEnrichmentTestDoc(t, docStore, "doc-1", "same content")
+	service.Enqueue(ctx, doc)
+	service.Close()
+
+	// Identical content is served from the cache without touching the queue.
+	doc2 := saveEnrichmentTestDoc(t, docStore, "doc-2", "same content")
+	assert.True(t, service.Enqueue(ctx, doc2))
+
+	assert.Equal(t, int32(1), llm.keywordCalls.Load())
+
+	stored, err := docStore.GetDocument(ctx, "doc-2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sercha"}, stored.Metadata[domain.MetadataKeywords])
+}
+
+func TestEnrichmentService_LLMErrorLeavesDocumentUnchanged(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	searchEngine := &mockSearchEngine{}
+	llm := &mockLLMService{keywordsErr: errors.New("llm unavailable")}
+	service := NewEnrichmentService(llm, docStore, searchEngine, 1)
+	ctx := context.Background()
+
+	doc := saveEnrichmentTestDoc(t, docStore, "doc-1", "content")
+	service.Enqueue(ctx, doc)
+	service.Close()
+
+	stored, err := docStore.GetDocument(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.NotContains(t, stored.Metadata, domain.MetadataKeywords)
+	assert.Empty(t, searchEngine.indexed)
+}
+
+func TestEnrichmentService_SkipsChangedAndDeletedDocuments(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	searchEngine := &mockSearchEngine{}
+	service := NewEnrichmentService(&mockLLMService{}, docStore, searchEngine, 1)
+	defer service.Close()
+	ctx := context.Background()
+
+	// Keywords extracted from content the document no longer has are dropped
+	saveEnrichmentTestDoc(t, docStore, "doc-1", "edited content")
+	require.NoError(t, service.apply(ctx, "doc-1", contentHash("original content"), []string{"stale"}))
+
+	stored, err := docStore.GetDocument(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.NotContains(t, stored.Metadata, domain.MetadataKeywords)
+
+	// A document deleted before its keywords arrive is not recreated
+	require.NoError(t, service.apply(ctx, "doc-2", contentHash("gone"), []string{"stale"}))
+
+	_, err = docStore.GetDocument(ctx, "doc-2")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Empty(t, searchEngine.indexed)
+}
+
+func TestEnrichmentService_KeepsStoredChangesAndCallerMetadata(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	service := NewEnrichmentService(&mockLLMService{keywords: []string{"sercha"}}, docStore, nil, 1)
+	ctx := context.Background()
+
+	doc := saveEnrichmentTestDoc(t, docStore, "doc-1", "content")
+	callerMetadata := map[string]any{"author": "alice"}
+	doc.Metadata = callerMetadata
+
+	// A title change saved after the document was queued must survive
+	renamed := *doc
+	renamed.Title = "Renamed"
+	renamed.Metadata = map[string]any{"author": "alice"}
+	require.NoError(t, docStore.SaveDocument(ctx, &renamed))
+
+	service.Enqueue(ctx, doc)
+	service.Close()
+
+	stored, err := docStore.GetDocument(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", stored.Title)
+	assert.Equal(t, []string{"sercha"}, stored.Metadata[domain.MetadataKeywords])
+	assert.Equal(t, "alice", stored.Metadata["author"])
+	assert.Equal(t, map[string]any{"author": "alice"}, callerMetadata)
+	assert.NotContains(t, renamed.Metadata, domain.MetadataKeywords)
+}
+
+func TestEnrichmentService_CloseIsIdempotent(t *testing.T) {
+	service := NewEnrichmentService(&mockLLMService{}, memory.NewDocumentStore(), nil, 1)
+
+	service.Close()
+	service.Close()
+}
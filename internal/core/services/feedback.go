@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// feedbackWeight is the largest fraction by which feedback raises or lowers
+// a score. A document rated relevant for the exact query scores 1.5x; one
+// rated irrelevant scores 0.5x.
+const feedbackWeight = 0.5
+
+// Ensure FeedbackService implements the interface.
+var _ driving.FeedbackService = (*FeedbackService)(nil)
+
+// FeedbackService records relevance feedback on search results.
+type FeedbackService struct {
+	store driven.FeedbackStore
+}
+
+// NewFeedbackService creates a new feedback service.
+func NewFeedbackService(store driven.FeedbackStore) *FeedbackService {
+	return &FeedbackService{
+		store: store,
+	}
+}
+
+// Rate records a signal for a document returned for a query.
+func (s *FeedbackService) Rate(
+	ctx context.Context, query, documentID string, signal domain.FeedbackSignal,
+) error {
+	if s.store == nil {
+		return domain.ErrNotImplemented
+	}
+	query = domain.NormaliseQuery(query)
+	if query == "" || documentID == "" || !signal.IsValid() {
+		return domain.ErrInvalidInput
+	}
+	return s.store.Record(ctx, &domain.Feedback{
+		Query:      query,
+		DocumentID: documentID,
+		Signal:     signal,
+		CreatedAt:  time.Now(),
+	})
+}
+
+// Unrate removes the feedback for a document returned for a query.
+func (s *FeedbackService) Unrate(ctx context.Context, query, documentID string) error {
+	if s.store == nil {
+		return domain.ErrNotImplemented
+	}
+	return s.store.Remove(ctx, domain.NormaliseQuery(query), documentID)
+}
+
+// Clear deletes all recorded feedback.
+func (s *FeedbackService) Clear(ctx context.Context) error {
+	if s.store == nil {
+		return domain.ErrNotImplemented
+	}
+	return s.store.Clear(ctx)
+}
+
+// feedbackBoost returns the net feedback on a document for a query, in [-1, 1].
+// Each rating counts in proportion to how similar its query is to this one,
+// so rating a result for "oauth refresh" also nudges it for "oauth token refresh".
+func feedbackBoost(query string, feedback []domain.Feedback) float64 {
+	var boost float64
+	for _, f := range feedback {
+		boost += f.Signal.Weight() * querySimilarity(query, f.Query)
+	}
+	return max(-1, min(1, boost))
+}
+
+// querySimilarity returns the Jaccard similarity of the terms of two
+// normalised queries: 1 for the same terms, 0 for no terms in common.
+func querySimilarity(a, b string) float64 {
+	termsA := make(map[string]bool)
+	for _, t := range strings.Fields(a) {
+		termsA[t] = true
+	}
+	termsB := make(map[string]bool)
+	for _, t := range strings.Fields(b) {
+		termsB[t] = true
+	}
+
+	shared := 0
+	for t := range termsB {
+		if termsA[t] {
+			shared++
+		}
+	}
+	union := len(termsA) + len(termsB) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
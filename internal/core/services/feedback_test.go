@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockFeedbackStore is an in-memory FeedbackStore for testing.
+type mockFeedbackStore struct {
+	feedback []domain.Feedback
+	err      error
+}
+
+func (m *mockFeedbackStore) Record(_ context.Context, feedback *domain.Feedback) error {
+	_ = m.Remove(context.Background(), feedback.Query, feedback.DocumentID)
+	m.feedback = append(m.feedback, *feedback)
+	return nil
+}
+
+func (m *mockFeedbackStore) Remove(_ context.Context, query, documentID string) error {
+	kept := m.feedback[:0]
+	for _, f := range m.feedback {
+		if f.Query != query || f.DocumentID != documentID {
+			kept = append(kept, f)
+		}
+	}
+	m.feedback = kept
+	return nil
+}
+
+func (m *mockFeedbackStore) ForDocuments(_ context.Context, documentIDs []string) ([]domain.Feedback, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	wanted := make(map[string]bool)
+	for _, id := range documentIDs {
+		wanted[id] = true
+	}
+	var result []domain.Feedback
+	for _, f := range m.feedback {
+		if wanted[f.DocumentID] {
+			result = append(result, f)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockFeedbackStore) Clear(_ context.Context) error {
+	m.feedback = nil
+	return nil
+}
+
+func TestFeedbackService_RateNormalisesQuery(t *testing.T) {
+	store := &mockFeedbackStore{}
+	service := NewFeedbackService(store)
+	ctx := context.Background()
+
+	require.NoError(t, service.Rate(ctx, "  OAuth   Refresh ", "doc-1", domain.FeedbackRelevant))
+	require.Len(t, store.feedback, 1)
+	assert.Equal(t, "oauth refresh", store.feedback[0].Query)
+	assert.False(t, store.feedback[0].CreatedAt.IsZero())
+
+	require.NoError(t, service.Unrate(ctx, "oauth REFRESH", "doc-1"))
+	assert.Empty(t, store.feedback)
+}
+
+func TestFeedbackService_Rate_InvalidInput(t *testing.T) {
+	service := NewFeedbackService(&mockFeedbackStore{})
+	ctx := context.Background()
+
+	assert.ErrorIs(t, service.Rate(ctx, " ", "doc-1", domain.FeedbackRelevant), domain.ErrInvalidInput)
+	assert.ErrorIs(t, service.Rate(ctx, "q", "", domain.FeedbackRelevant), domain.ErrInvalidInput)
+	assert.ErrorIs(t, service.Rate(ctx, "q", "doc-1", "meh"), domain.ErrInvalidInput)
+}
+
+func TestFeedbackService_NilStore(t *testing.T) {
+	service := NewFeedbackService(nil)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, service.Rate(ctx, "q", "doc-1", domain.FeedbackRelevant), domain.ErrNotImplemented)
+	assert.ErrorIs(t, service.Unrate(ctx, "q", "doc-1"), domain.ErrNotImplemented)
+	assert.ErrorIs(t, service.Clear(ctx), domain.ErrNotImplemented)
+}
+
+func TestQuerySimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, querySimilarity("oauth refresh", "oauth refresh"))
+	assert.Equal(t, 1.0, querySimilarity("refresh oauth", "oauth refresh"))
+	assert.InDelta(t, 2.0/3.0, querySimilarity("oauth token refresh", "oauth refresh"), 1e-9)
+	assert.Equal(t, 0.0, querySimilarity("oauth", "calendar"))
+	assert.Equal(t, 0.0, querySimilarity("", ""))
+}
+
+func TestFeedbackBoost_Clamped(t *testing.T) {
+	feedback := []domain.Feedback{
+		{Query: "oauth", Signal: domain.FeedbackRelevant},
+		{Query: "oauth", Signal: domain.FeedbackRelevant},
+	}
+	assert.Equal(t, 1.0, feedbackBoost("oauth", feedback))
+	assert.Equal(t, 0.0, feedbackBoost("calendar", feedback))
+}
+
+func TestSearchService_Search_AppliesFeedback(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	service := NewSearchService(docStore, &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+	service.SetFeedbackStore(&mockFeedbackStore{feedback: []domain.Feedback{
+		{Query: "sercha", DocumentID: "doc-3", Signal: domain.FeedbackRelevant},
+		{Query: "sercha", DocumentID: "doc-1", Signal: domain.FeedbackIrrelevant},
+		{Query: "unrelated", DocumentID: "doc-2", Signal: domain.FeedbackIrrelevant},
+	}})
+
+	results, err := service.Search(context.Background(), "Sercha", domain.SearchOptions{})
+	require.NoError(t, err)
+
+	// doc-3: 0.7 * 1.5, doc-2: unchanged 0.8, doc-1: 0.9 * 0.5
+	assert.Equal(t, []string{"doc-3", "doc-2", "doc-1"}, resultDocIDs(results))
+	assert.InDelta(t, 1.05, results[0].Score, 1e-9)
+	assert.Equal(t, domain.FeedbackRelevant, results[0].Feedback)
+	assert.Empty(t, results[1].Feedback)
+	assert.Equal(t, domain.FeedbackIrrelevant, results[2].Feedback)
+}
+
+func TestSearchService_Search_FeedbackErrorIgnored(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	service := NewSearchService(docStore, &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+	service.SetFeedbackStore(&mockFeedbackStore{err: errors.New("database locked")})
+
+	results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-1", "doc-2", "doc-3"}, resultDocIDs(results))
+}
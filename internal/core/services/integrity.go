@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure IntegrityService implements the interface.
+var _ driving.IntegrityService = (*IntegrityService)(nil)
+
+// IntegrityService verifies and repairs the keyword and vector indexes
+// against the document store, which is the source of truth.
+type IntegrityService struct {
+	sourceStore    driven.SourceStore
+	docStore       driven.DocumentStore
+	exclusionStore driven.ExclusionStore
+	searchEngine   driven.SearchEngine
+	vectorIndex    driven.VectorIndex // Optional, nil when embeddings are disabled
+}
+
+// NewIntegrityService creates a new integrity service.
+func NewIntegrityService(
+	sourceStore driven.SourceStore,
+	docStore driven.DocumentStore,
+	exclusionStore driven.ExclusionStore,
+	searchEngine driven.SearchEngine,
+	vectorIndex driven.VectorIndex,
+) *IntegrityService {
+	return &IntegrityService{
+		sourceStore:    sourceStore,
+		docStore:       docStore,
+		exclusionStore: exclusionStore,
+		searchEngine:   searchEngine,
+		vectorIndex:    vectorIndex,
+	}
+}
+
+// Verify cross-checks every non-excluded document against the indexes.
+// An index that cannot list its entries is skipped and marked unchecked.
+//
+//nolint:gocognit // Walks every source, document and chunk
+func (s *IntegrityService) Verify(ctx context.Context) (*domain.IntegrityReport, error) {
+	report := &domain.IntegrityReport{}
+
+	keywordIDs, err := listChunks(ctx, s.searchEngine)
+	if err != nil {
+		return nil, fmt.Errorf("list keyword index: %w", err)
+	}
+	report.KeywordChecked = keywordIDs != nil
+
+	var vectorIDs map[string]bool
+	if s.vectorIndex != nil {
+		if vectorIDs, err = listChunks(ctx, s.vectorIndex); err != nil {
+			return nil, fmt.Errorf("list vector index: %w", err)
+		}
+	}
+	report.VectorChecked = vectorIDs != nil
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	// Every stored chunk, excluded or not, so its index entries are not orphans
+	stored := make(map[string]bool)
+	for i := range sources {
+		excluded, err := s.excludedURIs(ctx, sources[i].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.eachDocument(ctx, sources[i].ID, func(doc *domain.Document) error {
+			chunks, err := s.docStore.GetChunks(ctx, doc.ID)
+			if err != nil {
+				return fmt.Errorf("get chunks for %s: %w", doc.ID, err)
+			}
+			for j := range chunks {
+				stored[chunks[j].ID] = true
+			}
+			if excluded[doc.URI] {
+				return nil
+			}
+
+			report.Documents++
+			for j := range chunks {
+				chunk := &chunks[j]
+				report.Chunks++
+				if report.KeywordChecked && !keywordIDs[chunk.ID] {
+					report.Issues = append(report.Issues, missingIssue(domain.IssueMissingKeyword, doc, chunk))
+				}
+				if len(chunk.Embedding) == 0 {
+					continue
+				}
+				report.Embedded++
+				if report.VectorChecked && !vectorIDs[chunk.ID] {
+					report.Issues = append(report.Issues, missingIssue(domain.IssueMissingVector, doc, chunk))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keywordOrphans, err := s.orphans(ctx, keywordIDs, stored, domain.IssueOrphanKeyword)
+	if err != nil {
+		return nil, err
+	}
+	vectorOrphans, err := s.orphans(ctx, vectorIDs, stored, domain.IssueOrphanVector)
+	if err != nil {
+		return nil, err
+	}
+	report.Issues = append(report.Issues, keywordOrphans...)
+	report.Issues = append(report.Issues, vectorOrphans...)
+
+	return report, nil
+}
+
+// Repair reindexes documents with missing index entries from the document
+// store and deletes orphaned entries. It stops at the first failure.
+func (s *IntegrityService) Repair(ctx context.Context, report *domain.IntegrityReport) (int, error) {
+	if report == nil || len(report.Issues) == 0 {
+		return 0, nil
+	}
+	if s.searchEngine == nil {
+		return 0, domain.ErrSearchUnavailable
+	}
+
+	issuesByDoc := make(map[string]int)
+	for _, issue := range report.Issues {
+		if issue.DocumentID != "" {
+			issuesByDoc[issue.DocumentID]++
+		}
+	}
+
+	repaired := 0
+	for _, docID := range report.DocumentIDs() {
+		if err := s.reindexDocument(ctx, docID); err != nil {
+			return repaired, fmt.Errorf("reindex document %s: %w", docID, err)
+		}
+		repaired += issuesByDoc[docID]
+	}
+
+	for _, issue := range report.Issues {
+		var err error
+		switch issue.Kind {
+		case domain.IssueOrphanKeyword:
+			err = s.searchEngine.Delete(ctx, issue.ChunkID)
+		case domain.IssueOrphanVector:
+			if s.vectorIndex == nil {
+				continue
+			}
+			err = s.vectorIndex.Delete(ctx, issue.ChunkID)
+		default:
+			continue
+		}
+		if err != nil {
+			return repaired, fmt.Errorf("delete orphaned chunk %s: %w", issue.ChunkID, err)
+		}
+		repaired++
+	}
+
+	return repaired, nil
+}
+
+// reindexDocument writes every chunk of a document to the indexes again.
+func (s *IntegrityService) reindexDocument(ctx context.Context, docID string) error {
+	chunks, err := s.docStore.GetChunks(ctx, docID)
+	if err != nil {
+		return fmt.Errorf("get chunks: %w", err)
+	}
+
+	for _, chunk := range chunks {
+		if err := s.searchEngine.Index(ctx, chunk); err != nil {
+			return fmt.Errorf("index chunk: %w", err)
+		}
+		if s.vectorIndex != nil && len(chunk.Embedding) > 0 {
+			if err := s.vectorIndex.Add(ctx, chunk.ID, chunk.Embedding); err != nil {
+				return fmt.Errorf("add vector: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// eachDocument calls fn for every document of a source, a page at a time.
+func (s *IntegrityService) eachDocument(
+	ctx context.Context, sourceID string, fn func(doc *domain.Document) error,
+) error {
+	for offset := 0; ; offset += documentPageSize {
+		docs, total, err := s.docStore.ListDocumentsPaginated(ctx, sourceID, offset, documentPageSize)
+		if err != nil {
+			return fmt.Errorf("list documents: %w", err)
+		}
+
+		for i := range docs {
+			if err := fn(&docs[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(docs) == 0 || int64(offset+len(docs)) >= total {
+			return nil
+		}
+	}
+}
+
+// excludedURIs returns the URIs excluded for a source.
+func (s *IntegrityService) excludedURIs(ctx context.Context, sourceID string) (map[string]bool, error) {
+	uris := make(map[string]bool)
+	if s.exclusionStore == nil {
+		return uris, nil
+	}
+
+	exclusions, err := s.exclusionStore.GetBySourceID(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("list exclusions: %w", err)
+	}
+	for i := range exclusions {
+		uris[exclusions[i].URI] = true
+	}
+	return uris, nil
+}
+
+// orphans returns an issue for each indexed chunk that is not in the
+// document store, in chunk ID order. Chunks not reached through a source
+// are looked up directly before being reported.
+func (s *IntegrityService) orphans(
+	ctx context.Context, indexed, stored map[string]bool, kind domain.IntegrityIssueKind,
+) ([]domain.IntegrityIssue, error) {
+	var ids []string
+	for id := range indexed {
+		if stored[id] {
+			continue
+		}
+		_, err := s.docStore.GetChunk(ctx, id)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("get chunk %s: %w", id, err)
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	issues := make([]domain.IntegrityIssue, len(ids))
+	for i, id := range ids {
+		issues[i] = domain.IntegrityIssue{Kind: kind, ChunkID: id}
+	}
+	return issues, nil
+}
+
+// listChunks returns the chunk IDs held by an index as a set, or nil if
+// the index cannot list them.
+func listChunks(ctx context.Context, index any) (map[string]bool, error) {
+	lister, ok := index.(driven.ChunkLister)
+	if !ok {
+		return nil, nil
+	}
+
+	ids, err := lister.ChunkIDs(ctx)
+	if errors.Is(err, domain.ErrNotImplemented) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set, nil
+}
+
+// missingIssue builds an issue for a stored chunk missing from an index.
+func missingIssue(kind domain.IntegrityIssueKind, doc *domain.Document, chunk *domain.Chunk) domain.IntegrityIssue {
+	return domain.IntegrityIssue{
+		Kind:       kind,
+		ChunkID:    chunk.ID,
+		DocumentID: doc.ID,
+		SourceID:   doc.SourceID,
+		URI:        doc.URI,
+	}
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// chunkSet records the chunk IDs held by a fake index.
+type chunkSet struct {
+	ids     map[string]bool
+	listErr error
+}
+
+func newChunkSet(ids ...string) chunkSet {
+	set := chunkSet{ids: make(map[string]bool)}
+	for _, id := range ids {
+		set.ids[id] = true
+	}
+	return set
+}
+
+func (s *chunkSet) ChunkIDs(_ context.Context) ([]string, error) {
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	ids := make([]string, 0, len(s.ids))
+	for id := range s.ids {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// listingSearchEngine is a keyword index that can list its chunks.
+type listingSearchEngine struct {
+	chunkSet
+}
+
+func (e *listingSearchEngine) Index(_ context.Context, chunk domain.Chunk) error {
+	e.ids[chunk.ID] = true
+	return nil
+}
+
+func (e *listingSearchEngine) Delete(_ context.Context, chunkID string) error {
+	delete(e.ids, chunkID)
+	return nil
+}
+
+func (e *listingSearchEngine) Search(_ context.Context, _ string, _ int) ([]driven.SearchHit, error) {
+	return nil, nil
+}
+
+func (e *listingSearchEngine) Close() error {
+	return nil
+}
+
+// listingVectorIndex is a vector index that can list its chunks.
+type listingVectorIndex struct {
+	chunkSet
+}
+
+func (v *listingVectorIndex) Add(_ context.Context, chunkID string, _ []float32) error {
+	v.ids[chunkID] = true
+	return nil
+}
+
+func (v *listingVectorIndex) Delete(_ context.Context, chunkID string) error {
+	delete(v.ids, chunkID)
+	return nil
+}
+
+func (v *listingVectorIndex) Search(_ context.Context, _ []float32, _ int) ([]driven.VectorHit, error) {
+	return nil, nil
+}
+
+func (v *listingVectorIndex) SearchWithFilter(
+	_ context.Context, _ []float32, _ int, _ []string,
+) ([]driven.VectorHit, error) {
+	return nil, nil
+}
+
+func (v *listingVectorIndex) Close() error {
+	return nil
+}
+
+// newIntegrityStores stores a source with one indexed document (chunks c1
+// with an embedding and c2 without) and one excluded document (chunk c3).
+func newIntegrityStores(t *testing.T) (*memory.SourceStore, *memory.DocumentStore, *memory.ExclusionStore) {
+	t.Helper()
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Type: "filesystem"}))
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "/a.md"}))
+	require.NoError(t, docStore.SaveChunks(ctx, []domain.Chunk{
+		{ID: "c1", DocumentID: "doc-1", Content: "alpha", Embedding: []float32{1, 0}},
+		{ID: "c2", DocumentID: "doc-1", Content: "beta", Position: 1},
+	}))
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-1", URI: "/b.md"}))
+	require.NoError(t, docStore.SaveChunks(ctx, []domain.Chunk{{ID: "c3", DocumentID: "doc-2", Content: "gamma"}}))
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{ID: "ex-1", SourceID: "src-1", URI: "/b.md"}))
+
+	return sourceStore, docStore, exclusionStore
+}
+
+func issueKinds(report *domain.IntegrityReport) []string {
+	kinds := make([]string, len(report.Issues))
+	for i, issue := range report.Issues {
+		kinds[i] = string(issue.Kind) + ":" + issue.ChunkID
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+func TestIntegrityService_Verify_Consistent(t *testing.T) {
+	sourceStore, docStore, exclusionStore := newIntegrityStores(t)
+	engine := &listingSearchEngine{newChunkSet("c1", "c2", "c3")}
+	vectors := &listingVectorIndex{newChunkSet("c1")}
+	svc := NewIntegrityService(sourceStore, docStore, exclusionStore, engine, vectors)
+
+	report, err := svc.Verify(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, report.OK(), "issues: %v", report.Issues)
+	assert.Equal(t, 1, report.Documents)
+	assert.Equal(t, 2, report.Chunks)
+	assert.Equal(t, 1, report.Embedded)
+	assert.True(t, report.KeywordChecked)
+	assert.True(t, report.VectorChecked)
+}
+
+func TestIntegrityService_VerifyAndRepair(t *testing.T) {
+	sourceStore, docStore, exclusionStore := newIntegrityStores(t)
+	// c2 is missing from Xapian, c1 from HNSW; "gone" points at a deleted chunk
+	engine := &listingSearchEngine{newChunkSet("c1", "gone")}
+	vectors := &listingVectorIndex{newChunkSet("gone")}
+	svc := NewIntegrityService(sourceStore, docStore, exclusionStore, engine, vectors)
+	ctx := context.Background()
+
+	report, err := svc.Verify(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"missing_keyword:c2",
+		"missing_vector:c1",
+		"orphan_keyword:gone",
+		"orphan_vector:gone",
+	}, issueKinds(report))
+	for _, issue := range report.Issues {
+		if !issue.Kind.IsOrphan() {
+			assert.Equal(t, "doc-1", issue.DocumentID)
+			assert.Equal(t, "/a.md", issue.URI)
+		}
+	}
+
+	repaired, err := svc.Repair(ctx, report)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, repaired)
+	assert.Equal(t, map[string]bool{"c1": true, "c2": true}, engine.ids)
+	assert.Equal(t, map[string]bool{"c1": true}, vectors.ids)
+
+	report, err = svc.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.OK(), "issues: %v", report.Issues)
+}
+
+func TestIntegrityService_Verify_ExcludedChunksAreNotOrphans(t *testing.T) {
+	sourceStore, docStore, exclusionStore := newIntegrityStores(t)
+	// c3 belongs to the excluded document: not required, but not an orphan either
+	engine := &listingSearchEngine{newChunkSet("c1", "c2", "c3")}
+	svc := NewIntegrityService(sourceStore, docStore, exclusionStore, engine, nil)
+
+	report, err := svc.Verify(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, report.OK(), "issues: %v", report.Issues)
+	assert.False(t, report.VectorChecked)
+}
+
+func TestIntegrityService_Verify_UnlistableIndexSkipped(t *testing.T) {
+	sourceStore, docStore, exclusionStore := newIntegrityStores(t)
+	unlistable := &listingSearchEngine{newChunkSet()}
+	unlistable.listErr = domain.ErrNotImplemented
+	svc := NewIntegrityService(sourceStore, docStore, exclusionStore, &mockSearchEngine{}, nil)
+
+	report, err := svc.Verify(context.Background())
+	require.NoError(t, err)
+	assert.False(t, report.KeywordChecked)
+	assert.True(t, report.OK())
+
+	svc = NewIntegrityService(sourceStore, docStore, exclusionStore, unlistable, nil)
+	report, err = svc.Verify(context.Background())
+	require.NoError(t, err)
+	assert.False(t, report.KeywordChecked)
+	assert.Equal(t, 2, report.Chunks)
+}
+
+func TestIntegrityService_Verify_ListError(t *testing.T) {
+	sourceStore, docStore, exclusionStore := newIntegrityStores(t)
+	engine := &listingSearchEngine{newChunkSet()}
+	engine.listErr = errors.New("disk error")
+	svc := NewIntegrityService(sourceStore, docStore, exclusionStore, engine, nil)
+
+	_, err := svc.Verify(context.Background())
+
+	assert.ErrorContains(t, err, "disk error")
+}
+
+func TestIntegrityService_Repair_NoSearchEngine(t *testing.T) {
+	svc := NewIntegrityService(nil, nil, nil, nil, nil)
+	report := &domain.IntegrityReport{Issues: []domain.IntegrityIssue{{Kind: domain.IssueOrphanKeyword, ChunkID: "c1"}}}
+
+	_, err := svc.Repair(context.Background(), report)
+
+	assert.ErrorIs(t, err, domain.ErrSearchUnavailable)
+}
+
+func TestIntegrityService_Repair_Nil(t *testing.T) {
+	svc := NewIntegrityService(nil, nil, nil, &mockSearchEngine{}, nil)
+
+	repaired, err := svc.Repair(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Zero(t, repaired)
+}
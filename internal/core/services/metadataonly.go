@@ -0,0 +1,54 @@
+package services
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// metadataOnlyResult builds the document for a metadata-only raw document
+// without normalising it, since there is no content to normalise.
+// The title comes from the connector's metadata, falling back to the file name.
+func metadataOnlyResult(raw *domain.RawDocument) *driven.NormaliseResult {
+	metadata := make(map[string]any, len(raw.Metadata)+1)
+	for k, v := range raw.Metadata {
+		metadata[k] = v
+	}
+	metadata["mime_type"] = raw.MIMEType
+	metadata[domain.MetadataMetadataOnly] = true
+
+	title, _ := metadata["title"].(string)
+	if title == "" {
+		title = path.Base(strings.TrimSuffix(raw.URI, "/"))
+	}
+
+	now := time.Now()
+	return &driven.NormaliseResult{
+		Document: domain.Document{
+			ID:        uuid.New().String(),
+			SourceID:  raw.SourceID,
+			URI:       raw.URI,
+			Title:     title,
+			Metadata:  metadata,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+}
+
+// metadataOnlyChunk returns the single chunk indexed for a metadata-only
+// document. It holds the title and location so the document can be found
+// by name with keyword search.
+func metadataOnlyChunk(doc *domain.Document) domain.Chunk {
+	return domain.Chunk{
+		ID:         uuid.New().String(),
+		DocumentID: doc.ID,
+		Content:    doc.Title + "\n" + doc.URI,
+		Metadata:   map[string]any{domain.MetadataMetadataOnly: true},
+	}
+}
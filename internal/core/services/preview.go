@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure SyncOrchestrator implements the interface.
+var _ driving.PreviewingSync = (*SyncOrchestrator)(nil)
+
+// diffContextLines is the number of unchanged lines shown around each change.
+const diffContextLines = 3
+
+// DryRun fetches a source's changes with the same strategy as Sync and calls
+// fn with the change to each document. Documents are normalised to compare
+// their content but are not stored or indexed, and the sync state is left
+// alone. Excluded documents and documents of unsupported types are skipped,
+// as they would be by a sync.
+func (o *SyncOrchestrator) DryRun(
+	ctx context.Context, sourceID string, fn func(*driving.DocumentDiff) error,
+) error {
+	source, err := o.sourceStore.Get(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("get source: %w", err)
+	}
+
+	if o.factory == nil {
+		return fmt.Errorf("create connector: connector factory not configured")
+	}
+	connector, err := o.factory.Create(ctx, *source)
+	if err != nil {
+		return fmt.Errorf("create connector: %w", err)
+	}
+	defer connector.Close()
+
+	syncState, err := o.syncStore.Get(ctx, sourceID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("get sync state: %w", err)
+	}
+
+	if connector.Capabilities().SupportsIncremental && syncState != nil && syncState.HasCursor() {
+		timeout := o.syncSettings.IncrementalSyncTimeout()
+		syncCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		changesCh, errsCh := connector.IncrementalSync(syncCtx, *syncState)
+		err := previewEach(syncCtx, changesCh, errsCh, func(change domain.RawDocumentChange) error {
+			if change.Type == domain.ChangeDeleted {
+				return o.previewDeletion(syncCtx, source.ID, change.Document.URI, fn)
+			}
+			return o.previewDocument(syncCtx, &change.Document, fn)
+		})
+		return deadlineError(ctx, err, "incremental sync", timeout)
+	}
+
+	timeout := o.syncSettings.FullSyncTimeout()
+	syncCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	docsCh, errsCh := connector.FullSync(syncCtx)
+	err = previewEach(syncCtx, docsCh, errsCh, func(raw domain.RawDocument) error {
+		return o.previewDocument(syncCtx, &raw, fn)
+	})
+	return deadlineError(ctx, err, "full sync", timeout)
+}
+
+// previewEach calls handle for each item from a connector until its channel
+// closes, stopping at the first connector error.
+func previewEach[T any](ctx context.Context, items <-chan T, errsCh <-chan error, handle func(T) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err, ok := <-errsCh:
+			if !ok {
+				errsCh = nil
+				continue
+			}
+			if _, isSyncComplete := driven.IsSyncComplete(err); isSyncComplete {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("connector error: %w", err)
+			}
+
+		case item, ok := <-items:
+			if !ok {
+				return nil
+			}
+			if err := handle(item); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// previewDocument reports the change an incoming document would make.
+// Documents that cannot be normalised are logged and skipped.
+func (o *SyncOrchestrator) previewDocument(
+	ctx context.Context, raw *domain.RawDocument, fn func(*driving.DocumentDiff) error,
+) error {
+	excluded, err := o.exclusionStore.IsExcluded(ctx, raw.SourceID, raw.URI)
+	if err != nil {
+		return fmt.Errorf("check exclusion: %w", err)
+	}
+	if excluded {
+		return nil
+	}
+
+	diff, err := o.DiffDocument(ctx, raw)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotImplemented) {
+			o.log.Debug("skipping document", "uri", raw.URI, "reason", err)
+		} else {
+			o.log.Warn("failed to preview document", "uri", raw.URI, "error", err)
+		}
+		return nil
+	}
+	return fn(diff)
+}
+
+// previewDeletion reports the stored documents a deletion would remove.
+func (o *SyncOrchestrator) previewDeletion(
+	ctx context.Context, sourceID, uri string, fn func(*driving.DocumentDiff) error,
+) error {
+	docs, err := o.findDocumentsByURI(ctx, sourceID, uri)
+	if err != nil {
+		return err
+	}
+	for i := range docs {
+		stored, err := o.documentContent(ctx, &docs[i])
+		if err != nil {
+			return err
+		}
+		if err := fn(&driving.DocumentDiff{
+			SourceID: sourceID,
+			URI:      docs[i].URI,
+			Change:   driving.DocumentDeleted,
+			Diff:     unifiedDiff(docs[i].URI, stored, ""),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DiffDocument normalises an incoming document and returns a unified diff
+// from the content of the stored document with the same URI to its content.
+// Documents not stored yet are diffed against empty content. Nothing is stored.
+func (o *SyncOrchestrator) DiffDocument(ctx context.Context, raw *domain.RawDocument) (*driving.DocumentDiff, error) {
+	if raw == nil {
+		return nil, fmt.Errorf("%w: document is nil", domain.ErrInvalidInput)
+	}
+
+	var incoming string
+	if domain.IsMetadataOnly(raw.Metadata) {
+		result := metadataOnlyResult(raw)
+		incoming = metadataOnlyChunk(&result.Document).Content
+	} else {
+		result, err := o.registry.Normalise(ctx, raw)
+		if err != nil {
+			return nil, fmt.Errorf("normalise: %w", err)
+		}
+		incoming = result.Document.Content
+	}
+
+	diff := &driving.DocumentDiff{SourceID: raw.SourceID, URI: raw.URI, Change: driving.DocumentNew}
+
+	// Normalisers assign new IDs, so the stored version is found by URI
+	doc, err := o.storedDocument(ctx, raw.SourceID, raw.URI)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		diff.Diff = unifiedDiff(raw.URI, "", incoming)
+		return diff, nil
+	}
+
+	stored, err := o.documentContent(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	diff.Diff = unifiedDiff(raw.URI, stored, incoming)
+	if diff.Diff == "" {
+		diff.Change = driving.DocumentUnchanged
+	} else {
+		diff.Change = driving.DocumentChanged
+	}
+	return diff, nil
+}
+
+// storedDocument returns the most recently added document with exactly the
+// given URI, or nil when none is stored.
+func (o *SyncOrchestrator) storedDocument(ctx context.Context, sourceID, uri string) (*domain.Document, error) {
+	docs, err := o.findDocumentsByURI(ctx, sourceID, uri)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(docs) - 1; i >= 0; i-- {
+		if docs[i].URI == uri {
+			return &docs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// documentContent returns a stored document's content. Documents stored
+// without content, such as metadata-only documents, use their chunks.
+func (o *SyncOrchestrator) documentContent(ctx context.Context, doc *domain.Document) (string, error) {
+	if doc.Content != "" {
+		return doc.Content, nil
+	}
+	chunks, err := o.docStore.GetChunks(ctx, doc.ID)
+	if err != nil {
+		return "", fmt.Errorf("get chunks: %w", err)
+	}
+	parts := make([]string, len(chunks))
+	for i := range chunks {
+		parts[i] = chunks[i].Content
+	}
+	return strings.Join(parts, "\n"), nil
+}
+
+// unifiedDiff returns a unified diff between two versions of a document,
+// or an empty string when they are the same.
+func unifiedDiff(uri, stored, incoming string) string {
+	if stored == incoming {
+		return ""
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitDiffLines(stored),
+		B:        splitDiffLines(incoming),
+		FromFile: uri + " (stored)",
+		ToFile:   uri + " (incoming)",
+		Context:  diffContextLines,
+	})
+	if err != nil {
+		// Writing to a string buffer does not fail
+		return ""
+	}
+	return diff
+}
+
+// splitDiffLines splits content into newline-terminated lines for difflib.
+// Empty content has no lines.
+func splitDiffLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(content, "\n"))
+}
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// saveStoredDocument saves a document as a previous sync would have.
+func saveStoredDocument(t *testing.T, docStore *memory.DocumentStore, uri, content string) {
+	t.Helper()
+	doc := domain.Document{ID: "stored-" + uri, SourceID: "src-1", URI: uri, Content: content}
+	require.NoError(t, docStore.SaveDocument(context.Background(), &doc))
+}
+
+func TestSyncOrchestrator_DiffDocument(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	saveStoredDocument(t, docStore, "notes.txt", "first\nsecond\nthird\n")
+	saveStoredDocument(t, docStore, "same.txt", "same")
+	orchestrator := NewSyncOrchestrator(nil, nil, docStore, nil, nil, &syncMockNormaliserRegistry{}, nil, nil, nil, nil)
+
+	t.Run("changed", func(t *testing.T) {
+		raw := &domain.RawDocument{SourceID: "src-1", URI: "notes.txt", Content: []byte("first\n2nd\nthird\n")}
+
+		diff, err := orchestrator.DiffDocument(ctx, raw)
+
+		require.NoError(t, err)
+		assert.Equal(t, driving.DocumentChanged, diff.Change)
+		assert.Equal(t, "notes.txt", diff.URI)
+		assert.Equal(t, "--- notes.txt (stored)\n+++ notes.txt (incoming)\n"+
+			"@@ -1,3 +1,3 @@\n first\n-second\n+2nd\n third\n", diff.Diff)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		raw := &domain.RawDocument{SourceID: "src-1", URI: "same.txt", Content: []byte("same")}
+
+		diff, err := orchestrator.DiffDocument(ctx, raw)
+
+		require.NoError(t, err)
+		assert.Equal(t, driving.DocumentUnchanged, diff.Change)
+		assert.Empty(t, diff.Diff)
+	})
+
+	t.Run("new", func(t *testing.T) {
+		raw := &domain.RawDocument{SourceID: "src-1", URI: "new.txt", Content: []byte("hello")}
+
+		diff, err := orchestrator.DiffDocument(ctx, raw)
+
+		require.NoError(t, err)
+		assert.Equal(t, driving.DocumentNew, diff.Change)
+		assert.Contains(t, diff.Diff, "+hello\n")
+	})
+
+	t.Run("nil document", func(t *testing.T) {
+		_, err := orchestrator.DiffDocument(ctx, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestSyncOrchestrator_DiffDocument_StoredWithoutContent(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	doc := domain.Document{ID: "doc-1", SourceID: "src-1", URI: "report.pdf"}
+	require.NoError(t, docStore.SaveDocument(ctx, &doc))
+	require.NoError(t, docStore.SaveChunks(ctx, []domain.Chunk{
+		{ID: "chunk-1", DocumentID: "doc-1", Content: "page two", Position: 1},
+		{ID: "chunk-0", DocumentID: "doc-1", Content: "page one", Position: 0},
+	}))
+	orchestrator := NewSyncOrchestrator(nil, nil, docStore, nil, nil, &syncMockNormaliserRegistry{}, nil, nil, nil, nil)
+
+	raw := &domain.RawDocument{SourceID: "src-1", URI: "report.pdf", Content: []byte("page one\npage two")}
+	diff, err := orchestrator.DiffDocument(ctx, raw)
+
+	require.NoError(t, err)
+	assert.Equal(t, driving.DocumentUnchanged, diff.Change)
+}
+
+func TestSyncOrchestrator_DryRun(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	saveStoredDocument(t, docStore, "changed.txt", "old")
+	saveStoredDocument(t, docStore, "same.txt", "same")
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{ID: "excl-1", SourceID: "src-1", URI: "excluded.txt"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "changed.txt", Content: []byte("new")},
+			{SourceID: "src-1", URI: "same.txt", Content: []byte("same")},
+			{SourceID: "src-1", URI: "added.txt", Content: []byte("added")},
+			{SourceID: "src-1", URI: "excluded.txt", Content: []byte("excluded")},
+		},
+		complete: &driven.SyncComplete{NewCursor: "cursor-1"},
+	}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	changes := make(map[string]driving.DocumentChange)
+	err := orchestrator.DryRun(ctx, "src-1", func(diff *driving.DocumentDiff) error {
+		changes[diff.URI] = diff.Change
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]driving.DocumentChange{
+		"changed.txt": driving.DocumentChanged,
+		"same.txt":    driving.DocumentUnchanged,
+		"added.txt":   driving.DocumentNew,
+	}, changes)
+
+	// Nothing is stored
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	_, err = syncStore.Get(ctx, "src-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSyncOrchestrator_DryRun_Incremental(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1"}))
+	saveStoredDocument(t, docStore, "gone.txt", "bye")
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true},
+		incSyncDocs: []domain.RawDocumentChange{
+			{Type: domain.ChangeDeleted, Document: domain.RawDocument{SourceID: "src-1", URI: "gone.txt"}},
+			{Type: domain.ChangeDeleted, Document: domain.RawDocument{SourceID: "src-1", URI: "never-stored.txt"}},
+		},
+	}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	var diffs []*driving.DocumentDiff
+	err := orchestrator.DryRun(ctx, "src-1", func(diff *driving.DocumentDiff) error {
+		diffs = append(diffs, diff)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, driving.DocumentDeleted, diffs[0].Change)
+	assert.Contains(t, diffs[0].Diff, "-bye\n")
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestSyncOrchestrator_DryRun_SkipsFailedDocuments(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		fullSyncDocs: []domain.RawDocument{{SourceID: "src-1", URI: "bad.pdf"}},
+	}
+	registry := &syncMockNormaliserRegistry{normaliseErr: errors.New("corrupt")}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, registry, nil, nil, nil, nil,
+	)
+
+	called := false
+	err := orchestrator.DryRun(ctx, "src-1", func(*driving.DocumentDiff) error {
+		called = true
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.False(t, called)
+	assert.Equal(t, 1, registry.normaliseCalls)
+}
+
+func TestSyncOrchestrator_DryRun_CallbackError(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		fullSyncDocs: rawDocuments(3),
+	}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, nil, nil, nil, nil,
+	)
+
+	calls := 0
+	err := orchestrator.DryRun(ctx, "src-1", func(*driving.DocumentDiff) error {
+		calls++
+		return fmt.Errorf("write failed")
+	})
+
+	require.EqualError(t, err, "write failed")
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,12 @@
+package services
+
+import "github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+
+// nopProgressReporter discards progress events.
+type nopProgressReporter struct{}
+
+var _ driving.IndexProgressReporter = nopProgressReporter{}
+
+func (nopProgressReporter) OnDocumentProcessed(_, _, _ string) {}
+
+func (nopProgressReporter) OnChunkEmbedded(_, _ string) {}
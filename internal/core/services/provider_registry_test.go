@@ -26,6 +26,10 @@ func (m *mockConnectorFactoryForProvider) SupportedTypes() []string {
 	return nil
 }
 
+func (m *mockConnectorFactoryForProvider) Version(_ string) string {
+	return ""
+}
+
 func (m *mockConnectorFactoryForProvider) BuildAuthURL(_ string, _ *domain.AuthProvider, _, _, _ string) (string, error) {
 	return "", nil
 }
@@ -38,8 +42,8 @@ func (m *mockConnectorFactoryForProvider) RefreshToken(_ context.Context, _ stri
 	return nil, nil
 }
 
-func (m *mockConnectorFactoryForProvider) GetUserInfo(_ context.Context, _ string, _ string) (string, error) {
-	return "", nil
+func (m *mockConnectorFactoryForProvider) GetUserInfo(_ context.Context, _ string, _ string) (domain.AccountInfo, error) {
+	return domain.AccountInfo{}, nil
 }
 
 func (m *mockConnectorFactoryForProvider) GetDefaultOAuthConfig(connectorType string) *driven.OAuthDefaults {
@@ -73,8 +77,8 @@ func TestProviderRegistry_GetProviders(t *testing.T) {
 
 	providers := registry.GetProviders()
 
-	// Should have local, google, github, microsoft, dropbox, notion (6 providers)
-	assert.Len(t, providers, 6)
+	// Should have local, google, github, microsoft, dropbox, notion, trello, basecamp, discord, slack (10 providers)
+	assert.Len(t, providers, 10)
 
 	// Verify all expected providers are present
 	providerSet := make(map[domain.ProviderType]bool)
@@ -87,6 +91,10 @@ func TestProviderRegistry_GetProviders(t *testing.T) {
 	assert.True(t, providerSet[domain.ProviderMicrosoft])
 	assert.True(t, providerSet[domain.ProviderDropbox])
 	assert.True(t, providerSet[domain.ProviderNotion])
+	assert.True(t, providerSet[domain.ProviderTrello])
+	assert.True(t, providerSet[domain.ProviderBasecamp])
+	assert.True(t, providerSet[domain.ProviderDiscord])
+	assert.True(t, providerSet[domain.ProviderSlack])
 }
 
 func TestProviderRegistry_GetConnectorsForProvider_Local(t *testing.T) {
@@ -96,6 +104,7 @@ func TestProviderRegistry_GetConnectorsForProvider_Local(t *testing.T) {
 
 	require.NotEmpty(t, connectors)
 	assert.Contains(t, connectors, "filesystem")
+	assert.Contains(t, connectors, "sqlite")
 }
 
 func TestProviderRegistry_GetConnectorsForProvider_Google(t *testing.T) {
@@ -326,7 +335,7 @@ func TestProviderRegistry_HasMultipleConnectors(t *testing.T) {
 		provider domain.ProviderType
 		expected bool
 	}{
-		{domain.ProviderLocal, false},
+		{domain.ProviderLocal, true},     // Filesystem, SQLite
 		{domain.ProviderGoogle, true},    // Drive, Gmail, Calendar
 		{domain.ProviderGitHub, false},   // Single connector
 		{domain.ProviderMicrosoft, true}, // Outlook, OneDrive, Calendar
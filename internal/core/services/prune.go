@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Prune deletes a source's documents that were not updated within its
+// retention window, along with their chunks and index entries. It returns
+// the number of documents deleted. Sources without a retention window are
+// never pruned and return domain.ErrInvalidInput.
+func (o *SyncOrchestrator) Prune(ctx context.Context, sourceID string) (int, error) {
+	source, err := o.sourceStore.Get(ctx, sourceID)
+	if err != nil {
+		return 0, fmt.Errorf("get source: %w", err)
+	}
+
+	retention, err := source.Retention()
+	if err != nil {
+		return 0, err
+	}
+	if retention == 0 {
+		return 0, fmt.Errorf("%w: source %s has no retention set", domain.ErrInvalidInput, sourceID)
+	}
+	return o.prune(ctx, sourceID, retention)
+}
+
+// PruneAll prunes every source with a retention window, skipping the rest.
+// It returns the total number of documents deleted.
+func (o *SyncOrchestrator) PruneAll(ctx context.Context) (int, error) {
+	sources, err := o.sourceStore.List(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list sources: %w", err)
+	}
+
+	var (
+		total int
+		errs  []error
+	)
+	for i := range sources {
+		retention, err := sources[i].Retention()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("prune %s: %w", sources[i].ID, err))
+			continue
+		}
+		if retention == 0 {
+			continue
+		}
+		pruned, err := o.prune(ctx, sources[i].ID, retention)
+		total += pruned
+		if err != nil {
+			errs = append(errs, fmt.Errorf("prune %s: %w", sources[i].ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return total, errors.Join(errs...)
+	}
+	return total, nil
+}
+
+// pruneAfterSync prunes a source with a retention window once its sync has
+// completed. Failures are logged rather than failing the sync.
+func (o *SyncOrchestrator) pruneAfterSync(ctx context.Context, source *domain.Source) {
+	log := o.log.With("source_id", source.ID)
+
+	retention, err := source.Retention()
+	if err != nil {
+		log.Warn("skipping prune", "error", err)
+		return
+	}
+	if retention == 0 {
+		return
+	}
+
+	pruned, err := o.prune(ctx, source.ID, retention)
+	if err != nil {
+		log.Warn("prune failed", "documents", pruned, "error", err)
+		return
+	}
+	if pruned > 0 {
+		log.Info("pruned documents", "documents", pruned, "retention", retention)
+	}
+}
+
+// prune deletes the source's documents last updated before the retention
+// window, falling back to their creation time when no update is recorded.
+func (o *SyncOrchestrator) prune(ctx context.Context, sourceID string, retention time.Duration) (int, error) {
+	// Deleted documents would leave their keyword index entries behind
+	if o.searchIndex == nil {
+		return 0, fmt.Errorf("prune source %s: %w", sourceID, domain.ErrSearchUnavailable)
+	}
+	cutoff := time.Now().Add(-retention)
+
+	// Collect first, as deleting while paging would shift the offsets
+	var expired []domain.Document
+	for offset := 0; ; offset += documentPageSize {
+		docs, total, err := o.docStore.ListDocumentsPaginated(ctx, sourceID, offset, documentPageSize)
+		if err != nil {
+			return 0, fmt.Errorf("list documents: %w", err)
+		}
+
+		for i := range docs {
+			if updated := documentTime(&docs[i]); !updated.IsZero() && updated.Before(cutoff) {
+				expired = append(expired, docs[i])
+			}
+		}
+
+		if len(docs) == 0 || int64(offset+len(docs)) >= total {
+			break
+		}
+	}
+
+	for i := range expired {
+		if err := o.deleteDocument(ctx, &expired[i]); err != nil {
+			return i, err
+		}
+	}
+	return len(expired), nil
+}
+
+// documentTime returns when a document was last updated, or created when
+// no update is recorded. Documents with neither are never pruned.
+func documentTime(doc *domain.Document) time.Time {
+	if !doc.UpdatedAt.IsZero() {
+		return doc.UpdatedAt
+	}
+	return doc.CreatedAt
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// saveAgedDocument saves a document with one indexed chunk, last updated age ago.
+func saveAgedDocument(
+	t *testing.T, docStore *memory.DocumentStore, searchEngine *syncMockSearchEngine,
+	sourceID, id string, age time.Duration,
+) {
+	t.Helper()
+	ctx := context.Background()
+	updated := time.Now().Add(-age)
+	doc := domain.Document{ID: id, SourceID: sourceID, URI: id + ".txt", CreatedAt: updated, UpdatedAt: updated}
+	require.NoError(t, docStore.SaveDocument(ctx, &doc))
+	chunk := domain.Chunk{ID: id + "-chunk", DocumentID: id, Content: "content"}
+	require.NoError(t, docStore.SaveChunks(ctx, []domain.Chunk{chunk}))
+	require.NoError(t, searchEngine.Index(ctx, chunk))
+}
+
+func TestSyncOrchestrator_Prune(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+	day := 24 * time.Hour
+
+	source := domain.Source{ID: "src-1", Type: "gmail", Config: map[string]string{"retain": "30d"}}
+	require.NoError(t, sourceStore.Save(ctx, source))
+	saveAgedDocument(t, docStore, searchEngine, "src-1", "old", 45*day)
+	saveAgedDocument(t, docStore, searchEngine, "src-1", "recent", 5*day)
+
+	// Only created_at is recorded
+	created := domain.Document{ID: "created", SourceID: "src-1", CreatedAt: time.Now().Add(-60 * day)}
+	require.NoError(t, docStore.SaveDocument(ctx, &created))
+
+	orchestrator := NewSyncOrchestrator(sourceStore, nil, docStore, nil, nil, nil, nil, searchEngine, nil, nil)
+
+	pruned, err := orchestrator.Prune(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, pruned)
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "recent", docs[0].ID)
+
+	chunks, err := docStore.GetChunks(ctx, "old")
+	require.NoError(t, err)
+	assert.Empty(t, chunks)
+	assert.Len(t, searchEngine.indexed, 1)
+}
+
+func TestSyncOrchestrator_Prune_NoRetention(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Type: "filesystem"}))
+	saveAgedDocument(t, docStore, searchEngine, "src-1", "old", 1000*24*time.Hour)
+
+	orchestrator := NewSyncOrchestrator(sourceStore, nil, docStore, nil, nil, nil, nil, searchEngine, nil, nil)
+
+	pruned, err := orchestrator.Prune(ctx, "src-1")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "no retention set")
+	assert.Zero(t, pruned)
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+}
+
+func TestSyncOrchestrator_Prune_InvalidRetention(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	source := domain.Source{ID: "src-1", Type: "gmail", Config: map[string]string{"retain": "forever"}}
+	require.NoError(t, sourceStore.Save(ctx, source))
+
+	orchestrator := NewSyncOrchestrator(sourceStore, nil, memory.NewDocumentStore(), nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := orchestrator.Prune(ctx, "src-1")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestSyncOrchestrator_Prune_AcrossPages(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+
+	source := domain.Source{ID: "src-1", Type: "gmail", Config: map[string]string{"retain": "1w"}}
+	require.NoError(t, sourceStore.Save(ctx, source))
+	for i := range documentPageSize + 2 {
+		saveAgedDocument(t, docStore, searchEngine, "src-1", fmt.Sprintf("doc-%d", i), 30*24*time.Hour)
+	}
+
+	orchestrator := NewSyncOrchestrator(sourceStore, nil, docStore, nil, nil, nil, nil, searchEngine, nil, nil)
+
+	pruned, err := orchestrator.Prune(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, documentPageSize+2, pruned)
+	assert.Empty(t, searchEngine.indexed)
+}
+
+func TestSyncOrchestrator_PruneAll_SkipsSourcesWithoutRetention(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+	age := 400 * 24 * time.Hour
+
+	retained := domain.Source{ID: "src-1", Type: "gmail", Config: map[string]string{"retain": "1y"}}
+	require.NoError(t, sourceStore.Save(ctx, retained))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-2", Type: "filesystem"}))
+	saveAgedDocument(t, docStore, searchEngine, "src-1", "mail", age)
+	saveAgedDocument(t, docStore, searchEngine, "src-2", "file", age)
+
+	orchestrator := NewSyncOrchestrator(sourceStore, nil, docStore, nil, nil, nil, nil, searchEngine, nil, nil)
+
+	pruned, err := orchestrator.PruneAll(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned)
+	count, err := docStore.CountBySource(ctx, "src-2")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+}
+
+func TestSyncOrchestrator_Sync_PrunesAfterSync(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+	factory := newSyncMockConnectorFactory()
+
+	source := domain.Source{ID: "src-1", Type: "mock", Config: map[string]string{"retain": "90d"}}
+	require.NoError(t, sourceStore.Save(ctx, source))
+	saveAgedDocument(t, docStore, searchEngine, "src-1", "old", 120*24*time.Hour)
+
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "new.txt", MIMEType: "text/plain", Content: []byte("new")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "new.txt", docs[0].URI)
+}
+
+func TestSyncOrchestrator_Prune_NoSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	source := domain.Source{ID: "src-1", Type: "gmail", Config: map[string]string{"retain": "30d"}}
+	require.NoError(t, sourceStore.Save(ctx, source))
+	saveAgedDocument(t, docStore, newSyncMockSearchEngine(), "src-1", "old", 45*24*time.Hour)
+
+	orchestrator := NewSyncOrchestrator(sourceStore, nil, docStore, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := orchestrator.Prune(ctx, "src-1")
+
+	assert.ErrorIs(t, err, domain.ErrSearchUnavailable)
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+}
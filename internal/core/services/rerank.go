@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
+)
+
+// rerankCacheSize bounds the number of cached rerank scores. The cache is
+// cleared when it fills up.
+const rerankCacheSize = 4096
+
+// rerankKey identifies a cached rerank score.
+type rerankKey struct {
+	query      string // normalised query
+	documentID string
+}
+
+// rerankCache keeps reranker scores by query and document, so paging
+// through or repeating a search does not call the reranker again.
+type rerankCache struct {
+	mu     sync.Mutex
+	scores map[rerankKey]float64
+}
+
+// get returns the cached score of a document for a query.
+func (c *rerankCache) get(key rerankKey) (float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	score, ok := c.scores[key]
+	return score, ok
+}
+
+// put caches the score of a document for a query.
+func (c *rerankCache) put(key rerankKey, score float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.scores) >= rerankCacheSize {
+		c.scores = make(map[rerankKey]float64)
+	}
+	c.scores[key] = score
+}
+
+// SetReranker sets the reranker that reorders the top results of a search.
+// Searches are reranked when settings.Enabled is set or a query asks for it.
+func (s *SearchService) SetReranker(reranker driven.Reranker, settings domain.RerankSettings) {
+	s.reranker = reranker
+	s.rerankByDefault = settings.Enabled
+	s.rerankCandidates = settings.CandidateCount()
+	s.rerankCache = &rerankCache{scores: make(map[rerankKey]float64)}
+}
+
+// rerankEnabled reports whether a query's results are reranked.
+func (s *SearchService) rerankEnabled(opts domain.SearchOptions) bool {
+	if s.reranker == nil {
+		return false
+	}
+	switch opts.Rerank {
+	case domain.RerankModeOn:
+		return true
+	case domain.RerankModeOff:
+		return false
+	default:
+		return s.rerankByDefault
+	}
+}
+
+// rerankCandidateCount returns how many results a query reranks: its own
+// count when set, otherwise the configured one.
+func (s *SearchService) rerankCandidateCount(opts domain.SearchOptions) int {
+	if opts.RerankCandidates > 0 {
+		return opts.RerankCandidates
+	}
+	if s.rerankCandidates > 0 {
+		return s.rerankCandidates
+	}
+	return domain.DefaultRerankCandidates
+}
+
+// rerank reorders the top candidates of results, in engine order, by
+// reranker score. Candidates are scored per document, from its title and
+// best matching chunk, and take over the fused scores of the candidates in
+// their new order, so the results after them still rank below. If the
+// reranker fails, the fused ranking is kept.
+func (s *SearchService) rerank(
+	ctx context.Context, query string, results []domain.SearchResult, opts domain.SearchOptions,
+) {
+	if !s.rerankEnabled(opts) || len(results) < 2 {
+		return
+	}
+	candidates := results[:min(s.rerankCandidateCount(opts), len(results))]
+
+	scores, err := s.rerankScores(ctx, query, candidates)
+	if err != nil {
+		logger.Warn("Rerank failed: %v (keeping fused ranking)", err)
+		return
+	}
+
+	fused := make([]float64, len(candidates))
+	for i := range candidates {
+		fused[i] = candidates[i].Score
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(fused)))
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].Document.ID] > scores[candidates[j].Document.ID]
+	})
+	for i := range candidates {
+		candidates[i].Score = fused[i]
+	}
+	logger.Debug("Reranked %d candidates", len(candidates))
+}
+
+// rerankScores returns the reranker score of each candidate document,
+// calling the reranker only for documents not cached for the query.
+func (s *SearchService) rerankScores(
+	ctx context.Context, query string, candidates []domain.SearchResult,
+) (map[string]float64, error) {
+	normalised := domain.NormaliseQuery(query)
+	scores := make(map[string]float64, len(candidates))
+	var uncached []string
+	var passages []string
+	for i := range candidates {
+		id := candidates[i].Document.ID
+		if _, seen := scores[id]; seen || slices.Contains(uncached, id) {
+			continue
+		}
+		if score, ok := s.rerankCache.get(rerankKey{query: normalised, documentID: id}); ok {
+			scores[id] = score
+			continue
+		}
+		uncached = append(uncached, id)
+		passages = append(passages, rerankPassage(&candidates[i]))
+	}
+	if len(uncached) == 0 {
+		return scores, nil
+	}
+
+	fresh, err := s.reranker.Rerank(ctx, query, passages)
+	if err != nil {
+		return nil, err
+	}
+	if len(fresh) != len(passages) {
+		return nil, fmt.Errorf("reranker returned %d scores for %d passages", len(fresh), len(passages))
+	}
+	for i, id := range uncached {
+		scores[id] = fresh[i]
+		s.rerankCache.put(rerankKey{query: normalised, documentID: id}, fresh[i])
+	}
+	return scores, nil
+}
+
+// rerankPassage returns the text a reranker scores for a result: the
+// document title followed by the matching chunk.
+func rerankPassage(result *domain.SearchResult) string {
+	if result.Document.Title == "" {
+		return result.Chunk.Content
+	}
+	return result.Document.Title + "\n" + result.Chunk.Content
+}
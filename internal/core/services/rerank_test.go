@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// stubReranker scores passages by the position of their title in order,
+// so the first listed title ranks first.
+type stubReranker struct {
+	order  []string
+	scores []float64 // returned as is when set
+	err    error
+	calls  int
+	seen   [][]string
+}
+
+func (r *stubReranker) Rerank(_ context.Context, _ string, passages []string) ([]float64, error) {
+	r.calls++
+	r.seen = append(r.seen, passages)
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.scores != nil {
+		return r.scores, nil
+	}
+	scores := make([]float64, len(passages))
+	for i, passage := range passages {
+		for rank, title := range r.order {
+			if strings.HasPrefix(passage, title+"\n") {
+				scores[i] = float64(len(r.order) - rank)
+			}
+		}
+	}
+	return scores, nil
+}
+
+func resultIDs(results []domain.SearchResult) []string {
+	ids := make([]string, len(results))
+	for i := range results {
+		ids[i] = results[i].Document.ID
+	}
+	return ids
+}
+
+func newRerankedSearchService(t *testing.T, reranker *stubReranker, settings domain.RerankSettings) *SearchService {
+	t.Helper()
+	service := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+	service.SetReranker(reranker, settings)
+	return service
+}
+
+func TestSearchService_Search_Rerank_ReordersCandidates(t *testing.T) {
+	reranker := &stubReranker{order: []string{"API Reference", "Configuration Guide", "Getting Started with Sercha"}}
+	service := newRerankedSearchService(t, reranker, domain.RerankSettings{Enabled: true})
+
+	results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-3", "doc-2", "doc-1"}, resultIDs(results))
+	assert.Equal(t, 1, reranker.calls)
+	// Scores stay in descending order
+	for i := 1; i < len(results); i++ {
+		assert.GreaterOrEqual(t, results[i-1].Score, results[i].Score)
+	}
+}
+
+func TestSearchService_Search_Rerank_FailureKeepsFusedRanking(t *testing.T) {
+	reranker := &stubReranker{err: errors.New("connection refused")}
+	service := newRerankedSearchService(t, reranker, domain.RerankSettings{Enabled: true})
+
+	results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-1", "doc-2", "doc-3"}, resultIDs(results))
+	assert.Equal(t, 1, reranker.calls)
+}
+
+func TestSearchService_Search_Rerank_ScoreCountMismatchKeepsFusedRanking(t *testing.T) {
+	reranker := &stubReranker{scores: []float64{1}}
+	service := newRerankedSearchService(t, reranker, domain.RerankSettings{Enabled: true})
+
+	results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-1", "doc-2", "doc-3"}, resultIDs(results))
+}
+
+func TestSearchService_Search_Rerank_CachesScores(t *testing.T) {
+	reranker := &stubReranker{order: []string{"API Reference"}}
+	service := newRerankedSearchService(t, reranker, domain.RerankSettings{Enabled: true})
+	ctx := context.Background()
+
+	_, err := service.Search(ctx, "sercha", domain.SearchOptions{})
+	require.NoError(t, err)
+	results, err := service.Search(ctx, "  Sercha ", domain.SearchOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, reranker.calls)
+	assert.Equal(t, "doc-3", results[0].Document.ID)
+}
+
+func TestSearchService_Search_Rerank_Modes(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("off overrides enabled", func(t *testing.T) {
+		reranker := &stubReranker{order: []string{"API Reference"}}
+		service := newRerankedSearchService(t, reranker, domain.RerankSettings{Enabled: true})
+
+		results, err := service.Search(ctx, "sercha", domain.SearchOptions{Rerank: domain.RerankModeOff})
+
+		require.NoError(t, err)
+		assert.Equal(t, "doc-1", results[0].Document.ID)
+		assert.Zero(t, reranker.calls)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		reranker := &stubReranker{order: []string{"API Reference"}}
+		service := newRerankedSearchService(t, reranker, domain.RerankSettings{})
+
+		_, err := service.Search(ctx, "sercha", domain.SearchOptions{})
+
+		require.NoError(t, err)
+		assert.Zero(t, reranker.calls)
+	})
+
+	t.Run("on overrides disabled", func(t *testing.T) {
+		reranker := &stubReranker{order: []string{"API Reference"}}
+		service := newRerankedSearchService(t, reranker, domain.RerankSettings{})
+
+		results, err := service.Search(ctx, "sercha", domain.SearchOptions{Rerank: domain.RerankModeOn})
+
+		require.NoError(t, err)
+		assert.Equal(t, "doc-3", results[0].Document.ID)
+		assert.Equal(t, 1, reranker.calls)
+	})
+}
+
+func TestSearchService_Search_Rerank_LimitsCandidates(t *testing.T) {
+	reranker := &stubReranker{order: []string{"Configuration Guide", "Getting Started with Sercha"}}
+	service := newRerankedSearchService(t, reranker, domain.RerankSettings{Enabled: true, Candidates: 10})
+
+	results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{RerankCandidates: 2})
+
+	require.NoError(t, err)
+	require.Len(t, reranker.seen, 1)
+	assert.Len(t, reranker.seen[0], 2)
+	assert.Equal(t, []string{"doc-2", "doc-1", "doc-3"}, resultIDs(results))
+}
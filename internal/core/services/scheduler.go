@@ -2,13 +2,14 @@ package services
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
 // Scheduler manages background task execution.
@@ -17,6 +18,7 @@ type Scheduler struct {
 	config   domain.SchedulerConfig
 	store    driven.SchedulerStore
 	syncOrch driving.SyncOrchestrator
+	log      *slog.Logger
 
 	mu      sync.Mutex
 	running bool
@@ -34,6 +36,15 @@ func NewScheduler(
 		config:   config,
 		store:    store,
 		syncOrch: syncOrch,
+		log:      logger.Slog(),
+	}
+}
+
+// SetLogger sets the structured logger used for scheduler events.
+// Defaults to the shared application logger.
+func (s *Scheduler) SetLogger(log *slog.Logger) {
+	if log != nil {
+		s.log = log
 	}
 }
 
@@ -50,7 +61,7 @@ func (s *Scheduler) Start(ctx context.Context) error {
 
 	// Initialise tasks in store
 	if err := s.initialiseTasks(ctx); err != nil {
-		log.Printf("scheduler: failed to initialise tasks: %v", err)
+		s.log.Error("scheduler: failed to initialise tasks", "error", err)
 	}
 
 	// Run the main scheduler loop
@@ -140,7 +151,7 @@ func (s *Scheduler) run(ctx context.Context) error {
 func (s *Scheduler) checkAndRunDueTasks(ctx context.Context) {
 	tasks, err := s.store.ListTasks(ctx)
 	if err != nil {
-		log.Printf("scheduler: failed to list tasks: %v", err)
+		s.log.Error("scheduler: failed to list tasks", "error", err)
 		return
 	}
 
@@ -172,16 +183,19 @@ func (s *Scheduler) runTask(ctx context.Context, task *domain.ScheduledTask) {
 		case domain.TaskIDDocumentSync:
 			result.ItemsProcessed, err = s.runDocumentSync(ctx)
 		default:
-			log.Printf("scheduler: unknown task ID: %s", task.ID)
+			s.log.Warn("scheduler: unknown task", "task_id", task.ID)
 			return
 		}
 
 		result.EndedAt = time.Now()
+		duration := result.EndedAt.Sub(result.StartedAt)
 		if err != nil {
+			s.log.Warn("scheduler: task failed", "task_id", task.ID, "duration", duration, "error", err)
 			result.Success = false
 			result.Error = err.Error()
 			task.LastError = err.Error()
 		} else {
+			s.log.Info("scheduler: task finished", "task_id", task.ID, "duration", duration)
 			result.Success = true
 			task.LastError = ""
 			task.LastSuccess = result.EndedAt
@@ -192,17 +206,17 @@ func (s *Scheduler) runTask(ctx context.Context, task *domain.ScheduledTask) {
 		task.NextRun = result.EndedAt.Add(task.Interval)
 
 		if saveErr := s.store.SaveTask(ctx, task); saveErr != nil {
-			log.Printf("scheduler: failed to save task %s: %v", task.ID, saveErr)
+			s.log.Error("scheduler: failed to save task", "task_id", task.ID, "error", saveErr)
 		}
 
 		// Record result for history
 		if recordErr := s.store.RecordResult(ctx, result); recordErr != nil {
-			log.Printf("scheduler: failed to record result for %s: %v", task.ID, recordErr)
+			s.log.Error("scheduler: failed to record result", "task_id", task.ID, "error", recordErr)
 		}
 
 		// Prune old history (keep last 100 results per task)
 		if pruneErr := s.store.PruneHistory(ctx, 100); pruneErr != nil {
-			log.Printf("scheduler: failed to prune history: %v", pruneErr)
+			s.log.Error("scheduler: failed to prune history", "error", pruneErr)
 		}
 	}()
 }
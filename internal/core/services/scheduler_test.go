@@ -122,6 +122,14 @@ func (m *mockSyncOrchestrator) SyncAll(_ context.Context) error {
 	return m.syncAllErr
 }
 
+func (m *mockSyncOrchestrator) SyncSince(ctx context.Context, sourceID string, _ time.Time) error {
+	return m.Sync(ctx, sourceID)
+}
+
+func (m *mockSyncOrchestrator) SyncAllSince(ctx context.Context, _ time.Time) error {
+	return m.SyncAll(ctx)
+}
+
 func (m *mockSyncOrchestrator) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
 	return &driving.SyncStatus{}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -33,6 +34,16 @@ type SearchService struct {
 	llmService       driven.LLMService
 	sourceStore      driven.SourceStore
 	credentialsStore driven.CredentialsStore
+	feedbackStore    driven.FeedbackStore
+	mode             domain.SearchMode
+	hybridOverFetch  int
+	minScore         float64
+	snippetLength    int
+	vectorIndexErr   error
+	reranker         driven.Reranker
+	rerankByDefault  bool
+	rerankCandidates int
+	rerankCache      *rerankCache
 }
 
 // NewSearchService creates a new search service.
@@ -63,6 +74,44 @@ func (s *SearchService) SetCredentialsStore(store driven.CredentialsStore) {
 	s.credentialsStore = store
 }
 
+// SetFeedbackStore sets the store of relevance feedback used to boost or
+// penalise results for queries similar to ones the user has rated.
+func (s *SearchService) SetFeedbackStore(store driven.FeedbackStore) {
+	s.feedbackStore = store
+}
+
+// SetSearchMode sets the configured search mode.
+// Only modes that cannot be inferred from the available services (vector-only)
+// change behaviour; other modes are still selected automatically.
+func (s *SearchService) SetSearchMode(mode domain.SearchMode) {
+	s.mode = mode
+}
+
+// SetVectorIndexUnavailable records that the vector index failed to load.
+// Searches fall back to keyword mode, but requests that explicitly ask for
+// vector or hybrid search fail with domain.ErrVectorIndexUnavailable.
+func (s *SearchService) SetVectorIndexUnavailable(err error) {
+	s.vectorIndexErr = err
+}
+
+// SetHybridOverFetch sets how many candidates per result hybrid search fetches
+// from each engine before fusion. Values below 1 use the default.
+func (s *SearchService) SetHybridOverFetch(multiplier int) {
+	s.hybridOverFetch = multiplier
+}
+
+// SetVectorMinScore sets the cosine similarity below which vector matches
+// are dropped before fusion. Zero keeps every match.
+func (s *SearchService) SetVectorMinScore(minScore float64) {
+	s.minScore = minScore
+}
+
+// SetVectorSnippetLength sets the length, in characters, of the snippet taken
+// from the matching chunk of a vector hit. Values below 1 use the default.
+func (s *SearchService) SetVectorSnippetLength(length int) {
+	s.snippetLength = length
+}
+
 // Search performs hybrid search across all indexed documents.
 func (s *SearchService) Search(
 	ctx context.Context, query string, opts domain.SearchOptions,
@@ -77,21 +126,119 @@ func (s *SearchService) Search(
 		return []domain.SearchResult{}, nil
 	}
 
-	// Determine limit (default to 20)
-	limit := opts.Limit
-	if limit <= 0 {
-		limit = 20
+	limit := resultLimit(opts)
+	results, err := s.rankedResults(ctx, query, opts, limit)
+	if err != nil {
+		return nil, err
 	}
+
+	// Order before paginating so pages are stable across runs
+	sortResults(results, opts.SortBy)
+
+	// Apply pagination
+	results = s.applyPagination(results, opts.Offset, limit)
+	logger.Info("Final results: %d", len(results))
+
+	return results, nil
+}
+
+// SearchByChunk performs the same search as Search but returns only the
+// highest-scoring chunk of each document.
+func (s *SearchService) SearchByChunk(
+	ctx context.Context, query string, opts domain.SearchOptions,
+) ([]domain.ChunkSearchResult, error) {
+	logger.Section("Chunk Search Execution")
+	logger.Debug("Query: %q", query)
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		logger.Debug("Empty query, returning no results")
+		return []domain.ChunkSearchResult{}, nil
+	}
+
+	limit := resultLimit(opts)
+	results, err := s.rankedResults(ctx, query, opts, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deduplicate before paginating so each page holds distinct documents
+	results = bestChunkPerDocument(results)
+	logger.Debug("After chunk deduplication: %d results", len(results))
+
+	sortResults(results, opts.SortBy)
+	results = s.applyPagination(results, opts.Offset, limit)
+
+	chunks := make([]domain.ChunkSearchResult, len(results))
+	for i := range results {
+		chunks[i] = domain.ChunkSearchResult{
+			Chunk:    results[i].Chunk,
+			Document: results[i].Document,
+			Score:    results[i].Score,
+			Feedback: results[i].Feedback,
+		}
+	}
+	logger.Info("Final chunk results: %d", len(chunks))
+
+	return chunks, nil
+}
+
+// resultLimit returns the requested result limit, defaulting to 20.
+func resultLimit(opts domain.SearchOptions) int {
+	if opts.Limit <= 0 {
+		return 20
+	}
+	return opts.Limit
+}
+
+// rankedResults runs the search for the effective mode and returns hydrated,
+// source-, author- and language-filtered results in engine order, before sorting and pagination.
+func (s *SearchService) rankedResults(
+	ctx context.Context, query string, opts domain.SearchOptions, limit int,
+) ([]domain.SearchResult, error) {
 	logger.Debug("Limit: %d, Offset: %d", limit, opts.Offset)
 
+	if opts.Collection != "" {
+		sourceIDs, err := s.collectionSourceIDs(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(sourceIDs) == 0 {
+			logger.Debug("No sources in both collection %q and the source filter", opts.Collection)
+			return []domain.SearchResult{}, nil
+		}
+		opts.SourceIDs = sourceIDs
+	}
+
 	// Request more results internally to account for filtering
 	internalLimit := limit * 2
 	if len(opts.SourceIDs) > 0 {
 		internalLimit = limit * 3
 		logger.Debug("Source filter: %v", opts.SourceIDs)
 	}
+	if len(opts.Authors) > 0 {
+		internalLimit = limit * 3
+		logger.Debug("Author filter: %v", opts.Authors)
+	}
+	if opts.Language != "" {
+		internalLimit = limit * 3
+		logger.Debug("Language filter: %s", opts.Language)
+	}
+	// Fetch enough to fill the rerank candidates
+	if s.rerankEnabled(opts) {
+		internalLimit = max(internalLimit, s.rerankCandidateCount(opts))
+	}
+	// Other orderings must see every match, not just the most relevant ones
+	if !opts.SortBy.ByRelevance() {
+		internalLimit = max(internalLimit, opts.Offset+limit, domain.SortCandidates)
+		logger.Debug("Sort by: %s", opts.SortBy)
+	}
 	logger.Debug("Internal limit: %d", internalLimit)
 
+	if err := s.checkVectorIndex(opts); err != nil {
+		return nil, err
+	}
+
 	// Determine effective search mode based on options and available services
 	mode := s.effectiveMode(opts)
 	logger.Info("Effective search mode: %s", mode.Description())
@@ -114,7 +261,11 @@ func (s *SearchService) Search(
 
 	case domain.SearchModeHybrid:
 		logger.Debug("Executing hybrid search (keyword + vector)")
-		chunks, err = s.hybridSearch(ctx, query, internalLimit)
+		chunks, err = s.hybridSearch(ctx, query, internalLimit, s.vectorMinScore(opts))
+
+	case domain.SearchModeVectorOnly:
+		logger.Debug("Executing vector-only search")
+		chunks, err = s.vectorSearch(ctx, query, internalLimit, s.vectorMinScore(opts))
 
 	case domain.SearchModeLLMAssisted:
 		logger.Debug("Executing LLM-assisted search")
@@ -122,7 +273,7 @@ func (s *SearchService) Search(
 
 	case domain.SearchModeFull:
 		logger.Debug("Executing full search (LLM + hybrid)")
-		chunks, err = s.fullSearch(ctx, query, internalLimit)
+		chunks, err = s.fullSearch(ctx, query, internalLimit, s.vectorMinScore(opts))
 
 	default:
 		logger.Debug("Fallback to keyword search")
@@ -150,13 +301,87 @@ func (s *SearchService) Search(
 		logger.Debug("After source filter: %d results", len(results))
 	}
 
-	// Apply pagination
-	results = s.applyPagination(results, opts.Offset, limit)
-	logger.Info("Final results: %d", len(results))
+	if len(opts.Authors) > 0 {
+		results = filterByAuthors(results, opts.Authors)
+		logger.Debug("After author filter: %d results", len(results))
+	}
+
+	if opts.Language != "" {
+		results = filterByLanguage(results, opts.Language)
+		logger.Debug("After language filter: %d results", len(results))
+	}
+
+	s.rerank(ctx, query, results, opts)
+	s.applyFeedback(ctx, results, query)
 
 	return results, nil
 }
 
+// applyFeedback adjusts result scores using the user's relevance feedback.
+// Feedback is best-effort: if it cannot be loaded, scores are left unchanged.
+func (s *SearchService) applyFeedback(ctx context.Context, results []domain.SearchResult, query string) {
+	if s.feedbackStore == nil || len(results) == 0 {
+		return
+	}
+
+	seen := make(map[string]bool, len(results))
+	docIDs := make([]string, 0, len(results))
+	for i := range results {
+		if id := results[i].Document.ID; !seen[id] {
+			seen[id] = true
+			docIDs = append(docIDs, id)
+		}
+	}
+
+	feedback, err := s.feedbackStore.ForDocuments(ctx, docIDs)
+	if err != nil {
+		logger.Warn("Loading search feedback failed: %v", err)
+		return
+	}
+	if len(feedback) == 0 {
+		return
+	}
+
+	byDocument := make(map[string][]domain.Feedback, len(feedback))
+	for _, f := range feedback {
+		byDocument[f.DocumentID] = append(byDocument[f.DocumentID], f)
+	}
+
+	normalised := domain.NormaliseQuery(query)
+	for i := range results {
+		docFeedback := byDocument[results[i].Document.ID]
+		if len(docFeedback) == 0 {
+			continue
+		}
+		results[i].Score *= 1 + feedbackWeight*feedbackBoost(normalised, docFeedback)
+		for _, f := range docFeedback {
+			if f.Query == normalised {
+				results[i].Feedback = f.Signal
+			}
+		}
+	}
+	logger.Debug("Applied feedback on %d documents", len(byDocument))
+}
+
+// checkVectorIndex fails requests that need vector search when the vector
+// index could not be loaded, rather than silently returning keyword results.
+func (s *SearchService) checkVectorIndex(opts domain.SearchOptions) error {
+	if s.vectorIndexErr == nil {
+		return nil
+	}
+
+	wantsVector := opts.Semantic || opts.Hybrid ||
+		opts.Mode == domain.SearchModeHybrid || opts.Mode == domain.SearchModeVectorOnly
+	if opts.Mode == "" && s.mode == domain.SearchModeVectorOnly {
+		wantsVector = true
+	}
+	if !wantsVector {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %w", domain.ErrVectorIndexUnavailable, s.vectorIndexErr)
+}
+
 // effectiveMode determines the search mode based on options and available services.
 // It gracefully degrades if required services are unavailable.
 func (s *SearchService) effectiveMode(opts domain.SearchOptions) domain.SearchMode {
@@ -164,6 +389,22 @@ func (s *SearchService) effectiveMode(opts domain.SearchOptions) domain.SearchMo
 	canDoVector := s.vectorIndex != nil && s.embeddingService != nil
 	canDoLLM := s.llmService != nil
 
+	// An explicit per-query mode takes precedence over everything else
+	switch opts.Mode {
+	case domain.SearchModeTextOnly:
+		return domain.SearchModeTextOnly
+	case domain.SearchModeHybrid, domain.SearchModeVectorOnly:
+		if canDoVector {
+			return opts.Mode
+		}
+		return domain.SearchModeTextOnly
+	case domain.SearchModeLLMAssisted:
+		if canDoLLM {
+			return domain.SearchModeLLMAssisted
+		}
+		return domain.SearchModeTextOnly
+	}
+
 	// If options explicitly request semantic search
 	if opts.Semantic && canDoVector {
 		return domain.SearchModeHybrid
@@ -178,6 +419,14 @@ func (s *SearchService) effectiveMode(opts domain.SearchOptions) domain.SearchMo
 		return domain.SearchModeTextOnly
 	}
 
+	// Vector-only must be configured explicitly and needs an embedding provider
+	if s.mode == domain.SearchModeVectorOnly {
+		if canDoVector {
+			return domain.SearchModeVectorOnly
+		}
+		return domain.SearchModeTextOnly
+	}
+
 	// Determine best available mode
 	if canDoVector && canDoLLM {
 		return domain.SearchModeFull
@@ -221,11 +470,24 @@ func (s *SearchService) keywordSearch(ctx context.Context, query string, limit i
 	return results, nil
 }
 
+// vectorMinScore returns the similarity threshold for a query: its own when
+// set, otherwise the configured one. Negative thresholds keep every match.
+func (s *SearchService) vectorMinScore(opts domain.SearchOptions) float64 {
+	if opts.MinScore != 0 {
+		return opts.MinScore
+	}
+	return s.minScore
+}
+
 // vectorSearch performs semantic similarity search using HNSW.
-func (s *SearchService) vectorSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
+// Hits with a similarity below minScore are dropped, so a query unrelated to
+// every document returns few or no chunks rather than the k nearest.
+func (s *SearchService) vectorSearch(
+	ctx context.Context, query string, limit int, minScore float64,
+) ([]scoredChunk, error) {
 	if s.vectorIndex == nil {
 		logger.Warn("Vector search unavailable: vector index is nil")
-		return nil, errors.New("vector index unavailable")
+		return nil, domain.ErrVectorIndexUnavailable
 	}
 	if s.embeddingService == nil {
 		logger.Warn("Vector search unavailable: embedding service is nil")
@@ -252,21 +514,53 @@ func (s *SearchService) vectorSearch(ctx context.Context, query string, limit in
 
 	logger.Debug("Vector search: %d hits", len(hits))
 
-	results := make([]scoredChunk, len(hits))
-	for i, hit := range hits {
-		results[i] = scoredChunk{
+	metric := vectorMetric(s.vectorIndex)
+	results := make([]scoredChunk, 0, len(hits))
+	for _, hit := range hits {
+		similarity := hitSimilarity(hit, metric)
+		if minScore > 0 && similarity < minScore {
+			continue
+		}
+		results = append(results, scoredChunk{
 			chunkID: hit.ChunkID,
-			score:   hit.Similarity, // Cosine similarity 0-1
+			score:   similarity,
 			source:  "vector",
-		}
+		})
+	}
+	if dropped := len(hits) - len(results); dropped > 0 {
+		logger.Debug("Vector search: dropped %d hits below similarity %.2f", dropped, minScore)
 	}
 
 	return results, nil
 }
 
+// vectorMetric returns the metric a vector index scores hits with.
+func vectorMetric(index driven.VectorIndex) driven.VectorMetric {
+	if reporter, ok := index.(driven.MetricReporter); ok {
+		return reporter.Metric()
+	}
+	return driven.VectorMetricCosine
+}
+
+// hitSimilarity returns the cosine similarity of a hit. L2 distances are
+// converted with cos = 1 - d²/2, which holds for the unit-length vectors
+// embedding models produce, so one threshold means the same for both metrics.
+func hitSimilarity(hit driven.VectorHit, metric driven.VectorMetric) float64 {
+	if metric == driven.VectorMetricL2 {
+		return 1 - hit.Distance*hit.Distance/2
+	}
+	return hit.Similarity
+}
+
 // hybridSearch combines keyword and vector search using RRF.
-func (s *SearchService) hybridSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
-	logger.Debug("Hybrid search: running keyword and vector searches in parallel")
+// Each engine is asked for limit × over-fetch candidates so documents that
+// only one engine ranks highly still collect the other engine's score before
+// the fused list is truncated to limit.
+func (s *SearchService) hybridSearch(
+	ctx context.Context, query string, limit int, minScore float64,
+) ([]scoredChunk, error) {
+	candidates := limit * s.overFetchMultiplier()
+	logger.Debug("Hybrid search: running keyword and vector searches in parallel (%d candidates each)", candidates)
 
 	// Run keyword and vector searches in parallel
 	var keywordResults, vectorResults []scoredChunk
@@ -277,12 +571,12 @@ func (s *SearchService) hybridSearch(ctx context.Context, query string, limit in
 
 	go func() {
 		defer wg.Done()
-		keywordResults, keywordErr = s.keywordSearch(ctx, query, limit)
+		keywordResults, keywordErr = s.keywordSearch(ctx, query, candidates)
 	}()
 
 	go func() {
 		defer wg.Done()
-		vectorResults, vectorErr = s.vectorSearch(ctx, query, limit)
+		vectorResults, vectorErr = s.vectorSearch(ctx, query, candidates, minScore)
 	}()
 
 	wg.Wait()
@@ -295,12 +589,12 @@ func (s *SearchService) hybridSearch(ctx context.Context, query string, limit in
 
 	if keywordErr != nil {
 		logger.Warn("Hybrid search: keyword search failed, using vector results only")
-		return vectorResults, nil
+		return truncateChunks(vectorResults, limit), nil
 	}
 
 	if vectorErr != nil {
 		logger.Warn("Hybrid search: vector search failed, using keyword results only")
-		return keywordResults, nil
+		return truncateChunks(keywordResults, limit), nil
 	}
 
 	// Merge using Reciprocal Rank Fusion
@@ -309,7 +603,23 @@ func (s *SearchService) hybridSearch(ctx context.Context, query string, limit in
 	merged := s.reciprocalRankFusion(keywordResults, vectorResults, 60)
 	logger.Debug("Hybrid search: merged to %d results", len(merged))
 
-	return merged, nil
+	return truncateChunks(merged, limit), nil
+}
+
+// overFetchMultiplier returns the configured hybrid over-fetch, or the default.
+func (s *SearchService) overFetchMultiplier() int {
+	if s.hybridOverFetch <= 0 {
+		return domain.DefaultHybridOverFetch
+	}
+	return s.hybridOverFetch
+}
+
+// truncateChunks returns at most limit chunks.
+func truncateChunks(chunks []scoredChunk, limit int) []scoredChunk {
+	if len(chunks) > limit {
+		return chunks[:limit]
+	}
+	return chunks
 }
 
 // llmAssistedSearch uses LLM to expand the query before keyword search.
@@ -334,7 +644,9 @@ func (s *SearchService) llmAssistedSearch(ctx context.Context, query string, lim
 }
 
 // fullSearch combines LLM query expansion with hybrid search.
-func (s *SearchService) fullSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
+func (s *SearchService) fullSearch(
+	ctx context.Context, query string, limit int, minScore float64,
+) ([]scoredChunk, error) {
 	// Expand query using LLM if available
 	expandedQuery := query
 	if s.llmService != nil {
@@ -349,7 +661,7 @@ func (s *SearchService) fullSearch(ctx context.Context, query string, limit int)
 	}
 
 	// Run hybrid search with the expanded query
-	return s.hybridSearch(ctx, expandedQuery, limit)
+	return s.hybridSearch(ctx, expandedQuery, limit, minScore)
 }
 
 // Merges two ranked lists using Reciprocal Rank Fusion (RRF).
@@ -374,13 +686,25 @@ func (s *SearchService) reciprocalRankFusion(list1, list2 []scoredChunk, k int)
 		seen[chunk.chunkID] = true
 	}
 
+	// Chunks found by only one list keep that list's source
+	sources := make(map[string]string, len(seen))
+	for _, list := range [][]scoredChunk{list1, list2} {
+		for _, chunk := range list {
+			if source, ok := sources[chunk.chunkID]; ok && source != chunk.source {
+				sources[chunk.chunkID] = "merged"
+			} else {
+				sources[chunk.chunkID] = chunk.source
+			}
+		}
+	}
+
 	// Convert to slice and sort by combined score
 	results := make([]scoredChunk, 0, len(seen))
 	for id := range seen {
 		results = append(results, scoredChunk{
 			chunkID: id,
 			score:   scores[id],
-			source:  "merged",
+			source:  sources[id],
 		})
 	}
 
@@ -422,8 +746,14 @@ func (s *SearchService) hydrateResults(
 			return nil, fmt.Errorf("get document %s: %w", chunk.DocumentID, err)
 		}
 
-		// Generate highlights
+		// Generate highlights; vector hits may share no terms with the query,
+		// so they show the text of the chunk that matched instead
 		highlights := s.generateHighlights(chunk.Content, query)
+		if len(highlights) == 0 && sc.source == "vector" {
+			if snippet := chunkSnippet(chunk.Content, s.vectorSnippetLength()); snippet != "" {
+				highlights = []string{snippet}
+			}
+		}
 
 		// Build SourceName from source and credentials
 		sourceName := s.getSourceName(ctx, doc.SourceID)
@@ -481,6 +811,30 @@ func (s *SearchService) generateHighlights(content, query string) []string {
 	return highlights
 }
 
+// vectorSnippetLength returns the configured vector snippet length.
+func (s *SearchService) vectorSnippetLength() int {
+	if s.snippetLength <= 0 {
+		return domain.DefaultVectorSnippetLength
+	}
+	return s.snippetLength
+}
+
+// chunkSnippet returns the start of a chunk's text with whitespace collapsed,
+// cut at a word boundary to at most length characters.
+func chunkSnippet(content string, length int) string {
+	snippet := strings.Join(strings.Fields(content), " ")
+	runes := []rune(snippet)
+	if len(runes) <= length {
+		return snippet
+	}
+
+	cut := string(runes[:length-3])
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + "..."
+}
+
 // splitSentences splits content into sentences.
 func splitSentences(content string) []string {
 	// Simple sentence splitting by common terminators
@@ -506,6 +860,36 @@ func splitSentences(content string) []string {
 	return sentences
 }
 
+// collectionSourceIDs returns the sources of the options' collection,
+// narrowed to opts.SourceIDs when those are also given.
+// Returns domain.ErrNotFound if no source is in the collection.
+func (s *SearchService) collectionSourceIDs(ctx context.Context, opts domain.SearchOptions) ([]string, error) {
+	if s.sourceStore == nil {
+		return nil, fmt.Errorf("search by collection: %w", domain.ErrNotImplemented)
+	}
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	var sourceIDs []string
+	found := false
+	for i := range sources {
+		if !sources[i].InCollection(opts.Collection) {
+			continue
+		}
+		found = true
+		if len(opts.SourceIDs) == 0 || slices.Contains(opts.SourceIDs, sources[i].ID) {
+			sourceIDs = append(sourceIDs, sources[i].ID)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: collection %q", domain.ErrNotFound, opts.Collection)
+	}
+	logger.Debug("Collection %q: %v", opts.Collection, sourceIDs)
+	return sourceIDs, nil
+}
+
 // filterBySourceIDs filters results to only include specified sources.
 func (s *SearchService) filterBySourceIDs(results []domain.SearchResult, sourceIDs []string) []domain.SearchResult {
 	sourceSet := make(map[string]bool)
@@ -523,6 +907,97 @@ func (s *SearchService) filterBySourceIDs(results []domain.SearchResult, sourceI
 	return filtered
 }
 
+// filterByAuthors filters results to documents by any of the given authors.
+func filterByAuthors(results []domain.SearchResult, authors []string) []domain.SearchResult {
+	filtered := make([]domain.SearchResult, 0)
+	for i := range results {
+		for _, author := range authors {
+			if results[i].Document.Author.Matches(author) {
+				filtered = append(filtered, results[i])
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+// filterByLanguage filters results to documents written in the given language.
+// Documents whose language is not known are dropped.
+func filterByLanguage(results []domain.SearchResult, lang string) []domain.SearchResult {
+	filtered := make([]domain.SearchResult, 0)
+	for i := range results {
+		if strings.EqualFold(domain.DocumentLanguage(results[i].Document.Metadata), lang) {
+			filtered = append(filtered, results[i])
+		}
+	}
+
+	return filtered
+}
+
+// sortResults orders results by the requested field.
+// Ties fall back to score, then document and chunk ID, so identical
+// queries always return results in the same order.
+func sortResults(results []domain.SearchResult, sortBy domain.SortField) {
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := &results[i], &results[j]
+		switch sortBy {
+		case domain.SortByDate:
+			if !a.Document.CreatedAt.Equal(b.Document.CreatedAt) {
+				return a.Document.CreatedAt.After(b.Document.CreatedAt)
+			}
+		case domain.SortByTitle:
+			if c := compareFold(a.Document.Title, b.Document.Title); c != 0 {
+				return c < 0
+			}
+		case domain.SortBySource:
+			if c := compareFold(resultSource(a), resultSource(b)); c != 0 {
+				return c < 0
+			}
+		}
+
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Document.ID != b.Document.ID {
+			return a.Document.ID < b.Document.ID
+		}
+		return a.Chunk.ID < b.Chunk.ID
+	})
+}
+
+// bestChunkPerDocument keeps the highest-scoring result of each document.
+// Documents keep the position of their first result.
+func bestChunkPerDocument(results []domain.SearchResult) []domain.SearchResult {
+	best := make(map[string]int, len(results))
+	deduped := make([]domain.SearchResult, 0, len(results))
+	for i := range results {
+		docID := results[i].Document.ID
+		if j, ok := best[docID]; ok {
+			if results[i].Score > deduped[j].Score {
+				deduped[j] = results[i]
+			}
+			continue
+		}
+		best[docID] = len(deduped)
+		deduped = append(deduped, results[i])
+	}
+	return deduped
+}
+
+// compareFold compares two strings case-insensitively.
+func compareFold(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// resultSource returns the source name of a result, or its source ID when unnamed.
+func resultSource(result *domain.SearchResult) string {
+	if result.SourceName != "" {
+		return result.SourceName
+	}
+	return result.Document.SourceID
+}
+
 // applyPagination applies offset and limit to results.
 func (s *SearchService) applyPagination(results []domain.SearchResult, offset, limit int) []domain.SearchResult {
 	if offset >= len(results) {
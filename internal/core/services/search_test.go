@@ -3,6 +3,10 @@ package services
 import (
 	"context"
 	"errors"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -22,9 +26,15 @@ type mockSearchEngine struct {
 	searchErr error
 	indexErr  error
 	deleteErr error
+
+	mu      sync.Mutex
+	indexed []domain.Chunk
 }
 
-func (m *mockSearchEngine) Index(_ context.Context, _ domain.Chunk) error {
+func (m *mockSearchEngine) Index(_ context.Context, chunk domain.Chunk) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.indexed = append(m.indexed, chunk)
 	return m.indexErr
 }
 
@@ -72,6 +82,25 @@ func (m *mockVectorIndex) Search(_ context.Context, _ []float32, k int) ([]drive
 	return m.hits[:k], nil
 }
 
+func (m *mockVectorIndex) SearchWithFilter(
+	_ context.Context, _ []float32, k int, candidates []string,
+) ([]driven.VectorHit, error) {
+	if m.searchErr != nil {
+		return nil, m.searchErr
+	}
+	allowed := make(map[string]bool, len(candidates))
+	for _, id := range candidates {
+		allowed[id] = true
+	}
+	var hits []driven.VectorHit
+	for _, hit := range m.hits {
+		if allowed[hit.ChunkID] && len(hits) < k {
+			hits = append(hits, hit)
+		}
+	}
+	return hits, nil
+}
+
 func (m *mockVectorIndex) Close() error {
 	return nil
 }
@@ -124,6 +153,9 @@ func (m *mockEmbeddingService) Close() error {
 type mockLLMService struct {
 	rewriteResult string
 	rewriteErr    error
+	keywords      []string
+	keywordsErr   error
+	keywordCalls  atomic.Int32
 }
 
 func (m *mockLLMService) Generate(_ context.Context, _ string, _ driven.GenerateOptions) (string, error) {
@@ -148,6 +180,14 @@ func (m *mockLLMService) Summarise(_ context.Context, _ string, _ int) (string,
 	return "", nil
 }
 
+func (m *mockLLMService) ExtractKeywords(_ context.Context, _ string, _ int) ([]string, error) {
+	m.keywordCalls.Add(1)
+	if m.keywordsErr != nil {
+		return nil, m.keywordsErr
+	}
+	return m.keywords, nil
+}
+
 func (m *mockLLMService) ModelName() string {
 	return "mock-llm"
 }
@@ -288,6 +328,53 @@ func TestSearchService_Search_HybridMode(t *testing.T) {
 	assert.NotEmpty(t, results)
 }
 
+func TestSearchService_hybridSearch_OverFetch(t *testing.T) {
+	// "x" is only in the vector engine's top 2; keyword search ranks it third.
+	searchEngine := &mockSearchEngine{hits: []driven.SearchHit{
+		{ChunkID: "a", Score: 0.9},
+		{ChunkID: "b", Score: 0.8},
+		{ChunkID: "x", Score: 0.7},
+	}}
+	vectorIndex := &mockVectorIndex{hits: []driven.VectorHit{
+		{ChunkID: "c", Similarity: 0.95},
+		{ChunkID: "x", Similarity: 0.9},
+		{ChunkID: "d", Similarity: 0.85},
+	}}
+	embedService := &mockEmbeddingService{embedding: make([]float32, 384)}
+	service := NewSearchService(nil, searchEngine, vectorIndex, embedService, nil)
+	ctx := context.Background()
+
+	ids := func(chunks []scoredChunk) []string {
+		out := make([]string, len(chunks))
+		for i, c := range chunks {
+			out[i] = c.chunkID
+		}
+		return out
+	}
+
+	// Without over-fetch the keyword rank of "x" is never seen and it is cut
+	service.SetHybridOverFetch(1)
+	chunks, err := service.hybridSearch(ctx, "query", 2, 0)
+	require.NoError(t, err)
+	assert.Len(t, chunks, 2)
+	assert.NotContains(t, ids(chunks), "x")
+
+	// With over-fetch both ranks contribute and "x" is fused to the top
+	service.SetHybridOverFetch(3)
+	chunks, err = service.hybridSearch(ctx, "query", 2, 0)
+	require.NoError(t, err)
+	assert.Len(t, chunks, 2)
+	assert.Equal(t, "x", chunks[0].chunkID)
+}
+
+func TestSearchService_overFetchMultiplier(t *testing.T) {
+	service := &SearchService{}
+	assert.Equal(t, domain.DefaultHybridOverFetch, service.overFetchMultiplier())
+
+	service.SetHybridOverFetch(5)
+	assert.Equal(t, 5, service.overFetchMultiplier())
+}
+
 func TestSearchService_Search_SemanticMode(t *testing.T) {
 	docStore := setupTestDocStore(t)
 	searchEngine := &mockSearchEngine{hits: createTestHits()}
@@ -304,6 +391,61 @@ func TestSearchService_Search_SemanticMode(t *testing.T) {
 	assert.NotEmpty(t, results)
 }
 
+func TestSearchService_Search_VectorOnlyMode(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	searchEngine := &mockSearchEngine{searchErr: errors.New("keyword search must not run")}
+	vectorIndex := &mockVectorIndex{hits: createTestVectorHits()}
+	embedService := &mockEmbeddingService{embedding: make([]float32, 384)}
+	service := NewSearchService(docStore, searchEngine, vectorIndex, embedService, nil)
+	service.SetSearchMode(domain.SearchModeVectorOnly)
+	ctx := context.Background()
+
+	results, err := service.Search(ctx, "how to configure", domain.SearchOptions{})
+
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "doc-2", results[0].Document.ID)
+	assert.InDelta(t, 0.95, results[0].Score, 0.001)
+}
+
+func TestSearchService_Search_VectorIndexUnavailable(t *testing.T) {
+	loadErr := errors.New("hnsw: failed to load index")
+	tests := []struct {
+		name       string
+		configured domain.SearchMode
+		opts       domain.SearchOptions
+		wantErr    bool
+	}{
+		{name: "auto mode falls back to keyword", opts: domain.SearchOptions{}},
+		{name: "explicit text mode", opts: domain.SearchOptions{Mode: domain.SearchModeTextOnly}},
+		{name: "hybrid flag", opts: domain.SearchOptions{Hybrid: true}, wantErr: true},
+		{name: "semantic flag", opts: domain.SearchOptions{Semantic: true}, wantErr: true},
+		{name: "hybrid mode", opts: domain.SearchOptions{Mode: domain.SearchModeHybrid}, wantErr: true},
+		{name: "vector mode", opts: domain.SearchOptions{Mode: domain.SearchModeVectorOnly}, wantErr: true},
+		{name: "configured vector mode", configured: domain.SearchModeVectorOnly, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			searchEngine := &mockSearchEngine{hits: createTestHits()}
+			service := NewSearchService(setupTestDocStore(t), searchEngine, nil, nil, nil)
+			service.SetSearchMode(tt.configured)
+			service.SetVectorIndexUnavailable(loadErr)
+
+			results, err := service.Search(context.Background(), "sercha", tt.opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, domain.ErrVectorIndexUnavailable)
+				assert.ErrorIs(t, err, loadErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, results, 3)
+		})
+	}
+}
+
 func TestSearchService_Search_FullMode(t *testing.T) {
 	docStore := setupTestDocStore(t)
 	searchEngine := &mockSearchEngine{hits: createTestHits()}
@@ -382,6 +524,82 @@ func TestSearchService_Search_SourceIDFilter(t *testing.T) {
 	}
 }
 
+func TestSearchService_Search_CollectionFilter(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Collection: "Work"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-2", Collection: "Personal"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-3", Collection: "Work"}))
+	service := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+	service.SetSourceStore(sourceStore)
+
+	results, err := service.Search(ctx, "test", domain.SearchOptions{Collection: "work"})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, r := range results {
+		assert.Equal(t, "src-1", r.Document.SourceID)
+	}
+
+	// Source filters narrow the collection
+	results, err = service.Search(ctx, "test", domain.SearchOptions{Collection: "Work", SourceIDs: []string{"src-2"}})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	_, err = service.Search(ctx, "test", domain.SearchOptions{Collection: "Travel"})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSearchService_Search_AuthorFilter(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	ctx := context.Background()
+	doc, err := docStore.GetDocument(ctx, "doc-2")
+	require.NoError(t, err)
+	doc.Author = domain.Author{Name: "Alice Smith", Identifier: "alice@example.com"}
+	require.NoError(t, docStore.SaveDocument(ctx, doc))
+
+	searchEngine := &mockSearchEngine{hits: createTestHits()}
+	service := NewSearchService(docStore, searchEngine, nil, nil, nil)
+
+	results, err := service.Search(ctx, "test", domain.SearchOptions{
+		Authors: []string{"bob@example.com", "ALICE SMITH"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "doc-2", results[0].Document.ID)
+
+	results, err = service.Search(ctx, "test", domain.SearchOptions{
+		Authors: []string{"carol@example.com"},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearchService_Search_LanguageFilter(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	ctx := context.Background()
+	doc, err := docStore.GetDocument(ctx, "doc-3")
+	require.NoError(t, err)
+	doc.Metadata = map[string]any{domain.MetadataLanguage: "fr"}
+	require.NoError(t, docStore.SaveDocument(ctx, doc))
+
+	searchEngine := &mockSearchEngine{hits: createTestHits()}
+	service := NewSearchService(docStore, searchEngine, nil, nil, nil)
+
+	results, err := service.Search(ctx, "test", domain.SearchOptions{Language: "FR"})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "doc-3", results[0].Document.ID)
+
+	// Documents without a detected language are not matched
+	results, err = service.Search(ctx, "test", domain.SearchOptions{Language: "en"})
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
 func TestSearchService_Search_NoSearchEngine(t *testing.T) {
 	docStore := setupTestDocStore(t)
 	service := NewSearchService(docStore, nil, nil, nil, nil)
@@ -537,12 +755,13 @@ func TestSearchService_Search_Highlights(t *testing.T) {
 
 func TestSearchService_effectiveMode(t *testing.T) {
 	tests := []struct {
-		name         string
-		hasVector    bool
-		hasEmbedding bool
-		hasLLM       bool
-		opts         domain.SearchOptions
-		expectedMode domain.SearchMode
+		name           string
+		hasVector      bool
+		hasEmbedding   bool
+		hasLLM         bool
+		configuredMode domain.SearchMode
+		opts           domain.SearchOptions
+		expectedMode   domain.SearchMode
 	}{
 		{
 			name:         "text only when nothing available",
@@ -579,6 +798,49 @@ func TestSearchService_effectiveMode(t *testing.T) {
 			opts:         domain.SearchOptions{Hybrid: true},
 			expectedMode: domain.SearchModeTextOnly,
 		},
+		{
+			name:           "vector only when configured and vector available",
+			hasVector:      true,
+			hasEmbedding:   true,
+			hasLLM:         true,
+			configuredMode: domain.SearchModeVectorOnly,
+			expectedMode:   domain.SearchModeVectorOnly,
+		},
+		{
+			name:           "vector only degraded when no embedding",
+			hasVector:      true,
+			configuredMode: domain.SearchModeVectorOnly,
+			expectedMode:   domain.SearchModeTextOnly,
+		},
+		{
+			name:         "text mode option overrides available services",
+			hasVector:    true,
+			hasEmbedding: true,
+			hasLLM:       true,
+			opts:         domain.SearchOptions{Mode: domain.SearchModeTextOnly},
+			expectedMode: domain.SearchModeTextOnly,
+		},
+		{
+			name:           "vector mode option overrides configured mode",
+			hasVector:      true,
+			hasEmbedding:   true,
+			configuredMode: domain.SearchModeHybrid,
+			opts:           domain.SearchOptions{Mode: domain.SearchModeVectorOnly},
+			expectedMode:   domain.SearchModeVectorOnly,
+		},
+		{
+			name:         "hybrid mode option skips llm",
+			hasVector:    true,
+			hasEmbedding: true,
+			hasLLM:       true,
+			opts:         domain.SearchOptions{Mode: domain.SearchModeHybrid},
+			expectedMode: domain.SearchModeHybrid,
+		},
+		{
+			name:         "vector mode option degraded when no vector",
+			opts:         domain.SearchOptions{Mode: domain.SearchModeVectorOnly},
+			expectedMode: domain.SearchModeTextOnly,
+		},
 	}
 
 	for _, tt := range tests {
@@ -598,6 +860,7 @@ func TestSearchService_effectiveMode(t *testing.T) {
 			}
 
 			service := NewSearchService(nil, nil, vectorIndex, embedService, llmService)
+			service.SetSearchMode(tt.configuredMode)
 			mode := service.effectiveMode(tt.opts)
 
 			assert.Equal(t, tt.expectedMode, mode)
@@ -635,6 +898,64 @@ func TestSearchService_reciprocalRankFusion(t *testing.T) {
 	assert.True(t, ids["d"])
 }
 
+func TestSearchService_reciprocalRankFusion_KeepsSingleListSource(t *testing.T) {
+	service := &SearchService{}
+
+	merged := service.reciprocalRankFusion(
+		[]scoredChunk{{chunkID: "a", source: "keyword"}, {chunkID: "b", source: "keyword"}},
+		[]scoredChunk{{chunkID: "b", source: "vector"}, {chunkID: "c", source: "vector"}},
+		60,
+	)
+
+	sources := make(map[string]string)
+	for _, c := range merged {
+		sources[c.chunkID] = c.source
+	}
+	assert.Equal(t, map[string]string{"a": "keyword", "b": "merged", "c": "vector"}, sources)
+}
+
+func TestSearchService_Search_VectorHitSnippet(t *testing.T) {
+	store := memory.NewDocumentStore()
+	ctx := context.Background()
+	require.NoError(t, store.SaveDocument(ctx, &domain.Document{ID: "doc-1", Title: "Handbook"}))
+	require.NoError(t, store.SaveChunks(ctx, []domain.Chunk{
+		{ID: "chunk-0", DocumentID: "doc-1", Content: "Welcome to the handbook.", Position: 0},
+		{ID: "chunk-1", DocumentID: "doc-1", Position: 1,
+			Content: "Staff may\nwork remotely up to three days a week with their manager's approval."},
+	}))
+	vectorIndex := &mockVectorIndex{hits: []driven.VectorHit{{ChunkID: "chunk-1", Similarity: 0.9}}}
+	service := NewSearchService(store, &mockSearchEngine{}, vectorIndex,
+		&mockEmbeddingService{embedding: make([]float32, 384)}, nil)
+	service.SetVectorSnippetLength(40)
+
+	results, err := service.Search(ctx, "home office policy", domain.SearchOptions{Mode: domain.SearchModeVectorOnly})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	// The snippet comes from the chunk that matched, not the document start
+	assert.Equal(t, "chunk-1", results[0].Chunk.ID)
+	assert.Equal(t, []string{"Staff may work remotely up to three..."}, results[0].Highlights)
+}
+
+func TestSearchService_Search_KeywordHitWithoutTermsHasNoSnippet(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	searchEngine := &mockSearchEngine{hits: []driven.SearchHit{{ChunkID: "chunk-doc-1", Score: 1}}}
+	service := NewSearchService(docStore, searchEngine, nil, nil, nil)
+
+	// Keyword hits matched on a stemmed form keep the existing fallback
+	results, err := service.Search(context.Background(), "searching", domain.SearchOptions{})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Empty(t, results[0].Highlights)
+}
+
+func TestChunkSnippet(t *testing.T) {
+	assert.Equal(t, "short text", chunkSnippet("  short\n\ttext ", 20))
+	assert.Equal(t, "one two...", chunkSnippet("one two three four", 12))
+	assert.Equal(t, "abcdefg...", chunkSnippet("abcdefghijklmnop", 10), "a single long word is cut mid-word")
+	assert.Empty(t, chunkSnippet("   ", 10))
+}
+
 func TestSearchService_splitSentences(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -771,6 +1092,180 @@ func TestSearchService_applyPagination(t *testing.T) {
 	}
 }
 
+func TestSearchService_Search_TiedScoresAreStable(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	// Engine returns tied hits in a different order on each call
+	searchEngine := &mockSearchEngine{hits: []driven.SearchHit{
+		{ChunkID: "chunk-doc-3", Score: 0.5},
+		{ChunkID: "chunk-doc-1", Score: 0.5},
+		{ChunkID: "chunk-doc-2", Score: 0.5},
+	}}
+	service := NewSearchService(docStore, searchEngine, nil, nil, nil)
+	ctx := context.Background()
+
+	first, err := service.Search(ctx, "sercha", domain.SearchOptions{})
+	require.NoError(t, err)
+
+	searchEngine.hits[0], searchEngine.hits[2] = searchEngine.hits[2], searchEngine.hits[0]
+	second, err := service.Search(ctx, "sercha", domain.SearchOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"doc-1", "doc-2", "doc-3"}, resultDocIDs(first))
+	assert.Equal(t, resultDocIDs(first), resultDocIDs(second))
+}
+
+func TestSearchService_Search_SortBy(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	ctx := context.Background()
+
+	// Give each document a distinct creation time
+	for i, id := range []string{"doc-2", "doc-3", "doc-1"} {
+		doc, err := docStore.GetDocument(ctx, id)
+		require.NoError(t, err)
+		doc.CreatedAt = time.Date(2024, 1, i+1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, docStore.SaveDocument(ctx, doc))
+	}
+
+	service := NewSearchService(docStore, &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+
+	tests := []struct {
+		sortBy   domain.SortField
+		expected []string
+	}{
+		{"", []string{"doc-1", "doc-2", "doc-3"}},
+		{domain.SortByScore, []string{"doc-1", "doc-2", "doc-3"}},
+		{domain.SortByDate, []string{"doc-1", "doc-3", "doc-2"}},
+		{domain.SortByTitle, []string{"doc-3", "doc-2", "doc-1"}},
+		{domain.SortBySource, []string{"doc-1", "doc-2", "doc-3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sortBy), func(t *testing.T) {
+			results, err := service.Search(ctx, "sercha", domain.SearchOptions{SortBy: tt.sortBy})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resultDocIDs(results))
+		})
+	}
+}
+
+func TestSearchService_Search_SortByDateConsidersAllMatches(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	ctx := context.Background()
+
+	// The newest document is the least relevant match
+	doc, err := docStore.GetDocument(ctx, "doc-3")
+	require.NoError(t, err)
+	doc.CreatedAt = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, docStore.SaveDocument(ctx, doc))
+
+	service := NewSearchService(docStore, &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+
+	results, err := service.Search(ctx, "sercha", domain.SearchOptions{Limit: 1, SortBy: domain.SortByDate})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-3"}, resultDocIDs(results))
+
+	results, err = service.Search(ctx, "sercha", domain.SearchOptions{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-1"}, resultDocIDs(results))
+}
+
+func TestSortResults_SourceUsesName(t *testing.T) {
+	results := []domain.SearchResult{
+		{Document: domain.Document{ID: "a", SourceID: "src-1"}, SourceName: "Zulip", Score: 0.9},
+		{Document: domain.Document{ID: "b", SourceID: "src-2"}, SourceName: "github", Score: 0.1},
+		{Document: domain.Document{ID: "c", SourceID: "src-2"}, SourceName: "github", Score: 0.5},
+	}
+
+	sortResults(results, domain.SortBySource)
+
+	assert.Equal(t, []string{"c", "b", "a"}, resultDocIDs(results))
+}
+
+// resultDocIDs returns the document IDs of results in order.
+func TestSearchService_SearchByChunk_BestChunkPerDocument(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	ctx := context.Background()
+	require.NoError(t, docStore.SaveChunks(ctx, []domain.Chunk{
+		{ID: "chunk-doc-1-b", DocumentID: "doc-1", Content: "Sercha indexes every chunk.", Position: 1},
+	}))
+
+	// doc-1 matches twice; its second chunk ranks first in both engines
+	searchEngine := &mockSearchEngine{hits: []driven.SearchHit{
+		{ChunkID: "chunk-doc-1-b", Score: 0.9},
+		{ChunkID: "chunk-doc-2", Score: 0.8},
+		{ChunkID: "chunk-doc-1", Score: 0.7},
+	}}
+	vectorIndex := &mockVectorIndex{hits: []driven.VectorHit{
+		{ChunkID: "chunk-doc-1-b", Similarity: 0.95},
+		{ChunkID: "chunk-doc-1", Similarity: 0.85},
+		{ChunkID: "chunk-doc-2", Similarity: 0.75},
+	}}
+	embedService := &mockEmbeddingService{embedding: make([]float32, 384)}
+	service := NewSearchService(docStore, searchEngine, vectorIndex, embedService, nil)
+
+	results, err := service.SearchByChunk(ctx, "sercha", domain.SearchOptions{Hybrid: true})
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "doc-1", results[0].Document.ID)
+	assert.Equal(t, "chunk-doc-1-b", results[0].Chunk.ID)
+	assert.Equal(t, 1, results[0].Chunk.Position)
+	assert.Equal(t, "doc-2", results[1].Document.ID)
+	assert.Greater(t, results[0].Score, results[1].Score)
+}
+
+func TestSearchService_SearchByChunk_EmptyQuery(t *testing.T) {
+	service := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+
+	results, err := service.SearchByChunk(context.Background(), "  ", domain.SearchOptions{})
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearchService_SearchByChunk_Pagination(t *testing.T) {
+	service := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: createTestHits()}, nil, nil, nil)
+
+	results, err := service.SearchByChunk(context.Background(), "sercha", domain.SearchOptions{Limit: 1, Offset: 1})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "doc-2", results[0].Document.ID)
+	assert.Equal(t, "chunk-doc-2", results[0].Chunk.ID)
+}
+
+func TestSearchService_SearchByChunk_SearchEngineError(t *testing.T) {
+	searchEngine := &mockSearchEngine{searchErr: errors.New("index closed")}
+	service := NewSearchService(setupTestDocStore(t), searchEngine, nil, nil, nil)
+
+	_, err := service.SearchByChunk(context.Background(), "sercha", domain.SearchOptions{})
+
+	require.Error(t, err)
+}
+
+func TestBestChunkPerDocument(t *testing.T) {
+	results := []domain.SearchResult{
+		{Document: domain.Document{ID: "a"}, Chunk: domain.Chunk{ID: "a-1"}, Score: 0.4},
+		{Document: domain.Document{ID: "b"}, Chunk: domain.Chunk{ID: "b-1"}, Score: 0.6},
+		{Document: domain.Document{ID: "a"}, Chunk: domain.Chunk{ID: "a-2"}, Score: 0.9},
+		{Document: domain.Document{ID: "b"}, Chunk: domain.Chunk{ID: "b-2"}, Score: 0.1},
+	}
+
+	deduped := bestChunkPerDocument(results)
+
+	require.Len(t, deduped, 2)
+	assert.Equal(t, "a-2", deduped[0].Chunk.ID)
+	assert.Equal(t, "b-1", deduped[1].Chunk.ID)
+}
+
+func resultDocIDs(results []domain.SearchResult) []string {
+	ids := make([]string, len(results))
+	for i := range results {
+		ids[i] = results[i].Document.ID
+	}
+	return ids
+}
+
 func TestSearchService_Search_BothSearchesFail(t *testing.T) {
 	docStore := setupTestDocStore(t)
 	searchEngine := &mockSearchEngine{searchErr: errors.New("keyword failed")}
@@ -796,3 +1291,95 @@ func TestSearchService_Search_NilDocStore(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "document store unavailable")
 }
+
+// exactVectorIndex is a brute-force vector index over unit vectors that
+// scores hits with its metric, as HNSW would.
+type exactVectorIndex struct {
+	mockVectorIndex
+	vectors map[string][]float32
+	metric  driven.VectorMetric
+}
+
+func (m *exactVectorIndex) Metric() driven.VectorMetric { return m.metric }
+
+func (m *exactVectorIndex) Search(_ context.Context, query []float32, k int) ([]driven.VectorHit, error) {
+	hits := make([]driven.VectorHit, 0, len(m.vectors))
+	for id, vec := range m.vectors {
+		var dot, sq float64
+		for i := range vec {
+			dot += float64(vec[i] * query[i])
+			sq += float64((vec[i] - query[i]) * (vec[i] - query[i]))
+		}
+		hit := driven.VectorHit{ChunkID: id}
+		if m.metric == driven.VectorMetricL2 {
+			hit.Distance = math.Sqrt(sq)
+		} else {
+			hit.Similarity = dot
+		}
+		hits = append(hits, hit)
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if m.metric == driven.VectorMetricL2 {
+			return hits[i].Distance < hits[j].Distance
+		}
+		return hits[i].Similarity > hits[j].Similarity
+	})
+	if len(hits) > k {
+		hits = hits[:k]
+	}
+	return hits, nil
+}
+
+// queryEmbeddingService embeds each query as a fixed vector.
+type queryEmbeddingService struct {
+	mockEmbeddingService
+	vectors map[string][]float32
+}
+
+func (m *queryEmbeddingService) Embed(_ context.Context, text string) ([]float32, error) {
+	return m.vectors[text], nil
+}
+
+func TestSearchService_Search_VectorMinScore(t *testing.T) {
+	ctx := context.Background()
+	embeddings := &queryEmbeddingService{vectors: map[string][]float32{
+		"search my files":    {1, 0, 0},
+		"lasagne recipe":     {0, 0, 1},
+		"configure settings": {0.6, 0.8, 0},
+	}}
+	chunkVectors := map[string][]float32{
+		"chunk-doc-1": {1, 0, 0},
+		"chunk-doc-2": {0, 1, 0},
+		"chunk-doc-3": {0.8, 0.6, 0},
+	}
+
+	for _, metric := range []driven.VectorMetric{driven.VectorMetricCosine, driven.VectorMetricL2} {
+		t.Run(string(metric), func(t *testing.T) {
+			index := &exactVectorIndex{vectors: chunkVectors, metric: metric}
+			service := NewSearchService(setupTestDocStore(t), nil, index, embeddings, nil)
+			service.SetSearchMode(domain.SearchModeVectorOnly)
+			service.SetVectorMinScore(domain.DefaultVectorMinScore)
+
+			results, err := service.Search(ctx, "lasagne recipe", domain.SearchOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, results, "an unrelated query matches nothing")
+
+			results, err = service.Search(ctx, "search my files", domain.SearchOptions{})
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			assert.Equal(t, "doc-1", results[0].Document.ID)
+			assert.InDelta(t, 1.0, results[0].Score, 1e-6)
+			assert.Equal(t, "doc-3", results[1].Document.ID)
+			assert.InDelta(t, 0.8, results[1].Score, 1e-6)
+
+			results, err = service.Search(ctx, "configure settings", domain.SearchOptions{MinScore: 0.9})
+			require.NoError(t, err)
+			require.Len(t, results, 1, "the query's threshold overrides the configured one")
+			assert.Equal(t, "doc-3", results[0].Document.ID)
+
+			results, err = service.Search(ctx, "lasagne recipe", domain.SearchOptions{MinScore: -1})
+			require.NoError(t, err)
+			assert.Len(t, results, 3, "a negative threshold keeps every match")
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -17,10 +18,21 @@ var _ driving.SettingsService = (*SettingsService)(nil)
 //nolint:gosec // G101: These are config key names, not actual credentials.
 const (
 	keySearchMode      = "search.mode"
+	keyHybridOverFetch = "search.hybrid_over_fetch"
+	keyVectorMinScore  = "search.min_score"
+	keyVectorSnippet   = "search.vector_snippet_length"
+	keyTitleWeight     = "search.title_weight"
+	keySearchLanguage  = "search.language"
+	keyShowChunks      = "search.show_chunks"
+	keyGroupBySource   = "search.group_by_source"
 	keyEmbedProvider   = "embedding.provider"
 	keyEmbedModel      = "embedding.model"
 	keyEmbedBaseURL    = "embedding.base_url"
 	keyEmbedAPIKey     = "embedding.api_key"
+	keyEmbedHeaders    = "embedding.headers"
+	keyEmbedWorkers    = "embedding.workers"
+	keyEmbedDims       = "embedding.dimensions"
+	keyEmbedAttempts   = "embedding.max_attempts"
 	keyLLMProvider     = "llm.provider"
 	keyLLMModel        = "llm.model"
 	keyLLMBaseURL      = "llm.base_url"
@@ -28,6 +40,34 @@ const (
 	keyVectorEnabled   = "vector_index.enabled"
 	keyVectorDims      = "vector_index.dimensions"
 	keyVectorPrecision = "vector_index.precision"
+	keyVectorWarmUp    = "vector_index.warm_up_on_start"
+	keyEnrichEnabled   = "enrichment.enabled"
+	keyRerankEnabled   = "rerank.enabled"
+	keyRerankProvider  = "rerank.provider"
+	keyRerankEndpoint  = "rerank.endpoint"
+	keyRerankModel     = "rerank.model"
+	keyRerankAPIKey    = "rerank.api_key"
+	keyRerankHeaders   = "rerank.headers"
+	keyRerankCands     = "rerank.candidates"
+	keyOAuthTimeout    = "auth.oauth_timeout_seconds"
+	keyValidateTimeout = "sync.validate_timeout_seconds"
+	keyFullTimeout     = "sync.full_timeout_seconds"
+	keyIncrTimeout     = "sync.incremental_timeout_seconds"
+	keyQuarantineAfter = "sync.quarantine_after_failures"
+	keySyncBatchSize   = "sync.batch_size"
+	keyKeepOriginals   = "sync.keep_originals"
+	keyOriginalMaxSize = "sync.original_max_size_kb"
+	keyMinContentLen   = "sync.min_content_length"
+	keyShortAsMetaOnly = "sync.short_as_metadata_only"
+	keyHTTPCacheOn     = "http_cache.enabled"
+	keyHTTPCacheSize   = "http_cache.max_size_mb"
+	keyLiveSearch      = "tui.live_search"
+	keyKeysUp          = "tui.keys.up"
+	keyKeysDown        = "tui.keys.down"
+	keyKeysSelect      = "tui.keys.select"
+	keyKeysBack        = "tui.keys.back"
+	keyKeysSearch      = "tui.keys.search"
+	keyKeysQuit        = "tui.keys.quit"
 )
 
 // SettingsService manages application settings.
@@ -50,13 +90,24 @@ func (s *SettingsService) Get() (*domain.AppSettings, error) {
 
 	settings := &domain.AppSettings{
 		Search: domain.SearchSettings{
-			Mode: s.getSearchMode(defaults.Search.Mode),
+			Mode:                s.getSearchMode(defaults.Search.Mode),
+			HybridOverFetch:     s.getInt(keyHybridOverFetch, defaults.Search.HybridOverFetch),
+			MinScore:            s.getFloat(keyVectorMinScore, defaults.Search.MinScore),
+			VectorSnippetLength: s.getInt(keyVectorSnippet, defaults.Search.VectorSnippetLength),
+			TitleWeight:         s.getFloat(keyTitleWeight, defaults.Search.TitleWeight),
+			Language:            s.getLanguage(defaults.Search.Language),
+			ShowChunks:          s.getBool(keyShowChunks, defaults.Search.ShowChunks),
+			GroupBySource:       s.getBool(keyGroupBySource, defaults.Search.GroupBySource),
 		},
 		Embedding: domain.EmbeddingSettings{
-			Provider: s.getProvider(keyEmbedProvider, defaults.Embedding.Provider),
-			Model:    s.getString(keyEmbedModel, defaults.Embedding.Model),
-			BaseURL:  s.configStore.GetString(keyEmbedBaseURL), // No default - empty is valid for cloud providers
-			APIKey:   s.configStore.GetString(keyEmbedAPIKey),
+			Provider:    s.getProvider(keyEmbedProvider, defaults.Embedding.Provider),
+			Model:       s.getString(keyEmbedModel, defaults.Embedding.Model),
+			BaseURL:     s.configStore.GetString(keyEmbedBaseURL), // No default - empty is valid for cloud providers
+			APIKey:      s.configStore.GetString(keyEmbedAPIKey),
+			Headers:     s.configStore.GetStringSlice(keyEmbedHeaders),
+			Workers:     s.getInt(keyEmbedWorkers, defaults.Embedding.Workers),
+			Dimensions:  s.getInt(keyEmbedDims, defaults.Embedding.Dimensions),
+			MaxAttempts: s.getInt(keyEmbedAttempts, defaults.Embedding.MaxAttempts),
 		},
 		LLM: domain.LLMSettings{
 			Provider: s.getProvider(keyLLMProvider, defaults.LLM.Provider),
@@ -65,9 +116,51 @@ func (s *SettingsService) Get() (*domain.AppSettings, error) {
 			APIKey:   s.configStore.GetString(keyLLMAPIKey),
 		},
 		VectorIndex: domain.VectorIndexSettings{
-			Enabled:    s.getBool(keyVectorEnabled, defaults.VectorIndex.Enabled),
-			Dimensions: s.getInt(keyVectorDims, defaults.VectorIndex.Dimensions),
-			Precision:  s.getVectorPrecision(defaults.VectorIndex.Precision),
+			Enabled:       s.getBool(keyVectorEnabled, defaults.VectorIndex.Enabled),
+			Dimensions:    s.getInt(keyVectorDims, defaults.VectorIndex.Dimensions),
+			Precision:     s.getVectorPrecision(defaults.VectorIndex.Precision),
+			WarmUpOnStart: s.getBool(keyVectorWarmUp, defaults.VectorIndex.WarmUpOnStart),
+		},
+		Enrichment: domain.EnrichmentSettings{
+			Enabled: s.getBool(keyEnrichEnabled, defaults.Enrichment.Enabled),
+		},
+		Rerank: domain.RerankSettings{
+			Enabled:    s.getBool(keyRerankEnabled, defaults.Rerank.Enabled),
+			Provider:   domain.RerankProvider(s.configStore.GetString(keyRerankProvider)),
+			Endpoint:   s.configStore.GetString(keyRerankEndpoint),
+			Model:      s.configStore.GetString(keyRerankModel),
+			APIKey:     s.configStore.GetString(keyRerankAPIKey),
+			Headers:    s.configStore.GetStringSlice(keyRerankHeaders),
+			Candidates: s.getInt(keyRerankCands, defaults.Rerank.Candidates),
+		},
+		Auth: domain.AuthSettings{
+			OAuthTimeoutSeconds: s.getInt(keyOAuthTimeout, defaults.Auth.OAuthTimeoutSeconds),
+		},
+		Sync: domain.SyncSettings{
+			ValidateTimeoutSeconds:        s.getInt(keyValidateTimeout, defaults.Sync.ValidateTimeoutSeconds),
+			FullSyncTimeoutSeconds:        s.getInt(keyFullTimeout, defaults.Sync.FullSyncTimeoutSeconds),
+			IncrementalSyncTimeoutSeconds: s.getInt(keyIncrTimeout, defaults.Sync.IncrementalSyncTimeoutSeconds),
+			QuarantineAfterFailures:       s.getInt(keyQuarantineAfter, defaults.Sync.QuarantineAfterFailures),
+			BatchSize:                     s.getInt(keySyncBatchSize, defaults.Sync.BatchSize),
+			KeepOriginals:                 s.getBool(keyKeepOriginals, defaults.Sync.KeepOriginals),
+			OriginalMaxSizeKB:             s.getInt(keyOriginalMaxSize, defaults.Sync.OriginalMaxSizeKB),
+			MinContentLength:              s.getInt(keyMinContentLen, defaults.Sync.MinContentLength),
+			ShortAsMetadataOnly:           s.getBool(keyShortAsMetaOnly, defaults.Sync.ShortAsMetadataOnly),
+		},
+		HTTPCache: domain.HTTPCacheSettings{
+			Enabled:   s.getBool(keyHTTPCacheOn, defaults.HTTPCache.Enabled),
+			MaxSizeMB: s.getInt(keyHTTPCacheSize, defaults.HTTPCache.MaxSizeMB),
+		},
+		TUI: domain.TUISettings{
+			LiveSearch: s.getBool(keyLiveSearch, defaults.TUI.LiveSearch),
+			Keys: domain.KeyBindings{
+				Up:     s.configStore.GetStringSlice(keyKeysUp),
+				Down:   s.configStore.GetStringSlice(keyKeysDown),
+				Select: s.configStore.GetStringSlice(keyKeysSelect),
+				Back:   s.configStore.GetStringSlice(keyKeysBack),
+				Search: s.configStore.GetStringSlice(keyKeysSearch),
+				Quit:   s.configStore.GetStringSlice(keyKeysQuit),
+			},
 		},
 	}
 
@@ -80,6 +173,33 @@ func (s *SettingsService) Save(settings *domain.AppSettings) error {
 	if err := s.configStore.Set(keySearchMode, settings.Search.Mode.String()); err != nil {
 		return fmt.Errorf("save search mode: %w", err)
 	}
+	if settings.Search.HybridOverFetch > 0 {
+		if err := s.configStore.Set(keyHybridOverFetch, settings.Search.HybridOverFetch); err != nil {
+			return fmt.Errorf("save hybrid over-fetch: %w", err)
+		}
+	}
+	if err := s.configStore.Set(keyVectorMinScore, settings.Search.MinScore); err != nil {
+		return fmt.Errorf("save vector min score: %w", err)
+	}
+	if settings.Search.VectorSnippetLength > 0 {
+		if err := s.configStore.Set(keyVectorSnippet, settings.Search.VectorSnippetLength); err != nil {
+			return fmt.Errorf("save vector snippet length: %w", err)
+		}
+	}
+	if err := s.configStore.Set(keyTitleWeight, settings.Search.TitleWeight); err != nil {
+		return fmt.Errorf("save title weight: %w", err)
+	}
+	if settings.Search.Language.IsValid() {
+		if err := s.configStore.Set(keySearchLanguage, settings.Search.Language.String()); err != nil {
+			return fmt.Errorf("save search language: %w", err)
+		}
+	}
+	if err := s.configStore.Set(keyShowChunks, settings.Search.ShowChunks); err != nil {
+		return fmt.Errorf("save show chunks: %w", err)
+	}
+	if err := s.configStore.Set(keyGroupBySource, settings.Search.GroupBySource); err != nil {
+		return fmt.Errorf("save group by source: %w", err)
+	}
 
 	// Save embedding settings
 	if err := s.configStore.Set(keyEmbedProvider, settings.Embedding.Provider.String()); err != nil {
@@ -96,6 +216,29 @@ func (s *SettingsService) Save(settings *domain.AppSettings) error {
 			return fmt.Errorf("save embedding api_key: %w", err)
 		}
 	}
+	// Only write headers once set, so clearing them still reaches the file
+	if _, ok := s.configStore.Get(keyEmbedHeaders); ok || len(settings.Embedding.Headers) > 0 {
+		headers := settings.Embedding.Headers
+		if headers == nil {
+			headers = []string{}
+		}
+		if err := s.configStore.Set(keyEmbedHeaders, headers); err != nil {
+			return fmt.Errorf("save embedding headers: %w", err)
+		}
+	}
+	if settings.Embedding.Workers > 0 {
+		if err := s.configStore.Set(keyEmbedWorkers, settings.Embedding.Workers); err != nil {
+			return fmt.Errorf("save embedding workers: %w", err)
+		}
+	}
+	if err := s.configStore.Set(keyEmbedDims, settings.Embedding.Dimensions); err != nil {
+		return fmt.Errorf("save embedding dimensions: %w", err)
+	}
+	if settings.Embedding.MaxAttempts > 0 {
+		if err := s.configStore.Set(keyEmbedAttempts, settings.Embedding.MaxAttempts); err != nil {
+			return fmt.Errorf("save embedding max_attempts: %w", err)
+		}
+	}
 
 	// Save LLM settings
 	if err := s.configStore.Set(keyLLMProvider, settings.LLM.Provider.String()); err != nil {
@@ -123,6 +266,123 @@ func (s *SettingsService) Save(settings *domain.AppSettings) error {
 	if err := s.configStore.Set(keyVectorPrecision, settings.VectorIndex.Precision.String()); err != nil {
 		return fmt.Errorf("save vector precision: %w", err)
 	}
+	if err := s.configStore.Set(keyVectorWarmUp, settings.VectorIndex.WarmUpOnStart); err != nil {
+		return fmt.Errorf("save vector warm-up: %w", err)
+	}
+
+	// Save enrichment settings
+	if err := s.configStore.Set(keyEnrichEnabled, settings.Enrichment.Enabled); err != nil {
+		return fmt.Errorf("save enrichment enabled: %w", err)
+	}
+
+	// Save rerank settings
+	if err := s.configStore.Set(keyRerankEnabled, settings.Rerank.Enabled); err != nil {
+		return fmt.Errorf("save rerank enabled: %w", err)
+	}
+	rerankValues := []struct {
+		key   string
+		value string
+		name  string
+	}{
+		{keyRerankProvider, settings.Rerank.Provider.String(), "rerank provider"},
+		{keyRerankEndpoint, settings.Rerank.Endpoint, "rerank endpoint"},
+		{keyRerankModel, settings.Rerank.Model, "rerank model"},
+		{keyRerankAPIKey, settings.Rerank.APIKey, "rerank api_key"},
+	}
+	for _, v := range rerankValues {
+		// Unset values are left out, so the file gains no empty rerank table
+		if _, ok := s.configStore.Get(v.key); ok || v.value != "" {
+			if err := s.configStore.Set(v.key, v.value); err != nil {
+				return fmt.Errorf("save %s: %w", v.name, err)
+			}
+		}
+	}
+	if _, ok := s.configStore.Get(keyRerankHeaders); ok || len(settings.Rerank.Headers) > 0 {
+		headers := settings.Rerank.Headers
+		if headers == nil {
+			headers = []string{}
+		}
+		if err := s.configStore.Set(keyRerankHeaders, headers); err != nil {
+			return fmt.Errorf("save rerank headers: %w", err)
+		}
+	}
+	if settings.Rerank.Candidates > 0 {
+		if err := s.configStore.Set(keyRerankCands, settings.Rerank.Candidates); err != nil {
+			return fmt.Errorf("save rerank candidates: %w", err)
+		}
+	}
+
+	// Save auth settings
+	if settings.Auth.OAuthTimeoutSeconds > 0 {
+		if err := s.configStore.Set(keyOAuthTimeout, settings.Auth.OAuthTimeoutSeconds); err != nil {
+			return fmt.Errorf("save oauth timeout: %w", err)
+		}
+	}
+
+	// Save sync settings
+	syncValues := []struct {
+		key   string
+		value int
+		name  string
+	}{
+		{keyValidateTimeout, settings.Sync.ValidateTimeoutSeconds, "validate timeout"},
+		{keyFullTimeout, settings.Sync.FullSyncTimeoutSeconds, "full sync timeout"},
+		{keyIncrTimeout, settings.Sync.IncrementalSyncTimeoutSeconds, "incremental sync timeout"},
+		{keyQuarantineAfter, settings.Sync.QuarantineAfterFailures, "quarantine threshold"},
+		{keySyncBatchSize, settings.Sync.BatchSize, "sync batch size"},
+		{keyOriginalMaxSize, settings.Sync.OriginalMaxSizeKB, "original size cap"},
+	}
+	for _, v := range syncValues {
+		if v.value > 0 {
+			if err := s.configStore.Set(v.key, v.value); err != nil {
+				return fmt.Errorf("save %s: %w", v.name, err)
+			}
+		}
+	}
+	if err := s.configStore.Set(keyKeepOriginals, settings.Sync.KeepOriginals); err != nil {
+		return fmt.Errorf("save keep originals: %w", err)
+	}
+	// Zero turns the minimum off, so it is saved once set
+	if _, ok := s.configStore.Get(keyMinContentLen); ok || settings.Sync.MinContentLength > 0 {
+		if err := s.configStore.Set(keyMinContentLen, settings.Sync.MinContentLength); err != nil {
+			return fmt.Errorf("save min content length: %w", err)
+		}
+	}
+	if err := s.configStore.Set(keyShortAsMetaOnly, settings.Sync.ShortAsMetadataOnly); err != nil {
+		return fmt.Errorf("save short as metadata only: %w", err)
+	}
+
+	// Save HTTP cache settings
+	if err := s.configStore.Set(keyHTTPCacheOn, settings.HTTPCache.Enabled); err != nil {
+		return fmt.Errorf("save http cache enabled: %w", err)
+	}
+	if settings.HTTPCache.MaxSizeMB > 0 {
+		if err := s.configStore.Set(keyHTTPCacheSize, settings.HTTPCache.MaxSizeMB); err != nil {
+			return fmt.Errorf("save http cache size: %w", err)
+		}
+	}
+
+	// Save TUI settings
+	if err := s.configStore.Set(keyLiveSearch, settings.TUI.LiveSearch); err != nil {
+		return fmt.Errorf("save live search: %w", err)
+	}
+	// Only rebound actions are written, so the rest follow the defaults
+	keys := map[string][]string{
+		keyKeysUp:     settings.TUI.Keys.Up,
+		keyKeysDown:   settings.TUI.Keys.Down,
+		keyKeysSelect: settings.TUI.Keys.Select,
+		keyKeysBack:   settings.TUI.Keys.Back,
+		keyKeysSearch: settings.TUI.Keys.Search,
+		keyKeysQuit:   settings.TUI.Keys.Quit,
+	}
+	for key, bound := range keys {
+		if len(bound) == 0 {
+			continue
+		}
+		if err := s.configStore.Set(key, bound); err != nil {
+			return fmt.Errorf("save key bindings: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -172,12 +432,18 @@ func (s *SettingsService) SetEmbeddingProvider(provider domain.AIProvider, model
 		return fmt.Errorf("API key required for %s", provider)
 	}
 
+	// Self-hosted servers have no default model to fall back on
+	if provider.RequiresBaseURL() && model == "" {
+		return fmt.Errorf("model name required for %s", provider)
+	}
+
 	settings, err := s.Get()
 	if err != nil {
 		return err
 	}
 
 	settings.Embedding.Provider = provider
+	previousModel := settings.Embedding.Model
 
 	// Set model - use provided or default
 	if model != "" {
@@ -195,7 +461,7 @@ func (s *SettingsService) SetEmbeddingProvider(provider domain.AIProvider, model
 		if settings.Embedding.BaseURL == "" {
 			settings.Embedding.BaseURL = "http://localhost:11434"
 		}
-	} else {
+	} else if !provider.RequiresBaseURL() {
 		// Cloud providers don't need a custom base URL
 		settings.Embedding.BaseURL = ""
 	}
@@ -203,15 +469,38 @@ func (s *SettingsService) SetEmbeddingProvider(provider domain.AIProvider, model
 	// Set API key
 	settings.Embedding.APIKey = apiKey
 
+	// A reduced size chosen for another model may not fit this one
+	if settings.Embedding.Model != previousModel {
+		settings.Embedding.Dimensions = 0
+	}
+
 	// Update vector dimensions based on model
-	dims := domain.EmbeddingDimensions()
-	if d, ok := dims[settings.Embedding.Model]; ok {
+	if d := settings.Embedding.VectorDimensions(); d > 0 {
 		settings.VectorIndex.Dimensions = d
 	}
 
 	return s.Save(settings)
 }
 
+// SetEmbeddingEndpoint sets the endpoint and extra request headers of the
+// embedding provider. Headers are written as "Name: value".
+func (s *SettingsService) SetEmbeddingEndpoint(baseURL string, headers []string) error {
+	embedding := domain.EmbeddingSettings{Headers: headers}
+	if _, err := embedding.RequestHeaders(); err != nil {
+		return err
+	}
+
+	settings, err := s.Get()
+	if err != nil {
+		return err
+	}
+
+	settings.Embedding.BaseURL = strings.TrimRight(baseURL, "/")
+	settings.Embedding.Headers = headers
+
+	return s.Save(settings)
+}
+
 // SetLLMProvider configures the LLM provider.
 func (s *SettingsService) SetLLMProvider(provider domain.AIProvider, model, apiKey string) error {
 	if !provider.IsValid() {
@@ -269,6 +558,21 @@ func (s *SettingsService) Validate() error {
 		return fmt.Errorf("invalid search mode: %s", settings.Search.Mode)
 	}
 
+	if settings.Sync.MinContentLength < 0 {
+		return fmt.Errorf("invalid min content length: %d", settings.Sync.MinContentLength)
+	}
+
+	if err := settings.TUI.Keys.Validate(); err != nil {
+		return fmt.Errorf("invalid TUI key bindings: %w", err)
+	}
+
+	if err := settings.Rerank.Validate(); err != nil {
+		return fmt.Errorf("invalid rerank settings: %w", err)
+	}
+	if settings.Rerank.Provider == domain.RerankProviderLLM && !settings.LLM.IsConfigured() {
+		return fmt.Errorf("the llm rerank provider requires LLM provider to be configured")
+	}
+
 	// Check embedding configuration if required
 	if settings.Search.Mode.RequiresEmbedding() {
 		if !settings.Embedding.IsConfigured() {
@@ -277,6 +581,9 @@ func (s *SettingsService) Validate() error {
 				settings.Search.Mode.Description(),
 			)
 		}
+		if err := settings.Embedding.ValidateDimensions(); err != nil {
+			return err
+		}
 	}
 
 	// Check LLM configuration if required
@@ -339,6 +646,11 @@ func (s *SettingsService) ValidateLLMConfig() error {
 	return s.aiValidator.ValidateLLM(&settings.LLM)
 }
 
+// ValidateConfig checks the configuration file against its schema.
+func (s *SettingsService) ValidateConfig() error {
+	return s.configStore.Validate()
+}
+
 // Helper methods for reading config with defaults.
 
 func (s *SettingsService) getString(key, defaultVal string) string {
@@ -357,6 +669,26 @@ func (s *SettingsService) getInt(key string, defaultVal int) int {
 	return val
 }
 
+// getFloat returns the value at key, or defaultVal when it is not set.
+// Zero is a valid setting, so only a missing key uses the default.
+func (s *SettingsService) getFloat(key string, defaultVal float64) float64 {
+	val, exists := s.configStore.Get(key)
+	if !exists {
+		return defaultVal
+	}
+	// TOML numbers without a fraction are parsed as int64
+	switch v := val.(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case int:
+		return float64(v)
+	default:
+		return defaultVal
+	}
+}
+
 func (s *SettingsService) getBool(key string, defaultVal bool) bool {
 	if _, exists := s.configStore.Get(key); !exists {
 		return defaultVal
@@ -376,6 +708,14 @@ func (s *SettingsService) getSearchMode(defaultVal domain.SearchMode) domain.Sea
 	return mode
 }
 
+func (s *SettingsService) getLanguage(defaultVal domain.Language) domain.Language {
+	lang := domain.Language(s.configStore.GetString(keySearchLanguage))
+	if !lang.IsValid() {
+		return defaultVal
+	}
+	return lang
+}
+
 func (s *SettingsService) getProvider(key string, defaultVal domain.AIProvider) domain.AIProvider {
 	val := s.configStore.GetString(key)
 	if val == "" {
@@ -439,7 +779,7 @@ func (s *SettingsService) loadProcessorConfig(prefix string) map[string]any {
 	cfg := make(map[string]any)
 
 	// Check common processor config keys
-	knownKeys := []string{"chunk_size", "overlap", "max_length", "model"}
+	knownKeys := []string{"chunk_size", "overlap", "chunk_tokens", "overlap_tokens", "max_length", "model"}
 	for _, key := range knownKeys {
 		fullKey := prefix + key
 		if val, exists := s.configStore.Get(fullKey); exists {
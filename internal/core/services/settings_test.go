@@ -29,6 +29,13 @@ func TestSettingsService_Get_ReturnsDefaults(t *testing.T) {
 	// Verify defaults
 	defaults := domain.DefaultAppSettings()
 	assert.Equal(t, defaults.Search.Mode, settings.Search.Mode)
+	assert.Equal(t, domain.DefaultHybridOverFetch, settings.Search.HybridOverFetch)
+	assert.Equal(t, domain.DefaultVectorMinScore, settings.Search.MinScore)
+	assert.Equal(t, domain.DefaultTitleWeight, settings.Search.TitleWeight)
+	assert.Equal(t, domain.LanguageEnglish, settings.Search.Language)
+	assert.False(t, settings.Search.ShowChunks)
+	assert.False(t, settings.Search.GroupBySource)
+	assert.False(t, settings.TUI.LiveSearch)
 	assert.Equal(t, defaults.Embedding.Provider, settings.Embedding.Provider)
 	assert.Equal(t, defaults.Embedding.Model, settings.Embedding.Model)
 	assert.Equal(t, defaults.LLM.Provider, settings.LLM.Provider)
@@ -73,12 +80,20 @@ func TestSettingsService_Save(t *testing.T) {
 
 	settings := &domain.AppSettings{
 		Search: domain.SearchSettings{
-			Mode: domain.SearchModeHybrid,
+			Mode:            domain.SearchModeHybrid,
+			HybridOverFetch: 5,
+			MinScore:        0.4,
+			TitleWeight:     3,
+			Language:        domain.LanguageCJK,
+			ShowChunks:      true,
+			GroupBySource:   true,
 		},
 		Embedding: domain.EmbeddingSettings{
-			Provider: domain.AIProviderOpenAI,
-			Model:    "text-embedding-3-small",
-			APIKey:   "sk-test-key",
+			Provider:    domain.AIProviderOpenAI,
+			Model:       "text-embedding-3-small",
+			APIKey:      "sk-test-key",
+			Dimensions:  512,
+			MaxAttempts: 8,
 		},
 		LLM: domain.LLMSettings{
 			Provider: domain.AIProviderAnthropic,
@@ -86,8 +101,34 @@ func TestSettingsService_Save(t *testing.T) {
 			APIKey:   "sk-ant-test",
 		},
 		VectorIndex: domain.VectorIndexSettings{
-			Enabled:    true,
-			Dimensions: 1536,
+			Enabled:       true,
+			Dimensions:    1536,
+			WarmUpOnStart: true,
+		},
+		Enrichment: domain.EnrichmentSettings{
+			Enabled: true,
+		},
+		Auth: domain.AuthSettings{
+			OAuthTimeoutSeconds: 120,
+		},
+		Sync: domain.SyncSettings{
+			ValidateTimeoutSeconds:        15,
+			FullSyncTimeoutSeconds:        3600,
+			IncrementalSyncTimeoutSeconds: 900,
+			QuarantineAfterFailures:       5,
+			BatchSize:                     20,
+			KeepOriginals:                 true,
+			OriginalMaxSizeKB:             256,
+			MinContentLength:              20,
+			ShortAsMetadataOnly:           true,
+		},
+		HTTPCache: domain.HTTPCacheSettings{
+			Enabled:   false,
+			MaxSizeMB: 25,
+		},
+		TUI: domain.TUISettings{
+			LiveSearch: true,
+			Keys:       domain.KeyBindings{Up: []string{"up", "c"}, Down: []string{"down", "t"}},
 		},
 	}
 
@@ -98,14 +139,40 @@ func TestSettingsService_Save(t *testing.T) {
 	retrieved, err := service.Get()
 	require.NoError(t, err)
 	assert.Equal(t, domain.SearchModeHybrid, retrieved.Search.Mode)
+	assert.Equal(t, 5, retrieved.Search.HybridOverFetch)
+	assert.Equal(t, 0.4, retrieved.Search.MinScore)
+	assert.Equal(t, 3.0, retrieved.Search.TitleWeight)
+	assert.Equal(t, domain.LanguageCJK, retrieved.Search.Language)
+	assert.True(t, retrieved.Search.ShowChunks)
+	assert.True(t, retrieved.Search.GroupBySource)
 	assert.Equal(t, domain.AIProviderOpenAI, retrieved.Embedding.Provider)
 	assert.Equal(t, "text-embedding-3-small", retrieved.Embedding.Model)
 	assert.Equal(t, "sk-test-key", retrieved.Embedding.APIKey)
+	assert.Equal(t, 512, retrieved.Embedding.Dimensions)
+	assert.Equal(t, 8, retrieved.Embedding.MaxAttempts)
 	assert.Equal(t, domain.AIProviderAnthropic, retrieved.LLM.Provider)
 	assert.Equal(t, "claude-3-5-sonnet-latest", retrieved.LLM.Model)
 	assert.Equal(t, "sk-ant-test", retrieved.LLM.APIKey)
 	assert.True(t, retrieved.VectorIndex.Enabled)
 	assert.Equal(t, 1536, retrieved.VectorIndex.Dimensions)
+	assert.True(t, retrieved.VectorIndex.WarmUpOnStart)
+	assert.True(t, retrieved.Enrichment.Enabled)
+	assert.Equal(t, 120, retrieved.Auth.OAuthTimeoutSeconds)
+	assert.Equal(t, 15, retrieved.Sync.ValidateTimeoutSeconds)
+	assert.Equal(t, 3600, retrieved.Sync.FullSyncTimeoutSeconds)
+	assert.Equal(t, 900, retrieved.Sync.IncrementalSyncTimeoutSeconds)
+	assert.Equal(t, 5, retrieved.Sync.QuarantineAfterFailures)
+	assert.Equal(t, 20, retrieved.Sync.BatchSize)
+	assert.True(t, retrieved.Sync.KeepOriginals)
+	assert.Equal(t, 256, retrieved.Sync.OriginalMaxSizeKB)
+	assert.Equal(t, 20, retrieved.Sync.MinContentLength)
+	assert.True(t, retrieved.Sync.ShortAsMetadataOnly)
+	assert.False(t, retrieved.HTTPCache.Enabled)
+	assert.Equal(t, 25, retrieved.HTTPCache.MaxSizeMB)
+	assert.True(t, retrieved.TUI.LiveSearch)
+	assert.Equal(t, []string{"up", "c"}, retrieved.TUI.Keys.Up)
+	assert.Equal(t, []string{"down", "t"}, retrieved.TUI.Keys.Down)
+	assert.Empty(t, retrieved.TUI.Keys.Select)
 }
 
 func TestSettingsService_SetSearchMode_Valid(t *testing.T) {
@@ -211,6 +278,29 @@ func TestSettingsService_SetEmbeddingProvider_UpdatesDimensions(t *testing.T) {
 	assert.Equal(t, 1536, settings.VectorIndex.Dimensions)
 }
 
+func TestSettingsService_SetEmbeddingProvider_ReducedDimensions(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("embedding.model", "text-embedding-3-large")
+	_ = store.Set("embedding.dimensions", 256)
+	service := NewSettingsService(store, nil)
+
+	// Keeping the model keeps the reduced size for the vector index
+	err := service.SetEmbeddingProvider(domain.AIProviderOpenAI, "text-embedding-3-large", "sk-test-key")
+	require.NoError(t, err)
+
+	settings, _ := service.Get()
+	assert.Equal(t, 256, settings.Embedding.Dimensions)
+	assert.Equal(t, 256, settings.VectorIndex.Dimensions)
+
+	// Switching model drops the reduced size
+	err = service.SetEmbeddingProvider(domain.AIProviderOllama, "all-minilm", "")
+	require.NoError(t, err)
+
+	settings, _ = service.Get()
+	assert.Equal(t, 0, settings.Embedding.Dimensions)
+	assert.Equal(t, 384, settings.VectorIndex.Dimensions)
+}
+
 func TestSettingsService_SetEmbeddingProvider_RequiresAPIKey(t *testing.T) {
 	store := memory.NewConfigStore()
 	service := NewSettingsService(store, nil)
@@ -242,6 +332,43 @@ func TestSettingsService_SetEmbeddingProvider_AnthropicNotSupported(t *testing.T
 	assert.Contains(t, err.Error(), "does not support embeddings")
 }
 
+func TestSettingsService_SetEmbeddingProvider_OpenAICompatible(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	// Self-hosted servers need an explicit model
+	err := service.SetEmbeddingProvider(domain.AIProviderOpenAICompatible, "", "")
+	require.Error(t, err)
+
+	require.NoError(t, service.SetEmbeddingEndpoint("http://localhost:8080/v1/", []string{"X-Api-Key: secret"}))
+	require.NoError(t, service.SetEmbeddingProvider(domain.AIProviderOpenAICompatible, "nomic-embed-text-v1.5.Q8_0", ""))
+
+	settings, _ := service.Get()
+	assert.Equal(t, domain.AIProviderOpenAICompatible, settings.Embedding.Provider)
+	assert.Equal(t, "nomic-embed-text-v1.5.Q8_0", settings.Embedding.Model)
+	assert.Equal(t, "http://localhost:8080/v1", settings.Embedding.BaseURL, "base URL kept for self-hosted servers")
+	assert.Equal(t, []string{"X-Api-Key: secret"}, settings.Embedding.Headers)
+	assert.True(t, settings.Embedding.IsConfigured())
+}
+
+func TestSettingsService_SetEmbeddingEndpoint(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	t.Run("rejects malformed headers", func(t *testing.T) {
+		err := service.SetEmbeddingEndpoint("http://localhost:8080/v1", []string{"no-colon"})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("clears headers", func(t *testing.T) {
+		require.NoError(t, service.SetEmbeddingEndpoint("http://localhost:8080/v1", []string{"X-Api-Key: secret"}))
+		require.NoError(t, service.SetEmbeddingEndpoint("http://localhost:8080/v1", nil))
+
+		settings, _ := service.Get()
+		assert.Empty(t, settings.Embedding.Headers)
+	})
+}
+
 func TestSettingsService_SetLLMProvider_Ollama(t *testing.T) {
 	store := memory.NewConfigStore()
 	service := NewSettingsService(store, nil)
@@ -357,6 +484,18 @@ func TestSettingsService_Validate_HybridModeWithEmbedding(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSettingsService_Validate_UnsupportedReducedDimensions(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	_ = service.SetSearchMode(domain.SearchModeHybrid)
+	_ = service.SetEmbeddingProvider(domain.AIProviderOllama, "all-minilm", "")
+	_ = store.Set("embedding.dimensions", 128)
+
+	err := service.Validate()
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
 func TestSettingsService_Validate_LLMAssistedModeWithoutLLM(t *testing.T) {
 	store := memory.NewConfigStore()
 	service := NewSettingsService(store, nil)
@@ -422,6 +561,81 @@ func TestSettingsService_Validate_InvalidSearchMode(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSettingsService_Validate_KeyBindingConflict(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("tui.keys.back", []string{"esc", "q"})
+
+	service := NewSettingsService(store, nil)
+
+	err := service.Validate()
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Contains(t, err.Error(), `key "q" is bound to both back and quit`)
+}
+
+func TestSettingsService_Validate_Rerank(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]any
+		wantErr string
+	}{
+		{"not configured", map[string]any{}, ""},
+		{"enabled without provider", map[string]any{"rerank.enabled": true}, "no rerank provider is set"},
+		{"unknown provider", map[string]any{"rerank.provider": "magic"}, `unknown rerank provider "magic"`},
+		{"cross encoder without endpoint", map[string]any{"rerank.provider": "cross_encoder"}, "needs an endpoint"},
+		{
+			"cross encoder",
+			map[string]any{"rerank.provider": "cross_encoder", "rerank.endpoint": "http://localhost:8080/v1/rerank"},
+			"",
+		},
+		{"llm without llm provider", map[string]any{"rerank.provider": "llm"}, "requires LLM provider"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewConfigStore()
+			for key, value := range tt.values {
+				_ = store.Set(key, value)
+			}
+			service := NewSettingsService(store, nil)
+
+			err := service.Validate()
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSettingsService_Save_Rerank(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings := domain.DefaultAppSettings()
+	require.NoError(t, service.Save(&settings))
+	_, ok := store.Get("rerank.provider")
+	assert.False(t, ok, "unset rerank values are not written")
+
+	settings.Rerank = domain.RerankSettings{
+		Enabled:    true,
+		Provider:   domain.RerankProviderCrossEncoder,
+		Endpoint:   "http://localhost:8080/v1/rerank",
+		Model:      "bge-reranker-v2-m3",
+		Headers:    []string{"X-Team: search"},
+		Candidates: 30,
+	}
+	require.NoError(t, service.Save(&settings))
+
+	got, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, settings.Rerank, got.Rerank)
+}
+
 func TestSettingsService_RequiresEmbedding(t *testing.T) {
 	tests := []struct {
 		mode     domain.SearchMode
@@ -429,6 +643,7 @@ func TestSettingsService_RequiresEmbedding(t *testing.T) {
 	}{
 		{domain.SearchModeTextOnly, false},
 		{domain.SearchModeHybrid, true},
+		{domain.SearchModeVectorOnly, true},
 		{domain.SearchModeLLMAssisted, false},
 		{domain.SearchModeFull, true},
 	}
@@ -1329,3 +1544,44 @@ func TestSettingsService_ValidateLLMConfig_Error(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+// invalidConfigStore reports a validation failure from the loaded file.
+type invalidConfigStore struct {
+	*memory.ConfigStore
+}
+
+func (s *invalidConfigStore) Validate() error {
+	return &domain.ConfigValidationError{
+		Path:   "config.toml",
+		Errors: []domain.ConfigFieldError{{Field: "search.mode", Value: "fast", Message: "must be one of: text_only"}},
+	}
+}
+
+func TestSettingsService_ValidateConfig(t *testing.T) {
+	service := NewSettingsService(memory.NewConfigStore(), nil)
+	assert.NoError(t, service.ValidateConfig())
+
+	service = NewSettingsService(&invalidConfigStore{ConfigStore: memory.NewConfigStore()}, nil)
+	err := service.ValidateConfig()
+
+	var validationErr *domain.ConfigValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "search.mode", validationErr.Errors[0].Field)
+}
+
+func TestSettingsService_Get_VectorMinScore(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	// Zero disables the threshold rather than falling back to the default
+	require.NoError(t, store.Set(keyVectorMinScore, 0.0))
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Zero(t, settings.Search.MinScore)
+
+	// Whole numbers are read back from TOML as integers
+	require.NoError(t, store.Set(keyVectorMinScore, int64(1)))
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, settings.Search.MinScore)
+}
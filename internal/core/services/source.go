@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
@@ -71,6 +73,8 @@ func (s *SourceService) List(ctx context.Context) ([]domain.Source, error) {
 }
 
 // Update modifies an existing source configuration.
+// The source keeps its ID, so sync state and indexed documents are preserved.
+// Returns domain.ErrNotFound if no source with the given ID exists.
 func (s *SourceService) Update(ctx context.Context, source domain.Source) error {
 	if s.sourceStore == nil {
 		return domain.ErrNotImplemented
@@ -79,11 +83,59 @@ func (s *SourceService) Update(ctx context.Context, source domain.Source) error
 		return domain.ErrInvalidInput
 	}
 	// Verify source exists
-	_, err := s.sourceStore.Get(ctx, source.ID)
+	if _, err := s.sourceStore.Get(ctx, source.ID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get source: %w", err)
+	}
+	// Save is an upsert, so the existing row is updated in place
+	if err := s.sourceStore.Save(ctx, source); err != nil {
+		return fmt.Errorf("save source: %w", err)
+	}
+	return nil
+}
+
+// Rename changes the display name of a source. The name is trimmed and must
+// not be empty. Names are not required to be unique, so a source sharing
+// the new name is returned rather than treated as an error; names are
+// compared case-insensitively.
+// Returns domain.ErrNotFound if no source with the given ID exists.
+func (s *SourceService) Rename(ctx context.Context, id, newName string) ([]domain.Source, error) {
+	if s.sourceStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return nil, fmt.Errorf("%w: source name cannot be empty", domain.ErrInvalidInput)
+	}
+	source, err := s.sourceStore.Get(ctx, id)
 	if err != nil {
-		return domain.ErrNotFound
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get source: %w", err)
 	}
-	return s.sourceStore.Save(ctx, source)
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+	var conflicts []domain.Source
+	for i := range sources {
+		if sources[i].ID != id && strings.EqualFold(sources[i].Name, newName) {
+			conflicts = append(conflicts, sources[i])
+		}
+	}
+
+	if source.Name == newName {
+		return conflicts, nil
+	}
+	source.Name = newName
+	if err := s.sourceStore.Save(ctx, *source); err != nil {
+		return nil, fmt.Errorf("save source: %w", err)
+	}
+	return conflicts, nil
 }
 
 // Remove deletes a source and its indexed data.
@@ -93,13 +145,7 @@ func (s *SourceService) Remove(ctx context.Context, id string) error {
 	}
 	// Cleanup: delete documents, sync state, then source
 	if s.docStore != nil {
-		docs, err := s.docStore.ListDocuments(ctx, id)
-		if err == nil {
-			for i := range docs {
-				//nolint:errcheck // Intentionally ignore errors to continue cleanup
-				_ = s.docStore.DeleteDocument(ctx, docs[i].ID)
-			}
-		}
+		s.deleteDocuments(ctx, id)
 	}
 	if s.syncStore != nil {
 		//nolint:errcheck // Intentionally ignore errors to continue cleanup
@@ -108,6 +154,194 @@ func (s *SourceService) Remove(ctx context.Context, id string) error {
 	return s.sourceStore.Delete(ctx, id)
 }
 
+// Archive stops scheduled and sync --all syncs of a source while keeping
+// its documents searchable. Archiving an archived source does nothing.
+// Returns domain.ErrNotFound if no source with the given ID exists.
+func (s *SourceService) Archive(ctx context.Context, id string) error {
+	return s.setArchived(ctx, id, true)
+}
+
+// Unarchive returns an archived source to scheduled syncs.
+// Returns domain.ErrNotFound if no source with the given ID exists.
+func (s *SourceService) Unarchive(ctx context.Context, id string) error {
+	return s.setArchived(ctx, id, false)
+}
+
+// setArchived saves a source's archived flag if it has changed.
+func (s *SourceService) setArchived(ctx context.Context, id string, archived bool) error {
+	if s.sourceStore == nil {
+		return domain.ErrNotImplemented
+	}
+	source, err := s.sourceStore.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get source: %w", err)
+	}
+	if source.Archived == archived {
+		return nil
+	}
+	source.Archived = archived
+	if err := s.sourceStore.Save(ctx, *source); err != nil {
+		return fmt.Errorf("save source: %w", err)
+	}
+	return nil
+}
+
+// SetCollection moves a source into the named collection, or out of any
+// collection when the name is empty. The name is trimmed; a source joining
+// an existing collection takes that collection's spelling of the name.
+// Returns domain.ErrNotFound if no source with the given ID exists.
+func (s *SourceService) SetCollection(ctx context.Context, id, collection string) error {
+	if s.sourceStore == nil {
+		return domain.ErrNotImplemented
+	}
+	source, err := s.sourceStore.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get source: %w", err)
+	}
+
+	collection = strings.TrimSpace(collection)
+	if collection != "" {
+		collections, err := s.Collections(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range collections {
+			if strings.EqualFold(collections[i].Name, collection) {
+				collection = collections[i].Name
+				break
+			}
+		}
+	}
+
+	if source.Collection == collection {
+		return nil
+	}
+	source.Collection = collection
+	if err := s.sourceStore.Save(ctx, *source); err != nil {
+		return fmt.Errorf("save source: %w", err)
+	}
+	return nil
+}
+
+// Collections returns the collections the sources are grouped in, ordered
+// by name.
+func (s *SourceService) Collections(ctx context.Context) ([]domain.Collection, error) {
+	if s.sourceStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+	return domain.GroupCollections(sources), nil
+}
+
+// RenameCollection renames a collection on every source in it, matching the
+// name ignoring case, and returns how many sources were moved. Renaming to
+// the name of another collection merges the two.
+// Returns domain.ErrNotFound if no source is in the collection.
+func (s *SourceService) RenameCollection(ctx context.Context, name, newName string) (int, error) {
+	if s.sourceStore == nil {
+		return 0, domain.ErrNotImplemented
+	}
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return 0, fmt.Errorf("%w: collection name cannot be empty", domain.ErrInvalidInput)
+	}
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list sources: %w", err)
+	}
+
+	// Join the spelling of a collection being merged into
+	for i := range sources {
+		if sources[i].InCollection(newName) && !sources[i].InCollection(name) {
+			newName = strings.TrimSpace(sources[i].Collection)
+			break
+		}
+	}
+
+	moved := 0
+	for i := range sources {
+		if !sources[i].InCollection(name) {
+			continue
+		}
+		moved++
+		if sources[i].Collection == newName {
+			continue
+		}
+		sources[i].Collection = newName
+		if err := s.sourceStore.Save(ctx, sources[i]); err != nil {
+			return 0, fmt.Errorf("save source: %w", err)
+		}
+	}
+	if moved == 0 {
+		return 0, fmt.Errorf("%w: collection %q", domain.ErrNotFound, strings.TrimSpace(name))
+	}
+	return moved, nil
+}
+
+// deleteDocuments deletes every document of a source a page at a time.
+// Errors are ignored so cleanup continues; documents that fail to delete
+// are skipped over rather than retried.
+func (s *SourceService) deleteDocuments(ctx context.Context, sourceID string) {
+	skipped := 0
+	for {
+		docs, _, err := s.docStore.ListDocumentsPaginated(ctx, sourceID, skipped, documentPageSize)
+		if err != nil || len(docs) == 0 {
+			return
+		}
+		for i := range docs {
+			if err := s.docStore.DeleteDocument(ctx, docs[i].ID); err != nil {
+				skipped++
+			}
+		}
+	}
+}
+
+// Statuses returns the sync status and document count of every source.
+// Sources that have never synced successfully have a zero LastSync.
+func (s *SourceService) Statuses(ctx context.Context) ([]domain.SourceStatus, error) {
+	if s.sourceStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	statuses := make([]domain.SourceStatus, 0, len(sources))
+	for i := range sources {
+		status := domain.SourceStatus{SourceID: sources[i].ID}
+		if s.syncStore != nil {
+			state, err := s.syncStore.Get(ctx, sources[i].ID)
+			if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return nil, fmt.Errorf("get sync state: %w", err)
+			}
+			if state != nil {
+				status.LastSync = state.LastSync
+				status.LastError = state.LastError
+				status.RecentErrors = state.RecentErrors
+			}
+		}
+		if s.docStore != nil {
+			count, err := s.docStore.CountBySource(ctx, sources[i].ID)
+			if err != nil {
+				return nil, fmt.Errorf("count documents: %w", err)
+			}
+			status.DocumentCount = count
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
 // ValidateConfig validates source configuration for a connector type.
 func (s *SourceService) ValidateConfig(_ context.Context, connectorType string, config map[string]string) error {
 	if s.connectorRegistry == nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -250,6 +251,28 @@ func TestSourceService_Remove_WithDocuments(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
 
+func TestSourceService_Remove_DeletesEveryPage(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	service := NewSourceService(sourceStore, nil, docStore)
+	ctx := context.Background()
+
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "test-source", Name: "Test Source"}))
+	for i := range documentPageSize*2 + 1 {
+		doc := &domain.Document{ID: fmt.Sprintf("doc-%d", i), SourceID: "test-source"}
+		require.NoError(t, docStore.SaveDocument(ctx, doc))
+	}
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: "other", SourceID: "other-source"}))
+
+	require.NoError(t, service.Remove(ctx, "test-source"))
+
+	_, total, err := docStore.ListDocumentsPaginated(ctx, "test-source", 0, 1)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	_, err = docStore.GetDocument(ctx, "other")
+	assert.NoError(t, err)
+}
+
 func TestSourceService_Remove_WithSyncState(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
@@ -280,6 +303,227 @@ func TestSourceService_Remove_WithSyncState(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
 
+func TestSourceService_Update_PreservesSyncState(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	service := NewSourceService(sourceStore, syncStore, docStore)
+	ctx := context.Background()
+
+	source := domain.Source{
+		ID:     "test-source",
+		Name:   "Test Source",
+		Type:   "filesystem",
+		Config: map[string]string{"path": "/old"},
+	}
+	require.NoError(t, service.Add(ctx, source))
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{SourceID: "test-source", Cursor: "cursor-1"}))
+
+	source.Config = map[string]string{"path": "/new", "content_types": "md"}
+	require.NoError(t, service.Update(ctx, source))
+
+	retrieved, err := service.Get(ctx, "test-source")
+	require.NoError(t, err)
+	assert.Equal(t, "/new", retrieved.Config["path"])
+	assert.Equal(t, "md", retrieved.Config["content_types"])
+
+	state, err := syncStore.Get(ctx, "test-source")
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-1", state.Cursor)
+}
+
+func TestSourceService_Update_Errors(t *testing.T) {
+	service := NewSourceService(memory.NewSourceStore(), memory.NewSyncStateStore(), memory.NewDocumentStore())
+	ctx := context.Background()
+
+	err := service.Update(ctx, domain.Source{})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	err = service.Update(ctx, domain.Source{ID: "missing"})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSourceService_Rename(t *testing.T) {
+	service := NewSourceService(memory.NewSourceStore(), memory.NewSyncStateStore(), memory.NewDocumentStore())
+	ctx := context.Background()
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "src-1", Name: "/home/user/notes", Type: "filesystem"}))
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "src-2", Name: "Work", Type: "github"}))
+
+	conflicts, err := service.Rename(ctx, "src-1", "  Notes  ")
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+	retrieved, err := service.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Notes", retrieved.Name)
+	assert.Equal(t, "filesystem", retrieved.Type)
+
+	// A name already in use is still applied, and the other source returned
+	conflicts, err = service.Rename(ctx, "src-1", "work")
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "src-2", conflicts[0].ID)
+	retrieved, err = service.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "work", retrieved.Name)
+}
+
+func TestSourceService_Rename_Errors(t *testing.T) {
+	service := NewSourceService(memory.NewSourceStore(), memory.NewSyncStateStore(), memory.NewDocumentStore())
+	ctx := context.Background()
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "src-1", Name: "Notes"}))
+
+	_, err := service.Rename(ctx, "src-1", "   ")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = service.Rename(ctx, "missing", "Name")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = NewSourceService(nil, nil, nil).Rename(ctx, "src-1", "Name")
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
+// failingSourceStore wraps a memory source store and fails selected operations.
+type failingSourceStore struct {
+	*memory.SourceStore
+	failGet  bool
+	failSave bool
+}
+
+func (f *failingSourceStore) Get(ctx context.Context, id string) (*domain.Source, error) {
+	if f.failGet {
+		return nil, assert.AnError
+	}
+	return f.SourceStore.Get(ctx, id)
+}
+
+func (f *failingSourceStore) Save(ctx context.Context, source domain.Source) error {
+	if f.failSave {
+		return assert.AnError
+	}
+	return f.SourceStore.Save(ctx, source)
+}
+
+func TestSourceService_Update_StoreErrors(t *testing.T) {
+	ctx := context.Background()
+	source := domain.Source{ID: "test-source", Name: "Test Source", Type: "filesystem"}
+
+	t.Run("get error is not reported as not found", func(t *testing.T) {
+		store := &failingSourceStore{SourceStore: memory.NewSourceStore(), failGet: true}
+		service := NewSourceService(store, nil, nil)
+
+		err := service.Update(ctx, source)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.NotErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("save error", func(t *testing.T) {
+		store := &failingSourceStore{SourceStore: memory.NewSourceStore()}
+		require.NoError(t, store.SourceStore.Save(ctx, source))
+		store.failSave = true
+		service := NewSourceService(store, nil, nil)
+
+		err := service.Update(ctx, source)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestSourceService_Archive(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	service := NewSourceService(sourceStore, memory.NewSyncStateStore(), docStore)
+	ctx := context.Background()
+
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "test-source", Name: "Test Source", Type: "filesystem"}))
+	doc := domain.Document{ID: "doc-1", SourceID: "test-source", URI: "notes.md"}
+	require.NoError(t, docStore.SaveDocument(ctx, &doc))
+
+	require.NoError(t, service.Archive(ctx, "test-source"))
+	source, err := service.Get(ctx, "test-source")
+	require.NoError(t, err)
+	assert.True(t, source.Archived)
+	require.NoError(t, service.Archive(ctx, "test-source"), "archiving twice is a no-op")
+
+	// Documents are kept
+	count, err := docStore.CountBySource(ctx, "test-source")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.NoError(t, service.Unarchive(ctx, "test-source"))
+	source, err = service.Get(ctx, "test-source")
+	require.NoError(t, err)
+	assert.False(t, source.Archived)
+}
+
+func TestSourceService_Archive_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	service := NewSourceService(memory.NewSourceStore(), nil, nil)
+	assert.ErrorIs(t, service.Archive(ctx, "missing"), domain.ErrNotFound)
+	assert.ErrorIs(t, service.Unarchive(ctx, "missing"), domain.ErrNotFound)
+
+	store := &failingSourceStore{SourceStore: memory.NewSourceStore()}
+	require.NoError(t, store.SourceStore.Save(ctx, domain.Source{ID: "test-source"}))
+	store.failSave = true
+	service = NewSourceService(store, nil, nil)
+	assert.ErrorIs(t, service.Archive(ctx, "test-source"), assert.AnError)
+
+	service = NewSourceService(nil, nil, nil)
+	assert.ErrorIs(t, service.Archive(ctx, "test-source"), domain.ErrNotImplemented)
+}
+
+func TestSourceService_Collections(t *testing.T) {
+	service := NewSourceService(memory.NewSourceStore(), nil, nil)
+	ctx := context.Background()
+	for _, id := range []string{"notes", "repo", "photos"} {
+		require.NoError(t, service.Add(ctx, domain.Source{ID: id, Name: id, Type: "filesystem"}))
+	}
+
+	require.NoError(t, service.SetCollection(ctx, "notes", " Work "))
+	require.NoError(t, service.SetCollection(ctx, "repo", "work"), "joins with the existing spelling")
+	require.NoError(t, service.SetCollection(ctx, "photos", "Personal"))
+
+	collections, err := service.Collections(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Collection{
+		{Name: "Personal", SourceIDs: []string{"photos"}},
+		{Name: "Work", SourceIDs: []string{"notes", "repo"}},
+	}, collections)
+
+	// Leaving the collection
+	require.NoError(t, service.SetCollection(ctx, "photos", ""))
+	source, err := service.Get(ctx, "photos")
+	require.NoError(t, err)
+	assert.Empty(t, source.Collection)
+
+	assert.ErrorIs(t, service.SetCollection(ctx, "missing", "Work"), domain.ErrNotFound)
+}
+
+func TestSourceService_RenameCollection(t *testing.T) {
+	service := NewSourceService(memory.NewSourceStore(), nil, nil)
+	ctx := context.Background()
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "notes", Collection: "Work"}))
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "repo", Collection: "Work"}))
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "wiki", Collection: "Job"}))
+
+	moved, err := service.RenameCollection(ctx, "work", "Office")
+	require.NoError(t, err)
+	assert.Equal(t, 2, moved)
+
+	// Renaming onto another collection merges them
+	moved, err = service.RenameCollection(ctx, "Job", "office")
+	require.NoError(t, err)
+	assert.Equal(t, 1, moved)
+
+	collections, err := service.Collections(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Collection{{Name: "Office", SourceIDs: []string{"notes", "repo", "wiki"}}}, collections)
+
+	_, err = service.RenameCollection(ctx, "Missing", "Other")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = service.RenameCollection(ctx, "Office", " ")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
 func TestSourceService_Remove_NilStore(t *testing.T) {
 	service := NewSourceService(nil, nil, nil)
 	ctx := context.Background()
@@ -325,6 +569,44 @@ func TestSourceService_Remove_NilSyncStore(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSourceService_Statuses(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	service := NewSourceService(sourceStore, syncStore, docStore)
+	ctx := context.Background()
+
+	lastSync := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "synced", Type: "filesystem"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "failed", Type: "github"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "never", Type: "gmail"}))
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{SourceID: "synced", LastSync: lastSync}))
+	recent := []domain.SyncErrorLog{{Timestamp: lastSync, Phase: domain.SyncPhaseValidation, Message: "token expired"}}
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{
+		SourceID: "failed", LastError: "token expired", RecentErrors: recent,
+	}))
+	for _, id := range []string{"doc-1", "doc-2"} {
+		require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: id, SourceID: "synced"}))
+	}
+
+	statuses, err := service.Statuses(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SourceStatus{
+		{SourceID: "synced", LastSync: lastSync, DocumentCount: 2},
+		{SourceID: "failed", LastError: "token expired", RecentErrors: recent},
+		{SourceID: "never"},
+	}, statuses)
+}
+
+func TestSourceService_Statuses_NilStore(t *testing.T) {
+	service := NewSourceService(nil, nil, nil)
+
+	_, err := service.Statuses(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
 func TestSourceService_ValidateConfig_NotImplemented(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
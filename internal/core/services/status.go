@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure StatusService implements the interface.
+var _ driving.StatusService = (*StatusService)(nil)
+
+// StatusService aggregates read-only counts over the stores and indexes.
+type StatusService struct {
+	sourceStore       driven.SourceStore
+	syncStore         driven.SyncStateStore
+	docStore          driven.DocumentStore
+	credentialsStore  driven.CredentialsStore
+	connectorRegistry driving.ConnectorRegistry
+	searchEngine      driven.SearchEngine // Optional, nil in safe mode
+	vectorIndex       driven.VectorIndex  // Optional, nil when embeddings are disabled
+}
+
+// NewStatusService creates a new status service.
+func NewStatusService(
+	sourceStore driven.SourceStore,
+	syncStore driven.SyncStateStore,
+	docStore driven.DocumentStore,
+	credentialsStore driven.CredentialsStore,
+	connectorRegistry driving.ConnectorRegistry,
+	searchEngine driven.SearchEngine,
+	vectorIndex driven.VectorIndex,
+) *StatusService {
+	return &StatusService{
+		sourceStore:       sourceStore,
+		syncStore:         syncStore,
+		docStore:          docStore,
+		credentialsStore:  credentialsStore,
+		connectorRegistry: connectorRegistry,
+		searchEngine:      searchEngine,
+		vectorIndex:       vectorIndex,
+	}
+}
+
+// Status counts the sources, documents and indexed chunks, and checks every
+// source's credentials.
+func (s *StatusService) Status(ctx context.Context) (*domain.SystemStatus, error) {
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	status := &domain.SystemStatus{Sources: len(sources), KeywordChunks: -1, VectorChunks: -1}
+	for i := range sources {
+		source := &sources[i]
+		if source.Archived {
+			status.ArchivedSources++
+		}
+
+		count, err := s.docStore.CountBySource(ctx, source.ID)
+		if err != nil {
+			return nil, fmt.Errorf("count documents: %w", err)
+		}
+		status.Documents += count
+
+		var lastError string
+		if s.syncStore != nil {
+			state, err := s.syncStore.Get(ctx, source.ID)
+			if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return nil, fmt.Errorf("get sync state: %w", err)
+			}
+			if state != nil {
+				lastError = state.LastError
+			}
+		}
+		if lastError != "" {
+			status.FailingSources++
+		}
+
+		issue, err := s.checkAuth(ctx, source, lastError)
+		if err != nil {
+			return nil, err
+		}
+		if issue != domain.AuthIssueNone {
+			status.AuthIssues = append(status.AuthIssues, domain.SourceAuthIssue{
+				SourceID:   source.ID,
+				SourceName: source.Name,
+				Issue:      issue,
+			})
+		}
+	}
+
+	if s.searchEngine != nil {
+		if status.KeywordChunks, err = countChunks(ctx, s.searchEngine); err != nil {
+			return nil, fmt.Errorf("count keyword index: %w", err)
+		}
+	}
+	if s.vectorIndex != nil {
+		if status.VectorChunks, err = countChunks(ctx, s.vectorIndex); err != nil {
+			return nil, fmt.Errorf("count vector index: %w", err)
+		}
+	}
+	return status, nil
+}
+
+// checkAuth checks the credentials of a source. Sources of unknown
+// connector types are not checked.
+func (s *StatusService) checkAuth(ctx context.Context, source *domain.Source, lastError string) (domain.AuthIssue, error) {
+	if s.connectorRegistry == nil {
+		return domain.AuthIssueNone, nil
+	}
+	connector, err := s.connectorRegistry.Get(source.Type)
+	if err != nil {
+		return domain.AuthIssueNone, nil //nolint:nilerr // unknown connectors have no auth to check
+	}
+
+	var creds *domain.Credentials
+	if s.credentialsStore != nil {
+		if creds, err = s.credentialsStore.GetBySourceID(ctx, source.ID); err != nil {
+			return domain.AuthIssueNone, fmt.Errorf("get credentials: %w", err)
+		}
+	}
+	return domain.CheckCredentials(connector.AuthCapability.RequiresAuth(), creds, lastError), nil
+}
+
+// countChunks returns the number of chunks in an index, or -1 if the index
+// cannot list them.
+func countChunks(ctx context.Context, index any) (int, error) {
+	ids, err := listChunks(ctx, index)
+	if err != nil {
+		return 0, err
+	}
+	if ids == nil {
+		return -1, nil
+	}
+	return len(ids), nil
+}
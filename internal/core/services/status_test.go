@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// authConnectorRegistry knows a filesystem connector without auth and a
+// github connector that needs a token.
+type authConnectorRegistry struct {
+	driving.ConnectorRegistry
+}
+
+func (r authConnectorRegistry) Get(id string) (*domain.ConnectorType, error) {
+	switch id {
+	case "filesystem":
+		return &domain.ConnectorType{ID: id, AuthCapability: domain.AuthCapNone}, nil
+	case "github":
+		return &domain.ConnectorType{ID: id, AuthCapability: domain.AuthCapPAT}, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func TestStatusService_Status(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	credentialsStore := memory.NewCredentialsStore()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "notes", Name: "Notes", Type: "filesystem"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "repo", Name: "Repo", Type: "github"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "old", Name: "Old", Type: "github", Archived: true}))
+	require.NoError(t, credentialsStore.Save(ctx, domain.Credentials{
+		ID: "cred-1", SourceID: "repo", PAT: &domain.PATCredentials{Token: "ghp_token"},
+	}))
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{
+		SourceID: "repo", LastError: "validation failed: " + domain.ErrAuthInvalid.Error(),
+	}))
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: "d1", SourceID: "notes", URI: "a.md"}))
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: "d2", SourceID: "repo", URI: "b.md"}))
+
+	keyword := &listingSearchEngine{chunkSet: newChunkSet("c1", "c2", "c3")}
+	service := NewStatusService(sourceStore, syncStore, docStore, credentialsStore,
+		authConnectorRegistry{}, keyword, nil)
+
+	status, err := service.Status(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, status.Sources)
+	assert.Equal(t, 1, status.ArchivedSources)
+	assert.Equal(t, 1, status.FailingSources)
+	assert.Equal(t, 2, status.Documents)
+	assert.Equal(t, 3, status.KeywordChunks)
+	assert.Equal(t, -1, status.VectorChunks)
+	assert.ElementsMatch(t, []domain.SourceAuthIssue{
+		{SourceID: "repo", SourceName: "Repo", Issue: domain.AuthIssueInvalid},
+		{SourceID: "old", SourceName: "Old", Issue: domain.AuthIssueMissing},
+	}, status.AuthIssues)
+}
@@ -2,8 +2,14 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,8 +19,15 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
-// Ensure SyncOrchestrator implements the interface.
-var _ driving.SyncOrchestrator = (*SyncOrchestrator)(nil)
+// Ensure SyncOrchestrator implements the interfaces.
+var (
+	_ driving.SyncOrchestrator       = (*SyncOrchestrator)(nil)
+	_ driving.ProgressReportingSync  = (*SyncOrchestrator)(nil)
+	_ driving.PolicyConfigurableSync = (*SyncOrchestrator)(nil)
+	_ driving.PruningSync            = (*SyncOrchestrator)(nil)
+	_ driving.UndoableSync           = (*SyncOrchestrator)(nil)
+	_ driving.ScopedSync             = (*SyncOrchestrator)(nil)
+)
 
 // SyncOrchestrator coordinates document synchronisation.
 type SyncOrchestrator struct {
@@ -28,10 +41,24 @@ type SyncOrchestrator struct {
 	searchIndex      driven.SearchEngine
 	vectorIndex      driven.VectorIndex
 	embeddingService driven.EmbeddingService
+	embeddingQueue   driven.EmbeddingJobStore
+	enrichment       *EnrichmentService
+	progress         driving.IndexProgressReporter
+	log              *slog.Logger
+	syncSettings     domain.SyncSettings
+	syncPolicy       domain.SyncPolicy
 
 	// Status tracking
 	mu          sync.RWMutex
 	activeSyncs map[string]*driving.SyncStatus
+	syncErrors  map[string][]domain.SyncErrorLog
+	skipped     map[string]map[string]int // Per source, documents skipped by MIME type
+	tooShort    map[string]int            // Per source, documents below the minimum length
+	running     sync.WaitGroup
+
+	// Change logs of running syncs, stored when they finish so they can be undone
+	changeLogStore driven.SyncChangeLogStore
+	changeLogs     map[string]*domain.SyncChangeLog
 }
 
 // NewSyncOrchestrator creates a new sync orchestrator.
@@ -60,14 +87,89 @@ func NewSyncOrchestrator(
 		searchIndex:      searchIndex,
 		vectorIndex:      vectorIndex,
 		embeddingService: embeddingService,
+		progress:         nopProgressReporter{},
+		log:              logger.Slog(),
+		syncSettings:     domain.DefaultAppSettings().Sync,
+		syncPolicy:       domain.SyncPolicyContinue,
 		activeSyncs:      make(map[string]*driving.SyncStatus),
+		syncErrors:       make(map[string][]domain.SyncErrorLog),
+		skipped:          make(map[string]map[string]int),
+		tooShort:         make(map[string]int),
+		changeLogs:       make(map[string]*domain.SyncChangeLog),
+	}
+}
+
+// SetLogger sets the structured logger used for sync events.
+// Defaults to the shared application logger.
+func (o *SyncOrchestrator) SetLogger(log *slog.Logger) {
+	if log != nil {
+		o.log = log
 	}
 }
 
+// SetSyncSettings sets the per-operation deadlines and quarantine threshold.
+// Non-positive values fall back to the defaults.
+func (o *SyncOrchestrator) SetSyncSettings(settings domain.SyncSettings) {
+	o.syncSettings = settings
+}
+
+// SetSyncPolicy sets how syncs handle documents that fail to index.
+// Under the strict policy a sync stops at the first failure and keeps its cursor.
+func (o *SyncOrchestrator) SetSyncPolicy(policy domain.SyncPolicy) {
+	o.syncPolicy = policy
+}
+
+// SetEnrichmentService enables background LLM keyword enrichment of synced documents.
+func (o *SyncOrchestrator) SetEnrichmentService(enrichment *EnrichmentService) {
+	o.enrichment = enrichment
+}
+
+// SetEmbeddingQueue queues chunks for the background embedding worker
+// instead of embedding them during sync.
+func (o *SyncOrchestrator) SetEmbeddingQueue(queue driven.EmbeddingJobStore) {
+	o.embeddingQueue = queue
+}
+
+// SetProgressReporter sets the reporter that receives an event for each phase
+// of each indexed document. A nil reporter discards events.
+func (o *SyncOrchestrator) SetProgressReporter(reporter driving.IndexProgressReporter) {
+	if reporter == nil {
+		reporter = nopProgressReporter{}
+	}
+	o.progress = reporter
+}
+
 // Sync triggers synchronisation for a source.
+func (o *SyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
+	return o.sync(ctx, sourceID, time.Time{}, nil)
+}
+
+// SyncSince synchronises a source, asking the connector for items changed
+// after since instead of after the stored cursor. Sources without a stored
+// cursor run a full sync as usual. The stored cursor is kept, so the next
+// sync still picks up everything changed since the previous one.
+func (o *SyncOrchestrator) SyncSince(ctx context.Context, sourceID string, since time.Time) error {
+	return o.sync(ctx, sourceID, since, nil)
+}
+
+// SyncScope synchronises the named sub-resources of a source, such as
+// "owner/repo" for GitHub, leaving the cursors of the others as they are.
+// Sources without a stored cursor run a full sync as usual.
+func (o *SyncOrchestrator) SyncScope(ctx context.Context, sourceID string, scope []string) error {
+	if len(scope) == 0 {
+		return fmt.Errorf("%w: empty sync scope", domain.ErrInvalidInput)
+	}
+	return o.sync(ctx, sourceID, time.Time{}, scope)
+}
+
+// sync runs a sync for a source, overriding the cursor when since is set
+// and limiting an incremental sync to scope when it is set.
 //
 //nolint:gocyclo // Orchestration function with necessary sequential steps
-func (o *SyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
+func (o *SyncOrchestrator) sync(ctx context.Context, sourceID string, since time.Time, scope []string) error {
+	o.running.Add(1)
+	defer o.running.Done()
+
 	// 1. Get source configuration
 	source, err := o.sourceStore.Get(ctx, sourceID)
 	if err != nil {
@@ -78,17 +180,34 @@ func (o *SyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
 	if o.factory == nil {
 		return fmt.Errorf("create connector: connector factory not configured")
 	}
+
+	// Documents saved without keyword index entries would look up to date
+	// to later syncs and never be indexed
+	if o.searchIndex == nil {
+		return fmt.Errorf("sync source %s: %w", sourceID, domain.ErrSearchUnavailable)
+	}
 	connector, err := o.factory.Create(ctx, *source)
 	if err != nil {
 		return fmt.Errorf("create connector: %w", err)
 	}
 	defer connector.Close()
 
+	// Let the connector skip fetching content no normaliser can read
+	if aware, ok := connector.(driven.ContentTypeAware); ok {
+		aware.SetContentTypeFilter(o.registry.Supports)
+	}
+
 	// 3. Validate connector (check auth, configuration, connectivity)
 	caps := connector.Capabilities()
 	if caps.SupportsValidation {
-		if err := connector.Validate(ctx); err != nil {
-			return fmt.Errorf("%w: %w", domain.ErrConnectorValidation, err)
+		timeout := o.syncSettings.ValidateTimeout()
+		validateCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := connector.Validate(validateCtx)
+		cancel()
+		if err != nil {
+			err = fmt.Errorf("%w: %w", domain.ErrConnectorValidation, deadlineError(ctx, err, "validation", timeout))
+			o.recordSyncError(ctx, sourceID, domain.SyncPhaseValidation, err)
+			return err
 		}
 	}
 
@@ -105,49 +224,204 @@ func (o *SyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
 		DocumentsProcessed: 0,
 		ErrorCount:         0,
 	}
-	o.setStatus(sourceID, status)
+	if err := o.claimSync(sourceID, status); err != nil {
+		return err
+	}
 	defer o.clearStatus(sourceID)
 
-	logger.Info("Starting sync for source %s", sourceID)
+	log := o.log.With("source_id", sourceID, "source_type", source.Type)
+	started := time.Now()
+
+	// Cursors saved by another version of the connector may not decode;
+	// drop them so the source is fully resynced
+	version := o.factory.Version(source.Type)
+	if syncState != nil && syncState.ConnectorVersion != "" && syncState.ConnectorVersion != version {
+		log.Info("connector version changed, discarding sync cursor",
+			"from_version", syncState.ConnectorVersion, "to_version", version)
+		migrated := *syncState
+		migrated.Cursor = ""
+		migrated.SubCursors = nil
+		syncState = &migrated
+	}
+
+	// Log the documents this sync changes so it can be undone
+	o.beginChangeLog(sourceID, syncState, started)
+	succeeded := false
+	defer func() { o.endChangeLog(ctx, sourceID, succeeded) }()
 
 	// 6. Choose sync strategy based on connector capabilities
-	var newCursor string
+	var result driven.SyncComplete
 
-	if caps.SupportsIncremental && syncState != nil && syncState.Cursor != "" {
+	incremental := caps.SupportsIncremental && syncState != nil && syncState.HasCursor()
+	if incremental {
 		// Incremental sync
-		changesCh, errsCh := connector.IncrementalSync(ctx, *syncState)
-		newCursor, err = o.processChanges(ctx, source, changesCh, errsCh, status)
+		timeout := o.syncSettings.IncrementalSyncTimeout()
+		state := *syncState
+		state.Since = since
+		state.Scope = scope
+		switch {
+		case len(scope) > 0:
+			log.Info("sync started", "mode", "incremental", "scope", scope, "timeout", timeout)
+		case since.IsZero():
+			log.Info("sync started", "mode", "incremental", "timeout", timeout)
+		default:
+			log.Info("sync started", "mode", "incremental", "since", since, "timeout", timeout)
+		}
+		syncCtx, cancel := context.WithTimeout(ctx, timeout)
+		changesCh, errsCh := connector.IncrementalSync(syncCtx, state)
+		result, err = o.processChanges(syncCtx, source, changesCh, errsCh, status)
+		cancel()
+		err = deadlineError(ctx, err, "incremental sync", timeout)
 	} else {
 		// Full sync
-		docsCh, errsCh := connector.FullSync(ctx)
-		newCursor, err = o.processDocuments(ctx, source, docsCh, errsCh, status)
+		timeout := o.syncSettings.FullSyncTimeout()
+		log.Info("sync started", "mode", "full", "timeout", timeout)
+		syncCtx, cancel := context.WithTimeout(ctx, timeout)
+		docsCh, errsCh := connector.FullSync(syncCtx)
+		result, err = o.processDocuments(syncCtx, source, docsCh, errsCh, status)
+		cancel()
+		err = deadlineError(ctx, err, "full sync", timeout)
 		// For full sync, fall back to current time if no cursor was returned
-		if err == nil && newCursor == "" && caps.SupportsCursorReturn {
-			newCursor = fmt.Sprintf("%d", time.Now().UnixNano())
+		if err == nil && result.NewCursor == "" && len(result.NewSubCursors) == 0 && caps.SupportsCursorReturn {
+			result.NewCursor = fmt.Sprintf("%d", time.Now().UnixNano())
 		}
 	}
 
 	if err != nil {
+		log.Error("sync failed", "error", err, "duration", time.Since(started))
+		o.recordSyncError(ctx, sourceID, domain.SyncPhaseSync, err)
 		return err
 	}
 
-	// 7. Update sync state with new cursor
+	// 7. Update sync state with new cursors
 	newState := domain.SyncState{
-		SourceID: sourceID,
-		Cursor:   newCursor,
-		LastSync: time.Now(),
+		SourceID:         sourceID,
+		Cursor:           result.NewCursor,
+		SubCursors:       result.NewSubCursors,
+		ConnectorVersion: version,
+		LastSync:         time.Now(),
+	}
+	if incremental && !since.IsZero() {
+		// The override may have produced an older cursor; never move it back
+		newState.Cursor = syncState.Cursor
+		newState.SubCursors = syncState.SubCursors
+	}
+	if incremental && len(scope) > 0 {
+		// Only the cursors in scope moved; keep the others as stored
+		newState.Cursor = syncState.Cursor
+		newState.SubCursors = scopedSubCursors(syncState.SubCursors, result.NewSubCursors, scope)
+	}
+	if syncState != nil {
+		newState.RecentErrors = syncState.RecentErrors
 	}
+	newState.AddErrors(o.takeSyncErrors(sourceID)...)
 	if err := o.syncStore.Save(ctx, newState); err != nil {
 		return fmt.Errorf("save sync state: %w", err)
 	}
 
-	logger.Info("Sync complete: %d documents, %d errors", status.DocumentsProcessed, status.ErrorCount)
+	log.Info("sync complete",
+		"documents", status.DocumentsProcessed,
+		"errors", status.ErrorCount,
+		"skipped", status.DocumentsSkipped,
+		"too_short", status.DocumentsTooShort,
+		"duration", time.Since(started),
+	)
 	status.Running = false
+	succeeded = true
+
+	// 8. Drop documents older than the source's retention window
+	o.pruneAfterSync(ctx, source)
 	return nil
 }
 
-// SyncAll triggers synchronisation for all configured sources.
+// scopedSubCursors returns the stored sub-cursors with those in scope
+// replaced by the ones a scoped sync returned. Keys match the scope ignoring
+// case, as connectors match scoped items, and a returned key replaces a
+// stored key that differs from it only in case.
+func scopedSubCursors(stored, synced map[string]string, scope []string) map[string]string {
+	merged := make(map[string]string, len(stored)+len(scope))
+	for key, cursor := range stored {
+		merged[key] = cursor
+	}
+	for key, cursor := range synced {
+		if !slices.ContainsFunc(scope, func(s string) bool { return strings.EqualFold(s, key) }) {
+			continue
+		}
+		for old := range merged {
+			if strings.EqualFold(old, key) {
+				delete(merged, old)
+			}
+		}
+		merged[key] = cursor
+	}
+	return merged
+}
+
+// Wait blocks until every sync in progress has returned, or ctx is done.
+// A cancelled sync returns once the documents it has buffered are handled
+// by its error policy, so waiting for it before closing the stores leaves
+// them consistent.
+func (o *SyncOrchestrator) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		o.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// recordSyncError saves syncErr as the source's last sync error, keeping
+// its cursors and last successful sync time. The document errors logged
+// before the failure are added to the recent errors along with it.
+// Cancelled syncs are not recorded.
+func (o *SyncOrchestrator) recordSyncError(ctx context.Context, sourceID, phase string, syncErr error) {
+	logged := o.takeSyncErrors(sourceID)
+	if ctx.Err() != nil {
+		return
+	}
+	state, err := o.syncStore.Get(ctx, sourceID)
+	if errors.Is(err, domain.ErrNotFound) {
+		state = &domain.SyncState{SourceID: sourceID}
+	} else if err != nil {
+		o.log.Warn("failed to record sync error", "source_id", sourceID, "error", err)
+		return
+	}
+	state.LastError = syncErr.Error()
+	state.AddErrors(logged...)
+	state.AddErrors(domain.SyncErrorLog{Timestamp: time.Now(), Phase: phase, Message: syncErr.Error()})
+	if err := o.syncStore.Save(ctx, *state); err != nil {
+		o.log.Warn("failed to record sync error", "source_id", sourceID, "error", err)
+	}
+}
+
+// deadlineError annotates err when an operation's own deadline expired.
+// Cancellation or deadlines inherited from parent are returned unchanged.
+func deadlineError(parent context.Context, err error, operation string, timeout time.Duration) error {
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) || parent.Err() != nil {
+		return err
+	}
+	return fmt.Errorf("%s timed out after %s: %w", operation, timeout, err)
+}
+
+// SyncAll triggers synchronisation for all configured sources that are not archived.
 func (o *SyncOrchestrator) SyncAll(ctx context.Context) error {
+	return o.syncAll(ctx, time.Time{})
+}
+
+// SyncAllSince runs SyncSince for all configured sources.
+func (o *SyncOrchestrator) SyncAllSince(ctx context.Context, since time.Time) error {
+	return o.syncAll(ctx, since)
+}
+
+// syncAll syncs every source in turn, collecting failures.
+// Archived sources are skipped.
+func (o *SyncOrchestrator) syncAll(ctx context.Context, since time.Time) error {
 	sources, err := o.sourceStore.List(ctx)
 	if err != nil {
 		return fmt.Errorf("list sources: %w", err)
@@ -155,8 +429,15 @@ func (o *SyncOrchestrator) SyncAll(ctx context.Context) error {
 
 	var errs []error
 	for _, source := range sources {
-		if err := o.Sync(ctx, source.ID); err != nil {
+		if source.Archived {
+			o.log.Debug("skipping archived source", "source_id", source.ID)
+			continue
+		}
+		if err := o.sync(ctx, source.ID, since, nil); err != nil {
 			errs = append(errs, fmt.Errorf("sync %s: %w", source.ID, err))
+			if o.syncPolicy.IsStrict() {
+				break
+			}
 		}
 	}
 
@@ -178,6 +459,8 @@ func (o *SyncOrchestrator) Status(_ context.Context, sourceID string) (*driving.
 			Running:            status.Running,
 			DocumentsProcessed: status.DocumentsProcessed,
 			ErrorCount:         status.ErrorCount,
+			DocumentsSkipped:   status.DocumentsSkipped,
+			DocumentsTooShort:  status.DocumentsTooShort,
 		}, nil
 	}
 
@@ -189,7 +472,7 @@ func (o *SyncOrchestrator) Status(_ context.Context, sourceID string) (*driving.
 }
 
 // processDocuments handles full sync - processes all documents from the connector.
-// Returns the new cursor from SyncComplete if the connector provides one.
+// Returns the SyncComplete sent by the connector, or a zero value if none was sent.
 //
 //nolint:gocognit // Orchestration function coordinating multiple async operations
 func (o *SyncOrchestrator) processDocuments(
@@ -198,13 +481,14 @@ func (o *SyncOrchestrator) processDocuments(
 	docsCh <-chan domain.RawDocument,
 	errsCh <-chan error,
 	status *driving.SyncStatus,
-) (string, error) {
-	var newCursor string
+) (driven.SyncComplete, error) {
+	var result driven.SyncComplete
+	batch := o.newDocumentBatch(source, status)
 
 	for {
 		select {
 		case <-ctx.Done():
-			return "", ctx.Err()
+			return driven.SyncComplete{}, o.abortBatch(ctx, batch, ctx.Err())
 
 		case err, ok := <-errsCh:
 			if !ok {
@@ -213,35 +497,59 @@ func (o *SyncOrchestrator) processDocuments(
 			}
 			// Check if this is a SyncComplete (successful completion with cursor)
 			if sc, isSyncComplete := driven.IsSyncComplete(err); isSyncComplete {
-				newCursor = sc.NewCursor
+				result = *sc
 				continue
 			}
 			if err != nil {
-				return "", fmt.Errorf("connector error: %w", err)
+				return driven.SyncComplete{}, o.abortBatch(ctx, batch, fmt.Errorf("connector error: %w", err))
 			}
 
 		case rawDoc, ok := <-docsCh:
 			if !ok {
-				return newCursor, nil // Done - channel closed
+				// Done - channel closed
+				final, err := pendingResult(errsCh, result)
+				if err != nil {
+					return driven.SyncComplete{}, o.abortBatch(ctx, batch, err)
+				}
+				if err := o.flushBatch(ctx, batch); err != nil {
+					return driven.SyncComplete{}, err
+				}
+				return final, nil
 			}
 
-			logger.Debug("Processing: %s", rawDoc.URI)
-			if err := o.processOneDocument(ctx, source, &rawDoc); err != nil {
-				status.ErrorCount++
-				if errors.Is(err, domain.ErrNotImplemented) {
-					logger.Debug("Skipping %s: %v", rawDoc.URI, err)
-				} else {
-					logger.Debug("Failed to process %s: %v", rawDoc.URI, err)
-				}
+			o.log.Debug("processing document", "uri", rawDoc.URI)
+			if err := o.addToBatch(ctx, batch, &rawDoc); err != nil {
+				return driven.SyncComplete{}, err
+			}
+		}
+	}
+}
+
+// pendingResult reads what the connector sent on errsCh before closing its
+// document channel. Connectors send their result and then close both
+// channels, so the closed document channel may be selected first.
+func pendingResult(errsCh <-chan error, result driven.SyncComplete) (driven.SyncComplete, error) {
+	for {
+		select {
+		case err, ok := <-errsCh:
+			if !ok {
+				return result, nil
+			}
+			if sc, isSyncComplete := driven.IsSyncComplete(err); isSyncComplete {
+				result = *sc
 				continue
 			}
-			status.DocumentsProcessed++
+			if err != nil {
+				return driven.SyncComplete{}, fmt.Errorf("connector error: %w", err)
+			}
+		default:
+			return result, nil
 		}
 	}
 }
 
 // processChanges handles incremental sync - processes document changes.
-// Returns the new cursor from SyncComplete if the connector provides one.
+// Returns the SyncComplete sent by the connector, or a zero value if none was sent.
 //
 //nolint:gocognit // Orchestration function coordinating multiple async operations
 func (o *SyncOrchestrator) processChanges(
@@ -250,13 +558,14 @@ func (o *SyncOrchestrator) processChanges(
 	changesCh <-chan domain.RawDocumentChange,
 	errsCh <-chan error,
 	status *driving.SyncStatus,
-) (string, error) {
-	var newCursor string
+) (driven.SyncComplete, error) {
+	var result driven.SyncComplete
+	batch := o.newDocumentBatch(source, status)
 
 	for {
 		select {
 		case <-ctx.Done():
-			return "", ctx.Err()
+			return driven.SyncComplete{}, o.abortBatch(ctx, batch, ctx.Err())
 
 		case err, ok := <-errsCh:
 			if !ok {
@@ -265,91 +574,297 @@ func (o *SyncOrchestrator) processChanges(
 			}
 			// Check if this is a SyncComplete (successful completion with cursor)
 			if sc, isSyncComplete := driven.IsSyncComplete(err); isSyncComplete {
-				newCursor = sc.NewCursor
+				result = *sc
 				continue
 			}
 			if err != nil {
-				return "", fmt.Errorf("connector error: %w", err)
+				return driven.SyncComplete{}, o.abortBatch(ctx, batch, fmt.Errorf("connector error: %w", err))
 			}
 
 		case change, ok := <-changesCh:
 			if !ok {
-				return newCursor, nil // Done - channel closed
+				// Done - channel closed
+				final, err := pendingResult(errsCh, result)
+				if err != nil {
+					return driven.SyncComplete{}, o.abortBatch(ctx, batch, err)
+				}
+				if err := o.flushBatch(ctx, batch); err != nil {
+					return driven.SyncComplete{}, err
+				}
+				return final, nil
 			}
 
 			switch change.Type {
 			case domain.ChangeCreated, domain.ChangeUpdated:
-				logger.Debug("Processing: %s", change.Document.URI)
-				if err := o.processOneDocument(ctx, source, &change.Document); err != nil {
-					status.ErrorCount++
-					if errors.Is(err, domain.ErrNotImplemented) {
-						logger.Debug("Skipping %s: %v", change.Document.URI, err)
-					} else {
-						logger.Debug("Failed to process %s: %v", change.Document.URI, err)
-					}
-					continue
+				o.log.Debug("processing document", "uri", change.Document.URI)
+				if err := o.addToBatch(ctx, batch, &change.Document); err != nil {
+					return driven.SyncComplete{}, err
 				}
 
 			case domain.ChangeDeleted:
-				logger.Debug("Deleting: %s", change.Document.URI)
+				// Store earlier changes first, as one of them may be the deleted document
+				if err := o.flushBatch(ctx, batch); err != nil {
+					return driven.SyncComplete{}, err
+				}
+				o.log.Debug("deleting document", "uri", change.Document.URI)
 				if err := o.deleteDocumentByURI(ctx, source.ID, change.Document.URI); err != nil {
-					status.ErrorCount++
-					logger.Debug("Failed to delete %s: %v", change.Document.URI, err)
+					if abortErr := o.documentFailed(status, change.Document.URI,
+						fmt.Errorf("delete: %w", err)); abortErr != nil {
+						return driven.SyncComplete{}, abortErr
+					}
 					continue
 				}
+				status.DocumentsProcessed++
 			}
-			status.DocumentsProcessed++
 		}
 	}
 }
 
-// processOneDocument handles the 7-step document processing pipeline.
+// unsupportedTypeError reports a document skipped because no normaliser
+// supports its MIME type.
+type unsupportedTypeError struct {
+	mimeType string
+}
+
+func (e *unsupportedTypeError) Error() string {
+	return fmt.Sprintf("skipped: no normaliser for MIME type %q", e.mimeType)
+}
+
+func (e *unsupportedTypeError) Unwrap() error {
+	return domain.ErrNotImplemented
+}
+
+// tooShortError reports a document skipped because its content is shorter
+// than the minimum length.
+type tooShortError struct {
+	minLength int
+}
+
+func (e *tooShortError) Error() string {
+	return fmt.Sprintf("skipped: content shorter than %d characters", e.minLength)
+}
+
+// documentFailed counts and logs a document that failed to sync. Under the
+// strict policy it returns the error that aborts the sync; documents skipped
+// for an unsupported type are counted apart and never abort it.
+func (o *SyncOrchestrator) documentFailed(status *driving.SyncStatus, uri string, err error) error {
+	var tooShort *tooShortError
+	if errors.As(err, &tooShort) {
+		o.log.Debug("skipping document", "uri", uri, "reason", err)
+		status.DocumentsTooShort++
+		o.countTooShort(status.SourceID)
+		return nil
+	}
+	if errors.Is(err, domain.ErrNotImplemented) {
+		o.log.Debug("skipping document", "uri", uri, "reason", err)
+		status.DocumentsSkipped++
+		mimeType := "unknown"
+		var unsupported *unsupportedTypeError
+		if errors.As(err, &unsupported) && unsupported.mimeType != "" {
+			mimeType = unsupported.mimeType
+		}
+		o.countSkipped(status.SourceID, mimeType)
+		return nil
+	}
+	status.ErrorCount++
+	o.log.Warn("failed to process document", "uri", uri, "error", err)
+	o.logSyncError(status.SourceID, domain.SyncErrorLog{
+		Timestamp: time.Now(),
+		Phase:     domain.SyncPhaseDocument,
+		Message:   fmt.Sprintf("%s: %v", uri, err),
+	})
+	if o.syncPolicy.IsStrict() {
+		return fmt.Errorf("%w after %d documents: %s: %w", domain.ErrSyncAborted, status.DocumentsProcessed, uri, err)
+	}
+	return nil
+}
+
+// prepareDocument runs the first four steps of the 8-step document processing
+// pipeline, producing a document ready to be stored and indexed with its batch.
+// Excluded documents return nil.
 //
 //nolint:gocognit,gocyclo // Pipeline orchestration with sequential steps
-func (o *SyncOrchestrator) processOneDocument(
+func (o *SyncOrchestrator) prepareDocument(
 	ctx context.Context,
 	source *domain.Source,
 	raw *domain.RawDocument,
-) error {
+) (*pendingDocument, error) {
 	// 1. CHECK EXCLUSION
 	excluded, err := o.exclusionStore.IsExcluded(ctx, source.ID, raw.URI)
 	if err != nil {
-		return fmt.Errorf("check exclusion: %w", err)
+		return nil, fmt.Errorf("check exclusion: %w", err)
 	}
 	if excluded {
-		return nil // Skip silently
+		return nil, nil // Skip silently
 	}
 
 	// 2. NORMALISE (produces Document with Content)
-	result, err := o.registry.Normalise(ctx, raw)
-	if err != nil {
-		return fmt.Errorf("normalise: %w", err)
+	// Metadata-only documents have no content and skip normalising, chunking,
+	// embedding and enrichment; they are indexed by name for keyword search.
+	metadataOnly := domain.IsMetadataOnly(raw.Metadata)
+	var result *driven.NormaliseResult
+	if metadataOnly {
+		result = metadataOnlyResult(raw)
+	} else if !o.registry.Supports(raw.MIMEType) {
+		return nil, &unsupportedTypeError{mimeType: raw.MIMEType}
+	} else {
+		if domain.IsPartial(raw.Metadata) {
+			result, err = o.appendDocument(ctx, source.ID, raw)
+		} else {
+			result, err = o.registry.Normalise(ctx, raw)
+		}
+		if err != nil {
+			return nil, o.recordNormaliseFailure(ctx, source.ID, raw.URI, err)
+		}
+	}
+	if err := o.exclusionStore.ClearFailures(ctx, source.ID, raw.URI); err != nil {
+		return nil, fmt.Errorf("clear failures: %w", err)
 	}
+	// Keep the connector's parent link so breadcrumbs can be rebuilt
+	if raw.ParentURI != nil && *raw.ParentURI != "" {
+		if result.Document.Metadata == nil {
+			result.Document.Metadata = make(map[string]any)
+		}
+		result.Document.Metadata[domain.MetadataParentURI] = *raw.ParentURI
+	}
+	// Fall back to the author the connector reported
+	if result.Document.Author.IsZero() {
+		result.Document.Author = domain.AuthorFromMetadata(raw.Metadata)
+	}
+	// Documents too short to be worth searching are skipped, or indexed by
+	// name only when configured
+	tooShort := !metadataOnly && o.syncSettings.TooShort(result.Document.Content)
+	if tooShort && !o.syncSettings.ShortAsMetadataOnly {
+		if err := o.deleteShortDocument(ctx, source.ID, raw.URI); err != nil {
+			return nil, err
+		}
+		return nil, &tooShortError{minLength: o.syncSettings.MinContentLength}
+	}
+	if tooShort {
+		metadataOnly = true
+		result.Document.Content = ""
+		if result.Document.Metadata == nil {
+			result.Document.Metadata = make(map[string]any)
+		}
+		result.Document.Metadata[domain.MetadataMetadataOnly] = true
+	}
+	docID := result.Document.ID
+	o.progress.OnDocumentProcessed(source.ID, docID, driving.IndexPhaseNormalised)
 
 	// 3. RUN POST-PROCESSOR PIPELINE (produces Chunks)
-	chunks, err := o.pipeline.Process(ctx, &result.Document)
-	if err != nil {
-		return fmt.Errorf("post-process: %w", err)
+	var chunks []domain.Chunk
+	if metadataOnly {
+		chunks = []domain.Chunk{metadataOnlyChunk(&result.Document)}
+	} else {
+		chunks, err = o.pipeline.Process(ctx, &result.Document)
+		if err != nil {
+			return nil, fmt.Errorf("post-process: %w", err)
+		}
 	}
+	tagChunks(&result.Document, chunks)
+	o.progress.OnDocumentProcessed(source.ID, docID, driving.IndexPhaseChunked)
 
-	// 4. GENERATE EMBEDDINGS (if service available)
-	if o.embeddingService != nil {
+	// 4. GENERATE EMBEDDINGS (if service available and not queued for the worker)
+	// Chunks left unchanged since the last sync keep their stored embeddings.
+	if o.embeddingService != nil && !metadataOnly {
+		o.reuseEmbeddings(ctx, source.ID, raw.URI, chunks)
+	}
+	if o.embeddingService != nil && o.embeddingQueue == nil && !metadataOnly {
 		for i := range chunks {
+			if chunks[i].Embedding != nil {
+				o.progress.OnChunkEmbedded(source.ID, chunks[i].ID)
+				continue
+			}
 			embedding, err := o.embeddingService.Embed(ctx, chunks[i].Content)
 			if err != nil {
-				return fmt.Errorf("embed chunk: %w", err)
+				return nil, fmt.Errorf("embed chunk: %w", err)
 			}
 			chunks[i].Embedding = embedding
+			o.progress.OnChunkEmbedded(source.ID, chunks[i].ID)
 		}
 	}
 
-	// 5. SAVE TO DOCUMENT STORE
-	if err := o.docStore.SaveDocument(ctx, &result.Document); err != nil {
-		return fmt.Errorf("save document: %w", err)
+	// 5. SAVE TO DOCUMENT STORE happens when the batch is flushed
+	pending := &pendingDocument{
+		uri:          raw.URI,
+		doc:          result.Document,
+		chunks:       chunks,
+		metadataOnly: metadataOnly,
+		tooShort:     tooShort,
 	}
-	if err := o.docStore.SaveChunks(ctx, chunks); err != nil {
-		return fmt.Errorf("save chunks: %w", err)
+	// A partial document's content is not the whole original
+	if !metadataOnly && !domain.IsPartial(raw.Metadata) && o.syncSettings.KeepsOriginal(len(raw.Content)) {
+		pending.original = raw.Content
 	}
+	return pending, nil
+}
+
+// deleteShortDocument removes the stored document with the URI of a document
+// that has become too short to index, so its old content is not found.
+// Documents nested under it are kept; they are synced on their own.
+func (o *SyncOrchestrator) deleteShortDocument(ctx context.Context, sourceID, uri string) error {
+	existing, err := o.docStore.GetDocumentByURI(ctx, sourceID, uri)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get document: %w", err)
+	}
+	return o.deleteDocument(ctx, existing)
+}
+
+// appendDocument merges a partial raw document, holding only what was added
+// since the last sync, into the stored document with the same URI. Without
+// a stored document the partial content is normalised on its own; the next
+// full sync restores what it leaves out.
+func (o *SyncOrchestrator) appendDocument(
+	ctx context.Context, sourceID string, raw *domain.RawDocument,
+) (*driven.NormaliseResult, error) {
+	existing, err := o.docStore.GetDocumentByURI(ctx, sourceID, raw.URI)
+	var result *driven.NormaliseResult
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		o.log.Debug("no stored document to append to", "uri", raw.URI)
+		result, err = o.registry.Normalise(ctx, raw)
+	case err != nil:
+		return nil, fmt.Errorf("get document: %w", err)
+	default:
+		result, err = o.registry.Append(ctx, existing, raw)
+	}
+	if err != nil {
+		return nil, err
+	}
+	delete(result.Document.Metadata, domain.MetadataPartial)
+	return result, nil
+}
+
+// tagChunks copies the document's title, author and language into chunk
+// metadata so the search index can store them with each chunk.
+func tagChunks(doc *domain.Document, chunks []domain.Chunk) {
+	lang := domain.DocumentLanguage(doc.Metadata)
+	if doc.Title == "" && doc.Author.IsZero() && lang == "" {
+		return
+	}
+	for i := range chunks {
+		if chunks[i].Metadata == nil {
+			chunks[i].Metadata = make(map[string]any)
+		}
+		if doc.Title != "" {
+			chunks[i].Metadata[domain.MetadataDocumentTitle] = doc.Title
+		}
+		if !doc.Author.IsZero() {
+			domain.SetAuthorMetadata(chunks[i].Metadata, doc.Author)
+		}
+		if lang != "" {
+			chunks[i].Metadata[domain.MetadataLanguage] = lang
+		}
+	}
+}
+
+// indexDocument runs the last steps of the document processing pipeline
+// for a document saved to the document store.
+func (o *SyncOrchestrator) indexDocument(ctx context.Context, sourceID string, pending *pendingDocument) error {
+	chunks := pending.chunks
 
 	// 6. INDEX FOR KEYWORD SEARCH
 	for _, chunk := range chunks {
@@ -358,7 +873,7 @@ func (o *SyncOrchestrator) processOneDocument(
 		}
 	}
 
-	// 7. INDEX FOR VECTOR SEARCH (if available)
+	// 7. INDEX FOR VECTOR SEARCH (if available; queued chunks are indexed by the worker)
 	if o.vectorIndex != nil && o.embeddingService != nil {
 		for _, chunk := range chunks {
 			if chunk.Embedding != nil {
@@ -368,42 +883,149 @@ func (o *SyncOrchestrator) processOneDocument(
 			}
 		}
 	}
+	if o.embeddingQueue != nil && !pending.metadataOnly {
+		if err := o.enqueueEmbeddings(ctx, chunks); err != nil {
+			return err
+		}
+	}
 
+	o.progress.OnDocumentProcessed(sourceID, pending.doc.ID, driving.IndexPhaseIndexed)
+
+	// 8. ENRICH WITH LLM KEYWORDS (if enabled, runs in the background)
+	if o.enrichment != nil && !pending.metadataOnly {
+		o.enrichment.Enqueue(ctx, &pending.doc)
+	}
+
+	return nil
+}
+
+// enqueueEmbeddings queues saved chunks for the background embedding worker.
+// Chunks that already have an embedding are skipped.
+func (o *SyncOrchestrator) enqueueEmbeddings(ctx context.Context, chunks []domain.Chunk) error {
+	ids := make([]string, 0, len(chunks))
+	for i := range chunks {
+		if chunks[i].Embedding == nil {
+			ids = append(ids, chunks[i].ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := o.embeddingQueue.Enqueue(ctx, ids); err != nil {
+		return fmt.Errorf("queue embeddings: %w", err)
+	}
 	return nil
 }
 
-// deleteDocumentByURI removes a document and its indexes by URI.
+// recordNormaliseFailure counts a normalisation failure for a document and
+// quarantines it once it has failed too many times in a row.
+// Returns the normalisation error for the caller to report.
+func (o *SyncOrchestrator) recordNormaliseFailure(ctx context.Context, sourceID, uri string, normErr error) error {
+	err := fmt.Errorf("normalise: %w", normErr)
+
+	// Unsupported types and cancellation are not faults of the document
+	if errors.Is(normErr, domain.ErrNotImplemented) || ctx.Err() != nil {
+		return err
+	}
+
+	failures, recordErr := o.exclusionStore.RecordFailure(ctx, sourceID, uri, normErr.Error())
+	if recordErr != nil {
+		o.log.Warn("failed to record normalisation failure", "uri", uri, "error", recordErr)
+		return err
+	}
+
+	threshold := o.syncSettings.QuarantineThreshold()
+	if failures < threshold {
+		return err
+	}
+
+	exclusion := &domain.Exclusion{
+		ID:          quarantineID(sourceID, uri),
+		SourceID:    sourceID,
+		URI:         uri,
+		Reason:      fmt.Sprintf("normalisation failed %d times in a row: %v", failures, normErr),
+		ExcludedAt:  time.Now(),
+		Quarantined: true,
+	}
+	if addErr := o.exclusionStore.Add(ctx, exclusion); addErr != nil {
+		o.log.Warn("failed to quarantine document", "uri", uri, "error", addErr)
+		return err
+	}
+	o.log.Warn("quarantined document", "uri", uri, "failures", failures, "error", normErr)
+
+	return err
+}
+
+// findDocumentsByURI pages through a source's documents, returning those
+// with the URI and those nested under it (URI followed by "/").
+func (o *SyncOrchestrator) findDocumentsByURI(
+	ctx context.Context, sourceID, uri string,
+) ([]domain.Document, error) {
+	var found []domain.Document
+	for offset := 0; ; offset += documentPageSize {
+		docs, total, err := o.docStore.ListDocumentsPaginated(ctx, sourceID, offset, documentPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("list documents: %w", err)
+		}
+
+		for i := range docs {
+			if docs[i].URI == uri || strings.HasPrefix(docs[i].URI, uri+"/") {
+				found = append(found, docs[i])
+			}
+		}
+
+		if len(docs) == 0 || int64(offset+len(docs)) >= total {
+			return found, nil
+		}
+	}
+}
+
+// quarantineID returns a stable exclusion ID for a quarantined document.
+func quarantineID(sourceID, uri string) string {
+	sum := sha256.Sum256([]byte(sourceID + "\x00" + uri))
+	return "quar-" + hex.EncodeToString(sum[:8])
+}
+
+// documentPageSize is how many documents are loaded at a time when
+// walking every document of a source.
+const documentPageSize = 500
+
+// deleteDocumentByURI removes a document and its indexes by URI, along with
+// any documents nested under it, such as the attachments of an email.
 func (o *SyncOrchestrator) deleteDocumentByURI(ctx context.Context, sourceID, uri string) error {
-	// Find document by URI - iterate through source documents
-	docs, err := o.docStore.ListDocuments(ctx, sourceID)
+	docs, err := o.findDocumentsByURI(ctx, sourceID, uri)
 	if err != nil {
-		return fmt.Errorf("list documents: %w", err)
+		return err
 	}
 
-	var docToDelete *domain.Document
+	// No documents means it might have been deleted already
 	for i := range docs {
-		if docs[i].URI == uri {
-			docToDelete = &docs[i]
-			break
+		if err := o.deleteDocument(ctx, &docs[i]); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	if docToDelete == nil {
-		// Document not found - might have been deleted already
-		return nil
-	}
-
+// deleteDocument removes a document, its chunks and their index entries.
+func (o *SyncOrchestrator) deleteDocument(ctx context.Context, doc *domain.Document) error {
 	// Get chunks before deleting
-	chunks, err := o.docStore.GetChunks(ctx, docToDelete.ID)
+	chunks, err := o.docStore.GetChunks(ctx, doc.ID)
 	if err != nil {
 		return fmt.Errorf("get chunks: %w", err)
 	}
+	o.recordChange(doc.SourceID, domain.SyncChange{
+		Kind:           domain.SyncChangeDeleted,
+		DocumentID:     doc.ID,
+		Previous:       doc,
+		PreviousChunks: chunks,
+	})
 
 	// Delete from vector index
 	if o.vectorIndex != nil {
 		for _, chunk := range chunks {
 			if err := o.vectorIndex.Delete(ctx, chunk.ID); err != nil {
-				logger.Debug("Failed to delete vector %s: %v", chunk.ID, err)
+				o.log.Debug("failed to delete vector", "chunk_id", chunk.ID, "error", err)
 			}
 		}
 	}
@@ -411,28 +1033,133 @@ func (o *SyncOrchestrator) deleteDocumentByURI(ctx context.Context, sourceID, ur
 	// Delete from search index
 	for _, chunk := range chunks {
 		if err := o.searchIndex.Delete(ctx, chunk.ID); err != nil {
-			logger.Debug("Failed to delete search index %s: %v", chunk.ID, err)
+			o.log.Debug("failed to delete from search index", "chunk_id", chunk.ID, "error", err)
 		}
 	}
 
 	// Delete document and chunks from store
-	if err := o.docStore.DeleteDocument(ctx, docToDelete.ID); err != nil {
+	if err := o.docStore.DeleteDocument(ctx, doc.ID); err != nil {
 		return fmt.Errorf("delete document: %w", err)
 	}
+	o.progress.OnDocumentProcessed(doc.SourceID, doc.ID, driving.IndexPhaseDeleted)
 
 	return nil
 }
 
-// setStatus sets the sync status for a source.
-func (o *SyncOrchestrator) setStatus(sourceID string, status *driving.SyncStatus) {
+// claimSync sets the sync status for a source, failing with
+// ErrSyncInProgress if a sync or undo of the source is already running.
+// The claim is released by clearStatus.
+func (o *SyncOrchestrator) claimSync(sourceID string, status *driving.SyncStatus) error {
 	o.mu.Lock()
 	defer o.mu.Unlock()
+	if _, ok := o.activeSyncs[sourceID]; ok {
+		return fmt.Errorf("source %s: %w", sourceID, domain.ErrSyncInProgress)
+	}
 	o.activeSyncs[sourceID] = status
+	return nil
 }
 
-// clearStatus removes the sync status for a source.
+// clearStatus removes the sync status for a source, with any errors
+// logged during its sync that were not saved.
 func (o *SyncOrchestrator) clearStatus(sourceID string) {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 	delete(o.activeSyncs, sourceID)
+	delete(o.syncErrors, sourceID)
+	delete(o.skipped, sourceID)
+	delete(o.tooShort, sourceID)
+}
+
+// logSyncError keeps a non-fatal error from a source's running sync until
+// the sync state is saved.
+func (o *SyncOrchestrator) logSyncError(sourceID string, entry domain.SyncErrorLog) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	logged := append(o.syncErrors[sourceID], entry)
+	// Only the latest errors are saved
+	if len(logged) > domain.MaxRecentSyncErrors {
+		logged = logged[1:]
+	}
+	o.syncErrors[sourceID] = logged
+}
+
+// countSkipped counts a document of a source's running sync skipped for
+// its MIME type.
+func (o *SyncOrchestrator) countSkipped(sourceID, mimeType string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.skipped[sourceID] == nil {
+		o.skipped[sourceID] = make(map[string]int)
+	}
+	o.skipped[sourceID][mimeType]++
+}
+
+// countTooShort counts a document of a source's running sync shorter than
+// the minimum length.
+func (o *SyncOrchestrator) countTooShort(sourceID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.tooShort[sourceID]++
+}
+
+// takeSyncErrors returns and forgets the errors logged during a source's sync.
+// Skipped documents are summarised in entries after the errors.
+func (o *SyncOrchestrator) takeSyncErrors(sourceID string) []domain.SyncErrorLog {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	logged := o.syncErrors[sourceID]
+	if skipped := o.skipped[sourceID]; len(skipped) > 0 {
+		logged = append(logged, skippedSummary(skipped))
+	}
+	if count := o.tooShort[sourceID]; count > 0 {
+		logged = append(logged, o.tooShortSummary(count))
+	}
+	delete(o.syncErrors, sourceID)
+	delete(o.skipped, sourceID)
+	delete(o.tooShort, sourceID)
+	return logged
+}
+
+// tooShortSummary describes the documents of a sync shorter than the minimum
+// length, such as "3 documents skipped: shorter than 20 characters".
+func (o *SyncOrchestrator) tooShortSummary(count int) domain.SyncErrorLog {
+	noun := "documents"
+	if count == 1 {
+		noun = "document"
+	}
+	outcome := "skipped"
+	if o.syncSettings.ShortAsMetadataOnly {
+		outcome = "indexed by name only"
+	}
+	return domain.SyncErrorLog{
+		Timestamp: time.Now(),
+		Phase:     domain.SyncPhaseSkipped,
+		Message: fmt.Sprintf("%d %s %s: shorter than %d characters",
+			count, noun, outcome, o.syncSettings.MinContentLength),
+	}
+}
+
+// skippedSummary describes the documents skipped during a sync, counted by
+// MIME type, such as "3 documents skipped: no normaliser for image/png (2), text/x-foo (1)".
+func skippedSummary(skipped map[string]int) domain.SyncErrorLog {
+	types := make([]string, 0, len(skipped))
+	total := 0
+	for mimeType, count := range skipped {
+		types = append(types, mimeType)
+		total += count
+	}
+	sort.Strings(types)
+	counts := make([]string, len(types))
+	for i, mimeType := range types {
+		counts[i] = fmt.Sprintf("%s (%d)", mimeType, skipped[mimeType])
+	}
+	noun := "documents"
+	if total == 1 {
+		noun = "document"
+	}
+	return domain.SyncErrorLog{
+		Timestamp: time.Now(),
+		Phase:     domain.SyncPhaseSkipped,
+		Message:   fmt.Sprintf("%d %s skipped: no normaliser for %s", total, noun, strings.Join(counts, ", ")),
+	}
 }
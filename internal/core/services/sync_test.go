@@ -3,6 +3,9 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	stdsync "sync"
 	"testing"
 	"time"
@@ -28,7 +31,22 @@ type syncMockConnector struct {
 	fullSyncErr  error
 	incSyncDocs  []domain.RawDocumentChange
 	incSyncErr   error
-	closed       bool
+	// complete is sent when a sync finishes; incState records the state passed to IncrementalSync.
+	complete *driven.SyncComplete
+	incState domain.SyncState
+	// block makes Validate and syncs wait until their context is done.
+	block  bool
+	closed bool
+	// hangAfterDocs makes a full sync wait until its context is done once its
+	// documents are sent, first sending failAfterDocs if set.
+	hangAfterDocs bool
+	failAfterDocs error
+	// contentTypeFilter records the filter set by the orchestrator.
+	contentTypeFilter func(mimeType string) bool
+}
+
+func (m *syncMockConnector) SetContentTypeFilter(supported func(mimeType string) bool) {
+	m.contentTypeFilter = supported
 }
 
 func (m *syncMockConnector) Type() string     { return m.connType }
@@ -49,6 +67,10 @@ func (m *syncMockConnector) FullSync(ctx context.Context) (<-chan domain.RawDocu
 			errs <- m.fullSyncErr
 			return
 		}
+		if m.block {
+			<-ctx.Done()
+			return
+		}
 
 		for _, doc := range m.fullSyncDocs {
 			select {
@@ -57,12 +79,23 @@ func (m *syncMockConnector) FullSync(ctx context.Context) (<-chan domain.RawDocu
 			case docs <- doc:
 			}
 		}
+		if m.hangAfterDocs {
+			if m.failAfterDocs != nil {
+				errs <- m.failAfterDocs
+			}
+			<-ctx.Done()
+			return
+		}
+		if m.complete != nil {
+			errs <- m.complete
+		}
 	}()
 
 	return docs, errs
 }
 
-func (m *syncMockConnector) IncrementalSync(ctx context.Context, _ domain.SyncState) (<-chan domain.RawDocumentChange, <-chan error) {
+func (m *syncMockConnector) IncrementalSync(ctx context.Context, state domain.SyncState) (<-chan domain.RawDocumentChange, <-chan error) {
+	m.incState = state
 	changes := make(chan domain.RawDocumentChange)
 	errs := make(chan error, 1)
 
@@ -82,6 +115,9 @@ func (m *syncMockConnector) IncrementalSync(ctx context.Context, _ domain.SyncSt
 			case changes <- change:
 			}
 		}
+		if m.complete != nil {
+			errs <- m.complete
+		}
 	}()
 
 	return changes, errs
@@ -91,7 +127,11 @@ func (m *syncMockConnector) Watch(_ context.Context) (<-chan domain.RawDocumentC
 	return nil, errors.New("watch not implemented")
 }
 
-func (m *syncMockConnector) Validate(_ context.Context) error {
+func (m *syncMockConnector) Validate(ctx context.Context) error {
+	if m.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
 	return nil
 }
 
@@ -108,6 +148,7 @@ func (m *syncMockConnector) GetAccountIdentifier(_ context.Context, _ string) (s
 type syncMockConnectorFactory struct {
 	connectors map[string]*syncMockConnector
 	createErr  error
+	version    string
 }
 
 func newSyncMockConnectorFactory() *syncMockConnectorFactory {
@@ -132,6 +173,10 @@ func (f *syncMockConnectorFactory) SupportedTypes() []string {
 	return []string{"mock"}
 }
 
+func (f *syncMockConnectorFactory) Version(_ string) string {
+	return f.version
+}
+
 func (f *syncMockConnectorFactory) GetDefaultOAuthConfig(_ string) *driven.OAuthDefaults {
 	return nil
 }
@@ -156,23 +201,42 @@ func (f *syncMockConnectorFactory) RefreshToken(_ context.Context, _ string, _ *
 	return nil, nil
 }
 
-func (f *syncMockConnectorFactory) GetUserInfo(_ context.Context, _, _ string) (string, error) {
-	return "", nil
+func (f *syncMockConnectorFactory) GetUserInfo(_ context.Context, _, _ string) (domain.AccountInfo, error) {
+	return domain.AccountInfo{}, nil
 }
 
 // syncMockNormaliserRegistry implements driven.NormaliserRegistry.
 type syncMockNormaliserRegistry struct {
 	normaliseResult *driven.NormaliseResult
 	normaliseErr    error
+	normaliseCalls  int
+	appendCalls     int
+	unsupported     []string // MIME types with no normaliser
+}
+
+func (r *syncMockNormaliserRegistry) Append(
+	_ context.Context, existing *domain.Document, raw *domain.RawDocument,
+) (*driven.NormaliseResult, error) {
+	r.appendCalls++
+	doc := *existing
+	doc.Content += string(raw.Content)
+	doc.Metadata = maps.Clone(raw.Metadata)
+	doc.UpdatedAt = time.Now()
+	return &driven.NormaliseResult{Document: doc}, nil
 }
 
 func (r *syncMockNormaliserRegistry) Register(_ driven.Normaliser) {}
 
+func (r *syncMockNormaliserRegistry) Supports(mimeType string) bool {
+	return !slices.Contains(r.unsupported, mimeType)
+}
+
 func (r *syncMockNormaliserRegistry) SupportedMIMETypes() []string {
 	return []string{"text/plain"}
 }
 
 func (r *syncMockNormaliserRegistry) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	r.normaliseCalls++
 	if r.normaliseErr != nil {
 		return nil, r.normaliseErr
 	}
@@ -266,6 +330,10 @@ func (v *syncMockVectorIndex) Search(_ context.Context, _ []float32, _ int) ([]d
 	return nil, nil
 }
 
+func (v *syncMockVectorIndex) SearchWithFilter(_ context.Context, _ []float32, _ int, _ []string) ([]driven.VectorHit, error) {
+	return nil, nil
+}
+
 func (v *syncMockVectorIndex) Delete(_ context.Context, id string) error {
 	v.mu.Lock()
 	defer v.mu.Unlock()
@@ -370,6 +438,34 @@ func TestSyncOrchestrator_Sync_ConnectorFactoryMissing(t *testing.T) {
 	assert.Contains(t, err.Error(), "create connector")
 }
 
+func TestSyncOrchestrator_Sync_SearchIndexMissing(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		fullSyncDocs: []domain.RawDocument{{SourceID: "src-1", URI: "/a.txt", MIMEType: "text/plain"}},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		nil, nil, nil,
+	)
+
+	err := orchestrator.Sync(ctx, "src-1")
+
+	// Nothing is saved that the keyword index would miss
+	assert.ErrorIs(t, err, domain.ErrSearchUnavailable)
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
 func TestSyncOrchestrator_Sync_FullSync_Success(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
@@ -419,278 +515,1268 @@ func TestSyncOrchestrator_Sync_FullSync_Success(t *testing.T) {
 	assert.Len(t, searchEngine.indexed, 2)
 }
 
-func TestSyncOrchestrator_Sync_WithExclusions(t *testing.T) {
+// recordingProgressReporter records progress events in order.
+type recordingProgressReporter struct {
+	mu     stdsync.Mutex
+	events []string
+}
+
+func (r *recordingProgressReporter) OnDocumentProcessed(sourceID, docID, phase string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, sourceID+" "+docID+" "+phase)
+}
+
+func (r *recordingProgressReporter) OnChunkEmbedded(sourceID, chunkID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, sourceID+" "+chunkID+" embedded")
+}
+
+func TestSyncOrchestrator_Sync_ReportsProgress(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
-	syncStore := memory.NewSyncStateStore()
-	docStore := memory.NewDocumentStore()
-	exclusionStore := memory.NewExclusionStore()
 	factory := newSyncMockConnectorFactory()
-	registry := &syncMockNormaliserRegistry{}
-	searchEngine := newSyncMockSearchEngine()
-
 	ctx := context.Background()
 
-	// Setup source
-	source := domain.Source{ID: "src-1", Name: "Test", Type: "mock"}
-	require.NoError(t, sourceStore.Save(ctx, source))
-
-	// Setup connector with documents
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
 	factory.connectors["src-1"] = &syncMockConnector{
 		sourceID: "src-1",
 		connType: "mock",
 		fullSyncDocs: []domain.RawDocument{
-			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
-			{SourceID: "src-1", URI: "file2.txt", MIMEType: "text/plain", Content: []byte("content 2")},
-			{SourceID: "src-1", URI: "excluded.txt", MIMEType: "text/plain", Content: []byte("excluded")},
+			{SourceID: "src-1", URI: "a.txt", MIMEType: "text/plain", Content: []byte("content a")},
+			{SourceID: "src-1", URI: "b.txt", MIMEType: "text/plain", Content: []byte("content b")},
 		},
 	}
 
-	// Add exclusion for one file
-	exclusion := &domain.Exclusion{
-		ID:         "exc-1",
-		SourceID:   "src-1",
-		DocumentID: "doc-excluded",
-		URI:        "excluded.txt",
-		Reason:     "test exclusion",
-	}
-	require.NoError(t, exclusionStore.Add(ctx, exclusion))
-
 	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), newSyncMockVectorIndex(), &syncMockEmbeddingService{},
 	)
+	reporter := &recordingProgressReporter{}
+	orchestrator.SetProgressReporter(reporter)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, []string{
+		"src-1 src-1-doc-a.txt normalised",
+		"src-1 src-1-doc-a.txt chunked",
+		"src-1 src-1-chunk-a.txt embedded",
+		"src-1 src-1-doc-b.txt normalised",
+		"src-1 src-1-doc-b.txt chunked",
+		"src-1 src-1-chunk-b.txt embedded",
+		// Both documents are stored in one batch, then indexed
+		"src-1 src-1-doc-a.txt stored",
+		"src-1 src-1-doc-b.txt stored",
+		"src-1 src-1-doc-a.txt indexed",
+		"src-1 src-1-doc-b.txt indexed",
+	}, reporter.events)
+}
 
-	err := orchestrator.Sync(ctx, "src-1")
+func TestSyncOrchestrator_SetProgressReporter_Nil(t *testing.T) {
+	orchestrator := NewSyncOrchestrator(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	require.NoError(t, err)
+	orchestrator.SetProgressReporter(nil)
 
-	// Verify only non-excluded documents were saved
-	docs, err := docStore.ListDocuments(ctx, "src-1")
-	require.NoError(t, err)
-	assert.Len(t, docs, 2) // Only 2 docs, excluded one skipped
+	assert.Equal(t, nopProgressReporter{}, orchestrator.progress)
 }
 
-func TestSyncOrchestrator_Sync_WithEmbeddings(t *testing.T) {
+func TestSyncOrchestrator_SetSyncSettings(t *testing.T) {
+	orchestrator := NewSyncOrchestrator(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	assert.Equal(t, domain.DefaultAppSettings().Sync, orchestrator.syncSettings)
+
+	timeouts := domain.SyncSettings{ValidateTimeoutSeconds: 5, FullSyncTimeoutSeconds: 60}
+	orchestrator.SetSyncSettings(timeouts)
+	assert.Equal(t, timeouts, orchestrator.syncSettings)
+}
+
+func TestSyncOrchestrator_Sync_ValidateDeadline(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
-	docStore := memory.NewDocumentStore()
-	exclusionStore := memory.NewExclusionStore()
 	factory := newSyncMockConnectorFactory()
-	registry := &syncMockNormaliserRegistry{}
-	searchEngine := newSyncMockSearchEngine()
-	vectorIndex := newSyncMockVectorIndex()
-	embeddingService := &syncMockEmbeddingService{
-		embedding: []float32{0.5, 0.5, 0.5},
-	}
 
 	ctx := context.Background()
 
-	// Setup source
 	source := domain.Source{ID: "src-1", Name: "Test", Type: "mock"}
 	require.NoError(t, sourceStore.Save(ctx, source))
 
-	// Setup connector
 	factory.connectors["src-1"] = &syncMockConnector{
-		sourceID: "src-1",
-		connType: "mock",
-		fullSyncDocs: []domain.RawDocument{
-			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
-		},
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsValidation: true},
+		block:        true,
 	}
 
 	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, vectorIndex, embeddingService,
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
 	)
+	orchestrator.SetSyncSettings(domain.SyncSettings{ValidateTimeoutSeconds: 1})
 
 	err := orchestrator.Sync(ctx, "src-1")
 
-	require.NoError(t, err)
-
-	// Verify vectors were indexed
-	assert.Len(t, vectorIndex.vectors, 1)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrConnectorValidation)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "validation timed out after 1s")
+	assert.True(t, factory.connectors["src-1"].closed)
 }
 
-func TestSyncOrchestrator_Sync_IncrementalSync(t *testing.T) {
+func TestSyncOrchestrator_Sync_FullSyncDeadline(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
-	docStore := memory.NewDocumentStore()
-	exclusionStore := memory.NewExclusionStore()
 	factory := newSyncMockConnectorFactory()
-	registry := &syncMockNormaliserRegistry{}
-	searchEngine := newSyncMockSearchEngine()
 
 	ctx := context.Background()
 
-	// Setup source
 	source := domain.Source{ID: "src-1", Name: "Test", Type: "mock"}
 	require.NoError(t, sourceStore.Save(ctx, source))
 
-	// Setup existing sync state with cursor (triggers incremental sync)
-	existingState := domain.SyncState{
-		SourceID: "src-1",
-		Cursor:   "cursor-123",
-		LastSync: time.Now().Add(-time.Hour),
-	}
-	require.NoError(t, syncStore.Save(ctx, existingState))
-
-	// Setup connector with incremental support
 	factory.connectors["src-1"] = &syncMockConnector{
 		sourceID: "src-1",
 		connType: "mock",
-		capabilities: driven.ConnectorCapabilities{
-			SupportsIncremental: true,
-		},
-		incSyncDocs: []domain.RawDocumentChange{
-			{
-				Type:     domain.ChangeCreated,
-				Document: domain.RawDocument{SourceID: "src-1", URI: "new.txt", MIMEType: "text/plain", Content: []byte("new content")},
-			},
-		},
+		block:    true,
 	}
 
 	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
 	)
+	orchestrator.SetSyncSettings(domain.SyncSettings{FullSyncTimeoutSeconds: 1})
 
 	err := orchestrator.Sync(ctx, "src-1")
 
-	require.NoError(t, err)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "full sync timed out after 1s")
 
-	// Verify new document was saved
-	docs, err := docStore.ListDocuments(ctx, "src-1")
+	// A sync that did not finish records only the error
+	state, err := syncStore.Get(ctx, "src-1")
 	require.NoError(t, err)
-	assert.Len(t, docs, 1)
+	assert.Contains(t, state.LastError, "full sync timed out")
+	assert.False(t, state.HasCursor())
+	assert.True(t, state.LastSync.IsZero())
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.False(t, status.Running)
 }
 
-func TestSyncOrchestrator_SyncAll_Success(t *testing.T) {
+// newQuarantineTestOrchestrator returns an orchestrator syncing a single document
+// through the given registry.
+func newQuarantineTestOrchestrator(
+	t *testing.T, registry *syncMockNormaliserRegistry,
+) (*SyncOrchestrator, *memory.ExclusionStore) {
+	t.Helper()
+
 	sourceStore := memory.NewSourceStore()
-	syncStore := memory.NewSyncStateStore()
-	docStore := memory.NewDocumentStore()
 	exclusionStore := memory.NewExclusionStore()
 	factory := newSyncMockConnectorFactory()
-	registry := &syncMockNormaliserRegistry{}
-	searchEngine := newSyncMockSearchEngine()
 
 	ctx := context.Background()
-
-	// Setup multiple sources
-	sources := []domain.Source{
-		{ID: "src-1", Name: "Source 1", Type: "mock"},
-		{ID: "src-2", Name: "Source 2", Type: "mock"},
-	}
-
-	for _, src := range sources {
-		require.NoError(t, sourceStore.Save(ctx, src))
-		factory.connectors[src.ID] = &syncMockConnector{
-			sourceID: src.ID,
-			connType: "mock",
-			fullSyncDocs: []domain.RawDocument{
-				{SourceID: src.ID, URI: "file.txt", MIMEType: "text/plain", Content: []byte("content")},
-			},
-		}
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "corrupt.pdf", MIMEType: "application/pdf", Content: []byte("%PDF")},
+		},
 	}
 
 	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), exclusionStore,
+		factory, registry, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
 	)
+	return orchestrator, exclusionStore
+}
 
-	err := orchestrator.SyncAll(ctx)
+func TestSyncOrchestrator_Sync_QuarantinesRepeatedFailures(t *testing.T) {
+	registry := &syncMockNormaliserRegistry{normaliseErr: errors.New("corrupt xref table")}
+	orchestrator, exclusionStore := newQuarantineTestOrchestrator(t, registry)
+	orchestrator.SetSyncSettings(domain.SyncSettings{QuarantineAfterFailures: 3})
+	ctx := context.Background()
 
+	for i := 0; i < 2; i++ {
+		require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	}
+	excluded, err := exclusionStore.IsExcluded(ctx, "src-1", "corrupt.pdf")
 	require.NoError(t, err)
+	assert.False(t, excluded, "should not quarantine before the threshold")
 
-	// Verify both sources were synced
-	for _, src := range sources {
-		docs, err := docStore.ListDocuments(ctx, src.ID)
-		require.NoError(t, err)
-		assert.Len(t, docs, 1)
-	}
+	// Third consecutive failure quarantines the document
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	exclusions, err := exclusionStore.GetBySourceID(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, exclusions, 1)
+	assert.True(t, exclusions[0].Quarantined)
+	assert.Equal(t, "corrupt.pdf", exclusions[0].URI)
+	assert.Contains(t, exclusions[0].Reason, "failed 3 times")
+	assert.Contains(t, exclusions[0].Reason, "corrupt xref table")
+
+	// Quarantined documents are skipped without normalising
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, 3, registry.normaliseCalls)
 }
 
-func TestSyncOrchestrator_SyncAll_NoSources(t *testing.T) {
-	sourceStore := memory.NewSourceStore()
-	syncStore := memory.NewSyncStateStore()
-	docStore := memory.NewDocumentStore()
-	exclusionStore := memory.NewExclusionStore()
+func TestSyncOrchestrator_Sync_SuccessResetsFailures(t *testing.T) {
+	registry := &syncMockNormaliserRegistry{normaliseErr: errors.New("malformed html")}
+	orchestrator, exclusionStore := newQuarantineTestOrchestrator(t, registry)
+	orchestrator.SetSyncSettings(domain.SyncSettings{QuarantineAfterFailures: 2})
+	ctx := context.Background()
 
-	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		nil, nil, nil, nil, nil, nil,
-	)
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
 
-	err := orchestrator.SyncAll(context.Background())
+	registry.normaliseErr = nil
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
 
-	// No sources means nothing to sync - should succeed
-	assert.NoError(t, err)
+	// Failures are no longer consecutive
+	registry.normaliseErr = errors.New("malformed html")
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	excluded, err := exclusionStore.IsExcluded(ctx, "src-1", "corrupt.pdf")
+	require.NoError(t, err)
+	assert.False(t, excluded)
 }
 
-func TestSyncOrchestrator_SyncAll_PartialFailure(t *testing.T) {
+func TestSyncOrchestrator_Sync_UnsupportedTypeNotQuarantined(t *testing.T) {
+	registry := &syncMockNormaliserRegistry{normaliseErr: fmt.Errorf("no normaliser: %w", domain.ErrNotImplemented)}
+	orchestrator, exclusionStore := newQuarantineTestOrchestrator(t, registry)
+	orchestrator.SetSyncSettings(domain.SyncSettings{QuarantineAfterFailures: 1})
+	ctx := context.Background()
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	excluded, err := exclusionStore.IsExcluded(ctx, "src-1", "corrupt.pdf")
+	require.NoError(t, err)
+	assert.False(t, excluded)
+}
+
+func TestSyncOrchestrator_Sync_SkipsUnsupportedTypes(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
 	docStore := memory.NewDocumentStore()
-	exclusionStore := memory.NewExclusionStore()
 	factory := newSyncMockConnectorFactory()
-	registry := &syncMockNormaliserRegistry{}
-	searchEngine := newSyncMockSearchEngine()
-
 	ctx := context.Background()
-
-	// Setup sources - one will fail
-	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Good", Type: "mock"}))
-	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-2", Name: "Bad", Type: "mock"}))
-
-	factory.connectors["src-1"] = &syncMockConnector{
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	connector := &syncMockConnector{
 		sourceID: "src-1",
 		connType: "mock",
 		fullSyncDocs: []domain.RawDocument{
-			{SourceID: "src-1", URI: "file.txt", MIMEType: "text/plain", Content: []byte("content")},
+			{SourceID: "src-1", URI: "a.bin", MIMEType: "application/octet-stream"},
+			{SourceID: "src-1", URI: "b.bin", MIMEType: "application/octet-stream"},
+			{SourceID: "src-1", URI: "c.xyz", MIMEType: "chemical/x-xyz"},
+			{SourceID: "src-1", URI: "notes.txt", MIMEType: "text/plain", Content: []byte("content")},
 		},
 	}
-	factory.connectors["src-2"] = &syncMockConnector{
-		sourceID:    "src-2",
-		connType:    "mock",
-		fullSyncErr: errors.New("connector error"),
-	}
-
+	factory.connectors["src-1"] = connector
+	registry := &syncMockNormaliserRegistry{unsupported: []string{"application/octet-stream", "chemical/x-xyz"}}
 	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+		sourceStore, syncStore, docStore, memory.NewExclusionStore(),
+		factory, registry, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
 	)
+	orchestrator.SetSyncPolicy(domain.SyncPolicyStrict)
 
-	err := orchestrator.SyncAll(ctx)
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
 
-	// Should return error for failed source
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "src-2")
+	// The connector can check types before fetching content
+	require.NotNil(t, connector.contentTypeFilter)
+	assert.False(t, connector.contentTypeFilter("application/octet-stream"))
+	assert.True(t, connector.contentTypeFilter("text/plain"))
 
-	// But first source should have succeeded
-	docs, _ := docStore.ListDocuments(ctx, "src-1")
+	// Unsupported documents are never normalised or stored
+	assert.Equal(t, 1, registry.normaliseCalls)
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
 	assert.Len(t, docs, 1)
+
+	// They are summarised in one entry of the recent errors
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, state.RecentErrors, 1)
+	assert.Equal(t, domain.SyncPhaseSkipped, state.RecentErrors[0].Phase)
+	assert.Equal(t,
+		"3 documents skipped: no normaliser for application/octet-stream (2), chemical/x-xyz (1)",
+		state.RecentErrors[0].Message)
 }
 
-func TestSyncOrchestrator_Status_NotRunning(t *testing.T) {
+func TestSyncOrchestrator_Sync_AppendsPartialDocuments(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
 	docStore := memory.NewDocumentStore()
-	exclusionStore := memory.NewExclusionStore()
-
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{
+		ID: "issue-1", SourceID: "src-1", URI: "issue/1", Content: "issue body",
+	}))
+	partial := map[string]any{domain.MetadataPartial: true}
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "issue/1", MIMEType: "text/plain", Content: []byte(" new comment"), Metadata: partial},
+			{SourceID: "src-1", URI: "issue/2", MIMEType: "text/plain", Content: []byte("comment"), Metadata: partial},
+		},
+	}
+	registry := &syncMockNormaliserRegistry{}
 	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		nil, nil, nil, nil, nil, nil,
+		sourceStore, syncStore, docStore, memory.NewExclusionStore(),
+		factory, registry, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
 	)
 
-	status, err := orchestrator.Status(context.Background(), "src-1")
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
 
+	// The stored document is extended in place
+	assert.Equal(t, 1, registry.appendCalls)
+	doc, err := docStore.GetDocument(ctx, "issue-1")
 	require.NoError(t, err)
-	assert.NotNil(t, status)
-	assert.Equal(t, "src-1", status.SourceID)
-	assert.False(t, status.Running)
-}
+	assert.Equal(t, "issue body new comment", doc.Content)
+	assert.False(t, domain.IsPartial(doc.Metadata))
 
-func TestSyncOrchestrator_Status_WhileRunning(t *testing.T) {
-	sourceStore := memory.NewSourceStore()
+	// Without a stored document the partial content is normalised on its own
+	assert.Equal(t, 1, registry.normaliseCalls)
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, docs, 2)
+}
+
+func TestSkippedSummary(t *testing.T) {
+	entry := skippedSummary(map[string]int{"image/png": 1})
+
+	assert.Equal(t, domain.SyncPhaseSkipped, entry.Phase)
+	assert.Equal(t, "1 document skipped: no normaliser for image/png (1)", entry.Message)
+	assert.False(t, entry.Timestamp.IsZero())
+}
+
+func TestSyncOrchestrator_Sync_StrictPolicy(t *testing.T) {
+	newOrchestrator := func(registry *syncMockNormaliserRegistry) (*SyncOrchestrator, *memory.SyncStateStore) {
+		sourceStore := memory.NewSourceStore()
+		syncStore := memory.NewSyncStateStore()
+		factory := newSyncMockConnectorFactory()
+		require.NoError(t, sourceStore.Save(context.Background(), domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+		factory.connectors["src-1"] = &syncMockConnector{
+			sourceID: "src-1",
+			connType: "mock",
+			fullSyncDocs: []domain.RawDocument{
+				{SourceID: "src-1", URI: "bad.pdf", MIMEType: "application/pdf", Content: []byte("%PDF")},
+				{SourceID: "src-1", URI: "good.txt", MIMEType: "text/plain", Content: []byte("content")},
+			},
+		}
+		orchestrator := NewSyncOrchestrator(
+			sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+			factory, registry, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+		)
+		return orchestrator, syncStore
+	}
+
+	t.Run("continue policy skips failed documents", func(t *testing.T) {
+		registry := &syncMockNormaliserRegistry{normaliseErr: errors.New("corrupt xref table")}
+		orchestrator, syncStore := newOrchestrator(registry)
+		ctx := context.Background()
+
+		require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+		assert.Equal(t, 2, registry.normaliseCalls)
+
+		// Skipped documents are kept in the recent errors of a successful sync
+		state, err := syncStore.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Empty(t, state.LastError)
+		require.Len(t, state.RecentErrors, 2)
+		assert.Equal(t, domain.SyncPhaseDocument, state.RecentErrors[0].Phase)
+		assert.Contains(t, state.RecentErrors[0].Message, "bad.pdf: ")
+		assert.Contains(t, state.RecentErrors[1].Message, "good.txt: ")
+		assert.False(t, state.RecentErrors[0].Timestamp.IsZero())
+
+		// A second sync adds to the log
+		require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+		state, err = syncStore.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.Len(t, state.RecentErrors, 4)
+	})
+
+	t.Run("strict policy aborts at the first failure", func(t *testing.T) {
+		registry := &syncMockNormaliserRegistry{normaliseErr: errors.New("corrupt xref table")}
+		orchestrator, syncStore := newOrchestrator(registry)
+		orchestrator.SetSyncPolicy(domain.SyncPolicyStrict)
+		ctx := context.Background()
+
+		err := orchestrator.Sync(ctx, "src-1")
+
+		require.ErrorIs(t, err, domain.ErrSyncAborted)
+		assert.Contains(t, err.Error(), "after 0 documents")
+		assert.Contains(t, err.Error(), "bad.pdf")
+		assert.Contains(t, err.Error(), "corrupt xref table")
+		assert.Equal(t, 1, registry.normaliseCalls)
+
+		// The failure is recorded without saving a cursor
+		state, err := syncStore.Get(ctx, "src-1")
+		require.NoError(t, err)
+		assert.False(t, state.HasCursor())
+		assert.Contains(t, state.LastError, "sync aborted")
+		require.Len(t, state.RecentErrors, 2)
+		assert.Equal(t, domain.SyncPhaseDocument, state.RecentErrors[0].Phase)
+		assert.Equal(t, domain.SyncPhaseSync, state.RecentErrors[1].Phase)
+		assert.Equal(t, state.LastError, state.RecentErrors[1].Message)
+	})
+
+	t.Run("strict policy skips unsupported types", func(t *testing.T) {
+		registry := &syncMockNormaliserRegistry{normaliseErr: fmt.Errorf("no normaliser: %w", domain.ErrNotImplemented)}
+		orchestrator, _ := newOrchestrator(registry)
+		orchestrator.SetSyncPolicy(domain.SyncPolicyStrict)
+
+		require.NoError(t, orchestrator.Sync(context.Background(), "src-1"))
+		assert.Equal(t, 2, registry.normaliseCalls)
+	})
+}
+
+func TestDeadlineError(t *testing.T) {
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-expired.Done()
+
+	tests := []struct {
+		name    string
+		parent  context.Context
+		err     error
+		wantMsg string
+	}{
+		{"nil error", context.Background(), nil, ""},
+		{"other error", context.Background(), errors.New("boom"), "boom"},
+		{"own deadline", context.Background(), context.DeadlineExceeded, "sync timed out after 1m0s: context deadline exceeded"},
+		{"parent deadline", expired, context.DeadlineExceeded, "context deadline exceeded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := deadlineError(tt.parent, tt.err, "sync", time.Minute)
+			if tt.wantMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantMsg)
+		})
+	}
+}
+
+func TestSyncOrchestrator_Sync_WithExclusions(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+	registry := &syncMockNormaliserRegistry{}
+	searchEngine := newSyncMockSearchEngine()
+
+	ctx := context.Background()
+
+	// Setup source
+	source := domain.Source{ID: "src-1", Name: "Test", Type: "mock"}
+	require.NoError(t, sourceStore.Save(ctx, source))
+
+	// Setup connector with documents
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
+			{SourceID: "src-1", URI: "file2.txt", MIMEType: "text/plain", Content: []byte("content 2")},
+			{SourceID: "src-1", URI: "excluded.txt", MIMEType: "text/plain", Content: []byte("excluded")},
+		},
+	}
+
+	// Add exclusion for one file
+	exclusion := &domain.Exclusion{
+		ID:         "exc-1",
+		SourceID:   "src-1",
+		DocumentID: "doc-excluded",
+		URI:        "excluded.txt",
+		Reason:     "test exclusion",
+	}
+	require.NoError(t, exclusionStore.Add(ctx, exclusion))
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+	)
+
+	err := orchestrator.Sync(ctx, "src-1")
+
+	require.NoError(t, err)
+
+	// Verify only non-excluded documents were saved
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, docs, 2) // Only 2 docs, excluded one skipped
+}
+
+func TestSyncOrchestrator_Sync_WithEmbeddings(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+	registry := &syncMockNormaliserRegistry{}
+	searchEngine := newSyncMockSearchEngine()
+	vectorIndex := newSyncMockVectorIndex()
+	embeddingService := &syncMockEmbeddingService{
+		embedding: []float32{0.5, 0.5, 0.5},
+	}
+
+	ctx := context.Background()
+
+	// Setup source
+	source := domain.Source{ID: "src-1", Name: "Test", Type: "mock"}
+	require.NoError(t, sourceStore.Save(ctx, source))
+
+	// Setup connector
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, vectorIndex, embeddingService,
+	)
+
+	err := orchestrator.Sync(ctx, "src-1")
+
+	require.NoError(t, err)
+
+	// Verify vectors were indexed
+	assert.Len(t, vectorIndex.vectors, 1)
+}
+
+func TestSyncOrchestrator_Sync_QueuesEmbeddings(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	vectorIndex := newSyncMockVectorIndex()
+	embeddingService := &syncMockEmbeddingService{err: errors.New("embedding must not run during sync")}
+	queue := newMockEmbeddingJobStore()
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, embeddingService,
+	)
+	orchestrator.SetEmbeddingQueue(queue)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	// Chunks are saved and queued; vectors wait for the embedding worker
+	assert.Empty(t, vectorIndex.vectors)
+	statuses := queue.statuses()
+	require.Len(t, statuses, 1)
+	for chunkID, status := range statuses {
+		assert.Equal(t, domain.EmbeddingJobPending, status)
+		chunk, err := docStore.GetChunk(ctx, chunkID)
+		require.NoError(t, err)
+		assert.Nil(t, chunk.Embedding)
+	}
+}
+
+func TestSyncOrchestrator_Sync_MetadataOnly(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	registry := &syncMockNormaliserRegistry{}
+	searchEngine := newSyncMockSearchEngine()
+	embeddingService := &syncMockEmbeddingService{err: errors.New("metadata-only documents must not be embedded")}
+	queue := newMockEmbeddingJobStore()
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{
+				SourceID: "src-1",
+				URI:      "/docs/report.pdf",
+				MIMEType: "application/pdf",
+				Metadata: map[string]any{"filename": "report.pdf", domain.MetadataMetadataOnly: true},
+			},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, registry, &syncMockPostProcessorPipeline{},
+		searchEngine, newSyncMockVectorIndex(), embeddingService,
+	)
+	orchestrator.SetEmbeddingQueue(queue)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	// The normaliser is bypassed and nothing is queued for embedding
+	assert.Zero(t, registry.normaliseCalls)
+	assert.Empty(t, queue.statuses())
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "report.pdf", docs[0].Title)
+	assert.Empty(t, docs[0].Content)
+	assert.True(t, domain.IsMetadataOnly(docs[0].Metadata))
+
+	// A single name chunk is indexed for keyword search
+	chunks, err := docStore.GetChunks(ctx, docs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Contains(t, chunks[0].Content, "report.pdf")
+	assert.Nil(t, chunks[0].Embedding)
+	assert.Len(t, searchEngine.indexed, 1)
+}
+
+func TestSyncOrchestrator_Sync_Author(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	searchEngine := newSyncMockSearchEngine()
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{
+				SourceID: "src-1",
+				URI:      "/docs/plan.md",
+				MIMEType: "text/markdown",
+				Content:  []byte("the plan"),
+				Metadata: map[string]any{
+					domain.MetadataAuthorName: "Alice Smith",
+					domain.MetadataAuthorID:   "alice@example.com",
+				},
+			},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		searchEngine, nil, nil,
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	// The connector's author is kept when the normaliser sets none
+	author := domain.Author{Name: "Alice Smith", Identifier: "alice@example.com"}
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, author, docs[0].Author)
+
+	// Indexed chunks carry the title and author for the search index
+	require.NotEmpty(t, searchEngine.indexed)
+	for _, chunk := range searchEngine.indexed {
+		assert.Equal(t, author, domain.AuthorFromMetadata(chunk.Metadata))
+		assert.Equal(t, "/docs/plan.md", chunk.Metadata[domain.MetadataDocumentTitle])
+	}
+}
+
+func TestTagChunks_Language(t *testing.T) {
+	doc := &domain.Document{Metadata: map[string]any{domain.MetadataLanguage: "de"}}
+	chunks := []domain.Chunk{{ID: "c1"}, {ID: "c2", Metadata: map[string]any{"k": "v"}}}
+
+	tagChunks(doc, chunks)
+
+	for _, chunk := range chunks {
+		assert.Equal(t, "de", domain.DocumentLanguage(chunk.Metadata))
+		assert.NotContains(t, chunk.Metadata, domain.MetadataDocumentTitle)
+	}
+	assert.Equal(t, "v", chunks[1].Metadata["k"])
+}
+
+func TestSyncOrchestrator_Sync_IncrementalSync(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+	registry := &syncMockNormaliserRegistry{}
+	searchEngine := newSyncMockSearchEngine()
+
+	ctx := context.Background()
+
+	// Setup source
+	source := domain.Source{ID: "src-1", Name: "Test", Type: "mock"}
+	require.NoError(t, sourceStore.Save(ctx, source))
+
+	// Setup existing sync state with cursor (triggers incremental sync)
+	existingState := domain.SyncState{
+		SourceID: "src-1",
+		Cursor:   "cursor-123",
+		LastSync: time.Now().Add(-time.Hour),
+	}
+	require.NoError(t, syncStore.Save(ctx, existingState))
+
+	// Setup connector with incremental support
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		capabilities: driven.ConnectorCapabilities{
+			SupportsIncremental: true,
+		},
+		incSyncDocs: []domain.RawDocumentChange{
+			{
+				Type:     domain.ChangeCreated,
+				Document: domain.RawDocument{SourceID: "src-1", URI: "new.txt", MIMEType: "text/plain", Content: []byte("new content")},
+			},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+	)
+
+	err := orchestrator.Sync(ctx, "src-1")
+
+	require.NoError(t, err)
+
+	// Verify new document was saved
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, docs, 1)
+}
+
+func TestSyncOrchestrator_Sync_SubCursors(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	factory := newSyncMockConnectorFactory()
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+
+	connector := &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true, SupportsCursorReturn: true},
+		complete: &driven.SyncComplete{
+			NewSubCursors: map[string]string{"org/a": "a1", "org/b": "b1"},
+		},
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	// Full sync: sub-cursors are saved without a fallback cursor
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, state.Cursor)
+	assert.Equal(t, map[string]string{"org/a": "a1", "org/b": "b1"}, state.SubCursors)
+
+	// Sub-cursors alone trigger an incremental sync and are replaced by the new set
+	connector.complete = &driven.SyncComplete{NewSubCursors: map[string]string{"org/a": "a2"}}
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, map[string]string{"org/a": "a1", "org/b": "b1"}, connector.incState.SubCursors)
+
+	state, err = syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"org/a": "a2"}, state.SubCursors)
+}
+
+func TestSyncOrchestrator_Sync_ConnectorVersionChanged(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	factory.version = "2.0.0"
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+
+	// Cursors saved by version 1 must not reach the version 2 connector
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{
+		SourceID:         "src-1",
+		Cursor:           "v1-cursor",
+		SubCursors:       map[string]string{"org/a": "v1"},
+		ConnectorVersion: "1.0.0",
+		RecentErrors:     []domain.SyncErrorLog{{Message: "earlier failure"}},
+	}))
+
+	connector := &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true, SupportsCursorReturn: true},
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "a.txt", MIMEType: "text/plain", Content: []byte("content")},
+		},
+		complete: &driven.SyncComplete{NewCursor: "v2-cursor"},
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	// The mismatch triggers a full sync
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Empty(t, connector.incState.SourceID, "incremental sync should not run")
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, docs, 1)
+
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "v2-cursor", state.Cursor)
+	assert.Empty(t, state.SubCursors)
+	assert.Equal(t, "2.0.0", state.ConnectorVersion)
+	require.Len(t, state.RecentErrors, 1)
+
+	// With matching versions the next sync is incremental again
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, "v2-cursor", connector.incState.Cursor)
+}
+
+func TestPendingResult(t *testing.T) {
+	// The cursor was sent before the connector closed its channels
+	errs := make(chan error, 1)
+	errs <- &driven.SyncComplete{NewCursor: "cursor-2"}
+	close(errs)
+	result, err := pendingResult(errs, driven.SyncComplete{})
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-2", result.NewCursor)
+
+	// A failure sent before closing is not mistaken for success
+	errs = make(chan error, 1)
+	errs <- errors.New("rate limited")
+	_, err = pendingResult(errs, driven.SyncComplete{})
+	assert.ErrorContains(t, err, "rate limited")
+
+	// Nothing pending keeps the result seen so far
+	result, err = pendingResult(nil, driven.SyncComplete{NewCursor: "cursor-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-1", result.NewCursor)
+}
+
+func TestSyncOrchestrator_Sync_UnversionedStateKeepsCursor(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	factory := newSyncMockConnectorFactory()
+	factory.version = "2.0.0"
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+
+	// States saved before versioning are left to the connector to migrate
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "legacy-cursor"}))
+
+	connector := &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true, SupportsCursorReturn: true},
+		complete:     &driven.SyncComplete{NewCursor: "next-cursor"},
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, "legacy-cursor", connector.incState.Cursor)
+
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", state.ConnectorVersion)
+}
+
+func TestSyncOrchestrator_SyncSince(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	factory := newSyncMockConnectorFactory()
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+
+	connector := &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true, SupportsCursorReturn: true},
+		complete: &driven.SyncComplete{
+			NewCursor:     "cursor-1",
+			NewSubCursors: map[string]string{"org/a": "a1"},
+		},
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	since := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// Without a stored cursor --since falls back to a full sync
+	require.NoError(t, orchestrator.SyncSince(ctx, "src-1", since))
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-1", state.Cursor)
+
+	// The override reaches the connector but the stored cursor is kept
+	connector.complete = &driven.SyncComplete{NewCursor: "older", NewSubCursors: map[string]string{"org/a": "a0"}}
+	require.NoError(t, orchestrator.SyncSince(ctx, "src-1", since))
+	assert.Equal(t, since, connector.incState.Since)
+	assert.Equal(t, "cursor-1", connector.incState.Cursor)
+
+	state, err = syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-1", state.Cursor)
+	assert.Equal(t, map[string]string{"org/a": "a1"}, state.SubCursors)
+	assert.True(t, state.Since.IsZero())
+
+	// A normal sync passes no override and advances the cursor
+	connector.complete = &driven.SyncComplete{NewCursor: "cursor-2"}
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.True(t, connector.incState.Since.IsZero())
+	state, err = syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-2", state.Cursor)
+}
+
+func TestSyncOrchestrator_SyncScope(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	factory := newSyncMockConnectorFactory()
+
+	ctx := context.Background()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{
+		SourceID:   "src-1",
+		Cursor:     "header-1",
+		SubCursors: map[string]string{"org/a": "a1", "org/b": "b1"},
+	}))
+
+	connector := &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true, SupportsCursorReturn: true},
+		complete: &driven.SyncComplete{
+			NewCursor:     "header-2",
+			NewSubCursors: map[string]string{"org/a": "a2", "org/b": "b2"},
+		},
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	require.NoError(t, orchestrator.SyncScope(ctx, "src-1", []string{"org/a"}))
+	assert.Equal(t, []string{"org/a"}, connector.incState.Scope)
+
+	// Only the cursor in scope moves
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "header-1", state.Cursor)
+	assert.Equal(t, map[string]string{"org/a": "a2", "org/b": "b1"}, state.SubCursors)
+	assert.Empty(t, state.Scope)
+
+	// A normal sync passes no scope
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Empty(t, connector.incState.Scope)
+
+	err = orchestrator.SyncScope(ctx, "src-1", nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestScopedSubCursors_MixedCase(t *testing.T) {
+	stored := map[string]string{"Org/Repo": "r1", "org/other": "o1", "org/Stale": "s1"}
+	synced := map[string]string{"Org/Repo": "r2", "org/other": "o2", "org/stale": "s2"}
+
+	merged := scopedSubCursors(stored, synced, []string{"org/repo", "ORG/STALE"})
+
+	assert.Equal(t, map[string]string{"Org/Repo": "r2", "org/other": "o1", "org/stale": "s2"}, merged)
+}
+
+func TestSyncOrchestrator_SyncAllSince(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	factory := newSyncMockConnectorFactory()
+
+	ctx := context.Background()
+	since := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	for _, id := range []string{"src-1", "src-2"} {
+		require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: id, Name: id, Type: "mock"}))
+		require.NoError(t, syncStore.Save(ctx, domain.SyncState{SourceID: id, Cursor: "cursor-" + id}))
+		factory.connectors[id] = &syncMockConnector{
+			sourceID:     id,
+			connType:     "mock",
+			capabilities: driven.ConnectorCapabilities{SupportsIncremental: true},
+		}
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	require.NoError(t, orchestrator.SyncAllSince(ctx, since))
+
+	for _, id := range []string{"src-1", "src-2"} {
+		assert.Equal(t, since, factory.connectors[id].incState.Since, id)
+	}
+}
+
+func TestSyncOrchestrator_SyncAll_Success(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+	registry := &syncMockNormaliserRegistry{}
+	searchEngine := newSyncMockSearchEngine()
+
+	ctx := context.Background()
+
+	// Setup multiple sources
+	sources := []domain.Source{
+		{ID: "src-1", Name: "Source 1", Type: "mock"},
+		{ID: "src-2", Name: "Source 2", Type: "mock"},
+	}
+
+	for _, src := range sources {
+		require.NoError(t, sourceStore.Save(ctx, src))
+		factory.connectors[src.ID] = &syncMockConnector{
+			sourceID: src.ID,
+			connType: "mock",
+			fullSyncDocs: []domain.RawDocument{
+				{SourceID: src.ID, URI: "file.txt", MIMEType: "text/plain", Content: []byte("content")},
+			},
+		}
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+	)
+
+	err := orchestrator.SyncAll(ctx)
+
+	require.NoError(t, err)
+
+	// Verify both sources were synced
+	for _, src := range sources {
+		docs, err := docStore.ListDocuments(ctx, src.ID)
+		require.NoError(t, err)
+		assert.Len(t, docs, 1)
+	}
+}
+
+func TestSyncOrchestrator_SyncAll_SkipsArchivedSources(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+
+	sources := []domain.Source{
+		{ID: "src-1", Name: "Source 1", Type: "mock"},
+		{ID: "src-2", Name: "Source 2", Type: "mock", Archived: true},
+	}
+	for _, src := range sources {
+		require.NoError(t, sourceStore.Save(ctx, src))
+		factory.connectors[src.ID] = &syncMockConnector{
+			sourceID: src.ID,
+			connType: "mock",
+			fullSyncDocs: []domain.RawDocument{
+				{SourceID: src.ID, URI: "file.txt", MIMEType: "text/plain", Content: []byte("content")},
+			},
+		}
+	}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	require.NoError(t, orchestrator.SyncAll(ctx))
+
+	count, err := docStore.CountBySource(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = docStore.CountBySource(ctx, "src-2")
+	require.NoError(t, err)
+	assert.Zero(t, count, "archived sources are not synced")
+
+	// An archived source can still be synced on its own
+	require.NoError(t, orchestrator.Sync(ctx, "src-2"))
+	count, err = docStore.CountBySource(ctx, "src-2")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestSyncOrchestrator_SyncAll_NoSources(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		nil, nil, nil, nil, nil, nil,
+	)
+
+	err := orchestrator.SyncAll(context.Background())
+
+	// No sources means nothing to sync - should succeed
+	assert.NoError(t, err)
+}
+
+func TestSyncOrchestrator_SyncAll_PartialFailure(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+	registry := &syncMockNormaliserRegistry{}
+	searchEngine := newSyncMockSearchEngine()
+
+	ctx := context.Background()
+
+	// Setup sources - one will fail
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Good", Type: "mock"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-2", Name: "Bad", Type: "mock"}))
+
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "file.txt", MIMEType: "text/plain", Content: []byte("content")},
+		},
+	}
+	factory.connectors["src-2"] = &syncMockConnector{
+		sourceID:    "src-2",
+		connType:    "mock",
+		fullSyncErr: errors.New("connector error"),
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		factory, registry, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+	)
+
+	err := orchestrator.SyncAll(ctx)
+
+	// Should return error for failed source
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "src-2")
+
+	// But first source should have succeeded
+	docs, _ := docStore.ListDocuments(ctx, "src-1")
+	assert.Len(t, docs, 1)
+}
+
+func TestSyncOrchestrator_Sync_RecordsLastError(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	connector := &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		complete: &driven.SyncComplete{NewCursor: "cursor-1"},
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	synced, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, synced.LastError)
+
+	// A failed sync records the error but keeps the last good state
+	connector.fullSyncErr = errors.New("token expired")
+	require.Error(t, orchestrator.Sync(ctx, "src-1"))
+	failed, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Contains(t, failed.LastError, "token expired")
+	assert.Equal(t, "cursor-1", failed.Cursor)
+	assert.Equal(t, synced.LastSync, failed.LastSync)
+
+	// The next successful sync clears it
+	connector.fullSyncErr = nil
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	recovered, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, recovered.LastError)
+}
+
+func TestSyncOrchestrator_Sync_RecordsErrorWithoutPriorState(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID:    "src-1",
+		connType:    "mock",
+		fullSyncErr: errors.New("connector error"),
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	require.Error(t, orchestrator.Sync(ctx, "src-1"))
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Contains(t, state.LastError, "connector error")
+	assert.True(t, state.LastSync.IsZero())
+	assert.False(t, state.HasCursor())
+}
+
+func TestSyncOrchestrator_Status_NotRunning(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		nil, nil, nil, nil, nil, nil,
+	)
+
+	status, err := orchestrator.Status(context.Background(), "src-1")
+
+	require.NoError(t, err)
+	assert.NotNil(t, status)
+	assert.Equal(t, "src-1", status.SourceID)
+	assert.False(t, status.Running)
+}
+
+func TestSyncOrchestrator_Status_WhileRunning(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
 	docStore := memory.NewDocumentStore()
 	exclusionStore := memory.NewExclusionStore()
@@ -864,3 +1950,73 @@ func TestSyncOrchestrator_ProcessChanges_Delete(t *testing.T) {
 	// Verify search index was cleaned
 	assert.Len(t, searchEngine.indexed, 0)
 }
+
+func TestSyncOrchestrator_FindDocumentsByURI_AcrossPages(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	for i := range documentPageSize + 2 {
+		doc := &domain.Document{ID: fmt.Sprintf("doc-%d", i), SourceID: "src-1", URI: fmt.Sprintf("file-%d.txt", i)}
+		require.NoError(t, docStore.SaveDocument(ctx, doc))
+	}
+	orchestrator := NewSyncOrchestrator(nil, nil, docStore, nil, nil, nil, nil, nil, nil, nil)
+
+	docs, err := orchestrator.findDocumentsByURI(ctx, "src-1", fmt.Sprintf("file-%d.txt", documentPageSize+1))
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, fmt.Sprintf("doc-%d", documentPageSize+1), docs[0].ID)
+
+	docs, err = orchestrator.findDocumentsByURI(ctx, "src-1", "missing.txt")
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+}
+
+func TestSyncOrchestrator_DeleteDocumentByURI_RemovesNestedDocuments(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	for id, uri := range map[string]string{
+		"msg":     "gmail://messages/m1",
+		"attach":  "gmail://messages/m1/attachments/report.pdf",
+		"sibling": "gmail://messages/m10",
+	} {
+		require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: id, SourceID: "src-1", URI: uri}))
+	}
+	orchestrator := NewSyncOrchestrator(
+		nil, nil, docStore, nil, nil, nil, nil, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	require.NoError(t, orchestrator.deleteDocumentByURI(ctx, "src-1", "gmail://messages/m1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "sibling", docs[0].ID)
+}
+
+func TestSyncOrchestrator_Wait(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	connector := &syncMockConnector{fullSyncDocs: rawDocuments(2), hangAfterDocs: true}
+	orchestrator := newBatchOrchestrator(t, connector, docStore)
+
+	require.NoError(t, orchestrator.Wait(context.Background()), "no syncs are running")
+
+	syncCtx, cancelSync := context.WithCancel(context.Background())
+	syncErr := make(chan error, 1)
+	go func() { syncErr <- orchestrator.Sync(syncCtx, "src-1") }()
+	require.Eventually(t, func() bool {
+		status, err := orchestrator.Status(context.Background(), "src-1")
+		return err == nil && status.Running
+	}, time.Second, 10*time.Millisecond)
+
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelWait()
+	assert.ErrorIs(t, orchestrator.Wait(waitCtx), context.DeadlineExceeded)
+
+	cancelSync()
+	require.NoError(t, orchestrator.Wait(context.Background()))
+	assert.ErrorIs(t, <-syncErr, context.Canceled)
+
+	// The buffered documents were flushed before the sync returned
+	count, err := docStore.CountBySource(context.Background(), "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
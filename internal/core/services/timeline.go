@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure TimelineService implements the interface.
+var _ driving.TimelineService = (*TimelineService)(nil)
+
+// TimelineService lists recently updated documents across sources.
+type TimelineService struct {
+	docStore    driven.DocumentStore
+	sourceStore driven.SourceStore
+}
+
+// NewTimelineService creates a new timeline service.
+func NewTimelineService(docStore driven.DocumentStore, sourceStore driven.SourceStore) *TimelineService {
+	return &TimelineService{
+		docStore:    docStore,
+		sourceStore: sourceStore,
+	}
+}
+
+// Timeline returns the documents last updated within the options' window,
+// most recently updated first, tagged with their source's name and type.
+func (s *TimelineService) Timeline(
+	ctx context.Context, opts domain.TimelineOptions,
+) ([]domain.TimelineEntry, error) {
+	if s.docStore == nil || s.sourceStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	if !opts.Since.IsZero() && !opts.Until.IsZero() && !opts.Until.After(opts.Since) {
+		return nil, fmt.Errorf("timeline window ends before it starts: %w", domain.ErrInvalidInput)
+	}
+
+	docs, err := s.docStore.ListRecentDocuments(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("list recent documents: %w", err)
+	}
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+	byID := make(map[string]domain.Source, len(sources))
+	for _, source := range sources {
+		byID[source.ID] = source
+	}
+
+	entries := make([]domain.TimelineEntry, len(docs))
+	for i := range docs {
+		entries[i] = domain.TimelineEntry{Document: docs[i], SourceName: docs[i].SourceID}
+		if source, ok := byID[docs[i].SourceID]; ok {
+			entries[i].SourceName = source.Name
+			entries[i].SourceType = source.Type
+		}
+	}
+	return entries, nil
+}
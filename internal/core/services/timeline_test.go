@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestTimelineService_Timeline(t *testing.T) {
+	ctx := context.Background()
+	docStore := memory.NewDocumentStore()
+	sourceStore := memory.NewSourceStore()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "gh", Type: "github", Name: "Work repos"}))
+
+	now := time.Now()
+	docs := []domain.Document{
+		{ID: "old", SourceID: "gh", Title: "Old", UpdatedAt: now.Add(-48 * time.Hour)},
+		{ID: "new", SourceID: "gh", Title: "New", UpdatedAt: now.Add(-time.Hour)},
+		{ID: "orphan", SourceID: "gone", Title: "Orphan", UpdatedAt: now.Add(-2 * time.Hour)},
+	}
+	require.NoError(t, docStore.SaveBatch(ctx, docs, nil))
+
+	service := NewTimelineService(docStore, sourceStore)
+
+	entries, err := service.Timeline(ctx, domain.TimelineOptions{Since: now.Add(-24 * time.Hour)})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "new", entries[0].Document.ID)
+	assert.Equal(t, "Work repos", entries[0].SourceName)
+	assert.Equal(t, "github", entries[0].SourceType)
+
+	// Documents of removed sources fall back to the source ID
+	assert.Equal(t, "orphan", entries[1].Document.ID)
+	assert.Equal(t, "gone", entries[1].SourceName)
+	assert.Empty(t, entries[1].SourceType)
+}
+
+func TestTimelineService_InvalidWindow(t *testing.T) {
+	service := NewTimelineService(memory.NewDocumentStore(), memory.NewSourceStore())
+	now := time.Now()
+
+	_, err := service.Timeline(context.Background(), domain.TimelineOptions{Since: now, Until: now.Add(-time.Hour)})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestTimelineService_NilStores(t *testing.T) {
+	service := NewTimelineService(nil, nil)
+
+	_, err := service.Timeline(context.Background(), domain.TimelineOptions{})
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
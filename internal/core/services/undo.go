@@ -0,0 +1,245 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// SetChangeLogStore sets the store for the change logs that let the last
+// sync of a source be undone. Without it, syncs are not logged.
+func (o *SyncOrchestrator) SetChangeLogStore(store driven.SyncChangeLogStore) {
+	o.changeLogStore = store
+}
+
+// UndoLastSync reverses the most recent sync of a source. Documents it
+// created are deleted, documents it updated or deleted are restored as they
+// were, and the sync position is moved back. The change log is removed
+// afterwards, so only one sync can be undone. Originals kept for restored
+// documents are not brought back.
+func (o *SyncOrchestrator) UndoLastSync(ctx context.Context, sourceID string) (*domain.SyncUndoResult, error) {
+	o.running.Add(1)
+	defer o.running.Done()
+
+	if o.changeLogStore == nil {
+		return nil, fmt.Errorf("undo sync: %w", domain.ErrNotImplemented)
+	}
+	// Restored documents would have no keyword index entries
+	if o.searchIndex == nil {
+		return nil, fmt.Errorf("undo sync of source %s: %w", sourceID, domain.ErrSearchUnavailable)
+	}
+	// Hold the source's sync slot so no sync interleaves with the undo
+	if err := o.claimSync(sourceID, &driving.SyncStatus{SourceID: sourceID, Running: true}); err != nil {
+		return nil, fmt.Errorf("undo sync: %w", err)
+	}
+	defer o.clearStatus(sourceID)
+
+	log, err := o.changeLogStore.Get(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("no sync of source %s to undo: %w", sourceID, err)
+		}
+		return nil, fmt.Errorf("get change log: %w", err)
+	}
+	if log.Truncated {
+		return nil, fmt.Errorf("%w: the sync of source %s changed more than %d documents",
+			domain.ErrSyncNotUndoable, sourceID, domain.MaxSyncChangeLogEntries)
+	}
+
+	result := &domain.SyncUndoResult{StartedAt: log.StartedAt}
+
+	// Reverse the changes newest first
+	for i := len(log.Changes) - 1; i >= 0; i-- {
+		change := &log.Changes[i]
+		current, err := o.docStore.GetDocument(ctx, change.DocumentID)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return result, fmt.Errorf("get document: %w", err)
+		}
+		if current != nil {
+			if err := o.deleteDocument(ctx, current); err != nil {
+				return result, err
+			}
+		}
+
+		if change.Kind == domain.SyncChangeCreated {
+			if current != nil {
+				result.Removed++
+			}
+			continue
+		}
+		if change.Previous == nil {
+			continue
+		}
+		if err := o.restoreDocument(ctx, change); err != nil {
+			return result, err
+		}
+		result.Restored++
+	}
+
+	if err := o.revertSyncState(ctx, sourceID, log.PreviousState); err != nil {
+		return result, err
+	}
+	if err := o.changeLogStore.Delete(ctx, sourceID); err != nil {
+		return result, fmt.Errorf("delete change log: %w", err)
+	}
+
+	o.log.Info("sync undone", "source_id", sourceID, "sync_started", log.StartedAt,
+		"removed", result.Removed, "restored", result.Restored)
+	return result, nil
+}
+
+// restoreDocument saves a document as it was before a sync and indexes it again.
+func (o *SyncOrchestrator) restoreDocument(ctx context.Context, change *domain.SyncChange) error {
+	pending := &pendingDocument{
+		uri:          change.Previous.URI,
+		doc:          *change.Previous,
+		chunks:       change.PreviousChunks,
+		metadataOnly: domain.IsMetadataOnly(change.Previous.Metadata),
+	}
+	if err := o.saveDocument(ctx, pending.doc.SourceID, pending); err != nil {
+		return fmt.Errorf("restore %s: %w", pending.uri, err)
+	}
+	if err := o.indexDocument(ctx, pending.doc.SourceID, pending); err != nil {
+		return fmt.Errorf("restore %s: %w", pending.uri, err)
+	}
+	return nil
+}
+
+// revertSyncState moves a source's sync position back to where it was
+// before the undone sync. Errors recorded since are kept.
+func (o *SyncOrchestrator) revertSyncState(ctx context.Context, sourceID string, previous *domain.SyncState) error {
+	state, err := o.syncStore.Get(ctx, sourceID)
+	if errors.Is(err, domain.ErrNotFound) {
+		state = &domain.SyncState{SourceID: sourceID}
+	} else if err != nil {
+		return fmt.Errorf("get sync state: %w", err)
+	}
+
+	if previous != nil {
+		state.Cursor = previous.Cursor
+		state.SubCursors = previous.SubCursors
+		state.ConnectorVersion = previous.ConnectorVersion
+		state.LastSync = previous.LastSync
+	} else {
+		// The source had never synced, so the next sync is a full one
+		state.Cursor = ""
+		state.SubCursors = nil
+		state.LastSync = time.Time{}
+	}
+
+	if err := o.syncStore.Save(ctx, *state); err != nil {
+		return fmt.Errorf("save sync state: %w", err)
+	}
+	return nil
+}
+
+// beginChangeLog starts logging the changes of a source's sync, if change
+// logs are stored. previous is the sync state before the sync.
+func (o *SyncOrchestrator) beginChangeLog(sourceID string, previous *domain.SyncState, started time.Time) {
+	if o.changeLogStore == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.changeLogs[sourceID] = &domain.SyncChangeLog{
+		SourceID:      sourceID,
+		StartedAt:     started,
+		PreviousState: previous,
+	}
+}
+
+// endChangeLog stops logging a source's sync and stores its log. A failed
+// sync that changed nothing keeps the log of the sync before it.
+func (o *SyncOrchestrator) endChangeLog(ctx context.Context, sourceID string, succeeded bool) {
+	o.mu.Lock()
+	log := o.changeLogs[sourceID]
+	delete(o.changeLogs, sourceID)
+	o.mu.Unlock()
+
+	if log == nil || (!succeeded && len(log.Changes) == 0 && !log.Truncated) {
+		return
+	}
+	// The sync's context may already be done
+	if err := o.changeLogStore.Save(context.WithoutCancel(ctx), log); err != nil {
+		o.log.Warn("failed to save sync change log", "source_id", sourceID, "error", err)
+	}
+}
+
+// logging reports whether the changes of a source's sync are being logged.
+func (o *SyncOrchestrator) logging(sourceID string) bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	log := o.changeLogs[sourceID]
+	return log != nil && !log.Truncated
+}
+
+// recordChange adds a change to the log of a source's running sync, if any.
+func (o *SyncOrchestrator) recordChange(sourceID string, change domain.SyncChange) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if log := o.changeLogs[sourceID]; log != nil {
+		log.Record(change)
+	}
+}
+
+// recordSaves logs documents about to be saved by a sync as created, or as
+// updated along with their stored version when any stored field has
+// changed. Re-saving an unchanged document is not logged, so a sync that touches many
+// documents but changes few stays within MaxSyncChangeLogEntries.
+func (o *SyncOrchestrator) recordSaves(ctx context.Context, sourceID string, docs []domain.Document) error {
+	if !o.logging(sourceID) {
+		return nil
+	}
+	for i := range docs {
+		previous, err := o.docStore.GetDocument(ctx, docs[i].ID)
+		if errors.Is(err, domain.ErrNotFound) {
+			o.recordChange(sourceID, domain.SyncChange{Kind: domain.SyncChangeCreated, DocumentID: docs[i].ID})
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("get document: %w", err)
+		}
+		if !documentChanged(previous, &docs[i]) {
+			continue
+		}
+		chunks, err := o.docStore.GetChunks(ctx, previous.ID)
+		if err != nil {
+			return fmt.Errorf("get chunks: %w", err)
+		}
+		o.recordChange(sourceID, domain.SyncChange{
+			Kind:           domain.SyncChangeUpdated,
+			DocumentID:     previous.ID,
+			Previous:       previous,
+			PreviousChunks: chunks,
+		})
+	}
+	return nil
+}
+
+// documentChanged reports whether saving next over the stored previous
+// changes any persisted field taken from the source. CreatedAt and UpdatedAt
+// are stamped by normalisers at indexing time and would differ on every save;
+// the source's own timestamps, such as modification times, are in metadata.
+// Metadata is compared in its JSON form, as stores keep it, so a number read
+// back as another numeric type is equal.
+func documentChanged(previous, next *domain.Document) bool {
+	if contentHash(previous.Content) != contentHash(next.Content) ||
+		previous.Title != next.Title || previous.URI != next.URI ||
+		previous.Author != next.Author {
+		return true
+	}
+	if (previous.ParentID == nil) != (next.ParentID == nil) ||
+		(previous.ParentID != nil && *previous.ParentID != *next.ParentID) {
+		return true
+	}
+	before, errBefore := json.Marshal(previous.Metadata)
+	after, errAfter := json.Marshal(next.Metadata)
+	return errBefore != nil || errAfter != nil || !bytes.Equal(before, after)
+}
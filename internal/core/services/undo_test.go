@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// undoFixture is a sync orchestrator over memory stores that logs changes.
+type undoFixture struct {
+	orchestrator *SyncOrchestrator
+	syncStore    *memory.SyncStateStore
+	docStore     *memory.DocumentStore
+	changeLogs   *memory.SyncChangeLogStore
+	searchEngine *syncMockSearchEngine
+	connector    *syncMockConnector
+}
+
+func newUndoFixture(t *testing.T) *undoFixture {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	require.NoError(t, sourceStore.Save(context.Background(), domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+
+	f := &undoFixture{
+		syncStore:    memory.NewSyncStateStore(),
+		docStore:     memory.NewDocumentStore(),
+		changeLogs:   memory.NewSyncChangeLogStore(),
+		searchEngine: newSyncMockSearchEngine(),
+		connector: &syncMockConnector{
+			sourceID:     "src-1",
+			connType:     "mock",
+			capabilities: driven.ConnectorCapabilities{SupportsIncremental: true},
+		},
+	}
+	factory := newSyncMockConnectorFactory()
+	factory.connectors["src-1"] = f.connector
+
+	f.orchestrator = NewSyncOrchestrator(
+		sourceStore, f.syncStore, f.docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, f.searchEngine, nil, nil,
+	)
+	f.orchestrator.SetChangeLogStore(f.changeLogs)
+	return f
+}
+
+// saveSyncedDocument stores an indexed document as the mock normaliser
+// would have produced it for uri.
+func saveSyncedDocument(t *testing.T, f *undoFixture, uri, content string) {
+	t.Helper()
+	ctx := context.Background()
+	doc := domain.Document{ID: "src-1-doc-" + uri, SourceID: "src-1", URI: uri, Title: uri, Content: content}
+	require.NoError(t, f.docStore.SaveDocument(ctx, &doc))
+	chunk := domain.Chunk{ID: doc.ID + "-chunk", DocumentID: doc.ID, Content: content}
+	require.NoError(t, f.docStore.SaveChunks(ctx, []domain.Chunk{chunk}))
+	require.NoError(t, f.searchEngine.Index(ctx, chunk))
+}
+
+func rawChange(kind domain.ChangeType, uri, content string) domain.RawDocumentChange {
+	return domain.RawDocumentChange{
+		Type:     kind,
+		Document: domain.RawDocument{SourceID: "src-1", URI: uri, MIMEType: "text/plain", Content: []byte(content)},
+	}
+}
+
+func TestSyncOrchestrator_UndoLastSync_FirstSync(t *testing.T) {
+	ctx := context.Background()
+	f := newUndoFixture(t)
+	f.connector.fullSyncDocs = []domain.RawDocument{
+		{SourceID: "src-1", URI: "a.txt", MIMEType: "text/plain", Content: []byte("a")},
+		{SourceID: "src-1", URI: "b.txt", MIMEType: "text/plain", Content: []byte("b")},
+	}
+	require.NoError(t, f.orchestrator.Sync(ctx, "src-1"))
+
+	log, err := f.changeLogs.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, log.Count(domain.SyncChangeCreated))
+	assert.Nil(t, log.PreviousState)
+
+	result, err := f.orchestrator.UndoLastSync(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Removed)
+	assert.Zero(t, result.Restored)
+
+	docs, err := f.docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+	assert.Empty(t, f.searchEngine.indexed)
+
+	// The source looks as if it had never synced
+	state, err := f.syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.False(t, state.HasCursor())
+	assert.True(t, state.LastSync.IsZero())
+
+	// Only one sync can be undone
+	_, err = f.orchestrator.UndoLastSync(ctx, "src-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSyncOrchestrator_UndoLastSync_RestoresChangedAndDeleted(t *testing.T) {
+	ctx := context.Background()
+	f := newUndoFixture(t)
+	lastSync := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, f.syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1", LastSync: lastSync}))
+	saveSyncedDocument(t, f, "kept.txt", "original")
+	saveSyncedDocument(t, f, "gone.txt", "deleted")
+
+	f.connector.incSyncDocs = []domain.RawDocumentChange{
+		rawChange(domain.ChangeUpdated, "kept.txt", "edited"),
+		rawChange(domain.ChangeDeleted, "gone.txt", ""),
+		rawChange(domain.ChangeCreated, "new.txt", "new"),
+	}
+	f.connector.complete = &driven.SyncComplete{NewCursor: "cursor-2"}
+	require.NoError(t, f.orchestrator.Sync(ctx, "src-1"))
+
+	result, err := f.orchestrator.UndoLastSync(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Removed)
+	assert.Equal(t, 2, result.Restored)
+
+	kept, err := f.docStore.GetDocument(ctx, "src-1-doc-kept.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "original", kept.Content)
+	chunks, err := f.docStore.GetChunks(ctx, "src-1-doc-gone.txt")
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "deleted", chunks[0].Content)
+
+	_, err = f.docStore.GetDocument(ctx, "src-1-doc-new.txt")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Len(t, f.searchEngine.indexed, 2)
+	assert.Contains(t, f.searchEngine.indexed, "src-1-doc-gone.txt-chunk")
+
+	state, err := f.syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-1", state.Cursor)
+	assert.True(t, lastSync.Equal(state.LastSync))
+}
+
+func TestSyncOrchestrator_Sync_LogsOnlyChangedDocuments(t *testing.T) {
+	ctx := context.Background()
+	f := newUndoFixture(t)
+	require.NoError(t, f.syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1"}))
+	saveSyncedDocument(t, f, "same.txt", "unchanged")
+	saveSyncedDocument(t, f, "edited.txt", "original")
+
+	f.connector.incSyncDocs = []domain.RawDocumentChange{
+		rawChange(domain.ChangeUpdated, "same.txt", "unchanged"),
+		rawChange(domain.ChangeUpdated, "edited.txt", "edited"),
+	}
+	f.connector.complete = &driven.SyncComplete{NewCursor: "cursor-2"}
+	require.NoError(t, f.orchestrator.Sync(ctx, "src-1"))
+
+	log, err := f.changeLogs.Get(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, log.Changes, 1)
+	assert.Equal(t, domain.SyncChangeUpdated, log.Changes[0].Kind)
+	assert.Equal(t, "src-1-doc-edited.txt", log.Changes[0].DocumentID)
+}
+
+func TestSyncOrchestrator_UndoLastSync_RestoresTitleOnlyChange(t *testing.T) {
+	ctx := context.Background()
+	f := newUndoFixture(t)
+	require.NoError(t, f.syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1"}))
+	saveSyncedDocument(t, f, "a.txt", "same")
+	doc, err := f.docStore.GetDocument(ctx, "src-1-doc-a.txt")
+	require.NoError(t, err)
+	doc.Title = "Old title"
+	require.NoError(t, f.docStore.SaveDocument(ctx, doc))
+
+	// The mock normaliser titles documents by URI, with the same content
+	f.connector.incSyncDocs = []domain.RawDocumentChange{rawChange(domain.ChangeUpdated, "a.txt", "same")}
+	f.connector.complete = &driven.SyncComplete{NewCursor: "cursor-2"}
+	require.NoError(t, f.orchestrator.Sync(ctx, "src-1"))
+	synced, err := f.docStore.GetDocument(ctx, "src-1-doc-a.txt")
+	require.NoError(t, err)
+	require.NotEqual(t, "Old title", synced.Title)
+
+	result, err := f.orchestrator.UndoLastSync(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Restored)
+	restored, err := f.docStore.GetDocument(ctx, "src-1-doc-a.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "Old title", restored.Title)
+}
+
+func TestDocumentChanged(t *testing.T) {
+	parent := "p1"
+	stored := domain.Document{
+		ID: "d1", URI: "a.txt", Title: "A", Content: "body", ParentID: &parent,
+		Author:   domain.Author{Name: "Ann"},
+		Metadata: map[string]any{"size": float64(4)},
+	}
+
+	same := stored
+	same.Metadata = map[string]any{"size": 4}
+	same.UpdatedAt = time.Now()
+	assert.False(t, documentChanged(&stored, &same), "index timestamps and numeric types are ignored")
+
+	tests := map[string]func(d *domain.Document){
+		"content":  func(d *domain.Document) { d.Content = "edited" },
+		"title":    func(d *domain.Document) { d.Title = "B" },
+		"author":   func(d *domain.Document) { d.Author = domain.Author{Name: "Bob"} },
+		"parent":   func(d *domain.Document) { d.ParentID = nil },
+		"metadata": func(d *domain.Document) { d.Metadata = map[string]any{"size": 5} },
+	}
+	for name, change := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := stored
+			change(&next)
+			assert.True(t, documentChanged(&stored, &next))
+		})
+	}
+}
+
+func TestSyncOrchestrator_UndoLastSync_SyncInProgress(t *testing.T) {
+	f := newUndoFixture(t)
+	require.NoError(t, f.orchestrator.claimSync("src-1", &driving.SyncStatus{SourceID: "src-1", Running: true}))
+
+	_, err := f.orchestrator.UndoLastSync(context.Background(), "src-1")
+
+	assert.ErrorIs(t, err, domain.ErrSyncInProgress)
+	// A sync cannot start while the slot is held either, as during an undo
+	assert.ErrorIs(t, f.orchestrator.Sync(context.Background(), "src-1"), domain.ErrSyncInProgress)
+}
+
+func TestSyncOrchestrator_UndoLastSync_FailedSyncKeepsPreviousLog(t *testing.T) {
+	ctx := context.Background()
+	f := newUndoFixture(t)
+	f.connector.fullSyncDocs = []domain.RawDocument{
+		{SourceID: "src-1", URI: "a.txt", MIMEType: "text/plain", Content: []byte("a")},
+	}
+	require.NoError(t, f.orchestrator.Sync(ctx, "src-1"))
+
+	f.connector.fullSyncDocs = nil
+	f.connector.fullSyncErr = assert.AnError
+	require.Error(t, f.orchestrator.Sync(ctx, "src-1"))
+
+	result, err := f.orchestrator.UndoLastSync(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Removed)
+}
+
+func TestSyncOrchestrator_UndoLastSync_Truncated(t *testing.T) {
+	ctx := context.Background()
+	f := newUndoFixture(t)
+	require.NoError(t, f.changeLogs.Save(ctx, &domain.SyncChangeLog{SourceID: "src-1", Truncated: true}))
+
+	_, err := f.orchestrator.UndoLastSync(ctx, "src-1")
+
+	assert.ErrorIs(t, err, domain.ErrSyncNotUndoable)
+}
+
+func TestSyncOrchestrator_UndoLastSync_NoChangeLogStore(t *testing.T) {
+	orchestrator := NewSyncOrchestrator(nil, nil, nil, nil, nil, nil, nil, newSyncMockSearchEngine(), nil, nil)
+
+	_, err := orchestrator.UndoLastSync(context.Background(), "src-1")
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
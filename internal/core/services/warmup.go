@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
+)
+
+// Ensure VectorIndexWarmer implements the interface.
+var _ driving.IndexWarmer = (*VectorIndexWarmer)(nil)
+
+// warmUpNeighbours is how many neighbours the warm-up search asks for.
+// A wide search visits more of the graph and so loads more pages.
+const warmUpNeighbours = 100
+
+// VectorIndexWarmer runs a throwaway search against a freshly loaded vector
+// index. The OS loads the index pages on first access, so without a warm-up
+// the user's first search pays that cost.
+type VectorIndexWarmer struct {
+	vectorIndex driven.VectorIndex
+	dimensions  int
+	log         *slog.Logger
+
+	mu     sync.Mutex
+	status domain.WarmUpStatus
+	done   chan struct{}
+}
+
+// NewVectorIndexWarmer creates a warmer for an index of the given dimension.
+// The vectorIndex parameter is optional (can be nil).
+func NewVectorIndexWarmer(vectorIndex driven.VectorIndex, dimensions int) *VectorIndexWarmer {
+	state := domain.WarmUpDisabled
+	if vectorIndex == nil || dimensions <= 0 {
+		state = domain.WarmUpUnavailable
+	}
+	return &VectorIndexWarmer{
+		vectorIndex: vectorIndex,
+		dimensions:  dimensions,
+		log:         logger.Slog(),
+		status:      domain.WarmUpStatus{State: state},
+	}
+}
+
+// SetLogger sets the structured logger used for warm-up events.
+func (w *VectorIndexWarmer) SetLogger(log *slog.Logger) {
+	if log != nil {
+		w.log = log
+	}
+}
+
+// Start searches the index with a zero vector in a background goroutine.
+func (w *VectorIndexWarmer) Start(ctx context.Context) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.status.State != domain.WarmUpDisabled {
+		return
+	}
+	w.status = domain.WarmUpStatus{State: domain.WarmUpRunning}
+	w.done = make(chan struct{})
+
+	go w.run(ctx)
+}
+
+// run performs the warm-up search and records the outcome.
+func (w *VectorIndexWarmer) run(ctx context.Context) {
+	defer close(w.done)
+
+	start := time.Now()
+	_, err := w.vectorIndex.Search(ctx, make([]float32, w.dimensions), warmUpNeighbours)
+	elapsed := time.Since(start)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err != nil {
+		w.status = domain.WarmUpStatus{State: domain.WarmUpFailed, Duration: elapsed, Error: err.Error()}
+		w.log.Warn("vector index warm-up failed", "error", err)
+		return
+	}
+	w.status = domain.WarmUpStatus{State: domain.WarmUpDone, Duration: elapsed}
+	w.log.Debug("vector index warmed", "duration", elapsed)
+}
+
+// Wait blocks until the warm-up finishes or ctx is done.
+func (w *VectorIndexWarmer) Wait(ctx context.Context) error {
+	w.mu.Lock()
+	done := w.done
+	w.mu.Unlock()
+
+	if done == nil {
+		return nil
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Status reports the warm-up state.
+func (w *VectorIndexWarmer) Status() domain.WarmUpStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.status
+}
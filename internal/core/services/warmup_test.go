@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// gatedVectorIndex blocks searches until release is closed and records the query.
+type gatedVectorIndex struct {
+	mockVectorIndex
+	release chan struct{}
+	query   []float32
+}
+
+func (g *gatedVectorIndex) Search(ctx context.Context, query []float32, k int) ([]driven.VectorHit, error) {
+	<-g.release
+	g.query = query
+	return g.mockVectorIndex.Search(ctx, query, k)
+}
+
+func TestVectorIndexWarmer_NoIndex(t *testing.T) {
+	w := NewVectorIndexWarmer(nil, 384)
+
+	w.Start(context.Background())
+
+	assert.Equal(t, domain.WarmUpUnavailable, w.Status().State)
+	assert.NoError(t, w.Wait(context.Background()))
+}
+
+func TestVectorIndexWarmer_NotStarted(t *testing.T) {
+	w := NewVectorIndexWarmer(&mockVectorIndex{}, 384)
+
+	assert.Equal(t, domain.WarmUpDisabled, w.Status().State)
+	assert.NoError(t, w.Wait(context.Background()))
+}
+
+func TestVectorIndexWarmer_RunsInBackground(t *testing.T) {
+	idx := &gatedVectorIndex{release: make(chan struct{})}
+	w := NewVectorIndexWarmer(idx, 4)
+
+	w.Start(context.Background())
+	assert.Equal(t, domain.WarmUpRunning, w.Status().State)
+
+	close(idx.release)
+	require.NoError(t, w.Wait(context.Background()))
+
+	status := w.Status()
+	assert.True(t, status.IsWarm())
+	assert.Equal(t, []float32{0, 0, 0, 0}, idx.query)
+}
+
+func TestVectorIndexWarmer_Failure(t *testing.T) {
+	w := NewVectorIndexWarmer(&mockVectorIndex{searchErr: errors.New("index is closed")}, 4)
+
+	w.Start(context.Background())
+	require.NoError(t, w.Wait(context.Background()))
+
+	status := w.Status()
+	assert.Equal(t, domain.WarmUpFailed, status.State)
+	assert.Contains(t, status.Error, "index is closed")
+}
+
+func TestVectorIndexWarmer_WaitHonoursContext(t *testing.T) {
+	idx := &gatedVectorIndex{release: make(chan struct{})}
+	defer close(idx.release)
+	w := NewVectorIndexWarmer(idx, 4)
+	w.Start(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, w.Wait(ctx), context.DeadlineExceeded)
+}
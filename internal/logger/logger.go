@@ -1,36 +1,90 @@
-// Package logger provides verbose logging for the Sercha CLI.
-// When verbose mode is enabled via the --verbose flag, debug messages
-// are printed to stderr to help users understand the search pipeline.
+// Package logger provides logging for the Sercha CLI.
+// Messages below the configured level are dropped. When console output is
+// enabled (via --verbose or --log-level) messages are printed to stderr to
+// help users understand the search and sync pipelines. Structured (JSON)
+// logs can additionally be written to a file under ~/.sercha/logs/.
 package logger
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 )
 
+// FileName is the name of the log file within the log directory.
+const FileName = "sercha.log"
+
 var (
-	mu      sync.RWMutex
-	verbose bool
-	output  io.Writer = os.Stderr
+	mu          sync.RWMutex
+	console     bool
+	output      io.Writer = os.Stderr
+	file        *os.File
+	fileHandler slog.Handler
+
+	// level is shared with the file handler so level changes apply to both outputs.
+	level = new(slog.LevelVar)
 )
 
 // SetVerbose enables or disables verbose logging.
+// Verbose mode prints all messages, including debug, to the console.
 func SetVerbose(v bool) {
 	mu.Lock()
 	defer mu.Unlock()
-	verbose = v
+	console = v
+	if v {
+		level.Set(slog.LevelDebug)
+	} else {
+		level.Set(slog.LevelInfo)
+	}
 }
 
 // IsVerbose returns true if verbose mode is enabled.
 func IsVerbose() bool {
 	mu.RLock()
 	defer mu.RUnlock()
-	return verbose
+	return console && level.Level() <= slog.LevelDebug
+}
+
+// SetConsole enables or disables printing messages to the console output.
+func SetConsole(enabled bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	console = enabled
+}
+
+// SetLevel sets the minimum level for all outputs.
+func SetLevel(l slog.Level) {
+	level.Set(l)
 }
 
-// SetOutput sets the output writer for verbose logs.
+// Level returns the minimum level for all outputs.
+func Level() slog.Level {
+	return level.Level()
+}
+
+// ParseLevel parses a level name: debug, info, warn or error.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("invalid log level %q (expected debug, info, warn or error)", s)
+	}
+}
+
+// SetOutput sets the output writer for console logs.
 // Defaults to os.Stderr. Useful for testing.
 func SetOutput(w io.Writer) {
 	mu.Lock()
@@ -38,38 +92,185 @@ func SetOutput(w io.Writer) {
 	output = w
 }
 
-// Debug prints a message if verbose mode is enabled.
-func Debug(format string, args ...any) {
-	mu.RLock()
-	defer mu.RUnlock()
-	if verbose {
-		fmt.Fprintf(output, "[DEBUG] "+format+"\n", args...)
+// DefaultDir returns the default log directory (~/.sercha/logs).
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
 	}
+	return filepath.Join(home, ".sercha", "logs"), nil
+}
+
+// OpenFile starts writing structured JSON logs to FileName in dir.
+// The file is appended to. Returns the path of the log file.
+func OpenFile(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("create log directory: %w", err)
+	}
+
+	path := filepath.Join(dir, FileName)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return "", fmt.Errorf("open log file: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if file != nil {
+		_ = file.Close()
+	}
+	file = f
+	fileHandler = slog.NewJSONHandler(f, &slog.HandlerOptions{Level: level})
+	return path, nil
+}
+
+// Close stops file logging and closes the log file, if open.
+func Close() error {
+	mu.Lock()
+	defer mu.Unlock()
+	fileHandler = nil
+	if file == nil {
+		return nil
+	}
+	err := file.Close()
+	file = nil
+	return err
+}
+
+// Slog returns a structured logger that writes to the shared outputs.
+// Output and level changes made after the logger is created still apply,
+// so it can be injected at startup before command-line flags are parsed.
+func Slog() *slog.Logger {
+	return slog.New(&handler{})
+}
+
+// Debug prints a debug message.
+func Debug(format string, args ...any) {
+	logf(slog.LevelDebug, format, args...)
 }
 
-// Section prints a section header if verbose mode is enabled.
+// Section prints a section header to the console.
 func Section(name string) {
 	mu.RLock()
 	defer mu.RUnlock()
-	if verbose {
+	if console && level.Level() <= slog.LevelInfo {
 		fmt.Fprintf(output, "\n=== %s ===\n", name)
 	}
 }
 
-// Info prints an informational message if verbose mode is enabled.
+// Info prints an informational message.
 func Info(format string, args ...any) {
+	logf(slog.LevelInfo, format, args...)
+}
+
+// Warn prints a warning message.
+func Warn(format string, args ...any) {
+	logf(slog.LevelWarn, format, args...)
+}
+
+// Error prints an error message.
+func Error(format string, args ...any) {
+	logf(slog.LevelError, format, args...)
+}
+
+// logf formats a message and writes it to the enabled outputs.
+func logf(l slog.Level, format string, args ...any) {
 	mu.RLock()
 	defer mu.RUnlock()
-	if verbose {
-		fmt.Fprintf(output, "[INFO] "+format+"\n", args...)
+	if l < level.Level() || (!console && fileHandler == nil) {
+		return
+	}
+
+	msg := fmt.Sprintf(format, args...)
+	if console {
+		fmt.Fprintf(output, "[%s] %s\n", l, msg)
+	}
+	if fileHandler != nil {
+		_ = fileHandler.Handle(context.Background(), slog.NewRecord(time.Now(), l, msg, 0))
 	}
 }
 
-// Warn prints a warning message if verbose mode is enabled.
-func Warn(format string, args ...any) {
+// handler is the slog.Handler behind Slog.
+// It resolves the current outputs on every record rather than at creation time.
+type handler struct {
+	// attrs are console attributes with group prefixes already applied.
+	attrs  []slog.Attr
+	groups []string
+	// wrap replays WithAttrs/WithGroup calls onto the file handler.
+	wrap []func(slog.Handler) slog.Handler
+}
+
+func (h *handler) Enabled(_ context.Context, l slog.Level) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return l >= level.Level() && (console || fileHandler != nil)
+}
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
 	mu.RLock()
 	defer mu.RUnlock()
-	if verbose {
-		fmt.Fprintf(output, "[WARN] "+format+"\n", args...)
+	if r.Level < level.Level() {
+		return nil
+	}
+
+	if console {
+		var b strings.Builder
+		fmt.Fprintf(&b, "[%s] %s", r.Level, r.Message)
+		for _, a := range h.attrs {
+			fmt.Fprintf(&b, " %s=%v", a.Key, a.Value)
+		}
+		prefix := h.groupPrefix()
+		r.Attrs(func(a slog.Attr) bool {
+			fmt.Fprintf(&b, " %s%s=%v", prefix, a.Key, a.Value)
+			return true
+		})
+		fmt.Fprintln(output, b.String())
+	}
+
+	if fileHandler != nil {
+		fh := fileHandler
+		for _, w := range h.wrap {
+			fh = w(fh)
+		}
+		return fh.Handle(ctx, r)
+	}
+	return nil
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	clone := h.clone()
+	prefix := h.groupPrefix()
+	for _, a := range attrs {
+		clone.attrs = append(clone.attrs, slog.Attr{Key: prefix + a.Key, Value: a.Value})
+	}
+	clone.wrap = append(clone.wrap, func(fh slog.Handler) slog.Handler { return fh.WithAttrs(attrs) })
+	return clone
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := h.clone()
+	clone.groups = append(clone.groups, name)
+	clone.wrap = append(clone.wrap, func(fh slog.Handler) slog.Handler { return fh.WithGroup(name) })
+	return clone
+}
+
+func (h *handler) clone() *handler {
+	return &handler{
+		attrs:  append([]slog.Attr(nil), h.attrs...),
+		groups: append([]string(nil), h.groups...),
+		wrap:   append([]func(slog.Handler) slog.Handler(nil), h.wrap...),
+	}
+}
+
+func (h *handler) groupPrefix() string {
+	if len(h.groups) == 0 {
+		return ""
 	}
+	return strings.Join(h.groups, ".") + "."
 }
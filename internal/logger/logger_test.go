@@ -2,7 +2,11 @@ package logger
 
 import (
 	"bytes"
+	"encoding/json"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -151,3 +155,140 @@ func TestConcurrentAccess(t *testing.T) {
 	}
 	// Test passes if no race conditions
 }
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input string
+		want  slog.Level
+	}{
+		{"debug", slog.LevelDebug},
+		{"INFO", slog.LevelInfo},
+		{"warn", slog.LevelWarn},
+		{"warning", slog.LevelWarn},
+		{" error ", slog.LevelError},
+	}
+	for _, tt := range tests {
+		got, err := ParseLevel(tt.input)
+		if err != nil {
+			t.Errorf("ParseLevel(%q) returned error: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseLevel(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("expected error for invalid level")
+	}
+}
+
+func TestSetLevel_FiltersConsole(t *testing.T) {
+	defer func() {
+		SetVerbose(false)
+		SetOutput(os.Stderr)
+	}()
+
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	SetConsole(true)
+	SetLevel(slog.LevelWarn)
+
+	Debug("debug message")
+	Info("info message")
+	Warn("warn message")
+	Error("error message")
+
+	output := buf.String()
+	if output != "[WARN] warn message\n[ERROR] error message\n" {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
+
+func TestConsoleDisabled_NoOutput(t *testing.T) {
+	defer func() {
+		SetVerbose(false)
+		SetOutput(os.Stderr)
+	}()
+
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	SetConsole(false)
+	SetLevel(slog.LevelDebug)
+
+	Error("error message")
+	Slog().Error("structured error")
+
+	if buf.Len() > 0 {
+		t.Errorf("expected no output with console disabled, got %q", buf.String())
+	}
+}
+
+func TestOpenFile_WritesJSON(t *testing.T) {
+	defer func() {
+		_ = Close()
+		SetVerbose(false)
+	}()
+
+	dir := filepath.Join(t.TempDir(), "logs")
+	SetVerbose(false)
+	SetLevel(slog.LevelInfo)
+
+	path, err := OpenFile(dir)
+	if err != nil {
+		t.Fatalf("OpenFile returned error: %v", err)
+	}
+	if path != filepath.Join(dir, FileName) {
+		t.Errorf("unexpected log path: %s", path)
+	}
+
+	Debug("dropped")
+	Info("sync %s", "started")
+	Slog().With("source_id", "src-1").Warn("connector slow", "elapsed_ms", 1500)
+
+	if err := Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading log file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log lines, got %d: %q", len(lines), data)
+	}
+
+	var first map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if first["level"] != "INFO" || first["msg"] != "sync started" {
+		t.Errorf("unexpected first record: %v", first)
+	}
+
+	var second map[string]any
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if second["source_id"] != "src-1" || second["elapsed_ms"] != float64(1500) {
+		t.Errorf("unexpected second record: %v", second)
+	}
+}
+
+func TestSlog_ConsoleFormat(t *testing.T) {
+	defer func() {
+		SetVerbose(false)
+		SetOutput(os.Stderr)
+	}()
+
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	SetVerbose(true)
+
+	Slog().With("source_id", "src-1").WithGroup("sync").Info("done", "documents", 3)
+
+	output := buf.String()
+	if output != "[INFO] done source_id=src-1 sync.documents=3\n" {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
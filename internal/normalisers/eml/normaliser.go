@@ -107,6 +107,7 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 		URI:       raw.URI,
 		Title:     title,
 		Content:   strings.TrimSpace(content.String()),
+		Author:    senderAuthor(msg.Header.Get("From")),
 		Metadata:  copyMetadata(raw.Metadata),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -132,6 +133,16 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 	}, nil
 }
 
+// senderAuthor returns the sender of a From header as the document author,
+// identified by email address. An unparseable header yields no author.
+func senderAuthor(header string) domain.Author {
+	addr, err := mail.ParseAddress(header)
+	if err != nil {
+		return domain.Author{}
+	}
+	return domain.Author{Name: strings.TrimSpace(addr.Name), Identifier: strings.ToLower(addr.Address)}
+}
+
 // decodeHeader decodes RFC 2047 encoded headers.
 func decodeHeader(header string) string {
 	if header == "" {
@@ -83,6 +83,26 @@ It has multiple lines.
 	assert.Equal(t, "eml", doc.Metadata["format"])
 	assert.Equal(t, "sender@example.com", doc.Metadata["from"])
 	assert.Equal(t, "recipient@example.com", doc.Metadata["to"])
+	assert.Equal(t, domain.Author{Identifier: "sender@example.com"}, doc.Author)
+}
+
+func TestNormalise_Author(t *testing.T) {
+	normaliser := New()
+	ctx := context.Background()
+
+	emlContent := "From: =?UTF-8?Q?Ren=C3=A9e_Smith?= <Renee@Example.com>\r\n" +
+		"Subject: Hello\r\n\r\nBody.\r\n"
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/email.eml",
+		MIMEType: "message/rfc822",
+		Content:  []byte(emlContent),
+	}
+
+	result, err := normaliser.Normalise(ctx, raw)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Author{Name: "Renée Smith", Identifier: "renee@example.com"}, result.Document.Author)
 }
 
 func TestNormalise_NoSubject(t *testing.T) {
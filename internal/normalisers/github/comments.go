@@ -0,0 +1,113 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// metadataCommentsOffset is the document metadata key for the byte offset of
+// the comments section in the content. Appending new comments keeps the
+// rendered content from there on instead of rebuilding it.
+const metadataCommentsOffset = "comments_offset"
+
+// commentsHeading starts the comments section, always the last in the content.
+const commentsHeading = "## Comments\n\n"
+
+// writeComments writes the comments section: comments rendered earlier
+// followed by the new comments. Nothing is written when both are empty.
+func writeComments(sb *strings.Builder, rendered string, comments []CommentContent) {
+	if rendered == "" && len(comments) == 0 {
+		return
+	}
+	sb.WriteString(commentsHeading)
+	sb.WriteString(rendered)
+	for _, comment := range comments {
+		sb.WriteString(fmt.Sprintf("### @%s (%s)\n\n%s\n\n",
+			comment.Author,
+			comment.CreatedAt.Format("2006-01-02 15:04"),
+			comment.Body))
+	}
+}
+
+// renderedComments returns the comments already rendered into a document,
+// without the section heading.
+func renderedComments(doc *domain.Document) string {
+	offset, ok := commentsOffset(doc.Metadata)
+	if !ok || offset > len(doc.Content) {
+		// Documents indexed before the offset was recorded
+		offset = strings.LastIndex(doc.Content, commentsHeading)
+		if offset < 0 {
+			return ""
+		}
+	}
+	section := doc.Content[offset:]
+	if !strings.HasPrefix(section, commentsHeading) {
+		return ""
+	}
+	return section[len(commentsHeading):]
+}
+
+// commentsOffset reads the comments offset from document metadata. Metadata
+// read back from storage holds numbers as float64.
+func commentsOffset(metadata map[string]any) (int, bool) {
+	switch v := metadata[metadataCommentsOffset].(type) {
+	case int:
+		return v, v >= 0
+	case int64:
+		return int(v), v >= 0
+	case float64:
+		return int(v), v >= 0
+	default:
+		return 0, false
+	}
+}
+
+// newDocument builds a document from the content rendered before the
+// comments section (head), the comments rendered earlier and new comments.
+func newDocument(
+	raw *domain.RawDocument, title, author, head, rendered string,
+	comments []CommentContent, format string,
+) domain.Document {
+	var sb strings.Builder
+	sb.WriteString(head)
+	writeComments(&sb, rendered, comments)
+
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     title,
+		Content:   sb.String(),
+		Author:    loginAuthor(author),
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// Add normaliser info to metadata
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	doc.Metadata["format"] = format
+	doc.Metadata[metadataCommentsOffset] = len(head)
+
+	return doc
+}
+
+// appendedDocument builds the document for a partial raw document appended
+// to an existing one. It keeps the existing document's identity.
+func appendedDocument(
+	existing *domain.Document, raw *domain.RawDocument, title, author, head string,
+	comments []CommentContent, format string,
+) domain.Document {
+	doc := newDocument(raw, title, author, head, renderedComments(existing), comments, format)
+	doc.ID = existing.ID
+	doc.CreatedAt = existing.CreatedAt
+	return doc
+}
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func issueRaw(t *testing.T, content IssueContent) *domain.RawDocument {
+	t.Helper()
+	data, err := json.Marshal(content)
+	require.NoError(t, err)
+	return &domain.RawDocument{
+		SourceID: "src-1",
+		URI:      "https://github.com/owner/repo/issues/1",
+		MIMEType: MIMETypeGitHubIssue,
+		Content:  data,
+	}
+}
+
+func comment(author, body string) CommentContent {
+	return CommentContent{Author: author, Body: body, CreatedAt: time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC)}
+}
+
+func TestIssueNormaliser_Append(t *testing.T) {
+	normaliser := NewIssue()
+	ctx := context.Background()
+	issue := IssueContent{Number: 1, Title: "Bug", Body: "It breaks", State: "open", Author: "alice"}
+
+	issue.Comments = []CommentContent{comment("bob", "Same here")}
+	first, err := normaliser.Normalise(ctx, issueRaw(t, issue))
+	require.NoError(t, err)
+
+	// Only the new comment is sent; the issue was closed since
+	issue.State = "closed"
+	issue.Comments = []CommentContent{comment("carol", "Fixed")}
+	appended, err := normaliser.Append(ctx, &first.Document, issueRaw(t, issue))
+	require.NoError(t, err)
+
+	doc := appended.Document
+	assert.Equal(t, first.Document.ID, doc.ID)
+	assert.Equal(t, first.Document.CreatedAt, doc.CreatedAt)
+	assert.Contains(t, doc.Content, "**State:** closed")
+	assert.Equal(t, 1, strings.Count(doc.Content, commentsHeading))
+	assert.Less(t, strings.Index(doc.Content, "@bob"), strings.Index(doc.Content, "@carol"))
+
+	// Appending to the appended document keeps every comment
+	issue.Comments = []CommentContent{comment("dave", "Thanks")}
+	again, err := normaliser.Append(ctx, &doc, issueRaw(t, issue))
+	require.NoError(t, err)
+	for _, author := range []string{"@bob", "@carol", "@dave"} {
+		assert.Contains(t, again.Document.Content, author)
+	}
+}
+
+func TestIssueNormaliser_AppendWithoutComments(t *testing.T) {
+	normaliser := NewIssue()
+	ctx := context.Background()
+	issue := IssueContent{Number: 1, Title: "Bug", Author: "alice"}
+
+	first, err := normaliser.Normalise(ctx, issueRaw(t, issue))
+	require.NoError(t, err)
+	assert.NotContains(t, first.Document.Content, commentsHeading)
+
+	issue.Comments = []CommentContent{comment("bob", "First!")}
+	appended, err := normaliser.Append(ctx, &first.Document, issueRaw(t, issue))
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(appended.Document.Content, commentsHeading+"### @bob (2025-01-02 03:04)\n\nFirst!\n\n"))
+}
+
+func TestRenderedComments(t *testing.T) {
+	content := "# Issue\n\n" + commentsHeading + "### @bob\n\nHi\n\n"
+
+	// The offset survives a round trip through JSON as float64
+	doc := &domain.Document{Content: content, Metadata: map[string]any{metadataCommentsOffset: float64(9)}}
+	assert.Equal(t, "### @bob\n\nHi\n\n", renderedComments(doc))
+
+	// Documents without an offset fall back to the last heading
+	doc.Metadata = nil
+	assert.Equal(t, "### @bob\n\nHi\n\n", renderedComments(doc))
+
+	// An offset at the end of the content means there are no comments
+	doc.Metadata = map[string]any{metadataCommentsOffset: len(content)}
+	assert.Empty(t, renderedComments(doc))
+}
+
+func TestPullNormaliser_Append(t *testing.T) {
+	normaliser := NewPull()
+	ctx := context.Background()
+	pr := PRContent{
+		Number: 2, Title: "Fix", Author: "alice",
+		Reviews:  []ReviewContent{{Author: "bob", State: "APPROVED"}},
+		Comments: []CommentContent{comment("bob", "LGTM")},
+	}
+	raw := func() *domain.RawDocument {
+		data, err := json.Marshal(pr)
+		require.NoError(t, err)
+		return &domain.RawDocument{URI: "https://github.com/owner/repo/pull/2", MIMEType: MIMETypeGitHubPull, Content: data}
+	}
+
+	first, err := normaliser.Normalise(ctx, raw())
+	require.NoError(t, err)
+
+	pr.Merged = true
+	pr.Comments = []CommentContent{comment("carol", "Shipped")}
+	appended, err := normaliser.Append(ctx, &first.Document, raw())
+	require.NoError(t, err)
+
+	content := appended.Document.Content
+	assert.Contains(t, content, "**State:** merged")
+	assert.Equal(t, 1, strings.Count(content, "## Reviews"))
+	assert.Less(t, strings.Index(content, "## Reviews"), strings.Index(content, commentsHeading))
+	assert.Contains(t, content, "LGTM")
+	assert.Contains(t, content, "Shipped")
+}
@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// MIMETypeGitHubCommit is the custom MIME type for GitHub commits.
+const MIMETypeGitHubCommit = "application/vnd.github.commit+json"
+
+// Ensure CommitNormaliser implements the interface.
+var _ driven.Normaliser = (*CommitNormaliser)(nil)
+
+// CommitNormaliser handles GitHub commit documents.
+type CommitNormaliser struct{}
+
+// NewCommit creates a new GitHub commit normaliser.
+func NewCommit() *CommitNormaliser {
+	return &CommitNormaliser{}
+}
+
+// SupportedMIMETypes returns the MIME types this normaliser handles.
+func (n *CommitNormaliser) SupportedMIMETypes() []string {
+	return []string{MIMETypeGitHubCommit}
+}
+
+// SupportedConnectorTypes returns connector types for specialised handling.
+func (n *CommitNormaliser) SupportedConnectorTypes() []string {
+	return []string{"github"} // GitHub-specific
+}
+
+// Priority returns the selection priority.
+func (n *CommitNormaliser) Priority() int {
+	return 95 // Connector-specific priority
+}
+
+// CommitContent represents the JSON content of a commit.
+type CommitContent struct {
+	SHA         string              `json:"sha"`
+	Message     string              `json:"message"`
+	Author      string              `json:"author"`
+	AuthorLogin string              `json:"author_login,omitempty"`
+	Date        time.Time           `json:"date"`
+	Files       []CommitFileContent `json:"files,omitempty"`
+	Truncated   bool                `json:"truncated,omitempty"`
+}
+
+// CommitFileContent represents a file changed by a commit.
+type CommitFileContent struct {
+	Filename  string `json:"filename"`
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Patch     string `json:"patch,omitempty"`
+}
+
+// Normalise converts a GitHub commit document to a normalised document.
+// The message comes first, followed by the changed files and any patches.
+func (n *CommitNormaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	// Parse JSON content
+	var content CommitContent
+	if err := json.Unmarshal(raw.Content, &content); err != nil {
+		return nil, fmt.Errorf("parse commit content: %w", err)
+	}
+
+	subject, body, _ := strings.Cut(strings.TrimSpace(content.Message), "\n")
+	shortSHA := content.SHA
+	if len(shortSHA) > 7 {
+		shortSHA = shortSHA[:7]
+	}
+
+	var sb strings.Builder
+
+	// Header with authorship
+	sb.WriteString(fmt.Sprintf("# Commit %s: %s\n\n", shortSHA, subject))
+	sb.WriteString(fmt.Sprintf("**Author:** %s", content.Author))
+	if content.AuthorLogin != "" {
+		sb.WriteString(fmt.Sprintf(" (@%s)", content.AuthorLogin))
+	}
+	sb.WriteString(fmt.Sprintf(" | **Date:** %s\n\n", content.Date.Format("2006-01-02 15:04")))
+
+	// Message body
+	if body = strings.TrimSpace(body); body != "" {
+		sb.WriteString(body)
+		sb.WriteString("\n\n")
+	}
+
+	// Changed files
+	if len(content.Files) > 0 {
+		sb.WriteString("## Files\n\n")
+		for _, file := range content.Files {
+			sb.WriteString(fmt.Sprintf("- %s (%s, +%d -%d)\n",
+				file.Filename, file.Status, file.Additions, file.Deletions))
+		}
+		sb.WriteString("\n")
+
+		for _, file := range content.Files {
+			if file.Patch == "" {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("### %s\n\n```diff\n%s\n```\n\n", file.Filename, file.Patch))
+		}
+		if content.Truncated {
+			sb.WriteString("*Diff truncated.*\n\n")
+		}
+	}
+
+	// Build document
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     fmt.Sprintf("Commit %s: %s", shortSHA, subject),
+		Content:   sb.String(),
+		Author:    domain.Author{Name: content.Author, Identifier: content.AuthorLogin},
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// Add normaliser info to metadata
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	doc.Metadata["format"] = "github_commit"
+
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
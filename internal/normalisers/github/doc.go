@@ -3,6 +3,8 @@
 // This package contains normalisers for:
 //   - Issues (application/vnd.github.issue+json)
 //   - Pull Requests (application/vnd.github.pull+json)
+//   - Gists (application/vnd.github.gist+json)
+//   - Commits (application/vnd.github.commit+json)
 //
 // These normalisers preserve authorship, labels, state, and comment history
 // in a structured text format suitable for search and retrieval.
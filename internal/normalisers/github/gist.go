@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// MIMETypeGitHubGist is the custom MIME type for GitHub gists.
+const MIMETypeGitHubGist = "application/vnd.github.gist+json"
+
+// Ensure GistNormaliser implements the interface.
+var _ driven.Normaliser = (*GistNormaliser)(nil)
+
+// GistNormaliser handles GitHub gist documents.
+type GistNormaliser struct{}
+
+// NewGist creates a new GitHub gist normaliser.
+func NewGist() *GistNormaliser {
+	return &GistNormaliser{}
+}
+
+// SupportedMIMETypes returns the MIME types this normaliser handles.
+func (n *GistNormaliser) SupportedMIMETypes() []string {
+	return []string{MIMETypeGitHubGist}
+}
+
+// SupportedConnectorTypes returns connector types for specialised handling.
+func (n *GistNormaliser) SupportedConnectorTypes() []string {
+	return []string{"github"} // GitHub-specific
+}
+
+// Priority returns the selection priority.
+func (n *GistNormaliser) Priority() int {
+	return 95 // Connector-specific priority
+}
+
+// GistContent represents the JSON content of a gist.
+type GistContent struct {
+	ID          string            `json:"id"`
+	Description string            `json:"description"`
+	Owner       string            `json:"owner"`
+	Public      bool              `json:"public"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	Files       []GistFileContent `json:"files"`
+}
+
+// GistFileContent represents a file in a gist.
+type GistFileContent struct {
+	Filename string `json:"filename"`
+	Language string `json:"language,omitempty"`
+	Content  string `json:"content"`
+}
+
+// Normalise converts a GitHub gist document to a normalised document.
+// The description and each file are written as separate sections.
+func (n *GistNormaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	// Parse JSON content
+	var content GistContent
+	if err := json.Unmarshal(raw.Content, &content); err != nil {
+		return nil, fmt.Errorf("parse gist content: %w", err)
+	}
+
+	title := gistTitle(&content)
+
+	var sb strings.Builder
+
+	// Header with metadata
+	sb.WriteString(fmt.Sprintf("# Gist: %s\n\n", title))
+	visibility := "secret"
+	if content.Public {
+		visibility = "public"
+	}
+	sb.WriteString(fmt.Sprintf("**Owner:** @%s | **Visibility:** %s\n\n", content.Owner, visibility))
+
+	// Timestamps
+	sb.WriteString(fmt.Sprintf("*Created: %s | Updated: %s*\n\n",
+		content.CreatedAt.Format("2006-01-02 15:04"),
+		content.UpdatedAt.Format("2006-01-02 15:04")))
+
+	// Description
+	sb.WriteString("## Description\n\n")
+	if content.Description != "" {
+		sb.WriteString(content.Description)
+	} else {
+		sb.WriteString("*No description provided.*")
+	}
+	sb.WriteString("\n\n")
+
+	// One section per file
+	for _, file := range content.Files {
+		if file.Language != "" {
+			sb.WriteString(fmt.Sprintf("## File: %s (%s)\n\n", file.Filename, file.Language))
+		} else {
+			sb.WriteString(fmt.Sprintf("## File: %s\n\n", file.Filename))
+		}
+		sb.WriteString(formatGistFile(file))
+		sb.WriteString("\n\n")
+	}
+
+	// Build document
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     "Gist: " + title,
+		Content:   sb.String(),
+		Author:    loginAuthor(content.Owner),
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// Add normaliser info to metadata
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	doc.Metadata["format"] = "github_gist"
+
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
+
+// gistTitle returns the description, falling back to the first filename.
+func gistTitle(content *GistContent) string {
+	if content.Description != "" {
+		return content.Description
+	}
+	if len(content.Files) > 0 {
+		return content.Files[0].Filename
+	}
+	return content.ID
+}
+
+// formatGistFile renders prose files as-is and code in a fenced block.
+func formatGistFile(file GistFileContent) string {
+	switch strings.ToLower(file.Language) {
+	case "", "text", "markdown":
+		return file.Content
+	}
+	return fmt.Sprintf("```%s\n%s\n```", strings.ToLower(file.Language), strings.TrimRight(file.Content, "\n"))
+}
@@ -7,8 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
-
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
@@ -16,8 +14,8 @@ import (
 // MIMETypeGitHubIssue is the custom MIME type for GitHub issues.
 const MIMETypeGitHubIssue = "application/vnd.github.issue+json"
 
-// Ensure IssueNormaliser implements the interface.
-var _ driven.Normaliser = (*IssueNormaliser)(nil)
+// Ensure IssueNormaliser implements the interfaces.
+var _ driven.AppendingNormaliser = (*IssueNormaliser)(nil)
 
 // IssueNormaliser handles GitHub issue documents.
 type IssueNormaliser struct{}
@@ -66,17 +64,59 @@ type CommentContent struct {
 
 // Normalise converts a GitHub issue document to a normalised document.
 func (n *IssueNormaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
-	if raw == nil {
+	content, err := parseIssue(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := newDocument(raw, issueTitle(content), content.Author, issueHead(content), "",
+		content.Comments, "github_issue")
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
+
+// Append adds the comments of a partial issue document to an existing one.
+// The header and description are rendered again from the partial document;
+// comments already in the existing document are kept as they are.
+func (n *IssueNormaliser) Append(
+	_ context.Context, existing *domain.Document, raw *domain.RawDocument,
+) (*driven.NormaliseResult, error) {
+	if existing == nil {
 		return nil, domain.ErrInvalidInput
 	}
+	content, err := parseIssue(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := appendedDocument(existing, raw, issueTitle(content), content.Author, issueHead(content),
+		content.Comments, "github_issue")
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
 
-	// Parse JSON content
+// parseIssue parses the JSON content of an issue document.
+func parseIssue(raw *domain.RawDocument) (*IssueContent, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
 	var content IssueContent
 	if err := json.Unmarshal(raw.Content, &content); err != nil {
 		return nil, fmt.Errorf("parse issue content: %w", err)
 	}
+	return &content, nil
+}
 
-	// Build normalised content with preserved authorship
+// issueTitle returns the document title for an issue.
+func issueTitle(content *IssueContent) string {
+	return fmt.Sprintf("Issue #%d: %s", content.Number, content.Title)
+}
+
+// issueHead renders the header and description of an issue, everything
+// before the comments section, with preserved authorship.
+func issueHead(content *IssueContent) string {
 	var sb strings.Builder
 
 	// Header with metadata
@@ -107,42 +147,12 @@ func (n *IssueNormaliser) Normalise(_ context.Context, raw *domain.RawDocument)
 	}
 	sb.WriteString("\n\n")
 
-	// Comments
-	if len(content.Comments) > 0 {
-		sb.WriteString("## Comments\n\n")
-		for _, comment := range content.Comments {
-			sb.WriteString(fmt.Sprintf("### @%s (%s)\n\n%s\n\n",
-				comment.Author,
-				comment.CreatedAt.Format("2006-01-02 15:04"),
-				comment.Body))
-		}
-	}
-
-	// Build title
-	title := fmt.Sprintf("Issue #%d: %s", content.Number, content.Title)
-
-	// Build document
-	doc := domain.Document{
-		ID:        uuid.New().String(),
-		SourceID:  raw.SourceID,
-		URI:       raw.URI,
-		Title:     title,
-		Content:   sb.String(),
-		Metadata:  copyMetadata(raw.Metadata),
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-
-	// Add normaliser info to metadata
-	if doc.Metadata == nil {
-		doc.Metadata = make(map[string]any)
-	}
-	doc.Metadata["mime_type"] = raw.MIMEType
-	doc.Metadata["format"] = "github_issue"
+	return sb.String()
+}
 
-	return &driven.NormaliseResult{
-		Document: doc,
-	}, nil
+// loginAuthor returns the author identified by a GitHub login.
+func loginAuthor(login string) domain.Author {
+	return domain.Author{Identifier: login}
 }
 
 // copyMetadata creates a shallow copy of metadata.
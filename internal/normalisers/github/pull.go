@@ -7,8 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
-
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
@@ -16,8 +14,8 @@ import (
 // MIMETypeGitHubPull is the custom MIME type for GitHub pull requests.
 const MIMETypeGitHubPull = "application/vnd.github.pull+json"
 
-// Ensure PullNormaliser implements the interface.
-var _ driven.Normaliser = (*PullNormaliser)(nil)
+// Ensure PullNormaliser implements the interfaces.
+var _ driven.AppendingNormaliser = (*PullNormaliser)(nil)
 
 // PullNormaliser handles GitHub pull request documents.
 type PullNormaliser struct{}
@@ -75,17 +73,59 @@ type ReviewContent struct {
 
 // Normalise converts a GitHub PR document to a normalised document.
 func (n *PullNormaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
-	if raw == nil {
+	content, err := parsePull(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := newDocument(raw, pullTitle(content), content.Author, pullHead(content), "",
+		content.Comments, "github_pull_request")
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
+
+// Append adds the comments of a partial PR document to an existing one.
+// The header, description and reviews are rendered again from the partial
+// document; comments already in the existing document are kept as they are.
+func (n *PullNormaliser) Append(
+	_ context.Context, existing *domain.Document, raw *domain.RawDocument,
+) (*driven.NormaliseResult, error) {
+	if existing == nil {
 		return nil, domain.ErrInvalidInput
 	}
+	content, err := parsePull(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := appendedDocument(existing, raw, pullTitle(content), content.Author, pullHead(content),
+		content.Comments, "github_pull_request")
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
 
-	// Parse JSON content
+// parsePull parses the JSON content of a PR document.
+func parsePull(raw *domain.RawDocument) (*PRContent, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
 	var content PRContent
 	if err := json.Unmarshal(raw.Content, &content); err != nil {
 		return nil, fmt.Errorf("parse PR content: %w", err)
 	}
+	return &content, nil
+}
+
+// pullTitle returns the document title for a PR.
+func pullTitle(content *PRContent) string {
+	return fmt.Sprintf("PR #%d: %s", content.Number, content.Title)
+}
 
-	// Build normalised content with preserved authorship
+// pullHead renders the header, description and reviews of a PR, everything
+// before the comments section, with preserved authorship.
+func pullHead(content *PRContent) string {
 	var sb strings.Builder
 
 	// Header with metadata
@@ -148,42 +188,7 @@ func (n *PullNormaliser) Normalise(_ context.Context, raw *domain.RawDocument) (
 		}
 	}
 
-	// Comments
-	if len(content.Comments) > 0 {
-		sb.WriteString("## Comments\n\n")
-		for _, comment := range content.Comments {
-			sb.WriteString(fmt.Sprintf("### @%s (%s)\n\n%s\n\n",
-				comment.Author,
-				comment.CreatedAt.Format("2006-01-02 15:04"),
-				comment.Body))
-		}
-	}
-
-	// Build title
-	title := fmt.Sprintf("PR #%d: %s", content.Number, content.Title)
-
-	// Build document
-	doc := domain.Document{
-		ID:        uuid.New().String(),
-		SourceID:  raw.SourceID,
-		URI:       raw.URI,
-		Title:     title,
-		Content:   sb.String(),
-		Metadata:  copyMetadata(raw.Metadata),
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-
-	// Add normaliser info to metadata
-	if doc.Metadata == nil {
-		doc.Metadata = make(map[string]any)
-	}
-	doc.Metadata["mime_type"] = raw.MIMEType
-	doc.Metadata["format"] = "github_pull_request"
-
-	return &driven.NormaliseResult{
-		Document: doc,
-	}, nil
+	return sb.String()
 }
 
 // getReviewStateEmoji returns an emoji for the review state.
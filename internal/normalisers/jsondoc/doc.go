@@ -0,0 +1,4 @@
+// Package jsondoc provides a Normaliser implementation for JSON documents.
+// It flattens nested objects and arrays into "path.to.key: value" lines,
+// so each value is searchable alongside the path that gives it meaning.
+package jsondoc
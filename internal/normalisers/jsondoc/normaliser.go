@@ -0,0 +1,286 @@
+package jsondoc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Normaliser implements the interface.
+var _ driven.Normaliser = (*Normaliser)(nil)
+
+// Limits bounding the flattened output of large or deeply nested JSON.
+const (
+	// maxDepth is the deepest nesting flattened. Deeper objects and arrays
+	// are shown as {...} or [...].
+	maxDepth = 32
+
+	// maxContentSize is the largest flattened content kept (1MB).
+	maxContentSize = 1024 * 1024
+
+	// maxValueLength is the longest value kept on a line, in bytes.
+	maxValueLength = 1024
+
+	// maxTopLevelKeys is the most top-level keys stored in metadata.
+	maxTopLevelKeys = 100
+)
+
+// errLimitReached stops flattening once maxContentSize is reached.
+var errLimitReached = errors.New("content limit reached")
+
+// Normaliser handles JSON documents.
+type Normaliser struct{}
+
+// New creates a new JSON normaliser.
+func New() *Normaliser {
+	return &Normaliser{}
+}
+
+// SupportedMIMETypes returns the MIME types this normaliser handles.
+func (n *Normaliser) SupportedMIMETypes() []string {
+	return []string{
+		"application/json",
+	}
+}
+
+// SupportedConnectorTypes returns connector types for specialised handling.
+func (n *Normaliser) SupportedConnectorTypes() []string {
+	return nil // All connectors
+}
+
+// Priority returns the selection priority.
+func (n *Normaliser) Priority() int {
+	return 50 // Generic MIME normaliser
+}
+
+// Normalise converts a JSON document to one "path: value" line per leaf,
+// with array indices in brackets (e.g. "servers[0].port: 8080").
+// The top-level keys of an object are stored in the "json_keys" metadata.
+// Invalid JSON is kept as plain text.
+func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	f := &flattener{dec: json.NewDecoder(bytes.NewReader(raw.Content))}
+	f.dec.UseNumber()
+
+	err := f.value("", 0)
+	if errors.Is(err, errLimitReached) {
+		f.truncated = true
+		err = nil
+	}
+	if err != nil {
+		// Not valid JSON, so index it as it is
+		result := n.createDocument(raw, string(raw.Content))
+		result.Document.Metadata["format"] = "text"
+		return result, nil
+	}
+
+	result := n.createDocument(raw, f.sb.String())
+	if len(f.keys) > 0 {
+		result.Document.Metadata["json_keys"] = f.keys
+	}
+	if f.truncated {
+		result.Document.Metadata["truncated"] = true
+	}
+	return result, nil
+}
+
+// createDocument builds the normalised document.
+func (n *Normaliser) createDocument(raw *domain.RawDocument, content string) *driven.NormaliseResult {
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     extractTitleFromMetadataOrURI(raw),
+		Content:   strings.TrimSpace(content),
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	doc.Metadata["format"] = "json"
+
+	return &driven.NormaliseResult{
+		Document: doc,
+	}
+}
+
+// flattener streams JSON tokens into "path: value" lines, keeping the
+// document's key order.
+type flattener struct {
+	dec       *json.Decoder
+	sb        strings.Builder
+	keys      []string
+	truncated bool
+}
+
+// value flattens the next JSON value found at path.
+func (f *flattener) value(path string, depth int) error {
+	tok, err := f.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return f.leaf(path, formatScalar(tok))
+	}
+
+	if depth >= maxDepth {
+		f.truncated = true
+		if err := f.skip(); err != nil {
+			return err
+		}
+		if delim == '{' {
+			return f.leaf(path, "{...}")
+		}
+		return f.leaf(path, "[...]")
+	}
+
+	if delim == '{' {
+		return f.object(path, depth)
+	}
+	return f.array(path, depth)
+}
+
+// object flattens the members of an object whose opening brace was read.
+func (f *flattener) object(path string, depth int) error {
+	empty := true
+	for f.dec.More() {
+		tok, err := f.dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		if depth == 0 && len(f.keys) < maxTopLevelKeys {
+			f.keys = append(f.keys, key)
+		}
+		empty = false
+
+		if err := f.value(joinKey(path, key), depth+1); err != nil {
+			return err
+		}
+	}
+	if _, err := f.dec.Token(); err != nil { // Closing brace
+		return err
+	}
+	if empty {
+		return f.leaf(path, "{}")
+	}
+	return nil
+}
+
+// array flattens the elements of an array whose opening bracket was read.
+func (f *flattener) array(path string, depth int) error {
+	i := 0
+	for ; f.dec.More(); i++ {
+		if err := f.value(fmt.Sprintf("%s[%d]", path, i), depth+1); err != nil {
+			return err
+		}
+	}
+	if _, err := f.dec.Token(); err != nil { // Closing bracket
+		return err
+	}
+	if i == 0 {
+		return f.leaf(path, "[]")
+	}
+	return nil
+}
+
+// skip consumes the rest of an object or array whose opening delimiter was read.
+func (f *flattener) skip() error {
+	for nesting := 1; nesting > 0; {
+		tok, err := f.dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			nesting++
+		case json.Delim('}'), json.Delim(']'):
+			nesting--
+		}
+	}
+	return nil
+}
+
+// leaf writes one "path: value" line, or the bare value at the root.
+func (f *flattener) leaf(path, value string) error {
+	line := value
+	if path != "" {
+		line = path + ": " + value
+	}
+	if f.sb.Len()+len(line)+1 > maxContentSize {
+		return errLimitReached
+	}
+	f.sb.WriteString(line)
+	f.sb.WriteString("\n")
+	return nil
+}
+
+// joinKey appends an object key to a path.
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// formatScalar formats a string, number, boolean or null token for a line.
+// Line breaks in strings are replaced so each value stays on one line.
+func formatScalar(tok json.Token) string {
+	switch v := tok.(type) {
+	case nil:
+		return "null"
+	case string:
+		v = strings.Join(strings.Fields(v), " ")
+		if len(v) > maxValueLength {
+			v = strings.ToValidUTF8(v[:maxValueLength], "") + "..."
+		}
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// extractTitleFromMetadataOrURI checks metadata for title first, then falls back to the file name.
+func extractTitleFromMetadataOrURI(raw *domain.RawDocument) string {
+	if raw.Metadata != nil {
+		if title, ok := raw.Metadata["title"].(string); ok && title != "" {
+			return title
+		}
+	}
+	return filepath.Base(raw.URI)
+}
+
+// copyMetadata creates a shallow copy of metadata.
+func copyMetadata(src map[string]any) map[string]any {
+	if src == nil {
+		return nil
+	}
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,161 @@
+package jsondoc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+func TestNew(t *testing.T) {
+	normaliser := New()
+	require.NotNil(t, normaliser)
+	assert.IsType(t, &Normaliser{}, normaliser)
+}
+
+func TestSupportedMIMETypes(t *testing.T) {
+	normaliser := New()
+	assert.Equal(t, []string{"application/json"}, normaliser.SupportedMIMETypes())
+}
+
+func TestSupportedConnectorTypes(t *testing.T) {
+	normaliser := New()
+	assert.Nil(t, normaliser.SupportedConnectorTypes())
+}
+
+func TestPriority(t *testing.T) {
+	normaliser := New()
+	assert.Equal(t, 50, normaliser.Priority())
+}
+
+func TestNormalise_NilDocument(t *testing.T) {
+	normaliser := New()
+	result, err := normaliser.Normalise(context.Background(), nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Nil(t, result)
+}
+
+// normalise runs the normaliser on JSON content.
+func normalise(t *testing.T, content string) *driven.NormaliseResult {
+	t.Helper()
+	raw := &domain.RawDocument{
+		SourceID: "source-1",
+		URI:      "/config/settings.json",
+		MIMEType: "application/json",
+		Content:  []byte(content),
+	}
+	result, err := New().Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	return result
+}
+
+func TestNormalise_FlattensNestedObjects(t *testing.T) {
+	result := normalise(t, `{
+		"name": "api",
+		"server": {"host": "localhost", "port": 8080, "tls": false},
+		"servers": [{"region": "eu"}, {"region": "us"}],
+		"tags": ["a", "b"],
+		"owner": null,
+		"extra": {},
+		"list": []
+	}`)
+
+	expected := strings.Join([]string{
+		"name: api",
+		"server.host: localhost",
+		"server.port: 8080",
+		"server.tls: false",
+		"servers[0].region: eu",
+		"servers[1].region: us",
+		"tags[0]: a",
+		"tags[1]: b",
+		"owner: null",
+		"extra: {}",
+		"list: []",
+	}, "\n")
+	doc := result.Document
+	assert.Equal(t, expected, doc.Content)
+	assert.Equal(t, []string{"name", "server", "servers", "tags", "owner", "extra", "list"}, doc.Metadata["json_keys"])
+	assert.Equal(t, "json", doc.Metadata["format"])
+	assert.Equal(t, "application/json", doc.Metadata["mime_type"])
+	assert.Equal(t, "settings.json", doc.Title)
+	assert.Equal(t, "source-1", doc.SourceID)
+	assert.NotContains(t, doc.Metadata, "truncated")
+}
+
+func TestNormalise_RootArrayAndScalar(t *testing.T) {
+	result := normalise(t, `[{"id": 1}, 2.5]`)
+	assert.Equal(t, "[0].id: 1\n[1]: 2.5", result.Document.Content)
+	assert.NotContains(t, result.Document.Metadata, "json_keys")
+
+	result = normalise(t, `"just a string"`)
+	assert.Equal(t, "just a string", result.Document.Content)
+}
+
+func TestNormalise_StringsStayOnOneLine(t *testing.T) {
+	result := normalise(t, `{"description": "first line\nsecond   line"}`)
+	assert.Equal(t, "description: first line second line", result.Document.Content)
+}
+
+func TestNormalise_DepthBounded(t *testing.T) {
+	content := strings.Repeat(`{"a":`, maxDepth+5) + "1" + strings.Repeat("}", maxDepth+5)
+
+	result := normalise(t, content)
+
+	path := strings.TrimSuffix(strings.Repeat("a.", maxDepth), ".")
+	assert.Equal(t, path+": {...}", result.Document.Content)
+	assert.Equal(t, true, result.Document.Metadata["truncated"])
+}
+
+func TestNormalise_SizeBounded(t *testing.T) {
+	value := strings.Repeat("x", maxValueLength)
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i := range 2 * maxContentSize / maxValueLength {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, "%q", value)
+	}
+	sb.WriteString("]")
+
+	result := normalise(t, sb.String())
+
+	assert.LessOrEqual(t, len(result.Document.Content), maxContentSize)
+	assert.Equal(t, true, result.Document.Metadata["truncated"])
+}
+
+func TestNormalise_LongValuesShortened(t *testing.T) {
+	result := normalise(t, fmt.Sprintf(`{"blob": %q}`, strings.Repeat("y", 2*maxValueLength)))
+	assert.Equal(t, "blob: "+strings.Repeat("y", maxValueLength)+"...", result.Document.Content)
+}
+
+func TestNormalise_InvalidJSON(t *testing.T) {
+	result := normalise(t, `{"unterminated": `)
+	assert.Equal(t, `{"unterminated":`, result.Document.Content)
+	assert.Equal(t, "text", result.Document.Metadata["format"])
+}
+
+func TestNormalise_MetadataTitle(t *testing.T) {
+	raw := &domain.RawDocument{
+		SourceID: "source-1",
+		URI:      "gdrive://file/abc",
+		MIMEType: "application/json",
+		Content:  []byte(`{"a": 1}`),
+		Metadata: map[string]any{"title": "export.json"},
+	}
+
+	result, err := New().Normalise(context.Background(), raw)
+
+	require.NoError(t, err)
+	assert.Equal(t, "export.json", result.Document.Title)
+	assert.Equal(t, "export.json", raw.Metadata["title"])
+	assert.NotContains(t, raw.Metadata, "format", "raw metadata must not be modified")
+}
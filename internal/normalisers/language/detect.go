@@ -0,0 +1,161 @@
+package language
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SampleSize is the number of bytes at the start of the content that
+// detection looks at. Longer documents rarely change language part way
+// through, and sampling keeps detection cheap for large files.
+const SampleSize = 4096
+
+// minLetters is the number of letters below which a sample is too short to judge.
+const minLetters = 20
+
+// minWordMatches is the number of common words a Latin-script language must
+// match before it is reported.
+const minWordMatches = 4
+
+// scriptShare is the share of letters a non-Latin script needs to decide
+// the language on its own.
+const scriptShare = 0.5
+
+// commonWords lists frequent short words of Latin-script languages that
+// Xapian has stemmers for. Words shared by several languages count for each.
+var commonWords = map[string][]string{
+	"en": {"the", "and", "of", "to", "is", "in", "that", "it", "was", "for",
+		"with", "you", "this", "are", "be", "have", "not", "on", "as", "by"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ein", "eine", "mit", "sich",
+		"auf", "den", "dem", "des", "von", "zu", "auch", "wir", "ich", "es"},
+	"fr": {"le", "la", "les", "et", "est", "des", "une", "un", "pas", "que",
+		"qui", "dans", "pour", "sur", "avec", "du", "au", "ce", "je", "nous"},
+	"es": {"el", "la", "los", "las", "y", "es", "que", "de", "en", "un",
+		"una", "por", "con", "para", "del", "se", "no", "lo", "como", "pero"},
+	"it": {"il", "lo", "la", "gli", "le", "di", "che", "è", "e", "per",
+		"non", "un", "una", "con", "del", "della", "sono", "anche", "come", "questo"},
+	"pt": {"o", "os", "a", "as", "e", "de", "que", "não", "um", "uma",
+		"para", "com", "do", "da", "em", "no", "na", "é", "se", "mais"},
+	"nl": {"de", "het", "een", "en", "van", "is", "dat", "niet", "op", "te",
+		"met", "zijn", "voor", "die", "er", "ook", "aan", "maar", "wij", "ik"},
+	"sv": {"och", "att", "det", "som", "en", "är", "på", "av", "för", "med",
+		"inte", "till", "den", "har", "jag", "vi", "de", "om", "ett", "men"},
+}
+
+// wordLanguages maps each common word to the languages it belongs to.
+var wordLanguages = func() map[string][]string {
+	m := make(map[string][]string)
+	for lang, words := range commonWords {
+		for _, w := range words {
+			m[w] = append(m[w], lang)
+		}
+	}
+	return m
+}()
+
+// scripts maps non-Latin scripts to the language reported when they make
+// up most of the sample. Han is handled separately, since Japanese mixes it
+// with kana.
+var scripts = []struct {
+	table *unicode.RangeTable
+	code  string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Greek, "el"},
+	{unicode.Arabic, "ar"},
+	{unicode.Hebrew, "he"},
+	{unicode.Thai, "th"},
+	{unicode.Devanagari, "hi"},
+}
+
+// Detect returns the ISO 639-1 code of the language text is written in,
+// judged from its first SampleSize bytes. ok is false when the sample is
+// too short or too mixed to tell, so callers can fall back to a default.
+func Detect(text string) (code string, ok bool) {
+	sample := sampleOf(text)
+
+	letters, han, kana := 0, 0, 0
+	counts := make([]int, len(scripts))
+	for _, r := range sample {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		switch {
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+			kana++
+		default:
+			for i, s := range scripts {
+				if unicode.Is(s.table, r) {
+					counts[i]++
+					break
+				}
+			}
+		}
+	}
+	if letters < minLetters {
+		return "", false
+	}
+
+	// Japanese writes kana between Han characters; Chinese has none
+	if float64(han+kana) >= scriptShare*float64(letters) {
+		if kana > 0 {
+			return "ja", true
+		}
+		return "zh", true
+	}
+	for i, s := range scripts {
+		if float64(counts[i]) >= scriptShare*float64(letters) {
+			return s.code, true
+		}
+	}
+
+	return detectLatin(sample)
+}
+
+// detectLatin picks the Latin-script language whose common words appear
+// most often. The winner must match enough words and clearly beat the
+// runner-up, as closely related languages share many of them.
+func detectLatin(sample string) (string, bool) {
+	scores := make(map[string]int)
+	words := strings.FieldsFunc(strings.ToLower(sample), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, w := range words {
+		for _, lang := range wordLanguages[w] {
+			scores[lang]++
+		}
+	}
+
+	best, bestScore, second := "", 0, 0
+	for lang, score := range scores {
+		switch {
+		case score > bestScore:
+			best, bestScore, second = lang, score, bestScore
+		case score > second:
+			second = score
+		}
+	}
+
+	if bestScore < minWordMatches || bestScore*4 < second*5 {
+		return "", false
+	}
+	return best, true
+}
+
+// sampleOf returns the first SampleSize bytes of text, cut back to a whole
+// rune so a multi-byte character is not split.
+func sampleOf(text string) string {
+	if len(text) <= SampleSize {
+		return text
+	}
+	end := SampleSize
+	for end > 0 && !utf8.RuneStart(text[end]) {
+		end--
+	}
+	return text[:end]
+}
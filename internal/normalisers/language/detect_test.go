@@ -0,0 +1,70 @@
+package language
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"english", "The meeting was moved to Thursday. Please bring the notes for the budget review, and let me know if this is not possible for you.", "en"},
+		{"german", "Das Treffen wurde auf Donnerstag verschoben. Bitte bring die Notizen mit, und sag mir, ob es dir nicht passt, denn wir müssen auch die Kosten besprechen.", "de"},
+		{"french", "La réunion est reportée à jeudi. Merci d'apporter les notes pour la revue du budget, et dites-moi si ce n'est pas possible pour vous avec nous.", "fr"},
+		{"spanish", "La reunión se ha movido al jueves. Por favor trae las notas para la revisión del presupuesto y dime si no es posible, pero como siempre lo intentamos.", "es"},
+		{"italian", "La riunione è stata spostata a giovedì. Per favore porta gli appunti per la revisione del bilancio e dimmi se non è possibile, anche questo è importante.", "it"},
+		{"portuguese", "A reunião foi movida para quinta-feira. Por favor traga as notas para a revisão do orçamento e diga se não é possível, mais uma vez com os dados.", "pt"},
+		{"dutch", "De vergadering is verplaatst naar donderdag. Neem de aantekeningen mee voor de begroting en laat het weten als dat niet lukt, maar ook wij zijn er.", "nl"},
+		{"swedish", "Mötet har flyttats till torsdag. Ta med anteckningarna för budgeten och säg till om det inte går, men vi kan också ses på fredag som jag sa.", "sv"},
+		{"russian", "Встреча перенесена на четверг. Пожалуйста, принесите заметки для обзора бюджета.", "ru"},
+		{"japanese", "会議は木曜日に変更されました。予算のレビューのためにメモを持ってきてください。", "ja"},
+		{"chinese", "会议改到星期四了。请带上预算审查的笔记，如果不方便请告诉我。", "zh"},
+		{"korean", "회의가 목요일로 변경되었습니다. 예산 검토를 위한 메모를 가져오세요.", "ko"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Detect(tt.text)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDetect_LowConfidence(t *testing.T) {
+	tests := map[string]string{
+		"empty": "",
+		"short": "Hello there",
+		"code":  "func main() { fmt.Println(strconv.Itoa(42)); os.Exit(1) }",
+		"names": "Alice Bob Carol Dave Erin Frank Grace Heidi Ivan Judy Mallory",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, ok := Detect(text)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestDetect_SamplesStart(t *testing.T) {
+	// Only the start of the content is looked at
+	text := strings.Repeat("The notes are in the shared folder for you. ", 200) +
+		strings.Repeat("Die Notizen sind nicht in dem Ordner, und wir auch nicht. ", 200)
+
+	got, ok := Detect(text)
+	assert.True(t, ok)
+	assert.Equal(t, "en", got)
+}
+
+func TestSampleOf_KeepsWholeRunes(t *testing.T) {
+	text := strings.Repeat("a", SampleSize-1) + "é"
+
+	sample := sampleOf(text)
+	assert.Equal(t, strings.Repeat("a", SampleSize-1), sample)
+}
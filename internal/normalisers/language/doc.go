@@ -0,0 +1,5 @@
+// Package language detects the natural language a document is written in.
+// Detection is cheap rather than exact: it samples the start of the content,
+// tells non-Latin scripts apart by their characters and Latin-script
+// languages by how often their most common words appear.
+package language
@@ -0,0 +1,4 @@
+// Package latex provides a Normaliser implementation for LaTeX sources.
+// It strips commands, comments and non-prose environments to produce
+// readable text, keeping section headings and citation keys.
+package latex
@@ -0,0 +1,495 @@
+package latex
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Normaliser implements the interface.
+var _ driven.Normaliser = (*Normaliser)(nil)
+
+// Normaliser handles LaTeX documents.
+type Normaliser struct{}
+
+// New creates a new LaTeX normaliser.
+func New() *Normaliser {
+	return &Normaliser{}
+}
+
+// SupportedMIMETypes returns the MIME types this normaliser handles.
+func (n *Normaliser) SupportedMIMETypes() []string {
+	return []string{"text/x-latex", "application/x-latex", "text/x-tex"}
+}
+
+// SupportedConnectorTypes returns connector types for specialised handling.
+func (n *Normaliser) SupportedConnectorTypes() []string {
+	return nil // All connectors
+}
+
+// Priority returns the selection priority.
+func (n *Normaliser) Priority() int {
+	return 50 // Generic MIME normaliser, higher than plaintext
+}
+
+// Normalise converts a LaTeX document to a normalised document.
+// The Content field contains readable prose with markup removed.
+// Chunking is handled by the PostProcessor pipeline.
+func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	p := newParser(string(raw.Content))
+	p.run(0)
+
+	title := p.title
+	if title == "" {
+		title = p.firstHeading
+	}
+	if title == "" {
+		title = titleFromFilename(raw.URI)
+	}
+
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     title,
+		Content:   tidy(p.out.String()),
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	doc.Metadata["format"] = "latex"
+	if p.author != "" {
+		doc.Metadata["author"] = p.author
+	}
+
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
+
+// Commands that start a heading.
+var sectionCommands = map[string]bool{
+	"part": true, "chapter": true, "section": true, "subsection": true,
+	"subsubsection": true, "paragraph": true, "subparagraph": true,
+}
+
+// Commands whose argument is a list of citation keys.
+var citeCommands = map[string]bool{
+	"cite": true, "citep": true, "citet": true, "parencite": true, "textcite": true,
+	"autocite": true, "footcite": true, "citeauthor": true, "citeyear": true,
+}
+
+// Commands whose arguments are not prose and are dropped entirely.
+var dropCommands = map[string]bool{
+	"label": true, "ref": true, "eqref": true, "autoref": true, "cref": true, "Cref": true,
+	"pageref": true, "nocite": true, "includegraphics": true, "usepackage": true,
+	"documentclass": true, "bibliographystyle": true, "bibliography": true,
+	"addbibresource": true, "input": true, "include": true, "vspace": true, "hspace": true,
+	"setlength": true, "setcounter": true, "addtocounter": true, "newcommand": true,
+	"renewcommand": true, "providecommand": true, "newenvironment": true,
+	"renewenvironment": true, "DeclareMathOperator": true, "pagestyle": true,
+	"thispagestyle": true, "graphicspath": true, "hypersetup": true, "geometry": true,
+	"date": true,
+}
+
+// Commands whose first argument is dropped and the rest kept.
+var lastArgCommands = map[string]bool{
+	"href": true, "textcolor": true, "colorbox": true,
+}
+
+// Commands that print literal text.
+var symbolCommands = map[string]string{
+	"LaTeX": "LaTeX", "LaTeXe": "LaTeX2e", "TeX": "TeX",
+	"ldots": "...", "dots": "...", "textendash": "-", "textemdash": "-",
+	"par": "\n\n", "newline": "\n", "linebreak": "\n",
+}
+
+// Environments replaced by a placeholder naming the environment.
+var placeholderEnvs = map[string]bool{
+	"equation": true, "align": true, "alignat": true, "gather": true, "multline": true,
+	"flalign": true, "eqnarray": true, "math": true, "displaymath": true,
+	"figure": true, "table": true, "tabular": true, "tabularx": true, "longtable": true,
+	"verbatim": true, "lstlisting": true, "minted": true, "tikzpicture": true,
+	"algorithm": true, "algorithmic": true, "comment": true,
+}
+
+// Environments that take a required argument that isn't prose.
+var envArgs = map[string]bool{
+	"thebibliography": true, "minipage": true, "multicols": true,
+}
+
+// parser is a small state machine over LaTeX source.
+// It copies text through and interprets commands, groups, comments and math.
+type parser struct {
+	src string
+	pos int
+	out strings.Builder
+
+	title        string
+	author       string
+	firstHeading string
+}
+
+// newParser creates a parser for the given source.
+func newParser(src string) *parser {
+	return &parser{src: src}
+}
+
+// run processes input until the end, or until the closing delimiter until
+// is consumed. A zero delimiter runs to the end of input.
+func (p *parser) run(until byte) {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case until != 0 && c == until:
+			p.pos++
+			return
+		case c == '%':
+			p.skipComment()
+		case c == '\\':
+			p.command()
+		case c == '{':
+			p.pos++
+			p.run('}')
+		case c == '}':
+			// Unbalanced close brace
+			p.pos++
+		case c == '$':
+			p.dollarMath()
+		case c == '~':
+			p.out.WriteByte(' ')
+			p.pos++
+		case strings.HasPrefix(p.src[p.pos:], "``"), strings.HasPrefix(p.src[p.pos:], "''"):
+			p.out.WriteByte('"')
+			p.pos += 2
+		default:
+			p.out.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// skipComment skips from % to the end of the line, including the newline.
+func (p *parser) skipComment() {
+	end := strings.IndexByte(p.src[p.pos:], '\n')
+	if end < 0 {
+		p.pos = len(p.src)
+		return
+	}
+	p.pos += end + 1
+}
+
+// command handles a backslash sequence.
+//
+//nolint:gocyclo // Dispatch over command families
+func (p *parser) command() {
+	p.pos++ // backslash
+	if p.pos >= len(p.src) {
+		return
+	}
+
+	if !isLetter(p.src[p.pos]) {
+		p.controlSymbol()
+		return
+	}
+
+	start := p.pos
+	for p.pos < len(p.src) && isLetter(p.src[p.pos]) {
+		p.pos++
+	}
+	name := p.src[start:p.pos]
+	if p.pos < len(p.src) && p.src[p.pos] == '*' {
+		p.pos++
+	}
+
+	switch {
+	case name == "begin":
+		p.beginEnv(p.readArg())
+	case name == "end":
+		p.readArg()
+		p.out.WriteString("\n\n")
+	case sectionCommands[name]:
+		p.skipOptional()
+		heading := render(p.readArg())
+		if p.firstHeading == "" {
+			p.firstHeading = heading
+		}
+		p.out.WriteString("\n\n" + heading + "\n\n")
+	case citeCommands[name]:
+		p.skipOptional()
+		p.skipOptional()
+		p.out.WriteString(formatKeys(p.readArg()))
+	case name == "bibitem":
+		p.skipOptional()
+		p.out.WriteString("\n\n" + formatKeys(p.readArg()) + " ")
+	case name == "item":
+		label := ""
+		if p.peek() == '[' {
+			label = render(p.readOptional()) + " "
+		}
+		p.out.WriteString("\n\n- " + label)
+	case name == "title":
+		p.title = render(p.readArg())
+	case name == "author":
+		authors := strings.Split(p.readArg(), `\and`)
+		for i := range authors {
+			authors[i] = render(authors[i])
+		}
+		p.author = strings.Join(authors, ", ")
+	case name == "url":
+		p.out.WriteString(p.readArg())
+	case name == "verb":
+		p.verb()
+	case name == "footnote":
+		p.out.WriteString(" (" + render(p.readArg()) + ")")
+	case dropCommands[name]:
+		p.skipArgs()
+	case lastArgCommands[name]:
+		p.readArg()
+	case symbolCommands[name] != "":
+		p.out.WriteString(symbolCommands[name])
+	default:
+		// Formatting commands such as \textbf{...} keep their argument.
+		// Commands without arguments are dropped.
+		p.skipOptional()
+	}
+}
+
+// controlSymbol handles a backslash followed by a non-letter.
+func (p *parser) controlSymbol() {
+	c := p.src[p.pos]
+	p.pos++
+	switch c {
+	case '\\':
+		p.skipOptional()
+		p.out.WriteByte('\n')
+	case '%', '&', '$', '#', '_', '{', '}':
+		p.out.WriteByte(c)
+	case ' ', ',', '\n':
+		p.out.WriteByte(' ')
+	case '[':
+		p.skipPast(`\]`)
+		p.out.WriteString("\n\n[equation]\n\n")
+	case '(':
+		end := strings.Index(p.src[p.pos:], `\)`)
+		if end < 0 {
+			end = len(p.src) - p.pos
+		}
+		p.out.WriteString(strings.TrimSpace(p.src[p.pos : p.pos+end]))
+		p.pos = min(p.pos+end+2, len(p.src))
+	}
+}
+
+// beginEnv handles \begin{env}. Non-prose environments are replaced by a
+// placeholder; others are transparent and their content is processed.
+func (p *parser) beginEnv(env string) {
+	name := strings.TrimSuffix(env, "*")
+	if placeholderEnvs[name] {
+		p.skipPast(`\end{` + env + `}`)
+		p.out.WriteString("\n\n[" + name + "]\n\n")
+		return
+	}
+
+	p.skipOptional()
+	if envArgs[name] {
+		p.readArg()
+	}
+	p.out.WriteString("\n\n")
+}
+
+// dollarMath handles $...$ inline math and $$...$$ display math.
+// Inline math is kept verbatim; display math becomes a placeholder.
+func (p *parser) dollarMath() {
+	if strings.HasPrefix(p.src[p.pos:], "$$") {
+		p.pos += 2
+		p.skipPast("$$")
+		p.out.WriteString("\n\n[equation]\n\n")
+		return
+	}
+
+	p.pos++
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] != '$' {
+		if p.src[p.pos] == '\\' {
+			p.pos++
+		}
+		p.pos++
+	}
+	p.out.WriteString(strings.TrimSpace(p.src[start:min(p.pos, len(p.src))]))
+	p.pos = min(p.pos+1, len(p.src))
+}
+
+// verb handles \verb|...| where | is any delimiter.
+func (p *parser) verb() {
+	if p.pos >= len(p.src) {
+		return
+	}
+	delim := p.src[p.pos]
+	p.pos++
+	end := strings.IndexByte(p.src[p.pos:], delim)
+	if end < 0 {
+		end = len(p.src) - p.pos
+	}
+	p.out.WriteString(p.src[p.pos : p.pos+end])
+	p.pos = min(p.pos+end+1, len(p.src))
+}
+
+// readArg reads a {...} argument and returns its raw content.
+// Returns an empty string if no argument follows.
+func (p *parser) readArg() string {
+	p.skipSpaces()
+	if p.peek() != '{' {
+		return ""
+	}
+	return p.readBalanced('{', '}')
+}
+
+// readOptional reads a [...] argument and returns its raw content.
+func (p *parser) readOptional() string {
+	if p.peek() != '[' {
+		return ""
+	}
+	return p.readBalanced('[', ']')
+}
+
+// skipOptional skips a [...] argument if present.
+func (p *parser) skipOptional() {
+	p.readOptional()
+}
+
+// skipArgs skips any sequence of [...] and {...} arguments.
+func (p *parser) skipArgs() {
+	for {
+		switch p.peek() {
+		case '[':
+			p.readBalanced('[', ']')
+		case '{':
+			p.readBalanced('{', '}')
+		default:
+			return
+		}
+	}
+}
+
+// readBalanced reads from an opening delimiter to its matching close,
+// returning the content between them.
+func (p *parser) readBalanced(open, closing byte) string {
+	p.pos++ // opening delimiter
+	start := p.pos
+	depth := 1
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos++
+		case open:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				content := p.src[start:p.pos]
+				p.pos++
+				return content
+			}
+		}
+		p.pos++
+	}
+	return p.src[start:min(p.pos, len(p.src))]
+}
+
+// skipPast advances past the next occurrence of marker, or to the end.
+func (p *parser) skipPast(marker string) {
+	end := strings.Index(p.src[p.pos:], marker)
+	if end < 0 {
+		p.pos = len(p.src)
+		return
+	}
+	p.pos += end + len(marker)
+}
+
+// skipSpaces skips spaces and tabs.
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// peek returns the current byte, or 0 at the end of input.
+func (p *parser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// render converts a LaTeX fragment to a single line of plain text.
+func render(src string) string {
+	p := newParser(src)
+	p.run(0)
+	return strings.Join(strings.Fields(p.out.String()), " ")
+}
+
+// formatKeys formats comma-separated citation keys as [a, b].
+func formatKeys(keys string) string {
+	parts := strings.Split(keys, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+var paragraphBreak = regexp.MustCompile(`\n[ \t]*\n\s*`)
+
+// tidy joins hard-wrapped lines within paragraphs and separates paragraphs
+// with a blank line.
+func tidy(text string) string {
+	paragraphs := paragraphBreak.Split(text, -1)
+	result := make([]string, 0, len(paragraphs))
+	for _, para := range paragraphs {
+		if line := strings.Join(strings.Fields(para), " "); line != "" {
+			result = append(result, line)
+		}
+	}
+	return strings.Join(result, "\n\n")
+}
+
+// isLetter reports whether c can appear in a command name.
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// titleFromFilename derives a title from the document URI.
+func titleFromFilename(uri string) string {
+	filename := filepath.Base(uri)
+	filename = strings.TrimSuffix(filename, filepath.Ext(filename))
+	filename = strings.ReplaceAll(filename, "_", " ")
+	filename = strings.ReplaceAll(filename, "-", " ")
+	return filename
+}
+
+// copyMetadata creates a shallow copy of metadata.
+func copyMetadata(src map[string]any) map[string]any {
+	if src == nil {
+		return nil
+	}
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
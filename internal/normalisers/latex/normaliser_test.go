@@ -0,0 +1,154 @@
+package latex
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+func TestNew(t *testing.T) {
+	normaliser := New()
+	require.NotNil(t, normaliser)
+	assert.IsType(t, &Normaliser{}, normaliser)
+}
+
+func TestSupportedMIMETypes(t *testing.T) {
+	normaliser := New()
+	assert.Contains(t, normaliser.SupportedMIMETypes(), "text/x-latex")
+}
+
+func TestSupportedConnectorTypes(t *testing.T) {
+	normaliser := New()
+	assert.Nil(t, normaliser.SupportedConnectorTypes())
+}
+
+func TestPriority(t *testing.T) {
+	normaliser := New()
+	assert.Equal(t, 50, normaliser.Priority())
+}
+
+func TestNormalise_NilDocument(t *testing.T) {
+	result, err := New().Normalise(context.Background(), nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Nil(t, result)
+}
+
+func TestNormalise_Fixture(t *testing.T) {
+	content, err := os.ReadFile("testdata/paper.tex")
+	require.NoError(t, err)
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/papers/paper.tex",
+		MIMEType: "text/x-latex",
+		Content:  content,
+		Metadata: map[string]any{"size": 100},
+	}
+
+	result, err := New().Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	doc := result.Document
+	assert.NotEmpty(t, doc.ID)
+	assert.Equal(t, "test-source", doc.SourceID)
+	assert.Equal(t, "/papers/paper.tex", doc.URI)
+	assert.Equal(t, "Efficient Retrieval over Local Documents", doc.Title)
+	assert.Equal(t, "text/x-latex", doc.Metadata["mime_type"])
+	assert.Equal(t, "latex", doc.Metadata["format"])
+	assert.Equal(t, "Ada Lovelace, Alan Turing", doc.Metadata["author"])
+	assert.Equal(t, 100, doc.Metadata["size"])
+
+	// Prose is kept with formatting commands removed
+	assert.Contains(t, doc.Content, "We present a hybrid search engine for personal files.")
+	assert.Contains(t, doc.Content, "Results improve recall by 12% over keyword search alone.")
+	assert.Contains(t, doc.Content, "has made semantic search practical on a laptop.")
+	assert.Contains(t, doc.Content, "- An evaluation on mixed corpora.")
+	assert.Contains(t, doc.Content, "Hybrid search is cheap and effective. (Code is available online.)")
+
+	// Headings are kept on their own lines
+	for _, heading := range []string{"Introduction", "Contributions", "Method", "Conclusion"} {
+		assert.Contains(t, doc.Content, "\n\n"+heading+"\n\n")
+	}
+
+	// Citations keep their keys
+	assert.Contains(t, doc.Content, "an old problem [salton1975].")
+	assert.Contains(t, doc.Content, "[karpukhin2020, lewis2020]")
+
+	// Non-prose environments become placeholders
+	assert.Contains(t, doc.Content, "\n\n[equation]\n\n")
+	assert.Contains(t, doc.Content, "\n\n[figure]\n\n")
+	assert.NotContains(t, doc.Content, "RRF")
+	assert.NotContains(t, doc.Content, "indexing pipeline")
+
+	// Comments and markup are removed
+	assert.NotContains(t, doc.Content, "TODO")
+	assert.NotContains(t, doc.Content, "\\")
+	assert.NotContains(t, doc.Content, "{")
+	assert.NotContains(t, doc.Content, "sec:intro")
+	assert.NotContains(t, doc.Content, "amsmath")
+}
+
+func TestNormalise_TitleFallbacks(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"section heading", `\section{Overview} Text.`, "Overview"},
+		{"filename", `Just text.`, "lecture notes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := &domain.RawDocument{URI: "/docs/lecture_notes.tex", Content: []byte(tt.content)}
+			result, err := New().Normalise(context.Background(), raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Document.Title)
+		})
+	}
+}
+
+func TestStrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"bold", `A \textbf{bold} word.`, "A bold word."},
+		{"nested", `\emph{very \textbf{nested}} text`, "very nested text"},
+		{"comment", "kept % dropped\nnext line", "kept next line"},
+		{"escaped percent", `50\% off`, "50% off"},
+		{"citation", `As shown \cite{knuth84}.`, "As shown [knuth84]."},
+		{"citation with keys", `\citep[p.~3]{a,b}`, "[a, b]"},
+		{"inline math", `where $x^2$ grows`, "where x^2 grows"},
+		{"display math", `before \[ x = 1 \] after`, "before\n\n[equation]\n\nafter"},
+		{"starred env", "a\n\\begin{align*}x\\end{align*}\nb", "a\n\n[align]\n\nb"},
+		{"href", `\href{https://example.com}{the site}`, "the site"},
+		{"url", `\url{https://example.com}`, "https://example.com"},
+		{"verb", `run \verb|go test| now`, "run go test now"},
+		{"quotes and ties", "``quoted''~text", `"quoted" text`},
+		{"paragraphs", "line one\nline two\n\nnew para", "line one line two\n\nnew para"},
+		{"subsection", `\subsection*{Results} Body`, "Results\n\nBody"},
+		{"unclosed group", `\textbf{never closed`, "never closed"},
+		{"unclosed env", `\begin{equation} x`, "[equation]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newParser(tt.input)
+			p.run(0)
+			assert.Equal(t, tt.expected, tidy(p.out.String()))
+		})
+	}
+}
+
+func TestNormalise_ImplementsInterface(t *testing.T) {
+	var _ driven.Normaliser = (*Normaliser)(nil)
+}
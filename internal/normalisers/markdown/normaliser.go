@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
@@ -47,10 +48,17 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 		return nil, domain.ErrInvalidInput
 	}
 
-	rawContent := string(raw.Content)
+	// Frontmatter is metadata, not prose
+	frontmatter, rawContent := splitFrontmatter(string(raw.Content))
 
-	// Extract title from first heading or filename
-	title := extractMarkdownTitle(rawContent, raw.URI)
+	// Extract title from frontmatter, first heading or filename
+	title, _ := frontmatter["title"].(string)
+	if strings.TrimSpace(title) == "" {
+		title = extractMarkdownTitle(rawContent, raw.URI)
+	}
+
+	// Collect wiki-link targets before they are flattened to text
+	links := wikiLinkTargets(rawContent)
 
 	// Convert markdown to plain text (simplified)
 	content := stripMarkdown(rawContent)
@@ -73,6 +81,15 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 	}
 	doc.Metadata["mime_type"] = raw.MIMEType
 	doc.Metadata["format"] = "markdown"
+	if tags := stringList(frontmatter["tags"]); len(tags) > 0 {
+		doc.Metadata["tags"] = tags
+	}
+	if aliases := stringList(frontmatter["aliases"]); len(aliases) > 0 {
+		doc.Metadata["aliases"] = aliases
+	}
+	if len(links) > 0 {
+		doc.Metadata["links"] = links
+	}
 
 	return &driven.NormaliseResult{
 		Document: doc,
@@ -101,6 +118,96 @@ func extractMarkdownTitle(content, uri string) string {
 	return filename
 }
 
+// splitFrontmatter separates a leading YAML frontmatter block from the body.
+// Content is returned unchanged when the block is missing or is not valid YAML.
+func splitFrontmatter(content string) (map[string]any, string) {
+	normalised := strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(normalised, "---\n") {
+		return nil, content
+	}
+
+	rest := normalised[len("---\n"):]
+	end := -1
+	bodyStart := 0
+	for offset := 0; offset < len(rest); {
+		line, _, _ := strings.Cut(rest[offset:], "\n")
+		if trimmed := strings.TrimRight(line, " \t"); trimmed == "---" || trimmed == "..." {
+			end = offset
+			bodyStart = min(offset+len(line)+1, len(rest))
+			break
+		}
+		offset += len(line) + 1
+	}
+	if end < 0 {
+		return nil, content
+	}
+
+	var frontmatter map[string]any
+	if err := yaml.Unmarshal([]byte(rest[:end]), &frontmatter); err != nil {
+		return nil, content
+	}
+	return frontmatter, rest[bodyStart:]
+}
+
+// stringList converts a frontmatter value that may be a single string or a list.
+// Obsidian accepts both forms, and comma-separated strings, for tags and aliases.
+func stringList(value any) []string {
+	var items []string
+	switch v := value.(type) {
+	case string:
+		items = strings.Split(v, ",")
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				items = append(items, s)
+			}
+		}
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(item), "#")); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// wikiLink matches Obsidian wiki-links: [[target]], [[target|alias]] and
+// [[target#heading]], optionally prefixed with ! for embeds.
+var wikiLink = regexp.MustCompile(`(!?)\[\[([^\[\]|]+)(?:\|([^\[\]]*))?\]\]`)
+
+// wikiLinkTargets returns the distinct notes linked to, in order of first use.
+func wikiLinkTargets(content string) []string {
+	var targets []string
+	seen := make(map[string]bool)
+	for _, match := range wikiLink.FindAllStringSubmatch(content, -1) {
+		target, _, _ := strings.Cut(match[2], "#")
+		target = strings.TrimSpace(target)
+		if target == "" || seen[target] {
+			continue
+		}
+		seen[target] = true
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// replaceWikiLinks converts wiki-links to their display text and drops embeds.
+func replaceWikiLinks(content string) string {
+	return wikiLink.ReplaceAllStringFunc(content, func(link string) string {
+		match := wikiLink.FindStringSubmatch(link)
+		if match[1] == "!" {
+			return ""
+		}
+		if alias := strings.TrimSpace(match[3]); alias != "" {
+			return alias
+		}
+		// [[Note#Heading]] reads as "Note Heading"
+		return strings.Join(strings.Fields(strings.ReplaceAll(match[2], "#", " ")), " ")
+	})
+}
+
 // stripMarkdown removes common markdown formatting for plain text content.
 // This is a simplified implementation that handles common cases.
 func stripMarkdown(content string) string {
@@ -108,6 +215,9 @@ func stripMarkdown(content string) string {
 	codeBlock := regexp.MustCompile("(?s)```[^`]*```")
 	content = codeBlock.ReplaceAllString(content, "")
 
+	// Convert wiki-links to their text and remove embeds
+	content = replaceWikiLinks(content)
+
 	// Remove inline code (`code`)
 	inlineCode := regexp.MustCompile("`[^`]+`")
 	content = inlineCode.ReplaceAllString(content, "")
@@ -322,3 +322,72 @@ code block
 		_ = stripMarkdown(content)
 	}
 }
+
+func TestNormalise_Frontmatter(t *testing.T) {
+	raw := &domain.RawDocument{
+		URI: "/vault/note.md",
+		Content: []byte("---\ntitle: Weekly Review\ntags: [work, \"#planning\"]\n" +
+			"aliases: review\n---\n# Heading\n\nBody text."),
+	}
+
+	result, err := New().Normalise(context.Background(), raw)
+	require.NoError(t, err)
+
+	doc := result.Document
+	assert.Equal(t, "Weekly Review", doc.Title)
+	assert.Equal(t, []string{"work", "planning"}, doc.Metadata["tags"])
+	assert.Equal(t, []string{"review"}, doc.Metadata["aliases"])
+	assert.NotContains(t, doc.Content, "tags")
+	assert.Equal(t, "Heading\n\nBody text.", doc.Content)
+}
+
+func TestNormalise_FrontmatterWithoutTitle(t *testing.T) {
+	raw := &domain.RawDocument{
+		URI:     "/vault/note.md",
+		Content: []byte("---\r\ntags: idea\r\n---\r\n# From Heading\r\n"),
+	}
+
+	result, err := New().Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, "From Heading", result.Document.Title)
+	assert.Equal(t, []string{"idea"}, result.Document.Metadata["tags"])
+}
+
+func TestSplitFrontmatter(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		frontmatter map[string]any
+		body        string
+	}{
+		{"none", "# Title\nText", nil, "# Title\nText"},
+		{"unterminated", "---\ntitle: x\nText", nil, "---\ntitle: x\nText"},
+		{"invalid yaml", "---\n: [\n---\nText", nil, "---\n: [\n---\nText"},
+		{"dots terminator", "---\nkey: v\n...\nText", map[string]any{"key": "v"}, "Text"},
+		{"empty body", "---\nkey: v\n---", map[string]any{"key": "v"}, ""},
+		{"horizontal rule later", "Text\n---\nMore", nil, "Text\n---\nMore"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			frontmatter, body := splitFrontmatter(tc.input)
+			assert.Equal(t, tc.frontmatter, frontmatter)
+			assert.Equal(t, tc.body, body)
+		})
+	}
+}
+
+func TestNormalise_WikiLinks(t *testing.T) {
+	raw := &domain.RawDocument{
+		URI: "/vault/note.md",
+		Content: []byte("See [[Project Plan]] and [[Meeting Notes#Actions|the actions]].\n" +
+			"Also [[Project Plan#Risks]].\n![[diagram.png]]"),
+	}
+
+	result, err := New().Normalise(context.Background(), raw)
+	require.NoError(t, err)
+
+	doc := result.Document
+	assert.Equal(t, "See Project Plan and the actions.\nAlso Project Plan Risks.", doc.Content)
+	assert.Equal(t, []string{"Project Plan", "Meeting Notes", "diagram.png"}, doc.Metadata["links"])
+}
@@ -36,6 +36,8 @@ func (n *Normaliser) SupportedMIMETypes() []string {
 		"text/x-ruby",
 		"text/x-shellscript",
 		"text/x-sql",
+		"text/x-dockerfile",
+		"text/x-makefile",
 		"text/csv",
 		"text/yaml",
 		"text/toml",
@@ -24,6 +24,8 @@ func TestSupportedMIMETypes(t *testing.T) {
 	require.NotEmpty(t, mimeTypes)
 	assert.Contains(t, mimeTypes, "text/plain")
 	assert.Contains(t, mimeTypes, "text/x-go")
+	assert.Contains(t, mimeTypes, "text/x-dockerfile")
+	assert.Contains(t, mimeTypes, "text/x-makefile")
 	assert.Contains(t, mimeTypes, "application/json")
 }
 
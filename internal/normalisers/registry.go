@@ -13,10 +13,14 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/github"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/html"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/ics"
+	"github.com/custodia-labs/sercha-cli/internal/normalisers/jsondoc"
+	"github.com/custodia-labs/sercha-cli/internal/normalisers/language"
+	"github.com/custodia-labs/sercha-cli/internal/normalisers/latex"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/markdown"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/notion"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/pdf"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/plaintext"
+	"github.com/custodia-labs/sercha-cli/internal/normalisers/slack"
 )
 
 // Ensure Registry implements the interface.
@@ -27,6 +31,9 @@ type Registry struct {
 	mu          sync.RWMutex
 	normalisers []driven.Normaliser
 	byMIME      map[string][]driven.Normaliser
+
+	// defaultLanguage is the language code stored when detection is unsure
+	defaultLanguage string
 }
 
 // NewRegistry creates a new normaliser registry with default normalisers.
@@ -40,6 +47,8 @@ func NewRegistry() *Registry {
 	r.Register(eml.New())
 	r.Register(html.New())
 	r.Register(ics.New())
+	r.Register(jsondoc.New())
+	r.Register(latex.New())
 	r.Register(markdown.New())
 	r.Register(pdf.New())
 	r.Register(plaintext.New())
@@ -47,12 +56,17 @@ func NewRegistry() *Registry {
 	// Register GitHub-specific normalisers
 	r.Register(github.NewIssue())
 	r.Register(github.NewPull())
+	r.Register(github.NewGist())
+	r.Register(github.NewCommit())
 
 	// Register Notion-specific normalisers
 	r.Register(notion.NewPage())
 	r.Register(notion.NewDatabase())
 	r.Register(notion.NewDatabaseItem())
 
+	// Register Slack message normaliser
+	r.Register(slack.NewMessage())
+
 	return r
 }
 
@@ -67,7 +81,66 @@ func (r *Registry) Normalise(ctx context.Context, raw *domain.RawDocument) (*dri
 	}
 
 	// Candidates are already sorted by priority
-	return candidates[0].Normalise(ctx, raw)
+	result, err := candidates[0].Normalise(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+	r.tagLanguage(result)
+	return result, nil
+}
+
+// Append merges a partial raw document into an existing document using the
+// highest priority normaliser for its MIME type that supports appending.
+func (r *Registry) Append(
+	ctx context.Context, existing *domain.Document, raw *domain.RawDocument,
+) (*driven.NormaliseResult, error) {
+	r.mu.RLock()
+	candidates := r.byMIME[raw.MIMEType]
+	r.mu.RUnlock()
+
+	for _, candidate := range candidates {
+		if appender, ok := candidate.(driven.AppendingNormaliser); ok {
+			result, err := appender.Append(ctx, existing, raw)
+			if err != nil {
+				return nil, err
+			}
+			r.tagLanguage(result)
+			return result, nil
+		}
+	}
+	return nil, fmt.Errorf("no appending normaliser for MIME type %q: %w", raw.MIMEType, domain.ErrNotImplemented)
+}
+
+// SetDefaultLanguage sets the ISO 639-1 code stored for documents whose
+// language cannot be detected. Empty leaves their language unset.
+func (r *Registry) SetDefaultLanguage(code string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.defaultLanguage = code
+}
+
+// tagLanguage stores the language of a normalised document in its metadata,
+// falling back to the default language when detection is unsure. A language
+// set by the normaliser is kept.
+func (r *Registry) tagLanguage(result *driven.NormaliseResult) {
+	if result == nil || domain.DocumentLanguage(result.Document.Metadata) != "" {
+		return
+	}
+
+	code, ok := language.Detect(result.Document.Content)
+	if !ok {
+		r.mu.RLock()
+		code = r.defaultLanguage
+		r.mu.RUnlock()
+	}
+	if code == "" {
+		return
+	}
+
+	if result.Document.Metadata == nil {
+		result.Document.Metadata = make(map[string]any)
+	}
+	result.Document.Metadata[domain.MetadataLanguage] = code
 }
 
 // Register adds a normaliser to the registry.
@@ -94,3 +167,10 @@ func (r *Registry) SupportedMIMETypes() []string {
 	}
 	return types
 }
+
+// Supports reports whether a normaliser is registered for the MIME type.
+func (r *Registry) Supports(mimeType string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.byMIME[mimeType]) > 0
+}
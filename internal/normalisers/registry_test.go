@@ -56,7 +56,7 @@ func TestNewRegistry(t *testing.T) {
 
 	// Verify default normalisers are registered
 	assert.NotEmpty(t, registry.normalisers, "registry should have default normalisers")
-	assert.Equal(t, 12, len(registry.normalisers), "should have 12 default normalisers (docx, eml, html, ics, markdown, pdf, plaintext, github-issue, github-pull, notion-page, notion-database, notion-database-item)")
+	assert.Equal(t, 17, len(registry.normalisers), "should have 17 default normalisers (docx, eml, html, ics, json, latex, markdown, pdf, plaintext, github-issue, github-pull, github-gist, github-commit, notion-page, notion-database, notion-database-item, slack-message)")
 
 	// Verify MIME types are indexed
 	supportedTypes := registry.SupportedMIMETypes()
@@ -69,6 +69,7 @@ func TestNewRegistry(t *testing.T) {
 		"message/rfc822":   true,
 		"text/calendar":    true,
 		"text/html":        true,
+		"text/x-latex":     true,
 		"text/markdown":    true,
 		"text/x-markdown":  true,
 		"text/plain":       true,
@@ -387,6 +388,100 @@ func TestRegistrySupportedMIMETypesEmpty(t *testing.T) {
 	assert.Empty(t, supportedTypes, "should have no supported MIME types")
 }
 
+// TestRegistrySupports verifies that only registered MIME types are supported.
+func TestRegistrySupports(t *testing.T) {
+	registry := &Registry{
+		normalisers: make([]driven.Normaliser, 0),
+		byMIME:      make(map[string][]driven.Normaliser),
+	}
+	registry.Register(&mockNormaliser{mimeTypes: []string{"text/type1"}})
+
+	assert.True(t, registry.Supports("text/type1"))
+	assert.False(t, registry.Supports("application/octet-stream"))
+	assert.False(t, registry.Supports(""))
+
+	// The default registry supports the built-in types
+	assert.True(t, NewRegistry().Supports("text/plain"))
+}
+
+// appendingNormaliser is a mock normaliser that also supports appending.
+type appendingNormaliser struct {
+	mockNormaliser
+}
+
+func (a *appendingNormaliser) Append(
+	_ context.Context, existing *domain.Document, raw *domain.RawDocument,
+) (*driven.NormaliseResult, error) {
+	doc := *existing
+	doc.Content += string(raw.Content)
+	return &driven.NormaliseResult{Document: doc}, nil
+}
+
+// TestRegistryAppend verifies that appending uses the highest priority
+// normaliser that supports it and fails for types without one.
+func TestRegistryAppend(t *testing.T) {
+	registry := &Registry{
+		normalisers: make([]driven.Normaliser, 0),
+		byMIME:      make(map[string][]driven.Normaliser),
+	}
+	registry.Register(&mockNormaliser{mimeTypes: []string{"text/type1"}, priority: 90})
+	registry.Register(&appendingNormaliser{mockNormaliser{mimeTypes: []string{"text/type1"}, priority: 50}})
+	registry.Register(&mockNormaliser{mimeTypes: []string{"text/type2"}})
+
+	existing := &domain.Document{ID: "doc-1", Content: "first"}
+	result, err := registry.Append(context.Background(), existing, &domain.RawDocument{
+		MIMEType: "text/type1",
+		Content:  []byte(" second"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "doc-1", result.Document.ID)
+	assert.Equal(t, "first second", result.Document.Content)
+	assert.Equal(t, "first", existing.Content)
+
+	_, err = registry.Append(context.Background(), existing, &domain.RawDocument{MIMEType: "text/type2"})
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
+// TestRegistryNormaliseDetectsLanguage verifies that normalised documents
+// are tagged with their detected language, or the default when unsure.
+func TestRegistryNormaliseDetectsLanguage(t *testing.T) {
+	registry := &Registry{
+		normalisers: make([]driven.Normaliser, 0),
+		byMIME:      make(map[string][]driven.Normaliser),
+	}
+	registry.Register(&mockNormaliser{mimeTypes: []string{"text/test"}})
+	registry.Register(&mockNormaliser{
+		mimeTypes: []string{"text/tagged"},
+		normaliseFunc: func(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+			return &driven.NormaliseResult{Document: domain.Document{
+				Content:  string(raw.Content),
+				Metadata: map[string]any{domain.MetadataLanguage: "nl"},
+			}}, nil
+		},
+	})
+	ctx := context.Background()
+	french := []byte("La réunion est reportée à jeudi. Merci d'apporter les notes pour la revue du budget, et dites-moi si ce n'est pas possible.")
+
+	result, err := registry.Normalise(ctx, &domain.RawDocument{MIMEType: "text/test", Content: french})
+	require.NoError(t, err)
+	assert.Equal(t, "fr", domain.DocumentLanguage(result.Document.Metadata))
+
+	// Too short to tell, without a default
+	result, err = registry.Normalise(ctx, &domain.RawDocument{MIMEType: "text/test", Content: []byte("v1.2.3")})
+	require.NoError(t, err)
+	assert.Empty(t, domain.DocumentLanguage(result.Document.Metadata))
+
+	registry.SetDefaultLanguage("en")
+	result, err = registry.Normalise(ctx, &domain.RawDocument{MIMEType: "text/test", Content: []byte("v1.2.3")})
+	require.NoError(t, err)
+	assert.Equal(t, "en", domain.DocumentLanguage(result.Document.Metadata))
+
+	// A language set by the normaliser is kept
+	result, err = registry.Normalise(ctx, &domain.RawDocument{MIMEType: "text/tagged", Content: french})
+	require.NoError(t, err)
+	assert.Equal(t, "nl", domain.DocumentLanguage(result.Document.Metadata))
+}
+
 // TestRegistryConcurrentAccess verifies thread-safe concurrent operations.
 func TestRegistryConcurrentAccess(t *testing.T) {
 	registry := NewRegistry()
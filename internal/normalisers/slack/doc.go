@@ -0,0 +1,7 @@
+// Package slack provides a normaliser for Slack messages
+// (application/vnd.slack.message+json).
+//
+// Each document is a top-level message with its thread. The normaliser
+// flattens it into one searchable text block: the message, its link unfurls,
+// shared files and reactions, followed by every reply with its author.
+package slack
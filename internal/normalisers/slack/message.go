@@ -0,0 +1,222 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// MIMETypeSlackMessage is the custom MIME type for Slack messages and their threads.
+const MIMETypeSlackMessage = "application/vnd.slack.message+json"
+
+// Ensure MessageNormaliser implements the interface.
+var _ driven.Normaliser = (*MessageNormaliser)(nil)
+
+// MessageNormaliser handles Slack message documents.
+type MessageNormaliser struct{}
+
+// NewMessage creates a new Slack message normaliser.
+func NewMessage() *MessageNormaliser {
+	return &MessageNormaliser{}
+}
+
+// SupportedMIMETypes returns the MIME types this normaliser handles.
+func (n *MessageNormaliser) SupportedMIMETypes() []string {
+	return []string{MIMETypeSlackMessage}
+}
+
+// SupportedConnectorTypes returns connector types for specialised handling.
+func (n *MessageNormaliser) SupportedConnectorTypes() []string {
+	return []string{"slack"}
+}
+
+// Priority returns the selection priority.
+func (n *MessageNormaliser) Priority() int {
+	return 95 // Connector-specific priority
+}
+
+// ThreadContent represents the JSON content of a message and its replies.
+type ThreadContent struct {
+	Workspace string `json:"workspace"`
+	Channel   string `json:"channel"`
+	PostContent
+	Replies []PostContent `json:"replies"`
+}
+
+// PostContent represents a message or thread reply.
+type PostContent struct {
+	Author    string            `json:"author"`
+	AuthorID  string            `json:"author_id"`
+	Text      string            `json:"text"`
+	Timestamp time.Time         `json:"timestamp"`
+	Edited    bool              `json:"edited"`
+	Reactions []ReactionContent `json:"reactions"`
+	Links     []LinkContent     `json:"links"`
+	Files     []string          `json:"files"`
+}
+
+// ReactionContent represents an emoji reaction and how many people added it.
+type ReactionContent struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// LinkContent represents an unfurled link.
+type LinkContent struct {
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Text    string `json:"text"`
+	Service string `json:"service"`
+}
+
+// Normalise converts a Slack message document to a normalised document.
+func (n *MessageNormaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
+	var content ThreadContent
+	if err := json.Unmarshal(raw.Content, &content); err != nil {
+		return nil, fmt.Errorf("parse slack message content: %w", err)
+	}
+
+	title := fmt.Sprintf("%s in %s", content.Author, content.Channel)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n\n", title))
+	sb.WriteString(fmt.Sprintf("*%s | %s*\n\n", content.Workspace, content.Timestamp.Format("2006-01-02 15:04")))
+	writePost(&sb, &content.PostContent)
+
+	if len(content.Replies) > 0 {
+		sb.WriteString("## Replies\n\n")
+		for i := range content.Replies {
+			reply := &content.Replies[i]
+			sb.WriteString(fmt.Sprintf("### %s (%s)\n\n", reply.Author, reply.Timestamp.Format("2006-01-02 15:04")))
+			writePost(&sb, reply)
+		}
+	}
+
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     title,
+		Content:   strings.TrimRight(sb.String(), "\n") + "\n",
+		Author:    domain.Author{Name: content.Author, Identifier: content.AuthorID},
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// Add normaliser info to metadata
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	doc.Metadata["format"] = "slack_message"
+
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
+
+// writePost renders the text of a message or reply followed by its link
+// unfurls, files and reactions.
+func writePost(sb *strings.Builder, post *PostContent) {
+	if text := strings.TrimSpace(formatText(post.Text)); text != "" {
+		sb.WriteString(text)
+		if post.Edited {
+			sb.WriteString(" *(edited)*")
+		}
+		sb.WriteString("\n\n")
+	}
+
+	for _, link := range post.Links {
+		sb.WriteString("> ")
+		switch {
+		case link.Title != "" && link.URL != "":
+			sb.WriteString(fmt.Sprintf("[%s](%s)", link.Title, link.URL))
+		default:
+			sb.WriteString(link.Title + link.URL)
+		}
+		if link.Service != "" {
+			sb.WriteString(fmt.Sprintf(" (%s)", link.Service))
+		}
+		if text := strings.TrimSpace(formatText(link.Text)); text != "" {
+			sb.WriteString(": " + strings.ReplaceAll(text, "\n", " "))
+		}
+		sb.WriteString("\n")
+	}
+	if len(post.Links) > 0 {
+		sb.WriteString("\n")
+	}
+
+	if len(post.Files) > 0 {
+		sb.WriteString(fmt.Sprintf("Files: %s\n\n", strings.Join(post.Files, ", ")))
+	}
+
+	if len(post.Reactions) > 0 {
+		reactions := make([]string, len(post.Reactions))
+		for i, r := range post.Reactions {
+			reactions[i] = fmt.Sprintf(":%s: %d", r.Name, r.Count)
+		}
+		sb.WriteString(fmt.Sprintf("Reactions: %s\n\n", strings.Join(reactions, ", ")))
+	}
+}
+
+// slackToken matches the angle-bracket tokens of Slack's mrkdwn, such as
+// <https://example.com|label>, <#C123|general> and <!here>.
+var slackToken = regexp.MustCompile(`<([^<>]+)>`)
+
+// entities unescapes the characters Slack escapes in message text.
+var entities = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&")
+
+// formatText turns Slack mrkdwn tokens into plain text: links become
+// "label (url)", channel and user references their names, and special
+// mentions such as <!here> become "@here".
+func formatText(text string) string {
+	text = slackToken.ReplaceAllStringFunc(text, func(token string) string {
+		target, label, hasLabel := strings.Cut(token[1:len(token)-1], "|")
+		switch {
+		case strings.HasPrefix(target, "#"):
+			if hasLabel {
+				return "#" + label
+			}
+			return target
+		case strings.HasPrefix(target, "@"):
+			if hasLabel {
+				return "@" + label
+			}
+			return target
+		case strings.HasPrefix(target, "!"):
+			if hasLabel {
+				return label
+			}
+			return "@" + strings.TrimPrefix(target, "!")
+		case hasLabel && label != target:
+			return fmt.Sprintf("%s (%s)", label, target)
+		default:
+			return target
+		}
+	})
+	return entities.Replace(text)
+}
+
+// copyMetadata creates a shallow copy of metadata.
+func copyMetadata(src map[string]any) map[string]any {
+	if src == nil {
+		return nil
+	}
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
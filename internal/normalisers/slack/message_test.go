@@ -0,0 +1,100 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestMessageNormaliser_Interface(t *testing.T) {
+	n := NewMessage()
+
+	assert.Equal(t, []string{"application/vnd.slack.message+json"}, n.SupportedMIMETypes())
+	assert.Equal(t, []string{"slack"}, n.SupportedConnectorTypes())
+	assert.Equal(t, 95, n.Priority())
+}
+
+func TestMessageNormaliser_Normalise(t *testing.T) {
+	raw := &domain.RawDocument{
+		SourceID: "src-1",
+		URI:      "slack://T1/C1/1767225600.000100",
+		MIMEType: MIMETypeSlackMessage,
+		Content: []byte(`{
+			"workspace": "Acme",
+			"channel": "#general",
+			"author": "Ann",
+			"author_id": "U1",
+			"text": "Deploy failed, see <https://ci.example.com/1|the build> &amp; ping <!here> in <#C2|ops>",
+			"timestamp": "2026-01-01T00:00:00Z",
+			"edited": true,
+			"reactions": [{"name": "eyes", "count": 3}],
+			"links": [{"title": "Build #1", "url": "https://ci.example.com/1", "text": "Failed in 2m", "service": "CI"}],
+			"files": ["log.txt"],
+			"replies": [
+				{"author": "Bob", "author_id": "U2", "text": "looking", "timestamp": "2026-01-01T00:05:00Z",
+				 "reactions": [{"name": "+1", "count": 1}]},
+				{"author": "Ann", "author_id": "U1", "text": "fixed by <https://git.example.com/pr/2>", "timestamp": "2026-01-01T00:30:00Z"}
+			]
+		}`),
+		Metadata: map[string]any{"channel_id": "C1"},
+	}
+
+	result, err := NewMessage().Normalise(context.Background(), raw)
+
+	require.NoError(t, err)
+	doc := result.Document
+	assert.Equal(t, "Ann in #general", doc.Title)
+	assert.Equal(t, domain.Author{Name: "Ann", Identifier: "U1"}, doc.Author)
+	assert.Equal(t, raw.URI, doc.URI)
+	assert.Equal(t, "C1", doc.Metadata["channel_id"])
+	assert.Equal(t, "slack_message", doc.Metadata["format"])
+
+	assert.Contains(t, doc.Content, "*Acme | 2026-01-01 00:00*")
+	assert.Contains(t, doc.Content,
+		"Deploy failed, see the build (https://ci.example.com/1) & ping @here in #ops *(edited)*")
+	assert.Contains(t, doc.Content, "> [Build #1](https://ci.example.com/1) (CI): Failed in 2m")
+	assert.Contains(t, doc.Content, "Files: log.txt")
+	assert.Contains(t, doc.Content, "Reactions: :eyes: 3")
+	assert.Contains(t, doc.Content, "## Replies\n\n### Bob (2026-01-01 00:05)\n\nlooking\n\nReactions: :+1: 1")
+	assert.Contains(t, doc.Content, "### Ann (2026-01-01 00:30)\n\nfixed by https://git.example.com/pr/2")
+}
+
+func TestMessageNormaliser_Normalise_NoReplies(t *testing.T) {
+	raw := &domain.RawDocument{
+		MIMEType: MIMETypeSlackMessage,
+		Content:  []byte(`{"workspace": "Acme", "channel": "DM with Bob", "author": "Bob", "text": "hi"}`),
+	}
+
+	result, err := NewMessage().Normalise(context.Background(), raw)
+
+	require.NoError(t, err)
+	assert.NotContains(t, result.Document.Content, "Replies")
+	assert.Equal(t, "Bob in DM with Bob", result.Document.Title)
+}
+
+func TestMessageNormaliser_Normalise_Invalid(t *testing.T) {
+	_, err := NewMessage().Normalise(context.Background(), nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = NewMessage().Normalise(context.Background(), &domain.RawDocument{Content: []byte("not json")})
+	assert.Error(t, err)
+}
+
+func TestFormatText(t *testing.T) {
+	tests := map[string]string{
+		"<https://example.com>":                     "https://example.com",
+		"<https://example.com|https://example.com>": "https://example.com",
+		"<mailto:a@example.com|a@example.com>":      "a@example.com (mailto:a@example.com)",
+		"<@U1|ann>":                                 "@ann",
+		"<!channel>":                                "@channel",
+		"<!subteam^S1|@oncall>":                     "@oncall",
+		"a &lt;b&gt; c":                             "a <b> c",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, formatText(in), in)
+	}
+}
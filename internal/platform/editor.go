@@ -0,0 +1,24 @@
+package platform
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// EditorCommand returns a command that opens path in the user's editor,
+// taken from $VISUAL or $EDITOR. The variable may include arguments, as in
+// "code --wait". Returns nil when neither is set.
+func EditorCommand(path string) *exec.Cmd {
+	editor := strings.TrimSpace(os.Getenv("VISUAL"))
+	if editor == "" {
+		editor = strings.TrimSpace(os.Getenv("EDITOR"))
+	}
+	if editor == "" {
+		return nil
+	}
+
+	fields := strings.Fields(editor)
+	args := append(fields[1:], path)
+	return exec.Command(fields[0], args...) //nolint:gosec // G204: runs the editor the user configured
+}
@@ -0,0 +1,37 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditorCommand(t *testing.T) {
+	t.Run("prefers VISUAL", func(t *testing.T) {
+		t.Setenv("VISUAL", "code --wait")
+		t.Setenv("EDITOR", "vim")
+
+		cmd := EditorCommand("/home/ada/notes.md")
+
+		require.NotNil(t, cmd)
+		assert.Equal(t, []string{"code", "--wait", "/home/ada/notes.md"}, cmd.Args)
+	})
+
+	t.Run("falls back to EDITOR", func(t *testing.T) {
+		t.Setenv("VISUAL", "")
+		t.Setenv("EDITOR", "vim")
+
+		cmd := EditorCommand("/home/ada/notes.md")
+
+		require.NotNil(t, cmd)
+		assert.Equal(t, []string{"vim", "/home/ada/notes.md"}, cmd.Args)
+	})
+
+	t.Run("nil without an editor", func(t *testing.T) {
+		t.Setenv("VISUAL", "")
+		t.Setenv("EDITOR", " ")
+
+		assert.Nil(t, EditorCommand("/home/ada/notes.md"))
+	})
+}
@@ -0,0 +1,121 @@
+// Package platform wraps behaviour that differs between operating systems.
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Operating system identifiers.
+const (
+	osDarwin  = "darwin"
+	osLinux   = "linux"
+	osWindows = "windows"
+)
+
+// ErrEmptyURI is returned when there is nothing to open.
+var ErrEmptyURI = errors.New("no URI to open")
+
+// ErrNoHandler is returned for URIs the system cannot open, such as
+// connector-specific schemes without a web URL.
+var ErrNoHandler = errors.New("no application can open this URI")
+
+// openableSchemes are the URI schemes passed to the system handler.
+var openableSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// Overridden in tests so nothing is actually launched.
+var (
+	goos         = runtime.GOOS
+	startCommand = func(name string, args ...string) error {
+		return exec.Command(name, args...).Start()
+	}
+)
+
+// OpenURI opens a URL or local path with the system's default handler:
+// the browser for web URLs and the associated application for files.
+// file:// URIs are converted to URL-decoded local paths first.
+// It returns once the handler has started, without waiting for it.
+func OpenURI(uri string) error {
+	if strings.TrimSpace(uri) == "" {
+		return ErrEmptyURI
+	}
+
+	target := uri
+	if strings.HasPrefix(uri, "file://") {
+		target = filePath(uri)
+	} else if !hasOpenableScheme(uri) {
+		return fmt.Errorf("can't open %s: %w", uri, ErrNoHandler)
+	}
+
+	name, args, err := openCommand(goos, target)
+	if err != nil {
+		return err
+	}
+	if err := startCommand(name, args...); err != nil {
+		return fmt.Errorf("open %s: %w", target, err)
+	}
+	return nil
+}
+
+// LocalPath returns the local path of a file:// URI.
+// It reports false for any other URI.
+func LocalPath(uri string) (string, bool) {
+	if !strings.HasPrefix(uri, "file://") {
+		return "", false
+	}
+	return filePath(uri), true
+}
+
+// hasOpenableScheme reports whether uri is a web URL or a plain path.
+// A single-letter scheme is a Windows drive letter, not a scheme.
+func hasOpenableScheme(uri string) bool {
+	scheme, _, found := strings.Cut(uri, ":")
+	if !found || len(scheme) <= 1 || strings.ContainsAny(scheme, `/\ `) {
+		return true
+	}
+	return openableSchemes[strings.ToLower(scheme)]
+}
+
+// openCommand returns the command that opens target on the given OS.
+func openCommand(goos, target string) (string, []string, error) {
+	switch goos {
+	case osDarwin:
+		return "open", []string{target}, nil
+	case osLinux, "freebsd", "openbsd", "netbsd":
+		return "xdg-open", []string{target}, nil
+	case osWindows:
+		// Equivalent to "start", but without cmd.exe interpreting & and ^ in URLs
+		return "rundll32", []string{"url.dll,FileProtocolHandler", target}, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported platform: %s", goos)
+	}
+}
+
+// filePath converts a file:// URI to a local path, decoding escapes such
+// as %20. URIs that do not parse are passed on with the scheme removed.
+func filePath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return strings.TrimPrefix(uri, "file://")
+	}
+
+	path := u.Path
+	if u.Host != "" && u.Host != "localhost" {
+		// file://server/share/doc is a network path
+		path = "//" + u.Host + path
+	}
+	// file:///C:/dir/doc has a drive letter after the leading slash
+	if len(path) >= 3 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+	return filepath.FromSlash(path)
+}
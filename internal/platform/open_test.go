@@ -0,0 +1,122 @@
+package platform
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedCommand captures the command OpenURI would have started.
+type recordedCommand struct {
+	name string
+	args []string
+}
+
+// fakeStart replaces the command runner and OS for the duration of a test.
+func fakeStart(t *testing.T, os string, err error) *recordedCommand {
+	t.Helper()
+	recorded := &recordedCommand{}
+	oldGOOS, oldStart := goos, startCommand
+	goos = os
+	startCommand = func(name string, args ...string) error {
+		recorded.name = name
+		recorded.args = args
+		return err
+	}
+	t.Cleanup(func() {
+		goos, startCommand = oldGOOS, oldStart
+	})
+	return recorded
+}
+
+func TestOpenURI_Commands(t *testing.T) {
+	tests := []struct {
+		os   string
+		name string
+		args []string
+	}{
+		{"linux", "xdg-open", []string{"https://example.com/a?b=1&c=2"}},
+		{"freebsd", "xdg-open", []string{"https://example.com/a?b=1&c=2"}},
+		{"darwin", "open", []string{"https://example.com/a?b=1&c=2"}},
+		{"windows", "rundll32", []string{"url.dll,FileProtocolHandler", "https://example.com/a?b=1&c=2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.os, func(t *testing.T) {
+			recorded := fakeStart(t, tt.os, nil)
+
+			require.NoError(t, OpenURI("https://example.com/a?b=1&c=2"))
+			assert.Equal(t, tt.name, recorded.name)
+			assert.Equal(t, tt.args, recorded.args)
+		})
+	}
+}
+
+func TestOpenURI_FileURIIsDecoded(t *testing.T) {
+	recorded := fakeStart(t, "linux", nil)
+
+	require.NoError(t, OpenURI("file:///home/ada/My%20Notes/caf%C3%A9.md"))
+	assert.Equal(t, []string{"/home/ada/My Notes/café.md"}, recorded.args)
+}
+
+func TestOpenURI_PlainPathUnchanged(t *testing.T) {
+	recorded := fakeStart(t, "linux", nil)
+
+	require.NoError(t, OpenURI("/home/ada/100% done.md"))
+	assert.Equal(t, []string{"/home/ada/100% done.md"}, recorded.args)
+}
+
+func TestOpenURI_Errors(t *testing.T) {
+	fakeStart(t, "linux", errors.New("xdg-open not found"))
+	err := OpenURI("https://example.com")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "xdg-open not found")
+
+	assert.ErrorIs(t, OpenURI("  "), ErrEmptyURI)
+
+	fakeStart(t, "plan9", nil)
+	assert.EqualError(t, OpenURI("https://example.com"), "unsupported platform: plan9")
+}
+
+func TestFilePath(t *testing.T) {
+	tests := []struct {
+		uri      string
+		expected string
+	}{
+		{"file:///tmp/a%20b.txt", "/tmp/a b.txt"},
+		{"file://localhost/tmp/a.txt", "/tmp/a.txt"},
+		{"file:///C:/Users/ada/doc.txt", "C:/Users/ada/doc.txt"},
+		{"file://server/share/doc.txt", "//server/share/doc.txt"},
+		{"file:///tmp/100%.txt", "/tmp/100%.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			assert.Equal(t, tt.expected, filePath(tt.uri))
+		})
+	}
+}
+
+func TestOpenURI_NoHandler(t *testing.T) {
+	recorded := fakeStart(t, "linux", nil)
+
+	err := OpenURI("gmail://message/123")
+
+	require.ErrorIs(t, err, ErrNoHandler)
+	assert.Contains(t, err.Error(), "can't open gmail://message/123")
+	assert.Empty(t, recorded.name)
+
+	require.NoError(t, OpenURI(`C:\Users\ada\notes.md`))
+	require.NoError(t, OpenURI("mailto:ada@example.com"))
+}
+
+func TestLocalPath(t *testing.T) {
+	path, ok := LocalPath("file:///home/ada/My%20Notes.md")
+	assert.True(t, ok)
+	assert.Equal(t, "/home/ada/My Notes.md", path)
+
+	_, ok = LocalPath("https://example.com")
+	assert.False(t, ok)
+}
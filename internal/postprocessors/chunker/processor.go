@@ -1,8 +1,11 @@
 // Package chunker provides a fixed-size text chunking processor.
+// Chunks are sized in characters by default, or in tokens when a token
+// budget is set, so they fit the input limit of the embedding model.
 package chunker
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -15,11 +18,21 @@ const DefaultChunkSize = 1000
 // DefaultChunkOverlap is the default number of overlapping characters.
 const DefaultChunkOverlap = 200
 
+// DefaultOverlapTokens is the default number of overlapping tokens
+// when chunks are sized in tokens.
+const DefaultOverlapTokens = 32
+
 // Processor splits document content into fixed-size chunks.
 // It implements the PostProcessor interface.
 type Processor struct {
 	chunkSize int
 	overlap   int
+
+	// Token sizing, used when chunkTokens is positive
+	chunkTokens   int
+	overlapTokens int
+	maxTokens     int
+	tokenizer     Tokenizer
 }
 
 // Option configures the chunker processor.
@@ -43,11 +56,52 @@ func WithOverlap(overlap int) Option {
 	}
 }
 
+// WithChunkTokens sizes chunks in tokens instead of characters.
+func WithChunkTokens(tokens int) Option {
+	return func(p *Processor) {
+		if tokens > 0 {
+			p.chunkTokens = tokens
+		}
+	}
+}
+
+// WithOverlapTokens sets the overlap between chunks in tokens.
+// Only used when chunks are sized in tokens.
+func WithOverlapTokens(tokens int) Option {
+	return func(p *Processor) {
+		if tokens >= 0 {
+			p.overlapTokens = tokens
+		}
+	}
+}
+
+// WithMaxTokens caps the chunk token budget at the embedding model's
+// maximum input length, so no chunk is rejected as too long.
+func WithMaxTokens(tokens int) Option {
+	return func(p *Processor) {
+		if tokens > 0 {
+			p.maxTokens = tokens
+		}
+	}
+}
+
+// WithTokenizer sets the tokenizer used to count tokens.
+// Defaults to WordTokenizer.
+func WithTokenizer(tokenizer Tokenizer) Option {
+	return func(p *Processor) {
+		if tokenizer != nil {
+			p.tokenizer = tokenizer
+		}
+	}
+}
+
 // New creates a new chunker processor with the given options.
 func New(opts ...Option) *Processor {
 	p := &Processor{
-		chunkSize: DefaultChunkSize,
-		overlap:   DefaultChunkOverlap,
+		chunkSize:     DefaultChunkSize,
+		overlap:       DefaultChunkOverlap,
+		overlapTokens: DefaultOverlapTokens,
+		tokenizer:     WordTokenizer{},
 	}
 
 	for _, opt := range opts {
@@ -59,9 +113,22 @@ func New(opts ...Option) *Processor {
 		p.overlap = p.chunkSize / 4
 	}
 
+	if p.maxTokens > 0 && p.chunkTokens > p.maxTokens {
+		p.chunkTokens = p.maxTokens
+	}
+	if p.overlapTokens >= p.chunkTokens {
+		p.overlapTokens = p.chunkTokens / 4
+	}
+
 	return p
 }
 
+// ChunkTokens returns the token budget per chunk, or 0 when chunks are
+// sized in characters.
+func (p *Processor) ChunkTokens() int {
+	return p.chunkTokens
+}
+
 // Name returns the processor name.
 func (p *Processor) Name() string {
 	return "chunker"
@@ -74,6 +141,9 @@ func (p *Processor) Process(ctx context.Context, doc *domain.Document, _ []domai
 		// Empty content produces no chunks
 		return nil, nil
 	}
+	if p.chunkTokens > 0 {
+		return p.processTokens(doc), nil
+	}
 
 	content := doc.Content
 	contentLen := len(content)
@@ -115,3 +185,44 @@ func (p *Processor) Process(ctx context.Context, doc *domain.Document, _ []domai
 
 	return chunks, nil
 }
+
+// processTokens splits the document content into chunks of at most
+// chunkTokens estimated tokens, overlapping by up to overlapTokens.
+func (p *Processor) processTokens(doc *domain.Document) []domain.Chunk {
+	tokens := p.tokenizer.Split(doc.Content)
+
+	var chunks []domain.Chunk
+	for start := 0; start < len(tokens); {
+		// A chunk always takes at least one token, even one over budget
+		end, size := start+1, len(tokens[start])
+		for end < len(tokens) && estimateTokens(end+1-start, size+len(tokens[end])) <= p.chunkTokens {
+			size += len(tokens[end])
+			end++
+		}
+
+		chunks = append(chunks, domain.Chunk{
+			ID:         uuid.New().String(),
+			DocumentID: doc.ID,
+			Content:    strings.Join(tokens[start:end], ""),
+			Position:   len(chunks),
+			Metadata:   map[string]any{"tokens": estimateTokens(end-start, size)},
+		})
+
+		if end == len(tokens) {
+			break
+		}
+		start = p.overlapStart(tokens, start, end)
+	}
+	return chunks
+}
+
+// overlapStart returns where the chunk after tokens[start:end] begins: early
+// enough to repeat up to overlapTokens estimated tokens, but after start.
+func (p *Processor) overlapStart(tokens []string, start, end int) int {
+	next, size := end, 0
+	for next-1 > start && estimateTokens(end-next+1, size+len(tokens[next-1])) <= p.overlapTokens {
+		next--
+		size += len(tokens[next])
+	}
+	return next
+}
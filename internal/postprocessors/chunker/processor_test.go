@@ -237,3 +237,83 @@ func TestProcessor_Process_MetadataInitialized(t *testing.T) {
 		}
 	}
 }
+
+func TestNew_TokenOptions(t *testing.T) {
+	t.Run("capped at model max tokens", func(t *testing.T) {
+		p := New(WithChunkTokens(1000), WithMaxTokens(256))
+		if p.ChunkTokens() != 256 {
+			t.Errorf("expected chunkTokens 256, got %d", p.ChunkTokens())
+		}
+	})
+
+	t.Run("overlap exceeds chunk tokens", func(t *testing.T) {
+		p := New(WithChunkTokens(40), WithOverlapTokens(50))
+		if p.overlapTokens != 10 {
+			t.Errorf("expected overlapTokens 10, got %d", p.overlapTokens)
+		}
+	})
+
+	t.Run("character sizing by default", func(t *testing.T) {
+		p := New()
+		if p.ChunkTokens() != 0 {
+			t.Errorf("expected chunkTokens 0, got %d", p.ChunkTokens())
+		}
+	})
+}
+
+func TestProcessor_Process_TokenChunks(t *testing.T) {
+	p := New(WithChunkTokens(10), WithOverlapTokens(2))
+	content := strings.TrimSpace(strings.Repeat("word ", 25))
+	doc := &domain.Document{ID: "doc-1", Content: content}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 25 tokens of about five bytes count as one token per three bytes:
+	// six tokens per chunk, each repeating the last token of the one before
+	if len(chunks) != 5 {
+		t.Fatalf("expected 5 chunks, got %d", len(chunks))
+	}
+	for i, chunk := range chunks {
+		if chunk.Position != i {
+			t.Errorf("chunk %d: expected position %d, got %d", i, i, chunk.Position)
+		}
+		if chunk.DocumentID != "doc-1" {
+			t.Errorf("chunk %d: expected document ID doc-1, got %s", i, chunk.DocumentID)
+		}
+	}
+	if chunks[0].Metadata["tokens"] != 10 || chunks[4].Metadata["tokens"] != 9 {
+		t.Errorf("unexpected token counts: %v, %v", chunks[0].Metadata["tokens"], chunks[4].Metadata["tokens"])
+	}
+	if !strings.HasPrefix(chunks[1].Content, " word") || strings.Count(chunks[1].Content, "word") != 6 {
+		t.Errorf("unexpected second chunk content: %q", chunks[1].Content)
+	}
+	if !strings.HasPrefix(chunks[0].Content, "word word") {
+		t.Errorf("unexpected first chunk content: %q", chunks[0].Content)
+	}
+}
+
+func TestProcessor_Process_TokenChunksBoundBytes(t *testing.T) {
+	p := New(WithChunkTokens(16), WithOverlapTokens(4))
+	content := strings.Repeat("日本語のテキスト getHTTPResponseStatusCode(ctx) ", 20)
+	doc := &domain.Document{ID: "doc-1", Content: content}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, chunk := range chunks {
+		if tokens, _ := chunk.Metadata["tokens"].(int); tokens > 16 {
+			t.Errorf("chunk %d: estimated %d tokens, over the budget of 16", i, tokens)
+		}
+		if len(chunk.Content) > 16*3 {
+			t.Errorf("chunk %d: %d bytes, more than three per token", i, len(chunk.Content))
+		}
+	}
+	if !strings.HasSuffix(chunks[len(chunks)-1].Content, "(ctx) ") {
+		t.Errorf("last chunk does not end the content: %q", chunks[len(chunks)-1].Content)
+	}
+}
@@ -0,0 +1,131 @@
+package chunker
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tokenizer splits text into tokens for token-based chunk sizing.
+// Concatenating the tokens must reproduce the text exactly, so chunks
+// keep the original content.
+type Tokenizer interface {
+	Split(text string) []string
+}
+
+// Limits used by WordTokenizer to approximate BPE vocabularies.
+const (
+	// maxWholeWordRunes is the longest word counted as a single token.
+	maxWholeWordRunes = 8
+
+	// wordPieceRunes is the size of the pieces longer words are split into.
+	wordPieceRunes = 4
+
+	// maxDigitRunes is the longest run of digits in one token.
+	maxDigitRunes = 3
+
+	// bytesPerToken is the fewest bytes of text a real tokenizer is assumed
+	// to fit in one token when estimating an upper bound.
+	bytesPerToken = 3
+)
+
+// WordTokenizer approximates the BPE tokenizers used by embedding models,
+// such as OpenAI's cl100k_base, without shipping their vocabularies.
+// It splits text the way BPE pre-tokenisation does: a word takes its leading
+// space, numbers are split into groups of up to three digits, and each
+// punctuation mark and CJK character is a token. Words of up to eight runes
+// count as one token and longer words are split into four-rune pieces.
+//
+// A real tokenizer often needs more tokens than this for CJK text, rare
+// words and identifiers, so the count alone is not an upper bound; the
+// chunker sizes chunks with estimateTokens instead.
+type WordTokenizer struct{}
+
+// Split splits text into approximate tokens.
+func (WordTokenizer) Split(text string) []string {
+	var tokens []string
+	for start := 0; start < len(text); {
+		end := start + nextTokenLen(text[start:])
+		tokens = append(tokens, text[start:end])
+		start = end
+	}
+	return tokens
+}
+
+// estimateTokens returns an upper bound on the model tokens in a run of count
+// tokens from Split that spans size bytes: the larger of the token count and
+// one token per bytesPerToken bytes.
+func estimateTokens(count, size int) int {
+	return max(count, (size+bytesPerToken-1)/bytesPerToken)
+}
+
+// nextTokenLen returns the length in bytes of the token at the start of s.
+func nextTokenLen(s string) int {
+	r, size := utf8.DecodeRuneInString(s)
+
+	// A single space joins the word, number or punctuation that follows it
+	if r == ' ' && len(s) > size {
+		next, _ := utf8.DecodeRuneInString(s[size:])
+		if !unicode.IsSpace(next) {
+			return size + nextTokenLen(s[size:])
+		}
+	}
+
+	switch {
+	case unicode.IsSpace(r):
+		// A trailing space before text is left to join the next token
+		n := runLen(s, unicode.IsSpace, -1)
+		if n > 1 && n < len(s) && s[n-1] == ' ' {
+			n--
+		}
+		return n
+	case isCJK(r):
+		return size
+	case isWordRune(r):
+		n := runeCount(s, isWordRune)
+		if n <= maxWholeWordRunes {
+			return runLen(s, isWordRune, n)
+		}
+		return runLen(s, isWordRune, wordPieceRunes)
+	case unicode.IsDigit(r):
+		return runLen(s, unicode.IsDigit, maxDigitRunes)
+	default:
+		return size
+	}
+}
+
+// runLen returns the byte length of the leading run of runes matching fn,
+// stopping after limit runes when limit is positive.
+func runLen(s string, fn func(rune) bool, limit int) int {
+	n, count := 0, 0
+	for _, r := range s {
+		if !fn(r) || (limit > 0 && count == limit) {
+			break
+		}
+		n += utf8.RuneLen(r)
+		count++
+	}
+	return n
+}
+
+// runeCount returns the number of leading runes matching fn.
+func runeCount(s string, fn func(rune) bool) int {
+	count := 0
+	for _, r := range s {
+		if !fn(r) {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// isWordRune reports whether r continues a word. CJK characters are
+// tokens of their own.
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsMark(r)) && !isCJK(r)
+}
+
+// isCJK reports whether r is a Chinese, Japanese or Korean character.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
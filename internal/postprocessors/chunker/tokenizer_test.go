@@ -0,0 +1,41 @@
+package chunker
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWordTokenizer_Split(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"words take leading space", "hello world", []string{"hello", " world"}},
+		{"punctuation", "end.", []string{"end", "."}},
+		{"digits in groups of three", "1234567", []string{"123", "456", "7"}},
+		{"long words split", "internationalisation", []string{"inte", "rnat", "iona", "lisation"}},
+		{"whitespace runs", "a\n\n b", []string{"a", "\n\n", " b"}},
+		{"CJK characters", "日本語", []string{"日", "本", "語"}},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WordTokenizer{}.Split(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordTokenizer_Split_RoundTrip(t *testing.T) {
+	text := "Sercha indexes 12,000 documents — ünïcödé, 日本語 and code: fmt.Println(x)\n\tdone  "
+
+	tokens := WordTokenizer{}.Split(text)
+	if got := strings.Join(tokens, ""); got != text {
+		t.Errorf("tokens do not reproduce text: %q", got)
+	}
+}
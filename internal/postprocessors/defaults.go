@@ -1,6 +1,7 @@
 package postprocessors
 
 import (
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/postprocessors/chunker"
 )
@@ -15,6 +16,9 @@ func RegisterDefaults(r *Registry) {
 // Supported config keys:
 //   - chunk_size (int): Characters per chunk (default: 1000)
 //   - overlap (int): Overlapping characters between chunks (default: 200)
+//   - chunk_tokens (int): Tokens per chunk; replaces chunk_size when set
+//   - overlap_tokens (int): Overlapping tokens between chunks (default: 32)
+//   - model (string): Embedding model whose input limit caps chunk_tokens
 func buildChunker(cfg map[string]any) (driven.PostProcessor, error) {
 	var opts []chunker.Option
 
@@ -25,6 +29,15 @@ func buildChunker(cfg map[string]any) (driven.PostProcessor, error) {
 		if overlap := getIntFromConfig(cfg, "overlap"); overlap >= 0 {
 			opts = append(opts, chunker.WithOverlap(overlap))
 		}
+		if tokens := getIntFromConfig(cfg, "chunk_tokens"); tokens > 0 {
+			opts = append(opts, chunker.WithChunkTokens(tokens))
+		}
+		if _, ok := cfg["overlap_tokens"]; ok {
+			opts = append(opts, chunker.WithOverlapTokens(getIntFromConfig(cfg, "overlap_tokens")))
+		}
+		if model, ok := cfg["model"].(string); ok {
+			opts = append(opts, chunker.WithMaxTokens(domain.EmbeddingMaxTokens()[model]))
+		}
 	}
 
 	return chunker.New(opts...), nil
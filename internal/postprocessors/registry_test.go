@@ -6,6 +6,7 @@ import (
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/postprocessors/chunker"
 )
 
 // registryMockProcessor is a simple mock for testing registry functionality.
@@ -148,6 +149,31 @@ func TestBuildChunker_WithConfig(t *testing.T) {
 	}
 }
 
+func TestBuildChunker_WithTokenConfig(t *testing.T) {
+	r := NewRegistry()
+	RegisterDefaults(r)
+
+	cfg := map[string]any{
+		"chunk_tokens":   1000,
+		"overlap_tokens": 50,
+		"model":          "all-minilm",
+	}
+
+	proc, err := r.Build("chunker", cfg)
+	if err != nil {
+		t.Fatalf("Build chunker failed: %v", err)
+	}
+
+	c, ok := proc.(*chunker.Processor)
+	if !ok {
+		t.Fatalf("expected *chunker.Processor, got %T", proc)
+	}
+	// all-minilm accepts at most 256 tokens
+	if c.ChunkTokens() != 256 {
+		t.Errorf("expected chunk tokens 256, got %d", c.ChunkTokens())
+	}
+}
+
 func TestBuildChunker_WithNilConfig(t *testing.T) {
 	r := NewRegistry()
 	RegisterDefaults(r)